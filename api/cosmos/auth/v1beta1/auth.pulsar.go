@@ -3,6 +3,7 @@ package authv1beta1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -1284,6 +1285,7 @@ var (
 	md_ModuleCredential                 protoreflect.MessageDescriptor
 	fd_ModuleCredential_module_name     protoreflect.FieldDescriptor
 	fd_ModuleCredential_derivation_keys protoreflect.FieldDescriptor
+	fd_ModuleCredential_account_address protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1291,6 +1293,7 @@ func init() {
 	md_ModuleCredential = File_cosmos_auth_v1beta1_auth_proto.Messages().ByName("ModuleCredential")
 	fd_ModuleCredential_module_name = md_ModuleCredential.Fields().ByName("module_name")
 	fd_ModuleCredential_derivation_keys = md_ModuleCredential.Fields().ByName("derivation_keys")
+	fd_ModuleCredential_account_address = md_ModuleCredential.Fields().ByName("account_address")
 }
 
 var _ protoreflect.Message = (*fastReflection_ModuleCredential)(nil)
@@ -1370,6 +1373,12 @@ func (x *fastReflection_ModuleCredential) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if len(x.AccountAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.AccountAddress)
+		if !f(fd_ModuleCredential_account_address, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1389,6 +1398,8 @@ func (x *fastReflection_ModuleCredential) Has(fd protoreflect.FieldDescriptor) b
 		return x.ModuleName != ""
 	case "cosmos.auth.v1beta1.ModuleCredential.derivation_keys":
 		return len(x.DerivationKeys) != 0
+	case "cosmos.auth.v1beta1.ModuleCredential.account_address":
+		return len(x.AccountAddress) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.ModuleCredential"))
@@ -1409,6 +1420,8 @@ func (x *fastReflection_ModuleCredential) Clear(fd protoreflect.FieldDescriptor)
 		x.ModuleName = ""
 	case "cosmos.auth.v1beta1.ModuleCredential.derivation_keys":
 		x.DerivationKeys = nil
+	case "cosmos.auth.v1beta1.ModuleCredential.account_address":
+		x.AccountAddress = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.ModuleCredential"))
@@ -1434,6 +1447,9 @@ func (x *fastReflection_ModuleCredential) Get(descriptor protoreflect.FieldDescr
 		}
 		listValue := &_ModuleCredential_2_list{list: &x.DerivationKeys}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.ModuleCredential.account_address":
+		value := x.AccountAddress
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.ModuleCredential"))
@@ -1460,6 +1476,8 @@ func (x *fastReflection_ModuleCredential) Set(fd protoreflect.FieldDescriptor, v
 		lv := value.List()
 		clv := lv.(*_ModuleCredential_2_list)
 		x.DerivationKeys = *clv.list
+	case "cosmos.auth.v1beta1.ModuleCredential.account_address":
+		x.AccountAddress = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.ModuleCredential"))
@@ -1488,6 +1506,8 @@ func (x *fastReflection_ModuleCredential) Mutable(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfList(value)
 	case "cosmos.auth.v1beta1.ModuleCredential.module_name":
 		panic(fmt.Errorf("field module_name of message cosmos.auth.v1beta1.ModuleCredential is not mutable"))
+	case "cosmos.auth.v1beta1.ModuleCredential.account_address":
+		panic(fmt.Errorf("field account_address of message cosmos.auth.v1beta1.ModuleCredential is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.ModuleCredential"))
@@ -1506,6 +1526,8 @@ func (x *fastReflection_ModuleCredential) NewField(fd protoreflect.FieldDescript
 	case "cosmos.auth.v1beta1.ModuleCredential.derivation_keys":
 		list := [][]byte{}
 		return protoreflect.ValueOfList(&_ModuleCredential_2_list{list: &list})
+	case "cosmos.auth.v1beta1.ModuleCredential.account_address":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.ModuleCredential"))
@@ -1585,6 +1607,10 @@ func (x *fastReflection_ModuleCredential) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.AccountAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1614,6 +1640,13 @@ func (x *fastReflection_ModuleCredential) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AccountAddress) > 0 {
+			i -= len(x.AccountAddress)
+			copy(dAtA[i:], x.AccountAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccountAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.DerivationKeys) > 0 {
 			for iNdEx := len(x.DerivationKeys) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.DerivationKeys[iNdEx])
@@ -1743,6 +1776,40 @@ func (x *fastReflection_ModuleCredential) ProtoMethods() *protoiface.Methods {
 				x.DerivationKeys = append(x.DerivationKeys, make([]byte, postIndex-iNdEx))
 				copy(x.DerivationKeys[len(x.DerivationKeys)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccountAddress = append(x.AccountAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.AccountAddress == nil {
+					x.AccountAddress = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1778,13 +1845,210 @@ func (x *fastReflection_ModuleCredential) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_8_list)(nil)
+
+type _Params_8_list struct {
+	list *[]string
+}
+
+func (x *_Params_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field PriorityMsgTypeUrls as it is not of Message kind"))
+}
+
+func (x *_Params_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]string
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field FeeExemptMsgTypeUrls as it is not of Message kind"))
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]string
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field FeeExemptAccounts as it is not of Message kind"))
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_max_memo_characters       protoreflect.FieldDescriptor
-	fd_Params_tx_sig_limit              protoreflect.FieldDescriptor
-	fd_Params_tx_size_cost_per_byte     protoreflect.FieldDescriptor
-	fd_Params_sig_verify_cost_ed25519   protoreflect.FieldDescriptor
-	fd_Params_sig_verify_cost_secp256k1 protoreflect.FieldDescriptor
+	md_Params                              protoreflect.MessageDescriptor
+	fd_Params_max_memo_characters          protoreflect.FieldDescriptor
+	fd_Params_tx_sig_limit                 protoreflect.FieldDescriptor
+	fd_Params_tx_size_cost_per_byte        protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_ed25519      protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_secp256k1    protoreflect.FieldDescriptor
+	fd_Params_enable_ed25519               protoreflect.FieldDescriptor
+	fd_Params_enable_secp256r1             protoreflect.FieldDescriptor
+	fd_Params_priority_msg_type_urls       protoreflect.FieldDescriptor
+	fd_Params_account_creation_fee         protoreflect.FieldDescriptor
+	fd_Params_fee_exempt_msg_type_urls     protoreflect.FieldDescriptor
+	fd_Params_fee_exempt_accounts          protoreflect.FieldDescriptor
+	fd_Params_max_fee_exempt_txs_per_block protoreflect.FieldDescriptor
+	fd_Params_track_account_activity       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1795,6 +2059,14 @@ func init() {
 	fd_Params_tx_size_cost_per_byte = md_Params.Fields().ByName("tx_size_cost_per_byte")
 	fd_Params_sig_verify_cost_ed25519 = md_Params.Fields().ByName("sig_verify_cost_ed25519")
 	fd_Params_sig_verify_cost_secp256k1 = md_Params.Fields().ByName("sig_verify_cost_secp256k1")
+	fd_Params_enable_ed25519 = md_Params.Fields().ByName("enable_ed25519")
+	fd_Params_enable_secp256r1 = md_Params.Fields().ByName("enable_secp256r1")
+	fd_Params_priority_msg_type_urls = md_Params.Fields().ByName("priority_msg_type_urls")
+	fd_Params_account_creation_fee = md_Params.Fields().ByName("account_creation_fee")
+	fd_Params_fee_exempt_msg_type_urls = md_Params.Fields().ByName("fee_exempt_msg_type_urls")
+	fd_Params_fee_exempt_accounts = md_Params.Fields().ByName("fee_exempt_accounts")
+	fd_Params_max_fee_exempt_txs_per_block = md_Params.Fields().ByName("max_fee_exempt_txs_per_block")
+	fd_Params_track_account_activity = md_Params.Fields().ByName("track_account_activity")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -1892,6 +2164,54 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.EnableEd25519 != false {
+		value := protoreflect.ValueOfBool(x.EnableEd25519)
+		if !f(fd_Params_enable_ed25519, value) {
+			return
+		}
+	}
+	if x.EnableSecp256R1 != false {
+		value := protoreflect.ValueOfBool(x.EnableSecp256R1)
+		if !f(fd_Params_enable_secp256r1, value) {
+			return
+		}
+	}
+	if len(x.PriorityMsgTypeUrls) != 0 {
+		value := protoreflect.ValueOfList(&_Params_8_list{list: &x.PriorityMsgTypeUrls})
+		if !f(fd_Params_priority_msg_type_urls, value) {
+			return
+		}
+	}
+	if len(x.AccountCreationFee) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.AccountCreationFee})
+		if !f(fd_Params_account_creation_fee, value) {
+			return
+		}
+	}
+	if len(x.FeeExemptMsgTypeUrls) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.FeeExemptMsgTypeUrls})
+		if !f(fd_Params_fee_exempt_msg_type_urls, value) {
+			return
+		}
+	}
+	if len(x.FeeExemptAccounts) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.FeeExemptAccounts})
+		if !f(fd_Params_fee_exempt_accounts, value) {
+			return
+		}
+	}
+	if x.MaxFeeExemptTxsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxFeeExemptTxsPerBlock)
+		if !f(fd_Params_max_fee_exempt_txs_per_block, value) {
+			return
+		}
+	}
+	if x.TrackAccountActivity != false {
+		value := protoreflect.ValueOfBool(x.TrackAccountActivity)
+		if !f(fd_Params_track_account_activity, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1917,6 +2237,22 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SigVerifyCostEd25519 != uint64(0)
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		return x.SigVerifyCostSecp256K1 != uint64(0)
+	case "cosmos.auth.v1beta1.Params.enable_ed25519":
+		return x.EnableEd25519 != false
+	case "cosmos.auth.v1beta1.Params.enable_secp256r1":
+		return x.EnableSecp256R1 != false
+	case "cosmos.auth.v1beta1.Params.priority_msg_type_urls":
+		return len(x.PriorityMsgTypeUrls) != 0
+	case "cosmos.auth.v1beta1.Params.account_creation_fee":
+		return len(x.AccountCreationFee) != 0
+	case "cosmos.auth.v1beta1.Params.fee_exempt_msg_type_urls":
+		return len(x.FeeExemptMsgTypeUrls) != 0
+	case "cosmos.auth.v1beta1.Params.fee_exempt_accounts":
+		return len(x.FeeExemptAccounts) != 0
+	case "cosmos.auth.v1beta1.Params.max_fee_exempt_txs_per_block":
+		return x.MaxFeeExemptTxsPerBlock != uint64(0)
+	case "cosmos.auth.v1beta1.Params.track_account_activity":
+		return x.TrackAccountActivity != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -1943,6 +2279,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SigVerifyCostEd25519 = uint64(0)
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		x.SigVerifyCostSecp256K1 = uint64(0)
+	case "cosmos.auth.v1beta1.Params.enable_ed25519":
+		x.EnableEd25519 = false
+	case "cosmos.auth.v1beta1.Params.enable_secp256r1":
+		x.EnableSecp256R1 = false
+	case "cosmos.auth.v1beta1.Params.priority_msg_type_urls":
+		x.PriorityMsgTypeUrls = nil
+	case "cosmos.auth.v1beta1.Params.account_creation_fee":
+		x.AccountCreationFee = nil
+	case "cosmos.auth.v1beta1.Params.fee_exempt_msg_type_urls":
+		x.FeeExemptMsgTypeUrls = nil
+	case "cosmos.auth.v1beta1.Params.fee_exempt_accounts":
+		x.FeeExemptAccounts = nil
+	case "cosmos.auth.v1beta1.Params.max_fee_exempt_txs_per_block":
+		x.MaxFeeExemptTxsPerBlock = uint64(0)
+	case "cosmos.auth.v1beta1.Params.track_account_activity":
+		x.TrackAccountActivity = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -1974,6 +2326,42 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		value := x.SigVerifyCostSecp256K1
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.enable_ed25519":
+		value := x.EnableEd25519
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.auth.v1beta1.Params.enable_secp256r1":
+		value := x.EnableSecp256R1
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.auth.v1beta1.Params.priority_msg_type_urls":
+		if len(x.PriorityMsgTypeUrls) == 0 {
+			return protoreflect.ValueOfList(&_Params_8_list{})
+		}
+		listValue := &_Params_8_list{list: &x.PriorityMsgTypeUrls}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.Params.account_creation_fee":
+		if len(x.AccountCreationFee) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.AccountCreationFee}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.Params.fee_exempt_msg_type_urls":
+		if len(x.FeeExemptMsgTypeUrls) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.FeeExemptMsgTypeUrls}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.Params.fee_exempt_accounts":
+		if len(x.FeeExemptAccounts) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.FeeExemptAccounts}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.Params.max_fee_exempt_txs_per_block":
+		value := x.MaxFeeExemptTxsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.track_account_activity":
+		value := x.TrackAccountActivity
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2004,6 +2392,30 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SigVerifyCostEd25519 = value.Uint()
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		x.SigVerifyCostSecp256K1 = value.Uint()
+	case "cosmos.auth.v1beta1.Params.enable_ed25519":
+		x.EnableEd25519 = value.Bool()
+	case "cosmos.auth.v1beta1.Params.enable_secp256r1":
+		x.EnableSecp256R1 = value.Bool()
+	case "cosmos.auth.v1beta1.Params.priority_msg_type_urls":
+		lv := value.List()
+		clv := lv.(*_Params_8_list)
+		x.PriorityMsgTypeUrls = *clv.list
+	case "cosmos.auth.v1beta1.Params.account_creation_fee":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.AccountCreationFee = *clv.list
+	case "cosmos.auth.v1beta1.Params.fee_exempt_msg_type_urls":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.FeeExemptMsgTypeUrls = *clv.list
+	case "cosmos.auth.v1beta1.Params.fee_exempt_accounts":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.FeeExemptAccounts = *clv.list
+	case "cosmos.auth.v1beta1.Params.max_fee_exempt_txs_per_block":
+		x.MaxFeeExemptTxsPerBlock = value.Uint()
+	case "cosmos.auth.v1beta1.Params.track_account_activity":
+		x.TrackAccountActivity = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2024,6 +2436,30 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.auth.v1beta1.Params.priority_msg_type_urls":
+		if x.PriorityMsgTypeUrls == nil {
+			x.PriorityMsgTypeUrls = []string{}
+		}
+		value := &_Params_8_list{list: &x.PriorityMsgTypeUrls}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.auth.v1beta1.Params.account_creation_fee":
+		if x.AccountCreationFee == nil {
+			x.AccountCreationFee = []*v1beta1.Coin{}
+		}
+		value := &_Params_9_list{list: &x.AccountCreationFee}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.auth.v1beta1.Params.fee_exempt_msg_type_urls":
+		if x.FeeExemptMsgTypeUrls == nil {
+			x.FeeExemptMsgTypeUrls = []string{}
+		}
+		value := &_Params_10_list{list: &x.FeeExemptMsgTypeUrls}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.auth.v1beta1.Params.fee_exempt_accounts":
+		if x.FeeExemptAccounts == nil {
+			x.FeeExemptAccounts = []string{}
+		}
+		value := &_Params_11_list{list: &x.FeeExemptAccounts}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.auth.v1beta1.Params.max_memo_characters":
 		panic(fmt.Errorf("field max_memo_characters of message cosmos.auth.v1beta1.Params is not mutable"))
 	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
@@ -2034,6 +2470,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field sig_verify_cost_ed25519 of message cosmos.auth.v1beta1.Params is not mutable"))
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		panic(fmt.Errorf("field sig_verify_cost_secp256k1 of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.enable_ed25519":
+		panic(fmt.Errorf("field enable_ed25519 of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.enable_secp256r1":
+		panic(fmt.Errorf("field enable_secp256r1 of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.max_fee_exempt_txs_per_block":
+		panic(fmt.Errorf("field max_fee_exempt_txs_per_block of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.track_account_activity":
+		panic(fmt.Errorf("field track_account_activity of message cosmos.auth.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2057,6 +2501,26 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.enable_ed25519":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.auth.v1beta1.Params.enable_secp256r1":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.auth.v1beta1.Params.priority_msg_type_urls":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_8_list{list: &list})
+	case "cosmos.auth.v1beta1.Params.account_creation_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "cosmos.auth.v1beta1.Params.fee_exempt_msg_type_urls":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "cosmos.auth.v1beta1.Params.fee_exempt_accounts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
+	case "cosmos.auth.v1beta1.Params.max_fee_exempt_txs_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.track_account_activity":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2141,6 +2605,42 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.SigVerifyCostSecp256K1 != 0 {
 			n += 1 + runtime.Sov(uint64(x.SigVerifyCostSecp256K1))
 		}
+		if x.EnableEd25519 {
+			n += 2
+		}
+		if x.EnableSecp256R1 {
+			n += 2
+		}
+		if len(x.PriorityMsgTypeUrls) > 0 {
+			for _, s := range x.PriorityMsgTypeUrls {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AccountCreationFee) > 0 {
+			for _, e := range x.AccountCreationFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeExemptMsgTypeUrls) > 0 {
+			for _, s := range x.FeeExemptMsgTypeUrls {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeExemptAccounts) > 0 {
+			for _, s := range x.FeeExemptAccounts {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxFeeExemptTxsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxFeeExemptTxsPerBlock))
+		}
+		if x.TrackAccountActivity {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2170,6 +2670,84 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.TrackAccountActivity {
+			i--
+			if x.TrackAccountActivity {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.MaxFeeExemptTxsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFeeExemptTxsPerBlock))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.FeeExemptAccounts) > 0 {
+			for iNdEx := len(x.FeeExemptAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FeeExemptAccounts[iNdEx])
+				copy(dAtA[i:], x.FeeExemptAccounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeExemptAccounts[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.FeeExemptMsgTypeUrls) > 0 {
+			for iNdEx := len(x.FeeExemptMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FeeExemptMsgTypeUrls[iNdEx])
+				copy(dAtA[i:], x.FeeExemptMsgTypeUrls[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeExemptMsgTypeUrls[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.AccountCreationFee) > 0 {
+			for iNdEx := len(x.AccountCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AccountCreationFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.PriorityMsgTypeUrls) > 0 {
+			for iNdEx := len(x.PriorityMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PriorityMsgTypeUrls[iNdEx])
+				copy(dAtA[i:], x.PriorityMsgTypeUrls[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PriorityMsgTypeUrls[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.EnableSecp256R1 {
+			i--
+			if x.EnableSecp256R1 {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.EnableEd25519 {
+			i--
+			if x.EnableEd25519 {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.SigVerifyCostSecp256K1 != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostSecp256K1))
 			i--
@@ -2339,6 +2917,215 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableEd25519", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableEd25519 = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableSecp256R1", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableSecp256R1 = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriorityMsgTypeUrls", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriorityMsgTypeUrls = append(x.PriorityMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountCreationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccountCreationFee = append(x.AccountCreationFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccountCreationFee[len(x.AccountCreationFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeExemptMsgTypeUrls", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeExemptMsgTypeUrls = append(x.FeeExemptMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeExemptAccounts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeExemptAccounts = append(x.FeeExemptAccounts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFeeExemptTxsPerBlock", wireType)
+				}
+				x.MaxFeeExemptTxsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFeeExemptTxsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrackAccountActivity", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.TrackAccountActivity = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2514,6 +3301,13 @@ type ModuleCredential struct {
 	// derivation_keys is for deriving a module account address (passed into address.Module)
 	// adding more keys creates sub-account addresses (passed into address.Derive)
 	DerivationKeys [][]byte `protobuf:"bytes,2,rep,name=derivation_keys,json=derivationKeys,proto3" json:"derivation_keys,omitempty"`
+	// account_address, if set, is the address of the credential instead of the
+	// address derived from module_name and derivation_keys. It is set for
+	// existing accounts put under the control of a module at their address, e.g.
+	// a legacy multisig account migrated to a group policy account.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountAddress []byte `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
 }
 
 func (x *ModuleCredential) Reset() {
@@ -2550,6 +3344,13 @@ func (x *ModuleCredential) GetDerivationKeys() [][]byte {
 	return nil
 }
 
+func (x *ModuleCredential) GetAccountAddress() []byte {
+	if x != nil {
+		return x.AccountAddress
+	}
+	return nil
+}
+
 // Params defines the parameters for the auth module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -2561,6 +3362,47 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostEd25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256K1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// enable_ed25519 defines whether user transactions may be signed with ed25519 keys.
+	//
+	// Since: x/auth 1.0.0
+	EnableEd25519 bool `protobuf:"varint,6,opt,name=enable_ed25519,json=enableEd25519,proto3" json:"enable_ed25519,omitempty"`
+	// enable_secp256r1 defines whether user transactions may be signed with secp256r1 keys.
+	//
+	// Since: x/auth 1.0.0
+	EnableSecp256R1 bool `protobuf:"varint,7,opt,name=enable_secp256r1,json=enableSecp256r1,proto3" json:"enable_secp256r1,omitempty"`
+	// priority_msg_type_urls lists the type URLs of the messages whose transactions
+	// are given an elevated priority in the mempool, and therefore in block proposals,
+	// when all of their messages are listed.
+	//
+	// Since: x/auth 1.0.0
+	PriorityMsgTypeUrls []string `protobuf:"bytes,8,rep,name=priority_msg_type_urls,json=priorityMsgTypeUrls,proto3" json:"priority_msg_type_urls,omitempty"`
+	// account_creation_fee is the fee charged by x/bank to the sender of coins to an
+	// address holding neither an account nor a balance, once per created account. It
+	// is credited to the community pool. An empty fee disables the charge.
+	//
+	// Since: x/auth 1.0.0
+	AccountCreationFee []*v1beta1.Coin `protobuf:"bytes,9,rep,name=account_creation_fee,json=accountCreationFee,proto3" json:"account_creation_fee,omitempty"`
+	// fee_exempt_msg_type_urls lists the type URLs of the messages, such as oracle
+	// votes or relayer client updates, whose transactions may pay no fee when sent
+	// by one of the fee_exempt_accounts.
+	//
+	// Since: x/auth 1.0.0
+	FeeExemptMsgTypeUrls []string `protobuf:"bytes,10,rep,name=fee_exempt_msg_type_urls,json=feeExemptMsgTypeUrls,proto3" json:"fee_exempt_msg_type_urls,omitempty"`
+	// fee_exempt_accounts lists the addresses of the fee payers whose transactions
+	// of fee_exempt_msg_type_urls messages only may pay no fee.
+	//
+	// Since: x/auth 1.0.0
+	FeeExemptAccounts []string `protobuf:"bytes,11,rep,name=fee_exempt_accounts,json=feeExemptAccounts,proto3" json:"fee_exempt_accounts,omitempty"`
+	// max_fee_exempt_txs_per_block is the maximum number of transactions paying no
+	// fee under the fee exemption in a block. Zero disables the fee exemption.
+	//
+	// Since: x/auth 1.0.0
+	MaxFeeExemptTxsPerBlock uint64 `protobuf:"varint,12,opt,name=max_fee_exempt_txs_per_block,json=maxFeeExemptTxsPerBlock,proto3" json:"max_fee_exempt_txs_per_block,omitempty"`
+	// track_account_activity defines whether the height of the last transaction
+	// signed by each account is recorded, and served by the account queries.
+	//
+	// Since: x/auth 1.0.0
+	TrackAccountActivity bool `protobuf:"varint,13,opt,name=track_account_activity,json=trackAccountActivity,proto3" json:"track_account_activity,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -2618,6 +3460,62 @@ func (x *Params) GetSigVerifyCostSecp256K1() uint64 {
 	return 0
 }
 
+func (x *Params) GetEnableEd25519() bool {
+	if x != nil {
+		return x.EnableEd25519
+	}
+	return false
+}
+
+func (x *Params) GetEnableSecp256R1() bool {
+	if x != nil {
+		return x.EnableSecp256R1
+	}
+	return false
+}
+
+func (x *Params) GetPriorityMsgTypeUrls() []string {
+	if x != nil {
+		return x.PriorityMsgTypeUrls
+	}
+	return nil
+}
+
+func (x *Params) GetAccountCreationFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.AccountCreationFee
+	}
+	return nil
+}
+
+func (x *Params) GetFeeExemptMsgTypeUrls() []string {
+	if x != nil {
+		return x.FeeExemptMsgTypeUrls
+	}
+	return nil
+}
+
+func (x *Params) GetFeeExemptAccounts() []string {
+	if x != nil {
+		return x.FeeExemptAccounts
+	}
+	return nil
+}
+
+func (x *Params) GetMaxFeeExemptTxsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxFeeExemptTxsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetTrackAccountActivity() bool {
+	if x != nil {
+		return x.TrackAccountActivity
+	}
+	return false
+}
+
 var File_cosmos_auth_v1beta1_auth_proto protoreflect.FileDescriptor
 
 var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
@@ -2625,7 +3523,9 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69,
-	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
 	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
@@ -2663,50 +3563,91 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x92, 0xe7, 0xb0, 0x2a, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x75,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x75,
 	0x6c, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
 	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
 	0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x73,
 	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x26, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xd7,
-	0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78,
-	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x43,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f,
-	0x73, 0x69, 0x67, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x74, 0x78, 0x53, 0x69, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x15, 0x74,
-	0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x78, 0x53, 0x69,
-	0x7a, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x4f, 0x0a,
-	0x17, 0x73, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x6f, 0x73, 0x74,
-	0x5f, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18,
-	0xe2, 0xde, 0x1f, 0x14, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73,
-	0x74, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x52, 0x14, 0x73, 0x69, 0x67, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x12, 0x55,
-	0x0a, 0x19, 0x73, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x6f, 0x73,
-	0x74, 0x5f, 0x73, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x16, 0x73,
-	0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70,
-	0x32, 0x35, 0x36, 0x6b, 0x31, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
-	0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41,
-	0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a,
+	0x26, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xc6, 0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x73, 0x69, 0x67, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x78, 0x53, 0x69, 0x67, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x15, 0x74, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x50,
+	0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x4f, 0x0a, 0x17, 0x73, 0x69, 0x67, 0x5f, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31,
+	0x39, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18, 0xe2, 0xde, 0x1f, 0x14, 0x53, 0x69, 0x67,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31,
+	0x39, 0x52, 0x14, 0x73, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74,
+	0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x12, 0x55, 0x0a, 0x19, 0x73, 0x69, 0x67, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x70, 0x32,
+	0x35, 0x36, 0x6b, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16,
+	0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63,
+	0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x16, 0x73, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x12, 0x38,
+	0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x52, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x12, 0x3e, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x72, 0x31, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x13, 0xe2, 0xde, 0x1f, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65,
+	0x63, 0x70, 0x32, 0x35, 0x36, 0x72, 0x31, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x72, 0x31, 0x12, 0x4c, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x17, 0xe2, 0xde, 0x1f, 0x13, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x52, 0x4c,
+	0x73, 0x52, 0x13, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x50, 0x0a, 0x18,
+	0x66, 0x65, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18,
+	0xe2, 0xde, 0x1f, 0x14, 0x46, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x4d, 0x73, 0x67,
+	0x54, 0x79, 0x70, 0x65, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x14, 0x66, 0x65, 0x65, 0x45, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x48,
+	0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x66, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x78, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17,
+	0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x78, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x3a, 0x21, 0xe8,
+	0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b,
+	0x61, 0x75, 0x74, 0x68, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41,
+	0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2728,15 +3669,17 @@ var file_cosmos_auth_v1beta1_auth_proto_goTypes = []interface{}{
 	(*ModuleCredential)(nil), // 2: cosmos.auth.v1beta1.ModuleCredential
 	(*Params)(nil),           // 3: cosmos.auth.v1beta1.Params
 	(*anypb.Any)(nil),        // 4: google.protobuf.Any
+	(*v1beta1.Coin)(nil),     // 5: cosmos.base.v1beta1.Coin
 }
 var file_cosmos_auth_v1beta1_auth_proto_depIdxs = []int32{
 	4, // 0: cosmos.auth.v1beta1.BaseAccount.pub_key:type_name -> google.protobuf.Any
 	0, // 1: cosmos.auth.v1beta1.ModuleAccount.base_account:type_name -> cosmos.auth.v1beta1.BaseAccount
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 2: cosmos.auth.v1beta1.Params.account_creation_fee:type_name -> cosmos.base.v1beta1.Coin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_auth_v1beta1_auth_proto_init() }
@@ -19,16 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Accounts_FullMethodName             = "/cosmos.auth.v1beta1.Query/Accounts"
-	Query_Account_FullMethodName              = "/cosmos.auth.v1beta1.Query/Account"
-	Query_AccountAddressByID_FullMethodName   = "/cosmos.auth.v1beta1.Query/AccountAddressByID"
-	Query_Params_FullMethodName               = "/cosmos.auth.v1beta1.Query/Params"
-	Query_ModuleAccounts_FullMethodName       = "/cosmos.auth.v1beta1.Query/ModuleAccounts"
-	Query_ModuleAccountByName_FullMethodName  = "/cosmos.auth.v1beta1.Query/ModuleAccountByName"
-	Query_Bech32Prefix_FullMethodName         = "/cosmos.auth.v1beta1.Query/Bech32Prefix"
-	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
-	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
-	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_Accounts_FullMethodName                 = "/cosmos.auth.v1beta1.Query/Accounts"
+	Query_Account_FullMethodName                  = "/cosmos.auth.v1beta1.Query/Account"
+	Query_AccountAddressByID_FullMethodName       = "/cosmos.auth.v1beta1.Query/AccountAddressByID"
+	Query_Params_FullMethodName                   = "/cosmos.auth.v1beta1.Query/Params"
+	Query_ModuleAccounts_FullMethodName           = "/cosmos.auth.v1beta1.Query/ModuleAccounts"
+	Query_ModuleAccountByName_FullMethodName      = "/cosmos.auth.v1beta1.Query/ModuleAccountByName"
+	Query_ModuleAccountPermissions_FullMethodName = "/cosmos.auth.v1beta1.Query/ModuleAccountPermissions"
+	Query_Bech32Prefix_FullMethodName             = "/cosmos.auth.v1beta1.Query/Bech32Prefix"
+	Query_AddressBytesToString_FullMethodName     = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
+	Query_AddressStringToBytes_FullMethodName     = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
+	Query_AccountInfo_FullMethodName              = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_LaneSequence_FullMethodName             = "/cosmos.auth.v1beta1.Query/LaneSequence"
 )
 
 // QueryClient is the client API for Query service.
@@ -56,6 +58,11 @@ type QueryClient interface {
 	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
 	// ModuleAccountByName returns the module account info by module name
 	ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error)
+	// ModuleAccountPermissions returns the permissions granted to a module account,
+	// along with the permissions it was registered with at app wiring.
+	//
+	// Since: x/auth 1.0.0
+	ModuleAccountPermissions(ctx context.Context, in *QueryModuleAccountPermissionsRequest, opts ...grpc.CallOption) (*QueryModuleAccountPermissionsResponse, error)
 	// Bech32Prefix queries bech32Prefix
 	//
 	// Since: cosmos-sdk 0.46
@@ -72,6 +79,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// LaneSequence queries the sequence of a nonce lane of an account.
+	//
+	// Since: x/auth 1.0.0
+	LaneSequence(ctx context.Context, in *QueryLaneSequenceRequest, opts ...grpc.CallOption) (*QueryLaneSequenceResponse, error)
 }
 
 type queryClient struct {
@@ -136,6 +147,15 @@ func (c *queryClient) ModuleAccountByName(ctx context.Context, in *QueryModuleAc
 	return out, nil
 }
 
+func (c *queryClient) ModuleAccountPermissions(ctx context.Context, in *QueryModuleAccountPermissionsRequest, opts ...grpc.CallOption) (*QueryModuleAccountPermissionsResponse, error) {
+	out := new(QueryModuleAccountPermissionsResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleAccountPermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Bech32Prefix(ctx context.Context, in *Bech32PrefixRequest, opts ...grpc.CallOption) (*Bech32PrefixResponse, error) {
 	out := new(Bech32PrefixResponse)
 	err := c.cc.Invoke(ctx, Query_Bech32Prefix_FullMethodName, in, out, opts...)
@@ -172,6 +192,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) LaneSequence(ctx context.Context, in *QueryLaneSequenceRequest, opts ...grpc.CallOption) (*QueryLaneSequenceResponse, error) {
+	out := new(QueryLaneSequenceResponse)
+	err := c.cc.Invoke(ctx, Query_LaneSequence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -197,6 +226,11 @@ type QueryServer interface {
 	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
 	// ModuleAccountByName returns the module account info by module name
 	ModuleAccountByName(context.Context, *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error)
+	// ModuleAccountPermissions returns the permissions granted to a module account,
+	// along with the permissions it was registered with at app wiring.
+	//
+	// Since: x/auth 1.0.0
+	ModuleAccountPermissions(context.Context, *QueryModuleAccountPermissionsRequest) (*QueryModuleAccountPermissionsResponse, error)
 	// Bech32Prefix queries bech32Prefix
 	//
 	// Since: cosmos-sdk 0.46
@@ -213,6 +247,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// LaneSequence queries the sequence of a nonce lane of an account.
+	//
+	// Since: x/auth 1.0.0
+	LaneSequence(context.Context, *QueryLaneSequenceRequest) (*QueryLaneSequenceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -238,6 +276,9 @@ func (UnimplementedQueryServer) ModuleAccounts(context.Context, *QueryModuleAcco
 func (UnimplementedQueryServer) ModuleAccountByName(context.Context, *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountByName not implemented")
 }
+func (UnimplementedQueryServer) ModuleAccountPermissions(context.Context, *QueryModuleAccountPermissionsRequest) (*QueryModuleAccountPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountPermissions not implemented")
+}
 func (UnimplementedQueryServer) Bech32Prefix(context.Context, *Bech32PrefixRequest) (*Bech32PrefixResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Bech32Prefix not implemented")
 }
@@ -250,6 +291,9 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) LaneSequence(context.Context, *QueryLaneSequenceRequest) (*QueryLaneSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LaneSequence not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -371,6 +415,24 @@ func _Query_ModuleAccountByName_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ModuleAccountPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleAccountPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountPermissions(ctx, req.(*QueryModuleAccountPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Bech32Prefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Bech32PrefixRequest)
 	if err := dec(in); err != nil {
@@ -443,6 +505,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LaneSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLaneSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LaneSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LaneSequence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LaneSequence(ctx, req.(*QueryLaneSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -474,6 +554,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleAccountByName",
 			Handler:    _Query_ModuleAccountByName_Handler,
 		},
+		{
+			MethodName: "ModuleAccountPermissions",
+			Handler:    _Query_ModuleAccountPermissions_Handler,
+		},
 		{
 			MethodName: "Bech32Prefix",
 			Handler:    _Query_Bech32Prefix_Handler,
@@ -490,6 +574,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "LaneSequence",
+			Handler:    _Query_LaneSequence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
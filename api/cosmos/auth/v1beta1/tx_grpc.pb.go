@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName                   = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_ConvertVestedAccount_FullMethodName           = "/cosmos.auth.v1beta1.Msg/ConvertVestedAccount"
+	Msg_UpdateModuleAccountPermissions_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateModuleAccountPermissions"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +33,17 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ConvertVestedAccount defines an operation for replacing a vesting account
+	// whose vesting schedule has fully elapsed with a BaseAccount.
+	//
+	// Since: x/auth 1.0.0
+	ConvertVestedAccount(ctx context.Context, in *MsgConvertVestedAccount, opts ...grpc.CallOption) (*MsgConvertVestedAccountResponse, error)
+	// UpdateModuleAccountPermissions defines a (governance) operation for replacing
+	// the permissions granted to a module account. The authority defaults to the
+	// x/gov module account.
+	//
+	// Since: x/auth 1.0.0
+	UpdateModuleAccountPermissions(ctx context.Context, in *MsgUpdateModuleAccountPermissions, opts ...grpc.CallOption) (*MsgUpdateModuleAccountPermissionsResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +63,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ConvertVestedAccount(ctx context.Context, in *MsgConvertVestedAccount, opts ...grpc.CallOption) (*MsgConvertVestedAccountResponse, error) {
+	out := new(MsgConvertVestedAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_ConvertVestedAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateModuleAccountPermissions(ctx context.Context, in *MsgUpdateModuleAccountPermissions, opts ...grpc.CallOption) (*MsgUpdateModuleAccountPermissionsResponse, error) {
+	out := new(MsgUpdateModuleAccountPermissionsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateModuleAccountPermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +90,17 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ConvertVestedAccount defines an operation for replacing a vesting account
+	// whose vesting schedule has fully elapsed with a BaseAccount.
+	//
+	// Since: x/auth 1.0.0
+	ConvertVestedAccount(context.Context, *MsgConvertVestedAccount) (*MsgConvertVestedAccountResponse, error)
+	// UpdateModuleAccountPermissions defines a (governance) operation for replacing
+	// the permissions granted to a module account. The authority defaults to the
+	// x/gov module account.
+	//
+	// Since: x/auth 1.0.0
+	UpdateModuleAccountPermissions(context.Context, *MsgUpdateModuleAccountPermissions) (*MsgUpdateModuleAccountPermissionsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +111,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ConvertVestedAccount(context.Context, *MsgConvertVestedAccount) (*MsgConvertVestedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestedAccount not implemented")
+}
+func (UnimplementedMsgServer) UpdateModuleAccountPermissions(context.Context, *MsgUpdateModuleAccountPermissions) (*MsgUpdateModuleAccountPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateModuleAccountPermissions not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +148,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertVestedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertVestedAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertVestedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConvertVestedAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertVestedAccount(ctx, req.(*MsgConvertVestedAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateModuleAccountPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateModuleAccountPermissions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateModuleAccountPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateModuleAccountPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateModuleAccountPermissions(ctx, req.(*MsgUpdateModuleAccountPermissions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +195,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ConvertVestedAccount",
+			Handler:    _Msg_ConvertVestedAccount_Handler,
+		},
+		{
+			MethodName: "UpdateModuleAccountPermissions",
+			Handler:    _Msg_UpdateModuleAccountPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
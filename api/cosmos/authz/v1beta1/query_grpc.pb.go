@@ -21,9 +21,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Grants_FullMethodName        = "/cosmos.authz.v1beta1.Query/Grants"
-	Query_GranterGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranterGrants"
-	Query_GranteeGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_Grants_FullMethodName         = "/cosmos.authz.v1beta1.Query/Grants"
+	Query_GranterGrants_FullMethodName  = "/cosmos.authz.v1beta1.Query/GranterGrants"
+	Query_GranteeGrants_FullMethodName  = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_GrantTemplates_FullMethodName = "/cosmos.authz.v1beta1.Query/GrantTemplates"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +41,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// GrantTemplates returns the grant templates stored by an owner.
+	//
+	// Since x/authz v1.0.0
+	GrantTemplates(ctx context.Context, in *QueryGrantTemplatesRequest, opts ...grpc.CallOption) (*QueryGrantTemplatesResponse, error)
 }
 
 type queryClient struct {
@@ -77,6 +82,15 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) GrantTemplates(ctx context.Context, in *QueryGrantTemplatesRequest, opts ...grpc.CallOption) (*QueryGrantTemplatesResponse, error) {
+	out := new(QueryGrantTemplatesResponse)
+	err := c.cc.Invoke(ctx, Query_GrantTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -91,6 +105,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// GrantTemplates returns the grant templates stored by an owner.
+	//
+	// Since x/authz v1.0.0
+	GrantTemplates(context.Context, *QueryGrantTemplatesRequest) (*QueryGrantTemplatesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +125,9 @@ func (UnimplementedQueryServer) GranterGrants(context.Context, *QueryGranterGran
 func (UnimplementedQueryServer) GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (UnimplementedQueryServer) GrantTemplates(context.Context, *QueryGrantTemplatesRequest) (*QueryGrantTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantTemplates not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +195,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GrantTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GrantTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantTemplates(ctx, req.(*QueryGrantTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +232,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "GrantTemplates",
+			Handler:    _Query_GrantTemplates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -21,10 +21,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Grant_FullMethodName              = "/cosmos.authz.v1beta1.Msg/Grant"
-	Msg_Exec_FullMethodName               = "/cosmos.authz.v1beta1.Msg/Exec"
-	Msg_Revoke_FullMethodName             = "/cosmos.authz.v1beta1.Msg/Revoke"
-	Msg_PruneExpiredGrants_FullMethodName = "/cosmos.authz.v1beta1.Msg/PruneExpiredGrants"
+	Msg_Grant_FullMethodName               = "/cosmos.authz.v1beta1.Msg/Grant"
+	Msg_Exec_FullMethodName                = "/cosmos.authz.v1beta1.Msg/Exec"
+	Msg_Revoke_FullMethodName              = "/cosmos.authz.v1beta1.Msg/Revoke"
+	Msg_PruneExpiredGrants_FullMethodName  = "/cosmos.authz.v1beta1.Msg/PruneExpiredGrants"
+	Msg_SetGrantTemplate_FullMethodName    = "/cosmos.authz.v1beta1.Msg/SetGrantTemplate"
+	Msg_DeleteGrantTemplate_FullMethodName = "/cosmos.authz.v1beta1.Msg/DeleteGrantTemplate"
+	Msg_GrantFromTemplate_FullMethodName   = "/cosmos.authz.v1beta1.Msg/GrantFromTemplate"
 )
 
 // MsgClient is the client API for Msg service.
@@ -47,6 +50,22 @@ type MsgClient interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredGrants, opts ...grpc.CallOption) (*MsgPruneExpiredGrantsResponse, error)
+	// SetGrantTemplate creates or replaces a named grant template of the owner.
+	//
+	// Since x/authz v1.0.0
+	SetGrantTemplate(ctx context.Context, in *MsgSetGrantTemplate, opts ...grpc.CallOption) (*MsgSetGrantTemplateResponse, error)
+	// DeleteGrantTemplate deletes a named grant template of the owner. Grants
+	// already made from the template are kept.
+	//
+	// Since x/authz v1.0.0
+	DeleteGrantTemplate(ctx context.Context, in *MsgDeleteGrantTemplate, opts ...grpc.CallOption) (*MsgDeleteGrantTemplateResponse, error)
+	// GrantFromTemplate grants all the authorizations of a grant template of the
+	// granter to each of the grantees, with the provided expiration time.
+	// Existing grants for the same (granter, grantee, Authorization) triples are
+	// overwritten.
+	//
+	// Since x/authz v1.0.0
+	GrantFromTemplate(ctx context.Context, in *MsgGrantFromTemplate, opts ...grpc.CallOption) (*MsgGrantFromTemplateResponse, error)
 }
 
 type msgClient struct {
@@ -93,6 +112,33 @@ func (c *msgClient) PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredG
 	return out, nil
 }
 
+func (c *msgClient) SetGrantTemplate(ctx context.Context, in *MsgSetGrantTemplate, opts ...grpc.CallOption) (*MsgSetGrantTemplateResponse, error) {
+	out := new(MsgSetGrantTemplateResponse)
+	err := c.cc.Invoke(ctx, Msg_SetGrantTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteGrantTemplate(ctx context.Context, in *MsgDeleteGrantTemplate, opts ...grpc.CallOption) (*MsgDeleteGrantTemplateResponse, error) {
+	out := new(MsgDeleteGrantTemplateResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteGrantTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantFromTemplate(ctx context.Context, in *MsgGrantFromTemplate, opts ...grpc.CallOption) (*MsgGrantFromTemplateResponse, error) {
+	out := new(MsgGrantFromTemplateResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -113,6 +159,22 @@ type MsgServer interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error)
+	// SetGrantTemplate creates or replaces a named grant template of the owner.
+	//
+	// Since x/authz v1.0.0
+	SetGrantTemplate(context.Context, *MsgSetGrantTemplate) (*MsgSetGrantTemplateResponse, error)
+	// DeleteGrantTemplate deletes a named grant template of the owner. Grants
+	// already made from the template are kept.
+	//
+	// Since x/authz v1.0.0
+	DeleteGrantTemplate(context.Context, *MsgDeleteGrantTemplate) (*MsgDeleteGrantTemplateResponse, error)
+	// GrantFromTemplate grants all the authorizations of a grant template of the
+	// granter to each of the grantees, with the provided expiration time.
+	// Existing grants for the same (granter, grantee, Authorization) triples are
+	// overwritten.
+	//
+	// Since x/authz v1.0.0
+	GrantFromTemplate(context.Context, *MsgGrantFromTemplate) (*MsgGrantFromTemplateResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -132,6 +194,15 @@ func (UnimplementedMsgServer) Revoke(context.Context, *MsgRevoke) (*MsgRevokeRes
 func (UnimplementedMsgServer) PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredGrants not implemented")
 }
+func (UnimplementedMsgServer) SetGrantTemplate(context.Context, *MsgSetGrantTemplate) (*MsgSetGrantTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGrantTemplate not implemented")
+}
+func (UnimplementedMsgServer) DeleteGrantTemplate(context.Context, *MsgDeleteGrantTemplate) (*MsgDeleteGrantTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGrantTemplate not implemented")
+}
+func (UnimplementedMsgServer) GrantFromTemplate(context.Context, *MsgGrantFromTemplate) (*MsgGrantFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantFromTemplate not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -217,6 +288,60 @@ func _Msg_PruneExpiredGrants_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetGrantTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGrantTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGrantTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetGrantTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGrantTemplate(ctx, req.(*MsgSetGrantTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteGrantTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteGrantTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteGrantTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteGrantTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteGrantTemplate(ctx, req.(*MsgDeleteGrantTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantFromTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantFromTemplate(ctx, req.(*MsgGrantFromTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,6 +365,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneExpiredGrants",
 			Handler:    _Msg_PruneExpiredGrants_Handler,
 		},
+		{
+			MethodName: "SetGrantTemplate",
+			Handler:    _Msg_SetGrantTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteGrantTemplate",
+			Handler:    _Msg_DeleteGrantTemplate_Handler,
+		},
+		{
+			MethodName: "GrantFromTemplate",
+			Handler:    _Msg_GrantFromTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -25,6 +25,7 @@ const (
 	Query_SpendableBalanceByDenom_FullMethodName    = "/cosmos.bank.v1beta1.Query/SpendableBalanceByDenom"
 	Query_TotalSupply_FullMethodName                = "/cosmos.bank.v1beta1.Query/TotalSupply"
 	Query_SupplyOf_FullMethodName                   = "/cosmos.bank.v1beta1.Query/SupplyOf"
+	Query_CirculatingSupplyOf_FullMethodName        = "/cosmos.bank.v1beta1.Query/CirculatingSupplyOf"
 	Query_Params_FullMethodName                     = "/cosmos.bank.v1beta1.Query/Params"
 	Query_DenomMetadata_FullMethodName              = "/cosmos.bank.v1beta1.Query/DenomMetadata"
 	Query_DenomMetadataByQueryString_FullMethodName = "/cosmos.bank.v1beta1.Query/DenomMetadataByQueryString"
@@ -32,6 +33,8 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_Swap_FullMethodName                       = "/cosmos.bank.v1beta1.Query/Swap"
+	Query_Swaps_FullMethodName                      = "/cosmos.bank.v1beta1.Query/Swaps"
 )
 
 // QueryClient is the client API for Query service.
@@ -71,6 +74,12 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error)
+	// CirculatingSupplyOf queries the total and circulating supply of a single
+	// coin. The circulating supply excludes the balances of the non-circulating
+	// addresses configured on the module.
+	//
+	// Since: cosmos-sdk 0.51
+	CirculatingSupplyOf(ctx context.Context, in *QueryCirculatingSupplyOfRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyOfResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// DenomMetadata queries the client metadata of a given coin denomination.
@@ -101,6 +110,14 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// Swap queries an open atomic swap by its id.
+	//
+	// Since: cosmos-sdk 0.51
+	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
+	// Swaps queries all the open atomic swaps.
+	//
+	// Since: cosmos-sdk 0.51
+	Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error)
 }
 
 type queryClient struct {
@@ -165,6 +182,15 @@ func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, op
 	return out, nil
 }
 
+func (c *queryClient) CirculatingSupplyOf(ctx context.Context, in *QueryCirculatingSupplyOfRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyOfResponse, error) {
+	out := new(QueryCirculatingSupplyOfResponse)
+	err := c.cc.Invoke(ctx, Query_CirculatingSupplyOf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -228,6 +254,24 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error) {
+	out := new(QuerySwapResponse)
+	err := c.cc.Invoke(ctx, Query_Swap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error) {
+	out := new(QuerySwapsResponse)
+	err := c.cc.Invoke(ctx, Query_Swaps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -265,6 +309,12 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error)
+	// CirculatingSupplyOf queries the total and circulating supply of a single
+	// coin. The circulating supply excludes the balances of the non-circulating
+	// addresses configured on the module.
+	//
+	// Since: cosmos-sdk 0.51
+	CirculatingSupplyOf(context.Context, *QueryCirculatingSupplyOfRequest) (*QueryCirculatingSupplyOfResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// DenomMetadata queries the client metadata of a given coin denomination.
@@ -295,6 +345,14 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// Swap queries an open atomic swap by its id.
+	//
+	// Since: cosmos-sdk 0.51
+	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
+	// Swaps queries all the open atomic swaps.
+	//
+	// Since: cosmos-sdk 0.51
+	Swaps(context.Context, *QuerySwapsRequest) (*QuerySwapsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -320,6 +378,9 @@ func (UnimplementedQueryServer) TotalSupply(context.Context, *QueryTotalSupplyRe
 func (UnimplementedQueryServer) SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
 }
+func (UnimplementedQueryServer) CirculatingSupplyOf(context.Context, *QueryCirculatingSupplyOfRequest) (*QueryCirculatingSupplyOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupplyOf not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -341,6 +402,12 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (UnimplementedQueryServer) Swaps(context.Context, *QuerySwapsRequest) (*QuerySwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swaps not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -462,6 +529,24 @@ func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CirculatingSupplyOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculatingSupplyOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculatingSupplyOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CirculatingSupplyOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculatingSupplyOf(ctx, req.(*QueryCirculatingSupplyOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -588,6 +673,42 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Swap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swap(ctx, req.(*QuerySwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Swaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Swaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Swaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swaps(ctx, req.(*QuerySwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -619,6 +740,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyOf",
 			Handler:    _Query_SupplyOf_Handler,
 		},
+		{
+			MethodName: "CirculatingSupplyOf",
+			Handler:    _Query_CirculatingSupplyOf_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -647,6 +772,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "Swap",
+			Handler:    _Query_Swap_Handler,
+		},
+		{
+			MethodName: "Swaps",
+			Handler:    _Query_Swaps_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -24,6 +24,10 @@ const (
 	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
 	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
 	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_SendAndCall_FullMethodName    = "/cosmos.bank.v1beta1.Msg/SendAndCall"
+	Msg_CreateSwap_FullMethodName     = "/cosmos.bank.v1beta1.Msg/CreateSwap"
+	Msg_AcceptSwap_FullMethodName     = "/cosmos.bank.v1beta1.Msg/AcceptSwap"
+	Msg_CancelSwap_FullMethodName     = "/cosmos.bank.v1beta1.Msg/CancelSwap"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,6 +54,27 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SendAndCall defines a method for sending coins from one account to another
+	// account and executing follow-up messages, signed by the sender, atomically
+	// with the transfer.
+	//
+	// Since: cosmos-sdk 0.51
+	SendAndCall(ctx context.Context, in *MsgSendAndCall, opts ...grpc.CallOption) (*MsgSendAndCallResponse, error)
+	// CreateSwap defines a method for creating an atomic swap, holding the
+	// offered coins in escrow until the swap is accepted or cancelled.
+	//
+	// Since: cosmos-sdk 0.51
+	CreateSwap(ctx context.Context, in *MsgCreateSwap, opts ...grpc.CallOption) (*MsgCreateSwapResponse, error)
+	// AcceptSwap defines a method for accepting an atomic swap, paying the asked
+	// coins to its creator in exchange for the offered coins.
+	//
+	// Since: cosmos-sdk 0.51
+	AcceptSwap(ctx context.Context, in *MsgAcceptSwap, opts ...grpc.CallOption) (*MsgAcceptSwapResponse, error)
+	// CancelSwap defines a method for cancelling an atomic swap, refunding the
+	// offered coins to its creator.
+	//
+	// Since: cosmos-sdk 0.51
+	CancelSwap(ctx context.Context, in *MsgCancelSwap, opts ...grpc.CallOption) (*MsgCancelSwapResponse, error)
 }
 
 type msgClient struct {
@@ -105,6 +130,42 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SendAndCall(ctx context.Context, in *MsgSendAndCall, opts ...grpc.CallOption) (*MsgSendAndCallResponse, error) {
+	out := new(MsgSendAndCallResponse)
+	err := c.cc.Invoke(ctx, Msg_SendAndCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSwap(ctx context.Context, in *MsgCreateSwap, opts ...grpc.CallOption) (*MsgCreateSwapResponse, error) {
+	out := new(MsgCreateSwapResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptSwap(ctx context.Context, in *MsgAcceptSwap, opts ...grpc.CallOption) (*MsgAcceptSwapResponse, error) {
+	out := new(MsgAcceptSwapResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSwap(ctx context.Context, in *MsgCancelSwap, opts ...grpc.CallOption) (*MsgCancelSwapResponse, error) {
+	out := new(MsgCancelSwapResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -129,6 +190,27 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SendAndCall defines a method for sending coins from one account to another
+	// account and executing follow-up messages, signed by the sender, atomically
+	// with the transfer.
+	//
+	// Since: cosmos-sdk 0.51
+	SendAndCall(context.Context, *MsgSendAndCall) (*MsgSendAndCallResponse, error)
+	// CreateSwap defines a method for creating an atomic swap, holding the
+	// offered coins in escrow until the swap is accepted or cancelled.
+	//
+	// Since: cosmos-sdk 0.51
+	CreateSwap(context.Context, *MsgCreateSwap) (*MsgCreateSwapResponse, error)
+	// AcceptSwap defines a method for accepting an atomic swap, paying the asked
+	// coins to its creator in exchange for the offered coins.
+	//
+	// Since: cosmos-sdk 0.51
+	AcceptSwap(context.Context, *MsgAcceptSwap) (*MsgAcceptSwapResponse, error)
+	// CancelSwap defines a method for cancelling an atomic swap, refunding the
+	// offered coins to its creator.
+	//
+	// Since: cosmos-sdk 0.51
+	CancelSwap(context.Context, *MsgCancelSwap) (*MsgCancelSwapResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -151,6 +233,18 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) SendAndCall(context.Context, *MsgSendAndCall) (*MsgSendAndCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAndCall not implemented")
+}
+func (UnimplementedMsgServer) CreateSwap(context.Context, *MsgCreateSwap) (*MsgCreateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwap not implemented")
+}
+func (UnimplementedMsgServer) AcceptSwap(context.Context, *MsgAcceptSwap) (*MsgAcceptSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSwap not implemented")
+}
+func (UnimplementedMsgServer) CancelSwap(context.Context, *MsgCancelSwap) (*MsgCancelSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSwap not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -254,6 +348,78 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SendAndCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendAndCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendAndCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendAndCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendAndCall(ctx, req.(*MsgSendAndCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSwap(ctx, req.(*MsgCreateSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptSwap(ctx, req.(*MsgAcceptSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSwap(ctx, req.(*MsgCancelSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +447,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SendAndCall",
+			Handler:    _Msg_SendAndCall_Handler,
+		},
+		{
+			MethodName: "CreateSwap",
+			Handler:    _Msg_CreateSwap_Handler,
+		},
+		{
+			MethodName: "AcceptSwap",
+			Handler:    _Msg_AcceptSwap_Handler,
+		},
+		{
+			MethodName: "CancelSwap",
+			Handler:    _Msg_CancelSwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -28,6 +28,7 @@ const (
 	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
+	Query_DelegatorTotalStake_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorTotalStake"
 	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
 )
 
@@ -54,6 +55,12 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorTotalStake queries the total position of a delegator across all
+	// validators: the tokens of its delegations, the balance of its unbonding
+	// delegations and its pending rewards.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorTotalStake(ctx context.Context, in *QueryDelegatorTotalStakeRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalStakeResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
@@ -151,6 +158,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorTotalStake(ctx context.Context, in *QueryDelegatorTotalStakeRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalStakeResponse, error) {
+	out := new(QueryDelegatorTotalStakeResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorTotalStake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -184,6 +200,12 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorTotalStake queries the total position of a delegator across all
+	// validators: the tokens of its delegations, the balance of its unbonding
+	// delegations and its pending rewards.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorTotalStake(context.Context, *QueryDelegatorTotalStakeRequest) (*QueryDelegatorTotalStakeResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
@@ -224,6 +246,9 @@ func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDeleg
 func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (UnimplementedQueryServer) DelegatorTotalStake(context.Context, *QueryDelegatorTotalStakeRequest) (*QueryDelegatorTotalStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorTotalStake not implemented")
+}
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -402,6 +427,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorTotalStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorTotalStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorTotalStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorTotalStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorTotalStake(ctx, req.(*QueryDelegatorTotalStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -463,6 +506,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorTotalStake",
+			Handler:    _Query_DelegatorTotalStake_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
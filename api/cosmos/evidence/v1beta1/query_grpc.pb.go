@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Evidence_FullMethodName    = "/cosmos.evidence.v1beta1.Query/Evidence"
-	Query_AllEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_Evidence_FullMethodName       = "/cosmos.evidence.v1beta1.Query/Evidence"
+	Query_AllEvidence_FullMethodName    = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_EvidenceWindow_FullMethodName = "/cosmos.evidence.v1beta1.Query/EvidenceWindow"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +32,11 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EvidenceWindow queries the window in which equivocation evidence is currently
+	// accepted, along with the parameters it is derived from.
+	//
+	// Since: x/evidence 0.2.0
+	EvidenceWindow(ctx context.Context, in *QueryEvidenceWindowRequest, opts ...grpc.CallOption) (*QueryEvidenceWindowResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +65,15 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EvidenceWindow(ctx context.Context, in *QueryEvidenceWindowRequest, opts ...grpc.CallOption) (*QueryEvidenceWindowResponse, error) {
+	out := new(QueryEvidenceWindowResponse)
+	err := c.cc.Invoke(ctx, Query_EvidenceWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +82,11 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EvidenceWindow queries the window in which equivocation evidence is currently
+	// accepted, along with the parameters it is derived from.
+	//
+	// Since: x/evidence 0.2.0
+	EvidenceWindow(context.Context, *QueryEvidenceWindowRequest) (*QueryEvidenceWindowResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +100,9 @@ func (UnimplementedQueryServer) Evidence(context.Context, *QueryEvidenceRequest)
 func (UnimplementedQueryServer) AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (UnimplementedQueryServer) EvidenceWindow(context.Context, *QueryEvidenceWindowRequest) (*QueryEvidenceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceWindow not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +152,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvidenceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EvidenceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceWindow(ctx, req.(*QueryEvidenceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +185,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EvidenceWindow",
+			Handler:    _Query_EvidenceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -21,17 +21,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName        = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
-	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
-	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_Constitution_FullMethodName         = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName             = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName            = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                 = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName               = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName              = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName             = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName          = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalVoteOptions_FullMethodName  = "/cosmos.gov.v1.Query/ProposalVoteOptions"
+	Query_MessageBasedParams_FullMethodName   = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_ProposalDependencies_FullMethodName = "/cosmos.gov.v1.Query/ProposalDependencies"
 )
 
 // QueryClient is the client API for Query service.
@@ -62,6 +63,10 @@ type QueryClient interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// ProposalDependencies queries the proposals a proposal depends on and the
+	// proposals depending on it.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ProposalDependencies(ctx context.Context, in *QueryProposalDependenciesRequest, opts ...grpc.CallOption) (*QueryProposalDependenciesResponse, error)
 }
 
 type queryClient struct {
@@ -171,6 +176,15 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) ProposalDependencies(ctx context.Context, in *QueryProposalDependenciesRequest, opts ...grpc.CallOption) (*QueryProposalDependenciesResponse, error) {
+	out := new(QueryProposalDependenciesResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -199,6 +213,10 @@ type QueryServer interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// ProposalDependencies queries the proposals a proposal depends on and the
+	// proposals depending on it.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ProposalDependencies(context.Context, *QueryProposalDependenciesRequest) (*QueryProposalDependenciesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -239,6 +257,9 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) ProposalDependencies(context.Context, *QueryProposalDependenciesRequest) (*QueryProposalDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalDependencies not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -450,6 +471,24 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalDependencies(ctx, req.(*QueryProposalDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -501,6 +540,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "ProposalDependencies",
+			Handler:    _Query_ProposalDependencies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
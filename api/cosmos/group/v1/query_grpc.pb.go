@@ -35,6 +35,8 @@ const (
 	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
 	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
 	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_ProposalExecFailure_FullMethodName    = "/cosmos.group.v1.Query/ProposalExecFailure"
+	Query_ProposalExecFailures_FullMethodName   = "/cosmos.group.v1.Query/ProposalExecFailures"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +77,14 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ProposalExecFailure queries why the execution of a proposal failed.
+	//
+	// Since: cosmos-sdk 0.51
+	ProposalExecFailure(ctx context.Context, in *QueryProposalExecFailureRequest, opts ...grpc.CallOption) (*QueryProposalExecFailureResponse, error)
+	// ProposalExecFailures queries the execution failures of all proposals.
+	//
+	// Since: cosmos-sdk 0.51
+	ProposalExecFailures(ctx context.Context, in *QueryProposalExecFailuresRequest, opts ...grpc.CallOption) (*QueryProposalExecFailuresResponse, error)
 }
 
 type queryClient struct {
@@ -211,6 +221,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ProposalExecFailure(ctx context.Context, in *QueryProposalExecFailureRequest, opts ...grpc.CallOption) (*QueryProposalExecFailureResponse, error) {
+	out := new(QueryProposalExecFailureResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalExecFailure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalExecFailures(ctx context.Context, in *QueryProposalExecFailuresRequest, opts ...grpc.CallOption) (*QueryProposalExecFailuresResponse, error) {
+	out := new(QueryProposalExecFailuresResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalExecFailures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -249,6 +277,14 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ProposalExecFailure queries why the execution of a proposal failed.
+	//
+	// Since: cosmos-sdk 0.51
+	ProposalExecFailure(context.Context, *QueryProposalExecFailureRequest) (*QueryProposalExecFailureResponse, error)
+	// ProposalExecFailures queries the execution failures of all proposals.
+	//
+	// Since: cosmos-sdk 0.51
+	ProposalExecFailures(context.Context, *QueryProposalExecFailuresRequest) (*QueryProposalExecFailuresResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -298,6 +334,12 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) ProposalExecFailure(context.Context, *QueryProposalExecFailureRequest) (*QueryProposalExecFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecFailure not implemented")
+}
+func (UnimplementedQueryServer) ProposalExecFailures(context.Context, *QueryProposalExecFailuresRequest) (*QueryProposalExecFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecFailures not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -563,6 +605,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalExecFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalExecFailure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecFailure(ctx, req.(*QueryProposalExecFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalExecFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalExecFailures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecFailures(ctx, req.(*QueryProposalExecFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -626,6 +704,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ProposalExecFailure",
+			Handler:    _Query_ProposalExecFailure_Handler,
+		},
+		{
+			MethodName: "ProposalExecFailures",
+			Handler:    _Query_ProposalExecFailures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
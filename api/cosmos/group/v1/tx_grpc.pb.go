@@ -34,7 +34,9 @@ const (
 	Msg_WithdrawProposal_FullMethodName                = "/cosmos.group.v1.Msg/WithdrawProposal"
 	Msg_Vote_FullMethodName                            = "/cosmos.group.v1.Msg/Vote"
 	Msg_Exec_FullMethodName                            = "/cosmos.group.v1.Msg/Exec"
+	Msg_RetryExec_FullMethodName                       = "/cosmos.group.v1.Msg/RetryExec"
 	Msg_LeaveGroup_FullMethodName                      = "/cosmos.group.v1.Msg/LeaveGroup"
+	Msg_MigrateMultisigToGroupPolicy_FullMethodName    = "/cosmos.group.v1.Msg/MigrateMultisigToGroupPolicy"
 )
 
 // MsgClient is the client API for Msg service.
@@ -67,8 +69,18 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// RetryExec retries the execution of a proposal whose last execution failed,
+	// within the retry exec window from the failure.
+	//
+	// Since: cosmos-sdk 0.51
+	RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// MigrateMultisigToGroupPolicy turns a legacy threshold multisig account into a group policy account at the same
+	// address, administered by itself. The keys of the multisig can no longer sign for the account afterwards.
+	//
+	// Since: cosmos-sdk 0.51
+	MigrateMultisigToGroupPolicy(ctx context.Context, in *MsgMigrateMultisigToGroupPolicy, opts ...grpc.CallOption) (*MsgMigrateMultisigToGroupPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -196,6 +208,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error) {
+	out := new(MsgRetryExecResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryExec_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
 	out := new(MsgLeaveGroupResponse)
 	err := c.cc.Invoke(ctx, Msg_LeaveGroup_FullMethodName, in, out, opts...)
@@ -205,6 +226,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) MigrateMultisigToGroupPolicy(ctx context.Context, in *MsgMigrateMultisigToGroupPolicy, opts ...grpc.CallOption) (*MsgMigrateMultisigToGroupPolicyResponse, error) {
+	out := new(MsgMigrateMultisigToGroupPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateMultisigToGroupPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -235,8 +265,18 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// RetryExec retries the execution of a proposal whose last execution failed,
+	// within the retry exec window from the failure.
+	//
+	// Since: cosmos-sdk 0.51
+	RetryExec(context.Context, *MsgRetryExec) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// MigrateMultisigToGroupPolicy turns a legacy threshold multisig account into a group policy account at the same
+	// address, administered by itself. The keys of the multisig can no longer sign for the account afterwards.
+	//
+	// Since: cosmos-sdk 0.51
+	MigrateMultisigToGroupPolicy(context.Context, *MsgMigrateMultisigToGroupPolicy) (*MsgMigrateMultisigToGroupPolicyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -283,9 +323,15 @@ func (UnimplementedMsgServer) Vote(context.Context, *MsgVote) (*MsgVoteResponse,
 func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (UnimplementedMsgServer) RetryExec(context.Context, *MsgRetryExec) (*MsgRetryExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryExec not implemented")
+}
 func (UnimplementedMsgServer) LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (UnimplementedMsgServer) MigrateMultisigToGroupPolicy(context.Context, *MsgMigrateMultisigToGroupPolicy) (*MsgMigrateMultisigToGroupPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateMultisigToGroupPolicy not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -533,6 +579,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RetryExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryExec(ctx, req.(*MsgRetryExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgLeaveGroup)
 	if err := dec(in); err != nil {
@@ -551,6 +615,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateMultisigToGroupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateMultisigToGroupPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateMultisigToGroupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateMultisigToGroupPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateMultisigToGroupPolicy(ctx, req.(*MsgMigrateMultisigToGroupPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -610,10 +692,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "RetryExec",
+			Handler:    _Msg_RetryExec_Handler,
+		},
 		{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "MigrateMultisigToGroupPolicy",
+			Handler:    _Msg_MigrateMultisigToGroupPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	ReflectionService_FileDescriptors_FullMethodName = "/cosmos.reflection.v1.ReflectionService/FileDescriptors"
+	ReflectionService_MsgDescriptor_FullMethodName   = "/cosmos.reflection.v1.ReflectionService/MsgDescriptor"
 )
 
 // ReflectionServiceClient is the client API for ReflectionService service.
@@ -29,6 +30,12 @@ type ReflectionServiceClient interface {
 	// FileDescriptors queries all the file descriptors in the app in order
 	// to enable easier generation of dynamic clients.
 	FileDescriptors(ctx context.Context, in *FileDescriptorsRequest, opts ...grpc.CallOption) (*FileDescriptorsResponse, error)
+	// MsgDescriptor resolves the type URL of a message to the description of its
+	// fields and signers, and reports whether the app can currently execute it,
+	// to enable generic clients such as explorers to handle any message.
+	//
+	// Since: cosmos-sdk 0.51
+	MsgDescriptor(ctx context.Context, in *MsgDescriptorRequest, opts ...grpc.CallOption) (*MsgDescriptorResponse, error)
 }
 
 type reflectionServiceClient struct {
@@ -48,6 +55,15 @@ func (c *reflectionServiceClient) FileDescriptors(ctx context.Context, in *FileD
 	return out, nil
 }
 
+func (c *reflectionServiceClient) MsgDescriptor(ctx context.Context, in *MsgDescriptorRequest, opts ...grpc.CallOption) (*MsgDescriptorResponse, error) {
+	out := new(MsgDescriptorResponse)
+	err := c.cc.Invoke(ctx, ReflectionService_MsgDescriptor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReflectionServiceServer is the server API for ReflectionService service.
 // All implementations must embed UnimplementedReflectionServiceServer
 // for forward compatibility
@@ -55,6 +71,12 @@ type ReflectionServiceServer interface {
 	// FileDescriptors queries all the file descriptors in the app in order
 	// to enable easier generation of dynamic clients.
 	FileDescriptors(context.Context, *FileDescriptorsRequest) (*FileDescriptorsResponse, error)
+	// MsgDescriptor resolves the type URL of a message to the description of its
+	// fields and signers, and reports whether the app can currently execute it,
+	// to enable generic clients such as explorers to handle any message.
+	//
+	// Since: cosmos-sdk 0.51
+	MsgDescriptor(context.Context, *MsgDescriptorRequest) (*MsgDescriptorResponse, error)
 	mustEmbedUnimplementedReflectionServiceServer()
 }
 
@@ -65,6 +87,9 @@ type UnimplementedReflectionServiceServer struct {
 func (UnimplementedReflectionServiceServer) FileDescriptors(context.Context, *FileDescriptorsRequest) (*FileDescriptorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FileDescriptors not implemented")
 }
+func (UnimplementedReflectionServiceServer) MsgDescriptor(context.Context, *MsgDescriptorRequest) (*MsgDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDescriptor not implemented")
+}
 func (UnimplementedReflectionServiceServer) mustEmbedUnimplementedReflectionServiceServer() {}
 
 // UnsafeReflectionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +121,24 @@ func _ReflectionService_FileDescriptors_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReflectionService_MsgDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReflectionServiceServer).MsgDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReflectionService_MsgDescriptor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReflectionServiceServer).MsgDescriptor(ctx, req.(*MsgDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReflectionService_ServiceDesc is the grpc.ServiceDesc for ReflectionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +150,10 @@ var ReflectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FileDescriptors",
 			Handler:    _ReflectionService_FileDescriptors_Handler,
 		},
+		{
+			MethodName: "MsgDescriptor",
+			Handler:    _ReflectionService_MsgDescriptor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/reflection/v1/reflection.proto",
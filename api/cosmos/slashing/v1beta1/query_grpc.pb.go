@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName             = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName        = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName       = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_MaintenanceWindows_FullMethodName = "/cosmos.slashing.v1beta1.Query/MaintenanceWindows"
+	Query_JailInfo_FullMethodName           = "/cosmos.slashing.v1beta1.Query/JailInfo"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,16 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MaintenanceWindows queries the maintenance windows which have not ended
+	// yet, of all validators or of a single one.
+	//
+	// Since: cosmos-sdk 0.52
+	MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error)
+	// JailInfo queries the jailing state of a validator and the reasons why it
+	// cannot be unjailed at the current block, if any.
+	//
+	// Since: cosmos-sdk 0.52
+	JailInfo(ctx context.Context, in *QueryJailInfoRequest, opts ...grpc.CallOption) (*QueryJailInfoResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +83,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error) {
+	out := new(QueryMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, Query_MaintenanceWindows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) JailInfo(ctx context.Context, in *QueryJailInfoRequest, opts ...grpc.CallOption) (*QueryJailInfoResponse, error) {
+	out := new(QueryJailInfoResponse)
+	err := c.cc.Invoke(ctx, Query_JailInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +111,16 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MaintenanceWindows queries the maintenance windows which have not ended
+	// yet, of all validators or of a single one.
+	//
+	// Since: cosmos-sdk 0.52
+	MaintenanceWindows(context.Context, *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error)
+	// JailInfo queries the jailing state of a validator and the reasons why it
+	// cannot be unjailed at the current block, if any.
+	//
+	// Since: cosmos-sdk 0.52
+	JailInfo(context.Context, *QueryJailInfoRequest) (*QueryJailInfoResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +137,12 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) MaintenanceWindows(context.Context, *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceWindows not implemented")
+}
+func (UnimplementedQueryServer) JailInfo(context.Context, *QueryJailInfoRequest) (*QueryJailInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JailInfo not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +210,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceWindows(ctx, req.(*QueryMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_JailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJailInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).JailInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_JailInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).JailInfo(ctx, req.(*QueryJailInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +265,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MaintenanceWindows",
+			Handler:    _Query_MaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "JailInfo",
+			Handler:    _Query_JailInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
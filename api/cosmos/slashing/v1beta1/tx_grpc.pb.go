@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName                    = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName              = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_ScheduleMaintenanceWindow_FullMethodName = "/cosmos.slashing.v1beta1.Msg/ScheduleMaintenanceWindow"
+	Msg_CancelMaintenanceWindow_FullMethodName   = "/cosmos.slashing.v1beta1.Msg/CancelMaintenanceWindow"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +38,18 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleMaintenanceWindow defines a method for a validator to schedule a
+	// maintenance window, during which the blocks it misses do not count toward
+	// its downtime. The windows are bounded by the module parameters.
+	//
+	// Since: cosmos-sdk 0.52
+	ScheduleMaintenanceWindow(ctx context.Context, in *MsgScheduleMaintenanceWindow, opts ...grpc.CallOption) (*MsgScheduleMaintenanceWindowResponse, error)
+	// CancelMaintenanceWindow defines a method for a validator to cancel a
+	// maintenance window. A window which already started ends at the current
+	// height.
+	//
+	// Since: cosmos-sdk 0.52
+	CancelMaintenanceWindow(ctx context.Context, in *MsgCancelMaintenanceWindow, opts ...grpc.CallOption) (*MsgCancelMaintenanceWindowResponse, error)
 }
 
 type msgClient struct {
@@ -64,6 +78,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMaintenanceWindow(ctx context.Context, in *MsgScheduleMaintenanceWindow, opts ...grpc.CallOption) (*MsgScheduleMaintenanceWindowResponse, error) {
+	out := new(MsgScheduleMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMaintenanceWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMaintenanceWindow(ctx context.Context, in *MsgCancelMaintenanceWindow, opts ...grpc.CallOption) (*MsgCancelMaintenanceWindowResponse, error) {
+	out := new(MsgCancelMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelMaintenanceWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -77,6 +109,18 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleMaintenanceWindow defines a method for a validator to schedule a
+	// maintenance window, during which the blocks it misses do not count toward
+	// its downtime. The windows are bounded by the module parameters.
+	//
+	// Since: cosmos-sdk 0.52
+	ScheduleMaintenanceWindow(context.Context, *MsgScheduleMaintenanceWindow) (*MsgScheduleMaintenanceWindowResponse, error)
+	// CancelMaintenanceWindow defines a method for a validator to cancel a
+	// maintenance window. A window which already started ends at the current
+	// height.
+	//
+	// Since: cosmos-sdk 0.52
+	CancelMaintenanceWindow(context.Context, *MsgCancelMaintenanceWindow) (*MsgCancelMaintenanceWindowResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -90,6 +134,12 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ScheduleMaintenanceWindow(context.Context, *MsgScheduleMaintenanceWindow) (*MsgScheduleMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenanceWindow not implemented")
+}
+func (UnimplementedMsgServer) CancelMaintenanceWindow(context.Context, *MsgCancelMaintenanceWindow) (*MsgCancelMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenanceWindow not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -139,6 +189,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMaintenanceWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMaintenanceWindow(ctx, req.(*MsgScheduleMaintenanceWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMaintenanceWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMaintenanceWindow(ctx, req.(*MsgCancelMaintenanceWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +240,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleMaintenanceWindow",
+			Handler:    _Msg_ScheduleMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "CancelMaintenanceWindow",
+			Handler:    _Msg_CancelMaintenanceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -31,8 +31,17 @@ const (
 	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_HistoricalInfoByChainID_FullMethodName       = "/cosmos.staking.v1beta1.Query/HistoricalInfoByChainID"
+	Query_HistoricalInfos_FullMethodName               = "/cosmos.staking.v1beta1.Query/HistoricalInfos"
+	Query_ValidatorSetChanges_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorSetChanges"
+	Query_ValidatorPerformance_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorPerformance"
+	Query_PendingCommissionChanges_FullMethodName      = "/cosmos.staking.v1beta1.Query/PendingCommissionChanges"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_CommissionChangeAllowance_FullMethodName     = "/cosmos.staking.v1beta1.Query/CommissionChangeAllowance"
+	Query_FractionAmount_FullMethodName                = "/cosmos.staking.v1beta1.Query/FractionAmount"
+	Query_MaturityCalendar_FullMethodName              = "/cosmos.staking.v1beta1.Query/MaturityCalendar"
+	Query_QueuedMsgs_FullMethodName                    = "/cosmos.staking.v1beta1.Query/QueuedMsgs"
 )
 
 // QueryClient is the client API for Query service.
@@ -88,10 +97,64 @@ type QueryClient interface {
 	DelegatorValidator(ctx context.Context, in *QueryDelegatorValidatorRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
+	// HistoricalInfoByChainID queries the historical info for given chain-id and
+	// height, including the records archived across state exports. An empty
+	// chain-id selects the current chain.
+	//
+	// Since: cosmos-sdk 0.51
+	HistoricalInfoByChainID(ctx context.Context, in *QueryHistoricalInfoByChainIDRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoByChainIDResponse, error)
+	// HistoricalInfos queries the historical info of the heights within the given
+	// range, in ascending order of height.
+	//
+	// Since: cosmos-sdk 0.51
+	HistoricalInfos(ctx context.Context, in *QueryHistoricalInfosRequest, opts ...grpc.CallOption) (*QueryHistoricalInfosResponse, error)
+	// ValidatorSetChanges queries the changes of the bonded validator set at the
+	// heights within the given range, in ascending order of height. The changes
+	// are kept for the last historical_entries heights.
+	//
+	// Since: cosmos-sdk 0.51
+	ValidatorSetChanges(ctx context.Context, in *QueryValidatorSetChangesRequest, opts ...grpc.CallOption) (*QueryValidatorSetChangesResponse, error)
+	// ValidatorPerformance queries a validator with its liveness, as tracked by
+	// the slashing module, and its jailing history over the last
+	// historical_entries heights.
+	//
+	// Since: cosmos-sdk 0.51
+	ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error)
+	// PendingCommissionChanges queries the commission rate changes announced by
+	// validators which did not take effect yet, of all validators or of the
+	// given validator.
+	//
+	// Since: cosmos-sdk 0.51
+	PendingCommissionChanges(ctx context.Context, in *QueryPendingCommissionChangesRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangesResponse, error)
 	// Pool queries the pool info.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CommissionChangeAllowance queries the commission rates a validator may
+	// change to at the current block time, and when it may next change them.
+	//
+	// Since: cosmos-sdk 0.51
+	CommissionChangeAllowance(ctx context.Context, in *QueryCommissionChangeAllowanceRequest, opts ...grpc.CallOption) (*QueryCommissionChangeAllowanceResponse, error)
+	// FractionAmount queries the amount of tokens worth a fraction of a delegation,
+	// to unbond or redelegate it, or of the spendable balance of the delegator in
+	// the bond denom, to delegate it.
+	//
+	// Since: cosmos-sdk 0.51
+	FractionAmount(ctx context.Context, in *QueryFractionAmountRequest, opts ...grpc.CallOption) (*QueryFractionAmountResponse, error)
+	// MaturityCalendar queries the unbonding delegation and redelegation entries
+	// completing within a time window, with their totals chain-wide and per
+	// delegator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the time window is wide.
+	//
+	// Since: cosmos-sdk 0.51
+	MaturityCalendar(ctx context.Context, in *QueryMaturityCalendarRequest, opts ...grpc.CallOption) (*QueryMaturityCalendarResponse, error)
+	// QueuedMsgs queries the messages queued until the end of the current epoch,
+	// when epoching is enabled.
+	//
+	// Since: cosmos-sdk 0.51
+	QueuedMsgs(ctx context.Context, in *QueryQueuedMsgsRequest, opts ...grpc.CallOption) (*QueryQueuedMsgsResponse, error)
 }
 
 type queryClient struct {
@@ -210,6 +273,51 @@ func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInf
 	return out, nil
 }
 
+func (c *queryClient) HistoricalInfoByChainID(ctx context.Context, in *QueryHistoricalInfoByChainIDRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoByChainIDResponse, error) {
+	out := new(QueryHistoricalInfoByChainIDResponse)
+	err := c.cc.Invoke(ctx, Query_HistoricalInfoByChainID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalInfos(ctx context.Context, in *QueryHistoricalInfosRequest, opts ...grpc.CallOption) (*QueryHistoricalInfosResponse, error) {
+	out := new(QueryHistoricalInfosResponse)
+	err := c.cc.Invoke(ctx, Query_HistoricalInfos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSetChanges(ctx context.Context, in *QueryValidatorSetChangesRequest, opts ...grpc.CallOption) (*QueryValidatorSetChangesResponse, error) {
+	out := new(QueryValidatorSetChangesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSetChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error) {
+	out := new(QueryValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorPerformance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingCommissionChanges(ctx context.Context, in *QueryPendingCommissionChangesRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangesResponse, error) {
+	out := new(QueryPendingCommissionChangesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingCommissionChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
 	out := new(QueryPoolResponse)
 	err := c.cc.Invoke(ctx, Query_Pool_FullMethodName, in, out, opts...)
@@ -228,6 +336,42 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) CommissionChangeAllowance(ctx context.Context, in *QueryCommissionChangeAllowanceRequest, opts ...grpc.CallOption) (*QueryCommissionChangeAllowanceResponse, error) {
+	out := new(QueryCommissionChangeAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_CommissionChangeAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FractionAmount(ctx context.Context, in *QueryFractionAmountRequest, opts ...grpc.CallOption) (*QueryFractionAmountResponse, error) {
+	out := new(QueryFractionAmountResponse)
+	err := c.cc.Invoke(ctx, Query_FractionAmount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaturityCalendar(ctx context.Context, in *QueryMaturityCalendarRequest, opts ...grpc.CallOption) (*QueryMaturityCalendarResponse, error) {
+	out := new(QueryMaturityCalendarResponse)
+	err := c.cc.Invoke(ctx, Query_MaturityCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedMsgs(ctx context.Context, in *QueryQueuedMsgsRequest, opts ...grpc.CallOption) (*QueryQueuedMsgsResponse, error) {
+	out := new(QueryQueuedMsgsResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -281,10 +425,64 @@ type QueryServer interface {
 	DelegatorValidator(context.Context, *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
+	// HistoricalInfoByChainID queries the historical info for given chain-id and
+	// height, including the records archived across state exports. An empty
+	// chain-id selects the current chain.
+	//
+	// Since: cosmos-sdk 0.51
+	HistoricalInfoByChainID(context.Context, *QueryHistoricalInfoByChainIDRequest) (*QueryHistoricalInfoByChainIDResponse, error)
+	// HistoricalInfos queries the historical info of the heights within the given
+	// range, in ascending order of height.
+	//
+	// Since: cosmos-sdk 0.51
+	HistoricalInfos(context.Context, *QueryHistoricalInfosRequest) (*QueryHistoricalInfosResponse, error)
+	// ValidatorSetChanges queries the changes of the bonded validator set at the
+	// heights within the given range, in ascending order of height. The changes
+	// are kept for the last historical_entries heights.
+	//
+	// Since: cosmos-sdk 0.51
+	ValidatorSetChanges(context.Context, *QueryValidatorSetChangesRequest) (*QueryValidatorSetChangesResponse, error)
+	// ValidatorPerformance queries a validator with its liveness, as tracked by
+	// the slashing module, and its jailing history over the last
+	// historical_entries heights.
+	//
+	// Since: cosmos-sdk 0.51
+	ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error)
+	// PendingCommissionChanges queries the commission rate changes announced by
+	// validators which did not take effect yet, of all validators or of the
+	// given validator.
+	//
+	// Since: cosmos-sdk 0.51
+	PendingCommissionChanges(context.Context, *QueryPendingCommissionChangesRequest) (*QueryPendingCommissionChangesResponse, error)
 	// Pool queries the pool info.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CommissionChangeAllowance queries the commission rates a validator may
+	// change to at the current block time, and when it may next change them.
+	//
+	// Since: cosmos-sdk 0.51
+	CommissionChangeAllowance(context.Context, *QueryCommissionChangeAllowanceRequest) (*QueryCommissionChangeAllowanceResponse, error)
+	// FractionAmount queries the amount of tokens worth a fraction of a delegation,
+	// to unbond or redelegate it, or of the spendable balance of the delegator in
+	// the bond denom, to delegate it.
+	//
+	// Since: cosmos-sdk 0.51
+	FractionAmount(context.Context, *QueryFractionAmountRequest) (*QueryFractionAmountResponse, error)
+	// MaturityCalendar queries the unbonding delegation and redelegation entries
+	// completing within a time window, with their totals chain-wide and per
+	// delegator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the time window is wide.
+	//
+	// Since: cosmos-sdk 0.51
+	MaturityCalendar(context.Context, *QueryMaturityCalendarRequest) (*QueryMaturityCalendarResponse, error)
+	// QueuedMsgs queries the messages queued until the end of the current epoch,
+	// when epoching is enabled.
+	//
+	// Since: cosmos-sdk 0.51
+	QueuedMsgs(context.Context, *QueryQueuedMsgsRequest) (*QueryQueuedMsgsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -328,12 +526,39 @@ func (UnimplementedQueryServer) DelegatorValidator(context.Context, *QueryDelega
 func (UnimplementedQueryServer) HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
 }
+func (UnimplementedQueryServer) HistoricalInfoByChainID(context.Context, *QueryHistoricalInfoByChainIDRequest) (*QueryHistoricalInfoByChainIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfoByChainID not implemented")
+}
+func (UnimplementedQueryServer) HistoricalInfos(context.Context, *QueryHistoricalInfosRequest) (*QueryHistoricalInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfos not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSetChanges(context.Context, *QueryValidatorSetChangesRequest) (*QueryValidatorSetChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetChanges not implemented")
+}
+func (UnimplementedQueryServer) ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (UnimplementedQueryServer) PendingCommissionChanges(context.Context, *QueryPendingCommissionChangesRequest) (*QueryPendingCommissionChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCommissionChanges not implemented")
+}
 func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) CommissionChangeAllowance(context.Context, *QueryCommissionChangeAllowanceRequest) (*QueryCommissionChangeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommissionChangeAllowance not implemented")
+}
+func (UnimplementedQueryServer) FractionAmount(context.Context, *QueryFractionAmountRequest) (*QueryFractionAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FractionAmount not implemented")
+}
+func (UnimplementedQueryServer) MaturityCalendar(context.Context, *QueryMaturityCalendarRequest) (*QueryMaturityCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaturityCalendar not implemented")
+}
+func (UnimplementedQueryServer) QueuedMsgs(context.Context, *QueryQueuedMsgsRequest) (*QueryQueuedMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedMsgs not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -563,6 +788,96 @@ func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HistoricalInfoByChainID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalInfoByChainIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalInfoByChainID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HistoricalInfoByChainID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalInfoByChainID(ctx, req.(*QueryHistoricalInfoByChainIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HistoricalInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalInfos(ctx, req.(*QueryHistoricalInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSetChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSetChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetChanges(ctx, req.(*QueryValidatorSetChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*QueryValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingCommissionChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCommissionChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCommissionChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingCommissionChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCommissionChanges(ctx, req.(*QueryPendingCommissionChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPoolRequest)
 	if err := dec(in); err != nil {
@@ -599,6 +914,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CommissionChangeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommissionChangeAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommissionChangeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CommissionChangeAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommissionChangeAllowance(ctx, req.(*QueryCommissionChangeAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FractionAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFractionAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FractionAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FractionAmount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FractionAmount(ctx, req.(*QueryFractionAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaturityCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaturityCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaturityCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaturityCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaturityCalendar(ctx, req.(*QueryMaturityCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedMsgs(ctx, req.(*QueryQueuedMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -654,6 +1041,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HistoricalInfo",
 			Handler:    _Query_HistoricalInfo_Handler,
 		},
+		{
+			MethodName: "HistoricalInfoByChainID",
+			Handler:    _Query_HistoricalInfoByChainID_Handler,
+		},
+		{
+			MethodName: "HistoricalInfos",
+			Handler:    _Query_HistoricalInfos_Handler,
+		},
+		{
+			MethodName: "ValidatorSetChanges",
+			Handler:    _Query_ValidatorSetChanges_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "PendingCommissionChanges",
+			Handler:    _Query_PendingCommissionChanges_Handler,
+		},
 		{
 			MethodName: "Pool",
 			Handler:    _Query_Pool_Handler,
@@ -662,6 +1069,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "CommissionChangeAllowance",
+			Handler:    _Query_CommissionChangeAllowance_Handler,
+		},
+		{
+			MethodName: "FractionAmount",
+			Handler:    _Query_FractionAmount_Handler,
+		},
+		{
+			MethodName: "MaturityCalendar",
+			Handler:    _Query_MaturityCalendar_Handler,
+		},
+		{
+			MethodName: "QueuedMsgs",
+			Handler:    _Query_QueuedMsgs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -27,6 +27,9 @@ const (
 	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
 	Msg_UpdateParams_FullMethodName              = "/cosmos.staking.v1beta1.Msg/UpdateParams"
 	Msg_RotateConsPubKey_FullMethodName          = "/cosmos.staking.v1beta1.Msg/RotateConsPubKey"
+	Msg_TransferDelegation_FullMethodName        = "/cosmos.staking.v1beta1.Msg/TransferDelegation"
+	Msg_TransferUnbonding_FullMethodName         = "/cosmos.staking.v1beta1.Msg/TransferUnbonding"
+	Msg_DelegateMulti_FullMethodName             = "/cosmos.staking.v1beta1.Msg/DelegateMulti"
 )
 
 // MsgClient is the client API for Msg service.
@@ -59,6 +62,20 @@ type MsgClient interface {
 	// of a validator.
 	// Since: cosmos-sdk 0.51
 	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// TransferDelegation defines a governance operation for transferring a
+	// delegation to another account without unbonding it, e.g. for account
+	// recovery.
+	// Since: cosmos-sdk 0.51
+	TransferDelegation(ctx context.Context, in *MsgTransferDelegation, opts ...grpc.CallOption) (*MsgTransferDelegationResponse, error)
+	// TransferUnbonding defines a governance operation for transferring the
+	// unbonding delegation entries of a delegator to another account, e.g. for
+	// account recovery.
+	// Since: cosmos-sdk 0.51
+	TransferUnbonding(ctx context.Context, in *MsgTransferUnbonding, opts ...grpc.CallOption) (*MsgTransferUnbondingResponse, error)
+	// DelegateMulti defines a method for performing a delegation of coins
+	// from a delegator split across a weighted list of validators atomically.
+	// Since: cosmos-sdk 0.51
+	DelegateMulti(ctx context.Context, in *MsgDelegateMulti, opts ...grpc.CallOption) (*MsgDelegateMultiResponse, error)
 }
 
 type msgClient struct {
@@ -141,6 +158,33 @@ func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKe
 	return out, nil
 }
 
+func (c *msgClient) TransferDelegation(ctx context.Context, in *MsgTransferDelegation, opts ...grpc.CallOption) (*MsgTransferDelegationResponse, error) {
+	out := new(MsgTransferDelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferUnbonding(ctx context.Context, in *MsgTransferUnbonding, opts ...grpc.CallOption) (*MsgTransferUnbondingResponse, error) {
+	out := new(MsgTransferUnbondingResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferUnbonding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateMulti(ctx context.Context, in *MsgDelegateMulti, opts ...grpc.CallOption) (*MsgDelegateMultiResponse, error) {
+	out := new(MsgDelegateMultiResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateMulti_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -171,6 +215,20 @@ type MsgServer interface {
 	// of a validator.
 	// Since: cosmos-sdk 0.51
 	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// TransferDelegation defines a governance operation for transferring a
+	// delegation to another account without unbonding it, e.g. for account
+	// recovery.
+	// Since: cosmos-sdk 0.51
+	TransferDelegation(context.Context, *MsgTransferDelegation) (*MsgTransferDelegationResponse, error)
+	// TransferUnbonding defines a governance operation for transferring the
+	// unbonding delegation entries of a delegator to another account, e.g. for
+	// account recovery.
+	// Since: cosmos-sdk 0.51
+	TransferUnbonding(context.Context, *MsgTransferUnbonding) (*MsgTransferUnbondingResponse, error)
+	// DelegateMulti defines a method for performing a delegation of coins
+	// from a delegator split across a weighted list of validators atomically.
+	// Since: cosmos-sdk 0.51
+	DelegateMulti(context.Context, *MsgDelegateMulti) (*MsgDelegateMultiResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -202,6 +260,15 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
 }
+func (UnimplementedMsgServer) TransferDelegation(context.Context, *MsgTransferDelegation) (*MsgTransferDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDelegation not implemented")
+}
+func (UnimplementedMsgServer) TransferUnbonding(context.Context, *MsgTransferUnbonding) (*MsgTransferUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferUnbonding not implemented")
+}
+func (UnimplementedMsgServer) DelegateMulti(context.Context, *MsgDelegateMulti) (*MsgDelegateMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateMulti not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -359,6 +426,60 @@ func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDelegation(ctx, req.(*MsgTransferDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferUnbonding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferUnbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferUnbonding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferUnbonding(ctx, req.(*MsgTransferUnbonding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateMulti)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateMulti_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateMulti(ctx, req.(*MsgDelegateMulti))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -398,6 +519,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RotateConsPubKey",
 			Handler:    _Msg_RotateConsPubKey_Handler,
 		},
+		{
+			MethodName: "TransferDelegation",
+			Handler:    _Msg_TransferDelegation_Handler,
+		},
+		{
+			MethodName: "TransferUnbonding",
+			Handler:    _Msg_TransferUnbonding_Handler,
+		},
+		{
+			MethodName: "DelegateMulti",
+			Handler:    _Msg_DelegateMulti_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -24,6 +24,7 @@ const (
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
+	Query_BinaryModuleVersions_FullMethodName   = "/cosmos.upgrade.v1beta1.Query/BinaryModuleVersions"
 )
 
 // QueryClient is the client API for Query service.
@@ -50,6 +51,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// BinaryModuleVersions queries the consensus versions of the modules of the
+	// running binary, the versions from which they can be migrated, and their
+	// versions in state.
+	BinaryModuleVersions(ctx context.Context, in *QueryBinaryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryBinaryModuleVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +111,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) BinaryModuleVersions(ctx context.Context, in *QueryBinaryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryBinaryModuleVersionsResponse, error) {
+	out := new(QueryBinaryModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_BinaryModuleVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -130,6 +144,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// BinaryModuleVersions queries the consensus versions of the modules of the
+	// running binary, the versions from which they can be migrated, and their
+	// versions in state.
+	BinaryModuleVersions(context.Context, *QueryBinaryModuleVersionsRequest) (*QueryBinaryModuleVersionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -152,6 +170,9 @@ func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVers
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (UnimplementedQueryServer) BinaryModuleVersions(context.Context, *QueryBinaryModuleVersionsRequest) (*QueryBinaryModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryModuleVersions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -255,6 +276,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BinaryModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBinaryModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BinaryModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BinaryModuleVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BinaryModuleVersions(ctx, req.(*QueryBinaryModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -282,6 +321,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "BinaryModuleVersions",
+			Handler:    _Query_BinaryModuleVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_SoftwareUpgrade_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/SoftwareUpgrade"
 	Msg_CancelUpgrade_FullMethodName   = "/cosmos.upgrade.v1beta1.Msg/CancelUpgrade"
+	Msg_PostponeUpgrade_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/PostponeUpgrade"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +39,10 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// PostponeUpgrade is an operation for postponing the scheduled software
+	// upgrade to a later height, e.g. when a bug is found late in the binary of
+	// an imminent upgrade.
+	PostponeUpgrade(ctx context.Context, in *MsgPostponeUpgrade, opts ...grpc.CallOption) (*MsgPostponeUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -66,6 +71,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) PostponeUpgrade(ctx context.Context, in *MsgPostponeUpgrade, opts ...grpc.CallOption) (*MsgPostponeUpgradeResponse, error) {
+	out := new(MsgPostponeUpgradeResponse)
+	err := c.cc.Invoke(ctx, Msg_PostponeUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -79,6 +93,10 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// PostponeUpgrade is an operation for postponing the scheduled software
+	// upgrade to a later height, e.g. when a bug is found late in the binary of
+	// an imminent upgrade.
+	PostponeUpgrade(context.Context, *MsgPostponeUpgrade) (*MsgPostponeUpgradeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -92,6 +110,9 @@ func (UnimplementedMsgServer) SoftwareUpgrade(context.Context, *MsgSoftwareUpgra
 func (UnimplementedMsgServer) CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (UnimplementedMsgServer) PostponeUpgrade(context.Context, *MsgPostponeUpgrade) (*MsgPostponeUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostponeUpgrade not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -141,6 +162,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PostponeUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPostponeUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PostponeUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PostponeUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PostponeUpgrade(ctx, req.(*MsgPostponeUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -156,6 +195,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "PostponeUpgrade",
+			Handler:    _Msg_PostponeUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -2,8 +2,10 @@ package baseapp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/storestats"
 )
 
 // Supported ABCI Query prefixes and paths
@@ -244,6 +247,18 @@ func (app *BaseApp) LoadSnapshotChunk(req *abci.RequestLoadSnapshotChunk) (*abci
 		return &abci.ResponseLoadSnapshotChunk{}, nil
 	}
 
+	// Never block here as the ABCI connections may share a lock with block execution:
+	// refuse the request instead, the syncing node will retry with another peer.
+	if !app.snapshotServeLimiter.available() {
+		app.logger.Debug(
+			"snapshot chunk serving rate limit exceeded",
+			"height", req.Height,
+			"format", req.Format,
+			"chunk", req.Chunk,
+		)
+		return nil, errors.New("snapshot chunk serving rate limit exceeded")
+	}
+
 	chunk, err := app.snapshotManager.LoadChunk(req.Height, req.Format, req.Chunk)
 	if err != nil {
 		app.logger.Error(
@@ -255,6 +270,7 @@ func (app *BaseApp) LoadSnapshotChunk(req *abci.RequestLoadSnapshotChunk) (*abci
 		)
 		return nil, err
 	}
+	app.snapshotServeLimiter.consume(len(chunk))
 
 	return &abci.ResponseLoadSnapshotChunk{Chunk: chunk}, nil
 }
@@ -391,6 +407,8 @@ func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abc
 		return nil, errors.New("PrepareProposal handler not set")
 	}
 
+	defer app.recordPrepareProposal(time.Now(), req.ProposerAddress)
+
 	// Always reset state given that PrepareProposal can timeout and be called
 	// again in a subsequent round.
 	header := cmtproto.Header{
@@ -479,6 +497,13 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 		return nil, errors.New("ProcessProposal called with invalid height")
 	}
 
+	start := time.Now()
+	defer func() {
+		if resp != nil {
+			app.recordProcessProposal(start, req.Height, req.ProposerAddress, resp.Status.String())
+		}
+	}()
+
 	// Always reset state given that ProcessProposal can timeout and be called
 	// again in a subsequent round.
 	header := cmtproto.Header{
@@ -632,6 +657,10 @@ func (app *BaseApp) ExtendVote(_ context.Context, req *abci.RequestExtendVote) (
 		return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
 	}
 
+	if resp != nil {
+		recordVoteExtension(len(resp.VoteExtension), nil)
+	}
+
 	return resp, err
 }
 
@@ -697,6 +726,8 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.RequestVerifyVoteExtension) (r
 			Hash:    req.Hash,
 		})
 
+	recordVoteExtension(len(req.VoteExtension), req.ValidatorAddress)
+
 	resp, err = app.verifyVoteExt(ctx, req)
 	if err != nil {
 		app.logger.Error("failed to verify vote extension", "height", req.Height, "err", err)
@@ -872,6 +903,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 // extensions into the proposal, which should not themselves be executed in cases
 // where they adhere to the sdk.Tx interface.
 func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
+	start := time.Now()
+	app.recordFinalizeBlockStart(start, req.Height, req.ProposerAddress, req.Time, req.DecidedLastCommit.Round)
+	defer app.recordFinalizeBlock(start)
+
 	defer func() {
 		// call the streaming service hooks with the FinalizeBlock messages
 		for _, streamingListener := range app.streamingManager.ABCIListeners {
@@ -937,6 +972,7 @@ func (app *BaseApp) checkHalt(height int64, time time.Time) error {
 // height.
 func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 	header := app.finalizeBlockState.Context().BlockHeader()
+	defer app.recordCommit(time.Now(), header.Height)
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
 	if app.precommiter != nil {
@@ -1037,6 +1073,9 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.RequestQuery) *abci.R
 				Value:     []byte(app.version),
 			}
 
+		case "store_stats":
+			return handleQueryStoreStats(app, path, req)
+
 		default:
 			return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
 		}
@@ -1045,10 +1084,43 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.RequestQuery) *abci.R
 	return sdkerrors.QueryResult(
 		errorsmod.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be either 'simulate', 'version' or 'store_stats', none was present",
 		), app.trace)
 }
 
+// handleQueryStoreStats reports the size of the stores at the requested height,
+// see storestats.CollectMultiStore. The number of largest keys reported per
+// store may be given as the third element of the path.
+func handleQueryStoreStats(app *BaseApp, path []string, req *abci.RequestQuery) *abci.ResponseQuery {
+	if !app.storeStatsQuery {
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrUnauthorized, "store stats query is disabled on this node"), app.trace)
+	}
+
+	top := storestats.DefaultTop
+	if len(path) >= 3 {
+		var err error
+		if top, err = strconv.Atoi(path[2]); err != nil || top < 0 {
+			return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid number of largest keys %s", path[2]), app.trace)
+		}
+	}
+
+	stats, err := storestats.CollectMultiStore(app.cms, req.Height, top)
+	if err != nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to collect store stats"), app.trace)
+	}
+
+	bz, err := json.Marshal(stats)
+	if err != nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode store stats"), app.trace)
+	}
+
+	return &abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    stats.Height,
+		Value:     bz,
+	}
+}
+
 func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) *abci.ResponseQuery {
 	// "/store" prefix for store queries
 	queryable, ok := app.cms.(storetypes.Queryable)
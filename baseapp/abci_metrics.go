@@ -0,0 +1,141 @@
+package baseapp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Metric keys and labels of the ABCI methods.
+const (
+	MetricKeyABCI                = "abci"
+	MetricKeyPrepareProposal     = "prepare_proposal"
+	MetricKeyProcessProposal     = "process_proposal"
+	MetricKeyFinalizeBlock       = "finalize_block"
+	MetricKeyCommit              = "commit"
+	MetricKeyBlockProcessing     = "block_processing"
+	MetricKeyBlockLag            = "block_lag_ms"
+	MetricKeyDecidedRound        = "decided_round"
+	MetricKeyProposalRounds      = "proposal_rounds"
+	MetricKeyVoteExtensionSize   = "vote_extension_size"
+	MetricLabelNameProposer      = "proposer"
+	MetricLabelNameValidator     = "validator"
+	MetricLabelNameStatus        = "status"
+	MetricLabelNameVoteExtOrigin = "origin"
+	metricLabelValueLocal        = "local"
+	metricLabelValueRemote       = "remote"
+)
+
+// blockMetrics holds the timings of the block being processed, from which the
+// metrics spanning several ABCI calls are derived. ABCI calls of the consensus
+// connection are serialized, so it is not guarded.
+type blockMetrics struct {
+	height int64
+	// proposer is the hex address of the proposer of the block.
+	proposer string
+	// proposals is the number of proposals processed at the height, one per
+	// round reaching ProcessProposal.
+	proposals int
+	// finalizeStart is when FinalizeBlock was called for the block.
+	finalizeStart time.Time
+}
+
+// proposerLabel returns the label of the hex address of a block proposer.
+func proposerLabel(proposer []byte) metrics.Label {
+	return telemetry.NewLabel(MetricLabelNameProposer, fmt.Sprintf("%X", proposer))
+}
+
+// recordPrepareProposal records the duration of PrepareProposal, the node being
+// the proposer of the block.
+func (app *BaseApp) recordPrepareProposal(start time.Time, proposer []byte) {
+	telemetry.MeasureSinceWithLabels(
+		[]string{MetricKeyABCI, MetricKeyPrepareProposal},
+		start,
+		[]metrics.Label{proposerLabel(proposer)},
+	)
+}
+
+// recordProcessProposal records the duration and outcome of ProcessProposal and
+// counts the proposals processed at the height.
+func (app *BaseApp) recordProcessProposal(start time.Time, height int64, proposer []byte, status string) {
+	if app.blockMetrics.height != height {
+		app.blockMetrics = blockMetrics{height: height}
+	}
+	app.blockMetrics.proposals++
+
+	telemetry.MeasureSinceWithLabels(
+		[]string{MetricKeyABCI, MetricKeyProcessProposal},
+		start,
+		[]metrics.Label{proposerLabel(proposer), telemetry.NewLabel(MetricLabelNameStatus, status)},
+	)
+}
+
+// recordFinalizeBlockStart records the start of the processing of the block
+// and how late it is compared to the time of its header.
+func (app *BaseApp) recordFinalizeBlockStart(start time.Time, height int64, proposer []byte, blockTime time.Time, decidedRound int32) {
+	if app.blockMetrics.height != height {
+		app.blockMetrics = blockMetrics{height: height}
+	}
+	app.blockMetrics.proposer = fmt.Sprintf("%X", proposer)
+	app.blockMetrics.finalizeStart = start
+
+	labels := []metrics.Label{proposerLabel(proposer)}
+	if !blockTime.IsZero() {
+		telemetry.SetGaugeWithLabels(
+			[]string{MetricKeyABCI, MetricKeyBlockLag},
+			float32(start.Sub(blockTime).Milliseconds()),
+			labels,
+		)
+	}
+
+	// the round in which the previous block was decided
+	telemetry.SetGauge(float32(decidedRound), MetricKeyABCI, MetricKeyDecidedRound)
+	// blocks replayed or decided without the node processing the proposal do
+	// not report the rounds
+	if app.blockMetrics.proposals > 0 {
+		telemetry.SetGaugeWithLabels(
+			[]string{MetricKeyABCI, MetricKeyProposalRounds},
+			float32(app.blockMetrics.proposals),
+			labels,
+		)
+	}
+}
+
+// recordFinalizeBlock records the duration of FinalizeBlock.
+func (app *BaseApp) recordFinalizeBlock(start time.Time) {
+	telemetry.MeasureSinceWithLabels(
+		[]string{MetricKeyABCI, MetricKeyFinalizeBlock},
+		start,
+		[]metrics.Label{telemetry.NewLabel(MetricLabelNameProposer, app.blockMetrics.proposer)},
+	)
+}
+
+// recordCommit records the duration of Commit and the time between the start
+// of FinalizeBlock and the end of Commit of the block.
+func (app *BaseApp) recordCommit(start time.Time, height int64) {
+	bm := app.blockMetrics
+	app.blockMetrics = blockMetrics{}
+
+	labels := []metrics.Label{telemetry.NewLabel(MetricLabelNameProposer, bm.proposer)}
+	telemetry.MeasureSinceWithLabels([]string{MetricKeyABCI, MetricKeyCommit}, start, labels)
+	if bm.height == height && !bm.finalizeStart.IsZero() {
+		telemetry.MeasureSinceWithLabels([]string{MetricKeyABCI, MetricKeyBlockProcessing}, bm.finalizeStart, labels)
+	}
+}
+
+// recordVoteExtension records the size of a vote extension, extended by the
+// node if validator is nil.
+func recordVoteExtension(size int, validator []byte) {
+	labels := []metrics.Label{telemetry.NewLabel(MetricLabelNameVoteExtOrigin, metricLabelValueLocal)}
+	if validator != nil {
+		labels = []metrics.Label{
+			telemetry.NewLabel(MetricLabelNameVoteExtOrigin, metricLabelValueRemote),
+			telemetry.NewLabel(MetricLabelNameValidator, fmt.Sprintf("%X", validator)),
+		}
+	}
+
+	telemetry.AddSampleWithLabels([]string{MetricKeyABCI, MetricKeyVoteExtensionSize}, float32(size), labels)
+}
@@ -0,0 +1,30 @@
+package baseapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockMetrics(t *testing.T) {
+	app := &BaseApp{}
+	proposer := []byte{0xab, 0xcd}
+	start := time.Now()
+
+	// a proposal of a previous height is not counted
+	app.recordProcessProposal(start, 9, proposer, "ACCEPT")
+	app.recordProcessProposal(start, 10, proposer, "REJECT")
+	app.recordProcessProposal(start, 10, proposer, "ACCEPT")
+	require.Equal(t, blockMetrics{height: 10, proposals: 2}, app.blockMetrics)
+
+	app.recordFinalizeBlockStart(start, 10, proposer, start.Add(-time.Second), 1)
+	require.Equal(t, blockMetrics{height: 10, proposer: "ABCD", proposals: 2, finalizeStart: start}, app.blockMetrics)
+
+	app.recordCommit(start, 10)
+	require.Equal(t, blockMetrics{}, app.blockMetrics)
+
+	// a replayed block starts from a clean state
+	app.recordFinalizeBlockStart(start, 11, proposer, time.Time{}, 0)
+	require.Equal(t, blockMetrics{height: 11, proposer: "ABCD", finalizeStart: start}, app.blockMetrics)
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -42,6 +43,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/storestats"
 )
 
 const (
@@ -1245,6 +1247,63 @@ func TestABCI_Query(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestABCI_Query_StoreStats(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			ctx.KVStore(capKey1).Set(key, value)
+			return
+		})
+	}
+
+	// disabled by default
+	suite := NewBaseAppSuite(t, anteOpt)
+	res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/store_stats"})
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+
+	suite = NewBaseAppSuite(t, anteOpt, baseapp.SetStoreStatsQuery(true))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	bz, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 1,
+		Txs:    [][]byte{bz},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/store_stats/1"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1), res.Height)
+
+	var stats storestats.Stats
+	require.NoError(t, json.Unmarshal(res.Value, &stats))
+	var found bool
+	for _, store := range stats.Stores {
+		if store.Name != capKey1.Name() {
+			continue
+		}
+		found = true
+		require.Equal(t, int64(1), store.Keys)
+		require.Equal(t, int64(len(key)+len(value)), store.Bytes)
+		require.Equal(t, []storestats.KeyStats{{Key: hex.EncodeToString(key), Bytes: store.Bytes}}, store.LargestKeys)
+	}
+	require.True(t, found)
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/store_stats/all"})
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+}
+
 func TestABCI_GetBlockRetentionHeight(t *testing.T) {
 	logger := log.NewTestLogger(t)
 	db := dbm.NewMemDB()
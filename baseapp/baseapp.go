@@ -32,6 +32,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/gasprice"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
@@ -98,6 +99,13 @@ type BaseApp struct {
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
 
+	// limit the bytes per second written when creating snapshots and served to state syncing peers
+	snapshotCreationLimiter *byteRateLimiter
+	snapshotServeLimiter    *byteRateLimiter
+
+	// tracks the gas prices paid by the transactions of the recent blocks
+	gasPriceTracker *gasprice.Tracker
+
 	// volatile states:
 	//
 	// - checkState is set on InitChain and reset on Commit
@@ -133,6 +141,9 @@ type BaseApp struct {
 	// queryGasLimit defines the maximum gas for queries; unbounded if 0.
 	queryGasLimit uint64
 
+	// storeStatsQuery enables the "/app/store_stats" query.
+	storeStatsQuery bool
+
 	// The minimum gas prices a validator is willing to accept for processing a
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
@@ -185,6 +196,10 @@ type BaseApp struct {
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+
+	// blockMetrics holds the state of the block being processed from which the
+	// ABCI metrics are derived.
+	blockMetrics blockMetrics
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -205,6 +220,10 @@ func NewBaseApp(
 		fauxMerkleMode:   false,
 		sigverifyTx:      true,
 		queryGasLimit:    math.MaxUint64,
+
+		snapshotCreationLimiter: newByteRateLimiter(),
+		snapshotServeLimiter:    newByteRateLimiter(),
+		gasPriceTracker:         gasprice.NewTracker(gasprice.DefaultBlocks),
 	}
 
 	for _, option := range options {
@@ -284,6 +303,10 @@ func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgService
 // GRPCQueryRouter returns the GRPCQueryRouter of a BaseApp.
 func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRouter }
 
+// GasPriceTracker returns the tracker of the gas prices paid by the transactions of the
+// recent blocks. It is fed by the fee ante decorator and served by the node service.
+func (app *BaseApp) GasPriceTracker() *gasprice.Tracker { return app.gasPriceTracker }
+
 // MountStores mounts all IAVL or DB stores to the provided keys in the BaseApp
 // multistore.
 func (app *BaseApp) MountStores(keys ...storetypes.StoreKey) {
@@ -816,6 +839,9 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
+// A panic other than running out of gas fails the transaction and is reported:
+// a panic event is appended to the ante events and the tx panic counter is
+// incremented, see PanicReport.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
@@ -830,11 +856,24 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		return gInfo, nil, nil, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
+	progress := newTxProgress()
+
 	defer func() {
 		if r := recover(); r != nil {
-			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
-			err, result = processRecovery(r, recoveryMW), nil
-			ctx.Logger().Error("panic recovered in runTx", "err", err)
+			// running out of gas is not a state machine error, it is not reported
+			if _, isOutOfGas := r.(storetypes.ErrorOutOfGas); isOutOfGas {
+				recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
+				err, result = processRecovery(r, recoveryMW), nil
+				ctx.Logger().Error("panic recovered in runTx", "err", err)
+			} else {
+				report := newPanicReport(r, progress)
+				err, result = processRecovery(r, app.runTxRecoveryMiddleware), nil
+				anteEvents = append(anteEvents, abci.Event(report.Event()))
+				report.emitTelemetry()
+				ctx.Logger().Error("panic recovered in runTx", "err", err, "phase", report.Phase,
+					"msg_index", report.MsgIndex, "msg_type", report.MsgTypeURL, "module", report.Module,
+					"fingerprint", report.Fingerprint)
+			}
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
@@ -892,7 +931,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
+		progress.enter(PanicPhaseAnte)
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
+		progress.enter(PanicPhaseTx)
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -940,7 +981,8 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 	// Result if any single message fails or does not have a registered Handler.
 	msgsV2, err := tx.GetMsgsV2()
 	if err == nil {
-		result, err = app.runMsgs(runMsgCtx, msgs, msgsV2, mode)
+		result, err = app.runMsgs(runMsgCtx, msgs, msgsV2, mode, progress)
+		progress.enter(PanicPhaseTx)
 	}
 
 	// Run optional postHandlers (should run regardless of the execution result).
@@ -952,7 +994,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		// Note that the state is still preserved.
 		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
 
+		progress.enter(PanicPhasePost)
 		newCtx, errPostHandler := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
+		progress.enter(PanicPhaseTx)
 		if errPostHandler != nil {
 			return gInfo, nil, anteEvents, errors.Join(err, errPostHandler)
 		}
@@ -986,7 +1030,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 // and DeliverTx. An error is returned if any single message fails or if a
 // Handler does not exist for a given message route. Otherwise, a reference to a
 // Result is returned. The caller must not commit state if an error is returned.
-func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Message, mode execMode) (*sdk.Result, error) {
+func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Message, mode execMode, progress *txProgress) (*sdk.Result, error) {
 	events := sdk.EmptyEvents()
 	msgResponses := make([]*codectypes.Any, 0, len(msgs))
 
@@ -1001,7 +1045,12 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
 		}
 
+		if mode == execModeFinalize {
+			app.checkMsgDeterminism(ctx, i, msg, handler)
+		}
+
 		// ADR 031 request type routing
+		progress.enterMsg(i, msg)
 		msgResult, err := handler(ctx, msg)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
@@ -32,6 +32,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -561,6 +562,83 @@ func TestCustomRunTxPanicHandler(t *testing.T) {
 	}
 }
 
+type PanicCounterServerImpl struct{}
+
+func (m PanicCounterServerImpl) IncrementCounter(context.Context, *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	panic("bad start time calculation")
+}
+
+func TestRunTxPanicReport(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			counter, _ := parseTxMemo(t, tx)
+			if counter < 0 {
+				panic("ante panic")
+			}
+			return ctx, nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), PanicCounterServerImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	panicEvent := func(res *abci.ExecTxResult) map[string]string {
+		for _, ev := range res.Events {
+			if ev.Type == baseapp.EventTypePanic {
+				attrs := map[string]string{}
+				for _, attr := range ev.Attributes {
+					attrs[attr.Key] = attr.Value
+				}
+				return attrs
+			}
+		}
+		return nil
+	}
+
+	var txs [][]byte
+	for _, tx := range []sdk.Tx{
+		newTxCounter(t, suite.txConfig, 0, 0, 1),
+		newTxCounter(t, suite.txConfig, 1, 0),
+		newTxCounter(t, suite.txConfig, -1, 0),
+	} {
+		bz, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txs = append(txs, bz)
+	}
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 3)
+
+	// the panic of the first message fails the tx and is reported
+	require.Equal(t, sdkerrors.ErrPanic.ABCICode(), res.TxResults[0].Code)
+	msgReport := panicEvent(res.TxResults[0])
+	require.NotNil(t, msgReport)
+	require.Equal(t, baseapp.PanicPhaseMsg, msgReport[baseapp.AttributeKeyPanicPhase])
+	require.Equal(t, "0", msgReport[baseapp.AttributeKeyPanicMsgIndex])
+	require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), msgReport[baseapp.AttributeKeyPanicMsgType])
+	// the test messages have no protobuf package to derive the module from
+	require.Empty(t, msgReport[sdk.AttributeKeyModule])
+	require.NotEmpty(t, msgReport[baseapp.AttributeKeyPanicFingerprint])
+
+	// the same panic site has the same fingerprint
+	require.Equal(t, msgReport, panicEvent(res.TxResults[1]))
+
+	// panics of the ante handler are not attributed to a message
+	require.Equal(t, sdkerrors.ErrPanic.ABCICode(), res.TxResults[2].Code)
+	anteReport := panicEvent(res.TxResults[2])
+	require.NotNil(t, anteReport)
+	require.Equal(t, baseapp.PanicPhaseAnte, anteReport[baseapp.AttributeKeyPanicPhase])
+	require.Equal(t, "-1", anteReport[baseapp.AttributeKeyPanicMsgIndex])
+	require.Empty(t, anteReport[baseapp.AttributeKeyPanicMsgType])
+	require.NotEqual(t, msgReport[baseapp.AttributeKeyPanicFingerprint], anteReport[baseapp.AttributeKeyPanicFingerprint])
+}
+
 func TestBaseAppAnteHandler(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) {
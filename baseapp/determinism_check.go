@@ -0,0 +1,133 @@
+//go:build determinism_check
+
+package baseapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DeterminismCheckRuns is the number of times each message of a finalized
+// transaction is executed by the determinism check before its actual execution.
+const DeterminismCheckRuns = 3
+
+// msgTrace holds the outputs of a message execution which are part of the
+// consensus: the store writes, the events, the gas consumed and the response.
+type msgTrace struct {
+	writes []string
+	events []abci.Event
+	gas    storetypes.Gas
+	data   []byte
+	err    string
+}
+
+// checkMsgDeterminism executes the message DeterminismCheckRuns times on
+// discarded branches of the context and logs an error naming the module and
+// the message if the executions diverge. Go randomizes the iteration order of
+// maps on every iteration, so a handler whose outputs depend on it, e.g. by
+// iterating over a map of coins, diverges between runs with a high
+// probability.
+//
+// Handlers are executed several times, so side effects outside of the store,
+// such as in-memory caches of keepers, are repeated. The check is only built
+// with the determinism_check build tag and must not be enabled on validators.
+func (app *BaseApp) checkMsgDeterminism(ctx sdk.Context, msgIndex int, msg sdk.Msg, handler MsgServiceHandler) {
+	typeURL := sdk.MsgTypeURL(msg)
+
+	var first msgTrace
+	for run := 0; run < DeterminismCheckRuns; run++ {
+		trace, err := traceMsg(ctx, msg, handler)
+		if err != nil {
+			app.logger.Error("failed to trace message for the determinism check", "msg", typeURL, "msg_index", msgIndex, "err", err)
+			return
+		}
+
+		if run == 0 {
+			first = trace
+			continue
+		}
+
+		if diff := first.diff(trace); diff != "" {
+			app.logger.Error("nondeterministic message execution, it may iterate over a map",
+				"module", moduleFromTypeURL(typeURL), "msg", typeURL, "msg_index", msgIndex, "height", ctx.BlockHeight(), "diff", diff)
+			return
+		}
+	}
+}
+
+// traceMsg executes the message on a discarded branch of the context, tracing
+// the writes flushed by the branch.
+func traceMsg(ctx sdk.Context, msg sdk.Msg, handler MsgServiceHandler) (trace msgTrace, err error) {
+	var buf bytes.Buffer
+	branch := ctx.MultiStore().CacheMultiStore().SetTracer(&buf).CacheMultiStore()
+	runCtx := ctx.WithMultiStore(branch).
+		WithEventManager(sdk.NewEventManager()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				trace.err = fmt.Sprintf("panic: %v", r)
+			}
+		}()
+
+		res, err := handler(runCtx, msg)
+		if err != nil {
+			trace.err = err.Error()
+			return
+		}
+		trace.events, trace.data = res.Events, res.Data
+	}()
+	trace.gas = runCtx.GasMeter().GasConsumed()
+
+	// the branch flushes the writes of each store in key order, but iterates
+	// over its stores in map order
+	branch.Write()
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		var op struct {
+			Operation string `json:"operation"`
+		}
+		if err := json.Unmarshal([]byte(line), &op); err != nil {
+			return msgTrace{}, err
+		}
+		if op.Operation == "write" || op.Operation == "delete" {
+			trace.writes = append(trace.writes, line)
+		}
+	}
+	sort.Strings(trace.writes)
+
+	return trace, nil
+}
+
+// diff returns a description of the first output differing between the traces,
+// or an empty string if they are the same.
+func (t msgTrace) diff(other msgTrace) string {
+	switch {
+	case t.err != other.err:
+		return fmt.Sprintf("error %q != %q", t.err, other.err)
+	case !reflect.DeepEqual(t.writes, other.writes):
+		return fmt.Sprintf("store writes %v != %v", t.writes, other.writes)
+	case !reflect.DeepEqual(t.events, other.events):
+		return fmt.Sprintf("events %v != %v", t.events, other.events)
+	case t.gas != other.gas:
+		return fmt.Sprintf("gas consumed %d != %d", t.gas, other.gas)
+	case !bytes.Equal(t.data, other.data):
+		return fmt.Sprintf("response %X != %X", t.data, other.data)
+	}
+
+	return ""
+}
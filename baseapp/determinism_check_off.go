@@ -0,0 +1,9 @@
+//go:build !determinism_check
+
+package baseapp
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// checkMsgDeterminism is a no-op unless built with the determinism_check build
+// tag.
+func (app *BaseApp) checkMsgDeterminism(sdk.Context, int, sdk.Msg, MsgServiceHandler) {}
@@ -0,0 +1,64 @@
+//go:build determinism_check
+
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCheckMsgDeterminism(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	balances := make(map[string]int)
+	for i := 0; i < 16; i++ {
+		balances[fmt.Sprintf("addr%d", i)] = i
+	}
+
+	// the handler stores the position of each address in the iteration order
+	// of the map
+	mapHandler := func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+		pos := 0
+		for addr := range balances {
+			ctx.KVStore(key).Set([]byte(addr), []byte{byte(pos)})
+			pos++
+		}
+		return &sdk.Result{}, nil
+	}
+	sortedHandler := func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+		for i := 0; i < len(balances); i++ {
+			ctx.KVStore(key).Set([]byte(fmt.Sprintf("addr%d", i)), []byte{byte(i)})
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent("sorted"))
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	}
+
+	var buf bytes.Buffer
+	app := &BaseApp{logger: log.NewLogger(&buf, log.ColorOption(false))}
+
+	app.checkMsgDeterminism(ctx, 0, &testdata.TestMsg{}, sortedHandler)
+	require.Empty(t, buf.String())
+
+	// the executions may iterate over the map in the same order by chance
+	for i := 0; i < 10 && buf.Len() == 0; i++ {
+		app.checkMsgDeterminism(ctx, 1, &testdata.TestMsg{}, mapHandler)
+	}
+	require.Contains(t, buf.String(), "nondeterministic message execution")
+	require.Contains(t, buf.String(), "module=testpb")
+	require.Contains(t, buf.String(), "msg=/testpb.TestMsg")
+	require.Contains(t, buf.String(), "msg_index=1")
+
+	// the checked executions are discarded
+	require.False(t, ctx.KVStore(key).Has([]byte("addr0")))
+}
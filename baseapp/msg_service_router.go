@@ -54,6 +54,17 @@ func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
 	msr.circuitBreaker = cb
 }
 
+// IsAllowed returns whether the circuit breaker allows the execution of the
+// messages of the given type URL. All messages are allowed when no circuit
+// breaker is set.
+func (msr *MsgServiceRouter) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	if msr.circuitBreaker == nil {
+		return true, nil
+	}
+
+	return msr.circuitBreaker.IsAllowed(ctx, typeURL)
+}
+
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
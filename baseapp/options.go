@@ -49,6 +49,12 @@ func SetQueryGasLimit(queryGasLimit uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.queryGasLimit = queryGasLimit }
 }
 
+// SetStoreStatsQuery returns an option that enables the "/app/store_stats" query,
+// which walks the whole state of the app and is therefore disabled by default.
+func SetStoreStatsQuery(enabled bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.storeStatsQuery = enabled }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
@@ -97,6 +103,16 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetSnapshotCreationRateLimit sets the maximum bytes per second written while creating snapshots.
+func SetSnapshotCreationRateLimit(bytesPerSecond uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotCreationRateLimit(bytesPerSecond) }
+}
+
+// SetSnapshotServeRateLimit sets the maximum bytes per second of snapshot chunks served to peers.
+func SetSnapshotServeRateLimit(bytesPerSecond uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotServeRateLimit(bytesPerSecond) }
+}
+
 // SetMempool sets the mempool on BaseApp.
 func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
@@ -303,7 +319,26 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 		return
 	}
 	app.cms.SetSnapshotInterval(opts.Interval)
-	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
+	multistore := throttledSnapshotter{Snapshotter: app.cms, limiter: app.snapshotCreationLimiter}
+	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, multistore, nil, app.logger)
+}
+
+// SetSnapshotCreationRateLimit sets the maximum number of bytes per second written
+// while creating a state sync snapshot. Zero disables the limit.
+func (app *BaseApp) SetSnapshotCreationRateLimit(bytesPerSecond uint64) {
+	if app.sealed {
+		panic("SetSnapshotCreationRateLimit() on sealed BaseApp")
+	}
+	app.snapshotCreationLimiter.setRate(bytesPerSecond)
+}
+
+// SetSnapshotServeRateLimit sets the maximum number of snapshot chunk bytes per second
+// served to state syncing peers. Zero disables the limit.
+func (app *BaseApp) SetSnapshotServeRateLimit(bytesPerSecond uint64) {
+	if app.sealed {
+		panic("SetSnapshotServeRateLimit() on sealed BaseApp")
+	}
+	app.snapshotServeLimiter.setRate(bytesPerSecond)
 }
 
 // SetInterfaceRegistry sets the InterfaceRegistry.
@@ -386,6 +421,11 @@ func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
 }
 
+// AddABCIListeners adds listeners to the streaming manager of the BaseApp.
+func (app *BaseApp) AddABCIListeners(listeners ...storetypes.ABCIListener) {
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, listeners...)
+}
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
@@ -1,12 +1,21 @@
 package baseapp
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"regexp"
+	"runtime"
 	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -78,3 +87,164 @@ func newDefaultRecoveryMiddleware() recoveryMiddleware {
 
 	return newRecoveryMiddleware(handler, nil)
 }
+
+// Phases of the execution of a transaction reported by a PanicReport.
+const (
+	PanicPhaseTx   = "tx"
+	PanicPhaseAnte = "ante"
+	PanicPhaseMsg  = "msg"
+	PanicPhasePost = "post"
+)
+
+// Event type and attribute keys of the event emitted for a panic recovered in
+// runTx.
+const (
+	EventTypePanic = "panic"
+
+	AttributeKeyPanicPhase       = "phase"
+	AttributeKeyPanicMsgIndex    = "msg_index"
+	AttributeKeyPanicMsgType     = "msg_type"
+	AttributeKeyPanicFingerprint = "fingerprint"
+)
+
+// PanicReport describes a panic recovered while running a transaction. Panics
+// are state machine errors: the report identifies the message and the module
+// which panicked, and fingerprints the panic site so that occurrences of the
+// same failure can be grouped.
+type PanicReport struct {
+	// Phase is the step of the transaction execution which panicked.
+	Phase string
+	// MsgIndex is the index of the message which panicked, or -1 if the panic
+	// did not happen while executing a message.
+	MsgIndex int
+	// MsgTypeURL is the type URL of the message which panicked, if any.
+	MsgTypeURL string
+	// Module is the module owning the message which panicked, derived from
+	// the protobuf package of the message.
+	Module string
+	// Value is the value the panic was called with.
+	Value string
+	// Fingerprint identifies the panic site. It is derived from the functions
+	// of the stack, from the panicking one to runTx, and does not depend on
+	// line numbers or on the panic value.
+	Fingerprint string
+	// Stack is the stack trace of the panic.
+	Stack string
+}
+
+// txProgress tracks the step of the execution of a transaction, to report
+// where a panic happened.
+type txProgress struct {
+	phase    string
+	msgIndex int
+	msg      sdk.Msg
+}
+
+func newTxProgress() *txProgress {
+	return &txProgress{phase: PanicPhaseTx, msgIndex: -1}
+}
+
+// enter records that the execution moved to the given phase.
+func (p *txProgress) enter(phase string) {
+	p.phase, p.msgIndex, p.msg = phase, -1, nil
+}
+
+// enterMsg records that the execution moved to the message of the given index.
+func (p *txProgress) enterMsg(i int, msg sdk.Msg) {
+	p.phase, p.msgIndex, p.msg = PanicPhaseMsg, i, msg
+}
+
+// newPanicReport returns the report of a panic. It must be called by the
+// function deferred to recover the panic, so that the stack still holds the
+// panic site.
+func newPanicReport(recoveryObj interface{}, progress *txProgress) PanicReport {
+	report := PanicReport{
+		Phase:       progress.phase,
+		MsgIndex:    progress.msgIndex,
+		Value:       fmt.Sprintf("%v", recoveryObj),
+		Fingerprint: panicFingerprint(),
+		Stack:       string(debug.Stack()),
+	}
+
+	if progress.msg != nil {
+		report.MsgTypeURL = sdk.MsgTypeURL(progress.msg)
+		report.Module = moduleFromTypeURL(report.MsgTypeURL)
+	}
+
+	return report
+}
+
+// Event returns the event emitted for the panic.
+func (r PanicReport) Event() sdk.Event {
+	return sdk.NewEvent(
+		EventTypePanic,
+		sdk.NewAttribute(AttributeKeyPanicPhase, r.Phase),
+		sdk.NewAttribute(AttributeKeyPanicMsgIndex, strconv.Itoa(r.MsgIndex)),
+		sdk.NewAttribute(AttributeKeyPanicMsgType, r.MsgTypeURL),
+		sdk.NewAttribute(sdk.AttributeKeyModule, r.Module),
+		sdk.NewAttribute(AttributeKeyPanicFingerprint, r.Fingerprint),
+	)
+}
+
+// emitTelemetry increments the counter of recovered panics, labeled with the
+// phase, module and message type of the panic.
+func (r PanicReport) emitTelemetry() {
+	telemetry.IncrCounterWithLabels(
+		[]string{"tx", "panic"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("phase", r.Phase),
+			telemetry.NewLabel("module", r.Module),
+			telemetry.NewLabel("msg_type", r.MsgTypeURL),
+		},
+	)
+}
+
+// panicFingerprint returns a hash of the functions of the stack of the panic
+// being recovered, from the panicking function to runTx. Runtime frames are
+// left out, so that the fingerprint only depends on the application code.
+func panicFingerprint() string {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(0, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	h := sha256.New()
+	panicking := false
+	for {
+		frame, more := frames.Next()
+		switch {
+		case frame.Function == "runtime.gopanic":
+			// the frames above are the recovery ones
+			panicking = true
+		case panicking && strings.HasSuffix(frame.Function, ".(*BaseApp).runTx"):
+			more = false
+		case panicking && !strings.HasPrefix(frame.Function, "runtime."):
+			_, _ = h.Write([]byte(frame.Function))
+			_, _ = h.Write([]byte{'\n'})
+		}
+		if !more {
+			break
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// versionRegex matches the version segments of protobuf packages.
+var versionRegex = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]+)?$`)
+
+// moduleFromTypeURL returns the module of a message from its type URL, as the
+// last segment of its protobuf package which is not a version, e.g. bank for
+// /cosmos.bank.v1beta1.MsgSend.
+func moduleFromTypeURL(typeURL string) string {
+	segments := strings.Split(strings.TrimPrefix(typeURL, "/"), ".")
+	// drop the message name
+	segments = segments[:len(segments)-1]
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !versionRegex.MatchString(segments[i]) {
+			return segments[i]
+		}
+	}
+
+	return ""
+}
@@ -62,3 +62,21 @@ func TestRecoveryChain(t *testing.T) {
 		require.Nil(t, receivedErr)
 	}
 }
+
+func TestModuleFromTypeURL(t *testing.T) {
+	testCases := []struct {
+		typeURL string
+		module  string
+	}{
+		{"/cosmos.bank.v1beta1.MsgSend", "bank"},
+		{"/cosmos.auth.vesting.v1beta1.MsgCreateVestingAccount", "vesting"},
+		{"/cosmos.gov.v1.MsgSubmitProposal", "gov"},
+		{"/ibc.applications.transfer.v1.MsgTransfer", "transfer"},
+		{"/cosmos.accounts.v1alpha1.MsgInit", "accounts"},
+		{"/MsgCounter", ""},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.module, moduleFromTypeURL(tc.typeURL), tc.typeURL)
+	}
+}
@@ -0,0 +1,122 @@
+package baseapp
+
+import (
+	"sync"
+	"time"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+// byteRateLimiter is a token bucket limiting the number of bytes processed per second.
+// A zero rate disables the limiter.
+type byteRateLimiter struct {
+	mtx    sync.Mutex
+	rate   float64 // bytes per second
+	tokens float64
+	last   time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newByteRateLimiter() *byteRateLimiter {
+	return &byteRateLimiter{
+		now:   time.Now,
+		sleep: time.Sleep,
+	}
+}
+
+// setRate sets the number of bytes allowed per second, the bucket holds at most
+// one second worth of bytes.
+func (l *byteRateLimiter) setRate(bytesPerSecond uint64) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.rate = float64(bytesPerSecond)
+	l.tokens = l.rate
+	l.last = l.now()
+}
+
+// refill adds the tokens accumulated since the last call. It must be called with mtx held.
+func (l *byteRateLimiter) refill() {
+	now := l.now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+}
+
+// wait consumes n bytes, blocking until the bucket is no longer in debt.
+func (l *byteRateLimiter) wait(n int) {
+	l.mtx.Lock()
+	if l.rate == 0 {
+		l.mtx.Unlock()
+		return
+	}
+
+	l.refill()
+	l.tokens -= float64(n)
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mtx.Unlock()
+
+	if delay > 0 {
+		l.sleep(delay)
+	}
+}
+
+// available returns whether the bucket has tokens left. It does not consume any.
+func (l *byteRateLimiter) available() bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.rate == 0 {
+		return true
+	}
+
+	l.refill()
+	return l.tokens > 0
+}
+
+// consume consumes n bytes without blocking. The bucket may go into debt, in which
+// case available returns false until it is refilled.
+func (l *byteRateLimiter) consume(n int) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.rate == 0 {
+		return
+	}
+
+	l.refill()
+	l.tokens -= float64(n)
+}
+
+// throttledSnapshotter wraps a Snapshotter so that the snapshot items it writes
+// are rate limited, bounding the disk IO used while creating a snapshot.
+type throttledSnapshotter struct {
+	snapshottypes.Snapshotter
+	limiter *byteRateLimiter
+}
+
+// Snapshot implements snapshottypes.Snapshotter.
+func (s throttledSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	return s.Snapshotter.Snapshot(height, throttledWriter{Writer: protoWriter, limiter: s.limiter})
+}
+
+type throttledWriter struct {
+	protoio.Writer
+	limiter *byteRateLimiter
+}
+
+// WriteMsg implements protoio.Writer.
+func (w throttledWriter) WriteMsg(msg proto.Message) error {
+	w.limiter.wait(proto.Size(msg))
+	return w.Writer.WriteMsg(msg)
+}
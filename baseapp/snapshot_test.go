@@ -10,6 +10,8 @@ import (
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 )
 
 func TestABCI_ListSnapshots(t *testing.T) {
@@ -210,6 +212,28 @@ func TestABCI_LoadSnapshotChunk(t *testing.T) {
 	}
 }
 
+func TestABCI_LoadSnapshotChunk_RateLimited(t *testing.T) {
+	ssCfg := SnapshotsConfig{
+		blocks:             2,
+		blockTxs:           5,
+		snapshotInterval:   2,
+		snapshotKeepRecent: snapshottypes.CurrentFormat,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+	suite := NewBaseAppSuiteWithSnapshots(t, ssCfg, baseapp.SetSnapshotServeRateLimit(1))
+
+	req := &abci.RequestLoadSnapshotChunk{Height: 2, Format: snapshottypes.CurrentFormat, Chunk: 0}
+
+	// the first chunk fits in the initial budget and puts the limiter in debt
+	resp, err := suite.baseApp.LoadSnapshotChunk(req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Chunk)
+
+	// further requests are refused rather than blocking
+	_, err = suite.baseApp.LoadSnapshotChunk(req)
+	require.ErrorContains(t, err, "rate limit exceeded")
+}
+
 func TestABCI_OfferSnapshot_Errors(t *testing.T) {
 	ssCfg := SnapshotsConfig{
 		blocks:             0,
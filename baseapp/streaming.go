@@ -73,7 +73,7 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	app.cms.AddListeners(exposedKeys)
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
+			ABCIListeners: append(app.streamingManager.ABCIListeners, abciListener),
 			StopNodeOnErr: stopNodeOnErr,
 		},
 	)
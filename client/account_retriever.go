@@ -23,6 +23,12 @@ type AccountRetriever interface {
 	GetAccountNumberSequence(clientCtx Context, addr sdk.AccAddress) (accNum, accSeq uint64, err error)
 }
 
+// LaneSequenceRetriever defines the interface an AccountRetriever implements
+// to retrieve the sequences of the nonce lanes of the accounts.
+type LaneSequenceRetriever interface {
+	GetLaneSequence(clientCtx Context, addr sdk.AccAddress, lane uint64) (uint64, error)
+}
+
 var _ AccountRetriever = (*MockAccountRetriever)(nil)
 
 // MockAccountRetriever defines a no-op basic AccountRetriever that can be used
@@ -38,6 +38,20 @@ const (
 	SignModeTextual = "textual"
 	// SignModeEIP191 is the value of the --sign-mode flag for SIGN_MODE_EIP_191
 	SignModeEIP191 = "eip-191"
+
+	// GasPricesSpeedSlow is the value of the --gas-prices-speed flag selecting the
+	// 25th percentile of the gas prices recently paid on chain.
+	GasPricesSpeedSlow = "slow"
+	// GasPricesSpeedAverage is the value of the --gas-prices-speed flag selecting the
+	// 50th percentile of the gas prices recently paid on chain.
+	GasPricesSpeedAverage = "average"
+	// GasPricesSpeedFast is the value of the --gas-prices-speed flag selecting the
+	// 90th percentile of the gas prices recently paid on chain.
+	GasPricesSpeedFast = "fast"
+
+	// DefaultWatchInterval is the interval at which queries are rerun when the
+	// --watch flag is set without a value.
+	DefaultWatchInterval = "5s"
 )
 
 // List of CLI flags
@@ -59,6 +73,7 @@ const (
 	FlagFees             = "fees"
 	FlagGas              = "gas"
 	FlagGasPrices        = "gas-prices"
+	FlagGasPricesSpeed   = "gas-prices-speed"
 	FlagBroadcastMode    = "broadcast-mode"
 	FlagDryRun           = "dry-run"
 	FlagGenerateOnly     = "generate-only"
@@ -75,6 +90,7 @@ const (
 	FlagCountTotal       = "count-total"
 	FlagTimeoutHeight    = "timeout-height"
 	FlagUnordered        = "unordered"
+	FlagLane             = "lane"
 	FlagKeyAlgorithm     = "algo"
 	FlagKeyType          = "key-type"
 	FlagFeePayer         = "fee-payer"
@@ -83,6 +99,7 @@ const (
 	FlagTip              = "tip"
 	FlagAux              = "aux"
 	FlagInitHeight       = "initial-height"
+	FlagWatch            = "watch"
 	// FlagOutput is the flag to set the output format.
 	// This differs from FlagOutputDocument that is used to set the output file.
 	FlagOutput = "output"
@@ -115,6 +132,14 @@ func AddQueryFlagsToCmd(cmd *cobra.Command) {
 	_ = cmd.MarkFlagRequired(FlagChainID)
 }
 
+// AddWatchFlagToCmd adds the --watch flag to a query command, rerunning the
+// query at the given interval. As the interval is optional, it must be passed
+// as --watch=<interval>.
+func AddWatchFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().Duration(FlagWatch, 0, fmt.Sprintf("Rerun the query at the given interval (e.g. --%s=10s, default %s), highlighting the lines of the output that changed", FlagWatch, DefaultWatchInterval))
+	cmd.Flags().Lookup(FlagWatch).NoOptDefVal = DefaultWatchInterval
+}
+
 // AddTxFlagsToCmd adds common flags to a module tx command.
 func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f := cmd.Flags()
@@ -126,7 +151,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Uint64P(FlagSequence, "s", 0, "The sequence number of the signing account (offline mode only)")
 	f.String(FlagNote, "", "Note to add a description to the transaction (previously --memo)")
 	f.String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
-	f.String(FlagGasPrices, "", "Gas prices in decimal format to determine the transaction fee (e.g. 0.1uatom)")
+	f.String(FlagGasPrices, "", fmt.Sprintf("Gas prices in decimal format to determine the transaction fee (e.g. 0.1uatom); set to %q to use the gas prices recently paid on chain, see --%s", GasFlagAuto, FlagGasPricesSpeed))
+	f.String(FlagGasPricesSpeed, GasPricesSpeedAverage, fmt.Sprintf("Target inclusion speed of the transaction with --%s %s (%s|%s|%s)", FlagGasPrices, GasFlagAuto, GasPricesSpeedSlow, GasPricesSpeedAverage, GasPricesSpeedFast))
 	f.String(FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT rpc interface for this chain")
 	f.Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	f.Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
@@ -138,6 +164,7 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.String(FlagSignMode, "", "Choose sign mode (direct|amino-json|direct-aux|textual), this is an advanced feature")
 	f.Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
 	f.Bool(FlagUnordered, false, "Enable unordered transaction delivery; must be used in conjunction with --timeout-height")
+	f.Uint64(FlagLane, 0, "Send the transaction on a nonce lane of the signer, signed with the sequence of the lane (requires sign mode direct)")
 	f.String(FlagFeePayer, "", "Fee payer pays fees for the transaction instead of deducting from the signer")
 	f.String(FlagFeeGranter, "", "Fee granter grants fees for the transaction")
 	f.String(FlagTip, "", "Tip is the amount that is going to be transferred to the fee payer on the target chain. This flag is only valid when used with --aux, and is ignored if the target chain didn't enable the TipDecorator")
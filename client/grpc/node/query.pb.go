@@ -5,7 +5,9 @@ package node
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -253,11 +255,175 @@ func (m *StatusResponse) GetValidatorHash() []byte {
 	return nil
 }
 
+// GasPricesRequest defines the request structure for the GasPrices gRPC query.
+//
+// Since: cosmos-sdk 0.51
+type GasPricesRequest struct {
+}
+
+func (m *GasPricesRequest) Reset()         { *m = GasPricesRequest{} }
+func (m *GasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPricesRequest) ProtoMessage()    {}
+func (*GasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{4}
+}
+func (m *GasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricesRequest.Merge(m, src)
+}
+func (m *GasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricesRequest proto.InternalMessageInfo
+
+// GasPricesResponse defines the response structure for the GasPrices gRPC query.
+//
+// Since: cosmos-sdk 0.51
+type GasPricesResponse struct {
+	// blocks is the number of recent blocks the gas prices are computed over.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// gas_prices are the suggested gas prices per denom, ordered by decreasing
+	// number of transactions paying in the denom.
+	GasPrices []GasPricePercentiles `protobuf:"bytes,2,rep,name=gas_prices,json=gasPrices,proto3" json:"gas_prices"`
+}
+
+func (m *GasPricesResponse) Reset()         { *m = GasPricesResponse{} }
+func (m *GasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPricesResponse) ProtoMessage()    {}
+func (*GasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{5}
+}
+func (m *GasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricesResponse.Merge(m, src)
+}
+func (m *GasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricesResponse proto.InternalMessageInfo
+
+func (m *GasPricesResponse) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *GasPricesResponse) GetGasPrices() []GasPricePercentiles {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+// GasPricePercentiles defines the gas prices paid in a denom by the transactions
+// included in the recent blocks. The gas prices are never lower than the node
+// minimum gas price for the denom.
+//
+// Since: cosmos-sdk 0.51
+type GasPricePercentiles struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// txs is the number of transactions paying fees in the denom.
+	Txs uint64 `protobuf:"varint,2,opt,name=txs,proto3" json:"txs,omitempty"`
+	// slow is the 25th percentile of the gas prices paid.
+	Slow cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=slow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slow"`
+	// average is the 50th percentile of the gas prices paid.
+	Average cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=average,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"average"`
+	// fast is the 90th percentile of the gas prices paid.
+	Fast cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=fast,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fast"`
+}
+
+func (m *GasPricePercentiles) Reset()         { *m = GasPricePercentiles{} }
+func (m *GasPricePercentiles) String() string { return proto.CompactTextString(m) }
+func (*GasPricePercentiles) ProtoMessage()    {}
+func (*GasPricePercentiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{6}
+}
+func (m *GasPricePercentiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricePercentiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricePercentiles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricePercentiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricePercentiles.Merge(m, src)
+}
+func (m *GasPricePercentiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricePercentiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricePercentiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricePercentiles proto.InternalMessageInfo
+
+func (m *GasPricePercentiles) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *GasPricePercentiles) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ConfigRequest)(nil), "cosmos.base.node.v1beta1.ConfigRequest")
 	proto.RegisterType((*ConfigResponse)(nil), "cosmos.base.node.v1beta1.ConfigResponse")
 	proto.RegisterType((*StatusRequest)(nil), "cosmos.base.node.v1beta1.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "cosmos.base.node.v1beta1.StatusResponse")
+	proto.RegisterType((*GasPricesRequest)(nil), "cosmos.base.node.v1beta1.GasPricesRequest")
+	proto.RegisterType((*GasPricesResponse)(nil), "cosmos.base.node.v1beta1.GasPricesResponse")
+	proto.RegisterType((*GasPricePercentiles)(nil), "cosmos.base.node.v1beta1.GasPricePercentiles")
 }
 
 func init() {
@@ -265,40 +431,53 @@ func init() {
 }
 
 var fileDescriptor_8324226a07064341 = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x97, 0x32, 0x3a, 0xe6, 0xb1, 0x8e, 0x79, 0x80, 0x4a, 0x85, 0xd2, 0xaa, 0x02, 0x51,
-	0x90, 0x66, 0x6b, 0xe5, 0xce, 0x61, 0x1c, 0x36, 0xc4, 0x05, 0xa5, 0x9c, 0xb8, 0x44, 0x6e, 0xfa,
-	0x96, 0x58, 0x4b, 0x6c, 0xcf, 0x76, 0x2a, 0x71, 0x45, 0xe2, 0x3e, 0x89, 0x13, 0xdf, 0x84, 0x8f,
-	0xc0, 0x71, 0x12, 0x17, 0x4e, 0x80, 0x5a, 0x3e, 0x08, 0x8a, 0x9d, 0x0c, 0xf5, 0xb0, 0xc1, 0x29,
-	0xf6, 0xff, 0xfd, 0xde, 0xcb, 0x7b, 0xef, 0x6f, 0xf4, 0x28, 0x91, 0xa6, 0x90, 0x86, 0x4e, 0x99,
-	0x01, 0x2a, 0xe4, 0x0c, 0xe8, 0xfc, 0x60, 0x0a, 0x96, 0x1d, 0xd0, 0xb3, 0x12, 0xf4, 0x7b, 0xa2,
-	0xb4, 0xb4, 0x12, 0x77, 0x3d, 0x45, 0x2a, 0x8a, 0x54, 0x14, 0xa9, 0xa9, 0xde, 0xc3, 0x54, 0xca,
-	0x34, 0x07, 0xca, 0x14, 0xa7, 0x4c, 0x08, 0x69, 0x99, 0xe5, 0x52, 0x18, 0x9f, 0xd7, 0xeb, 0xd7,
-	0x51, 0x77, 0x9b, 0x96, 0x27, 0xd4, 0xf2, 0x02, 0x8c, 0x65, 0x85, 0xaa, 0x81, 0xbb, 0xa9, 0x4c,
-	0xa5, 0x3b, 0xd2, 0xea, 0xe4, 0xd5, 0xe1, 0x0e, 0xda, 0x7e, 0x29, 0xc5, 0x09, 0x4f, 0x23, 0x38,
-	0x2b, 0xc1, 0xd8, 0xe1, 0x97, 0x00, 0x75, 0x1a, 0xc5, 0x28, 0x29, 0x0c, 0xe0, 0x67, 0x68, 0xb7,
-	0xe0, 0x82, 0x17, 0x65, 0x11, 0xa7, 0xcc, 0xc4, 0x4a, 0xf3, 0x04, 0xba, 0xc1, 0x20, 0x18, 0x6d,
-	0x46, 0x3b, 0x75, 0xe0, 0x88, 0x99, 0x37, 0x95, 0x8c, 0x09, 0xda, 0x53, 0xba, 0x14, 0x5c, 0xa4,
-	0xf1, 0x29, 0x80, 0x8a, 0x35, 0x24, 0x20, 0x6c, 0xb7, 0xe5, 0xe8, 0xdd, 0x3a, 0xf4, 0x1a, 0x40,
-	0x45, 0x2e, 0x80, 0x9f, 0xa2, 0x3b, 0x0d, 0xcf, 0x85, 0x05, 0x3d, 0x67, 0x79, 0xf7, 0x86, 0x2f,
-	0x5d, 0xeb, 0xaf, 0x6a, 0x19, 0xf7, 0xd1, 0x56, 0xc6, 0x72, 0x1b, 0x67, 0xc0, 0xd3, 0xcc, 0x76,
-	0xd7, 0x07, 0xc1, 0x68, 0x3d, 0x42, 0x95, 0x74, 0xec, 0x94, 0x6a, 0x96, 0x89, 0x65, 0xb6, 0x34,
-	0xcd, 0x2c, 0x3f, 0x02, 0xd4, 0x69, 0x94, 0x7a, 0x96, 0x31, 0xba, 0x07, 0x4c, 0xe7, 0x1c, 0x8c,
-	0x8d, 0x8d, 0x95, 0x1a, 0x9a, 0x72, 0x81, 0x2b, 0xb7, 0xd7, 0x04, 0x27, 0x55, 0xcc, 0xd7, 0xc5,
-	0xf7, 0x51, 0xbb, 0x86, 0x5a, 0x0e, 0xaa, 0x6f, 0xf8, 0x05, 0xda, 0xbc, 0x5c, 0xb2, 0x6b, 0x7a,
-	0x6b, 0xdc, 0x23, 0xde, 0x06, 0xd2, 0xd8, 0x40, 0xde, 0x36, 0xc4, 0xe1, 0xfa, 0xf9, 0xcf, 0x7e,
-	0x10, 0xfd, 0x4d, 0xc1, 0x0f, 0xd0, 0x2d, 0xa6, 0x54, 0x9c, 0x31, 0x93, 0xb9, 0x69, 0x6e, 0x47,
-	0x1b, 0x4c, 0xa9, 0x63, 0x66, 0x32, 0xfc, 0x18, 0x75, 0xe6, 0x2c, 0xe7, 0x33, 0x66, 0xa5, 0xf6,
-	0xc0, 0x4d, 0x07, 0x6c, 0x5f, 0xaa, 0x15, 0x36, 0xfe, 0xdc, 0x42, 0x1b, 0x13, 0xd0, 0xf3, 0x6a,
-	0xf3, 0x1f, 0x03, 0xd4, 0xf6, 0xc6, 0xe1, 0x27, 0xe4, 0xaa, 0x47, 0x44, 0x56, 0xcc, 0xee, 0x8d,
-	0xfe, 0x0d, 0xfa, 0xbd, 0x0d, 0x47, 0x1f, 0xbe, 0xfd, 0xfe, 0xd4, 0x1a, 0xe2, 0x01, 0xbd, 0xf2,
-	0x15, 0x27, 0xfe, 0xe7, 0x55, 0x1f, 0x7e, 0xe9, 0xd7, 0xf5, 0xb1, 0x62, 0xd4, 0x75, 0x7d, 0xac,
-	0xfa, 0xf7, 0x3f, 0x7d, 0x18, 0x97, 0x71, 0x78, 0xf4, 0x75, 0x11, 0x06, 0x17, 0x8b, 0x30, 0xf8,
-	0xb5, 0x08, 0x83, 0xf3, 0x65, 0xb8, 0x76, 0xb1, 0x0c, 0xd7, 0xbe, 0x2f, 0xc3, 0xb5, 0x77, 0xfb,
-	0x29, 0xb7, 0x59, 0x39, 0x25, 0x89, 0x2c, 0x9a, 0x2a, 0xfe, 0xb3, 0x6f, 0x66, 0xa7, 0x34, 0xc9,
-	0x39, 0x08, 0x4b, 0x53, 0xad, 0x12, 0x57, 0x77, 0xda, 0x76, 0x5e, 0x3e, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x0c, 0x93, 0xa1, 0xea, 0xc0, 0x03, 0x00, 0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0x93, 0x34, 0x7d, 0x99, 0xbe, 0x7e, 0x4d, 0xfb, 0x2a, 0x37, 0xef, 0x29, 0x89, 0xa2,
+	0x3e, 0x08, 0x85, 0xd8, 0x6a, 0xd8, 0xb3, 0x08, 0x45, 0x2d, 0x2a, 0x8b, 0xca, 0x65, 0xc5, 0x26,
+	0x9a, 0x38, 0xb7, 0xf6, 0x28, 0xb6, 0xc7, 0xf5, 0x4c, 0x02, 0x5d, 0x21, 0x21, 0xb1, 0xaf, 0x60,
+	0xc3, 0xaf, 0x60, 0xc5, 0x82, 0x9f, 0xd0, 0x65, 0x05, 0x1b, 0xc4, 0xa2, 0xa0, 0x96, 0x1f, 0x82,
+	0x3c, 0x33, 0x4e, 0xa9, 0x44, 0x68, 0xd5, 0x95, 0x67, 0xce, 0x3d, 0x73, 0xe6, 0xdc, 0xb9, 0xf7,
+	0x1a, 0xad, 0xb9, 0x8c, 0x87, 0x8c, 0xdb, 0x3d, 0xc2, 0xc1, 0x8e, 0x58, 0x1f, 0xec, 0xd1, 0x46,
+	0x0f, 0x04, 0xd9, 0xb0, 0x0f, 0x86, 0x90, 0x1c, 0x5a, 0x71, 0xc2, 0x04, 0xc3, 0xa6, 0x62, 0x59,
+	0x29, 0xcb, 0x4a, 0x59, 0x96, 0x66, 0x55, 0xfe, 0xf3, 0x18, 0xf3, 0x02, 0xb0, 0x49, 0x4c, 0x6d,
+	0x12, 0x45, 0x4c, 0x10, 0x41, 0x59, 0xc4, 0xd5, 0xb9, 0x4a, 0x4d, 0x47, 0xe5, 0xae, 0x37, 0xdc,
+	0xb7, 0x05, 0x0d, 0x81, 0x0b, 0x12, 0xc6, 0x9a, 0xb0, 0xec, 0x31, 0x8f, 0xc9, 0xa5, 0x9d, 0xae,
+	0x34, 0xba, 0xaa, 0xae, 0xeb, 0xaa, 0x80, 0xbe, 0x5b, 0x6e, 0x1a, 0xf3, 0x68, 0xf6, 0x21, 0x8b,
+	0xf6, 0xa9, 0xe7, 0xc0, 0xc1, 0x10, 0xb8, 0x68, 0x7c, 0x34, 0xd0, 0x5c, 0x86, 0xf0, 0x98, 0x45,
+	0x1c, 0xf0, 0x3a, 0x5a, 0x0c, 0x69, 0x44, 0xc3, 0x61, 0xd8, 0xf5, 0x48, 0xaa, 0x42, 0x5d, 0x30,
+	0x8d, 0xba, 0xd1, 0x2c, 0x3b, 0xf3, 0x3a, 0xb0, 0x45, 0xf8, 0x6e, 0x0a, 0x63, 0x0b, 0x2d, 0xc5,
+	0xc9, 0x30, 0xa2, 0x91, 0xd7, 0x1d, 0x00, 0xc4, 0xdd, 0x04, 0x5c, 0x88, 0x84, 0x99, 0x97, 0xec,
+	0x45, 0x1d, 0xda, 0x01, 0x88, 0x1d, 0x19, 0xc0, 0x77, 0xd0, 0x42, 0xc6, 0xa7, 0x91, 0x80, 0x64,
+	0x44, 0x02, 0xb3, 0xa0, 0xa4, 0x35, 0xfe, 0x58, 0xc3, 0xb8, 0x86, 0x66, 0x7c, 0x12, 0x88, 0xae,
+	0x0f, 0xd4, 0xf3, 0x85, 0x59, 0xac, 0x1b, 0xcd, 0xa2, 0x83, 0x52, 0x68, 0x5b, 0x22, 0x69, 0x2e,
+	0x7b, 0x82, 0x88, 0x21, 0xcf, 0x72, 0x39, 0x35, 0xd0, 0x5c, 0x86, 0xe8, 0x5c, 0xda, 0xe8, 0x1f,
+	0x20, 0x49, 0x40, 0x81, 0x8b, 0x2e, 0x17, 0x2c, 0x81, 0x4c, 0xce, 0x90, 0x72, 0x4b, 0x59, 0x70,
+	0x2f, 0x8d, 0x29, 0x5d, 0xbc, 0x82, 0x4a, 0x9a, 0x94, 0x97, 0x24, 0xbd, 0xc3, 0x0f, 0x50, 0x79,
+	0xfc, 0xfe, 0xd2, 0xf4, 0x4c, 0xbb, 0x62, 0xa9, 0x0a, 0x59, 0x59, 0x85, 0xac, 0xa7, 0x19, 0xa3,
+	0x53, 0x3c, 0xfa, 0x56, 0x33, 0x9c, 0x8b, 0x23, 0x78, 0x15, 0xfd, 0x45, 0xe2, 0xb8, 0xeb, 0x13,
+	0xee, 0xcb, 0x6c, 0xfe, 0x76, 0xa6, 0x49, 0x1c, 0x6f, 0x13, 0xee, 0xe3, 0xff, 0xd1, 0xdc, 0x88,
+	0x04, 0xb4, 0x4f, 0x04, 0x4b, 0x14, 0x61, 0x4a, 0x12, 0x66, 0xc7, 0x68, 0x4a, 0x6b, 0x60, 0xb4,
+	0x90, 0xbd, 0xfc, 0x38, 0xe9, 0x97, 0x68, 0xf1, 0x17, 0x4c, 0xa7, 0xbd, 0x82, 0x4a, 0xbd, 0x80,
+	0xb9, 0x03, 0xae, 0xf3, 0xd4, 0x3b, 0xec, 0x20, 0x34, 0x2e, 0x29, 0x37, 0xf3, 0xf5, 0x42, 0x73,
+	0xa6, 0xdd, 0xb2, 0x26, 0x75, 0xa7, 0x95, 0x09, 0xef, 0x42, 0x92, 0x56, 0x8f, 0x06, 0xc0, 0x3b,
+	0xc5, 0xe3, 0xd3, 0x5a, 0xce, 0x29, 0x7b, 0xd9, 0x9d, 0x8d, 0x77, 0x79, 0xb4, 0xf4, 0x1b, 0x22,
+	0x5e, 0x46, 0x53, 0x7d, 0x88, 0x58, 0xa8, 0x5b, 0x47, 0x6d, 0xf0, 0x02, 0x2a, 0x88, 0x17, 0x5c,
+	0xbf, 0x6c, 0xba, 0xc4, 0x8f, 0x50, 0x91, 0x07, 0xec, 0xb9, 0x6a, 0x83, 0xce, 0x46, 0x2a, 0xff,
+	0xf5, 0xb4, 0xf6, 0xaf, 0x32, 0xc5, 0xfb, 0x03, 0x8b, 0x32, 0x3b, 0x24, 0xc2, 0xb7, 0x9e, 0x80,
+	0x47, 0xdc, 0xc3, 0x4d, 0x70, 0x3f, 0x7d, 0x68, 0x21, 0xed, 0x79, 0x13, 0x5c, 0x47, 0x1e, 0xc7,
+	0x3b, 0x68, 0x9a, 0x8c, 0x20, 0x21, 0x1e, 0x98, 0xc5, 0x9b, 0x2a, 0x65, 0x0a, 0xa9, 0xa7, 0x7d,
+	0xc2, 0x85, 0x39, 0x75, 0x53, 0x25, 0x79, 0xbc, 0xfd, 0xbe, 0x80, 0xa6, 0xf7, 0x20, 0x19, 0xa5,
+	0x93, 0xf2, 0xda, 0x40, 0x25, 0x35, 0x68, 0xf8, 0xf6, 0xe4, 0x17, 0xbf, 0x34, 0x9c, 0x95, 0xe6,
+	0xd5, 0x44, 0x55, 0xf0, 0x46, 0xf3, 0xd5, 0xe7, 0x1f, 0x6f, 0xf3, 0x0d, 0x5c, 0xb7, 0x27, 0xfe,
+	0x90, 0x5c, 0x75, 0x79, 0xea, 0x43, 0x0d, 0xc9, 0x9f, 0x7c, 0x5c, 0x1a, 0xac, 0x4a, 0xf3, 0x6a,
+	0xe2, 0xf5, 0x7d, 0x70, 0x75, 0xf9, 0x1b, 0x03, 0x95, 0xc7, 0x8d, 0x8b, 0xd7, 0xaf, 0x6e, 0xc2,
+	0xb1, 0x9b, 0xbb, 0xd7, 0xe2, 0x6a, 0x43, 0xf7, 0xa4, 0xa1, 0x5b, 0x78, 0x6d, 0xb2, 0xa1, 0x8b,
+	0x89, 0xe8, 0x6c, 0x1d, 0x9f, 0x55, 0x8d, 0x93, 0xb3, 0xaa, 0xf1, 0xfd, 0xac, 0x6a, 0x1c, 0x9d,
+	0x57, 0x73, 0x27, 0xe7, 0xd5, 0xdc, 0x97, 0xf3, 0x6a, 0xee, 0x59, 0xcb, 0xa3, 0xc2, 0x1f, 0xf6,
+	0x2c, 0x97, 0x85, 0x99, 0x92, 0xfa, 0xb4, 0x78, 0x7f, 0x60, 0xbb, 0x01, 0x85, 0x48, 0xd8, 0x5e,
+	0x12, 0xbb, 0x52, 0xbb, 0x57, 0x92, 0x3f, 0x84, 0xfb, 0x3f, 0x07, 0x00, 0x30, 0x1d, 0x56, 0x2a,
+	0x20, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -317,6 +496,11 @@ type ServiceClient interface {
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// GasPrices queries for the gas prices paid by the transactions included in
+	// the recent blocks, to suggest the gas price of a transaction.
+	//
+	// Since: cosmos-sdk 0.51
+	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
 }
 
 type serviceClient struct {
@@ -345,12 +529,26 @@ func (c *serviceClient) Status(ctx context.Context, in *StatusRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error) {
+	out := new(GasPricesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/GasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Config queries for the operator configuration.
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// GasPrices queries for the gas prices paid by the transactions included in
+	// the recent blocks, to suggest the gas price of a transaction.
+	//
+	// Since: cosmos-sdk 0.51
+	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -363,6 +561,9 @@ func (*UnimplementedServiceServer) Config(ctx context.Context, req *ConfigReques
 func (*UnimplementedServiceServer) Status(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (*UnimplementedServiceServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -404,6 +605,24 @@ func _Service_Status_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/GasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GasPrices(ctx, req.(*GasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.node.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -416,6 +635,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Service_Status_Handler,
 		},
+		{
+			MethodName: "GasPrices",
+			Handler:    _Service_GasPrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
@@ -573,6 +796,136 @@ func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricePercentiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricePercentiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricePercentiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fast.Size()
+		i -= size
+		if _, err := m.Fast.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Average.Size()
+		i -= size
+		if _, err := m.Average.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Slow.Size()
+		i -= size
+		if _, err := m.Slow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Txs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -653,6 +1006,55 @@ func (m *StatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *GasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasPricePercentiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Txs != 0 {
+		n += 1 + sovQuery(uint64(m.Txs))
+	}
+	l = m.Slow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Average.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fast.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1116,6 +1518,362 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, GasPricePercentiles{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricePercentiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricePercentiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricePercentiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Average.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,14 +6,19 @@ import (
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/gasprice"
 )
 
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
-func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config) {
-	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg))
+// The gas prices suggested by the service are read from the given tracker, which
+// may be nil if the node does not track gas prices.
+func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config, tracker *gasprice.Tracker) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg, tracker))
 }
 
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
@@ -27,11 +32,13 @@ var _ ServiceServer = queryServer{}
 type queryServer struct {
 	clientCtx client.Context
 	cfg       config.Config
+	tracker   *gasprice.Tracker
 }
 
-func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
+func NewQueryServer(clientCtx client.Context, cfg config.Config, tracker *gasprice.Tracker) ServiceServer {
 	return queryServer{
 		clientCtx: clientCtx,
+		tracker:   tracker,
 	}
 }
 
@@ -62,3 +69,51 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
 	}, nil
 }
+
+func (s queryServer) GasPrices(ctx context.Context, _ *GasPricesRequest) (*GasPricesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	minGasPrices := sdkCtx.MinGasPrices()
+
+	var (
+		blocks      uint64
+		percentiles []gasprice.Percentiles
+	)
+	if s.tracker != nil {
+		blocks, percentiles = s.tracker.Percentiles()
+	}
+
+	// the suggested gas prices are floored at the node minimum gas prices, so that
+	// transactions using them are accepted by the node mempool.
+	gasPrices := make([]GasPricePercentiles, 0, len(percentiles)+len(minGasPrices))
+	seen := make(map[string]bool, len(percentiles))
+	for _, p := range percentiles {
+		minGasPrice := minGasPrices.AmountOf(p.Denom)
+		gasPrices = append(gasPrices, GasPricePercentiles{
+			Denom:   p.Denom,
+			Txs:     p.Txs,
+			Slow:    math.LegacyMaxDec(p.Slow, minGasPrice),
+			Average: math.LegacyMaxDec(p.Average, minGasPrice),
+			Fast:    math.LegacyMaxDec(p.Fast, minGasPrice),
+		})
+		seen[p.Denom] = true
+	}
+
+	// denoms accepted by the node but not used by recent transactions are suggested
+	// at the node minimum gas price.
+	for _, minGasPrice := range minGasPrices {
+		if seen[minGasPrice.Denom] {
+			continue
+		}
+		gasPrices = append(gasPrices, GasPricePercentiles{
+			Denom:   minGasPrice.Denom,
+			Slow:    minGasPrice.Amount,
+			Average: minGasPrice.Amount,
+			Fast:    minGasPrice.Amount,
+		})
+	}
+
+	return &GasPricesResponse{
+		Blocks:    blocks,
+		GasPrices: gasPrices,
+	}, nil
+}
@@ -5,14 +5,17 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/gasprice"
 )
 
 func TestServiceServer_Config(t *testing.T) {
 	defaultCfg := config.DefaultConfig()
-	svr := NewQueryServer(client.Context{}, *defaultCfg)
+	svr := NewQueryServer(client.Context{}, *defaultCfg, nil)
 	ctx := sdk.Context{}.WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 15)))
 
 	resp, err := svr.Config(ctx, &ConfigRequest{})
@@ -21,3 +24,29 @@ func TestServiceServer_Config(t *testing.T) {
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
 	require.Equal(t, defaultCfg.HaltHeight, resp.HaltHeight)
 }
+
+func TestServiceServer_GasPrices(t *testing.T) {
+	tracker := gasprice.NewTracker(gasprice.DefaultBlocks)
+	tracker.Record(1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 10)
+	tracker.Record(1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 10)
+	tracker.Record(2, sdk.NewCoins(sdk.NewInt64Coin("atom", 30)), 10)
+
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig(), tracker)
+	ctx := sdk.Context{}.WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 5), sdk.NewInt64DecCoin("photon", 2)))
+
+	resp, err := svr.GasPrices(ctx, &GasPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Blocks)
+	require.Equal(t, []GasPricePercentiles{
+		{Denom: "stake", Txs: 2, Slow: math.LegacyNewDec(5), Average: math.LegacyNewDec(5), Fast: math.LegacyNewDec(10)},
+		{Denom: "atom", Txs: 1, Slow: math.LegacyNewDec(3), Average: math.LegacyNewDec(3), Fast: math.LegacyNewDec(3)},
+		{Denom: "photon", Slow: math.LegacyNewDec(2), Average: math.LegacyNewDec(2), Fast: math.LegacyNewDec(2)},
+	}, resp.GasPrices)
+
+	// without a tracker, only the node minimum gas prices are suggested
+	svr = NewQueryServer(client.Context{}, *config.DefaultConfig(), nil)
+	resp, err = svr.GasPrices(ctx, &GasPricesRequest{})
+	require.NoError(t, err)
+	require.Zero(t, resp.Blocks)
+	require.Len(t, resp.GasPrices, 2)
+}
@@ -0,0 +1,174 @@
+package storestats
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/storestats"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagAppDBBackend = "app-db-backend"
+	FlagTop          = "top"
+	FlagPrevious     = "previous"
+	FlagOutputFile   = "output-file"
+)
+
+// Cmd returns the store group command.
+func Cmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Inspect the application store",
+	}
+	cmd.AddCommand(
+		StatsCmd(appCreator),
+	)
+	return cmd
+}
+
+// StatsCmd returns a command reporting the size of each store of the application.
+func StatsCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Report the number of keys, size and largest keys of each store",
+		Long: `Report, for each store of the application, its number of keys, its total size in bytes
+(keys and values) and its largest entries, as JSON.
+
+With --previous, the growth of each store since a previous report is included, so that
+state growth can be attributed to the modules owning the stores.
+
+By default the application database is opened from the node home, which requires the
+node to be stopped. With --node, the stats are queried from a running node instead,
+which must have the store-stats-query option enabled.`,
+		Example: fmt.Sprintf(`%[1]s store stats --output-file stats-1000.json
+%[1]s store stats --previous stats-1000.json
+%[1]s store stats --node tcp://localhost:26657 --top 5`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			top, err := cmd.Flags().GetInt(FlagTop)
+			if err != nil {
+				return err
+			}
+			if top < 0 {
+				return fmt.Errorf("invalid number of largest keys %d", top)
+			}
+
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			var stats storestats.Stats
+			if cmd.Flags().Changed(flags.FlagNode) {
+				stats, err = queryStats(cmd, height, top)
+			} else {
+				stats, err = collectStats(cmd, appCreator, height, top)
+			}
+			if err != nil {
+				return err
+			}
+
+			if previousFile, _ := cmd.Flags().GetString(FlagPrevious); previousFile != "" {
+				previous, err := readStats(previousFile)
+				if err != nil {
+					return err
+				}
+				stats.Compare(previous)
+			}
+
+			bz, err := json.MarshalIndent(stats, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			outputFile, _ := cmd.Flags().GetString(FlagOutputFile)
+			if outputFile == "" {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			}
+
+			return os.WriteFile(outputFile, append(bz, '\n'), 0o600)
+		},
+	}
+
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().Int(FlagTop, storestats.DefaultTop, "Number of largest keys reported per store")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Height to report, default to latest state height")
+	cmd.Flags().String(FlagPrevious, "", "Previous stats JSON file to compute the growth of the stores against")
+	cmd.Flags().String(FlagOutputFile, "", "Write the stats to the given file instead of stdout")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT RPC interface of a node to query the stats from")
+
+	return cmd
+}
+
+// collectStats collects the stats from the application database.
+func collectStats[T servertypes.Application](cmd *cobra.Command, appCreator servertypes.AppCreator[T], height int64, top int) (storestats.Stats, error) {
+	ctx := server.GetServerContextFromCmd(cmd)
+
+	db, err := openDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return storestats.Stats{}, err
+	}
+	defer db.Close()
+
+	// the app logs are discarded, keeping the JSON report alone on stdout
+	app := appCreator(log.NewNopLogger(), db, nil, ctx.Viper)
+
+	return storestats.CollectMultiStore(app.CommitMultiStore(), height, top)
+}
+
+// queryStats queries the stats from a running node.
+func queryStats(cmd *cobra.Command, height int64, top int) (storestats.Stats, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return storestats.Stats{}, err
+	}
+
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/app/store_stats/%d", top),
+		Height: height,
+	})
+	if err != nil {
+		return storestats.Stats{}, err
+	}
+
+	var stats storestats.Stats
+	if err := json.Unmarshal(res.Value, &stats); err != nil {
+		return storestats.Stats{}, fmt.Errorf("invalid store stats returned by the node: %w", err)
+	}
+
+	return stats, nil
+}
+
+// readStats reads a stats JSON file written by the stats command.
+func readStats(path string) (storestats.Stats, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return storestats.Stats{}, err
+	}
+
+	var stats storestats.Stats
+	if err := json.Unmarshal(bz, &stats); err != nil {
+		return storestats.Stats{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return stats, nil
+}
+
+func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("application", backendType, dataDir)
+}
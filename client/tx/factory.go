@@ -37,6 +37,7 @@ type Factory struct {
 	chainID            string
 	fromName           string
 	unordered          bool
+	lane               uint64
 	offline            bool
 	generateOnly       bool
 	memo               string
@@ -44,6 +45,8 @@ type Factory struct {
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
 	gasPrices          sdk.DecCoins
+	autoGasPrices      bool
+	gasPricesSpeed     string
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
 	simulateAndExecute bool
@@ -88,6 +91,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	memo := clientCtx.Viper.GetString(flags.FlagNote)
 	timeoutHeight := clientCtx.Viper.GetUint64(flags.FlagTimeoutHeight)
 	unordered := clientCtx.Viper.GetBool(flags.FlagUnordered)
+	lane := clientCtx.Viper.GetUint64(flags.FlagLane)
 
 	gasStr := clientCtx.Viper.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -106,6 +110,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
 		unordered:          unordered,
+		lane:               lane,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
@@ -117,7 +122,23 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	f = f.WithFees(feesStr)
 
 	gasPricesStr := clientCtx.Viper.GetString(flags.FlagGasPrices)
-	f = f.WithGasPrices(gasPricesStr)
+	if gasPricesStr == flags.GasFlagAuto {
+		gasPricesSpeed := clientCtx.Viper.GetString(flags.FlagGasPricesSpeed)
+		if gasPricesSpeed == "" {
+			gasPricesSpeed = flags.GasPricesSpeedAverage
+		}
+
+		switch gasPricesSpeed {
+		case flags.GasPricesSpeedSlow, flags.GasPricesSpeedAverage, flags.GasPricesSpeedFast:
+		default:
+			return Factory{}, fmt.Errorf("invalid gas prices speed %q, expected one of %s, %s or %s",
+				gasPricesSpeed, flags.GasPricesSpeedSlow, flags.GasPricesSpeedAverage, flags.GasPricesSpeedFast)
+		}
+
+		f = f.WithAutoGasPrices(gasPricesSpeed)
+	} else {
+		f = f.WithGasPrices(gasPricesStr)
+	}
 
 	f = f.WithPreprocessTxHook(clientCtx.PreprocessTxHook)
 
@@ -136,12 +157,17 @@ func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 func (f Factory) Unordered() bool                           { return f.unordered }
+func (f Factory) Lane() uint64                              { return f.lane }
 func (f Factory) FromName() string                          { return f.fromName }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
 
+// AutoGasPrices returns whether the gas prices are suggested by the node, and the
+// target inclusion speed they are suggested for.
+func (f Factory) AutoGasPrices() (bool, string) { return f.autoGasPrices, f.gasPricesSpeed }
+
 // WithTxConfig returns a copy of the Factory with an updated TxConfig.
 func (f Factory) WithTxConfig(g client.TxConfig) Factory {
 	f.txConfig = g
@@ -185,9 +211,41 @@ func (f Factory) WithGasPrices(gasPrices string) Factory {
 	}
 
 	f.gasPrices = parsedGasPrices
+	f.autoGasPrices = false
+	return f
+}
+
+// WithAutoGasPrices returns a copy of the Factory querying the node for the gas prices
+// recently paid on chain, and using the ones suggested for the given inclusion speed
+// (slow, average or fast).
+func (f Factory) WithAutoGasPrices(speed string) Factory {
+	f.gasPrices = nil
+	f.autoGasPrices = true
+	f.gasPricesSpeed = speed
 	return f
 }
 
+// withSuggestedGasPrices returns a copy of the Factory with the gas prices suggested by
+// the node, if they are set to be queried.
+func (f Factory) withSuggestedGasPrices(clientCtx client.Context) (Factory, error) {
+	if !f.autoGasPrices {
+		return f, nil
+	}
+
+	if clientCtx.Offline {
+		return f, errors.New("cannot query gas prices in offline mode")
+	}
+
+	gasPrice, err := SuggestGasPrice(clientCtx, f.gasPricesSpeed)
+	if err != nil {
+		return f, err
+	}
+
+	f = f.WithGasPrices(gasPrice.String())
+	_, _ = fmt.Fprintf(os.Stderr, "gas prices: %s\n", f.GasPrices())
+	return f, nil
+}
+
 // WithKeybase returns a copy of the Factory with updated Keybase.
 func (f Factory) WithKeybase(keybase keyring.Keyring) Factory {
 	f.keybase = keybase
@@ -255,6 +313,12 @@ func (f Factory) WithUnordered(v bool) Factory {
 	return f
 }
 
+// WithLane returns a copy of the Factory with an updated nonce lane.
+func (f Factory) WithLane(lane uint64) Factory {
+	f.lane = lane
+	return f
+}
+
 // WithFeeGranter returns a copy of the Factory with an updated fee granter.
 func (f Factory) WithFeeGranter(fg sdk.AccAddress) Factory {
 	f.feeGranter = fg
@@ -361,6 +425,7 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetFeeGranter(f.feeGranter)
 	tx.SetFeePayer(f.feePayer)
 	tx.SetTimeoutHeight(f.TimeoutHeight())
+	tx.SetLane(f.Lane())
 
 	if etx, ok := tx.(client.ExtendedTxBuilder); ok {
 		etx.SetExtensionOptions(f.extOptions...)
@@ -374,6 +439,11 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 // simulated and also printed to the same writer before the transaction is
 // printed.
 func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) error {
+	f, err := f.withSuggestedGasPrices(clientCtx)
+	if err != nil {
+		return err
+	}
+
 	if f.SimulateAndExecute() {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -521,5 +591,19 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 		}
 	}
 
+	// the transactions of a nonce lane are signed with the sequence of the lane
+	if initSeq == 0 && fc.lane != 0 {
+		laneRetriever, ok := fc.accountRetriever.(client.LaneSequenceRetriever)
+		if !ok {
+			return fc, errors.New("account retriever cannot retrieve the sequence of a nonce lane, the sequence must be set")
+		}
+
+		seq, err := laneRetriever.GetLaneSequence(clientCtx, from, fc.lane)
+		if err != nil {
+			return fc, err
+		}
+		fc = fc.WithSequence(seq)
+	}
+
 	return fc, nil
 }
@@ -3,9 +3,11 @@ package tx
 import (
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -43,6 +45,39 @@ func TestFactoryPrepare(t *testing.T) {
 	require.Equal(t, output.Sequence(), uint64(1))
 }
 
+func TestNewFactoryCLI_AutoGasPrices(t *testing.T) {
+	newFactory := func(args ...string) (Factory, error) {
+		cmd := &cobra.Command{}
+		flags.AddTxFlagsToCmd(cmd)
+		require.NoError(t, cmd.ParseFlags(args))
+		return NewFactoryCLI(client.Context{}, cmd.Flags())
+	}
+
+	factory, err := newFactory("--gas-prices", "0.1stake")
+	require.NoError(t, err)
+	auto, _ := factory.AutoGasPrices()
+	require.False(t, auto)
+	require.Equal(t, "0.100000000000000000stake", factory.GasPrices().String())
+
+	factory, err = newFactory("--gas-prices", "auto")
+	require.NoError(t, err)
+	auto, speed := factory.AutoGasPrices()
+	require.True(t, auto)
+	require.Equal(t, flags.GasPricesSpeedAverage, speed)
+	require.True(t, factory.GasPrices().IsZero())
+
+	factory, err = newFactory("--gas-prices", "auto", "--gas-prices-speed", "fast")
+	require.NoError(t, err)
+	_, speed = factory.AutoGasPrices()
+	require.Equal(t, flags.GasPricesSpeedFast, speed)
+
+	_, err = newFactory("--gas-prices", "auto", "--gas-prices-speed", "instant")
+	require.ErrorContains(t, err, "invalid gas prices speed")
+
+	_, err = factory.withSuggestedGasPrices(client.Context{}.WithOffline(true))
+	require.ErrorContains(t, err, "offline mode")
+}
+
 func TestFactory_getSimPKType(t *testing.T) {
 	// setup keyring
 	registry := codectypes.NewInterfaceRegistry()
@@ -14,6 +14,8 @@ import (
 	authsigning "cosmossdk.io/x/auth/signing"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -76,6 +78,11 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	txf, err = txf.withSuggestedGasPrices(clientCtx)
+	if err != nil {
+		return err
+	}
+
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -165,6 +172,32 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// SuggestGasPrice queries the node for the gas prices recently paid on chain and returns
+// the gas price suggested for the given inclusion speed (slow, average or fast), in the
+// denom most used to pay fees.
+func SuggestGasPrice(clientCtx gogogrpc.ClientConn, speed string) (sdk.DecCoin, error) {
+	res, err := node.NewServiceClient(clientCtx).GasPrices(context.Background(), &node.GasPricesRequest{})
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if len(res.GasPrices) == 0 {
+		return sdk.DecCoin{}, errors.New("node has no gas prices to suggest, set --gas-prices or --fees")
+	}
+
+	gasPrices := res.GasPrices[0]
+	switch speed {
+	case flags.GasPricesSpeedSlow:
+		return sdk.NewDecCoinFromDec(gasPrices.Denom, gasPrices.Slow), nil
+	case flags.GasPricesSpeedAverage:
+		return sdk.NewDecCoinFromDec(gasPrices.Denom, gasPrices.Average), nil
+	case flags.GasPricesSpeedFast:
+		return sdk.NewDecCoinFromDec(gasPrices.Denom, gasPrices.Fast), nil
+	default:
+		return sdk.DecCoin{}, fmt.Errorf("invalid gas prices speed %q", speed)
+	}
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
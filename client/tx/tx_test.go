@@ -9,11 +9,14 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/ante"
 	"cosmossdk.io/x/auth/signing"
 	authtx "cosmossdk.io/x/auth/tx"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/testutil"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -106,6 +109,44 @@ func TestCalculateGas(t *testing.T) {
 	}
 }
 
+// mockGasPricesContext is a mock client.Context returning the given gas prices, used to
+// unit test SuggestGasPrice.
+type mockGasPricesContext struct {
+	gasPrices []node.GasPricePercentiles
+}
+
+func (m mockGasPricesContext) Invoke(_ context.Context, _ string, _, reply interface{}, _ ...grpc.CallOption) error {
+	*(reply.(*node.GasPricesResponse)) = node.GasPricesResponse{Blocks: 20, GasPrices: m.gasPrices}
+	return nil
+}
+
+func (mockGasPricesContext) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestSuggestGasPrice(t *testing.T) {
+	clientCtx := mockGasPricesContext{gasPrices: []node.GasPricePercentiles{
+		{Denom: "stake", Txs: 10, Slow: math.LegacyNewDec(1), Average: math.LegacyNewDec(2), Fast: math.LegacyNewDec(5)},
+		{Denom: "atom", Txs: 1, Slow: math.LegacyNewDec(3), Average: math.LegacyNewDec(3), Fast: math.LegacyNewDec(3)},
+	}}
+
+	for speed, expected := range map[string]sdk.DecCoin{
+		flags.GasPricesSpeedSlow:    sdk.NewInt64DecCoin("stake", 1),
+		flags.GasPricesSpeedAverage: sdk.NewInt64DecCoin("stake", 2),
+		flags.GasPricesSpeedFast:    sdk.NewInt64DecCoin("stake", 5),
+	} {
+		gasPrice, err := SuggestGasPrice(clientCtx, speed)
+		require.NoError(t, err)
+		require.Equal(t, expected, gasPrice)
+	}
+
+	_, err := SuggestGasPrice(clientCtx, "instant")
+	require.ErrorContains(t, err, "invalid gas prices speed")
+
+	_, err = SuggestGasPrice(mockGasPricesContext{}, flags.GasPricesSpeedAverage)
+	require.ErrorContains(t, err, "no gas prices to suggest")
+}
+
 func mockTxFactory(txCfg client.TxConfig) Factory {
 	return Factory{}.
 		WithTxConfig(txCfg).
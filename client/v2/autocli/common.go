@@ -224,6 +224,17 @@ func enhanceCustomCmd(builder *Builder, cmd *cobra.Command, cmdType cmdType, mod
 
 // outOrStdoutFormat formats the output based on the output flag and writes it to the command's output stream.
 func (b *Builder) outOrStdoutFormat(cmd *cobra.Command, out []byte) error {
+	formatted, err := b.formatOutput(cmd, out)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(formatted)
+	return nil
+}
+
+// formatOutput formats the JSON output based on the output flag.
+func (b *Builder) formatOutput(cmd *cobra.Command, out []byte) (string, error) {
 	var err error
 	outputType := cmd.Flag(flags.FlagOutput)
 	// if the output type is text, convert the json to yaml
@@ -231,10 +242,9 @@ func (b *Builder) outOrStdoutFormat(cmd *cobra.Command, out []byte) error {
 	if outputType != nil && outputType.Value.String() == flags.OutputFormatText {
 		out, err = yaml.JSONToYAML(out)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 
-	cmd.Println(strings.TrimSpace(string(out)))
-	return nil
+	return strings.TrimSpace(string(out)), nil
 }
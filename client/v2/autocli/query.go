@@ -14,6 +14,9 @@ import (
 
 	"cosmossdk.io/client/v2/internal/flags"
 	"cosmossdk.io/client/v2/internal/util"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
 )
 
 // BuildQueryCommand builds the query commands for all the provided modules. If a custom command is provided for a
@@ -123,22 +126,36 @@ func (b *Builder) BuildQueryMethodCommand(ctx context.Context, descriptor protor
 			return err
 		}
 
-		output := outputType.New()
-		if err := clientConn.Invoke(cmd.Context(), methodName, input.Interface(), output.Interface()); err != nil {
-			return err
-		}
-
 		if noIndent, _ := cmd.Flags().GetBool(flags.FlagNoIndent); noIndent {
 			encoderOptions.Indent = ""
 		}
 
-		enc := encoder(aminojson.NewEncoder(encoderOptions))
-		bz, err := enc.Marshal(output.Interface())
+		query := func() (string, error) {
+			output := outputType.New()
+			if err := clientConn.Invoke(cmd.Context(), methodName, input.Interface(), output.Interface()); err != nil {
+				return "", err
+			}
+
+			enc := encoder(aminojson.NewEncoder(encoderOptions))
+			bz, err := enc.Marshal(output.Interface())
+			if err != nil {
+				return "", fmt.Errorf("cannot marshal response %v: %w", output.Interface(), err)
+			}
+
+			return b.formatOutput(cmd, bz)
+		}
+
+		if interval, _ := cmd.Flags().GetDuration(flags.FlagWatch); interval != 0 {
+			return client.Watch(cmd.Context(), cmd.OutOrStdout(), interval, query)
+		}
+
+		out, err := query()
 		if err != nil {
-			return fmt.Errorf("cannot marshal response %v: %w", output.Interface(), err)
+			return err
 		}
 
-		return b.outOrStdoutFormat(cmd, bz)
+		cmd.Println(out)
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -148,6 +165,8 @@ func (b *Builder) BuildQueryMethodCommand(ctx context.Context, descriptor protor
 		b.AddQueryConnFlags(cmd)
 
 		cmd.Flags().BoolP(flags.FlagNoIndent, "", false, "Do not indent JSON output")
+		cmd.Flags().Duration(flags.FlagWatch, 0, fmt.Sprintf("Rerun the query at the given interval (e.g. --%s=10s, default %s), highlighting the lines of the output that changed", flags.FlagWatch, sdkflags.DefaultWatchInterval))
+		cmd.Flags().Lookup(flags.FlagWatch).NoOptDefVal = sdkflags.DefaultWatchInterval
 	}
 
 	// silence usage only for inner txs & queries commands
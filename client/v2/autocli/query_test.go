@@ -1,6 +1,7 @@
 package autocli
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -621,6 +622,25 @@ func TestOutputFormat(t *testing.T) {
 	assert.Assert(t, strings.Contains(out.String(), "  positional1: 1"))
 }
 
+func TestWatch(t *testing.T) {
+	fixture := initFixture(t)
+
+	cmd, err := buildModuleQueryCommand("test", fixture)
+	assert.NilError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	out := &bytes.Buffer{}
+	cmd.SetArgs([]string{"echo", "1", "abc", "1foo", "--output", "text", "--watch=10ms"})
+	cmd.SetOut(out)
+	assert.NilError(t, cmd.ExecuteContext(ctx))
+	assert.Assert(t, strings.Count(out.String(), "Every 10ms: ") > 1)
+	// the echoed request does not change between runs
+	assert.Assert(t, strings.Contains(out.String(), "    positional1: 1"))
+	assert.Assert(t, !strings.Contains(out.String(), "* "))
+}
+
 func TestHelpQuery(t *testing.T) {
 	fixture := initFixture(t)
 
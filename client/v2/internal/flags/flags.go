@@ -11,6 +11,9 @@ const (
 	// FlagNoIndent is the flag to not indent the output.
 	FlagNoIndent = "no-indent"
 
+	// FlagWatch is the flag to rerun a query at an interval.
+	FlagWatch = "watch"
+
 	// FlagNoPrompt is the flag to not use a prompt for commands.
 	FlagNoPrompt = "no-prompt"
 
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	isatty "github.com/mattn/go-isatty"
+)
+
+const (
+	clearScreen    = "\x1b[H\x1b[2J"
+	highlightStart = "\x1b[7m"
+	highlightEnd   = "\x1b[0m"
+)
+
+// Watch runs query and writes its output to w, then reruns it every interval
+// until ctx is done. The lines of the output that changed since the previous
+// run are highlighted: on a terminal the screen is redrawn and the lines are
+// shown in reverse video, otherwise each run is appended and the changed lines
+// are prefixed with "* ".
+//
+// An error of the first run is returned, later errors are written to w and the
+// query is retried at the next interval, so that a node restart does not stop
+// the watch.
+func Watch(ctx context.Context, w io.Writer, interval time.Duration, query func() (string, error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid watch interval %s", interval)
+	}
+
+	previous, err := query()
+	if err != nil {
+		return err
+	}
+
+	terminal := isTerminal(w)
+	writeWatchFrame(w, terminal, interval, previous, "")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		out, err := query()
+		if err != nil {
+			fmt.Fprintf(w, "%s: %v\n", time.Now().Format(time.RFC3339), err)
+			continue
+		}
+
+		writeWatchFrame(w, terminal, interval, out, previous)
+		previous = out
+	}
+}
+
+// writeWatchFrame writes an output of a watched query, highlighting the lines
+// that differ from the line at the same position in the previous output. The
+// previous output is empty on the first run.
+func writeWatchFrame(w io.Writer, terminal bool, interval time.Duration, out, previous string) {
+	var sb strings.Builder
+	if terminal {
+		sb.WriteString(clearScreen)
+	} else if previous != "" {
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "Every %s: %s\n\n", interval, time.Now().Format(time.RFC3339))
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	previousLines := strings.Split(strings.TrimSpace(previous), "\n")
+	for i, line := range lines {
+		changed := previous != "" && (i >= len(previousLines) || previousLines[i] != line)
+		switch {
+		case terminal && changed:
+			sb.WriteString(highlightStart + line + highlightEnd)
+		case terminal || previous == "":
+			sb.WriteString(line)
+		case changed:
+			sb.WriteString("* " + line)
+		default:
+			sb.WriteString("  " + line)
+		}
+		sb.WriteString("\n")
+	}
+
+	_, _ = io.WriteString(w, sb.String())
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && (isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd()))
+}
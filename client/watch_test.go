@@ -0,0 +1,69 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestWatch(t *testing.T) {
+	outputs := []string{
+		"balance: 10\nheight: 1",
+		"", // error
+		"balance: 10\nheight: 2",
+		"balance: 7\nheight: 2\nlocked: 3",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	var buf bytes.Buffer
+	err := client.Watch(ctx, &buf, time.Millisecond, func() (string, error) {
+		out := outputs[calls]
+		calls++
+		if calls == len(outputs) {
+			cancel()
+		}
+		if out == "" {
+			return "", errors.New("node unavailable")
+		}
+		return out, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, len(outputs), calls)
+
+	var lines []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if line != "" && !strings.HasPrefix(line, "Every 1ms: ") {
+			lines = append(lines, line)
+		}
+	}
+	require.Len(t, lines, 8)
+	require.Equal(t, []string{"balance: 10", "height: 1"}, lines[:2])
+	require.Contains(t, lines[2], "node unavailable")
+	// changes are compared to the last successful run
+	require.Equal(t, []string{
+		"  balance: 10", "* height: 2",
+		"* balance: 7", "  height: 2", "* locked: 3",
+	}, lines[3:])
+}
+
+func TestWatchErrors(t *testing.T) {
+	err := client.Watch(context.Background(), &bytes.Buffer{}, 0, func() (string, error) {
+		return "", nil
+	})
+	require.ErrorContains(t, err, "invalid watch interval")
+
+	err = client.Watch(context.Background(), &bytes.Buffer{}, time.Millisecond, func() (string, error) {
+		return "", errors.New("invalid address")
+	})
+	require.ErrorContains(t, err, "invalid address")
+}
@@ -5,6 +5,7 @@ import (
 	"gitlab.com/yawning/secp256k1-voi/secec"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -16,6 +17,8 @@ const (
 	MultiType = PubKeyType("multi")
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters.
+	Secp256r1Type = PubKeyType("secp256r1")
 	// Ed25519Type represents the Ed25519Type signature system.
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
@@ -26,6 +29,10 @@ const (
 // Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 var Secp256k1 = secp256k1Algo{}
 
+// Secp256r1 uses the NIST P-256 ECDSA parameters. It is not supported by the
+// keyring by default, apps opt in by adding it to keyring.Options.SupportedAlgos.
+var Secp256r1 = secp256r1Algo{}
+
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
 	GenerateFn func(bz []byte) types.PrivKey
@@ -74,3 +81,36 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: privKeyObj.Bytes()}
 	}
 }
+
+type secp256r1Algo struct{}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secp256r1 secret for the given seed and HD path,
+// following the SLIP-10 derivation for the nist256p1 curve.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return DeriveNist256p1PrivateKeyForPath(seed, hdPath)
+	}
+}
+
+// Generate generates a secp256r1 private key from the given bytes. It returns nil
+// if the bytes are not a valid secp256r1 secret, which the secrets returned by
+// Derive always are.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		privKey, err := secp256r1.NewPrivKeyFromSecret(bz)
+		if err != nil {
+			return nil
+		}
+
+		return privKey
+	}
+}
@@ -167,22 +167,43 @@ func ComputeMastersFromSeed(seed []byte) (secret, chainCode [32]byte) {
 // DerivePrivateKeyForPath derives the private key by following the BIP 32/44 path from privKeyBytes,
 // using the given chainCode.
 func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]byte, error) {
+	data := privKeyBytes
+	err := walkPath(path, func(idx uint32, harden bool) error {
+		data, chainCode = derivePrivateKey(data, chainCode, idx, harden)
+		return nil
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+
+	derivedKey := make([]byte, 32)
+	n := copy(derivedKey, data[:])
+
+	if n != 32 || len(data) != 32 {
+		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
+	}
+
+	return derivedKey, nil
+}
+
+// walkPath calls fn with the index of each element of the BIP 32 path, and
+// whether the element is hardened.
+func walkPath(path string, fn func(idx uint32, harden bool) error) error {
 	// First step is to trim the right end path separator lest we panic.
 	// See issue https://github.com/cosmos/cosmos-sdk/issues/8557
 	path = strings.TrimRightFunc(path, func(r rune) bool { return r == filepath.Separator })
-	data := privKeyBytes
 	parts := strings.Split(path, "/")
 
 	switch {
 	case parts[0] == path:
-		return nil, fmt.Errorf("path '%s' doesn't contain '/' separators", path)
+		return fmt.Errorf("path '%s' doesn't contain '/' separators", path)
 	case strings.TrimSpace(parts[0]) == "m":
 		parts = parts[1:]
 	}
 
 	for i, part := range parts {
 		if part == "" {
-			return nil, fmt.Errorf("path %q with split element #%d is an empty string", part, i)
+			return fmt.Errorf("path %q with split element #%d is an empty string", part, i)
 		}
 		// do we have an apostrophe?
 		harden := part[len(part)-1:] == "'"
@@ -196,20 +217,15 @@ func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]b
 		// index values are in the range [0, 1<<31-1] aka [0, max(int32)]
 		idx, err := strconv.ParseUint(part, 10, 31)
 		if err != nil {
-			return []byte{}, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
+			return fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
 		}
 
-		data, chainCode = derivePrivateKey(data, chainCode, uint32(idx), harden)
-	}
-
-	derivedKey := make([]byte, 32)
-	n := copy(derivedKey, data[:])
-
-	if n != 32 || len(data) != 32 {
-		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
+		if err := fn(uint32(idx), harden); err != nil {
+			return err
+		}
 	}
 
-	return derivedKey, nil
+	return nil
 }
 
 // derivePrivateKey derives the private key with index and chainCode.
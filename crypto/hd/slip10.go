@@ -0,0 +1,92 @@
+package hd
+
+import (
+	"crypto/ecdh"
+	"crypto/elliptic"
+	"math/big"
+)
+
+// nist256p1Seed is the SLIP-10 curve identifier of NIST P-256.
+var nist256p1Seed = []byte("Nist256p1 seed")
+
+// DeriveNist256p1PrivateKeyForPath derives the NIST P-256 private key of the
+// seed by following the BIP 32 path as specified by SLIP-10
+// (https://github.com/satoshilabs/slips/blob/master/slip-0010.md). Unlike a
+// BIP 32 secp256k1 derivation, it always returns a secret in the [1, N-1]
+// range of the curve order. An empty path derives the master key.
+func DeriveNist256p1PrivateKeyForPath(seed []byte, path string) ([]byte, error) {
+	n := elliptic.P256().Params().N
+
+	// master key
+	secret, chainCode := i64(nist256p1Seed, seed)
+	for !isValidScalar(secret[:], n) {
+		secret, chainCode = i64(nist256p1Seed, append(secret[:], chainCode[:]...))
+	}
+
+	if len(path) == 0 {
+		return secret[:], nil
+	}
+
+	err := walkPath(path, func(idx uint32, harden bool) error {
+		var err error
+		secret, chainCode, err = deriveNist256p1PrivateKey(secret, chainCode, idx, harden, n)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return secret[:], nil
+}
+
+// deriveNist256p1PrivateKey derives the child private key of index as specified
+// by SLIP-10, retrying with the next candidate when the child key is invalid.
+func deriveNist256p1PrivateKey(secret, chainCode [32]byte, index uint32, harden bool, n *big.Int) ([32]byte, [32]byte, error) {
+	var data []byte
+	if harden {
+		index |= 0x80000000
+		data = append([]byte{0}, secret[:]...)
+	} else {
+		pubKey, err := nist256p1CompressedPubKey(secret)
+		if err != nil {
+			return [32]byte{}, [32]byte{}, err
+		}
+		data = pubKey
+	}
+	data = append(data, uint32ToBytes(index)...)
+
+	for {
+		il, ir := i64(chainCode[:], data)
+		if isValidScalar(il[:], n) {
+			child := new(big.Int).Add(new(big.Int).SetBytes(il[:]), new(big.Int).SetBytes(secret[:]))
+			child.Mod(child, n)
+			if child.Sign() != 0 {
+				var childSecret [32]byte
+				child.FillBytes(childSecret[:])
+				return childSecret, ir, nil
+			}
+		}
+
+		data = append(append([]byte{1}, ir[:]...), uint32ToBytes(index)...)
+	}
+}
+
+// nist256p1CompressedPubKey returns the SEC1 compressed public key of the
+// secret.
+func nist256p1CompressedPubKey(secret [32]byte) ([]byte, error) {
+	privKey, err := ecdh.P256().NewPrivateKey(secret[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// the uncompressed encoding is 0x04 | X | Y
+	uncompressed := privKey.PublicKey().Bytes()
+	compressed := append([]byte{0x02 | uncompressed[64]&1}, uncompressed[1:33]...)
+	return compressed, nil
+}
+
+// isValidScalar returns whether the big-endian scalar is in the [1, N-1] range.
+func isValidScalar(bz []byte, n *big.Int) bool {
+	k := new(big.Int).SetBytes(bz)
+	return k.Sign() != 0 && k.Cmp(n) < 0
+}
@@ -0,0 +1,40 @@
+package hd_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+)
+
+// TestDeriveNist256p1PrivateKeyForPath checks the test vectors of SLIP-10 for
+// the nist256p1 curve (https://github.com/satoshilabs/slips/blob/master/slip-0010.md).
+func TestDeriveNist256p1PrivateKeyForPath(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	tests := []struct {
+		path   string
+		secret string
+	}{
+		{"", "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2"},
+		{"m/0'", "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"},
+		{"m/0'/1", "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129"},
+		{"m/0'/1/2'", "694596e8a54f252c960eb771a3c41e7e32496d03b954aeb90f61635b8e092aa7"},
+		{"m/0'/1/2'/2", "5996c37fd3dd2679039b23ed6f70b506c6b56b3cb5e424681fb0fa64caf82aaa"},
+		{"m/0'/1/2'/2/1000000000", "21c4f269ef0a5fd1badf47eeacebeeaa3de22eb8e5b0adcd0f27dd99d34d0119"},
+		// derivation retry
+		{"m/28578'", "06f0db126f023755d0b8d86d4591718a5210dd8d024e3e14b6159d63f53aa669"},
+		{"m/28578'/33941", "092154eed4af83e078ff9b84322015aefe5769e31270f62c3f66c33888335f3a"},
+	}
+	for _, tt := range tests {
+		secret, err := hd.DeriveNist256p1PrivateKeyForPath(seed, tt.path)
+		require.NoError(t, err, tt.path)
+		require.Equal(t, tt.secret, hex.EncodeToString(secret), tt.path)
+	}
+
+	_, err = hd.DeriveNist256p1PrivateKeyForPath(seed, "m/0'/x")
+	require.ErrorContains(t, err, "invalid BIP 32 path")
+}
@@ -354,6 +354,9 @@ func (ks keystore) ImportPrivKeyHex(uid, privKey, algoStr string) error {
 		return err
 	}
 	priv := algo.Generate()(decodedPriv)
+	if priv == nil {
+		return fmt.Errorf("invalid %s private key", algo.Name())
+	}
 	_, err = ks.writeLocalKey(uid, priv)
 	if err != nil {
 		return err
@@ -589,6 +592,9 @@ func (ks keystore) NewAccount(name, mnemonic, bip39Passphrase, hdPath string, al
 	}
 
 	privKey := algo.Generate()(derivedPriv)
+	if privKey == nil {
+		return nil, fmt.Errorf("invalid %s private key", algo.Name())
+	}
 
 	// check if the key already exists with the same address and return an error
 	// if found
@@ -1902,6 +1902,46 @@ func TestAltKeyring_SignByAddress(t *testing.T) {
 	}
 }
 
+func TestAltKeyring_Secp256r1(t *testing.T) {
+	cdc := getCodec()
+	defaultKr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	_, _, err = defaultKr.NewMnemonic("r1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256r1)
+	require.ErrorIs(t, err, ErrUnsupportedSigningAlgo)
+
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc, func(options *Options) {
+		options.SupportedAlgos = SigningAlgoList{hd.Secp256k1, hd.Secp256r1}
+	})
+	require.NoError(t, err)
+
+	k, _, err := kr.NewMnemonic("r1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256r1)
+	require.NoError(t, err)
+	key, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, string(hd.Secp256r1Type), key.Type())
+
+	msg := []byte("some message")
+	sign, signKey, err := kr.Sign("r1", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, signKey.Equals(key))
+	require.True(t, key.VerifySignature(msg, sign))
+
+	armor, err := kr.ExportPrivKeyArmor("r1", "apassphrase")
+	require.NoError(t, err)
+	require.NoError(t, kr.Delete("r1"))
+	require.NoError(t, kr.ImportPrivKey("imported", armor, "apassphrase"))
+
+	imported, err := kr.Key("imported")
+	require.NoError(t, err)
+	importedKey, err := imported.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, importedKey.Equals(key))
+
+	// a secret out of the curve order range is rejected
+	err = kr.ImportPrivKeyHex("invalid", strings.Repeat("ff", 32), string(hd.Secp256r1Type))
+	require.ErrorContains(t, err, "invalid secp256r1 private key")
+}
+
 func TestAltKeyring_ConstructorSupportedAlgos(t *testing.T) {
 	cdc := getCodec()
 	tests := []struct {
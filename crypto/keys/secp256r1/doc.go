@@ -16,6 +16,11 @@ const (
 	pubKeySize = fieldSize + 1
 
 	name = "secp256r1"
+
+	// PubKeyName defines the amino name of the secp256r1 public key.
+	PubKeyName = "cosmos/PubKeySecp256r1"
+	// PrivKeyName defines the amino name of the secp256r1 private key.
+	PrivKeyName = "cosmos/PrivKeySecp256r1"
 )
 
 var secp256r1 elliptic.Curve
@@ -29,7 +34,8 @@ func init() {
 	}
 }
 
-// RegisterInterfaces adds secp256r1 PubKey to pubkey registry
+// RegisterInterfaces adds secp256r1 PubKey and PrivKey to the pubkey and privkey registries
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
 }
@@ -1,16 +1,34 @@
 package secp256r1
 
 import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
+var _ codec.AminoMarshaler = &PrivKey{}
+
 // GenPrivKey generates a new secp256r1 private key. It uses operating system randomness.
 func GenPrivKey() (*PrivKey, error) {
 	key, err := ecdsa.GenPrivKey(secp256r1)
 	return &PrivKey{&ecdsaSK{key}}, err
 }
 
+// NewPrivKeyFromSecret creates a private key from a big-endian encoded secret scalar.
+// The secret must be in the [1, N-1] range of the curve order.
+func NewPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	sk := &ecdsaSK{}
+	if err := sk.Unmarshal(secret); err != nil {
+		return nil, err
+	}
+	if sk.D.Sign() == 0 || sk.D.Cmp(secp256r1.Params().N) >= 0 {
+		return nil, errors.New("secp256r1 secret is out of the curve order range")
+	}
+	return &PrivKey{Secret: sk}, nil
+}
+
 // PubKey implements SDK PrivKey interface.
 func (m *PrivKey) PubKey() cryptotypes.PubKey {
 	return &PubKey{&ecdsaPK{m.Secret.PubKey()}}
@@ -48,6 +66,31 @@ func (m *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
 	return m.Secret.Equal(&sk2.Secret.PrivateKey)
 }
 
+// MarshalAmino overrides Amino binary marshaling.
+func (m PrivKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (m *PrivKey) UnmarshalAmino(bz []byte) error {
+	sk, err := NewPrivKeyFromSecret(bz)
+	if err != nil {
+		return err
+	}
+	m.Secret = sk.Secret
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (m PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (m *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaSK struct {
 	ecdsa.PrivKey
 }
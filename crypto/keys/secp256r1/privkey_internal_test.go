@@ -48,6 +48,43 @@ func (suite *SKSuite) TestBytes() {
 	suite.Nil(sk.Bytes())
 }
 
+func (suite *SKSuite) TestNewPrivKeyFromSecret() {
+	require := suite.Require()
+
+	sk, err := NewPrivKeyFromSecret(suite.sk.Bytes())
+	require.NoError(err)
+	require.True(sk.Equals(suite.sk))
+
+	_, err = NewPrivKeyFromSecret(make([]byte, fieldSize))
+	require.Error(err)
+
+	_, err = NewPrivKeyFromSecret(secp256r1.Params().N.FillBytes(make([]byte, fieldSize)))
+	require.Error(err)
+
+	_, err = NewPrivKeyFromSecret([]byte{1})
+	require.Error(err)
+}
+
+func (suite *SKSuite) TestMarshalAmino() {
+	require := suite.Require()
+
+	cdc := codec.NewLegacyAmino()
+	cdc.RegisterInterface((*cryptotypes.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(&PrivKey{}, PrivKeyName, nil)
+
+	bz, err := cdc.Marshal(suite.sk)
+	require.NoError(err)
+	var sk cryptotypes.PrivKey
+	require.NoError(cdc.Unmarshal(bz, &sk))
+	require.True(sk.Equals(suite.sk))
+
+	bz, err = cdc.MarshalJSON(suite.sk)
+	require.NoError(err)
+	sk = nil
+	require.NoError(cdc.UnmarshalJSON(bz, &sk))
+	require.True(sk.Equals(suite.sk))
+}
+
 func (suite *SKSuite) TestMarshalProto() {
 	require := suite.Require()
 
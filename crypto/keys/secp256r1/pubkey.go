@@ -4,10 +4,13 @@ import (
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	ecdsa "github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
+var _ codec.AminoMarshaler = &PubKey{}
+
 // String implements proto.Message interface.
 func (m *PubKey) String() string {
 	return m.Key.String(name)
@@ -45,6 +48,31 @@ func (m *PubKey) VerifySignature(msg, sig []byte) bool {
 	return m.Key.VerifySignature(msg, sig)
 }
 
+// MarshalAmino overrides Amino binary marshaling.
+func (m PubKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (m *PubKey) UnmarshalAmino(bz []byte) error {
+	pk := &ecdsaPK{}
+	if err := pk.Unmarshal(bz); err != nil {
+		return err
+	}
+	m.Key = pk
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (m PubKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (m *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaPK struct {
 	ecdsa.PubKey
 }
@@ -118,6 +118,26 @@ func (suite *PKSuite) TestMarshalProto() {
 	require.Error(emptyCodec.UnmarshalInterface(bz, nil), "nil should fail")
 }
 
+func (suite *PKSuite) TestMarshalAmino() {
+	require := suite.Require()
+
+	cdc := codec.NewLegacyAmino()
+	cdc.RegisterInterface((*cryptotypes.PubKey)(nil), nil)
+	cdc.RegisterConcrete(&PubKey{}, PubKeyName, nil)
+
+	bz, err := cdc.Marshal(suite.pk)
+	require.NoError(err)
+	var pk cryptotypes.PubKey
+	require.NoError(cdc.Unmarshal(bz, &pk))
+	require.True(pk.Equals(suite.pk))
+
+	bz, err = cdc.MarshalJSON(suite.pk)
+	require.NoError(err)
+	pk = nil
+	require.NoError(cdc.UnmarshalJSON(bz, &pk))
+	require.True(pk.Equals(suite.pk))
+}
+
 func (suite *PKSuite) TestSize() {
 	require := suite.Require()
 	var pk ecdsaPK
@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DSNTomlKey is the app.toml key of the PostgreSQL connection string of the
+	// indexer.
+	DSNTomlKey = "streaming.postgres.dsn"
+
+	// rpcLaddrKey is the config.toml key of the CometBFT RPC address, used to
+	// backfill the blocks the listener missed.
+	rpcLaddrKey = "rpc.laddr"
+
+	// DefaultQueueSize is the number of committed blocks waiting to be indexed
+	// beyond which the listener drops the new blocks and backfills them later.
+	DefaultQueueSize = 100
+
+	indexTimeout    = 30 * time.Second
+	minRetryDelay   = time.Second
+	maxRetryDelay   = time.Minute
+	backfillCommand = "postgres backfill"
+)
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// blockIndexer is the part of Indexer used by the listener.
+type blockIndexer interface {
+	LastIndexedHeight(ctx context.Context) (int64, error)
+	indexStreamedBlock(ctx context.Context, block Block) error
+	Close() error
+}
+
+// Listener is an ABCIListener indexing every committed block. The block is
+// kept in memory on FinalizeBlock and queued on Commit, so only committed
+// blocks end up in the database, and a worker writes the queued blocks without
+// holding up consensus.
+//
+// The height of the last block written by the worker is stored in the database.
+// When the worker finds a gap between it and the next block, because the node
+// restarted, the queue was full or a write failed, it backfills the missing
+// blocks from the CometBFT RPC before writing the block. A failed write is
+// retried until it succeeds or the listener is closed.
+type Listener struct {
+	indexer blockIndexer
+	resume  func(ctx context.Context, fromHeight, toHeight int64) error
+	logger  log.Logger
+
+	mu      sync.Mutex
+	pending *Block
+
+	queue      chan Block
+	retryDelay time.Duration
+	cancel     context.CancelFunc
+	done       chan struct{}
+}
+
+// NewListener returns a streaming listener writing blocks with the indexer and
+// starts its worker. The missed blocks are backfilled from node, or only logged
+// when node is nil. The listener must be closed to stop the worker.
+func NewListener(indexer *Indexer, node client.CometRPC, logger log.Logger) *Listener {
+	var resume func(ctx context.Context, fromHeight, toHeight int64) error
+	if node != nil {
+		resume = func(ctx context.Context, fromHeight, toHeight int64) error {
+			return Backfill(ctx, node, indexer, fromHeight, toHeight, nil)
+		}
+	}
+
+	return newListener(indexer, resume, logger, DefaultQueueSize, minRetryDelay)
+}
+
+func newListener(
+	indexer blockIndexer,
+	resume func(ctx context.Context, fromHeight, toHeight int64) error,
+	logger log.Logger,
+	queueSize int,
+	retryDelay time.Duration,
+) *Listener {
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &Listener{
+		indexer:    indexer,
+		resume:     resume,
+		logger:     logger.With(log.ModuleKey, "postgres-indexer"),
+		queue:      make(chan Block, queueSize),
+		retryDelay: retryDelay,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+
+	go l.run(ctx)
+
+	return l
+}
+
+// NewListenerFromAppOptions returns a streaming listener writing blocks with an
+// indexer connected to the database set with DSNTomlKey, or nil when it is not
+// set. The missed blocks are backfilled from the CometBFT RPC of the node. The
+// app registers the listener with BaseApp.AddABCIListeners and closes it on
+// shutdown.
+func NewListenerFromAppOptions(appOpts servertypes.AppOptions, txDecoder sdk.TxDecoder, cdc codec.Codec, logger log.Logger) (*Listener, error) {
+	dsn := strings.TrimSpace(cast.ToString(appOpts.Get(DSNTomlKey)))
+	if len(dsn) == 0 {
+		return nil, nil
+	}
+
+	var node client.CometRPC
+	if laddr := strings.TrimSpace(cast.ToString(appOpts.Get(rpcLaddrKey))); len(laddr) > 0 {
+		rpcClient, err := client.NewClientFromNode(laddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect postgres indexer to %s: %w", laddr, err)
+		}
+		node = rpcClient
+	}
+
+	indexer, err := NewIndexer(dsn, txDecoder, cdc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start postgres indexer: %w", err)
+	}
+
+	return NewListener(indexer, node, logger), nil
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.pending = &Block{
+		Height:    req.Height,
+		Time:      req.Time,
+		Hash:      req.Hash,
+		Proposer:  req.ProposerAddress,
+		Txs:       req.Txs,
+		TxResults: res.TxResults,
+		Events:    res.Events,
+	}
+
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener. It queues the block without
+// waiting for it to be written. When the queue is full the block is dropped and
+// backfilled by the worker once it catches up.
+func (l *Listener) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	l.mu.Lock()
+	block := l.pending
+	l.pending = nil
+	l.mu.Unlock()
+
+	if block == nil {
+		return nil
+	}
+
+	select {
+	case l.queue <- *block:
+	default:
+		l.logger.Error("indexer queue is full, the block will be backfilled", "height", block.Height)
+	}
+
+	return nil
+}
+
+// Close stops the worker and closes the indexer. The blocks still queued are
+// not written; the listener backfills them after the next start.
+func (l *Listener) Close() error {
+	l.cancel()
+	<-l.done
+
+	return l.indexer.Close()
+}
+
+func (l *Listener) run(ctx context.Context) {
+	defer close(l.done)
+
+	var lastHeight int64
+	if !l.retry(ctx, "failed to read the last indexed height", func() (err error) {
+		lastHeight, err = l.indexer.LastIndexedHeight(ctx)
+		return err
+	}) {
+		return
+	}
+
+	for {
+		var block Block
+		select {
+		case <-ctx.Done():
+			return
+		case block = <-l.queue:
+		}
+
+		if lastHeight > 0 && block.Height > lastHeight+1 {
+			if !l.backfill(ctx, lastHeight+1, block.Height-1) {
+				return
+			}
+		}
+
+		if !l.retry(ctx, "failed to index block", func() error {
+			indexCtx, cancel := context.WithTimeout(ctx, indexTimeout)
+			defer cancel()
+			return l.indexer.indexStreamedBlock(indexCtx, block)
+		}, "height", block.Height) {
+			return
+		}
+
+		lastHeight = block.Height
+	}
+}
+
+// backfill indexes the blocks from fromHeight to toHeight, retrying until it
+// succeeds. It returns false if the listener was closed in the meantime.
+func (l *Listener) backfill(ctx context.Context, fromHeight, toHeight int64) bool {
+	if l.resume == nil {
+		l.logger.Error("blocks were not indexed, run the backfill command to index them",
+			"from", fromHeight, "to", toHeight, "command", backfillCommand)
+		return true
+	}
+
+	l.logger.Info("backfilling blocks missed by the indexer", "from", fromHeight, "to", toHeight)
+
+	return l.retry(ctx, "failed to backfill blocks", func() error {
+		err := l.resume(ctx, fromHeight, toHeight)
+		if errors.Is(err, ErrBlocksPruned) {
+			// retrying would not help, the blocks are gone from this node
+			l.logger.Error("blocks were not indexed, backfill them from an archive node",
+				"from", fromHeight, "to", toHeight, "err", err)
+			return nil
+		}
+		return err
+	}, "from", fromHeight, "to", toHeight)
+}
+
+// retry calls fn until it succeeds, doubling the delay between the attempts up
+// to maxRetryDelay. It returns false if ctx was canceled first.
+func (l *Listener) retry(ctx context.Context, msg string, fn func() error, keyVals ...any) bool {
+	delay := l.retryDelay
+	for {
+		err := fn()
+		if err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+
+		l.logger.Error(msg, append(keyVals, "err", err, "retry_in", delay)...)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+
+		delay = min(2*delay, maxRetryDelay)
+	}
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+)
+
+func TestNewListenerFromAppOptions(t *testing.T) {
+	// the indexer is disabled without a connection string
+	listener, err := NewListenerFromAppOptions(simtestutil.AppOptionsMap{DSNTomlKey: " "}, nil, nil, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Nil(t, listener)
+
+	_, err = NewListenerFromAppOptions(simtestutil.AppOptionsMap{DSNTomlKey: "postgres://%"}, nil, nil, log.NewNopLogger())
+	require.ErrorContains(t, err, "failed to start postgres indexer")
+}
+
+type fakeIndexer struct {
+	mu         sync.Mutex
+	lastHeight int64
+	failures   int
+	indexed    []int64
+	closed     bool
+}
+
+func (f *fakeIndexer) LastIndexedHeight(context.Context) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.lastHeight, nil
+}
+
+func (f *fakeIndexer) indexStreamedBlock(_ context.Context, block Block) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("connection refused")
+	}
+	f.indexed = append(f.indexed, block.Height)
+	f.lastHeight = block.Height
+	return nil
+}
+
+func (f *fakeIndexer) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
+
+func (f *fakeIndexer) indexedHeights() []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]int64(nil), f.indexed...)
+}
+
+func commitBlock(t *testing.T, l *Listener, height int64) {
+	t.Helper()
+	require.NoError(t, l.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, l.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestListenerIndexesCommittedBlocks(t *testing.T) {
+	indexer := &fakeIndexer{failures: 2}
+	l := newListener(indexer, nil, log.NewNopLogger(), DefaultQueueSize, time.Millisecond)
+
+	// the failed writes are retried
+	commitBlock(t, l, 1)
+	commitBlock(t, l, 2)
+	require.Eventually(t, func() bool { return len(indexer.indexedHeights()) == 2 }, time.Second, time.Millisecond)
+	require.Equal(t, []int64{1, 2}, indexer.indexedHeights())
+
+	// nothing is queued without a block
+	require.NoError(t, l.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+
+	require.NoError(t, l.Close())
+	require.True(t, indexer.closed)
+}
+
+func TestListenerResumesFromLastIndexedHeight(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		resumed [][2]int64
+		calls   int
+	)
+	resume := func(_ context.Context, fromHeight, toHeight int64) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 1 {
+			return errors.New("node not ready")
+		}
+		resumed = append(resumed, [2]int64{fromHeight, toHeight})
+		return nil
+	}
+
+	// the node restarted at height 10 after indexing height 5
+	indexer := &fakeIndexer{lastHeight: 5}
+	l := newListener(indexer, resume, log.NewNopLogger(), DefaultQueueSize, time.Millisecond)
+
+	commitBlock(t, l, 10)
+	commitBlock(t, l, 11)
+	require.Eventually(t, func() bool { return len(indexer.indexedHeights()) == 2 }, time.Second, time.Millisecond)
+	require.Equal(t, []int64{10, 11}, indexer.indexedHeights())
+
+	mu.Lock()
+	require.Equal(t, [][2]int64{{6, 9}}, resumed)
+	mu.Unlock()
+
+	require.NoError(t, l.Close())
+}
+
+func TestListenerSkipsPrunedBlocks(t *testing.T) {
+	resume := func(context.Context, int64, int64) error {
+		return ErrBlocksPruned
+	}
+
+	indexer := &fakeIndexer{lastHeight: 5}
+	l := newListener(indexer, resume, log.NewNopLogger(), DefaultQueueSize, time.Millisecond)
+
+	commitBlock(t, l, 10)
+	require.Eventually(t, func() bool { return len(indexer.indexedHeights()) == 1 }, time.Second, time.Millisecond)
+
+	require.NoError(t, l.Close())
+}
+
+func TestListenerDropsBlocksWhenQueueIsFull(t *testing.T) {
+	// the worker is stuck retrying the first block
+	indexer := &fakeIndexer{failures: 1}
+	l := newListener(indexer, nil, log.NewNopLogger(), 1, time.Hour)
+
+	commitBlock(t, l, 1)
+	require.Eventually(t, func() bool {
+		indexer.mu.Lock()
+		defer indexer.mu.Unlock()
+		return indexer.failures == 0
+	}, time.Second, time.Millisecond)
+
+	// the next block is queued and the one after it dropped, without blocking
+	commitBlock(t, l, 2)
+	commitBlock(t, l, 3)
+	require.Len(t, l.queue, 1)
+
+	// the queued blocks are left to the next start
+	require.NoError(t, l.Close())
+	require.Empty(t, indexer.indexedHeights())
+}
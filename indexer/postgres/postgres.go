@@ -0,0 +1,261 @@
+// Package postgres implements an indexer writing decoded blocks, transactions,
+// messages, client metadata and events into a normalized PostgreSQL schema.
+//
+// The indexer is enabled by setting streaming.postgres.dsn in app.toml, for apps
+// registering the listener returned by NewListenerFromAppOptions, and historical
+// blocks can be indexed with the backfill command, which reads them from the
+// CometBFT RPC. The listener writes the blocks in the background and resumes
+// from the last block it indexed after a restart or a failure, backfilling the
+// blocks it missed.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	_ "github.com/lib/pq" // register the postgres driver
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// schema creates the indexer tables. Child tables cascade on delete, so a
+// block can be re-indexed by deleting and re-inserting it.
+const schema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height   BIGINT PRIMARY KEY,
+	time     TIMESTAMPTZ NOT NULL,
+	hash     BYTEA,
+	proposer BYTEA,
+	num_txs  INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS txs (
+	height     BIGINT NOT NULL REFERENCES blocks (height) ON DELETE CASCADE,
+	tx_index   INTEGER NOT NULL,
+	hash       BYTEA NOT NULL,
+	code       BIGINT NOT NULL,
+	codespace  TEXT NOT NULL,
+	gas_wanted BIGINT NOT NULL,
+	gas_used   BIGINT NOT NULL,
+	memo       TEXT NOT NULL,
+	log        TEXT NOT NULL,
+	PRIMARY KEY (height, tx_index)
+);
+
+CREATE INDEX IF NOT EXISTS txs_hash_idx ON txs (hash);
+
+CREATE TABLE IF NOT EXISTS messages (
+	height    BIGINT NOT NULL,
+	tx_index  INTEGER NOT NULL,
+	msg_index INTEGER NOT NULL,
+	type_url  TEXT NOT NULL,
+	body      JSONB,
+	PRIMARY KEY (height, tx_index, msg_index),
+	FOREIGN KEY (height, tx_index) REFERENCES txs (height, tx_index) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS messages_type_url_idx ON messages (type_url);
+
+CREATE TABLE IF NOT EXISTS client_metadata (
+	height         BIGINT NOT NULL,
+	tx_index       INTEGER NOT NULL,
+	wallet_name    TEXT NOT NULL,
+	wallet_version TEXT NOT NULL,
+	locale         TEXT NOT NULL,
+	PRIMARY KEY (height, tx_index),
+	FOREIGN KEY (height, tx_index) REFERENCES txs (height, tx_index) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS client_metadata_wallet_idx ON client_metadata (wallet_name, wallet_version);
+
+CREATE TABLE IF NOT EXISTS events (
+	height      BIGINT NOT NULL REFERENCES blocks (height) ON DELETE CASCADE,
+	event_index INTEGER NOT NULL,
+	tx_index    INTEGER,
+	msg_index   INTEGER,
+	type        TEXT NOT NULL,
+	typed       JSONB,
+	PRIMARY KEY (height, event_index)
+);
+
+CREATE INDEX IF NOT EXISTS events_type_idx ON events (type);
+
+CREATE TABLE IF NOT EXISTS event_attributes (
+	height      BIGINT NOT NULL,
+	event_index INTEGER NOT NULL,
+	attr_index  INTEGER NOT NULL,
+	key         TEXT NOT NULL,
+	value       TEXT NOT NULL,
+	PRIMARY KEY (height, event_index, attr_index),
+	FOREIGN KEY (height, event_index) REFERENCES events (height, event_index) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS event_attributes_key_value_idx ON event_attributes (key, value);
+
+CREATE TABLE IF NOT EXISTS indexer_state (
+	id          BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+	last_height BIGINT NOT NULL
+);
+`
+
+// Indexer writes blocks into PostgreSQL.
+type Indexer struct {
+	db        *sql.DB
+	txDecoder sdk.TxDecoder
+	cdc       codec.JSONCodec
+}
+
+// NewIndexer connects to the database with the given DSN and creates the
+// schema if it does not exist yet.
+func NewIndexer(dsn string, txDecoder sdk.TxDecoder, cdc codec.JSONCodec) (*Indexer, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create postgres indexer schema: %w", err)
+	}
+
+	return &Indexer{db: db, txDecoder: txDecoder, cdc: cdc}, nil
+}
+
+// Close closes the database connection.
+func (idx *Indexer) Close() error {
+	return idx.db.Close()
+}
+
+// IndexBlock writes the block in a single database transaction. A block that
+// was already indexed is replaced, so indexing the same height twice is safe.
+func (idx *Indexer) IndexBlock(ctx context.Context, block Block) error {
+	return idx.indexBlock(ctx, block, false)
+}
+
+// LastIndexedHeight returns the height of the last block indexed by the
+// streaming listener, or 0 if it indexed none. The blocks below it were all
+// indexed, by the listener or by a backfill.
+func (idx *Indexer) LastIndexedHeight(ctx context.Context) (int64, error) {
+	var height int64
+	err := idx.db.QueryRowContext(ctx, `SELECT last_height FROM indexer_state`).Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// indexStreamedBlock writes a block streamed by the listener, recording its
+// height as the last indexed height in the same database transaction.
+func (idx *Indexer) indexStreamedBlock(ctx context.Context, block Block) error {
+	return idx.indexBlock(ctx, block, true)
+}
+
+func (idx *Indexer) indexBlock(ctx context.Context, block Block, trackHeight bool) error {
+	r := buildRows(block, idx.txDecoder, idx.cdc)
+
+	dbTx, err := idx.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = dbTx.Rollback() }()
+
+	if err := writeRows(ctx, dbTx, r); err != nil {
+		return fmt.Errorf("failed to index block %d: %w", block.Height, err)
+	}
+
+	if trackHeight {
+		if _, err := dbTx.ExecContext(ctx,
+			`INSERT INTO indexer_state (id, last_height) VALUES (TRUE, $1) ON CONFLICT (id) DO UPDATE SET last_height = EXCLUDED.last_height`,
+			block.Height,
+		); err != nil {
+			return fmt.Errorf("failed to record the last indexed height %d: %w", block.Height, err)
+		}
+	}
+
+	return dbTx.Commit()
+}
+
+func writeRows(ctx context.Context, dbTx *sql.Tx, r rows) error {
+	b := r.block
+	if _, err := dbTx.ExecContext(ctx, `DELETE FROM blocks WHERE height = $1`, b.height); err != nil {
+		return err
+	}
+
+	if _, err := dbTx.ExecContext(ctx,
+		`INSERT INTO blocks (height, time, hash, proposer, num_txs) VALUES ($1, $2, $3, $4, $5)`,
+		b.height, b.time, b.hash, b.proposer, b.numTxs,
+	); err != nil {
+		return err
+	}
+
+	for _, tx := range r.txs {
+		if _, err := dbTx.ExecContext(ctx,
+			`INSERT INTO txs (height, tx_index, hash, code, codespace, gas_wanted, gas_used, memo, log) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			b.height, tx.txIndex, tx.hash, tx.code, tx.codespace, tx.gasWanted, tx.gasUsed, tx.memo, tx.log,
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, msg := range r.messages {
+		if _, err := dbTx.ExecContext(ctx,
+			`INSERT INTO messages (height, tx_index, msg_index, type_url, body) VALUES ($1, $2, $3, $4, $5)`,
+			b.height, msg.txIndex, msg.msgIndex, msg.typeURL, nullJSON(msg.body),
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, metadata := range r.clientMetadata {
+		if _, err := dbTx.ExecContext(ctx,
+			`INSERT INTO client_metadata (height, tx_index, wallet_name, wallet_version, locale) VALUES ($1, $2, $3, $4, $5)`,
+			b.height, metadata.txIndex, metadata.walletName, metadata.walletVersion, metadata.locale,
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, event := range r.events {
+		if _, err := dbTx.ExecContext(ctx,
+			`INSERT INTO events (height, event_index, tx_index, msg_index, type, typed) VALUES ($1, $2, $3, $4, $5, $6)`,
+			b.height, event.eventIndex, nullInt(event.txIndex), nullInt(event.msgIndex), event.typ, nullJSON(event.typed),
+		); err != nil {
+			return err
+		}
+
+		for i, attr := range event.attributes {
+			if _, err := dbTx.ExecContext(ctx,
+				`INSERT INTO event_attributes (height, event_index, attr_index, key, value) VALUES ($1, $2, $3, $4, $5)`,
+				b.height, event.eventIndex, i, attr.Key, attr.Value,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func nullInt(i *int) sql.NullInt64 {
+	if i == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*i), Valid: true}
+}
+
+// nullJSON returns JSON bodies as strings, the postgres driver would otherwise
+// send []byte as bytea.
+func nullJSON(bz []byte) sql.NullString {
+	if len(bz) == 0 {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(bz), Valid: true}
+}
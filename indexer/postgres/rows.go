@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// Block is the data of a single block to index. It is filled either from the
+// FinalizeBlock request and response when streaming, or from the CometBFT RPC
+// when backfilling.
+type Block struct {
+	Height    int64
+	Time      time.Time
+	Hash      []byte
+	Proposer  []byte
+	Txs       [][]byte
+	TxResults []*abci.ExecTxResult
+	Events    []abci.Event
+}
+
+type blockRow struct {
+	height   int64
+	time     time.Time
+	hash     []byte
+	proposer []byte
+	numTxs   int
+}
+
+type txRow struct {
+	txIndex   int
+	hash      []byte
+	code      uint32
+	codespace string
+	gasWanted int64
+	gasUsed   int64
+	memo      string
+	log       string
+}
+
+type messageRow struct {
+	txIndex  int
+	msgIndex int
+	typeURL  string
+	body     []byte
+}
+
+type clientMetadataRow struct {
+	txIndex       int
+	walletName    string
+	walletVersion string
+	locale        string
+}
+
+type eventRow struct {
+	eventIndex int
+	txIndex    *int
+	msgIndex   *int
+	typ        string
+	typed      []byte
+	attributes []abci.EventAttribute
+}
+
+// rows is the normalized representation of a block, one field per table.
+type rows struct {
+	block          blockRow
+	txs            []txRow
+	messages       []messageRow
+	clientMetadata []clientMetadataRow
+	events         []eventRow
+}
+
+// nonCriticalExtensionOptionsTx is implemented by protobuf transactions.
+type nonCriticalExtensionOptionsTx interface {
+	GetNonCriticalExtensionOptions() []*codectypes.Any
+}
+
+// buildRows decodes the block's transactions and events into table rows.
+// Transactions that fail to decode are still indexed, without messages. The
+// ClientMetadata extension option of transactions is indexed separately, so
+// that the adoption of wallets can be measured.
+func buildRows(block Block, txDecoder sdk.TxDecoder, cdc codec.JSONCodec) rows {
+	r := rows{
+		block: blockRow{
+			height:   block.Height,
+			time:     block.Time,
+			hash:     block.Hash,
+			proposer: block.Proposer,
+			numTxs:   len(block.Txs),
+		},
+	}
+
+	// block level events (begin and end block) come first, followed by the
+	// events of each transaction, so event indexes follow execution order
+	eventIndex := 0
+	for _, event := range block.Events {
+		r.events = append(r.events, newEventRow(eventIndex, nil, event, cdc))
+		eventIndex++
+	}
+
+	for i, txBytes := range block.Txs {
+		txIndex := i
+		hash := sha256.Sum256(txBytes)
+		row := txRow{txIndex: txIndex, hash: hash[:]}
+
+		if i < len(block.TxResults) && block.TxResults[i] != nil {
+			res := block.TxResults[i]
+			row.code = res.Code
+			row.codespace = res.Codespace
+			row.gasWanted = res.GasWanted
+			row.gasUsed = res.GasUsed
+			row.log = res.Log
+
+			for _, event := range res.Events {
+				r.events = append(r.events, newEventRow(eventIndex, &txIndex, event, cdc))
+				eventIndex++
+			}
+		}
+
+		if tx, err := txDecoder(txBytes); err == nil {
+			if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+				row.memo = memoTx.GetMemo()
+			}
+
+			for msgIndex, msg := range tx.GetMsgs() {
+				body, err := cdc.MarshalJSON(msg)
+				if err != nil {
+					body = nil
+				}
+				r.messages = append(r.messages, messageRow{
+					txIndex:  txIndex,
+					msgIndex: msgIndex,
+					typeURL:  sdk.MsgTypeURL(msg),
+					body:     body,
+				})
+			}
+
+			if extTx, ok := tx.(nonCriticalExtensionOptionsTx); ok {
+				if metadata := txtypes.GetClientMetadata(extTx.GetNonCriticalExtensionOptions()); metadata != nil {
+					r.clientMetadata = append(r.clientMetadata, clientMetadataRow{
+						txIndex:       txIndex,
+						walletName:    metadata.WalletName,
+						walletVersion: metadata.WalletVersion,
+						locale:        metadata.Locale,
+					})
+				}
+			}
+		}
+
+		r.txs = append(r.txs, row)
+	}
+
+	return r
+}
+
+func newEventRow(eventIndex int, txIndex *int, event abci.Event, cdc codec.JSONCodec) eventRow {
+	row := eventRow{
+		eventIndex: eventIndex,
+		txIndex:    txIndex,
+		typ:        event.Type,
+		attributes: event.Attributes,
+	}
+
+	for _, attr := range event.Attributes {
+		if attr.Key != "msg_index" {
+			continue
+		}
+		if msgIndex, err := strconv.Atoi(attr.Value); err == nil {
+			row.msgIndex = &msgIndex
+		}
+	}
+
+	// typed events are named after their proto message, decode them so they
+	// can be queried as JSON
+	if strings.Contains(event.Type, ".") {
+		if msg, err := sdk.ParseTypedEvent(event); err == nil {
+			if typed, err := cdc.MarshalJSON(msg); err == nil {
+				row.typed = typed
+			}
+		}
+	}
+
+	return row
+}
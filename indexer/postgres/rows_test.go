@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+type memoTx struct {
+	msgs   []sdk.Msg
+	memo   string
+	extOpt *codectypes.Any
+}
+
+func (tx memoTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx memoTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx memoTx) GetMemo() string                       { return tx.memo }
+
+func (tx memoTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	if tx.extOpt == nil {
+		return nil
+	}
+	return []*codectypes.Any{tx.extOpt}
+}
+
+func TestBuildRows(t *testing.T) {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	testdata.RegisterInterfaces(cdc.InterfaceRegistry())
+
+	msg := testdata.NewTestMsg(sdk.AccAddress("addr1"))
+	clientMetadata, err := txtypes.NewClientMetadataOption("wallet", "v1.2.0", "en-US")
+	require.NoError(t, err)
+	txDecoder := func(txBytes []byte) (sdk.Tx, error) {
+		switch string(txBytes) {
+		case "bad":
+			return nil, errors.New("decode error")
+		case "metadata":
+			return memoTx{msgs: []sdk.Msg{msg}, extOpt: clientMetadata}, nil
+		}
+		return memoTx{msgs: []sdk.Msg{msg}, memo: "hello"}, nil
+	}
+
+	typedEvent, err := sdk.TypedEventToEvent(msg)
+	require.NoError(t, err)
+	typedEvent.Attributes = append(typedEvent.Attributes, abci.EventAttribute{Key: "msg_index", Value: "0"})
+
+	now := time.Now().UTC()
+	block := Block{
+		Height:   10,
+		Time:     now,
+		Hash:     []byte("hash"),
+		Proposer: []byte("proposer"),
+		Txs:      [][]byte{[]byte("good"), []byte("bad"), []byte("metadata")},
+		TxResults: []*abci.ExecTxResult{
+			{Code: 0, GasWanted: 200, GasUsed: 100, Events: []abci.Event{
+				{Type: "tx", Attributes: []abci.EventAttribute{{Key: "fee", Value: "1stake"}}},
+				abci.Event(typedEvent),
+			}},
+			{Code: 5, Codespace: "sdk", Log: "failed"},
+		},
+		Events: []abci.Event{{Type: "mint"}},
+	}
+
+	r := buildRows(block, txDecoder, cdc)
+
+	require.Equal(t, blockRow{height: 10, time: now, hash: []byte("hash"), proposer: []byte("proposer"), numTxs: 3}, r.block)
+
+	require.Len(t, r.txs, 3)
+	hash := sha256.Sum256([]byte("good"))
+	require.Equal(t, hash[:], r.txs[0].hash)
+	require.Equal(t, "hello", r.txs[0].memo)
+	require.Equal(t, int64(100), r.txs[0].gasUsed)
+	require.Equal(t, uint32(5), r.txs[1].code)
+	require.Equal(t, "sdk", r.txs[1].codespace)
+	require.Empty(t, r.txs[1].memo)
+
+	// the undecodable transaction has no messages
+	require.Len(t, r.messages, 2)
+	require.Equal(t, 0, r.messages[0].txIndex)
+	require.Equal(t, sdk.MsgTypeURL(msg), r.messages[0].typeURL)
+	require.NotEmpty(t, r.messages[0].body)
+	require.Equal(t, 2, r.messages[1].txIndex)
+
+	require.Equal(t, []clientMetadataRow{{txIndex: 2, walletName: "wallet", walletVersion: "v1.2.0", locale: "en-US"}}, r.clientMetadata)
+
+	require.Len(t, r.events, 3)
+	require.Equal(t, "mint", r.events[0].typ)
+	require.Nil(t, r.events[0].txIndex)
+	require.Equal(t, 1, r.events[1].eventIndex)
+	require.Equal(t, 0, *r.events[1].txIndex)
+	require.Nil(t, r.events[1].msgIndex)
+	require.Nil(t, r.events[1].typed)
+	require.Equal(t, 0, *r.events[2].msgIndex)
+	require.NotEmpty(t, r.events[2].typed)
+}
@@ -11,7 +11,7 @@ import (
 func (a *App) registerRuntimeServices(cfg module.Configurator) error { // nolint:staticcheck // SA1019: Configurator is deprecated but still used in runtime v1.
 	autocliv1.RegisterQueryServer(cfg.QueryServer(), services.NewAutoCLIQueryService(a.ModuleManager.Modules))
 
-	reflectionSvc, err := services.NewReflectionService()
+	reflectionSvc, err := services.NewReflectionService(a.MsgServiceRouter())
 	if err != nil {
 		return err
 	}
@@ -2,26 +2,48 @@ package services
 
 import (
 	"context"
+	"strings"
 
+	cosmos_proto "github.com/cosmos/cosmos-proto"
 	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
 
+	"cosmossdk.io/api/amino"
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 )
 
+// MsgRouter reports how the messages of the app are routed, as implemented by
+// the baseapp MsgServiceRouter.
+type MsgRouter interface {
+	// ResponseNameByMsgName returns the name of the response of the Msg service
+	// method handling the message, or an empty string if no method handles it.
+	ResponseNameByMsgName(msgName string) string
+	// IsAllowed returns whether the circuit breaker allows the execution of the
+	// messages of the given type URL.
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
+
 // ReflectionService implements the cosmos.reflection.v1 service.
 type ReflectionService struct {
 	reflectionv1.UnimplementedReflectionServiceServer
-	files *descriptorpb.FileDescriptorSet
+	files     *descriptorpb.FileDescriptorSet
+	msgRouter MsgRouter
 }
 
-func NewReflectionService() (*ReflectionService, error) {
+// NewReflectionService returns the reflection service. The message router may
+// be nil, in which case no message is reported as routable or disabled.
+func NewReflectionService(msgRouter MsgRouter) (*ReflectionService, error) {
 	fds, err := proto.MergedGlobalFileDescriptors()
 	if err != nil {
 		return nil, err
 	}
 
-	return &ReflectionService{files: fds}, nil
+	return &ReflectionService{files: fds, msgRouter: msgRouter}, nil
 }
 
 func (r ReflectionService) FileDescriptors(_ context.Context, _ *reflectionv1.FileDescriptorsRequest) (*reflectionv1.FileDescriptorsResponse, error) {
@@ -30,4 +52,66 @@ func (r ReflectionService) FileDescriptors(_ context.Context, _ *reflectionv1.Fi
 	}, nil
 }
 
+func (r ReflectionService) MsgDescriptor(ctx context.Context, req *reflectionv1.MsgDescriptorRequest) (*reflectionv1.MsgDescriptorResponse, error) {
+	if req == nil || req.TypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty type url")
+	}
+
+	// type URLs are the full name of the message prefixed by a slash, possibly
+	// preceded by a host.
+	fullName := protoreflect.FullName(req.TypeUrl[strings.LastIndex(req.TypeUrl, "/")+1:])
+	desc, err := proto.HybridResolver.FindDescriptorByName(fullName)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "message %s not found", fullName)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a message", fullName)
+	}
+
+	res := &reflectionv1.MsgDescriptorResponse{
+		FullName:     string(fullName),
+		AminoName:    protov2.GetExtension(msgDesc.Options(), amino.E_Name).(string),
+		SignerFields: protov2.GetExtension(msgDesc.Options(), msgv1.E_Signer).([]string),
+	}
+
+	fields := msgDesc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		res.Fields = append(res.Fields, fieldDescriptor(fields.Get(i)))
+	}
+
+	if r.msgRouter != nil {
+		res.Routable = r.msgRouter.ResponseNameByMsgName(string(fullName)) != ""
+
+		allowed, err := r.msgRouter.IsAllowed(ctx, "/"+string(fullName))
+		if err != nil {
+			return nil, err
+		}
+		res.Disabled = !allowed
+	}
+
+	return res, nil
+}
+
+// fieldDescriptor returns the description of a message field.
+func fieldDescriptor(fd protoreflect.FieldDescriptor) *reflectionv1.FieldDescriptor {
+	var typeName protoreflect.FullName
+	switch {
+	case fd.Message() != nil:
+		typeName = fd.Message().FullName()
+	case fd.Enum() != nil:
+		typeName = fd.Enum().FullName()
+	}
+
+	return &reflectionv1.FieldDescriptor{
+		Name:     string(fd.Name()),
+		JsonName: fd.JSONName(),
+		Number:   int32(fd.Number()),
+		Kind:     fd.Kind().String(),
+		TypeName: string(typeName),
+		Repeated: fd.IsList() || fd.IsMap(),
+		Scalar:   protov2.GetExtension(fd.Options(), cosmos_proto.E_Scalar).(string),
+	}
+}
+
 var _ reflectionv1.ReflectionServiceServer = &ReflectionService{}
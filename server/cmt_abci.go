@@ -10,6 +10,12 @@ import (
 
 type cometABCIWrapper struct {
 	app servertypes.ABCI
+
+	// blocks tracks the block in flight, it is nil when not tracked.
+	blocks *blockTracker
+
+	// profiler profiles the slow blocks, it is nil when they are not profiled.
+	profiler *slowBlockProfiler
 }
 
 func NewCometABCIWrapper(app servertypes.ABCI) abci.Application {
@@ -41,6 +47,8 @@ func (w cometABCIWrapper) ProcessProposal(_ context.Context, req *abci.RequestPr
 }
 
 func (w cometABCIWrapper) FinalizeBlock(_ context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	w.blocks.begin()
+	w.profiler.begin(req.Height)
 	return w.app.FinalizeBlock(req)
 }
 
@@ -53,6 +61,8 @@ func (w cometABCIWrapper) VerifyVoteExtension(_ context.Context, req *abci.Reque
 }
 
 func (w cometABCIWrapper) Commit(_ context.Context, _ *abci.RequestCommit) (*abci.ResponseCommit, error) {
+	defer w.blocks.end()
+	defer w.profiler.end()
 	return w.app.Commit()
 }
 
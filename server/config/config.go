@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -42,6 +43,10 @@ type BaseConfig struct {
 	// If set to 0, it is unbounded.
 	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
 
+	// StoreStatsQuery enables the "/app/store_stats" ABCI query, which walks
+	// the whole state of the app.
+	StoreStatsQuery bool `mapstructure:"store-stats-query"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
@@ -156,6 +161,14 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotWriteRate sets the maximum number of bytes per second written while
+	// creating a snapshot. 0 disables the limit.
+	SnapshotWriteRate uint64 `mapstructure:"snapshot-write-rate"`
+
+	// ChunkServeRate sets the maximum number of snapshot chunk bytes per second
+	// served to state syncing peers. 0 disables the limit.
+	ChunkServeRate uint64 `mapstructure:"chunk-serve-rate"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -168,11 +181,51 @@ type MempoolConfig struct {
 	MaxTxs int `mapstructure:"max-txs"`
 }
 
+// SignGuardConfig defines the configuration of the sign guard, which records
+// the last sign watermark of the validator outside of the CometBFT priv
+// validator state file and refuses to start the node if it is behind.
+type SignGuardConfig struct {
+	// Enable defines if the sign guard should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// SharedStatePath is the path of a file, on a storage shared by the nodes
+	// of a failover setup, in which the watermark is also recorded. An empty
+	// path only records the watermark in the sign_guard database of the data
+	// directory.
+	SharedStatePath string `mapstructure:"shared-state-path"`
+
+	// SyncInterval defines how often the watermark is recorded while the node
+	// is running.
+	SyncInterval time.Duration `mapstructure:"sync-interval"`
+}
+
+// DiagnosticsConfig defines the configuration of the diagnostics captured
+// automatically by the node.
+type DiagnosticsConfig struct {
+	// SlowBlockThreshold is the block execution duration, from FinalizeBlock to
+	// Commit, past which CPU and heap profiles of the block are captured. 0
+	// disables the capture.
+	SlowBlockThreshold time.Duration `mapstructure:"slow-block-threshold"`
+
+	// ProfileDir is the directory the profiles are written to, relative to the
+	// node home directory if not absolute.
+	ProfileDir string `mapstructure:"profile-dir"`
+
+	// MaxProfiledBlocks is the number of slow blocks whose profiles are kept,
+	// the profiles of older blocks are deleted.
+	MaxProfiledBlocks uint32 `mapstructure:"max-profiled-blocks"`
+
+	// ProfileAddress is the address of the HTTP server serving the profiles.
+	// Empty disables the server.
+	ProfileAddress string `mapstructure:"profile-address"`
+}
+
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		ABCI     ABCIListenerConfig    `mapstructure:"abci"`
+		Postgres PostgresIndexerConfig `mapstructure:"postgres"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -180,6 +233,10 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// PostgresIndexerConfig defines application configuration for the built-in PostgreSQL indexer
+	PostgresIndexerConfig struct {
+		DSN string `mapstructure:"dsn"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -194,6 +251,9 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	SignGuard SignGuardConfig  `mapstructure:"sign-guard"`
+
+	Diagnostics DiagnosticsConfig `mapstructure:"diagnostics"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -265,6 +325,15 @@ func DefaultConfig() *Config {
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
 		},
+		SignGuard: SignGuardConfig{
+			Enable:       false,
+			SyncInterval: time.Second,
+		},
+		Diagnostics: DiagnosticsConfig{
+			SlowBlockThreshold: 0,
+			ProfileDir:         "data/slow-block-profiles",
+			MaxProfiledBlocks:  10,
+		},
 	}
 }
 
@@ -287,6 +356,15 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.SignGuard.Enable && c.SignGuard.SyncInterval <= 0 {
+		return sdkerrors.ErrAppConfig.Wrap("sign guard sync interval must be positive")
+	}
+	if c.Diagnostics.SlowBlockThreshold < 0 {
+		return sdkerrors.ErrAppConfig.Wrap("slow block threshold cannot be negative")
+	}
+	if c.Diagnostics.SlowBlockThreshold > 0 && c.Diagnostics.MaxProfiledBlocks == 0 {
+		return sdkerrors.ErrAppConfig.Wrap("max profiled blocks must be positive when slow blocks are profiled")
+	}
 
 	return nil
 }
@@ -0,0 +1,257 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	// KeyCleanShutdownHeight is the app option set at startup to the height at
+	// which the node last shut down cleanly. It is not set if the node crashed
+	// or was killed, in which case DefaultBaseappOptions removes the snapshots
+	// the previous run left incomplete.
+	KeyCleanShutdownHeight = "clean-shutdown-height"
+
+	// shutdownMarkerFile is the file, in the data directory, written once the
+	// node shut down cleanly and consumed at the next start.
+	shutdownMarkerFile = "shutdown_marker.json"
+)
+
+// ShutdownMarker records the state of a node at its last clean shutdown.
+type ShutdownMarker struct {
+	// Height is the height of the last block committed before shutting down.
+	Height int64 `json:"height"`
+	// Time is the time at which the node shut down.
+	Time time.Time `json:"time"`
+}
+
+func shutdownMarkerPath(home string) string {
+	return filepath.Join(home, "data", shutdownMarkerFile)
+}
+
+// writeShutdownMarker writes the shutdown marker to the data directory.
+func writeShutdownMarker(home string, height int64) error {
+	bz, err := json.Marshal(ShutdownMarker{Height: height, Time: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(shutdownMarkerPath(home), bz, 0o600)
+}
+
+// consumeShutdownMarker reads and removes the shutdown marker from the data
+// directory. It returns nil if the previous run did not shut down cleanly.
+func consumeShutdownMarker(home string) (*ShutdownMarker, error) {
+	path := shutdownMarkerPath(home)
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// the marker only describes the run that wrote it, remove it before
+	// anything else so that a crash of this run is not mistaken for a clean shutdown
+	if err := os.Remove(path); err != nil {
+		return nil, err
+	}
+
+	var marker ShutdownMarker
+	if err := json.Unmarshal(bz, &marker); err != nil {
+		return nil, fmt.Errorf("invalid shutdown marker %s: %w", path, err)
+	}
+
+	return &marker, nil
+}
+
+// blockTracker tracks the block being executed by the application, from
+// FinalizeBlock until the matching Commit, so that shutdown can wait for it
+// to be committed before closing the application.
+type blockTracker struct {
+	mtx  sync.Mutex
+	done chan struct{} // nil when no block is in flight
+}
+
+// begin marks a block as in flight. Calling begin again before end, e.g.
+// when FinalizeBlock is retried, keeps the same block in flight.
+func (t *blockTracker) begin() {
+	if t == nil {
+		return
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.done == nil {
+		t.done = make(chan struct{})
+	}
+}
+
+// end marks the block in flight, if any, as committed.
+func (t *blockTracker) end() {
+	if t == nil {
+		return
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.done != nil {
+		close(t.done)
+		t.done = nil
+	}
+}
+
+// wait blocks until no block is in flight or the timeout elapses, a
+// non-positive timeout waits forever. It returns false on timeout.
+func (t *blockTracker) wait(timeout time.Duration) bool {
+	if t == nil {
+		return true
+	}
+
+	t.mtx.Lock()
+	done := t.done
+	t.mtx.Unlock()
+	if done == nil {
+		return true
+	}
+
+	if timeout <= 0 {
+		<-done
+		return true
+	}
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// incompleteSnapshots returns the directories of the snapshots found in
+// snapshotDir which are not among the saved snapshots, i.e. the snapshots being
+// created or interrupted while they were created.
+func incompleteSnapshots(snapshotDir string, saved []*snapshottypes.Snapshot) ([]string, error) {
+	complete := make(map[string]bool, len(saved))
+	for _, snapshot := range saved {
+		complete[filepath.Join(strconv.FormatUint(snapshot.Height, 10), strconv.FormatUint(uint64(snapshot.Format), 10))] = true
+	}
+
+	heights, err := os.ReadDir(snapshotDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var dirs []string
+	for _, height := range heights {
+		// skip the metadata database
+		if _, err := strconv.ParseUint(height.Name(), 10, 64); err != nil || !height.IsDir() {
+			continue
+		}
+
+		formats, err := os.ReadDir(filepath.Join(snapshotDir, height.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, format := range formats {
+			if _, err := strconv.ParseUint(format.Name(), 10, 32); err != nil || !format.IsDir() {
+				continue
+			}
+			if name := filepath.Join(height.Name(), format.Name()); !complete[name] {
+				dirs = append(dirs, filepath.Join(snapshotDir, name))
+			}
+		}
+	}
+
+	return dirs, nil
+}
+
+// removeIncompleteSnapshots removes the chunks of the snapshots of the store
+// which were interrupted before their metadata was saved, as when the node
+// crashed while taking a snapshot. It returns the number of snapshots removed.
+// The store must not be creating snapshots.
+func removeIncompleteSnapshots(store *snapshots.Store, snapshotDir string) (int, error) {
+	saved, err := store.List()
+	if err != nil {
+		return 0, err
+	}
+
+	dirs, err := incompleteSnapshots(snapshotDir, saved)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			return 0, err
+		}
+
+		// remove the height directory once it holds no other format
+		if entries, err := os.ReadDir(filepath.Dir(dir)); err == nil && len(entries) == 0 {
+			if err := os.Remove(filepath.Dir(dir)); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return len(dirs), nil
+}
+
+// waitForSnapshots blocks until the snapshot manager is no longer creating a
+// snapshot, or until the deadline, a zero deadline waiting forever. It returns
+// false if the deadline elapsed first.
+func waitForSnapshots(manager *snapshots.Manager, snapshotDir string, height int64, deadline time.Time) bool {
+	if manager == nil {
+		return true
+	}
+
+	for {
+		// retaining the MaxUint32 most recent snapshots prunes none of them, it
+		// only fails with a conflict while another operation is in progress
+		_, err := manager.Prune(math.MaxUint32)
+		if !errors.Is(err, storetypes.ErrConflict) || !creatingSnapshot(manager, snapshotDir, height) {
+			return true
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// creatingSnapshot returns true if a snapshot is being created, telling it
+// apart from a state sync restore, which is never completed once CometBFT
+// stopped. The snapshot of the last committed height is created in the
+// background and may not have written its first chunk yet.
+func creatingSnapshot(manager *snapshots.Manager, snapshotDir string, height int64) bool {
+	saved, err := manager.List()
+	if err != nil {
+		return false
+	}
+
+	interval := manager.GetInterval()
+	pending := interval > 0 && height > 0 && uint64(height)%interval == 0
+	for _, snapshot := range saved {
+		if snapshot.Height >= uint64(height) {
+			pending = false
+		}
+	}
+
+	dirs, err := incompleteSnapshots(snapshotDir, saved)
+	return pending || (err == nil && len(dirs) > 0)
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/snapshots"
+)
+
+func TestShutdownMarker(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+
+	// no marker after a crash
+	marker, err := consumeShutdownMarker(home)
+	require.NoError(t, err)
+	require.Nil(t, marker)
+
+	require.NoError(t, writeShutdownMarker(home, 42))
+
+	marker, err = consumeShutdownMarker(home)
+	require.NoError(t, err)
+	require.NotNil(t, marker)
+	require.Equal(t, int64(42), marker.Height)
+
+	// the marker is consumed
+	marker, err = consumeShutdownMarker(home)
+	require.NoError(t, err)
+	require.Nil(t, marker)
+
+	// an invalid marker is consumed as well
+	require.NoError(t, os.WriteFile(shutdownMarkerPath(home), []byte("invalid"), 0o600))
+	_, err = consumeShutdownMarker(home)
+	require.Error(t, err)
+	require.NoFileExists(t, shutdownMarkerPath(home))
+}
+
+func TestBlockTracker(t *testing.T) {
+	var nilTracker *blockTracker
+	nilTracker.begin()
+	nilTracker.end()
+	require.True(t, nilTracker.wait(time.Millisecond))
+
+	blocks := &blockTracker{}
+	require.True(t, blocks.wait(time.Millisecond))
+
+	blocks.begin()
+	blocks.begin()
+	require.False(t, blocks.wait(time.Millisecond))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		blocks.end()
+	}()
+	require.True(t, blocks.wait(0))
+	require.True(t, blocks.wait(time.Millisecond))
+
+	// end without a block in flight is a no-op
+	blocks.end()
+	require.True(t, blocks.wait(time.Millisecond))
+}
+
+func TestRemoveIncompleteSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	store, err := snapshots.NewStore(dbm.NewMemDB(), dir)
+	require.NoError(t, err)
+
+	chunks := make(chan io.ReadCloser, 1)
+	chunks <- io.NopCloser(bytes.NewReader([]byte{1, 1, 0}))
+	close(chunks)
+	_, err = store.Save(1, 1, chunks)
+	require.NoError(t, err)
+
+	// snapshots interrupted before their metadata was saved
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "1", "2"), 0o750))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "2", "1"), 0o750))
+	require.NoError(t, os.WriteFile(store.PathChunk(2, 1, 0), []byte{2, 1, 0}, 0o600))
+
+	removed, err := removeIncompleteSnapshots(store, dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+	require.DirExists(t, filepath.Join(dir, "1", "1"))
+	require.NoDirExists(t, filepath.Join(dir, "1", "2"))
+	require.NoDirExists(t, filepath.Join(dir, "2"))
+
+	snapshot, err := store.Get(1, 1)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+
+	// nothing to remove afterwards
+	removed, err = removeIncompleteSnapshots(store, dir)
+	require.NoError(t, err)
+	require.Zero(t, removed)
+
+	// no snapshot manager, nothing to wait for
+	require.True(t, waitForSnapshots(nil, dir, 10, time.Now()))
+}
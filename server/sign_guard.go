@@ -0,0 +1,237 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// signGuardDBName is the name of the database, in the data directory, in which
+// the sign guard records the watermark. It is kept out of the application
+// database, which is only written by the application.
+const signGuardDBName = "sign_guard"
+
+// signGuardKey is the key of the last sign watermark seen by the sign guard.
+var signGuardKey = []byte("watermark")
+
+// SignWatermark is the height and round of the last vote or proposal signed by
+// a validator. It is encoded like the CometBFT priv validator state file, which
+// it can be read from.
+type SignWatermark struct {
+	Height int64 `json:"height"`
+	Round  int32 `json:"round"`
+}
+
+// After returns true if w was signed after o.
+func (w SignWatermark) After(o SignWatermark) bool {
+	return w.Height > o.Height || (w.Height == o.Height && w.Round > o.Round)
+}
+
+func (w SignWatermark) String() string {
+	return fmt.Sprintf("%d/%d", w.Height, w.Round)
+}
+
+// signGuard is a second layer against double signing, on top of the CometBFT
+// priv validator state file. It records the last sign watermark of the
+// validator in its own database and, optionally, in a file shared by the nodes
+// of a failover setup, and refuses to start the node if any of them is ahead of
+// the priv validator state file.
+type signGuard struct {
+	cfg         serverconfig.SignGuardConfig
+	pvStateFile string
+	db          dbm.DB
+	logger      log.Logger
+
+	last SignWatermark
+}
+
+// openSignGuard opens the database of the sign guard in the data directory of
+// the node. The sign guard must be closed once the node stopped.
+func openSignGuard(cfg serverconfig.SignGuardConfig, cmtCfg *cmtcfg.Config, backend dbm.BackendType, logger log.Logger) (*signGuard, error) {
+	db, err := dbm.NewDB(signGuardDBName, backend, filepath.Join(cmtCfg.RootDir, "data"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the sign guard database: %w", err)
+	}
+
+	return newSignGuard(cfg, cmtCfg.PrivValidatorStateFile(), db, logger), nil
+}
+
+func newSignGuard(cfg serverconfig.SignGuardConfig, pvStateFile string, db dbm.DB, logger log.Logger) *signGuard {
+	return &signGuard{
+		cfg:         cfg,
+		pvStateFile: pvStateFile,
+		db:          db,
+		logger:      logger.With("module", "sign-guard"),
+	}
+}
+
+// close closes the database of the sign guard.
+func (g *signGuard) close() error {
+	return g.db.Close()
+}
+
+// check returns an error if the sign guard database or the shared state
+// record a watermark ahead of the priv validator state file, which means the
+// state file was restored from a backup or another node is signing for the
+// validator. Otherwise it records the watermark of the state file.
+func (g *signGuard) check() error {
+	local, err := readSignWatermarkFile(g.pvStateFile)
+	if err != nil {
+		return err
+	}
+
+	stored, err := g.storedWatermark()
+	if err != nil {
+		return err
+	}
+	if stored.After(local) {
+		return fmt.Errorf("refusing to start: the priv validator state %s is behind the sign state %s recorded in the sign guard database, it may have been restored from a backup", local, stored)
+	}
+
+	shared, err := g.sharedWatermark()
+	if err != nil {
+		return err
+	}
+	if shared.After(local) {
+		return fmt.Errorf("refusing to start: the priv validator state %s is behind the shared sign state %s, another node may be signing for this validator", local, shared)
+	}
+
+	g.logger.Info("sign state checked", "height", local.Height, "round", local.Round)
+	return g.record(local)
+}
+
+// run records the watermark of the priv validator state file every sync
+// interval until the context is canceled. It returns an error, stopping the
+// node, if the shared state gets ahead of it.
+func (g *signGuard) run(ctx context.Context) error {
+	ticker := time.NewTicker(g.cfg.SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		local, err := readSignWatermarkFile(g.pvStateFile)
+		if err != nil {
+			g.logger.Error("failed to read priv validator state", "err", err)
+			continue
+		}
+
+		shared, err := g.sharedWatermark()
+		if err != nil {
+			g.logger.Error("failed to read shared sign state", "err", err)
+			continue
+		}
+		if shared.After(local) {
+			return fmt.Errorf("stopping: the shared sign state %s is ahead of the priv validator state %s, another node is signing for this validator", shared, local)
+		}
+
+		if local.After(g.last) {
+			if err := g.record(local); err != nil {
+				g.logger.Error("failed to record sign state", "err", err)
+			}
+		}
+	}
+}
+
+// record writes the watermark to the sign guard database and to the shared
+// state, if configured.
+func (g *signGuard) record(w SignWatermark) error {
+	bz, err := cmtjson.Marshal(w)
+	if err != nil {
+		return err
+	}
+
+	if err := g.db.SetSync(signGuardKey, bz); err != nil {
+		return err
+	}
+
+	if g.cfg.SharedStatePath != "" {
+		if err := writeFileAtomic(g.cfg.SharedStatePath, bz); err != nil {
+			return err
+		}
+	}
+
+	g.last = w
+	return nil
+}
+
+func (g *signGuard) storedWatermark() (SignWatermark, error) {
+	bz, err := g.db.Get(signGuardKey)
+	if err != nil || bz == nil {
+		return SignWatermark{}, err
+	}
+
+	var w SignWatermark
+	if err := cmtjson.Unmarshal(bz, &w); err != nil {
+		return w, fmt.Errorf("invalid sign state in the sign guard database: %w", err)
+	}
+
+	return w, nil
+}
+
+func (g *signGuard) sharedWatermark() (SignWatermark, error) {
+	if g.cfg.SharedStatePath == "" {
+		return SignWatermark{}, nil
+	}
+
+	return readSignWatermarkFile(g.cfg.SharedStatePath)
+}
+
+// readSignWatermarkFile reads a watermark from a file encoded like the CometBFT
+// priv validator state file. A missing file holds the zero watermark.
+func readSignWatermarkFile(path string) (SignWatermark, error) {
+	var w SignWatermark
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return w, nil
+		}
+		return w, err
+	}
+
+	if err := cmtjson.Unmarshal(bz, &w); err != nil {
+		return w, fmt.Errorf("invalid sign state %s: %w", path, err)
+	}
+
+	return w, nil
+}
+
+// writeFileAtomic writes the file through a temporary file renamed over it, so
+// that readers never see a partial write.
+func writeFileAtomic(path string, bz []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(bz); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func writePVState(t *testing.T, path string, height int64, round int32) {
+	t.Helper()
+	// same encoding as the CometBFT priv validator state file
+	bz := fmt.Sprintf(`{"height": "%d", "round": %d, "step": 3, "signature": "c2ln", "signbytes": "AB"}`, height, round)
+	require.NoError(t, os.WriteFile(path, []byte(bz), 0o600))
+}
+
+func TestSignGuard(t *testing.T) {
+	dir := t.TempDir()
+	pvStateFile := filepath.Join(dir, "priv_validator_state.json")
+	sharedPath := filepath.Join(dir, "shared_sign_state.json")
+	db := dbm.NewMemDB()
+	cfg := serverconfig.SignGuardConfig{Enable: true, SharedStatePath: sharedPath, SyncInterval: 10 * time.Millisecond}
+
+	// a fresh node has no sign state at all
+	guard := newSignGuard(cfg, pvStateFile, db, log.NewNopLogger())
+	require.NoError(t, guard.check())
+
+	writePVState(t, pvStateFile, 10, 1)
+	require.NoError(t, guard.check())
+
+	stored, err := guard.storedWatermark()
+	require.NoError(t, err)
+	require.Equal(t, SignWatermark{Height: 10, Round: 1}, stored)
+	shared, err := guard.sharedWatermark()
+	require.NoError(t, err)
+	require.Equal(t, SignWatermark{Height: 10, Round: 1}, shared)
+
+	// the priv validator state was restored from a backup
+	writePVState(t, pvStateFile, 10, 0)
+	require.ErrorContains(t, guard.check(), "sign guard database")
+
+	// another node signed a later height
+	writePVState(t, pvStateFile, 10, 1)
+	require.NoError(t, writeFileAtomic(sharedPath, []byte(`{"height":"11","round":0}`)))
+	require.ErrorContains(t, guard.check(), "another node may be signing")
+
+	// without a shared state only the sign guard database is checked
+	cfg.SharedStatePath = ""
+	require.NoError(t, newSignGuard(cfg, pvStateFile, db, log.NewNopLogger()).check())
+}
+
+func TestSignGuardRun(t *testing.T) {
+	dir := t.TempDir()
+	pvStateFile := filepath.Join(dir, "priv_validator_state.json")
+	sharedPath := filepath.Join(dir, "shared_sign_state.json")
+	cfg := serverconfig.SignGuardConfig{Enable: true, SharedStatePath: sharedPath, SyncInterval: 10 * time.Millisecond}
+
+	guard := newSignGuard(cfg, pvStateFile, dbm.NewMemDB(), log.NewNopLogger())
+	writePVState(t, pvStateFile, 5, 0)
+	require.NoError(t, guard.check())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() { errCh <- guard.run(ctx) }()
+
+	// signing a later height is recorded
+	writePVState(t, pvStateFile, 6, 0)
+	require.Eventually(t, func() bool {
+		w, err := readSignWatermarkFile(sharedPath)
+		return err == nil && w == SignWatermark{Height: 6}
+	}, time.Second, 10*time.Millisecond)
+
+	// another node getting ahead stops the node
+	require.NoError(t, writeFileAtomic(sharedPath, []byte(`{"height":"8","round":0}`)))
+	select {
+	case err := <-errCh:
+		require.ErrorContains(t, err, "another node is signing")
+	case <-time.After(time.Second):
+		t.Fatal("sign guard did not stop")
+	}
+}
+
+func TestOpenSignGuard(t *testing.T) {
+	cmtCfg := cmtcfg.TestConfig().SetRoot(t.TempDir())
+	cfg := serverconfig.SignGuardConfig{Enable: true, SyncInterval: 10 * time.Millisecond}
+
+	guard, err := openSignGuard(cfg, cmtCfg, dbm.GoLevelDBBackend, log.NewNopLogger())
+	require.NoError(t, err)
+	require.DirExists(t, filepath.Join(cmtCfg.RootDir, "data", signGuardDBName+".db"))
+
+	writePVState(t, cmtCfg.PrivValidatorStateFile(), 3, 0)
+	require.NoError(t, guard.check())
+	require.NoError(t, guard.close())
+
+	// the watermark is kept across restarts
+	guard, err = openSignGuard(cfg, cmtCfg, dbm.GoLevelDBBackend, log.NewNopLogger())
+	require.NoError(t, err)
+	defer guard.close()
+
+	writePVState(t, cmtCfg.PrivValidatorStateFile(), 2, 0)
+	require.ErrorContains(t, guard.check(), "sign guard database")
+}
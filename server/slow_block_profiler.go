@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"cosmossdk.io/log"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+const (
+	slowBlockProfilePrefix = "block-"
+	cpuProfileSuffix       = "-cpu.pprof"
+	heapProfileSuffix      = "-heap.pprof"
+)
+
+// slowBlockProfiler captures the profiles of the blocks whose execution, from
+// FinalizeBlock until the matching Commit, exceeds a threshold: a CPU profile
+// from the threshold until the block is committed, and a heap profile when it
+// is committed. The profiles of the most recent slow blocks are kept on disk.
+type slowBlockProfiler struct {
+	threshold time.Duration
+	dir       string
+	maxBlocks int
+	logger    log.Logger
+
+	mtx      sync.Mutex
+	inFlight bool
+	gen      uint64 // incremented for every block, so that a stale timer is ignored
+	height   int64
+	start    time.Time
+	timer    *time.Timer
+	cpuFile  *os.File // nil when the CPU of the block in flight is not profiled
+}
+
+// newSlowBlockProfiler returns a profiler writing to the profile directory of
+// the config, relative to home if not absolute, or nil if slow blocks are not
+// profiled.
+func newSlowBlockProfiler(cfg serverconfig.DiagnosticsConfig, home string, logger log.Logger) (*slowBlockProfiler, error) {
+	if cfg.SlowBlockThreshold <= 0 {
+		return nil, nil
+	}
+
+	dir := cfg.ProfileDir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(home, dir)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &slowBlockProfiler{
+		threshold: cfg.SlowBlockThreshold,
+		dir:       dir,
+		maxBlocks: int(cfg.MaxProfiledBlocks),
+		logger:    logger.With("module", "slow-block-profiler"),
+	}, nil
+}
+
+// begin starts timing the block of the given height. Calling begin again
+// before end, e.g. when FinalizeBlock is retried, keeps timing the same block.
+func (p *slowBlockProfiler) begin(height int64) {
+	if p == nil {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.inFlight {
+		return
+	}
+
+	p.inFlight = true
+	p.gen++
+	p.height = height
+	p.start = time.Now()
+
+	gen := p.gen
+	p.timer = time.AfterFunc(p.threshold, func() {
+		p.startCPUProfile(gen)
+	})
+}
+
+// startCPUProfile starts profiling the CPU if the block gen is still in flight.
+func (p *slowBlockProfiler) startCPUProfile(gen uint64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if !p.inFlight || p.gen != gen {
+		return
+	}
+
+	f, err := os.Create(p.profilePath(p.height, cpuProfileSuffix))
+	if err != nil {
+		p.logger.Error("failed to create cpu profile", "height", p.height, "err", err)
+		return
+	}
+
+	// fails if the CPU is already profiled, e.g. with --cpu-profile
+	if err := pprof.StartCPUProfile(f); err != nil {
+		p.logger.Error("failed to start cpu profile", "height", p.height, "err", err)
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return
+	}
+
+	p.logger.Info("block execution exceeds the slow block threshold, profiling", "height", p.height, "threshold", p.threshold)
+	p.cpuFile = f
+}
+
+// end stops timing the block in flight, if any. If the block is slow, its CPU
+// profile is completed, its heap profile is written and the profiles of the
+// oldest slow blocks are deleted.
+func (p *slowBlockProfiler) end() {
+	if p == nil {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if !p.inFlight {
+		return
+	}
+
+	p.inFlight = false
+	p.timer.Stop()
+	if p.cpuFile == nil {
+		return
+	}
+
+	pprof.StopCPUProfile()
+	if err := p.cpuFile.Close(); err != nil {
+		p.logger.Error("failed to close cpu profile", "height", p.height, "err", err)
+	}
+	p.cpuFile = nil
+
+	if err := p.writeHeapProfile(); err != nil {
+		p.logger.Error("failed to write heap profile", "height", p.height, "err", err)
+	}
+
+	p.logger.Info("captured the profiles of a slow block", "height", p.height, "duration", time.Since(p.start), "dir", p.dir)
+
+	if err := p.rotate(); err != nil {
+		p.logger.Error("failed to delete old slow block profiles", "err", err)
+	}
+}
+
+func (p *slowBlockProfiler) writeHeapProfile() error {
+	f, err := os.Create(p.profilePath(p.height, heapProfileSuffix))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return pprof.WriteHeapProfile(f)
+}
+
+func (p *slowBlockProfiler) profilePath(height int64, suffix string) string {
+	return filepath.Join(p.dir, fmt.Sprintf("%s%d%s", slowBlockProfilePrefix, height, suffix))
+}
+
+// rotate deletes the profiles of the oldest slow blocks, keeping those of the
+// maxBlocks most recent ones.
+func (p *slowBlockProfiler) rotate() error {
+	heights, err := p.profiledHeights()
+	if err != nil {
+		return err
+	}
+
+	if len(heights) <= p.maxBlocks {
+		return nil
+	}
+
+	var errs []error
+	for _, height := range heights[:len(heights)-p.maxBlocks] {
+		for _, suffix := range []string{cpuProfileSuffix, heapProfileSuffix} {
+			if err := os.Remove(p.profilePath(height, suffix)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// profiledHeights returns the heights of the slow blocks with profiles on
+// disk, in increasing order.
+func (p *slowBlockProfiler) profiledHeights() ([]int64, error) {
+	entries, err := os.ReadDir(p.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var heights []int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, slowBlockProfilePrefix) || !strings.HasSuffix(name, cpuProfileSuffix) {
+			continue
+		}
+
+		height, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, slowBlockProfilePrefix), cpuProfileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		heights = append(heights, height)
+	}
+
+	slices.Sort(heights)
+	return heights, nil
+}
+
+// serve serves the profiles on the address until ctx is canceled.
+func (p *slowBlockProfiler) serve(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	srv := &http.Server{Handler: p.handler(), ReadHeaderTimeout: 10 * time.Second}
+	errCh := make(chan error, 1)
+	go func() {
+		p.logger.Info("serving the slow block profiles", "address", listener.Addr())
+		errCh <- srv.Serve(listener)
+	}()
+
+	select {
+	case <-ctx.Done():
+		p.logger.Info("stopping the slow block profile server...")
+		return srv.Shutdown(context.Background())
+	case err := <-errCh:
+		return err
+	}
+}
+
+// handler returns the handler listing and serving the profiles:
+// GET /debug/slow-blocks returns the names of the profiles, and
+// GET /debug/slow-blocks/{name} a profile.
+func (p *slowBlockProfiler) handler() http.Handler {
+	router := mux.NewRouter()
+	router.HandleFunc("/debug/slow-blocks", func(w http.ResponseWriter, _ *http.Request) {
+		heights, err := p.profiledHeights()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		names := make([]string, 0, 2*len(heights))
+		for _, height := range heights {
+			for _, suffix := range []string{cpuProfileSuffix, heapProfileSuffix} {
+				names = append(names, filepath.Base(p.profilePath(height, suffix)))
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(names)
+	}).Methods("GET")
+
+	router.HandleFunc("/debug/slow-blocks/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+		if name != filepath.Base(name) || !strings.HasPrefix(name, slowBlockProfilePrefix) ||
+			(!strings.HasSuffix(name, cpuProfileSuffix) && !strings.HasSuffix(name, heapProfileSuffix)) {
+			http.Error(w, "invalid profile name", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeFile(w, r, filepath.Join(p.dir, name))
+	}).Methods("GET")
+
+	return router
+}
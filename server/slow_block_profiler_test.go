@@ -0,0 +1,79 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func TestSlowBlockProfiler(t *testing.T) {
+	var nilProfiler *slowBlockProfiler
+	nilProfiler.begin(1)
+	nilProfiler.end()
+
+	home := t.TempDir()
+	cfg := serverconfig.DiagnosticsConfig{ProfileDir: "profiles", MaxProfiledBlocks: 2}
+
+	// slow blocks are not profiled without a threshold
+	profiler, err := newSlowBlockProfiler(cfg, home, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Nil(t, profiler)
+
+	cfg.SlowBlockThreshold = 10 * time.Millisecond
+	profiler, err = newSlowBlockProfiler(cfg, home, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(home, "profiles"), profiler.dir)
+
+	// a fast block is not profiled
+	profiler.begin(1)
+	profiler.end()
+	heights, err := profiler.profiledHeights()
+	require.NoError(t, err)
+	require.Empty(t, heights)
+
+	slowBlock := func(height int64) {
+		profiler.begin(height)
+		profiler.begin(height) // FinalizeBlock retried
+		time.Sleep(50 * time.Millisecond)
+		profiler.end()
+	}
+
+	slowBlock(2)
+	require.FileExists(t, profiler.profilePath(2, cpuProfileSuffix))
+	require.FileExists(t, profiler.profilePath(2, heapProfileSuffix))
+
+	// only the profiles of the most recent slow blocks are kept
+	slowBlock(3)
+	slowBlock(4)
+	heights, err = profiler.profiledHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 4}, heights)
+	require.NoFileExists(t, profiler.profilePath(2, heapProfileSuffix))
+
+	router := profiler.handler()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/slow-blocks", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var names []string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &names))
+	require.Equal(t, []string{"block-3-cpu.pprof", "block-3-heap.pprof", "block-4-cpu.pprof", "block-4-heap.pprof"}, names)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/slow-blocks/block-4-heap.pprof", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotEmpty(t, rec.Body.Bytes())
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/slow-blocks/config.toml", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
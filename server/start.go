@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/cometbft/cometbft/abci/server"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
@@ -59,6 +60,7 @@ const (
 	flagCPUProfile         = "cpu-profile"
 	FlagMinGasPrices       = "minimum-gas-prices"
 	FlagQueryGasLimit      = "query-gas-limit"
+	FlagStoreStatsQuery    = "store-stats-query"
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
@@ -74,11 +76,14 @@ const (
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagShutdownGrace       = "shutdown-grace"
+	FlagShutdownBlockWait   = "shutdown-block-wait"
 
 	// state sync-related flags
 
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotWriteRate  = "state-sync.snapshot-write-rate"
+	FlagStateSyncChunkServeRate     = "state-sync.chunk-serve-rate"
 
 	// api-related flags
 
@@ -102,6 +107,15 @@ const (
 
 	FlagMempoolMaxTxs = "mempool.max-txs"
 
+	// sign guard flags
+
+	FlagSignGuardEnable          = "sign-guard.enable"
+	FlagSignGuardSharedStatePath = "sign-guard.shared-state-path"
+
+	// diagnostics flags
+
+	FlagDiagnosticsSlowBlockThreshold = "diagnostics.slow-block-threshold"
+
 	// testnet keys
 
 	KeyIsTestnet             = "is-testnet"
@@ -216,7 +230,32 @@ func start[T types.Application](svrCtx *Context, clientCtx client.Context, appCr
 	if err != nil {
 		return err
 	}
-	defer appCleanupFn()
+
+	blocks := &blockTracker{}
+	defer shutdownApp(svrCtx, app, blocks, appCleanupFn)
+
+	// only a node running CometBFT in-process signs with the priv validator
+	var guard *signGuard
+	if withCmt && svrCfg.SignGuard.Enable && !svrCtx.Viper.GetBool(flagGRPCOnly) {
+		guard, err = openSignGuard(svrCfg.SignGuard, svrCtx.Config, GetAppDBBackend(svrCtx.Viper), svrCtx.Logger)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := guard.close(); err != nil {
+				svrCtx.Logger.Error("failed to close the sign guard database", "err", err)
+			}
+		}()
+
+		if err := guard.check(); err != nil {
+			return err
+		}
+	}
+
+	profiler, err := newSlowBlockProfiler(svrCfg.Diagnostics, svrCtx.Config.RootDir, svrCtx.Logger)
+	if err != nil {
+		return err
+	}
 
 	metrics, err := startTelemetry(svrCfg)
 	if err != nil {
@@ -226,16 +265,54 @@ func start[T types.Application](svrCtx *Context, clientCtx client.Context, appCr
 	emitServerInfoMetrics()
 
 	if !withCmt {
-		return startStandAlone[T](svrCtx, svrCfg, clientCtx, app, metrics, opts)
+		return startStandAlone[T](svrCtx, svrCfg, clientCtx, app, blocks, profiler, metrics, opts)
 	}
-	return startInProcess[T](svrCtx, svrCfg, clientCtx, app, metrics, opts)
+	return startInProcess[T](svrCtx, svrCfg, clientCtx, app, blocks, guard, profiler, metrics, opts)
 }
 
-func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.Config, clientCtx client.Context, app T, metrics *telemetry.Metrics, opts StartCmdOptions[T]) error {
+// shutdownApp is called once CometBFT or the ABCI server stopped. It waits for
+// the block in flight, if any, to be committed and for the snapshot being
+// created, if any, to be saved, closes the application and writes the shutdown
+// marker if both completed in time.
+func shutdownApp[T types.Application](svrCtx *Context, app T, blocks *blockTracker, appCleanupFn func()) {
+	timeout := svrCtx.Viper.GetDuration(FlagShutdownBlockWait)
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	clean := blocks.wait(timeout)
+	if !clean {
+		svrCtx.Logger.Error("timed out waiting for the block in flight to be committed", FlagShutdownBlockWait, timeout)
+	}
+
+	var height int64
+	if cms := app.CommitMultiStore(); cms != nil {
+		height = cms.LastCommitID().Version
+	}
+
+	if clean && !waitForSnapshots(app.SnapshotManager(), snapshotsDir(svrCtx.Config.RootDir), height, deadline) {
+		svrCtx.Logger.Error("timed out waiting for the snapshot in progress to be saved", FlagShutdownBlockWait, timeout)
+		clean = false
+	}
+
+	svrCtx.Logger.Info("closing application", "height", height)
+	appCleanupFn()
+
+	if !clean {
+		return
+	}
+
+	if err := writeShutdownMarker(svrCtx.Config.RootDir, height); err != nil {
+		svrCtx.Logger.Error("failed to write shutdown marker", "err", err)
+	}
+}
+
+func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.Config, clientCtx client.Context, app T, blocks *blockTracker, profiler *slowBlockProfiler, metrics *telemetry.Metrics, opts StartCmdOptions[T]) error {
 	addr := svrCtx.Viper.GetString(flagAddress)
 	transport := svrCtx.Viper.GetString(flagTransport)
 
-	cmtApp := NewCometABCIWrapper(app)
+	cmtApp := cometABCIWrapper{app: app, blocks: blocks, profiler: profiler}
 	svr, err := server.NewServer(addr, transport, cmtApp)
 	if err != nil {
 		return fmt.Errorf("error creating listener: %w", err)
@@ -278,6 +355,8 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 		return err
 	}
 
+	startProfileServer(ctx, g, svrCfg.Diagnostics, profiler)
+
 	if opts.PostSetupStandalone != nil {
 		if err := opts.PostSetupStandalone(app, svrCtx, clientCtx, ctx, g); err != nil {
 			return err
@@ -301,7 +380,7 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 }
 
 func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Config, clientCtx client.Context, app T,
-	metrics *telemetry.Metrics, opts StartCmdOptions[T],
+	blocks *blockTracker, guard *signGuard, profiler *slowBlockProfiler, metrics *telemetry.Metrics, opts StartCmdOptions[T],
 ) error {
 	cmtCfg := svrCtx.Config
 	home := cmtCfg.RootDir
@@ -316,7 +395,13 @@ func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Co
 		svrCfg.GRPC.Enable = true
 	} else {
 		svrCtx.Logger.Info("starting node with ABCI CometBFT in-process")
-		tmNode, cleanupFn, err := startCmtNode(ctx, cmtCfg, app, svrCtx)
+		if guard != nil {
+			g.Go(func() error {
+				return guard.run(ctx)
+			})
+		}
+
+		tmNode, cleanupFn, err := startCmtNode(ctx, cmtCfg, cometABCIWrapper{app: app, blocks: blocks, profiler: profiler}, svrCtx)
 		if err != nil {
 			return err
 		}
@@ -346,6 +431,8 @@ func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Co
 		return err
 	}
 
+	startProfileServer(ctx, g, svrCfg.Diagnostics, profiler)
+
 	if opts.PostSetup != nil {
 		if err := opts.PostSetup(app, svrCtx, clientCtx, ctx, g); err != nil {
 			return err
@@ -361,7 +448,7 @@ func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Co
 func startCmtNode(
 	ctx context.Context,
 	cfg *cmtcfg.Config,
-	app types.Application,
+	cmtApp abci.Application,
 	svrCtx *Context,
 ) (tmNode *node.Node, cleanupFn func(), err error) {
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -369,7 +456,6 @@ func startCmtNode(
 		return nil, cleanupFn, err
 	}
 
-	cmtApp := NewCometABCIWrapper(app)
 	tmNode, err = node.NewNodeWithContext(
 		ctx,
 		cfg,
@@ -532,6 +618,19 @@ func startAPIServer(
 	return nil
 }
 
+// startProfileServer serves the profiles of the slow blocks on their own
+// address, if slow blocks are profiled and the address is set. The profiles are
+// kept off the API server, which is commonly exposed publicly.
+func startProfileServer(ctx context.Context, g *errgroup.Group, cfg serverconfig.DiagnosticsConfig, profiler *slowBlockProfiler) {
+	if profiler == nil || cfg.ProfileAddress == "" {
+		return
+	}
+
+	g.Go(func() error {
+		return profiler.serve(ctx, cfg.ProfileAddress)
+	})
+}
+
 func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	if !cfg.Telemetry.Enabled {
 		return nil, nil
@@ -610,6 +709,15 @@ func startApp[T types.Application](svrCtx *Context, appCreator types.AppCreator[
 		return app, traceCleanupFn, err
 	}
 
+	// a missing or unreadable marker means the previous run did not shut down cleanly
+	marker, err := consumeShutdownMarker(home)
+	if err != nil {
+		svrCtx.Logger.Error("failed to read shutdown marker", "err", err)
+	} else if marker != nil {
+		svrCtx.Logger.Info("previous run shut down cleanly", "height", marker.Height, "time", marker.Time)
+		svrCtx.Viper.Set(KeyCleanShutdownHeight, marker.Height)
+	}
+
 	if isTestnet, ok := svrCtx.Viper.Get(KeyIsTestnet).(bool); ok && isTestnet {
 		var appPtr *T
 		appPtr, err = testnetify[T](svrCtx, home, appCreator, db, traceWriter)
@@ -959,6 +1067,7 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a Rest/Grpc query can consume. Blank and 0 imply unbounded.")
+	cmd.Flags().Bool(FlagStoreStatsQuery, false, "Enable the /app/store_stats query, which walks the whole app state")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
@@ -984,9 +1093,15 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotWriteRate, 0, "Maximum bytes per second written while creating a state sync snapshot (0 is unlimited)")
+	cmd.Flags().Uint64(FlagStateSyncChunkServeRate, 0, "Maximum bytes per second of state sync snapshot chunks served to peers (0 is unlimited)")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Bool(FlagSignGuardEnable, false, "Refuse to start the node if a sign state newer than the priv validator state was recorded")
+	cmd.Flags().String(FlagSignGuardSharedStatePath, "", "Path of the sign state file shared by the nodes of a failover setup")
+	cmd.Flags().Duration(FlagDiagnosticsSlowBlockThreshold, 0, "Capture CPU and heap profiles of the blocks whose execution exceeds this duration (0 disables the capture)")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
+	cmd.Flags().Duration(FlagShutdownBlockWait, 30*time.Second, "On Shutdown, maximum duration to wait for the block in flight to be committed and the snapshot in progress to be saved (0 waits until they are)")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
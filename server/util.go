@@ -514,6 +514,14 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	// snapshots being created when the node crashed are left without their
+	// metadata, after a clean shutdown they were all saved
+	if appOpts.Get(KeyCleanShutdownHeight) == nil {
+		if _, err := removeIncompleteSnapshots(snapshotStore, snapshotsDir(homeDir)); err != nil {
+			panic(err)
+		}
+	}
+
 	snapshotOptions := snapshottypes.NewSnapshotOptions(
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
@@ -538,17 +546,19 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
+		baseapp.SetSnapshotCreationRateLimit(cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotWriteRate))),
+		baseapp.SetSnapshotServeRateLimit(cast.ToUint64(appOpts.Get(FlagStateSyncChunkServeRate))),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetStoreStatsQuery(cast.ToBool(appOpts.Get(FlagStoreStatsQuery))),
 	}
 }
 
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
-	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
-	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	snapshotDir := snapshotsDir(cast.ToString(appOpts.Get(flags.FlagHome)))
 	if err := os.MkdirAll(snapshotDir, 0o744); err != nil {
 		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
 	}
@@ -564,3 +574,8 @@ func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
 
 	return snapshotStore, nil
 }
+
+// snapshotsDir returns the directory of the state sync snapshots of the node.
+func snapshotsDir(home string) string {
+	return filepath.Join(home, "data", "snapshots")
+}
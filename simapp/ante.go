@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/x/auth/ante/unorderedtx"
 	circuitante "cosmossdk.io/x/circuit/ante"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -42,10 +43,19 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, options.TxManager),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker).WithGasPriceTracker(options.GasPriceTracker),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
+
+// setPrepareProposalHandler sets the default PrepareProposal handler, selecting at
+// most MaxFeeExemptTxsPerBlock fee exempt transactions per proposal, as the ante
+// handler rejects the ones above the maximum when the block is finalized.
+func setPrepareProposalHandler(app *baseapp.BaseApp, ak ante.AccountKeeper) {
+	proposalHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app)
+	proposalHandler.SetTxSelector(ante.NewFeeExemptTxSelector(ak, baseapp.NewDefaultTxSelector()))
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+}
@@ -3,6 +3,7 @@
 package simapp
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,6 +37,7 @@ import (
 	txmodule "cosmossdk.io/x/auth/tx/config"
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/auth/vesting"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
 	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 	"cosmossdk.io/x/authz"
 	authzkeeper "cosmossdk.io/x/authz/keeper"
@@ -90,6 +92,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/indexer/postgres"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	runtimeservices "github.com/cosmos/cosmos-sdk/runtime/services"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -118,15 +121,16 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		pooltypes.ModuleName:           nil,
-		pooltypes.StreamAccount:        nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		nft.ModuleName:                 nil,
+		authtypes.FeeCollectorName:       nil,
+		distrtypes.ModuleName:            nil,
+		pooltypes.ModuleName:             nil,
+		pooltypes.StreamAccount:          nil,
+		minttypes.ModuleName:             {authtypes.Minter},
+		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
+		stakingtypes.EpochEscrowPoolName: {authtypes.Staking},
+		govtypes.ModuleName:              {authtypes.Burner},
+		nft.ModuleName:                   nil,
 	}
 )
 
@@ -168,9 +172,15 @@ type SimApp struct {
 	PoolKeeper            poolkeeper.Keeper
 
 	// managers
-	ModuleManager      *module.Manager
-	UnorderedTxManager *unorderedtx.Manager
-	sm                 *module.SimulationManager
+	ModuleManager       *module.Manager
+	UnorderedTxManager  *unorderedtx.Manager
+	LockedValueReporter *vesting.LockedValueReporter
+	RewardHistory       *distrkeeper.RewardHistory
+	rewardHistoryDB     dbm.DB
+	BalanceHistory      *bankkeeper.BalanceHistory
+	balanceHistoryDB    dbm.DB
+	indexerListener     *postgres.Listener
+	sm                  *module.SimulationManager
 
 	// module configurator
 	configurator module.Configurator // nolint:staticcheck // SA1019: Configurator is deprecated but still used in runtime v1.
@@ -266,6 +276,15 @@ func NewSimApp(
 		panic(err)
 	}
 
+	// register the PostgreSQL indexer when it is configured
+	indexerListener, err := postgres.NewListenerFromAppOptions(appOpts, txConfig.TxDecoder(), appCodec, logger)
+	if err != nil {
+		panic(err)
+	}
+	if indexerListener != nil {
+		bApp.AddABCIListeners(indexerListener)
+	}
+
 	app := &SimApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -273,6 +292,7 @@ func NewSimApp(
 		txConfig:          txConfig,
 		interfaceRegistry: interfaceRegistry,
 		keys:              keys,
+		indexerListener:   indexerListener,
 	}
 
 	// set the BaseApp's parameter store
@@ -307,12 +327,24 @@ func NewSimApp(
 	app.AuthKeeper = authkeeper.NewAccountKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), logger), appCodec, authtypes.ProtoBaseAccount, maccPerms, signingCtx.AddressCodec(), sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
-		runtime.NewEnvironment(runtime.NewKVStoreService(keys[banktypes.StoreKey]), logger),
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[banktypes.StoreKey]), logger, runtime.EnvWithRouterService(app.GRPCQueryRouter(), app.MsgServiceRouter())),
 		appCodec,
 		app.AuthKeeper,
 		BlockedAddresses(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// credit the account creation fees to the community pool
+	app.BankKeeper.SetAccountCreationFeeCollector(pooltypes.ModuleName)
+	// open the node-local balance history, served by the bank Node service,
+	// when it is enabled
+	app.BalanceHistory, app.balanceHistoryDB, err = openBalanceHistory(appOpts, app.BankKeeper)
+	if err != nil {
+		panic(err)
+	}
+	if app.BalanceHistory != nil {
+		bApp.CommitMultiStore().AddListeners([]storetypes.StoreKey{keys[banktypes.StoreKey]})
+		bApp.AddABCIListeners(app.BalanceHistory)
+	}
 
 	// optional: enable sign mode textual by overwriting the default tx config (after setting the bank keeper)
 	enabledSignModes := append(authtx.DefaultSignModes, sigtypes.SignMode_SIGN_MODE_TEXTUAL)
@@ -341,18 +373,32 @@ func NewSimApp(
 	app.PoolKeeper = poolkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[pooltypes.StoreKey]), logger), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.DistrKeeper = distrkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, app.PoolKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// open the node-local reward history index, served by the distribution Node
+	// service, when it is enabled
+	app.RewardHistory, app.rewardHistoryDB, err = openRewardHistory(appOpts, app.AuthKeeper.AddressCodec())
+	if err != nil {
+		panic(err)
+	}
+	if app.RewardHistory != nil {
+		bApp.AddABCIListeners(app.RewardHistory)
+	}
 
 	app.SlashingKeeper = slashingkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[slashingtypes.StoreKey]), logger),
-		appCodec, legacyAmino, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		appCodec, legacyAmino, app.AuthKeeper, app.BankKeeper, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[feegrant.StoreKey]), logger), appCodec, app.AuthKeeper)
 
+	// validate the evidence max age against the unbonding time on consensus params updates
+	app.ConsensusParamsKeeper.SetStakingKeeper(app.StakingKeeper)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
+	app.StakingKeeper.SetSlashedTokensHandler(app.SlashingKeeper)
+	app.StakingKeeper.SetValidatorLivenessProvider(app.SlashingKeeper)
 
 	app.CircuitKeeper = circuitkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[circuittypes.StoreKey]), logger), appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.AuthKeeper.AddressCodec())
 	app.BaseApp.SetCircuitBreaker(&app.CircuitKeeper)
@@ -416,7 +462,8 @@ func NewSimApp(
 		genutil.NewAppModule(appCodec, app.AuthKeeper, app.StakingKeeper, app, txConfig, genutiltypes.DefaultMessageValidator),
 		accounts.NewAppModule(appCodec, app.AccountsKeeper),
 		auth.NewAppModule(appCodec, app.AuthKeeper, authsims.RandomGenesisAccounts),
-		vesting.NewAppModule(app.AuthKeeper, app.BankKeeper),
+		vesting.NewAppModule(app.AuthKeeper, app.BankKeeper, vestingkeeper.NewKeeper(app.AuthKeeper, app.BankKeeper,
+			runtime.NewRouterService(nil, app.GRPCQueryRouter(), app.MsgServiceRouter()).QueryRouterService())),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AuthKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AuthKeeper, app.BankKeeper, app.PoolKeeper),
@@ -445,6 +492,7 @@ func NewSimApp(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.ModuleManager.SetOrderBeginBlockers(
+		authtypes.ModuleName,
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -490,7 +538,7 @@ func NewSimApp(
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
 
-	reflectionSvc, err := runtimeservices.NewReflectionService()
+	reflectionSvc, err := runtimeservices.NewReflectionService(app.MsgServiceRouter())
 	if err != nil {
 		panic(err)
 	}
@@ -517,6 +565,15 @@ func NewSimApp(
 		panic(fmt.Errorf("failed to initialize unordered tx manager: %w", err))
 	}
 
+	// update the vesting locked value gauges in the background, off the block
+	// path, when telemetry is enabled
+	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
+		app.LockedValueReporter = vesting.NewLockedValueReporter(app.AuthKeeper, func() (sdk.Context, error) {
+			return app.CreateQueryContext(0, false)
+		}, logger)
+		app.LockedValueReporter.Start(vesting.LockedValueGaugeInterval)
+	}
+
 	// register custom snapshot extensions (if any)
 	if manager := app.SnapshotManager(); manager != nil {
 		err := manager.RegisterExtensions(
@@ -538,6 +595,7 @@ func NewSimApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.setAnteHandler(txConfig)
+	setPrepareProposalHandler(app.BaseApp, app.AuthKeeper)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
@@ -586,6 +644,7 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				SignModeHandler:          txConfig.SignModeHandler(),
 				FeegrantKeeper:           app.FeeGrantKeeper,
 				SigGasConsumer:           ante.DefaultSigVerificationGasConsumer,
+				GasPriceTracker:          app.GasPriceTracker(),
 			},
 			&app.CircuitKeeper,
 			app.UnorderedTxManager,
@@ -613,6 +672,26 @@ func (app *SimApp) setPostHandler() {
 // Close implements the Application interface and closes all necessary application
 // resources.
 func (app *SimApp) Close() error {
+	if app.LockedValueReporter != nil {
+		if err := app.LockedValueReporter.Close(); err != nil {
+			return err
+		}
+	}
+	if app.rewardHistoryDB != nil {
+		if err := app.rewardHistoryDB.Close(); err != nil {
+			return err
+		}
+	}
+	if app.balanceHistoryDB != nil {
+		if err := app.balanceHistoryDB.Close(); err != nil {
+			return err
+		}
+	}
+	if app.indexerListener != nil {
+		if err := app.indexerListener.Close(); err != nil {
+			return err
+		}
+	}
 	return app.UnorderedTxManager.Close()
 }
 
@@ -731,6 +810,17 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the node-local module services for grpc-gateway.
+	if err := stakingtypes.RegisterNodeHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, stakingtypes.NewNodeClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := distrtypes.RegisterNodeHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, distrtypes.NewNodeClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := banktypes.RegisterNodeHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, banktypes.NewNodeClient(clientCtx)); err != nil {
+		panic(err)
+	}
+
 	// Register grpc-gateway routes for all modules.
 	app.ModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -757,7 +847,13 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 }
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
-	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg, app.GasPriceTracker())
+
+	// The node-local module services are not part of the chain state, so they
+	// are registered here rather than by the modules.
+	stakingtypes.RegisterNodeServer(app.GRPCQueryRouter(), stakingkeeper.NewNodeServer(app.StakingKeeper))
+	distrtypes.RegisterNodeServer(app.GRPCQueryRouter(), distrkeeper.NewNodeServer(app.RewardHistory))
+	banktypes.RegisterNodeServer(app.GRPCQueryRouter(), bankkeeper.NewNodeServer(app.BalanceHistory))
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -782,5 +878,8 @@ func BlockedAddresses() map[string]bool {
 	// allow the following addresses to receive funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
+	// the swap escrow only receives coins through the swaps
+	modAccAddrs[banktypes.SwapEscrowAddress.String()] = true
+
 	return modAccAddrs
 }
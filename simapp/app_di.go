@@ -3,6 +3,7 @@
 package simapp
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	"io"
@@ -20,10 +21,13 @@ import (
 	authkeeper "cosmossdk.io/x/auth/keeper"
 	authsims "cosmossdk.io/x/auth/simulation"
 	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
 	authzkeeper "cosmossdk.io/x/authz/keeper"
 	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	distrkeeper "cosmossdk.io/x/distribution/keeper"
+	distrtypes "cosmossdk.io/x/distribution/types"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	govkeeper "cosmossdk.io/x/gov/keeper"
@@ -32,8 +36,10 @@ import (
 	nftkeeper "cosmossdk.io/x/nft/keeper"
 	_ "cosmossdk.io/x/protocolpool"
 	poolkeeper "cosmossdk.io/x/protocolpool/keeper"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -41,12 +47,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/indexer/postgres"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 )
@@ -69,7 +77,13 @@ type SimApp struct {
 	txConfig          client.TxConfig
 	interfaceRegistry codectypes.InterfaceRegistry
 
-	UnorderedTxManager *unorderedtx.Manager
+	UnorderedTxManager  *unorderedtx.Manager
+	LockedValueReporter *vesting.LockedValueReporter
+	RewardHistory       *distrkeeper.RewardHistory
+	rewardHistoryDB     dbm.DB
+	BalanceHistory      *bankkeeper.BalanceHistory
+	balanceHistoryDB    dbm.DB
+	indexerListener     *postgres.Listener
 
 	// keepers
 	AuthKeeper            authkeeper.AccountKeeper
@@ -240,8 +254,42 @@ func NewSimApp(
 		panic(err)
 	}
 
+	// register the PostgreSQL indexer when it is configured
+	indexerListener, err := postgres.NewListenerFromAppOptions(appOpts, app.txConfig.TxDecoder(), app.appCodec, logger)
+	if err != nil {
+		panic(err)
+	}
+	if indexerListener != nil {
+		app.AddABCIListeners(indexerListener)
+		app.indexerListener = indexerListener
+	}
+
+	// open the node-local reward history index, served by the distribution Node
+	// service, when it is enabled
+	app.RewardHistory, app.rewardHistoryDB, err = openRewardHistory(appOpts, app.AuthKeeper.AddressCodec())
+	if err != nil {
+		panic(err)
+	}
+	if app.RewardHistory != nil {
+		app.AddABCIListeners(app.RewardHistory)
+	}
+
+	// open the node-local balance history, served by the bank Node service,
+	// when it is enabled
+	app.BalanceHistory, app.balanceHistoryDB, err = openBalanceHistory(appOpts, app.BankKeeper.(bankkeeper.BaseKeeper))
+	if err != nil {
+		panic(err)
+	}
+	if app.BalanceHistory != nil {
+		app.CommitMultiStore().AddListeners([]storetypes.StoreKey{app.GetKey(banktypes.StoreKey)})
+		app.AddABCIListeners(app.BalanceHistory)
+	}
+
 	/****  Module Options ****/
 
+	// credit the account creation fees to the community pool
+	app.BankKeeper.SetAccountCreationFeeCollector(pooltypes.ModuleName)
+
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	app.RegisterUpgradeHandlers()
 
@@ -279,6 +327,17 @@ func NewSimApp(
 		panic(fmt.Errorf("failed to initialize unordered tx manager: %w", err))
 	}
 
+	// update the vesting locked value gauges in the background, off the block
+	// path, when telemetry is enabled
+	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
+		app.LockedValueReporter = vesting.NewLockedValueReporter(app.AuthKeeper, func() (sdk.Context, error) {
+			return app.CreateQueryContext(0, false)
+		}, logger)
+		app.LockedValueReporter.Start(vesting.LockedValueGaugeInterval)
+	}
+
+	setPrepareProposalHandler(app.App.BaseApp, app.AuthKeeper)
+
 	// register custom snapshot extensions (if any)
 	if manager := app.SnapshotManager(); manager != nil {
 		err := manager.RegisterExtensions(
@@ -299,6 +358,26 @@ func NewSimApp(
 // Close implements the Application interface and closes all necessary application
 // resources.
 func (app *SimApp) Close() error {
+	if app.LockedValueReporter != nil {
+		if err := app.LockedValueReporter.Close(); err != nil {
+			return err
+		}
+	}
+	if app.rewardHistoryDB != nil {
+		if err := app.rewardHistoryDB.Close(); err != nil {
+			return err
+		}
+	}
+	if app.balanceHistoryDB != nil {
+		if err := app.balanceHistoryDB.Close(); err != nil {
+			return err
+		}
+	}
+	if app.indexerListener != nil {
+		if err := app.indexerListener.Close(); err != nil {
+			return err
+		}
+	}
 	return app.UnorderedTxManager.Close()
 }
 
@@ -360,12 +439,34 @@ func (app *SimApp) SimulationManager() *module.SimulationManager {
 // API server.
 func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	app.App.RegisterAPIRoutes(apiSvr, apiConfig)
+
+	// Register the node-local module services for grpc-gateway.
+	if err := stakingtypes.RegisterNodeHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, stakingtypes.NewNodeClient(apiSvr.ClientCtx)); err != nil {
+		panic(err)
+	}
+	if err := distrtypes.RegisterNodeHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, distrtypes.NewNodeClient(apiSvr.ClientCtx)); err != nil {
+		panic(err)
+	}
+	if err := banktypes.RegisterNodeHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, banktypes.NewNodeClient(apiSvr.ClientCtx)); err != nil {
+		panic(err)
+	}
+
 	// register swagger API in app.go so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
 	}
 }
 
+// RegisterNodeService registers the node gRPC service and the node-local module
+// services. The latter are not part of the chain state, so they are registered
+// here rather than by the modules.
+func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
+	app.App.RegisterNodeService(clientCtx, cfg)
+	stakingtypes.RegisterNodeServer(app.GRPCQueryRouter(), stakingkeeper.NewNodeServer(app.StakingKeeper))
+	distrtypes.RegisterNodeServer(app.GRPCQueryRouter(), distrkeeper.NewNodeServer(app.RewardHistory))
+	banktypes.RegisterNodeServer(app.GRPCQueryRouter(), bankkeeper.NewNodeServer(app.BalanceHistory))
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 //
 // NOTE: This is solely to be used for testing purposes.
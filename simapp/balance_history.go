@@ -0,0 +1,131 @@
+package simapp
+
+import (
+	"encoding/binary"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	corestore "cosmossdk.io/core/store"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// FlagBalanceHistory enables the node-local bank balance history, recording
+// the balances of every committed block.
+const FlagBalanceHistory = "bank.balance-history"
+
+// AddBalanceHistoryFlags adds the flags of the bank balance history to the start
+// command.
+func AddBalanceHistoryFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagBalanceHistory, false, "Record the balances of every committed block in a node-local database, to be queried at past heights")
+}
+
+// openBalanceHistory opens the bank balance history in the balance_history
+// database of the node data directory when it is enabled with
+// FlagBalanceHistory, and returns a nil history and database otherwise. The
+// history is kept out of the application database, as it is not part of the
+// state.
+func openBalanceHistory(appOpts servertypes.AppOptions, bankKeeper bankkeeper.BaseKeeper) (*bankkeeper.BalanceHistory, dbm.DB, error) {
+	if !cast.ToBool(appOpts.Get(FlagBalanceHistory)) {
+		return nil, nil, nil
+	}
+
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	db, err := dbm.NewDB("balance_history", server.GetAppDBBackend(appOpts), dataDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bankkeeper.NewBalanceHistory(bankKeeper, versionedDB{db: db}), db, nil
+}
+
+var (
+	// versionedDBLatestKey is the key of the latest version of a versionedDB.
+	versionedDBLatestKey = []byte{0x00}
+	// versionedDBValuePrefix prefixes the values of a versionedDB.
+	versionedDBValuePrefix = []byte{0x01}
+)
+
+// versionedDB is a bankkeeper.VersionedStore keeping every version of the
+// values in a database. Each value is stored under its actor, key and version,
+// and a read at a version returns the value of the latest version up to it.
+// Past versions are never pruned.
+type versionedDB struct {
+	db dbm.DB
+}
+
+var _ bankkeeper.VersionedStore = versionedDB{}
+
+// valueKey returns the key of the value of the actor key at the version, or of
+// its first version when version is nil.
+func (s versionedDB) valueKey(actor, key []byte, version *uint64) []byte {
+	bz := append([]byte{}, versionedDBValuePrefix...)
+	bz = binary.AppendUvarint(bz, uint64(len(actor)))
+	bz = append(bz, actor...)
+	bz = binary.AppendUvarint(bz, uint64(len(key)))
+	bz = append(bz, key...)
+	if version == nil {
+		return bz
+	}
+	return binary.BigEndian.AppendUint64(bz, *version)
+}
+
+// Get implements bankkeeper.VersionedStore.
+func (s versionedDB) Get(actor []byte, version uint64, key []byte) ([]byte, error) {
+	next := version + 1
+	it, err := s.db.ReverseIterator(s.valueKey(actor, key, nil), s.valueKey(actor, key, &next))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil, it.Error()
+	}
+
+	// the values are prefixed with 0x01 to tell them apart from the 0x00
+	// tombstones of removed values, as the database does not store nil values
+	value := it.Value()
+	if len(value) == 0 || value[0] != 0x01 {
+		return nil, nil
+	}
+	return value[1:], nil
+}
+
+// GetLatestVersion implements bankkeeper.VersionedStore.
+func (s versionedDB) GetLatestVersion() (uint64, error) {
+	bz, err := s.db.Get(versionedDBLatestKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// ApplyChangeset implements bankkeeper.VersionedStore.
+func (s versionedDB) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, changes := range cs.Changes {
+		for _, pair := range changes.StateChanges {
+			value := []byte{0x00}
+			if !pair.Remove {
+				value = append([]byte{0x01}, pair.Value...)
+			}
+			if err := batch.Set(s.valueKey(changes.Actor, pair.Key, &version), value); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := batch.Set(versionedDBLatestKey, binary.BigEndian.AppendUint64(nil, version)); err != nil {
+		return err
+	}
+	return batch.Write()
+}
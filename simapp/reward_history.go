@@ -0,0 +1,54 @@
+package simapp
+
+import (
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/store/dbadapter"
+	distrkeeper "cosmossdk.io/x/distribution/keeper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// FlagRewardHistoryEpochBlocks enables the node-local distribution reward
+// history index, aggregating the rewards withdrawn by each delegator per epoch
+// of the given number of blocks.
+const FlagRewardHistoryEpochBlocks = "distribution.reward-history-epoch-blocks"
+
+// AddRewardHistoryFlags adds the flags of the distribution reward history index
+// to the start command.
+func AddRewardHistoryFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Uint64(FlagRewardHistoryEpochBlocks, 0, "Index the rewards withdrawn by each delegator per epoch of the given number of blocks in a node-local database (0 disables the index)")
+}
+
+// openRewardHistory opens the distribution reward history index in the
+// reward_history database of the node data directory when it is enabled with
+// FlagRewardHistoryEpochBlocks, and returns a nil index and database otherwise.
+// The index is kept out of the application database, as it is not part of the
+// state.
+func openRewardHistory(appOpts servertypes.AppOptions, addressCodec address.Codec) (*distrkeeper.RewardHistory, dbm.DB, error) {
+	epochBlocks := cast.ToUint64(appOpts.Get(FlagRewardHistoryEpochBlocks))
+	if epochBlocks == 0 {
+		return nil, nil, nil
+	}
+
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	db, err := dbm.NewDB("reward_history", server.GetAppDBBackend(appOpts), dataDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewardHistory, err := distrkeeper.NewRewardHistory(dbadapter.Store{DB: db}, addressCodec, epochBlocks)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+
+	return rewardHistory, db, nil
+}
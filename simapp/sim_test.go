@@ -44,12 +44,6 @@ func init() {
 	flag.BoolVar(&FlagEnableStreamingValue, "EnableStreaming", false, "Enable streaming service")
 }
 
-// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
-// an IAVLStore for faster simulation speed.
-func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
-	bapp.SetFauxMerkleMode()
-}
-
 // interBlockCacheOpt returns a BaseApp option function that sets the persistent
 // inter-block write-through cache.
 func interBlockCacheOpt() func(*baseapp.BaseApp) {
@@ -82,7 +76,7 @@ func TestFullAppSimulation(t *testing.T) {
 	require.Equal(t, "SimApp", app.Name())
 
 	// run randomized simulation
-	_, simParams, simErr := simulation.SimulateFromSeed(
+	_, simParams, simErr := simulation.SimulateFromSeedX(
 		t,
 		os.Stdout,
 		app.BaseApp,
@@ -92,6 +86,7 @@ func TestFullAppSimulation(t *testing.T) {
 		BlockedAddresses(),
 		config,
 		app.AppCodec(),
+		simtestutil.AppStateExportFn(app),
 	)
 
 	// export state and simParams before the simulation error is checked
@@ -130,7 +125,7 @@ func TestAppImportExport(t *testing.T) {
 	require.Equal(t, "SimApp", app.Name())
 
 	// Run randomized simulation
-	_, simParams, simErr := simulation.SimulateFromSeed(
+	_, simParams, simErr := simulation.SimulateFromSeedX(
 		t,
 		os.Stdout,
 		app.BaseApp,
@@ -140,6 +135,7 @@ func TestAppImportExport(t *testing.T) {
 		BlockedAddresses(),
 		config,
 		app.AppCodec(),
+		simtestutil.AppStateExportFn(app),
 	)
 
 	// export state and simParams before the simulation error is checked
@@ -251,7 +247,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	require.Equal(t, "SimApp", app.Name())
 
 	// Run randomized simulation
-	stopEarly, simParams, simErr := simulation.SimulateFromSeed(
+	stopEarly, simParams, simErr := simulation.SimulateFromSeedX(
 		t,
 		os.Stdout,
 		app.BaseApp,
@@ -261,6 +257,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		BlockedAddresses(),
 		config,
 		app.AppCodec(),
+		simtestutil.AppStateExportFn(app),
 	)
 
 	// export state and simParams before the simulation error is checked
@@ -15,6 +15,8 @@ import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 	authcmd "cosmossdk.io/x/auth/client/cli"
 	banktypes "cosmossdk.io/x/bank/types"
+	stakingcli "cosmossdk.io/x/staking/client/cli"
+	upgradecli "cosmossdk.io/x/upgrade/client/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/debug"
@@ -23,13 +25,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
+	"github.com/cosmos/cosmos-sdk/client/storestats"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/indexer/postgres"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 )
 
 func initRootCmd(
@@ -49,9 +54,13 @@ func initRootCmd(
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp),
 		snapshot.Cmd(newApp),
+		storestats.Cmd(newApp),
+		upgradecli.NewCompatCheckCmd(newApp),
+		simcli.SimCmd(simapp.ReplaySimulation),
+		postgres.Cmd(),
 	)
 
-	server.AddCommands(rootCmd, newApp, func(startCmd *cobra.Command) {})
+	server.AddCommands(rootCmd, newApp, addModuleInitFlags)
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
@@ -61,9 +70,15 @@ func initRootCmd(
 		txCommand(),
 		keys.Commands(),
 		offchain.OffChain(),
+		stakingcli.ExportValsetsCmd(),
 	)
 }
 
+func addModuleInitFlags(startCmd *cobra.Command) {
+	simapp.AddRewardHistoryFlags(startCmd)
+	simapp.AddBalanceHistoryFlags(startCmd)
+}
+
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
 func genesisCommand(txConfig client.TxConfig, moduleManager *module.Manager, appExport servertypes.AppExporter, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, moduleManager, appExport)
@@ -115,6 +130,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		authcmd.GetTxTemplateCommand(),
 	)
 
 	return cmd
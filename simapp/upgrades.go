@@ -21,6 +21,13 @@ import (
 const UpgradeName = "v050-to-v051"
 
 func (app SimApp) RegisterUpgradeHandlers() {
+	// served by the BinaryModuleVersions query and used by the compat-check command
+	migrations, err := app.ModuleManager.GetMigrationVersions(app.Configurator())
+	if err != nil {
+		panic(err)
+	}
+	app.UpgradeKeeper.SetBinaryModuleVersions(app.ModuleManager.GetVersionMap(), migrations)
+
 	app.UpgradeKeeper.SetUpgradeHandler(
 		UpgradeName,
 		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
@@ -49,6 +49,11 @@ type Manager struct {
 	chRestoreDone     <-chan restoreDone
 	restoreSnapshot   *types.Snapshot
 	restoreChunkIndex uint32
+
+	// wg tracks the snapshots taken in the background by SnapshotIfApplicable.
+	wg sync.WaitGroup
+	// closed is set once Close was called, no new snapshot is taken afterwards.
+	closed bool
 }
 
 // operation represents a Manager operation. Only one operation can be in progress at a time.
@@ -568,8 +573,20 @@ func (m *Manager) SnapshotIfApplicable(height int64) {
 		m.logger.Debug("snapshot is skipped", "height", height)
 		return
 	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.closed {
+		m.logger.Debug("snapshot is skipped, manager is closed", "height", height)
+		return
+	}
+
 	// start the routine after need to create a snapshot
-	go m.snapshot(height)
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.snapshot(height)
+	}()
 }
 
 // shouldTakeSnapshot returns true is snapshot should be taken at height.
@@ -606,5 +623,13 @@ func (m *Manager) snapshot(height int64) {
 	}
 }
 
-// Close the snapshot database.
-func (m *Manager) Close() error { return nil }
+// Close stops taking new snapshots and waits for the snapshot in progress, if any, to be
+// flushed to the snapshot store, so that a clean shutdown never leaves a partial snapshot.
+func (m *Manager) Close() error {
+	m.mtx.Lock()
+	m.closed = true
+	m.mtx.Unlock()
+
+	m.wg.Wait()
+	return nil
+}
@@ -3,6 +3,7 @@ package snapshots_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -254,3 +255,41 @@ func TestManager_TakeError(t *testing.T) {
 	_, err = manager.Create(1)
 	require.Error(t, err)
 }
+
+func TestManager_Close(t *testing.T) {
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	hung := newHungCommitSnapshotter()
+	manager := snapshots.NewManager(store, opts, hung, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+
+	// take a snapshot in the background, it hangs until the snapshotter is closed
+	manager.SnapshotIfApplicable(int64(opts.Interval))
+	time.Sleep(10 * time.Millisecond)
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		require.NoError(t, manager.Close())
+	}()
+
+	// close waits for the snapshot in progress to be saved
+	select {
+	case <-closed:
+		t.Fatal("close returned while a snapshot is in progress")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	hung.Close()
+	<-closed
+
+	snapshot, err := store.Get(opts.Interval, types.CurrentFormat)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+
+	// no snapshot is taken once closed
+	manager.SnapshotIfApplicable(int64(2 * opts.Interval))
+	require.NoError(t, manager.Close())
+	list, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
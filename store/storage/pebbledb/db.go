@@ -89,8 +89,15 @@ func (db *Database) SetSync(sync bool) {
 	db.sync = sync
 }
 
+// Close flushes the memtable when writes are not synced, so that no committed
+// batch is lost, and closes the database.
 func (db *Database) Close() error {
-	err := db.storage.Close()
+	var err error
+	if !db.sync {
+		err = db.storage.Flush()
+	}
+
+	err = errors.Join(err, db.storage.Close())
 	db.storage = nil
 	return err
 }
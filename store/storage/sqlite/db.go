@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 
+	"github.com/hashicorp/go-metrics"
 	_ "github.com/mattn/go-sqlite3"
 
 	corestore "cosmossdk.io/core/store"
@@ -18,7 +20,9 @@ import (
 
 const (
 	driverName       = "sqlite3"
-	dbName           = "file:ss.db?cache=shared&mode=rwc&_journal_mode=WAL"
+	dbFileName       = "file:ss.db"
+	dbName           = dbFileName + "?cache=shared&mode=rwc&_journal_mode=WAL"
+	readOnlyParams   = "?_query_only=true"
 	reservedStoreKey = "_RESERVED_"
 	keyLatestHeight  = "latest_height"
 	keyPruneHeight   = "prune_height"
@@ -49,11 +53,45 @@ type Database struct {
 	storage *sql.DB
 
 	// earliestVersion defines the earliest version set in the database, which is
-	// only updated when the database is pruned.
-	earliestVersion uint64
+	// only updated when the database is pruned. It is shared with the query
+	// database.
+	earliestVersion *atomic.Uint64
+
+	// queryDB is the database serving the queries through read-only
+	// connections, nil if no read connections are configured.
+	queryDB *Database
+	// readOnly is true for the query database.
+	readOnly bool
 }
 
+// Options defines the options of the SQLite database.
+type Options struct {
+	// ReadConnections is the maximum number of read-only connections of the
+	// query database, isolating the queries from the connections of the write
+	// path. If zero, the query database is the database itself.
+	ReadConnections int
+
+	// ReplicaPath is the path of a replica of the database file, e.g. synced
+	// through WAL shipping, that the query database reads from instead of the
+	// database file. It requires ReadConnections.
+	ReplicaPath string
+}
+
+// New returns a SQLite database stored in dataDir.
 func New(dataDir string) (*Database, error) {
+	return NewWithOptions(dataDir, Options{})
+}
+
+// NewWithOptions returns a SQLite database stored in dataDir, with a query
+// database reading through dedicated read-only connections if configured.
+func NewWithOptions(dataDir string, opts Options) (*Database, error) {
+	if opts.ReadConnections < 0 {
+		return nil, fmt.Errorf("invalid number of read connections: %d", opts.ReadConnections)
+	}
+	if opts.ReplicaPath != "" && opts.ReadConnections == 0 {
+		return nil, errors.New("a replica path requires read connections")
+	}
+
 	storage, err := sql.Open(driverName, filepath.Join(dataDir, dbName))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open sqlite DB: %w", err)
@@ -82,14 +120,107 @@ func New(dataDir string) (*Database, error) {
 		return nil, fmt.Errorf("failed to get prune height: %w", err)
 	}
 
-	return &Database{
+	db := &Database{
 		storage:         storage,
-		earliestVersion: pruneHeight + 1,
-	}, nil
+		earliestVersion: new(atomic.Uint64),
+	}
+	db.earliestVersion.Store(pruneHeight + 1)
+
+	if opts.ReadConnections > 0 {
+		path := opts.ReplicaPath
+		if path == "" {
+			path = filepath.Join(dataDir, dbFileName)
+		}
+
+		readStorage, err := sql.Open(driverName, path+readOnlyParams)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to open sqlite read connections: %w", err), storage.Close())
+		}
+		readStorage.SetMaxOpenConns(opts.ReadConnections)
+		readStorage.SetMaxIdleConns(opts.ReadConnections)
+
+		db.queryDB = &Database{
+			storage:         readStorage,
+			earliestVersion: db.earliestVersion,
+			readOnly:        true,
+		}
+	}
+
+	return db, nil
+}
+
+// QueryDatabase returns the database serving the queries, e.g. of the gRPC
+// query server. It reads through the read-only connections, from the replica
+// if configured, and is the database itself if no read connections are
+// configured. Its write methods fail, and it is closed with the database.
+func (db *Database) QueryDatabase() *Database {
+	if db.queryDB == nil {
+		return db
+	}
+
+	return db.queryDB
 }
 
+// ReplicaLag returns the number of versions the query database is behind the
+// database, always zero unless it reads from a replica.
+func (db *Database) ReplicaLag() (uint64, error) {
+	if db.queryDB == nil {
+		return 0, nil
+	}
+
+	latest, err := db.GetLatestVersion()
+	if err != nil {
+		return 0, err
+	}
+
+	replicaLatest, err := db.queryDB.GetLatestVersion()
+	if err != nil {
+		return 0, err
+	}
+
+	if replicaLatest >= latest {
+		return 0, nil
+	}
+
+	return latest - replicaLatest, nil
+}
+
+// emitQueryDatabaseMetrics sets the health gauges of the query database: its
+// replica lag and the usage of its read connections.
+func (db *Database) emitQueryDatabaseMetrics() {
+	if db.queryDB == nil {
+		return
+	}
+
+	if lag, err := db.ReplicaLag(); err == nil {
+		metrics.SetGauge([]string{"store", "sqlite", "replica_lag"}, float32(lag))
+	}
+
+	stats := db.queryDB.storage.Stats()
+	metrics.SetGauge([]string{"store", "sqlite", "read_connections", "in_use"}, float32(stats.InUse))
+	metrics.SetGauge([]string{"store", "sqlite", "read_connections", "wait_count"}, float32(stats.WaitCount))
+}
+
+// Close checkpoints the write-ahead log into the database file and closes the
+// database, so that the database file is complete on its own.
+//
+// Closing the query database is a no-op, it is closed with the database.
 func (db *Database) Close() error {
-	err := db.storage.Close()
+	if db.readOnly {
+		return nil
+	}
+
+	_, err := db.storage.Exec("PRAGMA wal_checkpoint(TRUNCATE);")
+	if err != nil {
+		err = fmt.Errorf("failed to checkpoint WAL: %w", err)
+	}
+
+	if db.queryDB != nil {
+		err = errors.Join(err, db.queryDB.storage.Close())
+		db.queryDB.storage = nil
+	}
+
+	err = errors.Join(err, db.storage.Close())
 	db.storage = nil
 	return err
 }
@@ -125,6 +256,8 @@ func (db *Database) SetLatestVersion(version uint64) error {
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
 	}
 
+	db.emitQueryDatabaseMetrics()
+
 	return nil
 }
 
@@ -138,8 +271,8 @@ func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, erro
 }
 
 func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]byte, error) {
-	if targetVersion < db.earliestVersion {
-		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion}
+	if earliestVersion := db.earliestVersion.Load(); targetVersion < earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: earliestVersion}
 	}
 
 	stmt, err := db.storage.Prepare(`
@@ -211,7 +344,7 @@ func (db *Database) Prune(version uint64) error {
 		return fmt.Errorf("failed to write SQL transaction: %w", err)
 	}
 
-	db.earliestVersion = version + 1
+	db.earliestVersion.Store(version + 1)
 
 	return nil
 }
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"fmt"
+	"path/filepath"
 	"sync"
 	"testing"
 
@@ -201,3 +202,69 @@ func TestParallelWriteAndPruning(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []byte(fmt.Sprintf("val-%d-%03d", version-1, 0)), val)
 }
+
+func TestDatabase_QueryDatabase(t *testing.T) {
+	db, err := NewWithOptions(t.TempDir(), Options{ReadConnections: 2})
+	require.NoError(t, err)
+	defer db.Close()
+
+	queryDB := db.QueryDatabase()
+	require.NotSame(t, db, queryDB)
+
+	batch, err := db.NewBatch(1)
+	require.NoError(t, err)
+	require.NoError(t, batch.Set(storeKey1, []byte("key"), []byte("val")))
+	require.NoError(t, batch.Write())
+	require.NoError(t, db.SetLatestVersion(1))
+
+	// the query database reads the writes of the database
+	val, err := queryDB.Get(storeKey1, 1, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("val"), val)
+
+	lag, err := db.ReplicaLag()
+	require.NoError(t, err)
+	require.Zero(t, lag)
+
+	// the query database is read-only
+	require.Error(t, queryDB.SetLatestVersion(2))
+
+	// the query database shares the earliest version of the database
+	require.NoError(t, db.Prune(1))
+	_, err = queryDB.Get(storeKey1, 1, []byte("key"))
+	require.Error(t, err)
+
+	// closing the query database is a no-op
+	require.NoError(t, queryDB.Close())
+	_, err = db.GetLatestVersion()
+	require.NoError(t, err)
+}
+
+func TestDatabase_ReplicaLag(t *testing.T) {
+	replicaDir := t.TempDir()
+	replica, err := New(replicaDir)
+	require.NoError(t, err)
+	require.NoError(t, replica.SetLatestVersion(1))
+	require.NoError(t, replica.Close())
+
+	db, err := NewWithOptions(t.TempDir(), Options{
+		ReadConnections: 1,
+		ReplicaPath:     filepath.Join(replicaDir, dbFileName),
+	})
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.SetLatestVersion(3))
+
+	lag, err := db.ReplicaLag()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), lag)
+}
+
+func TestNewWithOptions_Invalid(t *testing.T) {
+	_, err := NewWithOptions(t.TempDir(), Options{ReadConnections: -1})
+	require.Error(t, err)
+
+	_, err = NewWithOptions(t.TempDir(), Options{ReplicaPath: "replica.db"})
+	require.Error(t, err)
+}
@@ -22,7 +22,7 @@ type iterator struct {
 }
 
 func newIterator(db *Database, storeKey []byte, targetVersion uint64, start, end []byte, reverse bool) (*iterator, error) {
-	if targetVersion < db.earliestVersion {
+	if targetVersion < db.earliestVersion.Load() {
 		return &iterator{
 			start: start,
 			end:   end,
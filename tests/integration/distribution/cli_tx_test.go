@@ -89,10 +89,11 @@ func (s *CLITestSuite) TestTxWithdrawAllRewardsCmd() {
 		expectErrMsg string
 	}{
 		{
-			"invalid transaction (offline)",
+			"invalid transaction per validator (offline)",
 			[]string{
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagOffline),
+				fmt.Sprintf("--%s=1", cli.FlagMaxMessagesPerTx),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
 			},
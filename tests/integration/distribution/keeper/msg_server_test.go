@@ -766,10 +766,11 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:             communityTax,
+					BaseProposerReward:       math.LegacyZeroDec(),
+					BonusProposerReward:      math.LegacyZeroDec(),
+					WithdrawAddrEnabled:      withdrawAddrEnabled,
+					MaxWithdrawAllValidators: distrtypes.DefaultMaxWithdrawAllValidators,
 				},
 			},
 			expErr: false,
@@ -119,6 +119,14 @@ var (
 		GenType(&banktypes.MsgMultiSend{}, &bankapi.MsgMultiSend{}, GenOpts.WithDisallowNil()),
 		GenType(&banktypes.MsgUpdateParams{}, &bankapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
 		GenType(&banktypes.MsgSetSendEnabled{}, &bankapi.MsgSetSendEnabled{}, GenOpts),
+		GenType(&banktypes.MsgSendAndCall{}, &bankapi.MsgSendAndCall{},
+			GenOpts.WithAnyTypes(&bankapi.MsgSend{}).
+				WithDisallowNil().
+				WithInterfaceHint("cosmos.base.v1beta1.Msg", &bankapi.MsgSend{}),
+		),
+		GenType(&banktypes.MsgCreateSwap{}, &bankapi.MsgCreateSwap{}, GenOpts),
+		GenType(&banktypes.MsgAcceptSwap{}, &bankapi.MsgAcceptSwap{}, GenOpts),
+		GenType(&banktypes.MsgCancelSwap{}, &bankapi.MsgCancelSwap{}, GenOpts),
 
 		// consensus
 		GenType(&consensustypes.MsgUpdateParams{}, &consensusapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
@@ -145,6 +153,7 @@ var (
 				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
 				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
 		),
+		GenType(&feegranttypes.CategoryAllowance{}, &feegrantapi.CategoryAllowance{}, GenOpts.WithDisallowNil()),
 		GenType(&feegranttypes.MsgRevokeAllowance{}, &feegrantapi.MsgRevokeAllowance{}, GenOpts),
 
 		// gov v1beta1
@@ -96,6 +96,38 @@ func TestReflectionService(t *testing.T) {
 	})
 }
 
+func TestReflectionServiceMsgDescriptor(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	res, err := f.reflectionClient.MsgDescriptor(f.ctx, &reflectionv1.MsgDescriptorRequest{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"})
+	assert.NilError(t, err)
+	assert.Equal(t, "cosmos.bank.v1beta1.MsgSend", res.FullName)
+	assert.Equal(t, "cosmos-sdk/MsgSend", res.AminoName)
+	assert.DeepEqual(t, []string{"from_address"}, res.SignerFields)
+	assert.Assert(t, res.Routable)
+	assert.Assert(t, !res.Disabled)
+
+	assert.Equal(t, 3, len(res.Fields))
+	assert.Equal(t, "from_address", res.Fields[0].Name)
+	assert.Equal(t, "fromAddress", res.Fields[0].JsonName)
+	assert.Equal(t, "string", res.Fields[0].Kind)
+	assert.Equal(t, "cosmos.AddressString", res.Fields[0].Scalar)
+	assert.Equal(t, "amount", res.Fields[2].Name)
+	assert.Equal(t, int32(3), res.Fields[2].Number)
+	assert.Equal(t, "message", res.Fields[2].Kind)
+	assert.Equal(t, "cosmos.base.v1beta1.Coin", res.Fields[2].TypeName)
+	assert.Assert(t, res.Fields[2].Repeated)
+
+	// messages not handled by a Msg service are described but not routable
+	res, err = f.reflectionClient.MsgDescriptor(f.ctx, &reflectionv1.MsgDescriptorRequest{TypeUrl: "/cosmos.bank.v1beta1.QueryBalanceRequest"})
+	assert.NilError(t, err)
+	assert.Assert(t, !res.Routable)
+
+	_, err = f.reflectionClient.MsgDescriptor(f.ctx, &reflectionv1.MsgDescriptorRequest{TypeUrl: "/cosmos.bank.v1beta1.MsgUnknown"})
+	assert.ErrorContains(t, err, "not found")
+}
+
 func TestQueryAutoCLIAppOptions(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
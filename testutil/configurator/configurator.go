@@ -158,6 +158,7 @@ func AuthModule() ModuleOption {
 					{Account: testutil.MintModuleName, Permissions: []string{"minter"}},
 					{Account: "bonded_tokens_pool", Permissions: []string{"burner", testutil.StakingModuleName}},
 					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", testutil.StakingModuleName}},
+					{Account: "epoch_escrow_pool", Permissions: []string{testutil.StakingModuleName}},
 					{Account: testutil.GovModuleName, Permissions: []string{"burner"}},
 					{Account: testutil.NFTModuleName},
 					{Account: testutil.ProtocolPoolModuleName},
@@ -108,6 +108,19 @@ func CheckExportSimulation(app runtime.AppSimI, config simtypes.Config, params s
 	return nil
 }
 
+// AppStateExportFn returns a function exporting the app state at the current
+// height, to be included in simulation reproduction bundles.
+func AppStateExportFn(app runtime.AppSimI) simtypes.AppStateExportFn {
+	return func() (json.RawMessage, error) {
+		exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return exported.AppState, nil
+	}
+}
+
 // PrintStats prints the corresponding statistics from the app DB.
 func PrintStats(db dbm.DB) {
 	fmt.Println("\nLevelDB Stats")
@@ -0,0 +1,126 @@
+// Package gasprice tracks the gas prices paid by the transactions included in the most
+// recent blocks, so that a node can suggest gas prices to its clients.
+//
+// The tracker is local to the node and does not affect consensus.
+package gasprice
+
+import (
+	"sort"
+	"sync"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBlocks is the default number of recent blocks the gas prices are tracked over.
+	DefaultBlocks = 20
+
+	// SlowPercentile is the percentile of the recent gas prices suggested for a slow inclusion.
+	SlowPercentile = 25
+	// AveragePercentile is the percentile of the recent gas prices suggested for an average inclusion.
+	AveragePercentile = 50
+	// FastPercentile is the percentile of the recent gas prices suggested for a fast inclusion.
+	FastPercentile = 90
+)
+
+// Percentiles holds the gas price percentiles paid in a denom.
+type Percentiles struct {
+	Denom   string
+	Txs     uint64
+	Slow    math.LegacyDec
+	Average math.LegacyDec
+	Fast    math.LegacyDec
+}
+
+// blockGasPrices holds the gas prices paid per denom by the transactions of a block.
+type blockGasPrices struct {
+	height int64
+	prices map[string][]math.LegacyDec
+}
+
+// Tracker records the gas prices paid by the transactions included in the most recent
+// blocks. It is safe for concurrent use.
+type Tracker struct {
+	mtx       sync.Mutex
+	maxBlocks int
+	blocks    []blockGasPrices
+}
+
+// NewTracker returns a tracker of the gas prices paid over the given number of recent blocks.
+func NewTracker(blocks int) *Tracker {
+	if blocks <= 0 {
+		blocks = DefaultBlocks
+	}
+
+	return &Tracker{maxBlocks: blocks}
+}
+
+// Record records the gas prices paid by a transaction included in the block at the given
+// height. Transactions without gas limit or fees are ignored.
+func (t *Tracker) Record(height int64, fee sdk.Coins, gas uint64) {
+	if gas == 0 || fee.IsZero() {
+		return
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if len(t.blocks) == 0 || t.blocks[len(t.blocks)-1].height != height {
+		t.blocks = append(t.blocks, blockGasPrices{height: height, prices: make(map[string][]math.LegacyDec)})
+		if len(t.blocks) > t.maxBlocks {
+			t.blocks = t.blocks[len(t.blocks)-t.maxBlocks:]
+		}
+	}
+
+	block := t.blocks[len(t.blocks)-1]
+	gasLimit := math.NewIntFromUint64(gas)
+	for _, coin := range fee {
+		block.prices[coin.Denom] = append(block.prices[coin.Denom], math.LegacyNewDecFromInt(coin.Amount).QuoInt(gasLimit))
+	}
+}
+
+// Percentiles returns the number of blocks tracked and the gas price percentiles paid per
+// denom over these blocks, ordered by decreasing number of transactions paying in the denom.
+func (t *Tracker) Percentiles() (uint64, []Percentiles) {
+	t.mtx.Lock()
+	prices := make(map[string][]math.LegacyDec)
+	for _, block := range t.blocks {
+		for denom, p := range block.prices {
+			prices[denom] = append(prices[denom], p...)
+		}
+	}
+	blocks := uint64(len(t.blocks))
+	t.mtx.Unlock()
+
+	percentiles := make([]Percentiles, 0, len(prices))
+	for denom, p := range prices {
+		sort.Slice(p, func(i, j int) bool { return p[i].LT(p[j]) })
+		percentiles = append(percentiles, Percentiles{
+			Denom:   denom,
+			Txs:     uint64(len(p)),
+			Slow:    percentile(p, SlowPercentile),
+			Average: percentile(p, AveragePercentile),
+			Fast:    percentile(p, FastPercentile),
+		})
+	}
+
+	sort.Slice(percentiles, func(i, j int) bool {
+		if percentiles[i].Txs != percentiles[j].Txs {
+			return percentiles[i].Txs > percentiles[j].Txs
+		}
+		return percentiles[i].Denom < percentiles[j].Denom
+	})
+
+	return blocks, percentiles
+}
+
+// percentile returns the nearest-rank percentile p of the sorted prices.
+func percentile(sorted []math.LegacyDec, p int) math.LegacyDec {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,56 @@
+package gasprice_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/gasprice"
+)
+
+func TestTracker(t *testing.T) {
+	tracker := gasprice.NewTracker(2)
+
+	blocks, percentiles := tracker.Percentiles()
+	require.Zero(t, blocks)
+	require.Empty(t, percentiles)
+
+	// ignored: no fee or no gas
+	tracker.Record(1, nil, 100)
+	tracker.Record(1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 0)
+	blocks, _ = tracker.Percentiles()
+	require.Zero(t, blocks)
+
+	// block 1 is evicted once blocks 2 and 3 are tracked
+	tracker.Record(1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100000)), 100)
+	for i := int64(1); i <= 10; i++ {
+		height := int64(2)
+		if i > 5 {
+			height = 3
+		}
+		tracker.Record(height, sdk.NewCoins(sdk.NewInt64Coin("stake", i*100)), 100)
+	}
+	tracker.Record(3, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 100)), 10)
+
+	blocks, percentiles = tracker.Percentiles()
+	require.Equal(t, uint64(2), blocks)
+	require.Equal(t, []gasprice.Percentiles{
+		{
+			Denom:   "stake",
+			Txs:     11,
+			Slow:    math.LegacyNewDec(3),
+			Average: math.LegacyNewDec(6),
+			Fast:    math.LegacyNewDec(10),
+		},
+		{
+			Denom:   "atom",
+			Txs:     1,
+			Slow:    math.LegacyNewDecWithPrec(5, 1),
+			Average: math.LegacyNewDecWithPrec(5, 1),
+			Fast:    math.LegacyNewDecWithPrec(5, 1),
+		},
+	}, percentiles)
+}
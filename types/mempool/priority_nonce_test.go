@@ -81,6 +81,37 @@ func (a signerExtractionAdapter) GetSigners(tx sdk.Tx) ([]mempool.SignerData, er
 	return signerData, nil
 }
 
+// laneTx is a testTx sent on a nonce lane of its signer.
+type laneTx struct {
+	testTx
+	lane uint64
+}
+
+func (tx laneTx) GetLane() uint64 { return tx.lane }
+
+func TestNonceLanes(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+
+	for name, pool := range map[string]mempool.Mempool{
+		"priority nonce": mempool.DefaultPriorityMempool(),
+		"sender nonce":   mempool.NewSenderNonceMempool(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			// the transactions of the lanes of a signer do not replace each other
+			for lane := uint64(0); lane < 3; lane++ {
+				tx := laneTx{testTx: testTx{id: int(lane), nonce: 0, address: accounts[0].Address}, lane: lane}
+				require.NoError(t, pool.Insert(ctx, tx))
+			}
+			require.Equal(t, 3, pool.CountTx())
+
+			require.NoError(t, pool.Remove(laneTx{testTx: testTx{nonce: 0, address: accounts[0].Address}, lane: 1}))
+			require.Equal(t, 2, pool.CountTx())
+			require.ErrorIs(t, pool.Remove(laneTx{testTx: testTx{nonce: 0, address: accounts[0].Address}, lane: 1}), mempool.ErrTxNotFound)
+		})
+	}
+}
+
 func (s *MempoolTestSuite) TestPriorityNonceTxOrderWithAdapter() {
 	t := s.T()
 	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
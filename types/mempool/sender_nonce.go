@@ -137,7 +137,7 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 	}
 
 	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
+	sender := senderKey(sig.PubKey.Address().Bytes(), txLane(tx))
 	nonce := sig.Sequence
 
 	senderTxs, found := snm.senders[sender]
@@ -210,7 +210,7 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 	}
 
 	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
+	sender := senderKey(sig.PubKey.Address().Bytes(), txLane(tx))
 	nonce := sig.Sequence
 
 	senderTxs, found := snm.senders[sender]
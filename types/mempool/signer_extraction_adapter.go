@@ -12,6 +12,8 @@ import (
 type SignerData struct {
 	Signer   sdk.AccAddress
 	Sequence uint64
+	// Lane is the nonce lane of the signer the sequence belongs to.
+	Lane uint64
 }
 
 // NewSignerData returns a new SignerData instance.
@@ -27,6 +29,28 @@ func (s SignerData) String() string {
 	return fmt.Sprintf("SignerData{Signer: %s, Sequence: %d}", s.Signer, s.Sequence)
 }
 
+// senderKey returns the key under which the mempools order the transactions of
+// the signer, the nonce lanes of a signer being independent senders.
+func (s SignerData) senderKey() string {
+	return senderKey(s.Signer, s.Lane)
+}
+
+func senderKey(signer sdk.AccAddress, lane uint64) string {
+	if lane == 0 {
+		return signer.String()
+	}
+	return fmt.Sprintf("%s/%d", signer, lane)
+}
+
+// txLane returns the nonce lane the transaction is sent on.
+func txLane(tx sdk.Tx) uint64 {
+	laneTx, ok := tx.(sdk.TxWithLane)
+	if !ok {
+		return 0
+	}
+	return laneTx.GetLane()
+}
+
 // SignerExtractionAdapter is an interface used to determine how the signers of a transaction should be extracted
 // from the transaction.
 type SignerExtractionAdapter interface {
@@ -56,12 +80,14 @@ func (DefaultSignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]SignerData, error
 		return nil, err
 	}
 
+	lane := txLane(tx)
 	signers := make([]SignerData, len(sigs))
 	for i, sig := range sigs {
 		signers[i] = NewSignerData(
 			sig.PubKey.Address().Bytes(),
 			sig.Sequence,
 		)
+		signers[i].Lane = lane
 	}
 
 	return signers, nil
@@ -872,6 +872,31 @@ func (m *Manager) GetVersionMap() VersionMap {
 	return vermap
 }
 
+// GetMigrationVersions returns, for each module of the manager having in-place
+// store migrations registered in the configurator, the sorted versions the
+// migrations start from. A module at a version can be migrated by RunMigrations
+// to its consensus version when a migration is registered from that version and
+// from each version up to the consensus version.
+func (m *Manager) GetMigrationVersions(cfg Configurator) (map[string][]uint64, error) {
+	c, ok := cfg.(*configurator)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &configurator{}, cfg)
+	}
+
+	versions := make(map[string][]uint64)
+	for name := range m.Modules {
+		migrations := c.migrations[name]
+		if len(migrations) == 0 {
+			continue
+		}
+
+		versions[name] = maps.Keys(migrations)
+		sort.Slice(versions[name], func(i, j int) bool { return versions[name][i] < versions[name][j] })
+	}
+
+	return versions, nil
+}
+
 // ModuleNames returns list of all module names, without any particular order.
 func (m *Manager) ModuleNames() []string {
 	return maps.Keys(m.Modules)
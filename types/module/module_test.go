@@ -295,6 +295,24 @@ func TestCoreAPIManager(t *testing.T) {
 	require.Equal(t, module2, mm.Modules["module2"])
 }
 
+func TestManager_GetMigrationVersions(t *testing.T) {
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": MockCoreAppModule{},
+		"module2": MockCoreAppModule{},
+	})
+
+	cfg := module.NewConfigurator(nil, nil, nil)
+	noop := func(sdk.Context) error { return nil }
+	require.NoError(t, cfg.RegisterMigration("module1", 3, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	// migrations of modules missing from the manager are ignored
+	require.NoError(t, cfg.RegisterMigration("module3", 1, noop))
+
+	versions, err := mm.GetMigrationVersions(cfg)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]uint64{"module1": {1, 3}}, versions)
+}
+
 func TestCoreAPIManager_InitGenesis(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -9,6 +9,7 @@ type Config struct {
 	ExportParamsHeight int    // height to which export the randomly generated params
 	ExportStatePath    string // custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	ReproBundleDir     string // custom directory to write a reproduction bundle to when the simulation fails
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
@@ -0,0 +1,76 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ReproBundle contains everything needed to deterministically rerun a failed
+// simulation: the seed and config it was started with, the randomized params,
+// the height it failed at, the exported app state at that height and the tail
+// of the operation log.
+type ReproBundle struct {
+	Seed      int64             `json:"seed"`
+	Config    Config            `json:"config"`
+	Params    json.RawMessage   `json:"params"`
+	Height    int64             `json:"height"`
+	Failure   string            `json:"failure"`
+	AppState  json.RawMessage   `json:"app_state,omitempty"`
+	OpLogTail []json.RawMessage `json:"op_log_tail"`
+}
+
+// ReplayConfig returns the simulation config that reruns the bundle up to and
+// including the failing block. Exports are disabled so a replay never
+// overwrites the artifacts of the original run.
+func (b ReproBundle) ReplayConfig() Config {
+	config := b.Config
+	config.Seed = b.Seed
+	config.ExportParamsPath = ""
+	config.ExportStatePath = ""
+	config.ExportStatsPath = ""
+	config.ReproBundleDir = ""
+
+	if numBlocks := int(b.Height) - config.InitialBlockHeight + 1; numBlocks > 0 && numBlocks < config.NumBlocks {
+		config.NumBlocks = numBlocks
+	}
+
+	return config
+}
+
+// WriteReproBundle writes the bundle as JSON to the given directory and returns
+// the path of the created file.
+func WriteReproBundle(dir string, bundle ReproBundle) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	bz, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("sim-repro-%d-%d.json", bundle.Seed, bundle.Height))
+	if err := os.WriteFile(path, bz, 0o600); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// ReadReproBundle reads a bundle previously written by WriteReproBundle.
+func ReadReproBundle(path string) (ReproBundle, error) {
+	var bundle ReproBundle
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return bundle, err
+	}
+
+	if err := json.Unmarshal(bz, &bundle); err != nil {
+		return bundle, fmt.Errorf("failed to decode reproduction bundle %s: %w", path, err)
+	}
+
+	return bundle, nil
+}
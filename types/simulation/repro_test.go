@@ -0,0 +1,55 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestReproBundleRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	bundle := simulation.ReproBundle{
+		Seed: 7,
+		Config: simulation.Config{
+			Seed:               7,
+			InitialBlockHeight: 1,
+			NumBlocks:          500,
+			BlockSize:          200,
+			GenesisTime:        1700000000,
+			ChainID:            "sim",
+			ExportStatePath:    "state.json",
+			ReproBundleDir:     dir,
+		},
+		Params:    json.RawMessage(`{"num_keys":10}`),
+		Height:    42,
+		Failure:   "boom",
+		AppState:  json.RawMessage(`{"bank":{}}`),
+		OpLogTail: []json.RawMessage{json.RawMessage(`{"entry_kind":"begin_block","height":42}`)},
+	}
+
+	path, err := simulation.WriteReproBundle(dir, bundle)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "sim-repro-7-42.json"), path)
+
+	got, err := simulation.ReadReproBundle(path)
+	require.NoError(t, err)
+	require.Equal(t, bundle.Config, got.Config)
+	require.Equal(t, bundle.Height, got.Height)
+	require.Equal(t, bundle.Failure, got.Failure)
+	require.JSONEq(t, string(bundle.AppState), string(got.AppState))
+	require.Len(t, got.OpLogTail, 1)
+
+	replay := got.ReplayConfig()
+	require.Equal(t, int64(7), replay.Seed)
+	require.Equal(t, 42, replay.NumBlocks)
+	require.Equal(t, int64(1700000000), replay.GenesisTime)
+	require.Empty(t, replay.ExportStatePath)
+	require.Empty(t, replay.ReproBundleDir)
+
+	_, err = simulation.ReadReproBundle(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
@@ -180,6 +180,18 @@ type AppStateFn func(r *rand.Rand, accs []Account, config Config) (
 	appState json.RawMessage, accounts []Account, chainId string, genesisTimestamp time.Time,
 )
 
+// AppStateExportFn returns the exported app state json bytes at the current height
+type AppStateExportFn func() (json.RawMessage, error)
+
+// TB is the subset of testing.TB used by the simulation to report failures. It
+// is implemented by *testing.T and *testing.B, and by the harness replaying a
+// failed simulation outside of go test.
+type TB interface {
+	Helper()
+	Fatalf(format string, args ...any)
+	FailNow()
+}
+
 // RandomAccountFn returns a slice of n random simulation accounts
 type RandomAccountFn func(r *rand.Rand, n int) []Account
 
@@ -0,0 +1,183 @@
+// Package storestats reports the size of the state of each store of a multistore:
+// its number of keys, its total size in bytes and its largest entries, and the
+// growth since a previous report, so that operators can attribute state growth
+// to the modules owning the stores.
+//
+// Stores are walked with the core store iterators, implemented by both the
+// store v1 KV stores and the store/v2 state storage.
+package storestats
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/iavl"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// DefaultTop is the default number of largest entries reported per store.
+const DefaultTop = 10
+
+// KeyStats is the size of an entry of a store.
+type KeyStats struct {
+	// Key is the hex encoded key of the entry.
+	Key string `json:"key"`
+	// Bytes is the size of the key and of the value of the entry.
+	Bytes int64 `json:"bytes"`
+}
+
+// Growth is the difference between the size of a store and its size in a
+// previous report. It is negative when the store shrank.
+type Growth struct {
+	Keys  int64 `json:"keys"`
+	Bytes int64 `json:"bytes"`
+}
+
+// StoreStats is the size of the state of a store.
+type StoreStats struct {
+	Name        string     `json:"name"`
+	Keys        int64      `json:"keys"`
+	Bytes       int64      `json:"bytes"`
+	LargestKeys []KeyStats `json:"largest_keys"`
+	// Growth is set by Compare.
+	Growth *Growth `json:"growth,omitempty"`
+}
+
+// Stats is the size of the state of the stores of a multistore at a height.
+type Stats struct {
+	Height int64        `json:"height"`
+	Stores []StoreStats `json:"stores"`
+	// PreviousHeight is the height of the report the growth is computed
+	// against, set by Compare.
+	PreviousHeight int64 `json:"previous_height,omitempty"`
+}
+
+// Collect walks the given iterator and returns the size of the entries it
+// yields, reporting the top largest entries. The iterator is closed.
+func Collect(name string, it corestore.Iterator, top int) (stats StoreStats, err error) {
+	defer func() {
+		if cerr := it.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	stats = StoreStats{Name: name, LargestKeys: []KeyStats{}}
+	var largest []entry
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		size := int64(len(key) + len(it.Value()))
+		stats.Keys++
+		stats.Bytes += size
+		largest = insertLargest(largest, entry{key: key, size: size}, top)
+	}
+	if err := it.Error(); err != nil {
+		return StoreStats{}, fmt.Errorf("iterating store %s: %w", name, err)
+	}
+
+	for _, e := range largest {
+		stats.LargestKeys = append(stats.LargestKeys, KeyStats{Key: hex.EncodeToString(e.key), Bytes: e.size})
+	}
+
+	return stats, nil
+}
+
+// CollectMultiStore returns the size of the persistent stores of a multistore at
+// the given height, or at its latest height if height is 0. IAVL stores are read
+// from their immutable tree at that height, so that the stats can be collected
+// while blocks are being committed.
+func CollectMultiStore(cms storetypes.CommitMultiStore, height int64, top int) (Stats, error) {
+	lister, ok := cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return Stats{}, fmt.Errorf("multistore %T does not list its stores", cms)
+	}
+
+	if height == 0 {
+		height = cms.LastCommitID().Version
+	}
+	if height <= 0 {
+		return Stats{}, fmt.Errorf("invalid height %d, the store has no committed state", height)
+	}
+
+	names := make([]string, 0)
+	keys := lister.StoreKeysByName()
+	for name, key := range keys {
+		// transient and memory stores are not persisted
+		if _, ok := key.(*storetypes.KVStoreKey); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	stats := Stats{Height: height, Stores: make([]StoreStats, 0, len(names))}
+	for _, name := range names {
+		var store storetypes.KVStore = cms.GetCommitKVStore(keys[name])
+		if iavlStore, ok := store.(*iavl.Store); ok {
+			immutable, err := iavlStore.GetImmutable(height)
+			if err != nil {
+				return Stats{}, fmt.Errorf("store %s: %w", name, err)
+			}
+			store = immutable
+		}
+
+		storeStats, err := Collect(name, store.Iterator(nil, nil), top)
+		if err != nil {
+			return Stats{}, err
+		}
+		stats.Stores = append(stats.Stores, storeStats)
+	}
+
+	return stats, nil
+}
+
+// Compare sets the growth of the stores since the previous report. Stores
+// missing from the previous report grow by their whole size.
+func (s *Stats) Compare(previous Stats) {
+	prev := make(map[string]StoreStats, len(previous.Stores))
+	for _, store := range previous.Stores {
+		prev[store.Name] = store
+	}
+
+	s.PreviousHeight = previous.Height
+	for i, store := range s.Stores {
+		p := prev[store.Name]
+		s.Stores[i].Growth = &Growth{
+			Keys:  store.Keys - p.Keys,
+			Bytes: store.Bytes - p.Bytes,
+		}
+	}
+}
+
+// entry is an entry of a store, by size.
+type entry struct {
+	key  []byte
+	size int64
+}
+
+// insertLargest inserts e into largest, sorted by decreasing size and then by
+// key, keeping at most top entries.
+func insertLargest(largest []entry, e entry, top int) []entry {
+	i := sort.Search(len(largest), func(i int) bool {
+		if largest[i].size != e.size {
+			return largest[i].size < e.size
+		}
+		return bytes.Compare(largest[i].key, e.key) > 0
+	})
+	if i >= top {
+		return largest
+	}
+
+	// iterators may reuse the key buffer
+	largest = append(largest, entry{})
+	copy(largest[i+1:], largest[i:])
+	largest[i] = entry{key: bytes.Clone(e.key), size: e.size}
+	if len(largest) > top {
+		largest = largest[:top]
+	}
+
+	return largest
+}
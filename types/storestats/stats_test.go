@@ -0,0 +1,94 @@
+package storestats_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/storestats"
+)
+
+func TestCollectMultiStore(t *testing.T) {
+	bankKey := storetypes.NewKVStoreKey("bank")
+	stakingKey := storetypes.NewKVStoreKey("staking")
+	transientKey := storetypes.NewTransientStoreKey("transient_params")
+
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(stakingKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(transientKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	bank := cms.GetKVStore(bankKey)
+	bank.Set([]byte{0x01}, []byte("a"))
+	bank.Set([]byte{0x02}, bytes.Repeat([]byte("b"), 10))
+	staking := cms.GetKVStore(stakingKey)
+	staking.Set([]byte{0x50}, bytes.Repeat([]byte("c"), 4))
+	cms.GetKVStore(transientKey).Set([]byte{0x01}, []byte("d"))
+	cms.Commit()
+
+	previous, err := storestats.CollectMultiStore(cms, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, storestats.Stats{
+		Height: 1,
+		Stores: []storestats.StoreStats{
+			{
+				Name: "bank", Keys: 2, Bytes: 13,
+				LargestKeys: []storestats.KeyStats{{Key: hex.EncodeToString([]byte{0x02}), Bytes: 11}},
+			},
+			{
+				Name: "staking", Keys: 1, Bytes: 5,
+				LargestKeys: []storestats.KeyStats{{Key: hex.EncodeToString([]byte{0x50}), Bytes: 5}},
+			},
+		},
+	}, previous)
+
+	bank.Delete([]byte{0x01})
+	staking.Set([]byte{0x51}, bytes.Repeat([]byte("e"), 20))
+	cms.Commit()
+
+	stats, err := storestats.CollectMultiStore(cms, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stats.Height)
+	require.Equal(t, []storestats.KeyStats{
+		{Key: hex.EncodeToString([]byte{0x51}), Bytes: 21},
+		{Key: hex.EncodeToString([]byte{0x50}), Bytes: 5},
+	}, stats.Stores[1].LargestKeys)
+
+	stats.Compare(previous)
+	require.Equal(t, int64(1), stats.PreviousHeight)
+	require.Equal(t, &storestats.Growth{Keys: -1, Bytes: -2}, stats.Stores[0].Growth)
+	require.Equal(t, &storestats.Growth{Keys: 1, Bytes: 21}, stats.Stores[1].Growth)
+
+	// past heights are still reported
+	past, err := storestats.CollectMultiStore(cms, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, previous, past)
+}
+
+func TestCollectLargestKeysTies(t *testing.T) {
+	db := dbm.NewMemDB()
+	for _, key := range []string{"c", "a", "b", "dd"} {
+		require.NoError(t, db.Set([]byte(key), []byte("v")))
+	}
+
+	it, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	stats, err := storestats.Collect("test", it, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), stats.Keys)
+	require.Equal(t, int64(9), stats.Bytes)
+	require.Equal(t, []storestats.KeyStats{
+		{Key: hex.EncodeToString([]byte("dd")), Bytes: 3},
+		{Key: hex.EncodeToString([]byte("a")), Bytes: 2},
+		{Key: hex.EncodeToString([]byte("b")), Bytes: 2},
+	}, stats.LargestKeys)
+}
@@ -4,6 +4,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
 
+// ClientMetadataTypeURL is the type URL of the ClientMetadata non-critical
+// extension option.
+const ClientMetadataTypeURL = "/cosmos.tx.v1beta1.ClientMetadata"
+
 // TxExtensionOptionI defines the interface for tx extension options
 type TxExtensionOptionI interface{}
 
@@ -19,3 +23,32 @@ func unpackTxExtensionOptionsI(unpacker types.AnyUnpacker, anys []*types.Any) er
 
 	return nil
 }
+
+// NewClientMetadataOption packs the client metadata into an extension option,
+// to be set as a non-critical extension option of a transaction.
+func NewClientMetadataOption(walletName, walletVersion, locale string) (*types.Any, error) {
+	return types.NewAnyWithValue(&ClientMetadata{
+		WalletName:    walletName,
+		WalletVersion: walletVersion,
+		Locale:        locale,
+	})
+}
+
+// GetClientMetadata returns the first ClientMetadata found in the given
+// non-critical extension options, or nil if there is none or it cannot be
+// decoded.
+func GetClientMetadata(nonCriticalExtOpts []*types.Any) *ClientMetadata {
+	for _, opt := range nonCriticalExtOpts {
+		if opt == nil || opt.TypeUrl != ClientMetadataTypeURL {
+			continue
+		}
+
+		var metadata ClientMetadata
+		if err := metadata.Unmarshal(opt.Value); err != nil {
+			return nil
+		}
+		return &metadata
+	}
+
+	return nil
+}
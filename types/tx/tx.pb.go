@@ -481,6 +481,16 @@ type AuthInfo struct {
 	//
 	// Since: cosmos-sdk 0.46
 	Tip *Tip `protobuf:"bytes,3,opt,name=tip,proto3" json:"tip,omitempty"` // Deprecated: Do not use.
+	// lane is the nonce lane of the transaction. The signers of a transaction
+	// sent on a non-zero lane sign with the sequence of that lane instead of their
+	// account sequence, each lane of an account being an independent ordered
+	// stream of transactions. Lane 0 is the account sequence.
+	//
+	// Only SIGN_MODE_DIRECT signs over the lane: the signers of a transaction on a
+	// non-zero lane must use it.
+	//
+	// Since: cosmos-sdk 0.51
+	Lane uint64 `protobuf:"varint,4,opt,name=lane,proto3" json:"lane,omitempty"`
 }
 
 func (m *AuthInfo) Reset()         { *m = AuthInfo{} }
@@ -538,6 +548,13 @@ func (m *AuthInfo) GetTip() *Tip {
 	return nil
 }
 
+func (m *AuthInfo) GetLane() uint64 {
+	if m != nil {
+		return m.Lane
+	}
+	return 0
+}
+
 // SignerInfo describes the public key and signing mode of a single top-level
 // signer.
 type SignerInfo struct {
@@ -614,7 +631,6 @@ type ModeInfo struct {
 	// multisig signer
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*ModeInfo_Single_
 	//	*ModeInfo_Multi_
 	Sum isModeInfo_Sum `protobuf_oneof:"sum"`
@@ -1025,6 +1041,83 @@ func (m *AuxSignerData) GetSig() []byte {
 	return nil
 }
 
+// ClientMetadata is a standard non-critical extension option describing the
+// client which built the transaction, so that the adoption of wallets can be
+// measured. It is meant to be set in TxBody.non_critical_extension_options.
+// It is informational only and does not change how the transaction is
+// executed. It is signed over by the sign modes which sign the body bytes, and
+// left out of the SIGN_MODE_TEXTUAL screens, which are still bound by the hash
+// of these bytes. LEGACY_AMINO_JSON cannot sign it, so it rejects transactions
+// carrying it, like any other extension option.
+//
+// Fields added to ClientMetadata must use field numbers with bit 11 set
+// (i.e. 1024 and above), so that nodes which do not know them yet accept them
+// as unknown non-critical fields.
+//
+// Since: cosmos-sdk 0.51
+type ClientMetadata struct {
+	// wallet_name is the name of the wallet which built the transaction.
+	WalletName string `protobuf:"bytes,1,opt,name=wallet_name,json=walletName,proto3" json:"wallet_name,omitempty"`
+	// wallet_version is the version of the wallet which built the transaction.
+	WalletVersion string `protobuf:"bytes,2,opt,name=wallet_version,json=walletVersion,proto3" json:"wallet_version,omitempty"`
+	// locale is the BCP 47 language tag of the locale of the wallet user.
+	Locale string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (m *ClientMetadata) Reset()         { *m = ClientMetadata{} }
+func (m *ClientMetadata) String() string { return proto.CompactTextString(m) }
+func (*ClientMetadata) ProtoMessage()    {}
+func (*ClientMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96d1575ffde80842, []int{11}
+}
+func (m *ClientMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientMetadata.Merge(m, src)
+}
+func (m *ClientMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientMetadata proto.InternalMessageInfo
+
+func (m *ClientMetadata) GetWalletName() string {
+	if m != nil {
+		return m.WalletName
+	}
+	return ""
+}
+
+func (m *ClientMetadata) GetWalletVersion() string {
+	if m != nil {
+		return m.WalletVersion
+	}
+	return ""
+}
+
+func (m *ClientMetadata) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.v1beta1.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.v1beta1.TxRaw")
@@ -1039,80 +1132,85 @@ func init() {
 	proto.RegisterType((*Fee)(nil), "cosmos.tx.v1beta1.Fee")
 	proto.RegisterType((*Tip)(nil), "cosmos.tx.v1beta1.Tip")
 	proto.RegisterType((*AuxSignerData)(nil), "cosmos.tx.v1beta1.AuxSignerData")
+	proto.RegisterType((*ClientMetadata)(nil), "cosmos.tx.v1beta1.ClientMetadata")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1076 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x64, 0x14, 0xfd, 0xe5, 0x38, 0xff, 0xba, 0xc1,
-	0x55, 0xc1, 0xaa, 0xc8, 0x6e, 0x9b, 0x1e, 0x28, 0x15, 0x02, 0xec, 0x86, 0x28, 0x55, 0x29, 0x48,
-	0x93, 0x9c, 0x7a, 0x59, 0x8d, 0x77, 0x27, 0xeb, 0x51, 0xbd, 0x33, 0xcb, 0xce, 0x2c, 0x78, 0x8f,
-	0x7c, 0x00, 0xa4, 0x8a, 0x0b, 0x12, 0x67, 0x0e, 0x88, 0x53, 0x25, 0x10, 0x9f, 0xa1, 0x27, 0x54,
-	0x71, 0xe2, 0x04, 0x55, 0x72, 0xe8, 0x9d, 0x2f, 0x00, 0xda, 0xd9, 0x59, 0x27, 0x2d, 0xa9, 0x53,
-	0x04, 0x12, 0x17, 0x7b, 0xe6, 0xed, 0xef, 0xbd, 0xf9, 0xbd, 0x37, 0xbf, 0x79, 0x0f, 0x3a, 0xbe,
-	0x90, 0x91, 0x90, 0xae, 0x9a, 0xba, 0x9f, 0x5e, 0x1f, 0x51, 0x45, 0xae, 0xbb, 0x6a, 0xea, 0xc4,
-	0x89, 0x50, 0x02, 0xad, 0x14, 0xdf, 0x1c, 0x35, 0x75, 0xcc, 0xb7, 0xce, 0x0a, 0x89, 0x18, 0x17,
-	0xae, 0xfe, 0x2d, 0x50, 0x9d, 0xd5, 0x50, 0x84, 0x42, 0x2f, 0xdd, 0x7c, 0x65, 0xac, 0x9b, 0x26,
-	0xae, 0x9f, 0x64, 0xb1, 0x12, 0x6e, 0x94, 0x4e, 0x14, 0x93, 0x2c, 0x9c, 0x1d, 0x52, 0x1a, 0x0c,
-	0xbc, 0x6b, 0xe0, 0x23, 0x22, 0xe9, 0x0c, 0xe3, 0x0b, 0xc6, 0xcd, 0xf7, 0x37, 0x8e, 0x69, 0x4a,
-	0x16, 0x72, 0xc6, 0x8f, 0x23, 0x99, 0xbd, 0x01, 0xae, 0x85, 0x42, 0x84, 0x13, 0xea, 0xea, 0xdd,
-	0x28, 0x3d, 0x70, 0x09, 0xcf, 0xca, 0x4f, 0x45, 0x0c, 0xaf, 0xe0, 0x6a, 0x72, 0xd3, 0x9b, 0xde,
-	0x17, 0x16, 0x54, 0xf7, 0xa7, 0x68, 0x13, 0x6a, 0x23, 0x11, 0x64, 0x6d, 0x6b, 0xc3, 0xea, 0x9f,
-	0xdb, 0x5a, 0x73, 0xfe, 0x92, 0xbf, 0xb3, 0x3f, 0x1d, 0x8a, 0x20, 0xc3, 0x1a, 0x86, 0x6e, 0x42,
-	0x8b, 0xa4, 0x6a, 0xec, 0x31, 0x7e, 0x20, 0xda, 0x55, 0xed, 0xb3, 0x7e, 0x8a, 0xcf, 0x20, 0x55,
-	0xe3, 0x3b, 0xfc, 0x40, 0xe0, 0x26, 0x31, 0x2b, 0xd4, 0x05, 0xc8, 0x69, 0x13, 0x95, 0x26, 0x54,
-	0xb6, 0xed, 0x0d, 0xbb, 0xbf, 0x88, 0x4f, 0x58, 0x7a, 0x1c, 0xea, 0xfb, 0x53, 0x4c, 0x3e, 0x43,
-	0x17, 0x01, 0xf2, 0xa3, 0xbc, 0x51, 0xa6, 0xa8, 0xd4, 0xbc, 0x16, 0x71, 0x2b, 0xb7, 0x0c, 0x73,
-	0x03, 0x7a, 0x1d, 0x2e, 0xcc, 0x18, 0x18, 0x4c, 0x55, 0x63, 0x96, 0xca, 0xa3, 0x0a, 0xdc, 0x59,
-	0xe7, 0x7d, 0x69, 0xc1, 0xc2, 0x1e, 0x0b, 0xf9, 0xb6, 0xf0, 0xff, 0xad, 0x23, 0xd7, 0xa0, 0xe9,
-	0x8f, 0x09, 0xe3, 0x1e, 0x0b, 0xda, 0xf6, 0x86, 0xd5, 0x6f, 0xe1, 0x05, 0xbd, 0xbf, 0x13, 0xa0,
-	0x2b, 0x70, 0x9e, 0xf8, 0xbe, 0x48, 0xb9, 0xf2, 0x78, 0x1a, 0x8d, 0x68, 0xd2, 0xae, 0x6d, 0x58,
-	0xfd, 0x1a, 0x5e, 0x32, 0xd6, 0x8f, 0xb4, 0xb1, 0xf7, 0xbb, 0x05, 0xcb, 0x86, 0xd4, 0x36, 0x4b,
-	0xa8, 0xaf, 0x06, 0xe9, 0xf4, 0x2c, 0x76, 0x37, 0x00, 0xe2, 0x74, 0x34, 0x61, 0xbe, 0xf7, 0x80,
-	0x66, 0xe6, 0x4e, 0x56, 0x9d, 0x42, 0x13, 0x4e, 0xa9, 0x09, 0x67, 0xc0, 0x33, 0xdc, 0x2a, 0x70,
-	0x77, 0x69, 0xf6, 0xcf, 0xa9, 0xa2, 0x0e, 0x34, 0x25, 0xfd, 0x24, 0xa5, 0xdc, 0xa7, 0xed, 0xba,
-	0x06, 0xcc, 0xf6, 0xe8, 0x4d, 0xb0, 0x15, 0x8b, 0xdb, 0x0d, 0xcd, 0xe5, 0x7f, 0xa7, 0x69, 0x8a,
-	0xc5, 0xc3, 0x6a, 0xdb, 0xc2, 0x39, 0xac, 0xf7, 0x7d, 0x15, 0x1a, 0x85, 0xc8, 0xd0, 0x35, 0x68,
-	0x46, 0x54, 0x4a, 0x12, 0xea, 0x44, 0xed, 0x97, 0x66, 0x32, 0x43, 0x21, 0x04, 0xb5, 0x88, 0x46,
-	0x85, 0x16, 0x5b, 0x58, 0xaf, 0xf3, 0x0c, 0x14, 0x8b, 0xa8, 0x48, 0x95, 0x37, 0xa6, 0x2c, 0x1c,
-	0x2b, 0x9d, 0x62, 0x0d, 0x2f, 0x19, 0xeb, 0xae, 0x36, 0xa2, 0xff, 0x43, 0x2b, 0xe5, 0x22, 0x09,
-	0x68, 0x42, 0x03, 0x9d, 0x63, 0x13, 0x1f, 0x1b, 0xd0, 0x10, 0x56, 0xe8, 0x54, 0x51, 0x2e, 0x99,
-	0xe0, 0x9e, 0x88, 0x15, 0x13, 0x5c, 0xb6, 0xff, 0x58, 0x98, 0x43, 0x6a, 0x79, 0x86, 0xff, 0xb8,
-	0x80, 0xa3, 0xfb, 0xd0, 0xe5, 0x82, 0x7b, 0x7e, 0xc2, 0x14, 0xf3, 0xc9, 0xc4, 0x3b, 0x25, 0xe0,
-	0x85, 0x39, 0x01, 0xd7, 0xb9, 0xe0, 0xb7, 0x8d, 0xef, 0x07, 0x2f, 0xc4, 0xee, 0x7d, 0x63, 0x41,
-	0xb3, 0x7c, 0x66, 0xe8, 0x7d, 0x58, 0xcc, 0xa5, 0x4d, 0x13, 0xad, 0xd1, 0xb2, 0x76, 0x17, 0x4f,
-	0xa9, 0xfc, 0x9e, 0x86, 0xe9, 0xb7, 0x79, 0x4e, 0xce, 0xd6, 0x12, 0xf5, 0xc1, 0x3e, 0xa0, 0xd4,
-	0xc8, 0xe7, 0xb4, 0x2b, 0xdb, 0xa1, 0x14, 0xe7, 0x90, 0xf2, 0x72, 0xed, 0x57, 0xbb, 0xdc, 0xaf,
-	0x2c, 0x80, 0xe3, 0x33, 0x5f, 0x10, 0xab, 0xf5, 0x6a, 0x62, 0xbd, 0x09, 0xad, 0x48, 0x04, 0xf4,
-	0xac, 0xa6, 0x73, 0x4f, 0x04, 0xb4, 0x68, 0x3a, 0x91, 0x59, 0x3d, 0x27, 0x52, 0xfb, 0x79, 0x91,
-	0xf6, 0x9e, 0x56, 0xa1, 0x59, 0xba, 0xa0, 0x77, 0xa0, 0x21, 0x19, 0x0f, 0x27, 0xd4, 0x70, 0xea,
-	0xcd, 0x89, 0xef, 0xec, 0x69, 0xe4, 0x6e, 0x05, 0x1b, 0x1f, 0xf4, 0x36, 0xd4, 0x75, 0x73, 0x37,
-	0xe4, 0x5e, 0x9b, 0xe7, 0x7c, 0x2f, 0x07, 0xee, 0x56, 0x70, 0xe1, 0xd1, 0x19, 0x40, 0xa3, 0x08,
-	0x87, 0xde, 0x82, 0x5a, 0xce, 0x5b, 0x13, 0x38, 0xbf, 0x75, 0xf9, 0x44, 0x8c, 0xb2, 0xdd, 0x9f,
-	0xbc, 0xc3, 0x3c, 0x1e, 0xd6, 0x0e, 0x9d, 0x87, 0x16, 0xd4, 0x75, 0x54, 0x74, 0x17, 0x9a, 0x23,
-	0xa6, 0x48, 0x92, 0x90, 0xb2, 0xb6, 0x6e, 0x19, 0xa6, 0x18, 0x4a, 0xce, 0x6c, 0x06, 0x95, 0xb1,
-	0x6e, 0x8b, 0x28, 0x26, 0xbe, 0x1a, 0x32, 0x35, 0xc8, 0xdd, 0xf0, 0x2c, 0x00, 0xba, 0x05, 0x30,
-	0xab, 0x7a, 0xde, 0xf0, 0xec, 0xb3, 0xca, 0xde, 0x2a, 0xcb, 0x2e, 0x87, 0x75, 0xb0, 0x65, 0x1a,
-	0xf5, 0x3e, 0xaf, 0x82, 0xbd, 0x43, 0x29, 0xca, 0xa0, 0x41, 0xa2, 0xbc, 0x77, 0x18, 0x61, 0xce,
-	0xc6, 0x4c, 0x3e, 0xfb, 0x4e, 0x50, 0x61, 0x7c, 0xb8, 0xf3, 0xf8, 0xd7, 0x4b, 0x95, 0xef, 0x7e,
-	0xbb, 0xd4, 0x0f, 0x99, 0x1a, 0xa7, 0x23, 0xc7, 0x17, 0x91, 0x5b, 0xce, 0x55, 0xfd, 0xb7, 0x29,
-	0x83, 0x07, 0xae, 0xca, 0x62, 0x2a, 0xb5, 0x83, 0xfc, 0xfa, 0xd9, 0xa3, 0xab, 0x8b, 0x13, 0x1a,
-	0x12, 0x3f, 0xf3, 0xf2, 0xe9, 0x29, 0xbf, 0x7d, 0xf6, 0xe8, 0xaa, 0x85, 0xcd, 0x81, 0x68, 0x1d,
-	0x5a, 0x21, 0x91, 0xde, 0x84, 0x45, 0x4c, 0xe9, 0xeb, 0xa9, 0xe1, 0x66, 0x48, 0xe4, 0x87, 0xf9,
-	0x1e, 0x39, 0x50, 0x8f, 0x49, 0x46, 0x93, 0xa2, 0x05, 0x0e, 0xdb, 0x3f, 0xff, 0xb0, 0xb9, 0x6a,
-	0x98, 0x0d, 0x82, 0x20, 0xa1, 0x52, 0xee, 0xa9, 0x84, 0xf1, 0x10, 0x17, 0x30, 0xb4, 0x05, 0x0b,
-	0x61, 0x42, 0xb8, 0x32, 0x3d, 0x71, 0x9e, 0x47, 0x09, 0xec, 0xfd, 0x68, 0x81, 0xbd, 0xcf, 0xe2,
-	0xff, 0xb2, 0x06, 0xd7, 0xa0, 0xa1, 0x58, 0x1c, 0xd3, 0xa4, 0xe8, 0x92, 0x73, 0x58, 0x1b, 0xdc,
-	0xad, 0x6a, 0xdb, 0xea, 0xfd, 0x64, 0xc1, 0xd2, 0x20, 0x9d, 0x16, 0x8f, 0x77, 0x9b, 0x28, 0x92,
-	0xa7, 0x4f, 0x0a, 0xb8, 0x56, 0xd7, 0xdc, 0xf4, 0x0d, 0x10, 0xbd, 0x0b, 0xcd, 0x5c, 0xbe, 0x5e,
-	0x20, 0x7c, 0xf3, 0x3a, 0x2e, 0xbf, 0xa4, 0x2b, 0x9d, 0x9c, 0x79, 0x78, 0x41, 0x9a, 0xd1, 0x5c,
-	0xbe, 0x0a, 0xfb, 0x6f, 0xbe, 0x0a, 0xb4, 0x0c, 0xb6, 0x64, 0xa1, 0xbe, 0xa7, 0x45, 0x9c, 0x2f,
-	0x87, 0xef, 0x3d, 0x3e, 0xec, 0x5a, 0x4f, 0x0e, 0xbb, 0xd6, 0xd3, 0xc3, 0xae, 0xf5, 0xf0, 0xa8,
-	0x5b, 0x79, 0x72, 0xd4, 0xad, 0xfc, 0x72, 0xd4, 0xad, 0xdc, 0xbf, 0x72, 0x76, 0xa1, 0x5d, 0x35,
-	0x1d, 0x35, 0x74, 0x83, 0xba, 0xf1, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x48, 0xf7, 0x44,
-	0x41, 0x0a, 0x00, 0x00,
+	// 1140 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x8f, 0x14, 0x45,
+	0x14, 0xdf, 0x9e, 0x9e, 0x9d, 0x9d, 0x79, 0xec, 0xf2, 0xa7, 0x42, 0x48, 0xb3, 0xc8, 0xb0, 0x36,
+	0x41, 0x37, 0xc4, 0xed, 0xe6, 0xcf, 0x41, 0x24, 0x46, 0x9d, 0x01, 0x09, 0x04, 0xc1, 0xa4, 0x77,
+	0xe3, 0x81, 0x4b, 0xa7, 0xa6, 0xbb, 0xb6, 0xa7, 0x42, 0x77, 0x55, 0xdb, 0x55, 0x0d, 0xd3, 0x47,
+	0x3f, 0x80, 0x09, 0xf1, 0x62, 0xe2, 0x27, 0x30, 0x9e, 0x48, 0x34, 0xc6, 0x8f, 0xc0, 0xc9, 0x10,
+	0x4f, 0x9e, 0x94, 0xc0, 0x81, 0xbb, 0x5f, 0x40, 0x53, 0xd5, 0xd5, 0xbd, 0x0b, 0x2e, 0x3b, 0x18,
+	0x4d, 0xbc, 0xcc, 0x54, 0xbd, 0xfe, 0xbd, 0x5f, 0xfd, 0x5e, 0xbd, 0x57, 0xef, 0xc1, 0x6a, 0xc4,
+	0x45, 0xc6, 0x85, 0x2f, 0x67, 0xfe, 0xbd, 0xf3, 0x13, 0x22, 0xf1, 0x79, 0x5f, 0xce, 0xbc, 0xbc,
+	0xe0, 0x92, 0xa3, 0x23, 0xf5, 0x37, 0x4f, 0xce, 0x3c, 0xf3, 0x6d, 0xf5, 0x08, 0xce, 0x28, 0xe3,
+	0xbe, 0xfe, 0xad, 0x51, 0xab, 0x47, 0x13, 0x9e, 0x70, 0xbd, 0xf4, 0xd5, 0xca, 0x58, 0x37, 0x0c,
+	0x6f, 0x54, 0x54, 0xb9, 0xe4, 0x7e, 0x56, 0xa6, 0x92, 0x0a, 0x9a, 0xb4, 0x87, 0x34, 0x06, 0x03,
+	0x1f, 0x1a, 0xf8, 0x04, 0x0b, 0xd2, 0x62, 0x22, 0x4e, 0x99, 0xf9, 0xfe, 0xf6, 0x8e, 0x4c, 0x41,
+	0x13, 0x46, 0xd9, 0x0e, 0x93, 0xd9, 0x1b, 0xe0, 0xf1, 0x84, 0xf3, 0x24, 0x25, 0xbe, 0xde, 0x4d,
+	0xca, 0x6d, 0x1f, 0xb3, 0xaa, 0xf9, 0x54, 0x73, 0x84, 0xb5, 0x56, 0x13, 0x9b, 0xde, 0xb8, 0x5f,
+	0x5a, 0xd0, 0xd9, 0x9a, 0xa1, 0x0d, 0xe8, 0x4e, 0x78, 0x5c, 0x39, 0xd6, 0x9a, 0xb5, 0x7e, 0xe0,
+	0xc2, 0x71, 0xef, 0x6f, 0xf1, 0x7b, 0x5b, 0xb3, 0x31, 0x8f, 0xab, 0x40, 0xc3, 0xd0, 0x25, 0x18,
+	0xe0, 0x52, 0x4e, 0x43, 0xca, 0xb6, 0xb9, 0xd3, 0xd1, 0x3e, 0x27, 0xf6, 0xf0, 0x19, 0x95, 0x72,
+	0x7a, 0x83, 0x6d, 0xf3, 0xa0, 0x8f, 0xcd, 0x0a, 0x0d, 0x01, 0x94, 0x6c, 0x2c, 0xcb, 0x82, 0x08,
+	0xc7, 0x5e, 0xb3, 0xd7, 0x97, 0x83, 0x5d, 0x16, 0x97, 0xc1, 0xe2, 0xd6, 0x2c, 0xc0, 0xf7, 0xd1,
+	0x49, 0x00, 0x75, 0x54, 0x38, 0xa9, 0x24, 0x11, 0x5a, 0xd7, 0x72, 0x30, 0x50, 0x96, 0xb1, 0x32,
+	0xa0, 0xb7, 0xe0, 0x50, 0xab, 0xc0, 0x60, 0x3a, 0x1a, 0xb3, 0xd2, 0x1c, 0x55, 0xe3, 0xe6, 0x9d,
+	0xf7, 0x95, 0x05, 0x4b, 0x9b, 0x34, 0x61, 0x57, 0x79, 0xf4, 0x5f, 0x1d, 0x79, 0x1c, 0xfa, 0xd1,
+	0x14, 0x53, 0x16, 0xd2, 0xd8, 0xb1, 0xd7, 0xac, 0xf5, 0x41, 0xb0, 0xa4, 0xf7, 0x37, 0x62, 0x74,
+	0x06, 0x0e, 0xe2, 0x28, 0xe2, 0x25, 0x93, 0x21, 0x2b, 0xb3, 0x09, 0x29, 0x9c, 0xee, 0x9a, 0xb5,
+	0xde, 0x0d, 0x56, 0x8c, 0xf5, 0xb6, 0x36, 0xba, 0x7f, 0x58, 0x70, 0xd8, 0x88, 0xba, 0x4a, 0x0b,
+	0x12, 0xc9, 0x51, 0x39, 0x9b, 0xa7, 0xee, 0x22, 0x40, 0x5e, 0x4e, 0x52, 0x1a, 0x85, 0x77, 0x49,
+	0x65, 0x72, 0x72, 0xd4, 0xab, 0x6b, 0xc2, 0x6b, 0x6a, 0xc2, 0x1b, 0xb1, 0x2a, 0x18, 0xd4, 0xb8,
+	0x9b, 0xa4, 0xfa, 0xf7, 0x52, 0xd1, 0x2a, 0xf4, 0x05, 0xf9, 0xbc, 0x24, 0x2c, 0x22, 0xce, 0xa2,
+	0x06, 0xb4, 0x7b, 0xf4, 0x0e, 0xd8, 0x92, 0xe6, 0x4e, 0x4f, 0x6b, 0x39, 0xb6, 0x57, 0x4d, 0xd1,
+	0x7c, 0xdc, 0x71, 0xac, 0x40, 0xc1, 0xdc, 0xef, 0x3b, 0xd0, 0xab, 0x8b, 0x0c, 0x9d, 0x83, 0x7e,
+	0x46, 0x84, 0xc0, 0x89, 0x0e, 0xd4, 0x7e, 0x65, 0x24, 0x2d, 0x0a, 0x21, 0xe8, 0x66, 0x24, 0xab,
+	0x6b, 0x71, 0x10, 0xe8, 0xb5, 0x8a, 0x40, 0xd2, 0x8c, 0xf0, 0x52, 0x86, 0x53, 0x42, 0x93, 0xa9,
+	0xd4, 0x21, 0x76, 0x83, 0x15, 0x63, 0xbd, 0xae, 0x8d, 0xe8, 0x0d, 0x18, 0x94, 0x8c, 0x17, 0x31,
+	0x29, 0x48, 0xac, 0x63, 0xec, 0x07, 0x3b, 0x06, 0x34, 0x86, 0x23, 0x64, 0x26, 0x09, 0x13, 0x94,
+	0xb3, 0x90, 0xe7, 0x92, 0x72, 0x26, 0x9c, 0x3f, 0x97, 0xf6, 0x11, 0x75, 0xb8, 0xc5, 0x7f, 0x5a,
+	0xc3, 0xd1, 0x1d, 0x18, 0x32, 0xce, 0xc2, 0xa8, 0xa0, 0x92, 0x46, 0x38, 0x0d, 0xf7, 0x20, 0x3c,
+	0xb4, 0x0f, 0xe1, 0x09, 0xc6, 0xd9, 0x15, 0xe3, 0xfb, 0xf1, 0x4b, 0xdc, 0xee, 0x4f, 0x16, 0xf4,
+	0x9b, 0x67, 0x86, 0x3e, 0x82, 0x65, 0x55, 0xda, 0xa4, 0xd0, 0x35, 0xda, 0xdc, 0xdd, 0xc9, 0x3d,
+	0x6e, 0x7e, 0x53, 0xc3, 0xf4, 0xdb, 0x3c, 0x20, 0xda, 0xb5, 0x40, 0xeb, 0x60, 0x6f, 0x13, 0xe2,
+	0x74, 0x5e, 0x99, 0xb2, 0x6b, 0x84, 0x04, 0x0a, 0xd2, 0x24, 0xd7, 0x7e, 0xad, 0xe4, 0xaa, 0xfc,
+	0xa4, 0x98, 0x11, 0x53, 0x43, 0x7a, 0xed, 0x7e, 0x6d, 0x01, 0xec, 0xe8, 0x78, 0xa9, 0x80, 0xad,
+	0xd7, 0x2b, 0xe0, 0x4b, 0x30, 0xc8, 0x78, 0x4c, 0xe6, 0x35, 0xa2, 0x5b, 0x3c, 0x26, 0x75, 0x23,
+	0xca, 0xcc, 0xea, 0x85, 0xc2, 0xb5, 0x5f, 0x2c, 0x5c, 0xf7, 0x49, 0x07, 0xfa, 0x8d, 0x0b, 0x7a,
+	0x1f, 0x7a, 0x82, 0xb2, 0x24, 0x25, 0x46, 0x93, 0xbb, 0x0f, 0xbf, 0xb7, 0xa9, 0x91, 0xd7, 0x17,
+	0x02, 0xe3, 0x83, 0xde, 0x83, 0x45, 0xdd, 0xf0, 0x8d, 0xb8, 0x37, 0xf7, 0x73, 0xbe, 0xa5, 0x80,
+	0xd7, 0x17, 0x82, 0xda, 0x63, 0x75, 0x04, 0xbd, 0x9a, 0x0e, 0xbd, 0x0b, 0x5d, 0xa5, 0x5b, 0x0b,
+	0x38, 0x78, 0xe1, 0xf4, 0x2e, 0x8e, 0x66, 0x04, 0xec, 0xce, 0xab, 0xe2, 0x0b, 0xb4, 0xc3, 0xea,
+	0x03, 0x0b, 0x16, 0x35, 0x2b, 0xba, 0x09, 0xfd, 0x09, 0x95, 0xb8, 0x28, 0x70, 0x73, 0xb7, 0x7e,
+	0x43, 0x53, 0x0f, 0x2a, 0xaf, 0x9d, 0x4b, 0x0d, 0xd7, 0x15, 0x9e, 0xe5, 0x38, 0x92, 0x63, 0x2a,
+	0x47, 0xca, 0x2d, 0x68, 0x09, 0xd0, 0x65, 0x80, 0xf6, 0xd6, 0x55, 0x13, 0xb4, 0xe7, 0x5d, 0xfb,
+	0xa0, 0xb9, 0x76, 0x31, 0x5e, 0x04, 0x5b, 0x94, 0x99, 0xfb, 0x45, 0x07, 0xec, 0x6b, 0x84, 0xa0,
+	0x0a, 0x7a, 0x38, 0x53, 0xfd, 0xc4, 0x14, 0x6b, 0x3b, 0x7a, 0xd4, 0x3c, 0xdc, 0x25, 0x85, 0xb2,
+	0xf1, 0xb5, 0x47, 0xbf, 0x9d, 0x5a, 0xf8, 0xee, 0xf7, 0x53, 0xeb, 0x09, 0x95, 0xd3, 0x72, 0xe2,
+	0x45, 0x3c, 0xf3, 0x9b, 0x59, 0xab, 0xff, 0x36, 0x44, 0x7c, 0xd7, 0x97, 0x55, 0x4e, 0x84, 0x76,
+	0x10, 0xdf, 0x3c, 0x7f, 0x78, 0x76, 0x39, 0x25, 0x09, 0x8e, 0xaa, 0x50, 0x4d, 0x54, 0xf1, 0xed,
+	0xf3, 0x87, 0x67, 0xad, 0xc0, 0x1c, 0x88, 0x4e, 0xc0, 0x20, 0xc1, 0x22, 0x4c, 0x69, 0x46, 0xa5,
+	0x4e, 0x4f, 0x37, 0xe8, 0x27, 0x58, 0x7c, 0xa2, 0xf6, 0xc8, 0x83, 0xc5, 0x1c, 0x57, 0xa4, 0xa8,
+	0xdb, 0xe2, 0xd8, 0xf9, 0xe5, 0x87, 0x8d, 0xa3, 0x46, 0xd9, 0x28, 0x8e, 0x0b, 0x22, 0xc4, 0xa6,
+	0x2c, 0x28, 0x4b, 0x82, 0x1a, 0x86, 0x2e, 0xc0, 0x52, 0x52, 0x60, 0x26, 0x4d, 0x9f, 0xdc, 0xcf,
+	0xa3, 0x01, 0xba, 0x3f, 0x5a, 0x60, 0x6f, 0xd1, 0xfc, 0xff, 0xbc, 0x83, 0x73, 0xd0, 0x93, 0x34,
+	0xcf, 0x49, 0xe1, 0x74, 0xe6, 0xa8, 0x36, 0xb8, 0xcb, 0x1d, 0xc7, 0x72, 0x7f, 0xb6, 0x60, 0x65,
+	0x54, 0xce, 0xea, 0xc7, 0x7b, 0x15, 0x4b, 0xac, 0xc2, 0xc7, 0x35, 0xdc, 0xb1, 0xe6, 0x10, 0x35,
+	0x40, 0xf4, 0x01, 0xf4, 0x55, 0xf9, 0x86, 0x31, 0x8f, 0xcc, 0xeb, 0x38, 0xfd, 0x8a, 0x4e, 0xb5,
+	0x7b, 0x0e, 0x06, 0x4b, 0xa2, 0xb6, 0xb4, 0xaf, 0xc2, 0xfe, 0x87, 0xaf, 0x02, 0x1d, 0x06, 0x5b,
+	0xd0, 0x44, 0xe7, 0x69, 0x39, 0x50, 0x4b, 0x37, 0x87, 0x83, 0x57, 0x52, 0x4a, 0x98, 0xbc, 0x45,
+	0x24, 0x8e, 0x55, 0x40, 0xa7, 0xe0, 0xc0, 0x7d, 0x9c, 0xa6, 0x44, 0x86, 0x0c, 0x67, 0xf5, 0xcb,
+	0x1b, 0x04, 0x50, 0x9b, 0x6e, 0xe3, 0x8c, 0xa8, 0xe9, 0x62, 0x00, 0xf7, 0x48, 0xa1, 0x3a, 0xb2,
+	0x99, 0x3d, 0x2b, 0xb5, 0xf5, 0xb3, 0xda, 0x88, 0x8e, 0x41, 0x2f, 0xe5, 0x11, 0x4e, 0x89, 0x99,
+	0xaf, 0x66, 0x37, 0xfe, 0xf0, 0xd1, 0xd3, 0xa1, 0xf5, 0xf8, 0xe9, 0xd0, 0x7a, 0xf2, 0x74, 0x68,
+	0x3d, 0x78, 0x36, 0x5c, 0x78, 0xfc, 0x6c, 0xb8, 0xf0, 0xeb, 0xb3, 0xe1, 0xc2, 0x9d, 0x33, 0xf3,
+	0x53, 0xeb, 0xcb, 0xd9, 0xa4, 0xa7, 0x5b, 0xe2, 0xc5, 0xbf, 0x06, 0x00, 0x66, 0x4d, 0xa2, 0x18,
+	0xc7, 0x0a, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1448,6 +1546,11 @@ func (m *AuthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Lane != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Lane))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Tip != nil {
 		{
 			size, err := m.Tip.MarshalToSizedBuffer(dAtA[:i])
@@ -1846,6 +1949,50 @@ func (m *AuxSignerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locale) > 0 {
+		i -= len(m.Locale)
+		copy(dAtA[i:], m.Locale)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Locale)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WalletVersion) > 0 {
+		i -= len(m.WalletVersion)
+		copy(dAtA[i:], m.WalletVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletName) > 0 {
+		i -= len(m.WalletName)
+		copy(dAtA[i:], m.WalletName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2015,6 +2162,9 @@ func (m *AuthInfo) Size() (n int) {
 		l = m.Tip.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Lane != 0 {
+		n += 1 + sovTx(uint64(m.Lane))
+	}
 	return n
 }
 
@@ -2174,6 +2324,27 @@ func (m *AuxSignerData) Size() (n int) {
 	return n
 }
 
+func (m *ClientMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WalletVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Locale)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3237,6 +3408,25 @@ func (m *AuthInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			m.Lane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lane |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4162,6 +4352,152 @@ func (m *AuxSignerData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClientMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
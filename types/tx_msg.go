@@ -87,6 +87,14 @@ type (
 		GetUnordered() bool
 	}
 
+	// TxWithLane extends the Tx interface by allowing a transaction to be sent
+	// on a nonce lane of its signers, each lane having its own sequence.
+	TxWithLane interface {
+		Tx
+
+		GetLane() uint64
+	}
+
 	// HasValidateBasic defines a type that has a ValidateBasic method.
 	// ValidateBasic is deprecated and now facultative.
 	// Prefer validating messages directly in the msg server.
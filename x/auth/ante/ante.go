@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/gasprice"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -21,6 +22,8 @@ type HandlerOptions struct {
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
+	// GasPriceTracker, if set, records the gas prices paid by the transactions included in blocks.
+	GasPriceTracker *gasprice.Tracker
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -46,7 +49,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker).WithGasPriceTracker(options.GasPriceTracker),
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
 	}
@@ -20,6 +20,32 @@ type AccountKeeper interface {
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 }
 
+// LaneAccountKeeper extends the AccountKeeper with the sequences of the nonce
+// lanes of the accounts. Transactions sent on a non-zero lane are rejected by
+// the SigVerificationDecorator if its account keeper does not implement it.
+type LaneAccountKeeper interface {
+	AccountKeeper
+	GetLaneSequence(ctx context.Context, addr sdk.AccAddress, lane uint64) (uint64, error)
+	SetLaneSequence(ctx context.Context, addr sdk.AccAddress, lane, sequence uint64) error
+}
+
+// FeeExemptAccountKeeper extends the AccountKeeper with the count of the fee
+// exempt transactions of the block being finalized. Transactions are never
+// exempted from fees by the DeductFeeDecorator if its account keeper does not
+// implement it.
+type FeeExemptAccountKeeper interface {
+	AccountKeeper
+	IncrementFeeExemptTxCount(ctx context.Context) (uint64, error)
+}
+
+// ActivityAccountKeeper extends the AccountKeeper with the heights of the last
+// transactions of the accounts. The account activity is never recorded by the
+// SigVerificationDecorator if its account keeper does not implement it.
+type ActivityAccountKeeper interface {
+	AccountKeeper
+	SetLastActivityHeight(ctx context.Context, addr sdk.AccAddress, height int64) error
+}
+
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
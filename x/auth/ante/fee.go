@@ -2,15 +2,24 @@ package ante
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"math"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/auth/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/gasprice"
 )
 
+// ElevatedTxPriority is the priority added to the fee priority of the transactions
+// whose messages are all listed in the PriorityMsgTypeURLs auth param, so that
+// they are ordered before all other transactions while keeping their fee order.
+const ElevatedTxPriority int64 = math.MaxInt64 / 2
+
 // TxFeeChecker check if the provided fee is enough and returns the effective fee and tx priority,
 // the effective fee should be deducted later, and the priority should be returned in abci response.
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
@@ -18,12 +27,18 @@ type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
 // Call next AnteHandler if fees successfully deducted.
+//
+// Transactions exempted from fees by the auth params, e.g. the oracle votes of registered accounts, pay no fee and are
+// not checked against the minimum gas prices. They are given an elevated priority, and at most MaxFeeExemptTxsPerBlock
+// of them are accepted per block.
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
 	accountKeeper  AccountKeeper
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
 	txFeeChecker   TxFeeChecker
+
+	gasPriceTracker *gasprice.Tracker
 }
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
@@ -39,6 +54,13 @@ func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKee
 	}
 }
 
+// WithGasPriceTracker returns a copy of the decorator recording the gas prices paid by the
+// transactions included in blocks in the given tracker.
+func (dfd DeductFeeDecorator) WithGasPriceTracker(tracker *gasprice.Tracker) DeductFeeDecorator {
+	dfd.gasPriceTracker = tracker
+	return dfd
+}
+
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -54,8 +76,13 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, nex
 		err      error
 	)
 
+	feeExempt, err := dfd.useFeeExemption(ctx, feeTx)
+	if err != nil {
+		return ctx, err
+	}
+
 	fee := feeTx.GetFee()
-	if ctx.ExecMode() != sdk.ExecModeSimulate {
+	if ctx.ExecMode() != sdk.ExecModeSimulate && !feeExempt {
 		fee, priority, err = dfd.txFeeChecker(ctx, tx)
 		if err != nil {
 			return ctx, err
@@ -65,11 +92,122 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, nex
 		return ctx, err
 	}
 
+	// the fee exempt transactions would skew the recorded gas prices to zero
+	if dfd.gasPriceTracker != nil && ctx.ExecMode() == sdk.ExecModeFinalize && !feeExempt {
+		dfd.gasPriceTracker.Record(ctx.BlockHeight(), fee, feeTx.GetGas())
+	}
+
+	if feeExempt || dfd.accountKeeper.GetParams(ctx).IsPriorityTx(tx.GetMsgs()) {
+		priority = ElevatedTxPriority + min(max(priority, 0), ElevatedTxPriority)
+	}
+
 	newCtx := ctx.WithPriority(priority)
 
 	return next(newCtx, tx, ctx.ExecMode() == sdk.ExecModeSimulate)
 }
 
+// useFeeExemption returns true if the transaction pays no fee and is exempted
+// from fees by the auth params. When finalizing a block, it counts the
+// transaction against the maximum number of fee exempt transactions per block
+// and returns an error once the maximum is reached, as the transaction pays no
+// fee to fall back to. The transactions are not counted in the other modes,
+// which would otherwise share the count of the block being built with the
+// transactions checked for the mempool.
+func (dfd DeductFeeDecorator) useFeeExemption(ctx sdk.Context, feeTx sdk.FeeTx) (bool, error) {
+	exempt, err := isFeeExemptTx(ctx, dfd.accountKeeper, feeTx)
+	if err != nil || !exempt {
+		return false, err
+	}
+
+	if ctx.ExecMode() != sdk.ExecModeFinalize {
+		return true, nil
+	}
+
+	count, err := dfd.accountKeeper.(FeeExemptAccountKeeper).IncrementFeeExemptTxCount(ctx)
+	if err != nil {
+		return false, err
+	}
+	if maxTxs := dfd.accountKeeper.GetParams(ctx).MaxFeeExemptTxsPerBlock; count > maxTxs {
+		return false, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "the block already has the maximum of %d fee exempt transactions", maxTxs)
+	}
+
+	return true, nil
+}
+
+// isFeeExemptTx returns true if the transaction pays no fee and is exempted from
+// fees by the auth params. Transactions are never exempted if the account keeper
+// does not implement FeeExemptAccountKeeper.
+func isFeeExemptTx(ctx sdk.Context, ak AccountKeeper, feeTx sdk.FeeTx) (bool, error) {
+	if !feeTx.GetFee().IsZero() || feeTx.FeeGranter() != nil {
+		return false, nil
+	}
+
+	if _, ok := ak.(FeeExemptAccountKeeper); !ok {
+		return false, nil
+	}
+
+	feePayer, err := ak.AddressCodec().BytesToString(feeTx.FeePayer())
+	if err != nil {
+		return false, err
+	}
+
+	return ak.GetParams(ctx).IsFeeExemptTx(feeTx.GetMsgs(), feePayer), nil
+}
+
+var _ baseapp.TxSelector = (*FeeExemptTxSelector)(nil)
+
+// FeeExemptTxSelector is a TxSelector selecting at most MaxFeeExemptTxsPerBlock
+// fee exempt transactions for a proposal, and delegating the selection of the
+// transactions to the wrapped TxSelector. Without it, a proposal can hold more
+// fee exempt transactions than the DeductFeeDecorator accepts when the block is
+// finalized, and the transactions above the maximum fail in the block.
+type FeeExemptTxSelector struct {
+	baseapp.TxSelector
+
+	accountKeeper AccountKeeper
+	exemptTxs     uint64
+}
+
+// NewFeeExemptTxSelector returns a FeeExemptTxSelector wrapping ts, e.g. the
+// baseapp.NewDefaultTxSelector, for the DefaultProposalHandler.SetTxSelector.
+func NewFeeExemptTxSelector(ak AccountKeeper, ts baseapp.TxSelector) *FeeExemptTxSelector {
+	return &FeeExemptTxSelector{TxSelector: ts, accountKeeper: ak}
+}
+
+// Clear implements baseapp.TxSelector.
+func (ts *FeeExemptTxSelector) Clear() {
+	ts.exemptTxs = 0
+	ts.TxSelector.Clear()
+}
+
+// SelectTxForProposal implements baseapp.TxSelector. The fee exempt transactions
+// above the maximum are skipped, leaving room for the other transactions.
+func (ts *FeeExemptTxSelector) SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	feeTx, ok := memTx.(sdk.FeeTx)
+	if !ok {
+		return ts.TxSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	exempt, err := isFeeExemptTx(sdkCtx, ts.accountKeeper, feeTx)
+	if err != nil || !exempt {
+		// a transaction failing the check fails in the ante handler as well
+		return ts.TxSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
+	}
+
+	if ts.exemptTxs >= ts.accountKeeper.GetParams(sdkCtx).MaxFeeExemptTxsPerBlock {
+		return false
+	}
+
+	selected := len(ts.TxSelector.SelectedTxs(ctx))
+	stop := ts.TxSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
+	if len(ts.TxSelector.SelectedTxs(ctx)) > selected {
+		ts.exemptTxs++
+	}
+
+	return stop
+}
+
 func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
@@ -6,14 +6,17 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/ante"
 	authtypes "cosmossdk.io/x/auth/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/gasprice"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -137,3 +140,183 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeeDecorator_GasPriceTracker(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	tracker := gasprice.NewTracker(gasprice.DefaultBlocks)
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil).WithGasPriceTracker(tracker)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(15)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(2)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// transactions are not recorded before being included in a block
+	_, err = antehandler(s.ctx.WithExecMode(sdk.ExecModeCheck), tx, false)
+	require.NoError(t, err)
+	blocks, _ := tracker.Percentiles()
+	require.Zero(t, blocks)
+
+	_, err = antehandler(s.ctx.WithExecMode(sdk.ExecModeFinalize), tx, false)
+	require.NoError(t, err)
+	blocks, percentiles := tracker.Percentiles()
+	require.Equal(t, uint64(1), blocks)
+	require.Len(t, percentiles, 1)
+	require.Equal(t, "atom", percentiles[0].Denom)
+	require.Equal(t, math.LegacyNewDec(10), percentiles[0].Average)
+}
+
+func TestDeductFeeDecorator_PriorityMsgs(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(15)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(2)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// the priority is the gas price paid
+	newCtx, err := antehandler(s.ctx.WithExecMode(sdk.ExecModeCheck), tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), newCtx.Priority())
+
+	params := s.accountKeeper.GetParams(s.ctx)
+	params.PriorityMsgTypeURLs = []string{sdk.MsgTypeURL(msg)}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	// the priority is elevated, keeping the gas price order
+	newCtx, err = antehandler(s.ctx.WithExecMode(sdk.ExecModeCheck), tx, false)
+	require.NoError(t, err)
+	require.Equal(t, ante.ElevatedTxPriority+10, newCtx.Priority())
+}
+
+func TestDeductFeeDecorator_FeeExemptTxs(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	accs := s.CreateTestAccounts(2)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetGasLimit(15)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	minGasPrices := []sdk.DecCoin{sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(1))}
+	ctx := s.ctx.WithMinGasPrices(minGasPrices).WithExecMode(sdk.ExecModeFinalize).WithHeaderInfo(header.Info{Height: 1})
+
+	// without the exemption the zero fee is insufficient
+	_, err = antehandler(ctx.WithExecMode(sdk.ExecModeCheck), tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	feePayer, err := s.accountKeeper.AddressCodec().BytesToString(accs[0].acc.GetAddress())
+	require.NoError(t, err)
+	params := s.accountKeeper.GetParams(s.ctx)
+	params.FeeExemptMsgTypeURLs = []string{sdk.MsgTypeURL(msg)}
+	params.FeeExemptAccounts = []string{feePayer}
+	params.MaxFeeExemptTxsPerBlock = 2
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	// the exempt transactions pay no fee and get an elevated priority
+	for i := 0; i < 2; i++ {
+		newCtx, err := antehandler(ctx, tx, false)
+		require.NoError(t, err)
+		require.Equal(t, ante.ElevatedTxPriority, newCtx.Priority())
+	}
+
+	// the block is full of fee exempt transactions
+	_, err = antehandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the transactions are only counted when finalizing a block
+	for i := 0; i < 3; i++ {
+		newCtx, err := antehandler(ctx.WithExecMode(sdk.ExecModeCheck).WithHeaderInfo(header.Info{Height: 2}), tx, false)
+		require.NoError(t, err)
+		require.Equal(t, ante.ElevatedTxPriority, newCtx.Priority())
+	}
+
+	// the next block accepts them again
+	_, err = antehandler(ctx.WithHeaderInfo(header.Info{Height: 2}), tx, false)
+	require.NoError(t, err)
+
+	// the transactions of other accounts are not exempted
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[1].acc.GetAddress())))
+	s.txBuilder.SetGasLimit(15)
+	tx, err = s.CreateTestTx(s.ctx, []cryptotypes.PrivKey{accs[1].priv}, []uint64{1}, []uint64{0}, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	_, err = antehandler(ctx.WithExecMode(sdk.ExecModeCheck).WithHeaderInfo(header.Info{Height: 2}), tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+func TestFeeExemptTxSelector(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(2)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetGasLimit(15)
+	exemptTx, err := s.CreateTestTx(s.ctx, []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	exemptTxBz, err := s.clientCtx.TxConfig.TxEncoder()(exemptTx)
+	require.NoError(t, err)
+
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[1].acc.GetAddress())))
+	s.txBuilder.SetGasLimit(15)
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 15)))
+	feeTx, err := s.CreateTestTx(s.ctx, []cryptotypes.PrivKey{accs[1].priv}, []uint64{1}, []uint64{0}, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	feeTxBz, err := s.clientCtx.TxConfig.TxEncoder()(feeTx)
+	require.NoError(t, err)
+
+	feePayer, err := s.accountKeeper.AddressCodec().BytesToString(accs[0].acc.GetAddress())
+	require.NoError(t, err)
+	params := s.accountKeeper.GetParams(s.ctx)
+	params.FeeExemptMsgTypeURLs = []string{sdk.MsgTypeURL(msg)}
+	params.FeeExemptAccounts = []string{feePayer}
+	params.MaxFeeExemptTxsPerBlock = 2
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	selector := ante.NewFeeExemptTxSelector(s.accountKeeper, baseapp.NewDefaultTxSelector())
+
+	// the fee exempt transactions above the maximum are skipped
+	for i := 0; i < 3; i++ {
+		require.False(t, selector.SelectTxForProposal(s.ctx, 1_000_000, 0, exemptTx, exemptTxBz))
+	}
+	require.False(t, selector.SelectTxForProposal(s.ctx, 1_000_000, 0, feeTx, feeTxBz))
+	require.Equal(t, [][]byte{exemptTxBz, exemptTxBz, feeTxBz}, selector.SelectedTxs(s.ctx))
+
+	// the count is reset for the next proposal
+	selector.Clear()
+	require.False(t, selector.SelectTxForProposal(s.ctx, 1_000_000, 0, exemptTx, exemptTxBz))
+	require.Equal(t, [][]byte{exemptTxBz}, selector.SelectedTxs(s.ctx))
+}
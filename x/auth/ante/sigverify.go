@@ -104,6 +104,24 @@ func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
 	}
 }
 
+// onlyDirectSigners checks SignatureData to see if all signers are using
+// SIGN_MODE_DIRECT.
+func onlyDirectSigners(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_DIRECT
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if !onlyDirectSigners(s) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
 func verifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
 	// when simulating pubKey.Key will always be nil
 	if pubKey.Bytes() == nil {
@@ -123,6 +141,11 @@ func verifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256k1 key is not on curve")
 		}
 
+	case *ed25519.PubKey:
+		if len(typedPubKey.Key) != ed25519.PubKeySize {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid ed25519 key length")
+		}
+
 	case *secp256r1.PubKey:
 		pubKeyObject := typedPubKey.Key.PublicKey
 		if !pubKeyObject.IsOnCurve(pubKeyObject.X, pubKeyObject.Y) {
@@ -192,14 +215,24 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ boo
 		}
 	}
 
+	if err := svd.recordActivity(ctx, signers); err != nil {
+		return ctx, err
+	}
+
+	lane := txLane(tx)
 	var events sdk.Events
 	for i, sig := range signatures {
 		signerStr, err := svd.ak.AddressCodec().BytesToString(signers[i])
 		if err != nil {
 			return ctx, err
 		}
+		// the sequences of the nonce lanes are prefixed by their lane
+		accSeq := fmt.Sprintf("%s/%d", signerStr, sig.Sequence)
+		if lane != 0 {
+			accSeq = fmt.Sprintf("%s/%d/%d", signerStr, lane, sig.Sequence)
+		}
 		events = append(events, sdk.NewEvent(sdk.EventTypeTx,
-			sdk.NewAttribute(sdk.AttributeKeyAccountSequence, fmt.Sprintf("%s/%d", signerStr, sig.Sequence)),
+			sdk.NewAttribute(sdk.AttributeKeyAccountSequence, accSeq),
 		))
 
 		sigBzs, err := signatureDataToBz(sig.Data)
@@ -260,7 +293,12 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 		return err
 	}
 
-	err = svd.verifySig(ctx, tx, acc, sig, newlyCreated)
+	lane := txLane(tx)
+	if lane != 0 {
+		return svd.authenticateLane(ctx, tx, acc, sig, lane, newlyCreated)
+	}
+
+	err = svd.verifySig(ctx, tx, acc, sig, acc.GetSequence(), newlyCreated)
 	if err != nil {
 		return err
 	}
@@ -274,6 +312,41 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 	return nil
 }
 
+// authenticateLane verifies the signature of a transaction sent on a nonce lane
+// of the signer account against the sequence of the lane, and increases it.
+func (svd SigVerificationDecorator) authenticateLane(ctx sdk.Context, tx authsigning.Tx, acc sdk.AccountI, sig signing.SignatureV2, lane uint64, newlyCreated bool) error {
+	lak, ok := svd.ak.(LaneAccountKeeper)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrNotSupported, "nonce lanes are not supported by the account keeper")
+	}
+
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transactions cannot be sent on a nonce lane")
+	}
+
+	// the lane is only signed over by SIGN_MODE_DIRECT, as part of the auth info
+	if ctx.ExecMode() != sdk.ExecModeSimulate && !onlyDirectSigners(sig.Data) {
+		return errorsmod.Wrapf(sdkerrors.ErrNotSupported, "transactions sent on a nonce lane must be signed with %s", signing.SignMode_SIGN_MODE_DIRECT)
+	}
+
+	sequence, err := lak.GetLaneSequence(ctx, acc.GetAddress(), lane)
+	if err != nil {
+		return err
+	}
+
+	err = svd.verifySig(ctx, tx, acc, sig, sequence, newlyCreated)
+	if err != nil {
+		return err
+	}
+
+	if err := lak.SetLaneSequence(ctx, acc.GetAddress(), lane, sequence+1); err != nil {
+		return err
+	}
+	// update account changes in state, such as its public key.
+	svd.ak.SetAccount(ctx, acc)
+	return nil
+}
+
 // consumeSignatureGas will consume gas according to the pub-key being verified.
 func (svd SigVerificationDecorator) consumeSignatureGas(
 	ctx sdk.Context,
@@ -298,12 +371,13 @@ func (svd SigVerificationDecorator) consumeSignatureGas(
 	return nil
 }
 
-// verifySig will verify the signature of the provided signer account.
-func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool) error {
-	if sig.Sequence != acc.GetSequence() {
+// verifySig will verify the signature of the provided signer account, signed
+// with the given sequence.
+func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, sequence uint64, newlyCreated bool) error {
+	if sig.Sequence != sequence {
 		return errorsmod.Wrapf(
 			sdkerrors.ErrWrongSequence,
-			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			"account sequence mismatch, expected %d, got %d", sequence, sig.Sequence,
 		)
 	}
 
@@ -343,7 +417,7 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		Address:       acc.GetAddress().String(),
 		ChainID:       chainID,
 		AccountNumber: accNum,
-		Sequence:      acc.GetSequence(),
+		Sequence:      sequence,
 		PubKey: &anypb.Any{
 			TypeUrl: anyPk.TypeUrl,
 			Value:   anyPk.Value,
@@ -360,7 +434,7 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		if OnlyLegacyAminoSigners(sig.Data) {
 			// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 			// and therefore communicate sequence number as a potential cause of error.
-			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, sequence, chainID)
 		} else {
 			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
 		}
@@ -424,6 +498,32 @@ func (svd SigVerificationDecorator) increaseSequence(tx authsigning.Tx, acc sdk.
 	return acc.SetSequence(acc.GetSequence() + 1)
 }
 
+// recordActivity records the current height as the height of the last
+// transaction of the signers, if the account activity is tracked.
+func (svd SigVerificationDecorator) recordActivity(ctx sdk.Context, signers [][]byte) error {
+	aak, ok := svd.ak.(ActivityAccountKeeper)
+	if !ok || !svd.ak.GetParams(ctx).TrackAccountActivity {
+		return nil
+	}
+
+	for _, signer := range signers {
+		if err := aak.SetLastActivityHeight(ctx, signer, ctx.BlockHeight()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// txLane returns the nonce lane the transaction is sent on, 0 if the
+// transaction does not support lanes.
+func txLane(tx sdk.Tx) uint64 {
+	laneTx, ok := tx.(sdk.TxWithLane)
+	if !ok {
+		return 0
+	}
+	return laneTx.GetLane()
+}
+
 // authenticateAbstractedAccount computes an AA authentication instruction and invokes the auth flow on the AA.
 func (svd SigVerificationDecorator) authenticateAbstractedAccount(ctx sdk.Context, authTx authsigning.Tx, signer []byte, index int) error {
 	// the bundler is the AA itself.
@@ -484,14 +584,17 @@ func (vscd ValidateSigCountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ b
 
 // DefaultSigVerificationGasConsumer is the default implementation of SignatureVerificationGasConsumer. It consumes gas
 // for signature verification based upon the public key type. The cost is fetched from the given params and is matched
-// by the concrete type.
+// by the concrete type. Signatures from ed25519 and secp256r1 keys are rejected unless enabled in the params.
 func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error {
 	pubkey := sig.PubKey
 
 	switch pubkey := pubkey.(type) {
 	case *ed25519.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
-		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
+		if !params.EnableED25519 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
+		}
+		return nil
 
 	case *secp256k1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
@@ -499,6 +602,9 @@ func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.Si
 
 	case *secp256r1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
+		if !params.EnableSecp256r1 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 public keys are unsupported")
+		}
 		return nil
 
 	case multisig.PubKey:
@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -35,6 +36,10 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	msg := []byte{1, 2, 3, 4}
 
 	p := types.DefaultParams()
+	ed25519Params := types.DefaultParams()
+	ed25519Params.EnableED25519 = true
+	noSecp256r1Params := types.DefaultParams()
+	noSecp256r1Params.EnableSecp256r1 = false
 	skR1, _ := secp256r1.GenPrivKey()
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
@@ -71,7 +76,9 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}{
 		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
+		{"PubKeyEd25519 enabled", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), ed25519Params}, p.SigVerifyCostED25519, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeySecp256r1 disabled", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), noSecp256r1Params}, p.SigVerifyCostSecp256r1(), true},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"Multisig simulation", args{storetypes.NewInfiniteGasMeter(), multisigSimulationSignature, multisigKey1, params}, simulationExpectedCost, false},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
@@ -393,11 +400,110 @@ func TestAnteHandlerChecks(t *testing.T) {
 				if tc.supported {
 					require.ErrorContains(t, err, "not on curve")
 				} else {
-					require.ErrorContains(t, err, "unsupported key type")
+					require.ErrorContains(t, err, "unsupported")
 				}
 			} else {
 				require.Nil(t, err, "TestCase %d: %s errored unexpectedly. Err: %v", i, tc.name, err)
 			}
 		})
 	}
+
+	// ed25519 keys are accepted once enabled in the params
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	params.EnableED25519 = true
+	require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(msgs[2]))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv3}, []uint64{accs[2].GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	_, err = anteHandler(suite.ctx.WithTxBytes(txBytes), tx, true)
+	require.NoError(t, err)
+}
+
+func TestSigVerificationNonceLanes(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+	acc, priv := accs[0].acc, accs[0].priv
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	deliver := func(lane, seq uint64, signMode signing.SignMode) error {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.GetAddress())))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetLane(lane)
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{seq}, suite.ctx.ChainID(), signMode)
+		require.NoError(t, err)
+		_, err = antehandler(suite.ctx, tx, false)
+		return err
+	}
+
+	// each lane is an independent ordered stream of transactions
+	require.NoError(t, deliver(1, 0, signing.SignMode_SIGN_MODE_DIRECT))
+	require.NoError(t, deliver(1, 1, signing.SignMode_SIGN_MODE_DIRECT))
+	require.ErrorIs(t, deliver(1, 1, signing.SignMode_SIGN_MODE_DIRECT), sdkerrors.ErrWrongSequence)
+	require.NoError(t, deliver(2, 0, signing.SignMode_SIGN_MODE_DIRECT))
+	require.NoError(t, deliver(0, 0, signing.SignMode_SIGN_MODE_DIRECT))
+
+	seq, err := suite.accountKeeper.GetLaneSequence(suite.ctx, acc.GetAddress(), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), seq)
+	seq, err = suite.accountKeeper.GetLaneSequence(suite.ctx, acc.GetAddress(), 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), seq)
+	require.Equal(t, uint64(1), suite.accountKeeper.GetAccount(suite.ctx, acc.GetAddress()).GetSequence())
+
+	// the lane is only signed over by SIGN_MODE_DIRECT
+	require.ErrorIs(t, deliver(1, 2, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON), sdkerrors.ErrNotSupported)
+}
+
+func TestSigVerificationAccountActivity(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+	acc, priv := accs[0].acc, accs[0].priv
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	deliver := func(ctx sdk.Context, seq uint64) {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.GetAddress())))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{seq}, ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		_, err = antehandler(ctx, tx, false)
+		require.NoError(t, err)
+	}
+
+	// the account activity is not tracked by default
+	deliver(suite.ctx.WithBlockHeight(5), 0)
+	height, err := suite.accountKeeper.GetLastActivityHeight(suite.ctx, acc.GetAddress())
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	params.TrackAccountActivity = true
+	require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+
+	deliver(suite.ctx.WithBlockHeight(7), 1)
+	height, err = suite.accountKeeper.GetLastActivityHeight(suite.ctx, acc.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(7), height)
+
+	deliver(suite.ctx.WithBlockHeight(9), 2)
+	height, err = suite.accountKeeper.GetLastActivityHeight(suite.ctx, acc.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(9), height)
 }
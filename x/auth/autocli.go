@@ -34,6 +34,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query account info which is common to all account types.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "LaneSequence",
+					Use:            "lane-sequence [address] [lane]",
+					Short:          "Query the sequence of a nonce lane of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "lane"}},
+				},
 				{
 					RpcMethod:      "AccountAddressByID",
 					Use:            "address-by-acc-num [acc-num]",
@@ -52,6 +58,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf("%s q auth module-account gov", version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
 				},
+				{
+					RpcMethod:      "ModuleAccountPermissions",
+					Use:            "module-account-permissions [module-name]",
+					Short:          "Query the permissions granted to a module account",
+					Example:        fmt.Sprintf("%s q auth module-account-permissions mint", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
 				{
 					RpcMethod:      "AddressBytesToString",
 					Use:            "address-bytes-to-string [address-bytes]",
@@ -83,10 +96,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
 					Short:          "Submit a proposal to update auth module params. Note: the entire params must be provided.",
-					Example:        fmt.Sprintf(`%s tx auth update-params-proposal '{ "max_memo_characters": 0, "tx_sig_limit": 0, "tx_size_cost_per_byte": 0, "sig_verify_cost_ed25519": 0, "sig_verify_cost_secp256k1": 0 }'`, version.AppName),
+					Example:        fmt.Sprintf(`%s tx auth update-params-proposal '{ "max_memo_characters": 0, "tx_sig_limit": 0, "tx_size_cost_per_byte": 0, "sig_verify_cost_ed25519": 0, "sig_verify_cost_secp256k1": 0, "enable_ed25519": false, "enable_secp256r1": true }'`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod: "ConvertVestedAccount",
+					Use:       "convert-vested-account",
+					Short:     "Convert the sender's fully vested account into a base account",
+					Example:   fmt.Sprintf("%s tx auth convert-vested-account --from mykey", version.AppName),
+				},
+				{
+					RpcMethod:      "UpdateModuleAccountPermissions",
+					Use:            "update-module-account-permissions-proposal [module-name] [permission]...",
+					Short:          "Submit a proposal to replace the permissions granted to a module account. Note: all the permissions must be provided, none revokes them all.",
+					Example:        fmt.Sprintf(`%s tx auth update-module-account-permissions-proposal mint minter burner`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module_name"}, {ProtoField: "permissions", Varargs: true}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -0,0 +1,412 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	authclient "cosmossdk.io/x/auth/client"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagPlaceholder = "placeholder"
+	flagSet         = "set"
+
+	// txTemplatesDir is the directory, relative to the client home, in which
+	// transaction templates are stored.
+	txTemplatesDir = "tx-templates"
+)
+
+// txTemplateNameRegex restricts template and placeholder names so that they are
+// safe to use as file names and easy to spot inside a transaction.
+var txTemplateNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// txTemplate is a named unsigned transaction stored locally, in which some
+// string values have been replaced by placeholders of the form {{name}}.
+type txTemplate struct {
+	Name         string          `json:"name"`
+	Placeholders []string        `json:"placeholders"`
+	Tx           json.RawMessage `json:"tx"`
+}
+
+// GetTxTemplateCommand returns the template command, which saves unsigned
+// transactions as named local templates and generates new transactions from them.
+func GetTxTemplateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "template",
+		Short:                      "Save, list and apply local transaction templates",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetTxTemplateSaveCmd(),
+		GetTxTemplateListCmd(),
+		GetTxTemplateApplyCmd(),
+	)
+
+	return cmd
+}
+
+// GetTxTemplateSaveCmd returns a command that saves an unsigned transaction as
+// a named template.
+func GetTxTemplateSaveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save [name] [file]",
+		Short: "Save an unsigned transaction as a named template",
+		Long: strings.TrimSpace(`Save an unsigned transaction, typically generated by any transaction
+command with the --generate-only flag, as a named template in the client home.
+If you supply a dash (-) argument in place of an input filename, the command reads from standard input.
+
+Every --placeholder name=value flag replaces the string values of the transaction
+that are exactly equal to value by the {{name}} placeholder, which must then be
+set when applying the template:
+
+$ <appd> tx bank send treasury cosmos1... 1000stake --generate-only > grant.json
+$ <appd> tx template save monthly-grant grant.json --placeholder recipient=cosmos1... --placeholder amount=1000
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			name := args[0]
+			if !txTemplateNameRegex.MatchString(name) {
+				return fmt.Errorf("invalid template name %q: only letters, digits, '_' and '-' are allowed", name)
+			}
+
+			path := txTemplatePath(clientCtx, name)
+			overwrite, _ := cmd.Flags().GetBool(flagOverwrite)
+			if _, err := os.Stat(path); err == nil && !overwrite {
+				return fmt.Errorf("template %s already exists, use --%s to replace it", name, flagOverwrite)
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			if err := validateUnsignedTx(stdTx); err != nil {
+				return err
+			}
+
+			// re-encode the transaction so that the template holds its canonical JSON form
+			txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(stdTx)
+			if err != nil {
+				return err
+			}
+
+			placeholderArgs, _ := cmd.Flags().GetStringArray(flagPlaceholder)
+			placeholders, err := parseTxTemplateValues(placeholderArgs)
+			if err != nil {
+				return err
+			}
+
+			tmpl, err := newTxTemplate(name, txJSON, placeholders)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(tmpl, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(path, bz, 0o600); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("template %s saved to %s\n", name, path))
+		},
+	}
+
+	cmd.Flags().StringArray(flagPlaceholder, nil, "Replace the string values equal to value by the {{name}} placeholder, given as name=value (can be repeated)")
+	cmd.Flags().Bool(flagOverwrite, false, "Replace the template if it already exists")
+
+	return cmd
+}
+
+// GetTxTemplateListCmd returns a command that lists the saved templates and
+// their placeholders.
+func GetTxTemplateListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the saved transaction templates and their placeholders",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := os.ReadDir(filepath.Join(clientCtx.HomeDir, txTemplatesDir))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+
+			type templateInfo struct {
+				Name         string   `json:"name"`
+				Placeholders []string `json:"placeholders"`
+			}
+
+			templates := []templateInfo{}
+			for _, entry := range entries {
+				if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+					continue
+				}
+
+				tmpl, err := readTxTemplate(clientCtx, strings.TrimSuffix(entry.Name(), ".json"))
+				if err != nil {
+					return err
+				}
+
+				templates = append(templates, templateInfo{Name: tmpl.Name, Placeholders: tmpl.Placeholders})
+			}
+
+			bz, err := json.Marshal(templates)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// GetTxTemplateApplyCmd returns a command that generates an unsigned
+// transaction from a saved template.
+func GetTxTemplateApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply [name]",
+		Short: "Generate an unsigned transaction from a saved template",
+		Long: strings.TrimSpace(`Generate an unsigned transaction from a saved template, setting every
+placeholder of the template with a --set name=value flag. The resulting
+transaction can then be signed with the sign command and broadcast:
+
+$ <appd> tx template apply monthly-grant --set recipient=cosmos1... --set amount=2000 > grant.json
+$ <appd> tx sign grant.json --from treasury
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			tmpl, err := readTxTemplate(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			setArgs, _ := cmd.Flags().GetStringArray(flagSet)
+			values, err := parseTxTemplateValues(setArgs)
+			if err != nil {
+				return err
+			}
+
+			txJSON, err := tmpl.apply(values)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := clientCtx.TxConfig.TxJSONDecoder()(txJSON)
+			if err != nil {
+				return fmt.Errorf("template %s does not produce a valid transaction: %w", tmpl.Name, err)
+			}
+
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(stdTx)
+			if err != nil {
+				return err
+			}
+
+			closeFunc, err := setOutputFile(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFunc()
+
+			cmd.Printf("%s\n", bz)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArray(flagSet, nil, "Set the {{name}} placeholder of the template, given as name=value (can be repeated)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// newTxTemplate builds a template from the JSON encoded transaction, replacing
+// the string values equal to a placeholder value by that placeholder.
+func newTxTemplate(name string, txJSON []byte, placeholders map[string]string) (txTemplate, error) {
+	var tx any
+	if err := json.Unmarshal(txJSON, &tx); err != nil {
+		return txTemplate{}, err
+	}
+
+	names := make([]string, 0, len(placeholders))
+	for placeholder, value := range placeholders {
+		if value == "" {
+			return txTemplate{}, fmt.Errorf("placeholder %s has an empty value", placeholder)
+		}
+
+		var replaced int
+		tx = replaceTxTemplateStrings(tx, value, txTemplatePlaceholder(placeholder), &replaced)
+		if replaced == 0 {
+			return txTemplate{}, fmt.Errorf("placeholder %s: value %q not found in the transaction", placeholder, value)
+		}
+
+		names = append(names, placeholder)
+	}
+	sort.Strings(names)
+
+	bz, err := json.Marshal(tx)
+	if err != nil {
+		return txTemplate{}, err
+	}
+
+	return txTemplate{Name: name, Placeholders: names, Tx: bz}, nil
+}
+
+// apply returns the JSON encoded transaction of the template with its
+// placeholders set to the given values. Every placeholder must be set, and
+// only placeholders of the template can be set.
+func (t txTemplate) apply(values map[string]string) ([]byte, error) {
+	for name := range values {
+		if !slices.Contains(t.Placeholders, name) {
+			return nil, fmt.Errorf("template %s has no placeholder %s", t.Name, name)
+		}
+	}
+
+	var tx any
+	if err := json.Unmarshal(t.Tx, &tx); err != nil {
+		return nil, err
+	}
+
+	for _, name := range t.Placeholders {
+		value, ok := values[name]
+		if !ok {
+			return nil, fmt.Errorf("placeholder %s of template %s is not set, use --%s %s=<value>", name, t.Name, flagSet, name)
+		}
+
+		var replaced int
+		tx = replaceTxTemplateStrings(tx, txTemplatePlaceholder(name), value, &replaced)
+	}
+
+	return json.Marshal(tx)
+}
+
+// replaceTxTemplateStrings replaces the string values of v equal to old by
+// new, counting the replacements.
+func replaceTxTemplateStrings(v any, old, new string, replaced *int) any {
+	switch v := v.(type) {
+	case string:
+		if v == old {
+			*replaced++
+			return new
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = replaceTxTemplateStrings(v[i], old, new, replaced)
+		}
+		return v
+	case map[string]any:
+		for k := range v {
+			v[k] = replaceTxTemplateStrings(v[k], old, new, replaced)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// parseTxTemplateValues parses name=value pairs.
+func parseTxTemplateValues(args []string) (map[string]string, error) {
+	values := make(map[string]string, len(args))
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid value %q, expected name=value", arg)
+		}
+
+		if !txTemplateNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid placeholder name %q: only letters, digits, '_' and '-' are allowed", name)
+		}
+
+		if _, ok := values[name]; ok {
+			return nil, fmt.Errorf("placeholder %s is given more than once", name)
+		}
+
+		values[name] = value
+	}
+
+	return values, nil
+}
+
+// validateUnsignedTx ensures that a transaction saved as a template carries no
+// signatures, as they would not be valid anymore once placeholders are set.
+func validateUnsignedTx(tx sdk.Tx) error {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return fmt.Errorf("expected %T, got %T", (authsigning.Tx)(nil), tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	if len(sigs) > 0 {
+		return errors.New("only unsigned transactions can be saved as templates")
+	}
+
+	return nil
+}
+
+func readTxTemplate(clientCtx client.Context, name string) (txTemplate, error) {
+	if !txTemplateNameRegex.MatchString(name) {
+		return txTemplate{}, fmt.Errorf("invalid template name %q: only letters, digits, '_' and '-' are allowed", name)
+	}
+
+	bz, err := os.ReadFile(txTemplatePath(clientCtx, name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return txTemplate{}, fmt.Errorf("template %s not found", name)
+		}
+		return txTemplate{}, err
+	}
+
+	var tmpl txTemplate
+	if err := json.Unmarshal(bz, &tmpl); err != nil {
+		return txTemplate{}, fmt.Errorf("failed to decode template %s: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+func txTemplatePath(clientCtx client.Context, name string) string {
+	return filepath.Join(clientCtx.HomeDir, txTemplatesDir, name+".json")
+}
+
+func txTemplatePlaceholder(name string) string {
+	return "{{" + name + "}}"
+}
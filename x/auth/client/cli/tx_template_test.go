@@ -0,0 +1,97 @@
+package cli_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth"
+	"cosmossdk.io/x/auth/client/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestTxTemplate(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	txConfig := encodingConfig.TxConfig
+
+	payer := sdk.AccAddress("payer_______________")
+	newPayer := sdk.AccAddress("new_payer___________")
+	payerStr, err := encodingConfig.Codec.InterfaceRegistry().SigningContext().AddressCodec().BytesToString(payer)
+	require.NoError(t, err)
+	newPayerStr, err := encodingConfig.Codec.InterfaceRegistry().SigningContext().AddressCodec().BytesToString(newPayer)
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	builder.SetFeePayer(payer)
+	builder.SetMemo("monthly grant")
+	jsonEncoded, err := txConfig.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	txFileName := testutil.WriteToNewTempFile(t, string(jsonEncoded)).Name()
+
+	clientCtx := client.Context{}.
+		WithTxConfig(txConfig).
+		WithCodec(encodingConfig.Codec).
+		WithHomeDir(t.TempDir()).
+		WithOutputFormat("json")
+
+	execute := func(cmd *cobra.Command, args ...string) (string, error) {
+		_, out := testutil.ApplyMockIO(cmd)
+		ctx := clientCtx.WithOutput(out)
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &ctx))
+		return out.String(), err
+	}
+
+	// list with no template saved yet
+	out, err := execute(cli.GetTxTemplateListCmd())
+	require.NoError(t, err)
+	require.JSONEq(t, `[]`, out)
+
+	// save with a value not found in the transaction
+	_, err = execute(cli.GetTxTemplateSaveCmd(), "grant", txFileName, "--placeholder", "amount=999")
+	require.ErrorContains(t, err, "not found in the transaction")
+
+	_, err = execute(cli.GetTxTemplateSaveCmd(), "../grant", txFileName)
+	require.ErrorContains(t, err, "invalid template name")
+
+	_, err = execute(cli.GetTxTemplateSaveCmd(), "grant", txFileName, "--placeholder", "payer="+payerStr, "--placeholder", "amount=150")
+	require.NoError(t, err)
+
+	_, err = execute(cli.GetTxTemplateSaveCmd(), "grant", txFileName)
+	require.ErrorContains(t, err, "already exists")
+
+	out, err = execute(cli.GetTxTemplateListCmd())
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"name":"grant","placeholders":["amount","payer"]}]`, out)
+
+	// apply with missing or unknown placeholders
+	_, err = execute(cli.GetTxTemplateApplyCmd(), "grant", "--set", "amount=300")
+	require.ErrorContains(t, err, "placeholder payer of template grant is not set")
+
+	_, err = execute(cli.GetTxTemplateApplyCmd(), "grant", "--set", "amount=300", "--set", "payer="+newPayerStr, "--set", "memo=foo")
+	require.ErrorContains(t, err, "template grant has no placeholder memo")
+
+	_, err = execute(cli.GetTxTemplateApplyCmd(), "unknown")
+	require.ErrorContains(t, err, "template unknown not found")
+
+	out, err = execute(cli.GetTxTemplateApplyCmd(), "grant", "--set", "amount=300", "--set", "payer="+newPayerStr)
+	require.NoError(t, err)
+
+	tx, err := txConfig.TxJSONDecoder()([]byte(out))
+	require.NoError(t, err)
+	feeTx, ok := tx.(sdk.FeeTx)
+	require.True(t, ok)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 300)}, feeTx.GetFee())
+	require.Equal(t, uint64(50000), feeTx.GetGas())
+	require.Contains(t, out, newPayerStr)
+	require.NotContains(t, out, payerStr)
+}
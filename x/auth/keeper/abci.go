@@ -0,0 +1,9 @@
+package keeper
+
+import "context"
+
+// BeginBlocker deletes the counts of the fee exempt transactions of the
+// previous blocks.
+func (ak AccountKeeper) BeginBlocker(ctx context.Context) error {
+	return ak.PruneFeeExemptTxCounts(ctx)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetLastActivityHeight returns the height of the last transaction signed by
+// the account, 0 if none was recorded.
+func (ak AccountKeeper) GetLastActivityHeight(ctx context.Context, addr sdk.AccAddress) (int64, error) {
+	height, err := ak.LastActivityHeights.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// SetLastActivityHeight sets the height of the last transaction signed by the
+// account. It is only called by the ante handler when the account activity is
+// tracked.
+func (ak AccountKeeper) SetLastActivityHeight(ctx context.Context, addr sdk.AccAddress, height int64) error {
+	return ak.LastActivityHeights.Set(ctx, addr, height)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// IncrementFeeExemptTxCount increments the count of the transactions of the
+// current block paying no fee under the fee exemption, and returns it. It is
+// only called when finalizing a block, the counts of the previous blocks being
+// deleted by PruneFeeExemptTxCounts.
+func (ak AccountKeeper) IncrementFeeExemptTxCount(ctx context.Context) (uint64, error) {
+	height := ak.environment.HeaderService.GetHeaderInfo(ctx).Height
+
+	count, err := ak.FeeExemptTxs.Get(ctx, height)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	count++
+
+	return count, ak.FeeExemptTxs.Set(ctx, height, count)
+}
+
+// PruneFeeExemptTxCounts deletes the counts of the fee exempt transactions of
+// the blocks before the current one. It is called once per block by the begin
+// blocker, so at most the count of the previous block is deleted.
+func (ak AccountKeeper) PruneFeeExemptTxCounts(ctx context.Context) error {
+	height := ak.environment.HeaderService.GetHeaderInfo(ctx).Height
+
+	return ak.FeeExemptTxs.Clear(ctx, new(collections.Range[int64]).EndExclusive(height))
+}
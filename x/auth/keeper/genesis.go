@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,9 @@ import (
 // CONTRACT: old coins from the FeeCollectionKeeper need to be transferred through
 // a genesis port script to the new fee collector account
 func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState) error {
+	if err := data.Params.ValidateFeeExemptAccounts(ak.addressCodec); err != nil {
+		return err
+	}
 	if err := ak.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -35,6 +39,26 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, ls := range data.LaneSequences {
+		addr, err := ak.addressCodec.StringToBytes(ls.Address)
+		if err != nil {
+			return err
+		}
+		if err := ak.SetLaneSequence(ctx, addr, ls.Lane, ls.Sequence); err != nil {
+			return err
+		}
+	}
+
+	for _, activity := range data.AccountActivities {
+		addr, err := ak.addressCodec.StringToBytes(activity.Address)
+		if err != nil {
+			return err
+		}
+		if err := ak.SetLastActivityHeight(ctx, addr, activity.LastActivityHeight); err != nil {
+			return err
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 	return nil
 }
@@ -52,5 +76,37 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	err = ak.LaneSequences.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], sequence uint64) (stop bool, err error) {
+		addr, err := ak.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		genState.LaneSequences = append(genState.LaneSequences, types.LaneSequence{
+			Address:  addr,
+			Lane:     key.K2(),
+			Sequence: sequence,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ak.LastActivityHeights.Walk(ctx, nil, func(key sdk.AccAddress, height int64) (stop bool, err error) {
+		addr, err := ak.addressCodec.BytesToString(key)
+		if err != nil {
+			return true, err
+		}
+		genState.AccountActivities = append(genState.AccountActivities, types.AccountActivity{
+			Address:            addr,
+			LastActivityHeight: height,
+		})
+		return false, nil
+	})
+	return genState, err
 }
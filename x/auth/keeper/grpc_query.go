@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -89,7 +90,25 @@ func (s queryServer) Account(ctx context.Context, req *types.QueryAccountRequest
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAccountResponse{Account: any}, nil
+	lastActivityHeight, err := s.lastActivityHeight(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountResponse{Account: any, LastActivityHeight: lastActivityHeight}, nil
+}
+
+// lastActivityHeight returns the last activity height of the account, or 0 while
+// the TrackAccountActivity param is disabled, without reading it. The param is
+// read without charging gas, so that the account queries cost the same gas as
+// before the activity was tracked on the chains which do not track it.
+func (s queryServer) lastActivityHeight(ctx context.Context, addr sdk.AccAddress) (int64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !s.k.GetParams(sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())).TrackAccountActivity {
+		return 0, nil
+	}
+
+	return s.k.GetLastActivityHeight(ctx, addr)
 }
 
 // Params returns parameters of auth module
@@ -156,6 +175,43 @@ func (s queryServer) ModuleAccountByName(ctx context.Context, req *types.QueryMo
 	return &types.QueryModuleAccountByNameResponse{Account: any}, nil
 }
 
+// ModuleAccountPermissions returns the permissions granted to a module account
+func (s queryServer) ModuleAccountPermissions(ctx context.Context, req *types.QueryModuleAccountPermissionsRequest) (*types.QueryModuleAccountPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "module name is empty")
+	}
+
+	addr, wiringPerms := s.k.GetModuleAddressAndPermissions(req.Name)
+	if addr == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Name)
+	}
+
+	// a module account not created yet is granted its wiring permissions on creation.
+	perms := wiringPerms
+	if acc := s.k.GetAccount(ctx, addr); acc != nil {
+		macc, ok := acc.(sdk.ModuleAccountI)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "account %s is not a module account", req.Name)
+		}
+		perms = macc.GetPermissions()
+	}
+
+	addrStr, err := s.k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModuleAccountPermissionsResponse{
+		Address:           addrStr,
+		Permissions:       perms,
+		WiringPermissions: wiringPerms,
+	}, nil
+}
+
 // Bech32Prefix returns the keeper internally stored bech32 prefix.
 func (s queryServer) Bech32Prefix(ctx context.Context, req *types.Bech32PrefixRequest) (*types.Bech32PrefixResponse, error) {
 	bech32Prefix, err := s.k.getBech32Prefix()
@@ -238,6 +294,11 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		}
 	}
 
+	lastActivityHeight, err := s.lastActivityHeight(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &types.QueryAccountInfoResponse{
 		Info: &types.BaseAccount{
 			Address:       req.Address,
@@ -245,5 +306,33 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 			AccountNumber: account.GetAccountNumber(),
 			Sequence:      account.GetSequence(),
 		},
+		LastActivityHeight: lastActivityHeight,
 	}, nil
 }
+
+// LaneSequence implements the LaneSequence query.
+func (s queryServer) LaneSequence(ctx context.Context, req *types.QueryLaneSequenceRequest) (*types.QueryLaneSequenceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Lane == 0 && s.k.GetAccount(ctx, addr) == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	sequence, err := s.k.GetLaneSequence(ctx, addr, req.Lane)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLaneSequenceResponse{Sequence: sequence}, nil
+}
@@ -405,6 +405,30 @@ func (suite *KeeperTestSuite) TestGRPCQueryModuleAccountByName() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAccountPermissions() {
+	suite.SetupTest() // reset
+
+	_, err := suite.queryClient.ModuleAccountPermissions(suite.ctx, &types.QueryModuleAccountPermissionsRequest{})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.ModuleAccountPermissions(suite.ctx, &types.QueryModuleAccountPermissionsRequest{Name: "gover"})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.ModuleAccountPermissions(suite.ctx, &types.QueryModuleAccountPermissionsRequest{Name: "mint"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.accountKeeper.GetModuleAddress("mint").String(), res.Address)
+	suite.Require().Equal([]string{types.Minter}, res.Permissions)
+	suite.Require().Equal([]string{types.Minter}, res.WiringPermissions)
+
+	err = suite.accountKeeper.SetModuleAccountPermissions(suite.ctx, "mint", []string{types.Burner, types.Staking})
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.ModuleAccountPermissions(suite.ctx, &types.QueryModuleAccountPermissionsRequest{Name: "mint"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{types.Burner, types.Staking}, res.Permissions)
+	suite.Require().Equal([]string{types.Minter}, res.WiringPermissions)
+}
+
 func (suite *KeeperTestSuite) TestBech32Prefix() {
 	suite.SetupTest() // reset
 	req := &types.Bech32PrefixRequest{}
@@ -536,3 +560,61 @@ func (suite *KeeperTestSuite) TestQueryAccountInfoWithoutPubKey() {
 	suite.Require().Equal(addr.String(), res.Info.Address)
 	suite.Require().Nil(res.Info.PubKey)
 }
+
+func (suite *KeeperTestSuite) TestQueryLaneSequence() {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	// the account sequence is only known to existing accounts
+	_, err := suite.queryClient.LaneSequence(context.Background(), &types.QueryLaneSequenceRequest{Address: addr.String()})
+	suite.Require().ErrorContains(err, "not found")
+
+	res, err := suite.queryClient.LaneSequence(context.Background(), &types.QueryLaneSequenceRequest{Address: addr.String(), Lane: 1})
+	suite.Require().NoError(err)
+	suite.Require().Zero(res.Sequence)
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.Require().NoError(acc.SetSequence(3))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+	suite.Require().NoError(suite.accountKeeper.SetLaneSequence(suite.ctx, addr, 1, 5))
+
+	res, err = suite.queryClient.LaneSequence(context.Background(), &types.QueryLaneSequenceRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.Sequence)
+
+	res, err = suite.queryClient.LaneSequence(context.Background(), &types.QueryLaneSequenceRequest{Address: addr.String(), Lane: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), res.Sequence)
+
+	suite.Require().Error(suite.accountKeeper.SetLaneSequence(suite.ctx, addr, 0, 1))
+}
+
+func (suite *KeeperTestSuite) TestQueryLastActivityHeight() {
+	_, pk, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(pk))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	// no activity recorded yet
+	res, err := suite.queryClient.Account(context.Background(), &types.QueryAccountRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Zero(res.LastActivityHeight)
+
+	suite.Require().NoError(suite.accountKeeper.SetLastActivityHeight(suite.ctx, addr, 12))
+
+	// the height is not read while the activity is not tracked
+	res, err = suite.queryClient.Account(context.Background(), &types.QueryAccountRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Zero(res.LastActivityHeight)
+
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	params.TrackAccountActivity = true
+	suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, params))
+
+	res, err = suite.queryClient.Account(context.Background(), &types.QueryAccountRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(12), res.LastActivityHeight)
+
+	infoRes, err := suite.queryClient.AccountInfo(context.Background(), &types.QueryAccountInfoRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(12), infoRes.LastActivityHeight)
+}
@@ -100,6 +100,12 @@ type AccountKeeper struct {
 	AccountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// LaneSequences key: AccAddr+Lane | value: Sequence
+	LaneSequences collections.Map[collections.Pair[sdk.AccAddress, uint64], uint64]
+	// FeeExemptTxs key: Height | value: count of the fee exempt txs of the block
+	FeeExemptTxs collections.Map[int64, uint64]
+	// LastActivityHeights key: AccAddr | value: height of the last tx of the account
+	LastActivityHeights collections.Map[sdk.AccAddress, int64]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -122,16 +128,19 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
 	ak := AccountKeeper{
-		addressCodec:  ac,
-		bech32Prefix:  bech32Prefix,
-		environment:   env,
-		proto:         proto,
-		cdc:           cdc,
-		permAddrs:     permAddrs,
-		authority:     authority,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
-		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		addressCodec:        ac,
+		bech32Prefix:        bech32Prefix,
+		environment:         env,
+		proto:               proto,
+		cdc:                 cdc,
+		permAddrs:           permAddrs,
+		authority:           authority,
+		Params:              collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:       collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		Accounts:            collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		LaneSequences:       collections.NewMap(sb, types.LaneSequencesPrefix, "lane_sequences", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), collections.Uint64Value),
+		FeeExemptTxs:        collections.NewMap(sb, types.FeeExemptTxsPrefix, "fee_exempt_txs", collections.Int64Key, collections.Uint64Value),
+		LastActivityHeights: collections.NewMap(sb, types.LastActivityHeightsPrefix, "last_activity_heights", sdk.AccAddressKey, collections.Int64Value),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -262,6 +271,41 @@ func (ak AccountKeeper) SetModuleAccount(ctx context.Context, macc sdk.ModuleAcc
 	ak.SetAccount(ctx, macc)
 }
 
+// SetModuleAccountPermissions replaces the permissions granted to the module account
+// registered under moduleName, creating the account if it does not exist yet.
+// Only the permissions accepted by types.ValidateGrantablePermissions can be granted,
+// and the other permissions of the account, which cannot be granted or revoked at
+// runtime, are kept.
+//
+// The permissions are stored in the module account, which is what modules check
+// them against, so they may differ from the permissions registered at app wiring.
+func (ak AccountKeeper) SetModuleAccountPermissions(ctx context.Context, moduleName string, permissions []string) error {
+	if _, ok := ak.permAddrs[moduleName]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s is not registered", moduleName)
+	}
+
+	if err := types.ValidateGrantablePermissions(permissions); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	macc, ok := ak.GetModuleAccount(ctx, moduleName).(*types.ModuleAccount)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "module account %s does not support permission updates", moduleName)
+	}
+
+	kept := make([]string, 0, len(macc.Permissions)+len(permissions))
+	for _, perm := range macc.Permissions {
+		if types.ValidateGrantablePermissions([]string{perm}) != nil {
+			kept = append(kept, perm)
+		}
+	}
+
+	macc.Permissions = append(kept, permissions...)
+	ak.SetModuleAccount(ctx, macc)
+
+	return nil
+}
+
 // add getter for bech32Prefix
 func (ak AccountKeeper) getBech32Prefix() (string, error) {
 	return ak.bech32Prefix, nil
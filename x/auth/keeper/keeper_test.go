@@ -13,6 +13,7 @@ import (
 	authcodec "cosmossdk.io/x/auth/codec"
 	"cosmossdk.io/x/auth/keeper"
 	"cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -47,7 +48,7 @@ type KeeperTestSuite struct {
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
-	suite.encCfg = moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	suite.encCfg = moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
@@ -232,3 +233,88 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
 }
+
+func (suite *KeeperTestSuite) TestLaneSequencesGenesis() {
+	suite.SetupTest() // reset
+	ctx := suite.ctx
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addrStr, err := suite.accountKeeper.AddressCodec().BytesToString(addr)
+	suite.Require().NoError(err)
+
+	genState := *types.DefaultGenesisState()
+	genState.LaneSequences = []types.LaneSequence{
+		{Address: addrStr, Lane: 1, Sequence: 4},
+		{Address: addrStr, Lane: 7, Sequence: 2},
+	}
+	suite.Require().NoError(types.ValidateGenesis(genState))
+	suite.Require().NoError(suite.accountKeeper.InitGenesis(ctx, genState))
+
+	seq, err := suite.accountKeeper.GetLaneSequence(ctx, addr, 7)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), seq)
+
+	// lanes without transactions start at sequence 0
+	seq, err = suite.accountKeeper.GetLaneSequence(ctx, addr, 2)
+	suite.Require().NoError(err)
+	suite.Require().Zero(seq)
+
+	exported, err := suite.accountKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.LaneSequences, exported.LaneSequences)
+
+	// lane 0 is the account sequence
+	genState.LaneSequences = append(genState.LaneSequences, types.LaneSequence{Address: addrStr, Lane: 0, Sequence: 1})
+	suite.Require().ErrorContains(types.ValidateGenesis(genState), "lane 0")
+	genState.LaneSequences[2].Lane = 1
+	suite.Require().ErrorContains(types.ValidateGenesis(genState), "duplicate lane sequence")
+}
+
+func (suite *KeeperTestSuite) TestAccountActivitiesGenesis() {
+	suite.SetupTest() // reset
+	ctx := suite.ctx
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addrStr, err := suite.accountKeeper.AddressCodec().BytesToString(addr)
+	suite.Require().NoError(err)
+
+	genState := *types.DefaultGenesisState()
+	genState.AccountActivities = []types.AccountActivity{{Address: addrStr, LastActivityHeight: 42}}
+	suite.Require().NoError(types.ValidateGenesis(genState))
+	suite.Require().NoError(suite.accountKeeper.InitGenesis(ctx, genState))
+
+	height, err := suite.accountKeeper.GetLastActivityHeight(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(42), height)
+
+	exported, err := suite.accountKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.AccountActivities, exported.AccountActivities)
+
+	genState.AccountActivities = append(genState.AccountActivities, types.AccountActivity{Address: addrStr, LastActivityHeight: 43})
+	suite.Require().ErrorContains(types.ValidateGenesis(genState), "duplicate account activity")
+	genState.AccountActivities = []types.AccountActivity{{Address: addrStr}}
+	suite.Require().ErrorContains(types.ValidateGenesis(genState), "non-positive height")
+}
+
+func (suite *KeeperTestSuite) TestFeeExemptTxCounts() {
+	suite.SetupTest() // reset
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	for i := uint64(1); i <= 2; i++ {
+		count, err := suite.accountKeeper.IncrementFeeExemptTxCount(ctx)
+		suite.Require().NoError(err)
+		suite.Require().Equal(i, count)
+	}
+
+	// the count of the previous block is deleted by the begin blocker
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2})
+	suite.Require().NoError(suite.accountKeeper.BeginBlocker(ctx))
+	has, err := suite.accountKeeper.FeeExemptTxs.Has(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	count, err := suite.accountKeeper.IncrementFeeExemptTxCount(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), count)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetLaneSequence returns the sequence of a nonce lane of the account, which
+// the next transaction of the lane is signed with. Lane 0 is the account
+// sequence.
+func (ak AccountKeeper) GetLaneSequence(ctx context.Context, addr sdk.AccAddress, lane uint64) (uint64, error) {
+	if lane == 0 {
+		return ak.GetSequence(ctx, addr)
+	}
+
+	seq, err := ak.LaneSequences.Get(ctx, collections.Join(addr, lane))
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return seq, err
+}
+
+// SetLaneSequence sets the sequence of a nonce lane of the account. Lane 0 is
+// the account sequence, which is set through the account.
+func (ak AccountKeeper) SetLaneSequence(ctx context.Context, addr sdk.AccAddress, lane, sequence uint64) error {
+	if lane == 0 {
+		return errors.New("lane 0 is the account sequence")
+	}
+
+	return ak.LaneSequences.Set(ctx, collections.Join(addr, lane), sequence)
+}
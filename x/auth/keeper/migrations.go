@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v5 "cosmossdk.io/x/auth/migrations/v5"
+	v6 "cosmossdk.io/x/auth/migrations/v6"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -45,6 +46,12 @@ func (m Migrator) Migrate4To5(ctx context.Context) error {
 	return v5.Migrate(ctx, m.keeper.environment.KVStoreService, m.keeper.AccountNumber)
 }
 
+// Migrate5To6 migrates the x/auth module state from the consensus version 5 to 6.
+// It keeps secp256r1 signatures enabled now that they are toggled by the params.
+func (m Migrator) Migrate5To6(ctx context.Context) error {
+	return v6.Migrate(ctx, m.keeper.Params)
+}
+
 // V45_SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
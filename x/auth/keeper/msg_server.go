@@ -5,6 +5,9 @@ import (
 	"fmt"
 
 	"cosmossdk.io/x/auth/types"
+	vestingexported "cosmossdk.io/x/auth/vesting/exported"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -31,9 +34,56 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 		return nil, err
 	}
 
+	if err := msg.Params.ValidateFeeExemptAccounts(ms.ak.AddressCodec()); err != nil {
+		return nil, err
+	}
+
 	if err := ms.ak.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ConvertVestedAccount replaces a vesting account whose schedule has fully elapsed
+// with a BaseAccount keeping the same address, public key, account number and sequence.
+func (ms msgServer) ConvertVestedAccount(ctx context.Context, msg *types.MsgConvertVestedAccount) (*types.MsgConvertVestedAccountResponse, error) {
+	addr, err := ms.ak.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	acc := ms.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", msg.Address)
+	}
+
+	vestingAcc, ok := acc.(vestingexported.VestingAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s is not a vesting account", msg.Address)
+	}
+
+	if !vestingAcc.GetVestingCoins(ms.ak.environment.HeaderService.GetHeaderInfo(ctx).Time).IsZero() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s is still vesting", msg.Address)
+	}
+
+	baseAcc := types.NewBaseAccount(acc.GetAddress(), acc.GetPubKey(), acc.GetAccountNumber(), acc.GetSequence())
+	ms.ak.SetAccount(ctx, baseAcc)
+
+	return &types.MsgConvertVestedAccountResponse{}, nil
+}
+
+// UpdateModuleAccountPermissions replaces the permissions granted to a module account.
+func (ms msgServer) UpdateModuleAccountPermissions(ctx context.Context, msg *types.MsgUpdateModuleAccountPermissions) (*types.MsgUpdateModuleAccountPermissionsResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, fmt.Errorf(
+			"expected authority account as only signer for proposal message; invalid authority; expected %s, got %s",
+			ms.ak.authority, msg.Authority)
+	}
+
+	if err := ms.ak.SetModuleAccountPermissions(ctx, msg.ModuleName, msg.Permissions); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateModuleAccountPermissionsResponse{}, nil
+}
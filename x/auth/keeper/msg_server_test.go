@@ -1,7 +1,14 @@
 package keeper_test
 
 import (
+	"slices"
+	"time"
+
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -109,3 +116,187 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestConvertVestedAccount() {
+	now := time.Now()
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: now})
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	newBaseAccount := func() *types.BaseAccount {
+		addr := sdk.AccAddress([]byte("addr" + now.String())[:20])
+		now = now.Add(time.Nanosecond)
+		acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr)
+		s.Require().NoError(acc.SetSequence(3))
+		return acc.(*types.BaseAccount)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func() string
+		expErrMsg string
+	}{
+		{
+			name: "invalid address",
+			malleate: func() string {
+				return "invalid"
+			},
+			expErrMsg: "invalid address",
+		},
+		{
+			name: "account does not exist",
+			malleate: func() string {
+				return sdk.AccAddress([]byte("unknown_address_____")).String()
+			},
+			expErrMsg: "does not exist",
+		},
+		{
+			name: "not a vesting account",
+			malleate: func() string {
+				acc := newBaseAccount()
+				s.accountKeeper.SetAccount(s.ctx, acc)
+				return acc.Address
+			},
+			expErrMsg: "is not a vesting account",
+		},
+		{
+			name: "still vesting",
+			malleate: func() string {
+				acc, err := vestingtypes.NewContinuousVestingAccount(newBaseAccount(), coins, s.ctx.HeaderInfo().Time.Unix()-10, s.ctx.HeaderInfo().Time.Unix()+10)
+				s.Require().NoError(err)
+				s.accountKeeper.SetAccount(s.ctx, acc)
+				return acc.Address
+			},
+			expErrMsg: "is still vesting",
+		},
+		{
+			name: "permanent locked account",
+			malleate: func() string {
+				acc, err := vestingtypes.NewPermanentLockedAccount(newBaseAccount(), coins)
+				s.Require().NoError(err)
+				s.accountKeeper.SetAccount(s.ctx, acc)
+				return acc.Address
+			},
+			expErrMsg: "is still vesting",
+		},
+		{
+			name: "fully vested account",
+			malleate: func() string {
+				acc, err := vestingtypes.NewDelayedVestingAccount(newBaseAccount(), coins, s.ctx.HeaderInfo().Time.Unix()-1)
+				s.Require().NoError(err)
+				s.accountKeeper.SetAccount(s.ctx, acc)
+				return acc.Address
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			addr := tc.malleate()
+			_, err := s.msgServer.ConvertVestedAccount(s.ctx, &types.MsgConvertVestedAccount{Address: addr})
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			accAddr, err := s.accountKeeper.AddressCodec().StringToBytes(addr)
+			s.Require().NoError(err)
+			acc := s.accountKeeper.GetAccount(s.ctx, accAddr)
+			baseAcc, ok := acc.(*types.BaseAccount)
+			s.Require().True(ok)
+			s.Require().Equal(addr, baseAcc.Address)
+			s.Require().Equal(uint64(3), baseAcc.Sequence)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateModuleAccountPermissions() {
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateModuleAccountPermissions
+		expPerms  []string
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &types.MsgUpdateModuleAccountPermissions{
+				Authority:   "foo",
+				ModuleName:  "mint",
+				Permissions: []string{types.Minter},
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "unregistered module account",
+			req: &types.MsgUpdateModuleAccountPermissions{
+				Authority:   s.accountKeeper.GetAuthority(),
+				ModuleName:  "unknown",
+				Permissions: []string{types.Minter},
+			},
+			expErrMsg: "is not registered",
+		},
+		{
+			name: "permission cannot be granted",
+			req: &types.MsgUpdateModuleAccountPermissions{
+				Authority:   s.accountKeeper.GetAuthority(),
+				ModuleName:  "mint",
+				Permissions: []string{types.Minter, "random"},
+			},
+			expErrMsg: "cannot be granted",
+		},
+		{
+			name: "duplicate permission",
+			req: &types.MsgUpdateModuleAccountPermissions{
+				Authority:   s.accountKeeper.GetAuthority(),
+				ModuleName:  "mint",
+				Permissions: []string{types.Minter, types.Minter},
+			},
+			expErrMsg: "duplicate module permission",
+		},
+		{
+			name: "grant permissions",
+			req: &types.MsgUpdateModuleAccountPermissions{
+				Authority:   s.accountKeeper.GetAuthority(),
+				ModuleName:  "mint",
+				Permissions: []string{types.Minter, types.Burner},
+			},
+		},
+		{
+			name: "revoke all permissions",
+			req: &types.MsgUpdateModuleAccountPermissions{
+				Authority:  s.accountKeeper.GetAuthority(),
+				ModuleName: multiPerm,
+			},
+		},
+		{
+			name: "keep the permissions which cannot be granted",
+			req: &types.MsgUpdateModuleAccountPermissions{
+				Authority:   s.accountKeeper.GetAuthority(),
+				ModuleName:  randomPerm,
+				Permissions: []string{types.Burner},
+			},
+			expPerms: []string{"random", types.Burner},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateModuleAccountPermissions(s.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			expPerms := tc.req.Permissions
+			if tc.expPerms != nil {
+				expPerms = tc.expPerms
+			}
+			macc := s.accountKeeper.GetModuleAccount(s.ctx, tc.req.ModuleName)
+			s.Require().Equal(expPerms, macc.GetPermissions())
+			for _, perm := range []string{types.Minter, types.Burner, types.Staking} {
+				s.Require().Equal(slices.Contains(tc.req.Permissions, perm), macc.HasPermission(perm))
+			}
+		})
+	}
+}
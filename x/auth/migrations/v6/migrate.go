@@ -0,0 +1,25 @@
+package v6
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+)
+
+// Migrate enables secp256r1 signatures in the stored params. Params stored before the
+// signature algorithm toggles were introduced decode with every algorithm disabled,
+// whereas secp256r1 signatures were accepted until now.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	p.EnableSecp256r1 = true
+	return params.Set(ctx, p)
+}
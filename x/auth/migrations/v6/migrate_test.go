@@ -0,0 +1,38 @@
+package v6
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func TestMigrate(t *testing.T) {
+	kv, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(kv)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// no params stored
+	require.NoError(t, Migrate(ctx, params))
+
+	// params stored before the signature algorithm toggles
+	legacy := types.DefaultParams()
+	legacy.EnableSecp256r1 = false
+	legacy.EnableED25519 = false
+	require.NoError(t, params.Set(ctx, legacy))
+
+	require.NoError(t, Migrate(ctx, params))
+
+	got, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, got.EnableSecp256r1)
+	require.False(t, got.EnableED25519)
+	require.Equal(t, legacy.SigVerifyCostSecp256k1, got.SigVerifyCostSecp256k1)
+}
@@ -22,7 +22,7 @@ import (
 
 // ConsensusVersion defines the current x/auth module consensus version.
 const (
-	ConsensusVersion = 5
+	ConsensusVersion = 6
 	GovModuleName    = "gov"
 )
 
@@ -30,10 +30,11 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasName             = AppModule{}
 
-	_ appmodule.HasGenesis    = AppModule{}
-	_ appmodule.AppModule     = AppModule{}
-	_ appmodule.HasServices   = AppModule{}
-	_ appmodule.HasMigrations = AppModule{}
+	_ appmodule.HasGenesis      = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasServices     = AppModule{}
+	_ appmodule.HasMigrations   = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
 )
 
 // AppModule implements an application module for the auth module.
@@ -101,10 +102,18 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 	if err := mr.Register(types.ModuleName, 4, m.Migrate4To5); err != nil {
 		return fmt.Errorf("failed to migrate x/%s from version 4 to 5: %w", types.ModuleName, err)
 	}
+	if err := mr.Register(types.ModuleName, 5, m.Migrate5To6); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 5 to 6: %w", types.ModuleName, err)
+	}
 
 	return nil
 }
 
+// BeginBlock returns the begin blocker for the auth module.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.accountKeeper.BeginBlocker(ctx)
+}
+
 // DefaultGenesis returns default genesis state as raw bytes for the auth module.
 func (am AppModule) DefaultGenesis() json.RawMessage {
 	return am.cdc.MustMarshalJSON(types.DefaultGenesisState())
@@ -117,6 +126,10 @@ func (am AppModule) ValidateGenesis(bz json.RawMessage) error {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
 
+	if err := data.Params.ValidateFeeExemptAccounts(am.accountKeeper.AddressCodec()); err != nil {
+		return err
+	}
+
 	return types.ValidateGenesis(data)
 }
 
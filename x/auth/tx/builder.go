@@ -61,6 +61,7 @@ func newBuilderFromDecodedTx(addrCodec address.Codec, decoder *decode.Decoder, c
 		granter:                     decoded.FeeGranter(),
 		payer:                       payer,
 		unordered:                   decoded.GetUnordered(),
+		lane:                        decoded.GetLane(),
 		memo:                        decoded.GetMemo(),
 		gasLimit:                    decoded.GetGas(),
 		fees:                        decoded.GetFee(),
@@ -81,6 +82,7 @@ type builder struct {
 	granter       []byte
 	payer         []byte
 	unordered     bool
+	lane          uint64
 	memo          string
 	gasLimit      uint64
 	fees          sdk.Coins
@@ -125,6 +127,7 @@ func (w *builder) getTx() (*gogoTxWrapper, error) {
 		SignerInfos: intoV2SignerInfo(w.signerInfos),
 		Fee:         fee,
 		Tip:         nil, // deprecated
+		Lane:        w.lane,
 	}
 
 	bodyBytes, err := marshalOption.Marshal(body)
@@ -188,6 +191,9 @@ func (w *builder) SetTimeoutHeight(height uint64) { w.timeoutHeight = height }
 
 func (w *builder) SetUnordered(v bool) { w.unordered = v }
 
+// SetLane sets the nonce lane the transaction is sent on.
+func (w *builder) SetLane(lane uint64) { w.lane = lane }
+
 func (w *builder) SetMemo(memo string) { w.memo = memo }
 
 func (w *builder) SetGasLimit(limit uint64) { w.gasLimit = limit }
@@ -27,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/gasprice"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -101,7 +102,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	baseAppOption := func(app *baseapp.BaseApp) {
 		// AnteHandlers
 		if !in.Config.SkipAnteHandler {
-			anteHandler, err := newAnteHandler(txConfig, in)
+			anteHandler, err := newAnteHandler(txConfig, in, app.GasPriceTracker())
 			if err != nil {
 				panic(err)
 			}
@@ -140,7 +141,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	return ModuleOutputs{TxConfig: txConfig, TxConfigOptions: txConfigOptions, BaseAppOption: baseAppOption}
 }
 
-func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler, error) {
+func newAnteHandler(txConfig client.TxConfig, in ModuleInputs, gasPriceTracker *gasprice.Tracker) (sdk.AnteHandler, error) {
 	if in.BankKeeper == nil {
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
@@ -152,6 +153,7 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 			SignModeHandler: txConfig.SignModeHandler(),
 			FeegrantKeeper:  in.FeeGrantKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			GasPriceTracker: gasPriceTracker,
 		},
 	)
 	if err != nil {
@@ -176,6 +176,9 @@ func (w *gogoTxWrapper) GetTimeoutHeight() uint64 { return w.decodedTx.Tx.Body.T
 // GetUnordered returns the transaction's unordered field (if set).
 func (w *gogoTxWrapper) GetUnordered() bool { return w.decodedTx.Tx.Body.Unordered }
 
+// GetLane returns the nonce lane the transaction is sent on.
+func (w *gogoTxWrapper) GetLane() uint64 { return w.decodedTx.Tx.AuthInfo.Lane }
+
 // GetSignaturesV2 returns the signatures of the Tx.
 func (w *gogoTxWrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.decodedTx.Tx.AuthInfo.SignerInfos
@@ -16,8 +16,9 @@ import (
 )
 
 var (
-	_ client.Account          = sdk.AccountI(nil)
-	_ client.AccountRetriever = AccountRetriever{}
+	_ client.Account               = sdk.AccountI(nil)
+	_ client.AccountRetriever      = AccountRetriever{}
+	_ client.LaneSequenceRetriever = AccountRetriever{}
 )
 
 // AccountRetriever defines the properties of a type that can be used to
@@ -83,3 +84,18 @@ func (ar AccountRetriever) GetAccountNumberSequence(clientCtx client.Context, ad
 
 	return acc.GetAccountNumber(), acc.GetSequence(), nil
 }
+
+// GetLaneSequence returns the sequence of a nonce lane of the given address.
+// The lanes of an account which does not exist yet start at sequence 0.
+func (ar AccountRetriever) GetLaneSequence(clientCtx client.Context, addr sdk.AccAddress, lane uint64) (uint64, error) {
+	queryClient := NewQueryClient(clientCtx)
+	res, err := queryClient.LaneSequence(context.Background(), &QueryLaneSequenceRequest{Address: addr.String(), Lane: lane})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return res.Sequence, nil
+}
@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -118,6 +120,13 @@ type ModuleCredential struct {
 	// derivation_keys is for deriving a module account address (passed into address.Module)
 	// adding more keys creates sub-account addresses (passed into address.Derive)
 	DerivationKeys [][]byte `protobuf:"bytes,2,rep,name=derivation_keys,json=derivationKeys,proto3" json:"derivation_keys,omitempty"`
+	// account_address, if set, is the address of the credential instead of the
+	// address derived from module_name and derivation_keys. It is set for
+	// existing accounts put under the control of a module at their address, e.g.
+	// a legacy multisig account migrated to a group policy account.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountAddress []byte `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
 }
 
 func (m *ModuleCredential) Reset()         { *m = ModuleCredential{} }
@@ -167,6 +176,13 @@ func (m *ModuleCredential) GetDerivationKeys() [][]byte {
 	return nil
 }
 
+func (m *ModuleCredential) GetAccountAddress() []byte {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return nil
+}
+
 // Params defines the parameters for the auth module.
 type Params struct {
 	MaxMemoCharacters      uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty"`
@@ -174,6 +190,47 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// enable_ed25519 defines whether user transactions may be signed with ed25519 keys.
+	//
+	// Since: x/auth 1.0.0
+	EnableED25519 bool `protobuf:"varint,6,opt,name=enable_ed25519,json=enableEd25519,proto3" json:"enable_ed25519,omitempty"`
+	// enable_secp256r1 defines whether user transactions may be signed with secp256r1 keys.
+	//
+	// Since: x/auth 1.0.0
+	EnableSecp256r1 bool `protobuf:"varint,7,opt,name=enable_secp256r1,json=enableSecp256r1,proto3" json:"enable_secp256r1,omitempty"`
+	// priority_msg_type_urls lists the type URLs of the messages whose transactions
+	// are given an elevated priority in the mempool, and therefore in block proposals,
+	// when all of their messages are listed.
+	//
+	// Since: x/auth 1.0.0
+	PriorityMsgTypeURLs []string `protobuf:"bytes,8,rep,name=priority_msg_type_urls,json=priorityMsgTypeUrls,proto3" json:"priority_msg_type_urls,omitempty"`
+	// account_creation_fee is the fee charged by x/bank to the sender of coins to an
+	// address holding neither an account nor a balance, once per created account. It
+	// is credited to the community pool. An empty fee disables the charge.
+	//
+	// Since: x/auth 1.0.0
+	AccountCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=account_creation_fee,json=accountCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"account_creation_fee"`
+	// fee_exempt_msg_type_urls lists the type URLs of the messages, such as oracle
+	// votes or relayer client updates, whose transactions may pay no fee when sent
+	// by one of the fee_exempt_accounts.
+	//
+	// Since: x/auth 1.0.0
+	FeeExemptMsgTypeURLs []string `protobuf:"bytes,10,rep,name=fee_exempt_msg_type_urls,json=feeExemptMsgTypeUrls,proto3" json:"fee_exempt_msg_type_urls,omitempty"`
+	// fee_exempt_accounts lists the addresses of the fee payers whose transactions
+	// of fee_exempt_msg_type_urls messages only may pay no fee.
+	//
+	// Since: x/auth 1.0.0
+	FeeExemptAccounts []string `protobuf:"bytes,11,rep,name=fee_exempt_accounts,json=feeExemptAccounts,proto3" json:"fee_exempt_accounts,omitempty"`
+	// max_fee_exempt_txs_per_block is the maximum number of transactions paying no
+	// fee under the fee exemption in a block. Zero disables the fee exemption.
+	//
+	// Since: x/auth 1.0.0
+	MaxFeeExemptTxsPerBlock uint64 `protobuf:"varint,12,opt,name=max_fee_exempt_txs_per_block,json=maxFeeExemptTxsPerBlock,proto3" json:"max_fee_exempt_txs_per_block,omitempty"`
+	// track_account_activity defines whether the height of the last transaction
+	// signed by each account is recorded, and served by the account queries.
+	//
+	// Since: x/auth 1.0.0
+	TrackAccountActivity bool `protobuf:"varint,13,opt,name=track_account_activity,json=trackAccountActivity,proto3" json:"track_account_activity,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -244,6 +301,62 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetEnableED25519() bool {
+	if m != nil {
+		return m.EnableED25519
+	}
+	return false
+}
+
+func (m *Params) GetEnableSecp256r1() bool {
+	if m != nil {
+		return m.EnableSecp256r1
+	}
+	return false
+}
+
+func (m *Params) GetPriorityMsgTypeURLs() []string {
+	if m != nil {
+		return m.PriorityMsgTypeURLs
+	}
+	return nil
+}
+
+func (m *Params) GetAccountCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AccountCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetFeeExemptMsgTypeURLs() []string {
+	if m != nil {
+		return m.FeeExemptMsgTypeURLs
+	}
+	return nil
+}
+
+func (m *Params) GetFeeExemptAccounts() []string {
+	if m != nil {
+		return m.FeeExemptAccounts
+	}
+	return nil
+}
+
+func (m *Params) GetMaxFeeExemptTxsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxFeeExemptTxsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetTrackAccountActivity() bool {
+	if m != nil {
+		return m.TrackAccountActivity
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -254,53 +367,73 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x4f, 0xe3, 0x46,
-	0x18, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x11,
-	0x2a, 0x4e, 0x13, 0x44, 0xa5, 0xe6, 0x46, 0xd2, 0xaa, 0x42, 0x14, 0x8a, 0x1c, 0x2d, 0x07, 0x2e,
-	0xd6, 0xd8, 0x1e, 0xcc, 0x28, 0xb1, 0xc7, 0xeb, 0x19, 0xa3, 0x98, 0xf3, 0x1e, 0xd0, 0x9e, 0x56,
-	0xfb, 0x0b, 0xd8, 0xfd, 0x05, 0x1c, 0xf8, 0x11, 0xab, 0x3d, 0xa1, 0xbd, 0xec, 0x9e, 0xa2, 0x55,
-	0x38, 0x80, 0x56, 0xfb, 0x23, 0x56, 0x9e, 0x71, 0x20, 0x41, 0xb9, 0x44, 0x9e, 0xf7, 0xde, 0xf7,
-	0x7d, 0xef, 0x7b, 0x9e, 0x18, 0x94, 0x6d, 0x42, 0x3d, 0x42, 0x6b, 0x30, 0x62, 0xa7, 0xb5, 0xb3,
-	0xba, 0x85, 0x18, 0xac, 0xf3, 0x83, 0x1e, 0x84, 0x84, 0x11, 0x79, 0x59, 0xf0, 0x3a, 0x87, 0x52,
-	0xbe, 0xb4, 0x04, 0x3d, 0xec, 0x93, 0x1a, 0xff, 0x15, 0xba, 0xd2, 0xaa, 0xd0, 0x99, 0xfc, 0x54,
-	0x4b, 0x8b, 0x04, 0x55, 0x74, 0x89, 0x4b, 0x04, 0x9e, 0x3c, 0x8d, 0x0a, 0x5c, 0x42, 0xdc, 0x1e,
-	0xaa, 0xf1, 0x93, 0x15, 0x9d, 0xd4, 0xa0, 0x1f, 0x0b, 0xaa, 0xf2, 0x26, 0x0b, 0x0a, 0x2d, 0x48,
-	0xd1, 0x8e, 0x6d, 0x93, 0xc8, 0x67, 0x72, 0x03, 0xcc, 0x42, 0xc7, 0x09, 0x11, 0xa5, 0x8a, 0xa4,
-	0x49, 0xd5, 0xb9, 0x96, 0xf2, 0xe1, 0x7a, 0xb3, 0x98, 0xce, 0xd8, 0x11, 0x4c, 0x87, 0x85, 0xd8,
-	0x77, 0x8d, 0x91, 0x50, 0x3e, 0x02, 0xb3, 0x41, 0x64, 0x99, 0x5d, 0x14, 0x2b, 0x59, 0x4d, 0xaa,
-	0x16, 0x1a, 0x45, 0x5d, 0x0c, 0xd4, 0x47, 0x03, 0xf5, 0x1d, 0x3f, 0x6e, 0xad, 0x7f, 0x19, 0xa8,
-	0xc5, 0x20, 0xb2, 0x7a, 0xd8, 0x4e, 0xb4, 0xbf, 0x13, 0x0f, 0x33, 0xe4, 0x05, 0x2c, 0x7e, 0x7b,
-	0x77, 0xb5, 0x01, 0x1e, 0x09, 0x63, 0x26, 0x88, 0xac, 0x3d, 0x14, 0xcb, 0xbf, 0x82, 0x45, 0x28,
-	0x6c, 0x99, 0x7e, 0xe4, 0x59, 0x28, 0x54, 0x72, 0x9a, 0x54, 0xcd, 0x1b, 0x0b, 0x29, 0x7a, 0xc0,
-	0x41, 0xb9, 0x04, 0xbe, 0xa7, 0xe8, 0x79, 0x84, 0x7c, 0x1b, 0x29, 0x79, 0x2e, 0x78, 0x38, 0x37,
-	0xdb, 0x17, 0x97, 0x6a, 0xe6, 0xfe, 0x52, 0xcd, 0xbc, 0xbf, 0xde, 0xfc, 0x65, 0x4a, 0xbc, 0x7a,
-	0xba, 0xf7, 0xee, 0xcb, 0xbb, 0xab, 0x8d, 0x15, 0x21, 0xd8, 0xa4, 0x4e, 0xb7, 0x36, 0x96, 0x49,
-	0xe5, 0xab, 0x04, 0x16, 0xf6, 0x89, 0x13, 0xf5, 0x1e, 0x52, 0xda, 0x05, 0xf3, 0x16, 0xa4, 0xc8,
-	0x4c, 0x8d, 0xf0, 0xa8, 0x0a, 0x0d, 0x4d, 0x9f, 0x36, 0x61, 0xac, 0x53, 0x2b, 0x7f, 0x33, 0x50,
-	0x25, 0xa3, 0x60, 0x8d, 0x05, 0x2e, 0x83, 0xbc, 0x0f, 0x3d, 0xc4, 0x93, 0x9b, 0x33, 0xf8, 0xb3,
-	0xac, 0x81, 0x42, 0x80, 0x42, 0x0f, 0x53, 0x8a, 0x89, 0x4f, 0x95, 0x9c, 0x96, 0xab, 0xce, 0x19,
-	0xe3, 0x50, 0xf3, 0xf8, 0x42, 0xec, 0x54, 0x99, 0x36, 0x71, 0xc2, 0x2b, 0xdf, 0x4c, 0x19, 0xdb,
-	0x6c, 0x82, 0x7d, 0x7d, 0x77, 0xb5, 0xb1, 0xe8, 0x71, 0x64, 0xb4, 0x4c, 0xe5, 0x85, 0x04, 0x7e,
-	0x14, 0xa2, 0x76, 0x88, 0x1c, 0xe4, 0x33, 0x0c, 0x7b, 0xb2, 0x0a, 0x0a, 0xa9, 0x8c, 0xbb, 0xe5,
-	0x77, 0xc3, 0x00, 0x02, 0x3a, 0x48, 0x3c, 0xaf, 0x83, 0x1f, 0x1c, 0x14, 0xe2, 0x33, 0xc8, 0x30,
-	0xf1, 0x93, 0xd7, 0x48, 0x95, 0xac, 0x96, 0xab, 0xce, 0x1b, 0x8b, 0x8f, 0xf0, 0x1e, 0x8a, 0x69,
-	0xf3, 0xb7, 0xc4, 0xd0, 0xda, 0x98, 0xa1, 0x7f, 0x43, 0x12, 0x05, 0xa9, 0x9f, 0xc7, 0x89, 0x95,
-	0x8f, 0x59, 0x30, 0x73, 0x08, 0x43, 0xe8, 0x51, 0x59, 0x07, 0xcb, 0x1e, 0xec, 0x9b, 0x1e, 0xf2,
-	0x88, 0x69, 0x9f, 0xc2, 0x10, 0xda, 0x0c, 0x85, 0xe2, 0x82, 0xe6, 0x8d, 0x25, 0x0f, 0xf6, 0xf7,
-	0x91, 0x47, 0xda, 0x0f, 0x84, 0xac, 0x81, 0x79, 0xd6, 0x37, 0x29, 0x76, 0xcd, 0x1e, 0xf6, 0x30,
-	0xe3, 0xd9, 0xe6, 0x0d, 0xc0, 0xfa, 0x1d, 0xec, 0xfe, 0x97, 0x20, 0xf2, 0x1f, 0xe0, 0x27, 0xae,
-	0x38, 0x47, 0xa6, 0x4d, 0x28, 0x33, 0x03, 0x14, 0x9a, 0x56, 0xcc, 0x50, 0x7a, 0xc3, 0x96, 0x12,
-	0xe9, 0x39, 0x6a, 0x13, 0xca, 0x0e, 0x51, 0xd8, 0x8a, 0x19, 0x92, 0xff, 0x07, 0x3f, 0x27, 0x0d,
-	0xcf, 0x50, 0x88, 0x4f, 0x62, 0x51, 0x84, 0x9c, 0xc6, 0xf6, 0x76, 0xfd, 0x2f, 0x71, 0xe9, 0x5a,
-	0xca, 0x70, 0xa0, 0x16, 0x3b, 0xd8, 0x3d, 0xe2, 0x8a, 0xa4, 0xf4, 0x9f, 0xbf, 0x39, 0x6f, 0x14,
-	0xe9, 0x04, 0x2a, 0xaa, 0xe4, 0x67, 0x60, 0xf5, 0x69, 0x43, 0x8a, 0xec, 0xa0, 0xb1, 0xfd, 0x67,
-	0xb7, 0xae, 0x7c, 0xc7, 0x5b, 0x96, 0x86, 0x03, 0x75, 0x65, 0xa2, 0x65, 0x67, 0xa4, 0x30, 0x56,
-	0xe8, 0x54, 0xbc, 0xb9, 0x76, 0x7f, 0xa9, 0x4a, 0x4f, 0xdf, 0x79, 0x5f, 0x7c, 0x73, 0x44, 0x9c,
-	0xad, 0xad, 0x77, 0xc3, 0xb2, 0x74, 0x33, 0x2c, 0x4b, 0x9f, 0x87, 0x65, 0xe9, 0xd5, 0x6d, 0x39,
-	0x73, 0x73, 0x5b, 0xce, 0x7c, 0xba, 0x2d, 0x67, 0x8e, 0xd3, 0x2f, 0x0b, 0x75, 0xba, 0x3a, 0x26,
-	0xa3, 0x2a, 0x16, 0x07, 0x88, 0x5a, 0x33, 0xfc, 0xbf, 0xbc, 0xf5, 0x2d, 0x00, 0x00, 0xff, 0xff,
-	0x78, 0xbd, 0x49, 0x53, 0xc5, 0x04, 0x00, 0x00,
+	// 1052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x13, 0x15, 0x23, 0xfd, 0xfc, 0x67, 0x25, 0xdb, 0x31, 0xa5, 0x9f, 0x4d, 0x1b, 0x81, 0xa8, 0x18,
+	0x68, 0x23, 0x18, 0xb5, 0x54, 0x2b, 0x75, 0xd1, 0x1a, 0x68, 0x01, 0xcb, 0xb5, 0xdb, 0x20, 0x76,
+	0x6a, 0xd0, 0x49, 0x0e, 0xb9, 0x10, 0x4b, 0x6a, 0x4c, 0x2f, 0x24, 0x72, 0x59, 0xee, 0xd2, 0x10,
+	0xf3, 0x09, 0x82, 0x9e, 0x8a, 0xf6, 0xd6, 0x93, 0xdb, 0x53, 0x51, 0xa0, 0x80, 0x0f, 0xf9, 0x0c,
+	0x45, 0xd0, 0x93, 0xd1, 0x53, 0x4f, 0x6a, 0x21, 0x1f, 0x1c, 0x14, 0xfd, 0x10, 0xc5, 0xee, 0x92,
+	0xb2, 0x6c, 0x18, 0xbd, 0x08, 0xe2, 0xbc, 0x37, 0x33, 0x6f, 0x76, 0xde, 0x92, 0xa8, 0xea, 0x52,
+	0xe6, 0x53, 0xd6, 0xc4, 0x31, 0x3f, 0x6e, 0x9e, 0xac, 0x3b, 0xc0, 0xf1, 0xba, 0x7c, 0x68, 0x84,
+	0x11, 0xe5, 0x54, 0x2f, 0x2b, 0xbc, 0x21, 0x43, 0x29, 0xbe, 0x3c, 0x8f, 0x7d, 0x12, 0xd0, 0xa6,
+	0xfc, 0x55, 0xbc, 0xe5, 0xac, 0x8e, 0x83, 0x19, 0x8c, 0xea, 0xb8, 0x94, 0x04, 0x29, 0xbe, 0xa4,
+	0x70, 0x5b, 0x3e, 0x35, 0xd3, 0xa2, 0x0a, 0xaa, 0x78, 0xd4, 0xa3, 0x2a, 0x2e, 0xfe, 0x65, 0x09,
+	0x1e, 0xa5, 0x5e, 0x0f, 0x9a, 0xf2, 0xc9, 0x89, 0x8f, 0x9a, 0x38, 0x48, 0x14, 0xb4, 0xf2, 0xc3,
+	0x1d, 0x54, 0x6c, 0x63, 0x06, 0x5b, 0xae, 0x4b, 0xe3, 0x80, 0xeb, 0x2d, 0x34, 0x89, 0x3b, 0x9d,
+	0x08, 0x18, 0x33, 0xb4, 0x9a, 0x56, 0x9f, 0x6e, 0x1b, 0xbf, 0xbf, 0x5e, 0xab, 0xa4, 0x3d, 0xb6,
+	0x14, 0x72, 0xc8, 0x23, 0x12, 0x78, 0x56, 0x46, 0xd4, 0x9f, 0xa3, 0xc9, 0x30, 0x76, 0xec, 0x2e,
+	0x24, 0xc6, 0x9d, 0x9a, 0x56, 0x2f, 0xb6, 0x2a, 0x0d, 0xd5, 0xb0, 0x91, 0x35, 0x6c, 0x6c, 0x05,
+	0x49, 0xfb, 0xc1, 0xdf, 0x03, 0xb3, 0x12, 0xc6, 0x4e, 0x8f, 0xb8, 0x82, 0xfb, 0x1e, 0xf5, 0x09,
+	0x07, 0x3f, 0xe4, 0xc9, 0x8f, 0x97, 0x67, 0xab, 0xe8, 0x0a, 0xb0, 0x26, 0xc2, 0xd8, 0x79, 0x0c,
+	0x89, 0xfe, 0x0e, 0x9a, 0xc5, 0x4a, 0x96, 0x1d, 0xc4, 0xbe, 0x03, 0x91, 0x91, 0xaf, 0x69, 0xf5,
+	0x82, 0x35, 0x93, 0x46, 0x9f, 0xc8, 0xa0, 0xbe, 0x8c, 0xa6, 0x18, 0x7c, 0x15, 0x43, 0xe0, 0x82,
+	0x51, 0x90, 0x84, 0xd1, 0xf3, 0xe6, 0xf6, 0xab, 0x53, 0x33, 0xf7, 0xf6, 0xd4, 0xcc, 0xfd, 0xf6,
+	0x7a, 0xed, 0xde, 0x2d, 0xc7, 0xdf, 0x48, 0xe7, 0x7e, 0xf4, 0xf5, 0xe5, 0xd9, 0xea, 0x82, 0x22,
+	0xac, 0xb1, 0x4e, 0xb7, 0x39, 0x76, 0x26, 0x2b, 0xff, 0x68, 0x68, 0x66, 0x9f, 0x76, 0xe2, 0xde,
+	0xe8, 0x94, 0x1e, 0xa1, 0x92, 0x58, 0x8e, 0x9d, 0x0a, 0x91, 0x47, 0x55, 0x6c, 0xd5, 0x1a, 0xb7,
+	0x75, 0x18, 0xab, 0xd4, 0x2e, 0x9c, 0x0f, 0x4c, 0xcd, 0x2a, 0x3a, 0x63, 0x07, 0xae, 0xa3, 0x42,
+	0x80, 0x7d, 0x90, 0x27, 0x37, 0x6d, 0xc9, 0xff, 0x7a, 0x0d, 0x15, 0x43, 0x88, 0x7c, 0xc2, 0x18,
+	0xa1, 0x01, 0x33, 0xf2, 0xb5, 0x7c, 0x7d, 0xda, 0x1a, 0x0f, 0x6d, 0xbe, 0x78, 0xa5, 0x66, 0x5a,
+	0xb9, 0xad, 0xe3, 0x35, 0xad, 0x72, 0x32, 0x63, 0x6c, 0xb2, 0x6b, 0xe8, 0xb7, 0x97, 0x67, 0xab,
+	0xb3, 0xbe, 0x8c, 0x64, 0xc3, 0xac, 0xfc, 0xa2, 0xa1, 0xbb, 0x8a, 0xb4, 0x1d, 0x41, 0x07, 0x02,
+	0x4e, 0x70, 0x4f, 0x37, 0x51, 0x31, 0xa5, 0x49, 0xb5, 0xd2, 0x1b, 0x16, 0x52, 0xa1, 0x27, 0x42,
+	0xf3, 0x03, 0x34, 0xd7, 0x81, 0x88, 0x9c, 0x60, 0x4e, 0x68, 0x20, 0xd6, 0xc8, 0x8c, 0x3b, 0xb5,
+	0x7c, 0xbd, 0x64, 0xcd, 0x5e, 0x85, 0x1f, 0x43, 0xc2, 0x04, 0x31, 0xdb, 0x6a, 0xe6, 0x34, 0xb1,
+	0xd6, 0x92, 0x95, 0x2d, 0x3b, 0x75, 0xd9, 0xe6, 0xbb, 0x42, 0xf9, 0xfd, 0x31, 0xe5, 0x9f, 0x47,
+	0x34, 0x0e, 0x53, 0xe1, 0x57, 0xd2, 0x56, 0x7e, 0x9d, 0x44, 0x13, 0x07, 0x38, 0xc2, 0x3e, 0xd3,
+	0x1b, 0xa8, 0xec, 0xe3, 0xbe, 0xed, 0x83, 0x4f, 0x6d, 0xf7, 0x18, 0x47, 0xd8, 0xe5, 0x10, 0x29,
+	0x27, 0x17, 0xac, 0x79, 0x1f, 0xf7, 0xf7, 0xc1, 0xa7, 0xdb, 0x23, 0x40, 0xaf, 0xa1, 0x12, 0xef,
+	0xdb, 0x8c, 0x78, 0x76, 0x8f, 0xf8, 0x84, 0xcb, 0x25, 0x14, 0x2c, 0xc4, 0xfb, 0x87, 0xc4, 0xdb,
+	0x13, 0x11, 0xfd, 0x7d, 0xf4, 0x7f, 0xc9, 0x78, 0x09, 0xb6, 0x4b, 0x19, 0xb7, 0x43, 0x88, 0x6c,
+	0x27, 0xe1, 0x90, 0x5a, 0x71, 0x5e, 0x50, 0x5f, 0xc2, 0x36, 0x65, 0xfc, 0x00, 0xa2, 0x76, 0xc2,
+	0x41, 0xff, 0x12, 0x2d, 0x8a, 0x82, 0x27, 0x10, 0x91, 0xa3, 0x44, 0x25, 0x41, 0xa7, 0xb5, 0xb1,
+	0xb1, 0xfe, 0xb1, 0x72, 0x67, 0xdb, 0x18, 0x0e, 0xcc, 0xca, 0x21, 0xf1, 0x9e, 0x4b, 0x86, 0x48,
+	0xdd, 0xf9, 0x4c, 0xe2, 0x56, 0x85, 0x5d, 0x8b, 0xaa, 0x2c, 0xfd, 0x19, 0x5a, 0xba, 0x59, 0x90,
+	0x81, 0x1b, 0xb6, 0x36, 0x3e, 0xec, 0xae, 0x1b, 0xff, 0x93, 0x25, 0x97, 0x87, 0x03, 0x73, 0xe1,
+	0x5a, 0xc9, 0xc3, 0x8c, 0x61, 0x2d, 0xb0, 0x5b, 0xe3, 0xfa, 0x47, 0x68, 0x16, 0x02, 0xec, 0xf4,
+	0x60, 0x24, 0x6f, 0xa2, 0xa6, 0xd5, 0xa7, 0xda, 0xf3, 0xc3, 0x81, 0x39, 0xb3, 0x23, 0x91, 0x4c,
+	0xd7, 0x8c, 0x22, 0x66, 0x82, 0x3e, 0x45, 0x77, 0xd3, 0xcc, 0x54, 0x47, 0xb4, 0x6e, 0x4c, 0xca,
+	0xdc, 0xf2, 0x70, 0x60, 0xce, 0xa9, 0xdc, 0xc3, 0x0c, 0xb2, 0xe6, 0xe0, 0x7a, 0x40, 0xdf, 0x43,
+	0x0b, 0x61, 0x44, 0x68, 0x44, 0x78, 0x62, 0xfb, 0xcc, 0xb3, 0x79, 0x12, 0x82, 0x1d, 0x47, 0x3d,
+	0x66, 0x4c, 0x09, 0xa7, 0xb7, 0x17, 0x87, 0x03, 0xb3, 0x7c, 0x90, 0x32, 0xf6, 0x99, 0xf7, 0x34,
+	0x09, 0xe1, 0x99, 0xb5, 0xc7, 0xac, 0x72, 0x78, 0x23, 0x18, 0xf5, 0x98, 0xfe, 0x9d, 0x86, 0x2a,
+	0x99, 0xa1, 0xdc, 0x08, 0x94, 0xff, 0x8e, 0x00, 0x8c, 0xe9, 0x5a, 0xbe, 0x5e, 0x6c, 0x2d, 0x65,
+	0x97, 0x52, 0x5c, 0xba, 0xd1, 0x15, 0xd9, 0xa6, 0x24, 0x68, 0xef, 0xbe, 0x19, 0x98, 0xb9, 0x9f,
+	0xff, 0x34, 0xeb, 0x1e, 0xe1, 0xc7, 0xb1, 0xd3, 0x70, 0xa9, 0x9f, 0xbe, 0x4d, 0x9b, 0x63, 0xae,
+	0x13, 0xea, 0x98, 0x4c, 0x60, 0xdf, 0x5f, 0x9e, 0xad, 0x96, 0x7a, 0xe0, 0x61, 0x57, 0xec, 0x81,
+	0x04, 0xec, 0xa7, 0xcb, 0xb3, 0x55, 0xcd, 0xd2, 0xf1, 0xc8, 0x93, 0xb2, 0xfb, 0x2e, 0x80, 0x7e,
+	0x80, 0x8c, 0x23, 0x00, 0x1b, 0xfa, 0xe2, 0x0d, 0x77, 0x63, 0x4a, 0x24, 0xa7, 0x94, 0x36, 0xd8,
+	0x05, 0xd8, 0x91, 0x94, 0xf1, 0x31, 0x2b, 0x47, 0x37, 0xa3, 0x62, 0xce, 0x2f, 0x50, 0x79, 0xac,
+	0x62, 0xda, 0x92, 0x19, 0xc5, 0x5a, 0xfe, 0x3f, 0xdf, 0xd2, 0xf3, 0xa3, 0x62, 0xe9, 0xcd, 0x61,
+	0xfa, 0x27, 0xe8, 0x9e, 0xb8, 0x25, 0x63, 0xd5, 0x78, 0x9f, 0x29, 0x67, 0xf7, 0xa8, 0xdb, 0x35,
+	0x4a, 0xd2, 0xda, 0x8b, 0x3e, 0xee, 0x8f, 0xe4, 0x3d, 0xed, 0x33, 0xe1, 0x6f, 0x01, 0xeb, 0x1f,
+	0xa0, 0x05, 0x1e, 0x61, 0xb7, 0x6b, 0x8f, 0xae, 0xb1, 0xcb, 0xc9, 0x09, 0xe1, 0x89, 0x31, 0x23,
+	0x4c, 0x60, 0x55, 0x24, 0x9a, 0x76, 0xdb, 0x4a, 0xb1, 0xcd, 0xfb, 0x6f, 0x4f, 0x4d, 0xed, 0xe6,
+	0xbb, 0xa8, 0xaf, 0xbe, 0x95, 0xea, 0xf6, 0xb6, 0x1f, 0xbe, 0x19, 0x56, 0xb5, 0xf3, 0x61, 0x55,
+	0xfb, 0x6b, 0x58, 0xd5, 0xbe, 0xb9, 0xa8, 0xe6, 0xce, 0x2f, 0xaa, 0xb9, 0x3f, 0x2e, 0xaa, 0xb9,
+	0x17, 0xe9, 0x17, 0x8f, 0x75, 0xba, 0x0d, 0x42, 0xb3, 0x2c, 0xb9, 0x18, 0x67, 0x42, 0x7e, 0x63,
+	0x1e, 0xfe, 0x3b, 0x00, 0xab, 0x54, 0x39, 0xe9, 0x7d, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -337,6 +470,50 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.EnableED25519 != that1.EnableED25519 {
+		return false
+	}
+	if this.EnableSecp256r1 != that1.EnableSecp256r1 {
+		return false
+	}
+	if len(this.PriorityMsgTypeURLs) != len(that1.PriorityMsgTypeURLs) {
+		return false
+	}
+	for i := range this.PriorityMsgTypeURLs {
+		if this.PriorityMsgTypeURLs[i] != that1.PriorityMsgTypeURLs[i] {
+			return false
+		}
+	}
+	if len(this.AccountCreationFee) != len(that1.AccountCreationFee) {
+		return false
+	}
+	for i := range this.AccountCreationFee {
+		if !this.AccountCreationFee[i].Equal(&that1.AccountCreationFee[i]) {
+			return false
+		}
+	}
+	if len(this.FeeExemptMsgTypeURLs) != len(that1.FeeExemptMsgTypeURLs) {
+		return false
+	}
+	for i := range this.FeeExemptMsgTypeURLs {
+		if this.FeeExemptMsgTypeURLs[i] != that1.FeeExemptMsgTypeURLs[i] {
+			return false
+		}
+	}
+	if len(this.FeeExemptAccounts) != len(that1.FeeExemptAccounts) {
+		return false
+	}
+	for i := range this.FeeExemptAccounts {
+		if this.FeeExemptAccounts[i] != that1.FeeExemptAccounts[i] {
+			return false
+		}
+	}
+	if this.MaxFeeExemptTxsPerBlock != that1.MaxFeeExemptTxsPerBlock {
+		return false
+	}
+	if this.TrackAccountActivity != that1.TrackAccountActivity {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -462,6 +639,13 @@ func (m *ModuleCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DerivationKeys) > 0 {
 		for iNdEx := len(m.DerivationKeys) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DerivationKeys[iNdEx])
@@ -501,6 +685,82 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TrackAccountActivity {
+		i--
+		if m.TrackAccountActivity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxFeeExemptTxsPerBlock != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxFeeExemptTxsPerBlock))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.FeeExemptAccounts) > 0 {
+		for iNdEx := len(m.FeeExemptAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeExemptAccounts[iNdEx])
+			copy(dAtA[i:], m.FeeExemptAccounts[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.FeeExemptAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FeeExemptMsgTypeURLs) > 0 {
+		for iNdEx := len(m.FeeExemptMsgTypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeExemptMsgTypeURLs[iNdEx])
+			copy(dAtA[i:], m.FeeExemptMsgTypeURLs[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.FeeExemptMsgTypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AccountCreationFee) > 0 {
+		for iNdEx := len(m.AccountCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PriorityMsgTypeURLs) > 0 {
+		for iNdEx := len(m.PriorityMsgTypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriorityMsgTypeURLs[iNdEx])
+			copy(dAtA[i:], m.PriorityMsgTypeURLs[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.PriorityMsgTypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.EnableSecp256r1 {
+		i--
+		if m.EnableSecp256r1 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EnableED25519 {
+		i--
+		if m.EnableED25519 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -602,6 +862,10 @@ func (m *ModuleCredential) Size() (n int) {
 			n += 1 + l + sovAuth(uint64(l))
 		}
 	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -626,6 +890,42 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.EnableED25519 {
+		n += 2
+	}
+	if m.EnableSecp256r1 {
+		n += 2
+	}
+	if len(m.PriorityMsgTypeURLs) > 0 {
+		for _, s := range m.PriorityMsgTypeURLs {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.AccountCreationFee) > 0 {
+		for _, e := range m.AccountCreationFee {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.FeeExemptMsgTypeURLs) > 0 {
+		for _, s := range m.FeeExemptMsgTypeURLs {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.FeeExemptAccounts) > 0 {
+		for _, s := range m.FeeExemptAccounts {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.MaxFeeExemptTxsPerBlock != 0 {
+		n += 1 + sovAuth(uint64(m.MaxFeeExemptTxsPerBlock))
+	}
+	if m.TrackAccountActivity {
+		n += 2
+	}
 	return n
 }
 
@@ -1034,6 +1334,40 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
 			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = append(m.AccountAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccountAddress == nil {
+				m.AccountAddress = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -1179,6 +1513,215 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableED25519", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableED25519 = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSecp256r1", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableSecp256r1 = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityMsgTypeURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityMsgTypeURLs = append(m.PriorityMsgTypeURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountCreationFee = append(m.AccountCreationFee, types1.Coin{})
+			if err := m.AccountCreationFee[len(m.AccountCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptMsgTypeURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptMsgTypeURLs = append(m.FeeExemptMsgTypeURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptAccounts = append(m.FeeExemptAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeExemptTxsPerBlock", wireType)
+			}
+			m.MaxFeeExemptTxsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeeExemptTxsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackAccountActivity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackAccountActivity = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -22,6 +22,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgConvertVestedAccount{}, "cosmos-sdk/MsgConvertVestedAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateModuleAccountPermissions{}, "cosmos-sdk/MsgUpdateModuleAccountPerms")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -58,5 +60,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgConvertVestedAccount{},
+		&MsgUpdateModuleAccountPermissions{},
 	)
 }
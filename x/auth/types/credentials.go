@@ -45,7 +45,23 @@ func NewModuleCredential(moduleName string, derivationKeys ...[]byte) (*ModuleCr
 	}, nil
 }
 
+// NewModuleCredentialWithAddress creates a module credential key for an existing
+// account, whose address is not derived from the module name, so that the
+// account is controlled by the module at its address.
+func NewModuleCredentialWithAddress(moduleName string, addr []byte) (*ModuleCredential, error) {
+	if len(addr) == 0 {
+		return nil, fmt.Errorf("module credential address is empty")
+	}
+	return &ModuleCredential{
+		ModuleName:     moduleName,
+		AccountAddress: addr,
+	}, nil
+}
+
 func (m *ModuleCredential) Address() cryptotypes.Address {
+	if len(m.AccountAddress) > 0 {
+		return m.AccountAddress
+	}
 	return address.Module(m.ModuleName, m.DerivationKeys...)
 }
 
@@ -64,7 +80,7 @@ func (m *ModuleCredential) Equals(other cryptotypes.PubKey) bool {
 		return false
 	}
 
-	if m.ModuleName != om.ModuleName {
+	if m.ModuleName != om.ModuleName || !bytes.Equal(m.AccountAddress, om.AccountAddress) {
 		return false
 	}
 
@@ -42,6 +42,34 @@ func TestNewModuleCrendentials(t *testing.T) {
 	require.False(t, credential.Equals(c))
 }
 
+func TestNewModuleCredentialWithAddress(t *testing.T) {
+	_, err := authtypes.NewModuleCredentialWithAddress("group", nil)
+	require.Error(t, err)
+
+	addr := sdk.AccAddress("multisig_address____")
+	credential, err := authtypes.NewModuleCredentialWithAddress("group", addr)
+	require.NoError(t, err)
+	require.Equal(t, addr.Bytes(), credential.Address().Bytes())
+	require.False(t, credential.VerifySignature([]byte("msg"), []byte("sig")))
+
+	c, err := authtypes.NewModuleCredentialWithAddress("group", addr)
+	require.NoError(t, err)
+	require.True(t, credential.Equals(c))
+
+	c, err = authtypes.NewModuleCredentialWithAddress("group", sdk.AccAddress("other_address_______"))
+	require.NoError(t, err)
+	require.False(t, credential.Equals(c))
+
+	derived, err := authtypes.NewModuleCredential("group")
+	require.NoError(t, err)
+	require.False(t, credential.Equals(derived))
+
+	// an account keeps its address when put under the control of the module
+	account := authtypes.NewBaseAccountWithAddress(addr)
+	require.NoError(t, account.SetPubKey(credential))
+	require.NoError(t, account.Validate())
+}
+
 func TestNewBaseAccountWithPubKey(t *testing.T) {
 	expected := sdk.MustAccAddressFromBech32("cosmos1fpn0w0yf4x300llf5r66jnfhgj4ul6cfahrvqsskwkhsw6sv84wsmz359y")
 
@@ -72,7 +72,56 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	if err := validateLaneSequences(data.LaneSequences); err != nil {
+		return err
+	}
+
+	return validateAccountActivities(data.AccountActivities)
+}
+
+// validateLaneSequences checks that the lane sequences are of non-zero lanes,
+// without duplicates.
+func validateLaneSequences(laneSequences []LaneSequence) error {
+	seen := make(map[LaneSequence]bool, len(laneSequences))
+	for _, ls := range laneSequences {
+		if ls.Address == "" {
+			return errors.New("lane sequence with an empty address found in genesis state")
+		}
+		if ls.Lane == 0 {
+			return fmt.Errorf("lane sequence of lane 0 found in genesis state; address: %s", ls.Address)
+		}
+
+		key := LaneSequence{Address: ls.Address, Lane: ls.Lane}
+		if seen[key] {
+			return fmt.Errorf("duplicate lane sequence found in genesis state; address: %s, lane: %d", ls.Address, ls.Lane)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// validateAccountActivities checks that the account activities are of positive
+// heights, without duplicates.
+func validateAccountActivities(activities []AccountActivity) error {
+	seen := make(map[string]bool, len(activities))
+	for _, activity := range activities {
+		if activity.Address == "" {
+			return errors.New("account activity with an empty address found in genesis state")
+		}
+		if activity.LastActivityHeight <= 0 {
+			return fmt.Errorf("account activity with a non-positive height found in genesis state; address: %s", activity.Address)
+		}
+
+		if seen[activity.Address] {
+			return fmt.Errorf("duplicate account activity found in genesis state; address: %s", activity.Address)
+		}
+		seen[activity.Address] = true
+	}
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +32,15 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// lane_sequences are the sequences of the nonce lanes of the accounts.
+	//
+	// Since: x/auth 1.0.0
+	LaneSequences []LaneSequence `protobuf:"bytes,3,rep,name=lane_sequences,json=laneSequences,proto3" json:"lane_sequences"`
+	// account_activities are the heights of the last transactions of the
+	// accounts, recorded when the account activity is tracked.
+	//
+	// Since: x/auth 1.0.0
+	AccountActivities []AccountActivity `protobuf:"bytes,4,rep,name=account_activities,json=accountActivities,proto3" json:"account_activities"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,31 +90,182 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetLaneSequences() []LaneSequence {
+	if m != nil {
+		return m.LaneSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountActivities() []AccountActivity {
+	if m != nil {
+		return m.AccountActivities
+	}
+	return nil
+}
+
+// LaneSequence is the sequence of a nonce lane of an account.
+//
+// Since: x/auth 1.0.0
+type LaneSequence struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// lane is the nonce lane of the account.
+	Lane uint64 `protobuf:"varint,2,opt,name=lane,proto3" json:"lane,omitempty"`
+	// sequence is the sequence the next transaction of the lane is signed with.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *LaneSequence) Reset()         { *m = LaneSequence{} }
+func (m *LaneSequence) String() string { return proto.CompactTextString(m) }
+func (*LaneSequence) ProtoMessage()    {}
+func (*LaneSequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d897ccbce9822332, []int{1}
+}
+func (m *LaneSequence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneSequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneSequence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneSequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneSequence.Merge(m, src)
+}
+func (m *LaneSequence) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneSequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneSequence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneSequence proto.InternalMessageInfo
+
+func (m *LaneSequence) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LaneSequence) GetLane() uint64 {
+	if m != nil {
+		return m.Lane
+	}
+	return 0
+}
+
+func (m *LaneSequence) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// AccountActivity is the height of the last transaction signed by an account.
+//
+// Since: x/auth 1.0.0
+type AccountActivity struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// last_activity_height is the height of the last transaction signed by the
+	// account.
+	LastActivityHeight int64 `protobuf:"varint,2,opt,name=last_activity_height,json=lastActivityHeight,proto3" json:"last_activity_height,omitempty"`
+}
+
+func (m *AccountActivity) Reset()         { *m = AccountActivity{} }
+func (m *AccountActivity) String() string { return proto.CompactTextString(m) }
+func (*AccountActivity) ProtoMessage()    {}
+func (*AccountActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d897ccbce9822332, []int{2}
+}
+func (m *AccountActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountActivity.Merge(m, src)
+}
+func (m *AccountActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountActivity proto.InternalMessageInfo
+
+func (m *AccountActivity) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountActivity) GetLastActivityHeight() int64 {
+	if m != nil {
+		return m.LastActivityHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
+	proto.RegisterType((*LaneSequence)(nil), "cosmos.auth.v1beta1.LaneSequence")
+	proto.RegisterType((*AccountActivity)(nil), "cosmos.auth.v1beta1.AccountActivity")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0x64, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x92, 0x10, 0xab,
-	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x20, 0xfe, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x1b, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x57, 0x9c, 0xf7, 0x5c, 0x01,
-	0x00, 0x00,
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x31, 0x8f, 0xd3, 0x30,
+	0x18, 0x4d, 0x9a, 0xea, 0xb8, 0xf3, 0x1d, 0xa0, 0x33, 0x19, 0x72, 0x45, 0x0a, 0xbd, 0x8a, 0xa1,
+	0x42, 0xc2, 0xbe, 0xeb, 0xed, 0x48, 0xe9, 0x02, 0x03, 0x03, 0x4a, 0x36, 0x06, 0x22, 0x37, 0x35,
+	0xa9, 0x45, 0x6a, 0x97, 0xd8, 0x39, 0xc8, 0xbf, 0xe0, 0x67, 0x30, 0x32, 0xc0, 0x7f, 0xb8, 0xf1,
+	0xc4, 0xc4, 0x84, 0x50, 0x3b, 0xf0, 0x37, 0x50, 0x6c, 0xa7, 0x0a, 0x28, 0x13, 0x4b, 0xf4, 0xf9,
+	0x7b, 0xef, 0x7b, 0xef, 0xf9, 0x8b, 0xc1, 0x79, 0x26, 0xe4, 0x5a, 0x48, 0x4c, 0x2a, 0xb5, 0xc2,
+	0xd7, 0x97, 0x0b, 0xaa, 0xc8, 0x25, 0xce, 0x29, 0xa7, 0x92, 0x49, 0xb4, 0x29, 0x85, 0x12, 0xf0,
+	0x81, 0xa1, 0xa0, 0x86, 0x82, 0x2c, 0x65, 0x74, 0x96, 0x0b, 0x91, 0x17, 0x14, 0x6b, 0xca, 0xa2,
+	0x7a, 0x8b, 0x09, 0xaf, 0x0d, 0x7f, 0xe4, 0xe7, 0x22, 0x17, 0xba, 0xc4, 0x4d, 0x65, 0xbb, 0x61,
+	0x9f, 0x91, 0x96, 0x34, 0xf8, 0x29, 0x59, 0x33, 0x2e, 0xb0, 0xfe, 0xda, 0xd6, 0x99, 0x19, 0x49,
+	0x8d, 0x96, 0x4d, 0xa1, 0x0f, 0x93, 0x6f, 0x03, 0x70, 0xf2, 0xdc, 0xa4, 0x4c, 0x14, 0x51, 0x14,
+	0x3e, 0x03, 0x07, 0x1b, 0x52, 0x92, 0xb5, 0x0c, 0xdc, 0xb1, 0x3b, 0x3d, 0x9e, 0x3d, 0x44, 0x3d,
+	0xa9, 0xd1, 0x2b, 0x4d, 0x99, 0x1f, 0xdd, 0xfc, 0x7c, 0xe4, 0x7c, 0xfe, 0xfd, 0xe5, 0x89, 0x1b,
+	0xdb, 0x29, 0x78, 0x01, 0x0e, 0x49, 0x96, 0x89, 0x8a, 0x2b, 0x19, 0x0c, 0xc6, 0xde, 0xf4, 0x78,
+	0xe6, 0x23, 0x73, 0x45, 0xd4, 0x5e, 0x11, 0x45, 0xbc, 0x8e, 0xf7, 0x2c, 0x98, 0x80, 0x7b, 0x05,
+	0xe1, 0x34, 0x95, 0xf4, 0x7d, 0x45, 0x79, 0x46, 0x65, 0xe0, 0xe9, 0xb9, 0xf3, 0x5e, 0xe7, 0x97,
+	0x84, 0xd3, 0xc4, 0x32, 0xbb, 0xfe, 0x77, 0x8b, 0x0e, 0x20, 0xe1, 0x1b, 0x00, 0xad, 0x41, 0x4a,
+	0x32, 0xc5, 0xae, 0x99, 0x62, 0x54, 0x06, 0x43, 0x2d, 0xfc, 0xb8, 0x57, 0x38, 0x32, 0xf4, 0xc8,
+	0xb0, 0xeb, 0xae, 0xf6, 0x29, 0xf9, 0x0b, 0x63, 0x54, 0x4e, 0x4a, 0x70, 0xd2, 0x4d, 0x02, 0x67,
+	0xe0, 0x0e, 0x59, 0x2e, 0x4b, 0x2a, 0xcd, 0xde, 0x8e, 0xe6, 0xc1, 0xf7, 0xaf, 0x4f, 0x7d, 0xeb,
+	0x13, 0x19, 0x24, 0x51, 0x25, 0xe3, 0x79, 0xdc, 0x12, 0x21, 0x04, 0xc3, 0x26, 0x74, 0x30, 0x18,
+	0xbb, 0xd3, 0x61, 0xac, 0x6b, 0x38, 0x02, 0x87, 0xed, 0x1e, 0x02, 0x4f, 0xf7, 0xf7, 0xe7, 0xc9,
+	0x07, 0x70, 0xff, 0x9f, 0x90, 0xff, 0x65, 0x7b, 0x01, 0xfc, 0x82, 0xc8, 0xfd, 0x5e, 0xea, 0x74,
+	0x45, 0x59, 0xbe, 0x52, 0x3a, 0x86, 0x17, 0xc3, 0x06, 0x6b, 0xf5, 0x5f, 0x68, 0x64, 0x7e, 0x75,
+	0xb3, 0x0d, 0xdd, 0xdb, 0x6d, 0xe8, 0xfe, 0xda, 0x86, 0xee, 0xa7, 0x5d, 0xe8, 0xdc, 0xee, 0x42,
+	0xe7, 0xc7, 0x2e, 0x74, 0x5e, 0xdb, 0x97, 0x25, 0x97, 0xef, 0x10, 0x13, 0xf8, 0xa3, 0x79, 0x94,
+	0xaa, 0xde, 0x50, 0xb9, 0x38, 0xd0, 0xbf, 0xfb, 0xea, 0xcf, 0x00, 0x34, 0xb3, 0x88, 0xd4, 0x19,
+	0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +288,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountActivities) > 0 {
+		for iNdEx := len(m.AccountActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LaneSequences) > 0 {
+		for iNdEx := len(m.LaneSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LaneSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +343,81 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LaneSequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaneSequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Lane != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Lane))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastActivityHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastActivityHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -179,6 +443,53 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LaneSequences) > 0 {
+		for _, e := range m.LaneSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountActivities) > 0 {
+		for _, e := range m.AccountActivities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LaneSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Lane != 0 {
+		n += 1 + sovGenesis(uint64(m.Lane))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *AccountActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastActivityHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastActivityHeight))
+	}
 	return n
 }
 
@@ -284,6 +595,295 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaneSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LaneSequences = append(m.LaneSequences, LaneSequence{})
+			if err := m.LaneSequences[len(m.LaneSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountActivities = append(m.AccountActivities, AccountActivity{})
+			if err := m.AccountActivities[len(m.AccountActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LaneSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaneSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaneSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			m.Lane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lane |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityHeight", wireType)
+			}
+			m.LastActivityHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActivityHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
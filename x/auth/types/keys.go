@@ -26,6 +26,18 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// LaneSequencesPrefix is the prefix of the sequences of the nonce lanes of
+	// the accounts.
+	LaneSequencesPrefix = collections.NewPrefix(3)
+
+	// FeeExemptTxsPrefix is the prefix of the count of the fee exempt
+	// transactions of the current block, by height.
+	FeeExemptTxsPrefix = collections.NewPrefix(4)
+
+	// LastActivityHeightsPrefix is the prefix of the heights of the last
+	// transactions of the accounts, when the account activity is tracked.
+	LastActivityHeightsPrefix = collections.NewPrefix(5)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 )
@@ -2,6 +2,13 @@ package types
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Default parameter values
@@ -11,6 +18,8 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultEnableED25519          bool   = false
+	DefaultEnableSecp256r1        bool   = true
 )
 
 // NewParams creates a new Params object
@@ -21,6 +30,8 @@ func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		EnableED25519:          DefaultEnableED25519,
+		EnableSecp256r1:        DefaultEnableSecp256r1,
 	}
 }
 
@@ -32,6 +43,8 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		EnableED25519:          DefaultEnableED25519,
+		EnableSecp256r1:        DefaultEnableSecp256r1,
 	}
 }
 
@@ -112,6 +125,112 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validatePriorityMsgTypeURLs(i interface{}) error {
+	return validateMsgTypeURLs("priority", i)
+}
+
+func validateFeeExemptMsgTypeURLs(i interface{}) error {
+	return validateMsgTypeURLs("fee exempt", i)
+}
+
+func validateMsgTypeURLs(kind string, i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, typeURL := range v {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid %s message type url %q, expected a type url such as /cosmos.bank.v1beta1.MsgSend", kind, typeURL)
+		}
+		if seen[typeURL] {
+			return fmt.Errorf("duplicate %s message type url %s", kind, typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+func validateFeeExemptAccounts(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, addr := range v {
+		if addr == "" {
+			return fmt.Errorf("empty fee exempt account")
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate fee exempt account %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// ValidateFeeExemptAccounts checks that the fee exempt accounts are addresses of
+// the address codec. Validate cannot check it, as it has no address codec.
+func (p Params) ValidateFeeExemptAccounts(ac address.Codec) error {
+	for _, addr := range p.FeeExemptAccounts {
+		if _, err := ac.StringToBytes(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid fee exempt account %s: %s", addr, err)
+		}
+	}
+
+	return nil
+}
+
+func validateAccountCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid account creation fee: %s", err)
+	}
+
+	return nil
+}
+
+// IsPriorityTx returns true if the transaction has messages and all of them are
+// listed in PriorityMsgTypeURLs.
+func (p Params) IsPriorityTx(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 || len(p.PriorityMsgTypeURLs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !slices.Contains(p.PriorityMsgTypeURLs, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsFeeExemptTx returns true if the fee exemption is enabled, the fee payer is
+// listed in FeeExemptAccounts, and the transaction has messages and all of them
+// are listed in FeeExemptMsgTypeURLs.
+func (p Params) IsFeeExemptTx(msgs []sdk.Msg, feePayer string) bool {
+	if p.MaxFeeExemptTxsPerBlock == 0 || len(msgs) == 0 || !slices.Contains(p.FeeExemptAccounts, feePayer) {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !slices.Contains(p.FeeExemptMsgTypeURLs, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -129,6 +248,18 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validatePriorityMsgTypeURLs(p.PriorityMsgTypeURLs); err != nil {
+		return err
+	}
+	if err := validateAccountCreationFee(p.AccountCreationFee); err != nil {
+		return err
+	}
+	if err := validateFeeExemptMsgTypeURLs(p.FeeExemptMsgTypeURLs); err != nil {
+		return err
+	}
+	if err := validateFeeExemptAccounts(p.FeeExemptAccounts); err != nil {
+		return err
+	}
 
 	return nil
 }
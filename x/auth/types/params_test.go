@@ -7,6 +7,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/x/auth/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -35,6 +39,31 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"invalid priority message type url", func() types.Params {
+			p := types.DefaultParams()
+			p.PriorityMsgTypeURLs = []string{"cosmos.slashing.v1beta1.MsgUnjail"}
+			return p
+		}(), fmt.Errorf("invalid priority message type url %q, expected a type url such as /cosmos.bank.v1beta1.MsgSend", "cosmos.slashing.v1beta1.MsgUnjail")},
+		{"duplicate priority message type url", func() types.Params {
+			p := types.DefaultParams()
+			p.PriorityMsgTypeURLs = []string{"/cosmos.slashing.v1beta1.MsgUnjail", "/cosmos.slashing.v1beta1.MsgUnjail"}
+			return p
+		}(), fmt.Errorf("duplicate priority message type url /cosmos.slashing.v1beta1.MsgUnjail")},
+		{"invalid fee exempt message type url", func() types.Params {
+			p := types.DefaultParams()
+			p.FeeExemptMsgTypeURLs = []string{"slinky.oracle.v1.MsgVote"}
+			return p
+		}(), fmt.Errorf("invalid fee exempt message type url %q, expected a type url such as /cosmos.bank.v1beta1.MsgSend", "slinky.oracle.v1.MsgVote")},
+		{"duplicate fee exempt account", func() types.Params {
+			p := types.DefaultParams()
+			p.FeeExemptAccounts = []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"}
+			return p
+		}(), fmt.Errorf("duplicate fee exempt account cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu")},
+		{"invalid account creation fee", func() types.Params {
+			p := types.DefaultParams()
+			p.AccountCreationFee = sdk.Coins{sdk.NewInt64Coin("stake", 0)}
+			return p
+		}(), fmt.Errorf("invalid account creation fee: coin 0stake amount is not positive")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -50,3 +79,15 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateFeeExemptAccounts(t *testing.T) {
+	ac := codectestutil.CodecOptions{}.GetAddressCodec()
+
+	p := types.DefaultParams()
+	p.FeeExemptAccounts = []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"}
+	require.NoError(t, p.ValidateFeeExemptAccounts(ac))
+
+	p.FeeExemptAccounts = append(p.FeeExemptAccounts, "osmo1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu")
+	require.NoError(t, p.Validate())
+	require.ErrorIs(t, p.ValidateFeeExemptAccounts(ac), sdkerrors.ErrInvalidAddress)
+}
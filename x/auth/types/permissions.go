@@ -48,6 +48,26 @@ func (pa PermissionsForAddress) GetPermissions() []string {
 	return pa.permissions
 }
 
+// ValidateGrantablePermissions validates permissions granted to a module account at
+// runtime. Unlike the permissions registered at app wiring, only the minter, burner
+// and staking permissions can be granted, each at most once.
+func ValidateGrantablePermissions(permissions []string) error {
+	seen := make(map[string]bool, len(permissions))
+	for _, perm := range permissions {
+		switch perm {
+		case Minter, Burner, Staking:
+		default:
+			return fmt.Errorf("module permission %q cannot be granted, expected one of %s, %s or %s", perm, Minter, Burner, Staking)
+		}
+
+		if seen[perm] {
+			return fmt.Errorf("duplicate module permission %s", perm)
+		}
+		seen[perm] = true
+	}
+	return nil
+}
+
 // performs basic permission validation
 func validatePermissions(permissions ...string) error {
 	for _, perm := range permissions {
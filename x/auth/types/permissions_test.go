@@ -52,3 +52,29 @@ func TestValidatePermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGrantablePermissions(t *testing.T) {
+	cases := []struct {
+		name        string
+		permissions []string
+		expectPass  bool
+	}{
+		{"no permissions", []string{}, true},
+		{"all permissions", []string{Minter, Burner, Staking}, true},
+		{"empty permission", []string{""}, false},
+		{"unknown permission", []string{Minter, "upgrade"}, false},
+		{"duplicate permission", []string{Burner, Minter, Burner}, false},
+	}
+
+	for i, tc := range cases {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateGrantablePermissions(tc.permissions)
+			if tc.expectPass {
+				require.NoError(t, err, "test case #%d", i)
+			} else {
+				require.Error(t, err, "test case #%d", i)
+			}
+		})
+	}
+}
@@ -180,6 +180,12 @@ var xxx_messageInfo_QueryAccountRequest proto.InternalMessageInfo
 type QueryAccountResponse struct {
 	// account defines the account of the corresponding address.
 	Account *types.Any `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// last_activity_height is the height of the last transaction signed by the
+	// account, or zero if none was recorded or the track_account_activity param
+	// is disabled, as the account activity is only tracked when it is enabled.
+	//
+	// Since: x/auth 1.0.0
+	LastActivityHeight int64 `protobuf:"varint,2,opt,name=last_activity_height,json=lastActivityHeight,proto3" json:"last_activity_height,omitempty"`
 }
 
 func (m *QueryAccountResponse) Reset()         { *m = QueryAccountResponse{} }
@@ -222,6 +228,13 @@ func (m *QueryAccountResponse) GetAccount() *types.Any {
 	return nil
 }
 
+func (m *QueryAccountResponse) GetLastActivityHeight() int64 {
+	if m != nil {
+		return m.LastActivityHeight
+	}
+	return 0
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -481,6 +494,121 @@ func (m *QueryModuleAccountByNameResponse) GetAccount() *types.Any {
 	return nil
 }
 
+// QueryModuleAccountPermissionsRequest is the request type for the Query/ModuleAccountPermissions RPC method.
+//
+// Since: x/auth 1.0.0
+type QueryModuleAccountPermissionsRequest struct {
+	// name is the name of the module account.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryModuleAccountPermissionsRequest) Reset()         { *m = QueryModuleAccountPermissionsRequest{} }
+func (m *QueryModuleAccountPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountPermissionsRequest) ProtoMessage()    {}
+func (*QueryModuleAccountPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{10}
+}
+func (m *QueryModuleAccountPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountPermissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAccountPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountPermissionsRequest.Merge(m, src)
+}
+func (m *QueryModuleAccountPermissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAccountPermissionsRequest proto.InternalMessageInfo
+
+func (m *QueryModuleAccountPermissionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryModuleAccountPermissionsResponse is the response type for the Query/ModuleAccountPermissions RPC method.
+//
+// Since: x/auth 1.0.0
+type QueryModuleAccountPermissionsResponse struct {
+	// address is the address of the module account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// permissions defines the permissions currently granted to the module account.
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// wiring_permissions defines the permissions the module account was registered
+	// with at app wiring, granted to the account when it is created.
+	WiringPermissions []string `protobuf:"bytes,3,rep,name=wiring_permissions,json=wiringPermissions,proto3" json:"wiring_permissions,omitempty"`
+}
+
+func (m *QueryModuleAccountPermissionsResponse) Reset()         { *m = QueryModuleAccountPermissionsResponse{} }
+func (m *QueryModuleAccountPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountPermissionsResponse) ProtoMessage()    {}
+func (*QueryModuleAccountPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{11}
+}
+func (m *QueryModuleAccountPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAccountPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountPermissionsResponse.Merge(m, src)
+}
+func (m *QueryModuleAccountPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAccountPermissionsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleAccountPermissionsResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryModuleAccountPermissionsResponse) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *QueryModuleAccountPermissionsResponse) GetWiringPermissions() []string {
+	if m != nil {
+		return m.WiringPermissions
+	}
+	return nil
+}
+
 // Bech32PrefixRequest is the request type for Bech32Prefix rpc method.
 //
 // Since: cosmos-sdk 0.46
@@ -491,7 +619,7 @@ func (m *Bech32PrefixRequest) Reset()         { *m = Bech32PrefixRequest{} }
 func (m *Bech32PrefixRequest) String() string { return proto.CompactTextString(m) }
 func (*Bech32PrefixRequest) ProtoMessage()    {}
 func (*Bech32PrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{10}
+	return fileDescriptor_c451370b3929a27c, []int{12}
 }
 func (m *Bech32PrefixRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +659,7 @@ func (m *Bech32PrefixResponse) Reset()         { *m = Bech32PrefixResponse{} }
 func (m *Bech32PrefixResponse) String() string { return proto.CompactTextString(m) }
 func (*Bech32PrefixResponse) ProtoMessage()    {}
 func (*Bech32PrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{11}
+	return fileDescriptor_c451370b3929a27c, []int{13}
 }
 func (m *Bech32PrefixResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +706,7 @@ func (m *AddressBytesToStringRequest) Reset()         { *m = AddressBytesToStrin
 func (m *AddressBytesToStringRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressBytesToStringRequest) ProtoMessage()    {}
 func (*AddressBytesToStringRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{12}
+	return fileDescriptor_c451370b3929a27c, []int{14}
 }
 func (m *AddressBytesToStringRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +753,7 @@ func (m *AddressBytesToStringResponse) Reset()         { *m = AddressBytesToStri
 func (m *AddressBytesToStringResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressBytesToStringResponse) ProtoMessage()    {}
 func (*AddressBytesToStringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{13}
+	return fileDescriptor_c451370b3929a27c, []int{15}
 }
 func (m *AddressBytesToStringResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +800,7 @@ func (m *AddressStringToBytesRequest) Reset()         { *m = AddressStringToByte
 func (m *AddressStringToBytesRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressStringToBytesRequest) ProtoMessage()    {}
 func (*AddressStringToBytesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{14}
+	return fileDescriptor_c451370b3929a27c, []int{16}
 }
 func (m *AddressStringToBytesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +847,7 @@ func (m *AddressStringToBytesResponse) Reset()         { *m = AddressStringToByt
 func (m *AddressStringToBytesResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressStringToBytesResponse) ProtoMessage()    {}
 func (*AddressStringToBytesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{15}
+	return fileDescriptor_c451370b3929a27c, []int{17}
 }
 func (m *AddressStringToBytesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +903,7 @@ func (m *QueryAccountAddressByIDRequest) Reset()         { *m = QueryAccountAddr
 func (m *QueryAccountAddressByIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressByIDRequest) ProtoMessage()    {}
 func (*QueryAccountAddressByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{16}
+	return fileDescriptor_c451370b3929a27c, []int{18}
 }
 func (m *QueryAccountAddressByIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,7 +958,7 @@ func (m *QueryAccountAddressByIDResponse) Reset()         { *m = QueryAccountAdd
 func (m *QueryAccountAddressByIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressByIDResponse) ProtoMessage()    {}
 func (*QueryAccountAddressByIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{17}
+	return fileDescriptor_c451370b3929a27c, []int{19}
 }
 func (m *QueryAccountAddressByIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +1006,7 @@ func (m *QueryAccountInfoRequest) Reset()         { *m = QueryAccountInfoRequest
 func (m *QueryAccountInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountInfoRequest) ProtoMessage()    {}
 func (*QueryAccountInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{18}
+	return fileDescriptor_c451370b3929a27c, []int{20}
 }
 func (m *QueryAccountInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -920,13 +1048,19 @@ func (m *QueryAccountInfoRequest) GetAddress() string {
 type QueryAccountInfoResponse struct {
 	// info is the account info which is represented by BaseAccount.
 	Info *BaseAccount `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// last_activity_height is the height of the last transaction signed by the
+	// account, or zero if none was recorded or the track_account_activity param
+	// is disabled.
+	//
+	// Since: x/auth 1.0.0
+	LastActivityHeight int64 `protobuf:"varint,2,opt,name=last_activity_height,json=lastActivityHeight,proto3" json:"last_activity_height,omitempty"`
 }
 
 func (m *QueryAccountInfoResponse) Reset()         { *m = QueryAccountInfoResponse{} }
 func (m *QueryAccountInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountInfoResponse) ProtoMessage()    {}
 func (*QueryAccountInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{19}
+	return fileDescriptor_c451370b3929a27c, []int{21}
 }
 func (m *QueryAccountInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -962,6 +1096,118 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+func (m *QueryAccountInfoResponse) GetLastActivityHeight() int64 {
+	if m != nil {
+		return m.LastActivityHeight
+	}
+	return 0
+}
+
+// QueryLaneSequenceRequest is the Query/LaneSequence request type.
+//
+// Since: x/auth 1.0.0
+type QueryLaneSequenceRequest struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// lane is the nonce lane of the account. Lane 0 is the account sequence.
+	Lane uint64 `protobuf:"varint,2,opt,name=lane,proto3" json:"lane,omitempty"`
+}
+
+func (m *QueryLaneSequenceRequest) Reset()         { *m = QueryLaneSequenceRequest{} }
+func (m *QueryLaneSequenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLaneSequenceRequest) ProtoMessage()    {}
+func (*QueryLaneSequenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryLaneSequenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLaneSequenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLaneSequenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLaneSequenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLaneSequenceRequest.Merge(m, src)
+}
+func (m *QueryLaneSequenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLaneSequenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLaneSequenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLaneSequenceRequest proto.InternalMessageInfo
+
+func (m *QueryLaneSequenceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryLaneSequenceRequest) GetLane() uint64 {
+	if m != nil {
+		return m.Lane
+	}
+	return 0
+}
+
+// QueryLaneSequenceResponse is the Query/LaneSequence response type.
+//
+// Since: x/auth 1.0.0
+type QueryLaneSequenceResponse struct {
+	// sequence is the sequence the next transaction of the lane is signed with.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryLaneSequenceResponse) Reset()         { *m = QueryLaneSequenceResponse{} }
+func (m *QueryLaneSequenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLaneSequenceResponse) ProtoMessage()    {}
+func (*QueryLaneSequenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryLaneSequenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLaneSequenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLaneSequenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLaneSequenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLaneSequenceResponse.Merge(m, src)
+}
+func (m *QueryLaneSequenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLaneSequenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLaneSequenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLaneSequenceResponse proto.InternalMessageInfo
+
+func (m *QueryLaneSequenceResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -973,6 +1219,8 @@ func init() {
 	proto.RegisterType((*QueryModuleAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountsResponse")
 	proto.RegisterType((*QueryModuleAccountByNameRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameRequest")
 	proto.RegisterType((*QueryModuleAccountByNameResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameResponse")
+	proto.RegisterType((*QueryModuleAccountPermissionsRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountPermissionsRequest")
+	proto.RegisterType((*QueryModuleAccountPermissionsResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountPermissionsResponse")
 	proto.RegisterType((*Bech32PrefixRequest)(nil), "cosmos.auth.v1beta1.Bech32PrefixRequest")
 	proto.RegisterType((*Bech32PrefixResponse)(nil), "cosmos.auth.v1beta1.Bech32PrefixResponse")
 	proto.RegisterType((*AddressBytesToStringRequest)(nil), "cosmos.auth.v1beta1.AddressBytesToStringRequest")
@@ -983,78 +1231,93 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryLaneSequenceRequest)(nil), "cosmos.auth.v1beta1.QueryLaneSequenceRequest")
+	proto.RegisterType((*QueryLaneSequenceResponse)(nil), "cosmos.auth.v1beta1.QueryLaneSequenceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1056 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x24, 0x2b, 0xf1, 0xe3, 0x54, 0xb3, 0x80, 0x7a, 0x58, 0x14, 0xdc,
-	0x15, 0x42, 0x1c, 0x88, 0x9c, 0xda, 0x49, 0x2d, 0x36, 0x9e, 0x6c, 0xec, 0xc0, 0x86, 0x2a, 0x17,
-	0x24, 0xa4, 0x5e, 0x90, 0x90, 0xe0, 0x0f, 0xd8, 0x03, 0xe2, 0xbc, 0x48, 0xe5, 0xc6, 0x1f, 0xb0,
-	0xda, 0xd3, 0x0a, 0x2e, 0x9c, 0x10, 0x6a, 0x91, 0xe0, 0xc6, 0xbf, 0x80, 0x32, 0xf3, 0xec, 0xd8,
-	0xed, 0x24, 0x71, 0xd9, 0x9b, 0x33, 0xf3, 0xde, 0xf7, 0x7d, 0xe6, 0xcd, 0xb3, 0xbf, 0x2d, 0x14,
-	0x0e, 0xa9, 0xd7, 0xa1, 0x9e, 0x66, 0xf6, 0xfd, 0x23, 0xed, 0xf3, 0xdd, 0xa6, 0xed, 0x9b, 0xbb,
-	0xda, 0x83, 0xbe, 0xdd, 0x1b, 0xa8, 0xdd, 0x1e, 0xf5, 0x29, 0x59, 0xe5, 0x01, 0xea, 0x28, 0x40,
-	0xc5, 0x00, 0x79, 0x07, 0xb3, 0x9a, 0xa6, 0x67, 0xf3, 0xe8, 0x30, 0xb7, 0x6b, 0xb6, 0x1d, 0xd7,
-	0xf4, 0x1d, 0xea, 0x72, 0x01, 0x39, 0xd3, 0xa6, 0x6d, 0xca, 0x1e, 0xb5, 0xd1, 0x13, 0xae, 0xbe,
-	0xdc, 0xa6, 0xb4, 0x7d, 0xdf, 0xd6, 0xd8, 0xaf, 0x66, 0xbf, 0xa5, 0x99, 0x2e, 0x56, 0x94, 0xd7,
-	0x70, 0xcb, 0xec, 0x3a, 0x9a, 0xe9, 0xba, 0xd4, 0x67, 0x6a, 0x1e, 0xee, 0xe6, 0x45, 0xc0, 0x0c,
-	0x0e, 0x85, 0xf9, 0x7e, 0x83, 0x57, 0x44, 0x78, 0xbe, 0x95, 0xc3, 0xd4, 0x00, 0x38, 0x7a, 0x4e,
-	0xe5, 0x53, 0xc8, 0x7c, 0x38, 0xfa, 0xb9, 0x77, 0x78, 0x48, 0xfb, 0xae, 0xef, 0x19, 0xf6, 0x83,
-	0xbe, 0xed, 0xf9, 0xe4, 0x3d, 0x80, 0xf1, 0x91, 0xb2, 0x52, 0x51, 0x2a, 0x2d, 0x57, 0x36, 0x55,
-	0xd4, 0x1d, 0x9d, 0x5f, 0xe5, 0x2a, 0x88, 0xa2, 0xd6, 0xcd, 0xb6, 0x8d, 0xb9, 0x46, 0x24, 0x53,
-	0x39, 0x95, 0xe0, 0xe6, 0x85, 0x02, 0x5e, 0x97, 0xba, 0x9e, 0x4d, 0x0c, 0x58, 0x32, 0x71, 0x2d,
-	0x2b, 0x15, 0xaf, 0x95, 0x96, 0x2b, 0x19, 0x95, 0xb7, 0x40, 0x0d, 0xba, 0xa3, 0xee, 0xb9, 0x03,
-	0xbd, 0xf8, 0xf4, 0xb4, 0xbc, 0x26, 0xb8, 0x0d, 0x15, 0x15, 0xf7, 0x8d, 0x50, 0x87, 0xbc, 0x1f,
-	0xa3, 0x9e, 0x63, 0xd4, 0x5b, 0x33, 0xa9, 0x39, 0x50, 0x0c, 0xfb, 0x00, 0x56, 0xa3, 0xd4, 0x41,
-	0x57, 0x2a, 0xb0, 0x68, 0x5a, 0x56, 0xcf, 0xf6, 0x3c, 0xd6, 0x92, 0xeb, 0x7a, 0xf6, 0xd7, 0xd3,
-	0x72, 0x06, 0xf5, 0xf7, 0xf8, 0xce, 0x81, 0xdf, 0x73, 0xdc, 0xb6, 0x11, 0x04, 0xbe, 0xb5, 0x74,
-	0xf2, 0xa8, 0x90, 0xfa, 0xe7, 0x51, 0x21, 0xa5, 0x1c, 0xc5, 0x7b, 0x1d, 0x76, 0xa2, 0x0e, 0x8b,
-	0x78, 0x02, 0x6c, 0xf4, 0xff, 0x6d, 0x44, 0x20, 0xa3, 0x64, 0x80, 0xb0, 0x4a, 0x75, 0xb3, 0x67,
-	0x76, 0x82, 0x3b, 0x55, 0xea, 0x78, 0xa8, 0x60, 0x15, 0xcb, 0xbf, 0x09, 0x0b, 0x5d, 0xb6, 0x82,
-	0xd5, 0x73, 0xaa, 0xa8, 0x08, 0x4f, 0xd2, 0xe7, 0x9f, 0xfc, 0x51, 0x48, 0x19, 0x98, 0xa0, 0xac,
-	0x81, 0xcc, 0x14, 0xef, 0x52, 0xab, 0x7f, 0xdf, 0xbe, 0x30, 0x43, 0xca, 0x17, 0x90, 0x13, 0xee,
-	0x62, 0xdd, 0x8f, 0x13, 0x0e, 0xc0, 0xe6, 0xd3, 0xd3, 0xb2, 0x22, 0x42, 0x8a, 0xe9, 0x46, 0xc6,
-	0x40, 0xb9, 0x0d, 0x85, 0xcb, 0x85, 0xf5, 0xc1, 0x07, 0x66, 0x27, 0x98, 0x51, 0x42, 0x60, 0xde,
-	0x35, 0x3b, 0x36, 0xbf, 0x46, 0x83, 0x3d, 0x2b, 0x5f, 0x42, 0x71, 0x72, 0x1a, 0x42, 0x7f, 0x94,
-	0xec, 0xae, 0x92, 0x32, 0x87, 0x37, 0x76, 0x13, 0x56, 0x75, 0xfb, 0xf0, 0xa8, 0x5a, 0xa9, 0xf7,
-	0xec, 0x96, 0xf3, 0x30, 0x68, 0xe1, 0xdb, 0x90, 0x89, 0x2f, 0x23, 0xc6, 0x06, 0xdc, 0x68, 0xb2,
-	0xf5, 0x46, 0x97, 0x6d, 0xe0, 0x39, 0xd2, 0xcd, 0x48, 0xb0, 0xa2, 0x43, 0x0e, 0x67, 0x52, 0x1f,
-	0xf8, 0xb6, 0x77, 0x8f, 0xe2, 0x68, 0x62, 0x0b, 0x36, 0xe0, 0x06, 0xce, 0x68, 0xa3, 0x39, 0xda,
-	0x67, 0x1a, 0x69, 0x23, 0x6d, 0x46, 0x72, 0x94, 0x77, 0x61, 0x4d, 0xac, 0x81, 0x20, 0xaf, 0xc2,
-	0x4a, 0x20, 0xe2, 0xb1, 0x1d, 0x24, 0x09, 0xa4, 0x79, 0xb8, 0x72, 0x27, 0x44, 0xe1, 0x0b, 0xf7,
-	0x28, 0x93, 0x0b, 0x50, 0x12, 0xaa, 0xbc, 0x13, 0xc2, 0x5c, 0x50, 0x19, 0x77, 0x65, 0xf6, 0x89,
-	0x0e, 0x20, 0x1f, 0x7d, 0x0b, 0xc3, 0xd3, 0xed, 0xdf, 0x19, 0xcf, 0xc6, 0x9c, 0x63, 0xb1, 0xdc,
-	0x6b, 0xfa, 0x5c, 0x56, 0x32, 0xe6, 0x1c, 0x8b, 0xac, 0x03, 0xe0, 0x55, 0x35, 0x1c, 0x8b, 0x7d,
-	0x59, 0xe6, 0x8d, 0xeb, 0xb8, 0xb2, 0x6f, 0x29, 0x16, 0x4e, 0x9c, 0x48, 0x14, 0xe1, 0xf6, 0xe0,
-	0xc5, 0x40, 0x21, 0xe9, 0x37, 0x64, 0xc5, 0x8c, 0xc9, 0x29, 0x77, 0xe1, 0xa5, 0x68, 0x95, 0x7d,
-	0xb7, 0x45, 0x9f, 0xe3, 0xcb, 0xa4, 0xd4, 0x21, 0x7b, 0x59, 0x0e, 0x69, 0x6b, 0x30, 0xef, 0xb8,
-	0x2d, 0x8a, 0x43, 0x5e, 0x14, 0x7e, 0x12, 0x74, 0xd3, 0x0b, 0x26, 0xd9, 0x60, 0xd1, 0x95, 0x7f,
-	0xd3, 0xf0, 0x02, 0x93, 0x24, 0xdf, 0x48, 0xb0, 0x14, 0xbc, 0xf1, 0x64, 0x5b, 0x98, 0x2e, 0xf2,
-	0x1d, 0x79, 0x27, 0x49, 0x28, 0x67, 0x54, 0x76, 0x4e, 0xfe, 0x7e, 0xbc, 0x23, 0x7d, 0xf5, 0xdb,
-	0x5f, 0xdf, 0xcd, 0x15, 0xc8, 0xba, 0x26, 0x74, 0xc8, 0x00, 0xe1, 0x7b, 0x09, 0x16, 0x51, 0x80,
-	0x94, 0x66, 0xd6, 0x08, 0x68, 0xb6, 0x13, 0x44, 0x22, 0x4c, 0x6d, 0x0c, 0xb3, 0x4d, 0xb6, 0xa6,
-	0xc2, 0x68, 0xc7, 0x78, 0x03, 0x43, 0xf2, 0xb3, 0x04, 0xe4, 0xf2, 0xcc, 0x90, 0xea, 0xcc, 0xba,
-	0x97, 0xc7, 0x56, 0xae, 0x5d, 0x2d, 0xe9, 0x0a, 0xdc, 0xe1, 0x3b, 0xd5, 0x70, 0x2c, 0xed, 0xd8,
-	0xb1, 0x86, 0xe4, 0x6b, 0x09, 0x16, 0xb8, 0x23, 0x90, 0xad, 0xc9, 0x65, 0x63, 0xf6, 0x23, 0x97,
-	0x66, 0x07, 0x22, 0x53, 0x69, 0xcc, 0xb4, 0x4e, 0x72, 0x42, 0x26, 0x6e, 0x40, 0xe4, 0x47, 0x09,
-	0x56, 0xe2, 0xf6, 0x42, 0xb4, 0xc9, 0x65, 0x84, 0x36, 0x25, 0xbf, 0x9e, 0x3c, 0x01, 0xf9, 0x76,
-	0xc7, 0x7c, 0x9b, 0xe4, 0x15, 0x21, 0x5f, 0x87, 0x65, 0x36, 0xc2, 0xf9, 0xfb, 0x45, 0x82, 0x55,
-	0x81, 0xaf, 0x90, 0x5a, 0xc2, 0xe2, 0x31, 0xf7, 0x92, 0x6f, 0x5f, 0x31, 0x0b, 0xb9, 0xdf, 0x18,
-	0x73, 0x97, 0xc9, 0x6b, 0x49, 0xb8, 0xb5, 0xe3, 0x91, 0x33, 0x0e, 0xc9, 0x89, 0x04, 0xe9, 0xa8,
-	0x11, 0x4d, 0x78, 0x87, 0x04, 0x16, 0x36, 0xe1, 0x1d, 0x12, 0xb9, 0x9a, 0xb2, 0x31, 0xf5, 0xca,
-	0xb9, 0xb7, 0x91, 0xc7, 0x12, 0x64, 0x44, 0x96, 0x44, 0xc4, 0xf7, 0x38, 0xc5, 0x01, 0xe5, 0xdd,
-	0x2b, 0x64, 0x20, 0x62, 0x75, 0x6a, 0xf7, 0x38, 0x62, 0xf8, 0x7e, 0x73, 0x17, 0x1a, 0x92, 0x9f,
-	0xc6, 0xc8, 0x31, 0xe3, 0x9a, 0x8e, 0x2c, 0x72, 0xca, 0xe9, 0xc8, 0x42, 0x57, 0x54, 0x6a, 0x0c,
-	0x59, 0x25, 0xb7, 0x12, 0x21, 0x73, 0xff, 0x1d, 0x92, 0x1f, 0x24, 0x58, 0x8e, 0x18, 0x03, 0xb9,
-	0x35, 0xf3, 0xeb, 0x12, 0xb1, 0x23, 0xb9, 0x9c, 0x30, 0x3a, 0xf9, 0x60, 0x86, 0xee, 0xeb, 0xb6,
-	0xe8, 0xf8, 0x03, 0xaa, 0x57, 0x9f, 0x9c, 0xe5, 0xa5, 0x67, 0x67, 0x79, 0xe9, 0xcf, 0xb3, 0xbc,
-	0xf4, 0xed, 0x79, 0x3e, 0xf5, 0xec, 0x3c, 0x9f, 0xfa, 0xfd, 0x3c, 0x9f, 0xfa, 0x04, 0xff, 0x23,
-	0xf2, 0xac, 0xcf, 0x54, 0x87, 0x6a, 0x0f, 0xb9, 0x9a, 0x3f, 0xe8, 0xda, 0x5e, 0x73, 0x81, 0xfd,
-	0xad, 0x56, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xde, 0xbd, 0x53, 0x06, 0x0e, 0x00, 0x00,
+	// 1260 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x98, 0xcf, 0x6f, 0xe3, 0x54,
+	0x10, 0xc7, 0xf3, 0xd2, 0xd2, 0x1f, 0xd3, 0x6e, 0x57, 0xbc, 0x66, 0x45, 0xd6, 0x6d, 0x93, 0xc8,
+	0xdd, 0x6d, 0xd3, 0xb2, 0xb1, 0xb7, 0x3f, 0x96, 0xa5, 0xe5, 0x94, 0x50, 0x7e, 0x54, 0x62, 0x51,
+	0x70, 0x57, 0x08, 0x71, 0x20, 0x72, 0x12, 0x37, 0xb5, 0x68, 0xec, 0x6c, 0xec, 0xec, 0x6e, 0xa8,
+	0x72, 0x59, 0x09, 0xa9, 0x17, 0x24, 0x24, 0x38, 0x71, 0xda, 0x03, 0xe2, 0xc4, 0xa1, 0x48, 0xe5,
+	0xc6, 0x1f, 0xb0, 0xda, 0xd3, 0x0a, 0x0e, 0x70, 0x42, 0xa8, 0x45, 0x82, 0x3f, 0x82, 0x03, 0xf2,
+	0x7b, 0xe3, 0xc4, 0x6e, 0x9c, 0xc4, 0xe9, 0x9e, 0x6a, 0xbf, 0x37, 0xf3, 0x9d, 0xcf, 0x8c, 0xc7,
+	0xcf, 0xd3, 0x40, 0xb2, 0x64, 0x5a, 0x55, 0xd3, 0x92, 0xd5, 0x86, 0x7d, 0x20, 0x3f, 0x5c, 0x2b,
+	0x6a, 0xb6, 0xba, 0x26, 0x3f, 0x68, 0x68, 0xf5, 0xa6, 0x54, 0xab, 0x9b, 0xb6, 0x49, 0x67, 0xb9,
+	0x81, 0xe4, 0x18, 0x48, 0x68, 0x20, 0xac, 0xa2, 0x57, 0x51, 0xb5, 0x34, 0x6e, 0xdd, 0xf6, 0xad,
+	0xa9, 0x15, 0xdd, 0x50, 0x6d, 0xdd, 0x34, 0xb8, 0x80, 0x10, 0xab, 0x98, 0x15, 0x93, 0x5d, 0xca,
+	0xce, 0x15, 0xae, 0x5e, 0xaf, 0x98, 0x66, 0xe5, 0x50, 0x93, 0xd9, 0x5d, 0xb1, 0xb1, 0x2f, 0xab,
+	0x06, 0x46, 0x14, 0xe6, 0x71, 0x4b, 0xad, 0xe9, 0xb2, 0x6a, 0x18, 0xa6, 0xcd, 0xd4, 0x2c, 0xdc,
+	0x4d, 0x04, 0x01, 0x33, 0x38, 0x14, 0xe6, 0xfb, 0x05, 0x1e, 0x11, 0xe1, 0xf9, 0xd6, 0x1c, 0xba,
+	0xba, 0xc0, 0xde, 0x3c, 0xc5, 0xcf, 0x20, 0xf6, 0x91, 0x73, 0x9b, 0x2d, 0x95, 0xcc, 0x86, 0x61,
+	0x5b, 0x8a, 0xf6, 0xa0, 0xa1, 0x59, 0x36, 0x7d, 0x17, 0xa0, 0x93, 0x52, 0x9c, 0xa4, 0x48, 0x7a,
+	0x6a, 0x7d, 0x49, 0x42, 0x5d, 0x27, 0x7f, 0x89, 0xab, 0x20, 0x8a, 0x94, 0x57, 0x2b, 0x1a, 0xfa,
+	0x2a, 0x1e, 0x4f, 0xf1, 0x94, 0xc0, 0xb5, 0x0b, 0x01, 0xac, 0x9a, 0x69, 0x58, 0x1a, 0x55, 0x60,
+	0x42, 0xc5, 0xb5, 0x38, 0x49, 0x8d, 0xa4, 0xa7, 0xd6, 0x63, 0x12, 0x2f, 0x81, 0xe4, 0x56, 0x47,
+	0xca, 0x1a, 0xcd, 0x5c, 0xea, 0xf9, 0x69, 0x66, 0x3e, 0xe0, 0x69, 0x48, 0xa8, 0xb8, 0xab, 0xb4,
+	0x75, 0xe8, 0x7b, 0x3e, 0xea, 0x28, 0xa3, 0x5e, 0x1e, 0x48, 0xcd, 0x81, 0x7c, 0xd8, 0x7b, 0x30,
+	0xeb, 0xa5, 0x76, 0xab, 0xb2, 0x0e, 0xe3, 0x6a, 0xb9, 0x5c, 0xd7, 0x2c, 0x8b, 0x95, 0x64, 0x32,
+	0x17, 0xff, 0xf5, 0x34, 0x13, 0x43, 0xfd, 0x2c, 0xdf, 0xd9, 0xb3, 0xeb, 0xba, 0x51, 0x51, 0x5c,
+	0xc3, 0xed, 0x89, 0xe3, 0xa7, 0xc9, 0xc8, 0xbf, 0x4f, 0x93, 0x11, 0xf1, 0x3b, 0xe2, 0x2f, 0x76,
+	0xbb, 0x14, 0x79, 0x18, 0xc7, 0x14, 0xb0, 0xd2, 0x97, 0xad, 0x84, 0x2b, 0x43, 0x6f, 0x43, 0xec,
+	0x50, 0xb5, 0xec, 0x82, 0x5a, 0xb2, 0xf5, 0x87, 0xba, 0xdd, 0x2c, 0x1c, 0x68, 0x7a, 0xe5, 0xc0,
+	0x66, 0x25, 0x19, 0x51, 0xa8, 0xb3, 0x97, 0xc5, 0xad, 0xf7, 0xd9, 0x8e, 0x18, 0x03, 0xca, 0xd8,
+	0xf2, 0x6a, 0x5d, 0xad, 0xba, 0x6d, 0x20, 0xe6, 0x61, 0xd6, 0xb7, 0x8a, 0xc0, 0x5b, 0x30, 0x56,
+	0x63, 0x2b, 0xc8, 0x3b, 0x27, 0x05, 0x61, 0x71, 0xa7, 0xdc, 0xe8, 0xb3, 0x3f, 0x93, 0x11, 0x05,
+	0x1d, 0xc4, 0x79, 0x10, 0x98, 0xe2, 0x3d, 0xb3, 0xdc, 0x38, 0xd4, 0x2e, 0xb4, 0x9d, 0xf8, 0x08,
+	0xe6, 0x02, 0x77, 0x31, 0xee, 0x27, 0x21, 0x7b, 0x66, 0xe9, 0xf9, 0x69, 0x46, 0x0c, 0x42, 0xf2,
+	0xe9, 0x7a, 0x3a, 0x47, 0xbc, 0x03, 0xc9, 0xee, 0xc0, 0xb9, 0xe6, 0x87, 0x6a, 0xd5, 0x6d, 0x6b,
+	0x4a, 0x61, 0xd4, 0x50, 0xab, 0x1a, 0x7f, 0xf2, 0x0a, 0xbb, 0x16, 0xbf, 0x80, 0x54, 0x6f, 0x37,
+	0x84, 0xfe, 0x38, 0xdc, 0xd3, 0x0d, 0xcb, 0xec, 0x8a, 0x89, 0xdb, 0x70, 0xa3, 0x3b, 0x76, 0x5e,
+	0xab, 0x57, 0x75, 0xcb, 0x72, 0x4e, 0x8e, 0x7e, 0xdc, 0x3f, 0x12, 0xb8, 0x39, 0xc0, 0x19, 0xe9,
+	0x2f, 0xd1, 0xf2, 0x34, 0x05, 0x53, 0xb5, 0x8e, 0x54, 0x3c, 0x9a, 0x1a, 0x49, 0x4f, 0x2a, 0xde,
+	0x25, 0x9a, 0x01, 0xfa, 0x48, 0x77, 0x9c, 0x0a, 0x5e, 0xc3, 0x11, 0x66, 0xf8, 0x2a, 0xdf, 0xf1,
+	0xc0, 0x88, 0xd7, 0x60, 0x36, 0xa7, 0x95, 0x0e, 0x36, 0xd6, 0xf3, 0x75, 0x6d, 0x5f, 0x7f, 0xec,
+	0x76, 0xcb, 0x5b, 0x10, 0xf3, 0x2f, 0x23, 0xf3, 0x22, 0x5c, 0x29, 0xb2, 0xf5, 0x42, 0x8d, 0x6d,
+	0x60, 0xea, 0xd3, 0x45, 0x8f, 0xb1, 0x98, 0x83, 0x39, 0xc4, 0xcf, 0x35, 0x6d, 0xcd, 0xba, 0x6f,
+	0x62, 0x16, 0x58, 0xb5, 0x45, 0xb8, 0x82, 0xe9, 0x14, 0x8a, 0xce, 0x3e, 0xd3, 0x98, 0x56, 0xa6,
+	0x55, 0x8f, 0x8f, 0xf8, 0x0e, 0xcc, 0x07, 0x6b, 0x20, 0xc8, 0x4d, 0x98, 0x71, 0x45, 0x2c, 0xb6,
+	0x83, 0x24, 0xae, 0x34, 0x37, 0x17, 0x77, 0xda, 0x28, 0x7c, 0xe1, 0xbe, 0xc9, 0xe4, 0x5c, 0x94,
+	0x90, 0x2a, 0x6f, 0xb7, 0x61, 0x2e, 0xa8, 0x74, 0xaa, 0x32, 0x38, 0xa3, 0x3d, 0x48, 0x78, 0x8f,
+	0xa8, 0x76, 0x76, 0xbb, 0x3b, 0x9d, 0x76, 0x8a, 0xea, 0x65, 0xe6, 0x3b, 0x92, 0x8b, 0xc6, 0x89,
+	0x12, 0xd5, 0xcb, 0x74, 0x01, 0x00, 0xbb, 0xb2, 0xa0, 0x97, 0xd9, 0x21, 0x33, 0xaa, 0x4c, 0xe2,
+	0xca, 0x6e, 0x59, 0x2c, 0x43, 0xb2, 0xa7, 0x28, 0xc2, 0x65, 0xe1, 0xaa, 0xab, 0x10, 0xb6, 0xdd,
+	0x66, 0x54, 0x9f, 0x9c, 0x78, 0x0f, 0x5e, 0xf3, 0x46, 0xd9, 0x35, 0xf6, 0xcd, 0x97, 0x38, 0xb7,
+	0xc5, 0x27, 0x04, 0xe2, 0xdd, 0x7a, 0x88, 0xbb, 0x09, 0xa3, 0xba, 0xb1, 0x6f, 0xe2, 0x0b, 0x9d,
+	0x0a, 0x3c, 0xfe, 0x72, 0xaa, 0xe5, 0xbe, 0x58, 0x0a, 0xb3, 0xbe, 0xc4, 0xa9, 0x5c, 0x44, 0x86,
+	0x0f, 0x54, 0x43, 0xdb, 0x73, 0x92, 0x31, 0x4a, 0xda, 0x4b, 0x24, 0xe5, 0x9c, 0x05, 0x87, 0xaa,
+	0xa1, 0xe1, 0x23, 0x62, 0xd7, 0xe2, 0x5d, 0xb8, 0x1e, 0x10, 0x03, 0x13, 0x15, 0x60, 0xc2, 0xc2,
+	0x35, 0x16, 0x65, 0x54, 0x69, 0xdf, 0xaf, 0xff, 0x77, 0x15, 0x5e, 0x61, 0x9e, 0xf4, 0x2b, 0x02,
+	0x13, 0xee, 0x61, 0x4d, 0x57, 0x02, 0xab, 0x11, 0x34, 0x65, 0x08, 0xab, 0x61, 0x4c, 0x39, 0x89,
+	0xb8, 0x7a, 0xfc, 0xcf, 0xc9, 0x2a, 0x79, 0xf2, 0xdb, 0xdf, 0xdf, 0x44, 0x93, 0x74, 0x41, 0x0e,
+	0x9c, 0x87, 0x5c, 0x84, 0x6f, 0x09, 0x8c, 0xa3, 0x00, 0x4d, 0x0f, 0x8c, 0xe1, 0xd2, 0xac, 0x84,
+	0xb0, 0x44, 0x98, 0xcd, 0x0e, 0xcc, 0x0a, 0x5d, 0xee, 0x0b, 0x23, 0x1f, 0x61, 0xf1, 0x5b, 0xf4,
+	0x67, 0x02, 0xb4, 0xfb, 0x1d, 0xa0, 0x1b, 0x03, 0xe3, 0x76, 0xbf, 0x86, 0xc2, 0xe6, 0x70, 0x4e,
+	0x43, 0x70, 0xb7, 0xcf, 0x88, 0x82, 0x5e, 0x96, 0x8f, 0xf4, 0x72, 0x8b, 0x7e, 0x49, 0x60, 0x8c,
+	0x7f, 0xcc, 0xe9, 0x72, 0xef, 0xb0, 0xbe, 0xc9, 0x41, 0x48, 0x0f, 0x36, 0x44, 0xa6, 0x74, 0x87,
+	0x69, 0x81, 0xce, 0x05, 0x32, 0xf1, 0xd9, 0x81, 0xfe, 0x40, 0x60, 0xc6, 0x3f, 0x19, 0x50, 0xb9,
+	0x77, 0x98, 0xc0, 0x09, 0x43, 0xb8, 0x1d, 0xde, 0x01, 0xf9, 0xd6, 0x3a, 0x7c, 0x4b, 0xf4, 0x46,
+	0x20, 0x5f, 0x95, 0x79, 0x16, 0xda, 0xfd, 0xf7, 0x0b, 0x81, 0xd9, 0x80, 0x91, 0x80, 0x6e, 0x86,
+	0x0c, 0xee, 0x1b, 0x3c, 0x84, 0x3b, 0x43, 0x7a, 0x21, 0xf7, 0x9b, 0x1d, 0xee, 0x0c, 0x7d, 0x3d,
+	0x0c, 0xb7, 0x7c, 0xe4, 0x0c, 0x07, 0x2d, 0xfa, 0x3b, 0x81, 0x78, 0xaf, 0xc1, 0x80, 0x6e, 0x85,
+	0xa4, 0xe9, 0x9e, 0x44, 0x84, 0xed, 0xcb, 0xb8, 0x62, 0x36, 0x3b, 0x9d, 0x6c, 0xb6, 0xe8, 0xdd,
+	0x21, 0xb2, 0x91, 0xbd, 0x73, 0xc7, 0x31, 0x81, 0x69, 0xef, 0xc8, 0xd0, 0xe3, 0x74, 0x08, 0x18,
+	0x36, 0x84, 0x95, 0x10, 0x96, 0xc8, 0xba, 0xd8, 0xb7, 0x99, 0xf9, 0x14, 0x42, 0x4f, 0x08, 0xc4,
+	0x82, 0x86, 0x07, 0x1a, 0xdc, 0xa1, 0x7d, 0x66, 0x15, 0x61, 0x6d, 0x08, 0x0f, 0x44, 0xdc, 0xe8,
+	0xdb, 0x17, 0x1c, 0x51, 0x3e, 0xf2, 0xcd, 0x0b, 0x2d, 0xfa, 0x53, 0x07, 0xd9, 0x37, 0x62, 0xf4,
+	0x47, 0x0e, 0x9a, 0x69, 0x84, 0xb5, 0x21, 0x3c, 0xdc, 0xb3, 0x8b, 0x21, 0x4b, 0xf4, 0x56, 0x28,
+	0x64, 0x3e, 0x29, 0xb5, 0xe8, 0xf7, 0x04, 0xa6, 0x3c, 0x5f, 0x70, 0x7a, 0x6b, 0xe0, 0xb9, 0xe9,
+	0x19, 0x1c, 0x84, 0x4c, 0x48, 0xeb, 0xf0, 0xaf, 0x5c, 0x7b, 0x4e, 0x32, 0xf6, 0x4d, 0xcf, 0xa7,
+	0xe1, 0x84, 0xc0, 0xb4, 0xf7, 0x03, 0x4c, 0xfb, 0x44, 0x0e, 0x18, 0x06, 0x04, 0x29, 0xac, 0x39,
+	0x92, 0x66, 0x3b, 0xa4, 0x6f, 0xd0, 0xcd, 0x90, 0x1f, 0x30, 0xd9, 0x99, 0x17, 0x2c, 0xf9, 0xc8,
+	0xf9, 0xd3, 0xca, 0x6d, 0x3c, 0x3b, 0x4b, 0x90, 0x17, 0x67, 0x09, 0xf2, 0xd7, 0x59, 0x82, 0x7c,
+	0x7d, 0x9e, 0x88, 0xbc, 0x38, 0x4f, 0x44, 0xfe, 0x38, 0x4f, 0x44, 0x3e, 0xc5, 0x1f, 0x23, 0xac,
+	0xf2, 0xe7, 0x92, 0x6e, 0xca, 0x8f, 0xb9, 0xac, 0xdd, 0xac, 0x69, 0x56, 0x71, 0x8c, 0xfd, 0xcf,
+	0xb3, 0xf1, 0xff, 0x00, 0x4b, 0x57, 0xf0, 0xc8, 0x81, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1090,6 +1353,11 @@ type QueryClient interface {
 	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
 	// ModuleAccountByName returns the module account info by module name
 	ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error)
+	// ModuleAccountPermissions returns the permissions granted to a module account,
+	// along with the permissions it was registered with at app wiring.
+	//
+	// Since: x/auth 1.0.0
+	ModuleAccountPermissions(ctx context.Context, in *QueryModuleAccountPermissionsRequest, opts ...grpc.CallOption) (*QueryModuleAccountPermissionsResponse, error)
 	// Bech32Prefix queries bech32Prefix
 	//
 	// Since: cosmos-sdk 0.46
@@ -1106,6 +1374,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// LaneSequence queries the sequence of a nonce lane of an account.
+	//
+	// Since: x/auth 1.0.0
+	LaneSequence(ctx context.Context, in *QueryLaneSequenceRequest, opts ...grpc.CallOption) (*QueryLaneSequenceResponse, error)
 }
 
 type queryClient struct {
@@ -1170,6 +1442,15 @@ func (c *queryClient) ModuleAccountByName(ctx context.Context, in *QueryModuleAc
 	return out, nil
 }
 
+func (c *queryClient) ModuleAccountPermissions(ctx context.Context, in *QueryModuleAccountPermissionsRequest, opts ...grpc.CallOption) (*QueryModuleAccountPermissionsResponse, error) {
+	out := new(QueryModuleAccountPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAccountPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Bech32Prefix(ctx context.Context, in *Bech32PrefixRequest, opts ...grpc.CallOption) (*Bech32PrefixResponse, error) {
 	out := new(Bech32PrefixResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Bech32Prefix", in, out, opts...)
@@ -1206,6 +1487,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) LaneSequence(ctx context.Context, in *QueryLaneSequenceRequest, opts ...grpc.CallOption) (*QueryLaneSequenceResponse, error) {
+	out := new(QueryLaneSequenceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/LaneSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1229,6 +1519,11 @@ type QueryServer interface {
 	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
 	// ModuleAccountByName returns the module account info by module name
 	ModuleAccountByName(context.Context, *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error)
+	// ModuleAccountPermissions returns the permissions granted to a module account,
+	// along with the permissions it was registered with at app wiring.
+	//
+	// Since: x/auth 1.0.0
+	ModuleAccountPermissions(context.Context, *QueryModuleAccountPermissionsRequest) (*QueryModuleAccountPermissionsResponse, error)
 	// Bech32Prefix queries bech32Prefix
 	//
 	// Since: cosmos-sdk 0.46
@@ -1245,6 +1540,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// LaneSequence queries the sequence of a nonce lane of an account.
+	//
+	// Since: x/auth 1.0.0
+	LaneSequence(context.Context, *QueryLaneSequenceRequest) (*QueryLaneSequenceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1269,6 +1568,9 @@ func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) ModuleAccountByName(ctx context.Context, req *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountByName not implemented")
 }
+func (*UnimplementedQueryServer) ModuleAccountPermissions(ctx context.Context, req *QueryModuleAccountPermissionsRequest) (*QueryModuleAccountPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountPermissions not implemented")
+}
 func (*UnimplementedQueryServer) Bech32Prefix(ctx context.Context, req *Bech32PrefixRequest) (*Bech32PrefixResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Bech32Prefix not implemented")
 }
@@ -1281,6 +1583,9 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) LaneSequence(ctx context.Context, req *QueryLaneSequenceRequest) (*QueryLaneSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LaneSequence not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1394,6 +1699,24 @@ func _Query_ModuleAccountByName_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ModuleAccountPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/ModuleAccountPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountPermissions(ctx, req.(*QueryModuleAccountPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Bech32Prefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Bech32PrefixRequest)
 	if err := dec(in); err != nil {
@@ -1466,6 +1789,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LaneSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLaneSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LaneSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/LaneSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LaneSequence(ctx, req.(*QueryLaneSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1494,6 +1835,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleAccountByName",
 			Handler:    _Query_ModuleAccountByName_Handler,
 		},
+		{
+			MethodName: "ModuleAccountPermissions",
+			Handler:    _Query_ModuleAccountPermissions_Handler,
+		},
 		{
 			MethodName: "Bech32Prefix",
 			Handler:    _Query_Bech32Prefix_Handler,
@@ -1510,6 +1855,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "LaneSequence",
+			Handler:    _Query_LaneSequence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -1649,6 +1998,11 @@ func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastActivityHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastActivityHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Account != nil {
 		{
 			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
@@ -1845,7 +2199,7 @@ func (m *QueryModuleAccountByNameResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *Bech32PrefixRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryModuleAccountPermissionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1855,20 +2209,27 @@ func (m *Bech32PrefixRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Bech32PrefixRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryModuleAccountPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Bech32PrefixRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryModuleAccountPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Bech32PrefixResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryModuleAccountPermissionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1878,27 +2239,45 @@ func (m *Bech32PrefixResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Bech32PrefixResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryModuleAccountPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Bech32PrefixResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryModuleAccountPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Bech32Prefix) > 0 {
-		i -= len(m.Bech32Prefix)
-		copy(dAtA[i:], m.Bech32Prefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bech32Prefix)))
+	if len(m.WiringPermissions) > 0 {
+		for iNdEx := len(m.WiringPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WiringPermissions[iNdEx])
+			copy(dAtA[i:], m.WiringPermissions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.WiringPermissions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddressBytesToStringRequest) Marshal() (dAtA []byte, err error) {
+func (m *Bech32PrefixRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1908,27 +2287,20 @@ func (m *AddressBytesToStringRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddressBytesToStringRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Bech32PrefixRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddressBytesToStringRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Bech32PrefixRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AddressBytes) > 0 {
-		i -= len(m.AddressBytes)
-		copy(dAtA[i:], m.AddressBytes)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AddressBytes)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddressBytesToStringResponse) Marshal() (dAtA []byte, err error) {
+func (m *Bech32PrefixResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1938,7 +2310,67 @@ func (m *AddressBytesToStringResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddressBytesToStringResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Bech32PrefixResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bech32PrefixResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bech32Prefix) > 0 {
+		i -= len(m.Bech32Prefix)
+		copy(dAtA[i:], m.Bech32Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bech32Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressBytesToStringRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressBytesToStringRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressBytesToStringRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressBytes) > 0 {
+		i -= len(m.AddressBytes)
+		copy(dAtA[i:], m.AddressBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AddressBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressBytesToStringResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressBytesToStringResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2131,6 +2563,11 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.LastActivityHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastActivityHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Info != nil {
 		{
 			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
@@ -2146,6 +2583,69 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryLaneSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLaneSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLaneSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lane != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Lane))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLaneSequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLaneSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLaneSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2212,6 +2712,9 @@ func (m *QueryAccountResponse) Size() (n int) {
 		l = m.Account.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.LastActivityHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastActivityHeight))
+	}
 	return n
 }
 
@@ -2285,6 +2788,44 @@ func (m *QueryModuleAccountByNameResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryModuleAccountPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleAccountPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WiringPermissions) > 0 {
+		for _, s := range m.WiringPermissions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Bech32PrefixRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2410,6 +2951,37 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 		l = m.Info.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.LastActivityHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastActivityHeight))
+	}
+	return n
+}
+
+func (m *QueryLaneSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Lane != 0 {
+		n += 1 + sovQuery(uint64(m.Lane))
+	}
+	return n
+}
+
+func (m *QueryLaneSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -2772,6 +3344,25 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityHeight", wireType)
+			}
+			m.LastActivityHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActivityHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3228,57 +3819,7 @@ func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Bech32PrefixRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bech32PrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountPermissionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3301,15 +3842,15 @@ func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bech32PrefixResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountPermissionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bech32PrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3337,7 +3878,7 @@ func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3360,7 +3901,7 @@ func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountPermissionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3383,17 +3924,17 @@ func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressBytesToStringRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountPermissionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressBytesToStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3403,12 +3944,290 @@ func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WiringPermissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WiringPermissions = append(m.WiringPermissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bech32PrefixRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bech32PrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bech32PrefixResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bech32PrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressBytesToStringRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressBytesToStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
 			postIndex := iNdEx + byteLen
@@ -4009,6 +4828,195 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityHeight", wireType)
+			}
+			m.LastActivityHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActivityHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLaneSequenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLaneSequenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLaneSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			m.Lane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lane |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLaneSequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLaneSequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLaneSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
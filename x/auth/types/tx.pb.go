@@ -130,36 +130,255 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgConvertVestedAccount is the Msg/ConvertVestedAccount request type.
+//
+// Since: x/auth 1.0.0
+type MsgConvertVestedAccount struct {
+	// address is the address of the fully vested account to convert.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgConvertVestedAccount) Reset()         { *m = MsgConvertVestedAccount{} }
+func (m *MsgConvertVestedAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertVestedAccount) ProtoMessage()    {}
+func (*MsgConvertVestedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{2}
+}
+func (m *MsgConvertVestedAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertVestedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertVestedAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertVestedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertVestedAccount.Merge(m, src)
+}
+func (m *MsgConvertVestedAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertVestedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertVestedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertVestedAccount proto.InternalMessageInfo
+
+func (m *MsgConvertVestedAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgConvertVestedAccountResponse defines the response structure for executing a
+// MsgConvertVestedAccount message.
+//
+// Since: x/auth 1.0.0
+type MsgConvertVestedAccountResponse struct {
+}
+
+func (m *MsgConvertVestedAccountResponse) Reset()         { *m = MsgConvertVestedAccountResponse{} }
+func (m *MsgConvertVestedAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertVestedAccountResponse) ProtoMessage()    {}
+func (*MsgConvertVestedAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{3}
+}
+func (m *MsgConvertVestedAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertVestedAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertVestedAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertVestedAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertVestedAccountResponse.Merge(m, src)
+}
+func (m *MsgConvertVestedAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertVestedAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertVestedAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertVestedAccountResponse proto.InternalMessageInfo
+
+// MsgUpdateModuleAccountPermissions is the Msg/UpdateModuleAccountPermissions request type.
+//
+// Since: x/auth 1.0.0
+type MsgUpdateModuleAccountPermissions struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// module_name is the name of the module account to update.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// permissions defines the permissions granted to the module account, replacing
+	// its current minter, burner and staking permissions. Only these permissions can
+	// be granted, and an empty list revokes all of them. The other permissions of
+	// the account are kept.
+	//
+	// NOTE: All permissions must be supplied.
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (m *MsgUpdateModuleAccountPermissions) Reset()         { *m = MsgUpdateModuleAccountPermissions{} }
+func (m *MsgUpdateModuleAccountPermissions) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateModuleAccountPermissions) ProtoMessage()    {}
+func (*MsgUpdateModuleAccountPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{4}
+}
+func (m *MsgUpdateModuleAccountPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateModuleAccountPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateModuleAccountPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateModuleAccountPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateModuleAccountPermissions.Merge(m, src)
+}
+func (m *MsgUpdateModuleAccountPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateModuleAccountPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateModuleAccountPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateModuleAccountPermissions proto.InternalMessageInfo
+
+func (m *MsgUpdateModuleAccountPermissions) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateModuleAccountPermissions) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *MsgUpdateModuleAccountPermissions) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+// MsgUpdateModuleAccountPermissionsResponse defines the response structure for executing a
+// MsgUpdateModuleAccountPermissions message.
+//
+// Since: x/auth 1.0.0
+type MsgUpdateModuleAccountPermissionsResponse struct {
+}
+
+func (m *MsgUpdateModuleAccountPermissionsResponse) Reset() {
+	*m = MsgUpdateModuleAccountPermissionsResponse{}
+}
+func (m *MsgUpdateModuleAccountPermissionsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateModuleAccountPermissionsResponse) ProtoMessage() {}
+func (*MsgUpdateModuleAccountPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{5}
+}
+func (m *MsgUpdateModuleAccountPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateModuleAccountPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateModuleAccountPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateModuleAccountPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateModuleAccountPermissionsResponse.Merge(m, src)
+}
+func (m *MsgUpdateModuleAccountPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateModuleAccountPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateModuleAccountPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateModuleAccountPermissionsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.auth.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgConvertVestedAccount)(nil), "cosmos.auth.v1beta1.MsgConvertVestedAccount")
+	proto.RegisterType((*MsgConvertVestedAccountResponse)(nil), "cosmos.auth.v1beta1.MsgConvertVestedAccountResponse")
+	proto.RegisterType((*MsgUpdateModuleAccountPermissions)(nil), "cosmos.auth.v1beta1.MsgUpdateModuleAccountPermissions")
+	proto.RegisterType((*MsgUpdateModuleAccountPermissionsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateModuleAccountPermissionsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/tx.proto", fileDescriptor_c2d62bd9c4c212e5) }
 
 var fileDescriptor_c2d62bd9c4c212e5 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79, 0x7d, 0x10, 0x0b, 0xa2, 0x54, 0x4a,
-	0x12, 0xa2, 0x34, 0x1e, 0x22, 0x01, 0xd5, 0x07, 0x91, 0x12, 0x87, 0xda, 0x91, 0x5b, 0x9c, 0xae,
-	0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x2a,
-	0x24, 0x87, 0xcd, 0x3d, 0x60, 0xeb, 0xc1, 0xf2, 0x4a, 0xfb, 0x19, 0xb9, 0xf8, 0x7d, 0x8b, 0xd3,
-	0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x85, 0xcc, 0xb8, 0x38,
-	0x41, 0x2a, 0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e,
-	0x6d, 0xd1, 0x15, 0x81, 0x3a, 0xc2, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28,
-	0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x8e, 0x8b, 0xad, 0x00, 0x6c, 0x82, 0x04, 0x93, 0x02,
-	0xa3, 0x06, 0xb7, 0x91, 0xb4, 0x1e, 0x16, 0xef, 0xea, 0x41, 0x2c, 0x71, 0xe2, 0x3c, 0x71, 0x4f,
-	0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x56, 0x26, 0x4d, 0xcf, 0x37, 0x68,
-	0x21, 0xcc, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x11, 0x62, 0x82, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05,
-	0xc4, 0x13, 0x68, 0xae, 0x55, 0x92, 0xe4, 0x12, 0x47, 0x13, 0x0a, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf,
-	0x2b, 0x4e, 0x35, 0x2a, 0xe0, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x4a, 0xe2, 0xe2, 0x41, 0xf1, 0x9f,
-	0x0a, 0x56, 0x77, 0xa1, 0x19, 0x22, 0xa5, 0x43, 0x8c, 0x2a, 0x98, 0x55, 0x52, 0xac, 0x0d, 0x20,
-	0xaf, 0x38, 0x19, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x34, 0x3e,
-	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0x61, 0x7e, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0x47, 0x85, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x09, 0xbc, 0x34, 0x3c, 0x02, 0x00, 0x00,
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x11, 0x51, 0xe4, 0x0b, 0x12, 0xc2, 0x44, 0x6a, 0x6a, 0x90, 0x93, 0x58, 0x08, 0x85,
+	0x40, 0x6d, 0x25, 0xa9, 0x90, 0xc8, 0x50, 0xa9, 0x61, 0x0e, 0xaa, 0x8c, 0x60, 0x60, 0xa9, 0x9c,
+	0xf8, 0x64, 0xac, 0x62, 0x9f, 0xe5, 0x77, 0x89, 0x5a, 0x26, 0xd4, 0x05, 0x89, 0x89, 0x91, 0x3f,
+	0x81, 0x31, 0x03, 0x3b, 0x6b, 0xc7, 0x8a, 0x09, 0x16, 0x84, 0x92, 0x21, 0xff, 0x06, 0xf2, 0xdd,
+	0x39, 0x69, 0x83, 0x4d, 0x68, 0x97, 0xfc, 0x78, 0xdf, 0xf7, 0xde, 0xf7, 0x7d, 0x7e, 0xe7, 0xc3,
+	0xf7, 0x86, 0x14, 0x02, 0x0a, 0x96, 0x33, 0x62, 0x6f, 0xac, 0x71, 0x6b, 0x40, 0x98, 0xd3, 0xb2,
+	0xd8, 0x91, 0x19, 0xc5, 0x94, 0x51, 0xf5, 0x8e, 0x40, 0xcd, 0x04, 0x35, 0x25, 0xaa, 0x95, 0x3d,
+	0xea, 0x51, 0x8e, 0x5b, 0xc9, 0x2f, 0x41, 0xd5, 0xb6, 0x04, 0xf5, 0x40, 0x00, 0xb2, 0x4f, 0x40,
+	0x9b, 0x52, 0x23, 0x00, 0xcf, 0x1a, 0xb7, 0x92, 0x2f, 0x09, 0xdc, 0x76, 0x02, 0x3f, 0xa4, 0x16,
+	0xff, 0x94, 0x25, 0x3d, 0xcb, 0x0f, 0x97, 0xe7, 0xb8, 0xf1, 0x0d, 0xe1, 0x5b, 0x7d, 0xf0, 0x5e,
+	0x46, 0xae, 0xc3, 0xc8, 0xbe, 0x13, 0x3b, 0x01, 0xa8, 0x4f, 0xb0, 0x92, 0x30, 0x68, 0xec, 0xb3,
+	0xe3, 0x0a, 0xaa, 0xa1, 0x86, 0xd2, 0xab, 0x7c, 0xff, 0xba, 0x5d, 0x96, 0x26, 0xf6, 0x5c, 0x37,
+	0x26, 0x00, 0x2f, 0x58, 0xec, 0x87, 0x9e, 0xbd, 0xa4, 0xaa, 0xbb, 0x78, 0x23, 0xe2, 0x13, 0x2a,
+	0xd7, 0x6a, 0xa8, 0x51, 0x6a, 0xdf, 0x35, 0x33, 0xe2, 0x9a, 0x42, 0xa4, 0xa7, 0x9c, 0xfe, 0xaa,
+	0x16, 0xbe, 0xcc, 0x27, 0x4d, 0x64, 0xcb, 0xae, 0xee, 0xce, 0xc9, 0x7c, 0xd2, 0x5c, 0xce, 0xfb,
+	0x38, 0x9f, 0x34, 0xeb, 0x62, 0xc2, 0x36, 0xb8, 0x87, 0xd6, 0x91, 0x08, 0xb1, 0xe2, 0xd6, 0xd8,
+	0xc2, 0x9b, 0x2b, 0x25, 0x9b, 0x40, 0x44, 0x43, 0x20, 0xc6, 0x09, 0xe2, 0xd8, 0x33, 0x1a, 0x8e,
+	0x49, 0xcc, 0x5e, 0x11, 0x60, 0xc4, 0xdd, 0x1b, 0x0e, 0xe9, 0x28, 0x64, 0x6a, 0x1b, 0xdf, 0x70,
+	0x44, 0x90, 0xb5, 0x11, 0x53, 0x62, 0xb7, 0x93, 0x18, 0x4c, 0xff, 0x25, 0xf6, 0x8c, 0x73, 0xf6,
+	0x72, 0x84, 0x8c, 0x3a, 0xae, 0xe6, 0x40, 0x0b, 0x9f, 0x3f, 0x11, 0xae, 0x2f, 0x32, 0xf4, 0xa9,
+	0x3b, 0x7a, 0x4b, 0x24, 0x65, 0x9f, 0xc4, 0x81, 0x0f, 0xe0, 0xd3, 0xf0, 0xea, 0x6b, 0xa9, 0xe2,
+	0x52, 0xc0, 0x67, 0x1e, 0x84, 0x4e, 0x40, 0xf8, 0x6e, 0x14, 0x1b, 0x8b, 0xd2, 0x73, 0x27, 0x20,
+	0x6a, 0x0d, 0x97, 0xa2, 0xa5, 0x4e, 0xa5, 0x58, 0x2b, 0x36, 0x14, 0xfb, 0x7c, 0xa9, 0xfb, 0xf4,
+	0xef, 0xcd, 0x3c, 0xb8, 0x18, 0x3d, 0xc7, 0x3b, 0x18, 0x8f, 0xf0, 0xc3, 0xb5, 0xd1, 0xd2, 0x07,
+	0xd1, 0xfe, 0x50, 0xc4, 0xc5, 0x3e, 0x78, 0xea, 0x00, 0xdf, 0xbc, 0x70, 0x22, 0xef, 0x67, 0x9e,
+	0xa4, 0x95, 0xb5, 0x6b, 0x8f, 0xff, 0x87, 0x95, 0x6a, 0xa9, 0xef, 0x70, 0x39, 0xf3, 0x60, 0xe4,
+	0x4e, 0xc9, 0x62, 0x6b, 0x3b, 0x97, 0x61, 0x2f, 0xb4, 0x3f, 0x23, 0xac, 0xaf, 0xdb, 0xf6, 0xbf,
+	0xc3, 0xe4, 0xf5, 0x69, 0xbb, 0x57, 0xeb, 0x4b, 0xad, 0x69, 0xd7, 0xdf, 0x27, 0xef, 0x64, 0xaf,
+	0x73, 0x3a, 0xd5, 0xd1, 0xd9, 0x54, 0x47, 0xbf, 0xa7, 0x3a, 0xfa, 0x34, 0xd3, 0x0b, 0x67, 0x33,
+	0xbd, 0xf0, 0x63, 0xa6, 0x17, 0x5e, 0xcb, 0x8b, 0x09, 0xdc, 0x43, 0xd3, 0xa7, 0xe9, 0x4b, 0xc9,
+	0x8e, 0x23, 0x02, 0x83, 0x0d, 0x7e, 0xa7, 0x74, 0xfe, 0x0c, 0x00, 0x1e, 0xf5, 0xa2, 0xf4, 0x05,
+	0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -179,6 +398,17 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ConvertVestedAccount defines an operation for replacing a vesting account
+	// whose vesting schedule has fully elapsed with a BaseAccount.
+	//
+	// Since: x/auth 1.0.0
+	ConvertVestedAccount(ctx context.Context, in *MsgConvertVestedAccount, opts ...grpc.CallOption) (*MsgConvertVestedAccountResponse, error)
+	// UpdateModuleAccountPermissions defines a (governance) operation for replacing
+	// the permissions granted to a module account. The authority defaults to the
+	// x/gov module account.
+	//
+	// Since: x/auth 1.0.0
+	UpdateModuleAccountPermissions(ctx context.Context, in *MsgUpdateModuleAccountPermissions, opts ...grpc.CallOption) (*MsgUpdateModuleAccountPermissionsResponse, error)
 }
 
 type msgClient struct {
@@ -198,6 +428,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ConvertVestedAccount(ctx context.Context, in *MsgConvertVestedAccount, opts ...grpc.CallOption) (*MsgConvertVestedAccountResponse, error) {
+	out := new(MsgConvertVestedAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/ConvertVestedAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateModuleAccountPermissions(ctx context.Context, in *MsgUpdateModuleAccountPermissions, opts ...grpc.CallOption) (*MsgUpdateModuleAccountPermissionsResponse, error) {
+	out := new(MsgUpdateModuleAccountPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/UpdateModuleAccountPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
@@ -205,6 +453,17 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ConvertVestedAccount defines an operation for replacing a vesting account
+	// whose vesting schedule has fully elapsed with a BaseAccount.
+	//
+	// Since: x/auth 1.0.0
+	ConvertVestedAccount(context.Context, *MsgConvertVestedAccount) (*MsgConvertVestedAccountResponse, error)
+	// UpdateModuleAccountPermissions defines a (governance) operation for replacing
+	// the permissions granted to a module account. The authority defaults to the
+	// x/gov module account.
+	//
+	// Since: x/auth 1.0.0
+	UpdateModuleAccountPermissions(context.Context, *MsgUpdateModuleAccountPermissions) (*MsgUpdateModuleAccountPermissionsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -214,6 +473,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ConvertVestedAccount(ctx context.Context, req *MsgConvertVestedAccount) (*MsgConvertVestedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestedAccount not implemented")
+}
+func (*UnimplementedMsgServer) UpdateModuleAccountPermissions(ctx context.Context, req *MsgUpdateModuleAccountPermissions) (*MsgUpdateModuleAccountPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateModuleAccountPermissions not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -237,6 +502,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertVestedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertVestedAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertVestedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/ConvertVestedAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertVestedAccount(ctx, req.(*MsgConvertVestedAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateModuleAccountPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateModuleAccountPermissions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateModuleAccountPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/UpdateModuleAccountPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateModuleAccountPermissions(ctx, req.(*MsgUpdateModuleAccountPermissions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -245,6 +546,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ConvertVestedAccount",
+			Handler:    _Msg_ConvertVestedAccount_Handler,
+		},
+		{
+			MethodName: "UpdateModuleAccountPermissions",
+			Handler:    _Msg_UpdateModuleAccountPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -313,6 +622,128 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgConvertVestedAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertVestedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertVestedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertVestedAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertVestedAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertVestedAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateModuleAccountPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateModuleAccountPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateModuleAccountPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateModuleAccountPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateModuleAccountPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateModuleAccountPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -348,6 +779,60 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgConvertVestedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertVestedAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateModuleAccountPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateModuleAccountPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -519,6 +1004,334 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgConvertVestedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVestedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVestedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVestedAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVestedAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVestedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateModuleAccountPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateModuleAccountPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateModuleAccountPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateModuleAccountPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateModuleAccountPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateModuleAccountPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,329 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// Projection holds the amounts of a vesting account at a point in time.
+type Projection struct {
+	Time     time.Time `json:"time"`
+	Vested   sdk.Coins `json:"vested"`
+	Unvested sdk.Coins `json:"unvested"`
+	Locked   sdk.Coins `json:"locked"`
+}
+
+// ProjectionResponse holds the projected amounts of a vesting account.
+type ProjectionResponse struct {
+	Address     string       `json:"address"`
+	Projections []Projection `json:"projections"`
+}
+
+// SpendableResponse holds the spendable balance of an account and the reasons
+// the rest of its balance is locked.
+type SpendableResponse struct {
+	Address string    `json:"address"`
+	Time    time.Time `json:"time"`
+	Balance sdk.Coins `json:"balance"`
+	// Spendable is the part of the balance the account can spend, as computed by
+	// the bank keeper.
+	Spendable sdk.Coins `json:"spendable"`
+	// Locked is the part of the balance the account cannot spend.
+	Locked sdk.Coins `json:"locked"`
+	// Lockup are the coins of a permanent locked account, which never unlock.
+	Lockup sdk.Coins `json:"lockup"`
+	// Unvested are the coins of the vesting schedule that have not vested yet.
+	Unvested sdk.Coins `json:"unvested"`
+	// DelegatedVesting are the lockup and unvested coins that are delegated.
+	// They do not lock the balance since they are no longer part of it.
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"`
+}
+
+// GetQueryCmd returns the query commands for the vesting module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetProjectCmd(),
+		GetSpendableCmd(),
+		GetGrantsAuditCmd(),
+	)
+
+	return queryCmd
+}
+
+// GetProjectCmd returns a CLI command projecting the amounts of a vesting account
+// at arbitrary points in time from its on-chain schedule.
+func GetProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project [address] [timestamp...]",
+		Short: "Project the vested, unvested and locked amounts of a vesting account at the given times",
+		Long: `Project the vested, unvested and locked amounts of a vesting account at the given times.
+Timestamps are either RFC3339 dates or unix timestamps in seconds.
+
+The projection is computed locally from the account schedule queried on chain. The locked
+amounts assume the delegations of the account stay as they currently are.`,
+		Example: fmt.Sprintf(`%[1]s query vesting project cosmos1... 2025-01-01T00:00:00Z 1767225600
+%[1]s query vesting project cosmos1... 2025-01-01T00:00:00Z --watch=30s`, version.AppName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			times := make([]time.Time, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				t, err := ParseTimestamp(arg)
+				if err != nil {
+					return err
+				}
+				times = append(times, t)
+			}
+
+			query := func() (string, error) {
+				res, err := authtypes.NewQueryClient(clientCtx).Account(cmd.Context(), &authtypes.QueryAccountRequest{Address: args[0]})
+				if err != nil {
+					return "", err
+				}
+
+				var acc sdk.AccountI
+				if err := clientCtx.InterfaceRegistry.UnpackAny(res.Account, &acc); err != nil {
+					return "", err
+				}
+
+				vestingAcc, ok := acc.(exported.VestingAccount)
+				if !ok {
+					return "", fmt.Errorf("account %s is not a vesting account", args[0])
+				}
+
+				bz, err := json.Marshal(ProjectionResponse{
+					Address:     args[0],
+					Projections: Project(vestingAcc, times),
+				})
+				if err != nil {
+					return "", err
+				}
+
+				var out bytes.Buffer
+				if err := clientCtx.WithOutput(&out).PrintRaw(bz); err != nil {
+					return "", err
+				}
+				return out.String(), nil
+			}
+
+			// the locked amounts change as the account delegates and undelegates
+			if interval, _ := cmd.Flags().GetDuration(flags.FlagWatch); interval != 0 {
+				return client.Watch(cmd.Context(), cmd.OutOrStdout(), interval, query)
+			}
+
+			out, err := query()
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddWatchFlagToCmd(cmd)
+
+	return cmd
+}
+
+// GetSpendableCmd returns a CLI command querying the spendable balance of an
+// account and explaining why the rest of its balance is locked.
+func GetSpendableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable [address]",
+		Short: "Query the spendable balance of an account and why the rest of its balance is locked",
+		Long: `Query the spendable balance of an account, as computed by the bank keeper, and break
+down the locked part of its balance:
+
+- lockup: the coins of a permanent locked account, which never unlock,
+- unvested: the coins of the vesting schedule that have not vested yet,
+- delegated_vesting: the lockup and unvested coins that are delegated. They are no
+  longer part of the balance, so they do not lock it: delegating locked coins frees
+  as much of the balance, and undelegating them locks it again.
+
+The locked balance is the lockup and unvested coins that are not delegated. Spending
+more than the spendable balance fails with insufficient funds, even when the balance
+is large enough.`,
+		Example: fmt.Sprintf("%s query vesting spendable cosmos1...", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bankClient := banktypes.NewQueryClient(clientCtx)
+			all := &query.PageRequest{Limit: query.PaginationMaxLimit}
+			balances, err := bankClient.AllBalances(cmd.Context(), &banktypes.QueryAllBalancesRequest{Address: args[0], Pagination: all})
+			if err != nil {
+				return err
+			}
+			spendable, err := bankClient.SpendableBalances(cmd.Context(), &banktypes.QuerySpendableBalancesRequest{Address: args[0], Pagination: all})
+			if err != nil {
+				return err
+			}
+
+			// accounts that were never used only hold a balance
+			var acc sdk.AccountI
+			res, err := authtypes.NewQueryClient(clientCtx).Account(cmd.Context(), &authtypes.QueryAccountRequest{Address: args[0]})
+			switch {
+			case status.Code(err) == codes.NotFound:
+			case err != nil:
+				return err
+			default:
+				if err := clientCtx.InterfaceRegistry.UnpackAny(res.Account, &acc); err != nil {
+					return err
+				}
+			}
+
+			// the vesting schedule is evaluated at the time of the latest block, as
+			// the bank keeper does
+			nodeStatus, err := cmtservice.GetNodeStatus(cmd.Context(), clientCtx)
+			if err != nil {
+				return err
+			}
+
+			resp := Spendable(acc, balances.Balances, spendable.Balances, nodeStatus.SyncInfo.LatestBlockTime)
+			resp.Address = args[0]
+			bz, err := json.Marshal(resp)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetGrantsAuditCmd returns a CLI command listing the outgoing grants of a
+// vesting account and whether they can move its locked coins.
+func GetGrantsAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants-audit [address]",
+		Short: "Query the outgoing authz grants and fee allowances of a vesting account and whether they can move its locked coins",
+		Long: `Query the outgoing authz grants and fee allowances of a vesting account, and whether
+the grantees could use them to move its locked coins.
+
+Bank sends and fees only spend the spendable balance, but locked coins can be delegated:
+a grantee allowed to delegate or redelegate on behalf of the account can stake its locked
+coins with a validator of its choice. Authorizations of unknown types are reported as
+able to move locked coins.`,
+		Example: fmt.Sprintf("%s query vesting grants-audit cosmos1...", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).GrantsAudit(cmd.Context(), &types.QueryGrantsAuditRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Spendable returns the spendable balance of an account and the breakdown of its
+// locked balance at the given time, from its balance and its spendable balance
+// as computed by the bank keeper. The account may be nil.
+func Spendable(acc sdk.AccountI, balance, spendable sdk.Coins, blockTime time.Time) SpendableResponse {
+	locked, hasNeg := balance.SafeSub(spendable...)
+	if hasNeg {
+		locked = sdk.NewCoins()
+	}
+
+	resp := SpendableResponse{
+		Time:             blockTime.UTC(),
+		Balance:          balance,
+		Spendable:        spendable,
+		Locked:           locked,
+		Lockup:           sdk.NewCoins(),
+		Unvested:         sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+	}
+
+	vestingAcc, ok := acc.(exported.VestingAccount)
+	if !ok {
+		return resp
+	}
+
+	if _, ok := acc.(*types.PermanentLockedAccount); ok {
+		resp.Lockup = vestingAcc.GetVestingCoins(blockTime)
+	} else {
+		resp.Unvested = vestingAcc.GetVestingCoins(blockTime)
+	}
+	resp.DelegatedVesting = vestingAcc.GetDelegatedVesting()
+
+	return resp
+}
+
+// Project returns the amounts of the vesting account at each of the given times.
+func Project(acc exported.VestingAccount, times []time.Time) []Projection {
+	projections := make([]Projection, 0, len(times))
+	for _, t := range times {
+		projections = append(projections, Projection{
+			Time:     t,
+			Vested:   acc.GetVestedCoins(t),
+			Unvested: acc.GetVestingCoins(t),
+			Locked:   acc.LockedCoins(t),
+		})
+	}
+
+	return projections
+}
+
+// ParseTimestamp parses an RFC3339 date or a unix timestamp in seconds.
+func ParseTimestamp(s string) (time.Time, error) {
+	if unix, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(unix, 0).UTC(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %s, expected an RFC3339 date or a unix timestamp: %w", s, err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,102 @@
+package cli_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/client/cli"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expT   time.Time
+		expErr bool
+	}{
+		{"unix timestamp", "1767225600", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"rfc3339 date", "2026-01-01T00:00:00Z", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"invalid date", "2026-01-01", time.Time{}, true},
+		{"empty", "", time.Time{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := cli.ParseTimestamp(tc.input)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.expT.Equal(got))
+		})
+	}
+}
+
+func TestProject(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := now.Add(24 * time.Hour)
+	_, _, addr := testdata.KeyTestPubAddr()
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	acc, err := types.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(addr), origCoins, now.Unix(), endTime.Unix())
+	require.NoError(t, err)
+
+	projections := cli.Project(acc, []time.Time{now, now.Add(12 * time.Hour), endTime})
+	require.Len(t, projections, 3)
+
+	require.True(t, projections[0].Vested.IsZero())
+	require.Equal(t, origCoins, projections[0].Unvested)
+	require.Equal(t, origCoins, projections[0].Locked)
+
+	half := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	require.Equal(t, half, projections[1].Vested)
+	require.Equal(t, half, projections[1].Unvested)
+	require.Equal(t, half, projections[1].Locked)
+
+	require.Equal(t, origCoins, projections[2].Vested)
+	require.True(t, projections[2].Unvested.IsZero())
+	require.True(t, projections[2].Locked.IsZero())
+}
+
+func TestSpendable(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, addr := testdata.KeyTestPubAddr()
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	acc, err := types.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(addr), stake(100), now.Unix(), now.Add(24*time.Hour).Unix())
+	require.NoError(t, err)
+	// 30stake of unvested coins are delegated
+	acc.TrackDelegation(now, stake(100), stake(30))
+
+	// halfway through, 50stake are unvested and 30stake of them are delegated,
+	// so 20stake of the remaining 70stake balance are locked
+	halfway := now.Add(12 * time.Hour)
+	resp := cli.Spendable(acc, stake(70), stake(50), halfway)
+	require.Equal(t, stake(70), resp.Balance)
+	require.Equal(t, stake(50), resp.Spendable)
+	require.Equal(t, stake(20), resp.Locked)
+	require.Equal(t, stake(50), resp.Unvested)
+	require.Equal(t, stake(30), resp.DelegatedVesting)
+	require.True(t, resp.Lockup.IsZero())
+
+	// the coins of permanent locked accounts are lockup rather than unvested
+	locked, err := types.NewPermanentLockedAccount(authtypes.NewBaseAccountWithAddress(addr), stake(100))
+	require.NoError(t, err)
+	resp = cli.Spendable(locked, stake(100), sdk.NewCoins(), halfway)
+	require.Equal(t, stake(100), resp.Locked)
+	require.Equal(t, stake(100), resp.Lockup)
+	require.True(t, resp.Unvested.IsZero())
+
+	// accounts without a vesting schedule can spend their whole balance
+	resp = cli.Spendable(nil, stake(100), stake(100), halfway)
+	require.True(t, resp.Locked.IsZero())
+	require.True(t, resp.Unvested.IsZero())
+}
@@ -0,0 +1,358 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingData is the periods JSON file of a periodic vesting schedule.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+	// Total is the sum of the coins of the periods. It is optional in files
+	// written by hand and checked against the periods when set.
+	Total string `json:"total,omitempty"`
+}
+
+// InputPeriod is a period of a periodic vesting schedule: the coins vesting at
+// its end and its length in seconds.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// scheduleFile is the periods JSON file as written by operators. Periods carry
+// either a length, in seconds (v1) or as a human duration, or an absolute
+// timestamp (v2). The start time is either a unix timestamp, an RFC3339 date or
+// a time relative to the node time, such as "now+30d".
+type scheduleFile struct {
+	StartTime json.RawMessage `json:"start_time"`
+	Periods   []struct {
+		Coins    string `json:"coins"`
+		Length   *int64 `json:"length_seconds"`
+		Duration string `json:"duration"`
+		Time     string `json:"time"`
+	} `json:"periods"`
+	Total string `json:"total"`
+}
+
+// scheduleKeys and periodKeys are the keys allowed in a periods JSON file and in
+// its periods.
+var (
+	scheduleKeys = map[string]bool{"start_time": true, "periods": true, "total": true}
+	periodKeys   = map[string]bool{"coins": true, "length_seconds": true, "duration": true, "time": true}
+)
+
+// scheduleLines holds the lines of the elements of a periods JSON file, used
+// to point errors at the offending line.
+type scheduleLines struct {
+	keys    map[string]int
+	periods []int
+}
+
+// ReadScheduleFile reads a periods JSON file. Periods are given either by their
+// length in seconds:
+//
+//	{"start_time": 1735689600, "periods": [{"coins": "10stake", "length_seconds": 2678400}]}
+//
+// or by the absolute time at which they end, converted to lengths:
+//
+//	{"start_time": "2025-01-01T00:00:00Z", "periods": [{"coins": "10stake", "time": "2025-02-01T00:00:00Z"}]}
+//
+// Lengths may also be given as human durations, see ParseDuration, and the start
+// time relative to now:
+//
+//	{"start_time": "now+30d", "periods": [{"coins": "10stake", "duration": "30d"}, {"coins": "5stake", "duration": "6h"}]}
+//
+// An optional "total" field is checked against the sum of the period coins.
+// Relative start times are resolved against now, which should be the time of the
+// latest block rather than the local clock.
+func ReadScheduleFile(path string, now time.Time) (VestingData, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return VestingData{}, err
+	}
+
+	data, err := ParseScheduleAt(bz, now)
+	if err != nil {
+		return VestingData{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return data, nil
+}
+
+// ParseSchedule parses and validates the contents of a periods JSON file, see
+// ReadScheduleFile. Errors are prefixed with the line they were found at.
+// Relative start times are rejected, use ParseScheduleAt to resolve them.
+func ParseSchedule(bz []byte) (VestingData, error) {
+	return ParseScheduleAt(bz, time.Time{})
+}
+
+// ParseScheduleAt is ParseSchedule resolving relative start times against now.
+func ParseScheduleAt(bz []byte, now time.Time) (VestingData, error) {
+	lines, err := scanSchedule(bz)
+	if err != nil {
+		return VestingData{}, err
+	}
+
+	var file scheduleFile
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return VestingData{}, fmt.Errorf("line %d: %w", lineAt(bz, dec.InputOffset()), err)
+	}
+
+	startStr := strings.Trim(string(file.StartTime), `"`)
+	if startStr == "" {
+		return VestingData{}, errors.New("missing start_time")
+	}
+	start, err := parseStartTime(startStr, now)
+	if err != nil {
+		return VestingData{}, fmt.Errorf("line %d: %w", lines.keys["start_time"], err)
+	}
+
+	if len(file.Periods) == 0 {
+		return VestingData{}, errors.New("no periods")
+	}
+	absolute := file.Periods[0].Time != ""
+
+	data := VestingData{StartTime: start.Unix(), Total: file.Total}
+	prevTime := start
+	sum := sdk.NewCoins()
+	for i, p := range file.Periods {
+		periodErr := func(format string, args ...any) error {
+			if i >= len(lines.periods) {
+				return fmt.Errorf("period %d: %s", i, fmt.Sprintf(format, args...))
+			}
+			return fmt.Errorf("line %d: period %d: %s", lines.periods[i], i, fmt.Sprintf(format, args...))
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return VestingData{}, periodErr("invalid coins %q: %s", p.Coins, err)
+		}
+		if coins.IsZero() {
+			return VestingData{}, periodErr("coins must be positive, got %q", p.Coins)
+		}
+		sum = sum.Add(coins...)
+
+		var set []string
+		if p.Time != "" {
+			set = append(set, "time")
+		}
+		if p.Length != nil {
+			set = append(set, "length_seconds")
+		}
+		if p.Duration != "" {
+			set = append(set, "duration")
+		}
+
+		switch {
+		case len(set) > 1:
+			return VestingData{}, periodErr("both %s are set", strings.Join(set, " and "))
+		case absolute && p.Time == "", !absolute && p.Time != "", len(set) == 0:
+			return VestingData{}, periodErr("periods must all set either time or length_seconds/duration")
+		case p.Duration != "":
+			d, err := ParseDuration(p.Duration)
+			if err != nil {
+				return VestingData{}, periodErr("%s", err)
+			}
+			data.Periods = append(data.Periods, InputPeriod{Coins: p.Coins, Length: int64(d / time.Second)})
+			continue
+		case p.Length != nil:
+			if *p.Length <= 0 {
+				return VestingData{}, periodErr("length_seconds must be positive, got %d", *p.Length)
+			}
+			data.Periods = append(data.Periods, InputPeriod{Coins: p.Coins, Length: *p.Length})
+			continue
+		}
+
+		t, err := ParseTimestamp(p.Time)
+		if err != nil {
+			return VestingData{}, periodErr("%s", err)
+		}
+		if !t.After(prevTime) {
+			return VestingData{}, periodErr("time %s is not after %s", t.Format(time.RFC3339), prevTime.Format(time.RFC3339))
+		}
+
+		data.Periods = append(data.Periods, InputPeriod{Coins: p.Coins, Length: t.Unix() - prevTime.Unix()})
+		prevTime = t
+	}
+
+	if file.Total != "" {
+		total, err := sdk.ParseCoinsNormalized(file.Total)
+		if err != nil {
+			return VestingData{}, fmt.Errorf("line %d: invalid total %q: %w", lines.keys["total"], file.Total, err)
+		}
+		if !sum.Equal(total) {
+			return VestingData{}, fmt.Errorf("line %d: periods add up to %s, expected total %s", lines.keys["total"], sum, total)
+		}
+	}
+
+	return data, nil
+}
+
+// ParseDuration parses a human duration: a whole number followed by w (weeks)
+// or d (days), or a Go duration such as "6h" or "1h30m". The duration must be
+// positive and a whole number of seconds.
+func ParseDuration(s string) (time.Duration, error) {
+	var (
+		d   time.Duration
+		err error
+	)
+	switch {
+	case strings.HasSuffix(s, "w"), strings.HasSuffix(s, "d"):
+		unit := 24 * time.Hour
+		if strings.HasSuffix(s, "w") {
+			unit *= 7
+		}
+		var num int64
+		num, err = strconv.ParseInt(s[:len(s)-1], 10, 64)
+		d = time.Duration(num) * unit
+	default:
+		d, err = time.ParseDuration(s)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %s, expected e.g. 30d, 2w or 6h", s)
+	}
+	if d <= 0 || d%time.Second != 0 {
+		return 0, fmt.Errorf("duration %s must be a positive whole number of seconds", s)
+	}
+
+	return d, nil
+}
+
+// parseStartTime parses a start time, either a timestamp (see ParseTimestamp),
+// "now" or "now+" followed by a duration (see ParseDuration), resolved against
+// now.
+func parseStartTime(s string, now time.Time) (time.Time, error) {
+	rest, relative := strings.CutPrefix(s, "now")
+	if !relative {
+		return ParseTimestamp(s)
+	}
+	if now.IsZero() {
+		return time.Time{}, fmt.Errorf("relative start_time %s needs the node time to be resolved", s)
+	}
+
+	now = now.UTC().Truncate(time.Second)
+	if rest == "" {
+		return now, nil
+	}
+
+	durStr, ok := strings.CutPrefix(rest, "+")
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid start_time %s, expected now or now+ followed by a duration", s)
+	}
+	d, err := ParseDuration(durStr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return now.Add(d), nil
+}
+
+// scanSchedule walks the tokens of a periods JSON file to reject duplicate keys,
+// which the standard decoder silently overwrites, and unknown keys, and to
+// record the lines of the top-level keys and of the periods.
+func scanSchedule(bz []byte) (scheduleLines, error) {
+	type frame struct {
+		object  bool
+		wantKey bool
+		key     string
+		keys    map[string]bool
+		allowed map[string]bool
+		periods bool
+	}
+
+	lines := scheduleLines{keys: map[string]int{}}
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	var stack []*frame
+	for {
+		offset := dec.InputOffset()
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				offset = syntaxErr.Offset
+			}
+			return lines, fmt.Errorf("line %d: %w", lineAt(bz, offset), err)
+		}
+
+		var top *frame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+
+		// object key
+		if top != nil && top.object && top.wantKey && tok != json.Delim('}') {
+			key := tok.(string)
+			line := lineAt(bz, dec.InputOffset())
+			if top.keys[key] {
+				return lines, fmt.Errorf("line %d: duplicate key %q", line, key)
+			}
+			if top.allowed != nil && !top.allowed[key] {
+				return lines, fmt.Errorf("line %d: unknown key %q", line, key)
+			}
+			top.keys[key] = true
+			top.key, top.wantKey = key, false
+			if len(stack) == 1 {
+				lines.keys[key] = line
+			}
+			continue
+		}
+
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			f := &frame{
+				object:  tok == json.Delim('{'),
+				wantKey: tok == json.Delim('{'),
+				keys:    map[string]bool{},
+				periods: len(stack) == 1 && top.key == "periods" && tok == json.Delim('['),
+			}
+			switch {
+			case top == nil && f.object:
+				f.allowed = scheduleKeys
+			case top != nil && top.periods && f.object:
+				f.allowed = periodKeys
+				lines.periods = append(lines.periods, lineAt(bz, dec.InputOffset()))
+			}
+			stack = append(stack, f)
+			continue
+		case json.Delim('}'), json.Delim(']'):
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				top = stack[len(stack)-1]
+			} else {
+				top = nil
+			}
+		}
+
+		// a value was read, the parent object now expects a key
+		if top != nil && top.object {
+			top.wantKey = true
+		}
+	}
+
+	return lines, nil
+}
+
+// lineAt returns the line of the given byte offset of bz.
+func lineAt(bz []byte, offset int64) int {
+	if offset > int64(len(bz)) {
+		offset = int64(len(bz))
+	}
+
+	return bytes.Count(bz[:offset], []byte("\n")) + 1
+}
@@ -0,0 +1,405 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lockupv1 "cosmossdk.io/api/cosmos/accounts/defaults/lockup"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/math"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagCliff      = "cliff"
+	FlagStartTime  = "start-time"
+	FlagOutputFile = "output-file"
+)
+
+// PeriodicLockingAccountType is the x/accounts type of the periodic locking
+// accounts created by create-periodic-lockup.
+const PeriodicLockingAccountType = "periodic-locking-account"
+
+// Schedule intervals supported by gen-schedule.
+const (
+	IntervalMonthly = "monthly"
+	IntervalWeekly  = "weekly"
+	IntervalDaily   = "daily"
+)
+
+// Span is a calendar duration, in months and days.
+type Span struct {
+	Months int
+	Days   int
+}
+
+// IsZero returns true if the span is empty.
+func (s Span) IsZero() bool {
+	return s.Months == 0 && s.Days == 0
+}
+
+// addTo returns t moved forward by the span. Months are added first, clamping
+// to the last day of the month, so that a monthly schedule started on January
+// 31st vests on the last day of February.
+func (s Span) addTo(t time.Time) time.Time {
+	if s.Months != 0 {
+		t = addMonths(t, s.Months)
+	}
+
+	return t.AddDate(0, 0, s.Days)
+}
+
+// GetTxCmd returns the transaction commands for the vesting module.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetGenScheduleCmd(),
+		GetCreatePeriodicLockupCmd(),
+	)
+
+	return txCmd
+}
+
+// GetGenScheduleCmd returns a CLI command generating the periods JSON file of
+// a periodic vesting schedule from its high-level parameters.
+func GetGenScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gen-schedule [total-amount] [duration] [interval]",
+		Short: "Generate the periods JSON file of a periodic vesting schedule",
+		Long: `Generate the periods JSON file of a periodic vesting schedule, vesting the total amount
+evenly every interval (monthly, weekly or daily) over the duration. Nothing is broadcast.
+
+Durations are a number followed by a unit: y (years), mo (months), w (weeks) or d (days).
+The duration must be a whole number of intervals. With --cliff, nothing vests before the
+cliff and the amounts of the intervals elapsed by then vest with the first interval ending
+at or after the cliff.
+
+Amounts that cannot be split evenly are rounded down, the remainder vesting with the
+following intervals, so that the periods always add up to the total amount.`,
+		Example: fmt.Sprintf("%s tx vesting gen-schedule 48000000stake 4y monthly --cliff 1y --start-time 2025-01-01T00:00:00Z --output-file periods.json", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			total, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			duration, err := ParseSpan(args[1])
+			if err != nil {
+				return err
+			}
+
+			interval, err := ParseInterval(args[2])
+			if err != nil {
+				return err
+			}
+
+			cliffStr, _ := cmd.Flags().GetString(FlagCliff)
+			var cliff Span
+			if cliffStr != "" {
+				if cliff, err = ParseSpan(cliffStr); err != nil {
+					return err
+				}
+			}
+
+			start := time.Now().UTC().Truncate(time.Second)
+			if startStr, _ := cmd.Flags().GetString(FlagStartTime); startStr != "" {
+				if start, err = ParseTimestamp(startStr); err != nil {
+					return err
+				}
+			}
+
+			data, err := GenSchedule(total, start, duration, interval, cliff)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(data, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			outputFile, _ := cmd.Flags().GetString(FlagOutputFile)
+			if outputFile == "" {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			}
+
+			return os.WriteFile(outputFile, append(bz, '\n'), 0o600)
+		},
+	}
+
+	cmd.Flags().String(FlagCliff, "", "Duration before which nothing vests, e.g. 1y")
+	cmd.Flags().String(FlagStartTime, "", "Start of the schedule, as an RFC3339 date or a unix timestamp (default now)")
+	cmd.Flags().String(FlagOutputFile, "", "Write the periods to the given file instead of stdout")
+
+	return cmd
+}
+
+// GetCreatePeriodicLockupCmd returns a CLI command creating an x/accounts
+// periodic locking account from a periods JSON file.
+func GetCreatePeriodicLockupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-lockup [owner] [periods-file]",
+		Short: "Create a periodic locking account from a periods JSON file",
+		Long: `Create an x/accounts periodic locking account owned by the given address, locking the coins
+of the periods of a periods JSON file, such as written by gen-schedule. The sender funds the
+account with the sum of the period coins.
+
+The start time may be "now" or "now+" followed by a duration, and period lengths may be
+given in a "duration" field instead of "length_seconds". Durations are a whole number
+followed by w (weeks) or d (days), or a duration such as 6h or 1h30m. Relative times are
+resolved against the time of the latest block of the node, and the absolute vesting
+timestamps are printed before the transaction is signed.`,
+		Example: fmt.Sprintf("%s tx vesting create-periodic-lockup cosmos1... periods.json --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// relative times cannot be resolved offline
+			var nodeTime time.Time
+			if !clientCtx.Offline {
+				status, err := cmtservice.GetNodeStatus(cmd.Context(), clientCtx)
+				if err != nil {
+					return err
+				}
+				nodeTime = status.SyncInfo.LatestBlockTime
+			}
+
+			data, err := ReadScheduleFile(args[1], nodeTime)
+			if err != nil {
+				return err
+			}
+
+			if err := PrintSchedule(cmd.ErrOrStderr(), data, nodeTime); err != nil {
+				return err
+			}
+
+			sender, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg, err := NewMsgInitPeriodicLockup(sender, args[0], data)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgInitPeriodicLockup returns the x/accounts message creating a periodic
+// locking account of the owner with the periods of the schedule, funded by the
+// sender with the sum of the period coins.
+func NewMsgInitPeriodicLockup(sender, owner string, data VestingData) (*accountsv1.MsgInit, error) {
+	funds := sdk.NewCoins()
+	periods := make([]*lockupv1.Period, 0, len(data.Periods))
+	for i, p := range data.Periods {
+		coins, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("period %d: invalid coins %q: %w", i, p.Coins, err)
+		}
+		funds = funds.Add(coins...)
+
+		amount := make([]*basev1beta1.Coin, 0, len(coins))
+		for _, coin := range coins {
+			amount = append(amount, &basev1beta1.Coin{Denom: coin.Denom, Amount: coin.Amount.String()})
+		}
+		periods = append(periods, &lockupv1.Period{
+			Length: durationpb.New(time.Duration(p.Length) * time.Second),
+			Amount: amount,
+		})
+	}
+
+	initMsg, err := anyutil.New(&lockupv1.MsgInitPeriodicLockingAccount{
+		Owner:          owner,
+		StartTime:      timestamppb.New(time.Unix(data.StartTime, 0)),
+		LockingPeriods: periods,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountsv1.MsgInit{
+		Sender:      sender,
+		AccountType: PeriodicLockingAccountType,
+		Message:     &codectypes.Any{TypeUrl: initMsg.TypeUrl, Value: initMsg.Value},
+		Funds:       funds,
+	}, nil
+}
+
+// PrintSchedule writes the absolute start time of the schedule and the time at
+// which each of its periods vests, resolved against the given node time.
+func PrintSchedule(w io.Writer, data VestingData, nodeTime time.Time) error {
+	start := time.Unix(data.StartTime, 0).UTC()
+	if _, err := fmt.Fprintf(w, "node time: %s\nstart:     %s\n", nodeTime.UTC().Format(time.RFC3339), start.Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	t := start
+	for i, p := range data.Periods {
+		t = t.Add(time.Duration(p.Length) * time.Second)
+		if _, err := fmt.Fprintf(w, "period %d:  %s vest at %s\n", i, p.Coins, t.Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GenSchedule returns the periods vesting total evenly every interval over the
+// duration from start, with nothing vesting before the cliff.
+func GenSchedule(total sdk.Coins, start time.Time, duration, interval, cliff Span) (VestingData, error) {
+	if !total.IsValid() || total.IsZero() {
+		return VestingData{}, fmt.Errorf("invalid total amount %s", total)
+	}
+	if duration.IsZero() {
+		return VestingData{}, errors.New("duration must be positive")
+	}
+	if interval.IsZero() {
+		return VestingData{}, errors.New("interval must be positive")
+	}
+
+	end := duration.addTo(start)
+	cliffTime := cliff.addTo(start)
+	if cliffTime.After(end) {
+		return VestingData{}, errors.New("cliff must not be longer than the duration")
+	}
+
+	// vesting times are computed from the start rather than from each other,
+	// so that monthly schedules do not drift on short months.
+	var times []time.Time
+	for n := 1; ; n++ {
+		t := Span{Months: interval.Months * n, Days: interval.Days * n}.addTo(start)
+		if t.After(end) {
+			return VestingData{}, errors.New("duration must be a whole number of intervals")
+		}
+		times = append(times, t)
+		if t.Equal(end) {
+			break
+		}
+	}
+
+	data := VestingData{StartTime: start.Unix(), Total: total.String()}
+	n := int64(len(times))
+	prevTime := start
+	vested := sdk.NewCoins()
+	for i, t := range times {
+		if t.Before(cliffTime) {
+			continue
+		}
+
+		// the amount vested after i+1 intervals, rounded down
+		cumulative := sdk.NewCoins()
+		for _, coin := range total {
+			amount := coin.Amount.Mul(math.NewInt(int64(i + 1))).Quo(math.NewInt(n))
+			cumulative = cumulative.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+
+		coins := cumulative.Sub(vested...)
+		if coins.IsZero() {
+			continue
+		}
+
+		data.Periods = append(data.Periods, InputPeriod{
+			Coins:  coins.String(),
+			Length: t.Unix() - prevTime.Unix(),
+		})
+		prevTime = t
+		vested = cumulative
+	}
+
+	return data, nil
+}
+
+// ParseSpan parses a calendar duration: a number followed by y (years), mo
+// (months), w (weeks) or d (days).
+func ParseSpan(s string) (Span, error) {
+	units := []struct {
+		suffix string
+		span   Span
+	}{
+		{"mo", Span{Months: 1}},
+		{"y", Span{Months: 12}},
+		{"w", Span{Days: 7}},
+		{"d", Span{Days: 1}},
+	}
+
+	for _, unit := range units {
+		numStr, ok := strings.CutSuffix(s, unit.suffix)
+		if !ok {
+			continue
+		}
+
+		num, err := strconv.Atoi(numStr)
+		if err != nil || num < 0 {
+			return Span{}, fmt.Errorf("invalid duration %s", s)
+		}
+
+		return Span{Months: unit.span.Months * num, Days: unit.span.Days * num}, nil
+	}
+
+	return Span{}, fmt.Errorf("invalid duration %s, expected a number followed by y, mo, w or d", s)
+}
+
+// ParseInterval parses a schedule interval: monthly, weekly or daily.
+func ParseInterval(s string) (Span, error) {
+	switch s {
+	case IntervalMonthly:
+		return Span{Months: 1}, nil
+	case IntervalWeekly:
+		return Span{Days: 7}, nil
+	case IntervalDaily:
+		return Span{Days: 1}, nil
+	default:
+		return Span{}, fmt.Errorf("invalid interval %s, expected one of %s, %s or %s", s, IntervalMonthly, IntervalWeekly, IntervalDaily)
+	}
+}
+
+// addMonths adds months to t, clamping the day to the end of the month.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
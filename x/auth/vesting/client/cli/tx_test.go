@@ -0,0 +1,470 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	lockupv1 "cosmossdk.io/api/cosmos/accounts/defaults/lockup"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/x/auth/vesting/client/cli"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseSpan(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		expSpan cli.Span
+		expErr  bool
+	}{
+		{"years", "4y", cli.Span{Months: 48}, false},
+		{"months", "18mo", cli.Span{Months: 18}, false},
+		{"weeks", "2w", cli.Span{Days: 14}, false},
+		{"days", "30d", cli.Span{Days: 30}, false},
+		{"no unit", "30", cli.Span{}, true},
+		{"unknown unit", "30h", cli.Span{}, true},
+		{"negative", "-1y", cli.Span{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := cli.ParseSpan(tc.input)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expSpan, got)
+		})
+	}
+}
+
+func TestGenSchedule(t *testing.T) {
+	start := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	day := int64(24 * 60 * 60)
+	monthly, err := cli.ParseInterval(cli.IntervalMonthly)
+	require.NoError(t, err)
+	weekly, err := cli.ParseInterval(cli.IntervalWeekly)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		total      sdk.Coins
+		duration   cli.Span
+		interval   cli.Span
+		cliff      cli.Span
+		expPeriods []cli.InputPeriod
+		expErr     string
+	}{
+		{
+			name:     "monthly clamped to the end of the month",
+			total:    sdk.NewCoins(sdk.NewInt64Coin("stake", 300)),
+			duration: cli.Span{Months: 3},
+			interval: monthly,
+			expPeriods: []cli.InputPeriod{
+				{Coins: "100stake", Length: 28 * day},
+				{Coins: "100stake", Length: 31 * day},
+				{Coins: "100stake", Length: 30 * day},
+			},
+		},
+		{
+			name:     "cliff vests the elapsed intervals at once",
+			total:    sdk.NewCoins(sdk.NewInt64Coin("stake", 400)),
+			duration: cli.Span{Days: 28},
+			interval: weekly,
+			cliff:    cli.Span{Days: 14},
+			expPeriods: []cli.InputPeriod{
+				{Coins: "200stake", Length: 14 * day},
+				{Coins: "100stake", Length: 7 * day},
+				{Coins: "100stake", Length: 7 * day},
+			},
+		},
+		{
+			name:     "remainder spread over the intervals",
+			total:    sdk.NewCoins(sdk.NewInt64Coin("atom", 4), sdk.NewInt64Coin("stake", 10)),
+			duration: cli.Span{Days: 21},
+			interval: weekly,
+			expPeriods: []cli.InputPeriod{
+				{Coins: "1atom,3stake", Length: 7 * day},
+				{Coins: "1atom,3stake", Length: 7 * day},
+				{Coins: "2atom,4stake", Length: 7 * day},
+			},
+		},
+		{
+			name:     "empty intervals merged into the next one",
+			total:    sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+			duration: cli.Span{Days: 28},
+			interval: weekly,
+			expPeriods: []cli.InputPeriod{
+				{Coins: "1stake", Length: 14 * day},
+				{Coins: "1stake", Length: 14 * day},
+			},
+		},
+		{
+			name:     "duration not a whole number of intervals",
+			total:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			duration: cli.Span{Months: 2},
+			interval: weekly,
+			expErr:   "whole number of intervals",
+		},
+		{
+			name:     "cliff longer than the duration",
+			total:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			duration: cli.Span{Months: 12},
+			interval: monthly,
+			cliff:    cli.Span{Months: 13},
+			expErr:   "cliff",
+		},
+		{
+			name:     "zero total",
+			total:    sdk.NewCoins(),
+			duration: cli.Span{Months: 12},
+			interval: monthly,
+			expErr:   "invalid total amount",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := cli.GenSchedule(tc.total, start, tc.duration, tc.interval, tc.cliff)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, start.Unix(), data.StartTime)
+			require.Equal(t, tc.expPeriods, data.Periods)
+		})
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	day := int64(24 * 60 * 60)
+
+	testCases := []struct {
+		name    string
+		input   string
+		expData cli.VestingData
+		expErr  string
+	}{
+		{
+			name:  "lengths",
+			input: `{"start_time": 1735689600, "periods": [{"coins": "10stake", "length_seconds": 2678400}, {"coins": "5stake", "length_seconds": 2419200}]}`,
+			expData: cli.VestingData{StartTime: 1735689600, Periods: []cli.InputPeriod{
+				{Coins: "10stake", Length: 31 * day},
+				{Coins: "5stake", Length: 28 * day},
+			}},
+		},
+		{
+			name:  "absolute times",
+			input: `{"start_time": "2025-01-01T00:00:00Z", "periods": [{"coins": "10stake", "time": "2025-02-01T00:00:00Z"}, {"coins": "5stake", "time": "2025-03-01T00:00:00Z"}]}`,
+			expData: cli.VestingData{StartTime: 1735689600, Periods: []cli.InputPeriod{
+				{Coins: "10stake", Length: 31 * day},
+				{Coins: "5stake", Length: 28 * day},
+			}},
+		},
+		{
+			name:   "mixed formats",
+			input:  `{"start_time": 1735689600, "periods": [{"coins": "10stake", "time": "2025-02-01T00:00:00Z"}, {"coins": "5stake", "length_seconds": 2419200}]}`,
+			expErr: "either time or length_seconds",
+		},
+		{
+			name:   "time and length in the same period",
+			input:  `{"start_time": 1735689600, "periods": [{"coins": "10stake", "time": "2025-02-01T00:00:00Z", "length_seconds": 2678400}]}`,
+			expErr: "both time and length_seconds",
+		},
+		{
+			name:   "times out of order",
+			input:  `{"start_time": "2025-01-01T00:00:00Z", "periods": [{"coins": "10stake", "time": "2025-03-01T00:00:00Z"}, {"coins": "5stake", "time": "2025-02-01T00:00:00Z"}]}`,
+			expErr: "is not after",
+		},
+		{
+			name:   "non positive length",
+			input:  `{"start_time": 1735689600, "periods": [{"coins": "10stake", "length_seconds": 0}]}`,
+			expErr: "must be positive",
+		},
+		{
+			name:   "missing start time",
+			input:  `{"periods": [{"coins": "10stake", "length_seconds": 2678400}]}`,
+			expErr: "missing start_time",
+		},
+		{
+			name:   "no periods",
+			input:  `{"start_time": 1735689600, "periods": []}`,
+			expErr: "no periods",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := cli.ParseSchedule([]byte(tc.input))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expData, data)
+		})
+	}
+}
+
+func TestParseScheduleErrorLines(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expErr string
+	}{
+		{
+			name: "duplicate key",
+			input: `{
+  "start_time": 1735689600,
+  "periods": [
+    {"coins": "10stake", "length_seconds": 2678400, "length_seconds": 1}
+  ]
+}`,
+			expErr: `line 4: duplicate key "length_seconds"`,
+		},
+		{
+			name: "trailing comma",
+			input: `{
+  "start_time": 1735689600,
+  "periods": [
+    {"coins": "10stake", "length_seconds": 2678400},
+  ]
+}`,
+			expErr: "line 4: invalid character ','",
+		},
+		{
+			name: "unknown field",
+			input: `{
+  "start_time": 1735689600,
+  "periods": [
+    {"coins": "10stake", "length": 2678400}
+  ]
+}`,
+			expErr: `line 4: unknown key "length"`,
+		},
+		{
+			name: "bad coin string",
+			input: `{
+  "start_time": 1735689600,
+  "periods": [
+    {"coins": "10stake", "length_seconds": 2678400},
+    {"coins": "ten stake", "length_seconds": 2678400}
+  ]
+}`,
+			expErr: `line 5: period 1: invalid coins "ten stake"`,
+		},
+		{
+			name: "zero amount",
+			input: `{
+  "start_time": 1735689600,
+  "periods": [
+    {"coins": "0stake", "length_seconds": 2678400}
+  ]
+}`,
+			expErr: `line 4: period 0: coins must be positive`,
+		},
+		{
+			name: "non positive length",
+			input: `{
+  "start_time": 1735689600,
+  "periods": [
+    {"coins": "10stake", "length_seconds": 2678400},
+    {"coins": "10stake", "length_seconds": 0}
+  ]
+}`,
+			expErr: `line 5: period 1: length_seconds must be positive`,
+		},
+		{
+			name: "total mismatch",
+			input: `{
+  "start_time": 1735689600,
+  "periods": [
+    {"coins": "10stake", "length_seconds": 2678400},
+    {"coins": "10stake", "length_seconds": 2678400}
+  ],
+  "total": "25stake"
+}`,
+			expErr: `line 7: periods add up to 20stake, expected total 25stake`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := cli.ParseSchedule([]byte(tc.input))
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestParseScheduleTotal(t *testing.T) {
+	total := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	data, err := cli.GenSchedule(total, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), cli.Span{Months: 12}, cli.Span{Months: 1}, cli.Span{Months: 3})
+	require.NoError(t, err)
+	require.Equal(t, "1000stake", data.Total)
+
+	bz, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	parsed, err := cli.ParseSchedule(bz)
+	require.NoError(t, err)
+	require.Equal(t, data, parsed)
+}
+
+func TestParseDuration(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expDur time.Duration
+		expErr bool
+	}{
+		{"days", "30d", 30 * 24 * time.Hour, false},
+		{"weeks", "2w", 14 * 24 * time.Hour, false},
+		{"hours", "6h", 6 * time.Hour, false},
+		{"hours and minutes", "1h30m", 90 * time.Minute, false},
+		{"no unit", "30", 0, true},
+		{"fractional days", "1.5d", 0, true},
+		{"zero", "0d", 0, true},
+		{"negative", "-6h", 0, true},
+		{"sub second", "1500ms", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := cli.ParseDuration(tc.input)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDur, got)
+		})
+	}
+}
+
+func TestParseScheduleRelative(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 500, time.UTC)
+	day := int64(24 * 60 * 60)
+
+	testCases := []struct {
+		name    string
+		input   string
+		now     time.Time
+		expData cli.VestingData
+		expErr  string
+	}{
+		{
+			name:  "durations from now",
+			input: `{"start_time": "now", "periods": [{"coins": "10stake", "duration": "30d"}, {"coins": "5stake", "duration": "6h"}]}`,
+			now:   now,
+			expData: cli.VestingData{StartTime: 1735732800, Periods: []cli.InputPeriod{
+				{Coins: "10stake", Length: 30 * day},
+				{Coins: "5stake", Length: 6 * 60 * 60},
+			}},
+		},
+		{
+			name:  "durations and lengths from now+30d",
+			input: `{"start_time": "now+30d", "periods": [{"coins": "10stake", "duration": "1w"}, {"coins": "5stake", "length_seconds": 60}]}`,
+			now:   now,
+			expData: cli.VestingData{StartTime: 1735732800 + 30*day, Periods: []cli.InputPeriod{
+				{Coins: "10stake", Length: 7 * day},
+				{Coins: "5stake", Length: 60},
+			}},
+		},
+		{
+			name:  "absolute times from now",
+			input: `{"start_time": "now", "periods": [{"coins": "10stake", "time": "2025-02-01T00:00:00Z"}]}`,
+			now:   now,
+			expData: cli.VestingData{StartTime: 1735732800, Periods: []cli.InputPeriod{
+				{Coins: "10stake", Length: 1738368000 - 1735732800},
+			}},
+		},
+		{
+			name:   "relative start without node time",
+			input:  `{"start_time": "now+30d", "periods": [{"coins": "10stake", "duration": "30d"}]}`,
+			expErr: "needs the node time",
+		},
+		{
+			name:   "invalid relative start",
+			input:  `{"start_time": "now-30d", "periods": [{"coins": "10stake", "duration": "30d"}]}`,
+			now:    now,
+			expErr: "invalid start_time now-30d",
+		},
+		{
+			name:   "duration and length in the same period",
+			input:  `{"start_time": "now", "periods": [{"coins": "10stake", "length_seconds": 60, "duration": "30d"}]}`,
+			now:    now,
+			expErr: "both length_seconds and duration",
+		},
+		{
+			name:   "invalid duration",
+			input:  `{"start_time": "now", "periods": [{"coins": "10stake", "duration": "1mo"}]}`,
+			now:    now,
+			expErr: "period 0: invalid duration 1mo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := cli.ParseScheduleAt([]byte(tc.input), tc.now)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expData, data)
+		})
+	}
+}
+
+func TestPrintSchedule(t *testing.T) {
+	data := cli.VestingData{StartTime: 1735689600, Periods: []cli.InputPeriod{
+		{Coins: "10stake", Length: 31 * 24 * 60 * 60},
+		{Coins: "5stake", Length: 6 * 60 * 60},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, cli.PrintSchedule(&buf, data, time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC)))
+	require.Equal(t, `node time: 2024-12-02T00:00:00Z
+start:     2025-01-01T00:00:00Z
+period 0:  10stake vest at 2025-02-01T00:00:00Z
+period 1:  5stake vest at 2025-02-01T06:00:00Z
+`, buf.String())
+}
+
+func TestNewMsgInitPeriodicLockup(t *testing.T) {
+	data := cli.VestingData{StartTime: 1735689600, Periods: []cli.InputPeriod{
+		{Coins: "10stake,2atom", Length: 31 * 24 * 60 * 60},
+		{Coins: "5stake", Length: 6 * 60 * 60},
+	}}
+
+	msg, err := cli.NewMsgInitPeriodicLockup("cosmos1sender", "cosmos1owner", data)
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1sender", msg.Sender)
+	require.Equal(t, cli.PeriodicLockingAccountType, msg.AccountType)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 15), sdk.NewInt64Coin("atom", 2)), msg.Funds)
+
+	var initMsg lockupv1.MsgInitPeriodicLockingAccount
+	require.NoError(t, proto.Unmarshal(msg.Message.Value, &initMsg))
+	require.Equal(t, "/cosmos.accounts.defaults.lockup.MsgInitPeriodicLockingAccount", msg.Message.TypeUrl)
+	require.Equal(t, "cosmos1owner", initMsg.Owner)
+	require.Equal(t, time.Unix(1735689600, 0).UTC(), initMsg.StartTime.AsTime())
+	require.Len(t, initMsg.LockingPeriods, 2)
+	require.Equal(t, 31*24*time.Hour, initMsg.LockingPeriods[0].Length.AsDuration())
+	require.Len(t, initMsg.LockingPeriods[0].Amount, 2)
+	for i, coin := range []*basev1beta1.Coin{{Denom: "atom", Amount: "2"}, {Denom: "stake", Amount: "10"}} {
+		require.True(t, proto.Equal(coin, initMsg.LockingPeriods[0].Amount[i]))
+	}
+	require.Equal(t, 6*time.Hour, initMsg.LockingPeriods[1].Length.AsDuration())
+
+	data.Periods[1].Coins = "5"
+	_, err = cli.NewMsgInitPeriodicLockup("cosmos1sender", "cosmos1owner", data)
+	require.ErrorContains(t, err, "period 1: invalid coins")
+}
@@ -6,7 +6,11 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"cosmossdk.io/x/auth/keeper"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
 	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -25,16 +29,21 @@ type ModuleInputs struct {
 
 	AccountKeeper keeper.AccountKeeper
 	BankKeeper    types.BankKeeper
+	QueryRouter   *baseapp.GRPCQueryRouter
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	Module appmodule.AppModule
+	Module        appmodule.AppModule
+	VestingKeeper vestingkeeper.Keeper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	m := NewAppModule(in.AccountKeeper, in.BankKeeper)
+	// the module has no store, it only routes queries to the other modules
+	queryRouter := runtime.NewRouterService(nil, in.QueryRouter, nil).QueryRouterService()
+	k := vestingkeeper.NewKeeper(in.AccountKeeper, in.BankKeeper, queryRouter)
+	m := NewAppModule(in.AccountKeeper, in.BankKeeper, k)
 
-	return ModuleOutputs{Module: m}
+	return ModuleOutputs{Module: m, VestingKeeper: k}
 }
@@ -0,0 +1,105 @@
+package vesting
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the vesting module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, ak keeper.AccountKeeper, bk types.BankKeeper) {
+	ir.RegisterRoute(types.ModuleName, "account-consistency", AccountConsistencyInvariant(ak))
+	ir.RegisterRoute(types.ModuleName, "locked-coins", LockedCoinsInvariant(ak, bk))
+}
+
+// AllInvariants runs all invariants of the vesting module.
+func AllInvariants(ak keeper.AccountKeeper, bk types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := AccountConsistencyInvariant(ak)(ctx)
+		if stop {
+			return res, stop
+		}
+		return LockedCoinsInvariant(ak, bk)(ctx)
+	}
+}
+
+// AccountConsistencyInvariant checks that the vesting accounts are valid: the
+// delegated vesting coins do not exceed the original vesting coins, the periods
+// of periodic accounts add up to the original vesting coins and their end time
+// follows their start time.
+func AccountConsistencyInvariant(ak keeper.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		err := iterateVestingAccounts(ctx, ak, func(acc exported.VestingAccount) {
+			validator, ok := acc.(interface{ Validate() error })
+			if !ok {
+				return
+			}
+			if err := validator.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s is invalid: %s\n", acc.GetAddress(), err)
+			}
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "account-consistency",
+				fmt.Sprintf("error iterating accounts %v", err)), true
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "account-consistency",
+			fmt.Sprintf("amount of invalid vesting accounts found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// LockedCoinsInvariant checks that the locked coins of the vesting accounts,
+// the vesting coins that are not delegated, are held in their balance.
+func LockedCoinsInvariant(ak keeper.AccountKeeper, bk types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		blockTime := ctx.HeaderInfo().Time
+		err := iterateVestingAccounts(ctx, ak, func(acc exported.VestingAccount) {
+			locked := acc.LockedCoins(blockTime)
+			balance := bk.GetAllBalances(ctx, acc.GetAddress())
+			if !locked.IsAllLTE(balance) {
+				count++
+				msg += fmt.Sprintf("\t%s has locked coins %s exceeding its balance %s\n", acc.GetAddress(), locked, balance)
+			}
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "locked-coins",
+				fmt.Sprintf("error iterating accounts %v", err)), true
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "locked-coins",
+			fmt.Sprintf("amount of vesting accounts with locked coins exceeding their balance found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// iterateVestingAccounts calls cb on every vesting account.
+func iterateVestingAccounts(ctx sdk.Context, ak keeper.AccountKeeper, cb func(exported.VestingAccount)) error {
+	return ak.Accounts.Walk(ctx, nil, func(_ sdk.AccAddress, acc sdk.AccountI) (bool, error) {
+		if vacc, ok := acc.(exported.VestingAccount); ok {
+			cb(vacc)
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,88 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authcodec "cosmossdk.io/x/auth/codec"
+	"cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingtestutil "cosmossdk.io/x/auth/vesting/testutil"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestInvariants(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
+	key := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	now := time.Unix(1700000000, 0)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithHeaderInfo(header.Info{Time: now})
+
+	ak := keeper.NewAccountKeeper(
+		env,
+		encCfg.Codec,
+		authtypes.ProtoBaseAccount,
+		map[string][]string{},
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	bk := vestingtestutil.NewMockBankKeeper(gomock.NewController(t))
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	original := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	baseAcc := authtypes.NewBaseAccount(addr, pubKey, ak.NextAccountNumber(ctx), 0)
+	acc, err := types.NewContinuousVestingAccount(baseAcc, original, now.Unix()-50, now.Unix()+50)
+	require.NoError(t, err)
+	// half of the coins are vested, 10 of the vesting coins are delegated
+	acc.TrackDelegation(now, original, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	ak.SetAccount(ctx, acc)
+
+	// a regular account is ignored
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())))
+
+	// the 40 locked coins are held
+	bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+	_, broken := vesting.AllInvariants(ak, bk)(ctx)
+	require.False(t, broken)
+
+	// the locked coins exceed the balance
+	bk.EXPECT().GetAllBalances(gomock.Any(), addr).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 39)))
+	msg, broken := vesting.LockedCoinsInvariant(ak, bk)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, addr.String())
+
+	// the delegated vesting coins exceed the original vesting coins
+	acc.DelegatedVesting = original.Add(original...)
+	ak.SetAccount(ctx, acc)
+	msg, broken = vesting.AccountConsistencyInvariant(ak)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "delegated vesting amount cannot be greater than original vesting amount")
+
+	// the periods do not add up to the original vesting coins
+	periodic, err := types.NewPeriodicVestingAccount(baseAcc, original, now.Unix(), types.Periods{
+		{Length: 100, Amount: original},
+	})
+	require.NoError(t, err)
+	periodic.OriginalVesting = original.Add(original...)
+	ak.SetAccount(ctx, periodic)
+	msg, broken = vesting.AccountConsistencyInvariant(ak)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "does not match the sum of all coins in vesting periods")
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// lockedCoinsMsgs are the messages spending the locked coins of the account
+// executing them, by the reason they do so. Bank sends and module deposits only
+// spend the spendable balance.
+var lockedCoinsMsgs = map[string]string{
+	"cosmos.staking.v1beta1.MsgDelegate":        "delegates locked coins to a validator chosen by the grantee",
+	"cosmos.staking.v1beta1.MsgBeginRedelegate": "redelegates locked coins to a validator chosen by the grantee",
+	"cosmos.staking.v1beta1.MsgCreateValidator": "self-delegates locked coins to a validator run by the grantee",
+	"cosmos.authz.v1beta1.MsgGrant":             "grants any authorization on behalf of the account",
+}
+
+// GrantsAudit implements the Query/GrantsAudit gRPC method. The grants and
+// allowances are queried from the authz and feegrant modules through the query
+// router, and are left out if the module is not part of the app.
+func (k Keeper) GrantsAudit(ctx context.Context, req *types.QueryGrantsAuditRequest) (*types.QueryGrantsAuditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.accountKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+	if _, ok := acc.(exported.VestingAccount); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a vesting account", req.Address)
+	}
+
+	resp := &types.QueryGrantsAuditResponse{}
+	if k.canInvoke(ctx, &authzv1beta1.QueryGranterGrantsRequest{}) {
+		var pagination *queryv1beta1.PageRequest
+		for {
+			res := &authzv1beta1.QueryGranterGrantsResponse{}
+			if err := k.queryRouter.InvokeTyped(ctx, &authzv1beta1.QueryGranterGrantsRequest{Granter: req.Address, Pagination: pagination}, res); err != nil {
+				return nil, err
+			}
+
+			for _, grant := range res.Grants {
+				audited := types.AuditedGrant{
+					Grantee:       grant.Grantee,
+					Authorization: toAny(grant.Authorization),
+				}
+				if grant.Expiration != nil {
+					expiration := grant.Expiration.AsTime()
+					audited.Expiration = &expiration
+				}
+				audited.Reason, err = lockedCoinsReason(grant.Authorization)
+				if err != nil {
+					return nil, err
+				}
+				audited.CanMoveLockedCoins = audited.Reason != ""
+				resp.CanMoveLockedCoins = resp.CanMoveLockedCoins || audited.CanMoveLockedCoins
+				resp.Grants = append(resp.Grants, audited)
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			pagination = &queryv1beta1.PageRequest{Key: res.Pagination.NextKey}
+		}
+	}
+
+	if k.canInvoke(ctx, &feegrantv1beta1.QueryAllowancesByGranterRequest{}) {
+		var pagination *queryv1beta1.PageRequest
+		for {
+			res := &feegrantv1beta1.QueryAllowancesByGranterResponse{}
+			if err := k.queryRouter.InvokeTyped(ctx, &feegrantv1beta1.QueryAllowancesByGranterRequest{Granter: req.Address, Pagination: pagination}, res); err != nil {
+				return nil, err
+			}
+
+			for _, grant := range res.Allowances {
+				resp.Allowances = append(resp.Allowances, types.AuditedAllowance{
+					Grantee:   grant.Grantee,
+					Allowance: toAny(grant.Allowance),
+				})
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			pagination = &queryv1beta1.PageRequest{Key: res.Pagination.NextKey}
+		}
+	}
+
+	return resp, nil
+}
+
+// canInvoke returns true if the query router can route the request.
+func (k Keeper) canInvoke(ctx context.Context, req protov2.Message) bool {
+	if k.queryRouter == nil {
+		return false
+	}
+
+	return k.queryRouter.CanInvoke(ctx, string(req.ProtoReflect().Descriptor().FullName())) == nil
+}
+
+// lockedCoinsReason returns how a grantee of the authorization can move the
+// locked coins of the granter, or an empty string if it cannot. Authorizations
+// of unknown types are assumed to move locked coins.
+func lockedCoinsReason(authorization *anypb.Any) (string, error) {
+	switch typeName(authorization.GetTypeUrl()) {
+	case fullName(&bankv1beta1.SendAuthorization{}):
+		return "", nil
+
+	case fullName(&stakingv1beta1.StakeAuthorization{}):
+		var stake stakingv1beta1.StakeAuthorization
+		if err := authorization.UnmarshalTo(&stake); err != nil {
+			return "", err
+		}
+
+		switch stake.AuthorizationType {
+		case stakingv1beta1.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE:
+			return lockedCoinsMsgs["cosmos.staking.v1beta1.MsgDelegate"], nil
+		case stakingv1beta1.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
+			return lockedCoinsMsgs["cosmos.staking.v1beta1.MsgBeginRedelegate"], nil
+		}
+		return "", nil
+
+	case fullName(&authzv1beta1.GenericAuthorization{}):
+		var generic authzv1beta1.GenericAuthorization
+		if err := authorization.UnmarshalTo(&generic); err != nil {
+			return "", err
+		}
+
+		return lockedCoinsMsgs[typeName(generic.Msg)], nil
+
+	default:
+		return "unknown authorization type", nil
+	}
+}
+
+// typeName returns the full name of the message of a type URL.
+func typeName(typeURL string) string {
+	return typeURL[strings.LastIndex(typeURL, "/")+1:]
+}
+
+func fullName(msg protov2.Message) string {
+	return string(msg.ProtoReflect().Descriptor().FullName())
+}
+
+// toAny converts an api Any to the gogoproto Any of the response.
+func toAny(a *anypb.Any) *codectypes.Any {
+	if a == nil {
+		return nil
+	}
+
+	return &codectypes.Any{TypeUrl: a.TypeUrl, Value: a.Value}
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authcodec "cosmossdk.io/x/auth/codec"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	"cosmossdk.io/x/auth/vesting/keeper"
+	vestingtestutil "cosmossdk.io/x/auth/vesting/testutil"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// queryRouter routes the requests by their full name to canned responses.
+type queryRouter map[string]func(req protov2.Message) protov2.Message
+
+func (r queryRouter) CanInvoke(_ context.Context, typeURL string) error {
+	if _, ok := r[typeURL]; !ok {
+		return fmt.Errorf("unknown request: %s", typeURL)
+	}
+	return nil
+}
+
+func (r queryRouter) InvokeTyped(_ context.Context, req, resp protoiface.MessageV1) error {
+	reqV2 := req.(protov2.Message)
+	handler, ok := r[string(reqV2.ProtoReflect().Descriptor().FullName())]
+	if !ok {
+		return fmt.Errorf("unknown request: %T", req)
+	}
+	protov2.Merge(resp.(protov2.Message), handler(reqV2))
+	return nil
+}
+
+func (r queryRouter) InvokeUntyped(context.Context, protoiface.MessageV1) (protoiface.MessageV1, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func newAny(t *testing.T, msg protov2.Message) *anypb.Any {
+	t.Helper()
+	bz, err := protov2.Marshal(msg)
+	require.NoError(t, err)
+	return &anypb.Any{TypeUrl: "/" + string(msg.ProtoReflect().Descriptor().FullName()), Value: bz}
+}
+
+func TestGrantsAudit(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
+	key := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	ak := authkeeper.NewAccountKeeper(
+		env,
+		encCfg.Codec,
+		authtypes.ProtoBaseAccount,
+		map[string][]string{},
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	bk := vestingtestutil.NewMockBankKeeper(gomock.NewController(t))
+
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	vestingAddr, grantee, baseAddr := addrs[0].String(), addrs[1].String(), addrs[2].String()
+	vestingAcc, err := types.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addrs[0]), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 1000, 2000)
+	require.NoError(t, err)
+	ak.SetAccount(ctx, ak.NewAccount(ctx, vestingAcc))
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addrs[2]))
+
+	expiration := time.Unix(3000, 0).UTC()
+	send := newAny(t, &bankv1beta1.SendAuthorization{})
+	delegate := newAny(t, &authzv1beta1.GenericAuthorization{Msg: "/cosmos.staking.v1beta1.MsgDelegate"})
+	undelegate := newAny(t, &stakingv1beta1.StakeAuthorization{AuthorizationType: stakingv1beta1.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE})
+	allowance := newAny(t, &feegrantv1beta1.BasicAllowance{})
+
+	router := queryRouter{
+		// the grants are returned over two pages
+		"cosmos.authz.v1beta1.QueryGranterGrantsRequest": func(req protov2.Message) protov2.Message {
+			require.Equal(t, vestingAddr, req.(*authzv1beta1.QueryGranterGrantsRequest).Granter)
+			if req.(*authzv1beta1.QueryGranterGrantsRequest).Pagination == nil {
+				return &authzv1beta1.QueryGranterGrantsResponse{
+					Grants: []*authzv1beta1.GrantAuthorization{
+						{Granter: vestingAddr, Grantee: grantee, Authorization: send, Expiration: timestamppb.New(expiration)},
+						{Granter: vestingAddr, Grantee: grantee, Authorization: delegate},
+					},
+					Pagination: &queryv1beta1.PageResponse{NextKey: []byte("next")},
+				}
+			}
+			return &authzv1beta1.QueryGranterGrantsResponse{
+				Grants: []*authzv1beta1.GrantAuthorization{
+					{Granter: vestingAddr, Grantee: grantee, Authorization: undelegate},
+				},
+			}
+		},
+		"cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest": func(protov2.Message) protov2.Message {
+			return &feegrantv1beta1.QueryAllowancesByGranterResponse{
+				Allowances: []*feegrantv1beta1.Grant{{Granter: vestingAddr, Grantee: grantee, Allowance: allowance}},
+			}
+		},
+	}
+	k := keeper.NewKeeper(ak, bk, router)
+
+	res, err := k.GrantsAudit(ctx, &types.QueryGrantsAuditRequest{Address: vestingAddr})
+	require.NoError(t, err)
+	require.True(t, res.CanMoveLockedCoins)
+	require.Len(t, res.Grants, 3)
+	require.Equal(t, &expiration, res.Grants[0].Expiration)
+	require.Equal(t, send.TypeUrl, res.Grants[0].Authorization.TypeUrl)
+	require.False(t, res.Grants[0].CanMoveLockedCoins)
+	require.True(t, res.Grants[1].CanMoveLockedCoins)
+	require.Contains(t, res.Grants[1].Reason, "delegates locked coins")
+	require.False(t, res.Grants[2].CanMoveLockedCoins)
+	require.Len(t, res.Allowances, 1)
+	require.Equal(t, grantee, res.Allowances[0].Grantee)
+	require.Equal(t, allowance.TypeUrl, res.Allowances[0].Allowance.TypeUrl)
+
+	// the modules missing from the app are left out
+	delete(router, "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
+	res, err = k.GrantsAudit(ctx, &types.QueryGrantsAuditRequest{Address: vestingAddr})
+	require.NoError(t, err)
+	require.Len(t, res.Grants, 3)
+	require.Empty(t, res.Allowances)
+
+	_, err = k.GrantsAudit(ctx, &types.QueryGrantsAuditRequest{Address: baseAddr})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = k.GrantsAudit(ctx, &types.QueryGrantsAuditRequest{Address: authtypes.NewModuleAddress("unknown").String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
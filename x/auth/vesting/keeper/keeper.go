@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/core/router"
+	errorsmod "cosmossdk.io/errors"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Keeper creates vesting accounts funded by module accounts.
+type Keeper struct {
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    types.BankKeeper
+	// queryRouter routes the queries of the vesting module to the other
+	// modules of the app.
+	queryRouter router.Router
+}
+
+// NewKeeper returns a new vesting Keeper.
+func NewKeeper(ak authkeeper.AccountKeeper, bk types.BankKeeper, queryRouter router.Router) Keeper {
+	return Keeper{
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		queryRouter:   queryRouter,
+	}
+}
+
+// CreateVestingAccountFromModule creates a vesting account at the address
+// derived from the module name and key (see types.ModuleVestingAccountAddress)
+// and funds its original vesting coins from the module account.
+//
+// newAccount builds the vesting account from the base account at the derived
+// address, e.g. with types.NewContinuousVestingAccount. A base account already
+// holding coins sent to the derived address is converted, while any other
+// existing account is an error.
+func (k Keeper) CreateVestingAccountFromModule(
+	ctx context.Context, moduleName string, key []byte,
+	newAccount func(*authtypes.BaseAccount) (exported.VestingAccount, error),
+) (exported.VestingAccount, error) {
+	if len(key) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "vesting account key cannot be empty")
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(moduleName)
+	if moduleAddr == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName)
+	}
+
+	addr := types.ModuleVestingAccountAddress(moduleName, key)
+	var baseAcc *authtypes.BaseAccount
+	if existing := k.accountKeeper.GetAccount(ctx, addr); existing != nil {
+		// nobody holds the key of a derived address, so a base account there
+		// can only have been created by sending it coins
+		acc, ok := existing.(*authtypes.BaseAccount)
+		if !ok || acc.GetPubKey() != nil || acc.GetSequence() != 0 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", addr)
+		}
+		baseAcc = acc
+	} else {
+		baseAcc = authtypes.NewBaseAccountWithAddress(addr)
+		baseAcc.AccountNumber = k.accountKeeper.NextAccountNumber(ctx)
+	}
+
+	acc, err := newAccount(baseAcc)
+	if err != nil {
+		return nil, err
+	}
+	if !acc.GetAddress().Equals(addr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "vesting account address %s does not match the derived address %s", acc.GetAddress(), addr)
+	}
+
+	amount := acc.GetOriginalVesting()
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	k.accountKeeper.SetAccount(ctx, acc)
+
+	if err := k.bankKeeper.SendCoins(ctx, moduleAddr, addr, amount); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "create_from_module"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("module", moduleName)},
+		)
+		for _, coin := range amount {
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, "create_from_module", "amount"},
+				types.AmountToFloat32(coin.Amount),
+				[]metrics.Label{telemetry.NewLabel("denom", coin.Denom)},
+			)
+		}
+	}()
+
+	return acc, nil
+}
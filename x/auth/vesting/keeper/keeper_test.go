@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authcodec "cosmossdk.io/x/auth/codec"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/keeper"
+	vestingtestutil "cosmossdk.io/x/auth/vesting/testutil"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestCreateVestingAccountFromModule(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
+	key := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	ak := authkeeper.NewAccountKeeper(
+		env,
+		encCfg.Codec,
+		authtypes.ProtoBaseAccount,
+		map[string][]string{"escrow": nil},
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	bk := vestingtestutil.NewMockBankKeeper(gomock.NewController(t))
+	k := keeper.NewKeeper(ak, bk, nil)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	continuous := func(baseAcc *authtypes.BaseAccount) (exported.VestingAccount, error) {
+		return types.NewContinuousVestingAccount(baseAcc, amount, 1000, 2000)
+	}
+	escrow := authtypes.NewModuleAddress("escrow")
+
+	// the account is created at the derived address and funded by the module
+	addr := types.ModuleVestingAccountAddress("escrow", []byte("grant-1"))
+	require.NotEqual(t, escrow, addr)
+	require.NotEqual(t, types.ModuleVestingAccountAddress("escrow", []byte("grant-2")), addr)
+	bk.EXPECT().IsSendEnabledCoins(gomock.Any(), amount[0]).Return(nil)
+	bk.EXPECT().SendCoins(gomock.Any(), escrow, addr, amount).Return(nil)
+	acc, err := k.CreateVestingAccountFromModule(ctx, "escrow", []byte("grant-1"), continuous)
+	require.NoError(t, err)
+	require.Equal(t, addr, acc.GetAddress())
+	require.Equal(t, acc, ak.GetAccount(ctx, addr))
+
+	// the same grant cannot be created twice
+	_, err = k.CreateVestingAccountFromModule(ctx, "escrow", []byte("grant-1"), continuous)
+	require.ErrorContains(t, err, "already exists")
+
+	// coins sent to a derived address beforehand do not block the grant
+	addr = types.ModuleVestingAccountAddress("escrow", []byte("grant-2"))
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addr))
+	accNum := ak.GetAccount(ctx, addr).GetAccountNumber()
+	bk.EXPECT().IsSendEnabledCoins(gomock.Any(), amount[0]).Return(nil)
+	bk.EXPECT().SendCoins(gomock.Any(), escrow, addr, amount).Return(nil)
+	acc, err = k.CreateVestingAccountFromModule(ctx, "escrow", []byte("grant-2"), continuous)
+	require.NoError(t, err)
+	require.Equal(t, accNum, acc.GetAccountNumber())
+
+	// an account with a public key is not converted
+	addr = types.ModuleVestingAccountAddress("escrow", []byte("grant-3"))
+	ak.SetAccount(ctx, authtypes.NewBaseAccount(addr, secp256k1.GenPrivKey().PubKey(), ak.NextAccountNumber(ctx), 0))
+	_, err = k.CreateVestingAccountFromModule(ctx, "escrow", []byte("grant-3"), continuous)
+	require.ErrorContains(t, err, "already exists")
+
+	_, err = k.CreateVestingAccountFromModule(ctx, "escrow", nil, continuous)
+	require.ErrorContains(t, err, "key cannot be empty")
+
+	_, err = k.CreateVestingAccountFromModule(ctx, "unknown", []byte("grant-1"), continuous)
+	require.ErrorContains(t, err, "module account unknown does not exist")
+
+	_, err = k.CreateVestingAccountFromModule(ctx, "escrow", []byte("grant-4"), func(*authtypes.BaseAccount) (exported.VestingAccount, error) {
+		return types.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(escrow), amount, 1000, 2000)
+	})
+	require.ErrorContains(t, err, "does not match the derived address")
+}
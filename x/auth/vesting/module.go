@@ -1,32 +1,47 @@
 package vesting
 
 import (
+	"context"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/vesting/client/cli"
+	vestingkeeper "cosmossdk.io/x/auth/vesting/keeper"
 	"cosmossdk.io/x/auth/vesting/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 var (
-	_ module.AppModule = AppModule{}
-	_ module.HasName   = AppModule{}
+	_ module.AppModule      = AppModule{}
+	_ module.HasName        = AppModule{}
+	_ module.HasInvariants  = AppModule{}
+	_ module.HasGRPCGateway = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule   = AppModule{}
+	_ appmodule.HasServices = AppModule{}
 )
 
 // AppModule implementing the AppModule interface.
 type AppModule struct {
 	accountKeeper keeper.AccountKeeper
 	bankKeeper    types.BankKeeper
+	keeper        vestingkeeper.Keeper
 }
 
-func NewAppModule(ak keeper.AccountKeeper, bk types.BankKeeper) AppModule {
+func NewAppModule(ak keeper.AccountKeeper, bk types.BankKeeper, k vestingkeeper.Keeper) AppModule {
 	return AppModule{
 		accountKeeper: ak,
 		bankKeeper:    bk,
+		keeper:        k,
 	}
 }
 
@@ -49,5 +64,33 @@ func (AppModule) RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	types.RegisterInterfaces(registrar)
 }
 
+// RegisterGRPCGatewayRoutes registers the module's gRPC Gateway routes.
+func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterServices registers the module's services.
+func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
+	types.RegisterQueryServer(registrar, am.keeper)
+	return nil
+}
+
+// RegisterInvariants registers the vesting module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.accountKeeper, am.bankKeeper)
+}
+
+// GetTxCmd returns the root tx command for the vesting module.
+func (AppModule) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
+// GetQueryCmd returns the root query command for the vesting module.
+func (AppModule) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // ConsensusVersion implements HasConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
@@ -0,0 +1,136 @@
+package vesting
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LockedValueGaugeInterval is the default time between two updates of the
+// locked value gauges. Updating them walks all the accounts.
+const LockedValueGaugeInterval = 10 * time.Minute
+
+// LockedValueReporter updates the gauges of the coins still vesting in the
+// vesting accounts, one per denom, from the last committed state. It walks all
+// the accounts, so it runs in the background, off the block path, and should
+// only be started when telemetry is enabled.
+type LockedValueReporter struct {
+	ak keeper.AccountKeeper
+	// queryCtx returns a context reading the last committed state, such as
+	// the one of BaseApp.CreateQueryContext.
+	queryCtx func() (sdk.Context, error)
+	logger   log.Logger
+
+	// closeCh stops the report loop, and doneCh is closed once it returned.
+	closeCh chan struct{}
+	doneCh  chan struct{}
+
+	mu sync.Mutex
+	// denoms are the denoms of the last update, whose gauges are reset when
+	// they are no longer vesting.
+	denoms map[string]bool
+}
+
+// NewLockedValueReporter returns a LockedValueReporter reading the vesting
+// accounts from the contexts returned by queryCtx.
+func NewLockedValueReporter(ak keeper.AccountKeeper, queryCtx func() (sdk.Context, error), logger log.Logger) *LockedValueReporter {
+	return &LockedValueReporter{
+		ak:       ak,
+		queryCtx: queryCtx,
+		logger:   logger.With("module", "x/"+types.ModuleName),
+		closeCh:  make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Start updates the gauges every interval until Close is called.
+func (r *LockedValueReporter) Start(interval time.Duration) {
+	go r.reportLoop(interval)
+}
+
+// Close stops the updates. It must be called when the node shuts down,
+// typically in the application's Close function, and only after Start.
+func (r *LockedValueReporter) Close() error {
+	close(r.closeCh)
+	<-r.doneCh
+	return nil
+}
+
+func (r *LockedValueReporter) reportLoop(interval time.Duration) {
+	defer close(r.doneCh)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.closeCh:
+			return
+		case <-ticker.C:
+			// no state is committed before the first block, and metrics are
+			// best effort, so a failed update is only logged
+			if err := r.Update(); err != nil {
+				r.logger.Debug("failed to update the vesting locked value gauges", "err", err)
+			}
+		}
+	}
+}
+
+// Update sets the gauges from the last committed state.
+func (r *LockedValueReporter) Update() error {
+	ctx, err := r.queryCtx()
+	if err != nil {
+		return err
+	}
+	// walking all the accounts must not be bounded by the query gas limit
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// the query context of the latest height only carries the block time in
+	// its block header
+	blockTime := ctx.HeaderInfo().Time
+	if blockTime.IsZero() {
+		blockTime = ctx.BlockTime()
+	}
+
+	var locked sdk.Coins
+	err = iterateVestingAccounts(ctx, r.ak, func(acc exported.VestingAccount) {
+		locked = locked.Add(acc.GetVestingCoins(blockTime)...)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	denoms := make(map[string]bool, len(locked))
+	for _, coin := range locked {
+		denoms[coin.Denom] = true
+		setLockedValueGauge(coin.Denom, types.AmountToFloat32(coin.Amount))
+	}
+	for denom := range r.denoms {
+		if !denoms[denom] {
+			setLockedValueGauge(denom, 0)
+		}
+	}
+	r.denoms = denoms
+
+	return nil
+}
+
+func setLockedValueGauge(denom string, val float32) {
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, "locked_value"},
+		val,
+		[]metrics.Label{telemetry.NewLabel("denom", denom)},
+	)
+}
@@ -0,0 +1,82 @@
+package vesting_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authcodec "cosmossdk.io/x/auth/codec"
+	"cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestLockedValueReporter(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, vesting.AppModule{})
+	key := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	now := time.Unix(1700000000, 0)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithHeaderInfo(header.Info{Time: now})
+
+	ak := keeper.NewAccountKeeper(
+		env,
+		encCfg.Codec,
+		authtypes.ProtoBaseAccount,
+		map[string][]string{},
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	baseAcc := authtypes.NewBaseAccount(sdk.AccAddress(pubKey.Address()), pubKey, ak.NextAccountNumber(ctx), 0)
+	// half of the coins are vested
+	acc, err := types.NewContinuousVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), now.Unix()-50, now.Unix()+50)
+	require.NoError(t, err)
+	ak.SetAccount(ctx, acc)
+
+	queryErr := errors.New("not ready")
+	reporter := vesting.NewLockedValueReporter(ak, func() (sdk.Context, error) {
+		if queryErr != nil {
+			return sdk.Context{}, queryErr
+		}
+		return ctx, nil
+	}, log.NewNopLogger())
+	require.ErrorIs(t, reporter.Update(), queryErr)
+
+	queryErr = nil
+	require.NoError(t, reporter.Update())
+	gauge, ok := sink.Data()[0].Gauges["vesting.locked_value;denom=stake"]
+	require.True(t, ok)
+	require.Equal(t, float32(50), gauge.Value)
+
+	// the gauge of a denom no longer vesting is reset
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Minute)})
+	require.NoError(t, reporter.Update())
+	require.Equal(t, float32(0), sink.Data()[0].Gauges["vesting.locked_value;denom=stake"].Value)
+
+	reporter.Start(time.Millisecond)
+	require.NoError(t, reporter.Close())
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// ModuleVestingAccountAddress returns the deterministic address of the vesting
+// account a module creates with the given key. The address is derived under the
+// vesting module namespace, so it never collides with the module account or
+// other accounts derived by the module itself.
+func ModuleVestingAccountAddress(moduleName string, key []byte) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(moduleName), key)
+}
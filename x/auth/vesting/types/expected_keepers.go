@@ -7,9 +7,10 @@ import (
 )
 
 // BankKeeper defines the expected interface contract the vesting module requires
-// for creating vesting accounts with funds.
+// for creating vesting accounts with funds and checking their invariants.
 type BankKeeper interface {
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
@@ -42,4 +42,10 @@ func TestValidateGenesisInvalidAccounts(t *testing.T) {
 	// invalid start time
 	genAccs[0] = NewContinuousVestingAccountRaw(baseVestingAcc, 1548888000)
 	require.Error(t, authtypes.ValidateGenAccounts(genAccs))
+
+	// invalid address, without a public key to check it against
+	invalidAddrAcc := *baseVestingAcc
+	invalidAddrAcc.BaseAccount = &authtypes.BaseAccount{Address: "cosmos1invalid"}
+	genAccs[0] = &invalidAddrAcc
+	require.ErrorContains(t, authtypes.ValidateGenAccounts(genAccs), "invalid address")
 }
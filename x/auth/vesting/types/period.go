@@ -2,9 +2,12 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -31,13 +34,28 @@ func (p Periods) TotalDuration() time.Duration {
 	return time.Duration(len) * time.Second
 }
 
-// TotalDuration returns the sum of coins for the period
+// TotalAmount returns the sum of coins for the periods. The amounts are summed in place
+// per denom and sorted once, as merging the coins of each period is slow for long schedules.
 func (p Periods) TotalAmount() sdk.Coins {
-	total := sdk.Coins{}
+	amounts := make(map[string]*big.Int)
 	for _, period := range p {
-		total = total.Add(period.Amount...)
+		for _, coin := range period.Amount {
+			amount, ok := amounts[coin.Denom]
+			if !ok {
+				amount = new(big.Int)
+				amounts[coin.Denom] = amount
+			}
+			amount.Add(amount, coin.Amount.BigIntMut())
+		}
 	}
-	return total
+
+	total := make(sdk.Coins, 0, len(amounts))
+	for denom, amount := range amounts {
+		if amount.Sign() != 0 {
+			total = append(total, sdk.NewCoin(denom, math.NewIntFromBigInt(amount)))
+		}
+	}
+	return total.Sort()
 }
 
 // String implements the fmt.Stringer interface
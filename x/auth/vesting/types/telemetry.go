@@ -0,0 +1,14 @@
+package types
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+)
+
+// AmountToFloat32 converts a coin amount to a metric value. Amounts that do not
+// fit in an int64 are converted too, rounded to the nearest float32.
+func AmountToFloat32(amount math.Int) float32 {
+	f, _ := new(big.Float).SetInt(amount.BigInt()).Float32()
+	return f
+}
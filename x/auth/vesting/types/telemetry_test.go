@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/vesting/types"
+)
+
+func TestAmountToFloat32(t *testing.T) {
+	require.Equal(t, float32(0), types.AmountToFloat32(math.ZeroInt()))
+	require.Equal(t, float32(1500), types.AmountToFloat32(math.NewInt(1500)))
+
+	// amounts overflowing int64 are converted too
+	amount := math.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil))
+	require.False(t, amount.IsInt64())
+	require.InEpsilon(t, float32(1e30), types.AmountToFloat32(amount), 1e-6)
+}
@@ -147,6 +147,12 @@ func (bva BaseVestingAccount) GetEndTime() int64 {
 
 // Validate checks for errors on the account fields
 func (bva BaseVestingAccount) Validate() error {
+	// the base account only checks its address against its public key, which
+	// genesis vesting accounts usually do not have yet
+	if _, err := sdk.AccAddressFromBech32(bva.Address); err != nil {
+		return fmt.Errorf("invalid address %q: %w", bva.Address, err)
+	}
+
 	if bva.EndTime < 0 {
 		return errors.New("end time cannot be negative")
 	}
@@ -306,23 +312,22 @@ func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins
 		return pva.OriginalVesting
 	}
 
-	// track the start time of the next period
-	currentPeriodStartTime := pva.StartTime
-
-	// for each period, if the period is over, add those coins as vested and check the next period.
+	// count the periods that are over, only their lengths are needed for that.
+	elapsed := 0
+	periodEndTime := pva.StartTime
 	for _, period := range pva.VestingPeriods {
-		x := blockTime.Unix() - currentPeriodStartTime
-		if x < period.Length {
+		periodEndTime += period.Length
+		if blockTime.Unix() < periodEndTime {
 			break
 		}
-
-		vestedCoins = vestedCoins.Add(period.Amount...)
-
-		// update the start time of the next period
-		currentPeriodStartTime += period.Length
+		elapsed++
+	}
+	if elapsed == 0 {
+		return vestedCoins
 	}
 
-	return vestedCoins
+	// the coins of all the periods that are over are vested.
+	return Periods(pva.VestingPeriods[:elapsed]).TotalAmount()
 }
 
 // GetVestingCoins returns the total number of vesting coins. If no coins are
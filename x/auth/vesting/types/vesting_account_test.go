@@ -451,6 +451,50 @@ func TestGetVestedCoinsPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, origCoins, vestedCoins)
 }
 
+func TestGetVestedCoinsPeriodicVestingAccLongSchedule(t *testing.T) {
+	now := time.Now()
+	periods := make(types.Periods, 5000)
+	for i := range periods {
+		amount := sdk.Coins{sdk.NewInt64Coin(stakeDenom, int64(i+1))}
+		if i%2 == 0 {
+			amount = amount.Add(sdk.NewInt64Coin(feeDenom, 1))
+		}
+		periods[i] = types.Period{Length: 60, Amount: amount}
+	}
+
+	bacc, _ := initBaseAccount()
+	pva, err := types.NewPeriodicVestingAccount(bacc, periods.TotalAmount(), now.Unix(), periods)
+	require.NoError(t, err)
+
+	// require the vested coins to be the running sum of the periods that are over
+	vested := sdk.Coins{}
+	for i, period := range periods {
+		require.True(t, vested.Equal(pva.GetVestedCoins(now.Add(time.Duration(60*(i+1)-1)*time.Second))), "period %d", i)
+		vested = vested.Add(period.Amount...)
+		require.Equal(t, vested, pva.GetVestedCoins(now.Add(time.Duration(60*(i+1))*time.Second)), "period %d", i)
+	}
+	require.Equal(t, pva.OriginalVesting, vested)
+}
+
+func BenchmarkGetVestedCoinsPeriodicVestingAcc(b *testing.B) {
+	now := time.Now()
+	periods := make(types.Periods, 5000)
+	for i := range periods {
+		periods[i] = types.Period{Length: 60, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 1)}}
+	}
+
+	bacc, _ := initBaseAccount()
+	pva, err := types.NewPeriodicVestingAccount(bacc, periods.TotalAmount(), now.Unix(), periods)
+	require.NoError(b, err)
+	blockTime := now.Add(time.Duration(periods.TotalLength()/2) * time.Second)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pva.GetVestedCoins(blockTime)
+	}
+}
+
 func TestOverflowAndNegativeVestedCoinsPeriods(t *testing.T) {
 	now := time.Now()
 	tests := []struct {
@@ -194,45 +194,93 @@ func (m *GrantQueueItem) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
+// GrantTemplate is a named set of authorizations stored by an owner account,
+// which the owner can grant to many grantees at once with MsgGrantFromTemplate.
+//
+// Since x/authz v1.0.0
+type GrantTemplate struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// authorizations granted by the template, at most one per message type.
+	Authorizations []*types.Any `protobuf:"bytes,3,rep,name=authorizations,proto3" json:"authorizations,omitempty"`
+}
+
+func (m *GrantTemplate) Reset()         { *m = GrantTemplate{} }
+func (m *GrantTemplate) String() string { return proto.CompactTextString(m) }
+func (*GrantTemplate) ProtoMessage()    {}
+func (*GrantTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{4}
+}
+func (m *GrantTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantTemplate.Merge(m, src)
+}
+func (m *GrantTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantTemplate proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
+	proto.RegisterType((*GrantTemplate)(nil), "cosmos.authz.v1beta1.GrantTemplate")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x75, 0xfc, 0x99, 0xa7, 0x21, 0x88, 0x72, 0x28, 0x3d, 0x24, 0x55, 0x0e, 0x68,
-	0x42, 0x6a, 0xac, 0x15, 0x4e, 0x9c, 0x68, 0x84, 0x34, 0xc1, 0x8d, 0x30, 0x2e, 0x5c, 0x2a, 0x67,
-	0x31, 0x9e, 0xb5, 0x3a, 0x8e, 0x6c, 0x07, 0x2d, 0xfb, 0x08, 0x9c, 0xf6, 0x19, 0xf8, 0x04, 0x20,
-	0xed, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0xf8, 0xd3, 0x1e, 0xf8, 0x1a, 0xa8, 0x76, 0x22, 0x1a,
-	0x5a, 0x89, 0x1e, 0xb8, 0x44, 0x7e, 0xfd, 0x3e, 0xcf, 0xfb, 0x3e, 0xf9, 0x45, 0x81, 0xfd, 0x53,
-	0xa1, 0xb8, 0x50, 0x08, 0x97, 0xfa, 0xec, 0x12, 0xbd, 0x3b, 0x4a, 0x89, 0xc6, 0x47, 0xb6, 0x8a,
-	0x0a, 0x29, 0xb4, 0x70, 0x3d, 0xab, 0x88, 0xec, 0x5d, 0xad, 0xe8, 0xdd, 0xc3, 0x9c, 0xe5, 0x02,
-	0x99, 0xa7, 0x15, 0xf6, 0xee, 0x5b, 0xe1, 0xd8, 0x54, 0xa8, 0x76, 0xd9, 0x56, 0x40, 0x85, 0xa0,
-	0x13, 0x82, 0x4c, 0x95, 0x96, 0x6f, 0x91, 0x66, 0x9c, 0x28, 0x8d, 0x79, 0x51, 0x0b, 0x3c, 0x2a,
-	0xa8, 0xb0, 0xc6, 0xe5, 0xa9, 0x99, 0xf8, 0xb7, 0x0d, 0xe7, 0x95, 0x6d, 0x85, 0x1a, 0x7a, 0xc7,
-	0x24, 0x27, 0x92, 0x9d, 0x8e, 0x4a, 0x7d, 0x26, 0x24, 0xbb, 0xc4, 0x9a, 0x89, 0xdc, 0xbd, 0x0b,
-	0x3b, 0x5c, 0xd1, 0x2e, 0xe8, 0x83, 0xc3, 0xbd, 0x64, 0x79, 0x7c, 0xf2, 0xe2, 0xf3, 0xf5, 0x20,
-	0xdc, 0xf4, 0x0e, 0x51, 0xcb, 0xf9, 0xfe, 0xd7, 0xc7, 0x87, 0x81, 0x95, 0x0d, 0x54, 0x76, 0x8e,
-	0x36, 0x4d, 0x0f, 0x3f, 0x01, 0x78, 0xe3, 0x58, 0xe2, 0x5c, 0xbb, 0x29, 0x3c, 0xc0, 0xab, 0x2d,
-	0xb3, 0x71, 0x7f, 0xe8, 0x45, 0x36, 0x72, 0xd4, 0x44, 0x8e, 0x46, 0x79, 0x15, 0x3f, 0xd8, 0x2e,
-	0x42, 0xd2, 0x1e, 0xe9, 0x3e, 0x83, 0x90, 0x5c, 0x14, 0x4c, 0xda, 0x05, 0x3b, 0x66, 0x41, 0x6f,
-	0x6d, 0xc1, 0x49, 0x83, 0x32, 0xbe, 0x3d, 0xfd, 0x16, 0x80, 0xab, 0xef, 0x01, 0x48, 0x56, 0x7c,
-	0xe1, 0x87, 0x1d, 0xe8, 0x9a, 0xcc, 0x6d, 0x50, 0x43, 0x78, 0x8b, 0x2e, 0x6f, 0x89, 0xb4, 0xb0,
-	0xe2, 0xee, 0x97, 0xeb, 0x41, 0xf3, 0xad, 0x47, 0x59, 0x26, 0x89, 0x52, 0xaf, 0xb4, 0x64, 0x39,
-	0x4d, 0x1a, 0xe1, 0x1f, 0x0f, 0x31, 0x69, 0xb6, 0xf0, 0x90, 0x75, 0x50, 0x9d, 0xff, 0x0f, 0xea,
-	0x69, 0x0b, 0xd4, 0xee, 0x3f, 0x41, 0xed, 0xae, 0x41, 0x7a, 0x0c, 0xef, 0x18, 0x46, 0x2f, 0x4b,
-	0x52, 0x92, 0xe7, 0x9a, 0x70, 0x37, 0x84, 0x07, 0x5c, 0xd1, 0xb1, 0xae, 0x0a, 0x32, 0x2e, 0xe5,
-	0x44, 0x75, 0x41, 0xbf, 0x73, 0xb8, 0x97, 0xec, 0x73, 0x45, 0x4f, 0xaa, 0x82, 0xbc, 0x96, 0x13,
-	0x15, 0x0f, 0xa7, 0x3f, 0x7d, 0x67, 0x3a, 0xf7, 0xc1, 0x6c, 0xee, 0x83, 0x1f, 0x73, 0x1f, 0x5c,
-	0x2d, 0x7c, 0x67, 0xb6, 0xf0, 0x9d, 0xaf, 0x0b, 0xdf, 0x79, 0x53, 0x83, 0x51, 0xd9, 0x79, 0xc4,
-	0x04, 0xba, 0xb0, 0x3f, 0x55, 0x7a, 0xd3, 0xe4, 0x79, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0x57, 0xde, 0x7c, 0x79, 0x03, 0x00, 0x00,
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0x71, 0x0a, 0x74, 0xab, 0x54, 0xb0, 0xca, 0xc1, 0xe4, 0xe0, 0x44, 0x3e, 0xa0,
+	0x0a, 0x29, 0xb6, 0x1a, 0x38, 0x71, 0x22, 0x11, 0x52, 0x05, 0x37, 0x4c, 0xb8, 0x70, 0x89, 0x36,
+	0xf5, 0xe0, 0x5a, 0xcd, 0xee, 0x5a, 0xbb, 0x6b, 0x68, 0xfa, 0x08, 0x9c, 0xfa, 0x0c, 0x3c, 0x01,
+	0x48, 0xbd, 0xf0, 0x06, 0x11, 0xa7, 0x8a, 0x13, 0x27, 0xfe, 0x24, 0x07, 0x5e, 0x03, 0x65, 0xd7,
+	0x16, 0x75, 0x53, 0x89, 0x48, 0x70, 0xb1, 0x66, 0x76, 0xbe, 0x6f, 0x66, 0xf6, 0x67, 0x69, 0x71,
+	0xf7, 0x50, 0x28, 0x26, 0x54, 0x48, 0x73, 0x7d, 0x74, 0x1a, 0xbe, 0xd9, 0x9f, 0x80, 0xa6, 0xfb,
+	0x36, 0x0b, 0x32, 0x29, 0xb4, 0x20, 0x2d, 0xab, 0x08, 0xec, 0x59, 0xa1, 0x68, 0xdf, 0xa1, 0x2c,
+	0xe5, 0x22, 0x34, 0x5f, 0x2b, 0x6c, 0xdf, 0xb5, 0xc2, 0xb1, 0xc9, 0xc2, 0xc2, 0x65, 0x4b, 0x9d,
+	0x44, 0x88, 0x64, 0x0a, 0xa1, 0xc9, 0x26, 0xf9, 0xeb, 0x50, 0xa7, 0x0c, 0x94, 0xa6, 0x2c, 0x2b,
+	0x04, 0xad, 0x44, 0x24, 0xc2, 0x1a, 0x57, 0x51, 0xd9, 0xf1, 0xaa, 0x8d, 0xf2, 0x99, 0x2d, 0xf9,
+	0x1a, 0xb7, 0x0e, 0x80, 0x83, 0x4c, 0x0f, 0x07, 0xb9, 0x3e, 0x12, 0x32, 0x3d, 0xa5, 0x3a, 0x15,
+	0x9c, 0xdc, 0xc6, 0x0e, 0x53, 0x89, 0x8b, 0xba, 0x68, 0x6f, 0x3b, 0x5a, 0x85, 0x8f, 0x9e, 0x7d,
+	0x3e, 0xef, 0xf9, 0xd7, 0xdd, 0x21, 0xa8, 0x38, 0xdf, 0xfd, 0xfa, 0x70, 0xbf, 0x63, 0x65, 0x3d,
+	0x15, 0x1f, 0x87, 0xd7, 0x75, 0xf7, 0x3f, 0x22, 0xbc, 0x75, 0x20, 0x29, 0xd7, 0x64, 0x82, 0x9b,
+	0xf4, 0x72, 0xc9, 0x4c, 0xdc, 0xe9, 0xb7, 0x02, 0xbb, 0x72, 0x50, 0xae, 0x1c, 0x0c, 0xf8, 0x6c,
+	0x78, 0x6f, 0xb3, 0x15, 0xa2, 0x6a, 0x4b, 0xf2, 0x04, 0x63, 0x38, 0xc9, 0x52, 0x69, 0x07, 0xd4,
+	0xcd, 0x80, 0xf6, 0xda, 0x80, 0x51, 0x89, 0x72, 0x78, 0x6b, 0xfe, 0xad, 0x83, 0xce, 0xbe, 0x77,
+	0x50, 0x74, 0xc9, 0xe7, 0xbf, 0xaf, 0x63, 0x62, 0x76, 0xae, 0x82, 0xea, 0xe3, 0x9b, 0xc9, 0xea,
+	0x14, 0xa4, 0x85, 0x35, 0x74, 0xbf, 0x9c, 0xf7, 0xca, 0x7f, 0x3d, 0x88, 0x63, 0x09, 0x4a, 0xbd,
+	0xd0, 0x32, 0xe5, 0x49, 0x54, 0x0a, 0xff, 0x78, 0xc0, 0xad, 0x6f, 0xe6, 0x81, 0x75, 0x50, 0xce,
+	0xff, 0x07, 0xf5, 0xb8, 0x02, 0xaa, 0xf1, 0x57, 0x50, 0x8d, 0x35, 0x48, 0x0f, 0xf1, 0xae, 0x61,
+	0xf4, 0x3c, 0x87, 0x1c, 0x9e, 0x6a, 0x60, 0xc4, 0xc7, 0x4d, 0xa6, 0x92, 0xb1, 0x9e, 0x65, 0x30,
+	0xce, 0xe5, 0x54, 0xb9, 0xa8, 0xeb, 0xec, 0x6d, 0x47, 0x3b, 0x4c, 0x25, 0xa3, 0x59, 0x06, 0x2f,
+	0xe5, 0x54, 0xf9, 0x9f, 0x10, 0x6e, 0x1a, 0xdb, 0x08, 0x58, 0x36, 0xa5, 0x1a, 0x48, 0x80, 0xb7,
+	0xc4, 0x5b, 0xbe, 0x01, 0x53, 0x2b, 0x23, 0x04, 0x37, 0x38, 0x65, 0x05, 0xce, 0xc8, 0xc4, 0x24,
+	0xc6, 0xbb, 0x95, 0xeb, 0x29, 0xd7, 0xe9, 0x3a, 0xff, 0x8c, 0xec, 0x4a, 0xcf, 0x61, 0x7f, 0xfe,
+	0xd3, 0xab, 0xcd, 0x17, 0x1e, 0xba, 0x58, 0x78, 0xe8, 0xc7, 0xc2, 0x43, 0x67, 0x4b, 0xaf, 0x76,
+	0xb1, 0xf4, 0x6a, 0x5f, 0x97, 0x5e, 0xed, 0x55, 0xb1, 0xb4, 0x8a, 0x8f, 0x83, 0x54, 0x84, 0x27,
+	0xf6, 0x41, 0x98, 0xdc, 0x30, 0x93, 0x1f, 0xfc, 0x1e, 0x00, 0xf8, 0xb7, 0x2a, 0x62, 0x35, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -401,6 +449,57 @@ func (m *GrantQueueItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GrantTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -482,6 +581,29 @@ func (m *GrantQueueItem) Size() (n int) {
 	return n
 }
 
+func (m *GrantTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovAuthz(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -960,6 +1082,154 @@ func (m *GrantQueueItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GrantTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, &types.Any{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthz(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,6 +17,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgSetGrantTemplate{}, "cosmos-sdk/MsgSetGrantTemplate")
+	legacy.RegisterAminoMsg(cdc, &MsgDeleteGrantTemplate{}, "cosmos-sdk/MsgDeleteGrantTemplate")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantFromTemplate{}, "cosmos-sdk/MsgGrantFromTemplate")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
@@ -28,6 +31,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgGrant{},
 		&MsgRevoke{},
 		&MsgExec{},
+		&MsgSetGrantTemplate{},
+		&MsgDeleteGrantTemplate{},
+		&MsgGrantFromTemplate{},
 	)
 
 	// since bank.SendAuthorization and staking.StakeAuthorization both implement Authorization
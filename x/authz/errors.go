@@ -20,4 +20,6 @@ var (
 	ErrAuthorizationNumOfSigners = errors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = errors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrGrantTemplateNotFound error if there is no grant template found given an owner and a name
+	ErrGrantTemplateNotFound = errors.Register(ModuleName, 13, "grant template not found")
 )
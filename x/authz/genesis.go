@@ -24,6 +24,23 @@ func ValidateGenesis(data GenesisState) error {
 		}
 
 	}
+
+	type templateKey struct{ owner, name string }
+	templates := make(map[templateKey]struct{}, len(data.GrantTemplates))
+	for i, t := range data.GrantTemplates {
+		if t.Owner == "" {
+			return fmt.Errorf("grant template: %d, missing owner", i)
+		}
+		if err := t.ValidateBasic(); err != nil {
+			return fmt.Errorf("grant template: %d, %w", i, err)
+		}
+
+		key := templateKey{t.Owner, t.Name}
+		if _, ok := templates[key]; ok {
+			return fmt.Errorf("grant template: %d, duplicate template %s of %s", i, t.Name, t.Owner)
+		}
+		templates[key] = struct{}{}
+	}
 	return nil
 }
 
@@ -42,6 +59,11 @@ func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, t := range data.GrantTemplates {
+		if err := t.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,135 @@
+package authz
+
+import (
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxGrantTemplateNameLength is the maximum length of a grant template name.
+const MaxGrantTemplateNameLength = 64
+
+var (
+	_ cdctypes.UnpackInterfacesMessage = &GrantTemplate{}
+	_ cdctypes.UnpackInterfacesMessage = &QueryGrantTemplatesResponse{}
+)
+
+// NewGrantTemplate returns a new GrantTemplate of the owner granting the provided
+// authorizations.
+func NewGrantTemplate(owner, name string, authorizations []Authorization) (GrantTemplate, error) {
+	anys, err := packAuthorizations(authorizations)
+	if err != nil {
+		return GrantTemplate{}, err
+	}
+
+	return GrantTemplate{
+		Owner:          owner,
+		Name:           name,
+		Authorizations: anys,
+	}, nil
+}
+
+// GetAuthorizations returns the cached values from the GrantTemplate.Authorizations.
+func (t GrantTemplate) GetAuthorizations() ([]Authorization, error) {
+	return unpackAuthorizations(t.Authorizations)
+}
+
+// ValidateBasic checks that the template is named and grants at least one valid
+// authorization, with at most one authorization per message type.
+func (t GrantTemplate) ValidateBasic() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("grant template name cannot be empty")
+	}
+
+	if len(t.Name) > MaxGrantTemplateNameLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("grant template name cannot be longer than %d characters", MaxGrantTemplateNameLength)
+	}
+
+	if len(t.Authorizations) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("grant template must have at least one authorization")
+	}
+
+	authorizations, err := t.GetAuthorizations()
+	if err != nil {
+		return err
+	}
+
+	msgTypes := make(map[string]struct{}, len(authorizations))
+	for _, a := range authorizations {
+		if _, ok := msgTypes[a.MsgTypeURL()]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate authorization for %s", a.MsgTypeURL())
+		}
+		msgTypes[a.MsgTypeURL()] = struct{}{}
+
+		if err := a.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (t GrantTemplate) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return unpackAnyAuthorizations(unpacker, t.Authorizations)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryGrantTemplatesResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, t := range res.Templates {
+		if err := t.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func packAuthorizations(authorizations []Authorization) ([]*cdctypes.Any, error) {
+	anys := make([]*cdctypes.Any, len(authorizations))
+	for i, a := range authorizations {
+		m, ok := a.(proto.Message)
+		if !ok {
+			return nil, sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", a)
+		}
+
+		any, err := cdctypes.NewAnyWithValue(m)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+func unpackAuthorizations(anys []*cdctypes.Any) ([]Authorization, error) {
+	authorizations := make([]Authorization, len(anys))
+	for i, any := range anys {
+		if any == nil {
+			return nil, sdkerrors.ErrInvalidType.Wrap("authorization is nil")
+		}
+
+		a, ok := any.GetCachedValue().(Authorization)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), any.GetCachedValue())
+		}
+		authorizations[i] = a
+	}
+
+	return authorizations, nil
+}
+
+func unpackAnyAuthorizations(unpacker cdctypes.AnyUnpacker, anys []*cdctypes.Any) error {
+	for _, any := range anys {
+		var a Authorization
+		if err := unpacker.UnpackAny(any, &a); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
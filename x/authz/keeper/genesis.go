@@ -37,6 +37,17 @@ func (k Keeper) InitGenesis(ctx context.Context, data *authz.GenesisState) error
 			return err
 		}
 	}
+
+	for _, template := range data.GrantTemplates {
+		owner, err := k.authKeeper.AddressCodec().StringToBytes(template.Owner)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SaveGrantTemplate(ctx, owner, template); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -63,5 +74,17 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*authz.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
-	return authz.NewGenesisState(entries), nil
+
+	var templates []authz.GrantTemplate
+	err = k.IterateGrantTemplates(ctx, func(template authz.GrantTemplate) (bool, error) {
+		templates = append(templates, template)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState := authz.NewGenesisState(entries)
+	genState.GrantTemplates = templates
+	return genState, nil
 }
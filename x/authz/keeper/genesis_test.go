@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/authz"
 	"cosmossdk.io/x/authz/keeper"
 	authzmodule "cosmossdk.io/x/authz/module"
 	authztestutil "cosmossdk.io/x/authz/testutil"
@@ -81,11 +82,20 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	grant := &bank.SendAuthorization{SpendLimit: coins}
 	err := suite.keeper.SaveGrant(suite.ctx, granteeAddr, granterAddr, grant, &expires)
 	suite.Require().NoError(err)
+	granterStrAddr, err := suite.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	suite.Require().NoError(err)
+	template, err := authz.NewGrantTemplate(granterStrAddr, "operator", []authz.Authorization{grant})
+	suite.Require().NoError(err)
+	err = suite.keeper.SaveGrantTemplate(suite.ctx, granterAddr, template)
+	suite.Require().NoError(err)
 	genesis, err := suite.keeper.ExportGenesis(suite.ctx)
 	suite.Require().NoError(err)
+	suite.Require().Len(genesis.GrantTemplates, 1)
 	// Clear keeper
 	err = suite.keeper.DeleteGrant(suite.ctx, granteeAddr, granterAddr, grant.MsgTypeURL())
 	suite.Require().NoError(err)
+	err = suite.keeper.RemoveGrantTemplate(suite.ctx, granterAddr, template.Name)
+	suite.Require().NoError(err)
 	newGenesis, err := suite.keeper.ExportGenesis(suite.ctx)
 	suite.Require().NoError(err)
 	suite.Require().NotEqual(genesis, newGenesis)
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/authz"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SaveGrantTemplate stores the grant template under its owner and name, replacing
+// any existing template with the same name.
+func (k Keeper) SaveGrantTemplate(ctx context.Context, owner sdk.AccAddress, template authz.GrantTemplate) error {
+	bz, err := k.cdc.Marshal(&template)
+	if err != nil {
+		return err
+	}
+
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	return store.Set(grantTemplateStoreKey(owner, template.Name), bz)
+}
+
+// GetGrantTemplate returns the grant template of the owner with the given name.
+func (k Keeper) GetGrantTemplate(ctx context.Context, owner sdk.AccAddress, name string) (template authz.GrantTemplate, found bool, err error) {
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	bz, err := store.Get(grantTemplateStoreKey(owner, name))
+	if err != nil || bz == nil {
+		return template, false, err
+	}
+
+	if err := k.cdc.Unmarshal(bz, &template); err != nil {
+		return template, false, err
+	}
+
+	return template, true, nil
+}
+
+// RemoveGrantTemplate removes the grant template of the owner with the given name.
+// Grants already made from the template are kept.
+func (k Keeper) RemoveGrantTemplate(ctx context.Context, owner sdk.AccAddress, name string) error {
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	skey := grantTemplateStoreKey(owner, name)
+
+	has, err := store.Has(skey)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(authz.ErrGrantTemplateNotFound, "failed to delete grant template %s", name)
+	}
+
+	return store.Delete(skey)
+}
+
+// IterateGrantTemplates iterates over all grant templates.
+// The iteration stops when the handler function returns true or the iterator exhaust.
+func (k Keeper) IterateGrantTemplates(ctx context.Context, handler func(template authz.GrantTemplate) (bool, error)) error {
+	store := runtime.KVStoreAdapter(k.environment.KVStoreService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, GrantTemplatePrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var template authz.GrantTemplate
+		if err := k.cdc.Unmarshal(iter.Value(), &template); err != nil {
+			return err
+		}
+
+		stop, err := handler(template)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+
+	return nil
+}
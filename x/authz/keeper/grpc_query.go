@@ -192,3 +192,32 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		Pagination: pageRes,
 	}, nil
 }
+
+// GrantTemplates implements the Query/GrantTemplates gRPC method.
+func (k Keeper) GrantTemplates(ctx context.Context, req *authz.QueryGrantTemplatesRequest) (*authz.QueryGrantTemplatesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	store := runtime.KVStoreAdapter(k.environment.KVStoreService.OpenKVStore(ctx))
+	templateStore := prefix.NewStore(store, grantTemplateStoreKey(owner, ""))
+
+	templates, pageRes, err := query.GenericFilteredPaginate(k.cdc, templateStore, req.Pagination, func(_ []byte, template *authz.GrantTemplate) (*authz.GrantTemplate, error) {
+		return template, nil
+	}, func() *authz.GrantTemplate {
+		return &authz.GrantTemplate{}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGrantTemplatesResponse{
+		Templates:  templates,
+		Pagination: pageRes,
+	}, nil
+}
@@ -309,3 +309,34 @@ func (suite *TestSuite) createSendAuthorizationWithAllowList(grantee, granter sd
 	suite.Require().NoError(err)
 	return authorization
 }
+
+func (suite *TestSuite) TestGRPCQueryGrantTemplates() {
+	owner, err := suite.accountKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	other, err := suite.accountKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+
+	for _, name := range []string{"auditor", "operator"} {
+		msg, err := authz.NewMsgSetGrantTemplate(owner, name, []authz.Authorization{authz.NewGenericAuthorization(bankSendAuthMsgType)})
+		suite.Require().NoError(err)
+		_, err = suite.msgSrvr.SetGrantTemplate(suite.ctx, msg)
+		suite.Require().NoError(err)
+	}
+
+	_, err = suite.queryClient.GrantTemplates(suite.ctx, &authz.QueryGrantTemplatesRequest{Owner: "invalid"})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.GrantTemplates(suite.ctx, &authz.QueryGrantTemplatesRequest{Owner: owner})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Templates, 2)
+	suite.Require().Equal("auditor", res.Templates[0].Name)
+	suite.Require().Equal("operator", res.Templates[1].Name)
+
+	authorizations, err := res.Templates[0].GetAuthorizations()
+	suite.Require().NoError(err)
+	suite.Require().Equal(bankSendAuthMsgType, authorizations[0].MsgTypeURL())
+
+	res, err = suite.queryClient.GrantTemplates(suite.ctx, &authz.QueryGrantTemplatesRequest{Owner: other})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Templates)
+}
@@ -16,9 +16,11 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<owner_Bytes><name_Bytes>: GrantTemplate
 var (
-	GrantKey         = []byte{0x01} // prefix for each key
-	GrantQueuePrefix = []byte{0x02}
+	GrantKey            = []byte{0x01} // prefix for each key
+	GrantQueuePrefix    = []byte{0x02}
+	GrantTemplatePrefix = []byte{0x03}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -93,6 +95,15 @@ func GrantQueueTimePrefix(expiration time.Time) []byte {
 	return append(GrantQueuePrefix, sdk.FormatTimeBytes(expiration)...)
 }
 
+// grantTemplateStoreKey - return grant template store key
+// Items are stored with the following key: values
+//
+// - 0x03<ownerAddressLen (1 Byte)><ownerAddress_Bytes><name_Bytes>: GrantTemplate
+func grantTemplateStoreKey(owner sdk.AccAddress, name string) []byte {
+	owner = address.MustLengthPrefix(owner)
+	return sdk.AppendLengthPrefixedBytes(GrantTemplatePrefix, owner, conv.UnsafeStrToBytes(name))
+}
+
 // firstAddressFromGrantStoreKey parses the first address only
 func firstAddressFromGrantStoreKey(key []byte) sdk.AccAddress {
 	addrLen := key[0]
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"strings"
@@ -124,6 +125,115 @@ func (k Keeper) PruneExpiredGrants(ctx context.Context, msg *authz.MsgPruneExpir
 	return &authz.MsgPruneExpiredGrantsResponse{}, nil
 }
 
+// SetGrantTemplate implements the MsgServer.SetGrantTemplate method.
+func (k Keeper) SetGrantTemplate(ctx context.Context, msg *authz.MsgSetGrantTemplate) (*authz.MsgSetGrantTemplateResponse, error) {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	template := authz.GrantTemplate{
+		Owner:          msg.Owner,
+		Name:           msg.Name,
+		Authorizations: msg.Authorizations,
+	}
+	if err := template.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authorizations, err := template.GetAuthorizations()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range authorizations {
+		t := a.MsgTypeURL()
+		if err := k.environment.RouterService.MessageRouterService().CanInvoke(ctx, t); err != nil {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist", t)
+		}
+	}
+
+	if err := k.SaveGrantTemplate(ctx, owner, template); err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgSetGrantTemplateResponse{}, nil
+}
+
+// DeleteGrantTemplate implements the MsgServer.DeleteGrantTemplate method.
+func (k Keeper) DeleteGrantTemplate(ctx context.Context, msg *authz.MsgDeleteGrantTemplate) (*authz.MsgDeleteGrantTemplateResponse, error) {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	if msg.Name == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("missing grant template name")
+	}
+
+	if err := k.RemoveGrantTemplate(ctx, owner, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgDeleteGrantTemplateResponse{}, nil
+}
+
+// GrantFromTemplate implements the MsgServer.GrantFromTemplate method to grant
+// the authorizations of a grant template to many grantees.
+func (k Keeper) GrantFromTemplate(ctx context.Context, msg *authz.MsgGrantFromTemplate) (*authz.MsgGrantFromTemplateResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if len(msg.Grantees) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("grantees cannot be empty")
+	}
+
+	grantees := make([]sdk.AccAddress, 0, len(msg.Grantees))
+	seen := make(map[string]struct{}, len(msg.Grantees))
+	for _, g := range msg.Grantees {
+		grantee, err := k.authKeeper.AddressCodec().StringToBytes(g)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+		}
+
+		if bytes.Equal(grantee, granter) {
+			return nil, authz.ErrGranteeIsGranter
+		}
+
+		if _, ok := seen[string(grantee)]; ok {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate grantee %s", g)
+		}
+		seen[string(grantee)] = struct{}{}
+
+		grantees = append(grantees, grantee)
+	}
+
+	template, found, err := k.GetGrantTemplate(ctx, granter, msg.TemplateName)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(authz.ErrGrantTemplateNotFound, "granter %s has no grant template %s", msg.Granter, msg.TemplateName)
+	}
+
+	authorizations, err := template.GetAuthorizations()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grantee := range grantees {
+		for _, a := range authorizations {
+			if err := k.SaveGrant(ctx, grantee, granter, a, msg.Expiration); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &authz.MsgGrantFromTemplateResponse{}, nil
+}
+
 func validateMsgs(msgs []sdk.Msg) error {
 	for i, msg := range msgs {
 		m, ok := msg.(sdk.HasValidateBasic)
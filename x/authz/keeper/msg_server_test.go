@@ -435,3 +435,186 @@ func (suite *TestSuite) TestPruneExpiredGrants() {
 	})
 	suite.Require().Equal(0, totalGrants)
 }
+
+func (suite *TestSuite) TestSetGrantTemplate() {
+	addrs := suite.createAccounts()
+	owner, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[0])
+	suite.Require().NoError(err)
+
+	sendAuthz := banktypes.NewSendAuthorization(coins10, nil)
+
+	testCases := []struct {
+		name     string
+		malleate func() *authz.MsgSetGrantTemplate
+		errMsg   string
+	}{
+		{
+			name: "invalid owner",
+			malleate: func() *authz.MsgSetGrantTemplate {
+				msg, err := authz.NewMsgSetGrantTemplate("invalid", "operator", []authz.Authorization{sendAuthz})
+				suite.Require().NoError(err)
+				return msg
+			},
+			errMsg: "invalid owner address",
+		},
+		{
+			name: "empty name",
+			malleate: func() *authz.MsgSetGrantTemplate {
+				msg, err := authz.NewMsgSetGrantTemplate(owner, "", []authz.Authorization{sendAuthz})
+				suite.Require().NoError(err)
+				return msg
+			},
+			errMsg: "grant template name cannot be empty",
+		},
+		{
+			name: "no authorizations",
+			malleate: func() *authz.MsgSetGrantTemplate {
+				msg, err := authz.NewMsgSetGrantTemplate(owner, "operator", nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+			errMsg: "grant template must have at least one authorization",
+		},
+		{
+			name: "duplicate msg type",
+			malleate: func() *authz.MsgSetGrantTemplate {
+				msg, err := authz.NewMsgSetGrantTemplate(owner, "operator", []authz.Authorization{sendAuthz, authz.NewGenericAuthorization(bankSendAuthMsgType)})
+				suite.Require().NoError(err)
+				return msg
+			},
+			errMsg: "duplicate authorization",
+		},
+		{
+			name: "unknown msg type",
+			malleate: func() *authz.MsgSetGrantTemplate {
+				msg, err := authz.NewMsgSetGrantTemplate(owner, "operator", []authz.Authorization{authz.NewGenericAuthorization("/cosmos.unknown.v1.MsgUnknown")})
+				suite.Require().NoError(err)
+				return msg
+			},
+			errMsg: "doesn't exist",
+		},
+		{
+			name: "valid template",
+			malleate: func() *authz.MsgSetGrantTemplate {
+				msg, err := authz.NewMsgSetGrantTemplate(owner, "operator", []authz.Authorization{sendAuthz})
+				suite.Require().NoError(err)
+				return msg
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.SetGrantTemplate(suite.ctx, tc.malleate())
+			if tc.errMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			template, found, err := suite.authzKeeper.GetGrantTemplate(suite.ctx, addrs[0], "operator")
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(owner, template.Owner)
+			authorizations, err := template.GetAuthorizations()
+			suite.Require().NoError(err)
+			suite.Require().Len(authorizations, 1)
+		})
+	}
+
+	// delete the template
+	_, err = suite.msgSrvr.DeleteGrantTemplate(suite.ctx, authz.NewMsgDeleteGrantTemplate(owner, "operator"))
+	suite.Require().NoError(err)
+	_, found, err := suite.authzKeeper.GetGrantTemplate(suite.ctx, addrs[0], "operator")
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	_, err = suite.msgSrvr.DeleteGrantTemplate(suite.ctx, authz.NewMsgDeleteGrantTemplate(owner, "operator"))
+	suite.Require().ErrorIs(err, authz.ErrGrantTemplateNotFound)
+}
+
+func (suite *TestSuite) TestGrantFromTemplate() {
+	addrs := suite.addrs
+	addrStrs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addrStr, err := suite.accountKeeper.AddressCodec().BytesToString(addr)
+		suite.Require().NoError(err)
+		addrStrs[i] = addrStr
+	}
+	granter, grantees := addrStrs[0], addrStrs[1:4]
+
+	genericAuthz := authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgMultiSend")
+	sendAuthz := banktypes.NewSendAuthorization(coins10, nil)
+	msg, err := authz.NewMsgSetGrantTemplate(granter, "operator", []authz.Authorization{sendAuthz, genericAuthz})
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.SetGrantTemplate(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	expiration := suite.ctx.HeaderInfo().Time.Add(time.Hour)
+	past := suite.ctx.HeaderInfo().Time.Add(-time.Hour)
+
+	testCases := []struct {
+		name   string
+		msg    *authz.MsgGrantFromTemplate
+		errMsg string
+	}{
+		{
+			name:   "unknown template",
+			msg:    authz.NewMsgGrantFromTemplate(granter, "unknown", grantees, &expiration),
+			errMsg: "grant template not found",
+		},
+		{
+			name:   "no grantees",
+			msg:    authz.NewMsgGrantFromTemplate(granter, "operator", nil, &expiration),
+			errMsg: "grantees cannot be empty",
+		},
+		{
+			name:   "invalid grantee",
+			msg:    authz.NewMsgGrantFromTemplate(granter, "operator", []string{"invalid"}, &expiration),
+			errMsg: "invalid grantee address",
+		},
+		{
+			name:   "granter in grantees",
+			msg:    authz.NewMsgGrantFromTemplate(granter, "operator", []string{grantees[0], granter}, &expiration),
+			errMsg: "grantee and granter should be different",
+		},
+		{
+			name:   "duplicate grantee",
+			msg:    authz.NewMsgGrantFromTemplate(granter, "operator", []string{grantees[0], grantees[0]}, &expiration),
+			errMsg: "duplicate grantee",
+		},
+		{
+			name:   "expiration in the past",
+			msg:    authz.NewMsgGrantFromTemplate(granter, "operator", grantees, &past),
+			errMsg: "expiration must be after the current block time",
+		},
+		{
+			name: "valid",
+			msg:  authz.NewMsgGrantFromTemplate(granter, "operator", grantees, &expiration),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			_, err := suite.msgSrvr.GrantFromTemplate(ctx, tc.msg)
+			if tc.errMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			for _, grantee := range addrs[1:4] {
+				authorizations, err := suite.authzKeeper.GetAuthorizations(ctx, grantee, addrs[0])
+				suite.Require().NoError(err)
+				suite.Require().Len(authorizations, 2)
+
+				_, exp := suite.authzKeeper.GetAuthorization(ctx, grantee, addrs[0], bankSendAuthMsgType)
+				suite.Require().NotNil(exp)
+				suite.Require().Equal(expiration, *exp)
+			}
+		})
+	}
+}
@@ -44,6 +44,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "GrantTemplates",
+					Use:       "grant-templates [owner-addr]",
+					Short:     "Query the grant templates stored by an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -77,6 +85,36 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:      "Prune up to 75 expired grants in order to reduce the size of the store when the number of expired grants is large.",
 					Example:   fmt.Sprintf(`$ %s tx authz prune-grants --from [mykey]`, version.AppName),
 				},
+				{
+					RpcMethod: "SetGrantTemplate",
+					Use:       "set-grant-template [name] [authorization-json-file]... --from [owner]",
+					Short:     "Create or replace a named grant template",
+					Long:      "Create or replace a named grant template, a set of authorizations that can be granted to many grantees at once with grant-from-template.",
+					Example:   fmt.Sprintf(`$ %s tx authz set-grant-template grants-operator send.json delegate.json --from [owner]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "authorizations", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "DeleteGrantTemplate",
+					Use:       "delete-grant-template [name] --from [owner]",
+					Short:     "Delete a named grant template",
+					Long:      "Delete a named grant template. Grants already made from the template are kept.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+				},
+				{
+					RpcMethod: "GrantFromTemplate",
+					Use:       "grant-from-template [template-name] [grantee]... --from [granter]",
+					Short:     "Grant the authorizations of a grant template to many grantees",
+					Example:   fmt.Sprintf(`$ %s tx authz grant-from-template grants-operator cosmos1skj.. cosmos1skjwj.. --expiration 2030-01-01T00:00:00Z --from [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "template_name"},
+						{ProtoField: "grantees", Varargs: true},
+					},
+				},
 			},
 		},
 	}
@@ -14,9 +14,13 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgSetGrantTemplate{}
+	_ sdk.Msg = &MsgDeleteGrantTemplate{}
+	_ sdk.Msg = &MsgGrantFromTemplate{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSetGrantTemplate{}
 )
 
 // NewMsgGrant creates a new MsgGrant
@@ -110,3 +114,40 @@ func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
 
 	return msgs, nil
 }
+
+// NewMsgSetGrantTemplate creates a new MsgSetGrantTemplate
+func NewMsgSetGrantTemplate(owner, name string, authorizations []Authorization) (*MsgSetGrantTemplate, error) {
+	anys, err := packAuthorizations(authorizations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSetGrantTemplate{
+		Owner:          owner,
+		Name:           name,
+		Authorizations: anys,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSetGrantTemplate) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return unpackAnyAuthorizations(unpacker, msg.Authorizations)
+}
+
+// NewMsgDeleteGrantTemplate creates a new MsgDeleteGrantTemplate
+func NewMsgDeleteGrantTemplate(owner, name string) *MsgDeleteGrantTemplate {
+	return &MsgDeleteGrantTemplate{
+		Owner: owner,
+		Name:  name,
+	}
+}
+
+// NewMsgGrantFromTemplate creates a new MsgGrantFromTemplate
+func NewMsgGrantFromTemplate(granter, templateName string, grantees []string, expiration *time.Time) *MsgGrantFromTemplate {
+	return &MsgGrantFromTemplate{
+		Granter:      granter,
+		TemplateName: templateName,
+		Grantees:     grantees,
+		Expiration:   expiration,
+	}
+}
@@ -374,6 +374,119 @@ func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGrantTemplatesRequest is the request type for the Query/GrantTemplates RPC method.
+//
+// Since x/authz v1.0.0
+type QueryGrantTemplatesRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGrantTemplatesRequest) Reset()         { *m = QueryGrantTemplatesRequest{} }
+func (m *QueryGrantTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantTemplatesRequest) ProtoMessage()    {}
+func (*QueryGrantTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{6}
+}
+func (m *QueryGrantTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantTemplatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGrantTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantTemplatesRequest.Merge(m, src)
+}
+func (m *QueryGrantTemplatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantTemplatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGrantTemplatesRequest proto.InternalMessageInfo
+
+func (m *QueryGrantTemplatesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryGrantTemplatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGrantTemplatesResponse is the response type for the Query/GrantTemplates RPC method.
+//
+// Since x/authz v1.0.0
+type QueryGrantTemplatesResponse struct {
+	// templates is a list of grant templates stored by the owner.
+	Templates []*GrantTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGrantTemplatesResponse) Reset()         { *m = QueryGrantTemplatesResponse{} }
+func (m *QueryGrantTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantTemplatesResponse) ProtoMessage()    {}
+func (*QueryGrantTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{7}
+}
+func (m *QueryGrantTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantTemplatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGrantTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantTemplatesResponse.Merge(m, src)
+}
+func (m *QueryGrantTemplatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantTemplatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGrantTemplatesResponse proto.InternalMessageInfo
+
+func (m *QueryGrantTemplatesResponse) GetTemplates() []*GrantTemplate {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+func (m *QueryGrantTemplatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGrantsResponse")
@@ -381,45 +494,52 @@ func init() {
 	proto.RegisterType((*QueryGranterGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranterGrantsResponse")
 	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsRequest")
 	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryGrantTemplatesRequest)(nil), "cosmos.authz.v1beta1.QueryGrantTemplatesRequest")
+	proto.RegisterType((*QueryGrantTemplatesResponse)(nil), "cosmos.authz.v1beta1.QueryGrantTemplatesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xeb, 0x16, 0x8a, 0xf0, 0xe0, 0x62, 0x38, 0x64, 0x61, 0x8a, 0xa2, 0x0a, 0x41, 0x41,
-	0xc2, 0xde, 0x3a, 0x89, 0x33, 0xdb, 0x61, 0xbb, 0x42, 0x80, 0x0b, 0x97, 0xca, 0xa5, 0x9f, 0xb2,
-	0x88, 0x36, 0xce, 0x6c, 0x07, 0xd1, 0xa1, 0x5d, 0xe0, 0x05, 0x90, 0x76, 0xe0, 0x11, 0x90, 0x38,
-	0xf3, 0x10, 0x1c, 0x27, 0xb8, 0x70, 0x44, 0x2d, 0x82, 0xd7, 0x40, 0xb5, 0x5d, 0xba, 0x8c, 0x6c,
-	0x0b, 0x4c, 0x93, 0x76, 0x8b, 0xa3, 0xff, 0xf7, 0xff, 0x7e, 0xdf, 0xdf, 0xb1, 0x83, 0xc3, 0xe7,
-	0x42, 0x0d, 0x85, 0x62, 0x3c, 0xd7, 0x5b, 0x3b, 0xec, 0xe5, 0x4a, 0x0f, 0x34, 0x5f, 0x61, 0xdb,
-	0x39, 0xc8, 0x11, 0xcd, 0xa4, 0xd0, 0x82, 0x5c, 0xb7, 0x0a, 0x6a, 0x14, 0xd4, 0x29, 0xfc, 0xa5,
-	0x58, 0x88, 0x78, 0x00, 0x8c, 0x67, 0x09, 0xe3, 0x69, 0x2a, 0x34, 0xd7, 0x89, 0x48, 0x95, 0xad,
-	0xf1, 0xef, 0x3a, 0xd7, 0x1e, 0x57, 0x60, 0xcd, 0xfe, 0x58, 0x67, 0x3c, 0x4e, 0x52, 0x23, 0x76,
-	0xda, 0x72, 0x02, 0xdb, 0xcd, 0x2a, 0x16, 0xad, 0xa2, 0x6b, 0x56, 0xcc, 0xe1, 0x98, 0x45, 0xeb,
-	0x27, 0xc2, 0xe4, 0xd1, 0xd4, 0x7f, 0x53, 0xf2, 0x54, 0xab, 0x08, 0xb6, 0x73, 0x50, 0x9a, 0x74,
-	0xf0, 0xa5, 0x78, 0xfa, 0x02, 0xa4, 0x87, 0x42, 0xd4, 0xbe, 0xbc, 0xee, 0x7d, 0xf9, 0x74, 0x6f,
-	0x36, 0xc8, 0x5a, 0xbf, 0x2f, 0x41, 0xa9, 0xc7, 0x5a, 0x26, 0x69, 0x1c, 0xcd, 0x84, 0xf3, 0x1a,
-	0xf0, 0xea, 0xd5, 0x6a, 0x80, 0x84, 0xf8, 0xca, 0x50, 0xc5, 0x5d, 0x3d, 0xca, 0xa0, 0x9b, 0xcb,
-	0x81, 0xd7, 0x98, 0x16, 0x46, 0x78, 0xa8, 0xe2, 0x27, 0xa3, 0x0c, 0x9e, 0xca, 0x01, 0xd9, 0xc0,
-	0x78, 0x3e, 0xb1, 0x77, 0x21, 0x44, 0xed, 0x85, 0xce, 0x2d, 0xea, 0x5c, 0xa7, 0xf1, 0x50, 0x9b,
-	0xb5, 0x9b, 0x9b, 0x3e, 0xe4, 0x31, 0xb8, 0x29, 0xa2, 0x03, 0x95, 0xad, 0x3d, 0x84, 0xaf, 0x15,
-	0x06, 0x55, 0x99, 0x48, 0x15, 0x90, 0x55, 0xdc, 0x34, 0x30, 0xca, 0x43, 0x61, 0xa3, 0xbd, 0xd0,
-	0xb9, 0x41, 0xcb, 0xb6, 0x8b, 0x9a, 0xaa, 0xc8, 0x49, 0xc9, 0x66, 0x01, 0xaa, 0x6e, 0xa0, 0x6e,
-	0x9f, 0x08, 0x65, 0x3b, 0x16, 0xa8, 0xde, 0x23, 0xbc, 0x38, 0xa7, 0x02, 0x79, 0xfa, 0x5d, 0xd8,
-	0x28, 0x41, 0xfb, 0x9f, 0xbc, 0x3e, 0x20, 0xec, 0x97, 0x91, 0xb9, 0xd8, 0x1e, 0x1c, 0x8a, 0xad,
-	0x7d, 0x4c, 0x6c, 0x6b, 0xb9, 0xde, 0x12, 0x32, 0xd9, 0x31, 0xc6, 0x67, 0x9e, 0x21, 0x1c, 0x91,
-	0x21, 0x54, 0xcd, 0x10, 0xce, 0x2a, 0x43, 0x38, 0xb7, 0x19, 0x76, 0x7e, 0x35, 0xf0, 0x45, 0x43,
-	0x4a, 0xde, 0x22, 0xdc, 0xb4, 0x9c, 0xe4, 0x08, 0x9e, 0xbf, 0xaf, 0x0b, 0xff, 0x4e, 0x05, 0xa5,
-	0xed, 0xda, 0xba, 0xf9, 0xe6, 0xeb, 0x8f, 0xbd, 0x7a, 0x40, 0x96, 0x58, 0xe9, 0xb5, 0xe5, 0x06,
-	0xfb, 0x88, 0xf0, 0xd5, 0xc2, 0x87, 0x47, 0xd8, 0x49, 0x2d, 0x0e, 0x1d, 0x1e, 0x7f, 0xb9, 0x7a,
-	0x81, 0x43, 0xbb, 0x6f, 0xd0, 0x96, 0x09, 0x3d, 0x0e, 0x8d, 0xb9, 0x83, 0xc6, 0x5e, 0xbb, 0x87,
-	0xdd, 0x03, 0xb0, 0x50, 0x19, 0x16, 0xfe, 0x15, 0x16, 0x4e, 0x01, 0x0b, 0x33, 0x58, 0xd8, 0x5d,
-	0xa7, 0x9f, 0xc7, 0x01, 0xda, 0x1f, 0x07, 0xe8, 0xfb, 0x38, 0x40, 0xef, 0x26, 0x41, 0x6d, 0x7f,
-	0x12, 0xd4, 0xbe, 0x4d, 0x82, 0xda, 0x33, 0x77, 0x28, 0x54, 0xff, 0x05, 0x4d, 0x04, 0x7b, 0x65,
-	0x0d, 0x7b, 0x4d, 0xf3, 0x9f, 0x58, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x53, 0xa5, 0xac, 0xfc,
-	0xe8, 0x06, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0x19, 0x90, 0x1a, 0x06, 0xf5, 0x30, 0x7a, 0x58, 0x16, 0xb2, 0x69, 0xaa, 0xd1, 0x6a,
+	0xc2, 0x0c, 0x2d, 0xd1, 0xb3, 0xe5, 0x00, 0x57, 0x5d, 0xf1, 0xe2, 0xa5, 0xd9, 0xda, 0x37, 0xcb,
+	0xc6, 0x76, 0x67, 0xd9, 0x99, 0xaa, 0x85, 0xf4, 0xa2, 0x5f, 0xc0, 0x04, 0xa3, 0x1f, 0xc1, 0x68,
+	0x3c, 0xfa, 0x21, 0x3c, 0x12, 0xbd, 0x78, 0x34, 0xad, 0xf1, 0x73, 0x98, 0xce, 0x4c, 0x5b, 0xb6,
+	0x2e, 0xed, 0x22, 0x90, 0x78, 0x6a, 0x77, 0xfb, 0x3c, 0xef, 0xfb, 0x7b, 0x9f, 0xf9, 0x93, 0xe2,
+	0xfc, 0x53, 0x2e, 0x9a, 0x5c, 0x30, 0xaf, 0x25, 0x77, 0xf6, 0xd8, 0xf3, 0x52, 0x0d, 0xa4, 0x57,
+	0x62, 0xbb, 0x2d, 0x88, 0xdb, 0x34, 0x8a, 0xb9, 0xe4, 0xe4, 0x9a, 0x56, 0x50, 0xa5, 0xa0, 0x46,
+	0x61, 0xaf, 0xf8, 0x9c, 0xfb, 0x0d, 0x60, 0x5e, 0x14, 0x30, 0x2f, 0x0c, 0xb9, 0xf4, 0x64, 0xc0,
+	0x43, 0xa1, 0x3d, 0xf6, 0x1d, 0x53, 0xb5, 0xe6, 0x09, 0xd0, 0xc5, 0x86, 0xa5, 0x23, 0xcf, 0x0f,
+	0x42, 0x25, 0x36, 0xda, 0x74, 0x02, 0xdd, 0x4d, 0x2b, 0x96, 0xb4, 0xa2, 0xaa, 0x9e, 0x98, 0x7e,
+	0xd0, 0x3f, 0x15, 0x7e, 0x23, 0x4c, 0x1e, 0xf6, 0xeb, 0x6f, 0xc5, 0x5e, 0x28, 0x85, 0x0b, 0xbb,
+	0x2d, 0x10, 0x92, 0x94, 0xf1, 0x45, 0xbf, 0xff, 0x02, 0x62, 0x0b, 0xe5, 0x51, 0x71, 0x61, 0xc3,
+	0xfa, 0xf6, 0x65, 0x75, 0x30, 0x48, 0xa5, 0x5e, 0x8f, 0x41, 0x88, 0x47, 0x32, 0x0e, 0x42, 0xdf,
+	0x1d, 0x08, 0x47, 0x1e, 0xb0, 0x66, 0xb3, 0x79, 0x80, 0xe4, 0xf1, 0xa5, 0xa6, 0xf0, 0xab, 0xb2,
+	0x1d, 0x41, 0xb5, 0x15, 0x37, 0xac, 0xb9, 0xbe, 0xd1, 0xc5, 0x4d, 0xe1, 0x6f, 0xb7, 0x23, 0x78,
+	0x1c, 0x37, 0xc8, 0x26, 0xc6, 0xa3, 0x89, 0xad, 0x0b, 0x79, 0x54, 0x5c, 0x2c, 0xdf, 0xa4, 0xa6,
+	0x6a, 0x3f, 0x1e, 0xaa, 0xb3, 0x36, 0x73, 0xd3, 0x07, 0x9e, 0x0f, 0x66, 0x0a, 0xf7, 0x88, 0xb3,
+	0x70, 0x80, 0xf0, 0xd5, 0xc4, 0xa0, 0x22, 0xe2, 0xa1, 0x00, 0xb2, 0x8e, 0x73, 0x0a, 0x46, 0x58,
+	0x28, 0x3f, 0x57, 0x5c, 0x2c, 0x2f, 0xd3, 0xb4, 0xe5, 0xa2, 0xca, 0xe5, 0x1a, 0x29, 0xd9, 0x4a,
+	0x40, 0xcd, 0x2a, 0xa8, 0x5b, 0x53, 0xa1, 0x74, 0xc7, 0x04, 0xd5, 0x7b, 0x84, 0x97, 0x46, 0x54,
+	0x10, 0x9f, 0x7e, 0x15, 0x36, 0x53, 0xd0, 0xfe, 0x25, 0xaf, 0x0f, 0x08, 0xdb, 0x69, 0x64, 0x26,
+	0xb6, 0xfb, 0x63, 0xb1, 0x15, 0x27, 0xc4, 0x56, 0x69, 0xc9, 0x1d, 0x1e, 0x07, 0x7b, 0xaa, 0xf0,
+	0xb9, 0x67, 0x08, 0xc7, 0x64, 0x08, 0x59, 0x33, 0x84, 0xf3, 0xca, 0x10, 0xfe, 0xdf, 0x0c, 0xdf,
+	0x26, 0x48, 0xb7, 0xa1, 0x19, 0x35, 0x3c, 0x09, 0xc3, 0x10, 0x29, 0x9e, 0xe7, 0x2f, 0xc2, 0x0c,
+	0xdb, 0x50, 0xcb, 0xce, 0x2c, 0xc0, 0x8f, 0x08, 0x2f, 0xa7, 0x62, 0x99, 0x04, 0x2b, 0x78, 0x41,
+	0x0e, 0x5e, 0x9a, 0x10, 0xaf, 0x4f, 0x08, 0x71, 0x50, 0xc0, 0x1d, 0xb9, 0xce, 0x2c, 0xc2, 0xf2,
+	0xbb, 0x79, 0x3c, 0xaf, 0x58, 0xc9, 0x6b, 0x84, 0x73, 0x7a, 0xa9, 0xc9, 0x31, 0x4b, 0xfa, 0xf7,
+	0x8d, 0x6b, 0xdf, 0xce, 0xa0, 0xd4, 0x5d, 0x0b, 0x37, 0x5e, 0x7d, 0xff, 0x75, 0x30, 0xeb, 0x90,
+	0x15, 0x96, 0x7a, 0xf3, 0x9b, 0xbd, 0xf1, 0x09, 0xe1, 0xcb, 0x89, 0xb3, 0x4b, 0xd8, 0xb4, 0x16,
+	0x63, 0xf7, 0x8f, 0xbd, 0x96, 0xdd, 0x60, 0xd0, 0xee, 0x29, 0xb4, 0x35, 0x42, 0x27, 0xa1, 0xe9,
+	0x0f, 0x88, 0xd9, 0xbe, 0xf9, 0xd2, 0x39, 0x02, 0x0b, 0x99, 0x61, 0xe1, 0xa4, 0xb0, 0x70, 0x0a,
+	0x58, 0x18, 0xc0, 0x42, 0x87, 0x7c, 0x46, 0xf8, 0x4a, 0x72, 0x43, 0x92, 0xa9, 0xcd, 0xc7, 0x8f,
+	0x94, 0x5d, 0x3a, 0x81, 0xc3, 0xf0, 0xde, 0x55, 0xbc, 0x8c, 0xac, 0x4e, 0xe0, 0xad, 0x0e, 0x77,
+	0x36, 0xdb, 0x57, 0x67, 0xb1, 0xb3, 0x41, 0xbf, 0x76, 0x1d, 0x74, 0xd8, 0x75, 0xd0, 0xcf, 0xae,
+	0x83, 0xde, 0xf4, 0x9c, 0x99, 0xc3, 0x9e, 0x33, 0xf3, 0xa3, 0xe7, 0xcc, 0x3c, 0x31, 0x67, 0x58,
+	0xd4, 0x9f, 0xd1, 0x80, 0xb3, 0x97, 0xba, 0x5e, 0x2d, 0xa7, 0xfe, 0x19, 0xac, 0xff, 0x19, 0x00,
+	0xb7, 0x3a, 0x5f, 0x28, 0xda, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -444,6 +564,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// GrantTemplates returns the grant templates stored by an owner.
+	//
+	// Since x/authz v1.0.0
+	GrantTemplates(ctx context.Context, in *QueryGrantTemplatesRequest, opts ...grpc.CallOption) (*QueryGrantTemplatesResponse, error)
 }
 
 type queryClient struct {
@@ -481,6 +605,15 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) GrantTemplates(ctx context.Context, in *QueryGrantTemplatesRequest, opts ...grpc.CallOption) (*QueryGrantTemplatesResponse, error) {
+	out := new(QueryGrantTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/GrantTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -493,6 +626,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// GrantTemplates returns the grant templates stored by an owner.
+	//
+	// Since x/authz v1.0.0
+	GrantTemplates(context.Context, *QueryGrantTemplatesRequest) (*QueryGrantTemplatesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -508,6 +645,9 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) GrantTemplates(ctx context.Context, req *QueryGrantTemplatesRequest) (*QueryGrantTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantTemplates not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -567,6 +707,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GrantTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/GrantTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantTemplates(ctx, req.(*QueryGrantTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -583,6 +741,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "GrantTemplates",
+			Handler:    _Query_GrantTemplates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -875,6 +1037,97 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGrantTemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantTemplatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantTemplatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantTemplatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantTemplatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1002,6 +1255,42 @@ func (m *QueryGranteeGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGrantTemplatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantTemplatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1786,6 +2075,244 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGrantTemplatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantTemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGrantTemplatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantTemplatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, &GrantTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,6 +26,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &grantA)
 			cdc.MustUnmarshal(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], keeper.GrantTemplatePrefix):
+			var templateA, templateB authz.GrantTemplate
+			cdc.MustUnmarshal(kvA.Value, &templateA)
+			cdc.MustUnmarshal(kvB.Value, &templateB)
+			return fmt.Sprintf("%v\n%v", templateA, templateB)
 		default:
 			panic(fmt.Sprintf("invalid authz key %X", kvA.Key))
 		}
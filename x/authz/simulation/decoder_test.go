@@ -31,9 +31,14 @@ func TestDecodeStore(t *testing.T) {
 	grant, _ := authz.NewGrant(now, sendAuthz, &e)
 	grantBz, err := encCfg.Codec.Marshal(&grant)
 	require.NoError(t, err)
+	template, err := authz.NewGrantTemplate("owner", "role", []authz.Authorization{sendAuthz})
+	require.NoError(t, err)
+	templateBz, err := encCfg.Codec.Marshal(&template)
+	require.NoError(t, err)
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: keeper.GrantKey, Value: grantBz},
+			{Key: keeper.GrantTemplatePrefix, Value: templateBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -44,6 +49,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", false, fmt.Sprintf("%v\n%v", grant, grant)},
+		{"GrantTemplate", false, fmt.Sprintf("%v\n%v", template, template)},
 		{"other", true, ""},
 	}
 
@@ -27,3 +27,19 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) SendAndCall(ctx context.Context, req *bank.MsgSendAndCall) (*bank.MsgSendAndCallResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CreateSwap(ctx context.Context, req *bank.MsgCreateSwap) (*bank.MsgCreateSwapResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) AcceptSwap(ctx context.Context, req *bank.MsgAcceptSwap) (*bank.MsgAcceptSwapResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CancelSwap(ctx context.Context, req *bank.MsgCancelSwap) (*bank.MsgCancelSwapResponse, error) {
+	return nil, nil
+}
@@ -14,7 +14,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: bankv1beta1.Query_ServiceDesc.ServiceName,
+			Service:              bankv1beta1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod:      "Balance",
@@ -87,6 +88,38 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms"}},
 				},
+				{
+					RpcMethod:      "CirculatingSupplyOf",
+					Use:            "circulating-supply [denom]",
+					Short:          "Query the total and circulating supply of a coin",
+					Long:           "Query the total and circulating supply of a coin. The circulating supply excludes the balances of the non-circulating module accounts configured on the bank module.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "Swap",
+					Use:            "swap [id]",
+					Short:          "Query an open atomic swap by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Swaps",
+					Use:       "swaps",
+					Short:     "Query all the open atomic swaps",
+				},
+			},
+			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
+				"node": {
+					Service: bankv1beta1.Node_ServiceDesc.ServiceName,
+					RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+						{
+							RpcMethod:      "BalanceAtHeight",
+							Use:            "balance-at-height [address] [denom] [height]",
+							Short:          "Query an account balance by address and denom at a past height",
+							Long:           "Query an account balance by address and denom at the end of the block of the given height, from the balance history recorded by the queried node. The result is local to the node.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}, {ProtoField: "height"}},
+						},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -103,12 +136,47 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 Note: multiple coins can be send by space separated.`,
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_address"}, {ProtoField: "to_address"}, {ProtoField: "amount", Varargs: true}},
 				},
+				{
+					RpcMethod: "SendAndCall",
+					Use:       "send-and-call [from_key_or_address] [to_address] [amount] [msg-json-file]...",
+					Short:     "Send funds to an account and execute follow-up messages, signed by the sender, atomically.",
+					Long: `Send funds from one account to another and execute follow-up messages dispatched to the
+recipient, for instance executing the contract account the funds are sent to, in the same atomic
+operation. If any of the messages fails, the transfer is reverted. The sender must be the only
+signer of the follow-up messages, and each of them must name the recipient in an address field.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].`,
+					Example:        fmt.Sprintf(`%s tx bank send-and-call cosmos1.. cosmos1.. 1000stake execute.json`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_address"}, {ProtoField: "to_address"}, {ProtoField: "amount"}, {ProtoField: "msgs", Varargs: true}},
+				},
 				{
 					RpcMethod:      "Burn",
 					Use:            "burn [from_key_or_address] [amount]",
 					Short:          "Burns the amount specified from the given account.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_address"}, {ProtoField: "amount", Varargs: true}},
 				},
+				{
+					RpcMethod: "CreateSwap",
+					Use:       "create-swap [creator_key_or_address] [offer] [ask] [expiry]",
+					Short:     "Offer coins, held in escrow, in exchange for other coins until the expiry time.",
+					Long: `Create an atomic swap of the offered coins for the asked coins. The offered coins are held
+in escrow until the swap is accepted, before the expiry time, or cancelled by its creator.
+Any account can accept the swap, unless a counterparty is set.
+Note, the '--from' flag is ignored as it is implied from [creator_key_or_address].`,
+					Example:        fmt.Sprintf(`%s tx bank create-swap cosmos1.. 1000stake 500atom 2024-12-31T00:00:00Z --counterparty cosmos1..`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}, {ProtoField: "offer"}, {ProtoField: "ask"}, {ProtoField: "expiry"}},
+				},
+				{
+					RpcMethod:      "AcceptSwap",
+					Use:            "accept-swap [acceptor_key_or_address] [id]",
+					Short:          "Accept an atomic swap, paying the asked coins in exchange for the offered coins.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "acceptor"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "CancelSwap",
+					Use:            "cancel-swap [creator_key_or_address] [id]",
+					Short:          "Cancel an atomic swap, refunding the offered coins to its creator.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}, {ProtoField: "id"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
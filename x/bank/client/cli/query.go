@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/bank/client/supplyproof"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewQueryCmd returns a root CLI command handler for the x/bank query commands
+// not generated by AutoCLI.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		NewSupplyProofCmd(),
+	)
+
+	return queryCmd
+}
+
+// NewSupplyProofCmd returns a CLI command handler querying the supply of a coin
+// with a merkle proof of it.
+func NewSupplyProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-proof [denom] [height]",
+		Short: "Query the supply of a coin with a merkle proof of it against the app hash",
+		Long: `Query the total and circulating supply of a coin at a height, with a merkle proof of the total supply
+against the app hash committing the state of the height, read from the signed header of the next block.
+The header and the proof are served by the same node, so the proof must be verified against a trusted
+validator set before relying on the supply. The height defaults to the one before the latest block.`,
+		Example: fmt.Sprintf("%s query bank supply-proof stake", version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			if len(args) > 1 {
+				if height, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return fmt.Errorf("invalid height: %w", err)
+				}
+			}
+
+			proof, err := supplyproof.Query(cmd.Context(), clientCtx, args[0], height)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(proof)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,238 @@
+// Package supplyproof serves the supply of a coin with a merkle proof of it,
+// allowing exchanges and aggregators to verify the supply reported by a node
+// against the app hash signed by the validators.
+//
+// The Query service of the module runs on a branch of the state, without
+// access to the proofs of the store, so the proofs are built by the client from
+// an ABCI store query and the signed header of the block committing the state.
+// Both are served by the queried node, so a proof only proves the supply once
+// it is verified against a validator set trusted by the caller, with
+// SupplyProof.Verify.
+package supplyproof
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SupplyProof is the supply of a coin at a height, with a merkle proof of the
+// supply key of the coin against the app hash committing the state of the
+// height.
+type SupplyProof struct {
+	// Supply is the total supply of the coin, proven by Proof.
+	Supply sdk.Coin `json:"supply"`
+	// CirculatingSupply is the supply of the coin less the balances of the
+	// non-circulating addresses, queried at the same height. It is not proven.
+	CirculatingSupply sdk.Coin `json:"circulating_supply"`
+	Height            int64    `json:"height"`
+	// AppHash is the app hash committing the state of the height, found in the
+	// header of the next block.
+	AppHash cmtbytes.HexBytes `json:"app_hash"`
+	// Key is the key of the supply of the coin in the bank store.
+	Key cmtbytes.HexBytes `json:"key"`
+	// Proof is the proof of the supply, or of its absence if the coin has no
+	// supply, under the key path /bank/Key.
+	Proof *cmtcrypto.ProofOps `json:"proof"`
+	// SignedHeader is the header of the next block, holding AppHash, with the
+	// commit of the validators signing it.
+	SignedHeader *cmttypes.SignedHeader `json:"signed_header"`
+}
+
+// Query returns the supply of the denom at the height, with its proof. The app
+// hash committing the state of a height is found in the header of the next
+// block, so the height defaults to the one before the latest block.
+//
+// The proof is checked against the app hash of the header, but the header is
+// served by the same node as the proof: the returned supply is not proven until
+// the proof is verified with Verify.
+func Query(ctx context.Context, clientCtx client.Context, denom string, height int64) (*SupplyProof, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	if height == 0 {
+		nodeStatus, err := node.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		height = nodeStatus.SyncInfo.LatestBlockHeight - 1
+	}
+
+	key, err := collections.EncodeKeyWithPrefix(types.SupplyKey, collections.StringKey, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nextHeight := height + 1
+	commit, err := node.Commit(ctx, &nextHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyProof(res.ProofOps, commit.AppHash, key, res.Value); err != nil {
+		return nil, fmt.Errorf("invalid supply proof at height %d: %w", height, err)
+	}
+
+	supply := math.ZeroInt()
+	if res.Value != nil {
+		if supply, err = sdk.IntValue.Decode(res.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	circulating, err := types.NewQueryClient(clientCtx.WithHeight(height)).CirculatingSupplyOf(ctx, &types.QueryCirculatingSupplyOfRequest{Denom: denom})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SupplyProof{
+		Supply:            sdk.NewCoin(denom, supply),
+		CirculatingSupply: circulating.CirculatingSupply,
+		Height:            height,
+		AppHash:           commit.AppHash,
+		Key:               key,
+		Proof:             res.ProofOps,
+		SignedHeader:      &commit.SignedHeader,
+	}, nil
+}
+
+// Verify verifies the supply proof against the validator set of the next block,
+// as trusted by the caller, for instance through a light client. It checks that
+// validators holding more than two thirds of the voting power signed the header
+// holding the app hash, and that the proof of the supply matches the app hash.
+func (p *SupplyProof) Verify(chainID string, trustedVals *cmttypes.ValidatorSet) error {
+	if trustedVals == nil {
+		return errors.New("missing trusted validator set")
+	}
+	if p.SignedHeader == nil {
+		return errors.New("missing signed header")
+	}
+	if err := p.SignedHeader.ValidateBasic(chainID); err != nil {
+		return fmt.Errorf("invalid signed header: %w", err)
+	}
+
+	header, commit := p.SignedHeader.Header, p.SignedHeader.Commit
+	if header.Height != p.Height+1 {
+		return fmt.Errorf("header of height %d does not commit the state of height %d", header.Height, p.Height)
+	}
+	if !bytes.Equal(header.ValidatorsHash, trustedVals.Hash()) {
+		return fmt.Errorf("the trusted validator set is not the validator set of height %d", header.Height)
+	}
+	if err := trustedVals.VerifyCommitLight(chainID, commit.BlockID, header.Height, commit); err != nil {
+		return fmt.Errorf("invalid commit of height %d: %w", header.Height, err)
+	}
+	if !bytes.Equal(header.AppHash, p.AppHash) {
+		return fmt.Errorf("app hash %s does not match the signed header of height %d", p.AppHash, header.Height)
+	}
+
+	// the key is derived from the denom, so that the proof of another coin is
+	// not accepted
+	key, err := collections.EncodeKeyWithPrefix(types.SupplyKey, collections.StringKey, p.Supply.Denom)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(key, p.Key) {
+		return fmt.Errorf("key %s is not the supply key of %s", p.Key, p.Supply.Denom)
+	}
+
+	// the supply of a coin is removed from the store when it is zero
+	var value []byte
+	if !p.Supply.Amount.IsZero() {
+		if value, err = sdk.IntValue.Encode(p.Supply.Amount); err != nil {
+			return err
+		}
+	}
+	if err := verifyProof(p.Proof, header.AppHash, key, value); err != nil {
+		return fmt.Errorf("invalid supply proof at height %d: %w", p.Height, err)
+	}
+
+	return nil
+}
+
+// verifyProof verifies the proof of the value of the key in the bank store, or
+// of its absence if the value is nil, against the app hash.
+func verifyProof(proof *cmtcrypto.ProofOps, appHash, key, value []byte) error {
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+	if value == nil {
+		return rootmulti.DefaultProofRuntime().VerifyAbsence(proof, appHash, keyPath)
+	}
+	return rootmulti.DefaultProofRuntime().VerifyValue(proof, appHash, keyPath, value)
+}
+
+// RegisterHandler registers the GET /cosmos/bank/v1beta1/supply/by_denom/proof
+// route, serving the supply proof of the denom query parameter at the optional
+// height query parameter.
+func RegisterHandler(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	pattern := gwruntime.MustPattern(gwruntime.NewPattern(1,
+		[]int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 2, 5},
+		[]string{"cosmos", "bank", "v1beta1", "supply", "by_denom", "proof"},
+		"", gwruntime.AssumeColonVerbOpt(false)))
+
+	mux.Handle(http.MethodGet, pattern, func(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+		_, outboundMarshaler := gwruntime.MarshalerForRequest(mux, req)
+
+		var height int64
+		if h := req.URL.Query().Get("height"); h != "" {
+			var err error
+			if height, err = strconv.ParseInt(h, 10, 64); err != nil {
+				gwruntime.HTTPError(req.Context(), mux, outboundMarshaler, w, req, status.Errorf(codes.InvalidArgument, "invalid height: %s", err))
+				return
+			}
+		}
+
+		proof, err := Query(req.Context(), clientCtx, req.URL.Query().Get("denom"), height)
+		if err != nil {
+			gwruntime.HTTPError(req.Context(), mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		bz, err := json.Marshal(proof)
+		if err != nil {
+			gwruntime.HTTPError(req.Context(), mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	})
+}
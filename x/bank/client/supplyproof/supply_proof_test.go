@@ -0,0 +1,176 @@
+package supplyproof_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank"
+	"cosmossdk.io/x/bank/client/supplyproof"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const chainID = "test-chain"
+
+// node serves the store queries from a multistore and the blocks committing
+// its versions.
+type node struct {
+	clitestutil.MockCometRPC
+
+	cms     storetypes.CommitMultiStore
+	headers map[int64]cmttypes.SignedHeader
+	respBz  []byte
+}
+
+func (n node) ABCIQueryWithOptions(_ context.Context, path string, data cmtbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	if !strings.HasPrefix(path, "/store/") {
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: n.respBz, Height: opts.Height}}, nil
+	}
+
+	res, err := n.cms.(storetypes.Queryable).Query(&storetypes.RequestQuery{
+		Path:   strings.TrimPrefix(path, "/store"),
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery(*res)}, nil
+}
+
+func (n node) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: n.cms.LatestVersion()}}, nil
+}
+
+func (n node) Commit(_ context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	return &coretypes.ResultCommit{SignedHeader: n.headers[*height]}, nil
+}
+
+// signHeader returns the header of the block of the height, holding the app
+// hash of the previous one, signed by the validators.
+func signHeader(t *testing.T, vals *cmttypes.ValidatorSet, privVals []cmttypes.PrivValidator, height int64, appHash []byte) cmttypes.SignedHeader {
+	t.Helper()
+
+	header := &cmttypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            chainID,
+		Height:             height,
+		Time:               time.Now(),
+		ValidatorsHash:     vals.Hash(),
+		NextValidatorsHash: vals.Hash(),
+		AppHash:            appHash,
+		ProposerAddress:    vals.Proposer.Address,
+	}
+	blockID := cmttypes.BlockID{Hash: header.Hash(), PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum(nil)}}
+	voteSet := cmttypes.NewVoteSet(chainID, height, 0, cmtproto.PrecommitType, vals)
+	extCommit, err := cmttypes.MakeExtCommit(blockID, height, 0, voteSet, privVals, time.Now(), false)
+	require.NoError(t, err)
+
+	return cmttypes.SignedHeader{Header: header, Commit: extCommit.ToCommit()}
+}
+
+func TestQuery(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, bank.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	cms := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	supplyKey, err := collections.EncodeKeyWithPrefix(types.SupplyKey, collections.StringKey, "stake")
+	require.NoError(t, err)
+
+	vals, privVals := cmttypes.RandValidatorSet(4, 10)
+	appHashes := make(map[int64][]byte)
+	headers := make(map[int64]cmttypes.SignedHeader)
+	for height := int64(1); height <= 3; height++ {
+		bz, err := sdk.IntValue.Encode(math.NewInt(1000 * height))
+		require.NoError(t, err)
+		cms.GetKVStore(key).Set(supplyKey, bz)
+		appHashes[height] = cms.Commit().Hash
+		headers[height+1] = signHeader(t, vals, privVals, height+1, appHashes[height])
+	}
+
+	respBz, err := encCfg.Codec.Marshal(&types.QueryCirculatingSupplyOfResponse{
+		Supply:            sdk.NewInt64Coin("stake", 2000),
+		CirculatingSupply: sdk.NewInt64Coin("stake", 1500),
+	})
+	require.NoError(t, err)
+	n := node{cms: cms, headers: headers, respBz: respBz}
+	clientCtx := client.Context{}.WithClient(n).WithCodec(encCfg.Codec).WithInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	// the height defaults to the one before the latest block
+	proof, err := supplyproof.Query(context.Background(), clientCtx, "stake", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), proof.Height)
+	require.Equal(t, sdk.NewInt64Coin("stake", 2000), proof.Supply)
+	require.Equal(t, sdk.NewInt64Coin("stake", 1500), proof.CirculatingSupply)
+	require.Equal(t, cmtbytes.HexBytes(appHashes[2]), proof.AppHash)
+	require.Equal(t, cmtbytes.HexBytes(supplyKey), proof.Key)
+	require.NotEmpty(t, proof.Proof.Ops)
+	require.NoError(t, proof.Verify(chainID, vals))
+
+	proof, err = supplyproof.Query(context.Background(), clientCtx, "stake", 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 1000), proof.Supply)
+	require.NoError(t, proof.Verify(chainID, vals))
+
+	// the absence of supply is proven too
+	proof, err = supplyproof.Query(context.Background(), clientCtx, "atom", 2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("atom", 0), proof.Supply)
+	require.NoError(t, proof.Verify(chainID, vals))
+
+	// a proof not matching the app hash is rejected
+	n.headers = map[int64]cmttypes.SignedHeader{3: headers[2]}
+	_, err = supplyproof.Query(context.Background(), clientCtx.WithClient(n), "stake", 2)
+	require.ErrorContains(t, err, "invalid supply proof at height 2")
+
+	// a proof is only verified against the trusted validators
+	proof, err = supplyproof.Query(context.Background(), clientCtx, "stake", 2)
+	require.NoError(t, err)
+	require.ErrorContains(t, proof.Verify("other-chain", vals), "belongs to another chain")
+	otherVals, otherPrivVals := cmttypes.RandValidatorSet(4, 10)
+	require.ErrorContains(t, proof.Verify(chainID, otherVals), "is not the validator set of height 3")
+
+	// a header signed by other validators is rejected
+	forged := *proof
+	forgedHeader := signHeader(t, otherVals, otherPrivVals, 3, appHashes[2])
+	forged.SignedHeader = &forgedHeader
+	require.ErrorContains(t, forged.Verify(chainID, vals), "is not the validator set of height 3")
+
+	// a supply not matching the proof is rejected
+	forged = *proof
+	forged.Supply = sdk.NewInt64Coin("stake", 5000)
+	require.ErrorContains(t, forged.Verify(chainID, vals), "invalid supply proof at height 2")
+	forged.Supply = sdk.NewInt64Coin("atom", 2000)
+	require.ErrorContains(t, forged.Verify(chainID, vals), "is not the supply key of atom")
+
+	_, err = supplyproof.Query(context.Background(), clientCtx, "!", 2)
+	require.ErrorContains(t, err, "invalid denom")
+}
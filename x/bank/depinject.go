@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -64,6 +65,13 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		}
 	}
 
+	// the swap escrow only receives coins through the swaps
+	escrowStr, err := in.AccountKeeper.AddressCodec().BytesToString(types.SwapEscrowAddress)
+	if err != nil {
+		panic(err)
+	}
+	blockedAddresses[escrowStr] = true
+
 	// default to governance authority if not provided
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 	if in.Config.Authority != "" {
@@ -82,6 +90,12 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		blockedAddresses,
 		authStr,
 	)
+	nonCirculatingAddrs := make([]sdk.AccAddress, len(in.Config.NonCirculatingModuleAccounts))
+	for i, moduleName := range in.Config.NonCirculatingModuleAccounts {
+		nonCirculatingAddrs[i] = authtypes.NewModuleAddress(moduleName)
+	}
+	bankKeeper = bankKeeper.WithNonCirculatingAddresses(nonCirculatingAddrs...)
+
 	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper)
 
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VersionedStore is a store keeping the state of every committed version, as
+// implemented by the store/v2 state storage (storage.StorageStore).
+type VersionedStore interface {
+	Get(storeKey []byte, version uint64, key []byte) ([]byte, error)
+	GetLatestVersion() (uint64, error)
+	ApplyChangeset(version uint64, cs *corestore.Changeset) error
+}
+
+var (
+	// balanceHistoryKey is the actor under which the balances are recorded in
+	// the versioned store.
+	balanceHistoryKey = []byte(types.StoreKey)
+	// balanceHistoryMetaKey is the actor of the metadata of the history.
+	balanceHistoryMetaKey = []byte(types.StoreKey + "_history")
+	// balanceHistoryStartKey is the key of the first height of the history.
+	balanceHistoryStartKey = []byte("start")
+)
+
+var _ storetypes.ABCIListener = (*BalanceHistory)(nil)
+
+// BalanceHistory is a node-local record of the balances of every committed
+// block, kept in a versioned store, to be queried through the
+// Node/BalanceAtHeight gRPC method. It is not part of the state: it is an ABCI
+// listener writing the bank balance changes of each committed block to the
+// store.
+//
+// The history is wired by the application, which adds it to the ABCI listeners
+// of the app, adds the bank store key to the listened keys of its commit multi
+// store, and registers the Node service serving it.
+type BalanceHistory struct {
+	k     BaseKeeper
+	store VersionedStore
+}
+
+// NewBalanceHistory returns a balance history recording the balances of the
+// keeper in the given versioned store. The history starts with the state of
+// the first block committed once it is added to the ABCI listeners of the app,
+// or resumes from the latest version of the store. Which past heights can be
+// queried is defined by the pruning of the store.
+func NewBalanceHistory(k BaseKeeper, store VersionedStore) *BalanceHistory {
+	return &BalanceHistory{k: k, store: store}
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface.
+func (l *BalanceHistory) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface.
+func (l *BalanceHistory) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height <= 0 {
+		return nil
+	}
+	version := uint64(height)
+
+	latest, err := l.store.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case latest == 0:
+		return l.snapshot(ctx, version)
+	case latest >= version:
+		// the block was already recorded, e.g. when replaying blocks.
+		return nil
+	case latest+1 < version:
+		return fmt.Errorf("balance history is missing heights %d to %d", latest+1, version-1)
+	}
+
+	cs := corestore.NewChangeset()
+	for _, pair := range changeSet {
+		if pair.StoreKey != types.StoreKey || !bytes.HasPrefix(pair.Key, types.BalancesPrefix) {
+			continue
+		}
+		cs.Add(balanceHistoryKey, pair.Key, pair.Value, pair.Delete)
+	}
+
+	// the changeset is applied even when empty, so that the latest version of
+	// the store follows the chain.
+	return l.store.ApplyChangeset(version, cs)
+}
+
+// snapshot records all the balances at the given height, which starts the
+// history.
+func (l *BalanceHistory) snapshot(ctx context.Context, version uint64) error {
+	kc, vc := l.k.Balances.KeyCodec(), l.k.Balances.ValueCodec()
+
+	cs := corestore.NewChangeset()
+	err := l.k.Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], value math.Int) (stop bool, err error) {
+		bz, err := collections.EncodeKeyWithPrefix(types.BalancesPrefix, kc, key)
+		if err != nil {
+			return true, err
+		}
+		valueBz, err := vc.Encode(value)
+		if err != nil {
+			return true, err
+		}
+
+		cs.Add(balanceHistoryKey, bz, valueBz, false)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	cs.Add(balanceHistoryMetaKey, balanceHistoryStartKey, sdk.Uint64ToBigEndian(version), false)
+	return l.store.ApplyChangeset(version, cs)
+}
+
+var (
+	// errBalanceHistoryNotEnabled is returned when querying the balances at a
+	// past height on a node which does not record them.
+	errBalanceHistoryNotEnabled = errors.New("balance history is not enabled on this node")
+	// errHeightNotRecorded is returned when querying the balances at a height
+	// outside of the recorded history.
+	errHeightNotRecorded = errors.New("balances at this height are not recorded on this node")
+)
+
+// BalanceAtHeight returns the balance of the address in the given denom at the
+// end of the block of the given height.
+func (l *BalanceHistory) BalanceAtHeight(addr sdk.AccAddress, denom string, height uint64) (sdk.Coin, error) {
+	latest, err := l.store.GetLatestVersion()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if latest == 0 {
+		return sdk.Coin{}, fmt.Errorf("%w: %d", errHeightNotRecorded, height)
+	}
+	startBz, err := l.store.Get(balanceHistoryMetaKey, latest, balanceHistoryStartKey)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if height > latest || len(startBz) == 0 || height < sdk.BigEndianToUint64(startBz) {
+		return sdk.Coin{}, fmt.Errorf("%w: %d", errHeightNotRecorded, height)
+	}
+
+	key, err := collections.EncodeKeyWithPrefix(types.BalancesPrefix, l.k.Balances.KeyCodec(), collections.Join(addr, denom))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	bz, err := l.store.Get(balanceHistoryKey, height, key)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if bz == nil {
+		return sdk.NewCoin(denom, math.ZeroInt()), nil
+	}
+
+	amount, err := l.k.Balances.ValueCodec().Decode(bz)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(denom, amount), nil
+}
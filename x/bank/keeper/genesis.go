@@ -53,7 +53,31 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
-	return nil
+
+	return k.initGenesisSwaps(ctx, genState.Swaps)
+}
+
+// initGenesisSwaps sets the open swaps, checking that the swap escrow holds
+// their offers, and resumes the swap ids after the highest one.
+func (k BaseKeeper) initGenesisSwaps(ctx context.Context, swaps []types.Swap) error {
+	var (
+		escrowed sdk.Coins
+		nextID   uint64
+	)
+	for _, swap := range swaps {
+		if err := k.BaseViewKeeper.Swaps.Set(ctx, swap.Id, swap); err != nil {
+			return err
+		}
+
+		escrowed = escrowed.Add(swap.Offer...)
+		nextID = max(nextID, swap.Id+1)
+	}
+
+	if escrowBalance := k.GetAllBalances(ctx, types.SwapEscrowAddress); !escrowBalance.IsAllGTE(escrowed) {
+		return fmt.Errorf("swap escrow balance %s does not cover the swap offers %s", escrowBalance, escrowed)
+	}
+
+	return k.SwapSequence.Set(ctx, nextID)
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -70,5 +94,11 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	rv.Swaps, err = k.GetAllSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return rv, nil
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/bank/types"
 
@@ -75,6 +77,34 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *KeeperTestSuite) TestSwapsGenesis() {
+	creator := accAddrs[0].String()
+	offer := sdk.NewCoins(sdk.NewInt64Coin("offered", 100))
+	swap := types.Swap{
+		Id:      3,
+		Creator: creator,
+		Offer:   offer,
+		Ask:     sdk.NewCoins(sdk.NewInt64Coin("asked", 50)),
+		Expiry:  time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	g := types.DefaultGenesisState()
+	g.Swaps = []types.Swap{swap}
+	suite.Require().ErrorContains(suite.bankKeeper.InitGenesis(suite.ctx, g), "does not cover the swap offers")
+
+	g.Balances = []types.Balance{{Address: types.SwapEscrowAddress.String(), Coins: offer}}
+	suite.Require().NoError(suite.bankKeeper.InitGenesis(suite.ctx, g))
+
+	// swap ids resume after the highest one
+	next, err := suite.bankKeeper.SwapSequence.Peek(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), next)
+
+	exportGenesis, err := suite.bankKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Swap{swap}, exportGenesis.Swaps)
+}
+
 func (suite *KeeperTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
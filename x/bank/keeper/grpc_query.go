@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -156,6 +157,22 @@ func (k BaseKeeper) SupplyOf(ctx context.Context, req *types.QuerySupplyOfReques
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
 
+// CirculatingSupplyOf implements the Query/CirculatingSupplyOf gRPC method
+func (k BaseKeeper) CirculatingSupplyOf(ctx context.Context, req *types.QueryCirculatingSupplyOfRequest) (*types.QueryCirculatingSupplyOfResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCirculatingSupplyOfResponse{
+		Supply:            k.GetSupply(ctx, req.Denom),
+		CirculatingSupply: k.GetCirculatingSupply(ctx, req.Denom),
+	}, nil
+}
+
 // Params implements the gRPC service handler for querying x/bank parameters.
 func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -347,3 +364,36 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// Swap implements the Query/Swap gRPC method
+func (k BaseKeeper) Swap(ctx context.Context, req *types.QuerySwapRequest) (*types.QuerySwapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	swap, err := k.BaseViewKeeper.Swaps.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "swap %d", req.Id)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySwapResponse{Swap: swap}, nil
+}
+
+// Swaps implements the Query/Swaps gRPC method
+func (k BaseKeeper) Swaps(ctx context.Context, req *types.QuerySwapsRequest) (*types.QuerySwapsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	swaps, pageRes, err := query.CollectionPaginate(ctx, k.BaseViewKeeper.Swaps, req.Pagination, func(_ uint64, swap types.Swap) (types.Swap, error) {
+		return swap, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySwapsResponse{Swaps: swaps, Pagination: pageRes}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/x/bank/testutil"
 	"cosmossdk.io/x/bank/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -326,6 +327,34 @@ func (suite *KeeperTestSuite) TestQueryTotalSupplyOf() {
 	suite.Require().Equal(sdk.NewInt64Coin("bogus", 0), res.Amount)
 }
 
+func (suite *KeeperTestSuite) TestQueryCirculatingSupplyOf() {
+	ctx := suite.ctx
+
+	supply := sdk.NewInt64Coin("test1", 4000000)
+	suite.mockMintCoins(mintAcc)
+	suite.Require().NoError(suite.bankKeeper.MintCoins(ctx, types.MintModuleName, sdk.NewCoins(supply)))
+	suite.mockSendCoinsFromModuleToAccount(mintAcc, accAddrs[0])
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.MintModuleName, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("test1", 1000))))
+
+	// without non-circulating addresses, the whole supply circulates
+	res, err := suite.queryClient.CirculatingSupplyOf(gocontext.Background(), &types.QueryCirculatingSupplyOfRequest{Denom: supply.Denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply, res.Supply)
+	suite.Require().Equal(supply, res.CirculatingSupply)
+
+	// listing an address twice does not count its balance twice
+	k := suite.bankKeeper.WithNonCirculatingAddresses(mintAcc.GetAddress(), accAddrs[1]).WithNonCirculatingAddresses(mintAcc.GetAddress())
+	queryHelper := baseapp.NewQueryServerTestHelper(sdk.UnwrapSDKContext(ctx), suite.encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	res, err = types.NewQueryClient(queryHelper).CirculatingSupplyOf(gocontext.Background(), &types.QueryCirculatingSupplyOfRequest{Denom: supply.Denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply, res.Supply)
+	suite.Require().Equal(sdk.NewInt64Coin("test1", 1000), res.CirculatingSupply)
+
+	_, err = suite.queryClient.CirculatingSupplyOf(gocontext.Background(), &types.QueryCirculatingSupplyOfRequest{})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
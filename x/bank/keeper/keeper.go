@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
@@ -58,9 +59,10 @@ type BaseKeeper struct {
 	BaseSendKeeper
 
 	ak                     types.AccountKeeper
-	cdc                    codec.BinaryCodec
+	cdc                    codec.Codec
 	environment            appmodule.Environment
 	mintCoinsRestrictionFn types.MintingRestrictionFn
+	nonCirculatingAddrs    []sdk.AccAddress
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
@@ -81,9 +83,13 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *que
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
 // by using a SendCoinsFromModuleToAccount execution.
+//
+// The codec must be a full codec.Codec, as the signers of the follow-up messages
+// of a MsgSendAndCall are read from it, and the environment must hold a router
+// service, through which those messages are executed.
 func NewBaseKeeper(
 	env appmodule.Environment,
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	ak types.AccountKeeper,
 	blockedAddrs map[string]bool,
 	authority string,
@@ -114,6 +120,19 @@ func (k BaseKeeper) WithMintCoinsRestriction(check types.MintingRestrictionFn) B
 	return k
 }
 
+// WithNonCirculatingAddresses excludes the balances of the given addresses,
+// e.g. of a community pool or of a treasury, from the circulating supply.
+func (k BaseKeeper) WithNonCirculatingAddresses(addrs ...sdk.AccAddress) BaseKeeper {
+	nonCirculatingAddrs := slices.Clone(k.nonCirculatingAddrs)
+	for _, addr := range addrs {
+		if !slices.ContainsFunc(nonCirculatingAddrs, func(a sdk.AccAddress) bool { return a.Equals(addr) }) {
+			nonCirculatingAddrs = append(nonCirculatingAddrs, addr)
+		}
+	}
+	k.nonCirculatingAddrs = nonCirculatingAddrs
+	return k
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -211,6 +230,16 @@ func (k BaseKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
 	return sdk.NewCoin(denom, amt)
 }
 
+// GetCirculatingSupply returns the supply of the denom less the balances of
+// the non-circulating addresses.
+func (k BaseKeeper) GetCirculatingSupply(ctx context.Context, denom string) sdk.Coin {
+	circulating := k.GetSupply(ctx, denom)
+	for _, addr := range k.nonCirculatingAddrs {
+		circulating = circulating.Sub(k.GetBalance(ctx, addr, denom))
+	}
+	return circulating
+}
+
 // HasSupply checks if the supply coin exists in store.
 func (k BaseKeeper) HasSupply(ctx context.Context, denom string) bool {
 	has, err := k.Supply.Has(ctx, denom)
@@ -132,7 +132,16 @@ func (suite *KeeperTestSuite) SetupTest() {
 	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
 
-	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	baseApp := baseapp.NewBaseApp(
+		"bank",
+		log.NewNopLogger(),
+		testCtx.DB,
+		encCfg.TxConfig.TxDecoder(),
+	)
+	baseApp.SetCMS(testCtx.CMS)
+	baseApp.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger(), runtime.EnvWithRouterService(baseApp.GRPCQueryRouter(), baseApp.MsgServiceRouter()))
 
 	// gomock initializations
 	ctrl := gomock.NewController(suite.T())
@@ -156,6 +165,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	suite.queryClient = queryClient
 	suite.msgServer = keeper.NewMsgServerImpl(suite.bankKeeper)
+	banktypes.RegisterMsgServer(baseApp.MsgServiceRouter(), suite.msgServer)
 	suite.encCfg = encCfg
 }
 
@@ -1017,6 +1027,111 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *KeeperTestSuite) TestMaxDenomsPerAccount() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	params := banktypes.DefaultParams()
+	params.MaxDenomsPerAccount = 2
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, mintAcc.Name).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[1]).Return(acc1).AnyTimes()
+
+	// module accounts are exempt from the limit
+	bazCoin := sdk.NewInt64Coin("baz", 100)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, banktypes.MintModuleName, sdk.NewCoins(newFooCoin(100), newBarCoin(100), bazCoin)))
+
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[0], sdk.NewCoins(newFooCoin(10), newBarCoin(10))))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(bazCoin)))
+
+	// a third denom exceeds the limit
+	err := suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("baz", 1)))
+	require.ErrorIs(err, banktypes.ErrMaxDenomsExceeded)
+
+	// denoms already held can still be received
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[0], sdk.NewCoins(newFooCoin(10))))
+
+	// accounts above a lowered limit keep their balances and existing denoms
+	params.MaxDenomsPerAccount = 1
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[0], sdk.NewCoins(newBarCoin(10))))
+	require.Equal(sdk.NewCoins(newFooCoin(20), newBarCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("baz", 1)))
+	require.ErrorIs(err, banktypes.ErrMaxDenomsExceeded)
+
+	// the swap escrow is exempt from the limit
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], banktypes.SwapEscrowAddress, sdk.NewCoins(newFooCoin(1), newBarCoin(1))))
+
+	// zero disables the limit
+	params.MaxDenomsPerAccount = 0
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("baz", 1))))
+}
+
+func (suite *KeeperTestSuite) TestAccountCreationFee() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	fee := sdk.NewCoins(newFooCoin(5))
+	authParams := authtypes.DefaultParams()
+	authParams.AccountCreationFee = fee
+	suite.authKeeper.EXPECT().GetParams(ctx).Return(authParams).AnyTimes()
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, mintAcc.Name).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(holderAcc.Name).Return(holderAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(true).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(ctx, gomock.Any()).Return(false).AnyTimes()
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	// no fee is charged until a collector is set
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(10))))
+	require.Equal(sdk.NewCoins(newFooCoin(90)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	suite.bankKeeper.SetAccountCreationFeeCollector(holderAcc.Name)
+
+	// an address holding a balance or an account is not charged for
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.Equal(sdk.NewCoins(newFooCoin(70)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// the sender pays the fee of a new address once, on top of the coins sent
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newFooCoin(10))))
+	require.Equal(sdk.NewCoins(newFooCoin(45)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(fee, suite.bankKeeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+
+	// each new output of a multi-send is charged for
+	input := banktypes.NewInput(accAddrs[0], sdk.NewCoins(newFooCoin(20)))
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(accAddrs[4], sdk.NewCoins(newFooCoin(10))),
+		banktypes.NewOutput(accAddrs[4], sdk.NewCoins(newFooCoin(10))),
+	}
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+	require.Equal(sdk.NewCoins(newFooCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(fee.MulInt(math.NewInt(2)), suite.bankKeeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+
+	// the send fails if the sender cannot pay the fee
+	newAddr := sdk.AccAddress([]byte("addr6_______________"))
+	err := suite.bankKeeper.SendCoins(ctx, accAddrs[0], newAddr, sdk.NewCoins(newFooCoin(20)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// module accounts are not charged
+	require.NoError(suite.bankKeeper.MintCoins(ctx, banktypes.MintModuleName, sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, newAddr, sdk.NewCoins(newFooCoin(10))))
+	require.Equal(fee.MulInt(math.NewInt(2)), suite.bankKeeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+}
+
 func (suite *KeeperTestSuite) TestSendCoinsWithRestrictions() {
 	type restrictionArgs struct {
 		ctx      context.Context
@@ -1,13 +1,19 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
+	cosmos_proto "github.com/cosmos/cosmos-proto"
 	"github.com/hashicorp/go-metrics"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/bank/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -80,6 +86,166 @@ func (k msgServer) Send(ctx context.Context, msg *types.MsgSend) (*types.MsgSend
 	return &types.MsgSendResponse{}, nil
 }
 
+func (k msgServer) SendAndCall(ctx context.Context, msg *types.MsgSendAndCall) (*types.MsgSendAndCallResponse, error) {
+	// as for Send, the address codec, the codec and the router service are only
+	// held by the BaseKeeper, the Keeper interface does not expose them.
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	from, err := base.ak.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	to, err := base.ak.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgs) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("no follow-up messages, use MsgSend instead")
+	}
+
+	if err := validateMsgs(msgs); err != nil {
+		return nil, err
+	}
+
+	for i, m := range msgs {
+		signers, msgV2, err := base.cdc.GetMsgV1Signers(m)
+		if err != nil {
+			return nil, err
+		}
+
+		// the follow-up messages are executed with the authority of the sender,
+		// they must therefore be signed by the sender only.
+		if len(signers) != 1 || !bytes.Equal(signers[0], from) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidCallSigner, "message %d (%s)", i, sdk.MsgTypeURL(m))
+		}
+
+		// the follow-up messages are dispatched to the recipient, they must
+		// therefore name it, e.g. as the account or contract they execute on.
+		if !namesAddress(msgV2, to, base.ak.AddressCodec()) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidCallTarget, "message %d (%s)", i, sdk.MsgTypeURL(m))
+		}
+	}
+
+	if _, err := k.Send(ctx, &types.MsgSend{
+		FromAddress: msg.FromAddress,
+		ToAddress:   msg.ToAddress,
+		Amount:      msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	// no need to use the branch service here, as if a message fails, the
+	// transaction, including the transfer, will be reverted
+	responses := make([]*codectypes.Any, len(msgs))
+	for i, m := range msgs {
+		res, err := base.environment.RouterService.MessageRouterService().InvokeUntyped(ctx, m)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute message %d (%s)", i, sdk.MsgTypeURL(m))
+		}
+
+		responses[i], err = codectypes.NewAnyWithValue(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSendAndCallResponse{MsgResponses: responses}, nil
+}
+
+func (k msgServer) CreateSwap(ctx context.Context, msg *types.MsgCreateSwap) (*types.MsgCreateSwapResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	creator, err := base.ak.AddressCodec().StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.Counterparty != "" {
+		if _, err := base.ak.AddressCodec().StringToBytes(msg.Counterparty); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid counterparty address: %s", err)
+		}
+		if msg.Counterparty == msg.Creator {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("counterparty cannot be the creator")
+		}
+	}
+
+	for _, coins := range []sdk.Coins{msg.Offer, msg.Ask} {
+		if !coins.IsValid() || !coins.IsAllPositive() {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, coins.String())
+		}
+
+		if err := k.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return nil, err
+		}
+	}
+
+	if !msg.Expiry.After(base.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiry %s is not in the future", msg.Expiry)
+	}
+
+	id, err := base.CreateSwap(ctx, creator, types.Swap{
+		Creator:      msg.Creator,
+		Counterparty: msg.Counterparty,
+		Offer:        msg.Offer,
+		Ask:          msg.Ask,
+		Expiry:       msg.Expiry,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSwapResponse{Id: id}, nil
+}
+
+func (k msgServer) AcceptSwap(ctx context.Context, msg *types.MsgAcceptSwap) (*types.MsgAcceptSwapResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	acceptor, err := base.ak.AddressCodec().StringToBytes(msg.Acceptor)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid acceptor address: %s", err)
+	}
+
+	if err := base.AcceptSwap(ctx, acceptor, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptSwapResponse{}, nil
+}
+
+func (k msgServer) CancelSwap(ctx context.Context, msg *types.MsgCancelSwap) (*types.MsgCancelSwapResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	creator, err := base.ak.AddressCodec().StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if err := base.CancelSwap(ctx, creator, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelSwapResponse{}, nil
+}
+
 func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*types.MsgMultiSendResponse, error) {
 	if len(msg.Inputs) == 0 {
 		return nil, types.ErrNoInputs
@@ -212,3 +378,50 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+// validateMsgs runs the stateless validation of the messages, as it would be
+// run for the messages of a transaction.
+func validateMsgs(msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		m, ok := msg.(sdk.HasValidateBasic)
+		if !ok {
+			continue
+		}
+
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return nil
+}
+
+// namesAddress reports whether one of the account address fields of the
+// message, i.e. its top-level fields with the cosmos.AddressString scalar,
+// holds the given address.
+func namesAddress(msg protov2.Message, addr []byte, addressCodec address.Codec) bool {
+	found := false
+	matches := func(v protoreflect.Value) bool {
+		bz, err := addressCodec.StringToBytes(v.String())
+		return err == nil && bytes.Equal(bz, addr)
+	}
+
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.StringKind || protov2.GetExtension(fd.Options(), cosmos_proto.E_Scalar) != "cosmos.AddressString" {
+			return true
+		}
+
+		if !fd.IsList() {
+			found = matches(v)
+			return !found
+		}
+
+		list := v.List()
+		for i := 0; i < list.Len() && !found; i++ {
+			found = matches(list.Get(i))
+		}
+		return !found
+	})
+
+	return found
+}
@@ -1,10 +1,16 @@
 package keeper_test
 
 import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
 	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var govAcc = authtypes.NewEmptyModuleAccount(banktypes.GovModuleName, authtypes.Minter)
@@ -159,6 +165,76 @@ func (suite *KeeperTestSuite) TestMsgSend() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgSendAndCall() {
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("sendableCoin", 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("sendableCoin", 30))
+	callCoins := sdk.NewCoins(sdk.NewInt64Coin("sendableCoin", 20))
+	suite.bankKeeper.SetSendEnabled(suite.ctx, origCoins.Denoms()[0], true)
+
+	suite.mockMintCoins(minterAcc)
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, minterAcc.Name, origCoins))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), minterAcc.GetAddress()).Return(minterAcc).AnyTimes()
+
+	newMsg := func(to string, msgs ...sdk.Msg) *banktypes.MsgSendAndCall {
+		msg, err := banktypes.NewMsgSendAndCall(minterAcc.GetAddress().String(), to, sendCoins, msgs)
+		suite.Require().NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgSendAndCall
+		expErrMsg string
+	}{
+		{
+			name:      "no follow-up messages",
+			input:     newMsg(baseAcc.Address),
+			expErrMsg: "no follow-up messages",
+		},
+		{
+			name:      "follow-up message not signed by the sender",
+			input:     newMsg(baseAcc.Address, banktypes.NewMsgSend(baseAcc.Address, accAddrs[1].String(), callCoins)),
+			expErrMsg: "expected sender as only signer of follow-up message",
+		},
+		{
+			name:      "follow-up message not dispatched to the recipient",
+			input:     newMsg(baseAcc.Address, banktypes.NewMsgSend(minterAcc.GetAddress().String(), accAddrs[1].String(), callCoins)),
+			expErrMsg: "expected recipient as address of follow-up message",
+		},
+		{
+			name:      "send to blocked address",
+			input:     newMsg(accAddrs[4].String(), banktypes.NewMsgSend(minterAcc.GetAddress().String(), accAddrs[4].String(), callCoins)),
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name:      "failing follow-up message",
+			input:     newMsg(baseAcc.Address, banktypes.NewMsgSend(minterAcc.GetAddress().String(), baseAcc.Address, origCoins)),
+			expErrMsg: "failed to execute message 0",
+		},
+		{
+			name:  "all good",
+			input: newMsg(baseAcc.Address, banktypes.NewMsgSend(minterAcc.GetAddress().String(), baseAcc.Address, callCoins)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := sdk.UnwrapSDKContext(suite.ctx).CacheContext()
+			res, err := suite.msgServer.SendAndCall(ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(res.MsgResponses, 1)
+			suite.Require().Equal(sendCoins.Add(callCoins...), suite.bankKeeper.GetAllBalances(ctx, baseAcc.GetAddress()))
+			suite.Require().Equal(origCoins.Sub(sendCoins...).Sub(callCoins...), suite.bankKeeper.GetAllBalances(ctx, minterAcc.GetAddress()))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgMultiSend() {
 	origDenom := "sendableCoin"
 	origCoins := sdk.NewCoins(sdk.NewInt64Coin(origDenom, 100))
@@ -421,3 +497,75 @@ func (suite *KeeperTestSuite) TestMsgBurn() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSwaps() {
+	require := suite.Require()
+	ctx := suite.ctx
+	blockTime := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+
+	offer := sdk.NewCoins(sdk.NewInt64Coin("offered", 100))
+	ask := sdk.NewCoins(sdk.NewInt64Coin("asked", 50))
+	creator, acceptor, other := minterAcc.GetAddress(), accAddrs[1], accAddrs[2]
+	suite.bankKeeper.SetSendEnabled(ctx, "offered", true)
+	suite.bankKeeper.SetSendEnabled(ctx, "asked", true)
+
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	suite.mockMintCoins(minterAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, minterAcc.Name, offer.Add(offer...).Add(ask...)))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, creator, acceptor, ask))
+
+	// invalid swaps are rejected
+	_, err := suite.msgServer.CreateSwap(ctx, banktypes.NewMsgCreateSwap(creator.String(), "", offer, ask, blockTime))
+	require.ErrorContains(err, "is not in the future")
+	_, err = suite.msgServer.CreateSwap(ctx, banktypes.NewMsgCreateSwap(creator.String(), creator.String(), offer, ask, blockTime.Add(time.Hour)))
+	require.ErrorContains(err, "counterparty cannot be the creator")
+	_, err = suite.msgServer.CreateSwap(ctx, banktypes.NewMsgCreateSwap(creator.String(), "", offer, nil, blockTime.Add(time.Hour)))
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	// the offer is held in escrow
+	res, err := suite.msgServer.CreateSwap(ctx, banktypes.NewMsgCreateSwap(creator.String(), acceptor.String(), offer, ask, blockTime.Add(time.Hour)))
+	require.NoError(err)
+	require.Equal(uint64(0), res.Id)
+	require.Equal(offer, suite.bankKeeper.GetAllBalances(ctx, banktypes.SwapEscrowAddress))
+
+	swapRes, err := suite.queryClient.Swap(ctx, &banktypes.QuerySwapRequest{Id: res.Id})
+	require.NoError(err)
+	require.Equal(acceptor.String(), swapRes.Swap.Counterparty)
+	require.Equal(offer, swapRes.Swap.Offer)
+
+	// only the counterparty can accept the swap, until its expiry
+	_, err = suite.msgServer.AcceptSwap(ctx, banktypes.NewMsgAcceptSwap(other.String(), res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.AcceptSwap(ctx, banktypes.NewMsgAcceptSwap(creator.String(), res.Id))
+	require.ErrorContains(err, "cannot accept own swap")
+	expiredCtx := sdk.UnwrapSDKContext(ctx).WithHeaderInfo(header.Info{Time: blockTime.Add(2 * time.Hour)})
+	_, err = suite.msgServer.AcceptSwap(expiredCtx, banktypes.NewMsgAcceptSwap(acceptor.String(), res.Id))
+	require.ErrorIs(err, banktypes.ErrSwapExpired)
+
+	_, err = suite.msgServer.AcceptSwap(ctx, banktypes.NewMsgAcceptSwap(acceptor.String(), res.Id))
+	require.NoError(err)
+	require.Equal(offer, suite.bankKeeper.GetAllBalances(ctx, acceptor))
+	require.Equal(offer.Add(ask...), suite.bankKeeper.GetAllBalances(ctx, creator))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, banktypes.SwapEscrowAddress).IsZero())
+
+	_, err = suite.queryClient.Swap(ctx, &banktypes.QuerySwapRequest{Id: res.Id})
+	require.ErrorContains(err, "NotFound")
+	_, err = suite.msgServer.AcceptSwap(ctx, banktypes.NewMsgAcceptSwap(acceptor.String(), res.Id))
+	require.ErrorIs(err, banktypes.ErrSwapNotFound)
+
+	// an expired swap is refunded to its creator when cancelled
+	res, err = suite.msgServer.CreateSwap(ctx, banktypes.NewMsgCreateSwap(creator.String(), "", offer, ask, blockTime.Add(time.Hour)))
+	require.NoError(err)
+	require.Equal(uint64(1), res.Id)
+
+	swapsRes, err := suite.queryClient.Swaps(ctx, &banktypes.QuerySwapsRequest{})
+	require.NoError(err)
+	require.Len(swapsRes.Swaps, 1)
+
+	_, err = suite.msgServer.CancelSwap(expiredCtx, banktypes.NewMsgCancelSwap(acceptor.String(), res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.CancelSwap(expiredCtx, banktypes.NewMsgCancelSwap(creator.String(), res.Id))
+	require.NoError(err)
+	require.Equal(offer.Add(ask...), suite.bankKeeper.GetAllBalances(ctx, creator))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, banktypes.SwapEscrowAddress).IsZero())
+}
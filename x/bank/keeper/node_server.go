@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nodeServer implements the Node service, serving the bank data local to the
+// node. It is registered by the application rather than by the module, as its
+// responses differ between nodes.
+type nodeServer struct {
+	history *BalanceHistory
+}
+
+var _ types.NodeServer = nodeServer{}
+
+// NewNodeServer returns an implementation of the bank Node service serving the
+// provided balance history, which is nil when the history is disabled on the
+// node.
+func NewNodeServer(history *BalanceHistory) types.NodeServer {
+	return nodeServer{history: history}
+}
+
+// BalanceAtHeight implements the Node/BalanceAtHeight gRPC method
+func (s nodeServer) BalanceAtHeight(_ context.Context, req *types.BalanceAtHeightRequest) (*types.BalanceAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height: %d", req.Height)
+	}
+
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, errBalanceHistoryNotEnabled.Error())
+	}
+
+	address, err := s.history.k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	balance, err := s.history.BalanceAtHeight(address, req.Denom, uint64(req.Height))
+	switch {
+	case errors.Is(err, errHeightNotRecorded):
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.BalanceAtHeightResponse{Balance: &balance}, nil
+}
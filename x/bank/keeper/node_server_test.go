@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank/keeper"
+	"cosmossdk.io/x/bank/testutil"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// memVersionedStore is an in-memory keeper.VersionedStore.
+type memVersionedStore struct {
+	latest uint64
+	values map[string]map[uint64][]byte
+}
+
+func (s *memVersionedStore) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
+	var (
+		value []byte
+		found uint64
+	)
+	for v, bz := range s.values[string(storeKey)+"/"+string(key)] {
+		if v <= version && v >= found {
+			value, found = bz, v
+		}
+	}
+	return value, nil
+}
+
+func (s *memVersionedStore) GetLatestVersion() (uint64, error) {
+	return s.latest, nil
+}
+
+func (s *memVersionedStore) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
+	for _, changes := range cs.Changes {
+		for _, pair := range changes.StateChanges {
+			k := string(changes.Actor) + "/" + string(pair.Key)
+			if s.values[k] == nil {
+				s.values[k] = map[uint64][]byte{}
+			}
+			if pair.Remove {
+				s.values[k][version] = nil
+			} else {
+				s.values[k][version] = pair.Value
+			}
+		}
+	}
+	s.latest = version
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestNodeBalanceAtHeight() {
+	ctx := suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	req := &types.BalanceAtHeightRequest{Address: addr.String(), Denom: barDenom, Height: 1}
+	_, err := keeper.NewNodeServer(nil).BalanceAtHeight(ctx, req)
+	suite.Require().ErrorContains(err, "balance history is not enabled")
+
+	suite.mockFundAccount(addr)
+	suite.Require().NoError(testutil.FundAccount(ctx, suite.bankKeeper, addr, sdk.NewCoins(newBarCoin(30))))
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	listener := keeper.NewBalanceHistory(suite.bankKeeper, &memVersionedStore{values: map[string]map[uint64][]byte{}})
+	nodeServer := keeper.NewNodeServer(listener)
+
+	// the first commit records all the balances
+	suite.Require().NoError(listener.ListenCommit(sdkCtx.WithBlockHeight(2), abci.ResponseCommit{}, nil))
+
+	key, err := collections.EncodeKeyWithPrefix(types.BalancesPrefix, suite.bankKeeper.Balances.KeyCodec(), collections.Join(addr, barDenom))
+	suite.Require().NoError(err)
+	value, err := types.BalanceValueCodec.Encode(math.NewInt(50))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(listener.ListenCommit(sdkCtx.WithBlockHeight(3), abci.ResponseCommit{}, []*storetypes.StoreKVPair{
+		{StoreKey: types.StoreKey, Key: key, Value: value},
+		{StoreKey: "other", Key: key, Value: []byte("foo")},
+	}))
+	suite.Require().NoError(listener.ListenCommit(sdkCtx.WithBlockHeight(4), abci.ResponseCommit{}, nil))
+	suite.Require().NoError(listener.ListenCommit(sdkCtx.WithBlockHeight(5), abci.ResponseCommit{}, []*storetypes.StoreKVPair{
+		{StoreKey: types.StoreKey, Key: key, Delete: true},
+	}))
+
+	// blocks already recorded are skipped, missing ones are an error
+	suite.Require().NoError(listener.ListenCommit(sdkCtx.WithBlockHeight(4), abci.ResponseCommit{}, nil))
+	suite.Require().ErrorContains(listener.ListenCommit(sdkCtx.WithBlockHeight(7), abci.ResponseCommit{}, nil), "missing heights 6 to 6")
+
+	testCases := []struct {
+		name         string
+		req          *types.BalanceAtHeightRequest
+		expectErrMsg string
+		expBalance   sdk.Coin
+	}{
+		{
+			"invalid denom",
+			&types.BalanceAtHeightRequest{Address: addr.String(), Denom: "0000", Height: 2},
+			"invalid denom",
+			sdk.Coin{},
+		},
+		{
+			"invalid address",
+			&types.BalanceAtHeightRequest{Address: "foo", Denom: barDenom, Height: 2},
+			"invalid address",
+			sdk.Coin{},
+		},
+		{
+			"invalid height",
+			&types.BalanceAtHeightRequest{Address: addr.String(), Denom: barDenom},
+			"invalid height",
+			sdk.Coin{},
+		},
+		{
+			"height before the history",
+			&types.BalanceAtHeightRequest{Address: addr.String(), Denom: barDenom, Height: 1},
+			"not recorded",
+			sdk.Coin{},
+		},
+		{
+			"height after the history",
+			&types.BalanceAtHeightRequest{Address: addr.String(), Denom: barDenom, Height: 6},
+			"not recorded",
+			sdk.Coin{},
+		},
+		{
+			"first height",
+			&types.BalanceAtHeightRequest{Address: addr.String(), Denom: barDenom, Height: 2},
+			"",
+			newBarCoin(30),
+		},
+		{
+			"updated balance",
+			&types.BalanceAtHeightRequest{Address: addr.String(), Denom: barDenom, Height: 3},
+			"",
+			newBarCoin(50),
+		},
+		{
+			"unchanged balance",
+			&types.BalanceAtHeightRequest{Address: addr.String(), Denom: barDenom, Height: 4},
+			"",
+			newBarCoin(50),
+		},
+		{
+			"removed balance",
+			&types.BalanceAtHeightRequest{Address: addr.String(), Denom: barDenom, Height: 5},
+			"",
+			newBarCoin(0),
+		},
+		{
+			"no balance",
+			&types.BalanceAtHeightRequest{Address: addr.String(), Denom: fooDenom, Height: 3},
+			"",
+			newFooCoin(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := nodeServer.BalanceAtHeight(ctx, tc.req)
+			if tc.expectErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBalance, *res.Balance)
+		})
+	}
+}
@@ -25,6 +25,8 @@ type SendKeeper interface {
 	PrependSendRestriction(restriction types.SendRestrictionFn)
 	ClearSendRestriction()
 
+	SetAccountCreationFeeCollector(moduleName string)
+
 	InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
@@ -67,6 +69,8 @@ type BaseSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+
+	accountCreationFee *accountCreationFee
 }
 
 func NewBaseSendKeeper(
@@ -88,6 +92,8 @@ func NewBaseSendKeeper(
 		blockedAddrs:    blockedAddrs,
 		authority:       authority,
 		sendRestriction: newSendRestriction(),
+
+		accountCreationFee: &accountCreationFee{},
 	}
 }
 
@@ -106,6 +112,13 @@ func (k BaseSendKeeper) ClearSendRestriction() {
 	k.sendRestriction.clear()
 }
 
+// SetAccountCreationFeeCollector sets the module account credited the
+// AccountCreationFee x/auth param, typically the community pool. No account
+// creation fee is charged until it is set.
+func (k BaseSendKeeper) SetAccountCreationFeeCollector(moduleName string) {
+	k.accountCreationFee.collector = moduleName
+}
+
 // GetAuthority returns the x/bank module's authority.
 func (k BaseSendKeeper) GetAuthority() string {
 	return k.authority
@@ -128,7 +141,7 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil
 	}
 	return k.Params.Set(ctx, params)
 }
@@ -165,6 +178,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		if err := k.chargeAccountCreationFee(ctx, inAddress, outAddress); err != nil {
+			return err
+		}
+
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
@@ -195,6 +212,11 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	err = k.chargeAccountCreationFee(ctx, fromAddr, toAddr)
+	if err != nil {
+		return err
+	}
+
 	err = k.addCoins(ctx, toAddr, amt)
 	if err != nil {
 		return err
@@ -217,6 +239,65 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 	)
 }
 
+// chargeAccountCreationFee charges the AccountCreationFee x/auth param to payer
+// when coins are sent to recipient while it has neither an account nor a
+// balance, i.e. when the send creates the state of a new account, and credits
+// it to the account creation fee collector. The fee prices the permanent state
+// growth caused by account creation sprees, so it is charged once per address,
+// and not to module accounts, whose sends are not initiated by users.
+func (k BaseSendKeeper) chargeAccountCreationFee(ctx context.Context, payer, recipient sdk.AccAddress) error {
+	if k.accountCreationFee.collector == "" {
+		return nil
+	}
+
+	fee := k.ak.GetParams(ctx).AccountCreationFee
+	if fee.IsZero() || k.ak.HasAccount(ctx, recipient) || k.hasBalance(ctx, recipient) {
+		return nil
+	}
+
+	if _, ok := k.ak.GetAccount(ctx, payer).(sdk.ModuleAccountI); ok {
+		return nil
+	}
+
+	collectorAddr := k.ak.GetModuleAddress(k.accountCreationFee.collector)
+	if collectorAddr == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", k.accountCreationFee.collector)
+	}
+
+	if err := k.subUnlockedCoins(ctx, payer, fee); err != nil {
+		return errorsmod.Wrapf(err, "failed to pay the account creation fee %s", fee)
+	}
+	if err := k.addCoins(ctx, collectorAddr, fee); err != nil {
+		return err
+	}
+
+	recipientStr, err := k.ak.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return err
+	}
+	payerStr, err := k.ak.AddressCodec().BytesToString(payer)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAccountCreationFee,
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+		event.NewAttribute(types.AttributeKeySender, payerStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+	)
+}
+
+// hasBalance returns whether addr holds a balance of any denom.
+func (k BaseSendKeeper) hasBalance(ctx context.Context, addr sdk.AccAddress) bool {
+	found := false
+	k.IterateAccountBalances(ctx, addr, func(sdk.Coin) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
 // A coin_spent event is emitted after.
@@ -274,6 +355,10 @@ func (k BaseSendKeeper) addCoins(ctx context.Context, addr sdk.AccAddress, amt s
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	if err := k.checkDenomLimit(ctx, addr, amt); err != nil {
+		return err
+	}
+
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
 		newBalance := balance.Add(coin)
@@ -296,6 +381,63 @@ func (k BaseSendKeeper) addCoins(ctx context.Context, addr sdk.AccAddress, amt s
 	)
 }
 
+// checkDenomLimit returns an error if crediting amt to addr would make it hold
+// more distinct denoms than allowed by the MaxDenomsPerAccount param. Only new
+// denoms count against the limit, so accounts that already exceed it keep their
+// balances and can still receive denoms they hold. Module accounts and the swap
+// escrow, which hold the coins of many accounts, are exempt.
+func (k BaseSendKeeper) checkDenomLimit(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	limit := k.GetParams(ctx).MaxDenomsPerAccount
+	if limit == 0 {
+		return nil
+	}
+
+	var newDenoms uint64
+	for _, coin := range amt {
+		has, err := k.Balances.Has(ctx, collections.Join(addr, coin.Denom))
+		if err != nil {
+			return err
+		}
+		if !has {
+			newDenoms++
+		}
+	}
+	if newDenoms == 0 {
+		return nil
+	}
+
+	if addr.Equals(types.SwapEscrowAddress) {
+		return nil
+	}
+	if _, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+		return nil
+	}
+
+	// stop counting as soon as the limit is reached, so the cost of the check
+	// is bounded by the limit and not by the number of denoms held
+	var held uint64
+	err := k.Balances.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(_ collections.Pair[sdk.AccAddress, string], _ math.Int) (stop bool, err error) {
+		held++
+		return held >= limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if held+newDenoms > limit {
+		addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+		if err != nil {
+			return err
+		}
+		return errorsmod.Wrapf(
+			types.ErrMaxDenomsExceeded,
+			"account %s holds %d denoms, receiving %d new denoms would exceed the limit of %d", addrStr, held, newDenoms, limit,
+		)
+	}
+
+	return nil
+}
+
 // setBalance sets the coin balance for an account by address.
 func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error {
 	if !balance.IsValid() {
@@ -445,6 +587,13 @@ func (k BaseSendKeeper) getSendEnabledOrDefault(ctx context.Context, denom strin
 	return defaultVal
 }
 
+// accountCreationFee is a struct that houses the module account credited the
+// account creation fees. Like sendRestriction, it exists so that the collector
+// can be set in the SendKeeper without needing to have a pointer receiver.
+type accountCreationFee struct {
+	collector string
+}
+
 // sendRestriction is a struct that houses a SendRestrictionFn.
 // It exists so that the SendRestrictionFn can be updated in the SendKeeper without needing to have a pointer receiver.
 type sendRestriction struct {
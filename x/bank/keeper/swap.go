@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetSwap returns the open swap with the given id.
+func (k BaseKeeper) GetSwap(ctx context.Context, id uint64) (types.Swap, error) {
+	swap, err := k.BaseViewKeeper.Swaps.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Swap{}, errorsmod.Wrapf(types.ErrSwapNotFound, "id %d", id)
+	}
+	return swap, err
+}
+
+// GetAllSwaps returns all the open swaps, by id.
+func (k BaseKeeper) GetAllSwaps(ctx context.Context) ([]types.Swap, error) {
+	iter, err := k.BaseViewKeeper.Swaps.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// CreateSwap opens a swap of the offer of the creator for its ask, and moves
+// the offer to the swap escrow until the swap is accepted or cancelled. The id
+// of the swap is assigned and returned.
+func (k BaseKeeper) CreateSwap(ctx context.Context, creator sdk.AccAddress, swap types.Swap) (uint64, error) {
+	id, err := k.SwapSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	swap.Id = id
+
+	if err := k.SendCoins(ctx, creator, types.SwapEscrowAddress, swap.Offer); err != nil {
+		return 0, err
+	}
+
+	if err := k.BaseViewKeeper.Swaps.Set(ctx, id, swap); err != nil {
+		return 0, err
+	}
+
+	return id, k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateSwap,
+		event.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySwapCreator, swap.Creator),
+	)
+}
+
+// AcceptSwap executes the swap: the acceptor pays the ask to the creator and
+// receives the offer held in escrow. The swap can only be accepted until its
+// expiry, by its counterparty if one is set.
+func (k BaseKeeper) AcceptSwap(ctx context.Context, acceptor sdk.AccAddress, id uint64) error {
+	swap, err := k.GetSwap(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	acceptorStr, err := k.ak.AddressCodec().BytesToString(acceptor)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case acceptorStr == swap.Creator:
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot accept own swap")
+	case swap.Counterparty != "" && acceptorStr != swap.Counterparty:
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "swap %d can only be accepted by %s", id, swap.Counterparty)
+	case k.environment.HeaderService.GetHeaderInfo(ctx).Time.After(swap.Expiry):
+		return errorsmod.Wrapf(types.ErrSwapExpired, "swap %d expired at %s", id, swap.Expiry)
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, swap.Ask.Add(swap.Offer...)...); err != nil {
+		return err
+	}
+
+	creator, err := k.ak.AddressCodec().StringToBytes(swap.Creator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SendCoins(ctx, acceptor, creator, swap.Ask); err != nil {
+		return err
+	}
+
+	if err := k.SendCoins(ctx, types.SwapEscrowAddress, acceptor, swap.Offer); err != nil {
+		return err
+	}
+
+	if err := k.BaseViewKeeper.Swaps.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAcceptSwap,
+		event.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySwapCreator, swap.Creator),
+		event.NewAttribute(types.AttributeKeySwapAcceptor, acceptorStr),
+	)
+}
+
+// CancelSwap closes the swap and refunds the offer held in escrow to the
+// creator. Only the creator can cancel a swap, expired or not.
+func (k BaseKeeper) CancelSwap(ctx context.Context, creator sdk.AccAddress, id uint64) error {
+	swap, err := k.GetSwap(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	creatorStr, err := k.ak.AddressCodec().BytesToString(creator)
+	if err != nil {
+		return err
+	}
+
+	if creatorStr != swap.Creator {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "swap %d can only be cancelled by %s", id, swap.Creator)
+	}
+
+	if err := k.SendCoins(ctx, types.SwapEscrowAddress, creator, swap.Offer); err != nil {
+		return err
+	}
+
+	if err := k.BaseViewKeeper.Swaps.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCancelSwap,
+		event.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySwapCreator, swap.Creator),
+	)
+}
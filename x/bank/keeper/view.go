@@ -66,6 +66,8 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	Swaps         collections.Map[uint64, types.Swap]
+	SwapSequence  collections.Sequence
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -80,6 +82,8 @@ func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak type
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Swaps:         collections.NewMap(sb, types.SwapsPrefix, "swaps", collections.Uint64Key, codec.CollValue[types.Swap](cdc)),
+		SwapSequence:  collections.NewSequence(sb, types.SwapSequenceKey, "swap_sequence"),
 	}
 
 	schema, err := sb.Build()
@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/x/bank/client/cli"
+	"cosmossdk.io/x/bank/client/supplyproof"
 	"cosmossdk.io/x/bank/keeper"
 	"cosmossdk.io/x/bank/simulation"
 	"cosmossdk.io/x/bank/types"
@@ -72,6 +73,12 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwrunt
 	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
+	supplyproof.RegisterHandler(clientCtx, mux)
+}
+
+// GetQueryCmd returns the root query command for the bank module.
+func (AppModule) GetQueryCmd() *cobra.Command {
+	return cli.NewQueryCmd()
 }
 
 // GetTxCmd returns the root tx command for the bank module.
@@ -137,6 +137,20 @@ func (mr *MockAccountKeeperMockRecorder) GetModulePermissions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModulePermissions", reflect.TypeOf((*MockAccountKeeper)(nil).GetModulePermissions))
 }
 
+// GetParams mocks base method.
+func (m *MockAccountKeeper) GetParams(ctx context.Context) types.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockAccountKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockAccountKeeper)(nil).GetParams), ctx)
+}
+
 // HasAccount mocks base method.
 func (m *MockAccountKeeper) HasAccount(ctx context.Context, addr types0.AccAddress) bool {
 	m.ctrl.T.Helper()
@@ -12,15 +12,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +41,10 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// max_denoms_per_account caps the number of distinct denoms a non-module
+	// account can hold. Accounts already above the cap keep their balances but
+	// cannot receive new denoms. Zero disables the cap.
+	MaxDenomsPerAccount uint64 `protobuf:"varint,3,opt,name=max_denoms_per_account,json=maxDenomsPerAccount,proto3" json:"max_denoms_per_account,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +95,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxDenomsPerAccount() uint64 {
+	if m != nil {
+		return m.MaxDenomsPerAccount
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -451,6 +466,102 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// Swap defines an atomic swap between two accounts: the coins offered by the
+// creator are held in escrow until the swap is accepted, in exchange for the
+// coins asked, or cancelled.
+//
+// Since: cosmos-sdk 0.51
+type Swap struct {
+	// id is the unique identifier of the swap.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// creator is the address of the account that created the swap.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// counterparty is the address of the only account allowed to accept the
+	// swap. Any account can accept it if empty.
+	Counterparty string `protobuf:"bytes,3,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// offer is the amount of coins held in escrow and given to the acceptor.
+	Offer github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=offer,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"offer"`
+	// ask is the amount of coins paid by the acceptor to the creator.
+	Ask github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=ask,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ask"`
+	// expiry is the time after which the swap can no longer be accepted.
+	Expiry time.Time `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *Swap) Reset()         { *m = Swap{} }
+func (m *Swap) String() string { return proto.CompactTextString(m) }
+func (*Swap) ProtoMessage()    {}
+func (*Swap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *Swap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Swap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Swap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Swap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Swap.Merge(m, src)
+}
+func (m *Swap) XXX_Size() int {
+	return m.Size()
+}
+func (m *Swap) XXX_DiscardUnknown() {
+	xxx_messageInfo_Swap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Swap proto.InternalMessageInfo
+
+func (m *Swap) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Swap) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Swap) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *Swap) GetOffer() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Offer
+	}
+	return nil
+}
+
+func (m *Swap) GetAsk() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Ask
+	}
+	return nil
+}
+
+func (m *Swap) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -459,55 +570,66 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*Swap)(nil), "cosmos.bank.v1beta1.Swap")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 683 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0x13, 0x4b,
-	0x10, 0xf6, 0xda, 0xf1, 0x8f, 0xac, 0xf3, 0x8a, 0xb7, 0xcf, 0x7a, 0x6f, 0x93, 0x27, 0xce, 0x96,
-	0x0b, 0x64, 0x2c, 0xc5, 0x47, 0x92, 0xce, 0x0d, 0xc2, 0xe1, 0x97, 0x0b, 0x04, 0xba, 0x28, 0x42,
-	0xa2, 0xb1, 0xd6, 0xbe, 0xc5, 0x5e, 0xe5, 0x6e, 0xf7, 0x74, 0xbb, 0x17, 0xe2, 0x96, 0x0a, 0xa5,
-	0xa2, 0xa6, 0x8a, 0xa8, 0x10, 0xa2, 0x70, 0x91, 0x9e, 0x36, 0x4a, 0x15, 0x51, 0x51, 0x05, 0xe4,
-	0x14, 0xce, 0x9f, 0x81, 0x6e, 0xf7, 0xce, 0x71, 0xa4, 0xd0, 0x22, 0xd1, 0xd8, 0x33, 0xf3, 0x7d,
-	0x3b, 0xf3, 0xcd, 0xec, 0xdc, 0x42, 0x6b, 0x20, 0xa4, 0x2f, 0xa4, 0xdd, 0x27, 0x7c, 0xcf, 0xde,
-	0xdf, 0xe8, 0x53, 0x45, 0x36, 0xb4, 0xd3, 0x0a, 0x42, 0xa1, 0x04, 0xfa, 0xc7, 0xe0, 0x2d, 0x1d,
-	0x4a, 0xf0, 0xb5, 0xca, 0x50, 0x0c, 0x85, 0xc6, 0xed, 0xd8, 0x32, 0xd4, 0xb5, 0x55, 0x43, 0xed,
-	0x19, 0x20, 0x39, 0x67, 0xa0, 0xab, 0x2a, 0x92, 0xce, 0xab, 0x0c, 0x04, 0xe3, 0x09, 0xfe, 0x5f,
-	0x82, 0xfb, 0x72, 0x68, 0xef, 0x6f, 0xc4, 0x7f, 0x09, 0xf0, 0x37, 0xf1, 0x19, 0x17, 0xb6, 0xfe,
-	0x35, 0xa1, 0xfa, 0x07, 0x00, 0x0b, 0xcf, 0x49, 0x48, 0x7c, 0x89, 0x1e, 0xc3, 0x15, 0x49, 0xb9,
-	0xdb, 0xa3, 0x9c, 0xf4, 0x3d, 0xea, 0x62, 0x50, 0xcb, 0x35, 0xca, 0x9b, 0xb5, 0xd6, 0x0d, 0x9a,
-	0x5b, 0x3b, 0x94, 0xbb, 0x0f, 0x0d, 0xaf, 0x93, 0xc5, 0xc0, 0x29, 0xcb, 0xab, 0x00, 0xba, 0x0b,
-	0x2b, 0x2e, 0x7d, 0x45, 0x22, 0x4f, 0xf5, 0xae, 0x25, 0xcc, 0xd6, 0x40, 0xa3, 0xe4, 0xa0, 0x04,
-	0x5b, 0x48, 0xd1, 0xbe, 0x75, 0x38, 0x9b, 0x34, 0xb1, 0x29, 0xb4, 0x2e, 0xdd, 0x3d, 0xfb, 0xc0,
-	0x8c, 0xd0, 0x28, 0xab, 0x6f, 0xc3, 0xf2, 0x02, 0x1b, 0x55, 0x60, 0xde, 0xa5, 0x5c, 0xf8, 0x18,
-	0xd4, 0x40, 0x63, 0xd9, 0x31, 0x0e, 0xc2, 0xb0, 0x78, 0xbd, 0x50, 0xea, 0xb6, 0x97, 0x2e, 0x8f,
-	0xaa, 0xa0, 0x7e, 0x0a, 0x60, 0xbe, 0xcb, 0x83, 0x48, 0xa1, 0x4d, 0x58, 0x24, 0xae, 0x1b, 0x52,
-	0x29, 0x4d, 0x86, 0x0e, 0xfe, 0x7a, 0xbc, 0x5e, 0x49, 0xda, 0xbc, 0x6f, 0x90, 0x1d, 0x15, 0x32,
-	0x3e, 0x74, 0x52, 0x22, 0x7a, 0x0d, 0xf3, 0xf1, 0x84, 0x25, 0xce, 0xea, 0xa9, 0xac, 0x5e, 0x4d,
-	0x45, 0xd2, 0xf9, 0x54, 0xb6, 0x05, 0xe3, 0x9d, 0x47, 0x27, 0xe7, 0xd5, 0xcc, 0xa7, 0xef, 0xd5,
-	0xc6, 0x90, 0xa9, 0x51, 0xd4, 0x6f, 0x0d, 0x84, 0x9f, 0x5c, 0x9f, 0xbd, 0xd0, 0xa0, 0x1a, 0x07,
-	0x54, 0xea, 0x03, 0xf2, 0xfd, 0x6c, 0xd2, 0x5c, 0xf1, 0xe8, 0x90, 0x0c, 0xc6, 0x3d, 0x5d, 0xe3,
-	0xe3, 0x6c, 0xd2, 0x04, 0x8e, 0xa9, 0xd7, 0xae, 0xbc, 0x3d, 0xaa, 0x66, 0x2e, 0x8f, 0xaa, 0x99,
-	0x37, 0xb3, 0x49, 0x33, 0x95, 0x53, 0xff, 0x02, 0x60, 0xe1, 0x59, 0xa4, 0xfe, 0xb8, 0x6e, 0x4a,
-	0x69, 0x37, 0xf5, 0xcf, 0x00, 0x16, 0x76, 0xa2, 0x20, 0xf0, 0xc6, 0xb1, 0x1a, 0x25, 0x14, 0xf1,
-	0x92, 0x8d, 0xfb, 0x1d, 0x6a, 0x74, 0xbd, 0xf6, 0x9d, 0x44, 0x0d, 0x38, 0x3d, 0x5e, 0xff, 0xff,
-	0xc6, 0x35, 0xd7, 0x02, 0xbb, 0x18, 0xd4, 0x5f, 0xc0, 0xe5, 0x07, 0xf1, 0x9a, 0xed, 0x72, 0xa6,
-	0x7e, 0xb1, 0x80, 0x6b, 0xb0, 0x44, 0x0f, 0x02, 0xc1, 0x29, 0x57, 0x7a, 0x03, 0xff, 0x72, 0xe6,
-	0x7e, 0xbc, 0x9c, 0xc4, 0x63, 0x44, 0x52, 0x89, 0x73, 0xb5, 0x5c, 0x63, 0xd9, 0x49, 0xdd, 0xfa,
-	0x61, 0x16, 0x96, 0x9e, 0x52, 0x45, 0x5c, 0xa2, 0x08, 0xaa, 0xc1, 0xb2, 0x4b, 0xe5, 0x20, 0x64,
-	0x81, 0x62, 0x82, 0x27, 0xe9, 0x17, 0x43, 0xe8, 0x5e, 0xcc, 0xe0, 0xc2, 0xef, 0x45, 0x9c, 0xa9,
-	0xf4, 0xfe, 0xac, 0x1b, 0xbf, 0xd1, 0xb9, 0x5e, 0x07, 0xba, 0xa9, 0x29, 0x11, 0x82, 0x4b, 0xf1,
-	0x5c, 0x71, 0x4e, 0xe7, 0xd6, 0x76, 0xac, 0xce, 0x65, 0x32, 0xf0, 0xc8, 0x18, 0x2f, 0xe9, 0x70,
-	0xea, 0xc6, 0x6c, 0x4e, 0x7c, 0x8a, 0xf3, 0x86, 0x1d, 0xdb, 0xe8, 0x5f, 0x58, 0x90, 0x63, 0xbf,
-	0x2f, 0x3c, 0x5c, 0xd0, 0xd1, 0xc4, 0x43, 0xab, 0x30, 0x17, 0x85, 0x0c, 0x17, 0xf5, 0x12, 0x16,
-	0xa7, 0xe7, 0xd5, 0xdc, 0xae, 0xd3, 0x75, 0xe2, 0x18, 0xba, 0x0d, 0x4b, 0x51, 0xc8, 0x7a, 0x23,
-	0x22, 0x47, 0xb8, 0xa4, 0xf1, 0xf2, 0xf4, 0xbc, 0x5a, 0xdc, 0x75, 0xba, 0x4f, 0x88, 0x1c, 0x39,
-	0xc5, 0x28, 0x64, 0xb1, 0xd1, 0xd9, 0x3a, 0x99, 0x5a, 0xe0, 0x6c, 0x6a, 0x81, 0x1f, 0x53, 0x0b,
-	0xbc, 0xbb, 0xb0, 0x32, 0x67, 0x17, 0x56, 0xe6, 0xdb, 0x85, 0x95, 0x79, 0x99, 0x3c, 0x87, 0xd2,
-	0xdd, 0x6b, 0x31, 0x91, 0x3e, 0x0f, 0xfa, 0xa2, 0xfb, 0x05, 0xfd, 0x92, 0x6d, 0xfd, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0xee, 0xbd, 0x69, 0x7d, 0x05, 0x00, 0x00,
+	// 842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbb, 0x6f, 0x1b, 0x37,
+	0x18, 0x17, 0x75, 0x7a, 0x99, 0x72, 0x0a, 0x94, 0x11, 0x52, 0xda, 0x45, 0x75, 0x82, 0x86, 0x42,
+	0x35, 0xe0, 0xbb, 0xda, 0xde, 0x8c, 0x02, 0x85, 0x95, 0xbe, 0x3c, 0x14, 0x0d, 0xce, 0x35, 0x0a,
+	0x74, 0x39, 0x50, 0x3a, 0xfa, 0x4c, 0xf8, 0x8e, 0x3c, 0x1c, 0x79, 0x89, 0xb4, 0x76, 0x2a, 0x32,
+	0x65, 0xee, 0xe4, 0xb1, 0x28, 0x3a, 0x78, 0xc8, 0xd2, 0xa9, 0x6b, 0x90, 0x29, 0xe8, 0xd2, 0x4e,
+	0x4e, 0x21, 0x0f, 0xce, 0x9f, 0x51, 0x90, 0xbc, 0xb3, 0x65, 0xc0, 0x45, 0x36, 0x03, 0x59, 0x24,
+	0x7e, 0xdf, 0xef, 0x7b, 0xfc, 0xbe, 0x07, 0x8f, 0xb0, 0x3f, 0x15, 0x32, 0x15, 0xd2, 0x9f, 0x10,
+	0x7e, 0xe2, 0x3f, 0xde, 0x9a, 0x50, 0x45, 0xb6, 0x8c, 0xe0, 0x65, 0xb9, 0x50, 0x02, 0xdd, 0xb7,
+	0xb8, 0x67, 0x54, 0x25, 0xbe, 0xde, 0x8b, 0x45, 0x2c, 0x0c, 0xee, 0xeb, 0x93, 0x35, 0x5d, 0x77,
+	0x63, 0x21, 0xe2, 0x84, 0xfa, 0x46, 0x9a, 0x14, 0x47, 0xbe, 0x62, 0x29, 0x95, 0x8a, 0xa4, 0x59,
+	0x69, 0xb0, 0x66, 0x63, 0x85, 0xd6, 0xb3, 0x0c, 0x6c, 0xa1, 0x6b, 0x1a, 0x92, 0x5e, 0xd1, 0x98,
+	0x0a, 0xc6, 0x4b, 0xfc, 0x83, 0x12, 0x4f, 0x65, 0xec, 0x3f, 0xde, 0xd2, 0x7f, 0x25, 0xf0, 0x3e,
+	0x49, 0x19, 0x17, 0xbe, 0xf9, 0xb5, 0xaa, 0xe1, 0xdf, 0x00, 0xb6, 0x1e, 0x91, 0x9c, 0xa4, 0x12,
+	0x7d, 0x0d, 0x57, 0x25, 0xe5, 0x51, 0x48, 0x39, 0x99, 0x24, 0x34, 0xc2, 0x60, 0xe0, 0x8c, 0xba,
+	0xdb, 0x03, 0xef, 0x96, 0xa2, 0xbc, 0x03, 0xca, 0xa3, 0x2f, 0xad, 0xdd, 0xb8, 0x8e, 0x41, 0xd0,
+	0x95, 0xd7, 0x0a, 0xf4, 0x29, 0xec, 0x45, 0xf4, 0x88, 0x14, 0x89, 0x0a, 0x6f, 0x04, 0xac, 0x0f,
+	0xc0, 0xa8, 0x13, 0xa0, 0x12, 0x5b, 0x0a, 0x81, 0x76, 0xe0, 0x83, 0x94, 0xcc, 0xc2, 0x88, 0x72,
+	0x91, 0xca, 0x30, 0xa3, 0x79, 0x48, 0xa6, 0x53, 0x51, 0x70, 0x85, 0x9d, 0x01, 0x18, 0x35, 0x82,
+	0xfb, 0x29, 0x99, 0x7d, 0x61, 0xc0, 0x47, 0x34, 0xdf, 0xb3, 0xd0, 0xee, 0x47, 0x4f, 0x2f, 0xcf,
+	0x36, 0xb0, 0x65, 0xb7, 0x29, 0xa3, 0x13, 0x7f, 0x66, 0x07, 0x63, 0xcb, 0x19, 0x3e, 0x84, 0xdd,
+	0xe5, 0x14, 0x3d, 0xd8, 0x34, 0xe1, 0x31, 0x18, 0x80, 0xd1, 0x4a, 0x60, 0x05, 0x84, 0x61, 0xfb,
+	0x26, 0xbb, 0x4a, 0xdc, 0x6d, 0xbc, 0x39, 0x75, 0xc1, 0xf0, 0x25, 0x80, 0xcd, 0x7d, 0x9e, 0x15,
+	0x0a, 0x6d, 0xc3, 0x36, 0x89, 0xa2, 0x9c, 0x4a, 0x69, 0x23, 0x8c, 0xf1, 0x5f, 0xcf, 0x37, 0x7b,
+	0x65, 0x6f, 0xf6, 0x2c, 0x72, 0xa0, 0x72, 0xc6, 0xe3, 0xa0, 0x32, 0x44, 0x4f, 0x60, 0x53, 0x8f,
+	0x45, 0xe2, 0xba, 0x69, 0xe5, 0xda, 0x75, 0x2b, 0x25, 0xbd, 0x6a, 0xe5, 0x43, 0xc1, 0xf8, 0xf8,
+	0xab, 0x17, 0xe7, 0x6e, 0xed, 0xb7, 0xd7, 0xee, 0x28, 0x66, 0xea, 0xb8, 0x98, 0x78, 0x53, 0x91,
+	0x96, 0x33, 0xf7, 0x97, 0x0a, 0x54, 0xf3, 0x8c, 0x4a, 0xe3, 0x20, 0x7f, 0xb9, 0x3c, 0xdb, 0x58,
+	0x4d, 0x68, 0x4c, 0xa6, 0xf3, 0xd0, 0xe4, 0xf8, 0xf5, 0xf2, 0x6c, 0x03, 0x04, 0x36, 0xdf, 0x6e,
+	0xef, 0xe7, 0x53, 0xb7, 0xf6, 0xe6, 0xd4, 0xad, 0xfd, 0x74, 0x79, 0xb6, 0x51, 0xd1, 0x19, 0xfe,
+	0x09, 0x60, 0xeb, 0xbb, 0x42, 0xbd, 0x73, 0xd5, 0x74, 0xaa, 0x6a, 0x86, 0xbf, 0x03, 0xd8, 0x3a,
+	0x28, 0xb2, 0x2c, 0x99, 0x6b, 0x36, 0x4a, 0x28, 0x92, 0x60, 0x70, 0x67, 0x6c, 0x4c, 0xbe, 0xdd,
+	0x4f, 0x4a, 0x36, 0xe0, 0xe5, 0xf3, 0xcd, 0x0f, 0x6f, 0xbd, 0x1b, 0x86, 0xe0, 0x3e, 0x06, 0xc3,
+	0x1f, 0xe0, 0x8a, 0xd9, 0xda, 0x43, 0xce, 0xd4, 0xff, 0x2c, 0xe0, 0x3a, 0xec, 0xd0, 0x59, 0x26,
+	0x38, 0xe5, 0xca, 0x6c, 0xe0, 0xbd, 0xe0, 0x4a, 0xd6, 0xcb, 0x49, 0x12, 0x46, 0x24, 0x95, 0xd8,
+	0x19, 0x38, 0xa3, 0x95, 0xa0, 0x12, 0x87, 0x4f, 0xeb, 0xb0, 0xf3, 0x2d, 0x55, 0x24, 0x22, 0x8a,
+	0xa0, 0x01, 0xec, 0x46, 0x54, 0x4e, 0x73, 0x96, 0x29, 0x26, 0x78, 0x19, 0x7e, 0x59, 0x85, 0x3e,
+	0xd7, 0x16, 0x5c, 0xa4, 0x61, 0xc1, 0x99, 0xaa, 0xe6, 0xd7, 0xbf, 0xf5, 0x62, 0x5f, 0xf1, 0x0d,
+	0x60, 0x54, 0x1d, 0x25, 0x42, 0xb0, 0xa1, 0xfb, 0x6a, 0x6e, 0xe3, 0x4a, 0x60, 0xce, 0x9a, 0x5d,
+	0xc4, 0x64, 0x96, 0x90, 0x39, 0x6e, 0x18, 0x75, 0x25, 0x6a, 0x6b, 0x4e, 0x52, 0x8a, 0x9b, 0xd6,
+	0x5a, 0x9f, 0xd1, 0x03, 0xd8, 0x92, 0xf3, 0x74, 0x22, 0x12, 0xdc, 0x32, 0xda, 0x52, 0x42, 0x6b,
+	0xd0, 0x29, 0x72, 0x86, 0xdb, 0x66, 0x09, 0xdb, 0x8b, 0x73, 0xd7, 0x39, 0x0c, 0xf6, 0x03, 0xad,
+	0x43, 0x1f, 0xc3, 0x4e, 0x91, 0xb3, 0xf0, 0x98, 0xc8, 0x63, 0xdc, 0x31, 0x78, 0x77, 0x71, 0xee,
+	0xb6, 0x0f, 0x83, 0xfd, 0x6f, 0x88, 0x3c, 0x0e, 0xda, 0x45, 0xce, 0xf4, 0x61, 0xf8, 0x87, 0x03,
+	0x1b, 0x07, 0x4f, 0x48, 0x86, 0xde, 0x83, 0x75, 0x16, 0x99, 0xfa, 0x1b, 0x41, 0x9d, 0x45, 0x7a,
+	0xc9, 0xa7, 0x39, 0x25, 0x4a, 0xe4, 0xb8, 0xfe, 0xb6, 0x25, 0x2f, 0x0d, 0xd1, 0x67, 0x70, 0xd5,
+	0x7c, 0x5d, 0x68, 0x9e, 0x91, 0x5c, 0xcd, 0xb1, 0xf3, 0x16, 0xc7, 0x1b, 0xd6, 0x7a, 0x29, 0xc5,
+	0xd1, 0x11, 0xcd, 0x71, 0xe3, 0xce, 0x96, 0xd2, 0xe4, 0x43, 0x12, 0x3a, 0x44, 0x9e, 0xe0, 0xe6,
+	0x5d, 0xa5, 0xd5, 0xd9, 0xd0, 0x1e, 0x6c, 0xd1, 0x59, 0xc6, 0xf2, 0xb9, 0x99, 0x69, 0x77, 0x7b,
+	0xdd, 0xb3, 0x8f, 0x9a, 0x57, 0x3d, 0x6a, 0xde, 0xf7, 0xd5, 0xa3, 0x36, 0xbe, 0xa7, 0x13, 0x3f,
+	0x7b, 0xed, 0x02, 0xeb, 0x5f, 0x3a, 0x8e, 0x77, 0x5e, 0x2c, 0xfa, 0xe0, 0xd5, 0xa2, 0x0f, 0xfe,
+	0x5d, 0xf4, 0xc1, 0xb3, 0x8b, 0x7e, 0xed, 0xd5, 0x45, 0xbf, 0xf6, 0xcf, 0x45, 0xbf, 0xf6, 0x63,
+	0xf9, 0xfe, 0xc9, 0xe8, 0xc4, 0x63, 0xa2, 0xfa, 0xb4, 0x1b, 0x62, 0x93, 0x96, 0x89, 0xbf, 0xf3,
+	0xdf, 0x00, 0x47, 0x7f, 0x86, 0xf0, 0x8f, 0x07, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -586,6 +708,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDenomsPerAccount != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MaxDenomsPerAccount))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -908,6 +1035,84 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Swap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Swap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBank(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Ask) > 0 {
+		for iNdEx := len(m.Ask) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ask[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Offer) > 0 {
+		for iNdEx := len(m.Offer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -934,6 +1139,9 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if m.MaxDenomsPerAccount != 0 {
+		n += 1 + sovBank(uint64(m.MaxDenomsPerAccount))
+	}
 	return n
 }
 
@@ -1071,6 +1279,40 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *Swap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBank(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Offer) > 0 {
+		for _, e := range m.Offer {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.Ask) > 0 {
+		for _, e := range m.Ask {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1160,6 +1402,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomsPerAccount", wireType)
+			}
+			m.MaxDenomsPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomsPerAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2040,6 +2301,240 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Swap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Swap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Swap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offer = append(m.Offer, types.Coin{})
+			if err := m.Offer[len(m.Offer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ask = append(m.Ask, types.Coin{})
+			if err := m.Ask[len(m.Ask)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
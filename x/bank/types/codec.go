@@ -16,6 +16,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgSendAndCall{}, "cosmos-sdk/MsgSendAndCall")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateSwap{}, "cosmos-sdk/MsgCreateSwap")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptSwap{}, "cosmos-sdk/MsgAcceptSwap")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelSwap{}, "cosmos-sdk/MsgCancelSwap")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
@@ -26,6 +30,10 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgSend{},
 		&MsgMultiSend{},
 		&MsgUpdateParams{},
+		&MsgSendAndCall{},
+		&MsgCreateSwap{},
+		&MsgAcceptSwap{},
+		&MsgCancelSwap{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
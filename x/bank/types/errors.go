@@ -13,4 +13,9 @@ var (
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
 	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrMaxDenomsExceeded     = errors.Register(ModuleName, 11, "maximum number of denoms per account exceeded")
+	ErrInvalidCallSigner     = errors.Register(ModuleName, 12, "expected sender as only signer of follow-up message")
+	ErrSwapNotFound          = errors.Register(ModuleName, 13, "swap not found")
+	ErrSwapExpired           = errors.Register(ModuleName, 14, "swap expired")
+	ErrInvalidCallTarget     = errors.Register(ModuleName, 15, "expected recipient as address of follow-up message")
 )
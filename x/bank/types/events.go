@@ -8,6 +8,10 @@ import (
 const (
 	EventTypeTransfer = "transfer"
 
+	// EventTypeAccountCreationFee is emitted when a send charges the x/auth
+	// account creation fee for creating the recipient account.
+	EventTypeAccountCreationFee = "account_creation_fee"
+
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = sdk.AttributeKeySender
 
@@ -21,4 +25,13 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// atomic swap events name and attributes
+	EventTypeCreateSwap = "create_swap"
+	EventTypeAcceptSwap = "accept_swap"
+	EventTypeCancelSwap = "cancel_swap"
+
+	AttributeKeySwapID       = "swap_id"
+	AttributeKeySwapCreator  = "creator"
+	AttributeKeySwapAcceptor = "acceptor"
 )
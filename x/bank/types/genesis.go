@@ -62,6 +62,19 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenSwaps := make(map[uint64]bool)
+	for _, swap := range gs.Swaps {
+		if seenSwaps[swap.Id] {
+			return fmt.Errorf("duplicate swap %d", swap.Id)
+		}
+
+		if err := swap.Validate(); err != nil {
+			return fmt.Errorf("invalid swap %d: %w", swap.Id, err)
+		}
+
+		seenSwaps[swap.Id] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -42,6 +42,10 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// swaps defines the open atomic swaps, whose offers are held in escrow.
+	//
+	// Since: cosmos-sdk 0.51
+	Swaps []Swap `protobuf:"bytes,6,rep,name=swaps,proto3" json:"swaps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -112,6 +116,13 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetSwaps() []Swap {
+	if m != nil {
+		return m.Swaps
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -162,36 +173,38 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x90, 0x38, 0x3a, 0xb8, 0x05, 0x9c, 0xd0, 0x29, 0xaa,
-	0x54, 0x5b, 0x4d, 0x37, 0x06, 0x24, 0x5c, 0x01, 0x13, 0x3f, 0xd4, 0x6c, 0x2c, 0xd1, 0xd9, 0xf7,
-	0x64, 0xac, 0xc4, 0x77, 0x56, 0xde, 0x15, 0xc8, 0x7f, 0xc0, 0xc8, 0xcc, 0xd4, 0x11, 0xb1, 0xd0,
-	0x81, 0x3f, 0x80, 0xb1, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0x19, 0xca, 0x9f, 0x81, 0x7c, 0x77, 0x75,
-	0x2c, 0xe1, 0x99, 0xc5, 0x3e, 0xdd, 0xf7, 0xfb, 0x3e, 0xef, 0xc7, 0x3d, 0x72, 0x3f, 0x91, 0x98,
-	0x4b, 0x0c, 0x63, 0x26, 0x26, 0xe1, 0x9b, 0x83, 0x18, 0x14, 0x3b, 0x08, 0x53, 0x10, 0x80, 0x19,
-	0x06, 0xc5, 0x4c, 0x2a, 0x49, 0x6f, 0x1b, 0x4b, 0x50, 0x5a, 0x02, 0x6b, 0xd9, 0xd9, 0x4a, 0x65,
-	0x2a, 0xb5, 0x1e, 0x96, 0x27, 0x63, 0xdd, 0xf1, 0x2b, 0x1a, 0x42, 0x45, 0x4b, 0x64, 0x26, 0xfe,
-	0xd1, 0x6b, 0xd9, 0x34, 0xd7, 0xe8, 0xdb, 0x46, 0x1f, 0x1b, 0xb0, 0xcd, 0x6b, 0xa4, 0x5b, 0x2c,
-	0xcf, 0x84, 0x0c, 0xf5, 0xd7, 0x5c, 0xed, 0x7e, 0x69, 0x91, 0xee, 0x53, 0x53, 0xea, 0x48, 0x31,
-	0x05, 0xf4, 0x21, 0x69, 0x17, 0x6c, 0xc6, 0x72, 0xf4, 0xdc, 0xbe, 0x3b, 0xe8, 0x0c, 0xef, 0x04,
-	0x0d, 0xa5, 0x07, 0x2f, 0xb5, 0x25, 0xda, 0x3c, 0xff, 0xd9, 0x73, 0x3e, 0x5d, 0x9e, 0xed, 0xb9,
-	0xc7, 0x36, 0x8a, 0x1e, 0x91, 0x8d, 0x98, 0x4d, 0x99, 0x48, 0x00, 0xbd, 0x6b, 0xfd, 0xd6, 0xa0,
-	0x33, 0xbc, 0xdb, 0x48, 0x88, 0x8c, 0xa9, 0x8e, 0xa8, 0x02, 0xe9, 0x9c, 0xb4, 0xf1, 0xa4, 0x28,
-	0xa6, 0x73, 0xaf, 0xa5, 0x11, 0xdb, 0x2b, 0x04, 0x42, 0x85, 0x38, 0x92, 0x99, 0x88, 0x9e, 0x94,
-	0xf1, 0x9f, 0x7f, 0xf5, 0x06, 0x69, 0xa6, 0x5e, 0x9f, 0xc4, 0x41, 0x22, 0x73, 0xdb, 0xb4, 0xfd,
-	0xed, 0x23, 0x9f, 0x84, 0x6a, 0x5e, 0x00, 0xea, 0x00, 0xfc, 0x78, 0x79, 0xb6, 0xd7, 0x9d, 0x42,
-	0xca, 0x92, 0xf9, 0xb8, 0x1c, 0x2b, 0xda, 0xfa, 0x4d, 0x42, 0xfa, 0x82, 0xdc, 0xe4, 0x20, 0x64,
-	0x3e, 0xce, 0x41, 0x31, 0xce, 0x14, 0xf3, 0xae, 0xeb, 0x12, 0xee, 0x35, 0x76, 0xf1, 0xcc, 0x9a,
-	0xea, 0x6d, 0xdc, 0xd0, 0xf1, 0x57, 0x0a, 0x7d, 0x4e, 0xba, 0x08, 0x82, 0x8f, 0x41, 0xb0, 0x78,
-	0x0a, 0xdc, 0x5b, 0xd3, 0xb8, 0x7e, 0x23, 0x6e, 0x04, 0x82, 0x3f, 0x36, 0xbe, 0x3a, 0xb1, 0x83,
-	0xab, 0xfb, 0xdd, 0x6f, 0x2e, 0x59, 0xb7, 0xc3, 0xa3, 0x43, 0xb2, 0xce, 0x38, 0x9f, 0x01, 0x9a,
-	0xd7, 0xda, 0x8c, 0xbc, 0xef, 0x5f, 0xf7, 0xb7, 0x2c, 0xf9, 0x91, 0x51, 0x46, 0x6a, 0x96, 0x89,
-	0xf4, 0xf8, 0xca, 0x48, 0xdf, 0x92, 0x35, 0xdd, 0xb6, 0x7d, 0x9d, 0xff, 0x30, 0x5a, 0x93, 0xef,
-	0xc1, 0xc6, 0xfb, 0xd3, 0x9e, 0xf3, 0xe7, 0xb4, 0xe7, 0x44, 0x87, 0xe7, 0x0b, 0xdf, 0xbd, 0x58,
-	0xf8, 0xee, 0xef, 0x85, 0xef, 0x7e, 0x58, 0xfa, 0xce, 0xc5, 0xd2, 0x77, 0x7e, 0x2c, 0x7d, 0xe7,
-	0x95, 0x5d, 0x5e, 0xe4, 0x93, 0x20, 0x93, 0xe1, 0x3b, 0xb3, 0xe4, 0x3a, 0x43, 0xdc, 0xd6, 0x0b,
-	0x7b, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xa7, 0xab, 0xb1, 0x6e, 0x03, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xed, 0xa6, 0x49, 0xdb, 0x4b, 0x40, 0xe2, 0xe8, 0x70, 0x2d, 0xe0, 0x84, 0x4e, 0x51,
+	0xa5, 0xda, 0x6a, 0x2a, 0x16, 0x06, 0x24, 0x5c, 0x01, 0x13, 0x7f, 0x94, 0x6c, 0x2c, 0xd1, 0xd9,
+	0x77, 0x32, 0x56, 0xe2, 0x3b, 0x2b, 0xef, 0x95, 0x90, 0x6f, 0xc0, 0xc8, 0xcc, 0xd4, 0x11, 0x31,
+	0x75, 0xe0, 0x03, 0x30, 0x76, 0xac, 0x98, 0x98, 0x00, 0x25, 0x12, 0xe5, 0x63, 0x20, 0xdf, 0x5d,
+	0x53, 0x2b, 0x78, 0x65, 0xb1, 0xad, 0x7b, 0x9e, 0xf7, 0xf7, 0xbc, 0xf7, 0xde, 0x19, 0xdd, 0x8f,
+	0x25, 0x64, 0x12, 0x82, 0x88, 0x8a, 0x51, 0xf0, 0xf6, 0x30, 0xe2, 0x8a, 0x1e, 0x06, 0x09, 0x17,
+	0x1c, 0x52, 0xf0, 0xf3, 0x89, 0x54, 0x12, 0xdf, 0x36, 0x16, 0xbf, 0xb0, 0xf8, 0xd6, 0xb2, 0xbb,
+	0x9d, 0xc8, 0x44, 0x6a, 0x3d, 0x28, 0xbe, 0x8c, 0x75, 0xd7, 0x5b, 0xd2, 0x80, 0x2f, 0x69, 0xb1,
+	0x4c, 0xc5, 0x3f, 0x7a, 0x29, 0x4d, 0x73, 0x8d, 0xbe, 0x63, 0xf4, 0xa1, 0x01, 0xdb, 0x5c, 0x23,
+	0xdd, 0xa2, 0x59, 0x2a, 0x64, 0xa0, 0x9f, 0x66, 0x69, 0xef, 0x77, 0x0d, 0xb5, 0x9e, 0x99, 0x56,
+	0x07, 0x8a, 0x2a, 0x8e, 0x1f, 0xa1, 0x46, 0x4e, 0x27, 0x34, 0x03, 0xe2, 0x76, 0xdc, 0x6e, 0xb3,
+	0x77, 0xc7, 0xaf, 0x68, 0xdd, 0x7f, 0xa5, 0x2d, 0xe1, 0xd6, 0xf9, 0x8f, 0xb6, 0xf3, 0xe9, 0xf2,
+	0x6c, 0xdf, 0xed, 0xdb, 0x2a, 0x7c, 0x8c, 0x36, 0x23, 0x3a, 0xa6, 0x22, 0xe6, 0x40, 0xd6, 0x3a,
+	0xb5, 0x6e, 0xb3, 0x77, 0xb7, 0x92, 0x10, 0x1a, 0x53, 0x19, 0xb1, 0x2c, 0xc4, 0x33, 0xd4, 0x80,
+	0x93, 0x3c, 0x1f, 0xcf, 0x48, 0x4d, 0x23, 0x76, 0xae, 0x11, 0xc0, 0x97, 0x88, 0x63, 0x99, 0x8a,
+	0xf0, 0x69, 0x51, 0xff, 0xf9, 0x67, 0xbb, 0x9b, 0xa4, 0xea, 0xcd, 0x49, 0xe4, 0xc7, 0x32, 0xb3,
+	0x9b, 0xb6, 0xaf, 0x03, 0x60, 0xa3, 0x40, 0xcd, 0x72, 0x0e, 0xba, 0x00, 0x3e, 0x5e, 0x9e, 0xed,
+	0xb7, 0xc6, 0x3c, 0xa1, 0xf1, 0x6c, 0x58, 0x8c, 0x15, 0x6c, 0xff, 0x26, 0x10, 0xbf, 0x44, 0x37,
+	0x19, 0x17, 0x32, 0x1b, 0x66, 0x5c, 0x51, 0x46, 0x15, 0x25, 0xeb, 0xba, 0x85, 0x7b, 0x95, 0xbb,
+	0x78, 0x6e, 0x4d, 0xe5, 0x6d, 0xdc, 0xd0, 0xf5, 0x57, 0x0a, 0x7e, 0x81, 0x5a, 0xc0, 0x05, 0x1b,
+	0x72, 0x41, 0xa3, 0x31, 0x67, 0xa4, 0xae, 0x71, 0x9d, 0x4a, 0xdc, 0x80, 0x0b, 0xf6, 0xc4, 0xf8,
+	0xca, 0xc4, 0x26, 0x5c, 0xaf, 0xe3, 0x07, 0xa8, 0x0e, 0x53, 0x9a, 0x03, 0x69, 0xac, 0x8e, 0xa6,
+	0x0c, 0x9a, 0xd2, 0x3c, 0x5c, 0x2f, 0x08, 0x7d, 0xe3, 0xde, 0xfb, 0xea, 0xa2, 0x0d, 0x3b, 0x73,
+	0xdc, 0x43, 0x1b, 0x94, 0xb1, 0x09, 0x07, 0x73, 0xc8, 0x5b, 0x21, 0xf9, 0xf6, 0xe5, 0x60, 0xdb,
+	0x72, 0x1e, 0x1b, 0x65, 0xa0, 0x26, 0xa9, 0x48, 0xfa, 0x57, 0x46, 0x3c, 0x45, 0x75, 0x3d, 0x2d,
+	0xb2, 0xb6, 0x1a, 0xfb, 0xbf, 0x4e, 0xc4, 0xe4, 0x3d, 0xdc, 0x7c, 0x7f, 0xda, 0x76, 0xfe, 0x9c,
+	0xb6, 0x9d, 0xf0, 0xe8, 0x7c, 0xee, 0xb9, 0x17, 0x73, 0xcf, 0xfd, 0x35, 0xf7, 0xdc, 0x0f, 0x0b,
+	0xcf, 0xb9, 0x58, 0x78, 0xce, 0xf7, 0x85, 0xe7, 0xbc, 0xb6, 0x77, 0x1e, 0xd8, 0xc8, 0x4f, 0x65,
+	0xf0, 0xce, 0xfc, 0x1b, 0x3a, 0x21, 0x6a, 0xe8, 0x7b, 0x7e, 0xf4, 0x77, 0x00, 0x6f, 0xdc, 0x09,
+	0x09, 0xa5, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +227,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -370,6 +397,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -596,6 +629,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swaps = append(m.Swaps, Swap{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -143,6 +143,39 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"dup swaps",
+			GenesisState{
+				Swaps: []Swap{
+					{
+						Id:      1,
+						Creator: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Offer:   sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+						Ask:     sdk.Coins{sdk.NewInt64Coin("stake", 1)},
+					},
+					{
+						Id:      1,
+						Creator: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Offer:   sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+						Ask:     sdk.Coins{sdk.NewInt64Coin("stake", 1)},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid swap",
+			GenesisState{
+				Swaps: []Swap{
+					{
+						Id:      1,
+						Creator: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Offer:   sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -39,8 +40,17 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// SwapsPrefix is the prefix for the open atomic swaps store.
+	SwapsPrefix = collections.NewPrefix(6)
+	// SwapSequenceKey is the key of the sequence used to assign swap ids.
+	SwapSequenceKey = collections.NewPrefix(7)
 )
 
+// SwapEscrowAddress is the address holding the coins offered by the open atomic
+// swaps. It is derived from the module address and has no private key.
+var SwapEscrowAddress = sdk.AccAddress(address.Module(ModuleName, []byte("swap_escrow")))
+
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
 // Historically, balances were represented as Coin, now they're represented as a simple math.Int
 var BalanceValueCodec = collcodec.NewAltValueCodec(sdk.IntValue, func(bytes []byte) (math.Int, error) {
@@ -1,13 +1,23 @@
 package types
 
 import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSendAndCall{}
+	_ sdk.Msg = &MsgCreateSwap{}
+	_ sdk.Msg = &MsgAcceptSwap{}
+	_ sdk.Msg = &MsgCancelSwap{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgSendAndCall{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -15,6 +25,27 @@ func NewMsgSend(fromAddr, toAddr string, amount sdk.Coins) *MsgSend {
 	return &MsgSend{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount}
 }
 
+// NewMsgSendAndCall - construct a msg to send coins from one account to another
+// and execute the given messages, signed by the sender, after the transfer.
+func NewMsgSendAndCall(fromAddr, toAddr string, amount sdk.Coins, msgs []sdk.Msg) (*MsgSendAndCall, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSendAndCall{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount, Msgs: anys}, nil
+}
+
+// GetMsgs unpacks the follow-up messages of the MsgSendAndCall.
+func (msg MsgSendAndCall) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgSendAndCall")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSendAndCall) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
 // NewMsgMultiSend - construct arbitrary multi-in, multi-out send msg.
 func NewMsgMultiSend(in Input, out []Output) *MsgMultiSend {
 	return &MsgMultiSend{Inputs: []Input{in}, Outputs: out}
@@ -28,3 +59,20 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgCreateSwap - construct a msg to create an atomic swap of the offered
+// coins for the asked coins, which can be accepted until expiry. Any account can
+// accept the swap if counterparty is empty.
+func NewMsgCreateSwap(creator, counterparty string, offer, ask sdk.Coins, expiry time.Time) *MsgCreateSwap {
+	return &MsgCreateSwap{Creator: creator, Counterparty: counterparty, Offer: offer, Ask: ask, Expiry: expiry}
+}
+
+// NewMsgAcceptSwap - construct a msg to accept an atomic swap.
+func NewMsgAcceptSwap(acceptor string, id uint64) *MsgAcceptSwap {
+	return &MsgAcceptSwap{Acceptor: acceptor, Id: id}
+}
+
+// NewMsgCancelSwap - construct a msg to cancel an atomic swap.
+func NewMsgCancelSwap(creator string, id uint64) *MsgCancelSwap {
+	return &MsgCancelSwap{Creator: creator, Id: id}
+}
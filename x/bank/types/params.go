@@ -10,6 +10,10 @@ import (
 // DefaultDefaultSendEnabled is the value that DefaultSendEnabled will have from DefaultParams().
 var DefaultDefaultSendEnabled = true
 
+// DefaultMaxDenomsPerAccount is the value that MaxDenomsPerAccount will have from DefaultParams().
+// Zero means accounts can hold any number of denoms.
+var DefaultMaxDenomsPerAccount uint64 = 0
+
 // NewParams creates a new parameter configuration for the bank module
 func NewParams(defaultSendEnabled bool) Params {
 	return Params{
@@ -21,8 +25,9 @@ func NewParams(defaultSendEnabled bool) Params {
 // DefaultParams is the default parameter configuration for the bank module
 func DefaultParams() Params {
 	return Params{
-		SendEnabled:        nil,
-		DefaultSendEnabled: DefaultDefaultSendEnabled,
+		SendEnabled:         nil,
+		DefaultSendEnabled:  DefaultDefaultSendEnabled,
+		MaxDenomsPerAccount: DefaultMaxDenomsPerAccount,
 	}
 }
 
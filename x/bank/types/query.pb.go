@@ -609,6 +609,112 @@ func (m *QuerySupplyOfResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// QueryCirculatingSupplyOfRequest is the request type for the Query/CirculatingSupplyOf RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryCirculatingSupplyOfRequest struct {
+	// denom is the coin denom to query the supply for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryCirculatingSupplyOfRequest) Reset()         { *m = QueryCirculatingSupplyOfRequest{} }
+func (m *QueryCirculatingSupplyOfRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCirculatingSupplyOfRequest) ProtoMessage()    {}
+func (*QueryCirculatingSupplyOfRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{12}
+}
+func (m *QueryCirculatingSupplyOfRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCirculatingSupplyOfRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCirculatingSupplyOfRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCirculatingSupplyOfRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCirculatingSupplyOfRequest.Merge(m, src)
+}
+func (m *QueryCirculatingSupplyOfRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCirculatingSupplyOfRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCirculatingSupplyOfRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCirculatingSupplyOfRequest proto.InternalMessageInfo
+
+func (m *QueryCirculatingSupplyOfRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryCirculatingSupplyOfResponse is the response type for the Query/CirculatingSupplyOf RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryCirculatingSupplyOfResponse struct {
+	// supply is the total supply of the coin.
+	Supply types.Coin `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply"`
+	// circulating_supply is the supply of the coin less the balances of the
+	// non-circulating addresses.
+	CirculatingSupply types.Coin `protobuf:"bytes,2,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply"`
+}
+
+func (m *QueryCirculatingSupplyOfResponse) Reset()         { *m = QueryCirculatingSupplyOfResponse{} }
+func (m *QueryCirculatingSupplyOfResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCirculatingSupplyOfResponse) ProtoMessage()    {}
+func (*QueryCirculatingSupplyOfResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{13}
+}
+func (m *QueryCirculatingSupplyOfResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCirculatingSupplyOfResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCirculatingSupplyOfResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCirculatingSupplyOfResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCirculatingSupplyOfResponse.Merge(m, src)
+}
+func (m *QueryCirculatingSupplyOfResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCirculatingSupplyOfResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCirculatingSupplyOfResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCirculatingSupplyOfResponse proto.InternalMessageInfo
+
+func (m *QueryCirculatingSupplyOfResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+func (m *QueryCirculatingSupplyOfResponse) GetCirculatingSupply() types.Coin {
+	if m != nil {
+		return m.CirculatingSupply
+	}
+	return types.Coin{}
+}
+
 // QueryParamsRequest defines the request type for querying x/bank parameters.
 type QueryParamsRequest struct {
 }
@@ -617,7 +723,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{12}
+	return fileDescriptor_9c6fc1939682df13, []int{14}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +762,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{13}
+	return fileDescriptor_9c6fc1939682df13, []int{15}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,7 +808,7 @@ func (m *QueryDenomsMetadataRequest) Reset()         { *m = QueryDenomsMetadataR
 func (m *QueryDenomsMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomsMetadataRequest) ProtoMessage()    {}
 func (*QueryDenomsMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{14}
+	return fileDescriptor_9c6fc1939682df13, []int{16}
 }
 func (m *QueryDenomsMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +857,7 @@ func (m *QueryDenomsMetadataResponse) Reset()         { *m = QueryDenomsMetadata
 func (m *QueryDenomsMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomsMetadataResponse) ProtoMessage()    {}
 func (*QueryDenomsMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{15}
+	return fileDescriptor_9c6fc1939682df13, []int{17}
 }
 func (m *QueryDenomsMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,7 +910,7 @@ func (m *QueryDenomMetadataRequest) Reset()         { *m = QueryDenomMetadataReq
 func (m *QueryDenomMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataRequest) ProtoMessage()    {}
 func (*QueryDenomMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{16}
+	return fileDescriptor_9c6fc1939682df13, []int{18}
 }
 func (m *QueryDenomMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +957,7 @@ func (m *QueryDenomMetadataResponse) Reset()         { *m = QueryDenomMetadataRe
 func (m *QueryDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataResponse) ProtoMessage()    {}
 func (*QueryDenomMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{17}
+	return fileDescriptor_9c6fc1939682df13, []int{19}
 }
 func (m *QueryDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,7 +1006,7 @@ func (m *QueryDenomMetadataByQueryStringRequest) Reset() {
 func (m *QueryDenomMetadataByQueryStringRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataByQueryStringRequest) ProtoMessage()    {}
 func (*QueryDenomMetadataByQueryStringRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{18}
+	return fileDescriptor_9c6fc1939682df13, []int{20}
 }
 func (m *QueryDenomMetadataByQueryStringRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,7 +1055,7 @@ func (m *QueryDenomMetadataByQueryStringResponse) Reset() {
 func (m *QueryDenomMetadataByQueryStringResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataByQueryStringResponse) ProtoMessage()    {}
 func (*QueryDenomMetadataByQueryStringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{19}
+	return fileDescriptor_9c6fc1939682df13, []int{21}
 }
 func (m *QueryDenomMetadataByQueryStringResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -999,7 +1105,7 @@ func (m *QueryDenomOwnersRequest) Reset()         { *m = QueryDenomOwnersRequest
 func (m *QueryDenomOwnersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersRequest) ProtoMessage()    {}
 func (*QueryDenomOwnersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{20}
+	return fileDescriptor_9c6fc1939682df13, []int{22}
 }
 func (m *QueryDenomOwnersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1164,7 @@ func (m *DenomOwner) Reset()         { *m = DenomOwner{} }
 func (m *DenomOwner) String() string { return proto.CompactTextString(m) }
 func (*DenomOwner) ProtoMessage()    {}
 func (*DenomOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{21}
+	return fileDescriptor_9c6fc1939682df13, []int{23}
 }
 func (m *DenomOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1220,7 @@ func (m *QueryDenomOwnersResponse) Reset()         { *m = QueryDenomOwnersRespon
 func (m *QueryDenomOwnersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersResponse) ProtoMessage()    {}
 func (*QueryDenomOwnersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{22}
+	return fileDescriptor_9c6fc1939682df13, []int{24}
 }
 func (m *QueryDenomOwnersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1173,7 +1279,7 @@ func (m *QueryDenomOwnersByQueryRequest) Reset()         { *m = QueryDenomOwners
 func (m *QueryDenomOwnersByQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersByQueryRequest) ProtoMessage()    {}
 func (*QueryDenomOwnersByQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{23}
+	return fileDescriptor_9c6fc1939682df13, []int{25}
 }
 func (m *QueryDenomOwnersByQueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1229,7 +1335,7 @@ func (m *QueryDenomOwnersByQueryResponse) Reset()         { *m = QueryDenomOwner
 func (m *QueryDenomOwnersByQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersByQueryResponse) ProtoMessage()    {}
 func (*QueryDenomOwnersByQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{24}
+	return fileDescriptor_9c6fc1939682df13, []int{26}
 }
 func (m *QueryDenomOwnersByQueryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,7 +1393,7 @@ func (m *QuerySendEnabledRequest) Reset()         { *m = QuerySendEnabledRequest
 func (m *QuerySendEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySendEnabledRequest) ProtoMessage()    {}
 func (*QuerySendEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{25}
+	return fileDescriptor_9c6fc1939682df13, []int{27}
 }
 func (m *QuerySendEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1344,7 +1450,7 @@ func (m *QuerySendEnabledResponse) Reset()         { *m = QuerySendEnabledRespon
 func (m *QuerySendEnabledResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySendEnabledResponse) ProtoMessage()    {}
 func (*QuerySendEnabledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{26}
+	return fileDescriptor_9c6fc1939682df13, []int{28}
 }
 func (m *QuerySendEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1387,6 +1493,205 @@ func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySwapRequest is the request type for the Query/Swap RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QuerySwapRequest struct {
+	// id is the identifier of the swap to query.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QuerySwapRequest) Reset()         { *m = QuerySwapRequest{} }
+func (m *QuerySwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapRequest) ProtoMessage()    {}
+func (*QuerySwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{29}
+}
+func (m *QuerySwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapRequest.Merge(m, src)
+}
+func (m *QuerySwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapRequest proto.InternalMessageInfo
+
+func (m *QuerySwapRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QuerySwapResponse is the response type for the Query/Swap RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QuerySwapResponse struct {
+	Swap Swap `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap"`
+}
+
+func (m *QuerySwapResponse) Reset()         { *m = QuerySwapResponse{} }
+func (m *QuerySwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapResponse) ProtoMessage()    {}
+func (*QuerySwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{30}
+}
+func (m *QuerySwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapResponse.Merge(m, src)
+}
+func (m *QuerySwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapResponse proto.InternalMessageInfo
+
+func (m *QuerySwapResponse) GetSwap() Swap {
+	if m != nil {
+		return m.Swap
+	}
+	return Swap{}
+}
+
+// QuerySwapsRequest is the request type for the Query/Swaps RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QuerySwapsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySwapsRequest) Reset()         { *m = QuerySwapsRequest{} }
+func (m *QuerySwapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapsRequest) ProtoMessage()    {}
+func (*QuerySwapsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{31}
+}
+func (m *QuerySwapsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapsRequest.Merge(m, src)
+}
+func (m *QuerySwapsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapsRequest proto.InternalMessageInfo
+
+func (m *QuerySwapsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySwapsResponse is the response type for the Query/Swaps RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QuerySwapsResponse struct {
+	Swaps []Swap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySwapsResponse) Reset()         { *m = QuerySwapsResponse{} }
+func (m *QuerySwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapsResponse) ProtoMessage()    {}
+func (*QuerySwapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{32}
+}
+func (m *QuerySwapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapsResponse.Merge(m, src)
+}
+func (m *QuerySwapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapsResponse proto.InternalMessageInfo
+
+func (m *QuerySwapsResponse) GetSwaps() []Swap {
+	if m != nil {
+		return m.Swaps
+	}
+	return nil
+}
+
+func (m *QuerySwapsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -1400,6 +1705,8 @@ func init() {
 	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "cosmos.bank.v1beta1.QueryTotalSupplyResponse")
 	proto.RegisterType((*QuerySupplyOfRequest)(nil), "cosmos.bank.v1beta1.QuerySupplyOfRequest")
 	proto.RegisterType((*QuerySupplyOfResponse)(nil), "cosmos.bank.v1beta1.QuerySupplyOfResponse")
+	proto.RegisterType((*QueryCirculatingSupplyOfRequest)(nil), "cosmos.bank.v1beta1.QueryCirculatingSupplyOfRequest")
+	proto.RegisterType((*QueryCirculatingSupplyOfResponse)(nil), "cosmos.bank.v1beta1.QueryCirculatingSupplyOfResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.bank.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.bank.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryDenomsMetadataRequest)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataRequest")
@@ -1415,96 +1722,113 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersByQueryResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersByQueryResponse")
 	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
 	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QuerySwapRequest)(nil), "cosmos.bank.v1beta1.QuerySwapRequest")
+	proto.RegisterType((*QuerySwapResponse)(nil), "cosmos.bank.v1beta1.QuerySwapResponse")
+	proto.RegisterType((*QuerySwapsRequest)(nil), "cosmos.bank.v1beta1.QuerySwapsRequest")
+	proto.RegisterType((*QuerySwapsResponse)(nil), "cosmos.bank.v1beta1.QuerySwapsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xc1, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf6, 0xb4, 0xaa, 0x9b, 0x3c, 0xa7, 0x3f, 0x29, 0xd3, 0xfc, 0x68, 0xb2, 0x21, 0x76, 0xd8,
-	0x54, 0x89, 0x13, 0x92, 0xdd, 0xc6, 0x8e, 0x0a, 0x2d, 0x21, 0x52, 0xdc, 0x92, 0x1e, 0x10, 0x6a,
-	0x71, 0xe8, 0x05, 0x0e, 0xd6, 0xda, 0xbb, 0x18, 0x2b, 0xf6, 0xae, 0xeb, 0xd9, 0xb4, 0x58, 0x55,
-	0x10, 0x42, 0x42, 0xea, 0x11, 0x89, 0x9e, 0x2a, 0x21, 0x45, 0x48, 0x40, 0x05, 0x52, 0xd5, 0x03,
-	0x07, 0x0e, 0x1c, 0x39, 0x54, 0x9c, 0x2a, 0x38, 0x80, 0x38, 0x14, 0x94, 0x20, 0xb5, 0x7f, 0x06,
-	0xf2, 0xcc, 0x5b, 0xef, 0xae, 0xbd, 0x5e, 0x6f, 0x52, 0x53, 0x55, 0x5c, 0x12, 0x7b, 0xe6, 0xbd,
-	0x79, 0xdf, 0xfb, 0xe6, 0xcd, 0x9b, 0x6f, 0x0c, 0xa9, 0x92, 0xc5, 0x6a, 0x16, 0x53, 0x8b, 0x9a,
-	0xb9, 0xa5, 0x5e, 0x5f, 0x2e, 0x1a, 0xb6, 0xb6, 0xac, 0x5e, 0xdb, 0x36, 0x1a, 0x4d, 0xa5, 0xde,
-	0xb0, 0x6c, 0x8b, 0x9e, 0x14, 0x06, 0x4a, 0xcb, 0x40, 0x41, 0x03, 0x69, 0xa1, 0xed, 0xc5, 0x0c,
-	0x61, 0xdd, 0xf6, 0xad, 0x6b, 0xe5, 0x8a, 0xa9, 0xd9, 0x15, 0xcb, 0x14, 0x0b, 0x48, 0x63, 0x65,
-	0xab, 0x6c, 0xf1, 0x8f, 0x6a, 0xeb, 0x13, 0x8e, 0xbe, 0x58, 0xb6, 0xac, 0x72, 0xd5, 0x50, 0xb5,
-	0x7a, 0x45, 0xd5, 0x4c, 0xd3, 0xb2, 0xb9, 0x0b, 0xc3, 0xd9, 0xa4, 0x77, 0x7d, 0x67, 0xe5, 0x92,
-	0x55, 0x31, 0xbb, 0xe6, 0x3d, 0xa8, 0x39, 0x42, 0x31, 0x3f, 0x21, 0xe6, 0x0b, 0x22, 0x2c, 0x66,
-	0x20, 0xa6, 0x26, 0xd1, 0xd5, 0x41, 0xed, 0x4d, 0x56, 0x1a, 0xd5, 0x6a, 0x15, 0xd3, 0x52, 0xf9,
-	0x5f, 0x31, 0x24, 0x57, 0xe0, 0xe4, 0xdb, 0x2d, 0x8b, 0x9c, 0x56, 0xd5, 0xcc, 0x92, 0x91, 0x37,
-	0xae, 0x6d, 0x1b, 0xcc, 0xa6, 0x19, 0x38, 0xae, 0xe9, 0x7a, 0xc3, 0x60, 0x6c, 0x9c, 0x4c, 0x93,
-	0xf4, 0x70, 0x6e, 0xfc, 0x97, 0xef, 0x97, 0xc6, 0x30, 0xd2, 0xba, 0x98, 0xd9, 0xb4, 0x1b, 0x15,
-	0xb3, 0x9c, 0x77, 0x0c, 0xe9, 0x18, 0x1c, 0xd3, 0x0d, 0xd3, 0xaa, 0x8d, 0x1f, 0x69, 0x79, 0xe4,
-	0xc5, 0x97, 0xf3, 0x43, 0xb7, 0x76, 0x53, 0xb1, 0x27, 0xbb, 0xa9, 0x98, 0xfc, 0x26, 0x8c, 0xf9,
-	0x43, 0xb1, 0xba, 0x65, 0x32, 0x83, 0x66, 0xe1, 0x78, 0x51, 0x0c, 0xf1, 0x58, 0x89, 0xcc, 0x84,
-	0xd2, 0xde, 0x14, 0x66, 0x38, 0x9b, 0xa2, 0x5c, 0xb0, 0x2a, 0x66, 0xde, 0xb1, 0x94, 0x7f, 0x22,
-	0x70, 0x8a, 0xaf, 0xb6, 0x5e, 0xad, 0xe2, 0x82, 0xec, 0x69, 0xc0, 0x6f, 0x00, 0xb8, 0x5b, 0xcb,
-	0x33, 0x48, 0x64, 0x66, 0x7d, 0x38, 0x04, 0x91, 0x0e, 0x9a, 0x2b, 0x5a, 0xd9, 0x21, 0x2b, 0xef,
-	0xf1, 0xa4, 0x33, 0x70, 0xa2, 0x61, 0x30, 0xab, 0x7a, 0xdd, 0x28, 0x08, 0x32, 0x8e, 0x4e, 0x93,
-	0xf4, 0x50, 0x7e, 0x04, 0x07, 0x2f, 0x76, 0x70, 0xb2, 0x47, 0x60, 0xbc, 0x3b, 0x0d, 0x24, 0x66,
-	0x07, 0x86, 0x30, 0xdd, 0x56, 0x22, 0x47, 0x43, 0x99, 0xc9, 0x6d, 0x3c, 0x78, 0x94, 0x8a, 0x7d,
-	0xfb, 0x67, 0x2a, 0x5d, 0xae, 0xd8, 0x1f, 0x6c, 0x17, 0x95, 0x92, 0x55, 0xc3, 0xca, 0xc0, 0x7f,
-	0x4b, 0x4c, 0xdf, 0x52, 0xed, 0x66, 0xdd, 0x60, 0xdc, 0x81, 0xdd, 0x79, 0x7c, 0x7f, 0x61, 0xa4,
-	0x6a, 0x94, 0xb5, 0x52, 0xb3, 0xd0, 0xaa, 0x3d, 0x76, 0xf7, 0xf1, 0xfd, 0x05, 0x92, 0x6f, 0x87,
-	0xa4, 0x97, 0x02, 0x28, 0x99, 0xeb, 0x4b, 0x89, 0xc0, 0xee, 0xe5, 0x44, 0xfe, 0x8a, 0xc0, 0x14,
-	0x4f, 0x72, 0xb3, 0x6e, 0x98, 0xba, 0x56, 0xac, 0x1a, 0xcf, 0xd1, 0x8e, 0x79, 0x36, 0xe3, 0x09,
-	0x81, 0x64, 0x2f, 0x9c, 0xff, 0xb1, 0x2d, 0x69, 0xc2, 0x4c, 0x60, 0xa6, 0xb9, 0x26, 0xaf, 0xd0,
-	0x7f, 0xb3, 0x0d, 0xbc, 0x07, 0xa7, 0xc3, 0x43, 0x3f, 0x4d, 0x5b, 0xd8, 0xc2, 0xae, 0xf0, 0x8e,
-	0x65, 0x6b, 0xd5, 0xcd, 0xed, 0x7a, 0xbd, 0xda, 0x74, 0x72, 0xf1, 0xd7, 0x0b, 0x19, 0x40, 0xbd,
-	0x3c, 0x72, 0x0e, 0xaf, 0x2f, 0x1a, 0xc2, 0x6f, 0x42, 0x9c, 0xf1, 0x91, 0x67, 0x57, 0x27, 0x18,
-	0x70, 0x70, 0x55, 0xb2, 0x88, 0x1d, 0x5b, 0xa4, 0x76, 0xf9, 0x7d, 0x87, 0xca, 0xf6, 0x16, 0x13,
-	0xcf, 0x16, 0xcb, 0x57, 0xe1, 0xff, 0x1d, 0xd6, 0x48, 0xc5, 0x2a, 0xc4, 0xb5, 0x9a, 0xb5, 0x6d,
-	0xda, 0x7d, 0x37, 0x32, 0x37, 0xdc, 0xa2, 0x02, 0xb3, 0x11, 0x3e, 0xf2, 0x18, 0x50, 0xbe, 0xec,
-	0x15, 0xad, 0xa1, 0xd5, 0x9c, 0x8e, 0x21, 0x5f, 0xc5, 0x7b, 0xcb, 0x19, 0xc5, 0x50, 0x6b, 0x10,
-	0xaf, 0xf3, 0x11, 0x0c, 0x35, 0xa9, 0x04, 0xdc, 0xef, 0x8a, 0x70, 0xf2, 0x05, 0x13, 0x5e, 0xb2,
-	0x0e, 0x12, 0x5f, 0x96, 0x97, 0x22, 0x7b, 0xcb, 0xb0, 0x35, 0x5d, 0xb3, 0xb5, 0x01, 0x97, 0x90,
-	0x7c, 0x8f, 0xc0, 0x64, 0x60, 0x18, 0xcc, 0x62, 0x03, 0x86, 0x6b, 0x38, 0xe6, 0xb4, 0x99, 0xa9,
-	0xc0, 0x44, 0x1c, 0x4f, 0x6f, 0x2a, 0xae, 0xeb, 0xe0, 0x0a, 0x61, 0x19, 0x26, 0x5c, 0xbc, 0x9d,
-	0xac, 0x04, 0x57, 0x43, 0xd1, 0xcb, 0x64, 0x57, 0x86, 0x17, 0x61, 0xc8, 0x81, 0x89, 0x3c, 0x46,
-	0x4f, 0xb0, 0xed, 0x29, 0xaf, 0xc1, 0x6c, 0x77, 0x8c, 0x5c, 0x53, 0x54, 0xa1, 0x68, 0x4b, 0xa1,
-	0x18, 0x2d, 0x98, 0xeb, 0xeb, 0x3f, 0x50, 0xc0, 0x37, 0xb0, 0x3d, 0xf1, 0x80, 0x97, 0x6f, 0x98,
-	0x46, 0x83, 0x85, 0x22, 0x1c, 0xd4, 0x25, 0x27, 0x7f, 0x4c, 0x00, 0xdc, 0xa0, 0x87, 0xea, 0xeb,
-	0x6b, 0x6e, 0x3f, 0x3e, 0x72, 0x80, 0x63, 0xdc, 0x6e, 0xcd, 0xdf, 0x38, 0xdd, 0xd2, 0x97, 0x3c,
-	0xd2, 0x9b, 0x83, 0x11, 0x9e, 0x70, 0xc1, 0xe2, 0xe3, 0x58, 0xf4, 0xa9, 0x40, 0x8a, 0x5d, 0xff,
-	0x7c, 0x42, 0x77, 0xd7, 0x1a, 0x5c, 0xb5, 0x7f, 0x84, 0x32, 0xc0, 0x03, 0x14, 0x8b, 0xe2, 0xd9,
-	0x6c, 0xd6, 0x3d, 0x02, 0xa9, 0x9e, 0x00, 0x9e, 0x47, 0xc2, 0x9a, 0x58, 0xd6, 0x9b, 0x86, 0xa9,
-	0xbf, 0x61, 0xb6, 0xee, 0x74, 0xdd, 0x61, 0xea, 0x05, 0x88, 0xf3, 0x90, 0x02, 0xe1, 0x70, 0x1e,
-	0xbf, 0x75, 0x70, 0x55, 0x3a, 0x34, 0x57, 0x77, 0x9d, 0xaa, 0xf2, 0xc5, 0x46, 0x92, 0x2e, 0xc0,
-	0x08, 0x33, 0x4c, 0xbd, 0x60, 0x88, 0x71, 0x24, 0x69, 0x3a, 0x90, 0x24, 0xaf, 0x7f, 0x82, 0xb9,
-	0x5f, 0x3a, 0x58, 0x2a, 0x1d, 0x9a, 0xa5, 0xcc, 0xcf, 0xa3, 0x70, 0x8c, 0x43, 0xa5, 0x5f, 0x10,
-	0x38, 0x8e, 0xaa, 0x87, 0xa6, 0x03, 0xd1, 0x04, 0xbc, 0xc9, 0xa4, 0xf9, 0x08, 0x96, 0x22, 0xac,
-	0xfc, 0xfa, 0xad, 0xd6, 0xd9, 0xfb, 0xe4, 0xd7, 0xbf, 0x3f, 0x3f, 0x92, 0xa1, 0x67, 0xd4, 0xe0,
-	0xe7, 0xa4, 0xd0, 0x94, 0xea, 0x4d, 0x3c, 0xe0, 0x3b, 0x6a, 0xb1, 0x29, 0xde, 0x2c, 0x74, 0x97,
-	0x40, 0xc2, 0xf3, 0x20, 0xa1, 0x8b, 0xbd, 0x23, 0x77, 0x3f, 0xbf, 0xa4, 0xa5, 0x88, 0xd6, 0x88,
-	0x75, 0xc5, 0xc5, 0x3a, 0x4f, 0xe7, 0x22, 0x62, 0xa5, 0x3f, 0x12, 0x18, 0xed, 0x92, 0xe9, 0x34,
-	0xd3, 0x3b, 0x74, 0xaf, 0xb7, 0x87, 0x94, 0x3d, 0x90, 0x0f, 0x82, 0x5e, 0x73, 0x41, 0x67, 0xe9,
-	0x72, 0x20, 0x68, 0xe6, 0x38, 0x17, 0x02, 0xe0, 0xff, 0x46, 0xe0, 0x54, 0x0f, 0x01, 0x4c, 0x5f,
-	0x8d, 0x0e, 0xc8, 0x2f, 0xd7, 0xa5, 0x73, 0x87, 0xf0, 0xc4, 0x84, 0x2e, 0xb9, 0x09, 0xad, 0xd2,
-	0xf3, 0x07, 0x4e, 0xc8, 0xad, 0x9d, 0xdb, 0x04, 0x12, 0x1e, 0x3d, 0x1c, 0x56, 0x3b, 0xdd, 0x22,
-	0x3d, 0xac, 0x76, 0x02, 0x44, 0xb6, 0x9c, 0x76, 0x51, 0x4f, 0xd1, 0xc9, 0x60, 0xd4, 0x02, 0xc6,
-	0x6d, 0x02, 0x43, 0x8e, 0x30, 0xa5, 0x21, 0x27, 0xa9, 0x43, 0xea, 0x4a, 0x0b, 0x51, 0x4c, 0x11,
-	0xcd, 0xb2, 0x8b, 0x66, 0x96, 0x9e, 0x0e, 0x41, 0xe3, 0xb2, 0xf5, 0x29, 0x81, 0xb8, 0x50, 0xa3,
-	0x74, 0xae, 0x77, 0x24, 0x9f, 0xf4, 0x95, 0xd2, 0xfd, 0x0d, 0xa3, 0xd3, 0x23, 0x74, 0x2f, 0xfd,
-	0x8e, 0xc0, 0x09, 0x9f, 0x0a, 0xa2, 0x4a, 0xef, 0x28, 0x41, 0x2a, 0x50, 0x52, 0x23, 0xdb, 0x23,
-	0xb8, 0x73, 0x2e, 0x38, 0x85, 0x2e, 0x06, 0x82, 0x13, 0x77, 0x45, 0xc1, 0x91, 0x4f, 0xea, 0x4d,
-	0x3e, 0xb0, 0x43, 0xff, 0x20, 0x20, 0xf5, 0xd6, 0x6c, 0xf4, 0xb5, 0x88, 0x50, 0x82, 0x94, 0xa2,
-	0xb4, 0x7a, 0x38, 0x67, 0x4c, 0x6a, 0xdd, 0x4d, 0xea, 0x2c, 0x5d, 0x89, 0x92, 0x54, 0xa1, 0xd8,
-	0x2c, 0xf0, 0x0b, 0xa4, 0xc0, 0x04, 0xfa, 0xaf, 0x09, 0xfc, 0xcf, 0xff, 0x2e, 0xa0, 0xfd, 0xb8,
-	0xed, 0x7c, 0xa8, 0x48, 0x67, 0xa2, 0x3b, 0x44, 0xaf, 0xdd, 0x0e, 0xe0, 0xf4, 0x4b, 0x02, 0x09,
-	0x8f, 0x42, 0x09, 0x3b, 0xe9, 0xdd, 0x7a, 0x37, 0xec, 0xa4, 0x07, 0x08, 0x44, 0xf9, 0xac, 0x8b,
-	0xef, 0x65, 0x3a, 0xdf, 0x1b, 0x1f, 0xea, 0xa1, 0x76, 0xa9, 0xfc, 0x40, 0x80, 0x76, 0xcb, 0x28,
-	0x9a, 0x8d, 0x14, 0xdd, 0xaf, 0xfa, 0xa4, 0x95, 0x83, 0x39, 0x21, 0xf2, 0x57, 0x5c, 0xe4, 0x8b,
-	0x74, 0xa1, 0x2f, 0xf2, 0x76, 0x3d, 0xd0, 0x3b, 0x04, 0x12, 0x1e, 0x55, 0x12, 0xc6, 0x6f, 0xb7,
-	0xf0, 0x0a, 0xe3, 0x37, 0x40, 0x2a, 0xc9, 0x8a, 0x8b, 0x72, 0x86, 0xbe, 0x14, 0xdc, 0xbb, 0x3c,
-	0x52, 0x2a, 0x97, 0x7d, 0xb0, 0x97, 0x24, 0x0f, 0xf7, 0x92, 0xe4, 0xaf, 0xbd, 0x24, 0xf9, 0x6c,
-	0x3f, 0x19, 0x7b, 0xb8, 0x9f, 0x8c, 0xfd, 0xbe, 0x9f, 0x8c, 0xbd, 0x8b, 0x3f, 0x4e, 0x33, 0x7d,
-	0x4b, 0xa9, 0x58, 0xea, 0x87, 0x62, 0x0d, 0xfe, 0xe3, 0x45, 0x31, 0xce, 0x7f, 0x73, 0xce, 0xfe,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x9a, 0x88, 0xc1, 0x96, 0x17, 0x00, 0x00,
+	// 1544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x99, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xc0, 0x33, 0x81, 0x84, 0xe4, 0x39, 0xa0, 0x6f, 0x26, 0xe1, 0x4b, 0xd8, 0x80, 0x1d, 0x16,
+	0x9a, 0x84, 0x34, 0xf1, 0x92, 0x1f, 0xe5, 0x57, 0x69, 0x24, 0x0c, 0x85, 0x43, 0x85, 0xa0, 0x4e,
+	0xb9, 0x94, 0x83, 0xb5, 0xf6, 0x6e, 0xdd, 0x55, 0xec, 0x5d, 0xe3, 0x71, 0x48, 0x2d, 0x94, 0xaa,
+	0x3f, 0x54, 0x89, 0x63, 0xa5, 0x72, 0x68, 0x91, 0x2a, 0xa1, 0x4a, 0x6d, 0x51, 0x2b, 0x21, 0x0e,
+	0xad, 0xd4, 0x43, 0x2f, 0x95, 0x7a, 0xe0, 0x88, 0xda, 0x43, 0xab, 0x1e, 0x68, 0x15, 0x2a, 0xc1,
+	0x9f, 0x51, 0x79, 0xe6, 0xad, 0x67, 0xd7, 0xde, 0xb5, 0xd7, 0xc1, 0x45, 0xa8, 0x97, 0xc4, 0x3b,
+	0xfb, 0xde, 0xbc, 0xcf, 0x7b, 0xf3, 0x66, 0xe6, 0x3d, 0x1b, 0x12, 0x39, 0x87, 0x15, 0x1d, 0xa6,
+	0x65, 0x75, 0x7b, 0x55, 0xbb, 0x36, 0x9f, 0x35, 0x2b, 0xfa, 0xbc, 0x76, 0x75, 0xcd, 0x2c, 0x57,
+	0x93, 0xa5, 0xb2, 0x53, 0x71, 0xe8, 0x88, 0x10, 0x48, 0xd6, 0x04, 0x92, 0x28, 0xa0, 0xcc, 0xd4,
+	0xb5, 0x98, 0x29, 0xa4, 0xeb, 0xba, 0x25, 0x3d, 0x6f, 0xd9, 0x7a, 0xc5, 0x72, 0x6c, 0x31, 0x81,
+	0x32, 0x9a, 0x77, 0xf2, 0x0e, 0xff, 0xa8, 0xd5, 0x3e, 0xe1, 0xe8, 0xbe, 0xbc, 0xe3, 0xe4, 0x0b,
+	0xa6, 0xa6, 0x97, 0x2c, 0x4d, 0xb7, 0x6d, 0xa7, 0xc2, 0x55, 0x18, 0xbe, 0x8d, 0x7b, 0xe7, 0x77,
+	0x67, 0xce, 0x39, 0x96, 0xdd, 0xf4, 0xde, 0x43, 0xcd, 0x09, 0xc5, 0xfb, 0xbd, 0xe2, 0x7d, 0x46,
+	0x98, 0x45, 0x0f, 0xc4, 0xab, 0x71, 0x54, 0x75, 0xa9, 0xbd, 0xce, 0x2a, 0xc3, 0x7a, 0xd1, 0xb2,
+	0x1d, 0x8d, 0xff, 0x15, 0x43, 0xaa, 0x05, 0x23, 0xaf, 0xd7, 0x24, 0x52, 0x7a, 0x41, 0xb7, 0x73,
+	0x66, 0xda, 0xbc, 0xba, 0x66, 0xb2, 0x0a, 0x5d, 0x80, 0x1d, 0xba, 0x61, 0x94, 0x4d, 0xc6, 0xc6,
+	0xc8, 0x04, 0x99, 0x1e, 0x4c, 0x8d, 0xfd, 0xf2, 0xdd, 0xdc, 0x28, 0x5a, 0x3a, 0x2d, 0xde, 0xac,
+	0x54, 0xca, 0x96, 0x9d, 0x4f, 0xbb, 0x82, 0x74, 0x14, 0xfa, 0x0c, 0xd3, 0x76, 0x8a, 0x63, 0xbd,
+	0x35, 0x8d, 0xb4, 0x78, 0x38, 0x39, 0x70, 0xe3, 0x76, 0xa2, 0xe7, 0xc9, 0xed, 0x44, 0x8f, 0xfa,
+	0x1a, 0x8c, 0xfa, 0x4d, 0xb1, 0x92, 0x63, 0x33, 0x93, 0x2e, 0xc2, 0x8e, 0xac, 0x18, 0xe2, 0xb6,
+	0x62, 0x0b, 0x7b, 0x93, 0xf5, 0x45, 0x61, 0xa6, 0xbb, 0x28, 0xc9, 0x33, 0x8e, 0x65, 0xa7, 0x5d,
+	0x49, 0xf5, 0x67, 0x02, 0x7b, 0xf8, 0x6c, 0xa7, 0x0b, 0x05, 0x9c, 0x90, 0x3d, 0x0d, 0xfc, 0x39,
+	0x00, 0xb9, 0xb4, 0xdc, 0x83, 0xd8, 0xc2, 0xa4, 0x8f, 0x43, 0x04, 0xd2, 0xa5, 0xb9, 0xa4, 0xe7,
+	0xdd, 0x60, 0xa5, 0x3d, 0x9a, 0xf4, 0x20, 0xec, 0x2c, 0x9b, 0xcc, 0x29, 0x5c, 0x33, 0x33, 0x22,
+	0x18, 0xdb, 0x26, 0xc8, 0xf4, 0x40, 0x7a, 0x08, 0x07, 0xcf, 0x36, 0xc4, 0x64, 0x93, 0xc0, 0x58,
+	0xb3, 0x1b, 0x18, 0x98, 0x0d, 0x18, 0x40, 0x77, 0x6b, 0x8e, 0x6c, 0x6b, 0x19, 0x99, 0xd4, 0xb9,
+	0xfb, 0x0f, 0x13, 0x3d, 0xdf, 0xfc, 0x99, 0x98, 0xce, 0x5b, 0x95, 0xb7, 0xd7, 0xb2, 0xc9, 0x9c,
+	0x53, 0xc4, 0xcc, 0xc0, 0x7f, 0x73, 0xcc, 0x58, 0xd5, 0x2a, 0xd5, 0x92, 0xc9, 0xb8, 0x02, 0xbb,
+	0xf5, 0xf8, 0xde, 0xcc, 0x50, 0xc1, 0xcc, 0xeb, 0xb9, 0x6a, 0xa6, 0x96, 0x7b, 0xec, 0xce, 0xe3,
+	0x7b, 0x33, 0x24, 0x5d, 0x37, 0x49, 0xcf, 0x07, 0x84, 0x64, 0xaa, 0x6d, 0x48, 0x04, 0xbb, 0x37,
+	0x26, 0xea, 0x97, 0x04, 0xf6, 0x73, 0x27, 0x57, 0x4a, 0xa6, 0x6d, 0xe8, 0xd9, 0x82, 0xf9, 0x1c,
+	0xad, 0x98, 0x67, 0x31, 0x9e, 0x10, 0x88, 0x87, 0x71, 0xfe, 0xc7, 0x96, 0xa4, 0x0a, 0x07, 0x03,
+	0x3d, 0x4d, 0x55, 0x79, 0x86, 0xfe, 0x9b, 0xc7, 0xc0, 0x15, 0x38, 0xd4, 0xda, 0xf4, 0xd3, 0x1c,
+	0x0b, 0xab, 0x78, 0x2a, 0xbc, 0xe1, 0x54, 0xf4, 0xc2, 0xca, 0x5a, 0xa9, 0x54, 0xa8, 0xba, 0xbe,
+	0xf8, 0xf3, 0x85, 0x74, 0x21, 0x5f, 0x1e, 0xba, 0x9b, 0xd7, 0x67, 0x0d, 0xf1, 0xab, 0xd0, 0xcf,
+	0xf8, 0xc8, 0xb3, 0xcb, 0x13, 0x34, 0xd8, 0xbd, 0x2c, 0x99, 0xc5, 0x13, 0x5b, 0xb8, 0x76, 0xf1,
+	0x2d, 0x37, 0x94, 0xf5, 0x25, 0x26, 0x9e, 0x25, 0x56, 0x2f, 0xc3, 0xee, 0x06, 0x69, 0x0c, 0xc5,
+	0x29, 0xe8, 0xd7, 0x8b, 0xce, 0x9a, 0x5d, 0x69, 0xbb, 0x90, 0xa9, 0xc1, 0x5a, 0x28, 0xd0, 0x1b,
+	0xa1, 0xa3, 0x1e, 0x83, 0x04, 0x9f, 0xf6, 0x8c, 0x55, 0xce, 0xad, 0x15, 0xf4, 0x8a, 0x65, 0xe7,
+	0xa3, 0xf1, 0x7c, 0x4f, 0x60, 0x22, 0x5c, 0x53, 0xb2, 0xd5, 0x97, 0xa9, 0x03, 0x36, 0x8c, 0xf4,
+	0x0a, 0xd0, 0x9c, 0x9c, 0x3c, 0x83, 0x33, 0xf5, 0x76, 0x30, 0xd3, 0x70, 0xae, 0x11, 0x4e, 0x1d,
+	0x05, 0xca, 0xb1, 0x2f, 0xe9, 0x65, 0xbd, 0xe8, 0x1e, 0x91, 0xea, 0x65, 0x18, 0xf1, 0x8d, 0x22,
+	0xff, 0x32, 0xf4, 0x97, 0xf8, 0x08, 0xf2, 0x8f, 0x27, 0x03, 0x0a, 0x9a, 0xa4, 0x50, 0xf2, 0x79,
+	0x20, 0xb4, 0x54, 0x03, 0x14, 0x3e, 0x2d, 0xdf, 0x7b, 0xec, 0x82, 0x59, 0xd1, 0x0d, 0xbd, 0xa2,
+	0x77, 0x79, 0xcf, 0xa8, 0x77, 0x09, 0x8c, 0x07, 0x9a, 0x41, 0x2f, 0xce, 0xc1, 0x60, 0x11, 0xc7,
+	0xdc, 0x73, 0x75, 0x7f, 0xa0, 0x23, 0xae, 0xa6, 0xd7, 0x15, 0xa9, 0xda, 0xbd, 0xcc, 0x9f, 0x87,
+	0xbd, 0x92, 0xb7, 0x31, 0x2a, 0xc1, 0xe9, 0x96, 0x05, 0x25, 0x48, 0x05, 0x3d, 0x3c, 0x0b, 0x03,
+	0x2e, 0x26, 0xc6, 0x31, 0xba, 0x83, 0x75, 0x4d, 0x75, 0x19, 0x26, 0x9b, 0x6d, 0xa4, 0xaa, 0x62,
+	0xdb, 0x89, 0x73, 0xb8, 0x25, 0xa3, 0x03, 0x53, 0x6d, 0xf5, 0xbb, 0x0a, 0xbc, 0x0e, 0x7b, 0xa4,
+	0xc1, 0x8b, 0xeb, 0xb6, 0x59, 0x66, 0x2d, 0x09, 0xbb, 0x75, 0xab, 0xab, 0xef, 0x11, 0x00, 0x69,
+	0x74, 0x4b, 0x17, 0xd9, 0xb2, 0xbc, 0x80, 0x3a, 0xd9, 0xd1, 0xf5, 0xbb, 0xe8, 0x6b, 0xf7, 0x7a,
+	0xf0, 0x39, 0x8f, 0xe1, 0x4d, 0xc1, 0x10, 0x77, 0x38, 0xe3, 0xf0, 0x71, 0x4c, 0xfa, 0x44, 0x60,
+	0x88, 0xa5, 0x7e, 0x3a, 0x66, 0xc8, 0xb9, 0xba, 0x97, 0xed, 0xef, 0x62, 0xdd, 0xe3, 0x01, 0xc5,
+	0xa4, 0x78, 0x36, 0x8b, 0x75, 0x97, 0x40, 0x22, 0x14, 0xe0, 0x79, 0x0c, 0x58, 0x15, 0xd3, 0x7a,
+	0xc5, 0xb4, 0x8d, 0x57, 0xed, 0x5a, 0x11, 0x63, 0xb8, 0x91, 0xfa, 0x3f, 0xf4, 0x73, 0x93, 0x82,
+	0x70, 0x30, 0x8d, 0x4f, 0x0d, 0xb1, 0xca, 0x6d, 0x39, 0x56, 0x77, 0xdc, 0xac, 0xf2, 0xd9, 0xc6,
+	0x20, 0x9d, 0x81, 0x21, 0x66, 0xda, 0x46, 0xc6, 0x14, 0xe3, 0x18, 0xa4, 0x89, 0xc0, 0x20, 0x79,
+	0xf5, 0x63, 0x4c, 0x3e, 0xd0, 0xf3, 0x01, 0xa4, 0x5b, 0x8a, 0x92, 0x0a, 0xff, 0x13, 0xa4, 0xeb,
+	0x7a, 0xc9, 0x0d, 0xcf, 0x2e, 0xe8, 0xb5, 0x0c, 0x9e, 0x45, 0xdb, 0xd3, 0xbd, 0x96, 0xa1, 0x5e,
+	0x80, 0x61, 0x8f, 0x0c, 0xba, 0x71, 0x1c, 0xb6, 0xb3, 0x75, 0xbd, 0xd4, 0x7c, 0x25, 0x7b, 0xf1,
+	0xd7, 0xf5, 0x92, 0x77, 0xdb, 0x71, 0x0d, 0xf5, 0x8a, 0x67, 0x3a, 0xd6, 0xed, 0x5b, 0xec, 0x33,
+	0x02, 0xd4, 0x3b, 0x3b, 0xd2, 0x9e, 0x84, 0xbe, 0x9a, 0xed, 0x80, 0x86, 0xa0, 0x05, 0xae, 0x50,
+	0xe9, 0x5a, 0x46, 0x2e, 0x7c, 0xba, 0x1b, 0xfa, 0x38, 0x1b, 0xfd, 0x9c, 0xc0, 0x0e, 0x2c, 0xa9,
+	0xe9, 0x74, 0x20, 0x4b, 0x40, 0xc3, 0xaf, 0x1c, 0x8e, 0x20, 0x29, 0xcc, 0xaa, 0xaf, 0xdc, 0xa8,
+	0x79, 0xf0, 0xc1, 0xaf, 0x7f, 0x7f, 0xd2, 0xbb, 0x40, 0x8f, 0x68, 0xc1, 0xdf, 0x55, 0x70, 0x15,
+	0xa6, 0x5d, 0xc7, 0xc3, 0x74, 0x43, 0xcb, 0x56, 0x45, 0x43, 0x4c, 0x6f, 0x13, 0x88, 0x79, 0xba,
+	0x5d, 0x3a, 0x1b, 0x6e, 0xb9, 0xb9, 0xb7, 0x57, 0xe6, 0x22, 0x4a, 0x23, 0xeb, 0x92, 0x64, 0x3d,
+	0x4c, 0xa7, 0x22, 0xb2, 0xd2, 0x1f, 0x09, 0x0c, 0x37, 0xf5, 0x80, 0x74, 0x21, 0xdc, 0x74, 0x58,
+	0x63, 0xab, 0x2c, 0x76, 0xa4, 0x83, 0xd0, 0xcb, 0x12, 0x7a, 0x91, 0xce, 0x07, 0x42, 0x33, 0x57,
+	0x39, 0x13, 0x80, 0xff, 0x1b, 0x81, 0x3d, 0x21, 0xdd, 0x15, 0x3d, 0x1e, 0x1d, 0xc8, 0xdf, 0x0b,
+	0x2a, 0x27, 0xb6, 0xa0, 0x89, 0x0e, 0x9d, 0x97, 0x0e, 0x9d, 0xa2, 0x27, 0x3b, 0x76, 0x48, 0xe6,
+	0xce, 0x4d, 0x02, 0x31, 0x4f, 0xb3, 0xd5, 0x2a, 0x77, 0x9a, 0x3b, 0x40, 0x65, 0x2e, 0xa2, 0x34,
+	0x52, 0x4f, 0x4b, 0xea, 0xfd, 0x74, 0x3c, 0x98, 0x5a, 0x60, 0xdc, 0x24, 0x30, 0xe0, 0x76, 0x16,
+	0xb4, 0xc5, 0x4e, 0x6a, 0xe8, 0x5b, 0x94, 0x99, 0x28, 0xa2, 0x48, 0x33, 0x2f, 0x69, 0x26, 0xe9,
+	0xa1, 0x16, 0x34, 0x32, 0x5a, 0x3f, 0x11, 0x18, 0x09, 0xe8, 0x7d, 0xe8, 0x52, 0xb8, 0xd9, 0xf0,
+	0x26, 0x4b, 0x79, 0xa9, 0x43, 0xad, 0xe8, 0xa7, 0x45, 0x03, 0xb7, 0xe6, 0x69, 0x89, 0xe8, 0x47,
+	0x04, 0xfa, 0x45, 0xf7, 0x42, 0xa7, 0xc2, 0x01, 0x7c, 0xad, 0x92, 0x32, 0xdd, 0x5e, 0x30, 0xfa,
+	0x12, 0x8b, 0x3e, 0x89, 0x7e, 0x4b, 0x60, 0xa7, 0xaf, 0x6a, 0xa6, 0xc9, 0x70, 0x2b, 0x41, 0x5d,
+	0x83, 0xa2, 0x45, 0x96, 0x47, 0xb8, 0x13, 0x12, 0x2e, 0x49, 0x67, 0x03, 0xe1, 0x44, 0x6d, 0x91,
+	0x71, 0xcb, 0x6d, 0xed, 0x3a, 0x1f, 0xd8, 0xa0, 0x7f, 0x10, 0x50, 0xc2, 0x6b, 0x7c, 0xfa, 0x72,
+	0x44, 0x94, 0xa0, 0xce, 0x42, 0x39, 0xb5, 0x35, 0x65, 0x74, 0xea, 0xb4, 0x74, 0xea, 0x28, 0x5d,
+	0x8a, 0xe2, 0x54, 0x26, 0x5b, 0xcd, 0xf0, 0x4b, 0x30, 0xc3, 0x04, 0xfd, 0x57, 0x04, 0x76, 0xf9,
+	0xfb, 0x48, 0xda, 0x2e, 0xb6, 0x8d, 0x8d, 0xad, 0x72, 0x24, 0xba, 0x42, 0xf4, 0xfd, 0xd7, 0x00,
+	0x4e, 0xbf, 0x20, 0x10, 0xf3, 0x54, 0xb4, 0xad, 0x4e, 0xab, 0xe6, 0xfe, 0x48, 0x99, 0x8b, 0x28,
+	0x8d, 0x7c, 0x47, 0x25, 0xdf, 0x8b, 0xf4, 0x70, 0x38, 0x1f, 0xd6, 0xcf, 0xf5, 0x54, 0xf9, 0x81,
+	0x00, 0x6d, 0x2e, 0xbb, 0xe9, 0x62, 0x24, 0xeb, 0xfe, 0x2e, 0x41, 0x59, 0xea, 0x4c, 0x09, 0xc9,
+	0x8f, 0x49, 0xf2, 0x59, 0x3a, 0xd3, 0x96, 0xbc, 0x9e, 0x0f, 0xf4, 0x16, 0x81, 0x98, 0xa7, 0x8a,
+	0x6d, 0x15, 0xdf, 0xe6, 0x42, 0x5d, 0x99, 0x8b, 0x28, 0x8d, 0x94, 0x49, 0x49, 0x79, 0x90, 0x1e,
+	0x08, 0x3e, 0xc7, 0x3c, 0xa5, 0x37, 0xfd, 0x90, 0xc0, 0xf6, 0x5a, 0xd1, 0x47, 0x5f, 0x68, 0x61,
+	0x47, 0x16, 0xc6, 0xca, 0x64, 0x3b, 0x31, 0xe4, 0x98, 0x95, 0x1c, 0x07, 0x68, 0x22, 0x98, 0xa3,
+	0x56, 0x5a, 0x6a, 0xd7, 0x2d, 0x63, 0x83, 0xbe, 0x4f, 0xa0, 0xaf, 0xa6, 0xce, 0x68, 0x9b, 0xf9,
+	0xeb, 0x69, 0x37, 0xd5, 0x56, 0x0e, 0x41, 0xa6, 0x24, 0xc8, 0x3e, 0xaa, 0x84, 0x83, 0xa4, 0x16,
+	0xef, 0x6f, 0xc6, 0xc9, 0x83, 0xcd, 0x38, 0xf9, 0x6b, 0x33, 0x4e, 0x3e, 0x7e, 0x14, 0xef, 0x79,
+	0xf0, 0x28, 0xde, 0xf3, 0xfb, 0xa3, 0x78, 0xcf, 0x9b, 0xf8, 0x3b, 0x16, 0x33, 0x56, 0x93, 0x96,
+	0xa3, 0xbd, 0x23, 0x94, 0xf9, 0xf7, 0x9c, 0xd9, 0x7e, 0xfe, 0xf3, 0xd4, 0xe2, 0x3f, 0x03, 0x00,
+	0x79, 0x28, 0xe7, 0xe5, 0xc1, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1552,6 +1876,12 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error)
+	// CirculatingSupplyOf queries the total and circulating supply of a single
+	// coin. The circulating supply excludes the balances of the non-circulating
+	// addresses configured on the module.
+	//
+	// Since: cosmos-sdk 0.51
+	CirculatingSupplyOf(ctx context.Context, in *QueryCirculatingSupplyOfRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyOfResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// DenomMetadata queries the client metadata of a given coin denomination.
@@ -1582,6 +1912,14 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// Swap queries an open atomic swap by its id.
+	//
+	// Since: cosmos-sdk 0.51
+	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
+	// Swaps queries all the open atomic swaps.
+	//
+	// Since: cosmos-sdk 0.51
+	Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error)
 }
 
 type queryClient struct {
@@ -1646,6 +1984,15 @@ func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, op
 	return out, nil
 }
 
+func (c *queryClient) CirculatingSupplyOf(ctx context.Context, in *QueryCirculatingSupplyOfRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyOfResponse, error) {
+	out := new(QueryCirculatingSupplyOfResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/CirculatingSupplyOf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/Params", in, out, opts...)
@@ -1709,6 +2056,24 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error) {
+	out := new(QuerySwapResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error) {
+	out := new(QuerySwapsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/Swaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1744,6 +2109,12 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error)
+	// CirculatingSupplyOf queries the total and circulating supply of a single
+	// coin. The circulating supply excludes the balances of the non-circulating
+	// addresses configured on the module.
+	//
+	// Since: cosmos-sdk 0.51
+	CirculatingSupplyOf(context.Context, *QueryCirculatingSupplyOfRequest) (*QueryCirculatingSupplyOfResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// DenomMetadata queries the client metadata of a given coin denomination.
@@ -1774,6 +2145,14 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// Swap queries an open atomic swap by its id.
+	//
+	// Since: cosmos-sdk 0.51
+	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
+	// Swaps queries all the open atomic swaps.
+	//
+	// Since: cosmos-sdk 0.51
+	Swaps(context.Context, *QuerySwapsRequest) (*QuerySwapsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1798,6 +2177,9 @@ func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTota
 func (*UnimplementedQueryServer) SupplyOf(ctx context.Context, req *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
 }
+func (*UnimplementedQueryServer) CirculatingSupplyOf(ctx context.Context, req *QueryCirculatingSupplyOfRequest) (*QueryCirculatingSupplyOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupplyOf not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1819,6 +2201,12 @@ func (*UnimplementedQueryServer) DenomOwnersByQuery(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (*UnimplementedQueryServer) Swap(ctx context.Context, req *QuerySwapRequest) (*QuerySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (*UnimplementedQueryServer) Swaps(ctx context.Context, req *QuerySwapsRequest) (*QuerySwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swaps not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1932,6 +2320,24 @@ func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CirculatingSupplyOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculatingSupplyOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculatingSupplyOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/CirculatingSupplyOf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculatingSupplyOf(ctx, req.(*QueryCirculatingSupplyOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -2058,15 +2464,51 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.bank.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Balance",
-			Handler:    _Query_Balance_Handler,
-		},
-		{
+func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swap(ctx, req.(*QuerySwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Swaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Swaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/Swaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Swaps(ctx, req.(*QuerySwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.bank.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Balance",
+			Handler:    _Query_Balance_Handler,
+		},
+		{
 			MethodName: "AllBalances",
 			Handler:    _Query_AllBalances_Handler,
 		},
@@ -2086,6 +2528,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyOf",
 			Handler:    _Query_SupplyOf_Handler,
 		},
+		{
+			MethodName: "CirculatingSupplyOf",
+			Handler:    _Query_CirculatingSupplyOf_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2114,6 +2560,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "Swap",
+			Handler:    _Query_Swap_Handler,
+		},
+		{
+			MethodName: "Swaps",
+			Handler:    _Query_Swaps_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -2602,6 +3056,79 @@ func (m *QuerySupplyOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCirculatingSupplyOfRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculatingSupplyOfRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculatingSupplyOfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCirculatingSupplyOfResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculatingSupplyOfResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculatingSupplyOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CirculatingSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3187,6 +3714,151 @@ func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3389,6 +4061,32 @@ func (m *QuerySupplyOfResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCirculatingSupplyOfRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCirculatingSupplyOfResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3614,8 +4312,63 @@ func (m *QuerySendEnabledResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QuerySwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Swap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -4887,6 +5640,204 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCirculatingSupplyOfRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyOfRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyOfResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyOfResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6385,6 +7336,364 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swaps = append(m.Swaps, Swap{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
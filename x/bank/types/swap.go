@@ -0,0 +1,40 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the swap fields. It checks:
+//   - Creator and, if set, Counterparty are valid distinct addresses
+//   - Offer and Ask are valid non-empty coins
+func (s Swap) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	if s.Counterparty != "" {
+		if _, err := sdk.AccAddressFromBech32(s.Counterparty); err != nil {
+			return fmt.Errorf("invalid counterparty address: %w", err)
+		}
+		if s.Counterparty == s.Creator {
+			return errors.New("counterparty cannot be the creator")
+		}
+	}
+
+	if s.Offer.Empty() || s.Ask.Empty() {
+		return errors.New("offer and ask cannot be empty")
+	}
+
+	if err := s.Offer.Validate(); err != nil {
+		return fmt.Errorf("invalid offer: %w", err)
+	}
+
+	if err := s.Ask.Validate(); err != nil {
+		return fmt.Errorf("invalid ask: %w", err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -14,18 +15,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -493,6 +498,421 @@ func (m *MsgBurnResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
 
+// MsgSendAndCall represents a message to send coins from one account to another
+// and to execute follow-up messages dispatched to the recipient, for instance
+// executing the contract account the coins are sent to, in the same atomic
+// operation. If any of the messages fails, the transfer is reverted.
+//
+// Since: cosmos-sdk 0.51
+type MsgSendAndCall struct {
+	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// msgs are the messages executed, in order, after the transfer. Their only
+	// signer must be from_address, and one of their address fields must be
+	// to_address, the account or contract they are dispatched to.
+	Msgs []*types1.Any `protobuf:"bytes,4,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgSendAndCall) Reset()         { *m = MsgSendAndCall{} }
+func (m *MsgSendAndCall) String() string { return proto.CompactTextString(m) }
+func (*MsgSendAndCall) ProtoMessage()    {}
+func (*MsgSendAndCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{10}
+}
+func (m *MsgSendAndCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendAndCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendAndCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendAndCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendAndCall.Merge(m, src)
+}
+func (m *MsgSendAndCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendAndCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendAndCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendAndCall proto.InternalMessageInfo
+
+// MsgSendAndCallResponse defines the Msg/SendAndCall response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgSendAndCallResponse struct {
+	// msg_responses are the responses of the follow-up messages, in order.
+	MsgResponses []*types1.Any `protobuf:"bytes,1,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+}
+
+func (m *MsgSendAndCallResponse) Reset()         { *m = MsgSendAndCallResponse{} }
+func (m *MsgSendAndCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendAndCallResponse) ProtoMessage()    {}
+func (*MsgSendAndCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{11}
+}
+func (m *MsgSendAndCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendAndCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendAndCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendAndCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendAndCallResponse.Merge(m, src)
+}
+func (m *MsgSendAndCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendAndCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendAndCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendAndCallResponse proto.InternalMessageInfo
+
+func (m *MsgSendAndCallResponse) GetMsgResponses() []*types1.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
+// MsgCreateSwap represents a message to create an atomic swap, offering coins
+// held in escrow in exchange for other coins until the expiry time.
+//
+// Since: cosmos-sdk 0.51
+type MsgCreateSwap struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// counterparty is the address of the only account allowed to accept the
+	// swap. Any account can accept it if empty.
+	Counterparty string                                   `protobuf:"bytes,2,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Offer        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=offer,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"offer"`
+	Ask          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=ask,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ask"`
+	Expiry       time.Time                                `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *MsgCreateSwap) Reset()         { *m = MsgCreateSwap{} }
+func (m *MsgCreateSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSwap) ProtoMessage()    {}
+func (*MsgCreateSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{12}
+}
+func (m *MsgCreateSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSwap.Merge(m, src)
+}
+func (m *MsgCreateSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSwap proto.InternalMessageInfo
+
+func (m *MsgCreateSwap) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateSwap) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *MsgCreateSwap) GetOffer() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Offer
+	}
+	return nil
+}
+
+func (m *MsgCreateSwap) GetAsk() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Ask
+	}
+	return nil
+}
+
+func (m *MsgCreateSwap) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+// MsgCreateSwapResponse defines the Msg/CreateSwap response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgCreateSwapResponse struct {
+	// id is the identifier of the swap created.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateSwapResponse) Reset()         { *m = MsgCreateSwapResponse{} }
+func (m *MsgCreateSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSwapResponse) ProtoMessage()    {}
+func (*MsgCreateSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{13}
+}
+func (m *MsgCreateSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSwapResponse.Merge(m, src)
+}
+func (m *MsgCreateSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSwapResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSwapResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgAcceptSwap represents a message to accept an atomic swap.
+//
+// Since: cosmos-sdk 0.51
+type MsgAcceptSwap struct {
+	Acceptor string `protobuf:"bytes,1,opt,name=acceptor,proto3" json:"acceptor,omitempty"`
+	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgAcceptSwap) Reset()         { *m = MsgAcceptSwap{} }
+func (m *MsgAcceptSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptSwap) ProtoMessage()    {}
+func (*MsgAcceptSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{14}
+}
+func (m *MsgAcceptSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptSwap.Merge(m, src)
+}
+func (m *MsgAcceptSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptSwap proto.InternalMessageInfo
+
+func (m *MsgAcceptSwap) GetAcceptor() string {
+	if m != nil {
+		return m.Acceptor
+	}
+	return ""
+}
+
+func (m *MsgAcceptSwap) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgAcceptSwapResponse defines the Msg/AcceptSwap response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgAcceptSwapResponse struct {
+}
+
+func (m *MsgAcceptSwapResponse) Reset()         { *m = MsgAcceptSwapResponse{} }
+func (m *MsgAcceptSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptSwapResponse) ProtoMessage()    {}
+func (*MsgAcceptSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{15}
+}
+func (m *MsgAcceptSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptSwapResponse.Merge(m, src)
+}
+func (m *MsgAcceptSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptSwapResponse proto.InternalMessageInfo
+
+// MsgCancelSwap represents a message to cancel an atomic swap.
+//
+// Since: cosmos-sdk 0.51
+type MsgCancelSwap struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelSwap) Reset()         { *m = MsgCancelSwap{} }
+func (m *MsgCancelSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSwap) ProtoMessage()    {}
+func (*MsgCancelSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{16}
+}
+func (m *MsgCancelSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSwap.Merge(m, src)
+}
+func (m *MsgCancelSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelSwap proto.InternalMessageInfo
+
+func (m *MsgCancelSwap) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelSwap) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelSwapResponse defines the Msg/CancelSwap response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgCancelSwapResponse struct {
+}
+
+func (m *MsgCancelSwapResponse) Reset()         { *m = MsgCancelSwapResponse{} }
+func (m *MsgCancelSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSwapResponse) ProtoMessage()    {}
+func (*MsgCancelSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{17}
+}
+func (m *MsgCancelSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSwapResponse.Merge(m, src)
+}
+func (m *MsgCancelSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelSwapResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
@@ -504,60 +924,91 @@ func init() {
 	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
 	proto.RegisterType((*MsgBurn)(nil), "cosmos.bank.v1beta1.MsgBurn")
 	proto.RegisterType((*MsgBurnResponse)(nil), "cosmos.bank.v1beta1.MsgBurnResponse")
+	proto.RegisterType((*MsgSendAndCall)(nil), "cosmos.bank.v1beta1.MsgSendAndCall")
+	proto.RegisterType((*MsgSendAndCallResponse)(nil), "cosmos.bank.v1beta1.MsgSendAndCallResponse")
+	proto.RegisterType((*MsgCreateSwap)(nil), "cosmos.bank.v1beta1.MsgCreateSwap")
+	proto.RegisterType((*MsgCreateSwapResponse)(nil), "cosmos.bank.v1beta1.MsgCreateSwapResponse")
+	proto.RegisterType((*MsgAcceptSwap)(nil), "cosmos.bank.v1beta1.MsgAcceptSwap")
+	proto.RegisterType((*MsgAcceptSwapResponse)(nil), "cosmos.bank.v1beta1.MsgAcceptSwapResponse")
+	proto.RegisterType((*MsgCancelSwap)(nil), "cosmos.bank.v1beta1.MsgCancelSwap")
+	proto.RegisterType((*MsgCancelSwapResponse)(nil), "cosmos.bank.v1beta1.MsgCancelSwapResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 753 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x4f, 0x13, 0x5b,
-	0x18, 0xed, 0xb4, 0xbc, 0x92, 0x5e, 0xfa, 0x1e, 0x61, 0x1e, 0x79, 0xd0, 0x81, 0x4c, 0xa1, 0x79,
-	0x21, 0x88, 0x32, 0x63, 0xc1, 0x68, 0x52, 0xa3, 0xd1, 0xa2, 0x24, 0x92, 0x34, 0x9a, 0x12, 0x17,
-	0xba, 0x69, 0xa6, 0x9d, 0xcb, 0x30, 0xa1, 0x33, 0xb7, 0x99, 0x7b, 0x87, 0xd0, 0x9d, 0x71, 0x65,
-	0x5c, 0xb9, 0x70, 0xe5, 0x8a, 0xa5, 0x31, 0x2e, 0x58, 0xb8, 0x34, 0x71, 0xcb, 0xc6, 0x84, 0xb8,
-	0x72, 0xa5, 0x06, 0x16, 0xe8, 0x7f, 0x61, 0xee, 0x8f, 0xb9, 0x0c, 0xa5, 0x63, 0x89, 0x6e, 0x68,
-	0xb9, 0xdf, 0x77, 0xce, 0xf7, 0x9d, 0x33, 0xf7, 0x74, 0xc0, 0x74, 0x0b, 0x61, 0x0f, 0x61, 0xb3,
-	0x69, 0xf9, 0x5b, 0xe6, 0x76, 0xb9, 0x09, 0x89, 0x55, 0x36, 0xc9, 0x8e, 0xd1, 0x09, 0x10, 0x41,
-	0xea, 0xbf, 0xbc, 0x6a, 0xd0, 0xaa, 0x21, 0xaa, 0xda, 0xb8, 0x83, 0x1c, 0xc4, 0xea, 0x26, 0xfd,
-	0xc6, 0x5b, 0x35, 0x5d, 0x12, 0x61, 0x28, 0x89, 0x5a, 0xc8, 0xf5, 0xcf, 0xd4, 0x63, 0x83, 0x18,
-	0x2f, 0xaf, 0x17, 0x78, 0xbd, 0xc1, 0x89, 0xc5, 0x5c, 0x5e, 0x9a, 0x10, 0x50, 0x0f, 0x3b, 0xe6,
-	0x76, 0x99, 0x7e, 0x88, 0xc2, 0x98, 0xe5, 0xb9, 0x3e, 0x32, 0xd9, 0x5f, 0x7e, 0x54, 0x7a, 0x9b,
-	0x06, 0xc3, 0x35, 0xec, 0xac, 0x43, 0xdf, 0x56, 0xaf, 0x83, 0xfc, 0x46, 0x80, 0xbc, 0x86, 0x65,
-	0xdb, 0x01, 0xc4, 0x78, 0x52, 0x99, 0x51, 0xe6, 0x73, 0xd5, 0xc9, 0x4f, 0xef, 0x16, 0xc7, 0x05,
-	0xff, 0x6d, 0x5e, 0x59, 0x27, 0x81, 0xeb, 0x3b, 0xf5, 0x11, 0xda, 0x2d, 0x8e, 0xd4, 0x6b, 0x00,
-	0x10, 0x24, 0xa1, 0xe9, 0x01, 0xd0, 0x1c, 0x41, 0x11, 0xb0, 0x0b, 0xb2, 0x96, 0x87, 0x42, 0x9f,
-	0x4c, 0x66, 0x66, 0x32, 0xf3, 0x23, 0x4b, 0x05, 0x43, 0x9a, 0x88, 0x61, 0x64, 0xa2, 0xb1, 0x82,
-	0x5c, 0xbf, 0xba, 0xba, 0xff, 0xa5, 0x98, 0x7a, 0xf3, 0xb5, 0x38, 0xef, 0xb8, 0x64, 0x33, 0x6c,
-	0x1a, 0x2d, 0xe4, 0x09, 0xe5, 0xe2, 0x63, 0x11, 0xdb, 0x5b, 0x26, 0xe9, 0x76, 0x20, 0x66, 0x00,
-	0xfc, 0xea, 0x78, 0x6f, 0x21, 0xdf, 0x86, 0x8e, 0xd5, 0xea, 0x36, 0xa8, 0xb7, 0xf8, 0xf5, 0xf1,
-	0xde, 0x82, 0x52, 0x17, 0x03, 0x2b, 0x97, 0x9f, 0xed, 0x16, 0x53, 0xdf, 0x77, 0x8b, 0xa9, 0xa7,
-	0xb4, 0x2f, 0xae, 0xfd, 0xf9, 0xf1, 0xde, 0x82, 0x1a, 0xe3, 0x14, 0x16, 0x95, 0xc6, 0xc0, 0xa8,
-	0xf8, 0x5a, 0x87, 0xb8, 0x83, 0x7c, 0x0c, 0x4b, 0xef, 0x15, 0x90, 0xaf, 0x61, 0xa7, 0x16, 0xb6,
-	0x89, 0xcb, 0x6c, 0xbc, 0x01, 0xb2, 0xae, 0xdf, 0x09, 0x09, 0x35, 0x90, 0x0a, 0xd2, 0x8c, 0x3e,
-	0xb7, 0xc2, 0xb8, 0x47, 0x5b, 0xaa, 0x39, 0xaa, 0x48, 0x2c, 0xc5, 0x41, 0xea, 0x2d, 0x30, 0x8c,
-	0x42, 0xc2, 0xf0, 0x69, 0x86, 0x9f, 0xea, 0x8b, 0xbf, 0xcf, 0x7a, 0xe2, 0x04, 0x11, 0xac, 0x72,
-	0x31, 0x92, 0x24, 0x28, 0xa9, 0x98, 0x89, 0xd3, 0x62, 0xe4, 0xb6, 0xa5, 0xff, 0xc0, 0x78, 0xfc,
-	0x7f, 0x29, 0xeb, 0x83, 0xc2, 0xa4, 0x3e, 0xec, 0xd8, 0x16, 0x81, 0x0f, 0xac, 0xc0, 0xf2, 0xb0,
-	0x7a, 0x15, 0xe4, 0xac, 0x90, 0x6c, 0xa2, 0xc0, 0x25, 0xdd, 0x81, 0xb7, 0xe3, 0xa4, 0x55, 0xbd,
-	0x09, 0xb2, 0x1d, 0xc6, 0xc0, 0xee, 0x45, 0x92, 0x22, 0x3e, 0xe4, 0x94, 0x25, 0x1c, 0x55, 0xb9,
-	0x42, 0xc5, 0x9c, 0xf0, 0x51, 0x3d, 0xb3, 0x31, 0x3d, 0x3b, 0x3c, 0x24, 0x3d, 0xdb, 0x96, 0x0a,
-	0x60, 0xa2, 0xe7, 0x48, 0x8a, 0xfb, 0xa1, 0x80, 0x31, 0xf6, 0x1c, 0x09, 0xd5, 0x7c, 0xd7, 0xb7,
-	0x9a, 0x6d, 0x68, 0xff, 0xb6, 0xbc, 0x15, 0x90, 0xc7, 0xd0, 0xb7, 0x1b, 0x90, 0xf3, 0x88, 0xc7,
-	0x36, 0xd3, 0x57, 0x64, 0x6c, 0x5e, 0x7d, 0x04, 0xc7, 0x86, 0xcf, 0x81, 0xd1, 0x10, 0xc3, 0x86,
-	0x0d, 0x37, 0xac, 0xb0, 0x4d, 0x1a, 0x1b, 0x28, 0x60, 0x79, 0xc8, 0xd5, 0xff, 0x0e, 0x31, 0xbc,
-	0xc3, 0x4f, 0x57, 0x51, 0x50, 0x31, 0xcf, 0x7a, 0x31, 0xdd, 0x7b, 0x51, 0xe3, 0xaa, 0x4a, 0x53,
-	0xa0, 0x70, 0xe6, 0x50, 0x1a, 0xf1, 0x52, 0x61, 0xf1, 0xaf, 0x86, 0x81, 0xff, 0x67, 0xf1, 0x2f,
-	0xcb, 0x14, 0xa7, 0x07, 0xa4, 0x58, 0xa6, 0xaf, 0x90, 0x98, 0x3e, 0x11, 0x33, 0xba, 0x55, 0xb4,
-	0xe9, 0xd2, 0xc7, 0x0c, 0xc8, 0xd4, 0xb0, 0xa3, 0xae, 0x81, 0x21, 0x96, 0xb2, 0xe9, 0xbe, 0xf6,
-	0x8a, 0x70, 0x6a, 0xff, 0xff, 0xaa, 0x1a, 0x71, 0xaa, 0x8f, 0x40, 0xee, 0x24, 0xb6, 0xb3, 0x49,
-	0x10, 0xd9, 0xa2, 0x5d, 0x18, 0xd8, 0x22, 0xa9, 0xd7, 0xc0, 0x10, 0x33, 0x35, 0x71, 0x4d, 0x5a,
-	0x4d, 0x5e, 0x33, 0x2e, 0x5d, 0x6d, 0x82, 0xfc, 0xa9, 0x18, 0x26, 0xa2, 0xe2, 0x5d, 0xda, 0xa5,
-	0xf3, 0x74, 0xc9, 0x19, 0x9b, 0xe0, 0x9f, 0x9e, 0x34, 0xcc, 0x25, 0x5b, 0x18, 0xef, 0xd3, 0x8c,
-	0xf3, 0xf5, 0x45, 0x93, 0xb4, 0xbf, 0x9e, 0xd0, 0x6c, 0x57, 0x97, 0xf7, 0x0f, 0x75, 0xe5, 0xe0,
-	0x50, 0x57, 0xbe, 0x1d, 0xea, 0xca, 0x8b, 0x23, 0x3d, 0x75, 0x70, 0xa4, 0xa7, 0x3e, 0x1f, 0xe9,
-	0xa9, 0xc7, 0xe2, 0xcd, 0x86, 0xed, 0x2d, 0xc3, 0x45, 0x51, 0xb8, 0xd9, 0x8f, 0x7a, 0x33, 0xcb,
-	0x5e, 0x5a, 0xcb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x58, 0x76, 0xf8, 0x86, 0x07, 0x00,
-	0x00,
+	// 1128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0x8e, 0xf3, 0xf5, 0xc4, 0x49, 0x95, 0xfd, 0xa6, 0x8d, 0xbd, 0x89, 0xec, 0x74,
+	0xa9, 0x4a, 0xea, 0x92, 0x35, 0x49, 0x2b, 0x2a, 0xcc, 0x0f, 0x11, 0x87, 0x56, 0xa2, 0x92, 0x05,
+	0x72, 0xe0, 0x00, 0x42, 0xb2, 0xc6, 0xde, 0xf1, 0x66, 0x15, 0xef, 0xce, 0x6a, 0x67, 0xb6, 0x8d,
+	0x2f, 0x88, 0x72, 0x42, 0x9c, 0x7a, 0xe0, 0xc4, 0xa9, 0x47, 0x84, 0x38, 0xe4, 0xd0, 0x23, 0x12,
+	0xd7, 0x88, 0x53, 0xc5, 0x89, 0x0b, 0x14, 0x25, 0x87, 0xc0, 0x7f, 0x81, 0x66, 0x76, 0x76, 0xbc,
+	0x76, 0xec, 0x6c, 0x54, 0xa4, 0x5e, 0xb8, 0x24, 0xde, 0x79, 0x9f, 0xf7, 0xde, 0xe7, 0xfd, 0x9c,
+	0x01, 0xab, 0x5d, 0x4c, 0x1c, 0x4c, 0x6a, 0x1d, 0xe8, 0xee, 0xd7, 0x1e, 0x6c, 0x76, 0x10, 0x85,
+	0x9b, 0x35, 0x7a, 0x60, 0x78, 0x3e, 0xa6, 0x58, 0xfd, 0x7f, 0x28, 0x35, 0x98, 0xd4, 0x10, 0x52,
+	0x6d, 0xc9, 0xc2, 0x16, 0xe6, 0xf2, 0x1a, 0xfb, 0x15, 0x42, 0xb5, 0x92, 0x85, 0xb1, 0xd5, 0x47,
+	0x35, 0xfe, 0xd5, 0x09, 0x7a, 0x35, 0xe8, 0x0e, 0x84, 0xa8, 0x32, 0x2e, 0xa2, 0xb6, 0x83, 0x08,
+	0x85, 0x8e, 0x27, 0x00, 0x65, 0x49, 0x82, 0x20, 0x49, 0xa2, 0x8b, 0x6d, 0xf7, 0x8c, 0x3c, 0x46,
+	0x92, 0x73, 0x12, 0xbe, 0x43, 0x79, 0x3b, 0x24, 0x25, 0x38, 0x87, 0xa2, 0x65, 0xa1, 0xea, 0x10,
+	0xab, 0xf6, 0x60, 0x93, 0xfd, 0x13, 0x82, 0x45, 0xe8, 0xd8, 0x2e, 0xae, 0xf1, 0xbf, 0xe1, 0x91,
+	0xfe, 0x63, 0x1a, 0xcc, 0x36, 0x89, 0xb5, 0x8b, 0x5c, 0x53, 0x7d, 0x0b, 0x14, 0x7a, 0x3e, 0x76,
+	0xda, 0xd0, 0x34, 0x7d, 0x44, 0x48, 0x51, 0x59, 0x53, 0xd6, 0xf3, 0x8d, 0xe2, 0xaf, 0x4f, 0x37,
+	0x96, 0x84, 0xfd, 0xed, 0x50, 0xb2, 0x4b, 0x7d, 0xdb, 0xb5, 0x5a, 0x73, 0x0c, 0x2d, 0x8e, 0xd4,
+	0x3b, 0x00, 0x50, 0x2c, 0x55, 0xd3, 0x09, 0xaa, 0x79, 0x8a, 0x23, 0xc5, 0x01, 0xc8, 0x41, 0x07,
+	0x07, 0x2e, 0x2d, 0x66, 0xd6, 0x32, 0xeb, 0x73, 0x5b, 0x25, 0x43, 0x16, 0x80, 0xa0, 0xa8, 0x00,
+	0xc6, 0x0e, 0xb6, 0xdd, 0xc6, 0xbd, 0xa3, 0x3f, 0x2a, 0xa9, 0x1f, 0x9e, 0x57, 0xd6, 0x2d, 0x9b,
+	0xee, 0x05, 0x1d, 0xa3, 0x8b, 0x1d, 0x11, 0xb9, 0xf8, 0xb7, 0x41, 0xcc, 0xfd, 0x1a, 0x1d, 0x78,
+	0x88, 0x70, 0x05, 0xf2, 0xdd, 0xe9, 0x61, 0xb5, 0xd0, 0x47, 0x16, 0xec, 0x0e, 0xda, 0x2c, 0xb7,
+	0xe4, 0xfb, 0xd3, 0xc3, 0xaa, 0xd2, 0x12, 0x0e, 0xeb, 0xaf, 0x7f, 0xfd, 0xa4, 0x92, 0xfa, 0xeb,
+	0x49, 0x25, 0xf5, 0x15, 0xc3, 0xc5, 0x63, 0xff, 0xe6, 0xf4, 0xb0, 0xaa, 0xc6, 0x6c, 0x8a, 0x14,
+	0xe9, 0x8b, 0xe0, 0x92, 0xf8, 0xd9, 0x42, 0xc4, 0xc3, 0x2e, 0x41, 0xfa, 0x4f, 0x0a, 0x28, 0x34,
+	0x89, 0xd5, 0x0c, 0xfa, 0xd4, 0xe6, 0x69, 0x7c, 0x07, 0xe4, 0x6c, 0xd7, 0x0b, 0x28, 0x4b, 0x20,
+	0x0b, 0x48, 0x33, 0x26, 0x74, 0x94, 0xf1, 0x01, 0x83, 0x34, 0xf2, 0x2c, 0x22, 0x41, 0x2a, 0x54,
+	0x52, 0xdf, 0x03, 0xb3, 0x38, 0xa0, 0x5c, 0x3f, 0xcd, 0xf5, 0x57, 0x26, 0xea, 0x7f, 0x18, 0xd0,
+	0x31, 0x03, 0x91, 0x5a, 0xfd, 0x66, 0x14, 0x92, 0x30, 0xc9, 0x82, 0x59, 0x1e, 0x0d, 0x46, 0xb2,
+	0xd5, 0xaf, 0x80, 0xa5, 0xf8, 0xb7, 0x0c, 0xeb, 0x67, 0x85, 0x87, 0xfa, 0x89, 0x67, 0x42, 0x8a,
+	0x3e, 0x82, 0x3e, 0x74, 0x88, 0xfa, 0x06, 0xc8, 0xc3, 0x80, 0xee, 0x61, 0xdf, 0xa6, 0x83, 0xc4,
+	0xee, 0x18, 0x42, 0xd5, 0x77, 0x41, 0xce, 0xe3, 0x16, 0x78, 0x5f, 0x4c, 0x8b, 0x28, 0x74, 0x32,
+	0x92, 0x92, 0x50, 0xab, 0x7e, 0x9b, 0x05, 0x33, 0xb4, 0xc7, 0xe2, 0xb9, 0x1a, 0x8b, 0xe7, 0x20,
+	0x1c, 0x92, 0x31, 0xb6, 0x7a, 0x09, 0x2c, 0x8f, 0x1d, 0xc9, 0xe0, 0xfe, 0x56, 0xc0, 0x22, 0xaf,
+	0x23, 0x65, 0x31, 0xdf, 0x75, 0x61, 0xa7, 0x8f, 0xcc, 0x17, 0x0e, 0x6f, 0x07, 0x14, 0x08, 0x72,
+	0xcd, 0x36, 0x0a, 0xed, 0x88, 0xb2, 0xad, 0x4d, 0x0c, 0x32, 0xe6, 0xaf, 0x35, 0x47, 0x62, 0xce,
+	0xaf, 0x83, 0x4b, 0x01, 0x41, 0x6d, 0x13, 0xf5, 0x60, 0xd0, 0xa7, 0xed, 0x1e, 0xf6, 0xf9, 0x3c,
+	0xe4, 0x5b, 0xf3, 0x01, 0x41, 0xef, 0x87, 0xa7, 0xf7, 0xb0, 0x5f, 0xaf, 0x9d, 0xcd, 0xc5, 0xea,
+	0x78, 0xa3, 0xc6, 0xa3, 0xd2, 0x57, 0x40, 0xe9, 0xcc, 0xa1, 0x4c, 0xc4, 0xb7, 0x0a, 0x1f, 0xff,
+	0x46, 0xe0, 0xbb, 0xff, 0x6e, 0xfc, 0x37, 0xe5, 0x14, 0xa7, 0x13, 0xa6, 0x58, 0x4e, 0x5f, 0x69,
+	0xea, 0xf4, 0x89, 0x31, 0x63, 0xac, 0x24, 0xd3, 0x47, 0x19, 0xb0, 0x20, 0x46, 0x6f, 0xdb, 0x35,
+	0x77, 0x60, 0xbf, 0xff, 0x9f, 0xdb, 0x57, 0xea, 0x5d, 0x90, 0x75, 0x88, 0x45, 0x8a, 0x59, 0xee,
+	0x78, 0xc9, 0x08, 0xef, 0x18, 0x23, 0xba, 0x63, 0x8c, 0x6d, 0x77, 0xd0, 0x58, 0xf9, 0xe5, 0xe9,
+	0xc6, 0xf2, 0x24, 0x46, 0x4d, 0x62, 0xb5, 0xb8, 0x7a, 0xfd, 0xce, 0xb9, 0x6b, 0xaf, 0x74, 0x76,
+	0xed, 0x89, 0x84, 0xeb, 0xbb, 0xe0, 0xca, 0xe8, 0x49, 0x54, 0x1d, 0xf5, 0x4d, 0x30, 0xef, 0x10,
+	0xab, 0xed, 0x8b, 0xef, 0x68, 0xf5, 0x4d, 0xa4, 0xd8, 0x2a, 0x38, 0xc4, 0x8a, 0x34, 0x89, 0xfe,
+	0x7b, 0x06, 0xcc, 0x37, 0x89, 0xb5, 0xe3, 0x23, 0x48, 0xd1, 0xee, 0x43, 0xe8, 0xa9, 0x5b, 0x60,
+	0xb6, 0xcb, 0xbe, 0xb0, 0x9f, 0x58, 0xd2, 0x08, 0xa8, 0xbe, 0x0d, 0x0a, 0x5d, 0x96, 0x23, 0xe4,
+	0x7b, 0xd0, 0xa7, 0x83, 0xc4, 0x82, 0x8e, 0xa0, 0xd5, 0x87, 0x60, 0x06, 0xf7, 0x7a, 0xc8, 0x7f,
+	0x79, 0x25, 0x0d, 0xfd, 0xa9, 0x04, 0x64, 0x20, 0xd9, 0x2f, 0x66, 0x5f, 0x96, 0x5b, 0xe6, 0x4d,
+	0xdd, 0x06, 0x39, 0x74, 0xe0, 0xd9, 0xfe, 0xa0, 0x38, 0xc3, 0xd7, 0xb1, 0x76, 0xa6, 0x4a, 0x1f,
+	0x47, 0x8f, 0x95, 0xc6, 0x3c, 0x73, 0xfc, 0xf8, 0x79, 0x45, 0x11, 0x9d, 0x18, 0x2a, 0xd6, 0x6f,
+	0xb0, 0xd6, 0x89, 0x92, 0xcf, 0xba, 0xa6, 0x38, 0xda, 0x35, 0xc3, 0x6a, 0xea, 0xaf, 0x82, 0xcb,
+	0x23, 0x07, 0xb2, 0x67, 0x16, 0x40, 0xda, 0x36, 0x79, 0x85, 0xb3, 0xad, 0xb4, 0x6d, 0xea, 0x8f,
+	0x14, 0xde, 0x08, 0xdb, 0xdd, 0x2e, 0xf2, 0x28, 0x6f, 0x84, 0xdb, 0xe0, 0x7f, 0x90, 0x7f, 0x5d,
+	0xa0, 0x13, 0x24, 0x52, 0xd8, 0x4d, 0x47, 0x76, 0xeb, 0x55, 0xc6, 0x55, 0x8a, 0x27, 0x90, 0x1d,
+	0x7a, 0xd4, 0x97, 0xc1, 0xe5, 0x91, 0x03, 0xb9, 0x7e, 0xbe, 0x08, 0x9b, 0x14, 0xba, 0x5d, 0xd4,
+	0x7f, 0xe1, 0x26, 0x1d, 0x67, 0x96, 0x98, 0x45, 0xe9, 0x4e, 0x10, 0x1b, 0x1e, 0x44, 0xc4, 0xb6,
+	0x8e, 0x72, 0x20, 0xd3, 0x24, 0x96, 0x7a, 0x1f, 0x64, 0xf9, 0xeb, 0x63, 0x75, 0xe2, 0xb5, 0x23,
+	0xc6, 0x56, 0xbb, 0x76, 0x9e, 0x54, 0x56, 0xe6, 0x53, 0x90, 0x1f, 0x3e, 0x67, 0xae, 0x4e, 0x53,
+	0x91, 0x10, 0xed, 0x46, 0x22, 0x44, 0x9a, 0xbe, 0x0f, 0xb2, 0xfc, 0xb2, 0x99, 0x4a, 0x93, 0x49,
+	0xb5, 0x6b, 0xe7, 0x49, 0xa5, 0xad, 0x0e, 0x28, 0x8c, 0x3c, 0x4f, 0xa6, 0x6a, 0xc5, 0x51, 0xda,
+	0x6b, 0x17, 0x41, 0x49, 0x1f, 0x7b, 0x60, 0x61, 0xec, 0x95, 0x70, 0x7d, 0x7a, 0x0a, 0xe3, 0x38,
+	0xcd, 0xb8, 0x18, 0x4e, 0x7a, 0x6a, 0x83, 0xb9, 0xf8, 0xe5, 0xf6, 0xca, 0x79, 0x95, 0x12, 0x20,
+	0xed, 0xe6, 0x05, 0x40, 0xd2, 0xc1, 0xe7, 0x00, 0xc4, 0x96, 0xac, 0x3e, 0x4d, 0x75, 0x88, 0xd1,
+	0xaa, 0xc9, 0x98, 0xb8, 0xf5, 0xd8, 0xe4, 0x4e, 0xb5, 0x3e, 0xc4, 0x68, 0xd5, 0x64, 0xcc, 0x08,
+	0xf7, 0xe1, 0xec, 0x4d, 0xe7, 0x2e, 0x31, 0x5a, 0x35, 0x19, 0x13, 0x59, 0xd7, 0x66, 0xbe, 0x64,
+	0xcb, 0xad, 0x71, 0xeb, 0xe8, 0xb8, 0xac, 0x3c, 0x3b, 0x2e, 0x2b, 0x7f, 0x1e, 0x97, 0x95, 0xc7,
+	0x27, 0xe5, 0xd4, 0xb3, 0x93, 0x72, 0xea, 0xb7, 0x93, 0x72, 0xea, 0x33, 0x71, 0x27, 0x12, 0x73,
+	0xdf, 0xb0, 0x71, 0xf4, 0xde, 0xe4, 0xdb, 0xb6, 0x93, 0xe3, 0x4b, 0xf3, 0xd6, 0x3f, 0x03, 0x00,
+	0xc8, 0xa2, 0x8d, 0xd3, 0x55, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -592,6 +1043,27 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SendAndCall defines a method for sending coins from one account to another
+	// account and executing follow-up messages, signed by the sender, atomically
+	// with the transfer.
+	//
+	// Since: cosmos-sdk 0.51
+	SendAndCall(ctx context.Context, in *MsgSendAndCall, opts ...grpc.CallOption) (*MsgSendAndCallResponse, error)
+	// CreateSwap defines a method for creating an atomic swap, holding the
+	// offered coins in escrow until the swap is accepted or cancelled.
+	//
+	// Since: cosmos-sdk 0.51
+	CreateSwap(ctx context.Context, in *MsgCreateSwap, opts ...grpc.CallOption) (*MsgCreateSwapResponse, error)
+	// AcceptSwap defines a method for accepting an atomic swap, paying the asked
+	// coins to its creator in exchange for the offered coins.
+	//
+	// Since: cosmos-sdk 0.51
+	AcceptSwap(ctx context.Context, in *MsgAcceptSwap, opts ...grpc.CallOption) (*MsgAcceptSwapResponse, error)
+	// CancelSwap defines a method for cancelling an atomic swap, refunding the
+	// offered coins to its creator.
+	//
+	// Since: cosmos-sdk 0.51
+	CancelSwap(ctx context.Context, in *MsgCancelSwap, opts ...grpc.CallOption) (*MsgCancelSwapResponse, error)
 }
 
 type msgClient struct {
@@ -647,16 +1119,52 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Send defines a method for sending coins from one account to another account.
-	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
-	// MultiSend defines a method for sending coins from some accounts to other accounts.
-	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
-	// Burn defines a method for burning coins by an account.
-	//
-	// Since: cosmos-sdk 0.51
-	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+func (c *msgClient) SendAndCall(ctx context.Context, in *MsgSendAndCall, opts ...grpc.CallOption) (*MsgSendAndCallResponse, error) {
+	out := new(MsgSendAndCallResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SendAndCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSwap(ctx context.Context, in *MsgCreateSwap, opts ...grpc.CallOption) (*MsgCreateSwapResponse, error) {
+	out := new(MsgCreateSwapResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/CreateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptSwap(ctx context.Context, in *MsgAcceptSwap, opts ...grpc.CallOption) (*MsgAcceptSwapResponse, error) {
+	out := new(MsgAcceptSwapResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/AcceptSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSwap(ctx context.Context, in *MsgCancelSwap, opts ...grpc.CallOption) (*MsgCancelSwapResponse, error) {
+	out := new(MsgCancelSwapResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/CancelSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Send defines a method for sending coins from one account to another account.
+	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// MultiSend defines a method for sending coins from some accounts to other accounts.
+	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
+	// Burn defines a method for burning coins by an account.
+	//
+	// Since: cosmos-sdk 0.51
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
 	// UpdateParams defines a governance operation for updating the x/bank module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -669,6 +1177,27 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SendAndCall defines a method for sending coins from one account to another
+	// account and executing follow-up messages, signed by the sender, atomically
+	// with the transfer.
+	//
+	// Since: cosmos-sdk 0.51
+	SendAndCall(context.Context, *MsgSendAndCall) (*MsgSendAndCallResponse, error)
+	// CreateSwap defines a method for creating an atomic swap, holding the
+	// offered coins in escrow until the swap is accepted or cancelled.
+	//
+	// Since: cosmos-sdk 0.51
+	CreateSwap(context.Context, *MsgCreateSwap) (*MsgCreateSwapResponse, error)
+	// AcceptSwap defines a method for accepting an atomic swap, paying the asked
+	// coins to its creator in exchange for the offered coins.
+	//
+	// Since: cosmos-sdk 0.51
+	AcceptSwap(context.Context, *MsgAcceptSwap) (*MsgAcceptSwapResponse, error)
+	// CancelSwap defines a method for cancelling an atomic swap, refunding the
+	// offered coins to its creator.
+	//
+	// Since: cosmos-sdk 0.51
+	CancelSwap(context.Context, *MsgCancelSwap) (*MsgCancelSwapResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -690,6 +1219,18 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (*UnimplementedMsgServer) SendAndCall(ctx context.Context, req *MsgSendAndCall) (*MsgSendAndCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAndCall not implemented")
+}
+func (*UnimplementedMsgServer) CreateSwap(ctx context.Context, req *MsgCreateSwap) (*MsgCreateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwap not implemented")
+}
+func (*UnimplementedMsgServer) AcceptSwap(ctx context.Context, req *MsgAcceptSwap) (*MsgAcceptSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSwap not implemented")
+}
+func (*UnimplementedMsgServer) CancelSwap(ctx context.Context, req *MsgCancelSwap) (*MsgCancelSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSwap not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -785,6 +1326,78 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SendAndCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendAndCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendAndCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SendAndCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendAndCall(ctx, req.(*MsgSendAndCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/CreateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSwap(ctx, req.(*MsgCreateSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/AcceptSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptSwap(ctx, req.(*MsgAcceptSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/CancelSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSwap(ctx, req.(*MsgCancelSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -809,6 +1422,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SendAndCall",
+			Handler:    _Msg_SendAndCall_Handler,
+		},
+		{
+			MethodName: "CreateSwap",
+			Handler:    _Msg_CreateSwap_Handler,
+		},
+		{
+			MethodName: "AcceptSwap",
+			Handler:    _Msg_AcceptSwap_Handler,
+		},
+		{
+			MethodName: "CancelSwap",
+			Handler:    _Msg_CancelSwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -1168,102 +1797,421 @@ func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSendAndCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSendAndCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendAndCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSendAndCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMultiSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSendAndCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendAndCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMultiSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgCreateSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Ask) > 0 {
+		for iNdEx := len(m.Ask) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ask[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Offer) > 0 {
+		for iNdEx := len(m.Offer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Acceptor) > 0 {
+		i -= len(m.Acceptor)
+		copy(dAtA[i:], m.Acceptor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Acceptor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
 
 func (m *MsgSetSendEnabled) Size() (n int) {
 	if m == nil {
@@ -1327,13 +2275,681 @@ func (m *MsgBurnResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSendAndCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendAndCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Offer) > 0 {
+		for _, e := range m.Offer {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Ask) > 0 {
+		for _, e := range m.Ask {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAcceptSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Acceptor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAcceptSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSend) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1356,15 +2972,15 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSendEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1392,11 +3008,45 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1424,9 +3074,141 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1455,7 +3237,7 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
+			m.Amount = append(m.Amount, &types.Coin{})
 			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1481,7 +3263,7 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1504,10 +3286,10 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1531,7 +3313,7 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+func (m *MsgSendAndCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1554,15 +3336,79 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendAndCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendAndCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1589,14 +3435,14 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, Input{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1623,8 +3469,8 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, Output{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1649,7 +3495,7 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendAndCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1672,12 +3518,46 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendAndCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendAndCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types1.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1699,7 +3579,7 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1722,15 +3602,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1758,11 +3638,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1789,7 +3701,75 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offer = append(m.Offer, types.Coin{})
+			if err := m.Offer[len(m.Offer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ask = append(m.Ask, types.Coin{})
+			if err := m.Ask[len(m.Ask)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1814,7 +3794,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1837,12 +3817,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1864,7 +3863,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1887,15 +3886,15 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSendEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acceptor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1923,47 +3922,13 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Acceptor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
-			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
-			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1973,24 +3938,11 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2012,7 +3964,7 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2035,10 +3987,10 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSendEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2062,7 +4014,7 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2085,15 +4037,15 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2121,13 +4073,13 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2137,26 +4089,11 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, &types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2178,7 +4115,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2201,10 +4138,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	"github.com/cosmos/cosmos-sdk/x/consensus/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -28,9 +29,10 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
-	Config      *modulev1.Module
-	Cdc         codec.Codec
-	Environment appmodule.Environment
+	Config        *modulev1.Module
+	Cdc           codec.Codec
+	Environment   appmodule.Environment
+	StakingKeeper types.StakingKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -49,6 +51,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 
 	k := keeper.NewKeeper(in.Cdc, in.Environment, authority.String())
+	if in.StakingKeeper != nil {
+		k.SetStakingKeeper(in.StakingKeeper)
+	}
 	m := NewAppModule(in.Cdc, k)
 	baseappOpt := func(app *baseapp.BaseApp) {
 		app.SetParamStore(k.ParamsStore)
@@ -23,8 +23,9 @@ var StoreKey = "Consensus"
 type Keeper struct {
 	environment appmodule.Environment
 
-	authority   string
-	ParamsStore collections.Item[cmtproto.ConsensusParams]
+	authority     string
+	stakingKeeper types.StakingKeeper
+	ParamsStore   collections.Item[cmtproto.ConsensusParams]
 }
 
 var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
@@ -42,6 +43,12 @@ func (k *Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// SetStakingKeeper sets the staking keeper the evidence parameters are validated
+// against on update. It must be set before the keeper is passed to the module.
+func (k *Keeper) SetStakingKeeper(sk types.StakingKeeper) {
+	k.stakingKeeper = sk
+}
+
 // Querier
 
 var _ types.QueryServer = Keeper{}
@@ -73,6 +80,10 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*
 		return nil, err
 	}
 
+	if err := k.validateEvidenceMaxAge(ctx, consensusParams); err != nil {
+		return nil, err
+	}
+
 	if err := k.ParamsStore.Set(ctx, consensusParams); err != nil {
 		return nil, err
 	}
@@ -87,6 +98,26 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// validateEvidenceMaxAge rejects an evidence max age duration longer than the staking
+// unbonding time: evidence accepted once the stake bonded at the time of the
+// infraction has unbonded would leave the equivocation unpunished.
+func (k Keeper) validateEvidenceMaxAge(ctx context.Context, params cmtproto.ConsensusParams) error {
+	if k.stakingKeeper == nil || params.Evidence == nil {
+		return nil
+	}
+
+	unbondingTime, err := k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.Evidence.MaxAgeDuration > unbondingTime {
+		return fmt.Errorf("evidence max age duration %s must not be longer than the unbonding time %s", params.Evidence.MaxAgeDuration, unbondingTime)
+	}
+
+	return nil
+}
+
 // SetParams sets the consensus parameters on init of a chain. This is a consensus message. It can only be called by the consensus server
 // This is used in the consensus message handler set in module.go.
 func (k Keeper) SetParams(ctx context.Context, req *types.ConsensusMsgParams) (*types.ConsensusMsgParamsResponse, error) {
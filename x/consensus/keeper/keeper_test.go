@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -243,6 +245,45 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 	}
 }
 
+type mockStakingKeeper struct {
+	unbondingTime time.Duration
+}
+
+func (m mockStakingKeeper) UnbondingTime(context.Context) (time.Duration, error) {
+	return m.unbondingTime, nil
+}
+
+func (s *KeeperTestSuite) TestUpdateParamsEvidenceMaxAge() {
+	defaultConsensusParams := cmttypes.DefaultConsensusParams().ToProto()
+	newMsg := func(maxAgeDuration time.Duration) *types.MsgUpdateParams {
+		return &types.MsgUpdateParams{
+			Authority: s.consensusParamsKeeper.GetAuthority(),
+			Block:     defaultConsensusParams.Block,
+			Validator: defaultConsensusParams.Validator,
+			Evidence: &cmtproto.EvidenceParams{
+				MaxAgeNumBlocks: defaultConsensusParams.Evidence.MaxAgeNumBlocks,
+				MaxAgeDuration:  maxAgeDuration,
+				MaxBytes:        defaultConsensusParams.Evidence.MaxBytes,
+			},
+			Abci: defaultConsensusParams.Abci,
+		}
+	}
+
+	s.SetupTest()
+
+	// without a staking keeper, the evidence max age is not checked
+	_, err := s.consensusParamsKeeper.UpdateParams(s.ctx, newMsg(30*24*time.Hour))
+	s.Require().NoError(err)
+
+	s.consensusParamsKeeper.SetStakingKeeper(mockStakingKeeper{unbondingTime: 21 * 24 * time.Hour})
+
+	_, err = s.consensusParamsKeeper.UpdateParams(s.ctx, newMsg(30*24*time.Hour))
+	s.Require().ErrorContains(err, "must not be longer than the unbonding time")
+
+	_, err = s.consensusParamsKeeper.UpdateParams(s.ctx, newMsg(21*24*time.Hour))
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestSetParams() {
 	defaultConsensusParams := cmttypes.DefaultConsensusParams().ToProto()
 	testCases := []struct {
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"context"
+	"time"
+)
+
+// StakingKeeper defines the staking module interface contract needed by the
+// consensus module to validate the evidence parameters.
+type StakingKeeper interface {
+	UnbondingTime(context.Context) (time.Duration, error)
+}
@@ -19,5 +19,9 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) {
 		// skip running the invariant check
 		return
 	}
+	if budget := k.InvariantsBudget(); !budget.IsZero() {
+		k.AssertInvariantsWithBudget(sdkCtx, budget)
+		return
+	}
 	k.AssertInvariants(sdkCtx)
 }
@@ -79,6 +79,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	var skipGenesisInvariants bool
 	if in.AppOpts != nil {
 		skipGenesisInvariants = cast.ToBool(in.AppOpts.Get(FlagSkipGenesisInvariants))
+		k.SetInvariantsBudget(keeper.InvariantsBudget{
+			Gas:  cast.ToUint64(in.AppOpts.Get(FlagInvariantsGasBudget)),
+			Time: cast.ToDuration(in.AppOpts.Get(FlagInvariantsTimeBudget)),
+		})
 	}
 
 	m := NewAppModule(k, in.Codec, skipGenesisInvariants)
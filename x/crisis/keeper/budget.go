@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvariantsBudget bounds the resources spent by a single invariants check.
+// A zero value leaves the corresponding resource unbounded.
+type InvariantsBudget struct {
+	// Gas is the total gas the invariants of a check may consume.
+	Gas storetypes.Gas
+	// Time is the total wall-clock time the invariants of a check may take.
+	Time time.Duration
+}
+
+// IsZero returns true if the budget bounds neither gas nor time.
+func (b InvariantsBudget) IsZero() bool {
+	return b.Gas == 0 && b.Time == 0
+}
+
+// InvariantResult is the outcome of a single invariant within a budgeted check.
+type InvariantResult struct {
+	Route string
+	// Checked is false when the budget ran out before the invariant completed.
+	Checked  bool
+	GasUsed  storetypes.Gas
+	Duration time.Duration
+}
+
+// InvariantsReport is the outcome of a budgeted invariants check.
+type InvariantsReport struct {
+	// Results holds the invariants run by the check, in execution order.
+	Results []InvariantResult
+	// Cursor is the index of the invariant the next check starts from.
+	Cursor int
+	// Complete is true when every registered invariant was checked.
+	Complete bool
+}
+
+// invariantsCursor holds the position of the next budgeted invariants check.
+// It is kept behind a pointer so that copies of the keeper share it.
+type invariantsCursor struct {
+	mu   sync.Mutex
+	next int
+}
+
+// budgetGasMeter wraps a gas meter so that it runs out of gas once the
+// deadline is passed, aborting the invariant being run.
+type budgetGasMeter struct {
+	storetypes.GasMeter
+	deadline time.Time
+}
+
+func (m budgetGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	if !m.deadline.IsZero() && time.Now().After(m.deadline) {
+		panic(storetypes.ErrorOutOfGas{Descriptor: "invariants time budget"})
+	}
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// SetInvariantsBudget sets the budget used by the invariants checks of the
+// EndBlocker. A zero budget asserts all invariants on every check.
+func (k *Keeper) SetInvariantsBudget(budget InvariantsBudget) {
+	k.invBudget = budget
+}
+
+// InvariantsBudget returns the budget of the invariants checks.
+func (k *Keeper) InvariantsBudget() InvariantsBudget { return k.invBudget }
+
+// AssertInvariantsWithBudget asserts the registered invariants, starting from
+// the invariant following the last one checked, until all of them are checked
+// or the budget is spent. Each invariant runs in a branched context metered
+// against what is left of the budget. An invariant running out of budget does
+// not halt the chain: it is reported as not checked and the next check resumes
+// from it, unless it was the first of the check, in which case it can never
+// fit the budget and is skipped. If any invariant fails, the method panics.
+func (k *Keeper) AssertInvariantsWithBudget(ctx context.Context, budget InvariantsBudget) InvariantsReport {
+	logger := k.Logger(ctx)
+
+	k.cursor.mu.Lock()
+	defer k.cursor.mu.Unlock()
+
+	start := time.Now()
+	var deadline time.Time
+	if budget.Time > 0 {
+		deadline = start.Add(budget.Time)
+	}
+
+	invarRoutes := k.Routes()
+	n := len(invarRoutes)
+	if k.cursor.next >= n {
+		k.cursor.next = 0
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	report := InvariantsReport{Cursor: k.cursor.next, Complete: n == 0}
+	var gasUsed storetypes.Gas
+	for j := 0; j < n; j++ {
+		i := (k.cursor.next + j) % n
+		ir := invarRoutes[i]
+
+		var meter storetypes.GasMeter
+		if budget.Gas > 0 {
+			meter = storetypes.NewGasMeter(budget.Gas - gasUsed)
+		} else {
+			meter = storetypes.NewInfiniteGasMeter()
+		}
+
+		invStart := time.Now()
+		invCtx, _ := sdkCtx.CacheContext()
+		res, stop, ok := runInvariant(invCtx.WithGasMeter(budgetGasMeter{GasMeter: meter, deadline: deadline}), ir.Invar)
+		report.Results = append(report.Results, InvariantResult{
+			Route:    ir.FullRoute(),
+			Checked:  ok,
+			GasUsed:  meter.GasConsumed(),
+			Duration: time.Since(invStart),
+		})
+		gasUsed += meter.GasConsumedToLimit()
+
+		if !ok {
+			if j == 0 {
+				logger.Error("invariant exceeds the invariants budget, skipping it", "name", ir.FullRoute())
+				report.Cursor = (i + 1) % n
+			} else {
+				report.Cursor = i
+			}
+			break
+		}
+
+		if stop {
+			// TODO: Include app name as part of context to allow for this to be
+			// variable.
+			panic(fmt.Errorf("invariant broken: %s\n"+
+				"\tCRITICAL please submit the following transaction:\n"+
+				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+		}
+
+		report.Cursor = (i + 1) % n
+		if j == n-1 {
+			report.Complete = true
+		}
+	}
+	k.cursor.next = report.Cursor
+
+	var checked int
+	for _, r := range report.Results {
+		if r.Checked {
+			checked++
+		}
+	}
+
+	logger.Info("asserted invariants within budget", "checked", fmt.Sprint(checked, "/", n),
+		"gas", gasUsed, "duration", time.Since(start), "height", sdkCtx.BlockHeight())
+	if !report.Complete {
+		logger.Info("invariants check will resume", "next", invarRoutes[report.Cursor].FullRoute())
+	}
+
+	return report
+}
+
+// runInvariant runs the invariant, returning ok as false if it ran out of gas.
+func runInvariant(ctx sdk.Context, invar sdk.Invariant) (res string, stop, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(storetypes.ErrorOutOfGas); !isOutOfGas {
+				panic(r)
+			}
+			ok = false
+		}
+	}()
+
+	res, stop = invar(ctx)
+	return res, stop, true
+}
@@ -19,6 +19,8 @@ import (
 type Keeper struct {
 	routes         []types.InvarRoute
 	invCheckPeriod uint
+	invBudget      InvariantsBudget
+	cursor         *invariantsCursor
 	storeService   storetypes.KVStoreService
 	cdc            codec.BinaryCodec
 
@@ -47,6 +49,7 @@ func NewKeeper(
 		cdc:              cdc,
 		routes:           make([]types.InvarRoute, 0),
 		invCheckPeriod:   invCheckPeriod,
+		cursor:           &invariantsCursor{},
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
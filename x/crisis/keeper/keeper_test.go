@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -67,3 +68,68 @@ func TestAssertInvariants(t *testing.T) {
 	keeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { keeper.AssertInvariants(testCtx.Ctx) })
 }
+
+func TestAssertInvariantsWithBudget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, crisis.AppModule{})
+	k := keeper.NewKeeper(encCfg.Codec, storeService, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	consume := func(gas storetypes.Gas) sdk.Invariant {
+		return func(ctx sdk.Context) (string, bool) {
+			ctx.GasMeter().ConsumeGas(gas, "test")
+			return "", false
+		}
+	}
+	k.RegisterRoute("testModule", "testRoute1", consume(40))
+	k.RegisterRoute("testModule", "testRoute2", consume(40))
+	k.RegisterRoute("testModule", "testRoute3", consume(40))
+
+	// the budget runs out on the third invariant, which the next check resumes from
+	report := k.AssertInvariantsWithBudget(testCtx.Ctx, keeper.InvariantsBudget{Gas: 100})
+	require.False(t, report.Complete)
+	require.Equal(t, 2, report.Cursor)
+	require.Len(t, report.Results, 3)
+	require.True(t, report.Results[0].Checked)
+	require.True(t, report.Results[1].Checked)
+	require.False(t, report.Results[2].Checked)
+	require.Equal(t, "testModule/testRoute3", report.Results[2].Route)
+
+	report = k.AssertInvariantsWithBudget(testCtx.Ctx, keeper.InvariantsBudget{Gas: 100})
+	require.False(t, report.Complete)
+	require.Equal(t, 1, report.Cursor)
+	require.Equal(t, "testModule/testRoute3", report.Results[0].Route)
+	require.True(t, report.Results[0].Checked)
+
+	// an unbounded budget checks all invariants, starting from the cursor
+	report = k.AssertInvariantsWithBudget(testCtx.Ctx, keeper.InvariantsBudget{})
+	require.True(t, report.Complete)
+	require.Equal(t, 1, report.Cursor)
+	require.Len(t, report.Results, 3)
+	require.Equal(t, "testModule/testRoute2", report.Results[0].Route)
+
+	// an invariant exceeding the whole budget is skipped
+	report = k.AssertInvariantsWithBudget(testCtx.Ctx, keeper.InvariantsBudget{Gas: 30})
+	require.False(t, report.Complete)
+	require.Equal(t, 2, report.Cursor)
+	require.False(t, report.Results[0].Checked)
+
+	// an invariant exceeding the time budget does not halt the chain
+	k.RegisterRoute("testModule", "testRoute4", func(ctx sdk.Context) (string, bool) {
+		time.Sleep(10 * time.Millisecond)
+		ctx.GasMeter().ConsumeGas(1, "test")
+		return "", true
+	})
+	require.NotPanics(t, func() {
+		k.AssertInvariantsWithBudget(testCtx.Ctx, keeper.InvariantsBudget{Time: time.Millisecond})
+	})
+
+	// a broken invariant still halts the chain
+	require.Panics(t, func() {
+		k.AssertInvariantsWithBudget(testCtx.Ctx, keeper.InvariantsBudget{Gas: 1000})
+	})
+}
@@ -35,7 +35,11 @@ var (
 )
 
 // Module init related flags
-const FlagSkipGenesisInvariants = "x-crisis-skip-assert-invariants"
+const (
+	FlagSkipGenesisInvariants = "x-crisis-skip-assert-invariants"
+	FlagInvariantsGasBudget   = "x-crisis-invariants-gas-budget"
+	FlagInvariantsTimeBudget  = "x-crisis-invariants-time-budget"
+)
 
 // AppModule implements an application module for the crisis module.
 type AppModule struct {
@@ -81,6 +85,8 @@ func (AppModule) RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 // AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Bool(FlagSkipGenesisInvariants, false, "Skip x/crisis invariants check on startup")
+	startCmd.Flags().Uint64(FlagInvariantsGasBudget, 0, "Gas budget of each periodic x/crisis invariants check, the next check resuming where the budget ran out (0 for unbounded)")
+	startCmd.Flags().Duration(FlagInvariantsTimeBudget, 0, "Time budget of each periodic x/crisis invariants check, the next check resuming where the budget ran out (0 for unbounded)")
 }
 
 // RegisterServices registers module services.
@@ -79,6 +79,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorTotalStake",
+					Use:       "total-stake [delegator-addr]",
+					Short:     "Query the total bonded, unbonding and pending reward amounts of a delegator",
+					Long:      "Query the tokens of the delegations, the balance of the unbonding delegations and the pending rewards of a delegator across all validators",
+					Example:   fmt.Sprintf("$ %s query distribution total-stake [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -86,6 +96,23 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:   fmt.Sprintf(`$ %s query distribution community-pool`, version.AppName),
 				},
 			},
+			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
+				"node": {
+					Service: distributionv1beta1.Node_ServiceDesc.ServiceName,
+					RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+						{
+							RpcMethod: "DelegatorRewardHistory",
+							Use:       "reward-history [delegator-addr]",
+							Short:     "Query the rewards withdrawn by a delegator per epoch",
+							Long:      "Query the rewards withdrawn by a delegator per epoch from the reward history index of the queried node, enabled with --distribution.reward-history-epoch-blocks. The result is local to the node.",
+							Example:   fmt.Sprintf("$ %s query distribution node reward-history [delegator-address]", version.AppName),
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "delegator_address"},
+							},
+						},
+					},
+				},
+			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: distributionv1beta1.Msg_ServiceDesc.ServiceName,
@@ -107,6 +134,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawAllDelegatorRewards",
+					Skip:      true, // skipped because served by the custom withdraw-all-rewards command
+				},
 				{
 					RpcMethod: "WithdrawValidatorCommission",
 					Use:       "withdraw-validator-commission [validator-addr]",
@@ -139,7 +170,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
 					Short:          "Submit a proposal to update distribution module params. Note: the entire params must be provided.",
-					Example:        fmt.Sprintf(`%s tx distribution update-params-proposal '{ "community_tax": "20000", "base_proposer_reward": "0", "bonus_proposer_reward": "0", "withdraw_addr_enabled": true }'`, version.AppName),
+					Example:        fmt.Sprintf(`%s tx distribution update-params-proposal '{ "community_tax": "20000", "base_proposer_reward": "0", "bonus_proposer_reward": "0", "withdraw_addr_enabled": true, "max_withdraw_all_validators": 50 }'`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
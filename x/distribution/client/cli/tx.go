@@ -19,6 +19,7 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagStartValidator   = "start-validator"
 )
 
 const (
@@ -40,12 +41,15 @@ func NewTxCmd() *cobra.Command {
 	return distTxCmd
 }
 
-// NewWithdrawAllRewardsCmd returns a CLI command handler for creating a MsgWithdrawDelegatorReward transaction.
-// This command is more powerful than AutoCLI generated command as it allows sending batch of messages.
+// NewWithdrawAllRewardsCmd returns a CLI command handler for creating a MsgWithdrawAllDelegatorRewards transaction.
+// With --max-msgs, it instead sends batches of MsgWithdrawDelegatorReward, one per validator.
 func NewWithdrawAllRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "withdraw-all-rewards",
-		Short:   "Withdraw all delegations rewards for a delegator",
+		Use:   "withdraw-all-rewards",
+		Short: "Withdraw all delegations rewards for a delegator",
+		Long: `Withdraw all delegations rewards for a delegator with a single message, from at most the
+max_withdraw_all_validators param validators. If the delegator has more validators, the response
+returns the next_validator_address to resume from with --start-validator.`,
 		Example: fmt.Sprintf("%s tx distribution withdraw-all-rewards --from mykey", version.AppName),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -58,6 +62,19 @@ func NewWithdrawAllRewardsCmd() *cobra.Command {
 				return err
 			}
 
+			chunkSize, _ := cmd.Flags().GetInt(FlagMaxMessagesPerTx)
+			if chunkSize == 0 {
+				startValAddr, _ := cmd.Flags().GetString(FlagStartValidator)
+				if startValAddr != "" {
+					if _, err := clientCtx.ValidatorAddressCodec.StringToBytes(startValAddr); err != nil {
+						return err
+					}
+				}
+
+				msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr, startValAddr)
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
 			// The transaction cannot be generated offline since it requires a query
 			// to get all the validators.
 			if clientCtx.Offline {
@@ -83,11 +100,6 @@ func NewWithdrawAllRewardsCmd() *cobra.Command {
 				msgs = append(msgs, msg)
 			}
 
-			chunkSize, _ := cmd.Flags().GetInt(FlagMaxMessagesPerTx)
-			if chunkSize == 0 {
-				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
-			}
-
 			// split messages into slices of length chunkSize
 			totalMessages := len(msgs)
 			for i := 0; i < len(msgs); i += chunkSize {
@@ -107,7 +119,8 @@ func NewWithdrawAllRewardsCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	cmd.Flags().String(FlagStartValidator, "", "Validator address to resume the withdrawal from, as returned by a previous withdrawal")
+	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Send a MsgWithdrawDelegatorReward per validator instead, at most this number per tx (0 to send a single MsgWithdrawAllDelegatorRewards)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -256,8 +256,9 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 	finalRewards, remainder := rewards.TruncateDecimal()
 
 	// add coins to user account
+	var withdrawAddr sdk.AccAddress
 	if !finalRewards.IsZero() {
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		withdrawAddr, err = k.GetDelegatorWithdrawAddr(ctx, delAddr)
 		if err != nil {
 			return nil, err
 		}
@@ -313,6 +314,28 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 		finalRewards = sdk.Coins{sdk.NewCoin(baseDenom, math.ZeroInt())}
 	}
 
+	var withdrawAddrStr string
+	if withdrawAddr != nil {
+		withdrawAddrStr, err = addrCodec.BytesToString(withdrawAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = k.environment.EventService.EventManager(ctx).Emit(&types.EventWithdrawRewards{
+		Delegator:       del.GetDelegatorAddr(),
+		Validator:       val.GetOperator(),
+		WithdrawAddress: withdrawAddrStr,
+		Amount:          finalRewards,
+		StartingPeriod:  startingPeriod,
+		EndingPeriod:    endingPeriod,
+		StartingHeight:  startingInfo.Height,
+		EndingHeight:    uint64(k.environment.HeaderService.GetHeaderInfo(ctx).Height),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.environment.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeWithdrawRewards,
 		event.NewAttribute(sdk.AttributeKeyAmount, finalRewards.String()),
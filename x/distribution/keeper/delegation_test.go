@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -1096,4 +1097,17 @@ func Test100PercentCommissionReward(t *testing.T) {
 		}
 	}
 	require.True(t, hasValue)
+
+	// the typed event covers the accrual period of the rewards
+	typedEvent, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-2]))
+	require.NoError(t, err)
+	require.Equal(t, &disttypes.EventWithdrawRewards{
+		Delegator:      addr.String(),
+		Validator:      valAddr.String(),
+		Amount:         zeroRewards,
+		StartingPeriod: 1,
+		EndingPeriod:   2,
+		StartingHeight: 1,
+		EndingHeight:   5,
+	}, typedEvent)
 }
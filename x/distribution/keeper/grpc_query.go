@@ -8,7 +8,9 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -359,6 +361,92 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DelegatorTotalStake queries the tokens of the delegations, the balance of the
+// unbonding delegations and the pending rewards of a delegator across all
+// validators
+func (k Querier) DelegatorTotalStake(ctx context.Context, req *types.QueryDelegatorTotalStakeRequest) (*types.QueryDelegatorTotalStakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bonded := math.LegacyZeroDec()
+	rewards := sdk.DecCoins{}
+
+	var iterErr error
+	err = k.stakingKeeper.IterateDelegations(
+		ctx, delAdr,
+		func(_ int64, del sdk.DelegationI) (stop bool) {
+			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			val, err := k.stakingKeeper.Validator(ctx, valAddr)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			endingPeriod, err := k.IncrementValidatorPeriod(ctx, val)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			delReward, err := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			bonded = bonded.Add(val.TokensFromShares(del.GetShares()))
+			rewards = rewards.Add(delReward...)
+			return false
+		},
+	)
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	unbonding := math.ZeroInt()
+	err = k.stakingKeeper.IterateDelegatorUnbondingDelegations(
+		ctx, delAdr,
+		func(ubd stakingtypes.UnbondingDelegation) (stop bool) {
+			for _, entry := range ubd.Entries {
+				unbonding = unbonding.Add(entry.Balance)
+			}
+			return false
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorTotalStakeResponse{
+		Bonded:    sdk.NewCoin(bondDenom, bonded.TruncateInt()),
+		Unbonding: sdk.NewCoin(bondDenom, unbonding),
+		Rewards:   rewards,
+	}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated query request. Use CommunityPool from x/protocolpool module instead.
 // CommunityPool queries the community pool coins
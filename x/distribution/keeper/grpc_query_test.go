@@ -1,11 +1,13 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
@@ -138,6 +140,59 @@ func TestQueryDelegatorWithdrawAddress(t *testing.T) {
 	// currently tested in tests/e2e/distribution/grpc_query_suite.go
 }
 
+func TestQueryDelegatorTotalStake(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(1000))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares.QuoInt64(2))
+	ubd := stakingtypes.UnbondingDelegation{
+		DelegatorAddress: addr.String(),
+		ValidatorAddress: valAddr.String(),
+		Entries: []stakingtypes.UnbondingDelegationEntry{
+			{Balance: math.NewInt(30)},
+			{Balance: math.NewInt(20)},
+		},
+	}
+
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), addr, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(int64, sdk.DelegationI) bool) error {
+			fn(0, del)
+			return nil
+		},
+	).AnyTimes()
+	dep.stakingKeeper.EXPECT().IterateDelegatorUnbondingDelegations(gomock.Any(), addr, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(stakingtypes.UnbondingDelegation) bool) error {
+			fn(ubd)
+			return nil
+		},
+	).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}))
+
+	_, err = queryServer.DelegatorTotalStake(ctx, &types.QueryDelegatorTotalStakeRequest{})
+	require.ErrorContains(t, err, "empty delegator address")
+
+	resp, err := queryServer.DelegatorTotalStake(ctx, &types.QueryDelegatorTotalStakeRequest{DelegatorAddress: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), resp.Bonded)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), resp.Unbonding)
+	// the delegation holds half of the shares and gets half of the rewards after commission
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(25, 1)}}, resp.Rewards)
+}
+
 func TestQueryCommunityPool(t *testing.T) {
 	ctx, _, distrKeeper, dep := initFixture(t)
 	queryServer := keeper.NewQuerier(distrKeeper)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -209,6 +210,58 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of a delegator from its
+// validators in address order, starting at startValAddr if not nil, and from
+// at most limit of them. It returns the rewards withdrawn, the validators they
+// were withdrawn from, and the validator to resume from, nil once the rewards
+// were withdrawn from all the validators.
+func (k Keeper) WithdrawAllDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, startValAddr sdk.ValAddress, limit uint32) (sdk.Coins, []sdk.ValAddress, sdk.ValAddress, error) {
+	// the validators are collected first, as withdrawing the rewards while
+	// iterating the delegations would write to the store being iterated.
+	var (
+		valAddrs []sdk.ValAddress
+		iterErr  error
+	)
+	err := k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del sdk.DelegationI) (stop bool) {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		if startValAddr != nil && bytes.Compare(valAddr, startValAddr) < 0 {
+			return false
+		}
+
+		// one more validator than the limit tells where to resume from
+		valAddrs = append(valAddrs, valAddr)
+		return len(valAddrs) > int(limit)
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if iterErr != nil {
+		return nil, nil, nil, iterErr
+	}
+
+	var next sdk.ValAddress
+	if len(valAddrs) > int(limit) {
+		next = valAddrs[limit]
+		valAddrs = valAddrs[:limit]
+	}
+
+	rewards := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		amount, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		rewards = rewards.Add(amount...)
+	}
+
+	return rewards, valAddrs, next, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
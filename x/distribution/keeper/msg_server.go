@@ -76,6 +76,64 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount}, nil
 }
 
+// WithdrawAllDelegatorRewards withdraws the rewards of a delegator from its
+// validators, at most MaxWithdrawAllValidators of them per message.
+func (k msgServer) WithdrawAllDelegatorRewards(ctx context.Context, msg *types.MsgWithdrawAllDelegatorRewards) (*types.MsgWithdrawAllDelegatorRewardsResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	var startValAddr sdk.ValAddress
+	if msg.StartValidatorAddress != "" {
+		startValAddr, err = k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.StartValidatorAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid start validator address: %s", err)
+		}
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, valAddrs, next, err := k.WithdrawAllDelegationRewards(ctx, delegatorAddress, startValAddr, params.MaxWithdrawAllValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgWithdrawAllDelegatorRewardsResponse{
+		Amount:             amount,
+		ValidatorAddresses: make([]string, len(valAddrs)),
+	}
+	for i, valAddr := range valAddrs {
+		res.ValidatorAddresses[i], err = k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if next != nil {
+		res.NextValidatorAddress, err = k.stakingKeeper.ValidatorAddressCodec().BytesToString(next)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return res, nil
+}
+
 func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
 	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
 	if err != nil {
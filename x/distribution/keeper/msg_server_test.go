@@ -1,16 +1,23 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
+	"sort"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -117,6 +124,80 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	delAddr := addrs[0]
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	params := types.DefaultParams()
+	params.MaxWithdrawAllValidators = 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// delegate to three validators, iterated in address order like in x/staking
+	var (
+		validators  []stakingtypes.Validator
+		valAddrs    []sdk.ValAddress
+		delegations []sdk.DelegationI
+	)
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+		valAddr := sdk.ValAddress(pk.Address())
+
+		del := stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), val.DelegatorShares)
+		dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+		dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(del, nil).AnyTimes()
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, delAddr, valAddr))
+
+		validators = append(validators, val)
+		valAddrs = append(valAddrs, valAddr)
+		delegations = append(delegations, del)
+	}
+
+	// next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+	for _, val := range validators {
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 10)}))
+	}
+
+	sort.Slice(delegations, func(i, j int) bool { return delegations[i].GetValidatorAddr() < delegations[j].GetValidatorAddr() })
+	sort.Slice(valAddrs, func(i, j int) bool { return bytes.Compare(valAddrs[i], valAddrs[j]) < 0 })
+
+	dep.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), delAddr, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(int64, sdk.DelegationI) bool) error {
+			for i, del := range delegations {
+				if fn(int64(i), del) {
+					break
+				}
+			}
+			return nil
+		}).AnyTimes()
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, delAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))).Times(3)
+
+	_, err := msgServer.WithdrawAllDelegatorRewards(ctx, &types.MsgWithdrawAllDelegatorRewards{DelegatorAddress: "invalid"})
+	require.ErrorContains(t, err, "invalid delegator address")
+
+	_, err = msgServer.WithdrawAllDelegatorRewards(ctx, &types.MsgWithdrawAllDelegatorRewards{DelegatorAddress: delAddr.String(), StartValidatorAddress: "invalid"})
+	require.ErrorContains(t, err, "invalid start validator address")
+
+	// the rewards are withdrawn from the first two validators
+	res, err := msgServer.WithdrawAllDelegatorRewards(ctx, types.NewMsgWithdrawAllDelegatorRewards(delAddr.String(), ""))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), res.Amount)
+	require.Equal(t, []string{valAddrs[0].String(), valAddrs[1].String()}, res.ValidatorAddresses)
+	require.Equal(t, valAddrs[2].String(), res.NextValidatorAddress)
+
+	// and from the last one when resuming
+	res, err = msgServer.WithdrawAllDelegatorRewards(ctx, types.NewMsgWithdrawAllDelegatorRewards(delAddr.String(), res.NextValidatorAddress))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), res.Amount)
+	require.Equal(t, []string{valAddrs[2].String()}, res.ValidatorAddresses)
+	require.Empty(t, res.NextValidatorAddress)
+}
+
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	ctx, addrs, distrKeeper, _ := initFixture(t)
 	msgServer := keeper.NewMsgServerImpl(distrKeeper)
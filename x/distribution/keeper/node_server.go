@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/distribution/types"
+)
+
+// nodeServer implements the Node service, serving the distribution data local
+// to the node. It is registered by the application rather than by the module,
+// as its responses differ between nodes.
+type nodeServer struct {
+	rewardHistory *RewardHistory
+}
+
+var _ types.NodeServer = nodeServer{}
+
+// NewNodeServer returns an implementation of the distribution Node service
+// serving the provided reward history index, which is nil when the index is
+// disabled on the node.
+func NewNodeServer(rewardHistory *RewardHistory) types.NodeServer {
+	return nodeServer{rewardHistory: rewardHistory}
+}
+
+// DelegatorRewardHistory queries the rewards withdrawn by a delegator per epoch
+// from the node-local reward history index.
+func (s nodeServer) DelegatorRewardHistory(_ context.Context, req *types.DelegatorRewardHistoryRequest) (*types.DelegatorRewardHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if s.rewardHistory == nil {
+		return nil, status.Error(codes.Unavailable, "the reward history index is not enabled on this node")
+	}
+
+	delAdr, err := s.rewardHistory.addrCodec.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs, pageRes, err := s.rewardHistory.DelegatorRewardHistory(delAdr, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DelegatorRewardHistoryResponse{Epochs: epochs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/mem"
+	"cosmossdk.io/x/distribution/keeper"
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNodeDelegatorRewardHistory(t *testing.T) {
+	ctx := context.Background()
+	req := &types.DelegatorRewardHistoryRequest{DelegatorAddress: sdk.AccAddress("delegator1__________").String()}
+
+	_, err := keeper.NewNodeServer(nil).DelegatorRewardHistory(ctx, req)
+	require.ErrorContains(t, err, "reward history index is not enabled")
+
+	rewardHistory, err := keeper.NewRewardHistory(mem.NewStore(), address.NewBech32Codec("cosmos"), 10)
+	require.NoError(t, err)
+	nodeServer := keeper.NewNodeServer(rewardHistory)
+
+	_, err = nodeServer.DelegatorRewardHistory(ctx, &types.DelegatorRewardHistoryRequest{})
+	require.ErrorContains(t, err, "empty delegator address")
+
+	resp, err := nodeServer.DelegatorRewardHistory(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, resp.Epochs)
+}
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ storetypes.ABCIListener = (*RewardHistory)(nil)
+
+// rewardHistoryEpochBlocksKey stores the epoch length the index is built with.
+// Record keys start with the length of an address, which is never 0.
+var rewardHistoryEpochBlocksKey = []byte{0x00}
+
+// RewardHistory is a node-local index of the rewards withdrawn by each
+// delegator, aggregated per epoch of a fixed number of blocks. It is not part of
+// the state: it is built by streaming the EventWithdrawRewards events of the
+// blocks executed by the node, so it only covers the blocks executed while the
+// index was enabled.
+//
+// The events of a block are collected on FinalizeBlock and written on Commit, so
+// only committed blocks are indexed.
+//
+// The index is served by the Node service rather than the Query service, and it
+// is wired by the application, which registers it as an ABCI listener and
+// provides the node-local store it is kept in.
+type RewardHistory struct {
+	store       storetypes.KVStore
+	addrCodec   address.Codec
+	epochBlocks uint64
+
+	mu      sync.Mutex
+	height  int64
+	pending []*types.EventWithdrawRewards
+}
+
+// NewRewardHistory returns a reward history index kept in store, aggregating the
+// rewards per epoch of epochBlocks blocks. store must be local to the node and
+// outside of the state, e.g. a dbadapter.Store over a database of the node data
+// directory. The epoch length cannot be changed once the index is created.
+func NewRewardHistory(store storetypes.KVStore, addrCodec address.Codec, epochBlocks uint64) (*RewardHistory, error) {
+	if epochBlocks == 0 {
+		return nil, errors.New("reward history epoch must be at least one block")
+	}
+
+	bz := store.Get(rewardHistoryEpochBlocksKey)
+	if bz == nil {
+		store.Set(rewardHistoryEpochBlocksKey, binary.BigEndian.AppendUint64(nil, epochBlocks))
+	} else if indexed := binary.BigEndian.Uint64(bz); indexed != epochBlocks {
+		return nil, fmt.Errorf("reward history index is built with epochs of %d blocks, not %d", indexed, epochBlocks)
+	}
+
+	return &RewardHistory{store: store, addrCodec: addrCodec, epochBlocks: epochBlocks}, nil
+}
+
+// Epoch returns the epoch of the block at the given height, and the first and
+// last heights of the epoch. Epoch 0 starts at height 1.
+func (h *RewardHistory) Epoch(height uint64) (epoch, startHeight, endHeight uint64) {
+	if height > 0 {
+		epoch = (height - 1) / h.epochBlocks
+	}
+
+	return epoch, epoch*h.epochBlocks + 1, (epoch + 1) * h.epochBlocks
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (h *RewardHistory) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	var pending []*types.EventWithdrawRewards
+	collect := func(events []abci.Event) error {
+		for _, event := range events {
+			ev, err := parseEventWithdrawRewards(event)
+			if err != nil {
+				return err
+			}
+			if ev != nil {
+				pending = append(pending, ev)
+			}
+		}
+		return nil
+	}
+
+	if err := collect(res.Events); err != nil {
+		return err
+	}
+	for _, txResult := range res.TxResults {
+		// the events of failed transactions are not committed
+		if txResult == nil || txResult.Code != abci.CodeTypeOK {
+			continue
+		}
+		if err := collect(txResult.Events); err != nil {
+			return err
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.height = req.Height
+	h.pending = pending
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (h *RewardHistory) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	h.mu.Lock()
+	height, pending := h.height, h.pending
+	h.pending = nil
+	h.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	epoch, startHeight, endHeight := h.Epoch(uint64(height))
+	records := make(map[string]*types.DelegatorEpochRewards)
+	for _, ev := range pending {
+		delAddr, err := h.addrCodec.StringToBytes(ev.Delegator)
+		if err != nil {
+			return err
+		}
+
+		key := string(rewardHistoryKey(delAddr, epoch))
+		record, ok := records[key]
+		if !ok {
+			record, err = h.get([]byte(key))
+			if err != nil {
+				return err
+			}
+			if record == nil {
+				record = &types.DelegatorEpochRewards{
+					DelegatorAddress: ev.Delegator,
+					Epoch:            epoch,
+					StartHeight:      startHeight,
+					EndHeight:        endHeight,
+				}
+			}
+			records[key] = record
+		}
+
+		if record.Withdrawals == 0 || ev.StartingHeight < record.AccruedSinceHeight {
+			record.AccruedSinceHeight = ev.StartingHeight
+		}
+		record.Amount = record.Amount.Add(ev.Amount...)
+		record.Withdrawals++
+	}
+
+	for key, record := range records {
+		bz, err := record.Marshal()
+		if err != nil {
+			return err
+		}
+		h.store.Set([]byte(key), bz)
+	}
+
+	return nil
+}
+
+// DelegatorRewardHistory returns the rewards withdrawn by the delegator per
+// epoch, by increasing epoch.
+func (h *RewardHistory) DelegatorRewardHistory(delAddr sdk.AccAddress, pageReq *query.PageRequest) ([]types.DelegatorEpochRewards, *query.PageResponse, error) {
+	store := prefix.NewStore(h.store, sdkaddress.MustLengthPrefix(delAddr))
+
+	var epochs []types.DelegatorEpochRewards
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var record types.DelegatorEpochRewards
+		if err := record.Unmarshal(value); err != nil {
+			return err
+		}
+		epochs = append(epochs, record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return epochs, pageRes, nil
+}
+
+func (h *RewardHistory) get(key []byte) (*types.DelegatorEpochRewards, error) {
+	bz := h.store.Get(key)
+	if bz == nil {
+		return nil, nil
+	}
+
+	var record types.DelegatorEpochRewards
+	if err := record.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// rewardHistoryKey returns the key of the rewards of a delegator during an
+// epoch: len(delAddr) | delAddr | epoch.
+func rewardHistoryKey(delAddr sdk.AccAddress, epoch uint64) []byte {
+	return binary.BigEndian.AppendUint64(sdkaddress.MustLengthPrefix(delAddr), epoch)
+}
+
+// parseEventWithdrawRewards returns the EventWithdrawRewards of a typed event,
+// or nil for other events.
+func parseEventWithdrawRewards(event abci.Event) (*types.EventWithdrawRewards, error) {
+	if event.Type != gogoproto.MessageName(&types.EventWithdrawRewards{}) {
+		return nil, nil
+	}
+
+	// drop the mode attribute baseapp adds to the block events, which is not a
+	// JSON value
+	attrs := make([]abci.EventAttribute, 0, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		if attr.Key != "mode" {
+			attrs = append(attrs, attr)
+		}
+	}
+	event.Attributes = attrs
+
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg.(*types.EventWithdrawRewards), nil
+}
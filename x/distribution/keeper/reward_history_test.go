@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/mem"
+	"cosmossdk.io/x/distribution/keeper"
+	disttypes "cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestRewardHistory(t *testing.T) {
+	store := mem.NewStore()
+	addrCodec := address.NewBech32Codec("cosmos")
+	history, err := keeper.NewRewardHistory(store, addrCodec, 5)
+	require.NoError(t, err)
+
+	del1, del2 := sdk.AccAddress("delegator1__________"), sdk.AccAddress("delegator2__________")
+	withdraw := func(del sdk.AccAddress, amount, startingHeight int64) abci.Event {
+		ev, err := sdk.TypedEventToEvent(&disttypes.EventWithdrawRewards{
+			Delegator:      del.String(),
+			Validator:      sdk.ValAddress("validator___________").String(),
+			Amount:         sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+			StartingHeight: uint64(startingHeight),
+		})
+		require.NoError(t, err)
+		return abci.Event(ev)
+	}
+
+	commitBlock := func(height int64, blockEvents []abci.Event, txResults ...*abci.ExecTxResult) {
+		require.NoError(t, history.ListenFinalizeBlock(context.Background(),
+			abci.RequestFinalizeBlock{Height: height},
+			abci.ResponseFinalizeBlock{Events: blockEvents, TxResults: txResults},
+		))
+		require.NoError(t, history.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+	}
+
+	endBlockEvent := withdraw(del1, 1, 0)
+	endBlockEvent.Attributes = append(endBlockEvent.Attributes, abci.EventAttribute{Key: "mode", Value: "EndBlock"})
+
+	commitBlock(2, []abci.Event{endBlockEvent},
+		&abci.ExecTxResult{Events: []abci.Event{withdraw(del1, 10, 1), withdraw(del2, 5, 2), {Type: "transfer"}}},
+		&abci.ExecTxResult{Code: 1, Events: []abci.Event{withdraw(del1, 100, 1)}},
+	)
+	commitBlock(5, nil, &abci.ExecTxResult{Events: []abci.Event{withdraw(del1, 20, 3)}})
+	commitBlock(12, nil, &abci.ExecTxResult{Events: []abci.Event{withdraw(del1, 30, 5)}})
+
+	epochs, _, err := history.DelegatorRewardHistory(del1, nil)
+	require.NoError(t, err)
+	require.Equal(t, []disttypes.DelegatorEpochRewards{
+		{
+			DelegatorAddress:   del1.String(),
+			Epoch:              0,
+			StartHeight:        1,
+			EndHeight:          5,
+			Amount:             sdk.NewCoins(sdk.NewInt64Coin("stake", 31)),
+			Withdrawals:        3,
+			AccruedSinceHeight: 0,
+		},
+		{
+			DelegatorAddress:   del1.String(),
+			Epoch:              2,
+			StartHeight:        11,
+			EndHeight:          15,
+			Amount:             sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+			Withdrawals:        1,
+			AccruedSinceHeight: 5,
+		},
+	}, epochs)
+
+	epochs, pageRes, err := history.DelegatorRewardHistory(del1, &query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, epochs, 1)
+	require.Equal(t, uint64(0), epochs[0].Epoch)
+	require.Equal(t, uint64(2), pageRes.Total)
+
+	epochs, _, err = history.DelegatorRewardHistory(del2, nil)
+	require.NoError(t, err)
+	require.Len(t, epochs, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), epochs[0].Amount)
+
+	// the epoch length cannot be changed once the index is created
+	_, err = keeper.NewRewardHistory(store, addrCodec, 10)
+	require.ErrorContains(t, err, "built with epochs of 5 blocks")
+	_, err = keeper.NewRewardHistory(store, addrCodec, 5)
+	require.NoError(t, err)
+}
@@ -38,8 +38,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:             communityTax,
+			WithdrawAddrEnabled:      withdrawEnabled,
+			MaxWithdrawAllValidators: types.DefaultMaxWithdrawAllValidators,
 		},
 	}
 
@@ -432,6 +432,20 @@ func (mr *MockStakingKeeperMockRecorder) IterateDelegations(ctx, delegator, fn i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegations), ctx, delegator, fn)
 }
 
+// IterateDelegatorUnbondingDelegations mocks base method.
+func (m *MockStakingKeeper) IterateDelegatorUnbondingDelegations(ctx context.Context, delegator types0.AccAddress, fn func(types.UnbondingDelegation) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateDelegatorUnbondingDelegations", ctx, delegator, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateDelegatorUnbondingDelegations indicates an expected call of IterateDelegatorUnbondingDelegations.
+func (mr *MockStakingKeeperMockRecorder) IterateDelegatorUnbondingDelegations(ctx, delegator, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegatorUnbondingDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegatorUnbondingDelegations), ctx, delegator, fn)
+}
+
 // IterateValidators mocks base method.
 func (m *MockStakingKeeper) IterateValidators(arg0 context.Context, arg1 func(int64, types0.ValidatorI) bool) error {
 	m.ctrl.T.Helper()
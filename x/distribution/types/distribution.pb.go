@@ -38,6 +38,11 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// max_withdraw_all_validators is the maximum number of validators a
+	// MsgWithdrawAllDelegatorRewards withdraws the rewards from.
+	//
+	// Since: cosmos-sdk 0.51
+	MaxWithdrawAllValidators uint32 `protobuf:"varint,5,opt,name=max_withdraw_all_validators,json=maxWithdrawAllValidators,proto3" json:"max_withdraw_all_validators,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +85,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxWithdrawAllValidators() uint32 {
+	if m != nil {
+		return m.MaxWithdrawAllValidators
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -657,73 +669,74 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
+	// 1071 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	0x14, 0xf6, 0xa4, 0x89, 0xd3, 0x4c, 0x7e, 0xb5, 0x93, 0x1f, 0x75, 0x9c, 0x62, 0xbb, 0x2b, 0x2a,
+	0x4c, 0x20, 0x36, 0x69, 0x25, 0x84, 0x22, 0x21, 0xd4, 0x24, 0xad, 0x40, 0x2a, 0x10, 0x6d, 0x10,
+	0x48, 0x70, 0x58, 0x8d, 0x77, 0x27, 0xf6, 0x90, 0xdd, 0x99, 0x65, 0x66, 0xec, 0x24, 0x27, 0x38,
+	0x16, 0x0e, 0xc0, 0x0d, 0xc4, 0xa9, 0x82, 0x4b, 0xc5, 0x29, 0x87, 0xfc, 0x11, 0x15, 0xa7, 0xaa,
+	0x02, 0x04, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
+	0x52, 0x45, 0x5c, 0x2c, 0xcf, 0x7b, 0x3b, 0xef, 0xfb, 0xde, 0x9b, 0x6f, 0xde, 0x1b, 0x58, 0xf3,
+	0xb9, 0x8c, 0xb8, 0xac, 0x07, 0x54, 0x2a, 0x41, 0x1b, 0x6d, 0x45, 0x39, 0xab, 0x77, 0x16, 0x1a,
+	0x44, 0xe1, 0x85, 0x23, 0xc6, 0x5a, 0x2c, 0xb8, 0xe2, 0x68, 0xd6, 0x7c, 0x5f, 0x3b, 0xe2, 0xb2,
+	0xdf, 0x17, 0x27, 0x9b, 0xbc, 0xc9, 0x93, 0xef, 0xea, 0xfa, 0x9f, 0xd9, 0x52, 0x2c, 0x59, 0x88,
+	0x06, 0x96, 0x24, 0x0d, 0xed, 0x73, 0x6a, 0x43, 0x16, 0x67, 0x8c, 0xdf, 0x33, 0x1b, 0x6d, 0x7c,
+	0xe3, 0xba, 0x8c, 0x23, 0xca, 0x78, 0x3d, 0xf9, 0x35, 0x26, 0xe7, 0xe7, 0x0b, 0x30, 0xbf, 0x8a,
+	0x05, 0x8e, 0x24, 0x7a, 0x0f, 0x8e, 0xfa, 0x3c, 0x8a, 0xda, 0x8c, 0xaa, 0x6d, 0x4f, 0xe1, 0xad,
+	0x02, 0xa8, 0x80, 0xea, 0xd0, 0xd2, 0x8b, 0x0f, 0xf7, 0xca, 0xb9, 0x5f, 0xf6, 0xca, 0x96, 0xaa,
+	0x0c, 0x36, 0x6a, 0x94, 0xd7, 0x23, 0xac, 0x5a, 0xb5, 0xbb, 0xa4, 0x89, 0xfd, 0xed, 0x15, 0xe2,
+	0x3f, 0xde, 0x9d, 0x87, 0x16, 0x69, 0x85, 0xf8, 0x0f, 0x0e, 0x77, 0xe6, 0x80, 0x3b, 0x92, 0x06,
+	0x7b, 0x0b, 0x6f, 0xa1, 0xf7, 0xe1, 0xa4, 0x26, 0xac, 0x59, 0xc5, 0x5c, 0x12, 0xe1, 0x09, 0xb2,
+	0x89, 0x45, 0x50, 0xe8, 0x4b, 0x30, 0x5e, 0x3a, 0x1b, 0x46, 0x01, 0xb8, 0x48, 0x47, 0x5d, 0xb5,
+	0x41, 0xdd, 0x24, 0x26, 0x0a, 0xe1, 0x54, 0x83, 0xb3, 0xb6, 0xfc, 0x1b, 0xd8, 0x85, 0x27, 0x04,
+	0x9b, 0x48, 0xc2, 0x1e, 0x43, 0xbb, 0x01, 0xa7, 0x36, 0xa9, 0x6a, 0x05, 0x02, 0x6f, 0x7a, 0x38,
+	0x08, 0x84, 0x47, 0x18, 0x6e, 0x84, 0x24, 0x28, 0xf4, 0x57, 0x40, 0xf5, 0xa2, 0x3b, 0xd1, 0x75,
+	0xde, 0x0a, 0x02, 0x71, 0xdb, 0xb8, 0xd0, 0xcb, 0x70, 0x36, 0xc2, 0x5b, 0x5e, 0xb6, 0x2f, 0x0c,
+	0xbd, 0x0e, 0x0e, 0x69, 0x80, 0x15, 0x17, 0xb2, 0x30, 0x50, 0x01, 0xd5, 0x51, 0xb7, 0x10, 0xe1,
+	0xad, 0x77, 0xba, 0x9b, 0xc3, 0xf0, 0xed, 0xd4, 0xbf, 0x78, 0xfd, 0x93, 0xc3, 0x9d, 0xb9, 0x8a,
+	0xe1, 0x37, 0x2f, 0x83, 0x8d, 0xfa, 0xd6, 0x51, 0xc1, 0x99, 0x03, 0x75, 0x7e, 0x04, 0xb0, 0x98,
+	0xee, 0x7a, 0x95, 0x4a, 0xc5, 0x05, 0xf5, 0x71, 0x68, 0x78, 0x4b, 0xf4, 0x29, 0x80, 0x57, 0xfc,
+	0x76, 0xd4, 0x0e, 0xb1, 0xa2, 0x1d, 0x62, 0x6b, 0xe4, 0x09, 0xac, 0x28, 0x2f, 0x80, 0xca, 0x85,
+	0xea, 0xf0, 0x8d, 0xab, 0x56, 0xce, 0x35, 0x5d, 0xe4, 0xae, 0x2c, 0x75, 0x41, 0x96, 0x39, 0x65,
+	0xa6, 0x8e, 0xdf, 0xfe, 0x5a, 0x7e, 0xae, 0x49, 0x55, 0xab, 0xdd, 0xa8, 0xf9, 0x3c, 0xb2, 0x72,
+	0xab, 0xf7, 0x50, 0x53, 0xdb, 0x31, 0x91, 0xdd, 0x3d, 0xd2, 0x48, 0x63, 0x2a, 0x83, 0x35, 0x64,
+	0x5c, 0x0d, 0x8a, 0x9e, 0x81, 0xe3, 0x82, 0xac, 0x13, 0x41, 0x98, 0x4f, 0x3c, 0x9f, 0xb7, 0x99,
+	0x4a, 0xe4, 0x31, 0xea, 0x8e, 0xa5, 0xe6, 0x65, 0x6d, 0x75, 0xbe, 0x01, 0xf0, 0x4a, 0x9a, 0xd8,
+	0x72, 0x5b, 0x08, 0xc2, 0x54, 0x37, 0xab, 0x18, 0x0e, 0x9a, 0x4c, 0xe4, 0x39, 0x27, 0xd1, 0x85,
+	0x41, 0xd3, 0x30, 0x1f, 0x13, 0x41, 0xb9, 0x11, 0x73, 0xbf, 0x6b, 0x57, 0xce, 0x97, 0x00, 0x96,
+	0x52, 0x96, 0xb7, 0x7c, 0x9b, 0x33, 0x09, 0x96, 0x79, 0x14, 0x51, 0x29, 0x29, 0x67, 0xa8, 0x03,
+	0xa1, 0x9f, 0xae, 0xce, 0x99, 0x6f, 0x0f, 0x92, 0xf3, 0x19, 0x80, 0xb3, 0x29, 0xb5, 0x37, 0xdb,
+	0x4a, 0x2a, 0xcc, 0x02, 0xca, 0x9a, 0xff, 0x5b, 0x11, 0x35, 0xa3, 0x89, 0x94, 0xd1, 0x5a, 0x88,
+	0x65, 0xeb, 0x76, 0x87, 0x30, 0x85, 0x9e, 0x85, 0x97, 0xd2, 0x8b, 0xe1, 0xd9, 0x32, 0x83, 0xa4,
+	0xcc, 0xe3, 0xa9, 0x7d, 0x35, 0x31, 0xa3, 0xd7, 0xe1, 0xc5, 0x75, 0x81, 0x7d, 0x7d, 0x03, 0x6c,
+	0x5b, 0x59, 0xf8, 0xcf, 0x37, 0xdd, 0x4d, 0x43, 0x38, 0x1f, 0x03, 0x38, 0x79, 0x02, 0x23, 0x89,
+	0x3e, 0x80, 0xd3, 0x19, 0x25, 0xa9, 0x1d, 0x1e, 0x49, 0x3c, 0xb6, 0x56, 0x2f, 0xd4, 0xfe, 0xa5,
+	0xa9, 0xd7, 0x4e, 0x08, 0xb9, 0x34, 0xa4, 0x79, 0x9a, 0x82, 0x4c, 0x76, 0x4e, 0x80, 0x74, 0x3e,
+	0xea, 0x83, 0x83, 0x77, 0x08, 0x59, 0xe5, 0x3c, 0x44, 0x1f, 0xc2, 0xb1, 0xac, 0x4d, 0xc7, 0x9c,
+	0x87, 0xa7, 0x3a, 0xa2, 0xc5, 0xb3, 0x1e, 0x51, 0x01, 0xb8, 0xd9, 0x58, 0x48, 0x08, 0x28, 0x38,
+	0x12, 0x10, 0x9f, 0x46, 0x38, 0x34, 0xf0, 0x7d, 0xa7, 0x80, 0xbf, 0x79, 0x06, 0x78, 0x77, 0xd8,
+	0xc2, 0x68, 0x54, 0xe7, 0x8b, 0x3e, 0x58, 0x5c, 0xee, 0xe5, 0xb1, 0x16, 0x13, 0x16, 0x98, 0x5e,
+	0x8c, 0x43, 0x34, 0x09, 0x07, 0x14, 0x55, 0x21, 0x31, 0x43, 0xcb, 0x35, 0x0b, 0x54, 0x81, 0xc3,
+	0x01, 0x91, 0xbe, 0xa0, 0x71, 0xa6, 0x0a, 0xb7, 0xd7, 0x84, 0xae, 0xc2, 0x21, 0x41, 0x7c, 0x1a,
+	0x53, 0xc2, 0x94, 0x99, 0x0f, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0xa4, 0x11, 0xf5, 0x27,
+	0x49, 0xce, 0x9c, 0x98, 0x64, 0x92, 0xe1, 0x1d, 0x9b, 0x61, 0xf5, 0x14, 0x19, 0x26, 0xe9, 0x7d,
+	0x75, 0xb8, 0x33, 0x37, 0x12, 0x26, 0x32, 0xf4, 0xfc, 0xec, 0x46, 0x58, 0xc0, 0xc5, 0xea, 0xbd,
+	0xfb, 0xe5, 0xdc, 0x1f, 0xf7, 0xcb, 0xb9, 0xef, 0x76, 0xe7, 0x8b, 0x16, 0xb5, 0xc9, 0x3b, 0x3d,
+	0xa0, 0x4c, 0x69, 0xce, 0xc0, 0xf9, 0x1e, 0xc0, 0xa9, 0x15, 0xa2, 0x23, 0x69, 0xd5, 0x28, 0x2c,
+	0x14, 0x65, 0xcd, 0xd7, 0xd8, 0x7a, 0xd2, 0x50, 0x63, 0x41, 0x3a, 0x94, 0xeb, 0x59, 0xd8, 0x7b,
+	0x77, 0xc6, 0xba, 0x66, 0x7b, 0x75, 0xee, 0xc2, 0x01, 0xa9, 0xf0, 0x06, 0x29, 0xf4, 0x3d, 0xd1,
+	0xc8, 0x37, 0x41, 0xd0, 0x0a, 0xcc, 0xb7, 0x08, 0x6d, 0xb6, 0x4c, 0x41, 0xfb, 0x97, 0x9e, 0xff,
+	0x73, 0xaf, 0x3c, 0xee, 0x0b, 0x82, 0x75, 0xc5, 0x3d, 0xe3, 0xfa, 0xfa, 0x70, 0x67, 0xee, 0xb8,
+	0xcd, 0x16, 0xc0, 0x2c, 0x9c, 0xdf, 0x01, 0x9c, 0xb1, 0x69, 0x51, 0xce, 0xd2, 0x04, 0xed, 0xd4,
+	0x7d, 0x03, 0x5e, 0xce, 0x2e, 0xa1, 0x1e, 0xbb, 0x44, 0x4a, 0xfb, 0x60, 0xb9, 0xf6, 0x78, 0x77,
+	0xfe, 0x29, 0x4b, 0x2d, 0xeb, 0xbf, 0xe6, 0x93, 0x35, 0x25, 0x74, 0x9b, 0xbb, 0xd4, 0x39, 0x66,
+	0x47, 0x0c, 0xe6, 0xd3, 0x17, 0xc9, 0x79, 0x36, 0x3c, 0x8b, 0xb2, 0xd8, 0xaf, 0x8f, 0xd7, 0xf9,
+	0x01, 0xc0, 0xeb, 0xff, 0x2c, 0x6a, 0x3d, 0xfc, 0x57, 0x48, 0xcc, 0x25, 0x55, 0xe7, 0xa4, 0xef,
+	0xe9, 0x1e, 0x7d, 0x6b, 0x97, 0x5d, 0xa1, 0x02, 0x1c, 0x0c, 0x0c, 0x70, 0xf2, 0x16, 0x19, 0x72,
+	0xbb, 0xcb, 0xc5, 0xa7, 0xef, 0x9d, 0x42, 0x92, 0x4b, 0xaf, 0x3c, 0xd8, 0x2f, 0x81, 0x87, 0xfb,
+	0x25, 0xf0, 0x68, 0xbf, 0x04, 0x7e, 0xdb, 0x2f, 0x81, 0xcf, 0x0f, 0x4a, 0xb9, 0x47, 0x07, 0xa5,
+	0xdc, 0x4f, 0x07, 0xa5, 0xdc, 0xbb, 0xd7, 0x8e, 0xc8, 0xea, 0xd8, 0xdb, 0x25, 0x29, 0x5a, 0x23,
+	0x9f, 0xbc, 0x4e, 0x6f, 0xfe, 0x35, 0x00, 0x4a, 0x72, 0x18, 0xf2, 0x50, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +770,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.MaxWithdrawAllValidators != that1.MaxWithdrawAllValidators {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1108,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxWithdrawAllValidators != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxWithdrawAllValidators))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1648,6 +1669,9 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.MaxWithdrawAllValidators != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxWithdrawAllValidators))
+	}
 	return n
 }
 
@@ -2016,6 +2040,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawAllValidators", wireType)
+			}
+			m.MaxWithdrawAllValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWithdrawAllValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
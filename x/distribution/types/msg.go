@@ -8,6 +8,7 @@ import (
 var (
 	_ sdk.Msg = (*MsgSetWithdrawAddress)(nil)
 	_ sdk.Msg = (*MsgWithdrawDelegatorReward)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllDelegatorRewards)(nil)
 	_ sdk.Msg = (*MsgWithdrawValidatorCommission)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
@@ -29,6 +30,16 @@ func NewMsgWithdrawDelegatorReward(delAddr, valAddr string) *MsgWithdrawDelegato
 	}
 }
 
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of a delegator from its validators, starting at
+// startValAddr if not empty.
+func NewMsgWithdrawAllDelegatorRewards(delAddr, startValAddr string) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress:      delAddr,
+		StartValidatorAddress: startValAddr,
+	}
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr string) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr,
@@ -6,19 +6,28 @@ import (
 	"cosmossdk.io/math"
 )
 
+// DefaultMaxWithdrawAllValidators is the default maximum number of validators
+// a MsgWithdrawAllDelegatorRewards withdraws the rewards from.
+const DefaultMaxWithdrawAllValidators uint32 = 50
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:             math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:       math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:      math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:      true,
+		MaxWithdrawAllValidators: DefaultMaxWithdrawAllValidators,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateMaxWithdrawAllValidators(p.MaxWithdrawAllValidators)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -39,3 +48,11 @@ func validateCommunityTax(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxWithdrawAllValidators(v uint32) error {
+	if v == 0 {
+		return fmt.Errorf("max withdraw all validators must be positive")
+	}
+
+	return nil
+}
@@ -17,25 +17,28 @@ func TestParams_ValidateBasic(t *testing.T) {
 		BaseProposerReward  sdkmath.LegacyDec
 		BonusProposerReward sdkmath.LegacyDec
 		WithdrawAddrEnabled bool
+		MaxWithdrawAll      uint32
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0"), toDec("0"), false}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0"), toDec("0"), false}, true},
-		{"negative base proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false}, false},
-		{"negative bonus proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false}, false},
-		{"total sum greater than 1 (must not matter)", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false}, false},
-		{"community tax greater than 1", fields{toDec("1.1"), toDec("0"), toDec("0"), false}, true},
-		{"community tax nil", fields{sdkmath.LegacyDec{}, toDec("0"), toDec("0"), false}, true},
+		{"success", fields{toDec("0.1"), toDec("0"), toDec("0"), false, 10}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0"), toDec("0"), false, 10}, true},
+		{"negative base proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false, 10}, false},
+		{"negative bonus proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false, 10}, false},
+		{"total sum greater than 1 (must not matter)", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, 10}, false},
+		{"community tax greater than 1", fields{toDec("1.1"), toDec("0"), toDec("0"), false, 10}, true},
+		{"community tax nil", fields{sdkmath.LegacyDec{}, toDec("0"), toDec("0"), false, 10}, true},
+		{"zero max withdraw all validators", fields{toDec("0.1"), toDec("0"), toDec("0"), false, 0}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := types.Params{
-				CommunityTax:        tt.fields.CommunityTax,
-				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+				CommunityTax:             tt.fields.CommunityTax,
+				WithdrawAddrEnabled:      tt.fields.WithdrawAddrEnabled,
+				MaxWithdrawAllValidators: tt.fields.MaxWithdrawAll,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
@@ -867,6 +867,117 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryDelegatorTotalStakeRequest is the request type for the
+// Query/DelegatorTotalStake RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryDelegatorTotalStakeRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorTotalStakeRequest) Reset()         { *m = QueryDelegatorTotalStakeRequest{} }
+func (m *QueryDelegatorTotalStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorTotalStakeRequest) ProtoMessage()    {}
+func (*QueryDelegatorTotalStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorTotalStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorTotalStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorTotalStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorTotalStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorTotalStakeRequest.Merge(m, src)
+}
+func (m *QueryDelegatorTotalStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorTotalStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorTotalStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorTotalStakeRequest proto.InternalMessageInfo
+
+// QueryDelegatorTotalStakeResponse is the response type for the
+// Query/DelegatorTotalStake RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryDelegatorTotalStakeResponse struct {
+	// bonded defines the tokens worth the delegations of the delegator, at the
+	// current exchange rates of the validators.
+	Bonded types.Coin `protobuf:"bytes,1,opt,name=bonded,proto3" json:"bonded"`
+	// unbonding defines the balance of the unbonding delegations of the
+	// delegator.
+	Unbonding types.Coin `protobuf:"bytes,2,opt,name=unbonding,proto3" json:"unbonding"`
+	// rewards defines the pending rewards of the delegations of the delegator.
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *QueryDelegatorTotalStakeResponse) Reset()         { *m = QueryDelegatorTotalStakeResponse{} }
+func (m *QueryDelegatorTotalStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorTotalStakeResponse) ProtoMessage()    {}
+func (*QueryDelegatorTotalStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorTotalStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorTotalStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorTotalStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorTotalStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorTotalStakeResponse.Merge(m, src)
+}
+func (m *QueryDelegatorTotalStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorTotalStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorTotalStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorTotalStakeResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorTotalStakeResponse) GetBonded() types.Coin {
+	if m != nil {
+		return m.Bonded
+	}
+	return types.Coin{}
+}
+
+func (m *QueryDelegatorTotalStakeResponse) GetUnbonding() types.Coin {
+	if m != nil {
+		return m.Unbonding
+	}
+	return types.Coin{}
+}
+
+func (m *QueryDelegatorTotalStakeResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 //
@@ -881,7 +992,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,7 +1037,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -981,6 +1092,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorTotalStakeRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorTotalStakeRequest")
+	proto.RegisterType((*QueryDelegatorTotalStakeResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorTotalStakeResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -990,88 +1103,93 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x46, 0xc8, 0xd9, 0xa6, 0x4e, 0xba,
-	0xa1, 0x4d, 0xd4, 0x28, 0xde, 0x36, 0x95, 0x42, 0x49, 0xa8, 0x20, 0x76, 0x12, 0x8a, 0x88, 0xd2,
-	0xd6, 0x2d, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0xdd, 0x38, 0x4b, 0xed, 0x1d, 0x67, 0x67, 0x1d, 0x13,
-	0x55, 0xbd, 0x94, 0x4b, 0xa9, 0x38, 0x20, 0xb8, 0x70, 0xe4, 0x88, 0x38, 0x71, 0xe8, 0x81, 0x1b,
-	0xdc, 0xe8, 0xb1, 0x2a, 0x12, 0xe2, 0x04, 0x28, 0x41, 0xa2, 0x1c, 0x40, 0xdc, 0xb8, 0xa2, 0x9d,
-	0x99, 0xb5, 0x77, 0xed, 0xf5, 0xfa, 0x9f, 0x7c, 0x49, 0xac, 0xd9, 0x79, 0xdf, 0xf7, 0xbe, 0xf7,
-	0xde, 0xcc, 0x7e, 0x36, 0xcc, 0xe6, 0x08, 0x2d, 0x12, 0xaa, 0x68, 0x06, 0xb5, 0x2d, 0x23, 0x5b,
-	0xb6, 0x0d, 0x62, 0x2a, 0xfb, 0x97, 0xb2, 0xba, 0xad, 0x5e, 0x52, 0xf6, 0xca, 0xba, 0x75, 0x90,
-	0x28, 0x59, 0xc4, 0x26, 0xf8, 0x34, 0xdf, 0x98, 0xf0, 0x6e, 0x4c, 0x88, 0x8d, 0xd2, 0x05, 0x81,
-	0x92, 0x55, 0xa9, 0xce, 0xa3, 0xaa, 0x18, 0x25, 0x35, 0x6f, 0x98, 0x2a, 0xdb, 0xcd, 0x80, 0xa4,
-	0xf1, 0x3c, 0xc9, 0x13, 0xf6, 0x51, 0x71, 0x3e, 0x89, 0xd5, 0xc9, 0x3c, 0x21, 0xf9, 0x82, 0xae,
-	0xa8, 0x25, 0x43, 0x51, 0x4d, 0x93, 0xd8, 0x2c, 0x84, 0x8a, 0xa7, 0x71, 0x2f, 0xbe, 0x8b, 0x9c,
-	0x23, 0x86, 0x8b, 0x99, 0x08, 0x53, 0xe1, 0xcb, 0x98, 0xef, 0x9f, 0xe0, 0xfb, 0x33, 0x3c, 0x0d,
-	0xa1, 0x8c, 0x3f, 0x8a, 0xaa, 0x45, 0xc3, 0x24, 0x0a, 0xfb, 0xcb, 0x97, 0xe4, 0x71, 0xc0, 0x37,
-	0x1d, 0x4d, 0x37, 0x54, 0x4b, 0x2d, 0xd2, 0xb4, 0xbe, 0x57, 0xd6, 0xa9, 0x2d, 0xdf, 0x81, 0x53,
-	0xbe, 0x55, 0x5a, 0x22, 0x26, 0xd5, 0xf1, 0x06, 0x0c, 0x97, 0xd8, 0x4a, 0x0c, 0x4d, 0xa3, 0xb9,
-	0x13, 0x8b, 0x33, 0x89, 0x90, 0xc2, 0x25, 0x78, 0x70, 0x72, 0xe4, 0xc9, 0x2f, 0x53, 0x03, 0x5f,
-	0xfd, 0xf1, 0xcd, 0x05, 0x94, 0x16, 0xd1, 0x72, 0x05, 0xce, 0x31, 0xf8, 0x77, 0xd4, 0x82, 0xa1,
-	0xa9, 0x36, 0xb1, 0xd6, 0x3c, 0xf1, 0x6f, 0x9a, 0x3b, 0x44, 0xe4, 0x81, 0xb7, 0x20, 0xba, 0xef,
-	0xee, 0xc9, 0xa8, 0x9a, 0x66, 0xe9, 0x94, 0x73, 0x8f, 0x24, 0xcf, 0x3e, 0x7b, 0xbc, 0x70, 0x46,
-	0xd0, 0x57, 0x71, 0x56, 0xf9, 0x96, 0x5b, 0xb6, 0x65, 0x98, 0xf9, 0xf4, 0xd8, 0x7e, 0xdd, 0xba,
-	0xfc, 0x77, 0x04, 0xce, 0xb7, 0x62, 0x16, 0x5a, 0x37, 0x61, 0x8c, 0x94, 0x74, 0xab, 0x3b, 0xe6,
-	0x51, 0x37, 0x54, 0x2c, 0xe3, 0x07, 0x08, 0xa2, 0x54, 0x2f, 0xec, 0x64, 0xb2, 0xc4, 0xd4, 0x32,
-	0x96, 0x5e, 0x51, 0x2d, 0x8d, 0xc6, 0x22, 0xd3, 0x83, 0x73, 0x27, 0x16, 0x27, 0xdd, 0x2a, 0x3a,
-	0x13, 0x50, 0xad, 0xde, 0x9a, 0x9e, 0x4b, 0x11, 0xc3, 0x4c, 0x5e, 0x71, 0xca, 0xf7, 0xf5, 0xaf,
-	0x53, 0xf3, 0x79, 0xc3, 0xde, 0x2d, 0x67, 0x13, 0x39, 0x52, 0x14, 0x4d, 0x15, 0xff, 0x16, 0xa8,
-	0x76, 0x57, 0xb1, 0x0f, 0x4a, 0x3a, 0x75, 0x63, 0x28, 0xaf, 0xf6, 0xa8, 0x43, 0x98, 0x24, 0xa6,
-	0x96, 0xe6, 0x74, 0x78, 0x0f, 0x20, 0x47, 0x8a, 0x45, 0x83, 0x52, 0x83, 0x98, 0xb1, 0xc1, 0x36,
-	0xc8, 0x2f, 0x77, 0x41, 0x9e, 0xf6, 0x90, 0xc8, 0x07, 0x30, 0xeb, 0xaf, 0xf7, 0xf5, 0xb2, 0x4d,
-	0x6d, 0xd5, 0xd4, 0x9c, 0x2a, 0xf1, 0xb4, 0xfa, 0xd5, 0xeb, 0x8f, 0x11, 0xcc, 0xb5, 0xe6, 0x16,
-	0xdd, 0xbe, 0x03, 0xc7, 0xdd, 0xa6, 0xf0, 0xd1, 0xbe, 0x12, 0x3a, 0xda, 0x21, 0x90, 0xde, 0x79,
-	0x77, 0x31, 0xe5, 0x3d, 0x98, 0xf2, 0xa7, 0x92, 0xaa, 0x96, 0xa8, 0x5f, 0xf2, 0x1f, 0x21, 0x98,
-	0x6e, 0xce, 0x29, 0x64, 0xef, 0xf8, 0x26, 0x82, 0x2b, 0x5f, 0x69, 0x4f, 0xf9, 0x6a, 0x2e, 0x57,
-	0x2e, 0x96, 0x0b, 0xaa, 0xad, 0x6b, 0x35, 0x60, 0xaf, 0x78, 0xef, 0x18, 0x3c, 0x8a, 0xc0, 0xa4,
-	0x3f, 0x99, 0x5b, 0x05, 0x95, 0xee, 0xea, 0xfd, 0x6a, 0x3e, 0x9e, 0x85, 0x51, 0x6a, 0xab, 0x96,
-	0x6d, 0x98, 0xf9, 0xcc, 0xae, 0x6e, 0xe4, 0x77, 0xed, 0x58, 0x64, 0x1a, 0xcd, 0x0d, 0xa5, 0x5f,
-	0x70, 0x97, 0xaf, 0xb1, 0x55, 0x3c, 0x03, 0x27, 0x75, 0xd6, 0x3e, 0x77, 0xdb, 0x20, 0xdb, 0xf6,
-	0x7f, 0xbe, 0x28, 0x36, 0x6d, 0x00, 0xd4, 0xae, 0xfa, 0xd8, 0x10, 0x2b, 0xd3, 0x79, 0xdf, 0xc1,
-	0xe1, 0x6f, 0x93, 0xda, 0xcd, 0x97, 0xd7, 0x85, 0xb2, 0xb4, 0x27, 0x72, 0x79, 0xe8, 0xe1, 0x97,
-	0x53, 0x03, 0xf2, 0x77, 0x08, 0xce, 0x34, 0x29, 0x86, 0x68, 0xcb, 0xdb, 0x70, 0x9c, 0xf2, 0xa5,
-	0x18, 0x62, 0xa7, 0xf4, 0x62, 0x7b, 0x3d, 0x61, 0x38, 0xeb, 0xfb, 0xba, 0x69, 0xfb, 0xa6, 0x50,
-	0x60, 0xe1, 0x37, 0x7c, 0x32, 0x22, 0x4c, 0xc6, 0x6c, 0x4b, 0x19, 0x3c, 0x27, 0xaf, 0x0e, 0xf9,
-	0x7b, 0x57, 0xc1, 0x9a, 0x5e, 0xd0, 0xf3, 0x6c, 0xad, 0xee, 0x30, 0xaf, 0x43, 0x54, 0xe3, 0xcf,
-	0x1a, 0xfa, 0x19, 0x7b, 0xf6, 0x78, 0x61, 0x5c, 0x90, 0xd6, 0xb5, 0xb1, 0x1a, 0xe2, 0xb6, 0x31,
-	0x70, 0x2c, 0x22, 0x5d, 0x8f, 0xc5, 0xf2, 0xff, 0x9c, 0x06, 0x3c, 0x77, 0x9a, 0xf0, 0x19, 0x82,
-	0x78, 0x33, 0x09, 0xa2, 0x0b, 0x25, 0xef, 0x9d, 0xd0, 0xcf, 0x8b, 0xba, 0x7a, 0x4d, 0x94, 0x41,
-	0xae, 0xcb, 0xe9, 0x36, 0xb1, 0xd5, 0x42, 0x5f, 0x6a, 0xeb, 0xa9, 0xc5, 0x3f, 0x08, 0x66, 0x42,
-	0x79, 0x45, 0x41, 0xde, 0xaf, 0x2f, 0xc8, 0x52, 0xe8, 0x58, 0xd6, 0xd0, 0xd6, 0x5c, 0x6e, 0x8e,
-	0x18, 0x74, 0x45, 0xe2, 0x02, 0x1c, 0xb3, 0x1d, 0xd2, 0x3e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x9a, 0x59, 0x75, 0x84, 0xfa, 0x57, 0xe6, 0x4d, 0x71, 0x21, 0x07, 0x72, 0x8a, 0x12,
-	0xc7, 0x01, 0xaa, 0x43, 0xcb, 0xab, 0x3c, 0x92, 0xf6, 0xac, 0x78, 0xd0, 0x2a, 0xf0, 0x92, 0x1f,
-	0x6d, 0xdb, 0xb0, 0x77, 0x35, 0x4b, 0xad, 0x08, 0xe2, 0xbe, 0xc9, 0xd8, 0x17, 0xe6, 0xad, 0x39,
-	0xb1, 0xd0, 0x92, 0x82, 0xb1, 0x8a, 0x78, 0xd4, 0x36, 0xf1, 0x68, 0xc5, 0x0f, 0xe6, 0xe1, 0x9d,
-	0x82, 0x09, 0xc6, 0xeb, 0xbc, 0x6d, 0xca, 0xa6, 0x61, 0x1f, 0xdc, 0x20, 0xa4, 0x20, 0x54, 0x2e,
-	0x47, 0x62, 0x48, 0xfe, 0x04, 0x81, 0x14, 0xb4, 0x43, 0xa4, 0xf3, 0x01, 0x0c, 0x95, 0x08, 0x29,
-	0xf4, 0xf9, 0x2c, 0x33, 0x0e, 0x27, 0x9d, 0xc5, 0x1f, 0xa2, 0x70, 0x8c, 0xa5, 0x83, 0xbf, 0x40,
-	0x30, 0xcc, 0xcd, 0x30, 0x56, 0x42, 0x4f, 0x4c, 0xa3, 0x13, 0x97, 0x2e, 0xb6, 0x1f, 0xc0, 0x75,
-	0xca, 0xf3, 0x0f, 0x7e, 0xfc, 0xfd, 0xf3, 0xc8, 0x39, 0x3c, 0xa3, 0x84, 0x7d, 0x71, 0xe0, 0x4e,
-	0x1c, 0xff, 0x89, 0x60, 0xa2, 0xa9, 0x17, 0xc6, 0xc9, 0xd6, 0xe4, 0xad, 0x2c, 0xbc, 0x94, 0xea,
-	0x09, 0x43, 0x68, 0x4a, 0x31, 0x4d, 0x57, 0xf1, 0x4a, 0xa8, 0xa6, 0xda, 0x39, 0x51, 0xee, 0x35,
-	0xbc, 0x36, 0xee, 0xe3, 0x8f, 0x22, 0x70, 0x3a, 0xc4, 0xb8, 0xe1, 0xb5, 0x0e, 0x32, 0x6d, 0x6a,
-	0x63, 0xa5, 0xf5, 0x1e, 0x51, 0x84, 0xe2, 0x6d, 0xa6, 0xf8, 0x26, 0xbe, 0xde, 0x83, 0x62, 0x85,
-	0xd4, 0xf0, 0xdd, 0xef, 0x1c, 0xf8, 0x10, 0xc1, 0xa9, 0x00, 0x4b, 0x88, 0x5f, 0xed, 0x20, 0xef,
-	0x06, 0xf7, 0x2a, 0x5d, 0xed, 0x32, 0x5a, 0xa8, 0xdd, 0x62, 0x6a, 0xaf, 0xe1, 0x8d, 0x5e, 0xd4,
-	0xd6, 0xfc, 0x26, 0xfe, 0x09, 0xc1, 0x58, 0xbd, 0xbb, 0xc2, 0xaf, 0x74, 0x90, 0xa3, 0xdf, 0x9e,
-	0x4a, 0xcb, 0xdd, 0x84, 0x0a, 0x6d, 0x6f, 0x31, 0x6d, 0xeb, 0x38, 0xd5, 0x8b, 0x36, 0xd7, 0xc2,
-	0xfd, 0x85, 0x20, 0xda, 0xe0, 0x58, 0x70, 0x1b, 0xe9, 0x35, 0x73, 0x6a, 0xd2, 0x4a, 0x57, 0xb1,
-	0x42, 0x5b, 0x86, 0x69, 0x7b, 0x17, 0x6f, 0x87, 0x6a, 0xab, 0xbe, 0x4c, 0xa8, 0x72, 0xaf, 0xe1,
-	0x5d, 0x74, 0x5f, 0x11, 0x93, 0x19, 0x78, 0x66, 0x9f, 0x23, 0x78, 0x31, 0xd8, 0x95, 0xe0, 0xd7,
-	0x3a, 0x49, 0x3c, 0xc0, 0x47, 0x49, 0xaf, 0x77, 0x0f, 0xd0, 0x51, 0x6b, 0xdb, 0x93, 0xcf, 0x0e,
-	0x66, 0x80, 0x35, 0x68, 0xe7, 0x60, 0x36, 0x77, 0x31, 0xed, 0x1c, 0xcc, 0x10, 0x3f, 0xd2, 0xe6,
-	0xc1, 0x6c, 0xa1, 0xb0, 0x36, 0xdb, 0xf8, 0x5f, 0x04, 0xb1, 0x66, 0xc6, 0x01, 0xaf, 0x76, 0x90,
-	0x6b, 0xb0, 0xdb, 0x91, 0x92, 0xbd, 0x40, 0x08, 0xcd, 0xb7, 0x99, 0xe6, 0x2d, 0xbc, 0xd9, 0x8b,
-	0xe6, 0x7a, 0xe7, 0x83, 0xbf, 0x45, 0x70, 0xd2, 0x67, 0x4c, 0xf0, 0x52, 0xeb, 0x5c, 0x83, 0xbc,
-	0x8e, 0xf4, 0x72, 0xc7, 0x71, 0x42, 0xd8, 0x12, 0x13, 0xb6, 0x80, 0xe7, 0x43, 0x85, 0xe5, 0xdc,
-	0xd8, 0x8c, 0x63, 0x65, 0x1e, 0x46, 0x50, 0x72, 0xe5, 0xc9, 0x61, 0x1c, 0x3d, 0x3d, 0x8c, 0xa3,
-	0xdf, 0x0e, 0xe3, 0xe8, 0xd3, 0xa3, 0xf8, 0xc0, 0xd3, 0xa3, 0xf8, 0xc0, 0xcf, 0x47, 0xf1, 0x81,
-	0xf7, 0xce, 0x72, 0x20, 0xaa, 0xdd, 0x4d, 0x18, 0x44, 0xf9, 0xd0, 0x0f, 0xc8, 0xec, 0x51, 0x76,
-	0x98, 0xfd, 0xce, 0x78, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xd3, 0xf1, 0xe8, 0x8d,
-	0x15, 0x00, 0x00,
+	// 1371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0x6c, 0xd2, 0x94, 0xbc, 0x52, 0x92, 0x4c, 0x2b, 0xe4, 0x6c, 0x5b, 0xc7, 0xdd, 0xd0,
+	0x26, 0x6a, 0x15, 0x6f, 0x7f, 0xa4, 0x52, 0x92, 0x46, 0x50, 0x3b, 0xe9, 0x8f, 0xa8, 0xfa, 0xe3,
+	0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x36, 0x4b, 0xec, 0x1d, 0x67, 0x67, 0x1d, 0x13, 0x55,
+	0xbd, 0x94, 0x4b, 0xa9, 0x38, 0x20, 0x90, 0x10, 0x47, 0x8e, 0x08, 0x09, 0x89, 0x43, 0x0f, 0xdc,
+	0xe0, 0xd8, 0x63, 0x55, 0x24, 0xc4, 0x09, 0x50, 0x82, 0x44, 0x39, 0x80, 0xb8, 0x71, 0x45, 0x3b,
+	0x33, 0x6b, 0xef, 0xfa, 0x67, 0xfd, 0x27, 0x73, 0x69, 0xad, 0xd9, 0x79, 0xdf, 0xf7, 0xbe, 0xf7,
+	0xde, 0xbc, 0x79, 0x13, 0x98, 0xcd, 0x13, 0x5a, 0x24, 0x54, 0xd5, 0x2d, 0xea, 0x3a, 0x56, 0xae,
+	0xec, 0x5a, 0xc4, 0x56, 0xb7, 0x4e, 0xe7, 0x0c, 0x57, 0x3b, 0xad, 0x6e, 0x96, 0x0d, 0x67, 0x3b,
+	0x59, 0x72, 0x88, 0x4b, 0xf0, 0x21, 0xbe, 0x31, 0x19, 0xdc, 0x98, 0x14, 0x1b, 0xe5, 0x13, 0x02,
+	0x25, 0xa7, 0x51, 0x83, 0x5b, 0x55, 0x31, 0x4a, 0x9a, 0x69, 0xd9, 0x1a, 0xdb, 0xcd, 0x80, 0xe4,
+	0x83, 0x26, 0x31, 0x09, 0xfb, 0xa9, 0x7a, 0xbf, 0xc4, 0xea, 0x61, 0x93, 0x10, 0xb3, 0x60, 0xa8,
+	0x5a, 0xc9, 0x52, 0x35, 0xdb, 0x26, 0x2e, 0x33, 0xa1, 0xe2, 0x6b, 0x3c, 0x88, 0xef, 0x23, 0xe7,
+	0x89, 0xe5, 0x63, 0x26, 0xa3, 0x54, 0x84, 0x3c, 0xe6, 0xfb, 0xa7, 0xf8, 0xfe, 0x2c, 0x77, 0x43,
+	0x28, 0xe3, 0x9f, 0x26, 0xb5, 0xa2, 0x65, 0x13, 0x95, 0xfd, 0xcb, 0x97, 0x94, 0x83, 0x80, 0x6f,
+	0x79, 0x9a, 0x6e, 0x6a, 0x8e, 0x56, 0xa4, 0x19, 0x63, 0xb3, 0x6c, 0x50, 0x57, 0xb9, 0x0b, 0x07,
+	0x42, 0xab, 0xb4, 0x44, 0x6c, 0x6a, 0xe0, 0x4b, 0x30, 0x5a, 0x62, 0x2b, 0x31, 0x94, 0x40, 0x73,
+	0xfb, 0xce, 0xcc, 0x24, 0x23, 0x02, 0x97, 0xe4, 0xc6, 0xa9, 0xb1, 0x27, 0xbf, 0x4c, 0x0f, 0x7d,
+	0xf5, 0xc7, 0xb7, 0x27, 0x50, 0x46, 0x58, 0x2b, 0x15, 0x38, 0xc6, 0xe0, 0xdf, 0xd6, 0x0a, 0x96,
+	0xae, 0xb9, 0xc4, 0x59, 0x0e, 0xd8, 0x5f, 0xb5, 0xd7, 0x88, 0xf0, 0x03, 0x5f, 0x87, 0xc9, 0x2d,
+	0x7f, 0x4f, 0x56, 0xd3, 0x75, 0xc7, 0xa0, 0x9c, 0x7b, 0x2c, 0x75, 0xf4, 0xd9, 0xe3, 0xf9, 0x23,
+	0x82, 0xbe, 0x8a, 0x73, 0x91, 0x6f, 0xb9, 0xed, 0x3a, 0x96, 0x6d, 0x66, 0x26, 0xb6, 0xea, 0xd6,
+	0x95, 0xbf, 0x25, 0x38, 0xde, 0x8e, 0x59, 0x68, 0xbd, 0x06, 0x13, 0xa4, 0x64, 0x38, 0xbd, 0x31,
+	0x8f, 0xfb, 0xa6, 0x62, 0x19, 0x3f, 0x40, 0x30, 0x49, 0x8d, 0xc2, 0x5a, 0x36, 0x47, 0x6c, 0x3d,
+	0xeb, 0x18, 0x15, 0xcd, 0xd1, 0x69, 0x4c, 0x4a, 0x0c, 0xcf, 0xed, 0x3b, 0x73, 0xd8, 0x8f, 0xa2,
+	0x57, 0x01, 0xd5, 0xe8, 0x2d, 0x1b, 0xf9, 0x34, 0xb1, 0xec, 0xd4, 0x79, 0x2f, 0x7c, 0x5f, 0xff,
+	0x3a, 0x7d, 0xd2, 0xb4, 0xdc, 0xf5, 0x72, 0x2e, 0x99, 0x27, 0x45, 0x91, 0x54, 0xf1, 0xdf, 0x3c,
+	0xd5, 0x37, 0x54, 0x77, 0xbb, 0x64, 0x50, 0xdf, 0x86, 0xf2, 0x68, 0x8f, 0x7b, 0x84, 0x29, 0x62,
+	0xeb, 0x19, 0x4e, 0x87, 0x37, 0x01, 0xf2, 0xa4, 0x58, 0xb4, 0x28, 0xb5, 0x88, 0x1d, 0x1b, 0xee,
+	0x80, 0xfc, 0x6c, 0x0f, 0xe4, 0x99, 0x00, 0x89, 0xb2, 0x0d, 0xb3, 0xe1, 0x78, 0xdf, 0x28, 0xbb,
+	0xd4, 0xd5, 0x6c, 0xdd, 0x8b, 0x12, 0x77, 0x6b, 0x50, 0xb9, 0xfe, 0x08, 0xc1, 0x5c, 0x7b, 0x6e,
+	0x91, 0xed, 0xbb, 0xb0, 0xd7, 0x4f, 0x0a, 0x2f, 0xed, 0xf3, 0x91, 0xa5, 0x1d, 0x01, 0x19, 0xac,
+	0x77, 0x1f, 0x53, 0xd9, 0x84, 0xe9, 0xb0, 0x2b, 0xe9, 0x6a, 0x88, 0x06, 0x25, 0xff, 0x11, 0x82,
+	0x44, 0x6b, 0x4e, 0x21, 0x7b, 0x2d, 0x54, 0x11, 0x5c, 0xf9, 0x62, 0x67, 0xca, 0x2f, 0xe6, 0xf3,
+	0xe5, 0x62, 0xb9, 0xa0, 0xb9, 0x86, 0x5e, 0x03, 0x0e, 0x8a, 0x0f, 0x96, 0xc1, 0x23, 0x09, 0x0e,
+	0x87, 0x9d, 0xb9, 0x5d, 0xd0, 0xe8, 0xba, 0x31, 0xa8, 0xe4, 0xe3, 0x59, 0x18, 0xa7, 0xae, 0xe6,
+	0xb8, 0x96, 0x6d, 0x66, 0xd7, 0x0d, 0xcb, 0x5c, 0x77, 0x63, 0x52, 0x02, 0xcd, 0x8d, 0x64, 0x5e,
+	0xf2, 0x97, 0xaf, 0xb0, 0x55, 0x3c, 0x03, 0xfb, 0x0d, 0x5b, 0x0f, 0x6c, 0x1b, 0x66, 0xdb, 0x5e,
+	0xe4, 0x8b, 0x62, 0xd3, 0x25, 0x80, 0x5a, 0xab, 0x8f, 0x8d, 0xb0, 0x30, 0x1d, 0x0f, 0x1d, 0x1c,
+	0x7e, 0x9b, 0xd4, 0x3a, 0x9f, 0x69, 0x08, 0x65, 0x99, 0x80, 0xe5, 0xc2, 0xc8, 0xc3, 0x2f, 0xa7,
+	0x87, 0x94, 0xef, 0x11, 0x1c, 0x69, 0x11, 0x0c, 0x91, 0x96, 0xb7, 0x60, 0x2f, 0xe5, 0x4b, 0x31,
+	0xc4, 0x4e, 0xe9, 0xa9, 0xce, 0x72, 0xc2, 0x70, 0x56, 0xb6, 0x0c, 0xdb, 0x0d, 0x55, 0xa1, 0xc0,
+	0xc2, 0x97, 0x43, 0x32, 0x24, 0x26, 0x63, 0xb6, 0xad, 0x0c, 0xee, 0x53, 0x50, 0x87, 0xf2, 0x83,
+	0xaf, 0x60, 0xd9, 0x28, 0x18, 0x26, 0x5b, 0xab, 0x3b, 0xcc, 0x2b, 0x30, 0xa9, 0xf3, 0x6f, 0x0d,
+	0xf9, 0x8c, 0x3d, 0x7b, 0x3c, 0x7f, 0x50, 0x90, 0xd6, 0xa5, 0xb1, 0x6a, 0xe2, 0xa7, 0xb1, 0x69,
+	0x59, 0x48, 0x3d, 0x97, 0xc5, 0xc2, 0x0b, 0x5e, 0x02, 0x9e, 0x7b, 0x49, 0xf8, 0x14, 0x41, 0xbc,
+	0x95, 0x04, 0x91, 0x85, 0x52, 0xb0, 0x27, 0x0c, 0xb2, 0x51, 0x57, 0xdb, 0x44, 0x19, 0x94, 0x3a,
+	0x9f, 0xee, 0x10, 0x57, 0x2b, 0x0c, 0x24, 0xb6, 0x81, 0x58, 0xfc, 0x83, 0x60, 0x26, 0x92, 0x57,
+	0x04, 0xe4, 0xbd, 0xfa, 0x80, 0x9c, 0x8b, 0x2c, 0xcb, 0x1a, 0xda, 0xb2, 0xcf, 0xcd, 0x11, 0x9b,
+	0xb5, 0x48, 0x5c, 0x80, 0x3d, 0xae, 0x47, 0x3a, 0xe0, 0x4b, 0x91, 0x93, 0x28, 0x8e, 0x68, 0xc8,
+	0x55, 0xcf, 0xaa, 0x25, 0x34, 0xb8, 0x30, 0x5f, 0x83, 0x44, 0x6b, 0x4e, 0x11, 0xe2, 0x38, 0x40,
+	0xb5, 0x68, 0x79, 0x94, 0xc7, 0x32, 0x81, 0x95, 0x00, 0x5a, 0x05, 0x5e, 0x09, 0xa3, 0xad, 0x5a,
+	0xee, 0xba, 0xee, 0x68, 0x15, 0x41, 0x3c, 0x30, 0x19, 0x5b, 0x70, 0xac, 0x0d, 0xb1, 0xd0, 0x92,
+	0x86, 0x89, 0x8a, 0xf8, 0xd4, 0x31, 0xf1, 0x78, 0x25, 0x0c, 0x16, 0xe0, 0x6d, 0x48, 0x19, 0xab,
+	0xd1, 0xdb, 0xae, 0xb6, 0x61, 0x0c, 0x4c, 0xeb, 0xe7, 0x12, 0x24, 0x5a, 0x93, 0x0a, 0x9d, 0x17,
+	0x60, 0xd4, 0x9b, 0xea, 0x0c, 0x5d, 0x5c, 0xa0, 0x53, 0x4d, 0x4b, 0x97, 0xd5, 0x6d, 0x70, 0x16,
+	0xe6, 0x36, 0x38, 0x05, 0x63, 0x65, 0xdb, 0xfb, 0x6d, 0xd9, 0x66, 0x4c, 0xea, 0x02, 0xa0, 0x66,
+	0x16, 0xec, 0x54, 0xc3, 0xff, 0x4f, 0xa7, 0x9a, 0x86, 0x29, 0x16, 0x17, 0xef, 0xea, 0x2f, 0xdb,
+	0x96, 0xbb, 0x7d, 0x93, 0x90, 0x82, 0x48, 0xc3, 0x82, 0x14, 0x43, 0xca, 0xc7, 0x08, 0xe4, 0x66,
+	0x3b, 0x44, 0xcc, 0xde, 0x87, 0x91, 0x12, 0x21, 0x85, 0x01, 0x37, 0x56, 0xc6, 0xe1, 0xb9, 0x73,
+	0xe6, 0x9b, 0x03, 0xb0, 0x87, 0xb9, 0x83, 0xbf, 0x40, 0x30, 0xca, 0x5f, 0x26, 0x58, 0x8d, 0x6c,
+	0x5f, 0x8d, 0xcf, 0x22, 0xf9, 0x54, 0xe7, 0x06, 0x5c, 0xa7, 0x72, 0xf2, 0xc1, 0x8f, 0xbf, 0x7f,
+	0x26, 0x1d, 0xc3, 0x33, 0x6a, 0xd4, 0x2b, 0x8e, 0x3f, 0x8b, 0xf0, 0x9f, 0x08, 0xa6, 0x5a, 0x3e,
+	0x4c, 0x70, 0xaa, 0x3d, 0x79, 0xbb, 0xf7, 0x94, 0x9c, 0xee, 0x0b, 0x43, 0x68, 0x4a, 0x33, 0x4d,
+	0x4b, 0x78, 0x31, 0x52, 0x53, 0xad, 0x69, 0xa9, 0xf7, 0x1a, 0xee, 0xf0, 0xfb, 0xf8, 0x43, 0x09,
+	0x0e, 0x45, 0x4c, 0xd1, 0x78, 0xb9, 0x0b, 0x4f, 0x5b, 0xbe, 0x29, 0xe4, 0x95, 0x3e, 0x51, 0x84,
+	0xe2, 0x55, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x87, 0x62, 0x95, 0xd4, 0xf0, 0xfd, 0x07, 0x20, 0xde,
+	0x41, 0x70, 0xa0, 0xc9, 0x7c, 0x8e, 0x2f, 0x74, 0xe1, 0x77, 0xc3, 0x53, 0x42, 0x5e, 0xea, 0xd1,
+	0x5a, 0xa8, 0xbd, 0xce, 0xd4, 0x5e, 0xc1, 0x97, 0xfa, 0x51, 0x5b, 0x1b, 0xfe, 0xf1, 0x4f, 0x08,
+	0x26, 0xea, 0x47, 0x5d, 0xfc, 0x5a, 0x17, 0x3e, 0x86, 0xdf, 0x0a, 0xf2, 0x42, 0x2f, 0xa6, 0x42,
+	0xdb, 0x9b, 0x4c, 0xdb, 0x0a, 0x4e, 0xf7, 0xa3, 0xcd, 0x9f, 0xa7, 0xff, 0x42, 0x30, 0xd9, 0x30,
+	0x3e, 0xe2, 0x0e, 0xdc, 0x6b, 0x35, 0x36, 0xcb, 0x8b, 0x3d, 0xd9, 0x0a, 0x6d, 0x59, 0xa6, 0xed,
+	0x1d, 0xbc, 0x1a, 0xa9, 0xad, 0x7a, 0xdb, 0x51, 0xf5, 0x5e, 0xc3, 0x65, 0x79, 0x5f, 0x15, 0x95,
+	0xd9, 0xf4, 0xcc, 0x3e, 0x47, 0xf0, 0x72, 0xf3, 0x11, 0x11, 0xbf, 0xde, 0x8d, 0xe3, 0x4d, 0x86,
+	0x5a, 0xf9, 0x8d, 0xde, 0x01, 0xba, 0x4a, 0x6d, 0x67, 0xf2, 0xd9, 0xc1, 0x6c, 0x32, 0xa7, 0x75,
+	0x72, 0x30, 0x5b, 0x8f, 0x94, 0xf2, 0x52, 0x8f, 0xd6, 0x5d, 0x1d, 0xcc, 0x36, 0x0a, 0x6b, 0xb5,
+	0x8d, 0xff, 0x45, 0x10, 0x6b, 0x35, 0xc5, 0xe1, 0x8b, 0x5d, 0xf8, 0xda, 0x7c, 0xf4, 0x94, 0x53,
+	0xfd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0xeb, 0xf8, 0x5a, 0x3f, 0x9a, 0xeb, 0xc7, 0x50, 0xbc,
+	0x1b, 0x4c, 0x6f, 0x6d, 0xa4, 0xeb, 0x2a, 0xbd, 0x0d, 0xe3, 0xa7, 0xbc, 0xd4, 0xa3, 0xb5, 0x90,
+	0x7a, 0x83, 0x49, 0xbd, 0x8a, 0x2f, 0xf7, 0x23, 0x95, 0x3d, 0x6f, 0xb2, 0x94, 0xa9, 0xf9, 0x0e,
+	0xc1, 0xfe, 0xd0, 0xf8, 0x85, 0xcf, 0xb5, 0xf7, 0xb0, 0xd9, 0x44, 0x27, 0xbf, 0xda, 0xb5, 0x9d,
+	0xd0, 0x74, 0x8e, 0x69, 0x9a, 0xc7, 0x27, 0x23, 0x35, 0xe5, 0x7d, 0xdb, 0xac, 0x37, 0xb0, 0x3d,
+	0x94, 0x50, 0x6a, 0xf1, 0xc9, 0x4e, 0x1c, 0x3d, 0xdd, 0x89, 0xa3, 0xdf, 0x76, 0xe2, 0xe8, 0x93,
+	0xdd, 0xf8, 0xd0, 0xd3, 0xdd, 0xf8, 0xd0, 0xcf, 0xbb, 0xf1, 0xa1, 0x77, 0x8f, 0x72, 0x20, 0xaa,
+	0x6f, 0x24, 0x2d, 0xa2, 0x7e, 0x10, 0x06, 0x64, 0x43, 0x60, 0x6e, 0x94, 0xfd, 0x69, 0xfb, 0xec,
+	0x7f, 0x03, 0x00, 0xa4, 0x6a, 0x03, 0xdd, 0x00, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1105,6 +1223,12 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorTotalStake queries the total position of a delegator across all
+	// validators: the tokens of its delegations, the balance of its unbonding
+	// delegations and its pending rewards.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorTotalStake(ctx context.Context, in *QueryDelegatorTotalStakeRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalStakeResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1201,6 +1325,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorTotalStake(ctx context.Context, in *QueryDelegatorTotalStakeRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalStakeResponse, error) {
+	out := new(QueryDelegatorTotalStakeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorTotalStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -1232,6 +1365,12 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorTotalStake queries the total position of a delegator across all
+	// validators: the tokens of its delegations, the balance of its unbonding
+	// delegations and its pending rewards.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorTotalStake(context.Context, *QueryDelegatorTotalStakeRequest) (*QueryDelegatorTotalStakeResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1270,6 +1409,9 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorTotalStake(ctx context.Context, req *QueryDelegatorTotalStakeRequest) (*QueryDelegatorTotalStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorTotalStake not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1440,6 +1582,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorTotalStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorTotalStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorTotalStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorTotalStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorTotalStake(ctx, req.(*QueryDelegatorTotalStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1498,6 +1658,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorTotalStake",
+			Handler:    _Query_DelegatorTotalStake_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -2155,6 +2319,93 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorTotalStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorTotalStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorTotalStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorTotalStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorTotalStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorTotalStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Unbonding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Bonded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2494,6 +2745,38 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorTotalStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorTotalStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bonded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unbonding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4221,6 +4504,238 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorTotalStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorTotalStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorTotalStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorTotalStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorTotalStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorTotalStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
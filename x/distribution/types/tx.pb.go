@@ -198,6 +198,123 @@ func (m *MsgWithdrawDelegatorRewardResponse) GetAmount() github_com_cosmos_cosmo
 	return nil
 }
 
+// MsgWithdrawAllDelegatorRewards represents delegation withdrawal to a
+// delegator from all its validators, in validator address order.
+//
+// Since: cosmos-sdk 0.51
+type MsgWithdrawAllDelegatorRewards struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// start_validator_address is the validator to resume the withdrawal from,
+	// as returned by next_validator_address. If empty, the withdrawal starts
+	// from the first validator.
+	StartValidatorAddress string `protobuf:"bytes,2,opt,name=start_validator_address,json=startValidatorAddress,proto3" json:"start_validator_address,omitempty"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) Reset()         { *m = MsgWithdrawAllDelegatorRewards{} }
+func (m *MsgWithdrawAllDelegatorRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewards) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{4}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewards proto.InternalMessageInfo
+
+// MsgWithdrawAllDelegatorRewardsResponse defines the
+// Msg/WithdrawAllDelegatorRewards response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgWithdrawAllDelegatorRewardsResponse struct {
+	// amount is the total of the rewards withdrawn.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// validator_addresses are the validators the rewards were withdrawn from.
+	ValidatorAddresses []string `protobuf:"bytes,2,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+	// next_validator_address is the validator to resume the withdrawal from in
+	// another message, if the delegator has more validators than
+	// max_withdraw_all_validators. It is empty once the rewards were withdrawn
+	// from all the validators.
+	NextValidatorAddress string `protobuf:"bytes,3,opt,name=next_validator_address,json=nextValidatorAddress,proto3" json:"next_validator_address,omitempty"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Reset() {
+	*m = MsgWithdrawAllDelegatorRewardsResponse{}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{5}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) GetValidatorAddresses() []string {
+	if m != nil {
+		return m.ValidatorAddresses
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) GetNextValidatorAddress() string {
+	if m != nil {
+		return m.NextValidatorAddress
+	}
+	return ""
+}
+
 // MsgWithdrawValidatorCommission withdraws the full commission to the validator
 // address.
 type MsgWithdrawValidatorCommission struct {
@@ -208,7 +325,7 @@ func (m *MsgWithdrawValidatorCommission) Reset()         { *m = MsgWithdrawValid
 func (m *MsgWithdrawValidatorCommission) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawValidatorCommission) ProtoMessage()    {}
 func (*MsgWithdrawValidatorCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{4}
+	return fileDescriptor_ed4f433d965e58ca, []int{6}
 }
 func (m *MsgWithdrawValidatorCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,7 +367,7 @@ func (m *MsgWithdrawValidatorCommissionResponse) Reset() {
 func (m *MsgWithdrawValidatorCommissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawValidatorCommissionResponse) ProtoMessage()    {}
 func (*MsgWithdrawValidatorCommissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{5}
+	return fileDescriptor_ed4f433d965e58ca, []int{7}
 }
 func (m *MsgWithdrawValidatorCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +419,7 @@ func (m *MsgFundCommunityPool) Reset()         { *m = MsgFundCommunityPool{} }
 func (m *MsgFundCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPool) ProtoMessage()    {}
 func (*MsgFundCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{6}
+	return fileDescriptor_ed4f433d965e58ca, []int{8}
 }
 func (m *MsgFundCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +461,7 @@ func (m *MsgFundCommunityPoolResponse) Reset()         { *m = MsgFundCommunityPo
 func (m *MsgFundCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPoolResponse) ProtoMessage()    {}
 func (*MsgFundCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{7}
+	return fileDescriptor_ed4f433d965e58ca, []int{9}
 }
 func (m *MsgFundCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +506,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{8}
+	return fileDescriptor_ed4f433d965e58ca, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +560,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{9}
+	return fileDescriptor_ed4f433d965e58ca, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +604,7 @@ func (m *MsgCommunityPoolSpend) Reset()         { *m = MsgCommunityPoolSpend{} }
 func (m *MsgCommunityPoolSpend) String() string { return proto.CompactTextString(m) }
 func (*MsgCommunityPoolSpend) ProtoMessage()    {}
 func (*MsgCommunityPoolSpend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{10}
+	return fileDescriptor_ed4f433d965e58ca, []int{12}
 }
 func (m *MsgCommunityPoolSpend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +668,7 @@ func (m *MsgCommunityPoolSpendResponse) Reset()         { *m = MsgCommunityPoolS
 func (m *MsgCommunityPoolSpendResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCommunityPoolSpendResponse) ProtoMessage()    {}
 func (*MsgCommunityPoolSpendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{11}
+	return fileDescriptor_ed4f433d965e58ca, []int{13}
 }
 func (m *MsgCommunityPoolSpendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,7 +711,7 @@ func (m *MsgDepositValidatorRewardsPool) Reset()         { *m = MsgDepositValida
 func (m *MsgDepositValidatorRewardsPool) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositValidatorRewardsPool) ProtoMessage()    {}
 func (*MsgDepositValidatorRewardsPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{12}
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
 }
 func (m *MsgDepositValidatorRewardsPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +753,7 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Reset() {
 func (m *MsgDepositValidatorRewardsPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositValidatorRewardsPoolResponse) ProtoMessage()    {}
 func (*MsgDepositValidatorRewardsPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{13}
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
 }
 func (m *MsgDepositValidatorRewardsPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,6 +787,8 @@ func init() {
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward")
 	proto.RegisterType((*MsgWithdrawDelegatorRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardResponse")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewards)(nil), "cosmos.distribution.v1beta1.MsgWithdrawAllDelegatorRewards")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewardsResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawAllDelegatorRewardsResponse")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission")
 	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
@@ -687,67 +806,72 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xce, 0x24, 0x62, 0x51, 0xa6, 0x95, 0xda, 0x58, 0x8b, 0x36, 0xeb, 0xb6, 0x4e, 0xeb, 0x85,
-	0x25, 0x8a, 0x58, 0x5b, 0x09, 0x08, 0x84, 0x8b, 0x54, 0x48, 0x4a, 0x24, 0x0e, 0x41, 0x55, 0x56,
-	0x80, 0xc4, 0x65, 0xe5, 0xc4, 0xc6, 0x3b, 0xea, 0xda, 0x63, 0x79, 0x26, 0x49, 0x73, 0x43, 0x08,
-	0x41, 0xc5, 0x09, 0x89, 0x1b, 0x97, 0x56, 0x42, 0x48, 0x15, 0xa7, 0x1c, 0x7a, 0xe0, 0x27, 0xf4,
-	0x82, 0x54, 0xf5, 0xc4, 0x09, 0x50, 0x56, 0x28, 0x48, 0x9c, 0xf8, 0x03, 0x08, 0xd9, 0x1e, 0x3b,
-	0x76, 0xec, 0xd8, 0xbb, 0x4b, 0x45, 0xb9, 0xec, 0xae, 0x66, 0xde, 0xfb, 0xe6, 0x7b, 0xdf, 0x7c,
-	0xf3, 0x9e, 0x17, 0xbe, 0x38, 0xc4, 0xc4, 0xc4, 0x44, 0xd6, 0x10, 0xa1, 0x0e, 0x1a, 0x8c, 0x28,
-	0xc2, 0x96, 0x3c, 0x6e, 0x0e, 0x74, 0xaa, 0x36, 0x65, 0x7a, 0x47, 0xb2, 0x1d, 0x4c, 0x31, 0x77,
-	0xc9, 0x8f, 0x92, 0xa2, 0x51, 0x12, 0x8b, 0xe2, 0x37, 0x0d, 0x6c, 0x60, 0x2f, 0x4e, 0x76, 0xff,
-	0xf2, 0x53, 0x78, 0x81, 0x01, 0x0f, 0x54, 0xa2, 0x87, 0x80, 0x43, 0x8c, 0x2c, 0xb6, 0xbf, 0xed,
-	0xef, 0x1f, 0xf8, 0x89, 0x0c, 0xdf, 0xdf, 0xda, 0x62, 0xa9, 0x26, 0x31, 0xe4, 0x71, 0xd3, 0xfd,
-	0xc5, 0x36, 0x2a, 0xaa, 0x89, 0x2c, 0x2c, 0x7b, 0x3f, 0xd9, 0x92, 0x94, 0xc5, 0x3f, 0x46, 0xd7,
-	0x8b, 0x17, 0xff, 0x04, 0xf0, 0x85, 0x1e, 0x31, 0xf6, 0x75, 0xfa, 0x11, 0xa2, 0x87, 0x9a, 0xa3,
-	0x4e, 0xde, 0xd1, 0x34, 0x47, 0x27, 0x84, 0x7b, 0x17, 0x56, 0x34, 0xfd, 0x48, 0x37, 0x54, 0x8a,
-	0x9d, 0x03, 0xd5, 0x5f, 0xac, 0x82, 0xab, 0xa0, 0x5e, 0x6e, 0x57, 0x9f, 0x3c, 0xdc, 0xdb, 0x64,
-	0x14, 0x59, 0xf8, 0x3e, 0x75, 0x90, 0x65, 0xf4, 0x2f, 0x86, 0x29, 0x01, 0x4c, 0x07, 0x5e, 0x9c,
-	0x30, 0xe4, 0x10, 0xa5, 0x98, 0x83, 0x72, 0x61, 0x12, 0xe7, 0xa2, 0x74, 0xef, 0xde, 0xaf, 0x15,
-	0xfe, 0xb8, 0x5f, 0x2b, 0x7c, 0xb6, 0x98, 0x35, 0x92, 0xb4, 0xbe, 0x5a, 0xcc, 0x1a, 0x3b, 0x3e,
-	0xd2, 0x1e, 0xd1, 0x6e, 0xcb, 0x3d, 0x62, 0xf4, 0xb0, 0x86, 0x3e, 0x99, 0xae, 0xd4, 0x24, 0xd6,
-	0xe0, 0x95, 0xd4, 0x62, 0xfb, 0x3a, 0xb1, 0xb1, 0x45, 0x74, 0xf1, 0x6f, 0x00, 0xf9, 0x1e, 0x31,
-	0x82, 0xed, 0x9b, 0xc1, 0x49, 0x7d, 0x7d, 0xa2, 0x3a, 0xda, 0xd3, 0xd2, 0xe4, 0x7d, 0x58, 0x19,
-	0xab, 0x47, 0x48, 0x8b, 0xc1, 0xf8, 0xa2, 0x5c, 0x7b, 0xf2, 0x70, 0xef, 0x0a, 0x83, 0xf9, 0x30,
-	0x88, 0x59, 0xc1, 0x1b, 0xaf, 0xac, 0x2b, 0xef, 0xe5, 0xcb, 0xb3, 0x1b, 0x97, 0x67, 0xa5, 0x40,
-	0x84, 0x2d, 0xbf, 0x42, 0xf1, 0x1e, 0x80, 0xe2, 0x7a, 0x01, 0x02, 0x9d, 0xb8, 0x29, 0xdc, 0x50,
-	0x4d, 0x3c, 0xb2, 0x68, 0x15, 0x5c, 0x2d, 0xd5, 0xcf, 0xb5, 0xb6, 0x99, 0xef, 0x24, 0xd7, 0xde,
-	0xc1, 0x4b, 0x90, 0x3a, 0x18, 0x59, 0xed, 0xee, 0xa3, 0x5f, 0x6a, 0x85, 0x1f, 0x7e, 0xad, 0xd5,
-	0x0d, 0x44, 0x0f, 0x47, 0x03, 0x69, 0x88, 0x4d, 0x66, 0x6f, 0x39, 0xc2, 0x89, 0x4e, 0x6d, 0x9d,
-	0x78, 0x09, 0xe4, 0xdb, 0xc5, 0xac, 0x71, 0xde, 0x3d, 0x76, 0x38, 0x3d, 0x70, 0x1f, 0x08, 0x79,
-	0xb0, 0x98, 0x35, 0x40, 0x9f, 0x1d, 0x28, 0xfe, 0x08, 0xa0, 0x10, 0x61, 0x18, 0x8a, 0xd4, 0xc1,
-	0xa6, 0x89, 0x08, 0x41, 0xd8, 0x4a, 0xd7, 0x17, 0x9c, 0x5d, 0xdf, 0xb8, 0xfd, 0x12, 0xd0, 0x29,
-	0xf6, 0x8b, 0xb0, 0x5b, 0xf2, 0x12, 0xbf, 0x03, 0x70, 0x37, 0x9b, 0xfa, 0xff, 0x41, 0xe0, 0x2f,
-	0x8b, 0x70, 0xb3, 0x47, 0x8c, 0xee, 0xc8, 0xd2, 0x5c, 0x62, 0x23, 0x0b, 0xd1, 0xe9, 0x2d, 0x8c,
-	0x8f, 0x9e, 0x21, 0x27, 0xee, 0x75, 0x58, 0xd6, 0x74, 0x1b, 0x13, 0x44, 0xb1, 0x93, 0xdb, 0x3e,
-	0x96, 0xa1, 0xca, 0x5b, 0xd1, 0x9b, 0x5b, 0xae, 0xbb, 0x37, 0x56, 0x8b, 0xdf, 0x58, 0xa2, 0xdc,
-	0x2a, 0x10, 0x45, 0x78, 0x39, 0x6d, 0x27, 0xb8, 0x24, 0xa5, 0x58, 0x05, 0xe2, 0x4f, 0x00, 0x5e,
-	0xe8, 0x11, 0xe3, 0x03, 0x5b, 0x53, 0xa9, 0x7e, 0x4b, 0x75, 0x54, 0x93, 0xb8, 0x6c, 0xd5, 0x11,
-	0x3d, 0xc4, 0x0e, 0xa2, 0xd3, 0xdc, 0xf6, 0xb0, 0x0c, 0xe5, 0xba, 0x70, 0xc3, 0xf6, 0x10, 0xbc,
-	0x12, 0xcf, 0xb5, 0x76, 0xa4, 0x8c, 0x39, 0x23, 0xf9, 0x87, 0xb5, 0xcb, 0xae, 0xd4, 0x4c, 0x2d,
-	0x3f, 0x5b, 0x51, 0xbc, 0x6a, 0x43, 0x5c, 0xb7, 0xda, 0x97, 0x23, 0xd5, 0xc6, 0x66, 0xc3, 0x0a,
-	0x77, 0x71, 0x1b, 0x6e, 0xad, 0x2c, 0x85, 0xcd, 0xf1, 0xfb, 0xa2, 0x37, 0x2b, 0x62, 0x5a, 0xec,
-	0xdb, 0xba, 0xa5, 0x9d, 0xb9, 0xe0, 0xcb, 0xb0, 0xec, 0xe8, 0x43, 0x64, 0x23, 0xdd, 0xa2, 0xfe,
-	0xb5, 0xf6, 0x97, 0x0b, 0x11, 0xbf, 0x95, 0xfe, 0x63, 0xbf, 0x29, 0xd7, 0x93, 0x0a, 0xee, 0xae,
-	0x2a, 0x28, 0xa7, 0x6a, 0x51, 0x05, 0xe2, 0x8e, 0x37, 0x65, 0x92, 0x5b, 0x31, 0xdf, 0xfc, 0x5e,
-	0xf4, 0xda, 0xd8, 0x4d, 0xdf, 0x92, 0x61, 0x2b, 0xf0, 0xfb, 0x2c, 0xf1, 0xde, 0x5b, 0xcc, 0xf4,
-	0xe0, 0xc4, 0xa6, 0x7f, 0xda, 0xe3, 0xe5, 0x59, 0xde, 0xc3, 0xdb, 0xeb, 0xdf, 0xef, 0x4b, 0x69,
-	0xf7, 0xb1, 0x94, 0x93, 0x09, 0x29, 0xd6, 0xbd, 0x96, 0x9b, 0x21, 0x73, 0x70, 0x2b, 0xad, 0xbf,
-	0x9e, 0x87, 0xa5, 0x1e, 0x31, 0xb8, 0xcf, 0x01, 0xe4, 0x52, 0xbe, 0x87, 0x5a, 0x99, 0x8f, 0x31,
-	0xf5, 0xb3, 0x82, 0x57, 0x4e, 0x9f, 0x13, 0x4e, 0x80, 0x6f, 0x00, 0xdc, 0x5a, 0xf7, 0x1d, 0xf2,
-	0x46, 0x1e, 0xee, 0x9a, 0x44, 0xfe, 0xc6, 0x19, 0x13, 0x43, 0x56, 0xf7, 0x00, 0xbc, 0x94, 0x35,
-	0x7a, 0xaf, 0x9f, 0xf4, 0x80, 0x94, 0x64, 0xbe, 0xf3, 0x2f, 0x92, 0x43, 0x86, 0x5f, 0x00, 0x58,
-	0x49, 0xce, 0xae, 0x66, 0x1e, 0x74, 0x22, 0x85, 0x7f, 0xf3, 0xd4, 0x29, 0x61, 0xa7, 0x2c, 0xdd,
-	0x2d, 0x02, 0xce, 0x81, 0xe7, 0x63, 0x53, 0xe1, 0x95, 0x3c, 0xbc, 0x68, 0x34, 0xff, 0xda, 0x69,
-	0xa2, 0xc3, 0xe2, 0x5d, 0xef, 0xa6, 0xf4, 0xe7, 0x5c, 0xef, 0x26, 0x73, 0xf2, 0xbd, 0xbb, 0xbe,
-	0xc1, 0x79, 0x2e, 0xc9, 0xea, 0x6c, 0xb9, 0x2e, 0xc9, 0x48, 0xce, 0x77, 0xc9, 0x09, 0x1e, 0x3b,
-	0xff, 0xdc, 0xa7, 0x6e, 0x9f, 0x69, 0xdf, 0x78, 0x30, 0x17, 0xc0, 0xa3, 0xb9, 0x00, 0x1e, 0xcf,
-	0x05, 0xf0, 0xdb, 0x5c, 0x00, 0x5f, 0x1f, 0x0b, 0x85, 0xc7, 0xc7, 0x42, 0xe1, 0xe7, 0x63, 0xa1,
-	0xf0, 0xf1, 0x35, 0xff, 0x20, 0xa2, 0xdd, 0x96, 0x10, 0x96, 0xef, 0xc4, 0x07, 0xa7, 0xd7, 0xc4,
-	0x06, 0x1b, 0xde, 0xbf, 0x51, 0xaf, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x70, 0xb5, 0x49, 0xbe,
-	0x38, 0x0e, 0x00, 0x00,
+	// 1036 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6b, 0x1c, 0x55,
+	0x1c, 0xdf, 0xb7, 0x8b, 0x81, 0x7d, 0x2d, 0xb4, 0x19, 0x53, 0xb3, 0x99, 0x36, 0xb3, 0xed, 0x44,
+	0x63, 0x08, 0x66, 0x86, 0x44, 0x51, 0x9c, 0x0a, 0xb5, 0xd9, 0x1a, 0xf0, 0xb0, 0x52, 0x36, 0xd8,
+	0xa2, 0x97, 0x30, 0xbb, 0xf3, 0x9c, 0x3c, 0xba, 0x3b, 0x6f, 0x98, 0xf7, 0x36, 0xc9, 0xde, 0x44,
+	0x44, 0x8b, 0x20, 0x08, 0xbd, 0x79, 0x69, 0x41, 0x84, 0xe2, 0x29, 0x87, 0x1e, 0xfc, 0x13, 0x7a,
+	0x11, 0x42, 0x4f, 0x9e, 0x54, 0x12, 0x24, 0x82, 0xff, 0x83, 0xc8, 0xcc, 0xbc, 0x99, 0xdd, 0x99,
+	0x79, 0xd9, 0xd9, 0xcd, 0x86, 0xb6, 0x97, 0x24, 0xbc, 0xf7, 0xfd, 0x7c, 0xe6, 0xf3, 0xfd, 0xfd,
+	0x08, 0x7c, 0xbd, 0x45, 0x68, 0x87, 0x50, 0xdd, 0xc2, 0x94, 0x79, 0xb8, 0xd9, 0x65, 0x98, 0x38,
+	0xfa, 0xce, 0x6a, 0x13, 0x31, 0x73, 0x55, 0x67, 0x7b, 0x9a, 0xeb, 0x11, 0x46, 0xa4, 0xcb, 0xa1,
+	0x95, 0x36, 0x68, 0xa5, 0x71, 0x2b, 0x79, 0xc6, 0x26, 0x36, 0x09, 0xec, 0x74, 0xff, 0xaf, 0x10,
+	0x22, 0x2b, 0x9c, 0xb8, 0x69, 0x52, 0x14, 0x13, 0xb6, 0x08, 0x76, 0xf8, 0xfd, 0x5c, 0x78, 0xbf,
+	0x15, 0x02, 0x39, 0x7f, 0x78, 0x35, 0xcb, 0xa1, 0x1d, 0x6a, 0xeb, 0x3b, 0xab, 0xfe, 0x2f, 0x7e,
+	0x31, 0x6d, 0x76, 0xb0, 0x43, 0xf4, 0xe0, 0x27, 0x3f, 0xd2, 0x86, 0xe9, 0x4f, 0xc8, 0x0d, 0xec,
+	0xd5, 0x7f, 0x01, 0xbc, 0x54, 0xa7, 0xf6, 0x26, 0x62, 0x77, 0x31, 0xdb, 0xb6, 0x3c, 0x73, 0xf7,
+	0xa6, 0x65, 0x79, 0x88, 0x52, 0xe9, 0x23, 0x38, 0x6d, 0xa1, 0x36, 0xb2, 0x4d, 0x46, 0xbc, 0x2d,
+	0x33, 0x3c, 0xac, 0x80, 0xab, 0x60, 0xa9, 0xbc, 0x5e, 0x79, 0xf6, 0x64, 0x65, 0x86, 0x4b, 0xe4,
+	0xe6, 0x9b, 0xcc, 0xc3, 0x8e, 0xdd, 0xb8, 0x18, 0x43, 0x22, 0x9a, 0x1a, 0xbc, 0xb8, 0xcb, 0x99,
+	0x63, 0x96, 0x62, 0x0e, 0xcb, 0x85, 0xdd, 0xa4, 0x16, 0x63, 0xe3, 0xfe, 0xa3, 0x6a, 0xe1, 0x9f,
+	0x47, 0xd5, 0xc2, 0x57, 0xc7, 0xfb, 0xcb, 0x59, 0x59, 0xdf, 0x1d, 0xef, 0x2f, 0x2f, 0x84, 0x4c,
+	0x2b, 0xd4, 0xba, 0xa7, 0xd7, 0xa9, 0x5d, 0x27, 0x16, 0xfe, 0xa2, 0x97, 0xf2, 0x49, 0xad, 0xc2,
+	0x79, 0xa1, 0xb3, 0x0d, 0x44, 0x5d, 0xe2, 0x50, 0xa4, 0xfe, 0x07, 0xa0, 0x5c, 0xa7, 0x76, 0x74,
+	0x7d, 0x2b, 0xfa, 0x52, 0x03, 0xed, 0x9a, 0x9e, 0x75, 0x56, 0x31, 0xf9, 0x04, 0x4e, 0xef, 0x98,
+	0x6d, 0x6c, 0x25, 0x68, 0xc2, 0xa0, 0x5c, 0x7b, 0xf6, 0x64, 0x65, 0x9e, 0xd3, 0xdc, 0x89, 0x6c,
+	0x52, 0x7c, 0x3b, 0xa9, 0x73, 0xe3, 0xe3, 0xfc, 0xf0, 0x2c, 0x26, 0xc3, 0x93, 0x72, 0x10, 0x13,
+	0x27, 0xf4, 0x50, 0x7d, 0x08, 0xa0, 0x7a, 0x72, 0x00, 0xa2, 0x38, 0x49, 0x3d, 0x38, 0x65, 0x76,
+	0x48, 0xd7, 0x61, 0x15, 0x70, 0xb5, 0xb4, 0x74, 0x6e, 0x6d, 0x8e, 0xd7, 0x9d, 0xe6, 0x97, 0x77,
+	0xd4, 0x09, 0x5a, 0x8d, 0x60, 0x67, 0x7d, 0xe3, 0xe9, 0x1f, 0xd5, 0xc2, 0x2f, 0x7f, 0x56, 0x97,
+	0x6c, 0xcc, 0xb6, 0xbb, 0x4d, 0xad, 0x45, 0x3a, 0xbc, 0xbc, 0xf5, 0x01, 0x4d, 0xac, 0xe7, 0x22,
+	0x1a, 0x00, 0xe8, 0x8f, 0xc7, 0xfb, 0xcb, 0xe7, 0xfd, 0xcf, 0xb6, 0x7a, 0x5b, 0x7e, 0x83, 0xd0,
+	0xc7, 0xc7, 0xfb, 0xcb, 0xa0, 0xc1, 0x3f, 0xa8, 0x7e, 0x5f, 0x84, 0xca, 0x80, 0xc2, 0x9b, 0xed,
+	0x76, 0x4a, 0xe4, 0x99, 0x95, 0xee, 0x67, 0x70, 0x96, 0x32, 0xd3, 0x63, 0x5b, 0x13, 0x24, 0xeb,
+	0x52, 0xc0, 0x70, 0x67, 0xc2, 0x8c, 0x05, 0x8d, 0xab, 0x27, 0xbd, 0xe6, 0xce, 0xaa, 0x07, 0x45,
+	0xb8, 0x38, 0x3c, 0x1e, 0x2f, 0x41, 0xd6, 0xa4, 0x06, 0x7c, 0x35, 0x13, 0x45, 0xe4, 0xc7, 0xb1,
+	0x34, 0x5a, 0x1c, 0xa5, 0x74, 0xd1, 0x23, 0x2a, 0xdd, 0x85, 0xaf, 0x39, 0x68, 0x4f, 0x94, 0x9e,
+	0xd2, 0xa8, 0xe9, 0x99, 0xf1, 0x09, 0xd2, 0x77, 0xea, 0xaf, 0x20, 0x51, 0x62, 0xf1, 0x7d, 0x8d,
+	0x74, 0x3a, 0x98, 0x52, 0x4c, 0x1c, 0x71, 0x0b, 0x83, 0xd3, 0xb7, 0x70, 0x72, 0xc2, 0x65, 0xa8,
+	0x05, 0x13, 0x6e, 0x40, 0x5d, 0x5f, 0x97, 0xfa, 0x13, 0x48, 0x54, 0x83, 0x40, 0xfa, 0xcb, 0xd0,
+	0xc3, 0xdf, 0x16, 0xe1, 0x4c, 0x9d, 0xda, 0x1b, 0x5d, 0xc7, 0xf2, 0x85, 0x75, 0x1d, 0xcc, 0x7a,
+	0xb7, 0x09, 0x69, 0xbf, 0xc8, 0x0a, 0x7d, 0x17, 0x96, 0x2d, 0xe4, 0x12, 0x8a, 0x19, 0xf1, 0x72,
+	0x37, 0x54, 0xdf, 0xd4, 0xf8, 0x60, 0x30, 0x73, 0xfd, 0x73, 0x3f, 0x63, 0xd5, 0x64, 0xc6, 0x32,
+	0xee, 0x56, 0x80, 0xaa, 0xc2, 0x2b, 0xa2, 0x9b, 0x28, 0x49, 0x46, 0xb1, 0x02, 0xd4, 0xdf, 0x00,
+	0xbc, 0x50, 0xa7, 0xf6, 0xa7, 0xae, 0x65, 0x32, 0x74, 0xdb, 0xf4, 0xcc, 0x0e, 0xf5, 0xd5, 0x9a,
+	0x5d, 0xb6, 0x4d, 0x3c, 0xcc, 0x7a, 0xb9, 0xa3, 0xad, 0x6f, 0x2a, 0x6d, 0xc0, 0x29, 0x37, 0x60,
+	0x08, 0x5c, 0x3c, 0xb7, 0xb6, 0xa0, 0x0d, 0x79, 0xca, 0x68, 0xe1, 0xc7, 0xd6, 0xcb, 0x7e, 0xa8,
+	0x79, 0xb4, 0x42, 0xb4, 0x61, 0x04, 0xde, 0xc6, 0xbc, 0xbe, 0xb7, 0x6f, 0xa6, 0x07, 0x56, 0xf4,
+	0xfc, 0x48, 0x69, 0x57, 0xe7, 0xe0, 0x6c, 0xea, 0x28, 0xde, 0xbf, 0x3f, 0x17, 0x83, 0xe7, 0x48,
+	0x22, 0x16, 0x9b, 0x2e, 0x72, 0xac, 0x53, 0x3b, 0x7c, 0x05, 0x96, 0x3d, 0xd4, 0xc2, 0x2e, 0x46,
+	0x0e, 0x0b, 0xd3, 0xda, 0xe8, 0x1f, 0x0c, 0xd4, 0x5b, 0xe9, 0x39, 0xd7, 0x9b, 0x71, 0x3d, 0x1b,
+	0x41, 0xe1, 0xc8, 0xcf, 0xc6, 0xa2, 0x02, 0xd4, 0x05, 0x38, 0x2f, 0xbc, 0x4a, 0xd4, 0xcd, 0xdf,
+	0xe1, 0xa6, 0xbc, 0x15, 0x96, 0x64, 0x3c, 0x0a, 0xf8, 0x56, 0x08, 0xfa, 0x2d, 0x51, 0xf4, 0x60,
+	0xe4, 0xa2, 0x3f, 0xeb, 0x17, 0xcc, 0x8b, 0xcc, 0xc3, 0x87, 0x27, 0xf7, 0xef, 0x1b, 0xa2, 0x7c,
+	0xf4, 0xc3, 0x19, 0x6d, 0xe0, 0x25, 0xb8, 0x98, 0x38, 0xcf, 0x84, 0x39, 0xca, 0xca, 0xda, 0x83,
+	0x32, 0x2c, 0xd5, 0xa9, 0x2d, 0x7d, 0x0d, 0xa0, 0x24, 0x78, 0x72, 0xaf, 0x0d, 0x6d, 0x46, 0xe1,
+	0xcb, 0x55, 0x36, 0xc6, 0xc7, 0xc4, 0x1b, 0xe0, 0x01, 0x80, 0xb3, 0x27, 0x3d, 0x75, 0xdf, 0xcb,
+	0xe3, 0x3d, 0x01, 0x28, 0xdf, 0x38, 0x25, 0x30, 0x56, 0xf5, 0x10, 0xc0, 0xcb, 0xc3, 0x5e, 0x77,
+	0xd7, 0x47, 0xfd, 0x80, 0x00, 0x2c, 0xd7, 0x26, 0x00, 0x0b, 0x15, 0x8a, 0x1e, 0x07, 0x23, 0x2b,
+	0x14, 0x80, 0xe5, 0xda, 0x04, 0xe0, 0x58, 0xe1, 0x37, 0x00, 0x4e, 0x67, 0xb7, 0xeb, 0x6a, 0x1e,
+	0x75, 0x06, 0x22, 0xbf, 0x3f, 0x36, 0x24, 0x9e, 0xe5, 0xa5, 0xfb, 0x45, 0x20, 0x79, 0xf0, 0x7c,
+	0x62, 0x6f, 0xbd, 0x95, 0xc7, 0x37, 0x68, 0x2d, 0xbf, 0x33, 0x8e, 0x75, 0xec, 0xbc, 0xdf, 0x5d,
+	0x82, 0x0d, 0x92, 0xdb, 0x5d, 0x59, 0x8c, 0x6c, 0x8c, 0x8f, 0x49, 0x54, 0xc9, 0xb0, 0xd9, 0x9b,
+	0x5b, 0x25, 0x43, 0xc0, 0x72, 0x6d, 0x02, 0x70, 0xa4, 0x50, 0x7e, 0xe5, 0x4b, 0x7f, 0x12, 0xae,
+	0xdf, 0x78, 0x7c, 0xa8, 0x80, 0xa7, 0x87, 0x0a, 0x38, 0x38, 0x54, 0xc0, 0x5f, 0x87, 0x0a, 0xf8,
+	0xe1, 0x48, 0x29, 0x1c, 0x1c, 0x29, 0x85, 0xdf, 0x8f, 0x94, 0xc2, 0xe7, 0xd7, 0xc2, 0x0f, 0x51,
+	0xeb, 0x9e, 0x86, 0x89, 0xbe, 0x97, 0x5c, 0xed, 0xc1, 0x98, 0x6d, 0x4e, 0x05, 0xff, 0x4b, 0x78,
+	0xfb, 0xff, 0x01, 0x00, 0x33, 0x34, 0xbc, 0x00, 0x3d, 0x11, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -800,6 +924,46 @@ func (this *MsgWithdrawDelegatorRewardResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawAllDelegatorRewardsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawAllDelegatorRewardsResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawAllDelegatorRewardsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if len(this.ValidatorAddresses) != len(that1.ValidatorAddresses) {
+		return false
+	}
+	for i := range this.ValidatorAddresses {
+		if this.ValidatorAddresses[i] != that1.ValidatorAddresses[i] {
+			return false
+		}
+	}
+	if this.NextValidatorAddress != that1.NextValidatorAddress {
+		return false
+	}
+	return true
+}
 func (this *MsgWithdrawValidatorCommissionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -994,6 +1158,12 @@ type MsgClient interface {
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
 	// from a single validator.
 	WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of
+	// delegator from all its validators, at most max_withdraw_all_validators of
+	// them per message.
+	//
+	// Since: cosmos-sdk 0.51
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
@@ -1049,6 +1219,15 @@ func (c *msgClient) WithdrawDelegatorReward(ctx context.Context, in *MsgWithdraw
 	return out, nil
 }
 
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error) {
 	out := new(MsgWithdrawValidatorCommissionResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission", in, out, opts...)
@@ -1103,6 +1282,12 @@ type MsgServer interface {
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
 	// from a single validator.
 	WithdrawDelegatorReward(context.Context, *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of
+	// delegator from all its validators, at most max_withdraw_all_validators of
+	// them per message.
+	//
+	// Since: cosmos-sdk 0.51
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(context.Context, *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error)
@@ -1142,6 +1327,9 @@ func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) WithdrawDelegatorReward(ctx context.Context, req *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDelegatorReward not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawAllDelegatorRewards(ctx context.Context, req *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
 func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context, req *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawValidatorCommission not implemented")
 }
@@ -1198,6 +1386,24 @@ func _Msg_WithdrawDelegatorReward_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawValidatorCommission)
 	if err := dec(in); err != nil {
@@ -1300,6 +1506,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawDelegatorReward",
 			Handler:    _Msg_WithdrawDelegatorReward_Handler,
 		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
 		{
 			MethodName: "WithdrawValidatorCommission",
 			Handler:    _Msg_WithdrawValidatorCommission_Handler,
@@ -1459,6 +1669,96 @@ func (m *MsgWithdrawDelegatorRewardResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawAllDelegatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StartValidatorAddress) > 0 {
+		i -= len(m.StartValidatorAddress)
+		copy(dAtA[i:], m.StartValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StartValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextValidatorAddress) > 0 {
+		i -= len(m.NextValidatorAddress)
+		copy(dAtA[i:], m.NextValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgWithdrawValidatorCommission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1873,6 +2173,48 @@ func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawAllDelegatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StartValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.NextValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgWithdrawValidatorCommission) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2385,6 +2727,268 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawAllDelegatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
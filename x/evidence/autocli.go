@@ -28,6 +28,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all (paginated) submitted evidence",
 					Example:   fmt.Sprintf("%s query evidence --page=2 --page-limit=50", version.AppName),
 				},
+				{
+					RpcMethod: "EvidenceWindow",
+					Use:       "window",
+					Short:     "Query the window in which equivocation evidence is currently accepted",
+					Example:   fmt.Sprintf("%s query evidence window", version.AppName),
+				},
 			},
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/x/evidence/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -74,3 +75,39 @@ func (k Querier) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceReq
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidences, Pagination: pageRes}, nil
 }
+
+// EvidenceWindow implements the Query/EvidenceWindow gRPC method
+func (k Querier) EvidenceWindow(ctx context.Context, req *types.QueryEvidenceWindowRequest) (*types.QueryEvidenceWindowResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	unbondingTime, err := k.k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	historicalEntries, err := k.k.stakingKeeper.HistoricalEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryEvidenceWindowResponse{
+		UnbondingTime:     unbondingTime,
+		HistoricalEntries: historicalEntries,
+	}
+
+	// evidence is only rejected once it is too old both in blocks and in time,
+	// see handleEquivocationEvidence.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cp := sdkCtx.ConsensusParams() // TODO: remove in favor of querying consensus module
+	if cp.Evidence != nil {
+		headerInfo := k.k.environment.HeaderService.GetHeaderInfo(ctx)
+		res.MaxAgeNumBlocks = cp.Evidence.MaxAgeNumBlocks
+		res.MaxAgeDuration = cp.Evidence.MaxAgeDuration
+		res.MinHeight = max(headerInfo.Height-cp.Evidence.MaxAgeNumBlocks, 0)
+		res.MinTime = headerInfo.Time.Add(-cp.Evidence.MaxAgeDuration)
+	}
+
+	return res, nil
+}
@@ -4,8 +4,14 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -156,3 +162,29 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryEvidenceWindow() {
+	suite.SetupTest()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 1000, Time: now}).WithConsensusParams(cmtproto.ConsensusParams{
+		Evidence: &cmtproto.EvidenceParams{
+			MaxAgeNumBlocks: 100,
+			MaxAgeDuration:  48 * time.Hour,
+		},
+	})
+
+	suite.stakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(21*24*time.Hour, nil)
+	suite.stakingKeeper.EXPECT().HistoricalEntries(gomock.Any()).Return(uint32(10000), nil)
+
+	res, err := keeper.NewQuerier(&suite.evidenceKeeper).EvidenceWindow(ctx, &types.QueryEvidenceWindowRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryEvidenceWindowResponse{
+		MaxAgeNumBlocks:   100,
+		MaxAgeDuration:    48 * time.Hour,
+		UnbondingTime:     21 * 24 * time.Hour,
+		HistoricalEntries: 10000,
+		MinHeight:         900,
+		MinTime:           now.Add(-48 * time.Hour),
+	}, res)
+}
@@ -18,6 +18,8 @@ type (
 	StakingKeeper interface {
 		ConsensusAddressCodec() address.Codec
 		ValidatorByConsAddr(context.Context, sdk.ConsAddress) (sdk.ValidatorI, error)
+		UnbondingTime(context.Context) (time.Duration, error)
+		HistoricalEntries(context.Context) (uint32, error)
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -8,21 +8,27 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -238,11 +244,151 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEvidenceWindowRequest is the request type for the Query/EvidenceWindow RPC
+// method.
+//
+// Since: x/evidence 0.2.0
+type QueryEvidenceWindowRequest struct {
+}
+
+func (m *QueryEvidenceWindowRequest) Reset()         { *m = QueryEvidenceWindowRequest{} }
+func (m *QueryEvidenceWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceWindowRequest) ProtoMessage()    {}
+func (*QueryEvidenceWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryEvidenceWindowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceWindowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceWindowRequest.Merge(m, src)
+}
+func (m *QueryEvidenceWindowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceWindowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceWindowRequest proto.InternalMessageInfo
+
+// QueryEvidenceWindowResponse is the response type for the Query/EvidenceWindow RPC
+// method. Evidence of an infraction is accepted if the infraction happened at or
+// after min_height, or at or after min_time.
+//
+// Since: x/evidence 0.2.0
+type QueryEvidenceWindowResponse struct {
+	// max_age_num_blocks is the evidence max age in blocks, from the consensus parameters.
+	MaxAgeNumBlocks int64 `protobuf:"varint,1,opt,name=max_age_num_blocks,json=maxAgeNumBlocks,proto3" json:"max_age_num_blocks,omitempty"`
+	// max_age_duration is the evidence max age in time, from the consensus parameters.
+	MaxAgeDuration time.Duration `protobuf:"bytes,2,opt,name=max_age_duration,json=maxAgeDuration,proto3,stdduration" json:"max_age_duration"`
+	// unbonding_time is the staking unbonding time. Stake unbonding at the time
+	// of an infraction can only be slashed within this duration.
+	UnbondingTime time.Duration `protobuf:"bytes,3,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time"`
+	// historical_entries is the number of historical info entries kept by x/staking.
+	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
+	// min_height is the lowest infraction height for which evidence is accepted
+	// regardless of its age in time.
+	MinHeight int64 `protobuf:"varint,5,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// min_time is the earliest infraction time for which evidence is accepted
+	// regardless of its age in blocks.
+	MinTime time.Time `protobuf:"bytes,6,opt,name=min_time,json=minTime,proto3,stdtime" json:"min_time"`
+}
+
+func (m *QueryEvidenceWindowResponse) Reset()         { *m = QueryEvidenceWindowResponse{} }
+func (m *QueryEvidenceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceWindowResponse) ProtoMessage()    {}
+func (*QueryEvidenceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryEvidenceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceWindowResponse.Merge(m, src)
+}
+func (m *QueryEvidenceWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceWindowResponse proto.InternalMessageInfo
+
+func (m *QueryEvidenceWindowResponse) GetMaxAgeNumBlocks() int64 {
+	if m != nil {
+		return m.MaxAgeNumBlocks
+	}
+	return 0
+}
+
+func (m *QueryEvidenceWindowResponse) GetMaxAgeDuration() time.Duration {
+	if m != nil {
+		return m.MaxAgeDuration
+	}
+	return 0
+}
+
+func (m *QueryEvidenceWindowResponse) GetUnbondingTime() time.Duration {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *QueryEvidenceWindowResponse) GetHistoricalEntries() uint32 {
+	if m != nil {
+		return m.HistoricalEntries
+	}
+	return 0
+}
+
+func (m *QueryEvidenceWindowResponse) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *QueryEvidenceWindowResponse) GetMinTime() time.Time {
+	if m != nil {
+		return m.MinTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryEvidenceWindowRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceWindowRequest")
+	proto.RegisterType((*QueryEvidenceWindowResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceWindowResponse")
 }
 
 func init() {
@@ -250,34 +396,49 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcb, 0x6e, 0x1a, 0x31,
-	0x18, 0x85, 0xf1, 0xf4, 0x22, 0x6a, 0xe8, 0xc6, 0xa2, 0x82, 0x8e, 0xda, 0x11, 0x1d, 0xa4, 0x42,
-	0x91, 0x6a, 0x03, 0x5d, 0x74, 0x0d, 0x52, 0x6f, 0xbb, 0x76, 0xba, 0xeb, 0xa6, 0xf2, 0x80, 0x3b,
-	0x8c, 0x4a, 0xed, 0x01, 0x0f, 0xa8, 0xa8, 0xea, 0xa6, 0x4f, 0x50, 0x29, 0xca, 0x2a, 0xca, 0xe3,
-	0x44, 0xca, 0x12, 0x29, 0x9b, 0x2c, 0x23, 0xc8, 0x83, 0x44, 0xd8, 0x1e, 0x20, 0x5c, 0x42, 0xb2,
-	0x83, 0xf9, 0xcf, 0x39, 0xff, 0xa7, 0xf3, 0x1b, 0x96, 0xda, 0x42, 0xfe, 0x12, 0x92, 0xb0, 0x51,
-	0xd8, 0x61, 0xbc, 0xcd, 0xc8, 0xa8, 0xee, 0xb3, 0x98, 0xd6, 0x49, 0x7f, 0xc8, 0x06, 0x63, 0x1c,
-	0x0d, 0x44, 0x2c, 0x50, 0x5e, 0x8b, 0x70, 0x22, 0xc2, 0x46, 0x64, 0x57, 0x8d, 0xdb, 0xa7, 0x92,
-	0x69, 0xc7, 0xc2, 0x1f, 0xd1, 0x20, 0xe4, 0x34, 0x0e, 0x05, 0xd7, 0x21, 0xf6, 0xd3, 0x40, 0x88,
-	0xa0, 0xc7, 0x88, 0xfa, 0xe7, 0x0f, 0x7f, 0x10, 0xca, 0x4d, 0xbe, 0xfd, 0xcc, 0x8c, 0x68, 0x14,
-	0x12, 0xca, 0xb9, 0x88, 0x95, 0x4f, 0xea, 0xa9, 0xfb, 0x15, 0xe6, 0xbe, 0xcc, 0xa3, 0xdf, 0x99,
-	0xed, 0x1e, 0xeb, 0x0f, 0x99, 0x8c, 0x51, 0x19, 0x3e, 0x4e, 0x80, 0xbe, 0x77, 0xa9, 0xec, 0x16,
-	0x40, 0x11, 0x54, 0xb2, 0x2d, 0xab, 0x00, 0xbc, 0x6c, 0x32, 0xf8, 0x48, 0x65, 0x17, 0x21, 0x78,
-	0x5f, 0xcd, 0xad, 0x22, 0xa8, 0x3c, 0xf2, 0xd4, 0x6f, 0xf7, 0x13, 0x7c, 0xb2, 0x16, 0x2a, 0x23,
-	0xc1, 0x25, 0x43, 0x35, 0x98, 0x4e, 0xcc, 0x2a, 0x30, 0xd3, 0xc8, 0x61, 0x8d, 0x87, 0x13, 0x72,
-	0xdc, 0xe4, 0x63, 0x6f, 0xa1, 0x72, 0x29, 0xcc, 0xab, 0xa8, 0x66, 0xaf, 0xb7, 0x8e, 0xf8, 0x1e,
-	0xc2, 0x65, 0x0f, 0x26, 0xee, 0x25, 0x36, 0x6d, 0xce, 0x4b, 0xc3, 0xba, 0x66, 0x53, 0x1a, 0xfe,
-	0x4c, 0x83, 0xc4, 0xeb, 0xad, 0x38, 0xdd, 0x43, 0x00, 0x0b, 0x9b, 0x3b, 0xb6, 0x12, 0xdf, 0xdb,
-	0x4f, 0x8c, 0x3e, 0x5c, 0xc3, 0xb2, 0x14, 0x56, 0x79, 0x2f, 0x96, 0x5e, 0xb7, 0xca, 0xd5, 0x38,
-	0xb1, 0xe0, 0x03, 0xc5, 0x85, 0x8e, 0x00, 0x4c, 0x27, 0x64, 0xe8, 0x35, 0xde, 0xf1, 0x60, 0xf0,
-	0xb6, 0x43, 0xda, 0xf8, 0xb6, 0x72, 0x4d, 0xe0, 0xd6, 0xfe, 0x9d, 0x5d, 0x1e, 0x58, 0x55, 0x54,
-	0x21, 0xbb, 0x1e, 0xef, 0xe2, 0xc3, 0x9f, 0xf9, 0xb1, 0xff, 0xa2, 0x63, 0x00, 0x33, 0x2b, 0xd5,
-	0xa1, 0xda, 0xcd, 0x1b, 0x37, 0x2f, 0x69, 0xd7, 0xef, 0xe0, 0x30, 0x98, 0xaf, 0x14, 0x66, 0x09,
-	0xbd, 0xd8, 0x8b, 0xd9, 0x7a, 0x7b, 0x3a, 0x75, 0xc0, 0x64, 0xea, 0x80, 0x8b, 0xa9, 0x03, 0xfe,
-	0xcf, 0x9c, 0xd4, 0x64, 0xe6, 0xa4, 0xce, 0x67, 0x4e, 0xea, 0xdb, 0x73, 0xed, 0x95, 0x9d, 0x9f,
-	0x38, 0x14, 0xe4, 0xf7, 0x32, 0x23, 0x1e, 0x47, 0x4c, 0xfa, 0x0f, 0xd5, 0x85, 0xdf, 0x5c, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x9c, 0x7a, 0xc6, 0xc7, 0x03, 0x00, 0x00,
+	// 671 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdd, 0x4e, 0x14, 0x3d,
+	0x1c, 0xc6, 0xb7, 0xbb, 0xc0, 0xbb, 0x94, 0x8f, 0x57, 0x1b, 0x0c, 0xcb, 0x08, 0x03, 0x2e, 0x89,
+	0xac, 0x18, 0x3a, 0x7c, 0x98, 0x78, 0x68, 0xd8, 0x88, 0xa2, 0x89, 0x46, 0x47, 0x12, 0x13, 0x4f,
+	0x26, 0xdd, 0xdd, 0x3a, 0xdb, 0xb0, 0xd3, 0x0e, 0xdb, 0x19, 0x3e, 0x62, 0x3c, 0xf1, 0x0a, 0x48,
+	0x8c, 0x89, 0x89, 0xf1, 0x12, 0xbc, 0x0f, 0x0e, 0x49, 0x3c, 0xf1, 0x48, 0x0d, 0x78, 0x09, 0x7a,
+	0x6e, 0xa6, 0xed, 0x2c, 0xec, 0x2e, 0x08, 0x7b, 0xd6, 0xe9, 0xf3, 0x7f, 0x9e, 0xfe, 0xfa, 0x6f,
+	0x3b, 0x70, 0xb6, 0x2a, 0x64, 0x20, 0xa4, 0x43, 0xb7, 0x59, 0x8d, 0xf2, 0x2a, 0x75, 0xb6, 0x97,
+	0x2a, 0x34, 0x22, 0x4b, 0xce, 0x56, 0x4c, 0x9b, 0x7b, 0x38, 0x6c, 0x8a, 0x48, 0xa0, 0x71, 0x5d,
+	0x84, 0xd3, 0x22, 0x6c, 0x8a, 0xac, 0x79, 0xe3, 0xae, 0x10, 0x49, 0xb5, 0xa3, 0xe5, 0x0f, 0x89,
+	0xcf, 0x38, 0x89, 0x98, 0xe0, 0x3a, 0xc4, 0x1a, 0xf3, 0x85, 0x2f, 0xd4, 0xd0, 0x49, 0x46, 0x66,
+	0x76, 0xc2, 0x17, 0xc2, 0x6f, 0x50, 0x47, 0x7d, 0x55, 0xe2, 0xd7, 0x0e, 0xe1, 0x66, 0x55, 0xcb,
+	0xee, 0x94, 0x6a, 0x71, 0xf3, 0x74, 0xe0, 0x74, 0xa7, 0x1e, 0xb1, 0x80, 0xca, 0x88, 0x04, 0xa1,
+	0x29, 0x98, 0x34, 0x05, 0x24, 0x64, 0x0e, 0xe1, 0x5c, 0x44, 0xca, 0x2d, 0xb5, 0x5a, 0x7c, 0x01,
+	0xc7, 0x9e, 0x27, 0xc4, 0x6b, 0x66, 0x53, 0x2e, 0xdd, 0x8a, 0xa9, 0x8c, 0xd0, 0x1c, 0x1c, 0x49,
+	0xf7, 0xe9, 0xd5, 0x89, 0xac, 0x17, 0xc0, 0x0c, 0x28, 0x0d, 0x97, 0xb3, 0x05, 0xe0, 0x0e, 0xa7,
+	0xc2, 0x3a, 0x91, 0x75, 0x84, 0x60, 0x9f, 0xd2, 0xb3, 0x33, 0xa0, 0x34, 0xe8, 0xaa, 0x71, 0xf1,
+	0x11, 0xbc, 0xd6, 0x11, 0x2a, 0x43, 0xc1, 0x25, 0x45, 0x8b, 0x30, 0x9f, 0x9a, 0x55, 0xe0, 0xd0,
+	0xf2, 0x18, 0xd6, 0x78, 0x38, 0xe5, 0xc7, 0xab, 0x7c, 0xcf, 0x6d, 0x55, 0x15, 0x09, 0x1c, 0x57,
+	0x51, 0xab, 0x8d, 0x46, 0x27, 0xe2, 0x03, 0x08, 0x4f, 0xda, 0x6b, 0xe2, 0x6e, 0x62, 0x73, 0x48,
+	0xc9, 0x59, 0x60, 0x7d, 0x7a, 0xe6, 0x2c, 0xf0, 0x33, 0xe2, 0xa7, 0x5e, 0xf7, 0x94, 0xb3, 0xf8,
+	0x01, 0xc0, 0x42, 0xf7, 0x1a, 0x67, 0x12, 0xe7, 0x2e, 0x26, 0x46, 0x0f, 0xdb, 0xb0, 0xb2, 0x0a,
+	0x6b, 0xee, 0x42, 0x2c, 0xbd, 0x5c, 0x1b, 0xd7, 0x24, 0xb4, 0xda, 0xba, 0xf8, 0x92, 0xf1, 0x9a,
+	0xd8, 0x31, 0x3b, 0x28, 0xfe, 0xce, 0xc2, 0xeb, 0x67, 0xca, 0x06, 0xfc, 0x36, 0x44, 0x01, 0xd9,
+	0xf5, 0x88, 0x4f, 0x3d, 0x1e, 0x07, 0x5e, 0xa5, 0x21, 0xaa, 0x9b, 0x52, 0x75, 0x29, 0xe7, 0xfe,
+	0x1f, 0x90, 0xdd, 0x55, 0x9f, 0x3e, 0x8d, 0x83, 0xb2, 0x9a, 0x46, 0x4f, 0xe0, 0x95, 0xb4, 0x38,
+	0xbd, 0x5e, 0x86, 0x7c, 0xa2, 0x6b, 0xb7, 0xf7, 0x4d, 0x41, 0x39, 0x7f, 0xf0, 0x7d, 0x3a, 0xf3,
+	0xf1, 0xc7, 0x34, 0x70, 0x47, 0x75, 0x5e, 0xaa, 0xa0, 0xc7, 0x70, 0x34, 0xe6, 0x15, 0xc1, 0x6b,
+	0x8c, 0xfb, 0x5e, 0x72, 0x1f, 0x0b, 0xb9, 0xcb, 0x87, 0x8d, 0xb4, 0xac, 0x1b, 0x2c, 0xa0, 0x68,
+	0x01, 0xa2, 0x3a, 0x93, 0x91, 0x68, 0xb2, 0x2a, 0x69, 0x78, 0x94, 0x47, 0x4d, 0x46, 0x65, 0xa1,
+	0x6f, 0x06, 0x94, 0x46, 0xdc, 0xab, 0x27, 0xca, 0x9a, 0x16, 0xd0, 0x14, 0x84, 0x01, 0xe3, 0x5e,
+	0x9d, 0x32, 0xbf, 0x1e, 0x15, 0xfa, 0xd5, 0x76, 0x07, 0x03, 0xc6, 0xd7, 0xd5, 0x04, 0xba, 0x07,
+	0xf3, 0x89, 0xac, 0x98, 0x06, 0x14, 0x93, 0xd5, 0xc5, 0xb4, 0x91, 0x3e, 0x20, 0x0d, 0xb5, 0x9f,
+	0x40, 0xfd, 0x17, 0x30, 0x9e, 0xcc, 0x2f, 0xff, 0xc9, 0xc1, 0x7e, 0xd5, 0x76, 0xf4, 0x09, 0xc0,
+	0x7c, 0xda, 0x7b, 0xb4, 0x80, 0xcf, 0xf9, 0x39, 0xe0, 0xb3, 0x5e, 0x97, 0x85, 0x2f, 0x5b, 0xae,
+	0x0f, 0xb3, 0xb8, 0xf8, 0xee, 0xeb, 0xaf, 0xf7, 0xd9, 0x79, 0x54, 0x72, 0xce, 0xfb, 0x51, 0xb5,
+	0x26, 0xde, 0x24, 0x2f, 0xf0, 0x2d, 0xfa, 0x0c, 0xe0, 0xd0, 0xa9, 0xfb, 0x8c, 0x16, 0xff, 0xbd,
+	0x62, 0xf7, 0xf3, 0xb2, 0x96, 0x7a, 0x70, 0x18, 0xcc, 0x5b, 0x0a, 0x73, 0x16, 0xdd, 0xb8, 0x10,
+	0x13, 0x7d, 0x01, 0x70, 0xb4, 0xfd, 0xe6, 0xa2, 0x95, 0xcb, 0x35, 0xa5, 0xed, 0x19, 0x58, 0x77,
+	0x7a, 0x33, 0xf5, 0xdc, 0x4f, 0x6f, 0x47, 0x39, 0xcb, 0x77, 0x0f, 0x8e, 0x6c, 0x70, 0x78, 0x64,
+	0x83, 0x9f, 0x47, 0x36, 0xd8, 0x3f, 0xb6, 0x33, 0x87, 0xc7, 0x76, 0xe6, 0xdb, 0xb1, 0x9d, 0x79,
+	0x35, 0xa5, 0x23, 0x64, 0x6d, 0x13, 0x33, 0xe1, 0xec, 0x9e, 0x44, 0x45, 0x7b, 0x21, 0x95, 0x95,
+	0x01, 0x75, 0xaf, 0x56, 0xfe, 0x0e, 0x00, 0xeb, 0xc3, 0x4d, 0x39, 0x63, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +457,11 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EvidenceWindow queries the window in which equivocation evidence is currently
+	// accepted, along with the parameters it is derived from.
+	//
+	// Since: x/evidence 0.2.0
+	EvidenceWindow(ctx context.Context, in *QueryEvidenceWindowRequest, opts ...grpc.CallOption) (*QueryEvidenceWindowResponse, error)
 }
 
 type queryClient struct {
@@ -324,12 +490,26 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EvidenceWindow(ctx context.Context, in *QueryEvidenceWindowRequest, opts ...grpc.CallOption) (*QueryEvidenceWindowResponse, error) {
+	out := new(QueryEvidenceWindowResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/EvidenceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EvidenceWindow queries the window in which equivocation evidence is currently
+	// accepted, along with the parameters it is derived from.
+	//
+	// Since: x/evidence 0.2.0
+	EvidenceWindow(context.Context, *QueryEvidenceWindowRequest) (*QueryEvidenceWindowResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -342,6 +522,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) EvidenceWindow(ctx context.Context, req *QueryEvidenceWindowRequest) (*QueryEvidenceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceWindow not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -383,6 +566,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvidenceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/EvidenceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceWindow(ctx, req.(*QueryEvidenceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -395,6 +596,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EvidenceWindow",
+			Handler:    _Query_EvidenceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -556,6 +761,91 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEvidenceWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MinTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MinTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxAgeNumBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAgeNumBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -629,6 +919,39 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEvidenceWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEvidenceWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAgeNumBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAgeNumBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovQuery(uint64(m.HistoricalEntries))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MinTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1043,6 +1366,262 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEvidenceWindowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceWindowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeNumBlocks", wireType)
+			}
+			m.MaxAgeNumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeNumBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAgeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MinTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
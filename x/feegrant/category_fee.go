@@ -0,0 +1,131 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*CategoryAllowance)(nil)
+
+// Accept grants the fees of the transaction when all its messages belong to one
+// of the categories, the first one in order, and spends the fees from the limit
+// of that category.
+//
+// The allowance is removed when it expires, or when every category has a spend
+// limit and all the limits are used up.
+func (a *CategoryAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if a.Expiration != nil && a.Expiration.Before(sdkCtx.HeaderInfo().Time) {
+		return true, errorsmod.Wrap(ErrFeeLimitExpired, "category allowance")
+	}
+
+	i, found := a.category(ctx, msgs)
+	if !found {
+		return false, errorsmod.Wrap(ErrMessageNotAllowed, "messages do not belong to a single allowed category")
+	}
+
+	category := &a.Categories[i]
+	if category.SpendLimit != nil {
+		left, invalid := category.SpendLimit.SafeSub(fee...)
+		if invalid {
+			return false, errorsmod.Wrapf(ErrFeeLimitExceeded, "category %s", category.Name)
+		}
+		category.SpendLimit = left
+	}
+
+	return a.usedUp(), nil
+}
+
+// category returns the index of the first category all the messages belong to.
+func (a *CategoryAllowance) category(ctx context.Context, msgs []sdk.Msg) (int, bool) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	typeURLs := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		typeURLs = append(typeURLs, sdk.MsgTypeURL(msg))
+	}
+
+	for i, category := range a.Categories {
+		msgsMap := make(map[string]bool, len(category.Messages))
+		for _, msg := range category.Messages {
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+			msgsMap[msg] = true
+		}
+
+		allowed := true
+		for _, typeURL := range typeURLs {
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+			if !msgsMap[typeURL] {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// usedUp returns whether every category has a spend limit and all the limits
+// are used up.
+func (a *CategoryAllowance) usedUp() bool {
+	for _, category := range a.Categories {
+		if category.SpendLimit == nil || !category.SpendLimit.IsZero() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *CategoryAllowance) ValidateBasic() error {
+	if len(a.Categories) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "categories shouldn't be empty")
+	}
+
+	names := make(map[string]bool, len(a.Categories))
+	for _, category := range a.Categories {
+		if category.Name == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "category name cannot be empty")
+		}
+		if names[category.Name] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate category %s", category.Name)
+		}
+		names[category.Name] = true
+
+		if len(category.Messages) == 0 {
+			return errorsmod.Wrapf(ErrNoMessages, "messages of category %s shouldn't be empty", category.Name)
+		}
+
+		if category.SpendLimit != nil {
+			if !category.SpendLimit.IsValid() {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit of category %s is invalid: %s", category.Name, category.SpendLimit)
+			}
+			if !category.SpendLimit.IsAllPositive() {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit of category %s must be positive", category.Name)
+			}
+		}
+	}
+
+	if a.Expiration != nil && a.Expiration.Unix() < 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
+	}
+
+	return nil
+}
+
+// ExpiresAt returns the expiry time of the CategoryAllowance.
+func (a *CategoryAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Expiration, nil
+}
+
+// UpdatePeriodReset CategoryAllowance does not update "PeriodReset"
+func (a *CategoryAllowance) UpdatePeriodReset(validTime time.Time) error { return nil }
@@ -0,0 +1,178 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCategoryAllowance(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	now := time.Now()
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: now})
+	oneHour := now.Add(time.Hour)
+
+	send := &banktypes.MsgSend{}
+	multiSend := &banktypes.MsgMultiSend{}
+	setSendEnabled := &banktypes.MsgSetSendEnabled{}
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+
+	newAllowance := func() *feegrant.CategoryAllowance {
+		return &feegrant.CategoryAllowance{
+			Categories: []feegrant.MsgCategory{
+				{Name: "send", Messages: []string{sdk.MsgTypeURL(send)}, SpendLimit: atom(100)},
+				{Name: "transfers", Messages: []string{sdk.MsgTypeURL(send), sdk.MsgTypeURL(multiSend)}},
+			},
+			Expiration: &oneHour,
+		}
+	}
+
+	cases := map[string]struct {
+		msgs      []sdk.Msg
+		fee       sdk.Coins
+		blockTime time.Time
+		accept    bool
+		remove    bool
+		remains   []sdk.Coins
+	}{
+		"first category spends its limit": {
+			msgs:    []sdk.Msg{send, send},
+			fee:     atom(40),
+			accept:  true,
+			remains: []sdk.Coins{atom(60), nil},
+		},
+		"category without limit": {
+			msgs:    []sdk.Msg{send, multiSend},
+			fee:     atom(1000),
+			accept:  true,
+			remains: []sdk.Coins{atom(100), nil},
+		},
+		"category limit exceeded": {
+			msgs:   []sdk.Msg{send},
+			fee:    atom(101),
+			accept: false,
+		},
+		"message not in any category": {
+			msgs:   []sdk.Msg{setSendEnabled},
+			fee:    atom(1),
+			accept: false,
+		},
+		"messages of different categories": {
+			msgs:   []sdk.Msg{multiSend, setSendEnabled},
+			fee:    atom(1),
+			accept: false,
+		},
+		"expired": {
+			msgs:      []sdk.Msg{send},
+			fee:       atom(1),
+			blockTime: oneHour.Add(time.Second),
+			accept:    false,
+			remove:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance := newAllowance()
+			require.NoError(t, allowance.ValidateBasic())
+
+			ctx := ctx
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithHeaderInfo(header.Info{Time: tc.blockTime})
+			}
+
+			remove, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			require.Equal(t, tc.remove, remove)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for i, remains := range tc.remains {
+				require.Equal(t, remains, allowance.Categories[i].SpendLimit)
+			}
+		})
+	}
+}
+
+func TestCategoryAllowanceUsedUp(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	allowance := &feegrant.CategoryAllowance{
+		Categories: []feegrant.MsgCategory{
+			{Name: "send", Messages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, SpendLimit: atom},
+			{Name: "multi-send", Messages: []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, SpendLimit: atom},
+		},
+	}
+
+	remove, err := allowance.Accept(ctx, atom, []sdk.Msg{&banktypes.MsgSend{}})
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	// the allowance is removed once every category limit is used up
+	remove, err = allowance.Accept(ctx, atom, []sdk.Msg{&banktypes.MsgMultiSend{}})
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestCategoryAllowanceValidateBasic(t *testing.T) {
+	msgs := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	negative := time.Unix(-1, 0)
+
+	cases := map[string]struct {
+		allowance *feegrant.CategoryAllowance
+		err       string
+	}{
+		"valid": {
+			allowance: &feegrant.CategoryAllowance{Categories: []feegrant.MsgCategory{{Name: "send", Messages: msgs, SpendLimit: atom}}},
+		},
+		"no categories": {
+			allowance: &feegrant.CategoryAllowance{},
+			err:       "categories shouldn't be empty",
+		},
+		"empty name": {
+			allowance: &feegrant.CategoryAllowance{Categories: []feegrant.MsgCategory{{Messages: msgs}}},
+			err:       "category name cannot be empty",
+		},
+		"duplicate name": {
+			allowance: &feegrant.CategoryAllowance{Categories: []feegrant.MsgCategory{{Name: "send", Messages: msgs}, {Name: "send", Messages: msgs}}},
+			err:       "duplicate category send",
+		},
+		"no messages": {
+			allowance: &feegrant.CategoryAllowance{Categories: []feegrant.MsgCategory{{Name: "send"}}},
+			err:       "messages of category send shouldn't be empty",
+		},
+		"zero spend limit": {
+			allowance: &feegrant.CategoryAllowance{Categories: []feegrant.MsgCategory{{Name: "send", Messages: msgs, SpendLimit: sdk.Coins{sdk.NewInt64Coin("atom", 0)}}}},
+			err:       "spend limit of category send",
+		},
+		"negative expiration": {
+			allowance: &feegrant.CategoryAllowance{Categories: []feegrant.MsgCategory{{Name: "send", Messages: msgs}}, Expiration: &negative},
+			err:       "expiration time cannot be negative",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -24,6 +24,7 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagMsgCategory = "msg-category"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -60,8 +61,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
-				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --expiration 2022-01-30T15:04:05Z
+	--msg-category "gov-deposits=/cosmos.gov.v1.MsgDeposit:100stake"
+	--msg-category "staking=/cosmos.staking.v1beta1.MsgDelegate,/cosmos.staking.v1beta1.MsgUndelegate:50stake"
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -85,6 +89,34 @@ Examples:
 			if err != nil {
 				return err
 			}
+
+			categories, err := cmd.Flags().GetStringArray(FlagMsgCategory)
+			if err != nil {
+				return err
+			}
+
+			// the categories carry their own spend limits, a category allowance
+			// is not combined with the basic, periodic and filtered allowances
+			if len(categories) > 0 {
+				for _, flag := range []string{FlagSpendLimit, FlagPeriod, FlagPeriodLimit, FlagAllowedMsgs} {
+					if cmd.Flags().Changed(flag) {
+						return fmt.Errorf("--%s cannot be combined with --%s", FlagMsgCategory, flag)
+					}
+				}
+
+				grant, err := newCategoryAllowance(cmd, categories)
+				if err != nil {
+					return err
+				}
+
+				msg, err := feegrant.NewMsgGrantAllowance(grant, granterStr, args[1])
+				if err != nil {
+					return err
+				}
+
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
 			sl, err := cmd.Flags().GetString(FlagSpendLimit)
 			if err != nil {
 				return err
@@ -186,10 +218,64 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringArray(FlagMsgCategory, []string{}, "Category of messages with its own spend limit, as <name>=<msg_type_url>[,<msg_type_url>...][:<spend_limit>]; can be repeated, and cannot be combined with the spend limit, period and allowed messages flags")
 
 	return cmd
 }
 
+// newCategoryAllowance returns the category allowance of the given categories,
+// expiring at the --expiration time.
+func newCategoryAllowance(cmd *cobra.Command, categories []string) (*feegrant.CategoryAllowance, error) {
+	allowance := &feegrant.CategoryAllowance{}
+	for _, s := range categories {
+		category, err := ParseMsgCategory(s)
+		if err != nil {
+			return nil, err
+		}
+		allowance.Categories = append(allowance.Categories, category)
+	}
+
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	if exp != "" {
+		expiresAtTime, err := time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, err
+		}
+		allowance.Expiration = &expiresAtTime
+	}
+
+	return allowance, allowance.ValidateBasic()
+}
+
+// ParseMsgCategory parses a category of messages given as
+// <name>=<msg_type_url>[,<msg_type_url>...][:<spend_limit>]. Without a spend
+// limit, the fees of the messages of the category are not limited.
+func ParseMsgCategory(s string) (feegrant.MsgCategory, error) {
+	name, rest, found := strings.Cut(s, "=")
+	if !found || name == "" {
+		return feegrant.MsgCategory{}, fmt.Errorf("invalid message category %q, expected <name>=<msg_type_url>[,<msg_type_url>...][:<spend_limit>]", s)
+	}
+
+	msgs, spendLimit, _ := strings.Cut(rest, ":")
+	limit, err := sdk.ParseCoinsNormalized(spendLimit)
+	if err != nil {
+		return feegrant.MsgCategory{}, fmt.Errorf("invalid spend limit of message category %s: %w", name, err)
+	}
+
+	category := feegrant.MsgCategory{Name: name, SpendLimit: limit}
+	for _, msg := range strings.Split(msgs, ",") {
+		if msg = strings.TrimSpace(msg); msg != "" {
+			category.Messages = append(category.Messages, msg)
+		}
+	}
+
+	return category, nil
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
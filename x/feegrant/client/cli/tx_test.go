@@ -10,6 +10,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	_ "cosmossdk.io/api/cosmos/feegrant/v1beta1"
@@ -684,6 +685,103 @@ func (s *CLITestSuite) TestFilteredFeeAllowance() {
 	}
 }
 
+func (s *CLITestSuite) TestCategoryFeeAllowance() {
+	granter := s.addedGranter
+	grantee := s.addedGrantee
+	clientCtx := s.clientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))).String()),
+	}
+	deposits := fmt.Sprintf("gov-deposits=%s:100stake", sdk.MsgTypeURL(&v1.MsgDeposit{}))
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+	}{
+		{
+			"valid category fee grant",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagMsgCategory, deposits),
+					fmt.Sprintf("--%s=votes=%s,%s", cli.FlagMsgCategory, sdk.MsgTypeURL(&v1.MsgVote{}), sdk.MsgTypeURL(&v1.MsgVoteWeighted{})),
+					fmt.Sprintf("--%s=%s", cli.FlagExpiration, getFormattedExpiration(oneYear)),
+				},
+				commonFlags...,
+			),
+			"",
+		},
+		{
+			"combined with a spend limit",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagMsgCategory, deposits),
+					fmt.Sprintf("--%s=100stake", cli.FlagSpendLimit),
+				},
+				commonFlags...,
+			),
+			"--msg-category cannot be combined with --spend-limit",
+		},
+		{
+			"duplicate category",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagMsgCategory, deposits),
+					fmt.Sprintf("--%s=%s", cli.FlagMsgCategory, deposits),
+				},
+				commonFlags...,
+			),
+			"duplicate category gov-deposits",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdFeeGrant()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				msg := &sdk.TxResponse{}
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), msg), out.String())
+			}
+		})
+	}
+}
+
+func TestParseMsgCategory(t *testing.T) {
+	category, err := cli.ParseMsgCategory("staking=/cosmos.staking.v1beta1.MsgDelegate, /cosmos.staking.v1beta1.MsgUndelegate:10atom,50stake")
+	require.NoError(t, err)
+	require.Equal(t, feegrant.MsgCategory{
+		Name:       "staking",
+		Messages:   []string{"/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.staking.v1beta1.MsgUndelegate"},
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 50)),
+	}, category)
+
+	category, err = cli.ParseMsgCategory("votes=/cosmos.gov.v1.MsgVote")
+	require.NoError(t, err)
+	require.Nil(t, category.SpendLimit)
+
+	_, err = cli.ParseMsgCategory("/cosmos.gov.v1.MsgVote")
+	require.ErrorContains(t, err, "invalid message category")
+
+	_, err = cli.ParseMsgCategory("votes=/cosmos.gov.v1.MsgVote:ten")
+	require.ErrorContains(t, err, "invalid spend limit of message category votes")
+}
+
 // msgVote votes for a proposal
 func (s *CLITestSuite) msgVote(clientCtx client.Context, from, id, vote string, extraArgs ...string) error {
 	commonArgs := []string{
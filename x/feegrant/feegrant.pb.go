@@ -219,6 +219,116 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// MsgCategory is a category of messages whose fees are granted up to a spend
+// limit of their own.
+type MsgCategory struct {
+	// name identifies the category within the allowance, e.g. "gov-deposits".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// messages are the type URLs of the messages of the category.
+	Messages []string `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// spend_limit specifies the maximum amount of coins that can be spent on the
+	// fees of the messages of the category and will be updated as coins are
+	// spent. If it is empty, there is no spend limit for the category.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *MsgCategory) Reset()         { *m = MsgCategory{} }
+func (m *MsgCategory) String() string { return proto.CompactTextString(m) }
+func (*MsgCategory) ProtoMessage()    {}
+func (*MsgCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *MsgCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCategory.Merge(m, src)
+}
+func (m *MsgCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCategory proto.InternalMessageInfo
+
+func (m *MsgCategory) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgCategory) GetMessages() []string {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *MsgCategory) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// CategoryAllowance creates allowance only for the messages of its categories,
+// each category spending its own limit. The fees of a transaction are granted
+// when all its messages belong to the same category.
+type CategoryAllowance struct {
+	// categories are the categories of messages the fees of which are granted.
+	Categories []MsgCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"`
+	// expiration specifies an optional time when this allowance expires
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *CategoryAllowance) Reset()         { *m = CategoryAllowance{} }
+func (m *CategoryAllowance) String() string { return proto.CompactTextString(m) }
+func (*CategoryAllowance) ProtoMessage()    {}
+func (*CategoryAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *CategoryAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CategoryAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CategoryAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CategoryAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoryAllowance.Merge(m, src)
+}
+func (m *CategoryAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *CategoryAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoryAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoryAllowance proto.InternalMessageInfo
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +343,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{5}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +397,8 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*MsgCategory)(nil), "cosmos.feegrant.v1beta1.MsgCategory")
+	proto.RegisterType((*CategoryAllowance)(nil), "cosmos.feegrant.v1beta1.CategoryAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,48 +407,53 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
+	// 738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0xb4, 0x85, 0xef, 0xb7, 0x53, 0x44, 0x58, 0x49, 0xdc, 0x36, 0x66, 0x4b, 0x1a, 0x7f,
+	0x14, 0x12, 0x76, 0x03, 0xde, 0x38, 0xc1, 0x62, 0x40, 0x0d, 0x28, 0x59, 0x3c, 0x99, 0x98, 0x66,
+	0xba, 0x3b, 0xac, 0x13, 0xba, 0x3b, 0xcd, 0xce, 0xa2, 0xf4, 0xea, 0xc9, 0xe8, 0x41, 0x8e, 0xc6,
+	0x13, 0x47, 0xe3, 0x89, 0x03, 0x7f, 0x81, 0x27, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07,
+	0xce, 0x5e, 0x3c, 0x9b, 0x9d, 0x99, 0xdd, 0x2e, 0xad, 0x44, 0x48, 0x08, 0x5e, 0xda, 0x9d, 0x37,
+	0xef, 0x7d, 0xde, 0xe7, 0xf3, 0xde, 0xdb, 0xd7, 0xc2, 0x9b, 0x36, 0x65, 0x1e, 0x65, 0xc6, 0x2a,
+	0xc6, 0x6e, 0x80, 0xfc, 0xd0, 0x78, 0x36, 0xd9, 0xc0, 0x21, 0x9a, 0x4c, 0x0c, 0x7a, 0x2b, 0xa0,
+	0x21, 0x55, 0xae, 0x0a, 0x3f, 0x3d, 0x31, 0x4b, 0xbf, 0xf2, 0x88, 0x4b, 0x5d, 0xca, 0x7d, 0x8c,
+	0xe8, 0x49, 0xb8, 0x97, 0x4b, 0x2e, 0xa5, 0x6e, 0x13, 0x1b, 0xfc, 0xd4, 0x58, 0x5f, 0x35, 0x90,
+	0xdf, 0x8e, 0xaf, 0x04, 0x52, 0x5d, 0xc4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x32, 0x0d, 0xc4, 0x70,
+	0x42, 0xc4, 0xa6, 0xc4, 0x97, 0xf7, 0xc3, 0xc8, 0x23, 0x3e, 0x35, 0xf8, 0xa7, 0x34, 0x55, 0xba,
+	0x13, 0x85, 0xc4, 0xc3, 0x2c, 0x44, 0x5e, 0x2b, 0xc6, 0xec, 0x76, 0x70, 0xd6, 0x03, 0x14, 0x12,
+	0x2a, 0x31, 0xab, 0x5b, 0x59, 0x38, 0x68, 0x22, 0x46, 0xec, 0xd9, 0x66, 0x93, 0x3e, 0x47, 0xbe,
+	0x8d, 0x95, 0x17, 0x00, 0x16, 0x59, 0x0b, 0xfb, 0x4e, 0xbd, 0x49, 0x3c, 0x12, 0xaa, 0x60, 0x34,
+	0x57, 0x2b, 0x4e, 0x95, 0x74, 0xc9, 0x35, 0x62, 0x17, 0xcb, 0xd7, 0xe7, 0x28, 0xf1, 0xcd, 0xf9,
+	0xdd, 0xef, 0x95, 0xcc, 0x87, 0xfd, 0x4a, 0xcd, 0x25, 0xe1, 0xd3, 0xf5, 0x86, 0x6e, 0x53, 0x4f,
+	0x0a, 0x93, 0x5f, 0x13, 0xcc, 0x59, 0x33, 0xc2, 0x76, 0x0b, 0x33, 0x1e, 0xc0, 0xde, 0x1d, 0x6d,
+	0x8f, 0x0f, 0x34, 0xb1, 0x8b, 0xec, 0x76, 0x3d, 0xd2, 0xc7, 0xde, 0x1f, 0x6d, 0x8f, 0x03, 0x0b,
+	0xf2, 0xac, 0x8b, 0x51, 0x52, 0x65, 0x06, 0x42, 0xbc, 0xd1, 0x22, 0x82, 0xab, 0x9a, 0x1d, 0x05,
+	0xb5, 0xe2, 0x54, 0x59, 0x17, 0x62, 0xf4, 0x58, 0x8c, 0xfe, 0x28, 0x56, 0x6b, 0xe6, 0x37, 0xf7,
+	0x2b, 0xc0, 0x4a, 0xc5, 0x4c, 0x2f, 0x7c, 0xda, 0x99, 0xb8, 0x71, 0x42, 0xdb, 0xf4, 0x79, 0x8c,
+	0x13, 0xc1, 0xf7, 0x5e, 0x1d, 0x6d, 0x8f, 0x97, 0x52, 0x4c, 0x8f, 0xd7, 0xa3, 0xfa, 0x2d, 0x0f,
+	0x87, 0x97, 0x71, 0x40, 0xa8, 0x93, 0xae, 0xd2, 0x5d, 0xd8, 0xd7, 0x88, 0xfc, 0x54, 0xc0, 0xb9,
+	0xdd, 0xd2, 0x4f, 0x4a, 0x75, 0x1c, 0xcd, 0x2c, 0x44, 0xc5, 0x12, 0x7a, 0x05, 0x80, 0x32, 0x03,
+	0xfb, 0x5b, 0x1c, 0x5e, 0xca, 0x2c, 0xf5, 0xc8, 0xbc, 0x23, 0x7b, 0x66, 0x5e, 0x8a, 0x82, 0xdf,
+	0xee, 0x57, 0x80, 0x00, 0x90, 0x71, 0xca, 0x1b, 0x00, 0x15, 0xf1, 0x58, 0x4f, 0x37, 0x2e, 0x77,
+	0x51, 0x8d, 0x1b, 0x12, 0xc9, 0x57, 0x3a, 0xed, 0x7b, 0x0d, 0xa0, 0x34, 0xd6, 0x6d, 0xe4, 0x0b,
+	0x56, 0x6a, 0xfe, 0xa2, 0xf8, 0x0c, 0x8a, 0xd4, 0x73, 0xc8, 0xe7, 0x94, 0x94, 0x45, 0x38, 0x20,
+	0xc9, 0x04, 0x98, 0xe1, 0x50, 0xed, 0xfb, 0xeb, 0x38, 0xf1, 0x42, 0x6f, 0x26, 0x85, 0x2e, 0x8a,
+	0x70, 0x2b, 0x8a, 0x9e, 0xbe, 0x7f, 0xa6, 0xc1, 0xba, 0x96, 0x62, 0xde, 0x33, 0x45, 0xd5, 0x9f,
+	0x00, 0x5e, 0xe1, 0x27, 0xec, 0x2c, 0x31, 0xb7, 0x33, 0x5d, 0x4f, 0x60, 0x01, 0xc5, 0x07, 0x39,
+	0x61, 0x23, 0x3d, 0x74, 0x67, 0xfd, 0xb6, 0x39, 0x76, 0x6a, 0x32, 0x56, 0x07, 0x51, 0x19, 0x83,
+	0x43, 0x48, 0x64, 0xad, 0x7b, 0x98, 0x31, 0xe4, 0x62, 0xa6, 0x66, 0x47, 0x73, 0xb5, 0x82, 0x75,
+	0x59, 0xda, 0x97, 0xa4, 0x79, 0x7a, 0xf9, 0xe5, 0x56, 0x25, 0x73, 0x26, 0xc5, 0x5a, 0x4a, 0xf1,
+	0x1f, 0xb4, 0x55, 0x3f, 0x02, 0x58, 0x5c, 0x62, 0xee, 0x1c, 0x0a, 0xb1, 0x4b, 0x83, 0xb6, 0xa2,
+	0xc0, 0xbc, 0x8f, 0x3c, 0x21, 0xb3, 0x60, 0xf1, 0x67, 0xa5, 0x0c, 0xff, 0xef, 0x22, 0x96, 0x9c,
+	0x7b, 0xf6, 0x53, 0xee, 0x1f, 0xec, 0xa7, 0xea, 0x2f, 0x00, 0x87, 0x63, 0x05, 0x9d, 0xb6, 0x3d,
+	0x84, 0xd0, 0x16, 0x46, 0x82, 0x99, 0x5c, 0x9c, 0xd7, 0x4f, 0xdc, 0x0c, 0xa9, 0x22, 0xa4, 0xd7,
+	0x42, 0x0a, 0xe2, 0x1c, 0xd6, 0xe0, 0x83, 0x33, 0xf7, 0x2f, 0x3d, 0xb1, 0x3d, 0x12, 0xab, 0x9f,
+	0x01, 0xec, 0x5b, 0x88, 0x00, 0x94, 0x29, 0xf8, 0x1f, 0x47, 0xc2, 0x81, 0x68, 0x9d, 0xa9, 0x7e,
+	0xd9, 0x99, 0x18, 0x91, 0x69, 0x66, 0x1d, 0x27, 0xc0, 0x8c, 0xad, 0x84, 0x01, 0xf1, 0x5d, 0x2b,
+	0x76, 0xec, 0xc4, 0x60, 0x35, 0x7b, 0xba, 0x98, 0xae, 0x77, 0x21, 0x77, 0xde, 0xef, 0x82, 0x39,
+	0xb9, 0x7b, 0xa0, 0x81, 0xbd, 0x03, 0x0d, 0xfc, 0x38, 0xd0, 0xc0, 0xe6, 0xa1, 0x96, 0xd9, 0x3b,
+	0xd4, 0x32, 0x5f, 0x0f, 0xb5, 0xcc, 0x63, 0xf9, 0xa3, 0xcf, 0x9c, 0x35, 0x9d, 0x50, 0x63, 0x23,
+	0xf9, 0x4f, 0xd0, 0xe8, 0xe7, 0x69, 0x6f, 0xff, 0x1e, 0x00, 0x56, 0xde, 0x2a, 0x96, 0x3e, 0x08,
 	0x00, 0x00,
 }
 
@@ -508,6 +625,106 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCategory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCategory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CategoryAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CategoryAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintFeegrant(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Categories) > 0 {
+		for iNdEx := len(m.Categories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Categories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -633,6 +850,50 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *MsgCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CategoryAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Categories) > 0 {
+		for _, e := range m.Categories {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1115,6 +1376,274 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CategoryAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CategoryAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CategoryAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Categories = append(m.Categories, MsgCategory{})
+			if err := m.Categories[len(m.Categories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
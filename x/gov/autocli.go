@@ -44,6 +44,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "ProposalDependencies",
+					Use:       "proposal-dependencies [proposal-id]",
+					Short:     "Query the dependencies and dependents of a proposal",
+					Example:   fmt.Sprintf("%s query gov proposal-dependencies 2", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "Vote",
 					Use:       "vote [proposal-id] [voter-addr]",
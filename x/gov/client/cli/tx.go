@@ -112,6 +112,8 @@ Where proposal.json contains:
   "title": "My proposal",
   "summary": "A short summary of my proposal",
   "proposal_type": "standard",
+  // optional ids of the proposals that must be executed before this one
+  "dependencies": [1, 2],
 }
 
 metadata example: 
@@ -142,6 +144,7 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.Dependencies = proposal.Dependencies
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
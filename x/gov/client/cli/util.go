@@ -94,6 +94,7 @@ type proposal struct {
 	Title           string            `json:"title"`
 	Summary         string            `json:"summary"`
 	ProposalTypeStr string            `json:"proposal_type,omitempty"`
+	Dependencies    []uint64          `json:"dependencies,omitempty"`
 
 	proposalType govv1.ProposalType `json:"-"`
 }
@@ -71,6 +71,11 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		if err := k.Proposals.Set(ctx, proposal.Id, *proposal); err != nil {
 			return err
 		}
+		for _, dependency := range proposal.Dependencies {
+			if err := k.ProposalDependents.Set(ctx, collections.Join(dependency, proposal.Id)); err != nil {
+				return err
+			}
+		}
 	}
 
 	// if account has zero balance it probably means it's not set, so we set it
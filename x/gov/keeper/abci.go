@@ -159,46 +159,23 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 		switch {
 		case passes:
-			var (
-				idx int
-				msg sdk.Msg
-			)
-
-			messages, err := proposal.GetMsgs()
+			executed, failedReason, err := k.checkDependencies(ctx, proposal)
 			if err != nil {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
-
-				break
+				return false, err
 			}
 
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
-				// execute all messages
-				for idx, msg = range messages {
-					if _, err := safeExecuteHandler(ctx, msg, k.environment.RouterService.MessageRouterService()); err != nil {
-						// `idx` and `err` are populated with the msg index and error.
-						proposal.Status = v1.StatusFailed
-						proposal.FailedReason = err.Error()
-						tagValue = types.AttributeValueProposalFailed
-						logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
-
-						return err
-					}
-				}
-
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				return nil
-			}); err != nil {
-				break // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+			switch {
+			case failedReason != "":
+				proposal.Status = v1.StatusFailed
+				proposal.FailedReason = failedReason
+				tagValue = types.AttributeValueProposalFailed
+				logMsg = fmt.Sprintf("passed, but %s", failedReason)
+			case !executed:
+				proposal.Status = v1.StatusAwaitingDependencies
+				tagValue = types.AttributeValueProposalAwaitingDependencies
+				logMsg = "passed, awaiting dependencies"
+			default:
+				tagValue, logMsg = k.executeProposal(ctx, &proposal)
 			}
 		case !burnDeposits && (proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED ||
 			proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC):
@@ -265,11 +242,62 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			logger.Error("failed to emit event", "error", err)
 		}
 
+		// the proposal reached a final status, proposals awaiting it can now be executed or failed
+		if err := k.processDependents(ctx, proposal.Id); err != nil {
+			return false, err
+		}
+
 		return false, nil
 	})
 	return err
 }
 
+// executeProposal executes the messages of a passed proposal and sets its status
+// accordingly. It returns the event tag value and log message of the execution.
+func (k Keeper) executeProposal(ctx context.Context, proposal *v1.Proposal) (tagValue, logMsg string) {
+	var (
+		idx int
+		msg sdk.Msg
+	)
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		tagValue = types.AttributeValueProposalFailed
+		logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
+
+		return tagValue, logMsg
+	}
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	_ = k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+		// execute all messages
+		for idx, msg = range messages {
+			if _, err := safeExecuteHandler(ctx, msg, k.environment.RouterService.MessageRouterService()); err != nil {
+				// `idx` and `err` are populated with the msg index and error.
+				proposal.Status = v1.StatusFailed
+				proposal.FailedReason = err.Error()
+				tagValue = types.AttributeValueProposalFailed
+				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+
+				return err
+			}
+		}
+
+		proposal.Status = v1.StatusPassed
+		tagValue = types.AttributeValueProposalPassed
+		logMsg = "passed"
+
+		return nil
+	}) // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+
+	return tagValue, logMsg
+}
+
 // executes route(msg) and recovers from panic.
 func safeExecuteHandler(ctx context.Context, msg sdk.Msg, router router.Router) (res protoiface.MessageV1, err error) {
 	defer func() {
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// MaxProposalDependencies is the maximum number of proposals a proposal can depend on.
+const MaxProposalDependencies = 10
+
+// SetProposalDependencies sets the proposals a newly submitted proposal depends on.
+// The dependencies must exist and must not have been rejected or failed. As a proposal
+// can only depend on proposals submitted before it, the dependency graph has no cycles.
+func (k Keeper) SetProposalDependencies(ctx context.Context, proposal *v1.Proposal, dependencies []uint64) error {
+	if len(dependencies) == 0 {
+		return nil
+	}
+
+	if len(dependencies) > MaxProposalDependencies {
+		return types.ErrInvalidProposal.Wrapf("proposal cannot have more than %d dependencies, got %d", MaxProposalDependencies, len(dependencies))
+	}
+
+	seen := make(map[uint64]bool, len(dependencies))
+	for _, id := range dependencies {
+		if id == proposal.Id {
+			return types.ErrInvalidProposal.Wrap("proposal cannot depend on itself")
+		}
+
+		if seen[id] {
+			return types.ErrInvalidProposal.Wrapf("duplicate dependency %d", id)
+		}
+		seen[id] = true
+
+		dependency, err := k.Proposals.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return types.ErrInvalidProposal.Wrapf("dependency %d doesn't exist", id)
+			}
+			return err
+		}
+
+		if dependency.Status == v1.StatusRejected || dependency.Status == v1.StatusFailed {
+			return types.ErrInvalidProposal.Wrapf("dependency %d has status %s", id, dependency.Status)
+		}
+
+		if err := k.ProposalDependents.Set(ctx, collections.Join(id, proposal.Id)); err != nil {
+			return err
+		}
+	}
+
+	proposal.Dependencies = dependencies
+	return k.Proposals.Set(ctx, proposal.Id, *proposal)
+}
+
+// GetProposalDependents returns the ids of the proposals depending on the given proposal.
+func (k Keeper) GetProposalDependents(ctx context.Context, proposalID uint64) ([]uint64, error) {
+	var dependents []uint64
+	rng := collections.NewPrefixedPairRange[uint64, uint64](proposalID)
+	err := k.ProposalDependents.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		dependents = append(dependents, key.K2())
+		return false, nil
+	})
+
+	return dependents, err
+}
+
+// checkDependencies returns whether all the dependencies of the proposal were executed.
+// If one of them will never be executed, it returns the reason the proposal fails.
+func (k Keeper) checkDependencies(ctx context.Context, proposal v1.Proposal) (executed bool, failedReason string, err error) {
+	executed = true
+	for _, id := range proposal.Dependencies {
+		dependency, err := k.Proposals.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return false, fmt.Sprintf("dependency %d was removed", id), nil
+			}
+			return false, "", err
+		}
+
+		switch dependency.Status {
+		case v1.StatusPassed:
+		case v1.StatusRejected, v1.StatusFailed:
+			return false, fmt.Sprintf("dependency %d has status %s", id, dependency.Status), nil
+		default:
+			executed = false
+		}
+	}
+
+	return executed, "", nil
+}
+
+// processDependents executes the proposals awaiting the given proposal once all their
+// dependencies were executed, or fails them if the given proposal will never be executed.
+// Proposals depending on the proposals executed or failed here are processed in turn.
+func (k Keeper) processDependents(ctx context.Context, proposalID uint64) error {
+	dependents, err := k.GetProposalDependents(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range dependents {
+		proposal, err := k.Proposals.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		if proposal.Status != v1.StatusAwaitingDependencies {
+			continue
+		}
+
+		executed, failedReason, err := k.checkDependencies(ctx, proposal)
+		if err != nil {
+			return err
+		}
+
+		var tagValue, logMsg string
+		switch {
+		case failedReason != "":
+			proposal.Status = v1.StatusFailed
+			proposal.FailedReason = failedReason
+			tagValue = types.AttributeValueProposalFailed
+			logMsg = fmt.Sprintf("passed, but %s", failedReason)
+		case !executed:
+			continue
+		default:
+			tagValue, logMsg = k.executeProposal(ctx, &proposal)
+		}
+
+		if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return err
+		}
+
+		k.Logger().Info(
+			"proposal dependencies resolved",
+			"proposal", proposal.Id,
+			"dependency", proposalID,
+			"status", proposal.Status.String(),
+			"title", proposal.Title,
+			"results", logMsg,
+		)
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(types.EventTypeActiveProposal,
+			event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			event.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			event.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+		); err != nil {
+			k.Logger().Error("failed to emit event", "error", err)
+		}
+
+		if err := k.processDependents(ctx, proposal.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
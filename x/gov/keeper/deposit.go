@@ -148,7 +148,7 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDepositAmount) {
+	if proposal.Status == v1.StatusDepositPeriod && isMinDepositMet(params, proposal.TotalDeposit, minDepositAmount) {
 		err = k.ActivateVotingPeriod(ctx, proposal)
 		if err != nil {
 			return false, err
@@ -304,12 +304,31 @@ func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coin
 	for i := range minDepositCoins {
 		minDepositCoins[i].Amount = sdkmath.LegacyNewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
 	}
-	if !initialDeposit.IsAllGTE(minDepositCoins) {
+	if !isMinDepositMet(params, initialDeposit, minDepositCoins) {
+		if params.MinDepositAnyDenom {
+			return errors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need at least one of (%s)", initialDeposit, minDepositCoins)
+		}
 		return errors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minDepositCoins)
 	}
 	return nil
 }
 
+// isMinDepositMet returns true if the deposit meets the minimum deposit, in all
+// of its denoms or, if the MinDepositAnyDenom param is set, in any of them.
+func isMinDepositMet(params v1.Params, deposit, minDeposit sdk.Coins) bool {
+	deposit = sdk.NewCoins(deposit...)
+	if !params.MinDepositAnyDenom {
+		return deposit.IsAllGTE(minDeposit)
+	}
+
+	for _, coin := range minDeposit {
+		if deposit.AmountOf(coin.Denom).GTE(coin.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
 // validateDepositDenom validates if the deposit denom is accepted by the governance module.
 func (k Keeper) validateDepositDenom(params v1.Params, depositAmount sdk.Coins) error {
 	denoms := []string{}
@@ -241,12 +241,51 @@ func TestDepositAmount(t *testing.T) {
 	}
 }
 
+func TestMinDepositAnyDenom(t *testing.T) {
+	for _, anyDenom := range []bool{false, true} {
+		t.Run(fmt.Sprintf("any denom: %t", anyDenom), func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t)
+			authKeeper, bankKeeper, stakingKeeper := mocks.acctKeeper, mocks.bankKeeper, mocks.stakingKeeper
+			trackMockBalances(bankKeeper)
+
+			testAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+			authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+			zcoins := sdk.NewCoins(sdk.NewInt64Coin("zcoin", 10000))
+			require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, mintModuleName, testAddrs[0], zcoins))
+			initialBalance := bankKeeper.GetAllBalances(ctx, testAddrs[0])
+
+			params, err := govKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			params.MinDeposit = sdk.NewCoins(params.MinDeposit...).Add(zcoins...)
+			params.MinDepositAnyDenom = anyDenom
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", testAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+
+			votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, testAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("zcoin", 9900)))
+			require.NoError(t, err)
+			require.False(t, votingStarted)
+
+			// the min deposit of zcoin alone only meets the min deposit of any denom
+			votingStarted, err = govKeeper.AddDeposit(ctx, proposal.Id, testAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("zcoin", 100)))
+			require.NoError(t, err)
+			require.Equal(t, anyDenom, votingStarted)
+
+			// the deposits of all denoms are refunded
+			require.NoError(t, govKeeper.RefundAndDeleteDeposits(ctx, proposal.Id))
+			require.Equal(t, initialBalance, bankKeeper.GetAllBalances(ctx, testAddrs[0]))
+		})
+	}
+}
+
 func TestValidateInitialDeposit(t *testing.T) {
 	testcases := map[string]struct {
 		minDeposit               sdk.Coins
 		minInitialDepositPercent int64
 		initialDeposit           sdk.Coins
 		expedited                bool
+		anyDenom                 bool
 
 		expectError bool
 	}{
@@ -309,6 +348,29 @@ func TestValidateInitialDeposit(t *testing.T) {
 				sdk.NewCoin("uosmo", sdkmath.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1)),
 			),
 		},
+		"min deposit * initial percent > initial deposit (multiple coins, any denom): success": {
+			minDeposit: sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(baseDepositTestAmount)),
+				sdk.NewCoin("uosmo", sdkmath.NewInt(baseDepositTestAmount*2))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit: sdk.NewCoins(
+				sdk.NewCoin("uosmo", sdkmath.NewInt(baseDepositTestAmount*2*baseDepositTestPercent/100)),
+			),
+			anyDenom: true,
+		},
+		"min deposit * initial percent > initial deposit (multiple coins, any denom): error": {
+			minDeposit: sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(baseDepositTestAmount)),
+				sdk.NewCoin("uosmo", sdkmath.NewInt(baseDepositTestAmount*2))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit: sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1)),
+				sdk.NewCoin("uosmo", sdkmath.NewInt(baseDepositTestAmount*2*baseDepositTestPercent/100-1)),
+			),
+			anyDenom: true,
+
+			expectError: true,
+		},
 		"0 initial percent: success": {
 			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(baseDepositTestAmount))),
 			minInitialDepositPercent: 0,
@@ -339,6 +401,7 @@ func TestValidateInitialDeposit(t *testing.T) {
 				params.MinDeposit = tc.minDeposit
 			}
 			params.MinInitialDepositRatio = sdkmath.LegacyNewDec(tc.minInitialDepositPercent).Quo(sdkmath.LegacyNewDec(100)).String()
+			params.MinDepositAnyDenom = tc.anyDenom
 
 			err := govKeeper.Params.Set(ctx, params)
 			require.NoError(t, err)
@@ -248,6 +248,35 @@ func (q queryServer) MessageBasedParams(ctx context.Context, req *v1.QueryMessag
 	return nil, status.Error(codes.Internal, err.Error())
 }
 
+// ProposalDependencies returns the proposals a proposal depends on and the proposals depending on it.
+func (q queryServer) ProposalDependencies(ctx context.Context, req *v1.QueryProposalDependenciesRequest) (*v1.QueryProposalDependenciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dependents, err := q.k.GetProposalDependents(ctx, req.ProposalId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalDependenciesResponse{
+		Dependencies: proposal.Dependencies,
+		Dependents:   dependents,
+	}, nil
+}
+
 // Deposit queries single deposit information based on proposalID, depositAddr.
 func (q queryServer) Deposit(ctx context.Context, req *v1.QueryDepositRequest) (*v1.QueryDepositResponse, error) {
 	if req == nil {
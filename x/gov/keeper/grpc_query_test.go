@@ -2007,3 +2007,61 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalDependencies() {
+	suite.reset()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		req      *v1.QueryProposalDependenciesRequest
+		expResp  *v1.QueryProposalDependenciesResponse
+		errStr   string
+	}{
+		{
+			name:   "invalid proposal id",
+			req:    &v1.QueryProposalDependenciesRequest{},
+			errStr: "proposal id can not be 0",
+		},
+		{
+			name:   "proposal not found",
+			req:    &v1.QueryProposalDependenciesRequest{ProposalId: 1},
+			errStr: "proposal 1 doesn't exist",
+		},
+		{
+			name: "proposal with dependencies and dependents",
+			malleate: func() {
+				for i := 0; i < 3; i++ {
+					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+					suite.Require().NoError(err)
+					if proposal.Id > 1 {
+						err = suite.govKeeper.SetProposalDependencies(suite.ctx, &proposal, []uint64{proposal.Id - 1})
+						suite.Require().NoError(err)
+					}
+				}
+			},
+			req: &v1.QueryProposalDependenciesRequest{ProposalId: 2},
+			expResp: &v1.QueryProposalDependenciesResponse{
+				Dependencies: []uint64{1},
+				Dependents:   []uint64{3},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			resp, err := suite.queryClient.ProposalDependencies(suite.ctx, tc.req)
+			if tc.errStr != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errStr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResp, resp)
+			}
+		})
+	}
+}
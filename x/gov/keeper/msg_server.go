@@ -91,6 +91,10 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 		return nil, err
 	}
 
+	if err := k.SetProposalDependencies(ctx, &proposal, msg.Dependencies); err != nil {
+		return nil, err
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -249,7 +249,22 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 		}
 	}
 
-	return k.Proposals.Remove(ctx, proposalID)
+	if err := k.Proposals.Remove(ctx, proposalID); err != nil {
+		return err
+	}
+
+	for _, dependency := range proposal.Dependencies {
+		if err := k.ProposalDependents.Remove(ctx, collections.Join(dependency, proposalID)); err != nil {
+			return err
+		}
+	}
+
+	// proposals awaiting the deleted proposal will never be executed
+	if err := k.processDependents(ctx, proposalID); err != nil {
+		return err
+	}
+
+	return k.ProposalDependents.Clear(ctx, collections.NewPrefixedPairRange[uint64, uint64](proposalID))
 }
 
 // ActivateVotingPeriod activates the voting period of a proposal
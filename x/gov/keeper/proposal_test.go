@@ -328,3 +328,96 @@ func TestMigrateProposalMessages(t *testing.T) {
 	require.Equal(t, "Test", content.GetTitle())
 	require.Equal(t, "description", content.GetDescription())
 }
+
+func (suite *KeeperTestSuite) TestSetProposalDependencies() {
+	tp := TestProposal
+	dependency, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+
+	rejected, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+	rejected.Status = v1.StatusRejected
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, rejected.Id, rejected))
+
+	testCases := []struct {
+		name           string
+		dependencies   []uint64
+		expectedErrMsg string
+	}{
+		{
+			name:           "self dependency",
+			dependencies:   []uint64{0},
+			expectedErrMsg: "proposal cannot depend on itself",
+		},
+		{
+			name:           "duplicate dependency",
+			dependencies:   []uint64{dependency.Id, dependency.Id},
+			expectedErrMsg: fmt.Sprintf("duplicate dependency %d", dependency.Id),
+		},
+		{
+			name:           "non-existing dependency",
+			dependencies:   []uint64{100},
+			expectedErrMsg: "dependency 100 doesn't exist",
+		},
+		{
+			name:           "rejected dependency",
+			dependencies:   []uint64{rejected.Id},
+			expectedErrMsg: fmt.Sprintf("dependency %d has status PROPOSAL_STATUS_REJECTED", rejected.Id),
+		},
+		{
+			name:         "valid dependency",
+			dependencies: []uint64{dependency.Id},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			suite.Require().NoError(err)
+
+			dependencies := tc.dependencies
+			if len(dependencies) == 1 && dependencies[0] == 0 {
+				dependencies = []uint64{proposal.Id}
+			}
+
+			err = suite.govKeeper.SetProposalDependencies(suite.ctx, &proposal, dependencies)
+			if tc.expectedErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expectedErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			stored, err := suite.govKeeper.Proposals.Get(suite.ctx, proposal.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.dependencies, stored.Dependencies)
+
+			dependents, err := suite.govKeeper.GetProposalDependents(suite.ctx, dependency.Id)
+			suite.Require().NoError(err)
+			suite.Require().Contains(dependents, proposal.Id)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeleteProposalFailsDependents() {
+	tp := TestProposal
+	dependency, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.govKeeper.SetProposalDependencies(suite.ctx, &proposal, []uint64{dependency.Id}))
+
+	proposal.Status = v1.StatusAwaitingDependencies
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, proposal.Id, proposal))
+
+	suite.Require().NoError(suite.govKeeper.DeleteProposal(suite.ctx, dependency.Id))
+
+	proposal, err = suite.govKeeper.Proposals.Get(suite.ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusFailed, proposal.Status)
+	suite.Require().Equal(fmt.Sprintf("dependency %d was removed", dependency.Id), proposal.FailedReason)
+
+	dependents, err := suite.govKeeper.GetProposalDependents(suite.ctx, dependency.Id)
+	suite.Require().NoError(err)
+	suite.Require().Empty(dependents)
+}
@@ -834,6 +834,18 @@ func (mr *MockBankKeeperMockRecorder) SendEnabled(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SendEnabled), arg0, arg1)
 }
 
+// SetAccountCreationFeeCollector mocks base method.
+func (m *MockBankKeeper) SetAccountCreationFeeCollector(moduleName string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAccountCreationFeeCollector", moduleName)
+}
+
+// SetAccountCreationFeeCollector indicates an expected call of SetAccountCreationFeeCollector.
+func (mr *MockBankKeeperMockRecorder) SetAccountCreationFeeCollector(moduleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountCreationFeeCollector", reflect.TypeOf((*MockBankKeeper)(nil).SetAccountCreationFeeCollector), moduleName)
+}
+
 // SetAllSendEnabled mocks base method.
 func (m *MockBankKeeper) SetAllSendEnabled(ctx context.Context, sendEnableds []*types.SendEnabled) {
 	m.ctrl.T.Helper()
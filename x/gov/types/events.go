@@ -19,13 +19,14 @@ const (
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
 
-	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
-	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
-	AttributeValueProposalRejected           = "proposal_rejected"            // didn't meet vote quorum
-	AttributeValueExpeditedProposalRejected  = "expedited_proposal_rejected"  // didn't meet expedited vote quorum
-	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // didn't meet optimistic vote quorum
-	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
-	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeValueProposalDropped              = "proposal_dropped"               // didn't meet min deposit
+	AttributeValueProposalPassed               = "proposal_passed"                // met vote quorum
+	AttributeValueProposalRejected             = "proposal_rejected"              // didn't meet vote quorum
+	AttributeValueExpeditedProposalRejected    = "expedited_proposal_rejected"    // didn't meet expedited vote quorum
+	AttributeValueOptimisticProposalRejected   = "optimistic_proposal_rejected"   // didn't meet optimistic vote quorum
+	AttributeValueProposalFailed               = "proposal_failed"                // error on proposal handler
+	AttributeValueProposalCanceled             = "proposal_canceled"              // error on proposal handler
+	AttributeValueProposalAwaitingDependencies = "proposal_awaiting_dependencies" // passed, waiting for its dependencies to be executed
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
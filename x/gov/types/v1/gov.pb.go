@@ -154,6 +154,11 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_AWAITING_DEPENDENCIES defines a proposal status of a
+	// proposal that has passed and waits for its dependencies to be executed.
+	//
+	// Since: x/gov v1.0.0
+	ProposalStatus_PROPOSAL_STATUS_AWAITING_DEPENDENCIES ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -163,15 +168,17 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_AWAITING_DEPENDENCIES",
 }
 
 var ProposalStatus_value = map[string]int32{
-	"PROPOSAL_STATUS_UNSPECIFIED":    0,
-	"PROPOSAL_STATUS_DEPOSIT_PERIOD": 1,
-	"PROPOSAL_STATUS_VOTING_PERIOD":  2,
-	"PROPOSAL_STATUS_PASSED":         3,
-	"PROPOSAL_STATUS_REJECTED":       4,
-	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_UNSPECIFIED":           0,
+	"PROPOSAL_STATUS_DEPOSIT_PERIOD":        1,
+	"PROPOSAL_STATUS_VOTING_PERIOD":         2,
+	"PROPOSAL_STATUS_PASSED":                3,
+	"PROPOSAL_STATUS_REJECTED":              4,
+	"PROPOSAL_STATUS_FAILED":                5,
+	"PROPOSAL_STATUS_AWAITING_DEPENDENCIES": 6,
 }
 
 func (x ProposalStatus) String() string {
@@ -353,6 +360,11 @@ type Proposal struct {
 	//
 	// Since: x/gov v1.0.0
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// dependencies are the ids of the proposals that must have passed and been
+	// executed before this proposal is executed.
+	//
+	// Since: x/gov v1.0.0
+	Dependencies []uint64 `protobuf:"varint,17,rep,packed,name=dependencies,proto3" json:"dependencies,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -501,6 +513,13 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetDependencies() []uint64 {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 //
@@ -971,15 +990,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -998,7 +1017,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.50
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	//
@@ -1045,6 +1064,12 @@ type Params struct {
 	//
 	// Since: x/gov v1.0.0
 	ExpeditedQuorum string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
+	// min_deposit_any_denom defines whether the minimum deposits are met by the
+	// deposits of any single of their denoms, instead of all of them. The
+	// deposits of the other denoms are kept and refunded or burned like them.
+	//
+	// Since: x/gov v1.0.0
+	MinDepositAnyDenom bool `protobuf:"varint,22,opt,name=min_deposit_any_denom,json=minDepositAnyDenom,proto3" json:"min_deposit_any_denom,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1227,6 +1252,13 @@ func (m *Params) GetExpeditedQuorum() string {
 	return ""
 }
 
+func (m *Params) GetMinDepositAnyDenom() bool {
+	if m != nil {
+		return m.MinDepositAnyDenom
+	}
+	return false
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1334,124 +1366,128 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1867 bytes of a gzipped FileDescriptorProto
+	// 1929 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x73, 0xe3, 0x48,
-	0x15, 0x1f, 0xd9, 0x8e, 0x63, 0xbf, 0x38, 0x8e, 0xa6, 0x93, 0xd9, 0x28, 0xc9, 0xe6, 0xcf, 0x98,
-	0xad, 0xad, 0xd4, 0xb0, 0xb1, 0xc9, 0xc2, 0x50, 0xb0, 0x2c, 0x05, 0x76, 0xac, 0x21, 0x1a, 0x92,
-	0xd8, 0xc8, 0x9a, 0x64, 0x86, 0x8b, 0x50, 0xa2, 0x9e, 0x44, 0x60, 0xa9, 0x8d, 0xd4, 0x4e, 0x62,
-	0x3e, 0xc5, 0x9e, 0x28, 0x4e, 0x14, 0x37, 0x38, 0x72, 0xd8, 0xa2, 0xf8, 0x08, 0x5b, 0x1c, 0xa8,
-	0xad, 0x3d, 0x71, 0x61, 0xa0, 0x66, 0x0e, 0x54, 0xed, 0x47, 0xa0, 0xf6, 0x40, 0xf5, 0x1f, 0x59,
-	0xb2, 0xe3, 0x4c, 0x92, 0x2d, 0x2e, 0x89, 0xf5, 0xde, 0xef, 0xf7, 0xfa, 0xf5, 0xfb, 0xd7, 0x2d,
-	0xc1, 0xe2, 0x09, 0x89, 0x7c, 0x12, 0xd5, 0x4e, 0xc9, 0x79, 0xed, 0x7c, 0x9b, 0xfd, 0xab, 0xf6,
-	0x42, 0x42, 0x09, 0x9a, 0x15, 0x8a, 0x2a, 0x93, 0x9c, 0x6f, 0x2f, 0xaf, 0x49, 0xdc, 0xb1, 0x13,
-	0xe1, 0xda, 0xf9, 0xf6, 0x31, 0xa6, 0xce, 0x76, 0xed, 0x84, 0x78, 0x81, 0x80, 0x2f, 0x2f, 0x9c,
-	0x92, 0x53, 0xc2, 0x7f, 0xd6, 0xd8, 0x2f, 0x29, 0x5d, 0x3f, 0x25, 0xe4, 0xb4, 0x8b, 0x6b, 0xfc,
-	0xe9, 0xb8, 0xff, 0xb2, 0x46, 0x3d, 0x1f, 0x47, 0xd4, 0xf1, 0x7b, 0x12, 0xb0, 0x34, 0x0e, 0x70,
-	0x82, 0x81, 0x54, 0xad, 0x8d, 0xab, 0xdc, 0x7e, 0xe8, 0x50, 0x8f, 0xc4, 0x2b, 0x2e, 0x09, 0x8f,
-	0x6c, 0xb1, 0xa8, 0xf4, 0x56, 0xa8, 0xee, 0x3b, 0xbe, 0x17, 0x90, 0x1a, 0xff, 0x2b, 0x44, 0x15,
-	0x02, 0xe8, 0x08, 0x7b, 0xa7, 0x67, 0x14, 0xbb, 0x87, 0x84, 0xe2, 0x56, 0x8f, 0x59, 0x42, 0xdb,
-	0x90, 0x27, 0xfc, 0x97, 0xa6, 0x6c, 0x28, 0x9b, 0xe5, 0x0f, 0x97, 0xaa, 0x23, 0xbb, 0xae, 0x26,
-	0x50, 0x53, 0x02, 0xd1, 0xfb, 0x90, 0xbf, 0xe0, 0x86, 0xb4, 0xcc, 0x86, 0xb2, 0x59, 0x6c, 0x94,
-	0xbf, 0xf8, 0x74, 0x0b, 0x24, 0xab, 0x89, 0x4f, 0x4c, 0xa9, 0xad, 0xfc, 0x41, 0x81, 0xe9, 0x26,
-	0xee, 0x91, 0xc8, 0xa3, 0x68, 0x1d, 0x66, 0x7a, 0x21, 0xe9, 0x91, 0xc8, 0xe9, 0xda, 0x9e, 0xcb,
-	0xd7, 0xca, 0x99, 0x10, 0x8b, 0x0c, 0x17, 0x7d, 0x17, 0x8a, 0xae, 0xc0, 0x92, 0x50, 0xda, 0xd5,
-	0xbe, 0xf8, 0x74, 0x6b, 0x41, 0xda, 0xad, 0xbb, 0x6e, 0x88, 0xa3, 0xa8, 0x43, 0x43, 0x2f, 0x38,
-	0x35, 0x13, 0x28, 0xfa, 0x18, 0xf2, 0x8e, 0x4f, 0xfa, 0x01, 0xd5, 0xb2, 0x1b, 0xd9, 0xcd, 0x99,
-	0xc4, 0x7f, 0x96, 0xa6, 0xaa, 0x4c, 0x53, 0x75, 0x87, 0x78, 0x41, 0xa3, 0xf8, 0xd9, 0xab, 0xf5,
-	0x7b, 0x7f, 0xfa, 0xcf, 0x9f, 0x1f, 0x29, 0xa6, 0xe4, 0x54, 0xfe, 0x96, 0x87, 0x42, 0x5b, 0x3a,
-	0x81, 0xca, 0x90, 0x19, 0xba, 0x96, 0xf1, 0x5c, 0xf4, 0x2d, 0x28, 0xf8, 0x38, 0x8a, 0x9c, 0x53,
-	0x1c, 0x69, 0x19, 0x6e, 0x7c, 0xa1, 0x2a, 0x32, 0x52, 0x8d, 0x33, 0x52, 0xad, 0x07, 0x03, 0x73,
-	0x88, 0x42, 0x8f, 0x21, 0x1f, 0x51, 0x87, 0xf6, 0x23, 0x2d, 0xcb, 0x83, 0xb9, 0x3a, 0x16, 0xcc,
-	0x78, 0xa9, 0x0e, 0x07, 0x99, 0x12, 0x8c, 0x76, 0x01, 0xbd, 0xf4, 0x02, 0xa7, 0x6b, 0x53, 0xa7,
-	0xdb, 0x1d, 0xd8, 0x21, 0x8e, 0xfa, 0x5d, 0xaa, 0xe5, 0x36, 0x94, 0xcd, 0x99, 0x0f, 0x97, 0xc7,
-	0x4c, 0x58, 0x0c, 0x62, 0x72, 0x84, 0xa9, 0x72, 0x56, 0x4a, 0x82, 0xea, 0x30, 0x13, 0xf5, 0x8f,
-	0x7d, 0x8f, 0xda, 0xac, 0xcc, 0xb4, 0x29, 0x69, 0x62, 0xdc, 0x6b, 0x2b, 0xae, 0xc1, 0x46, 0xee,
-	0x93, 0x7f, 0xad, 0x2b, 0x26, 0x08, 0x12, 0x13, 0xa3, 0xa7, 0xa0, 0xca, 0xe8, 0xda, 0x38, 0x70,
-	0x85, 0x9d, 0xfc, 0x2d, 0xed, 0x94, 0x25, 0x53, 0x0f, 0x5c, 0x6e, 0xcb, 0x80, 0x59, 0x4a, 0xa8,
-	0xd3, 0xb5, 0xa5, 0x5c, 0x9b, 0xbe, 0x43, 0x8e, 0x4a, 0x9c, 0x1a, 0x17, 0xd0, 0x1e, 0xdc, 0x3f,
-	0x27, 0xd4, 0x0b, 0x4e, 0xed, 0x88, 0x3a, 0xa1, 0xdc, 0x5f, 0xe1, 0x96, 0x7e, 0xcd, 0x09, 0x6a,
-	0x87, 0x31, 0xb9, 0x63, 0xbb, 0x20, 0x45, 0xc9, 0x1e, 0x8b, 0xb7, 0xb4, 0x35, 0x2b, 0x88, 0xf1,
-	0x16, 0x97, 0x59, 0x91, 0x50, 0xc7, 0x75, 0xa8, 0xa3, 0x01, 0x2b, 0x5b, 0x73, 0xf8, 0x8c, 0x16,
-	0x60, 0x8a, 0x7a, 0xb4, 0x8b, 0xb5, 0x19, 0xae, 0x10, 0x0f, 0x48, 0x83, 0xe9, 0xa8, 0xef, 0xfb,
-	0x4e, 0x38, 0xd0, 0x4a, 0x5c, 0x1e, 0x3f, 0xa2, 0xef, 0x40, 0x41, 0x74, 0x04, 0x0e, 0xb5, 0xd9,
-	0x1b, 0x5a, 0x60, 0x88, 0x44, 0x1b, 0x50, 0xc4, 0x97, 0x3d, 0xec, 0x7a, 0x14, 0xbb, 0x5a, 0x79,
-	0x43, 0xd9, 0x2c, 0x34, 0x32, 0x9a, 0x62, 0x26, 0x42, 0xf4, 0x0d, 0x98, 0x7d, 0xe9, 0x78, 0x5d,
-	0xec, 0xda, 0x21, 0x76, 0x22, 0x12, 0x68, 0x73, 0x7c, 0xdd, 0x92, 0x10, 0x9a, 0x5c, 0x86, 0x7e,
-	0x0c, 0xb3, 0xc3, 0x0e, 0xa5, 0x83, 0x1e, 0xd6, 0x54, 0x5e, 0xc2, 0x2b, 0xd7, 0x94, 0xb0, 0x35,
-	0xe8, 0x61, 0xb3, 0xd4, 0x4b, 0x3d, 0x55, 0xfe, 0xaa, 0xc0, 0x7c, 0xac, 0x4e, 0xc6, 0x46, 0x84,
-	0x56, 0x01, 0xc4, 0xe4, 0xb0, 0x49, 0x80, 0x79, 0x7f, 0x15, 0xcd, 0xa2, 0x90, 0xb4, 0x02, 0x9c,
-	0x52, 0xd3, 0x0b, 0x22, 0x5a, 0x3f, 0x56, 0x5b, 0x17, 0x04, 0x3d, 0x84, 0x52, 0xac, 0x3e, 0x0b,
-	0x31, 0xe6, 0x9d, 0x55, 0x34, 0x67, 0x24, 0x80, 0x89, 0xd8, 0x70, 0x91, 0x90, 0x97, 0xa4, 0x1f,
-	0xf2, 0xc6, 0x29, 0x9a, 0xd2, 0xe8, 0x13, 0xd2, 0x0f, 0x53, 0x80, 0xa8, 0xe7, 0xf8, 0xbc, 0x2d,
-	0x86, 0x80, 0x4e, 0xcf, 0xf1, 0x2b, 0x5f, 0x65, 0x61, 0x26, 0xdd, 0x47, 0x5b, 0x50, 0x1c, 0xe0,
-	0xc8, 0x3e, 0xe1, 0x83, 0x85, 0x7b, 0xdc, 0x50, 0x53, 0x53, 0xce, 0x60, 0x52, 0xb3, 0x30, 0xc0,
-	0xd1, 0x0e, 0x43, 0xa0, 0xc7, 0x30, 0xeb, 0x1c, 0x47, 0xd4, 0xf1, 0x02, 0x49, 0xc9, 0x5c, 0x43,
-	0x29, 0x49, 0x98, 0xa0, 0x7d, 0x13, 0x0a, 0x01, 0x91, 0x8c, 0xec, 0x35, 0x8c, 0xe9, 0x80, 0x08,
-	0xf0, 0x0f, 0x01, 0x05, 0xc4, 0xbe, 0xf0, 0xe8, 0x99, 0x7d, 0x8e, 0x69, 0x4c, 0xcb, 0x5d, 0x43,
-	0x9b, 0x0b, 0xc8, 0x91, 0x47, 0xcf, 0x0e, 0x31, 0x95, 0xf4, 0xef, 0x81, 0x9a, 0x24, 0x41, 0x92,
-	0xa7, 0xae, 0x8c, 0x6f, 0x23, 0xa0, 0x66, 0x79, 0x98, 0x9a, 0x71, 0x26, 0xbd, 0x88, 0x97, 0xcd,
-	0xbf, 0x8d, 0x69, 0x5d, 0xc8, 0x35, 0x3f, 0x06, 0x94, 0x4e, 0x9d, 0xe4, 0x4e, 0x4f, 0xe4, 0xaa,
-	0xa9, 0x84, 0x0a, 0xf6, 0x47, 0x70, 0x3f, 0x95, 0x55, 0x49, 0x2e, 0x4c, 0x24, 0xcf, 0x25, 0xb9,
-	0x16, 0xdc, 0x2d, 0x00, 0x96, 0x69, 0x49, 0x2a, 0x4e, 0x24, 0x15, 0x19, 0x82, 0xc3, 0x2b, 0x7f,
-	0x51, 0x20, 0xc7, 0x2a, 0xf6, 0xe6, 0x63, 0xaa, 0x0a, 0x53, 0xe7, 0x84, 0xe2, 0x9b, 0x8f, 0x28,
-	0x01, 0x43, 0x3f, 0x80, 0x69, 0xe1, 0x5b, 0xa4, 0xe5, 0xf8, 0xec, 0x7b, 0x38, 0xd6, 0x4f, 0x57,
-	0x8f, 0x64, 0x33, 0x66, 0x8c, 0xcc, 0x96, 0xa9, 0xd1, 0xd9, 0xf2, 0x34, 0x57, 0xc8, 0xaa, 0xb9,
-	0xca, 0x3f, 0x15, 0x98, 0x95, 0x13, 0xb2, 0xed, 0x84, 0x8e, 0x1f, 0xa1, 0x17, 0x30, 0xe3, 0x7b,
-	0xc1, 0x70, 0xe0, 0x2a, 0x37, 0x0d, 0xdc, 0x55, 0x36, 0x70, 0xbf, 0x7c, 0xb5, 0xfe, 0x20, 0xc5,
-	0xfa, 0x80, 0xf8, 0x1e, 0xc5, 0x7e, 0x8f, 0x0e, 0x4c, 0xf0, 0xbd, 0x20, 0x1e, 0xc1, 0x3e, 0x20,
-	0xdf, 0xb9, 0x8c, 0x41, 0x76, 0x0f, 0x87, 0x1e, 0x71, 0x79, 0x20, 0xd8, 0x0a, 0xe3, 0x73, 0xb3,
-	0x29, 0xef, 0x2a, 0x8d, 0xf7, 0xbe, 0x7c, 0xb5, 0xfe, 0xee, 0x55, 0x62, 0xb2, 0xc8, 0xef, 0xd8,
-	0x58, 0x55, 0x7d, 0xe7, 0x32, 0xde, 0x09, 0xd7, 0x7f, 0x94, 0xd1, 0x94, 0xca, 0x73, 0x28, 0x1d,
-	0xf2, 0x71, 0x2b, 0x77, 0xd7, 0x04, 0x39, 0x7e, 0xe3, 0xd5, 0x95, 0x9b, 0x56, 0xcf, 0x71, 0xeb,
-	0x25, 0xc1, 0x4a, 0x59, 0xfe, 0xbd, 0x22, 0x3b, 0x5e, 0x5a, 0x7e, 0x1f, 0xf2, 0xbf, 0xee, 0x93,
-	0xb0, 0xef, 0xcb, 0x76, 0xbf, 0x72, 0xa9, 0x11, 0x5a, 0xf4, 0x01, 0x14, 0x59, 0x31, 0x47, 0x67,
-	0xa4, 0xeb, 0x5e, 0x73, 0xff, 0x49, 0x00, 0xe8, 0x31, 0x94, 0x79, 0xb3, 0x26, 0x94, 0xec, 0x44,
-	0xca, 0x2c, 0x43, 0x59, 0x31, 0x88, 0x3b, 0xf8, 0x15, 0x40, 0x5e, 0xfa, 0xa6, 0xdf, 0x31, 0xa7,
-	0xa9, 0x43, 0x34, 0x9d, 0xbf, 0xfd, 0xaf, 0x97, 0xbf, 0xdc, 0xe4, 0xfc, 0x5c, 0xcd, 0x45, 0xf6,
-	0x6b, 0xe4, 0x22, 0x15, 0xf7, 0xdc, 0xed, 0xe3, 0x3e, 0x75, 0xf7, 0xb8, 0xe7, 0x6f, 0x11, 0x77,
-	0x64, 0xc0, 0x12, 0x0b, 0xb4, 0x17, 0x78, 0xd4, 0x4b, 0x6e, 0x2d, 0x36, 0x77, 0x7f, 0xc2, 0xdc,
-	0x62, 0x16, 0xde, 0xf1, 0xbd, 0xc0, 0x10, 0x78, 0x19, 0x1e, 0x93, 0xa1, 0x51, 0x03, 0x1e, 0x0c,
-	0x27, 0xc9, 0x89, 0x13, 0x9c, 0xe0, 0xae, 0x34, 0x53, 0x98, 0x68, 0x66, 0x3e, 0x06, 0xef, 0x70,
-	0xac, 0xb0, 0xf1, 0x14, 0x16, 0xc6, 0x6d, 0xb8, 0x38, 0x8a, 0xe7, 0xd9, 0xf5, 0xb3, 0x07, 0x8d,
-	0x1a, 0x6b, 0xe2, 0x88, 0xa2, 0x23, 0x58, 0x1c, 0x5e, 0x08, 0xec, 0xd1, 0xbc, 0xc1, 0xed, 0xf2,
-	0xf6, 0x60, 0xc8, 0x3f, 0x4c, 0x27, 0xf0, 0x47, 0x30, 0x9f, 0x18, 0x4e, 0xe2, 0x3d, 0x33, 0x71,
-	0x9b, 0x68, 0x08, 0x4d, 0x82, 0xfe, 0x1c, 0x12, 0xcb, 0x76, 0xba, 0xce, 0x4b, 0x77, 0xa8, 0xf3,
-	0xc4, 0x87, 0xfd, 0xa4, 0xe0, 0x37, 0x41, 0x3d, 0xee, 0x87, 0x01, 0xdb, 0x2e, 0xb6, 0x65, 0x95,
-	0xb1, 0x7b, 0x55, 0xc1, 0x2c, 0x33, 0x39, 0x1b, 0xb9, 0x3f, 0x13, 0xd5, 0x55, 0x87, 0x55, 0x8e,
-	0x1c, 0x86, 0x7b, 0xd8, 0x24, 0x21, 0x66, 0x6c, 0x71, 0xaf, 0x32, 0x97, 0x19, 0x28, 0xbe, 0xe2,
-	0xc4, 0xdd, 0x20, 0x10, 0xe8, 0x3d, 0x28, 0x27, 0x8b, 0xb1, 0xb2, 0xe2, 0xb7, 0xac, 0x82, 0x59,
-	0x8a, 0x97, 0x62, 0x67, 0x31, 0x3b, 0xd4, 0x52, 0x5b, 0x94, 0x25, 0xa1, 0x4e, 0x8c, 0xd5, 0x5c,
-	0xd2, 0xba, 0xa2, 0x1c, 0x7e, 0x0a, 0xcb, 0xe3, 0xe5, 0xc0, 0xfa, 0x59, 0x66, 0xf1, 0xfe, 0x44,
-	0x23, 0x8b, 0xa3, 0xa5, 0xb0, 0xef, 0x5c, 0xca, 0xb4, 0xfd, 0x02, 0xd6, 0xd9, 0x31, 0xe3, 0x7b,
-	0x11, 0xf5, 0x4e, 0x6c, 0xa7, 0x4f, 0xcf, 0x48, 0xe8, 0xfd, 0x06, 0xbb, 0xb6, 0x23, 0x4a, 0x09,
-	0x47, 0x1a, 0xda, 0xc8, 0xbe, 0xb5, 0xcc, 0x56, 0x13, 0x03, 0xf5, 0x21, 0xbf, 0x1e, 0xd3, 0x91,
-	0x09, 0x29, 0x80, 0x1d, 0xe2, 0x5f, 0xe2, 0x93, 0xd1, 0x12, 0x99, 0x9f, 0xe8, 0xf1, 0x4a, 0x42,
-	0x32, 0x25, 0x27, 0xa9, 0x95, 0x2d, 0x00, 0x76, 0x2f, 0x93, 0xb9, 0x5c, 0x98, 0x3c, 0x06, 0x06,
-	0x38, 0x92, 0x69, 0xfd, 0x3e, 0xa8, 0x49, 0x69, 0x49, 0xd2, 0x83, 0xc9, 0xc1, 0x1e, 0xe2, 0x04,
-	0xb5, 0xf2, 0xdb, 0x0c, 0xa0, 0x7d, 0xf1, 0x5e, 0xd7, 0x70, 0x22, 0xec, 0xfe, 0x3f, 0x0f, 0xa0,
-	0xd4, 0xd0, 0xcb, 0xbc, 0x75, 0xe8, 0xdd, 0x71, 0xbb, 0x23, 0x33, 0x32, 0x7b, 0xf7, 0x19, 0x99,
-	0xbb, 0xc5, 0x8c, 0x7c, 0xf4, 0x47, 0x05, 0x4a, 0xe9, 0x97, 0x00, 0xb4, 0x0a, 0x4b, 0x6d, 0xb3,
-	0xd5, 0x6e, 0x75, 0xea, 0x7b, 0xb6, 0xf5, 0xa2, 0xad, 0xdb, 0xcf, 0x0e, 0x3a, 0x6d, 0x7d, 0xc7,
-	0x78, 0x62, 0xe8, 0x4d, 0xf5, 0x1e, 0x5a, 0x86, 0x77, 0x46, 0xd5, 0x1d, 0xab, 0x7e, 0xd0, 0xac,
-	0x9b, 0x4d, 0x55, 0x41, 0x0f, 0x61, 0x75, 0x54, 0xb7, 0xff, 0x6c, 0xcf, 0x32, 0xda, 0x7b, 0xba,
-	0xbd, 0xb3, 0xdb, 0x32, 0x76, 0x74, 0x35, 0x83, 0xde, 0x05, 0x6d, 0x14, 0xd2, 0x6a, 0x5b, 0xc6,
-	0xbe, 0xd1, 0xb1, 0x8c, 0x1d, 0x35, 0x8b, 0x56, 0x60, 0x71, 0x54, 0xab, 0x3f, 0x6f, 0xeb, 0x4d,
-	0xc3, 0xd2, 0x9b, 0x6a, 0xee, 0xd1, 0x7f, 0x15, 0x80, 0xd4, 0x97, 0x8e, 0x15, 0x58, 0x3c, 0x6c,
-	0x59, 0xc2, 0x40, 0xeb, 0x60, 0xcc, 0xcb, 0x79, 0x98, 0x4b, 0x2b, 0x5f, 0xe8, 0x1d, 0x55, 0x19,
-	0x17, 0xb6, 0x0e, 0x74, 0x55, 0x41, 0x8b, 0x30, 0x9f, 0x16, 0xd6, 0x1b, 0x1d, 0xab, 0x6e, 0x1c,
-	0xa8, 0x99, 0x71, 0xb4, 0x75, 0xd4, 0x52, 0x33, 0x08, 0x41, 0x39, 0x2d, 0x3c, 0x68, 0xa9, 0x59,
-	0xf4, 0x00, 0xee, 0x8f, 0x00, 0x77, 0x4d, 0x5d, 0x57, 0xb3, 0x6c, 0xa7, 0xa3, 0x50, 0xfb, 0xc8,
-	0xb0, 0x76, 0xed, 0x43, 0xdd, 0x6a, 0xa9, 0x39, 0xb4, 0x00, 0x6a, 0x5a, 0xfb, 0xa4, 0xf5, 0xcc,
-	0xbc, 0x2a, 0xed, 0xb4, 0xeb, 0xfb, 0xea, 0xd4, 0x72, 0x46, 0x55, 0x1e, 0xfd, 0x5d, 0x81, 0xf2,
-	0xe8, 0xe7, 0x06, 0xb4, 0x0e, 0x2b, 0xc3, 0x60, 0x75, 0xac, 0xba, 0xf5, 0xac, 0x33, 0x16, 0x84,
-	0x0a, 0xac, 0x8d, 0x03, 0x9a, 0x7a, 0xbb, 0xd5, 0x31, 0x2c, 0xbb, 0xad, 0x9b, 0x46, 0x6b, 0x3c,
-	0x65, 0x12, 0x73, 0xd8, 0xb2, 0x8c, 0x83, 0x9f, 0xc4, 0x90, 0xcc, 0x48, 0xc6, 0x25, 0xa4, 0x5d,
-	0xef, 0x74, 0xf4, 0xa6, 0xd8, 0xe4, 0xb8, 0xce, 0xd4, 0x9f, 0xea, 0x3b, 0x3c, 0x63, 0x93, 0x98,
-	0x4f, 0xea, 0xc6, 0x9e, 0xde, 0x54, 0xa7, 0x1a, 0x8f, 0x3f, 0x7b, 0xbd, 0xa6, 0x7c, 0xfe, 0x7a,
-	0x4d, 0xf9, 0xf7, 0xeb, 0x35, 0xe5, 0x93, 0x37, 0x6b, 0xf7, 0x3e, 0x7f, 0xb3, 0x76, 0xef, 0x1f,
-	0x6f, 0xd6, 0xee, 0xfd, 0x7c, 0x45, 0x14, 0x6b, 0xe4, 0xfe, 0xaa, 0xea, 0x91, 0xda, 0x25, 0xff,
-	0x90, 0xc7, 0xde, 0x60, 0xa3, 0xda, 0xf9, 0xf6, 0x71, 0x9e, 0x77, 0xe4, 0xb7, 0xff, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0xb0, 0x5e, 0x88, 0xa8, 0xe6, 0x13, 0x00, 0x00,
+	0x15, 0x8f, 0x6c, 0xc7, 0xb1, 0x9f, 0x1d, 0x47, 0xe9, 0x24, 0x13, 0x25, 0xd9, 0xfc, 0x19, 0xb3,
+	0x6c, 0x85, 0x61, 0xe3, 0x90, 0x85, 0xa1, 0x60, 0x59, 0x0a, 0xec, 0x58, 0x43, 0x34, 0x24, 0xb1,
+	0x91, 0x35, 0xc9, 0x0c, 0x17, 0xa1, 0x44, 0x3d, 0x89, 0xc0, 0x52, 0x1b, 0xa9, 0x9d, 0xc4, 0x7c,
+	0x8a, 0x3d, 0x51, 0x9c, 0x28, 0x6e, 0x70, 0x83, 0xc3, 0x16, 0xc5, 0x47, 0xd8, 0xe3, 0xd6, 0x9e,
+	0xb8, 0x30, 0x50, 0x33, 0x07, 0xaa, 0xf6, 0xc0, 0x1d, 0x8a, 0x03, 0xd5, 0xad, 0x96, 0x25, 0x2b,
+	0xce, 0x24, 0xd9, 0xe2, 0x92, 0x58, 0xef, 0xfd, 0x7e, 0xaf, 0x5f, 0xbf, 0x7f, 0xdd, 0x12, 0x2c,
+	0x9e, 0x92, 0xc0, 0x25, 0xc1, 0xf6, 0x19, 0xb9, 0xd8, 0xbe, 0xd8, 0x61, 0xff, 0x6a, 0x3d, 0x9f,
+	0x50, 0x82, 0xa6, 0x43, 0x45, 0x8d, 0x49, 0x2e, 0x76, 0x96, 0xd7, 0x04, 0xee, 0xc4, 0x0a, 0xf0,
+	0xf6, 0xc5, 0xce, 0x09, 0xa6, 0xd6, 0xce, 0xf6, 0x29, 0x71, 0xbc, 0x10, 0xbe, 0x3c, 0x7f, 0x46,
+	0xce, 0x08, 0xff, 0xb9, 0xcd, 0x7e, 0x09, 0xe9, 0xfa, 0x19, 0x21, 0x67, 0x5d, 0xbc, 0xcd, 0x9f,
+	0x4e, 0xfa, 0x2f, 0xb7, 0xa9, 0xe3, 0xe2, 0x80, 0x5a, 0x6e, 0x4f, 0x00, 0x96, 0xd2, 0x00, 0xcb,
+	0x1b, 0x08, 0xd5, 0x5a, 0x5a, 0x65, 0xf7, 0x7d, 0x8b, 0x3a, 0x24, 0x5a, 0x71, 0x29, 0xf4, 0xc8,
+	0x0c, 0x17, 0x15, 0xde, 0x86, 0xaa, 0x59, 0xcb, 0x75, 0x3c, 0xb2, 0xcd, 0xff, 0x86, 0xa2, 0x2a,
+	0x01, 0x74, 0x8c, 0x9d, 0xb3, 0x73, 0x8a, 0xed, 0x23, 0x42, 0x71, 0xab, 0xc7, 0x2c, 0xa1, 0x1d,
+	0xc8, 0x13, 0xfe, 0x4b, 0x91, 0x36, 0xa4, 0xcd, 0xca, 0x07, 0x4b, 0xb5, 0x91, 0x5d, 0xd7, 0x62,
+	0xa8, 0x2e, 0x80, 0xe8, 0x3d, 0xc8, 0x5f, 0x72, 0x43, 0x4a, 0x66, 0x43, 0xda, 0x2c, 0x36, 0x2a,
+	0x9f, 0x7f, 0xb2, 0x05, 0x82, 0xd5, 0xc4, 0xa7, 0xba, 0xd0, 0x56, 0x7f, 0x27, 0xc1, 0x54, 0x13,
+	0xf7, 0x48, 0xe0, 0x50, 0xb4, 0x0e, 0xa5, 0x9e, 0x4f, 0x7a, 0x24, 0xb0, 0xba, 0xa6, 0x63, 0xf3,
+	0xb5, 0x72, 0x3a, 0x44, 0x22, 0xcd, 0x46, 0xdf, 0x86, 0xa2, 0x1d, 0x62, 0x89, 0x2f, 0xec, 0x2a,
+	0x9f, 0x7f, 0xb2, 0x35, 0x2f, 0xec, 0xd6, 0x6d, 0xdb, 0xc7, 0x41, 0xd0, 0xa1, 0xbe, 0xe3, 0x9d,
+	0xe9, 0x31, 0x14, 0x7d, 0x04, 0x79, 0xcb, 0x25, 0x7d, 0x8f, 0x2a, 0xd9, 0x8d, 0xec, 0x66, 0x29,
+	0xf6, 0x9f, 0xa5, 0xa9, 0x26, 0xd2, 0x54, 0xdb, 0x25, 0x8e, 0xd7, 0x28, 0x7e, 0xfa, 0x6a, 0x7d,
+	0xe2, 0x0f, 0xff, 0xfc, 0xd3, 0x23, 0x49, 0x17, 0x9c, 0xea, 0xbf, 0xf2, 0x50, 0x68, 0x0b, 0x27,
+	0x50, 0x05, 0x32, 0x43, 0xd7, 0x32, 0x8e, 0x8d, 0xbe, 0x01, 0x05, 0x17, 0x07, 0x81, 0x75, 0x86,
+	0x03, 0x25, 0xc3, 0x8d, 0xcf, 0xd7, 0xc2, 0x8c, 0xd4, 0xa2, 0x8c, 0xd4, 0xea, 0xde, 0x40, 0x1f,
+	0xa2, 0xd0, 0x63, 0xc8, 0x07, 0xd4, 0xa2, 0xfd, 0x40, 0xc9, 0xf2, 0x60, 0xae, 0xa6, 0x82, 0x19,
+	0x2d, 0xd5, 0xe1, 0x20, 0x5d, 0x80, 0xd1, 0x1e, 0xa0, 0x97, 0x8e, 0x67, 0x75, 0x4d, 0x6a, 0x75,
+	0xbb, 0x03, 0xd3, 0xc7, 0x41, 0xbf, 0x4b, 0x95, 0xdc, 0x86, 0xb4, 0x59, 0xfa, 0x60, 0x39, 0x65,
+	0xc2, 0x60, 0x10, 0x9d, 0x23, 0x74, 0x99, 0xb3, 0x12, 0x12, 0x54, 0x87, 0x52, 0xd0, 0x3f, 0x71,
+	0x1d, 0x6a, 0xb2, 0x32, 0x53, 0x26, 0x85, 0x89, 0xb4, 0xd7, 0x46, 0x54, 0x83, 0x8d, 0xdc, 0xc7,
+	0x7f, 0x5f, 0x97, 0x74, 0x08, 0x49, 0x4c, 0x8c, 0x9e, 0x82, 0x2c, 0xa2, 0x6b, 0x62, 0xcf, 0x0e,
+	0xed, 0xe4, 0xef, 0x68, 0xa7, 0x22, 0x98, 0xaa, 0x67, 0x73, 0x5b, 0x1a, 0x4c, 0x53, 0x42, 0xad,
+	0xae, 0x29, 0xe4, 0xca, 0xd4, 0x3d, 0x72, 0x54, 0xe6, 0xd4, 0xa8, 0x80, 0xf6, 0x61, 0xf6, 0x82,
+	0x50, 0xc7, 0x3b, 0x33, 0x03, 0x6a, 0xf9, 0x62, 0x7f, 0x85, 0x3b, 0xfa, 0x35, 0x13, 0x52, 0x3b,
+	0x8c, 0xc9, 0x1d, 0xdb, 0x03, 0x21, 0x8a, 0xf7, 0x58, 0xbc, 0xa3, 0xad, 0xe9, 0x90, 0x18, 0x6d,
+	0x71, 0x99, 0x15, 0x09, 0xb5, 0x6c, 0x8b, 0x5a, 0x0a, 0xb0, 0xb2, 0xd5, 0x87, 0xcf, 0x68, 0x1e,
+	0x26, 0xa9, 0x43, 0xbb, 0x58, 0x29, 0x71, 0x45, 0xf8, 0x80, 0x14, 0x98, 0x0a, 0xfa, 0xae, 0x6b,
+	0xf9, 0x03, 0xa5, 0xcc, 0xe5, 0xd1, 0x23, 0xfa, 0x16, 0x14, 0xc2, 0x8e, 0xc0, 0xbe, 0x32, 0x7d,
+	0x4b, 0x0b, 0x0c, 0x91, 0x68, 0x03, 0x8a, 0xf8, 0xaa, 0x87, 0x6d, 0x87, 0x62, 0x5b, 0xa9, 0x6c,
+	0x48, 0x9b, 0x85, 0x46, 0x46, 0x91, 0xf4, 0x58, 0x88, 0xbe, 0x02, 0xd3, 0x2f, 0x2d, 0xa7, 0x8b,
+	0x6d, 0xd3, 0xc7, 0x56, 0x40, 0x3c, 0x65, 0x86, 0xaf, 0x5b, 0x0e, 0x85, 0x3a, 0x97, 0xa1, 0x1f,
+	0xc2, 0xf4, 0xb0, 0x43, 0xe9, 0xa0, 0x87, 0x15, 0x99, 0x97, 0xf0, 0xca, 0x0d, 0x25, 0x6c, 0x0c,
+	0x7a, 0x58, 0x2f, 0xf7, 0x12, 0x4f, 0xa8, 0x0a, 0x65, 0x1b, 0xf7, 0xb0, 0x67, 0x63, 0xef, 0xd4,
+	0xc1, 0x81, 0x32, 0xbb, 0x91, 0xdd, 0xcc, 0xe9, 0x23, 0xb2, 0xea, 0x5f, 0x24, 0x98, 0x8b, 0x4c,
+	0xc4, 0xa3, 0x25, 0x40, 0xab, 0x00, 0xe1, 0x74, 0x31, 0x89, 0x87, 0x79, 0x0f, 0x16, 0xf5, 0x62,
+	0x28, 0x69, 0x79, 0x38, 0xa1, 0xa6, 0x97, 0x44, 0xc9, 0x24, 0xd5, 0xc6, 0x25, 0x41, 0x0f, 0xa1,
+	0x1c, 0xa9, 0xcf, 0x7d, 0x8c, 0x79, 0xf7, 0x15, 0xf5, 0x92, 0x00, 0x30, 0x11, 0x1b, 0x40, 0x02,
+	0xf2, 0x92, 0xf4, 0x7d, 0xde, 0x5c, 0x45, 0x5d, 0x18, 0x7d, 0x42, 0xfa, 0x7e, 0x02, 0x10, 0xf4,
+	0x2c, 0x57, 0x99, 0x4c, 0x02, 0x3a, 0x3d, 0xcb, 0xad, 0xfe, 0x37, 0x0b, 0xa5, 0x64, 0xaf, 0x6d,
+	0x41, 0x71, 0x80, 0x03, 0xf3, 0x94, 0x0f, 0x1f, 0xee, 0x71, 0x43, 0x4e, 0x4c, 0x42, 0x8d, 0x49,
+	0xf5, 0xc2, 0x00, 0x07, 0xbb, 0x0c, 0x81, 0x1e, 0xc3, 0xb4, 0x75, 0x12, 0x50, 0xcb, 0xf1, 0x04,
+	0x25, 0x73, 0x03, 0xa5, 0x2c, 0x60, 0x21, 0xed, 0xeb, 0x50, 0xf0, 0x88, 0x60, 0x64, 0x6f, 0x60,
+	0x4c, 0x79, 0x24, 0x04, 0x7f, 0x1f, 0x90, 0x47, 0xcc, 0x4b, 0x87, 0x9e, 0x9b, 0x17, 0x98, 0x46,
+	0xb4, 0xdc, 0x0d, 0xb4, 0x19, 0x8f, 0x1c, 0x3b, 0xf4, 0xfc, 0x08, 0x53, 0x41, 0xff, 0x0e, 0xc8,
+	0x71, 0x12, 0x04, 0x79, 0xf2, 0xda, 0x88, 0xd7, 0x3c, 0xaa, 0x57, 0x86, 0xa9, 0x49, 0x33, 0xe9,
+	0x65, 0xb4, 0x6c, 0xfe, 0x6d, 0x4c, 0xe3, 0x52, 0xac, 0xf9, 0x11, 0xa0, 0x64, 0xea, 0x04, 0x77,
+	0x6a, 0x2c, 0x57, 0x4e, 0x24, 0x34, 0x64, 0x7f, 0x08, 0xb3, 0x89, 0xac, 0x0a, 0x72, 0x61, 0x2c,
+	0x79, 0x26, 0xce, 0x75, 0xc8, 0xdd, 0x02, 0x60, 0x99, 0x16, 0xa4, 0xe2, 0x58, 0x52, 0x91, 0x21,
+	0x38, 0xbc, 0xfa, 0x67, 0x09, 0x72, 0xac, 0x62, 0x6f, 0x3f, 0xca, 0x6a, 0x30, 0x79, 0x41, 0x28,
+	0xbe, 0xfd, 0x18, 0x0b, 0x61, 0xe8, 0x7b, 0x30, 0x15, 0xfa, 0x16, 0x28, 0x39, 0x3e, 0x1f, 0x1f,
+	0xa6, 0x7a, 0xee, 0xfa, 0xb1, 0xad, 0x47, 0x8c, 0x91, 0xf9, 0x33, 0x39, 0x3a, 0x7f, 0x9e, 0xe6,
+	0x0a, 0x59, 0x39, 0x57, 0xfd, 0x9b, 0x04, 0xd3, 0x62, 0x8a, 0xb6, 0x2d, 0xdf, 0x72, 0x03, 0xf4,
+	0x02, 0x4a, 0xae, 0xe3, 0x0d, 0x87, 0xb2, 0x74, 0xdb, 0x50, 0x5e, 0x65, 0x43, 0xf9, 0x8b, 0x57,
+	0xeb, 0x0b, 0x09, 0xd6, 0xfb, 0xc4, 0x75, 0x28, 0x76, 0x7b, 0x74, 0xa0, 0x83, 0xeb, 0x78, 0xd1,
+	0x98, 0x76, 0x01, 0xb9, 0xd6, 0x55, 0x04, 0x32, 0x7b, 0xd8, 0x77, 0x88, 0xcd, 0x03, 0xc1, 0x56,
+	0x48, 0xcf, 0xd6, 0xa6, 0xb8, 0xcf, 0x34, 0xde, 0xfd, 0xe2, 0xd5, 0xfa, 0x3b, 0xd7, 0x89, 0xf1,
+	0x22, 0xbf, 0x61, 0xa3, 0x57, 0x76, 0xad, 0xab, 0x68, 0x27, 0x5c, 0xff, 0x61, 0x46, 0x91, 0xaa,
+	0xcf, 0xa1, 0x7c, 0xc4, 0x47, 0xb2, 0xd8, 0x5d, 0x13, 0xc4, 0x88, 0x8e, 0x56, 0x97, 0x6e, 0x5b,
+	0x3d, 0xc7, 0xad, 0x97, 0x43, 0x56, 0xc2, 0xf2, 0x6f, 0x25, 0xd1, 0xf1, 0xc2, 0xf2, 0x7b, 0x90,
+	0xff, 0x65, 0x9f, 0xf8, 0x7d, 0x57, 0x91, 0xae, 0x55, 0x0b, 0xbf, 0xf8, 0x84, 0x5a, 0xf4, 0x3e,
+	0x14, 0x59, 0x31, 0x07, 0xe7, 0xa4, 0x6b, 0xdf, 0x70, 0x47, 0x8a, 0x01, 0xe8, 0x31, 0x54, 0x78,
+	0xb3, 0xc6, 0x94, 0xec, 0x58, 0xca, 0x34, 0x43, 0x19, 0x11, 0x88, 0x3b, 0xf8, 0xc7, 0x12, 0xe4,
+	0x85, 0x6f, 0xea, 0x3d, 0x73, 0x9a, 0x38, 0x68, 0x93, 0xf9, 0x3b, 0xf8, 0x72, 0xf9, 0xcb, 0x8d,
+	0xcf, 0xcf, 0xf5, 0x5c, 0x64, 0xbf, 0x44, 0x2e, 0x12, 0x71, 0xcf, 0xdd, 0x3d, 0xee, 0x93, 0xf7,
+	0x8f, 0x7b, 0xfe, 0x0e, 0x71, 0x47, 0x1a, 0x2c, 0xb1, 0x40, 0x3b, 0x9e, 0x43, 0x9d, 0xf8, 0x66,
+	0x63, 0x72, 0xf7, 0x95, 0xa9, 0xb1, 0x16, 0x1e, 0xb8, 0x8e, 0xa7, 0x85, 0x78, 0x11, 0x1e, 0x9d,
+	0xa1, 0x51, 0x03, 0x16, 0x86, 0x93, 0xe4, 0xd4, 0xf2, 0x4e, 0x71, 0x57, 0x98, 0x29, 0x8c, 0x35,
+	0x33, 0x17, 0x81, 0x77, 0x39, 0x36, 0xb4, 0xf1, 0x14, 0xe6, 0xd3, 0x36, 0x6c, 0x1c, 0x44, 0xf3,
+	0xec, 0xe6, 0xd9, 0x83, 0x46, 0x8d, 0x35, 0x71, 0x40, 0xd1, 0x31, 0x2c, 0x0e, 0x2f, 0x0d, 0xe6,
+	0x68, 0xde, 0xe0, 0x6e, 0x79, 0x5b, 0x18, 0xf2, 0x8f, 0x92, 0x09, 0xfc, 0x01, 0xcc, 0xc5, 0x86,
+	0xe3, 0x78, 0x97, 0xc6, 0x6e, 0x13, 0x0d, 0xa1, 0x71, 0xd0, 0x9f, 0x43, 0x6c, 0xd9, 0x4c, 0xd6,
+	0x79, 0xf9, 0x1e, 0x75, 0x1e, 0xfb, 0x70, 0x10, 0x17, 0xfc, 0x26, 0xc8, 0x27, 0x7d, 0xdf, 0x63,
+	0xdb, 0xc5, 0xa6, 0xa8, 0x32, 0x76, 0xf7, 0x2a, 0xe8, 0x15, 0x26, 0x67, 0x23, 0xf7, 0x27, 0x61,
+	0x75, 0xd5, 0x61, 0x95, 0x23, 0x87, 0xe1, 0x1e, 0x36, 0x89, 0x8f, 0x19, 0x3b, 0xbc, 0x7b, 0xe9,
+	0xcb, 0x0c, 0x14, 0x5d, 0x71, 0xa2, 0x6e, 0x08, 0x11, 0xe8, 0x5d, 0xa8, 0xc4, 0x8b, 0xb1, 0xb2,
+	0xe2, 0x37, 0xb1, 0x82, 0x5e, 0x8e, 0x96, 0x62, 0x67, 0x31, 0x3b, 0xd4, 0x12, 0x5b, 0x14, 0x25,
+	0x21, 0x8f, 0x8d, 0xd5, 0x4c, 0xdc, 0xba, 0x61, 0x39, 0xfc, 0x18, 0x96, 0xd3, 0xe5, 0xc0, 0xfa,
+	0x59, 0x64, 0x71, 0x76, 0xac, 0x91, 0xc5, 0xd1, 0x52, 0x38, 0xb0, 0xae, 0x44, 0xda, 0x7e, 0x06,
+	0xeb, 0xec, 0x98, 0x71, 0x9d, 0x80, 0x3a, 0xa7, 0xa6, 0xd5, 0xa7, 0xe7, 0xc4, 0x77, 0x7e, 0x85,
+	0x6d, 0xd3, 0x0a, 0x4b, 0x09, 0x07, 0x0a, 0xda, 0xc8, 0xbe, 0xb5, 0xcc, 0x56, 0x63, 0x03, 0xf5,
+	0x21, 0xbf, 0x1e, 0xd1, 0x91, 0x0e, 0x09, 0x80, 0xe9, 0xe3, 0x9f, 0xe3, 0xd3, 0xd1, 0x12, 0x99,
+	0x1b, 0xeb, 0xf1, 0x4a, 0x4c, 0xd2, 0x05, 0x27, 0xae, 0x95, 0x2d, 0x00, 0x76, 0x2f, 0x13, 0xb9,
+	0x9c, 0x1f, 0x3f, 0x06, 0x06, 0x38, 0x10, 0x69, 0xfd, 0x2e, 0xc8, 0x71, 0x69, 0x09, 0xd2, 0xc2,
+	0xf8, 0x60, 0x0f, 0x71, 0x82, 0xba, 0x03, 0xc9, 0x13, 0xd1, 0xb4, 0xbc, 0x81, 0x69, 0x63, 0x8f,
+	0xb8, 0xca, 0x03, 0x9e, 0x55, 0x14, 0x27, 0xa7, 0xee, 0x0d, 0x9a, 0x4c, 0x53, 0xfd, 0x75, 0x06,
+	0xd0, 0x41, 0xf8, 0xba, 0xd8, 0xb0, 0x02, 0x6c, 0xff, 0x3f, 0xcf, 0xac, 0xc4, 0x9c, 0xcc, 0xbc,
+	0x75, 0x4e, 0xde, 0x33, 0x42, 0x23, 0x63, 0x35, 0x7b, 0xff, 0xb1, 0x9a, 0xbb, 0xc3, 0x58, 0x7d,
+	0xf4, 0x7b, 0x09, 0xca, 0xc9, 0x77, 0x0b, 0xb4, 0x0a, 0x4b, 0x6d, 0xbd, 0xd5, 0x6e, 0x75, 0xea,
+	0xfb, 0xa6, 0xf1, 0xa2, 0xad, 0x9a, 0xcf, 0x0e, 0x3b, 0x6d, 0x75, 0x57, 0x7b, 0xa2, 0xa9, 0x4d,
+	0x79, 0x02, 0x2d, 0xc3, 0x83, 0x51, 0x75, 0xc7, 0xa8, 0x1f, 0x36, 0xeb, 0x7a, 0x53, 0x96, 0xd0,
+	0x43, 0x58, 0x1d, 0xd5, 0x1d, 0x3c, 0xdb, 0x37, 0xb4, 0xf6, 0xbe, 0x6a, 0xee, 0xee, 0xb5, 0xb4,
+	0x5d, 0x55, 0xce, 0xa0, 0x77, 0x40, 0x19, 0x85, 0xb4, 0xda, 0x86, 0x76, 0xa0, 0x75, 0x0c, 0x6d,
+	0x57, 0xce, 0xa2, 0x15, 0x58, 0x1c, 0xd5, 0xaa, 0xcf, 0xdb, 0x6a, 0x53, 0x33, 0xd4, 0xa6, 0x9c,
+	0x7b, 0xf4, 0x1f, 0x09, 0x20, 0xf1, 0x01, 0x65, 0x05, 0x16, 0x8f, 0x5a, 0x46, 0x68, 0xa0, 0x75,
+	0x98, 0xf2, 0x72, 0x0e, 0x66, 0x92, 0xca, 0x17, 0x6a, 0x47, 0x96, 0xd2, 0xc2, 0xd6, 0xa1, 0x2a,
+	0x4b, 0x68, 0x11, 0xe6, 0x92, 0xc2, 0x7a, 0xa3, 0x63, 0xd4, 0xb5, 0x43, 0x39, 0x93, 0x46, 0x1b,
+	0xc7, 0x2d, 0x39, 0x83, 0x10, 0x54, 0x92, 0xc2, 0xc3, 0x96, 0x9c, 0x45, 0x0b, 0x30, 0x3b, 0x02,
+	0xdc, 0xd3, 0x55, 0x55, 0xce, 0xb2, 0x9d, 0x8e, 0x42, 0xcd, 0x63, 0xcd, 0xd8, 0x33, 0x8f, 0x54,
+	0xa3, 0x25, 0xe7, 0xd0, 0x3c, 0xc8, 0x49, 0xed, 0x93, 0xd6, 0x33, 0xfd, 0xba, 0xb4, 0xd3, 0xae,
+	0x1f, 0xc8, 0x93, 0xcb, 0x19, 0x59, 0x7a, 0xf4, 0x6f, 0x09, 0x2a, 0xa3, 0x5f, 0x31, 0xd0, 0x3a,
+	0xac, 0x0c, 0x83, 0xd5, 0x31, 0xea, 0xc6, 0xb3, 0x4e, 0x2a, 0x08, 0x55, 0x58, 0x4b, 0x03, 0x9a,
+	0x6a, 0xbb, 0xd5, 0xd1, 0x0c, 0xb3, 0xad, 0xea, 0x5a, 0x2b, 0x9d, 0x32, 0x81, 0x39, 0x6a, 0x19,
+	0xda, 0xe1, 0x8f, 0x22, 0x48, 0x66, 0x24, 0xe3, 0x02, 0xd2, 0xae, 0x77, 0x3a, 0x6a, 0x33, 0xdc,
+	0x64, 0x5a, 0xa7, 0xab, 0x4f, 0xd5, 0x5d, 0x9e, 0xb1, 0x71, 0xcc, 0x27, 0x75, 0x6d, 0x5f, 0x6d,
+	0xca, 0x93, 0xe8, 0x6b, 0xf0, 0xd5, 0xb4, 0xae, 0x7e, 0x5c, 0xd7, 0xf8, 0xd2, 0x4d, 0xb5, 0xad,
+	0x1e, 0x36, 0xd5, 0xc3, 0x5d, 0x4d, 0xed, 0xc8, 0xf9, 0xc6, 0xe3, 0x4f, 0x5f, 0xaf, 0x49, 0x9f,
+	0xbd, 0x5e, 0x93, 0xfe, 0xf1, 0x7a, 0x4d, 0xfa, 0xf8, 0xcd, 0xda, 0xc4, 0x67, 0x6f, 0xd6, 0x26,
+	0xfe, 0xfa, 0x66, 0x6d, 0xe2, 0xa7, 0x2b, 0x61, 0x5d, 0x07, 0xf6, 0x2f, 0x6a, 0x0e, 0xd9, 0xbe,
+	0xe2, 0x9f, 0x12, 0xd9, 0x3b, 0x74, 0xc0, 0xbe, 0x13, 0xe6, 0x79, 0xf3, 0x7e, 0xf3, 0x7f, 0x03,
+	0x00, 0x9a, 0xc9, 0x5f, 0x11, 0x68, 0x14, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1558,6 +1594,26 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Dependencies) > 0 {
+		dAtA2 := make([]byte, len(m.Dependencies)*10)
+		var j1 int
+		for _, num := range m.Dependencies {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -1611,22 +1667,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1645,22 +1701,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1924,12 +1980,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1971,12 +2027,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2047,6 +2103,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinDepositAnyDenom {
+		i--
+		if m.MinDepositAnyDenom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	if len(m.ExpeditedQuorum) > 0 {
 		i -= len(m.ExpeditedQuorum)
 		copy(dAtA[i:], m.ExpeditedQuorum)
@@ -2155,12 +2223,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2207,22 +2275,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2294,12 +2362,12 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2425,6 +2493,13 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	if len(m.Dependencies) > 0 {
+		l = 0
+		for _, e := range m.Dependencies {
+			l += sovGov(uint64(e))
+		}
+		n += 2 + sovGov(uint64(l)) + l
+	}
 	return n
 }
 
@@ -2674,6 +2749,9 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	if m.MinDepositAnyDenom {
+		n += 3
+	}
 	return n
 }
 
@@ -3462,6 +3540,82 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Dependencies = append(m.Dependencies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Dependencies) == 0 {
+					m.Dependencies = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Dependencies = append(m.Dependencies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5231,6 +5385,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ExpeditedQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositAnyDenom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinDepositAnyDenom = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
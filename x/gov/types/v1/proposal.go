@@ -14,12 +14,13 @@ const (
 	// DefaultStartingProposalID is 1
 	DefaultStartingProposalID uint64 = 1
 
-	StatusNil           = ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
-	StatusDepositPeriod = ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD
-	StatusVotingPeriod  = ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD
-	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
-	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
-	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusNil                  = ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+	StatusDepositPeriod        = ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD
+	StatusVotingPeriod         = ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD
+	StatusPassed               = ProposalStatus_PROPOSAL_STATUS_PASSED
+	StatusRejected             = ProposalStatus_PROPOSAL_STATUS_REJECTED
+	StatusFailed               = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusAwaitingDependencies = ProposalStatus_PROPOSAL_STATUS_AWAITING_DEPENDENCIES
 )
 
 // NewProposal creates a new Proposal instance
@@ -136,7 +137,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusAwaitingDependencies {
 		return true
 	}
 	return false
@@ -1163,6 +1163,111 @@ func (m *QueryMessageBasedParamsResponse) GetParams() *MessageBasedParams {
 	return nil
 }
 
+// QueryProposalDependenciesRequest is the request type for the Query/ProposalDependencies RPC method.
+//
+// Since: x/gov 1.0.0
+type QueryProposalDependenciesRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalDependenciesRequest) Reset()         { *m = QueryProposalDependenciesRequest{} }
+func (m *QueryProposalDependenciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalDependenciesRequest) ProtoMessage()    {}
+func (*QueryProposalDependenciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{22}
+}
+func (m *QueryProposalDependenciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalDependenciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalDependenciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalDependenciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalDependenciesRequest.Merge(m, src)
+}
+func (m *QueryProposalDependenciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalDependenciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalDependenciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalDependenciesRequest proto.InternalMessageInfo
+
+func (m *QueryProposalDependenciesRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalDependenciesResponse is the response type for the Query/ProposalDependencies RPC method.
+//
+// Since: x/gov 1.0.0
+type QueryProposalDependenciesResponse struct {
+	// dependencies are the ids of the proposals the proposal depends on.
+	Dependencies []uint64 `protobuf:"varint,1,rep,packed,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// dependents are the ids of the proposals depending on the proposal.
+	Dependents []uint64 `protobuf:"varint,2,rep,packed,name=dependents,proto3" json:"dependents,omitempty"`
+}
+
+func (m *QueryProposalDependenciesResponse) Reset()         { *m = QueryProposalDependenciesResponse{} }
+func (m *QueryProposalDependenciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalDependenciesResponse) ProtoMessage()    {}
+func (*QueryProposalDependenciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{23}
+}
+func (m *QueryProposalDependenciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalDependenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalDependenciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalDependenciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalDependenciesResponse.Merge(m, src)
+}
+func (m *QueryProposalDependenciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalDependenciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalDependenciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalDependenciesResponse proto.InternalMessageInfo
+
+func (m *QueryProposalDependenciesResponse) GetDependencies() []uint64 {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *QueryProposalDependenciesResponse) GetDependents() []uint64 {
+	if m != nil {
+		return m.Dependents
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -1186,86 +1291,91 @@ func init() {
 	proto.RegisterType((*QueryProposalVoteOptionsResponse)(nil), "cosmos.gov.v1.QueryProposalVoteOptionsResponse")
 	proto.RegisterType((*QueryMessageBasedParamsRequest)(nil), "cosmos.gov.v1.QueryMessageBasedParamsRequest")
 	proto.RegisterType((*QueryMessageBasedParamsResponse)(nil), "cosmos.gov.v1.QueryMessageBasedParamsResponse")
+	proto.RegisterType((*QueryProposalDependenciesRequest)(nil), "cosmos.gov.v1.QueryProposalDependenciesRequest")
+	proto.RegisterType((*QueryProposalDependenciesResponse)(nil), "cosmos.gov.v1.QueryProposalDependenciesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1172 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdc, 0x54,
-	0x17, 0x8e, 0x27, 0xdf, 0x67, 0x92, 0xbc, 0x2f, 0x27, 0x4d, 0x33, 0x75, 0x9a, 0x49, 0xe2, 0x40,
-	0x12, 0x10, 0xb1, 0x99, 0xa4, 0x69, 0x15, 0x5a, 0x84, 0x9a, 0x96, 0x14, 0x24, 0x10, 0x61, 0x5a,
-	0x58, 0x20, 0xa4, 0x91, 0x93, 0xb1, 0x2c, 0x8b, 0x89, 0xaf, 0x3b, 0xd7, 0x63, 0x11, 0x42, 0x84,
-	0x54, 0x89, 0x8f, 0x15, 0x20, 0x51, 0x01, 0x0b, 0x7e, 0x01, 0x5b, 0xf8, 0x11, 0x2c, 0x2b, 0xd8,
-	0xb0, 0x44, 0x09, 0x3f, 0x04, 0xf9, 0xde, 0x63, 0x8f, 0xed, 0x78, 0x26, 0x33, 0x55, 0xc5, 0x72,
-	0xae, 0x9f, 0xf3, 0x9c, 0xe7, 0x9c, 0x7b, 0x3e, 0xee, 0xc0, 0x95, 0x03, 0xc6, 0x0f, 0x19, 0x37,
-	0x6c, 0x16, 0x18, 0x41, 0xc5, 0x78, 0xd8, 0xb2, 0x9a, 0x47, 0xba, 0xd7, 0x64, 0x3e, 0xc3, 0x49,
-	0xf9, 0x49, 0xb7, 0x59, 0xa0, 0x07, 0x15, 0xf5, 0x25, 0x42, 0xee, 0x9b, 0xdc, 0x92, 0x38, 0x23,
-	0xa8, 0xec, 0x5b, 0xbe, 0x59, 0x31, 0x3c, 0xd3, 0x76, 0x5c, 0xd3, 0x77, 0x98, 0x2b, 0x4d, 0xd5,
-	0xab, 0x36, 0x63, 0x76, 0xc3, 0x32, 0x4c, 0xcf, 0x31, 0x4c, 0xd7, 0x65, 0xbe, 0xf8, 0xc8, 0xe9,
-	0xeb, 0x6c, 0xda, 0x67, 0xc8, 0x2f, 0x3f, 0x90, 0x98, 0x9a, 0xf8, 0x65, 0x90, 0x7b, 0xf1, 0x43,
-	0x53, 0xa1, 0xf4, 0x5e, 0xe8, 0xf3, 0x0e, 0x73, 0xb9, 0xef, 0xf8, 0xad, 0x90, 0xaf, 0x6a, 0x3d,
-	0x6c, 0x59, 0xdc, 0xd7, 0x5e, 0x87, 0x2b, 0x39, 0xdf, 0xb8, 0xc7, 0x5c, 0x6e, 0xa1, 0x06, 0x13,
-	0x07, 0x89, 0xf3, 0x92, 0xb2, 0xa8, 0xac, 0x8d, 0x57, 0x53, 0x67, 0xda, 0x0d, 0xb8, 0x24, 0x08,
-	0xf6, 0x9a, 0xcc, 0x63, 0xdc, 0x6c, 0x10, 0x31, 0x2e, 0x40, 0xd1, 0xa3, 0xa3, 0x9a, 0x53, 0x17,
-	0xa6, 0x43, 0x55, 0x88, 0x8e, 0xde, 0xaa, 0x6b, 0x6f, 0xc3, 0x4c, 0xc6, 0x90, 0xbc, 0x6e, 0xc2,
-	0x58, 0x04, 0x13, 0x66, 0xc5, 0x8d, 0x59, 0x3d, 0x95, 0x4e, 0x3d, 0x36, 0x89, 0x81, 0xda, 0xb7,
-	0x85, 0x0c, 0x1d, 0x8f, 0x84, 0xec, 0xc2, 0xff, 0x62, 0x21, 0xdc, 0x37, 0xfd, 0x16, 0x17, 0xac,
-	0x53, 0x1b, 0xf3, 0x1d, 0x58, 0xef, 0x0b, 0x50, 0x75, 0xca, 0x4b, 0xfd, 0x46, 0x1d, 0x86, 0x03,
-	0xe6, 0x5b, 0xcd, 0x52, 0x21, 0xcc, 0xc2, 0x4e, 0xe9, 0x8f, 0xdf, 0xd6, 0x2f, 0x11, 0xc1, 0xed,
-	0x7a, 0xbd, 0x69, 0x71, 0x7e, 0xdf, 0x6f, 0x3a, 0xae, 0x5d, 0x95, 0x30, 0xbc, 0x0e, 0xe3, 0x75,
-	0xcb, 0x63, 0xdc, 0xf1, 0x59, 0xb3, 0x34, 0x78, 0x81, 0x4d, 0x1b, 0x8a, 0xbb, 0x00, 0xed, 0x9a,
-	0x28, 0x0d, 0x89, 0x04, 0xac, 0x44, 0x52, 0xc3, 0x02, 0xd2, 0x65, 0xa1, 0x51, 0x01, 0xe9, 0x7b,
-	0xa6, 0x6d, 0x51, 0xac, 0xd5, 0x84, 0xa5, 0xf6, 0x93, 0x02, 0x97, 0xb3, 0x19, 0xa1, 0x0c, 0x6f,
-	0xc1, 0x78, 0x14, 0x5c, 0x98, 0x8c, 0xc1, 0x6e, 0x29, 0x6e, 0x23, 0xf1, 0x5e, 0x4a, 0x59, 0x41,
-	0x28, 0x5b, 0xbd, 0x50, 0x99, 0xf4, 0x99, 0x92, 0x76, 0x00, 0xff, 0x17, 0xca, 0x3e, 0x60, 0xbe,
-	0xd5, 0x6b, 0xbd, 0xf4, 0x9b, 0x7f, 0xed, 0x16, 0x3c, 0x97, 0x70, 0x42, 0x91, 0xaf, 0xc2, 0x50,
-	0xf8, 0x95, 0xea, 0x6a, 0x3a, 0x13, 0xb4, 0x80, 0x0a, 0x80, 0xf6, 0x59, 0xc2, 0x9a, 0xf7, 0xac,
-	0x71, 0x37, 0x27, 0x43, 0x4f, 0x73, 0x77, 0x5f, 0x2b, 0x80, 0x49, 0xf7, 0xa4, 0xfe, 0x45, 0x99,
-	0x82, 0xe8, 0xce, 0x72, 0xe5, 0x4b, 0xc4, 0xb3, 0xbb, 0xab, 0x6d, 0x52, 0xb2, 0x67, 0x36, 0xcd,
-	0xc3, 0x38, 0x13, 0xcb, 0x50, 0xf4, 0xc4, 0x41, 0xcd, 0x3f, 0xf2, 0x64, 0x3a, 0xc7, 0x77, 0x0a,
-	0x25, 0x25, 0x34, 0x0d, 0x8f, 0x1f, 0x1c, 0x79, 0x96, 0xf6, 0x43, 0x01, 0xa6, 0x53, 0xb6, 0x14,
-	0xc6, 0x5d, 0x98, 0x0c, 0x98, 0xef, 0xb8, 0x76, 0x4d, 0x82, 0xe9, 0x36, 0xe6, 0xce, 0x87, 0xe3,
-	0xb8, 0xb6, 0xb4, 0x15, 0xdc, 0x13, 0x41, 0xe2, 0x04, 0xef, 0xc1, 0x14, 0x35, 0x4d, 0x44, 0x23,
-	0xa3, 0xbc, 0x9a, 0xa1, 0xb9, 0x2b, 0x41, 0x09, 0x9e, 0xc9, 0x7a, 0xf2, 0x08, 0x6f, 0xc3, 0x84,
-	0x6f, 0x36, 0x1a, 0x47, 0x11, 0xcd, 0xa0, 0xa0, 0x51, 0x33, 0x34, 0x0f, 0x42, 0x48, 0x82, 0xa4,
-	0xe8, 0xb7, 0x0f, 0x70, 0x1d, 0x46, 0xc8, 0x58, 0xf6, 0xeb, 0x4c, 0xb6, 0x9b, 0x64, 0x02, 0x08,
-	0xa4, 0xb9, 0x94, 0x17, 0x92, 0xd6, 0x73, 0x79, 0xa5, 0x46, 0x4a, 0xa1, 0xe7, 0x91, 0xa2, 0xbd,
-	0x49, 0x33, 0x3a, 0xf6, 0x47, 0x17, 0xf1, 0x0a, 0x8c, 0x12, 0x88, 0xae, 0xe0, 0x72, 0x7e, 0xee,
-	0xaa, 0x11, 0x4c, 0xfb, 0x3c, 0xcd, 0xf4, 0xdf, 0x77, 0xc6, 0x63, 0x85, 0xe6, 0x7c, 0x5b, 0x01,
-	0x05, 0xb3, 0x01, 0x63, 0xa4, 0x32, 0xea, 0x8f, 0x4e, 0xd1, 0xc4, 0xb8, 0x67, 0xd7, 0x25, 0xaf,
-	0xc2, 0xac, 0x50, 0x25, 0xaa, 0xa4, 0x6a, 0xf1, 0x56, 0xc3, 0xef, 0x63, 0x11, 0x96, 0xce, 0xdb,
-	0xc6, 0x37, 0x34, 0x2c, 0xea, 0x8c, 0xee, 0x27, 0xb7, 0x28, 0xc9, 0x44, 0x02, 0xb5, 0x1d, 0x58,
-	0x48, 0x4d, 0xfd, 0x70, 0x28, 0xbc, 0xeb, 0x89, 0x27, 0x44, 0xcf, 0x8a, 0x1c, 0x58, 0xec, 0xcc,
-	0x41, 0xca, 0xde, 0x80, 0xb0, 0x1d, 0xad, 0x1a, 0x93, 0xe7, 0x24, 0x50, 0xeb, 0xb0, 0x46, 0x92,
-	0x0c, 0xc5, 0xa0, 0xfd, 0x43, 0xdb, 0x86, 0xb2, 0x70, 0xf5, 0x8e, 0xc5, 0xb9, 0x69, 0x5b, 0x3b,
-	0x26, 0xb7, 0xea, 0xe9, 0x51, 0x33, 0x0b, 0xa3, 0x87, 0xdc, 0xae, 0xb5, 0x9a, 0x0d, 0x7a, 0x7f,
-	0x8c, 0x1c, 0x72, 0xfb, 0xfd, 0x66, 0x43, 0xfb, 0x88, 0x22, 0xcd, 0x33, 0x25, 0x91, 0xdb, 0x71,
-	0x5f, 0x4a, 0x79, 0x4b, 0x19, 0x79, 0x39, 0xa6, 0x64, 0xb0, 0xf1, 0xcb, 0x04, 0x0c, 0x0b, 0x7a,
-	0xfc, 0x52, 0x81, 0x89, 0xe4, 0xf3, 0x08, 0x57, 0x33, 0x2c, 0x9d, 0x1e, 0x57, 0xea, 0xda, 0xc5,
-	0x40, 0x29, 0x54, 0x5b, 0x7e, 0xf4, 0xe7, 0x3f, 0xdf, 0x17, 0xe6, 0x71, 0xce, 0x48, 0xbf, 0xef,
-	0x92, 0x4f, 0x2d, 0xfc, 0x42, 0x81, 0xb1, 0x28, 0xa1, 0xb8, 0x9c, 0xc7, 0x9d, 0x79, 0x84, 0xa9,
-	0xcf, 0x77, 0x07, 0x91, 0x73, 0x5d, 0x38, 0x5f, 0xc3, 0x95, 0x8c, 0xf3, 0x78, 0xf3, 0x1b, 0xc7,
-	0x89, 0x7a, 0x39, 0xc1, 0x4f, 0x61, 0x3c, 0x7e, 0x53, 0x60, 0x57, 0x17, 0xd1, 0x25, 0xaa, 0x2f,
-	0x5c, 0x80, 0x22, 0x25, 0x8b, 0x42, 0x89, 0x8a, 0xa5, 0x4e, 0x4a, 0xf0, 0x2b, 0x05, 0x86, 0xc2,
-	0x62, 0xc2, 0x85, 0x3c, 0xc6, 0xc4, 0x83, 0x42, 0x5d, 0xec, 0x0c, 0x20, 0x6f, 0xb7, 0x84, 0xb7,
-	0xeb, 0x78, 0xad, 0xb7, 0xb8, 0x0d, 0xb1, 0x59, 0x8d, 0x63, 0xf1, 0xbc, 0x38, 0xc1, 0x47, 0x0a,
-	0x0c, 0x8b, 0xf5, 0x8c, 0x1d, 0x3d, 0xc5, 0xe1, 0x2f, 0x75, 0x41, 0x90, 0x98, 0x6b, 0x42, 0x8c,
-	0x8e, 0x2f, 0xf7, 0x23, 0x06, 0x5d, 0x18, 0xa1, 0x15, 0x94, 0xeb, 0x22, 0xd5, 0x49, 0xaa, 0xd6,
-	0x0d, 0x42, 0x32, 0xe6, 0x85, 0x8c, 0x59, 0x9c, 0xc9, 0xca, 0x90, 0x5e, 0x7e, 0x54, 0x60, 0x94,
-	0xc6, 0x28, 0xe6, 0xd2, 0xa5, 0x57, 0x9a, 0xba, 0xdc, 0x15, 0x43, 0x3e, 0xef, 0x08, 0x9f, 0xaf,
-	0xe1, 0xcd, 0x1e, 0x43, 0x8f, 0xc6, 0xb7, 0x71, 0x1c, 0xaf, 0xb8, 0x13, 0xfc, 0x46, 0x81, 0xb1,
-	0x68, 0x27, 0x60, 0x37, 0xb7, 0xbc, 0x6b, 0x73, 0x64, 0xd7, 0x8a, 0x76, 0x43, 0x88, 0xab, 0xa0,
-	0xd1, 0xa7, 0x38, 0x7c, 0xac, 0x40, 0x31, 0x31, 0x9f, 0x71, 0x25, 0xcf, 0xdd, 0xf9, 0x7d, 0xa1,
-	0xae, 0x5e, 0x88, 0x7b, 0xca, 0x8a, 0x11, 0xfb, 0x01, 0x7f, 0x55, 0x60, 0x3a, 0x67, 0x2a, 0xa3,
-	0xde, 0xad, 0x43, 0xcf, 0x2f, 0x11, 0xd5, 0xe8, 0x19, 0x4f, 0x72, 0x6f, 0x0a, 0xb9, 0x5b, 0xb8,
-	0xd9, 0x47, 0x81, 0x47, 0xdb, 0x05, 0x7f, 0x56, 0x00, 0xcf, 0x0f, 0x6b, 0x5c, 0xcf, 0x13, 0xd1,
-	0x71, 0x95, 0xa8, 0x7a, 0xaf, 0x70, 0x92, 0xbc, 0x2a, 0x24, 0x2f, 0xe1, 0x42, 0x6e, 0x33, 0x18,
-	0xc7, 0xb4, 0x97, 0x4e, 0x76, 0xb6, 0x7e, 0x3f, 0x2d, 0x2b, 0x4f, 0x4e, 0xcb, 0xca, 0xdf, 0xa7,
-	0x65, 0xe5, 0xbb, 0xb3, 0xf2, 0xc0, 0x93, 0xb3, 0xf2, 0xc0, 0x5f, 0x67, 0xe5, 0x81, 0x0f, 0xe7,
-	0xa4, 0x25, 0xaf, 0x7f, 0xac, 0x3b, 0xcc, 0xf8, 0x44, 0x30, 0x84, 0x4f, 0x66, 0x6e, 0x04, 0x95,
-	0xfd, 0x11, 0xf1, 0xff, 0x7c, 0xf3, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x36, 0xe1, 0xe8,
-	0x49, 0x10, 0x00, 0x00,
+	// 1229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5f, 0x4f, 0xe4, 0x54,
+	0x14, 0xa7, 0xc3, 0xff, 0x33, 0x80, 0x7a, 0x80, 0x65, 0xb6, 0x2c, 0x03, 0x5c, 0x14, 0xd0, 0x48,
+	0xbb, 0xc0, 0xb2, 0x1b, 0xdc, 0x35, 0x66, 0x01, 0x59, 0x4d, 0x34, 0xe2, 0xec, 0xea, 0x83, 0x31,
+	0x99, 0x74, 0x99, 0xa6, 0x69, 0x1c, 0xda, 0xee, 0xdc, 0xce, 0x44, 0x44, 0x62, 0xb2, 0x89, 0x7f,
+	0x9e, 0xd4, 0xc4, 0x8d, 0xfa, 0xe0, 0xb7, 0xd0, 0xf8, 0x19, 0x7c, 0xdc, 0xe8, 0x8b, 0x8f, 0x06,
+	0xfc, 0x20, 0xa6, 0xf7, 0x9e, 0xce, 0xb4, 0xa5, 0x53, 0x0a, 0xd9, 0xf8, 0x44, 0x7a, 0xfa, 0x3b,
+	0xbf, 0xf3, 0x3b, 0xe7, 0x9e, 0xd3, 0x73, 0x19, 0xb8, 0xba, 0xef, 0xf2, 0x03, 0x97, 0xeb, 0x96,
+	0xdb, 0xd2, 0x5b, 0xab, 0xfa, 0xa3, 0xa6, 0xd9, 0x38, 0xd4, 0xbc, 0x86, 0xeb, 0xbb, 0x38, 0x2a,
+	0x5f, 0x69, 0x96, 0xdb, 0xd2, 0x5a, 0xab, 0xea, 0x2b, 0x84, 0x7c, 0x68, 0x70, 0x53, 0xe2, 0xf4,
+	0xd6, 0xea, 0x43, 0xd3, 0x37, 0x56, 0x75, 0xcf, 0xb0, 0x6c, 0xc7, 0xf0, 0x6d, 0xd7, 0x91, 0xae,
+	0xea, 0x35, 0xcb, 0x75, 0xad, 0xba, 0xa9, 0x1b, 0x9e, 0xad, 0x1b, 0x8e, 0xe3, 0xfa, 0xe2, 0x25,
+	0xa7, 0xb7, 0x53, 0xf1, 0x98, 0x01, 0xbf, 0x7c, 0x41, 0x62, 0xaa, 0xe2, 0x49, 0xa7, 0xf0, 0xe2,
+	0x81, 0xa9, 0x50, 0x7a, 0x3f, 0x88, 0xb9, 0xed, 0x3a, 0xdc, 0xb7, 0xfd, 0x66, 0xc0, 0x57, 0x31,
+	0x1f, 0x35, 0x4d, 0xee, 0xb3, 0x37, 0xe0, 0x6a, 0xca, 0x3b, 0xee, 0xb9, 0x0e, 0x37, 0x91, 0xc1,
+	0xc8, 0x7e, 0xc4, 0x5e, 0x52, 0xe6, 0x94, 0xe5, 0xe1, 0x4a, 0xcc, 0xc6, 0x6e, 0xc1, 0x84, 0x20,
+	0xd8, 0x6b, 0xb8, 0x9e, 0xcb, 0x8d, 0x3a, 0x11, 0xe3, 0x2c, 0x14, 0x3d, 0x32, 0x55, 0xed, 0x9a,
+	0x70, 0xed, 0xab, 0x40, 0x68, 0x7a, 0xbb, 0xc6, 0xde, 0x81, 0xc9, 0x84, 0x23, 0x45, 0x5d, 0x87,
+	0xa1, 0x10, 0x26, 0xdc, 0x8a, 0x6b, 0x53, 0x5a, 0xac, 0x9c, 0x5a, 0xdb, 0xa5, 0x0d, 0x64, 0xdf,
+	0x15, 0x12, 0x74, 0x3c, 0x14, 0xb2, 0x0b, 0xcf, 0xb5, 0x85, 0x70, 0xdf, 0xf0, 0x9b, 0x5c, 0xb0,
+	0x8e, 0xad, 0xcd, 0x74, 0x61, 0xbd, 0x2f, 0x40, 0x95, 0x31, 0x2f, 0xf6, 0x8c, 0x1a, 0xf4, 0xb7,
+	0x5c, 0xdf, 0x6c, 0x94, 0x0a, 0x41, 0x15, 0xb6, 0x4a, 0x7f, 0xfe, 0xb6, 0x32, 0x41, 0x04, 0x77,
+	0x6b, 0xb5, 0x86, 0xc9, 0xf9, 0x7d, 0xbf, 0x61, 0x3b, 0x56, 0x45, 0xc2, 0xf0, 0x26, 0x0c, 0xd7,
+	0x4c, 0xcf, 0xe5, 0xb6, 0xef, 0x36, 0x4a, 0xbd, 0xe7, 0xf8, 0x74, 0xa0, 0xb8, 0x0b, 0xd0, 0xe9,
+	0x89, 0x52, 0x9f, 0x28, 0xc0, 0x62, 0x28, 0x35, 0x68, 0x20, 0x4d, 0x36, 0x1a, 0x35, 0x90, 0xb6,
+	0x67, 0x58, 0x26, 0xe5, 0x5a, 0x89, 0x78, 0xb2, 0x9f, 0x15, 0xb8, 0x92, 0xac, 0x08, 0x55, 0x78,
+	0x03, 0x86, 0xc3, 0xe4, 0x82, 0x62, 0xf4, 0x66, 0x95, 0xb8, 0x83, 0xc4, 0x7b, 0x31, 0x65, 0x05,
+	0xa1, 0x6c, 0xe9, 0x5c, 0x65, 0x32, 0x66, 0x4c, 0xda, 0x3e, 0x3c, 0x2f, 0x94, 0x7d, 0xe8, 0xfa,
+	0x66, 0xde, 0x7e, 0xb9, 0x68, 0xfd, 0xd9, 0x1d, 0x78, 0x21, 0x12, 0x84, 0x32, 0x5f, 0x82, 0xbe,
+	0xe0, 0x2d, 0xf5, 0xd5, 0x78, 0x22, 0x69, 0x01, 0x15, 0x00, 0xf6, 0x79, 0xc4, 0x9b, 0xe7, 0xd6,
+	0xb8, 0x9b, 0x52, 0xa1, 0xcb, 0x9c, 0xdd, 0x37, 0x0a, 0x60, 0x34, 0x3c, 0xa9, 0x7f, 0x59, 0x96,
+	0x20, 0x3c, 0xb3, 0x54, 0xf9, 0x12, 0xf1, 0xec, 0xce, 0x6a, 0x93, 0x94, 0xec, 0x19, 0x0d, 0xe3,
+	0xa0, 0x5d, 0x89, 0x05, 0x28, 0x7a, 0xc2, 0x50, 0xf5, 0x0f, 0x3d, 0x59, 0xce, 0xe1, 0xad, 0x42,
+	0x49, 0xa9, 0x80, 0x34, 0x3f, 0x38, 0xf4, 0x4c, 0xf6, 0x63, 0x01, 0xc6, 0x63, 0xbe, 0x94, 0xc6,
+	0x0e, 0x8c, 0xb6, 0x5c, 0xdf, 0x76, 0xac, 0xaa, 0x04, 0xd3, 0x69, 0x4c, 0x9f, 0x4d, 0xc7, 0x76,
+	0x2c, 0xe9, 0x2b, 0xb8, 0x47, 0x5a, 0x11, 0x0b, 0xde, 0x83, 0x31, 0x1a, 0x9a, 0x90, 0x46, 0x66,
+	0x79, 0x2d, 0x41, 0xb3, 0x23, 0x41, 0x11, 0x9e, 0xd1, 0x5a, 0xd4, 0x84, 0x77, 0x61, 0xc4, 0x37,
+	0xea, 0xf5, 0xc3, 0x90, 0xa6, 0x57, 0xd0, 0xa8, 0x09, 0x9a, 0x07, 0x01, 0x24, 0x42, 0x52, 0xf4,
+	0x3b, 0x06, 0x5c, 0x81, 0x01, 0x72, 0x96, 0xf3, 0x3a, 0x99, 0x9c, 0x26, 0x59, 0x00, 0x02, 0x31,
+	0x87, 0xea, 0x42, 0xd2, 0x72, 0xb7, 0x57, 0xec, 0x93, 0x52, 0xc8, 0xfd, 0x49, 0x61, 0x6f, 0xc1,
+	0x44, 0x3c, 0x1e, 0x1d, 0xc4, 0x75, 0x18, 0x24, 0x10, 0x1d, 0xc1, 0x95, 0xf4, 0xda, 0x55, 0x42,
+	0x18, 0xfb, 0x22, 0xce, 0xf4, 0xff, 0x4f, 0xc6, 0x13, 0x05, 0x26, 0x13, 0x0a, 0x28, 0x99, 0x35,
+	0x18, 0x22, 0x95, 0xe1, 0x7c, 0x74, 0xcb, 0xa6, 0x8d, 0x7b, 0x76, 0x53, 0xf2, 0x1a, 0x4c, 0x09,
+	0x55, 0xa2, 0x4b, 0x2a, 0x26, 0x6f, 0xd6, 0xfd, 0x0b, 0x2c, 0xc2, 0xd2, 0x59, 0xdf, 0xf6, 0x09,
+	0xf5, 0x8b, 0x3e, 0x2b, 0x29, 0xdd, 0x9b, 0x92, 0x5c, 0x24, 0x90, 0x6d, 0xc1, 0x6c, 0xec, 0xab,
+	0x1f, 0x7c, 0x14, 0xde, 0xf3, 0x02, 0x91, 0xb9, 0x0f, 0x8b, 0xd9, 0x30, 0xd7, 0x9d, 0x83, 0x94,
+	0xbd, 0x09, 0xc1, 0x38, 0x9a, 0x55, 0x57, 0xda, 0x49, 0x20, 0xeb, 0xb2, 0x46, 0xa2, 0x0c, 0xc5,
+	0x56, 0xe7, 0x81, 0x6d, 0x42, 0x59, 0x84, 0x7a, 0xd7, 0xe4, 0xdc, 0xb0, 0xcc, 0x2d, 0x83, 0x9b,
+	0xb5, 0xf8, 0xa7, 0x66, 0x0a, 0x06, 0x0f, 0xb8, 0x55, 0x6d, 0x36, 0xea, 0x74, 0xff, 0x18, 0x38,
+	0xe0, 0xd6, 0x07, 0x8d, 0x3a, 0xfb, 0x18, 0x66, 0xbb, 0xba, 0x92, 0xc8, 0xcd, 0xf6, 0x5c, 0x4a,
+	0x79, 0xf3, 0x09, 0x79, 0x29, 0xae, 0xe1, 0x8c, 0x6e, 0x27, 0x6a, 0xb0, 0x63, 0x7a, 0xa6, 0x53,
+	0x33, 0x9d, 0x7d, 0x3b, 0xff, 0x3e, 0x60, 0x16, 0xcc, 0x67, 0x90, 0x74, 0x6e, 0x59, 0xb5, 0x88,
+	0x5d, 0x34, 0x6f, 0x5f, 0x25, 0x66, 0xc3, 0x32, 0x40, 0xf8, 0xec, 0x07, 0x1f, 0xba, 0x00, 0x11,
+	0xb1, 0xac, 0x9d, 0x8e, 0x42, 0xbf, 0x88, 0x84, 0x5f, 0x29, 0x30, 0x12, 0xbd, 0xcc, 0xe1, 0x52,
+	0x22, 0xe7, 0x6e, 0x57, 0x41, 0x75, 0xf9, 0x7c, 0xa0, 0x54, 0xcc, 0x16, 0x1e, 0xff, 0xf5, 0xef,
+	0x0f, 0x85, 0x19, 0x9c, 0xd6, 0xe3, 0xb7, 0xd1, 0xe8, 0xc5, 0x10, 0xbf, 0x54, 0x60, 0x28, 0xcc,
+	0x1b, 0x17, 0xd2, 0xb8, 0x13, 0x57, 0x46, 0xf5, 0xc5, 0x6c, 0x10, 0x05, 0xd7, 0x44, 0xf0, 0x65,
+	0x5c, 0x4c, 0x04, 0x0f, 0xcb, 0xce, 0xf5, 0xa3, 0xc8, 0xa1, 0x1c, 0xe3, 0x67, 0x30, 0x1c, 0x72,
+	0x70, 0xcc, 0x0c, 0x11, 0x9e, 0xab, 0xfa, 0xd2, 0x39, 0x28, 0x52, 0x32, 0x27, 0x94, 0xa8, 0x58,
+	0xea, 0xa6, 0x04, 0xbf, 0x56, 0xa0, 0x2f, 0x68, 0x7d, 0x9c, 0x4d, 0x63, 0x8c, 0x5c, 0x7f, 0xd4,
+	0xb9, 0xee, 0x00, 0x8a, 0x76, 0x47, 0x44, 0xbb, 0x89, 0x37, 0xf2, 0xe5, 0xad, 0x8b, 0x7b, 0x80,
+	0x7e, 0x14, 0xfc, 0x69, 0x1c, 0xe3, 0x63, 0x05, 0xfa, 0x03, 0x3a, 0x8e, 0x5d, 0x23, 0xb5, 0xd3,
+	0x9f, 0xcf, 0x40, 0x90, 0x98, 0x1b, 0x42, 0x8c, 0x86, 0xaf, 0x5e, 0x44, 0x0c, 0x3a, 0x30, 0x40,
+	0x0b, 0x33, 0x35, 0x44, 0x6c, 0xee, 0x55, 0x96, 0x05, 0x21, 0x19, 0x33, 0x42, 0xc6, 0x14, 0x4e,
+	0x26, 0x65, 0xc8, 0x28, 0x3f, 0x29, 0x30, 0x48, 0x1f, 0x7d, 0x4c, 0xa5, 0x8b, 0x2f, 0x60, 0x75,
+	0x21, 0x13, 0x43, 0x31, 0xb7, 0x45, 0xcc, 0xd7, 0xf1, 0x76, 0xce, 0xd4, 0xc3, 0x65, 0xa3, 0x1f,
+	0xb5, 0x17, 0xf2, 0x31, 0x7e, 0xab, 0xc0, 0x10, 0x11, 0x73, 0xcc, 0x0a, 0xcb, 0x33, 0x87, 0x23,
+	0xb9, 0x04, 0xd9, 0x2d, 0x21, 0x6e, 0x15, 0xf5, 0x0b, 0x8a, 0xc3, 0x27, 0x0a, 0x14, 0x23, 0xdb,
+	0x04, 0x17, 0xd3, 0xc2, 0x9d, 0xdd, 0x6e, 0xea, 0xd2, 0xb9, 0xb8, 0x4b, 0x76, 0x8c, 0xd8, 0x66,
+	0xf8, 0xab, 0x02, 0xe3, 0x29, 0x3b, 0x04, 0xb5, 0xac, 0x09, 0x3d, 0xbb, 0xf2, 0x54, 0x3d, 0x37,
+	0x9e, 0xe4, 0xde, 0x16, 0x72, 0x37, 0x70, 0xfd, 0x02, 0x0d, 0x1e, 0xee, 0x42, 0xfc, 0x45, 0x01,
+	0x3c, 0xbb, 0x5a, 0x70, 0x25, 0x4d, 0x44, 0xd7, 0xc5, 0xa7, 0x6a, 0x79, 0xe1, 0x24, 0x79, 0x49,
+	0x48, 0x9e, 0xc7, 0xd9, 0xd4, 0x61, 0xd0, 0x8f, 0x68, 0x8b, 0x1e, 0xe3, 0xef, 0x0a, 0x4c, 0xa4,
+	0x6d, 0x24, 0xcc, 0xac, 0x52, 0xca, 0x02, 0x54, 0xaf, 0xe7, 0x77, 0xb8, 0x64, 0x5d, 0xa3, 0x5b,
+	0x70, 0x6b, 0xe3, 0x8f, 0x93, 0xb2, 0xf2, 0xf4, 0xa4, 0xac, 0xfc, 0x73, 0x52, 0x56, 0xbe, 0x3f,
+	0x2d, 0xf7, 0x3c, 0x3d, 0x2d, 0xf7, 0xfc, 0x7d, 0x5a, 0xee, 0xf9, 0x68, 0x5a, 0xb2, 0xf1, 0xda,
+	0x27, 0x9a, 0xed, 0xea, 0x9f, 0x0a, 0xd6, 0xe0, 0x3f, 0x13, 0x1e, 0xfc, 0xce, 0x32, 0x20, 0x7e,
+	0x06, 0x59, 0xff, 0x6f, 0x00, 0xee, 0xde, 0x84, 0x21, 0xb0, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1304,6 +1414,10 @@ type QueryClient interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// ProposalDependencies queries the proposals a proposal depends on and the
+	// proposals depending on it.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ProposalDependencies(ctx context.Context, in *QueryProposalDependenciesRequest, opts ...grpc.CallOption) (*QueryProposalDependenciesResponse, error)
 }
 
 type queryClient struct {
@@ -1413,6 +1527,15 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) ProposalDependencies(ctx context.Context, in *QueryProposalDependenciesRequest, opts ...grpc.CallOption) (*QueryProposalDependenciesResponse, error) {
+	out := new(QueryProposalDependenciesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ProposalDependencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1439,6 +1562,10 @@ type QueryServer interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// ProposalDependencies queries the proposals a proposal depends on and the
+	// proposals depending on it.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ProposalDependencies(context.Context, *QueryProposalDependenciesRequest) (*QueryProposalDependenciesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1478,6 +1605,9 @@ func (*UnimplementedQueryServer) ProposalVoteOptions(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) MessageBasedParams(ctx context.Context, req *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (*UnimplementedQueryServer) ProposalDependencies(ctx context.Context, req *QueryProposalDependenciesRequest) (*QueryProposalDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalDependencies not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1681,6 +1811,24 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ProposalDependencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalDependencies(ctx, req.(*QueryProposalDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1729,6 +1877,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "ProposalDependencies",
+			Handler:    _Query_ProposalDependencies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -2563,6 +2715,93 @@ func (m *QueryMessageBasedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposalDependenciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalDependenciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalDependenciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalDependenciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalDependenciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalDependenciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dependents) > 0 {
+		dAtA18 := make([]byte, len(m.Dependents)*10)
+		var j17 int
+		for _, num := range m.Dependents {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintQuery(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dependencies) > 0 {
+		dAtA20 := make([]byte, len(m.Dependencies)*10)
+		var j19 int
+		for _, num := range m.Dependencies {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintQuery(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2906,6 +3145,41 @@ func (m *QueryMessageBasedParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalDependenciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalDependenciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dependencies) > 0 {
+		l = 0
+		for _, e := range m.Dependencies {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.Dependents) > 0 {
+		l = 0
+		for _, e := range m.Dependents {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5066,6 +5340,277 @@ func (m *QueryMessageBasedParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalDependenciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalDependenciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalDependenciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalDependenciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalDependenciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalDependenciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Dependencies = append(m.Dependencies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Dependencies) == 0 {
+					m.Dependencies = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Dependencies = append(m.Dependencies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Dependents = append(m.Dependents, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Dependents) == 0 {
+					m.Dependents = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Dependents = append(m.Dependents, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependents", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
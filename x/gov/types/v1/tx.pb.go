@@ -69,6 +69,11 @@ type MsgSubmitProposal struct {
 	//
 	// Since: x/gov v1.0.0
 	ProposalType ProposalType `protobuf:"varint,8,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// dependencies are the ids of the proposals that must have passed and been
+	// executed before this proposal is executed.
+	//
+	// Since: x/gov v1.0.0
+	Dependencies []uint64 `protobuf:"varint,9,rep,packed,name=dependencies,proto3" json:"dependencies,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -161,6 +166,13 @@ func (m *MsgSubmitProposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *MsgSubmitProposal) GetDependencies() []uint64 {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -1223,93 +1235,94 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0x9d, 0x4c, 0xbe, 0x94, 0xad, 0x9b, 0x6c, 0xb6, 0xc5, 0x76, 0xb7, 0x40,
-	0xad, 0x94, 0xac, 0x71, 0xa0, 0x15, 0x84, 0x0a, 0x51, 0x87, 0x02, 0xad, 0x30, 0x54, 0xdb, 0x0f,
-	0x24, 0x84, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xef, 0xce, 0xca, 0x33, 0xb6, 0xe2, 0x1b, 0xe2,
-	0xd0, 0x43, 0x4f, 0x3d, 0xf7, 0x2f, 0x40, 0x3d, 0xe5, 0x90, 0x5b, 0x4f, 0xdc, 0xaa, 0x9e, 0x2a,
-	0x4e, 0x9c, 0x5a, 0xd4, 0x0a, 0x22, 0xc1, 0x1f, 0x01, 0x9a, 0xd9, 0xd9, 0xf1, 0x7e, 0xd9, 0x49,
-	0x8b, 0x84, 0xb8, 0xd8, 0x3b, 0xef, 0x6b, 0xde, 0xfb, 0xbd, 0x37, 0xef, 0x3d, 0xb0, 0xd2, 0x46,
-	0xd8, 0x45, 0xb8, 0x66, 0xa3, 0x7e, 0xad, 0x5f, 0xaf, 0x91, 0x3d, 0xdd, 0xef, 0x22, 0x82, 0xe4,
-	0x85, 0x80, 0xae, 0xdb, 0xa8, 0xaf, 0xf7, 0xeb, 0x6a, 0x89, 0x8b, 0xed, 0x98, 0x18, 0xd6, 0xfa,
-	0xf5, 0x1d, 0x48, 0xcc, 0x7a, 0xad, 0x8d, 0x1c, 0x2f, 0x10, 0x57, 0x57, 0xe3, 0x66, 0xa8, 0x56,
-	0xc0, 0x28, 0xda, 0xc8, 0x46, 0xec, 0xb3, 0x46, 0xbf, 0x38, 0x75, 0x2d, 0x10, 0x6f, 0x05, 0x0c,
-	0x7e, 0x15, 0x67, 0xd9, 0x08, 0xd9, 0x1d, 0x58, 0x63, 0xa7, 0x9d, 0xde, 0xf7, 0x35, 0xd3, 0x1b,
-	0x24, 0x2e, 0x71, 0xb1, 0x4d, 0x2f, 0x71, 0xb1, 0xcd, 0x19, 0xcb, 0xa6, 0xeb, 0x78, 0xa8, 0xc6,
-	0x7e, 0x39, 0xa9, 0x9c, 0x34, 0x43, 0x1c, 0x17, 0x62, 0x62, 0xba, 0x7e, 0x20, 0xa0, 0xfd, 0x95,
-	0x03, 0xcb, 0x4d, 0x6c, 0xdf, 0xe8, 0xed, 0xb8, 0x0e, 0xb9, 0xde, 0x45, 0x3e, 0xc2, 0x66, 0x47,
-	0x7e, 0x17, 0xcc, 0xb8, 0x10, 0x63, 0xd3, 0x86, 0x58, 0x91, 0x2a, 0xb9, 0xea, 0xdc, 0x66, 0x51,
-	0x0f, 0x2c, 0xe9, 0xa1, 0x25, 0xfd, 0xb2, 0x37, 0x30, 0x84, 0x94, 0x7c, 0x4f, 0x02, 0x4b, 0x8e,
-	0xe7, 0x10, 0xc7, 0xec, 0xb4, 0x2c, 0xe8, 0x23, 0xec, 0x10, 0x65, 0x92, 0x69, 0xae, 0xe9, 0x3c,
-	0x30, 0x0a, 0x9a, 0xce, 0x41, 0xd3, 0xb7, 0x91, 0xe3, 0x35, 0x3e, 0x7b, 0xfc, 0xac, 0x3c, 0xf1,
-	0xf0, 0x79, 0xb9, 0x6a, 0x3b, 0x64, 0xb7, 0xb7, 0xa3, 0xb7, 0x91, 0xcb, 0x51, 0xe0, 0x7f, 0x1b,
-	0xd8, 0xba, 0x53, 0x23, 0x03, 0x1f, 0x62, 0xa6, 0x80, 0x1f, 0x1c, 0xee, 0xaf, 0xcf, 0x77, 0xa0,
-	0x6d, 0xb6, 0x07, 0x2d, 0x0a, 0x3b, 0xfe, 0xe9, 0x70, 0x7f, 0x5d, 0x32, 0x16, 0xf9, 0xcd, 0x9f,
-	0x06, 0x17, 0xcb, 0xef, 0x83, 0x19, 0x9f, 0x85, 0x02, 0xbb, 0x4a, 0xae, 0x22, 0x55, 0x67, 0x1b,
-	0xca, 0x2f, 0x07, 0x1b, 0x45, 0xee, 0xc7, 0x65, 0xcb, 0xea, 0x42, 0x8c, 0x6f, 0x90, 0xae, 0xe3,
-	0xd9, 0x86, 0x90, 0x94, 0x55, 0x1a, 0x34, 0x31, 0x2d, 0x93, 0x98, 0xca, 0x14, 0xd5, 0x32, 0xc4,
-	0x59, 0x2e, 0x82, 0x69, 0xe2, 0x90, 0x0e, 0x54, 0xa6, 0x19, 0x23, 0x38, 0xc8, 0x0a, 0x28, 0xe0,
-	0x9e, 0xeb, 0x9a, 0xdd, 0x81, 0x92, 0x67, 0xf4, 0xf0, 0x28, 0x57, 0xc0, 0x2c, 0xdc, 0xf3, 0xa1,
-	0xe5, 0x10, 0x68, 0x29, 0x85, 0x8a, 0x54, 0x9d, 0x69, 0x4c, 0x2a, 0x92, 0x31, 0x24, 0xca, 0x9f,
-	0x80, 0x05, 0x9f, 0xc3, 0xdd, 0xa2, 0x11, 0x2a, 0x33, 0x15, 0xa9, 0xba, 0xb8, 0x79, 0x4a, 0x8f,
-	0x55, 0x9c, 0x1e, 0xa6, 0xe4, 0xe6, 0xc0, 0x87, 0xc6, 0xbc, 0x1f, 0x39, 0x6d, 0xd5, 0x7f, 0x3c,
-	0xdc, 0x5f, 0x17, 0xee, 0xdf, 0x3b, 0xdc, 0x5f, 0x2f, 0x47, 0x50, 0xeb, 0xd7, 0x6b, 0xa9, 0xbc,
-	0x6a, 0x97, 0xc0, 0x5a, 0x8a, 0x68, 0x40, 0xec, 0x23, 0x0f, 0x43, 0xb9, 0x0c, 0xe6, 0x84, 0x47,
-	0x8e, 0xa5, 0x48, 0x15, 0xa9, 0x3a, 0x65, 0x80, 0x90, 0x74, 0xd5, 0xd2, 0x1e, 0x49, 0xa0, 0xd8,
-	0xc4, 0xf6, 0x95, 0x3d, 0xd8, 0xfe, 0x92, 0xe5, 0x60, 0x1b, 0x79, 0x04, 0x7a, 0x44, 0xfe, 0x0a,
-	0x14, 0xda, 0xc1, 0x27, 0xd3, 0x1a, 0x51, 0x2d, 0x8d, 0xd2, 0x93, 0x83, 0x0d, 0x35, 0x16, 0x5e,
-	0x58, 0x0b, 0x4c, 0xd7, 0x08, 0x8d, 0xc8, 0xa7, 0xc1, 0xac, 0xd9, 0x23, 0xbb, 0xa8, 0xeb, 0x90,
-	0x81, 0x32, 0xc9, 0x90, 0x1d, 0x12, 0xb6, 0x2e, 0xd0, 0xb8, 0x87, 0x67, 0x1a, 0xb8, 0x96, 0x0a,
-	0x3c, 0xe5, 0xa4, 0x56, 0x02, 0xa7, 0xb3, 0xe8, 0x61, 0xf8, 0xda, 0xef, 0x12, 0x28, 0x34, 0xb1,
-	0x7d, 0x1b, 0x11, 0x28, 0x5f, 0xc8, 0x80, 0xa2, 0x51, 0xfc, 0xf3, 0x59, 0x39, 0x4a, 0x0e, 0x6a,
-	0x2f, 0x02, 0x90, 0xac, 0x83, 0xe9, 0x3e, 0x22, 0xb0, 0x1b, 0xf8, 0x3c, 0xa6, 0xe8, 0x02, 0x31,
-	0xb9, 0x0e, 0xf2, 0xc8, 0x27, 0x0e, 0xf2, 0x58, 0x95, 0x2e, 0x0e, 0x9f, 0x0a, 0x4f, 0x3e, 0xf5,
-	0xe5, 0x6b, 0x26, 0x60, 0x70, 0xc1, 0x71, 0x45, 0xba, 0xf5, 0x26, 0x05, 0x26, 0x30, 0x4d, 0x41,
-	0x39, 0x99, 0x02, 0x85, 0xda, 0xd3, 0x96, 0xc1, 0x12, 0xff, 0x14, 0xa1, 0xff, 0x2d, 0x09, 0xda,
-	0x37, 0xd0, 0xb1, 0x77, 0x69, 0x7d, 0xfe, 0x47, 0x10, 0x7c, 0x04, 0x0a, 0x41, 0x64, 0x58, 0xc9,
-	0xb1, 0x76, 0x71, 0x26, 0x81, 0x41, 0xe8, 0x50, 0x04, 0x8b, 0x50, 0x63, 0x2c, 0x18, 0xef, 0xc4,
-	0xc1, 0x78, 0x23, 0x13, 0x8c, 0xd0, 0xb8, 0xb6, 0x06, 0x56, 0x13, 0x24, 0x01, 0xce, 0x1f, 0x12,
-	0x00, 0x4d, 0x6c, 0x87, 0xbd, 0xe5, 0x35, 0x71, 0xb9, 0x08, 0x66, 0x79, 0x5b, 0x44, 0x47, 0x63,
-	0x33, 0x14, 0x95, 0x2f, 0x81, 0xbc, 0xe9, 0xa2, 0x9e, 0x47, 0x38, 0x3c, 0x63, 0xba, 0xe9, 0x2c,
-	0xed, 0xa6, 0xc1, 0xcd, 0x5c, 0x67, 0xeb, 0x3c, 0x7b, 0x2a, 0xc2, 0x1a, 0x05, 0x42, 0x49, 0x01,
-	0xc1, 0x23, 0xd3, 0x8a, 0x40, 0x1e, 0x9e, 0x44, 0xf8, 0x8f, 0x82, 0xda, 0xb8, 0xe5, 0x5b, 0x26,
-	0x81, 0xd7, 0xcd, 0xae, 0xe9, 0x62, 0x1a, 0xcc, 0xf0, 0x7d, 0x4a, 0x47, 0x05, 0x23, 0x44, 0xe5,
-	0x0f, 0x40, 0xde, 0x67, 0x16, 0x18, 0x02, 0x73, 0x9b, 0x27, 0x93, 0xcd, 0x8e, 0x31, 0x63, 0x81,
-	0x04, 0xf2, 0x5b, 0x17, 0xd3, 0x6f, 0xfe, 0x6c, 0x24, 0x90, 0xbd, 0x70, 0xe2, 0x26, 0x3c, 0xe5,
-	0x79, 0x8d, 0x92, 0x44, 0x60, 0xf7, 0x24, 0x36, 0xf9, 0xb6, 0x4d, 0xaf, 0x0d, 0x3b, 0x91, 0xc9,
-	0x97, 0x91, 0xde, 0xa5, 0x44, 0x7a, 0x63, 0x99, 0x8d, 0x0e, 0x9b, 0xc9, 0xe3, 0x0e, 0x9b, 0xad,
-	0x85, 0x58, 0xf3, 0xd6, 0x7e, 0x96, 0x58, 0x67, 0x8e, 0x3b, 0x23, 0x3a, 0xf3, 0xab, 0x3b, 0x75,
-	0x15, 0x2c, 0xb4, 0x99, 0x2d, 0x68, 0xb5, 0xe8, 0xc8, 0xe7, 0x80, 0xab, 0xa9, 0xbe, 0x7c, 0x33,
-	0xdc, 0x07, 0x1a, 0x33, 0x14, 0xf5, 0xfb, 0xcf, 0xcb, 0x92, 0x31, 0x1f, 0xaa, 0x52, 0xa6, 0x7c,
-	0x0e, 0x2c, 0x09, 0x53, 0xbb, 0xec, 0x71, 0xb0, 0x6e, 0x35, 0x65, 0x2c, 0x86, 0xe4, 0x2f, 0x18,
-	0x55, 0xbb, 0x9b, 0x03, 0x65, 0x31, 0x5d, 0x9a, 0xbd, 0x0e, 0x71, 0xfc, 0x0e, 0xdc, 0xde, 0x45,
-	0x4e, 0x1b, 0x0a, 0x78, 0xb3, 0xd6, 0x04, 0xe9, 0xff, 0xb0, 0x26, 0x4c, 0xbe, 0xd6, 0x9a, 0x90,
-	0x1b, 0xb5, 0x26, 0x4c, 0x8d, 0x58, 0x13, 0xa6, 0xe3, 0x6b, 0xc2, 0x15, 0x30, 0x4f, 0x3b, 0x54,
-	0x2b, 0x6c, 0x81, 0x79, 0x96, 0x25, 0x6d, 0xc4, 0x0e, 0x30, 0x6c, 0x81, 0xd8, 0x98, 0xeb, 0x0f,
-	0x0f, 0xc9, 0x62, 0xba, 0x06, 0xce, 0x1d, 0x91, 0x87, 0xe3, 0xcf, 0xfc, 0x03, 0x09, 0xac, 0x88,
-	0x17, 0xd4, 0x0c, 0xb6, 0xbd, 0x7f, 0xd9, 0x05, 0x56, 0x41, 0xc1, 0xc5, 0x76, 0xab, 0xd7, 0xed,
-	0xf0, 0xd9, 0x9e, 0x77, 0xb1, 0x7d, 0xab, 0xdb, 0x91, 0x3f, 0x14, 0xed, 0x21, 0xc7, 0x70, 0x48,
-	0x8e, 0x02, 0x7e, 0x7d, 0xc3, 0xc4, 0xd0, 0xe2, 0x8f, 0x39, 0xec, 0x0f, 0x8b, 0xf1, 0xfe, 0xa0,
-	0x55, 0x40, 0x29, 0xdb, 0x6b, 0xf1, 0xfc, 0x1f, 0x48, 0x60, 0x8e, 0xa1, 0x64, 0x21, 0xba, 0x13,
-	0xbc, 0x76, 0x34, 0xdb, 0x20, 0xe7, 0x62, 0x9b, 0xbf, 0xaf, 0xec, 0xbd, 0xe7, 0xd4, 0x93, 0x83,
-	0x8d, 0xd5, 0xac, 0xea, 0x6e, 0x62, 0xdb, 0xa0, 0xda, 0x29, 0xf7, 0x37, 0xc0, 0x89, 0x88, 0x6f,
-	0x22, 0x5b, 0x2b, 0x20, 0xdf, 0x85, 0xb8, 0xd7, 0x09, 0xd6, 0xac, 0x79, 0x83, 0x9f, 0x36, 0x1f,
-	0x16, 0x40, 0xae, 0x89, 0x6d, 0xf9, 0x3b, 0xb0, 0x98, 0x58, 0xe4, 0x2b, 0x49, 0x08, 0x93, 0xdb,
-	0x9f, 0x5a, 0x3d, 0x4a, 0x42, 0xdc, 0x0e, 0xc1, 0x72, 0x7a, 0xf5, 0x3b, 0x9b, 0x56, 0x4f, 0x09,
-	0xa9, 0xe7, 0x8f, 0x21, 0x24, 0xae, 0xf9, 0x18, 0x4c, 0xb1, 0x1d, 0x6c, 0x25, 0xad, 0x44, 0xe9,
-	0x6a, 0x29, 0x9b, 0x2e, 0xf4, 0x6f, 0x83, 0xf9, 0xd8, 0x22, 0x33, 0x42, 0x3e, 0xe4, 0xab, 0x6f,
-	0x8f, 0xe7, 0x0b, 0xbb, 0x9f, 0x83, 0x42, 0xd8, 0x38, 0xd6, 0xd2, 0x2a, 0x9c, 0xa5, 0x9e, 0x19,
-	0xc9, 0x8a, 0x3a, 0x18, 0x9b, 0xa6, 0x19, 0x0e, 0x46, 0xf9, 0x59, 0x0e, 0x66, 0x0d, 0x34, 0x9a,
-	0xfd, 0xc4, 0x30, 0xcb, 0xc8, 0x7e, 0x5c, 0x22, 0x2b, 0xfb, 0x23, 0x66, 0xd0, 0x5d, 0x09, 0x9c,
-	0x1e, 0xdb, 0xda, 0xf5, 0x51, 0x85, 0x94, 0x2d, 0xaf, 0x5e, 0x7c, 0x35, 0x79, 0xe1, 0xc8, 0x1d,
-	0x70, 0x22, 0xab, 0x1b, 0xbd, 0x35, 0x0a, 0xa5, 0x98, 0x98, 0xba, 0x71, 0x2c, 0x31, 0x71, 0xd9,
-	0x35, 0x30, 0x23, 0x3a, 0x84, 0x9a, 0xe5, 0x70, 0xc0, 0x53, 0xb5, 0xd1, 0xbc, 0xd0, 0x96, 0x3a,
-	0xfd, 0x03, 0x9d, 0x42, 0x8d, 0x0b, 0x8f, 0x5f, 0x94, 0xa4, 0xa7, 0x2f, 0x4a, 0xd2, 0x6f, 0x2f,
-	0x4a, 0xd2, 0xfd, 0x97, 0xa5, 0x89, 0xa7, 0x2f, 0x4b, 0x13, 0xbf, 0xbe, 0x2c, 0x4d, 0x7c, 0x7b,
-	0x2a, 0xb0, 0x81, 0xad, 0x3b, 0xba, 0x83, 0xf8, 0x4e, 0xc3, 0xa6, 0x5a, 0xad, 0x5f, 0xdf, 0xc9,
-	0xb3, 0x96, 0xf2, 0xde, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x5a, 0x98, 0xb8, 0xaa, 0x10,
-	0x00, 0x00,
+	// 1383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x25, 0x59, 0xb2, 0xd7, 0xb2, 0x0c, 0x33, 0x8a, 0x4d, 0x33, 0x79, 0x92, 0xc2, 0xbc,
+	0x97, 0x08, 0xce, 0x33, 0x55, 0xb9, 0x4d, 0xd0, 0xba, 0x41, 0xd1, 0xc8, 0x4d, 0xdb, 0x04, 0x55,
+	0x1b, 0x30, 0x7f, 0x0a, 0x14, 0x01, 0x04, 0x5a, 0xdc, 0xd2, 0x44, 0x44, 0x2e, 0xa1, 0x5d, 0x09,
+	0xd6, 0xad, 0xe8, 0x21, 0x87, 0x5c, 0x9a, 0x73, 0x3e, 0x41, 0x91, 0x93, 0x0f, 0xbe, 0xe5, 0xd4,
+	0x5b, 0x90, 0x53, 0xd0, 0x53, 0x4f, 0x49, 0x91, 0xa0, 0x35, 0xd0, 0x2f, 0xd1, 0x62, 0x97, 0xcb,
+	0x95, 0x28, 0x52, 0xb2, 0x93, 0x02, 0x45, 0x2f, 0x36, 0x77, 0xfe, 0xed, 0xcc, 0x6f, 0x66, 0x67,
+	0x06, 0x02, 0x2b, 0x6d, 0x84, 0x5d, 0x84, 0x6b, 0x36, 0xea, 0xd7, 0xfa, 0xf5, 0x1a, 0xd9, 0xd3,
+	0xfd, 0x2e, 0x22, 0x48, 0x5e, 0x0c, 0xe8, 0xba, 0x8d, 0xfa, 0x7a, 0xbf, 0xae, 0x96, 0xb8, 0xd8,
+	0x8e, 0x89, 0x61, 0xad, 0x5f, 0xdf, 0x81, 0xc4, 0xac, 0xd7, 0xda, 0xc8, 0xf1, 0x02, 0x71, 0x75,
+	0x35, 0x6a, 0x86, 0x6a, 0x05, 0x8c, 0xa2, 0x8d, 0x6c, 0xc4, 0x3e, 0x6b, 0xf4, 0x8b, 0x53, 0xd7,
+	0x02, 0xf1, 0x56, 0xc0, 0xe0, 0x57, 0x71, 0x96, 0x8d, 0x90, 0xdd, 0x81, 0x35, 0x76, 0xda, 0xe9,
+	0x7d, 0x5b, 0x33, 0xbd, 0xc1, 0xd8, 0x25, 0x2e, 0xb6, 0xe9, 0x25, 0x2e, 0xb6, 0x39, 0x63, 0xd9,
+	0x74, 0x1d, 0x0f, 0xd5, 0xd8, 0x5f, 0x4e, 0x2a, 0x8f, 0x9b, 0x21, 0x8e, 0x0b, 0x31, 0x31, 0x5d,
+	0x3f, 0x10, 0xd0, 0x7e, 0xc8, 0x80, 0xe5, 0x26, 0xb6, 0x6f, 0xf6, 0x76, 0x5c, 0x87, 0xdc, 0xe8,
+	0x22, 0x1f, 0x61, 0xb3, 0x23, 0xbf, 0x03, 0xe6, 0x5c, 0x88, 0xb1, 0x69, 0x43, 0xac, 0x48, 0x95,
+	0x74, 0x75, 0x61, 0xb3, 0xa8, 0x07, 0x96, 0xf4, 0xd0, 0x92, 0x7e, 0xc5, 0x1b, 0x18, 0x42, 0x4a,
+	0x7e, 0x20, 0x81, 0x25, 0xc7, 0x73, 0x88, 0x63, 0x76, 0x5a, 0x16, 0xf4, 0x11, 0x76, 0x88, 0x92,
+	0x62, 0x9a, 0x6b, 0x3a, 0x0f, 0x8c, 0x82, 0xa6, 0x73, 0xd0, 0xf4, 0x6d, 0xe4, 0x78, 0x8d, 0x4f,
+	0x9f, 0xbe, 0x28, 0xcf, 0x3c, 0x7e, 0x59, 0xae, 0xda, 0x0e, 0xd9, 0xed, 0xed, 0xe8, 0x6d, 0xe4,
+	0x72, 0x14, 0xf8, 0xbf, 0x0d, 0x6c, 0xdd, 0xab, 0x91, 0x81, 0x0f, 0x31, 0x53, 0xc0, 0x8f, 0x0e,
+	0xf7, 0xd7, 0xf3, 0x1d, 0x68, 0x9b, 0xed, 0x41, 0x8b, 0xc2, 0x8e, 0x7f, 0x3c, 0xdc, 0x5f, 0x97,
+	0x8c, 0x02, 0xbf, 0xf9, 0x93, 0xe0, 0x62, 0xf9, 0x3d, 0x30, 0xe7, 0xb3, 0x50, 0x60, 0x57, 0x49,
+	0x57, 0xa4, 0xea, 0x7c, 0x43, 0xf9, 0xf9, 0x60, 0xa3, 0xc8, 0xfd, 0xb8, 0x62, 0x59, 0x5d, 0x88,
+	0xf1, 0x4d, 0xd2, 0x75, 0x3c, 0xdb, 0x10, 0x92, 0xb2, 0x4a, 0x83, 0x26, 0xa6, 0x65, 0x12, 0x53,
+	0xc9, 0x50, 0x2d, 0x43, 0x9c, 0xe5, 0x22, 0x98, 0x25, 0x0e, 0xe9, 0x40, 0x65, 0x96, 0x31, 0x82,
+	0x83, 0xac, 0x80, 0x1c, 0xee, 0xb9, 0xae, 0xd9, 0x1d, 0x28, 0x59, 0x46, 0x0f, 0x8f, 0x72, 0x05,
+	0xcc, 0xc3, 0x3d, 0x1f, 0x5a, 0x0e, 0x81, 0x96, 0x92, 0xab, 0x48, 0xd5, 0xb9, 0x46, 0x4a, 0x91,
+	0x8c, 0x21, 0x51, 0xfe, 0x18, 0x2c, 0xfa, 0x1c, 0xee, 0x16, 0x8d, 0x50, 0x99, 0xab, 0x48, 0xd5,
+	0xc2, 0xe6, 0x29, 0x3d, 0x52, 0x71, 0x7a, 0x98, 0x92, 0x5b, 0x03, 0x1f, 0x1a, 0x79, 0x7f, 0xe4,
+	0x24, 0x6b, 0x20, 0x6f, 0x41, 0x1f, 0x7a, 0x16, 0xf4, 0xda, 0x0e, 0xc4, 0xca, 0x7c, 0x25, 0x5d,
+	0xcd, 0x18, 0x11, 0xda, 0x56, 0xfd, 0xfb, 0xc3, 0xfd, 0x75, 0x11, 0xe2, 0x83, 0xc3, 0xfd, 0xf5,
+	0xf2, 0x08, 0xb2, 0xfd, 0x7a, 0x2d, 0x96, 0x7b, 0xed, 0x32, 0x58, 0x8b, 0x11, 0x0d, 0x88, 0x7d,
+	0xe4, 0x61, 0x28, 0x97, 0xc1, 0x82, 0xf0, 0xda, 0xb1, 0x14, 0xa9, 0x22, 0x55, 0x33, 0x06, 0x08,
+	0x49, 0xd7, 0x2c, 0xed, 0x89, 0x04, 0x8a, 0x4d, 0x6c, 0x5f, 0xdd, 0x83, 0xed, 0x2f, 0x58, 0x9e,
+	0xb6, 0x91, 0x47, 0xa0, 0x47, 0xe4, 0x2f, 0x41, 0xae, 0x1d, 0x7c, 0x32, 0xad, 0x09, 0x15, 0xd5,
+	0x28, 0x3d, 0x3b, 0xd8, 0x50, 0x23, 0x10, 0x84, 0xf5, 0xc2, 0x74, 0x8d, 0xd0, 0x88, 0x7c, 0x1a,
+	0xcc, 0x9b, 0x3d, 0xb2, 0x8b, 0xba, 0x0e, 0x19, 0x28, 0x29, 0x86, 0xfe, 0x90, 0xb0, 0x75, 0x91,
+	0xc6, 0x3d, 0x3c, 0xd3, 0xc0, 0xb5, 0x58, 0xe0, 0x31, 0x27, 0xb5, 0x12, 0x38, 0x9d, 0x44, 0x0f,
+	0xc3, 0xd7, 0x7e, 0x93, 0x40, 0xae, 0x89, 0xed, 0x3b, 0x88, 0x40, 0xf9, 0x62, 0x02, 0x14, 0x8d,
+	0xe2, 0x1f, 0x2f, 0xca, 0xa3, 0xe4, 0xa0, 0x3e, 0x47, 0x00, 0x92, 0x75, 0x30, 0xdb, 0x47, 0x04,
+	0x76, 0x95, 0xd4, 0x11, 0x85, 0x19, 0x88, 0xc9, 0x75, 0x90, 0x45, 0x3e, 0x71, 0x90, 0xc7, 0x2a,
+	0xb9, 0x30, 0x7c, 0x4e, 0xbc, 0x40, 0xa8, 0x2f, 0x5f, 0x31, 0x01, 0x83, 0x0b, 0x4e, 0x2b, 0xe4,
+	0xad, 0xff, 0x52, 0x60, 0x02, 0xd3, 0x14, 0x94, 0x93, 0x31, 0x50, 0xa8, 0x3d, 0x6d, 0x19, 0x2c,
+	0xf1, 0x4f, 0x11, 0xfa, 0x9f, 0x92, 0xa0, 0x7d, 0x0d, 0x1d, 0x7b, 0x97, 0xd6, 0xf0, 0x3f, 0x04,
+	0xc1, 0x87, 0x20, 0x17, 0x44, 0x86, 0x95, 0x34, 0x6b, 0x29, 0x67, 0xc6, 0x30, 0x08, 0x1d, 0x1a,
+	0xc1, 0x22, 0xd4, 0x98, 0x0a, 0xc6, 0xff, 0xa3, 0x60, 0xfc, 0x27, 0x11, 0x8c, 0xd0, 0xb8, 0xb6,
+	0x06, 0x56, 0xc7, 0x48, 0x02, 0x9c, 0xdf, 0x25, 0x00, 0x9a, 0xd8, 0x0e, 0xfb, 0xcf, 0x5b, 0xe2,
+	0x72, 0x09, 0xcc, 0xf3, 0xd6, 0x89, 0x8e, 0xc6, 0x66, 0x28, 0x2a, 0x5f, 0x06, 0x59, 0xd3, 0x45,
+	0x3d, 0x8f, 0x70, 0x78, 0xa6, 0x74, 0xdc, 0x79, 0xda, 0x71, 0x83, 0x9b, 0xb9, 0xce, 0xd6, 0x05,
+	0xf6, 0x54, 0x84, 0x35, 0x0a, 0x84, 0x12, 0x03, 0x82, 0x47, 0xa6, 0x15, 0x81, 0x3c, 0x3c, 0x89,
+	0xf0, 0x9f, 0x04, 0xb5, 0x71, 0xdb, 0xb7, 0x4c, 0x02, 0x6f, 0x98, 0x5d, 0xd3, 0xc5, 0x34, 0x98,
+	0xe1, 0xfb, 0x94, 0x8e, 0x0a, 0x46, 0x88, 0xca, 0xef, 0x83, 0xac, 0xcf, 0x2c, 0x30, 0x04, 0x16,
+	0x36, 0x4f, 0x8e, 0x37, 0x44, 0xc6, 0x8c, 0x04, 0x12, 0xc8, 0x6f, 0x5d, 0x8a, 0xbf, 0xf9, 0xb3,
+	0x23, 0x81, 0xec, 0x85, 0x53, 0x79, 0xcc, 0x53, 0x9e, 0xd7, 0x51, 0x92, 0x08, 0xec, 0x81, 0xc4,
+	0xa6, 0xe3, 0xb6, 0xe9, 0xb5, 0x61, 0x67, 0x64, 0x3a, 0x26, 0xa4, 0x77, 0x69, 0x2c, 0xbd, 0x91,
+	0xcc, 0x8e, 0x0e, 0xa4, 0xd4, 0x71, 0x07, 0xd2, 0xd6, 0x62, 0xa4, 0x79, 0x6b, 0x3f, 0x49, 0x60,
+	0x2d, 0xe6, 0x8c, 0xe8, 0xcc, 0x6f, 0xee, 0xd4, 0x35, 0xb0, 0xd8, 0x66, 0xb6, 0xa0, 0xd5, 0xa2,
+	0x6b, 0x01, 0x07, 0x5c, 0x8d, 0xf5, 0xe5, 0x5b, 0xe1, 0xce, 0xd0, 0x98, 0xa3, 0xa8, 0x3f, 0x7c,
+	0x59, 0x96, 0x8c, 0x7c, 0xa8, 0x4a, 0x99, 0xf2, 0x79, 0xb0, 0x24, 0x4c, 0xed, 0xb2, 0xc7, 0xc1,
+	0xba, 0x55, 0xc6, 0x28, 0x84, 0xe4, 0xcf, 0x19, 0x55, 0xbb, 0x9f, 0x06, 0x65, 0x31, 0x5d, 0x9a,
+	0xbd, 0x0e, 0x71, 0xfc, 0x0e, 0xdc, 0xde, 0x45, 0x4e, 0x1b, 0x0a, 0x78, 0x93, 0x56, 0x09, 0xe9,
+	0xdf, 0xb0, 0x4a, 0xa4, 0xde, 0x6a, 0x95, 0x48, 0x4f, 0x5a, 0x25, 0x32, 0x13, 0x56, 0x89, 0xd9,
+	0xe8, 0x2a, 0x71, 0x15, 0xe4, 0x69, 0x87, 0x6a, 0x85, 0x2d, 0x30, 0xcb, 0xb2, 0xa4, 0x4d, 0xd8,
+	0x13, 0x86, 0x2d, 0x10, 0x1b, 0x0b, 0xfd, 0xe1, 0x61, 0xbc, 0x98, 0xae, 0x83, 0xf3, 0x47, 0xe4,
+	0xe1, 0xf8, 0x33, 0xff, 0x40, 0x02, 0x2b, 0xe2, 0x05, 0x35, 0x83, 0x8d, 0xf0, 0x6f, 0x76, 0x81,
+	0x55, 0x90, 0x73, 0xb1, 0xdd, 0xea, 0x75, 0x3b, 0x7c, 0xb6, 0x67, 0x5d, 0x6c, 0xdf, 0xee, 0x76,
+	0xe4, 0x0f, 0x44, 0x7b, 0x48, 0x57, 0xa4, 0x84, 0x51, 0xc0, 0xaf, 0x6f, 0x98, 0x18, 0x5a, 0xfc,
+	0x31, 0x87, 0xfd, 0xa1, 0x10, 0xed, 0x0f, 0x5a, 0x05, 0x94, 0x92, 0xbd, 0x16, 0xcf, 0xff, 0x91,
+	0x04, 0x16, 0x18, 0x4a, 0x16, 0xa2, 0x3b, 0xc1, 0x5b, 0x47, 0xb3, 0x0d, 0xd2, 0x2e, 0xb6, 0x95,
+	0xd4, 0x94, 0xbd, 0xe7, 0xd4, 0xb3, 0x83, 0x8d, 0xd5, 0xa4, 0xea, 0x6e, 0x62, 0xdb, 0xa0, 0xda,
+	0x31, 0xf7, 0x37, 0xc0, 0x89, 0x11, 0xdf, 0x44, 0xb6, 0x56, 0x40, 0xb6, 0x0b, 0x71, 0xaf, 0x13,
+	0xac, 0x59, 0x79, 0x83, 0x9f, 0x36, 0x1f, 0xe7, 0x40, 0xba, 0x89, 0x6d, 0xf9, 0x2e, 0x28, 0x8c,
+	0x2d, 0xfb, 0x95, 0x71, 0x08, 0xc7, 0xb7, 0x3f, 0xb5, 0x7a, 0x94, 0x84, 0xb8, 0x1d, 0x82, 0xe5,
+	0xf8, 0xea, 0x77, 0x36, 0xae, 0x1e, 0x13, 0x52, 0x2f, 0x1c, 0x43, 0x48, 0x5c, 0xf3, 0x11, 0xc8,
+	0xb0, 0x1d, 0x6c, 0x25, 0xae, 0x44, 0xe9, 0x6a, 0x29, 0x99, 0x2e, 0xf4, 0xef, 0x80, 0x7c, 0x64,
+	0x91, 0x99, 0x20, 0x1f, 0xf2, 0xd5, 0x73, 0xd3, 0xf9, 0xc2, 0xee, 0x67, 0x20, 0x17, 0x36, 0x8e,
+	0xb5, 0xb8, 0x0a, 0x67, 0xa9, 0x67, 0x26, 0xb2, 0x46, 0x1d, 0x8c, 0x4c, 0xd3, 0x04, 0x07, 0x47,
+	0xf9, 0xea, 0xb9, 0xe9, 0x7c, 0x61, 0xf7, 0x2e, 0x28, 0x8c, 0x0d, 0xb3, 0x84, 0xec, 0x47, 0x25,
+	0xd4, 0xea, 0x51, 0x12, 0xc2, 0xfa, 0x7d, 0x09, 0x9c, 0x9e, 0xda, 0xda, 0xf5, 0x49, 0x85, 0x94,
+	0x2c, 0xaf, 0x5e, 0x7a, 0x33, 0x79, 0xe1, 0xc8, 0x3d, 0x70, 0x22, 0xa9, 0x1b, 0xfd, 0x6f, 0x12,
+	0x4a, 0x11, 0x31, 0x75, 0xe3, 0x58, 0x62, 0xe2, 0xb2, 0xeb, 0x60, 0x4e, 0x74, 0x08, 0x35, 0xc9,
+	0xe1, 0x80, 0xa7, 0x6a, 0x93, 0x79, 0xa1, 0x2d, 0x75, 0xf6, 0x3b, 0x3a, 0x85, 0x1a, 0x17, 0x9f,
+	0xbe, 0x2a, 0x49, 0xcf, 0x5f, 0x95, 0xa4, 0x5f, 0x5f, 0x95, 0xa4, 0x87, 0xaf, 0x4b, 0x33, 0xcf,
+	0x5f, 0x97, 0x66, 0x7e, 0x79, 0x5d, 0x9a, 0xf9, 0xe6, 0x54, 0x60, 0x03, 0x5b, 0xf7, 0x74, 0x07,
+	0xf1, 0x9d, 0x86, 0x4d, 0x35, 0xfa, 0x73, 0x44, 0x96, 0xb5, 0x94, 0x77, 0xff, 0x1a, 0x00, 0xa0,
+	0x32, 0x99, 0x66, 0xce, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1782,6 +1795,24 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Dependencies) > 0 {
+		dAtA2 := make([]byte, len(m.Dependencies)*10)
+		var j1 int
+		for _, num := range m.Dependencies {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -2293,12 +2324,12 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x18
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if m.ProposalId != 0 {
@@ -2609,6 +2640,13 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 1 + sovTx(uint64(m.ProposalType))
 	}
+	if len(m.Dependencies) > 0 {
+		l = 0
+		for _, e := range m.Dependencies {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -3182,6 +3220,82 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Dependencies = append(m.Dependencies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Dependencies) == 0 {
+					m.Dependencies = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Dependencies = append(m.Dependencies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
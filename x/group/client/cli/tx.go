@@ -39,6 +39,7 @@ func TxCmd(name string) *cobra.Command {
 		MsgUpdateGroupMembersCmd(),
 		MsgCreateGroupWithPolicyCmd(),
 		MsgCreateGroupPolicyCmd(),
+		MsgMigrateMultisigToGroupPolicyCmd(),
 		MsgUpdateGroupPolicyDecisionPolicyCmd(),
 		MsgSubmitProposalCmd(),
 		NewCmdDraftProposal(),
@@ -289,6 +290,73 @@ and policy.json contains:
 	return cmd
 }
 
+// MsgMigrateMultisigToGroupPolicyCmd creates a CLI command for Msg/MigrateMultisigToGroupPolicy.
+func MsgMigrateMultisigToGroupPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-multisig-to-group-policy [multisig-address] [group-metadata] [group-policy-metadata] [members-json-file] [decision-policy-json-file]",
+		Short: "Turn a legacy multisig account into a group policy account at the same address.",
+		Long: `Turn a legacy threshold multisig account into a group policy account at the same address.
+A new group is created with the given members, and the multisig account becomes the policy of this group
+with the given decision policy. The account administers both the group and the group policy.
+Once migrated, the keys of the multisig can no longer sign for the account, which is controlled through
+group proposals only. The transaction must be signed by the multisig threshold, usually by generating it
+with --generate-only and assembling the signatures with 'tx multi-sign'.
+Note, the '--from' flag is ignored as it is implied from [multisig-address].
+Members and decision policy are given through JSON files, as for create-group-with-policy.`,
+		Example: fmt.Sprintf(`
+%s tx group migrate-multisig-to-group-policy [multisig-address] [group-metadata] [group-policy-metadata] members.json policy.json --generate-only
+`, version.AppName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[3])
+			if err != nil {
+				return err
+			}
+
+			for _, member := range members {
+				if _, err := math.NewPositiveDecFromString(member.Weight); err != nil {
+					return fmt.Errorf("invalid weight %s for %s: weight must be positive", member.Weight, member.Address)
+				}
+			}
+
+			policy, err := parseDecisionPolicy(clientCtx.Codec, args[4])
+			if err != nil {
+				return err
+			}
+
+			if err := policy.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := group.NewMsgMigrateMultisigToGroupPolicy(
+				clientCtx.GetFromAddress().String(),
+				members,
+				args[1],
+				args[2],
+				policy,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgCreateGroupPolicyCmd creates a CLI command for Msg/CreateGroupPolicy.
 //
 // This command is being handled better here, not converting to autocli
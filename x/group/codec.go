@@ -30,7 +30,9 @@ func RegisterLegacyAminoCodec(cdc *codectypes.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawProposal{}, "cosmos-sdk/group/MsgWithdrawProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/group/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/group/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgRetryExec{}, "cosmos-sdk/group/MsgRetryExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgMigrateMultisigToGroupPolicy{}, "cosmos-sdk/group/MsgMigrateMultisig")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -49,7 +51,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgWithdrawProposal{},
 		&MsgVote{},
 		&MsgExec{},
+		&MsgRetryExec{},
 		&MsgLeaveGroup{},
+		&MsgMigrateMultisigToGroupPolicy{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -20,6 +20,11 @@ type Config struct {
 	// summary field
 	// Defaults to 10200 if not explicitly set.
 	MaxProposalSummaryLen uint64
+
+	// RetryExecWindow defines the max duration after a failed execution of a
+	// proposal that members can retry its execution.
+	// Defaults to MaxExecutionPeriod if not explicitly set.
+	RetryExecWindow time.Duration
 }
 
 // DefaultConfig returns the default config for group.
@@ -29,5 +34,6 @@ func DefaultConfig() Config {
 		MaxMetadataLen:        255,
 		MaxProposalTitleLen:   255,
 		MaxProposalSummaryLen: 10200,
+		RetryExecWindow:       2 * time.Hour * 24 * 7, // Two weeks.
 	}
 }
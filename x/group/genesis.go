@@ -79,6 +79,18 @@ func (s GenesisState) Validate() error {
 			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, f := range s.ProposalExecFailures {
+
+		if err := f.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "ProposalExecFailure validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[f.ProposalId]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", f.ProposalId))
+		}
+	}
 	return nil
 }
 
@@ -705,6 +705,35 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"exec failure of proposal that doesn't exist",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				ProposalExecFailures: []*ProposalExecFailure{
+					{
+						ProposalId: 2,
+						Reason:     "failed",
+						FailedAt:   submittedAt,
+						Attempts:   1,
+					},
+				},
+			},
+			true,
+		},
 		{
 			"invalid vote option",
 			GenesisState{
@@ -41,6 +41,10 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 		return errors.Wrap(err, "votes")
 	}
 
+	if err := k.proposalExecFailureTable.Import(store, genesisState.ProposalExecFailures, 0); err != nil {
+		return errors.Wrap(err, "proposal exec failures")
+	}
+
 	return nil
 }
 
@@ -89,5 +93,12 @@ func (k Keeper) ExportGenesis(ctx context.Context, _ codec.JSONCodec) (*group.Ge
 	}
 	genesisState.Votes = votes
 
+	var proposalExecFailures []*group.ProposalExecFailure
+	_, err = k.proposalExecFailureTable.Export(store, &proposalExecFailures)
+	if err != nil {
+		return nil, errors.Wrap(err, "proposal exec failures")
+	}
+	genesisState.ProposalExecFailures = proposalExecFailures
+
 	return genesisState, nil
 }
@@ -137,6 +137,9 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		ProposalSeq:    1,
 		Proposals:      []*group.Proposal{proposal},
 		Votes:          []*group.Vote{{ProposalId: proposal.Id, Voter: memberAddr.String(), SubmitTime: submittedAt, Option: group.VOTE_OPTION_YES}},
+		ProposalExecFailures: []*group.ProposalExecFailure{
+			{ProposalId: proposal.Id, Reason: "insufficient funds", Codespace: "sdk", Code: 5, Height: 1, FailedAt: submittedAt, Attempts: 1},
+		},
 	}
 	genesisBytes, err := cdc.MarshalJSON(genesisState)
 	s.Require().NoError(err)
@@ -212,6 +215,7 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.assertProposalsEqual(g, res)
 	}
 	s.Require().Equal(genesisState.Votes, exportedGenesisState.Votes)
+	s.Require().Equal(genesisState.ProposalExecFailures, exportedGenesisState.ProposalExecFailures)
 
 	s.Require().Equal(genesisState.GroupSeq, exportedGenesisState.GroupSeq)
 	s.Require().Equal(genesisState.GroupPolicySeq, exportedGenesisState.GroupPolicySeq)
@@ -371,3 +371,46 @@ func (k Keeper) Groups(ctx context.Context, request *group.QueryGroupsRequest) (
 		Pagination: pageRes,
 	}, nil
 }
+
+// ProposalExecFailure queries why the execution of a proposal failed.
+func (k Keeper) ProposalExecFailure(ctx context.Context, request *group.QueryProposalExecFailureRequest) (*group.QueryProposalExecFailureResponse, error) {
+	if request.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	failure, err := k.getProposalExecFailure(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalExecFailureResponse{Failure: &failure}, nil
+}
+
+// ProposalExecFailures queries the execution failures of all proposals.
+func (k Keeper) ProposalExecFailures(ctx context.Context, request *group.QueryProposalExecFailuresRequest) (*group.QueryProposalExecFailuresResponse, error) {
+	it, err := k.proposalExecFailureTable.PrefixScan(k.environment.KVStoreService.OpenKVStore(ctx), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var failures []*group.ProposalExecFailure
+	pageRes, err := orm.Paginate(it, request.Pagination, &failures)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalExecFailuresResponse{
+		Failures:   failures,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) getProposalExecFailure(ctx context.Context, proposalID uint64) (group.ProposalExecFailure, error) {
+	var f group.ProposalExecFailure
+	key := orm.PrimaryKey(&group.ProposalExecFailure{ProposalId: proposalID})
+	if err := k.proposalExecFailureTable.GetOne(k.environment.KVStoreService.OpenKVStore(ctx), key, &f); err != nil {
+		return group.ProposalExecFailure{}, errorsmod.Wrap(err, "load proposal exec failure")
+	}
+	return f, nil
+}
@@ -43,6 +43,9 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Proposal Exec Failure Table
+	ProposalExecFailureTablePrefix byte = 0x50
 )
 
 type Keeper struct {
@@ -74,6 +77,9 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Proposal Exec Failure Table
+	proposalExecFailureTable orm.PrimaryKeyTable
+
 	config group.Config
 
 	cdc codec.Codec
@@ -93,6 +99,7 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 		config.MaxMetadataLen = 1000 			// example metadata length in bytes
 		config.MaxProposalTitleLen = 255 		// example max title length in characters
 		config.MaxProposalSummaryLen = 10200 	// example max summary length in characters
+		config.RetryExecWindow = "1209600s" 	// example retry exec window in seconds
 	*/
 
 	defaultConfig := group.DefaultConfig()
@@ -112,6 +119,10 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	if config.MaxProposalSummaryLen <= 0 {
 		config.MaxProposalSummaryLen = defaultConfig.MaxProposalSummaryLen
 	}
+	// If RetryExecWindow not set by app developer, set to the max execution period.
+	if config.RetryExecWindow <= 0 {
+		config.RetryExecWindow = config.MaxExecutionPeriod
+	}
 	k.config = config
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
@@ -228,6 +239,13 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	}
 	k.voteTable = *voteTable
 
+	// Proposal Exec Failure Table
+	proposalExecFailureTable, err := orm.NewPrimaryKeyTable([2]byte{ProposalExecFailureTablePrefix}, &group.ProposalExecFailure{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalExecFailureTable = *proposalExecFailureTable
+
 	return k
 }
 
@@ -281,11 +299,19 @@ func (k Keeper) proposalsByVPEnd(ctx context.Context, endTime time.Time) (propos
 
 // pruneProposal deletes a proposal from state.
 func (k Keeper) pruneProposal(ctx context.Context, proposalID uint64) error {
-	err := k.proposalTable.Delete(k.environment.KVStoreService.OpenKVStore(ctx), proposalID)
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	err := k.proposalTable.Delete(store, proposalID)
 	if err != nil {
 		return err
 	}
 
+	failure := &group.ProposalExecFailure{ProposalId: proposalID}
+	if k.proposalExecFailureTable.Contains(store, failure) {
+		if err := k.proposalExecFailureTable.Delete(store, failure); err != nil {
+			return err
+		}
+	}
+
 	k.Logger().Debug(fmt.Sprintf("Pruned proposal %d", proposalID))
 	return nil
 }
@@ -16,6 +16,7 @@ import (
 	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/internal/orm"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -847,12 +848,67 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 		}
 	}
 
+	result, err := k.execProposal(ctx, proposal, policyInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgExecResponse{
+		Result: result,
+	}, nil
+}
+
+// RetryExec implements the MsgServer/RetryExec method.
+func (k Keeper) RetryExec(ctx context.Context, msg *group.MsgRetryExec) (*group.MsgRetryExecResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_FAILURE {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "not possible to retry exec with proposal executor result %s", proposal.ExecutorResult.String())
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	result, err := k.execProposal(ctx, proposal, policyInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &group.MsgRetryExecResponse{Result: result}
+	if result == group.PROPOSAL_EXECUTOR_RESULT_FAILURE {
+		failure, err := k.getProposalExecFailure(ctx, proposal.Id)
+		if err != nil {
+			return nil, err
+		}
+		res.Failure = &failure
+	}
+
+	return res, nil
+}
+
+// execProposal executes the messages of an accepted proposal which did not
+// run successfully yet, records the failure of the execution or prunes the
+// executed proposal, and returns the executor result.
+func (k Keeper) execProposal(ctx context.Context, proposal group.Proposal, policyInfo group.GroupPolicyInfo) (group.ProposalExecutorResult, error) {
 	// Execute proposal payload.
 	var logs string
 	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		if err := k.checkRetryExecWindow(ctx, proposal); err != nil {
+			return 0, err
+		}
+
 		addr, err := k.accKeeper.AddressCodec().StringToBytes(policyInfo.Address)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 
 		decisionPolicy := policyInfo.DecisionPolicy.GetCachedValue().(group.DecisionPolicy)
@@ -863,6 +919,10 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
 			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, err.Error())
 			k.Logger().Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
+
+			if err := k.recordExecFailure(ctx, proposal.Id, err); err != nil {
+				return 0, err
+			}
 		} else {
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
 		}
@@ -872,7 +932,7 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 	// If proposal has successfully run, delete it from state.
 	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
-			return nil, err
+			return 0, err
 		}
 
 		// Emit event for proposal finalized with its result
@@ -882,12 +942,12 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 				Status:      proposal.Status,
 				TallyResult: &proposal.FinalTallyResult,
 			}); err != nil {
-			return nil, err
+			return 0, err
 		}
 	} else {
 		store := k.environment.KVStoreService.OpenKVStore(ctx)
 		if err := k.proposalTable.Update(store, proposal.Id, &proposal); err != nil {
-			return nil, err
+			return 0, err
 		}
 	}
 
@@ -896,12 +956,10 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 		Logs:       logs,
 		Result:     proposal.ExecutorResult,
 	}); err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return &group.MsgExecResponse{
-		Result: proposal.ExecutorResult,
-	}, nil
+	return proposal.ExecutorResult, nil
 }
 
 // LeaveGroup implements the MsgServer/LeaveGroup method.
@@ -972,6 +1030,94 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 	return &group.MsgLeaveGroupResponse{}, nil
 }
 
+// MigrateMultisigToGroupPolicy implements the MsgServer/MigrateMultisigToGroupPolicy method.
+func (k Keeper) MigrateMultisigToGroupPolicy(ctx context.Context, msg *group.MsgMigrateMultisigToGroupPolicy) (*group.MsgMigrateMultisigToGroupPolicyResponse, error) {
+	accountAddr, err := k.accKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "multisig address")
+	}
+
+	account := k.accKeeper.GetAccount(ctx, accountAddr)
+	if account == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	// Only accounts controlled by a legacy threshold multisig key can be migrated:
+	// the signatures of the multisig threshold authorize the migration.
+	if _, ok := account.GetPubKey().(*multisig.LegacyAminoPubKey); !ok {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "account %s is not a legacy multisig account", msg.Address)
+	}
+
+	if err := k.assertMetadataLength(msg.GroupPolicyMetadata, "group policy metadata"); err != nil {
+		return nil, err
+	}
+
+	policy, err := msg.GetDecisionPolicy()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "request decision policy")
+	}
+
+	if err := policy.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "decision policy")
+	}
+
+	// NOTE: admin, and group message validation is performed in the CreateGroup method
+	groupRes, err := k.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:    msg.Address,
+		Members:  msg.Members,
+		Metadata: msg.GroupMetadata,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "group response")
+	}
+
+	groupInfo, err := k.getGroupInfo(ctx, groupRes.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := policy.Validate(groupInfo, k.config); err != nil {
+		return nil, err
+	}
+
+	// Replace the multisig key by a module credential, which cannot sign, so
+	// that the account can only be controlled through group proposals, as any
+	// other group policy account. The credential keeps the address of the
+	// account, which is not derived by the group module.
+	ac, err := authtypes.NewModuleCredentialWithAddress(group.ModuleName, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := account.SetPubKey(ac); err != nil {
+		return nil, errorsmod.Wrap(err, "could not migrate multisig account")
+	}
+	k.accKeeper.SetAccount(ctx, account)
+
+	groupPolicy, err := group.NewGroupPolicyInfo(
+		accountAddr,
+		groupRes.GroupId,
+		accountAddr,
+		msg.GroupPolicyMetadata,
+		1,
+		policy,
+		k.environment.HeaderService.GetHeaderInfo(ctx).Time,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
+	if err := k.groupPolicyTable.Create(kvStore, &groupPolicy); err != nil {
+		return nil, errorsmod.Wrap(err, "could not create group policy")
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).Emit(&group.EventCreateGroupPolicy{Address: msg.Address}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgMigrateMultisigToGroupPolicyResponse{GroupId: groupRes.GroupId}, nil
+}
+
 func (k Keeper) getGroupMember(ctx context.Context, member *group.GroupMember) (*group.GroupMember, error) {
 	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
 	var groupMember group.GroupMember
@@ -14,17 +14,28 @@ import (
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/bank"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/keeper"
+	"cosmossdk.io/x/group/module"
 	minttypes "cosmossdk.io/x/mint/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -2830,6 +2841,81 @@ func (s *TestSuite) TestExecProposal() {
 	}
 }
 
+func (s *TestSuite) TestRetryExecProposal() {
+	addr1 := s.addrs[0]
+	addr2 := s.addrs[1]
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposers := []string{addr2.String()}
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{}, bank.AppModule{})
+	config := group.DefaultConfig()
+	config.RetryExecWindow = time.Hour
+	groupKeeper := keeper.NewKeeper(s.environment, encCfg.Codec, s.accountKeeper, config)
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+
+	// a proposal whose execution did not fail cannot be retried
+	_, err := groupKeeper.RetryExec(sdkCtx, &group.MsgRetryExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().ErrorContains(err, "not possible to retry exec")
+
+	// the failure of the execution is recorded
+	failedAt := s.blockTime.Add(minExecutionPeriod)
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Height: 10, Time: failedAt})
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, sdkerrors.ErrInsufficientFunds)
+	execRes, err := groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, execRes.Result)
+
+	failureRes, err := groupKeeper.ProposalExecFailure(sdkCtx, &group.QueryProposalExecFailureRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	failure := failureRes.Failure
+	s.Require().Contains(failure.Reason, sdkerrors.ErrInsufficientFunds.Error())
+	s.Require().Equal(sdkerrors.ErrInsufficientFunds.Codespace(), failure.Codespace)
+	s.Require().Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), failure.Code)
+	s.Require().Equal(sdk.MsgTypeURL(msgSend), failure.MsgTypeUrl)
+	s.Require().Equal(uint32(0), failure.MsgIndex)
+	s.Require().Equal(int64(10), failure.Height)
+	s.Require().Equal(failedAt, failure.FailedAt)
+	s.Require().Equal(uint64(1), failure.Attempts)
+
+	// a failed retry replaces the failure
+	retriedAt := failedAt.Add(time.Minute)
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Height: 11, Time: retriedAt})
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, sdkerrors.ErrInsufficientFunds)
+	retryRes, err := groupKeeper.RetryExec(sdkCtx, &group.MsgRetryExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, retryRes.Result)
+	s.Require().Equal(int64(11), retryRes.Failure.Height)
+	s.Require().Equal(uint64(2), retryRes.Failure.Attempts)
+
+	failuresRes, err := groupKeeper.ProposalExecFailures(sdkCtx, &group.QueryProposalExecFailuresRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]*group.ProposalExecFailure{retryRes.Failure}, failuresRes.Failures)
+
+	// the execution cannot be retried after the retry exec window
+	expiredCtx := sdkCtx.WithHeaderInfo(header.Info{Time: retriedAt.Add(time.Hour + time.Second)})
+	_, err = groupKeeper.RetryExec(expiredCtx, &group.MsgRetryExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().ErrorContains(err, "retry exec window")
+	_, err = groupKeeper.Exec(expiredCtx, &group.MsgExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().ErrorContains(err, "retry exec window")
+
+	// a successful retry prunes the proposal with its failure
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+	retryRes, err = groupKeeper.RetryExec(sdkCtx, &group.MsgRetryExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, retryRes.Result)
+	s.Require().Nil(retryRes.Failure)
+
+	_, err = groupKeeper.ProposalExecFailure(sdkCtx, &group.QueryProposalExecFailureRequest{ProposalId: proposalID})
+	s.Require().ErrorContains(err, "not found")
+}
+
 func (s *TestSuite) TestExecPrunedProposalsAndVotes() {
 	addrs := s.addrs
 	addr1 := addrs[0]
@@ -3451,3 +3537,112 @@ func eventTypeFound(events []abci.Event, eventType string) bool {
 	}
 	return eventTypeFound
 }
+
+func (s *TestSuite) TestMigrateMultisigToGroupPolicy() {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, pk2, addr2 := testdata.KeyTestPubAddr()
+	_, pk3, _ := testdata.KeyTestPubAddr()
+
+	multisigPk := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{pk2, pk3})
+	multisigAddr := sdk.AccAddress(multisigPk.Address())
+	multisigAcc := authtypes.NewBaseAccount(multisigAddr, multisigPk, 42, 3)
+	singleSigAcc := authtypes.NewBaseAccount(addr2, pk2, 43, 0)
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), multisigAddr).Return(multisigAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), addr2).Return(singleSigAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), addr1).Return(nil).AnyTimes()
+	s.accountKeeper.EXPECT().SetAccount(gomock.Any(), multisigAcc).Return().Times(1)
+
+	members := []group.MemberRequest{
+		{Address: s.addrs[4].String(), Weight: "1"},
+		{Address: s.addrs[5].String(), Weight: "2"},
+	}
+	policy := group.NewThresholdDecisionPolicy("2", time.Hour, 0)
+
+	// the specs run in order, the last one migrating an account migrated by the previous one
+	specs := []struct {
+		name      string
+		address   string
+		policy    group.DecisionPolicy
+		expErrMsg string
+	}{
+		{
+			name:      "account does not exist",
+			address:   addr1.String(),
+			policy:    policy,
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "not a multisig account",
+			address:   addr2.String(),
+			policy:    policy,
+			expErrMsg: "not a legacy multisig account",
+		},
+		{
+			name:      "invalid decision policy",
+			address:   multisigAddr.String(),
+			policy:    group.NewPercentageDecisionPolicy("2", time.Hour, 0),
+			expErrMsg: "decision policy",
+		},
+		{
+			name:    "all good",
+			address: multisigAddr.String(),
+			policy:  policy,
+		},
+		{
+			name:      "already migrated",
+			address:   multisigAddr.String(),
+			policy:    policy,
+			expErrMsg: "not a legacy multisig account",
+		},
+	}
+
+	for _, spec := range specs {
+		s.Run(spec.name, func() {
+			msg, err := group.NewMsgMigrateMultisigToGroupPolicy(spec.address, members, "group metadata", "policy metadata", spec.policy)
+			s.Require().NoError(err)
+
+			res, err := s.groupKeeper.MigrateMultisigToGroupPolicy(s.ctx, msg)
+			if spec.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), spec.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the multisig keys can no longer sign for the account, which keeps
+			// a credential matching its address
+			s.Require().IsType(&authtypes.ModuleCredential{}, multisigAcc.GetPubKey())
+			s.Require().Equal(multisigAddr.Bytes(), multisigAcc.GetPubKey().Address().Bytes())
+			s.Require().False(multisigAcc.GetPubKey().VerifySignature([]byte("msg"), []byte("sig")))
+			s.Require().Equal(uint64(42), multisigAcc.GetAccountNumber())
+			s.Require().NoError(multisigAcc.Validate())
+
+			// the migrated account survives a genesis export and import
+			registry := codectypes.NewInterfaceRegistry()
+			authtypes.RegisterInterfaces(registry)
+			cryptocodec.RegisterInterfaces(registry)
+			cdc := codec.NewProtoCodec(registry)
+			genState := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{multisigAcc})
+			var exported authtypes.GenesisState
+			s.Require().NoError(cdc.UnmarshalJSON(cdc.MustMarshalJSON(genState), &exported))
+			s.Require().NoError(authtypes.ValidateGenesis(exported))
+			accs, err := authtypes.UnpackAccounts(exported.Accounts)
+			s.Require().NoError(err)
+			s.Require().Equal(multisigAcc.GetPubKey(), accs[0].GetPubKey())
+
+			groupRes, err := s.groupKeeper.GroupInfo(s.ctx, &group.QueryGroupInfoRequest{GroupId: res.GroupId})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.address, groupRes.Info.Admin)
+
+			policyRes, err := s.groupKeeper.GroupPolicyInfo(s.ctx, &group.QueryGroupPolicyInfoRequest{Address: spec.address})
+			s.Require().NoError(err)
+			s.Require().Equal(res.GroupId, policyRes.Info.GroupId)
+			s.Require().Equal(spec.address, policyRes.Info.Admin)
+			s.Require().Equal("policy metadata", policyRes.Info.Metadata)
+			dp, err := policyRes.Info.GetDecisionPolicy()
+			s.Require().NoError(err)
+			s.Require().Equal(policy, dp)
+		})
+	}
+}
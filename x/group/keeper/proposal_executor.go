@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	stderrors "errors"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/group"
@@ -45,12 +46,93 @@ func (k Keeper) doExecuteMsgs(ctx context.Context, proposal group.Proposal, grou
 
 	for i, msg := range msgs {
 		if _, err := k.environment.RouterService.MessageRouterService().InvokeUntyped(ctx, msg); err != nil {
-			return errorsmod.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i)
+			return &msgExecError{
+				index:   i,
+				typeURL: sdk.MsgTypeURL(msg),
+				err:     errorsmod.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i),
+			}
 		}
 	}
 	return nil
 }
 
+// msgExecError is the error returned by doExecuteMsgs when a proposal message
+// fails, recording which one.
+type msgExecError struct {
+	index   int
+	typeURL string
+	err     error
+}
+
+func (e *msgExecError) Error() string { return e.err.Error() }
+
+// Cause implements the causer interface used to get the ABCI code of the error.
+func (e *msgExecError) Cause() error { return e.err }
+
+func (e *msgExecError) Unwrap() error { return e.err }
+
+// checkRetryExecWindow returns an error if the last execution of a proposal
+// failed more than the retry exec window ago.
+func (k Keeper) checkRetryExecWindow(ctx context.Context, proposal group.Proposal) error {
+	if proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_FAILURE {
+		return nil
+	}
+
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	if !k.proposalExecFailureTable.Contains(store, &group.ProposalExecFailure{ProposalId: proposal.Id}) {
+		// the execution failed before the failures were recorded
+		return nil
+	}
+
+	failure, err := k.getProposalExecFailure(ctx, proposal.Id)
+	if err != nil {
+		return err
+	}
+
+	windowEnd := failure.FailedAt.Add(k.config.RetryExecWindow)
+	if windowEnd.Before(k.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+		return errors.ErrExpired.Wrapf("retry exec window of proposal %d expired on %s", proposal.Id, windowEnd)
+	}
+
+	return nil
+}
+
+// recordExecFailure stores the failure of the execution of a proposal,
+// replacing the one of its previous failed execution.
+func (k Keeper) recordExecFailure(ctx context.Context, proposalID uint64, execErr error) error {
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	headerInfo := k.environment.HeaderService.GetHeaderInfo(ctx)
+
+	codespace, code, _ := errorsmod.ABCIInfo(execErr, false)
+	failure := group.ProposalExecFailure{
+		ProposalId: proposalID,
+		Reason:     execErr.Error(),
+		Codespace:  codespace,
+		Code:       code,
+		Height:     headerInfo.Height,
+		FailedAt:   headerInfo.Time,
+		Attempts:   1,
+	}
+
+	var msgErr *msgExecError
+	if stderrors.As(execErr, &msgErr) {
+		failure.MsgTypeUrl = msgErr.typeURL
+		failure.MsgIndex = uint32(msgErr.index)
+	}
+
+	if !k.proposalExecFailureTable.Contains(store, &failure) {
+		return k.proposalExecFailureTable.Create(store, &failure)
+	}
+
+	previous, err := k.getProposalExecFailure(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	failure.Attempts = previous.Attempts + 1
+
+	return k.proposalExecFailureTable.Update(store, &failure)
+}
+
 // ensureMsgAuthZ checks that if a message requires signers that all of them
 // are equal to the given account address of group policy.
 func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress, cdc codec.Codec) error {
@@ -121,6 +121,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "groups",
 					Short:     "Query for all groups on chain",
 				},
+				{
+					RpcMethod: "ProposalExecFailure",
+					Use:       "proposal-exec-failure [proposal-id]",
+					Short:     "Query why the execution of a proposal failed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "ProposalExecFailures",
+					Use:       "proposal-exec-failures",
+					Short:     "Query the execution failures of all proposals",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -197,6 +210,15 @@ Parameters:
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "RetryExec",
+					Use:       "retry-exec [proposal-id]",
+					Short:     "Retry the execution of a proposal whose last execution failed",
+					Long:      "Retry the execution of a proposal whose last execution failed, within the retry exec window from the failure",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "LeaveGroup",
 					Use:       "leave-group [member-address] [group-id]",
@@ -51,6 +51,7 @@ func ProvideModule(in GroupInputs) GroupOutputs {
 			MaxMetadataLen:        in.Config.MaxMetadataLen,
 			MaxProposalTitleLen:   in.Config.MaxProposalTitleLen,
 			MaxProposalSummaryLen: in.Config.MaxProposalSummaryLen,
+			RetryExecWindow:       in.Config.RetryExecWindow.AsDuration(),
 		},
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
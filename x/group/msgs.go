@@ -22,14 +22,17 @@ var (
 	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
 	_ sdk.Msg = &MsgLeaveGroup{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgRetryExec{}
 	_ sdk.Msg = &MsgVote{}
 	_ sdk.Msg = &MsgWithdrawProposal{}
 	_ sdk.Msg = &MsgSubmitProposal{}
 	_ sdk.Msg = &MsgCreateGroupPolicy{}
+	_ sdk.Msg = &MsgMigrateMultisigToGroupPolicy{}
 
 	_ types.UnpackInterfacesMessage = MsgCreateGroupPolicy{}
 	_ types.UnpackInterfacesMessage = MsgUpdateGroupPolicyDecisionPolicy{}
 	_ types.UnpackInterfacesMessage = MsgCreateGroupWithPolicy{}
+	_ types.UnpackInterfacesMessage = MsgMigrateMultisigToGroupPolicy{}
 )
 
 // GetGroupID gets the group id of the MsgUpdateGroupMetadata.
@@ -221,3 +224,43 @@ func (m MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return tx.UnpackInterfaces(unpacker, m.Messages)
 }
+
+// NewMsgMigrateMultisigToGroupPolicy creates a new MsgMigrateMultisigToGroupPolicy.
+func NewMsgMigrateMultisigToGroupPolicy(address string, members []MemberRequest, groupMetadata, groupPolicyMetadata string, decisionPolicy DecisionPolicy) (*MsgMigrateMultisigToGroupPolicy, error) {
+	m := &MsgMigrateMultisigToGroupPolicy{
+		Address:             address,
+		Members:             members,
+		GroupMetadata:       groupMetadata,
+		GroupPolicyMetadata: groupPolicyMetadata,
+	}
+	err := m.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetDecisionPolicy gets the decision policy of MsgMigrateMultisigToGroupPolicy.
+func (m *MsgMigrateMultisigToGroupPolicy) GetDecisionPolicy() (DecisionPolicy, error) {
+	decisionPolicy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (DecisionPolicy)(nil), m.DecisionPolicy.GetCachedValue())
+	}
+	return decisionPolicy, nil
+}
+
+// SetDecisionPolicy sets the decision policy for MsgMigrateMultisigToGroupPolicy.
+func (m *MsgMigrateMultisigToGroupPolicy) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := types.NewAnyWithValue(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgMigrateMultisigToGroupPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
+}
@@ -1486,6 +1486,207 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryProposalExecFailureRequest is the Query/ProposalExecFailure request type.
+//
+// Since: cosmos-sdk 0.51
+type QueryProposalExecFailureRequest struct {
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalExecFailureRequest) Reset()         { *m = QueryProposalExecFailureRequest{} }
+func (m *QueryProposalExecFailureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecFailureRequest) ProtoMessage()    {}
+func (*QueryProposalExecFailureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryProposalExecFailureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecFailureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecFailureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecFailureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecFailureRequest.Merge(m, src)
+}
+func (m *QueryProposalExecFailureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecFailureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecFailureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecFailureRequest proto.InternalMessageInfo
+
+func (m *QueryProposalExecFailureRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalExecFailureResponse is the Query/ProposalExecFailure response type.
+//
+// Since: cosmos-sdk 0.51
+type QueryProposalExecFailureResponse struct {
+	// failure is the execution failure of the proposal.
+	Failure *ProposalExecFailure `protobuf:"bytes,1,opt,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (m *QueryProposalExecFailureResponse) Reset()         { *m = QueryProposalExecFailureResponse{} }
+func (m *QueryProposalExecFailureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecFailureResponse) ProtoMessage()    {}
+func (*QueryProposalExecFailureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryProposalExecFailureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecFailureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecFailureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecFailureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecFailureResponse.Merge(m, src)
+}
+func (m *QueryProposalExecFailureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecFailureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecFailureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecFailureResponse proto.InternalMessageInfo
+
+func (m *QueryProposalExecFailureResponse) GetFailure() *ProposalExecFailure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+// QueryProposalExecFailuresRequest is the Query/ProposalExecFailures request type.
+//
+// Since: cosmos-sdk 0.51
+type QueryProposalExecFailuresRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalExecFailuresRequest) Reset()         { *m = QueryProposalExecFailuresRequest{} }
+func (m *QueryProposalExecFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecFailuresRequest) ProtoMessage()    {}
+func (*QueryProposalExecFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{30}
+}
+func (m *QueryProposalExecFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecFailuresRequest.Merge(m, src)
+}
+func (m *QueryProposalExecFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecFailuresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecFailuresRequest proto.InternalMessageInfo
+
+func (m *QueryProposalExecFailuresRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalExecFailuresResponse is the Query/ProposalExecFailures response type.
+//
+// Since: cosmos-sdk 0.51
+type QueryProposalExecFailuresResponse struct {
+	// failures are the execution failures of the proposals, by proposal ID.
+	Failures []*ProposalExecFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalExecFailuresResponse) Reset()         { *m = QueryProposalExecFailuresResponse{} }
+func (m *QueryProposalExecFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecFailuresResponse) ProtoMessage()    {}
+func (*QueryProposalExecFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{31}
+}
+func (m *QueryProposalExecFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecFailuresResponse.Merge(m, src)
+}
+func (m *QueryProposalExecFailuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecFailuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecFailuresResponse proto.InternalMessageInfo
+
+func (m *QueryProposalExecFailuresResponse) GetFailures() []*ProposalExecFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *QueryProposalExecFailuresResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1515,94 +1716,106 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryProposalExecFailureRequest)(nil), "cosmos.group.v1.QueryProposalExecFailureRequest")
+	proto.RegisterType((*QueryProposalExecFailureResponse)(nil), "cosmos.group.v1.QueryProposalExecFailureResponse")
+	proto.RegisterType((*QueryProposalExecFailuresRequest)(nil), "cosmos.group.v1.QueryProposalExecFailuresRequest")
+	proto.RegisterType((*QueryProposalExecFailuresResponse)(nil), "cosmos.group.v1.QueryProposalExecFailuresResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0xa5, 0xf9, 0xf5, 0xd2, 0x36, 0xea, 0x34, 0x6d, 0x93, 0x6d, 0xe4, 0x84, 0x2d,
-	0xe4, 0x77, 0x76, 0x63, 0x27, 0x4d, 0x11, 0x50, 0xa1, 0x5a, 0x82, 0x90, 0x43, 0x51, 0x6a, 0x2a,
-	0x24, 0x10, 0x52, 0xb4, 0xae, 0x37, 0xd6, 0x0a, 0x7b, 0xc7, 0xf5, 0x6e, 0x22, 0xac, 0xc8, 0x17,
-	0x24, 0x38, 0x20, 0x0e, 0xd0, 0x22, 0x54, 0x22, 0x0e, 0x3d, 0x20, 0xd1, 0x23, 0x07, 0x10, 0x12,
-	0xb7, 0xde, 0x7a, 0xac, 0xe0, 0xc2, 0x09, 0xa1, 0x04, 0x89, 0x3f, 0x82, 0x0b, 0xda, 0x99, 0xb7,
-	0xf6, 0xfe, 0xf6, 0x5a, 0x58, 0xe0, 0x4b, 0x55, 0xef, 0xbc, 0x99, 0xf7, 0x99, 0xef, 0x7b, 0x3b,
-	0xfb, 0x9d, 0xc0, 0x95, 0xbb, 0xcc, 0xaa, 0x32, 0x4b, 0x2d, 0xd7, 0xd9, 0x7e, 0x4d, 0x3d, 0xc8,
-	0xaa, 0xf7, 0xf6, 0xf5, 0x7a, 0x43, 0xa9, 0xd5, 0x99, 0xcd, 0xe8, 0xb8, 0x18, 0x54, 0xf8, 0xa0,
-	0x72, 0x90, 0x95, 0x26, 0xca, 0xac, 0xcc, 0xf8, 0x98, 0xea, 0xfc, 0x4f, 0x84, 0x49, 0xd3, 0x65,
-	0xc6, 0xca, 0x15, 0x5d, 0xd5, 0x6a, 0x86, 0xaa, 0x99, 0x26, 0xb3, 0x35, 0xdb, 0x60, 0xa6, 0x85,
-	0xa3, 0xa1, 0x0c, 0x76, 0xa3, 0xa6, 0xbb, 0x83, 0x4b, 0x38, 0x58, 0xd4, 0x2c, 0x5d, 0xa4, 0x56,
-	0x0f, 0xb2, 0x45, 0xdd, 0xd6, 0xb2, 0x6a, 0x4d, 0x2b, 0x1b, 0x26, 0x5f, 0x09, 0x63, 0xa7, 0x44,
-	0xec, 0xae, 0xc8, 0x8f, 0x68, 0x62, 0xe8, 0xbc, 0x56, 0x35, 0x4c, 0xa6, 0xf2, 0x7f, 0xc5, 0x23,
-	0x39, 0x07, 0x17, 0x6f, 0x3b, 0xeb, 0x6d, 0x39, 0x69, 0xb7, 0xcd, 0x3d, 0x56, 0xd0, 0xef, 0xed,
-	0xeb, 0x96, 0x4d, 0xa7, 0x60, 0x84, 0xa3, 0xec, 0x1a, 0xa5, 0x49, 0x32, 0x4b, 0x16, 0x4e, 0x17,
-	0x86, 0xf9, 0xef, 0xed, 0x92, 0xfc, 0x26, 0x5c, 0x0a, 0xce, 0xb1, 0x6a, 0xcc, 0xb4, 0x74, 0xaa,
-	0xc0, 0x69, 0xc3, 0xdc, 0x63, 0x7c, 0xc2, 0x58, 0x4e, 0x52, 0x02, 0xc2, 0x28, 0xed, 0x19, 0x3c,
-	0x4e, 0xbe, 0x0d, 0x57, 0xda, 0x2b, 0xed, 0xb0, 0x8a, 0x71, 0xb7, 0xe1, 0x65, 0xc8, 0xc1, 0xb0,
-	0x56, 0x2a, 0xd5, 0x75, 0xcb, 0xe2, 0x2b, 0x8e, 0xe6, 0x27, 0x7f, 0xf9, 0x61, 0x75, 0x02, 0x17,
-	0xbd, 0x29, 0x46, 0xde, 0xb6, 0xeb, 0x86, 0x59, 0x2e, 0xb8, 0x81, 0xf2, 0x1d, 0x98, 0x8e, 0x5e,
-	0x12, 0x11, 0x37, 0x7c, 0x88, 0xb3, 0xd1, 0x88, 0x9e, 0x79, 0x02, 0xb4, 0x09, 0x93, 0xed, 0x55,
-	0x6f, 0xe9, 0xd5, 0xa2, 0x5e, 0xb7, 0x3a, 0x2b, 0x45, 0xdf, 0x00, 0x68, 0xd7, 0x67, 0xf2, 0x14,
-	0x4f, 0x39, 0xe7, 0xa6, 0x74, 0x8a, 0xa9, 0x88, 0x3e, 0xc2, 0x62, 0x2a, 0x3b, 0x5a, 0x59, 0xc7,
-	0x65, 0x0b, 0x9e, 0x99, 0xf2, 0x37, 0x04, 0xa6, 0x22, 0xf2, 0xe3, 0x96, 0x36, 0x61, 0xb8, 0x2a,
-	0x1e, 0x4d, 0x92, 0xd9, 0xe7, 0x16, 0xc6, 0x72, 0xd3, 0xd1, 0xbb, 0x12, 0xf3, 0x0a, 0x6e, 0x30,
-	0xdd, 0x8a, 0xa0, 0x9b, 0xef, 0x48, 0x27, 0x92, 0xfa, 0xf0, 0x1e, 0xf8, 0xf0, 0xac, 0x7c, 0xe3,
-	0x66, 0xa9, 0x6a, 0x98, 0xae, 0x3e, 0x0a, 0x0c, 0x6a, 0xce, 0xef, 0x8e, 0x35, 0x14, 0x61, 0x3d,
-	0x13, 0xed, 0x6b, 0x02, 0x52, 0x14, 0x15, 0xaa, 0x96, 0x83, 0x21, 0x2e, 0x8f, 0x2b, 0x5a, 0x52,
-	0xb7, 0x62, 0x64, 0xef, 0x14, 0xfb, 0x98, 0xc0, 0x6c, 0xa0, 0x4d, 0x0d, 0xdd, 0xca, 0x8b, 0x9f,
-	0xff, 0x61, 0x63, 0xfd, 0x48, 0xe0, 0xf9, 0x04, 0x0e, 0x94, 0x6a, 0x0b, 0xce, 0x09, 0x90, 0x1a,
-	0x06, 0xa0, 0x64, 0x9d, 0xdf, 0x9e, 0xb3, 0x65, 0xef, 0xba, 0xbd, 0xd3, 0xef, 0x28, 0x46, 0xbf,
-	0xbe, 0x68, 0xbc, 0x38, 0x51, 0xfd, 0xfd, 0xd7, 0x7f, 0xa2, 0x5e, 0x87, 0x09, 0x8e, 0xbd, 0x53,
-	0x67, 0x35, 0x66, 0x69, 0x15, 0x57, 0xc7, 0x19, 0x18, 0xab, 0xe1, 0xa3, 0x76, 0x2b, 0x82, 0xfb,
-	0x68, 0xbb, 0x24, 0xbf, 0x85, 0x1f, 0x91, 0xf6, 0x44, 0xdc, 0xe3, 0x35, 0x18, 0x71, 0xc3, 0xf0,
-	0xc0, 0x9d, 0x0a, 0xed, 0xae, 0x35, 0xa9, 0x15, 0x2a, 0x3f, 0x22, 0x20, 0xfb, 0x16, 0x74, 0x3b,
-	0x52, 0x88, 0xf0, 0x2f, 0x3e, 0x0f, 0x3d, 0xab, 0xf1, 0x77, 0x04, 0xae, 0x26, 0x22, 0xa2, 0x02,
-	0xd7, 0x61, 0xd4, 0xdd, 0x96, 0x5b, 0xe0, 0x04, 0x09, 0xda, 0xb1, 0xbd, 0xab, 0x6a, 0x1d, 0x66,
-	0x38, 0xe8, 0x3b, 0xcc, 0xd6, 0xf3, 0x2d, 0x5c, 0xe7, 0x57, 0x3d, 0x6d, 0x81, 0x9d, 0x37, 0xe9,
-	0xc0, 0x99, 0xc0, 0x39, 0x12, 0xdf, 0x24, 0x1e, 0x26, 0xdf, 0xc2, 0xb7, 0x33, 0x32, 0x27, 0x2a,
-	0xb3, 0x08, 0xa7, 0x9d, 0x60, 0xec, 0x8b, 0x8b, 0x21, 0x51, 0x9c, 0xe8, 0x02, 0x0f, 0x91, 0x3f,
-	0x21, 0xe8, 0x13, 0x9c, 0x67, 0x56, 0xbe, 0xeb, 0x06, 0xed, 0x59, 0xd5, 0xbf, 0x24, 0xe8, 0x2e,
-	0x42, 0x20, 0xb8, 0xa9, 0x65, 0x21, 0x94, 0x5b, 0xea, 0x98, 0x5d, 0x89, 0x98, 0xde, 0x95, 0xf8,
-	0x3e, 0x41, 0x7b, 0x82, 0x58, 0xbe, 0xe2, 0xb6, 0x6a, 0x47, 0x52, 0xd5, 0xae, 0x67, 0x5a, 0x7d,
-	0xe1, 0x9a, 0x02, 0x3f, 0xd4, 0xff, 0x2a, 0xd4, 0xc3, 0xa0, 0x25, 0x40, 0x4b, 0xd4, 0x07, 0x07,
-	0xca, 0x11, 0xf1, 0x7a, 0x61, 0x0f, 0x5a, 0x3f, 0xd8, 0x95, 0x97, 0xe1, 0x32, 0x67, 0xbb, 0xa3,
-	0x55, 0x2a, 0xce, 0xd9, 0xb6, 0x5f, 0xb1, 0x53, 0x7f, 0x1c, 0xde, 0xc5, 0xde, 0xf4, 0xcd, 0xc5,
-	0x4d, 0xdd, 0x80, 0x41, 0xdb, 0x79, 0x8c, 0x87, 0x40, 0xd8, 0xb7, 0x7a, 0x26, 0xe5, 0x47, 0x9f,
-	0xfe, 0x3e, 0x33, 0xf0, 0xf8, 0xaf, 0xef, 0x97, 0x48, 0x41, 0xcc, 0x92, 0xdf, 0x07, 0xea, 0x91,
-	0xcc, 0x25, 0xea, 0x55, 0x45, 0xee, 0x13, 0xb8, 0xe0, 0x5b, 0xbe, 0x0f, 0x2a, 0x91, 0xfb, 0xfb,
-	0x3c, 0x0c, 0x72, 0x28, 0xfa, 0x19, 0x81, 0xd1, 0x56, 0x22, 0x3a, 0x17, 0x82, 0x88, 0xbc, 0xd6,
-	0x49, 0xf3, 0x1d, 0xe3, 0x44, 0x52, 0x59, 0xf9, 0xe8, 0xd7, 0x3f, 0x1f, 0x9c, 0x5a, 0xa0, 0x73,
-	0x6a, 0xf0, 0x62, 0x8a, 0x9e, 0xd4, 0xdc, 0x63, 0xea, 0xa1, 0xeb, 0x4f, 0x9b, 0xf4, 0x5b, 0x02,
-	0xe3, 0x01, 0xa3, 0x42, 0x57, 0x12, 0x92, 0x85, 0x6e, 0x7b, 0xd2, 0x6a, 0xca, 0x68, 0x04, 0xdc,
-	0xe0, 0x80, 0x0a, 0x5d, 0x89, 0x01, 0xe4, 0xb6, 0xaa, 0x81, 0x9c, 0xf8, 0xb6, 0x36, 0xe9, 0x43,
-	0x02, 0x67, 0xbc, 0x97, 0x28, 0xba, 0x98, 0x90, 0xd5, 0x7f, 0xd1, 0x93, 0x96, 0xd2, 0x84, 0x22,
-	0x5d, 0x96, 0xd3, 0x2d, 0xd3, 0xc5, 0x18, 0x3a, 0xbc, 0x83, 0x79, 0x15, 0x3c, 0x22, 0x70, 0xd6,
-	0x77, 0x55, 0xa1, 0x49, 0x09, 0x03, 0x66, 0x57, 0x5a, 0x4e, 0x15, 0x8b, 0x74, 0x6b, 0x9c, 0x6e,
-	0x89, 0x2e, 0x44, 0xd3, 0x59, 0xbb, 0xc5, 0xc6, 0x2e, 0xf7, 0xc4, 0x8e, 0x72, 0x55, 0xc3, 0x6c,
-	0xd2, 0x9f, 0x09, 0x4c, 0x44, 0xdd, 0x11, 0x68, 0xb6, 0x53, 0xd5, 0x42, 0xf7, 0x1a, 0x29, 0xd7,
-	0xcd, 0x14, 0x24, 0x7e, 0x85, 0x13, 0x5f, 0xa3, 0xeb, 0x49, 0xd5, 0x36, 0x74, 0x4e, 0x2e, 0x86,
-	0x3c, 0xca, 0xfe, 0x14, 0x86, 0x17, 0x02, 0xa7, 0x83, 0xf7, 0xe9, 0x9c, 0xeb, 0x66, 0x0a, 0xc2,
-	0xbf, 0xc4, 0xe1, 0x73, 0x74, 0x2d, 0x05, 0xbc, 0x5f, 0xf6, 0x4f, 0x09, 0x8c, 0xb8, 0x26, 0x83,
-	0xbe, 0x18, 0x9d, 0x3a, 0xe0, 0x86, 0xa4, 0xb9, 0x4e, 0x61, 0x48, 0xa5, 0x72, 0xaa, 0x45, 0x3a,
-	0x1f, 0xa2, 0x72, 0x4f, 0x6f, 0xf5, 0xd0, 0x73, 0xb4, 0x37, 0xe9, 0x13, 0x02, 0x97, 0xa2, 0xed,
-	0x2e, 0x5d, 0x4f, 0xce, 0x19, 0xe9, 0xdf, 0xa5, 0x8d, 0xee, 0x26, 0x21, 0xf6, 0xab, 0x1c, 0x7b,
-	0x93, 0x6e, 0xc4, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x84, 0xc0, 0x85, 0x08,
-	0x57, 0x4a, 0xd7, 0xa2, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0xed, 0x62, 0x06, 0xa2, 0xbf, 0xce, 0xd1,
-	0x5f, 0xa3, 0x37, 0x42, 0xe8, 0x8e, 0xcf, 0x71, 0xa8, 0x5b, 0x7a, 0x73, 0x2f, 0xe6, 0xd7, 0x5f,
-	0x3d, 0xe4, 0x0f, 0x9b, 0xf4, 0x31, 0x81, 0xf1, 0x80, 0x01, 0x8d, 0x3b, 0x6a, 0xa3, 0x0d, 0x73,
-	0xdc, 0x51, 0x1b, 0xe3, 0x6a, 0x13, 0xfa, 0x97, 0xfb, 0x33, 0x2f, 0x78, 0xa0, 0x65, 0xbe, 0x22,
-	0x70, 0xc6, 0xeb, 0xff, 0xe2, 0x8e, 0xdb, 0x08, 0xe3, 0x1a, 0x77, 0xdc, 0x46, 0xd9, 0xc9, 0x84,
-	0x5e, 0x6e, 0x11, 0xa2, 0xa2, 0xa8, 0xe1, 0x23, 0x02, 0xe7, 0xfc, 0x4e, 0x8b, 0x76, 0x38, 0x41,
-	0x7d, 0x56, 0x51, 0x5a, 0x49, 0x17, 0x8c, 0x78, 0xeb, 0x1c, 0x6f, 0x95, 0x2e, 0x27, 0x9c, 0xb7,
-	0xe2, 0x8b, 0xe0, 0x69, 0xd5, 0x23, 0x02, 0x63, 0x1e, 0xff, 0x43, 0x17, 0xa2, 0x53, 0x86, 0x3d,
-	0x99, 0xb4, 0x98, 0x22, 0x12, 0xc9, 0x36, 0x39, 0xd9, 0x1a, 0x55, 0xe2, 0xdf, 0xa6, 0x40, 0x17,
-	0x72, 0xeb, 0x45, 0x6d, 0x18, 0x12, 0x7b, 0xa5, 0x57, 0x93, 0x94, 0x70, 0x89, 0x5e, 0x48, 0x0e,
-	0x42, 0x98, 0x19, 0x0e, 0x33, 0x45, 0x2f, 0xc7, 0xc8, 0x94, 0x57, 0x9e, 0x1e, 0x67, 0xc8, 0xb3,
-	0xe3, 0x0c, 0xf9, 0xe3, 0x38, 0x43, 0x3e, 0x3f, 0xc9, 0x0c, 0x3c, 0x3b, 0xc9, 0x0c, 0xfc, 0x76,
-	0x92, 0x19, 0x78, 0x0f, 0x5d, 0xba, 0x55, 0xfa, 0x40, 0x31, 0x98, 0xfa, 0xa1, 0x98, 0x50, 0x1c,
-	0xe2, 0x7f, 0xe4, 0x5e, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x52, 0x63, 0x6a, 0x95, 0xbf, 0x17,
-	0x00, 0x00,
+	// 1425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0xa5, 0x6d, 0x92, 0x97, 0xfe, 0x10, 0x53, 0xb7, 0x75, 0xb6, 0x91, 0x13, 0xb6,
+	0x25, 0xcd, 0x8f, 0x66, 0x37, 0x76, 0xd2, 0x16, 0x01, 0x05, 0x6a, 0xa9, 0x2d, 0x39, 0x14, 0xa5,
+	0xa6, 0x42, 0x02, 0x21, 0x59, 0xeb, 0x78, 0x63, 0x2d, 0xd8, 0xbb, 0xae, 0xd7, 0x89, 0x62, 0x45,
+	0xbe, 0x20, 0xc1, 0x01, 0x71, 0x80, 0x16, 0xa1, 0x12, 0x71, 0xe8, 0x01, 0x89, 0x9e, 0x2a, 0x0e,
+	0x20, 0x04, 0xb7, 0xde, 0x7a, 0xac, 0xe0, 0xc2, 0x09, 0xa1, 0x04, 0x09, 0xfe, 0x0c, 0xb4, 0x33,
+	0x6f, 0xed, 0xfd, 0x31, 0xbb, 0x5e, 0x83, 0x05, 0xb9, 0x54, 0xde, 0x99, 0xf7, 0xe6, 0x7d, 0xe6,
+	0xfb, 0x66, 0x67, 0xdf, 0x6b, 0xe0, 0xcc, 0x9a, 0x65, 0xd7, 0x2c, 0x5b, 0xad, 0x34, 0xac, 0x8d,
+	0xba, 0xba, 0x99, 0x55, 0xef, 0x6c, 0xe8, 0x8d, 0x96, 0x52, 0x6f, 0x58, 0x4d, 0x8b, 0x1e, 0xe7,
+	0x93, 0x0a, 0x9b, 0x54, 0x36, 0xb3, 0x52, 0xaa, 0x62, 0x55, 0x2c, 0x36, 0xa7, 0x3a, 0xbf, 0xb8,
+	0x99, 0x34, 0x51, 0xb1, 0xac, 0x4a, 0x55, 0x57, 0xb5, 0xba, 0xa1, 0x6a, 0xa6, 0x69, 0x35, 0xb5,
+	0xa6, 0x61, 0x99, 0x36, 0xce, 0x86, 0x22, 0x34, 0x5b, 0x75, 0xdd, 0x9d, 0x9c, 0xc3, 0xc9, 0x92,
+	0x66, 0xeb, 0x3c, 0xb4, 0xba, 0x99, 0x2d, 0xe9, 0x4d, 0x2d, 0xab, 0xd6, 0xb5, 0x8a, 0x61, 0xb2,
+	0x95, 0xd0, 0x76, 0x9c, 0xdb, 0x16, 0x79, 0x7c, 0xfe, 0x80, 0x53, 0xcf, 0x6a, 0x35, 0xc3, 0xb4,
+	0x54, 0xf6, 0x2f, 0x1f, 0x92, 0x73, 0x70, 0xf2, 0x96, 0xb3, 0xde, 0x0d, 0x27, 0xec, 0x8a, 0xb9,
+	0x6e, 0x15, 0xf4, 0x3b, 0x1b, 0xba, 0xdd, 0xa4, 0xe3, 0x30, 0xc2, 0x50, 0x8a, 0x46, 0x39, 0x4d,
+	0xa6, 0xc8, 0xcc, 0xc1, 0xc2, 0x30, 0x7b, 0x5e, 0x29, 0xcb, 0xaf, 0xc3, 0xa9, 0xa0, 0x8f, 0x5d,
+	0xb7, 0x4c, 0x5b, 0xa7, 0x0a, 0x1c, 0x34, 0xcc, 0x75, 0x8b, 0x39, 0x8c, 0xe5, 0x24, 0x25, 0x20,
+	0x8c, 0xd2, 0xf5, 0x60, 0x76, 0xf2, 0x2d, 0x38, 0xd3, 0x5d, 0x69, 0xd5, 0xaa, 0x1a, 0x6b, 0x2d,
+	0x2f, 0x43, 0x0e, 0x86, 0xb5, 0x72, 0xb9, 0xa1, 0xdb, 0x36, 0x5b, 0x71, 0x34, 0x9f, 0xfe, 0xf9,
+	0xbb, 0x85, 0x14, 0x2e, 0x7a, 0x95, 0xcf, 0xbc, 0xd9, 0x6c, 0x18, 0x66, 0xa5, 0xe0, 0x1a, 0xca,
+	0xb7, 0x61, 0x42, 0xbc, 0x24, 0x22, 0x2e, 0xfb, 0x10, 0xa7, 0xc4, 0x88, 0x1e, 0x3f, 0x0e, 0xda,
+	0x86, 0x74, 0x77, 0xd5, 0x9b, 0x7a, 0xad, 0xa4, 0x37, 0xec, 0xde, 0x4a, 0xd1, 0xeb, 0x00, 0xdd,
+	0xfc, 0xa4, 0x0f, 0xb0, 0x90, 0xd3, 0x6e, 0x48, 0x27, 0x99, 0x0a, 0x3f, 0x47, 0x98, 0x4c, 0x65,
+	0x55, 0xab, 0xe8, 0xb8, 0x6c, 0xc1, 0xe3, 0x29, 0x7f, 0x45, 0x60, 0x5c, 0x10, 0x1f, 0xb7, 0x74,
+	0x09, 0x86, 0x6b, 0x7c, 0x28, 0x4d, 0xa6, 0x9e, 0x99, 0x19, 0xcb, 0x4d, 0x88, 0x77, 0xc5, 0xfd,
+	0x0a, 0xae, 0x31, 0xbd, 0x21, 0xa0, 0x3b, 0xdf, 0x93, 0x8e, 0x07, 0xf5, 0xe1, 0xdd, 0xf3, 0xe1,
+	0xd9, 0xf9, 0xd6, 0xd5, 0x72, 0xcd, 0x30, 0x5d, 0x7d, 0x14, 0x38, 0xa4, 0x39, 0xcf, 0x3d, 0x73,
+	0xc8, 0xcd, 0x06, 0x26, 0xda, 0x97, 0x04, 0x24, 0x11, 0x15, 0xaa, 0x96, 0x83, 0xc3, 0x4c, 0x1e,
+	0x57, 0xb4, 0xb8, 0xd3, 0x8a, 0x96, 0x83, 0x53, 0xec, 0x43, 0x02, 0x53, 0x81, 0x63, 0x6a, 0xe8,
+	0x76, 0x9e, 0x3f, 0xfe, 0x87, 0x07, 0xeb, 0x7b, 0x02, 0xcf, 0xc5, 0x70, 0xa0, 0x54, 0x37, 0xe0,
+	0x18, 0x07, 0xa9, 0xa3, 0x01, 0x4a, 0xd6, 0xfb, 0xed, 0x39, 0x5a, 0xf1, 0xae, 0x3b, 0x38, 0xfd,
+	0x76, 0x22, 0xf4, 0xdb, 0x17, 0x07, 0x2f, 0x4a, 0x54, 0xff, 0xf9, 0xdb, 0x7f, 0xa2, 0x5e, 0x86,
+	0x14, 0xc3, 0x5e, 0x6d, 0x58, 0x75, 0xcb, 0xd6, 0xaa, 0xae, 0x8e, 0x93, 0x30, 0x56, 0xc7, 0xa1,
+	0xee, 0x51, 0x04, 0x77, 0x68, 0xa5, 0x2c, 0xbf, 0x01, 0x27, 0x03, 0x8e, 0xb8, 0xc7, 0x8b, 0x30,
+	0xe2, 0x9a, 0xe1, 0x85, 0x3b, 0x1e, 0xda, 0x5d, 0xc7, 0xa9, 0x63, 0x2a, 0x3f, 0x20, 0x20, 0xfb,
+	0x16, 0x74, 0x4f, 0x24, 0x17, 0xe1, 0x5f, 0x7c, 0x1e, 0x06, 0x96, 0xe3, 0x6f, 0x08, 0x9c, 0x8d,
+	0x45, 0x44, 0x05, 0x2e, 0xc3, 0xa8, 0xbb, 0x2d, 0x37, 0xc1, 0x31, 0x12, 0x74, 0x6d, 0x07, 0x97,
+	0xd5, 0x06, 0x4c, 0x32, 0xd0, 0xb7, 0xac, 0xa6, 0x9e, 0xef, 0xe0, 0x3a, 0x4f, 0x8d, 0xa4, 0x09,
+	0x76, 0xde, 0xa4, 0x4d, 0xc7, 0x21, 0x7d, 0xa0, 0x87, 0xce, 0xdc, 0x4c, 0xbe, 0x89, 0x6f, 0xa7,
+	0x30, 0x26, 0x2a, 0x33, 0x0b, 0x07, 0x1d, 0x63, 0x3c, 0x17, 0x27, 0x43, 0xa2, 0x38, 0xd6, 0x05,
+	0x66, 0x22, 0x7f, 0x44, 0xb0, 0x4e, 0x70, 0xc6, 0xec, 0x7c, 0xdf, 0x07, 0x74, 0x60, 0x59, 0xff,
+	0x9c, 0xc0, 0x84, 0x18, 0x04, 0x37, 0x35, 0xcf, 0x85, 0x72, 0x53, 0x1d, 0xb1, 0x2b, 0x6e, 0x33,
+	0xb8, 0x14, 0xdf, 0x25, 0x58, 0x9e, 0x20, 0x96, 0x2f, 0xb9, 0x9d, 0xdc, 0x91, 0x44, 0xb9, 0x1b,
+	0x98, 0x56, 0x9f, 0xb9, 0x45, 0x81, 0x1f, 0xea, 0x7f, 0x15, 0xea, 0x7e, 0xb0, 0x24, 0xc0, 0x92,
+	0x68, 0x1f, 0x5c, 0x28, 0x3b, 0x04, 0xce, 0x08, 0xd1, 0xf6, 0x43, 0xb9, 0xf2, 0x22, 0x9c, 0x66,
+	0x6c, 0xb7, 0xb5, 0x6a, 0xd5, 0xb9, 0xdb, 0x36, 0xaa, 0xcd, 0xc4, 0x1f, 0x87, 0xb7, 0x21, 0x1d,
+	0xf6, 0xc5, 0x4d, 0x5d, 0x81, 0x43, 0x4d, 0x67, 0x18, 0x2f, 0x81, 0x70, 0xdd, 0xea, 0x71, 0xca,
+	0x8f, 0x3e, 0xf9, 0x6d, 0x72, 0xe8, 0xe1, 0x9f, 0xdf, 0xce, 0x91, 0x02, 0xf7, 0x92, 0xdf, 0x05,
+	0xea, 0x91, 0xcc, 0x25, 0x1a, 0x54, 0x46, 0xee, 0x12, 0x38, 0xe1, 0x5b, 0x7e, 0x3f, 0x64, 0x22,
+	0x8f, 0xb7, 0xb9, 0x7b, 0xf3, 0x5c, 0xdb, 0xd2, 0xd7, 0xae, 0x6b, 0x46, 0x75, 0xa3, 0xa1, 0x27,
+	0xce, 0x48, 0x09, 0xa6, 0xa2, 0xd7, 0xc0, 0x4d, 0xbe, 0x02, 0xc3, 0xeb, 0x7c, 0x08, 0x73, 0x73,
+	0x2e, 0xf2, 0xab, 0xe5, 0x75, 0x77, 0x9d, 0xe4, 0xf7, 0xa2, 0x63, 0x44, 0x24, 0x8a, 0xfc, 0xe3,
+	0x44, 0x3d, 0x72, 0xeb, 0x2d, 0x71, 0x30, 0xdc, 0xd1, 0x6b, 0x30, 0x82, 0x70, 0x6e, 0xe2, 0x92,
+	0x6d, 0xa9, 0xe3, 0x35, 0xb0, 0x24, 0xe6, 0xfe, 0x4a, 0xc1, 0x21, 0x06, 0x4c, 0x3f, 0x21, 0x30,
+	0xda, 0x39, 0x2d, 0x74, 0x3a, 0x04, 0x24, 0xec, 0xcd, 0xa5, 0xf3, 0x3d, 0xed, 0x78, 0x50, 0x59,
+	0xf9, 0xe0, 0x97, 0x3f, 0xee, 0x1d, 0x98, 0xa1, 0xd3, 0x6a, 0xf0, 0x7f, 0x17, 0xb0, 0xb1, 0x30,
+	0xd7, 0x2d, 0x75, 0x1b, 0x7f, 0x97, 0xdb, 0xf4, 0x6b, 0x02, 0xc7, 0x03, 0xd5, 0x26, 0xbd, 0x10,
+	0x13, 0x2c, 0xd4, 0xb2, 0x4b, 0x0b, 0x09, 0xad, 0x11, 0x70, 0x99, 0x01, 0x2a, 0xf4, 0x42, 0x04,
+	0x20, 0xab, 0x8d, 0x5b, 0xc8, 0x89, 0x57, 0x6e, 0x9b, 0xde, 0x27, 0x70, 0xc4, 0xdb, 0x09, 0xd3,
+	0xd9, 0x98, 0xa8, 0xfe, 0x6e, 0x5d, 0x9a, 0x4b, 0x62, 0x8a, 0x74, 0x59, 0x46, 0x37, 0x4f, 0x67,
+	0x23, 0xe8, 0xb0, 0x91, 0xf6, 0x2a, 0xb8, 0x43, 0xe0, 0xa8, 0xaf, 0xdf, 0xa4, 0x71, 0x01, 0x03,
+	0x1d, 0x8b, 0x34, 0x9f, 0xc8, 0x16, 0xe9, 0x16, 0x19, 0xdd, 0x1c, 0x9d, 0x11, 0xd3, 0xd9, 0xc5,
+	0x52, 0xab, 0xc8, 0x1a, 0x1b, 0x47, 0xb9, 0x9a, 0x61, 0xb6, 0xe9, 0x4f, 0x04, 0x52, 0xa2, 0x46,
+	0x8f, 0x66, 0x7b, 0x65, 0x2d, 0xd4, 0x9c, 0x4a, 0xb9, 0x7e, 0x5c, 0x90, 0xf8, 0x25, 0x46, 0x7c,
+	0x91, 0x2e, 0xc5, 0x65, 0xdb, 0xd0, 0x19, 0x39, 0x9f, 0xf2, 0x28, 0xfb, 0x43, 0x18, 0x9e, 0x0b,
+	0x9c, 0x0c, 0xde, 0xa7, 0x73, 0xae, 0x1f, 0x17, 0x84, 0x7f, 0x81, 0xc1, 0xe7, 0xe8, 0x62, 0x02,
+	0x78, 0xbf, 0xec, 0x1f, 0x13, 0x18, 0x71, 0x6f, 0x16, 0xfa, 0xbc, 0x38, 0x74, 0xa0, 0xa4, 0x95,
+	0xa6, 0x7b, 0x99, 0x21, 0x95, 0xca, 0xa8, 0x66, 0xe9, 0xf9, 0x10, 0x95, 0x7b, 0xe1, 0xab, 0xdb,
+	0x9e, 0xaf, 0x41, 0x9b, 0x3e, 0x26, 0x70, 0x4a, 0xdc, 0xb3, 0xd0, 0xa5, 0xf8, 0x98, 0xc2, 0x26,
+	0x4c, 0x5a, 0xee, 0xcf, 0x09, 0xb1, 0x5f, 0x66, 0xd8, 0x97, 0xe8, 0x72, 0x24, 0x76, 0xf7, 0x10,
+	0xe0, 0x25, 0xe0, 0x79, 0xff, 0x1f, 0x13, 0x38, 0x21, 0x68, 0x2d, 0xe8, 0xa2, 0x98, 0x25, 0xba,
+	0xf3, 0x91, 0xb2, 0x7d, 0x78, 0x20, 0xfa, 0x35, 0x86, 0xfe, 0x2a, 0xbd, 0x12, 0x42, 0x77, 0x8a,
+	0x55, 0x87, 0xba, 0xa3, 0xb7, 0x33, 0xd0, 0xf0, 0xeb, 0xaf, 0x6e, 0xb3, 0xc1, 0x36, 0x7d, 0x48,
+	0xe0, 0x78, 0xa0, 0x8b, 0x88, 0xba, 0x6a, 0xc5, 0x5d, 0x8f, 0xb4, 0x90, 0xd0, 0xba, 0xe7, 0xf9,
+	0x75, 0x88, 0x6c, 0x2f, 0x78, 0xe0, 0xc8, 0x7c, 0x41, 0xe0, 0x88, 0xb7, 0x88, 0x8f, 0xba, 0x6e,
+	0x05, 0xdd, 0x47, 0xd4, 0x75, 0x2b, 0xea, 0x09, 0x62, 0xce, 0x72, 0x87, 0x10, 0x15, 0x45, 0x0d,
+	0x1f, 0x10, 0x38, 0xe6, 0x2f, 0x97, 0x69, 0x8f, 0x1b, 0xd4, 0x57, 0xef, 0x4b, 0x17, 0x92, 0x19,
+	0x23, 0xde, 0x12, 0xc3, 0x5b, 0xa0, 0xf3, 0x31, 0xf7, 0x2d, 0xff, 0x22, 0x78, 0x8e, 0xea, 0x0e,
+	0x81, 0x31, 0x4f, 0x11, 0x4b, 0x67, 0xc4, 0x21, 0xc3, 0x85, 0xb5, 0x34, 0x9b, 0xc0, 0x12, 0xc9,
+	0x2e, 0x31, 0xb2, 0x45, 0xaa, 0x44, 0xbf, 0x4d, 0x81, 0x53, 0xc8, 0xea, 0x67, 0xda, 0x84, 0xc3,
+	0x7c, 0xaf, 0xf4, 0x6c, 0x9c, 0x12, 0x2e, 0xd1, 0xb9, 0x78, 0x23, 0x84, 0x99, 0x64, 0x30, 0xe3,
+	0xf4, 0x74, 0x84, 0x4c, 0xf4, 0x47, 0x02, 0x27, 0x04, 0x85, 0x56, 0xd4, 0xdb, 0x1b, 0x5d, 0xe9,
+	0x4a, 0xd9, 0x3e, 0x3c, 0x90, 0xee, 0x0a, 0xa3, 0xbb, 0x4c, 0x2f, 0x26, 0x96, 0x4a, 0xdf, 0xd2,
+	0xd7, 0x8a, 0x58, 0x03, 0xd2, 0x47, 0x04, 0x52, 0x82, 0xe5, 0x6d, 0x9a, 0x1c, 0xc5, 0xee, 0xf1,
+	0x11, 0x8a, 0x2b, 0x62, 0x13, 0x5c, 0xf7, 0x45, 0x2f, 0xaf, 0x9d, 0x57, 0x9e, 0xec, 0x66, 0xc8,
+	0xd3, 0xdd, 0x0c, 0xf9, 0x7d, 0x37, 0x43, 0x3e, 0xdd, 0xcb, 0x0c, 0x3d, 0xdd, 0xcb, 0x0c, 0xfd,
+	0xba, 0x97, 0x19, 0x7a, 0x07, 0xfb, 0x5a, 0xbb, 0xfc, 0xbe, 0x62, 0x58, 0xea, 0x16, 0x5f, 0xa9,
+	0x74, 0x98, 0xfd, 0x59, 0x68, 0xe9, 0xef, 0x01, 0x00, 0xc4, 0x76, 0xcb, 0x11, 0xf1, 0x1a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +1864,14 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ProposalExecFailure queries why the execution of a proposal failed.
+	//
+	// Since: cosmos-sdk 0.51
+	ProposalExecFailure(ctx context.Context, in *QueryProposalExecFailureRequest, opts ...grpc.CallOption) (*QueryProposalExecFailureResponse, error)
+	// ProposalExecFailures queries the execution failures of all proposals.
+	//
+	// Since: cosmos-sdk 0.51
+	ProposalExecFailures(ctx context.Context, in *QueryProposalExecFailuresRequest, opts ...grpc.CallOption) (*QueryProposalExecFailuresResponse, error)
 }
 
 type queryClient struct {
@@ -1787,6 +2008,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ProposalExecFailure(ctx context.Context, in *QueryProposalExecFailureRequest, opts ...grpc.CallOption) (*QueryProposalExecFailureResponse, error) {
+	out := new(QueryProposalExecFailureResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalExecFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalExecFailures(ctx context.Context, in *QueryProposalExecFailuresRequest, opts ...grpc.CallOption) (*QueryProposalExecFailuresResponse, error) {
+	out := new(QueryProposalExecFailuresResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalExecFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1823,6 +2062,14 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ProposalExecFailure queries why the execution of a proposal failed.
+	//
+	// Since: cosmos-sdk 0.51
+	ProposalExecFailure(context.Context, *QueryProposalExecFailureRequest) (*QueryProposalExecFailureResponse, error)
+	// ProposalExecFailures queries the execution failures of all proposals.
+	//
+	// Since: cosmos-sdk 0.51
+	ProposalExecFailures(context.Context, *QueryProposalExecFailuresRequest) (*QueryProposalExecFailuresResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1871,6 +2118,12 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) ProposalExecFailure(ctx context.Context, req *QueryProposalExecFailureRequest) (*QueryProposalExecFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecFailure not implemented")
+}
+func (*UnimplementedQueryServer) ProposalExecFailures(ctx context.Context, req *QueryProposalExecFailuresRequest) (*QueryProposalExecFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecFailures not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2128,6 +2381,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalExecFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalExecFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecFailure(ctx, req.(*QueryProposalExecFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalExecFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalExecFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecFailures(ctx, req.(*QueryProposalExecFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2188,6 +2477,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ProposalExecFailure",
+			Handler:    _Query_ProposalExecFailure_Handler,
+		},
+		{
+			MethodName: "ProposalExecFailures",
+			Handler:    _Query_ProposalExecFailures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3321,72 +3618,219 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryProposalExecFailureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGroupInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
-	}
-	return n
+
+func (m *QueryProposalExecFailureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProposalExecFailureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryProposalExecFailureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProposalExecFailureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalExecFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProposalExecFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalExecFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalExecFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalExecFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalExecFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalExecFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGroupInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryGroupInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.GroupId != 0 {
@@ -3777,6 +4221,63 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalExecFailureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalExecFailureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalExecFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalExecFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6671,6 +7172,367 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalExecFailureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecFailureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecFailureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalExecFailureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecFailureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &ProposalExecFailure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalExecFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalExecFailuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecFailuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, &ProposalExecFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -126,6 +126,21 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AcceptSwap mocks base method.
+func (m *MockBankKeeper) AcceptSwap(arg0 context.Context, arg1 *types.MsgAcceptSwap) (*types.MsgAcceptSwapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptSwap", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgAcceptSwapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptSwap indicates an expected call of AcceptSwap.
+func (mr *MockBankKeeperMockRecorder) AcceptSwap(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptSwap", reflect.TypeOf((*MockBankKeeper)(nil).AcceptSwap), arg0, arg1)
+}
+
 // Burn mocks base method.
 func (m *MockBankKeeper) Burn(arg0 context.Context, arg1 *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +156,36 @@ func (mr *MockBankKeeperMockRecorder) Burn(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockBankKeeper)(nil).Burn), arg0, arg1)
 }
 
+// CancelSwap mocks base method.
+func (m *MockBankKeeper) CancelSwap(arg0 context.Context, arg1 *types.MsgCancelSwap) (*types.MsgCancelSwapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSwap", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgCancelSwapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSwap indicates an expected call of CancelSwap.
+func (mr *MockBankKeeperMockRecorder) CancelSwap(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSwap", reflect.TypeOf((*MockBankKeeper)(nil).CancelSwap), arg0, arg1)
+}
+
+// CreateSwap mocks base method.
+func (m *MockBankKeeper) CreateSwap(arg0 context.Context, arg1 *types.MsgCreateSwap) (*types.MsgCreateSwapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSwap", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgCreateSwapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSwap indicates an expected call of CreateSwap.
+func (mr *MockBankKeeperMockRecorder) CreateSwap(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSwap", reflect.TypeOf((*MockBankKeeper)(nil).CreateSwap), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -199,6 +244,21 @@ func (mr *MockBankKeeperMockRecorder) Send(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBankKeeper)(nil).Send), arg0, arg1)
 }
 
+// SendAndCall mocks base method.
+func (m *MockBankKeeper) SendAndCall(arg0 context.Context, arg1 *types.MsgSendAndCall) (*types.MsgSendAndCallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndCall", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSendAndCallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendAndCall indicates an expected call of SendAndCall.
+func (mr *MockBankKeeperMockRecorder) SendAndCall(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndCall", reflect.TypeOf((*MockBankKeeper)(nil).SendAndCall), arg0, arg1)
+}
+
 // SendCoinsFromModuleToAccount mocks base method.
 func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -1377,6 +1377,120 @@ func (m *MsgExecResponse) GetResult() ProposalExecutorResult {
 	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
 }
 
+// MsgRetryExec is the Msg/RetryExec request type.
+//
+// Since: cosmos-sdk 0.51
+type MsgRetryExec struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// executor is the account address used to execute the proposal.
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *MsgRetryExec) Reset()         { *m = MsgRetryExec{} }
+func (m *MsgRetryExec) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryExec) ProtoMessage()    {}
+func (*MsgRetryExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{26}
+}
+func (m *MsgRetryExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryExec.Merge(m, src)
+}
+func (m *MsgRetryExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryExec proto.InternalMessageInfo
+
+func (m *MsgRetryExec) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgRetryExec) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+// MsgRetryExecResponse is the Msg/RetryExec response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgRetryExecResponse struct {
+	// result is the result of the retried execution.
+	Result ProposalExecutorResult `protobuf:"varint,1,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+	// failure is the failure of the retried execution, set if it failed.
+	Failure *ProposalExecFailure `protobuf:"bytes,2,opt,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (m *MsgRetryExecResponse) Reset()         { *m = MsgRetryExecResponse{} }
+func (m *MsgRetryExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryExecResponse) ProtoMessage()    {}
+func (*MsgRetryExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{27}
+}
+func (m *MsgRetryExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryExecResponse.Merge(m, src)
+}
+func (m *MsgRetryExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryExecResponse proto.InternalMessageInfo
+
+func (m *MsgRetryExecResponse) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (m *MsgRetryExecResponse) GetFailure() *ProposalExecFailure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
 // MsgLeaveGroup is the Msg/LeaveGroup request type.
 type MsgLeaveGroup struct {
 	// address is the account address of the group member.
@@ -1389,7 +1503,7 @@ func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
 func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroup) ProtoMessage()    {}
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
 func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1440,7 +1554,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{29}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1469,6 +1583,106 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgMigrateMultisigToGroupPolicy is the Msg/MigrateMultisigToGroupPolicy request type.
+//
+// Since: cosmos-sdk 0.51
+type MsgMigrateMultisigToGroupPolicy struct {
+	// address is the account address of the legacy threshold multisig account, which becomes the group policy account
+	// and the admin of the group and of the group policy.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// members defines the group members.
+	Members []MemberRequest `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
+	// group_metadata is any arbitrary metadata attached to the group.
+	GroupMetadata string `protobuf:"bytes,3,opt,name=group_metadata,json=groupMetadata,proto3" json:"group_metadata,omitempty"`
+	// group_policy_metadata is any arbitrary metadata attached to the group policy.
+	GroupPolicyMetadata string `protobuf:"bytes,4,opt,name=group_policy_metadata,json=groupPolicyMetadata,proto3" json:"group_policy_metadata,omitempty"`
+	// decision_policy specifies the group policy's decision policy.
+	DecisionPolicy *types.Any `protobuf:"bytes,5,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+}
+
+func (m *MsgMigrateMultisigToGroupPolicy) Reset()         { *m = MsgMigrateMultisigToGroupPolicy{} }
+func (m *MsgMigrateMultisigToGroupPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateMultisigToGroupPolicy) ProtoMessage()    {}
+func (*MsgMigrateMultisigToGroupPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgMigrateMultisigToGroupPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateMultisigToGroupPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateMultisigToGroupPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateMultisigToGroupPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateMultisigToGroupPolicy.Merge(m, src)
+}
+func (m *MsgMigrateMultisigToGroupPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateMultisigToGroupPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateMultisigToGroupPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateMultisigToGroupPolicy proto.InternalMessageInfo
+
+// MsgMigrateMultisigToGroupPolicyResponse is the Msg/MigrateMultisigToGroupPolicy response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgMigrateMultisigToGroupPolicyResponse struct {
+	// group_id is the unique ID of the newly created group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *MsgMigrateMultisigToGroupPolicyResponse) Reset() {
+	*m = MsgMigrateMultisigToGroupPolicyResponse{}
+}
+func (m *MsgMigrateMultisigToGroupPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateMultisigToGroupPolicyResponse) ProtoMessage()    {}
+func (*MsgMigrateMultisigToGroupPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgMigrateMultisigToGroupPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateMultisigToGroupPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateMultisigToGroupPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateMultisigToGroupPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateMultisigToGroupPolicyResponse.Merge(m, src)
+}
+func (m *MsgMigrateMultisigToGroupPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateMultisigToGroupPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateMultisigToGroupPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateMultisigToGroupPolicyResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateMultisigToGroupPolicyResponse) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1497,104 +1711,117 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
+	proto.RegisterType((*MsgRetryExec)(nil), "cosmos.group.v1.MsgRetryExec")
+	proto.RegisterType((*MsgRetryExecResponse)(nil), "cosmos.group.v1.MsgRetryExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgMigrateMultisigToGroupPolicy)(nil), "cosmos.group.v1.MsgMigrateMultisigToGroupPolicy")
+	proto.RegisterType((*MsgMigrateMultisigToGroupPolicyResponse)(nil), "cosmos.group.v1.MsgMigrateMultisigToGroupPolicyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0xce, 0xd7, 0x0b, 0x75, 0x93, 0x6d, 0xd2, 0x6e, 0xb6, 0xad, 0xed, 0x4e, 0x3f,
-	0x92, 0x5a, 0x8d, 0xdd, 0x38, 0xb4, 0x12, 0x06, 0x09, 0x35, 0xa9, 0x41, 0x41, 0x18, 0xa2, 0x6d,
-	0x4b, 0x81, 0x8b, 0xd9, 0x64, 0xb7, 0xcb, 0xaa, 0xb1, 0xd7, 0x78, 0xd6, 0x69, 0x72, 0xe3, 0xe3,
-	0x02, 0x08, 0x09, 0x24, 0xf8, 0x03, 0xe0, 0xc6, 0xb1, 0x48, 0x3d, 0x70, 0xe3, 0x86, 0xaa, 0x72,
-	0xa9, 0x38, 0x71, 0x42, 0xd0, 0x0a, 0xf5, 0xc6, 0xbf, 0x00, 0xda, 0x99, 0xdd, 0xf1, 0x8e, 0x77,
-	0xd7, 0xbb, 0xb1, 0x2c, 0xb8, 0x44, 0xde, 0x79, 0xbf, 0xf7, 0xf5, 0x7b, 0x6f, 0xde, 0xcc, 0x04,
-	0xa4, 0x1d, 0x0b, 0x37, 0x2d, 0x5c, 0x36, 0x3a, 0x56, 0xb7, 0x5d, 0xde, 0x5b, 0x2d, 0xdb, 0xfb,
-	0xa5, 0x76, 0xc7, 0xb2, 0x2d, 0xf1, 0x28, 0x95, 0x94, 0x88, 0xa4, 0xb4, 0xb7, 0x2a, 0xcf, 0x1b,
-	0x96, 0x61, 0x11, 0x59, 0xd9, 0xf9, 0x45, 0x61, 0xf2, 0x22, 0x85, 0x35, 0xa8, 0xc0, 0xd5, 0x71,
-	0x45, 0x86, 0x65, 0x19, 0xbb, 0x7a, 0x99, 0x7c, 0x6d, 0x77, 0xef, 0x94, 0xd5, 0xd6, 0x81, 0x2b,
-	0x3a, 0x19, 0x70, 0x7b, 0xd0, 0xd6, 0x3d, 0xbd, 0x13, 0xae, 0xb0, 0x89, 0x0d, 0x47, 0xd4, 0xc4,
-	0x86, 0x2b, 0x98, 0x53, 0x9b, 0x66, 0xcb, 0x2a, 0x93, 0xbf, 0x74, 0x09, 0xfd, 0x22, 0x40, 0xb6,
-	0x8e, 0x8d, 0x8d, 0x8e, 0xae, 0xda, 0xfa, 0xab, 0x8e, 0x35, 0xb1, 0x04, 0xe3, 0xaa, 0xd6, 0x34,
-	0x5b, 0x92, 0x50, 0x10, 0x96, 0xa7, 0xd7, 0xa5, 0x5f, 0x1f, 0xac, 0xcc, 0xbb, 0x71, 0x5d, 0xd3,
-	0xb4, 0x8e, 0x8e, 0xf1, 0x0d, 0xbb, 0x63, 0xb6, 0x0c, 0x85, 0xc2, 0xc4, 0x0d, 0x98, 0x6c, 0xea,
-	0xcd, 0x6d, 0xbd, 0x83, 0xa5, 0x54, 0x21, 0xbd, 0x3c, 0x53, 0xc9, 0x95, 0xfa, 0x52, 0x2f, 0xd5,
-	0x89, 0x5c, 0xd1, 0x3f, 0xe8, 0xea, 0xd8, 0x5e, 0x9f, 0x7e, 0xf8, 0x7b, 0x7e, 0xec, 0xfb, 0x67,
-	0xf7, 0x8b, 0x82, 0xe2, 0x69, 0x8a, 0x32, 0x4c, 0x35, 0x75, 0x5b, 0xd5, 0x54, 0x5b, 0x95, 0xd2,
-	0x8e, 0x5f, 0x85, 0x7d, 0x57, 0x97, 0x3f, 0x7e, 0x76, 0xbf, 0x48, 0x9d, 0x7d, 0xfe, 0xec, 0x7e,
-	0xd1, 0x65, 0x6c, 0x05, 0x6b, 0x77, 0xcb, 0x7c, 0xe8, 0x68, 0x0d, 0x8e, 0xf3, 0x2b, 0x8a, 0x8e,
-	0xdb, 0x56, 0x0b, 0xeb, 0xe2, 0x22, 0x4c, 0x91, 0x68, 0x1a, 0xa6, 0x46, 0xf2, 0xca, 0x28, 0x93,
-	0xe4, 0x7b, 0x53, 0x43, 0x7f, 0x09, 0xb0, 0x50, 0xc7, 0xc6, 0xad, 0xb6, 0xe6, 0x69, 0xd5, 0xdd,
-	0xa0, 0x0e, 0xcb, 0x84, 0xdf, 0x49, 0x8a, 0x73, 0x22, 0x6e, 0x41, 0x96, 0xa6, 0xda, 0xe8, 0x12,
-	0x3f, 0x58, 0x4a, 0x1f, 0x96, 0xab, 0x23, 0xd4, 0x00, 0x8d, 0x13, 0x57, 0xcb, 0x3c, 0x2b, 0x05,
-	0x9e, 0x95, 0x60, 0x36, 0x28, 0x0f, 0xa7, 0x43, 0x05, 0x1e, 0x47, 0xe8, 0x67, 0x01, 0x8e, 0xf1,
-	0x88, 0x6b, 0x24, 0xad, 0x11, 0xd2, 0x70, 0x05, 0xa6, 0x5b, 0xfa, 0xbd, 0x06, 0x35, 0x97, 0x8e,
-	0x31, 0x37, 0xd5, 0xd2, 0xef, 0x91, 0x08, 0xaa, 0x2b, 0x7c, 0xae, 0xb9, 0xc8, 0x5c, 0x09, 0x1c,
-	0x9d, 0x86, 0x93, 0x21, 0xcb, 0x2c, 0xcf, 0x1f, 0x04, 0xd2, 0x26, 0x1c, 0x13, 0xb4, 0xd5, 0x46,
-	0x99, 0xea, 0xa0, 0x8e, 0xbe, 0xcc, 0xe7, 0x73, 0x66, 0x40, 0xed, 0xa8, 0x06, 0x2a, 0x40, 0x2e,
-	0x5c, 0xc2, 0xb2, 0xfa, 0x26, 0x05, 0xf3, 0x7c, 0xf3, 0x6f, 0x59, 0xbb, 0xe6, 0xce, 0xc1, 0x7f,
-	0x94, 0x93, 0xa8, 0xc2, 0x51, 0x4d, 0xdf, 0x31, 0xb1, 0x69, 0xb5, 0x1a, 0x6d, 0xe2, 0x59, 0xca,
-	0x14, 0x84, 0xe5, 0x99, 0xca, 0x7c, 0x89, 0xce, 0xb1, 0x92, 0x37, 0xc7, 0x4a, 0xd7, 0x5a, 0x07,
-	0xeb, 0xe8, 0xd1, 0x83, 0x95, 0x5c, 0x7f, 0xef, 0x5f, 0x77, 0x0d, 0xd0, 0xc8, 0x95, 0xac, 0xc6,
-	0x7d, 0x57, 0x2b, 0x9f, 0x7e, 0x9b, 0x1f, 0xe3, 0xa9, 0xcb, 0x47, 0x0e, 0x03, 0xaa, 0x83, 0x14,
-	0x38, 0x15, 0xb6, 0xce, 0x06, 0x43, 0x05, 0x26, 0x55, 0xca, 0x42, 0x2c, 0x3f, 0x1e, 0x10, 0x7d,
-	0x92, 0x82, 0x45, 0xbe, 0x1a, 0xd4, 0xe8, 0x70, 0xdb, 0xe5, 0x35, 0x98, 0xa7, 0x7c, 0x53, 0xd6,
-	0x1a, 0x5e, 0x38, 0xa9, 0x18, 0x75, 0xd1, 0xf0, 0x7b, 0x26, 0x92, 0x61, 0xf7, 0xd7, 0x1a, 0x4f,
-	0xea, 0xb9, 0xc8, 0x7e, 0xf4, 0xe5, 0x89, 0xce, 0xc2, 0x99, 0x48, 0x21, 0xeb, 0xca, 0x1f, 0xd3,
-	0x20, 0xf1, 0xfc, 0xdf, 0x36, 0xed, 0xf7, 0x87, 0xec, 0xcc, 0x91, 0x9c, 0x34, 0xe7, 0x21, 0x4b,
-	0xe9, 0xee, 0xeb, 0xe4, 0x23, 0x06, 0x37, 0x09, 0x2a, 0xb0, 0xc0, 0x55, 0x85, 0xa1, 0x33, 0x04,
-	0x7d, 0xcc, 0x47, 0x3e, 0xd3, 0x59, 0xed, 0xd3, 0x51, 0xb1, 0x5b, 0x89, 0xf1, 0x82, 0xb0, 0x3c,
-	0xc5, 0x17, 0x0c, 0xd3, 0x66, 0x09, 0xd9, 0x35, 0x13, 0x23, 0xde, 0x35, 0x57, 0x83, 0xbb, 0xe6,
-	0x6c, 0xe4, 0xae, 0xe9, 0x55, 0x07, 0x7d, 0x26, 0x40, 0x21, 0x4a, 0x98, 0xe0, 0x5c, 0x1d, 0x65,
-	0x5f, 0xa3, 0x9f, 0x52, 0x80, 0xc2, 0x9a, 0x8d, 0x4f, 0xfd, 0x7f, 0xdd, 0x7a, 0x21, 0x95, 0x4c,
-	0x8f, 0xb8, 0x92, 0xd5, 0x60, 0x25, 0x97, 0x22, 0xb7, 0x2a, 0x6f, 0x0b, 0x5d, 0x82, 0x62, 0x3c,
-	0x81, 0x6c, 0xdb, 0xfe, 0x2d, 0x90, 0xb1, 0x19, 0x80, 0x0f, 0x7d, 0x50, 0x8e, 0x92, 0xe9, 0x41,
-	0x27, 0xeb, 0xd5, 0xa4, 0xf4, 0xf0, 0xf9, 0xa0, 0x0b, 0x70, 0x6e, 0x90, 0x9c, 0x11, 0xf3, 0x67,
-	0x0a, 0xe6, 0xea, 0xd8, 0xb8, 0xd1, 0xdd, 0x6e, 0x9a, 0xf6, 0x56, 0xc7, 0x6a, 0x5b, 0x58, 0xdd,
-	0x8d, 0xcc, 0x4e, 0x18, 0x22, 0xbb, 0x53, 0x30, 0xdd, 0x26, 0x76, 0xbd, 0x31, 0x37, 0xad, 0xf4,
-	0x16, 0x06, 0x9e, 0xc0, 0x97, 0x1d, 0x19, 0xc6, 0xaa, 0xa1, 0x63, 0x29, 0x43, 0xe6, 0x63, 0x68,
-	0xeb, 0x29, 0x0c, 0x25, 0x5e, 0x84, 0x8c, 0xbe, 0xaf, 0xef, 0x90, 0xf9, 0x94, 0xad, 0x2c, 0x04,
-	0xa6, 0x69, 0x6d, 0x5f, 0xdf, 0x51, 0x08, 0x44, 0x9c, 0x87, 0x71, 0xdb, 0xb4, 0x77, 0x75, 0x32,
-	0x9e, 0xa6, 0x15, 0xfa, 0x21, 0x4a, 0x30, 0x89, 0xbb, 0xcd, 0xa6, 0xda, 0x39, 0x90, 0x26, 0xc9,
-	0xba, 0xf7, 0x59, 0x7d, 0xc1, 0xeb, 0xd5, 0x5e, 0xf0, 0x4e, 0x41, 0x90, 0xaf, 0x20, 0xf4, 0xf1,
-	0x12, 0x60, 0x13, 0xbd, 0x44, 0x4e, 0x57, 0x7e, 0x91, 0x0d, 0x9c, 0x3c, 0xcc, 0xb4, 0xdd, 0xb5,
-	0xde, 0xcc, 0x01, 0x6f, 0x69, 0x53, 0x43, 0xdf, 0xd1, 0x5b, 0xac, 0x33, 0xab, 0xb4, 0x8e, 0x7a,
-	0x8f, 0xd5, 0x28, 0x4e, 0xd1, 0x7f, 0x13, 0x48, 0x25, 0xbc, 0x09, 0x54, 0xaf, 0x38, 0x19, 0x7a,
-	0x5f, 0xfd, 0x47, 0x27, 0xcb, 0xaf, 0x3f, 0x16, 0xf7, 0x82, 0xda, 0xbf, 0xcc, 0x9a, 0xec, 0x1f,
-	0x01, 0x26, 0xeb, 0xd8, 0x78, 0xcb, 0xb2, 0xe3, 0xf3, 0x75, 0x76, 0xe2, 0x9e, 0x65, 0xeb, 0x9d,
-	0xd8, 0xa0, 0x29, 0x4c, 0x5c, 0x83, 0x09, 0xab, 0x6d, 0x9b, 0x16, 0xbd, 0x1f, 0x64, 0x2b, 0x27,
-	0x03, 0x55, 0x77, 0xfc, 0xbe, 0x49, 0x20, 0x8a, 0x0b, 0xe5, 0xda, 0x2e, 0xd3, 0xd7, 0x76, 0xc9,
-	0x9b, 0xa8, 0xba, 0x44, 0x76, 0x27, 0x89, 0xc3, 0x21, 0x4b, 0x0a, 0x23, 0xcb, 0xf1, 0x8e, 0xe6,
-	0xe0, 0xa8, 0xfb, 0x93, 0x91, 0xf2, 0x05, 0x25, 0xc5, 0xb1, 0x16, 0x4f, 0xca, 0xf3, 0x30, 0xe5,
-	0x38, 0xec, 0xda, 0x56, 0x3c, 0x2f, 0x0c, 0x59, 0x2d, 0x3a, 0xe1, 0xb1, 0xcf, 0xc8, 0x08, 0x9d,
-	0x10, 0x90, 0x42, 0x22, 0x24, 0xb9, 0x79, 0xad, 0xf9, 0x32, 0x4c, 0x74, 0x74, 0xdc, 0xdd, 0xb5,
-	0x89, 0xcb, 0x6c, 0x65, 0x29, 0x40, 0x85, 0x57, 0xe9, 0x9a, 0xeb, 0x42, 0x21, 0x70, 0xc5, 0x55,
-	0x43, 0x5f, 0x0a, 0x70, 0xa4, 0x8e, 0x8d, 0xd7, 0x75, 0x75, 0xcf, 0x7d, 0x8b, 0x0f, 0x71, 0x3b,
-	0x1d, 0x70, 0x7f, 0xa7, 0x6f, 0x46, 0x7f, 0xbb, 0xe6, 0xc2, 0xf2, 0xeb, 0xf9, 0x47, 0x27, 0xc8,
-	0xd3, 0xb8, 0xb7, 0xe0, 0xe5, 0x5a, 0x2c, 0x42, 0xa6, 0x46, 0xc7, 0xc2, 0x6c, 0xed, 0xed, 0xda,
-	0x46, 0xe3, 0xd6, 0x1b, 0x37, 0xb6, 0x6a, 0x1b, 0x9b, 0xaf, 0x6c, 0xd6, 0xae, 0xcf, 0x8e, 0x89,
-	0xcf, 0xc1, 0x14, 0x59, 0xbd, 0xa9, 0xbc, 0x33, 0x2b, 0x54, 0x1e, 0xcd, 0x40, 0xba, 0x8e, 0x0d,
-	0xf1, 0x36, 0xcc, 0xf8, 0xff, 0xcf, 0x90, 0x0f, 0x5e, 0xde, 0xb8, 0xdb, 0x86, 0xbc, 0x14, 0x03,
-	0x60, 0xc4, 0xef, 0x82, 0x18, 0xf2, 0x7a, 0xbf, 0x10, 0xa6, 0x1e, 0xc4, 0xc9, 0xa5, 0x64, 0x38,
-	0xe6, 0xed, 0x0e, 0xcc, 0x06, 0x9e, 0xc8, 0xe7, 0x62, 0x6c, 0x10, 0x94, 0x7c, 0x29, 0x09, 0x8a,
-	0xf9, 0xb1, 0xe0, 0x58, 0xd8, 0x13, 0x75, 0x29, 0x36, 0x5c, 0x0a, 0x94, 0xcb, 0x09, 0x81, 0xcc,
-	0xa1, 0x09, 0x73, 0xc1, 0xd7, 0xe3, 0xf9, 0x98, 0x22, 0x50, 0x98, 0xbc, 0x92, 0x08, 0xc6, 0x5c,
-	0x75, 0x61, 0x21, 0xfc, 0x49, 0x70, 0x31, 0xc6, 0x4e, 0x0f, 0x2a, 0xaf, 0x26, 0x86, 0x32, 0xb7,
-	0xfb, 0x70, 0x3c, 0xe2, 0xd1, 0x56, 0x8c, 0x21, 0xcb, 0x87, 0x95, 0x2b, 0xc9, 0xb1, 0xcc, 0xf3,
-	0xd7, 0x02, 0xe4, 0xe3, 0x6e, 0xaf, 0x6b, 0x89, 0xec, 0xf2, 0x4a, 0xf2, 0x8b, 0x43, 0x28, 0xb1,
-	0xa8, 0x3e, 0x12, 0x60, 0x31, 0xfa, 0x8e, 0xb7, 0x92, 0xc8, 0x34, 0xeb, 0xb7, 0x2b, 0x87, 0x82,
-	0xb3, 0x18, 0xde, 0x83, 0x6c, 0xdf, 0x6d, 0x0a, 0x85, 0x19, 0xe2, 0x31, 0x72, 0x31, 0x1e, 0xe3,
-	0xdf, 0xb0, 0x81, 0xdb, 0x40, 0xe8, 0x86, 0xed, 0x47, 0x85, 0x6f, 0xd8, 0xa8, 0x63, 0x5b, 0x5c,
-	0x87, 0x0c, 0x39, 0xb2, 0xa5, 0x30, 0x2d, 0x47, 0x22, 0x17, 0xa2, 0x24, 0x7e, 0x1b, 0x64, 0xae,
-	0x86, 0xda, 0x70, 0x24, 0xe1, 0x36, 0xb8, 0x73, 0xe8, 0x26, 0x80, 0xef, 0x08, 0xc9, 0x85, 0xe1,
-	0x7b, 0x72, 0xf9, 0xc2, 0x60, 0xb9, 0x67, 0x55, 0x1e, 0xff, 0xd0, 0x79, 0x47, 0xaf, 0x97, 0x1e,
-	0x3e, 0xc9, 0x09, 0x8f, 0x9f, 0xe4, 0x84, 0x3f, 0x9e, 0xe4, 0x84, 0xaf, 0x9e, 0xe6, 0xc6, 0x1e,
-	0x3f, 0xcd, 0x8d, 0xfd, 0xf6, 0x34, 0x37, 0xf6, 0xae, 0x7b, 0x2c, 0x61, 0xed, 0x6e, 0xc9, 0xb4,
-	0xca, 0xfb, 0xf4, 0x3c, 0xd9, 0x9e, 0x20, 0x57, 0xcf, 0xb5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xaa, 0x49, 0x9b, 0xdc, 0x29, 0x17, 0x00, 0x00,
+	// 1575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0x93, 0xf4, 0xeb, 0x74, 0x4d, 0x5b, 0xaf, 0xdd, 0x52, 0x6f, 0x4d, 0xb2, 0xbb, 0x6e,
+	0xed, 0xa2, 0x35, 0x59, 0x53, 0x36, 0x8d, 0x80, 0x40, 0x6b, 0x97, 0xa1, 0x22, 0x02, 0xc5, 0xdb,
+	0x18, 0xf0, 0x12, 0xdc, 0xc6, 0x33, 0xd6, 0x92, 0x38, 0xf8, 0x3a, 0x5d, 0xfb, 0xc6, 0xc7, 0x0b,
+	0x4c, 0x48, 0x20, 0x40, 0xf0, 0x0a, 0x6f, 0x88, 0xa7, 0x21, 0xed, 0x81, 0x37, 0xde, 0xd0, 0x04,
+	0x2f, 0x13, 0x4f, 0x3c, 0x21, 0xd8, 0x84, 0xf6, 0xc6, 0xbf, 0x00, 0xf2, 0xbd, 0xf6, 0x8d, 0x1d,
+	0xdb, 0xb1, 0x1b, 0x85, 0x8f, 0x97, 0x28, 0xbe, 0xe7, 0x77, 0xbe, 0x7e, 0xe7, 0xdc, 0x7b, 0x8f,
+	0x0d, 0xa9, 0x1d, 0x0d, 0x37, 0x34, 0x5c, 0x50, 0x74, 0xad, 0xdd, 0x2a, 0xec, 0xae, 0x16, 0x8c,
+	0xbd, 0x7c, 0x4b, 0xd7, 0x0c, 0x8d, 0x9f, 0xa2, 0x92, 0x3c, 0x91, 0xe4, 0x77, 0x57, 0x85, 0x59,
+	0x45, 0x53, 0x34, 0x22, 0x2b, 0x98, 0xff, 0x28, 0x4c, 0x98, 0xa7, 0xb0, 0x2a, 0x15, 0x58, 0x3a,
+	0x96, 0x48, 0xd1, 0x34, 0xa5, 0x2e, 0x17, 0xc8, 0xd3, 0x76, 0xfb, 0x66, 0x41, 0x6a, 0xee, 0x5b,
+	0xa2, 0x63, 0x1e, 0xb7, 0xfb, 0x2d, 0xd9, 0xd6, 0x3b, 0x6a, 0x09, 0x1b, 0x58, 0x31, 0x45, 0x0d,
+	0xac, 0x58, 0x82, 0x19, 0xa9, 0xa1, 0x36, 0xb5, 0x02, 0xf9, 0xa5, 0x4b, 0xe8, 0x27, 0x0e, 0x92,
+	0x15, 0xac, 0x6c, 0xe8, 0xb2, 0x64, 0xc8, 0xcf, 0x99, 0xd6, 0xf8, 0x3c, 0x0c, 0x4b, 0xb5, 0x86,
+	0xda, 0x4c, 0x71, 0x59, 0x6e, 0x79, 0x7c, 0x3d, 0xf5, 0xf3, 0xbd, 0x95, 0x59, 0x2b, 0xae, 0x4b,
+	0xb5, 0x9a, 0x2e, 0x63, 0x7c, 0xd5, 0xd0, 0xd5, 0xa6, 0x22, 0x52, 0x18, 0xbf, 0x01, 0xa3, 0x0d,
+	0xb9, 0xb1, 0x2d, 0xeb, 0x38, 0x15, 0xcb, 0xc6, 0x97, 0x27, 0x8a, 0xe9, 0x7c, 0x57, 0xea, 0xf9,
+	0x0a, 0x91, 0x8b, 0xf2, 0x5b, 0x6d, 0x19, 0x1b, 0xeb, 0xe3, 0xf7, 0x7f, 0xcd, 0x0c, 0x7d, 0xfd,
+	0xf8, 0x6e, 0x8e, 0x13, 0x6d, 0x4d, 0x5e, 0x80, 0xb1, 0x86, 0x6c, 0x48, 0x35, 0xc9, 0x90, 0x52,
+	0x71, 0xd3, 0xaf, 0xc8, 0x9e, 0x4b, 0xcb, 0xef, 0x3e, 0xbe, 0x9b, 0xa3, 0xce, 0xee, 0x3c, 0xbe,
+	0x9b, 0xb3, 0x18, 0x5b, 0xc1, 0xb5, 0x5b, 0x05, 0x77, 0xe8, 0x68, 0x0d, 0x8e, 0xb8, 0x57, 0x44,
+	0x19, 0xb7, 0xb4, 0x26, 0x96, 0xf9, 0x79, 0x18, 0x23, 0xd1, 0x54, 0xd5, 0x1a, 0xc9, 0x2b, 0x21,
+	0x8e, 0x92, 0xe7, 0xcd, 0x1a, 0xfa, 0x83, 0x83, 0xb9, 0x0a, 0x56, 0xae, 0xb7, 0x6a, 0xb6, 0x56,
+	0xc5, 0x0a, 0xea, 0xa0, 0x4c, 0x38, 0x9d, 0xc4, 0x5c, 0x4e, 0xf8, 0x2d, 0x48, 0xd2, 0x54, 0xab,
+	0x6d, 0xe2, 0x07, 0xa7, 0xe2, 0x07, 0xe5, 0x6a, 0x92, 0x1a, 0xa0, 0x71, 0xe2, 0x52, 0xc1, 0xcd,
+	0x4a, 0xd6, 0xcd, 0x8a, 0x37, 0x1b, 0x94, 0x81, 0x05, 0x5f, 0x81, 0xcd, 0x11, 0xfa, 0x81, 0x83,
+	0xc3, 0x6e, 0xc4, 0x25, 0x92, 0xd6, 0x00, 0x69, 0x38, 0x0f, 0xe3, 0x4d, 0xf9, 0x76, 0x95, 0x9a,
+	0x8b, 0x87, 0x98, 0x1b, 0x6b, 0xca, 0xb7, 0x49, 0x04, 0xa5, 0x15, 0x77, 0xae, 0xe9, 0xc0, 0x5c,
+	0x09, 0x1c, 0x2d, 0xc0, 0x31, 0x9f, 0x65, 0x96, 0xe7, 0xb7, 0x1c, 0x1c, 0x71, 0xcb, 0x2b, 0x56,
+	0xab, 0x0d, 0x32, 0xd5, 0x5e, 0x1d, 0x7d, 0xce, 0x9d, 0xcf, 0x89, 0x1e, 0xb5, 0xa3, 0x1a, 0x28,
+	0x0b, 0x69, 0x7f, 0x09, 0xcb, 0xea, 0xb3, 0x18, 0xcc, 0xba, 0x9b, 0x7f, 0x4b, 0xab, 0xab, 0x3b,
+	0xfb, 0xff, 0x52, 0x4e, 0xbc, 0x04, 0x53, 0x35, 0x79, 0x47, 0xc5, 0xaa, 0xd6, 0xac, 0xb6, 0x88,
+	0xe7, 0x54, 0x22, 0xcb, 0x2d, 0x4f, 0x14, 0x67, 0xf3, 0xf4, 0x1c, 0xcb, 0xdb, 0xe7, 0x58, 0xfe,
+	0x52, 0x73, 0x7f, 0x1d, 0xfd, 0x78, 0x6f, 0x25, 0xdd, 0xdd, 0xfb, 0x97, 0x2d, 0x03, 0x34, 0x72,
+	0x31, 0x59, 0x73, 0x3d, 0x97, 0x8a, 0xef, 0x7f, 0x99, 0x19, 0x72, 0x53, 0x97, 0x09, 0x3c, 0x0c,
+	0xa8, 0x0e, 0x12, 0xe1, 0xb8, 0xdf, 0x3a, 0x3b, 0x18, 0x8a, 0x30, 0x2a, 0x51, 0x16, 0x42, 0xf9,
+	0xb1, 0x81, 0xe8, 0xbd, 0x18, 0xcc, 0xbb, 0xab, 0x41, 0x8d, 0xf6, 0xb7, 0x5d, 0x9e, 0x87, 0x59,
+	0xca, 0x37, 0x65, 0xad, 0x6a, 0x87, 0x13, 0x0b, 0x51, 0xe7, 0x15, 0xa7, 0x67, 0x22, 0xe9, 0x77,
+	0x7f, 0xad, 0xb9, 0x49, 0x5d, 0x0c, 0xec, 0x47, 0x47, 0x9e, 0xe8, 0x24, 0x9c, 0x08, 0x14, 0xb2,
+	0xae, 0xfc, 0x2e, 0x0e, 0x29, 0x37, 0xff, 0x37, 0x54, 0xe3, 0xcd, 0x3e, 0x3b, 0x73, 0x20, 0x37,
+	0xcd, 0x29, 0x48, 0x52, 0xba, 0xbb, 0x3a, 0x79, 0x52, 0x71, 0x9d, 0x04, 0x45, 0x98, 0x73, 0x55,
+	0x85, 0xa1, 0x13, 0x04, 0x7d, 0xd8, 0x41, 0x3e, 0xd3, 0x59, 0xed, 0xd2, 0x91, 0xb0, 0x55, 0x89,
+	0xe1, 0x2c, 0xb7, 0x3c, 0xe6, 0x2e, 0x18, 0xa6, 0xcd, 0xe2, 0xb3, 0x6b, 0x46, 0x06, 0xbc, 0x6b,
+	0x2e, 0x78, 0x77, 0xcd, 0xc9, 0xc0, 0x5d, 0xd3, 0xa9, 0x0e, 0xfa, 0x80, 0x83, 0x6c, 0x90, 0x30,
+	0xc2, 0xbd, 0x3a, 0xc8, 0xbe, 0x46, 0xdf, 0xc7, 0x00, 0xf9, 0x35, 0x9b, 0x3b, 0xf5, 0xff, 0x74,
+	0xeb, 0xf9, 0x54, 0x32, 0x3e, 0xe0, 0x4a, 0x96, 0xbc, 0x95, 0x5c, 0x0a, 0xdc, 0xaa, 0x6e, 0x5b,
+	0xe8, 0x2c, 0xe4, 0xc2, 0x09, 0x64, 0xdb, 0xf6, 0x4f, 0x0e, 0x8e, 0xfb, 0xc1, 0xfb, 0xbe, 0x28,
+	0x07, 0xc9, 0x74, 0xaf, 0x9b, 0xf5, 0x42, 0x54, 0x7a, 0xdc, 0xf9, 0xa0, 0xd3, 0xb0, 0xd8, 0x4b,
+	0xce, 0x88, 0xf9, 0x3d, 0x06, 0x33, 0x15, 0xac, 0x5c, 0x6d, 0x6f, 0x37, 0x54, 0x63, 0x4b, 0xd7,
+	0x5a, 0x1a, 0x96, 0xea, 0x81, 0xd9, 0x71, 0x7d, 0x64, 0x77, 0x1c, 0xc6, 0x5b, 0xc4, 0xae, 0x7d,
+	0xcc, 0x8d, 0x8b, 0x9d, 0x85, 0x9e, 0x37, 0xf0, 0x39, 0x53, 0x86, 0xb1, 0xa4, 0xc8, 0x38, 0x95,
+	0xc8, 0xc6, 0x83, 0x5a, 0x4f, 0x64, 0x28, 0xfe, 0x0c, 0x24, 0xe4, 0x3d, 0x79, 0x87, 0x9c, 0x4f,
+	0xc9, 0xe2, 0x9c, 0xe7, 0x34, 0x2d, 0xef, 0xc9, 0x3b, 0x22, 0x81, 0xf0, 0xb3, 0x30, 0x6c, 0xa8,
+	0x46, 0x5d, 0x26, 0xc7, 0xd3, 0xb8, 0x48, 0x1f, 0xf8, 0x14, 0x8c, 0xe2, 0x76, 0xa3, 0x21, 0xe9,
+	0xfb, 0xa9, 0x51, 0xb2, 0x6e, 0x3f, 0x96, 0x9e, 0xb4, 0x7b, 0xb5, 0x13, 0xbc, 0x59, 0x10, 0xe4,
+	0x28, 0x08, 0x7d, 0x79, 0xf1, 0xb0, 0x89, 0x9e, 0x86, 0x79, 0xcf, 0x22, 0x3b, 0x70, 0x32, 0x30,
+	0xd1, 0xb2, 0xd6, 0x3a, 0x67, 0x0e, 0xd8, 0x4b, 0x9b, 0x35, 0xf4, 0x15, 0x9d, 0x62, 0xcd, 0xb3,
+	0xaa, 0xa6, 0x4b, 0xb7, 0x59, 0x8d, 0xc2, 0x14, 0x9d, 0x93, 0x40, 0x2c, 0xe2, 0x24, 0x50, 0x3a,
+	0x6f, 0x66, 0x68, 0x3f, 0x75, 0x5f, 0x9d, 0x2c, 0xbf, 0xee, 0x58, 0xac, 0x01, 0xb5, 0x7b, 0x99,
+	0x35, 0xd9, 0x5f, 0x1c, 0x8c, 0x56, 0xb0, 0xf2, 0x8a, 0x66, 0x84, 0xe7, 0x6b, 0xee, 0xc4, 0x5d,
+	0xcd, 0x90, 0xf5, 0xd0, 0xa0, 0x29, 0x8c, 0x5f, 0x83, 0x11, 0xad, 0x65, 0xa8, 0x1a, 0x9d, 0x0f,
+	0x92, 0xc5, 0x63, 0x9e, 0xaa, 0x9b, 0x7e, 0x5f, 0x22, 0x10, 0xd1, 0x82, 0xba, 0xda, 0x2e, 0xd1,
+	0xd5, 0x76, 0xd1, 0x9b, 0xa8, 0xb4, 0x44, 0x76, 0x27, 0x89, 0xc3, 0x24, 0x2b, 0xe5, 0x47, 0x96,
+	0xe9, 0x1d, 0xcd, 0xc0, 0x94, 0xf5, 0x97, 0x91, 0xf2, 0x21, 0x25, 0xc5, 0xb4, 0x16, 0x4e, 0xca,
+	0x13, 0x30, 0x66, 0x3a, 0x6c, 0x1b, 0x5a, 0x38, 0x2f, 0x0c, 0x59, 0xca, 0x99, 0xe1, 0xb1, 0xc7,
+	0xc0, 0x08, 0xcd, 0x10, 0x90, 0x08, 0x53, 0xd6, 0x5f, 0xd6, 0x9a, 0xcf, 0xc2, 0x88, 0x2e, 0xe3,
+	0x76, 0xdd, 0x20, 0x2e, 0x93, 0xc5, 0x25, 0x0f, 0x15, 0x76, 0xa5, 0xcb, 0x96, 0x0b, 0x91, 0xc0,
+	0x45, 0x4b, 0x0d, 0x7d, 0xce, 0xc1, 0xa1, 0x0a, 0x56, 0x44, 0xd9, 0xd0, 0xf7, 0xff, 0xc9, 0x3c,
+	0x0b, 0x9e, 0x3c, 0x17, 0xfc, 0xf2, 0x64, 0x71, 0xa0, 0x2f, 0x38, 0x98, 0x75, 0x2e, 0xf8, 0xa4,
+	0xcc, 0xf5, 0x95, 0x32, 0xff, 0x0c, 0x8c, 0xde, 0x94, 0xd4, 0x7a, 0x5b, 0x97, 0x49, 0xfc, 0x13,
+	0xc5, 0xc5, 0x9e, 0x16, 0xae, 0x50, 0xac, 0x68, 0x2b, 0xa1, 0x8f, 0x38, 0x98, 0xac, 0x60, 0xe5,
+	0x05, 0x59, 0xda, 0xb5, 0x3e, 0x5f, 0xf4, 0x31, 0xd0, 0xf7, 0x78, 0xe5, 0xa1, 0x5c, 0x39, 0x77,
+	0x78, 0xda, 0x8f, 0xaa, 0x8e, 0x7f, 0x74, 0x14, 0xe6, 0x5c, 0x0b, 0xac, 0x81, 0x3f, 0x89, 0x43,
+	0xa6, 0x82, 0x95, 0x8a, 0xaa, 0xe8, 0x92, 0x21, 0x57, 0xda, 0x75, 0x43, 0xc5, 0xaa, 0x72, 0x4d,
+	0x73, 0xbe, 0xab, 0xf5, 0x13, 0xfc, 0xff, 0x7d, 0x2a, 0xf6, 0x19, 0x8c, 0x86, 0x07, 0x3c, 0x18,
+	0x5d, 0xb4, 0x2f, 0x1b, 0x67, 0xa1, 0x4e, 0xfa, 0x15, 0xaa, 0x8b, 0x7d, 0x74, 0x19, 0x96, 0x42,
+	0x6a, 0x12, 0x61, 0xd4, 0xcd, 0xe5, 0x20, 0x51, 0xa6, 0x97, 0xe4, 0x74, 0xf9, 0xd5, 0xf2, 0x46,
+	0xf5, 0xfa, 0x8b, 0x57, 0xb7, 0xca, 0x1b, 0x9b, 0x57, 0x36, 0xcb, 0x97, 0xa7, 0x87, 0xf8, 0x43,
+	0x30, 0x46, 0x56, 0xaf, 0x89, 0xaf, 0x4d, 0x73, 0xc5, 0x6f, 0x26, 0x21, 0x5e, 0xc1, 0x0a, 0x7f,
+	0x03, 0x26, 0x9c, 0x5f, 0xdd, 0x32, 0xde, 0xa2, 0xb9, 0x66, 0x6f, 0x61, 0x29, 0x04, 0xc0, 0xe2,
+	0xac, 0x03, 0xef, 0xf3, 0x2d, 0xeb, 0xb4, 0x9f, 0xba, 0x17, 0x27, 0xe4, 0xa3, 0xe1, 0x98, 0xb7,
+	0x9b, 0x30, 0xed, 0xf9, 0x60, 0xb4, 0x18, 0x62, 0x83, 0xa0, 0x84, 0xb3, 0x51, 0x50, 0xcc, 0x8f,
+	0x06, 0x87, 0xfd, 0x3e, 0xd8, 0x2c, 0x85, 0x86, 0x4b, 0x81, 0x42, 0x21, 0x22, 0x90, 0x39, 0x54,
+	0x61, 0xc6, 0xfb, 0x2d, 0xe5, 0x54, 0x48, 0x11, 0x28, 0x4c, 0x58, 0x89, 0x04, 0x63, 0xae, 0xda,
+	0x30, 0xe7, 0xff, 0x82, 0x7c, 0x26, 0xc4, 0x4e, 0x07, 0x2a, 0xac, 0x46, 0x86, 0x32, 0xb7, 0x7b,
+	0x70, 0x24, 0xe0, 0x13, 0x46, 0x2e, 0x84, 0x2c, 0x07, 0x56, 0x28, 0x46, 0xc7, 0x32, 0xcf, 0x9f,
+	0x72, 0x90, 0x09, 0x7b, 0x97, 0x5b, 0x8b, 0x64, 0xd7, 0xad, 0x24, 0x3c, 0xd5, 0x87, 0x12, 0x8b,
+	0xea, 0x1d, 0x0e, 0xe6, 0x83, 0xdf, 0x78, 0x56, 0x22, 0x99, 0x66, 0xfd, 0x76, 0xfe, 0x40, 0x70,
+	0x16, 0xc3, 0x1b, 0x90, 0xec, 0x7a, 0xb7, 0x40, 0x7e, 0x86, 0xdc, 0x18, 0x21, 0x17, 0x8e, 0x71,
+	0x6e, 0x58, 0xcf, 0x6c, 0xec, 0xbb, 0x61, 0xbb, 0x51, 0xc2, 0xd9, 0x28, 0x28, 0xe6, 0x67, 0x1d,
+	0x12, 0x64, 0x80, 0x4d, 0xf9, 0x69, 0x99, 0x12, 0x21, 0x1b, 0x24, 0x71, 0xda, 0x20, 0xe7, 0xaa,
+	0xaf, 0x0d, 0x53, 0x22, 0x64, 0x83, 0x24, 0xcc, 0xc6, 0xcb, 0x30, 0xde, 0x19, 0xa8, 0x16, 0xfc,
+	0xe0, 0x4c, 0x2c, 0x9c, 0xea, 0x29, 0x66, 0x26, 0xaf, 0x01, 0x38, 0x06, 0x8e, 0xb4, 0x9f, 0x52,
+	0x47, 0x2e, 0x9c, 0xee, 0x2d, 0x67, 0x56, 0xef, 0x98, 0xef, 0xdc, 0xbd, 0x86, 0x83, 0x73, 0x7e,
+	0x86, 0x7a, 0x69, 0x08, 0x17, 0x0f, 0xaa, 0x61, 0x07, 0x23, 0x0c, 0xbf, 0x6d, 0xce, 0x07, 0xeb,
+	0xf9, 0xfb, 0x0f, 0xd3, 0xdc, 0x83, 0x87, 0x69, 0xee, 0xb7, 0x87, 0x69, 0xee, 0xe3, 0x47, 0xe9,
+	0xa1, 0x07, 0x8f, 0xd2, 0x43, 0xbf, 0x3c, 0x4a, 0x0f, 0xbd, 0x6e, 0x0d, 0x25, 0xb8, 0x76, 0x2b,
+	0xaf, 0x6a, 0x85, 0x3d, 0x7a, 0xc3, 0x6e, 0x8f, 0x90, 0xab, 0x7c, 0xed, 0xef, 0x01, 0x00, 0x33,
+	0x4b, 0x66, 0xa8, 0x17, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1635,8 +1862,18 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// RetryExec retries the execution of a proposal whose last execution failed,
+	// within the retry exec window from the failure.
+	//
+	// Since: cosmos-sdk 0.51
+	RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// MigrateMultisigToGroupPolicy turns a legacy threshold multisig account into a group policy account at the same
+	// address, administered by itself. The keys of the multisig can no longer sign for the account afterwards.
+	//
+	// Since: cosmos-sdk 0.51
+	MigrateMultisigToGroupPolicy(ctx context.Context, in *MsgMigrateMultisigToGroupPolicy, opts ...grpc.CallOption) (*MsgMigrateMultisigToGroupPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -1764,6 +2001,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error) {
+	out := new(MsgRetryExecResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/RetryExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
 	out := new(MsgLeaveGroupResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/LeaveGroup", in, out, opts...)
@@ -1773,6 +2019,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) MigrateMultisigToGroupPolicy(ctx context.Context, in *MsgMigrateMultisigToGroupPolicy, opts ...grpc.CallOption) (*MsgMigrateMultisigToGroupPolicyResponse, error) {
+	out := new(MsgMigrateMultisigToGroupPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/MigrateMultisigToGroupPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1801,8 +2056,18 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// RetryExec retries the execution of a proposal whose last execution failed,
+	// within the retry exec window from the failure.
+	//
+	// Since: cosmos-sdk 0.51
+	RetryExec(context.Context, *MsgRetryExec) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// MigrateMultisigToGroupPolicy turns a legacy threshold multisig account into a group policy account at the same
+	// address, administered by itself. The keys of the multisig can no longer sign for the account afterwards.
+	//
+	// Since: cosmos-sdk 0.51
+	MigrateMultisigToGroupPolicy(context.Context, *MsgMigrateMultisigToGroupPolicy) (*MsgMigrateMultisigToGroupPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1848,9 +2113,15 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) RetryExec(ctx context.Context, req *MsgRetryExec) (*MsgRetryExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryExec not implemented")
+}
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) MigrateMultisigToGroupPolicy(ctx context.Context, req *MsgMigrateMultisigToGroupPolicy) (*MsgMigrateMultisigToGroupPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateMultisigToGroupPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2090,6 +2361,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RetryExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/RetryExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryExec(ctx, req.(*MsgRetryExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgLeaveGroup)
 	if err := dec(in); err != nil {
@@ -2108,6 +2397,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateMultisigToGroupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateMultisigToGroupPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateMultisigToGroupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/MigrateMultisigToGroupPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateMultisigToGroupPolicy(ctx, req.(*MsgMigrateMultisigToGroupPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2164,10 +2471,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "RetryExec",
+			Handler:    _Msg_RetryExec_Handler,
+		},
 		{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "MigrateMultisigToGroupPolicy",
+			Handler:    _Msg_MigrateMultisigToGroupPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -3162,6 +3477,81 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRetryExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3220,13 +3610,111 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgMigrateMultisigToGroupPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateMultisigToGroupPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateMultisigToGroupPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GroupPolicyMetadata) > 0 {
+		i -= len(m.GroupPolicyMetadata)
+		copy(dAtA[i:], m.GroupPolicyMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyMetadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GroupMetadata) > 0 {
+		i -= len(m.GroupMetadata)
+		copy(dAtA[i:], m.GroupMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupMetadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateMultisigToGroupPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateMultisigToGroupPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateMultisigToGroupPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -3672,6 +4160,38 @@ func (m *MsgExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRetryExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgLeaveGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3697,6 +4217,49 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrateMultisigToGroupPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.GroupMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateMultisigToGroupPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6525,7 +7088,7 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6548,17 +7111,17 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6568,29 +7131,16 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
-			m.GroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6600,11 +7150,24 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6626,7 +7189,7 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6649,12 +7212,503 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &ProposalExecFailure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateMultisigToGroupPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateMultisigToGroupPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateMultisigToGroupPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, MemberRequest{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateMultisigToGroupPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateMultisigToGroupPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateMultisigToGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
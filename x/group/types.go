@@ -447,6 +447,23 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (f ProposalExecFailure) PrimaryKeyFields() []interface{} {
+	return []interface{}{f.ProposalId}
+}
+
+var _ orm.Validateable = ProposalExecFailure{}
+
+// ValidateBasic does basic validation on proposal exec failure.
+func (f ProposalExecFailure) ValidateBasic() error {
+	if f.ProposalId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "proposal exec failure ProposalId")
+	}
+	if f.Attempts == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "proposal exec failure attempts")
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
@@ -901,6 +901,131 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// ProposalExecFailure records why the execution of a proposal failed. It is
+// updated at each failed execution and pruned with the proposal.
+//
+// Since: cosmos-sdk 0.51
+type ProposalExecFailure struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// reason is the error returned by the last failed execution.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// codespace is the codespace of the error.
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code is the code of the error within its codespace.
+	Code uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	// msg_type_url is the type URL of the proposal message which failed. It is
+	// empty if the execution failed before routing the messages, e.g. because
+	// the min execution period did not elapse yet.
+	MsgTypeUrl string `protobuf:"bytes,5,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// msg_index is the index of the failed message in the proposal messages.
+	// It is only meaningful if msg_type_url is set.
+	MsgIndex uint32 `protobuf:"varint,6,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// height is the block height of the last failed execution.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// failed_at is the block time of the last failed execution. The proposal
+	// can be retried within the retry exec window from it.
+	FailedAt time.Time `protobuf:"bytes,8,opt,name=failed_at,json=failedAt,proto3,stdtime" json:"failed_at"`
+	// attempts is the number of failed executions of the proposal.
+	Attempts uint64 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *ProposalExecFailure) Reset()         { *m = ProposalExecFailure{} }
+func (m *ProposalExecFailure) String() string { return proto.CompactTextString(m) }
+func (*ProposalExecFailure) ProtoMessage()    {}
+func (*ProposalExecFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
+}
+func (m *ProposalExecFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalExecFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalExecFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalExecFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalExecFailure.Merge(m, src)
+}
+func (m *ProposalExecFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalExecFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalExecFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalExecFailure proto.InternalMessageInfo
+
+func (m *ProposalExecFailure) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalExecFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ProposalExecFailure) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *ProposalExecFailure) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ProposalExecFailure) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *ProposalExecFailure) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *ProposalExecFailure) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProposalExecFailure) GetFailedAt() time.Time {
+	if m != nil {
+		return m.FailedAt
+	}
+	return time.Time{}
+}
+
+func (m *ProposalExecFailure) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -916,97 +1041,106 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*ProposalExecFailure)(nil), "cosmos.group.v1.ProposalExecFailure")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x0a, 0xaa, 0xdd, 0xa6, 0x12, 0x48, 0x3d, 0x61, 0x3b, 0x5b, 0xea, 0xa8, 0xb5, 0xad,
-	0xf5, 0x3a, 0xa1, 0xbd, 0xac, 0x36, 0xde, 0xa9, 0xb3, 0xaa, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd,
-	0x1f, 0x54, 0x5c, 0xe8, 0x91, 0x0b, 0x52, 0x25, 0x2e, 0x1c, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0xc4, 0x09, 0x50, 0x73, 0x28, 0x27, 0x4e, 0x5c, 0x41, 0x68, 0x67, 0x66,
-	0x1d, 0x7f, 0x24, 0x2e, 0xa9, 0x2a, 0x2e, 0x51, 0x66, 0x9e, 0xe7, 0x7d, 0xe7, 0x7d, 0xde, 0xaf,
-	0x4d, 0x60, 0xb5, 0x45, 0xdc, 0x2e, 0x71, 0x0b, 0x6d, 0x87, 0xf4, 0x7b, 0x85, 0x83, 0x6b, 0x05,
-	0x3a, 0xe8, 0x61, 0x37, 0xdf, 0x73, 0x08, 0x25, 0x28, 0xc5, 0xc1, 0x3c, 0x03, 0xf3, 0x07, 0xd7,
-	0x56, 0x16, 0xdb, 0xa4, 0x4d, 0x18, 0x56, 0xf0, 0x7e, 0xe3, 0xb4, 0x95, 0x4c, 0x9b, 0x90, 0x76,
-	0x07, 0x17, 0xd8, 0x69, 0xaf, 0x7f, 0xbf, 0x60, 0xf6, 0x1d, 0x83, 0x5a, 0xc4, 0x16, 0x78, 0x76,
-	0x12, 0xa7, 0x56, 0x17, 0xbb, 0xd4, 0xe8, 0xf6, 0x04, 0x61, 0x99, 0xbf, 0xa3, 0x73, 0xcf, 0xe2,
-	0x51, 0x01, 0x4d, 0xda, 0x1a, 0xf6, 0x40, 0x40, 0xe7, 0x8c, 0xae, 0x65, 0x93, 0x02, 0xfb, 0xc9,
-	0xaf, 0x72, 0xdf, 0x48, 0x10, 0xb9, 0x83, 0xbb, 0x7b, 0xd8, 0x41, 0x9b, 0x10, 0x35, 0x4c, 0xd3,
-	0xc1, 0xae, 0x2b, 0x4b, 0x6b, 0xd2, 0x7a, 0xbc, 0x24, 0xff, 0xf4, 0xec, 0xca, 0xa2, 0xf0, 0x5d,
-	0xe4, 0x48, 0x83, 0x3a, 0x96, 0xdd, 0x56, 0x7d, 0x22, 0xba, 0x00, 0x91, 0x43, 0x6c, 0xb5, 0xf7,
-	0xa9, 0x1c, 0xf4, 0x4c, 0x54, 0x71, 0x42, 0x2b, 0x10, 0xeb, 0x62, 0x6a, 0x98, 0x06, 0x35, 0xe4,
-	0x10, 0x43, 0x86, 0x67, 0xb4, 0x05, 0x31, 0xc3, 0x34, 0xb1, 0xa9, 0x1b, 0x54, 0x0e, 0xaf, 0x49,
-	0xeb, 0x89, 0xcd, 0x95, 0x3c, 0x8f, 0x39, 0xef, 0xc7, 0x9c, 0xd7, 0x7c, 0xbd, 0xa5, 0x85, 0xe7,
-	0xbf, 0x64, 0x03, 0x8f, 0x7f, 0xcd, 0x4a, 0x5f, 0xbf, 0x7a, 0xba, 0x21, 0xb1, 0x97, 0xb1, 0x59,
-	0xa4, 0xb9, 0x43, 0x58, 0xe0, 0x71, 0xab, 0xf8, 0xd3, 0x3e, 0x76, 0xe9, 0x7f, 0x15, 0x7e, 0xee,
-	0x7b, 0x09, 0x96, 0xb4, 0x7d, 0x07, 0xbb, 0xfb, 0xa4, 0x63, 0x6e, 0xe1, 0x96, 0xe5, 0x5a, 0xc4,
-	0xae, 0x93, 0x8e, 0xd5, 0x1a, 0xa0, 0x8b, 0x10, 0xa7, 0x3e, 0xc4, 0xa3, 0x50, 0x8f, 0x2f, 0xd0,
-	0x47, 0x10, 0x3d, 0xb4, 0x6c, 0x93, 0x1c, 0xba, 0xec, 0xb9, 0xc4, 0xe6, 0xbb, 0xf9, 0x89, 0x76,
-	0xc9, 0x8f, 0xfb, 0xdb, 0xe5, 0x6c, 0xd5, 0x37, 0xbb, 0x51, 0xf9, 0xe1, 0xd9, 0x95, 0xcc, 0x6c,
-	0x9b, 0xcf, 0x5e, 0x3d, 0xdd, 0xc8, 0x71, 0xca, 0x15, 0xd7, 0x7c, 0x50, 0x38, 0x25, 0xd4, 0xdc,
-	0x73, 0x09, 0xe4, 0x3a, 0x76, 0x5a, 0xd8, 0xa6, 0x46, 0x1b, 0x4f, 0xe8, 0xc8, 0x00, 0xf4, 0x86,
-	0x98, 0x10, 0x32, 0x72, 0xf3, 0x16, 0x94, 0x6c, 0xff, 0x3b, 0x25, 0x97, 0x46, 0x94, 0x9c, 0x16,
-	0x6d, 0xee, 0x3b, 0x09, 0xfe, 0x77, 0xe2, 0x73, 0xe8, 0x0e, 0x2c, 0x1c, 0x10, 0x6a, 0xd9, 0x6d,
-	0xbd, 0x87, 0x1d, 0x8b, 0xf0, 0x9a, 0x24, 0x36, 0x97, 0xa7, 0xfa, 0x6d, 0x4b, 0xcc, 0x1f, 0x6f,
-	0xb7, 0x2f, 0x86, 0xed, 0x36, 0xcf, 0xcd, 0xeb, 0xcc, 0x1a, 0xdd, 0x83, 0xc5, 0xae, 0x65, 0xeb,
-	0xf8, 0x21, 0x6e, 0xf5, 0x3d, 0xb6, 0xef, 0x35, 0x78, 0x46, 0xaf, 0xa8, 0x6b, 0xd9, 0x8a, 0xef,
-	0x84, 0xfb, 0xce, 0xfd, 0x21, 0x41, 0xfc, 0x63, 0x2f, 0x11, 0x15, 0xfb, 0x3e, 0x41, 0x49, 0x08,
-	0x5a, 0x3c, 0xda, 0xb0, 0x1a, 0xb4, 0x4c, 0x94, 0x87, 0x39, 0xc3, 0xec, 0x5a, 0x36, 0xef, 0xd3,
-	0x19, 0xad, 0xcd, 0x69, 0x33, 0xe7, 0x4f, 0x86, 0xe8, 0x01, 0x76, 0xbc, 0x64, 0xb1, 0xf1, 0x0b,
-	0xab, 0xfe, 0x11, 0xbd, 0x03, 0xf3, 0x94, 0x50, 0xa3, 0xa3, 0x8b, 0xa1, 0x98, 0x63, 0x96, 0x09,
-	0x76, 0xb7, 0xcb, 0x27, 0xe3, 0x16, 0x40, 0xcb, 0xc1, 0x06, 0xe5, 0xe3, 0x1b, 0x39, 0xeb, 0xf8,
-	0xc6, 0x85, 0x71, 0x91, 0xe6, 0xee, 0x42, 0x82, 0xe9, 0x15, 0xdb, 0x67, 0x19, 0x62, 0xac, 0x0f,
-	0xf4, 0xa1, 0xee, 0x28, 0x3b, 0x57, 0x4c, 0x54, 0x80, 0x48, 0x97, 0x91, 0x44, 0xa2, 0x97, 0xa6,
-	0x9a, 0x4d, 0x6c, 0x02, 0x41, 0xcb, 0xfd, 0x15, 0x84, 0x14, 0xf3, 0xcd, 0xbb, 0x81, 0x65, 0xf4,
-	0x4d, 0xd6, 0xc3, 0x68, 0x4c, 0xc1, 0xf1, 0x98, 0x86, 0x05, 0x09, 0x9d, 0xbd, 0x20, 0xe1, 0xd3,
-	0x0b, 0x32, 0x37, 0x5e, 0x10, 0x03, 0x52, 0xa6, 0x68, 0x6c, 0xbd, 0xc7, 0xb4, 0x88, 0x94, 0x2f,
-	0x4e, 0xa5, 0xbc, 0x68, 0x0f, 0x4a, 0xb9, 0xd7, 0x0f, 0x95, 0x9a, 0x34, 0xc7, 0x47, 0x7d, 0xbc,
-	0xa0, 0xd1, 0x37, 0x2f, 0xe8, 0x8d, 0xd8, 0xa3, 0x27, 0xd9, 0xc0, 0xef, 0x4f, 0xb2, 0x52, 0xee,
-	0xef, 0x39, 0x88, 0xd5, 0x1d, 0xd2, 0x23, 0xae, 0xd1, 0x99, 0x6a, 0xe5, 0x6d, 0x58, 0xe4, 0x49,
-	0xe5, 0x82, 0x74, 0xbf, 0x2a, 0xaf, 0xeb, 0x6c, 0xd4, 0x3e, 0xae, 0xa8, 0x40, 0x66, 0xb6, 0xf9,
-	0x07, 0x10, 0xef, 0xb1, 0x18, 0xb0, 0xe3, 0xca, 0xe1, 0xb5, 0xd0, 0x4c, 0xe7, 0xc7, 0x54, 0xb4,
-	0x0d, 0x09, 0xb7, 0xbf, 0xd7, 0xb5, 0xa8, 0xee, 0x7d, 0x74, 0x59, 0x45, 0xce, 0x94, 0x11, 0xe0,
-	0xd6, 0x1e, 0x8e, 0x2e, 0xc1, 0x02, 0xd7, 0xea, 0xd7, 0x37, 0xc2, 0xd2, 0x30, 0xcf, 0x2e, 0x77,
-	0x44, 0x91, 0xaf, 0x4e, 0x24, 0xc4, 0xe7, 0x46, 0x19, 0x77, 0x54, 0xb6, 0x6f, 0xf1, 0x21, 0x44,
-	0x5c, 0x6a, 0xd0, 0xbe, 0x2b, 0xc7, 0xd6, 0xa4, 0xf5, 0xe4, 0x66, 0x76, 0x6a, 0x20, 0xfc, 0xec,
-	0x37, 0x18, 0x4d, 0x15, 0x74, 0xd4, 0x04, 0x74, 0xdf, 0xb2, 0x8d, 0x8e, 0x4e, 0x8d, 0x4e, 0x67,
-	0xa0, 0x3b, 0xd8, 0xed, 0x77, 0xa8, 0x1c, 0x67, 0x12, 0x2f, 0x4e, 0x39, 0xd1, 0x3c, 0x92, 0xca,
-	0x38, 0xa5, 0xb8, 0x27, 0x92, 0x0b, 0x4c, 0x33, 0x17, 0x23, 0x20, 0x6a, 0xc2, 0xb9, 0xb1, 0x35,
-	0xab, 0x63, 0xdb, 0x94, 0xe1, 0xac, 0x89, 0x4b, 0x8d, 0xee, 0x5a, 0xc5, 0x36, 0x51, 0x1d, 0x52,
-	0x7c, 0xd5, 0x12, 0xc7, 0x0f, 0x35, 0xc1, 0xf4, 0xbe, 0x77, 0xaa, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x12, 0x8f, 0x9d, 0xd1, 0x55, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x63, 0x57, 0x9e, 0x5f, 0x0b,
-	0x9d, 0x36, 0x48, 0xea, 0x90, 0x85, 0x16, 0x61, 0x8e, 0x5a, 0xb4, 0x83, 0xe5, 0x05, 0xd6, 0x5e,
-	0xfc, 0xe0, 0x4d, 0xac, 0xdb, 0xef, 0x76, 0x0d, 0x67, 0x20, 0x27, 0xd9, 0xbd, 0x7f, 0xbc, 0x11,
-	0xf6, 0x86, 0x20, 0xf7, 0xa5, 0x04, 0x89, 0xd1, 0x04, 0xad, 0x42, 0x7c, 0x80, 0x5d, 0xbd, 0x45,
-	0xfa, 0x36, 0x15, 0x9f, 0xd3, 0xd8, 0x00, 0xbb, 0x65, 0xef, 0xec, 0x35, 0x89, 0xb1, 0xe7, 0x52,
-	0xc3, 0xb2, 0x05, 0x81, 0xff, 0x2d, 0x32, 0x2f, 0x2e, 0x39, 0x69, 0x19, 0x62, 0x36, 0x11, 0x38,
-	0xef, 0xf4, 0xa8, 0x4d, 0x38, 0xf4, 0x3e, 0x20, 0x9b, 0xe8, 0x87, 0x16, 0xdd, 0xd7, 0x0f, 0x30,
-	0xf5, 0x49, 0x7c, 0xc9, 0xa4, 0x6c, 0xb2, 0x6b, 0xd1, 0xfd, 0x1d, 0x4c, 0x39, 0x59, 0xc4, 0xf7,
-	0xa7, 0x04, 0xe1, 0x1d, 0x42, 0x31, 0xca, 0x42, 0xa2, 0x27, 0x52, 0x77, 0xbc, 0x78, 0xc1, 0xbf,
-	0xe2, 0x7b, 0xee, 0x80, 0x50, 0xb1, 0x7a, 0x67, 0xee, 0x39, 0x46, 0x43, 0xd7, 0x21, 0x42, 0x7a,
-	0xde, 0x67, 0x8d, 0x45, 0x99, 0xdc, 0x5c, 0x9d, 0x2a, 0x95, 0xf7, 0x6e, 0x8d, 0x51, 0x54, 0x41,
-	0x9d, 0xb9, 0x1c, 0xdf, 0xe2, 0x38, 0x6e, 0x7c, 0x2e, 0x01, 0x1c, 0x3f, 0x8f, 0x56, 0x61, 0x69,
-	0xa7, 0xa6, 0x29, 0x7a, 0xad, 0xae, 0x55, 0x6a, 0x55, 0xbd, 0x59, 0x6d, 0xd4, 0x95, 0x72, 0xe5,
-	0x66, 0x45, 0xd9, 0x4a, 0x07, 0xd0, 0x79, 0x48, 0x8d, 0x82, 0x77, 0x95, 0x46, 0x5a, 0x42, 0x4b,
-	0x70, 0x7e, 0xf4, 0xb2, 0x58, 0x6a, 0x68, 0xc5, 0x4a, 0x35, 0x1d, 0x44, 0x08, 0x92, 0xa3, 0x40,
-	0xb5, 0x96, 0x0e, 0xa1, 0x8b, 0x20, 0x8f, 0xdf, 0xe9, 0xbb, 0x15, 0xed, 0x96, 0xbe, 0xa3, 0x68,
-	0xb5, 0x74, 0x78, 0x25, 0xfc, 0xe8, 0xab, 0x4c, 0x60, 0xe3, 0x47, 0x09, 0x92, 0xe3, 0xb3, 0x8a,
-	0xb2, 0xb0, 0x5a, 0x57, 0x6b, 0xf5, 0x5a, 0xa3, 0x78, 0x5b, 0x6f, 0x68, 0x45, 0xad, 0xd9, 0x98,
-	0x88, 0xec, 0xff, 0xb0, 0x3c, 0x49, 0x68, 0x34, 0x4b, 0x77, 0x2a, 0x9a, 0xa6, 0x6c, 0xa5, 0x25,
-	0xef, 0xd9, 0x49, 0xb8, 0x58, 0x2e, 0x2b, 0x75, 0x0f, 0x0d, 0x9e, 0x84, 0xaa, 0xca, 0xb6, 0x52,
-	0xf6, 0xd0, 0x90, 0x97, 0x91, 0x29, 0xdb, 0x52, 0x4d, 0xf5, 0xc0, 0xf0, 0x49, 0xef, 0x7a, 0x82,
-	0xb6, 0xd4, 0xe2, 0x6e, 0x35, 0x3d, 0x27, 0x04, 0x7d, 0x2b, 0xc1, 0x85, 0x93, 0x87, 0x11, 0xad,
-	0xc3, 0xe5, 0xa1, 0xbd, 0xf2, 0x89, 0x52, 0x6e, 0x6a, 0x35, 0x55, 0x57, 0x95, 0x46, 0xf3, 0xb6,
-	0x36, 0xa1, 0xf0, 0x32, 0xac, 0x9d, 0xca, 0xac, 0xd6, 0x34, 0x5d, 0x6d, 0x56, 0xd3, 0xd2, 0x4c,
-	0x56, 0xa3, 0x59, 0x2e, 0x2b, 0x8d, 0x46, 0x3a, 0x38, 0x93, 0x75, 0xb3, 0x58, 0xb9, 0xdd, 0x54,
-	0x95, 0x74, 0x88, 0x07, 0x5f, 0xca, 0x3f, 0x7f, 0x99, 0x91, 0x5e, 0xbc, 0xcc, 0x48, 0xbf, 0xbd,
-	0xcc, 0x48, 0x8f, 0x8f, 0x32, 0x81, 0x17, 0x47, 0x99, 0xc0, 0xcf, 0x47, 0x99, 0xc0, 0x3d, 0xd1,
-	0xf3, 0xae, 0xf9, 0x20, 0x6f, 0x91, 0xc2, 0x43, 0xfe, 0xcf, 0xdf, 0x5e, 0x84, 0xb5, 0xdf, 0xf5,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x5f, 0xf0, 0x46, 0x13, 0x0e, 0x00, 0x00,
+	// 1487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xbd, 0x6f, 0x1b, 0xc9,
+	0x15, 0xd7, 0x92, 0x14, 0x45, 0x3e, 0x4a, 0x14, 0x3d, 0x56, 0xac, 0x95, 0xe4, 0x90, 0x0a, 0x6d,
+	0x24, 0x82, 0x02, 0x93, 0xb6, 0x0c, 0x24, 0x80, 0xab, 0x90, 0xd4, 0x2a, 0xa6, 0x60, 0x8b, 0xc4,
+	0x72, 0x29, 0xc5, 0x6e, 0x16, 0x2b, 0xee, 0x88, 0x5a, 0x98, 0xbb, 0xc3, 0xec, 0x0c, 0x25, 0xf3,
+	0x3f, 0x30, 0xd2, 0xc4, 0x65, 0x9a, 0x00, 0x06, 0xd2, 0xa4, 0x74, 0x61, 0xa4, 0x48, 0xba, 0x20,
+	0x85, 0x91, 0x22, 0x30, 0x52, 0x5d, 0x75, 0x77, 0xb0, 0x0b, 0x5f, 0x75, 0xd5, 0xb5, 0x77, 0x38,
+	0xec, 0xcc, 0x2c, 0xc5, 0x0f, 0x89, 0xb6, 0x0c, 0xe3, 0x1a, 0x41, 0x6f, 0x7e, 0xbf, 0xf7, 0xe6,
+	0x7d, 0xcf, 0x12, 0xd6, 0x5a, 0x84, 0xba, 0x84, 0x16, 0xdb, 0x3e, 0xe9, 0x75, 0x8b, 0x27, 0x77,
+	0x8a, 0xac, 0xdf, 0xc5, 0xb4, 0xd0, 0xf5, 0x09, 0x23, 0x68, 0x51, 0x80, 0x05, 0x0e, 0x16, 0x4e,
+	0xee, 0xac, 0x2e, 0xb5, 0x49, 0x9b, 0x70, 0xac, 0x18, 0xfc, 0x27, 0x68, 0xab, 0xd9, 0x36, 0x21,
+	0xed, 0x0e, 0x2e, 0x72, 0xe9, 0xb0, 0x77, 0x54, 0xb4, 0x7b, 0xbe, 0xc5, 0x1c, 0xe2, 0x49, 0x3c,
+	0x37, 0x8e, 0x33, 0xc7, 0xc5, 0x94, 0x59, 0x6e, 0x57, 0x12, 0x56, 0xc4, 0x3d, 0xa6, 0xb0, 0x2c,
+	0x2f, 0x95, 0xd0, 0xb8, 0xae, 0xe5, 0xf5, 0x25, 0x74, 0xc5, 0x72, 0x1d, 0x8f, 0x14, 0xf9, 0x5f,
+	0x71, 0x94, 0xff, 0x87, 0x02, 0xf1, 0x87, 0xd8, 0x3d, 0xc4, 0x3e, 0xda, 0x82, 0x39, 0xcb, 0xb6,
+	0x7d, 0x4c, 0xa9, 0xaa, 0xac, 0x2b, 0x1b, 0xc9, 0xb2, 0xfa, 0xff, 0x57, 0xb7, 0x96, 0xa4, 0xed,
+	0x92, 0x40, 0x1a, 0xcc, 0x77, 0xbc, 0xb6, 0x1e, 0x12, 0xd1, 0x35, 0x88, 0x9f, 0x62, 0xa7, 0x7d,
+	0xcc, 0xd4, 0x48, 0xa0, 0xa2, 0x4b, 0x09, 0xad, 0x42, 0xc2, 0xc5, 0xcc, 0xb2, 0x2d, 0x66, 0xa9,
+	0x51, 0x8e, 0x0c, 0x64, 0xb4, 0x0d, 0x09, 0xcb, 0xb6, 0xb1, 0x6d, 0x5a, 0x4c, 0x8d, 0xad, 0x2b,
+	0x1b, 0xa9, 0xad, 0xd5, 0x82, 0xf0, 0xb9, 0x10, 0xfa, 0x5c, 0x30, 0xc2, 0x78, 0xcb, 0x0b, 0xaf,
+	0xbf, 0xcc, 0xcd, 0x3c, 0xff, 0x2a, 0xa7, 0xfc, 0xfd, 0xfd, 0xcb, 0x4d, 0x85, 0xdf, 0x8c, 0xed,
+	0x12, 0xcb, 0x9f, 0xc2, 0x82, 0xf0, 0x5b, 0xc7, 0x7f, 0xec, 0x61, 0xca, 0x7e, 0x2a, 0xf7, 0xf3,
+	0xff, 0x51, 0x60, 0xd9, 0x38, 0xf6, 0x31, 0x3d, 0x26, 0x1d, 0x7b, 0x1b, 0xb7, 0x1c, 0xea, 0x10,
+	0xaf, 0x4e, 0x3a, 0x4e, 0xab, 0x8f, 0xae, 0x43, 0x92, 0x85, 0x90, 0xf0, 0x42, 0x3f, 0x3b, 0x40,
+	0xbf, 0x83, 0xb9, 0x53, 0xc7, 0xb3, 0xc9, 0x29, 0xe5, 0xd7, 0xa5, 0xb6, 0x7e, 0x59, 0x18, 0x6b,
+	0x97, 0xc2, 0xa8, 0xbd, 0x03, 0xc1, 0xd6, 0x43, 0xb5, 0x7b, 0xd5, 0xff, 0xbe, 0xba, 0x95, 0x9d,
+	0xae, 0xf3, 0xa7, 0xf7, 0x2f, 0x37, 0xf3, 0x82, 0x72, 0x8b, 0xda, 0x4f, 0x8a, 0x17, 0xb8, 0x9a,
+	0x7f, 0xad, 0x80, 0x5a, 0xc7, 0x7e, 0x0b, 0x7b, 0xcc, 0x6a, 0xe3, 0xb1, 0x38, 0xb2, 0x00, 0xdd,
+	0x01, 0x26, 0x03, 0x19, 0x3a, 0xf9, 0x0c, 0x91, 0xec, 0x7e, 0x5c, 0x24, 0x37, 0x86, 0x22, 0xb9,
+	0xc8, 0xdb, 0xfc, 0xbf, 0x15, 0xf8, 0xd9, 0xb9, 0xd7, 0xa1, 0x87, 0xb0, 0x70, 0x42, 0x98, 0xe3,
+	0xb5, 0xcd, 0x2e, 0xf6, 0x1d, 0x22, 0x6a, 0x92, 0xda, 0x5a, 0x99, 0xe8, 0xb7, 0x6d, 0x39, 0x7f,
+	0xa2, 0xdd, 0xfe, 0x32, 0x68, 0xb7, 0x79, 0xa1, 0x5e, 0xe7, 0xda, 0xe8, 0x31, 0x2c, 0xb9, 0x8e,
+	0x67, 0xe2, 0xa7, 0xb8, 0xd5, 0x0b, 0xd8, 0xa1, 0xd5, 0xc8, 0x25, 0xad, 0x22, 0xd7, 0xf1, 0xb4,
+	0xd0, 0x88, 0xb0, 0x9d, 0xff, 0x56, 0x81, 0xe4, 0xef, 0x83, 0x44, 0x54, 0xbd, 0x23, 0x82, 0xd2,
+	0x10, 0x71, 0x84, 0xb7, 0x31, 0x3d, 0xe2, 0xd8, 0xa8, 0x00, 0xb3, 0x96, 0xed, 0x3a, 0x9e, 0x1a,
+	0xf9, 0x40, 0x6b, 0x0b, 0xda, 0xd4, 0xf9, 0x53, 0x61, 0xee, 0x04, 0xfb, 0x41, 0xb2, 0xf8, 0xf8,
+	0xc5, 0xf4, 0x50, 0x44, 0xbf, 0x80, 0x79, 0x46, 0x98, 0xd5, 0x31, 0xe5, 0x50, 0xcc, 0x72, 0xcd,
+	0x14, 0x3f, 0x3b, 0x10, 0x93, 0x71, 0x1f, 0xa0, 0xe5, 0x63, 0x8b, 0x89, 0xf1, 0x8d, 0x5f, 0x76,
+	0x7c, 0x93, 0x52, 0xb9, 0xc4, 0xf2, 0x8f, 0x20, 0xc5, 0xe3, 0x95, 0xdb, 0x67, 0x05, 0x12, 0xbc,
+	0x0f, 0xcc, 0x41, 0xdc, 0x73, 0x5c, 0xae, 0xda, 0xa8, 0x08, 0x71, 0x97, 0x93, 0x64, 0xa2, 0x97,
+	0x27, 0x9a, 0x4d, 0x6e, 0x02, 0x49, 0xcb, 0x7f, 0x1f, 0x81, 0x45, 0x6e, 0x5b, 0x74, 0x03, 0xcf,
+	0xe8, 0xa7, 0xac, 0x87, 0x61, 0x9f, 0x22, 0xa3, 0x3e, 0x0d, 0x0a, 0x12, 0xbd, 0x7c, 0x41, 0x62,
+	0x17, 0x17, 0x64, 0x76, 0xb4, 0x20, 0x16, 0x2c, 0xda, 0xb2, 0xb1, 0xcd, 0x2e, 0x8f, 0x45, 0xa6,
+	0x7c, 0x69, 0x22, 0xe5, 0x25, 0xaf, 0x5f, 0xce, 0x7f, 0x78, 0xa8, 0xf4, 0xb4, 0x3d, 0x3a, 0xea,
+	0xa3, 0x05, 0x9d, 0xfb, 0xf4, 0x82, 0xde, 0x4b, 0x3c, 0x7b, 0x91, 0x9b, 0xf9, 0xe6, 0x45, 0x4e,
+	0xc9, 0xff, 0x30, 0x0b, 0x89, 0xba, 0x4f, 0xba, 0x84, 0x5a, 0x9d, 0x89, 0x56, 0xde, 0x85, 0x25,
+	0x91, 0x54, 0x11, 0x90, 0x19, 0x56, 0xe5, 0x43, 0x9d, 0x8d, 0xda, 0x67, 0x15, 0x95, 0xc8, 0xd4,
+	0x36, 0xff, 0x0d, 0x24, 0xbb, 0xdc, 0x07, 0xec, 0x53, 0x35, 0xb6, 0x1e, 0x9d, 0x6a, 0xfc, 0x8c,
+	0x8a, 0x76, 0x21, 0x45, 0x7b, 0x87, 0xae, 0xc3, 0xcc, 0xe0, 0xd1, 0x55, 0x67, 0x2f, 0x9b, 0x11,
+	0x10, 0xda, 0x01, 0x8e, 0x6e, 0xc0, 0x82, 0x88, 0x35, 0xac, 0x6f, 0x9c, 0xa7, 0x61, 0x9e, 0x1f,
+	0xee, 0xcb, 0x22, 0xdf, 0x1e, 0x4b, 0x48, 0xc8, 0x9d, 0xe3, 0xdc, 0xe1, 0xb0, 0x43, 0x8d, 0xdf,
+	0x42, 0x9c, 0x32, 0x8b, 0xf5, 0xa8, 0x9a, 0x58, 0x57, 0x36, 0xd2, 0x5b, 0xb9, 0x89, 0x81, 0x08,
+	0xb3, 0xdf, 0xe0, 0x34, 0x5d, 0xd2, 0x51, 0x13, 0xd0, 0x91, 0xe3, 0x59, 0x1d, 0x93, 0x59, 0x9d,
+	0x4e, 0xdf, 0xf4, 0x31, 0xed, 0x75, 0x98, 0x9a, 0xe4, 0x21, 0x5e, 0x9f, 0x30, 0x62, 0x04, 0x24,
+	0x9d, 0x73, 0xca, 0xc9, 0x20, 0x48, 0x11, 0x60, 0x86, 0x9b, 0x18, 0x02, 0x51, 0x13, 0xae, 0x8c,
+	0xac, 0x59, 0x13, 0x7b, 0xb6, 0x0a, 0x97, 0x4d, 0xdc, 0xe2, 0xf0, 0xae, 0xd5, 0x3c, 0x1b, 0xd5,
+	0x61, 0x51, 0xac, 0x5a, 0xe2, 0x87, 0xae, 0xa6, 0x78, 0xbc, 0xbf, 0xba, 0x30, 0x5e, 0x4d, 0xf2,
+	0x85, 0x63, 0x7a, 0x1a, 0x8f, 0xc8, 0xe8, 0x76, 0xd0, 0x2f, 0x94, 0x5a, 0x6d, 0x4c, 0xd5, 0xf9,
+	0xf5, 0xe8, 0x45, 0x83, 0xa4, 0x0f, 0x58, 0x68, 0x09, 0x66, 0x99, 0xc3, 0x3a, 0x58, 0x5d, 0xe0,
+	0xed, 0x25, 0x84, 0x60, 0x62, 0x69, 0xcf, 0x75, 0x2d, 0xbf, 0xaf, 0xa6, 0xf9, 0x79, 0x28, 0xde,
+	0x8b, 0x05, 0x43, 0x90, 0xff, 0xab, 0x02, 0xa9, 0xe1, 0x04, 0xad, 0x41, 0xb2, 0x8f, 0xa9, 0xd9,
+	0x22, 0x3d, 0x8f, 0xc9, 0xe7, 0x34, 0xd1, 0xc7, 0xb4, 0x12, 0xc8, 0x41, 0x93, 0x58, 0x87, 0x94,
+	0x59, 0x8e, 0x27, 0x09, 0xe2, 0x5b, 0x64, 0x5e, 0x1e, 0x0a, 0xd2, 0x0a, 0x24, 0x3c, 0x22, 0x71,
+	0xd1, 0xe9, 0x73, 0x1e, 0x11, 0xd0, 0xaf, 0x01, 0x79, 0xc4, 0x3c, 0x75, 0xd8, 0xb1, 0x79, 0x82,
+	0x59, 0x48, 0x12, 0x4b, 0x66, 0xd1, 0x23, 0x07, 0x0e, 0x3b, 0xde, 0xc7, 0x4c, 0x90, 0xa5, 0x7f,
+	0xdf, 0x29, 0x10, 0xdb, 0x27, 0x0c, 0xa3, 0x1c, 0xa4, 0xba, 0x32, 0x75, 0x67, 0x8b, 0x17, 0xc2,
+	0x23, 0xb1, 0xe7, 0x4e, 0x08, 0x93, 0xab, 0x77, 0xea, 0x9e, 0xe3, 0x34, 0x74, 0x17, 0xe2, 0xa4,
+	0x1b, 0x3c, 0x6b, 0xdc, 0xcb, 0xf4, 0xd6, 0xda, 0x44, 0xa9, 0x82, 0x7b, 0x6b, 0x9c, 0xa2, 0x4b,
+	0xea, 0xd4, 0xe5, 0xf8, 0x19, 0xc7, 0x31, 0xff, 0xaf, 0x08, 0x5c, 0x1d, 0xee, 0x94, 0x1d, 0xcb,
+	0xe9, 0xf4, 0xfc, 0x8f, 0xc8, 0xc2, 0x35, 0x88, 0xfb, 0xd8, 0xa2, 0xc4, 0x0b, 0xbf, 0x13, 0x85,
+	0x14, 0x7c, 0xef, 0xb5, 0x88, 0x8d, 0x69, 0xd7, 0x6a, 0x61, 0x59, 0x96, 0xb3, 0x03, 0x84, 0x20,
+	0x16, 0x08, 0x3c, 0xa4, 0x05, 0x9d, 0xff, 0x8f, 0xd6, 0x61, 0xde, 0xa5, 0x6d, 0x33, 0xf8, 0xcd,
+	0x60, 0xf6, 0xfc, 0x8e, 0x7c, 0x62, 0xc1, 0xa5, 0x6d, 0xa3, 0xdf, 0xc5, 0x4d, 0xbf, 0x13, 0xf4,
+	0x4a, 0xc0, 0x70, 0x3c, 0x1b, 0x3f, 0xe5, 0xfb, 0x62, 0x41, 0x4f, 0xb8, 0xb4, 0x5d, 0x0d, 0xe4,
+	0xc0, 0x91, 0x63, 0xf1, 0x36, 0x07, 0xdb, 0x21, 0xaa, 0x4b, 0x09, 0xed, 0x40, 0xf2, 0xc8, 0x72,
+	0x3a, 0x62, 0x89, 0x27, 0x2e, 0x9b, 0xa3, 0x84, 0xd0, 0x2d, 0xf1, 0x0f, 0x5f, 0x8b, 0x31, 0xec,
+	0x76, 0x19, 0xe5, 0x6b, 0x21, 0xa6, 0x0f, 0xe4, 0xcd, 0x3f, 0x2b, 0x00, 0x67, 0xc5, 0x43, 0x6b,
+	0xb0, 0xbc, 0x5f, 0x33, 0x34, 0xb3, 0x56, 0x37, 0xaa, 0xb5, 0x3d, 0xb3, 0xb9, 0xd7, 0xa8, 0x6b,
+	0x95, 0xea, 0x4e, 0x55, 0xdb, 0xce, 0xcc, 0xa0, 0xab, 0xb0, 0x38, 0x0c, 0x3e, 0xd2, 0x1a, 0x19,
+	0x05, 0x2d, 0xc3, 0xd5, 0xe1, 0xc3, 0x52, 0xb9, 0x61, 0x94, 0xaa, 0x7b, 0x99, 0x08, 0x42, 0x90,
+	0x1e, 0x06, 0xf6, 0x6a, 0x99, 0x28, 0xba, 0x0e, 0xea, 0xe8, 0x99, 0x79, 0x50, 0x35, 0xee, 0x9b,
+	0xfb, 0x9a, 0x51, 0xcb, 0xc4, 0x56, 0x63, 0xcf, 0xfe, 0x96, 0x9d, 0xd9, 0xfc, 0x9f, 0x02, 0xe9,
+	0xd1, 0x4d, 0x87, 0x72, 0xb0, 0x56, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xf4, 0xc0, 0x6c, 0x18, 0x25,
+	0xa3, 0xd9, 0x18, 0xf3, 0xec, 0xe7, 0xb0, 0x32, 0x4e, 0x68, 0x34, 0xcb, 0x0f, 0xab, 0x86, 0xa1,
+	0x6d, 0x67, 0x94, 0xe0, 0xda, 0x71, 0xb8, 0x54, 0xa9, 0x68, 0xf5, 0x00, 0x8d, 0x9c, 0x87, 0xea,
+	0xda, 0xae, 0x56, 0x09, 0xd0, 0x68, 0x90, 0x91, 0x09, 0xdd, 0x72, 0x4d, 0x0f, 0xc0, 0xd8, 0x79,
+	0xf7, 0x06, 0x01, 0x6d, 0xeb, 0xa5, 0x83, 0xbd, 0xcc, 0xac, 0x0c, 0xe8, 0x9f, 0x0a, 0x5c, 0x3b,
+	0x7f, 0x95, 0xa1, 0x0d, 0xb8, 0x39, 0xd0, 0xd7, 0xfe, 0xa0, 0x55, 0x9a, 0x46, 0x4d, 0x37, 0x75,
+	0xad, 0xd1, 0x7c, 0x60, 0x8c, 0x45, 0x78, 0x13, 0xd6, 0x2f, 0x64, 0xee, 0xd5, 0x0c, 0x53, 0x6f,
+	0xee, 0x65, 0x94, 0xa9, 0xac, 0x46, 0xb3, 0x52, 0xd1, 0x1a, 0x8d, 0x4c, 0x64, 0x2a, 0x6b, 0xa7,
+	0x54, 0x7d, 0xd0, 0xd4, 0xb5, 0x4c, 0x54, 0x38, 0x5f, 0x2e, 0xbc, 0x7e, 0x9b, 0x55, 0xde, 0xbc,
+	0xcd, 0x2a, 0x5f, 0xbf, 0xcd, 0x2a, 0xcf, 0xdf, 0x65, 0x67, 0xde, 0xbc, 0xcb, 0xce, 0x7c, 0xf1,
+	0x2e, 0x3b, 0xf3, 0x58, 0x6e, 0x0c, 0x6a, 0x3f, 0x29, 0x38, 0xa4, 0xf8, 0x54, 0xfc, 0x74, 0x3e,
+	0x8c, 0xf3, 0xc6, 0xbc, 0xfb, 0xe3, 0x00, 0x5d, 0x8b, 0x4f, 0x16, 0x51, 0x0f, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1677,6 +1811,83 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalExecFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalExecFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalExecFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x48
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FailedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FailedAt):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Code != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1965,6 +2176,44 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *ProposalExecFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovTypes(uint64(m.Code))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovTypes(uint64(m.MsgIndex))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FailedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovTypes(uint64(m.Attempts))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4024,6 +4273,280 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalExecFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalExecFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalExecFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FailedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
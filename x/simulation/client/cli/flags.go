@@ -17,6 +17,7 @@ var (
 	FlagExportParamsHeightValue int
 	FlagExportStatePathValue    string
 	FlagExportStatsPathValue    string
+	FlagReproBundleDirValue     string
 	FlagSeedValue               int64
 	FlagInitialBlockHeightValue int
 	FlagNumBlocksValue          int
@@ -43,6 +44,7 @@ func GetSimulatorFlags() {
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
 	flag.StringVar(&FlagExportStatsPathValue, "ExportStatsPath", "", "custom file path to save the exported simulation statistics JSON")
+	flag.StringVar(&FlagReproBundleDirValue, "ReproBundleDir", "", "custom directory to write a reproduction bundle to when the simulation fails")
 	flag.Int64Var(&FlagSeedValue, "Seed", DefaultSeedValue, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -70,6 +72,7 @@ func NewConfigFromFlags() simulation.Config {
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
 		ExportStatsPath:    FlagExportStatsPathValue,
+		ReproBundleDir:     FlagReproBundleDirValue,
 		Seed:               FlagSeedValue,
 		InitialBlockHeight: FlagInitialBlockHeightValue,
 		GenesisTime:        FlagGenesisTimeValue,
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// ReplayFn runs a simulation of the application with the given config. Failures
+// are reported through tb, exactly like when running the simulation as a test.
+type ReplayFn func(tb simtypes.TB, w io.Writer, config simtypes.Config) error
+
+// SimCmd returns the simulation command group.
+func SimCmd(replayFn ReplayFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sim",
+		Short: "Simulation subcommands",
+		RunE:  func(cmd *cobra.Command, _ []string) error { return cmd.Help() },
+	}
+
+	cmd.AddCommand(ReplayCmd(replayFn))
+
+	return cmd
+}
+
+// ReplayCmd returns a command that deterministically reruns a failed
+// simulation from a reproduction bundle.
+func ReplayCmd(replayFn ReplayFn) *cobra.Command {
+	return &cobra.Command{
+		Use:   "replay [bundle]",
+		Short: "Rerun a failed simulation from a reproduction bundle",
+		Long: `Rerun a failed simulation from a reproduction bundle written with the -ReproBundleDir flag.
+The simulation is run with the seed and config of the failed run, up to and including the failing block.`,
+		Example: "$ <appd> sim replay ~/.simapp/simulations/sim-repro-42-103.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bundle, err := simtypes.ReadReproBundle(args[0])
+			if err != nil {
+				return err
+			}
+
+			config := bundle.ReplayConfig()
+			w := cmd.OutOrStdout()
+			fmt.Fprintf(w, "replaying simulation with seed %d up to block %d, original failure: %s\n", config.Seed, bundle.Height, bundle.Failure)
+
+			tb := newReplayTB(w)
+			var replayErr error
+			tb.run(func() { replayErr = replayFn(tb, w, config) })
+
+			if tb.Failed() {
+				return errors.New("simulation failure reproduced")
+			}
+			if replayErr != nil {
+				return fmt.Errorf("simulation failure reproduced: %w", replayErr)
+			}
+
+			fmt.Fprintln(w, "simulation completed without reproducing the failure")
+			return nil
+		},
+	}
+}
+
+// replayTB implements simtypes.TB to run a simulation outside of go test. It
+// prints the failures to w and records that the simulation failed.
+type replayTB struct {
+	w      io.Writer
+	mu     sync.Mutex
+	failed bool
+}
+
+var _ simtypes.TB = (*replayTB)(nil)
+
+func newReplayTB(w io.Writer) *replayTB {
+	return &replayTB{w: w}
+}
+
+// run executes fn in its own goroutine, so FailNow can stop it with
+// runtime.Goexit like the testing package does.
+func (t *replayTB) run(fn func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	<-done
+}
+
+func (t *replayTB) Helper() {}
+
+func (t *replayTB) Failed() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failed
+}
+
+func (t *replayTB) FailNow() {
+	t.mu.Lock()
+	t.failed = true
+	t.mu.Unlock()
+	runtime.Goexit()
+}
+
+func (t *replayTB) Fatalf(format string, args ...any) {
+	fmt.Fprintf(t.w, format+"\n", args...)
+	t.FailNow()
+}
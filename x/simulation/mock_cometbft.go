@@ -4,12 +4,13 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
-	"testing"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
 type mockValidator struct {
@@ -78,7 +79,7 @@ func (vals mockValidators) randomProposer(r *rand.Rand) []byte {
 
 // updateValidators mimics CometBFT's update logic.
 func updateValidators(
-	tb testing.TB,
+	tb simtypes.TB,
 	r *rand.Rand,
 	params Params,
 	current map[string]mockValidator,
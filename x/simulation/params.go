@@ -75,6 +75,22 @@ func (p Params) BlockSizeTransitionMatrix() simulation.TransitionMatrix {
 	return p.blockSizeTransitionMatrix
 }
 
+// MarshalJSON implements json.Marshaler so the randomized params can be
+// printed and exported alongside the simulation results.
+func (p Params) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		PastEvidenceFraction      float64 `json:"past_evidence_fraction"`
+		NumKeys                   int     `json:"num_keys"`
+		EvidenceFraction          float64 `json:"evidence_fraction"`
+		InitialLivenessWeightings []int   `json:"initial_liveness_weightings"`
+	}{
+		PastEvidenceFraction:      p.pastEvidenceFraction,
+		NumKeys:                   p.numKeys,
+		EvidenceFraction:          p.evidenceFraction,
+		InitialLivenessWeightings: p.initialLivenessWeightings,
+	})
+}
+
 // RandomParams returns random simulation parameters
 func RandomParams(r *rand.Rand) Params {
 	return Params{
@@ -0,0 +1,92 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// reproLogTailSize is the number of trailing operation log entries kept in a
+// reproduction bundle.
+const reproLogTailSize = 100
+
+// reproRecorder writes a reproduction bundle when a simulation fails. It is a
+// no-op when no bundle directory is configured.
+type reproRecorder struct {
+	w         io.Writer
+	config    simulation.Config
+	params    Params
+	logWriter LogWriter
+	exportFn  simulation.AppStateExportFn
+}
+
+func (rr reproRecorder) record(height int64, failure string) {
+	if rr.config.ReproBundleDir == "" {
+		return
+	}
+
+	bundle := simulation.ReproBundle{
+		Seed:      rr.config.Seed,
+		Config:    rr.config,
+		Params:    mustMarshalJSONIndent(rr.params),
+		Height:    height,
+		Failure:   failure,
+		AppState:  rr.exportAppState(),
+		OpLogTail: logTail(rr.logWriter, reproLogTailSize),
+	}
+
+	path, err := simulation.WriteReproBundle(rr.config.ReproBundleDir, bundle)
+	if err != nil {
+		fmt.Fprintf(rr.w, "failed to write reproduction bundle: %v\n", err)
+		return
+	}
+
+	fmt.Fprintf(rr.w, "reproduction bundle written to %s\n", path)
+}
+
+// exportAppState exports the app state, if an export function was provided.
+// The app may be in an inconsistent state after a failure, so a failing
+// export only results in a bundle without app state.
+func (rr reproRecorder) exportAppState() (appState json.RawMessage) {
+	if rr.exportFn == nil {
+		return nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintf(rr.w, "failed to export app state for reproduction bundle: %v\n", r)
+			appState = nil
+		}
+	}()
+
+	appState, err := rr.exportFn()
+	if err != nil {
+		fmt.Fprintf(rr.w, "failed to export app state for reproduction bundle: %v\n", err)
+		return nil
+	}
+
+	return appState
+}
+
+// logTail returns the last n entries of the operation log, if the log writer
+// keeps them in memory.
+func logTail(lw LogWriter, n int) []json.RawMessage {
+	slw, ok := lw.(*StandardLogWriter)
+	if !ok {
+		return nil
+	}
+
+	entries := slw.OpEntries
+	if len(entries) > n {
+		entries = entries[len(entries)-n:]
+	}
+
+	tail := make([]json.RawMessage, len(entries))
+	for i, entry := range entries {
+		tail[i] = entry.MustMarshal()
+	}
+
+	return tail
+}
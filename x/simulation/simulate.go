@@ -7,7 +7,6 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
-	"testing"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -57,7 +56,7 @@ func initChain(
 // SimulateFromSeed tests an application by running the provided
 // operations, testing the provided invariants, but using the provided config.Seed.
 func SimulateFromSeed(
-	tb testing.TB,
+	tb simulation.TB,
 	w io.Writer,
 	app *baseapp.BaseApp,
 	appStateFn simulation.AppStateFn,
@@ -66,6 +65,25 @@ func SimulateFromSeed(
 	blockedAddrs map[string]bool,
 	config simulation.Config,
 	cdc codec.JSONCodec,
+) (stopEarly bool, exportedParams Params, err error) {
+	tb.Helper()
+	return SimulateFromSeedX(tb, w, app, appStateFn, randAccFn, ops, blockedAddrs, config, cdc, nil)
+}
+
+// SimulateFromSeedX is the same as SimulateFromSeed, but additionally takes a
+// function exporting the app state. When config.ReproBundleDir is set, the
+// exported state is included in the reproduction bundle written on failure.
+func SimulateFromSeedX(
+	tb simulation.TB,
+	w io.Writer,
+	app *baseapp.BaseApp,
+	appStateFn simulation.AppStateFn,
+	randAccFn simulation.RandomAccountFn,
+	ops WeightedOperations,
+	blockedAddrs map[string]bool,
+	config simulation.Config,
+	cdc codec.JSONCodec,
+	exportFn simulation.AppStateExportFn,
 ) (stopEarly bool, exportedParams Params, err error) {
 	tb.Helper()
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
@@ -145,6 +163,7 @@ func SimulateFromSeed(
 	// These are operations which have been queued by previous operations
 	operationQueue := NewOperationQueue()
 	logWriter := NewLogWriter(testingMode)
+	recorder := reproRecorder{w: w, config: config, params: params, logWriter: logWriter, exportFn: exportFn}
 
 	blockSimulator := createBlockSimulator(
 		tb,
@@ -156,6 +175,7 @@ func SimulateFromSeed(
 		operationQueue,
 		timeOperationQueue,
 		logWriter,
+		recorder,
 		config,
 	)
 
@@ -167,6 +187,7 @@ func SimulateFromSeed(
 			if r := recover(); r != nil {
 				_, _ = fmt.Fprintf(w, "simulation halted due to panic on block %d\n", blockHeight)
 				logWriter.PrintLogs()
+				recorder.record(blockHeight, fmt.Sprintf("panic: %v", r))
 				panic(r)
 			}
 		}()
@@ -186,6 +207,7 @@ func SimulateFromSeed(
 
 		res, err := app.FinalizeBlock(finalizeBlockReq)
 		if err != nil {
+			recorder.record(blockHeight, err.Error())
 			return true, params, err
 		}
 
@@ -203,12 +225,12 @@ func SimulateFromSeed(
 		// run queued operations; ignores block size if block size is too small
 		numQueuedOpsRan, futureOps := runQueuedOperations(
 			tb, operationQueue, int(blockHeight), r, app, ctx, accs, logWriter,
-			eventStats.Tally, config.Lean, config.ChainID,
+			recorder, eventStats.Tally, config.Lean, config.ChainID,
 		)
 
 		numQueuedTimeOpsRan, timeFutureOps := runQueuedTimeOperations(tb,
 			timeOperationQueue, int(blockHeight), blockTime,
-			r, app, ctx, accs, logWriter, eventStats.Tally,
+			r, app, ctx, accs, logWriter, recorder, eventStats.Tally,
 			config.Lean, config.ChainID,
 		)
 
@@ -235,6 +257,7 @@ func SimulateFromSeed(
 		if config.Commit {
 			_, err := app.Commit()
 			if err != nil {
+				recorder.record(blockHeight, err.Error())
 				return true, params, err
 			}
 
@@ -298,10 +321,10 @@ type blockSimFn func(
 
 // Returns a function to simulate blocks. Written like this to avoid constant
 // parameters being passed every time, to minimize memory overhead.
-func createBlockSimulator(tb testing.TB, testingMode bool, w io.Writer, params Params,
+func createBlockSimulator(tb simulation.TB, testingMode bool, w io.Writer, params Params,
 	event func(route, op, evResult string), ops WeightedOperations,
 	operationQueue OperationQueue, timeOperationQueue []simulation.FutureOperation,
-	logWriter LogWriter, config simulation.Config,
+	logWriter LogWriter, recorder reproRecorder, config simulation.Config,
 ) blockSimFn {
 	tb.Helper()
 	lastBlockSizeState := 0 // state for [4 * uniform distribution]
@@ -346,6 +369,7 @@ func createBlockSimulator(tb testing.TB, testingMode bool, w io.Writer, params P
 
 			if err != nil {
 				logWriter.PrintLogs()
+				recorder.record(header.Height, fmt.Sprintf("operation %d from x/%s: %v", opCount, opMsg.Route, err))
 				tb.Fatalf(`error on block  %d/%d, operation (%d/%d) from x/%s:
 %v
 Comment: %s`,
@@ -366,9 +390,9 @@ Comment: %s`,
 	}
 }
 
-func runQueuedOperations(tb testing.TB, queueOps map[int][]simulation.Operation,
+func runQueuedOperations(tb simulation.TB, queueOps map[int][]simulation.Operation,
 	height int, r *rand.Rand, app *baseapp.BaseApp,
-	ctx sdk.Context, accounts []simulation.Account, logWriter LogWriter,
+	ctx sdk.Context, accounts []simulation.Account, logWriter LogWriter, recorder reproRecorder,
 	event func(route, op, evResult string), lean bool, chainID string,
 ) (numOpsRan int, allFutureOps []simulation.FutureOperation) {
 	tb.Helper()
@@ -395,6 +419,7 @@ func runQueuedOperations(tb testing.TB, queueOps map[int][]simulation.Operation,
 
 		if err != nil {
 			logWriter.PrintLogs()
+			recorder.record(int64(height), fmt.Sprintf("queued operation from x/%s: %v", opMsg.Route, err))
 			tb.FailNow()
 		}
 	}
@@ -403,10 +428,10 @@ func runQueuedOperations(tb testing.TB, queueOps map[int][]simulation.Operation,
 	return numOpsRan, allFutureOps
 }
 
-func runQueuedTimeOperations(tb testing.TB, queueOps []simulation.FutureOperation,
+func runQueuedTimeOperations(tb simulation.TB, queueOps []simulation.FutureOperation,
 	height int, currentTime time.Time, r *rand.Rand,
 	app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account,
-	logWriter LogWriter, event func(route, op, evResult string),
+	logWriter LogWriter, recorder reproRecorder, event func(route, op, evResult string),
 	lean bool, chainID string,
 ) (numOpsRan int, allFutureOps []simulation.FutureOperation) {
 	tb.Helper()
@@ -425,6 +450,7 @@ func runQueuedTimeOperations(tb testing.TB, queueOps []simulation.FutureOperatio
 
 		if err != nil {
 			logWriter.PrintLogs()
+			recorder.record(int64(height), fmt.Sprintf("queued time operation from x/%s: %v", opMsg.Route, err))
 			tb.FailNow()
 		}
 
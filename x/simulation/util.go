@@ -14,15 +14,18 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
-func getTestingMode(tb testing.TB) (testingMode bool, t *testing.T, b *testing.B) {
+// getTestingMode reports whether the simulation runs as a test rather than a
+// benchmark. Any TB other than *testing.B (e.g. a replay harness) is treated as
+// a test.
+func getTestingMode(tb simtypes.TB) (testingMode bool, t *testing.T, b *testing.B) {
 	tb.Helper()
 	testingMode = false
 
-	if _t, ok := tb.(*testing.T); ok {
-		t = _t
-		testingMode = true
+	if _b, ok := tb.(*testing.B); ok {
+		b = _b
 	} else {
-		b = tb.(*testing.B)
+		t, _ = tb.(*testing.T)
+		testingMode = true
 	}
 
 	return testingMode, t, b
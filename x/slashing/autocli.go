@@ -34,6 +34,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "MaintenanceWindows",
+					Use:       "maintenance-windows",
+					Short:     "Query the maintenance windows which have not ended yet",
+					Long:      "Query the maintenance windows which have not ended yet, of all validators or of a single one with --validator-address",
+				},
+				{
+					RpcMethod:      "JailInfo",
+					Use:            "jail-info [validator-address]",
+					Short:          "Query the jailing state of a validator and why it cannot be unjailed",
+					Long:           "Query whether a validator is jailed or tombstoned, its jail end time, missed blocks and self-delegation, and the reasons why it cannot be unjailed at the current block, if any.",
+					Example:        fmt.Sprintf("%s query slashing jail-info [validator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -45,6 +59,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Unjail a jailed validator",
 					Example:   fmt.Sprintf("%s tx slashing unjail --from [validator]", version.AppName),
 				},
+				{
+					RpcMethod:      "ScheduleMaintenanceWindow",
+					Use:            "schedule-maintenance-window [start-height] [end-height]",
+					Short:          "Schedule a maintenance window during which the blocks missed by the validator do not count toward its downtime",
+					Long:           "Schedule a maintenance window over the heights from start-height included to end-height excluded. The window is bounded by the max_maintenance_blocks, maintenance_period and min_maintenance_notice params.",
+					Example:        fmt.Sprintf("%s tx slashing schedule-maintenance-window 1000000 1000600 --from [validator]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "start_height"}, {ProtoField: "end_height"}},
+				},
+				{
+					RpcMethod:      "CancelMaintenanceWindow",
+					Use:            "cancel-maintenance-window [start-height]",
+					Short:          "Cancel the maintenance window of the validator starting at start-height",
+					Example:        fmt.Sprintf("%s tx slashing cancel-maintenance-window 1000000 --from [validator]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "start_height"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -48,6 +48,9 @@ type ModuleOutputs struct {
 	Keeper keeper.Keeper
 	Module appmodule.AppModule
 	Hooks  staking.StakingHooksWrapper
+
+	SlashedTokensHandler staking.SlashedTokensHandlerWrapper
+	LivenessProvider     staking.ValidatorLivenessProviderWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -62,11 +65,14 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic(fmt.Errorf("unable to decode authority in slashing: %w", err))
 	}
 
-	k := keeper.NewKeeper(in.Environment, in.Cdc, in.LegacyAmino, in.StakingKeeper, authStr)
+	k := keeper.NewKeeper(in.Environment, in.Cdc, in.LegacyAmino, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, authStr)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, in.Registry)
 	return ModuleOutputs{
 		Keeper: k,
 		Module: m,
 		Hooks:  staking.StakingHooksWrapper{StakingHooks: k.Hooks()},
+
+		SlashedTokensHandler: staking.SlashedTokensHandlerWrapper{SlashedTokensHandler: k},
+		LivenessProvider:     staking.ValidatorLivenessProviderWrapper{ValidatorLivenessProvider: k},
 	}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/slashing/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -59,6 +60,20 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	for _, w := range data.MaintenanceWindows {
+		valAddr, err := keeper.sk.ValidatorAddressCodec().StringToBytes(w.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := keeper.MaintenanceWindows.Set(ctx, collections.Join(sdk.ValAddress(valAddr), w.StartHeight), w.EndHeight); err != nil {
+			return err
+		}
+	}
+
+	if err := keeper.ValidateSlashDestinationAddresses(data.Params); err != nil {
+		return err
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -100,5 +115,23 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks), nil
+
+	genState := types.NewGenesisState(params, signingInfos, missedBlocks)
+	err = keeper.MaintenanceWindows.Walk(ctx, nil, func(key collections.Pair[sdk.ValAddress, int64], end int64) (stop bool, err error) {
+		valStr, err := keeper.sk.ValidatorAddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		genState.MaintenanceWindows = append(genState.MaintenanceWindows, types.MaintenanceWindow{
+			ValidatorAddress: valStr,
+			StartHeight:      key.K2(),
+			EndHeight:        end,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genState, nil
 }
@@ -2,14 +2,17 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -80,3 +83,68 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// MaintenanceWindows returns the maintenance windows which have not ended yet.
+func (k Querier) MaintenanceWindows(ctx context.Context, req *types.QueryMaintenanceWindowsRequest) (*types.QueryMaintenanceWindowsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	var opts []func(o *query.CollectionsPaginateOptions[collections.Pair[sdk.ValAddress, int64]])
+	if req.ValidatorAddress != "" {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+		}
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[sdk.ValAddress, int64](valAddr))
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	windows, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.Keeper.MaintenanceWindows,
+		req.Pagination,
+		func(_ collections.Pair[sdk.ValAddress, int64], end int64) (bool, error) {
+			return end > height, nil
+		},
+		func(key collections.Pair[sdk.ValAddress, int64], end int64) (types.MaintenanceWindow, error) {
+			valStr, err := k.sk.ValidatorAddressCodec().BytesToString(key.K1())
+			if err != nil {
+				return types.MaintenanceWindow{}, err
+			}
+			return types.MaintenanceWindow{ValidatorAddress: valStr, StartHeight: key.K2(), EndHeight: end}, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMaintenanceWindowsResponse{MaintenanceWindows: windows, Pagination: pageRes}, nil
+}
+
+// JailInfo returns the jailing state of a validator, with the reasons why it
+// cannot be unjailed at the current block.
+func (k Querier) JailInfo(ctx context.Context, req *types.QueryJailInfoRequest) (*types.QueryJailInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	info, err := k.GetJailInfo(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorForAddress) {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryJailInfoResponse{JailInfo: info}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 
@@ -48,8 +49,13 @@ func (h Hooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddres
 	return h.k.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo)
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
-func (h Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+// AfterValidatorRemoved deletes the address-pubkey relation and the maintenance
+// windows when a validator is removed,
+func (h Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	if err := h.k.MaintenanceWindows.Clear(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, int64](valAddr)); err != nil {
+		return err
+	}
+
 	return h.k.AddrPubkeyRelation.Remove(ctx, crypto.Address(consAddr))
 }
 
@@ -82,6 +82,19 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 
 	modifiedSignInfo := false
 	missed := signed == comet.BlockIDFlagAbsent
+	if missed && params.MaxMaintenanceBlocks > 0 {
+		// blocks missed during a maintenance window are not counted
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+		if err != nil {
+			return err
+		}
+
+		inMaintenance, err := k.IsInMaintenance(ctx, valAddr, height)
+		if err != nil {
+			return err
+		}
+		missed = !inMaintenance
+	}
 	switch {
 	case !previous && missed:
 		// Bitmap value has changed from not missed to missed, so we flip the bit
@@ -22,6 +22,8 @@ type Keeper struct {
 	environment appmodule.Environment
 	cdc         codec.BinaryCodec
 	legacyAmino *codec.LegacyAmino
+	ak          types.AccountKeeper
+	bk          types.BankKeeper
 	sk          types.StakingKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -35,15 +37,19 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// MaintenanceWindows key: ValAddr+StartHeight | value: EndHeight
+	MaintenanceWindows collections.Map[collections.Pair[sdk.ValAddress, int64], int64]
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, sk types.StakingKeeper, authority string) Keeper {
+func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, authority string) Keeper {
 	sb := collections.NewSchemaBuilder(environment.KVStoreService)
 	k := Keeper{
 		environment: environment,
 		cdc:         cdc,
 		legacyAmino: legacyAmino,
+		ak:          ak,
+		bk:          bk,
 		sk:          sk,
 		authority:   authority,
 		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
@@ -68,6 +74,13 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		MaintenanceWindows: collections.NewMap(
+			sb,
+			types.MaintenanceWindowKeyPrefix,
+			"maintenance_windows",
+			collections.PairKeyCodec(sdk.ValAddressKey, collections.Int64Key),
+			collections.Int64Value,
+		),
 	}
 
 	schema, err := sb.Build()
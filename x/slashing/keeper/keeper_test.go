@@ -35,6 +35,8 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx            sdk.Context
+	accountKeeper  *slashingtestutil.MockAccountKeeper
+	bankKeeper     *slashingtestutil.MockBankKeeper
 	stakingKeeper  *slashingtestutil.MockStakingKeeper
 	slashingKeeper slashingkeeper.Keeper
 	queryClient    slashingtypes.QueryClient
@@ -53,6 +55,9 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	// gomock initializations
 	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = slashingtestutil.NewMockAccountKeeper(ctrl)
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	s.bankKeeper = slashingtestutil.NewMockBankKeeper(ctrl)
 	s.stakingKeeper = slashingtestutil.NewMockStakingKeeper(ctrl)
 	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
 	s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).AnyTimes()
@@ -65,6 +70,8 @@ func (s *KeeperTestSuite) SetupTest() {
 		env,
 		encCfg.Codec,
 		encCfg.Amino,
+		s.accountKeeper,
+		s.bankKeeper,
 		s.stakingKeeper,
 		authStr,
 	)
@@ -162,7 +169,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 		func(i int64) {
 			s.ctx.KVStore(s.key).Set(validatorMissedBlockBitmapKey(consAddr, index), []byte{})
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"ad8ab0b2275c38db1c7d978bac4fc1dcec41431e8776913241434839eda83f93",
 	)
 	s.Require().NoError(err)
 
@@ -174,7 +181,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 			err := s.slashingKeeper.SetMissedBlockBitmapChunk(s.ctx, consAddr, index, []byte{})
 			s.Require().NoError(err)
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"ad8ab0b2275c38db1c7d978bac4fc1dcec41431e8776913241434839eda83f93",
 	)
 	s.Require().NoError(err)
 }
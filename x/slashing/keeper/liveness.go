@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ stakingtypes.ValidatorLivenessProvider = Keeper{}
+
+// ValidatorLiveness returns the liveness of a validator over the signing
+// window, computed from its signing info, or nil if the validator has no
+// signing info, i.e. it was never bonded.
+func (k Keeper) ValidatorLiveness(ctx context.Context, consAddr sdk.ConsAddress) (*stakingtypes.ValidatorLiveness, error) {
+	signInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the blocks of the window elapsed since the validator was bonded or
+	// unjailed, as its missed blocks are reset when it is jailed
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	windowBlocks := min(max(height-signInfo.StartHeight, 0), params.SignedBlocksWindow)
+
+	uptime := sdkmath.LegacyOneDec()
+	if windowBlocks > 0 {
+		signed := max(windowBlocks-signInfo.MissedBlocksCounter, 0)
+		uptime = sdkmath.LegacyNewDec(signed).QuoInt64(windowBlocks)
+	}
+
+	return &stakingtypes.ValidatorLiveness{
+		SignedBlocksWindow:  params.SignedBlocksWindow,
+		MinSignedPerWindow:  params.MinSignedPerWindow,
+		StartHeight:         signInfo.StartHeight,
+		WindowBlocks:        windowBlocks,
+		MissedBlocksCounter: signInfo.MissedBlocksCounter,
+		Uptime:              uptime,
+		JailedUntil:         signInfo.JailedUntil,
+		Tombstoned:          signInfo.Tombstoned,
+	}, nil
+}
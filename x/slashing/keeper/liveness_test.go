@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorLiveness() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	consAddr := sdk.ConsAddress("cons_addr___________")
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SignedBlocksWindow = 100
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// the liveness of a validator without signing info is not tracked
+	liveness, err := keeper.ValidatorLiveness(ctx, consAddr)
+	require.NoError(err)
+	require.Nil(liveness)
+
+	jailedUntil := time.Unix(1000, 0).UTC()
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr.String(), 10, jailedUntil, false, 5)))
+
+	// the validator is bonded for less than a window
+	liveness, err = keeper.ValidatorLiveness(ctx.WithHeaderInfo(header.Info{Height: 30}), consAddr)
+	require.NoError(err)
+	require.Equal(int64(100), liveness.SignedBlocksWindow)
+	require.Equal(params.MinSignedPerWindow, liveness.MinSignedPerWindow)
+	require.Equal(int64(10), liveness.StartHeight)
+	require.Equal(int64(20), liveness.WindowBlocks)
+	require.Equal(int64(5), liveness.MissedBlocksCounter)
+	require.Equal(sdkmath.LegacyNewDecWithPrec(75, 2), liveness.Uptime)
+	require.Equal(jailedUntil, liveness.JailedUntil)
+	require.False(liveness.Tombstoned)
+
+	// the window is full
+	liveness, err = keeper.ValidatorLiveness(ctx.WithHeaderInfo(header.Info{Height: 500}), consAddr)
+	require.NoError(err)
+	require.Equal(int64(100), liveness.WindowBlocks)
+	require.Equal(sdkmath.LegacyNewDecWithPrec(95, 2), liveness.Uptime)
+
+	// no block of the window elapsed yet
+	liveness, err = keeper.ValidatorLiveness(ctx.WithHeaderInfo(header.Info{Height: 10}), consAddr)
+	require.NoError(err)
+	require.Zero(liveness.WindowBlocks)
+	require.Equal(sdkmath.LegacyOneDec(), liveness.Uptime)
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScheduleMaintenanceWindow schedules a maintenance window of the validator
+// over the heights [startHeight, endHeight), during which the blocks it misses
+// do not count toward its downtime. The window must start at least
+// MinMaintenanceNotice blocks ahead, and the maintenance blocks of the
+// validator must not exceed MaxMaintenanceBlocks in any maintenance period.
+// The windows of a validator must be at least SignedBlocksWindow blocks apart,
+// so that windows cannot be chained, e.g. across a period boundary, and the
+// bonded tokens of the validators in maintenance must not exceed
+// MaxMaintenancePower of the bonded tokens at any height.
+func (k Keeper) ScheduleMaintenanceWindow(ctx context.Context, valAddr sdk.ValAddress, startHeight, endHeight int64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxMaintenanceBlocks == 0 {
+		return types.ErrMaintenanceDisabled
+	}
+
+	if endHeight <= startHeight {
+		return types.ErrInvalidMaintenanceWindow.Wrapf("end height %d must be greater than start height %d", endHeight, startHeight)
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	if startHeight < height+params.MinMaintenanceNotice {
+		return types.ErrInvalidMaintenanceWindow.Wrapf("window must start at least %d blocks after the current height %d", params.MinMaintenanceNotice, height)
+	}
+
+	if endHeight-startHeight > params.MaxMaintenanceBlocks {
+		return types.ErrMaintenanceBudgetExceeded.Wrapf("window of %d blocks exceeds %d blocks", endHeight-startHeight, params.MaxMaintenanceBlocks)
+	}
+
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil {
+		return types.ErrNoValidatorForAddress
+	}
+
+	// windows which ended before the current maintenance period no longer
+	// count toward the budget, nor are close enough to the new window to be
+	// chained with it
+	if err := k.pruneMaintenanceWindows(ctx, valAddr, min(height-height%params.MaintenancePeriod, height-params.SignedBlocksWindow)); err != nil {
+		return err
+	}
+
+	// blocks of maintenance by period, over the periods of the new window
+	firstPeriod, lastPeriod := startHeight/params.MaintenancePeriod, (endHeight-1)/params.MaintenancePeriod
+	used := make(map[int64]int64)
+	err = k.MaintenanceWindows.Walk(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, int64](valAddr), func(key collections.Pair[sdk.ValAddress, int64], end int64) (bool, error) {
+		start := key.K2()
+		if start < endHeight+params.SignedBlocksWindow && startHeight < end+params.SignedBlocksWindow {
+			return true, types.ErrInvalidMaintenanceWindow.Wrapf("window is less than %d blocks apart from the window [%d, %d)", params.SignedBlocksWindow, start, end)
+		}
+
+		for p := firstPeriod; p <= lastPeriod; p++ {
+			used[p] += overlap(start, end, p*params.MaintenancePeriod, (p+1)*params.MaintenancePeriod)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for p := firstPeriod; p <= lastPeriod; p++ {
+		blocks := used[p] + overlap(startHeight, endHeight, p*params.MaintenancePeriod, (p+1)*params.MaintenancePeriod)
+		if blocks > params.MaxMaintenanceBlocks {
+			return types.ErrMaintenanceBudgetExceeded.Wrapf("%d maintenance blocks in the period starting at height %d, at most %d allowed",
+				blocks, p*params.MaintenancePeriod, params.MaxMaintenanceBlocks)
+		}
+	}
+
+	if err := k.checkMaintenancePower(ctx, params, valAddr, validator.GetBondedTokens(), startHeight, endHeight); err != nil {
+		return err
+	}
+
+	if err := k.MaintenanceWindows.Set(ctx, collections.Join(valAddr, startHeight), endHeight); err != nil {
+		return err
+	}
+
+	return k.emitMaintenanceWindowEvent(ctx, types.EventTypeScheduleMaintenanceWindow, valAddr, startHeight, endHeight)
+}
+
+// checkMaintenancePower checks that the bonded tokens of the validators in
+// maintenance do not exceed MaxMaintenancePower of the bonded tokens at any
+// height of the new window of the validator. The tokens of the validators are
+// the ones bonded when the window is scheduled.
+func (k Keeper) checkMaintenancePower(ctx context.Context, params types.Params, valAddr sdk.ValAddress, tokens math.Int, startHeight, endHeight int64) error {
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+	maxPower := params.MaxMaintenancePower.MulInt(totalBonded)
+
+	// the changes of the power in maintenance over the new window, windows
+	// ending at a height being sorted before the ones starting at it
+	type powerChange struct {
+		height int64
+		power  math.Int
+	}
+	changes := []powerChange{{startHeight, tokens}, {endHeight, tokens.Neg()}}
+	err = k.MaintenanceWindows.Walk(ctx, nil, func(key collections.Pair[sdk.ValAddress, int64], end int64) (bool, error) {
+		start := key.K2()
+		if key.K1().Equals(valAddr) || end <= startHeight || endHeight <= start {
+			return false, nil
+		}
+
+		validator, err := k.sk.Validator(ctx, key.K1())
+		if err != nil {
+			return true, err
+		}
+		if validator == nil {
+			return false, nil
+		}
+
+		power := validator.GetBondedTokens()
+		changes = append(changes, powerChange{max(start, startHeight), power}, powerChange{min(end, endHeight), power.Neg()})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	slices.SortStableFunc(changes, func(a, b powerChange) int {
+		if a.height != b.height {
+			return cmp.Compare(a.height, b.height)
+		}
+		return a.power.BigInt().Cmp(b.power.BigInt())
+	})
+
+	power := math.ZeroInt()
+	for _, c := range changes {
+		power = power.Add(c.power)
+		if math.LegacyNewDecFromInt(power).GT(maxPower) {
+			return types.ErrMaintenancePowerExceeded.Wrapf("%s tokens in maintenance at height %d, at most %s allowed",
+				power, c.height, maxPower.TruncateInt())
+		}
+	}
+
+	return nil
+}
+
+// CancelMaintenanceWindow cancels the maintenance window of the validator
+// starting at the given height. A window which already started ends at the
+// current height, its blocks still counting toward the budget of the period.
+func (k Keeper) CancelMaintenanceWindow(ctx context.Context, valAddr sdk.ValAddress, startHeight int64) error {
+	key := collections.Join(valAddr, startHeight)
+	endHeight, err := k.MaintenanceWindows.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrMaintenanceWindowNotFound.Wrapf("no window starting at height %d", startHeight)
+		}
+		return err
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	switch {
+	case endHeight <= height:
+		return types.ErrInvalidMaintenanceWindow.Wrapf("window ended at height %d", endHeight)
+	case startHeight > height:
+		if err := k.MaintenanceWindows.Remove(ctx, key); err != nil {
+			return err
+		}
+	default:
+		endHeight = height + 1
+		if err := k.MaintenanceWindows.Set(ctx, key, endHeight); err != nil {
+			return err
+		}
+	}
+
+	return k.emitMaintenanceWindowEvent(ctx, types.EventTypeCancelMaintenanceWindow, valAddr, startHeight, endHeight)
+}
+
+// IsInMaintenance returns true if the validator is within one of its
+// maintenance windows at the given height.
+func (k Keeper) IsInMaintenance(ctx context.Context, valAddr sdk.ValAddress, height int64) (bool, error) {
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, int64](valAddr).EndInclusive(height).Descending()
+	iter, err := k.MaintenanceWindows.Iterate(ctx, rng)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	// windows do not overlap, only the last one starting before the height
+	// may contain it
+	if !iter.Valid() {
+		return false, nil
+	}
+	end, err := iter.Value()
+	if err != nil {
+		return false, err
+	}
+
+	return height < end, nil
+}
+
+// pruneMaintenanceWindows removes the windows of the validator which ended
+// before the given height.
+func (k Keeper) pruneMaintenanceWindows(ctx context.Context, valAddr sdk.ValAddress, height int64) error {
+	var ended []collections.Pair[sdk.ValAddress, int64]
+	err := k.MaintenanceWindows.Walk(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, int64](valAddr), func(key collections.Pair[sdk.ValAddress, int64], end int64) (bool, error) {
+		if end <= height {
+			ended = append(ended, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range ended {
+		if err := k.MaintenanceWindows.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) emitMaintenanceWindowEvent(ctx context.Context, eventType string, valAddr sdk.ValAddress, startHeight, endHeight int64) error {
+	valStr, err := k.sk.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		eventType,
+		event.NewAttribute(types.AttributeKeyValidator, valStr),
+		event.NewAttribute(types.AttributeKeyStartHeight, fmt.Sprintf("%d", startHeight)),
+		event.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", endHeight)),
+	)
+}
+
+// overlap returns the number of heights of [start, end) within [from, to).
+func overlap(start, end, from, to int64) int64 {
+	start, end = max(start, from), min(end, to)
+	if end <= start {
+		return 0
+	}
+	return end - start
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	slashingkeeper "cosmossdk.io/x/slashing/keeper"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMaintenanceWindows() {
+	ctx, keeper := s.ctx.WithHeaderInfo(header.Info{Height: 100}), s.slashingKeeper
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(err)
+	validator, err := stakingtypes.NewValidator(valStr, pubKey, stakingtypes.Description{})
+	require.NoError(err)
+	s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+
+	// maintenance windows are disabled by default
+	err = keeper.ScheduleMaintenanceWindow(ctx, valAddr, 200, 210)
+	require.ErrorIs(err, slashingtypes.ErrMaintenanceDisabled)
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxMaintenanceBlocks = 100
+	params.MaintenancePeriod = 1000
+	params.MinMaintenanceNotice = 10
+	params.SignedBlocksWindow = 20
+	require.NoError(params.Validate())
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	testCases := []struct {
+		name       string
+		start, end int64
+		expErr     error
+	}{
+		{"empty window", 200, 200, slashingtypes.ErrInvalidMaintenanceWindow},
+		{"short notice", 105, 150, slashingtypes.ErrInvalidMaintenanceWindow},
+		{"window too long", 200, 301, slashingtypes.ErrMaintenanceBudgetExceeded},
+		{"valid", 110, 160, nil},
+		{"overlapping", 150, 170, slashingtypes.ErrInvalidMaintenanceWindow},
+		{"back to back", 160, 200, slashingtypes.ErrInvalidMaintenanceWindow},
+		{"less than a signed blocks window apart", 179, 200, slashingtypes.ErrInvalidMaintenanceWindow},
+		{"a signed blocks window apart", 180, 200, nil},
+		{"period budget exceeded", 300, 331, slashingtypes.ErrMaintenanceBudgetExceeded},
+		{"across periods", 990, 1010, nil},
+		{"chained across the period boundary", 1010, 1030, slashingtypes.ErrInvalidMaintenanceWindow},
+		{"next period budget exceeded", 1100, 1191, slashingtypes.ErrMaintenanceBudgetExceeded},
+		{"next period", 1100, 1190, nil},
+	}
+
+	for _, tc := range testCases {
+		err := keeper.ScheduleMaintenanceWindow(ctx, valAddr, tc.start, tc.end)
+		if tc.expErr != nil {
+			require.ErrorIs(err, tc.expErr, tc.name)
+		} else {
+			require.NoError(err, tc.name)
+		}
+	}
+
+	for height, expected := range map[int64]bool{109: false, 110: true, 159: true, 160: false, 180: true, 199: true, 200: false, 995: true, 1010: false} {
+		inMaintenance, err := keeper.IsInMaintenance(ctx, valAddr, height)
+		require.NoError(err)
+		require.Equal(expected, inMaintenance, height)
+	}
+
+	res, err := s.queryClient.MaintenanceWindows(ctx, &slashingtypes.QueryMaintenanceWindowsRequest{ValidatorAddress: valStr})
+	require.NoError(err)
+	require.Equal([]slashingtypes.MaintenanceWindow{
+		{ValidatorAddress: valStr, StartHeight: 110, EndHeight: 160},
+		{ValidatorAddress: valStr, StartHeight: 180, EndHeight: 200},
+		{ValidatorAddress: valStr, StartHeight: 990, EndHeight: 1010},
+		{ValidatorAddress: valStr, StartHeight: 1100, EndHeight: 1190},
+	}, res.MaintenanceWindows)
+
+	// a window which did not start is removed
+	require.NoError(keeper.CancelMaintenanceWindow(ctx, valAddr, 1100))
+	require.ErrorIs(keeper.CancelMaintenanceWindow(ctx, valAddr, 1100), slashingtypes.ErrMaintenanceWindowNotFound)
+
+	// a started window ends at the current height
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 120})
+	require.NoError(keeper.CancelMaintenanceWindow(ctx, valAddr, 110))
+	end, err := keeper.MaintenanceWindows.Get(ctx, collections.Join(valAddr, int64(110)))
+	require.NoError(err)
+	require.Equal(int64(121), end)
+
+	// ended windows are not queried
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 500})
+	require.ErrorIs(keeper.CancelMaintenanceWindow(ctx, valAddr, 110), slashingtypes.ErrInvalidMaintenanceWindow)
+	res, err = slashingkeeper.NewQuerier(keeper).MaintenanceWindows(ctx, &slashingtypes.QueryMaintenanceWindowsRequest{})
+	require.NoError(err)
+	require.Equal([]slashingtypes.MaintenanceWindow{
+		{ValidatorAddress: valStr, StartHeight: 990, EndHeight: 1010},
+	}, res.MaintenanceWindows)
+
+	// the windows are removed with the validator
+	require.NoError(keeper.Hooks().AfterValidatorRemoved(ctx, sdk.ConsAddress(pubKey.Address()), valAddr))
+	inMaintenance, err := keeper.IsInMaintenance(ctx, valAddr, 995)
+	require.NoError(err)
+	require.False(inMaintenance)
+}
+
+func (s *KeeperTestSuite) TestMaintenancePower() {
+	ctx, keeper := s.ctx.WithHeaderInfo(header.Info{Height: 100}), s.slashingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxMaintenanceBlocks = 100
+	params.MaintenancePeriod = 1000
+	params.MinMaintenanceNotice = 10
+	params.MaxMaintenancePower = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(params.Validate())
+	require.NoError(keeper.Params.Set(ctx, params))
+	s.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+
+	// validators bonding 6%, 5% and 3% of the tokens
+	valAddrs := make([]sdk.ValAddress, 3)
+	for i, tokens := range []int64{60, 50, 30} {
+		_, pubKey, addr := testdata.KeyTestPubAddr()
+		valAddrs[i] = sdk.ValAddress(addr)
+		valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+		require.NoError(err)
+		validator, err := stakingtypes.NewValidator(valStr, pubKey, stakingtypes.Description{})
+		require.NoError(err)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = math.NewInt(tokens)
+		s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddrs[i]).Return(validator, nil).AnyTimes()
+	}
+
+	require.NoError(keeper.ScheduleMaintenanceWindow(ctx, valAddrs[0], 200, 300))
+	// 11% of the tokens would be in maintenance over [250, 300)
+	err = keeper.ScheduleMaintenanceWindow(ctx, valAddrs[1], 250, 350)
+	require.ErrorIs(err, slashingtypes.ErrMaintenancePowerExceeded)
+	// the window of the first validator ends when the second one starts
+	require.NoError(keeper.ScheduleMaintenanceWindow(ctx, valAddrs[1], 300, 400))
+	// 9% of the tokens are in maintenance over [280, 300), then 8%
+	require.NoError(keeper.ScheduleMaintenanceWindow(ctx, valAddrs[2], 280, 320))
+}
+
+func (s *KeeperTestSuite) TestMissedBlocksDuringMaintenance() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(err)
+	validator, err := stakingtypes.NewValidator(valStr, pubKey, stakingtypes.Description{})
+	require.NoError(err)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(consAddr, nil).AnyTimes()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxMaintenanceBlocks = 100
+	params.MaintenancePeriod = 1000
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consStr, 1, time.Unix(0, 0), false, 0)))
+	require.NoError(keeper.MaintenanceWindows.Set(ctx, collections.Join(valAddr, int64(10)), 20))
+
+	for height := int64(5); height < 25; height++ {
+		ctx := ctx.WithHeaderInfo(header.Info{Height: height})
+		require.NoError(keeper.HandleValidatorSignatureWithParams(ctx, params, pubKey.Address(), 100, comet.BlockIDFlagAbsent))
+	}
+
+	// only the blocks missed out of the window count
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(int64(10), info.MissedBlocksCounter)
+}
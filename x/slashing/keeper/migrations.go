@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v4 "cosmossdk.io/x/slashing/migrations/v4"
+	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -42,3 +43,18 @@ func (m Migrator) Migrate3to4(ctx context.Context) error {
 	}
 	return v4.Migrate(ctx, m.keeper.cdc, store, params)
 }
+
+// Migrate4to5 migrates the x/slashing module state from the consensus
+// version 4 to version 5. Specifically, it sets the new slash destinations
+// param to an empty list, so that all slashed tokens keep being burned, and
+// the new max maintenance power param to its default.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.SlashDestinations = nil
+	params.MaxMaintenancePower = types.DefaultMaxMaintenancePower
+	return m.keeper.Params.Set(ctx, params)
+}
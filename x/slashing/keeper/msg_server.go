@@ -32,6 +32,10 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	if err := k.ValidateSlashDestinationAddresses(msg.Params); err != nil {
+		return nil, err
+	}
+
 	if err := k.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -54,3 +58,33 @@ func (k msgServer) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.Msg
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// ScheduleMaintenanceWindow implements MsgServer.ScheduleMaintenanceWindow method.
+// Validators schedule the windows during which the blocks they miss do not
+// count toward their downtime.
+func (k msgServer) ScheduleMaintenanceWindow(ctx context.Context, msg *types.MsgScheduleMaintenanceWindow) (*types.MsgScheduleMaintenanceWindowResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	if err := k.Keeper.ScheduleMaintenanceWindow(ctx, valAddr, msg.StartHeight, msg.EndHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleMaintenanceWindowResponse{}, nil
+}
+
+// CancelMaintenanceWindow implements MsgServer.CancelMaintenanceWindow method.
+func (k msgServer) CancelMaintenanceWindow(ctx context.Context, msg *types.MsgCancelMaintenanceWindow) (*types.MsgCancelMaintenanceWindowResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	if err := k.Keeper.CancelMaintenanceWindow(ctx, valAddr, msg.StartHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMaintenanceWindowResponse{}, nil
+}
@@ -128,6 +128,7 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					DowntimeJailDuration:    time.Duration(34800000000000),
 					SlashFractionDoubleSign: slashFractionDoubleSign,
 					SlashFractionDowntime:   slashFractionDowntime,
+					MaxMaintenancePower:     slashingtypes.DefaultMaxMaintenancePower,
 				},
 			},
 			expectErr: false,
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/event"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ stakingtypes.SlashedTokensHandler = Keeper{}
+
+// HandleSlashedTokens routes the tokens slashed from a staking pool to the
+// slash destinations set in the params. Tokens not routed to any destination
+// are burned, as is the share of an address destination which can't receive
+// it, e.g. because it has been blocked since the params were set, so that a
+// slash never fails on a destination. A slash_destination event is emitted for
+// every destination.
+func (k Keeper) HandleSlashedTokens(ctx context.Context, fromModule string, amt sdk.Coins) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	remaining := amt
+	for _, dest := range params.SlashDestinations {
+		// burn destinations are burned together with the remainder
+		if dest.Type == types.SlashDestinationTypeBurn {
+			continue
+		}
+
+		share := shareOf(amt, dest.Share)
+		if share.IsZero() {
+			continue
+		}
+
+		switch dest.Type {
+		case types.SlashDestinationTypeCommunityPool:
+			if err := k.bk.SendCoinsFromModuleToModule(ctx, fromModule, types.ProtocolPoolModuleName, share); err != nil {
+				return err
+			}
+		case types.SlashDestinationTypeAddress:
+			addr, err := k.ak.AddressCodec().StringToBytes(dest.Address)
+			if err != nil {
+				return err
+			}
+			if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+				return k.bk.SendCoinsFromModuleToAccount(ctx, fromModule, addr, share)
+			}); err != nil {
+				k.Logger(ctx).Error("failed to send slashed tokens, burning them", "address", dest.Address, "amount", share, "err", err)
+				continue
+			}
+		default:
+			return fmt.Errorf("invalid slash destination type: %s", dest.Type)
+		}
+
+		if err := k.emitSlashDestinationEvent(ctx, dest.Type, dest.Address, share); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(share...)
+	}
+
+	if remaining.IsZero() {
+		return nil
+	}
+
+	if err := k.bk.BurnCoins(ctx, k.ak.GetModuleAddress(fromModule), remaining); err != nil {
+		return err
+	}
+
+	return k.emitSlashDestinationEvent(ctx, types.SlashDestinationTypeBurn, "", remaining)
+}
+
+// ValidateSlashDestinationAddresses checks that the addresses of the slash
+// destinations are valid account addresses allowed to receive funds.
+func (k Keeper) ValidateSlashDestinationAddresses(params types.Params) error {
+	for _, dest := range params.SlashDestinations {
+		if dest.Type != types.SlashDestinationTypeAddress {
+			continue
+		}
+
+		addr, err := k.ak.AddressCodec().StringToBytes(dest.Address)
+		if err != nil {
+			return fmt.Errorf("invalid slash destination address %s: %w", dest.Address, err)
+		}
+
+		if k.bk.BlockedAddr(addr) {
+			return fmt.Errorf("slash destination address %s is not allowed to receive funds", dest.Address)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) emitSlashDestinationEvent(ctx context.Context, destType types.SlashDestinationType, addr string, amt sdk.Coins) error {
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSlashDestination,
+		event.NewAttribute(types.AttributeKeyDestination, destType.String()),
+		event.NewAttribute(types.AttributeKeyAddress, addr),
+		event.NewAttribute(types.AttributeKeyAmount, amt.String()),
+	)
+}
+
+// shareOf returns the share of the coins, rounded down.
+func shareOf(coins sdk.Coins, share sdkmath.LegacyDec) sdk.Coins {
+	result := sdk.NewCoins()
+	for _, c := range coins {
+		amount := sdkmath.LegacyNewDecFromInt(c.Amount).Mul(share).TruncateInt()
+		result = result.Add(sdk.NewCoin(c.Denom, amount))
+	}
+
+	return result
+}
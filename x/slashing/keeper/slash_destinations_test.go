@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestHandleSlashedTokens() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	const pool = "bonded_tokens_pool"
+	poolAddr := authtypes.NewModuleAddress(pool)
+	insuranceFund := sdk.AccAddress("insurance_fund______")
+	insuranceFundStr, err := s.accountKeeper.AddressCodec().BytesToString(insuranceFund)
+	require.NoError(err)
+
+	slashed := sdk.NewCoins(sdk.NewInt64Coin("stake", 1001))
+
+	// all tokens are burned by default
+	s.accountKeeper.EXPECT().GetModuleAddress(pool).Return(poolAddr)
+	s.bankKeeper.EXPECT().BurnCoins(ctx, []byte(poolAddr), slashed).Return(nil)
+	require.NoError(keeper.HandleSlashedTokens(ctx, pool, slashed))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.SlashDestinations = []slashingtypes.SlashDestination{
+		{Type: slashingtypes.SlashDestinationTypeCommunityPool, Share: sdkmath.LegacyNewDecWithPrec(5, 1)},
+		{Type: slashingtypes.SlashDestinationTypeAddress, Address: insuranceFundStr, Share: sdkmath.LegacyNewDecWithPrec(2, 1)},
+		{Type: slashingtypes.SlashDestinationTypeBurn, Share: sdkmath.LegacyNewDecWithPrec(1, 1)},
+	}
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// shares are rounded down and the remainder, including the burn share, is burned
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, pool, slashingtypes.ProtocolPoolModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 500))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), pool, sdk.AccAddress(insuranceFund), sdk.NewCoins(sdk.NewInt64Coin("stake", 200))).Return(nil)
+	s.accountKeeper.EXPECT().GetModuleAddress(pool).Return(poolAddr)
+	s.bankKeeper.EXPECT().BurnCoins(ctx, []byte(poolAddr), sdk.NewCoins(sdk.NewInt64Coin("stake", 301))).Return(nil)
+	require.NoError(keeper.HandleSlashedTokens(ctx, pool, slashed))
+
+	var destinations []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != slashingtypes.EventTypeSlashDestination {
+			continue
+		}
+		attr, ok := event.GetAttribute(slashingtypes.AttributeKeyAmount)
+		require.True(ok)
+		destinations = append(destinations, attr.Value)
+	}
+	require.Equal([]string{"1001stake", "500stake", "200stake", "301stake"}, destinations)
+
+	// the share of an address which can't receive it is burned
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, pool, slashingtypes.ProtocolPoolModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 500))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), pool, sdk.AccAddress(insuranceFund), sdk.NewCoins(sdk.NewInt64Coin("stake", 200))).Return(errors.New("blocked"))
+	s.accountKeeper.EXPECT().GetModuleAddress(pool).Return(poolAddr)
+	s.bankKeeper.EXPECT().BurnCoins(ctx, []byte(poolAddr), sdk.NewCoins(sdk.NewInt64Coin("stake", 501))).Return(nil)
+	require.NoError(keeper.HandleSlashedTokens(ctx, pool, slashed))
+}
+
+func (s *KeeperTestSuite) TestValidateSlashDestinationAddresses() {
+	keeper := s.slashingKeeper
+	require := s.Require()
+
+	insuranceFund := sdk.AccAddress("insurance_fund______")
+	insuranceFundStr, err := s.accountKeeper.AddressCodec().BytesToString(insuranceFund)
+	require.NoError(err)
+
+	params := slashingtypes.DefaultParams()
+	params.SlashDestinations = []slashingtypes.SlashDestination{
+		{Type: slashingtypes.SlashDestinationTypeAddress, Address: insuranceFundStr, Share: sdkmath.LegacyNewDecWithPrec(5, 1)},
+	}
+
+	s.bankKeeper.EXPECT().BlockedAddr(insuranceFund).Return(false)
+	require.NoError(keeper.ValidateSlashDestinationAddresses(params))
+
+	// a blocked address can't be a slash destination
+	s.bankKeeper.EXPECT().BlockedAddr(insuranceFund).Return(true)
+	require.ErrorContains(keeper.ValidateSlashDestinationAddresses(params), "not allowed to receive funds")
+
+	params.SlashDestinations[0].Address = "cosmos1invalid"
+	require.ErrorContains(keeper.ValidateSlashDestinationAddresses(params), "invalid slash destination address")
+}
+
+func (s *KeeperTestSuite) TestSlashDestinationsParamsValidation() {
+	require := s.Require()
+	half := sdkmath.LegacyNewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name         string
+		destinations []slashingtypes.SlashDestination
+		expErr       bool
+	}{
+		{"empty", nil, false},
+		{"valid", []slashingtypes.SlashDestination{
+			{Type: slashingtypes.SlashDestinationTypeCommunityPool, Share: half},
+			{Type: slashingtypes.SlashDestinationTypeBurn, Share: half},
+		}, false},
+		{"unspecified type", []slashingtypes.SlashDestination{{Share: half}}, true},
+		{"address missing", []slashingtypes.SlashDestination{{Type: slashingtypes.SlashDestinationTypeAddress, Share: half}}, true},
+		{"address not allowed", []slashingtypes.SlashDestination{{Type: slashingtypes.SlashDestinationTypeBurn, Address: "cosmos1", Share: half}}, true},
+		{"zero share", []slashingtypes.SlashDestination{{Type: slashingtypes.SlashDestinationTypeBurn, Share: sdkmath.LegacyZeroDec()}}, true},
+		{"shares above one", []slashingtypes.SlashDestination{
+			{Type: slashingtypes.SlashDestinationTypeCommunityPool, Share: half},
+			{Type: slashingtypes.SlashDestinationTypeBurn, Share: sdkmath.LegacyNewDecWithPrec(6, 1)},
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		params := slashingtypes.DefaultParams()
+		params.SlashDestinations = tc.destinations
+		err := params.Validate()
+		if tc.expErr {
+			require.Error(err, tc.name)
+		} else {
+			require.NoError(err, tc.name)
+		}
+	}
+}
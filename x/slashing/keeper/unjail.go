@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
-	"cosmossdk.io/errors"
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,32 +23,82 @@ func (k Keeper) Unjail(ctx context.Context, validatorAddr sdk.ValAddress) error
 		return types.ErrNoValidatorForAddress
 	}
 
-	// cannot be unjailed if no self-delegation exists
-	selfDel, err := k.sk.Delegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
+	_, blockers, err := k.jailInfo(ctx, validatorAddr, validator)
 	if err != nil {
 		return err
 	}
+	if len(blockers) > 0 {
+		return blockers[0]
+	}
 
-	if selfDel == nil {
-		return types.ErrMissingSelfDelegation
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	return k.sk.Unjail(ctx, consAddr)
+}
+
+// GetJailInfo returns the jailing state of a validator, with the reasons why it
+// cannot be unjailed at the current block.
+func (k Keeper) GetJailInfo(ctx context.Context, validatorAddr sdk.ValAddress) (types.JailInfo, error) {
+	validator, err := k.sk.Validator(ctx, validatorAddr)
+	if err != nil {
+		return types.JailInfo{}, err
+	}
+	if validator == nil {
+		return types.JailInfo{}, types.ErrNoValidatorForAddress
+	}
+
+	info, blockers, err := k.jailInfo(ctx, validatorAddr, validator)
+	if err != nil {
+		return types.JailInfo{}, err
 	}
 
-	tokens := validator.TokensFromShares(selfDel.GetShares()).TruncateInt()
-	minSelfBond := validator.GetMinSelfDelegation()
-	if tokens.LT(minSelfBond) {
-		return errors.Wrapf(
-			types.ErrSelfDelegationTooLowToUnjail, "%s less than %s", tokens, minSelfBond,
-		)
+	for _, blocker := range blockers {
+		info.UnjailBlockers = append(info.UnjailBlockers, blocker.Error())
+	}
+
+	return info, nil
+}
+
+// jailInfo returns the jailing state of a validator, and the errors preventing
+// it from being unjailed at the current block, in the order they are checked.
+func (k Keeper) jailInfo(ctx context.Context, validatorAddr sdk.ValAddress, validator sdk.ValidatorI) (types.JailInfo, []error, error) {
+	info := types.JailInfo{
+		ValidatorAddress:  validator.GetOperator(),
+		Status:            validator.GetStatus().String(),
+		Jailed:            validator.IsJailed(),
+		SelfDelegation:    math.ZeroInt(),
+		MinSelfDelegation: validator.GetMinSelfDelegation(),
+	}
+	var blockers []error
+
+	// cannot be unjailed if no self-delegation exists
+	selfDel, err := k.sk.Delegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
+	if err != nil {
+		return info, nil, err
+	}
+
+	if selfDel == nil {
+		blockers = append(blockers, types.ErrMissingSelfDelegation)
+	} else {
+		info.SelfDelegation = validator.TokensFromShares(selfDel.GetShares()).TruncateInt()
+		if info.SelfDelegation.LT(info.MinSelfDelegation) {
+			blockers = append(blockers, errorsmod.Wrapf(
+				types.ErrSelfDelegationTooLowToUnjail, "%s less than %s", info.SelfDelegation, info.MinSelfDelegation,
+			))
+		}
 	}
 
 	// cannot be unjailed if not jailed
-	if !validator.IsJailed() {
-		return types.ErrValidatorNotJailed
+	if !info.Jailed {
+		blockers = append(blockers, types.ErrValidatorNotJailed)
 	}
 
 	consAddr, err := validator.GetConsAddr()
 	if err != nil {
-		return err
+		return info, nil, err
 	}
 	// If the validator has a ValidatorSigningInfo object that signals that the
 	// validator was bonded and so we must check that the validator is not tombstoned
@@ -55,17 +108,24 @@ func (k Keeper) Unjail(ctx context.Context, validatorAddr sdk.ValAddress) error
 	// that the validator was never bonded and must've been jailed due to falling
 	// below their minimum self-delegation. The validator can unjail at any point
 	// assuming they've now bonded above their minimum self-delegation.
-	info, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
-	if err == nil {
-		// cannot be unjailed if tombstoned
-		if info.Tombstoned {
-			return types.ErrValidatorJailed
-		}
+	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return info, blockers, nil
+	case err != nil:
+		return info, nil, err
+	}
 
-		if k.environment.HeaderService.GetHeaderInfo(ctx).Time.Before(info.JailedUntil) {
-			return types.ErrValidatorJailed
-		}
+	info.Tombstoned = signingInfo.Tombstoned
+	info.JailedUntil = signingInfo.JailedUntil
+	info.MissedBlocksCounter = signingInfo.MissedBlocksCounter
+
+	// cannot be unjailed if tombstoned
+	if signingInfo.Tombstoned {
+		blockers = append(blockers, errorsmod.Wrap(types.ErrValidatorJailed, "validator is tombstoned"))
+	} else if blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time; blockTime.Before(signingInfo.JailedUntil) {
+		blockers = append(blockers, errorsmod.Wrapf(types.ErrValidatorJailed, "validator is jailed until %s", signingInfo.JailedUntil))
 	}
 
-	return k.sk.Unjail(ctx, consAddr)
+	return info, blockers, nil
 }
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestJailInfo() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	addrStr, err := ac.BytesToString(addr)
+	require.NoError(err)
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(addr)
+	require.NoError(err)
+
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Tokens = sdkmath.NewInt(100)
+	val.DelegatorShares = sdkmath.LegacyNewDec(100)
+	val.MinSelfDelegation = sdkmath.NewInt(60)
+	val.Jailed = true
+	del := types.NewDelegation(addrStr, valStr, sdkmath.LegacyNewDec(50))
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+	jailedUntil := ctx.HeaderInfo().Time.Add(time.Hour)
+	info := slashingtypes.NewValidatorSigningInfo(consStr, int64(4), jailedUntil, false, int64(10))
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, sdk.ConsAddress(addr), info))
+
+	// all the reasons preventing the validator from being unjailed are reported
+	jailInfo, err := keeper.GetJailInfo(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(valStr, jailInfo.ValidatorAddress)
+	require.True(jailInfo.Jailed)
+	require.False(jailInfo.Tombstoned)
+	require.Equal(jailedUntil, jailInfo.JailedUntil)
+	require.Equal(int64(10), jailInfo.MissedBlocksCounter)
+	require.Equal(sdkmath.NewInt(50), jailInfo.SelfDelegation)
+	require.Equal(sdkmath.NewInt(60), jailInfo.MinSelfDelegation)
+	require.Len(jailInfo.UnjailBlockers, 2)
+	require.Contains(jailInfo.UnjailBlockers[0], slashingtypes.ErrSelfDelegationTooLowToUnjail.Error())
+	require.Contains(jailInfo.UnjailBlockers[1], "jailed until")
+
+	// MsgUnjail fails with the first of them
+	err = keeper.Unjail(ctx, valAddr)
+	require.ErrorIs(err, slashingtypes.ErrSelfDelegationTooLowToUnjail)
+
+	info.Tombstoned = true
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, sdk.ConsAddress(addr), info))
+
+	res, err := s.queryClient.JailInfo(gocontext.Background(), &slashingtypes.QueryJailInfoRequest{ValidatorAddress: valStr})
+	require.NoError(err)
+	require.True(res.JailInfo.Tombstoned)
+	require.Len(res.JailInfo.UnjailBlockers, 2)
+	require.Contains(res.JailInfo.UnjailBlockers[1], "tombstoned")
+
+	_, err = s.queryClient.JailInfo(gocontext.Background(), &slashingtypes.QueryJailInfoRequest{})
+	require.ErrorContains(err, "validator address cannot be empty")
+}
@@ -14,6 +14,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/slashing/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUnjail{}, "cosmos-sdk/MsgUnjail")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/slashing/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMaintenanceWindow{}, "cosmos-sdk/MsgScheduleMaintenance")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelMaintenanceWindow{}, "cosmos-sdk/MsgCancelMaintenance")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -21,6 +23,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnjail{},
 		&MsgUpdateParams{},
+		&MsgScheduleMaintenanceWindow{},
+		&MsgCancelMaintenanceWindow{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
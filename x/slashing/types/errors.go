@@ -14,4 +14,9 @@ var (
 	ErrValidatorTombstoned          = errors.Register(ModuleName, 9, "validator already tombstoned")
 	ErrInvalidSigner                = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
 	ErrInvalidConsPubKey            = errors.Register(ModuleName, 11, "invalid consensus pubkey")
+	ErrMaintenanceDisabled          = errors.Register(ModuleName, 12, "maintenance windows are disabled")
+	ErrInvalidMaintenanceWindow     = errors.Register(ModuleName, 13, "invalid maintenance window")
+	ErrMaintenanceBudgetExceeded    = errors.Register(ModuleName, 14, "maintenance blocks exceed the budget of the maintenance period")
+	ErrMaintenanceWindowNotFound    = errors.Register(ModuleName, 15, "maintenance window not found")
+	ErrMaintenancePowerExceeded     = errors.Register(ModuleName, 16, "maintenance windows exceed the max maintenance power")
 )
@@ -2,8 +2,12 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash    = "slash"
-	EventTypeLiveness = "liveness"
+	EventTypeSlash            = "slash"
+	EventTypeLiveness         = "liveness"
+	EventTypeSlashDestination = "slash_destination"
+
+	EventTypeScheduleMaintenanceWindow = "schedule_maintenance_window"
+	EventTypeCancelMaintenanceWindow   = "cancel_maintenance_window"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -12,6 +16,11 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyDestination  = "destination"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyStartHeight  = "start_height"
+	AttributeKeyEndHeight    = "end_height"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
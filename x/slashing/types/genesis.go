@@ -62,5 +62,11 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	for _, w := range data.MaintenanceWindows {
+		if w.StartHeight <= 0 || w.EndHeight <= w.StartHeight {
+			return fmt.Errorf("invalid maintenance window of %s: [%d, %d)", w.ValidatorAddress, w.StartHeight, w.EndHeight)
+		}
+	}
+
 	return nil
 }
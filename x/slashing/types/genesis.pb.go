@@ -35,6 +35,10 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// maintenance_windows are the maintenance windows scheduled by validators.
+	//
+	// Since: cosmos-sdk 0.52
+	MaintenanceWindows []MaintenanceWindow `protobuf:"bytes,4,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +95,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetMaintenanceWindows() []MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindows
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -269,34 +280,37 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0xef, 0xd2, 0x40,
-	0x18, 0xc6, 0x7b, 0x10, 0xd1, 0xff, 0x15, 0x06, 0x2f, 0x88, 0x95, 0xc4, 0x82, 0x44, 0x0d, 0x31,
-	0xa1, 0x0d, 0x38, 0x38, 0x30, 0x59, 0x07, 0xe3, 0x60, 0x62, 0x0a, 0x71, 0x70, 0xb0, 0x39, 0xe8,
-	0x51, 0x2f, 0xd0, 0x3b, 0xd2, 0xb7, 0x12, 0xfc, 0x06, 0x8e, 0x7e, 0x0c, 0xe3, 0xe4, 0xe0, 0xe4,
-	0x27, 0x60, 0x24, 0x4e, 0x4e, 0xc6, 0xc0, 0xe0, 0xd7, 0x30, 0xde, 0x15, 0xa9, 0x86, 0x86, 0xe1,
-	0xbf, 0x34, 0xbd, 0xbb, 0xdf, 0xf3, 0xbc, 0xef, 0xfb, 0xb4, 0x87, 0xef, 0x4d, 0x25, 0xc4, 0x12,
-	0x5c, 0x58, 0x50, 0x78, 0xc3, 0x45, 0xe4, 0xae, 0xfa, 0x13, 0x96, 0xd2, 0xbe, 0x1b, 0x31, 0xc1,
-	0x80, 0x83, 0xb3, 0x4c, 0x64, 0x2a, 0xc9, 0x4d, 0x8d, 0x39, 0x07, 0xcc, 0xc9, 0xb0, 0x66, 0x3d,
-	0x92, 0x91, 0x54, 0x8c, 0xfb, 0xe7, 0x4d, 0xe3, 0xcd, 0xfb, 0x45, 0xae, 0x7f, 0xf5, 0x9a, 0xbb,
-	0xa5, 0xb9, 0x40, 0x1b, 0x64, 0x35, 0xf4, 0xd1, 0x75, 0x1a, 0x73, 0x21, 0x5d, 0xf5, 0xd4, 0x5b,
-	0x9d, 0xf7, 0x25, 0x5c, 0x7d, 0xaa, 0xdb, 0x1a, 0xa5, 0x34, 0x65, 0xc4, 0xc3, 0x95, 0x25, 0x4d,
-	0x68, 0x0c, 0x16, 0x6a, 0xa3, 0xae, 0x39, 0x68, 0x39, 0x05, 0x6d, 0x3a, 0x2f, 0x14, 0xe6, 0x5d,
-	0x6c, 0x7e, 0xb4, 0x8c, 0x8f, 0xbf, 0x3e, 0x3f, 0x40, 0x7e, 0xa6, 0x24, 0x63, 0x5c, 0x03, 0x1e,
-	0x09, 0x2e, 0xa2, 0x80, 0x8b, 0x99, 0x04, 0xab, 0xd4, 0x2e, 0x77, 0xcd, 0xc1, 0xdd, 0x42, 0xab,
-	0x91, 0xa6, 0x9f, 0x89, 0x99, 0xcc, 0xfb, 0x55, 0xe1, 0xb8, 0x0f, 0xe4, 0x35, 0xae, 0xc5, 0x1c,
-	0x80, 0x85, 0xc1, 0x64, 0x21, 0xa7, 0x73, 0xb0, 0xca, 0xca, 0xd5, 0x29, 0x74, 0x7d, 0x49, 0x17,
-	0x3c, 0xa4, 0xa9, 0x4c, 0x9e, 0x2b, 0x99, 0xa7, 0x54, 0xff, 0xf8, 0xc7, 0xb9, 0x83, 0xce, 0x57,
-	0x84, 0xcd, 0x5c, 0x23, 0x64, 0x88, 0xaf, 0xd2, 0x30, 0x4c, 0x18, 0xe8, 0x28, 0x2e, 0xbc, 0x3b,
-	0xdf, 0xbe, 0xf4, 0x6e, 0x67, 0xc5, 0x9e, 0x48, 0x01, 0x4c, 0xc0, 0x5b, 0x78, 0xac, 0x91, 0x51,
-	0x9a, 0x70, 0x11, 0xf9, 0x07, 0x05, 0x11, 0xb8, 0xb1, 0x3a, 0x94, 0x0f, 0xf2, 0x61, 0x58, 0x25,
-	0x15, 0x6b, 0xef, 0x7c, 0xd7, 0x05, 0xa1, 0xd4, 0x57, 0x27, 0x80, 0xce, 0x27, 0x84, 0x6f, 0x9c,
-	0x9c, 0xf7, 0x72, 0x63, 0x8c, 0xff, 0xcf, 0xfc, 0xdc, 0x97, 0xcc, 0x95, 0x2e, 0x4e, 0x7a, 0x88,
-	0xcd, 0x1c, 0x47, 0xea, 0xf8, 0x0a, 0x17, 0x21, 0x5b, 0xab, 0xfe, 0xca, 0xbe, 0x5e, 0x90, 0x06,
-	0xae, 0x68, 0x91, 0x4a, 0xec, 0x9a, 0x9f, 0xad, 0xbc, 0x47, 0x9b, 0x9d, 0x8d, 0xb6, 0x3b, 0x1b,
-	0xfd, 0xdc, 0xd9, 0xe8, 0xc3, 0xde, 0x36, 0xb6, 0x7b, 0xdb, 0xf8, 0xbe, 0xb7, 0x8d, 0x57, 0xd9,
-	0x50, 0x10, 0xce, 0x1d, 0x2e, 0xdd, 0xf5, 0xf1, 0xa6, 0xa4, 0xef, 0x96, 0x0c, 0x26, 0x15, 0xf5,
-	0xc7, 0x3f, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xca, 0xae, 0x5a, 0x9f, 0x03, 0x00, 0x00,
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x09, 0x04, 0x7a, 0x6e, 0x07, 0x8e, 0x50, 0x4c, 0x25, 0xdc, 0x62, 0x01, 0xaa,
+	0x2a, 0xd5, 0x56, 0xcb, 0xc0, 0xd0, 0x09, 0x33, 0x20, 0x86, 0x4a, 0xc8, 0xa9, 0x40, 0x62, 0xc0,
+	0xba, 0xc4, 0x17, 0x73, 0x6a, 0xfc, 0x2e, 0xf2, 0x3b, 0xd2, 0xf2, 0x2d, 0xf8, 0x18, 0x88, 0x89,
+	0x81, 0x89, 0x4f, 0xd0, 0xb1, 0x62, 0x62, 0x42, 0x28, 0x19, 0x58, 0xf9, 0x08, 0x88, 0x3b, 0x87,
+	0x98, 0xaa, 0x56, 0x87, 0x2e, 0x51, 0xee, 0xee, 0xf7, 0xff, 0xbf, 0x7b, 0xff, 0xe7, 0xa3, 0x0f,
+	0x06, 0x0a, 0x0b, 0x85, 0x11, 0x8e, 0x38, 0xbe, 0x95, 0x90, 0x47, 0x93, 0x9d, 0xbe, 0xd0, 0x7c,
+	0x27, 0xca, 0x05, 0x08, 0x94, 0x18, 0x8e, 0x4b, 0xa5, 0x15, 0xbb, 0x6d, 0xb1, 0x70, 0x8e, 0x85,
+	0x15, 0xb6, 0xd6, 0xcd, 0x55, 0xae, 0x0c, 0x13, 0xfd, 0xfd, 0x67, 0xf1, 0xb5, 0x87, 0x4d, 0xae,
+	0xff, 0xf4, 0x96, 0xbb, 0x63, 0xb9, 0xd4, 0x1a, 0x54, 0x35, 0xec, 0xd1, 0x0d, 0x5e, 0x48, 0x50,
+	0x91, 0xf9, 0xb5, 0x5b, 0xc1, 0xef, 0x16, 0x5d, 0x7e, 0x66, 0xaf, 0xd5, 0xd3, 0x5c, 0x0b, 0x16,
+	0xd3, 0xce, 0x98, 0x97, 0xbc, 0x40, 0x8f, 0x6c, 0x90, 0x4d, 0x77, 0x77, 0x3d, 0x6c, 0xb8, 0x66,
+	0xf8, 0xc2, 0x60, 0xf1, 0xd2, 0xc9, 0x8f, 0x75, 0xe7, 0xe3, 0xaf, 0xcf, 0x5b, 0x24, 0xa9, 0x94,
+	0xec, 0x80, 0xae, 0xa0, 0xcc, 0x41, 0x42, 0x9e, 0x4a, 0x18, 0x2a, 0xf4, 0x5a, 0x1b, 0xed, 0x4d,
+	0x77, 0xf7, 0x7e, 0xa3, 0x55, 0xcf, 0xd2, 0xcf, 0x61, 0xa8, 0xea, 0x7e, 0xcb, 0xb8, 0xd8, 0x47,
+	0xf6, 0x86, 0xae, 0x14, 0x12, 0x51, 0x64, 0x69, 0x7f, 0xa4, 0x06, 0x87, 0xe8, 0xb5, 0x8d, 0x6b,
+	0xd8, 0xe8, 0xfa, 0x92, 0x8f, 0x64, 0xc6, 0xb5, 0x2a, 0xf7, 0x8d, 0x2c, 0x36, 0xaa, 0xff, 0xfc,
+	0x8b, 0xda, 0x01, 0x1b, 0xd2, 0x9b, 0x05, 0x97, 0xa0, 0x05, 0x70, 0x18, 0x88, 0xf4, 0x48, 0x42,
+	0xa6, 0x8e, 0xd0, 0xbb, 0x62, 0xaa, 0x6c, 0x35, 0x56, 0xd9, 0x5f, 0x68, 0x5e, 0x19, 0x49, 0xbd,
+	0x02, 0x2b, 0xce, 0x9e, 0x62, 0xf0, 0x95, 0x50, 0xb7, 0xd6, 0x30, 0xdb, 0xa3, 0xd7, 0x78, 0x96,
+	0x95, 0x02, 0x6d, 0xe4, 0x4b, 0xf1, 0xbd, 0x6f, 0x5f, 0xb6, 0xef, 0x56, 0xe5, 0x9e, 0x2a, 0x40,
+	0x01, 0xf8, 0x0e, 0x9f, 0x58, 0xa4, 0xa7, 0x4b, 0x09, 0x79, 0x32, 0x57, 0x30, 0xa0, 0xab, 0x93,
+	0x79, 0x9b, 0x69, 0x3d, 0x74, 0xaf, 0x65, 0xc6, 0xb7, 0x7d, 0x71, 0x3a, 0x0d, 0xe1, 0x77, 0x27,
+	0xe7, 0x00, 0xc1, 0x27, 0x42, 0x6f, 0x9d, 0x9b, 0xeb, 0xe5, 0xda, 0x38, 0x38, 0x3b, 0xdb, 0x8b,
+	0xbe, 0x98, 0x5a, 0xe9, 0xc6, 0x89, 0x06, 0x7b, 0xd4, 0xad, 0x71, 0xac, 0x4b, 0xaf, 0x4a, 0xc8,
+	0xc4, 0xb1, 0xb9, 0x5f, 0x3b, 0xb1, 0x0b, 0xb6, 0x4a, 0x3b, 0x56, 0x64, 0x12, 0xbb, 0x9e, 0x54,
+	0xab, 0xf8, 0xf1, 0xc9, 0xd4, 0x27, 0xa7, 0x53, 0x9f, 0xfc, 0x9c, 0xfa, 0xe4, 0xc3, 0xcc, 0x77,
+	0x4e, 0x67, 0xbe, 0xf3, 0x7d, 0xe6, 0x3b, 0xaf, 0xab, 0xa6, 0x30, 0x3b, 0x0c, 0xa5, 0x8a, 0x8e,
+	0x17, 0x2f, 0x52, 0xbf, 0x1f, 0x0b, 0xec, 0x77, 0xcc, 0xcb, 0x7a, 0xf4, 0x67, 0x00, 0xb7, 0xb4,
+	0x95, 0x1d, 0x07, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -319,6 +333,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaintenanceWindows) > 0 {
+		for iNdEx := len(m.MaintenanceWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaintenanceWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -513,6 +541,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, e := range m.MaintenanceWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -701,6 +735,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceWindows = append(m.MaintenanceWindows, MaintenanceWindow{})
+			if err := m.MaintenanceWindows[len(m.MaintenanceWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -40,6 +40,10 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// ProtocolPoolModuleName duplicates the protocolpool module's name to avoid a cyclic dependency with x/protocolpool.
+	// It is the module account receiving slashed tokens routed to the community pool.
+	ProtocolPoolModuleName = "protocolpool"
 )
 
 // Keys for slashing store
@@ -50,12 +54,15 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<valAddrLen (1 Byte)><valAddr_Bytes><start_height>: end_height
 
 var (
 	ParamsKey                           = collections.NewPrefix(0) // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1) // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2) // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3) // Prefix for address-pubkey relation
+	MaintenanceWindowKeyPrefix          = collections.NewPrefix(4) // Prefix for maintenance windows
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
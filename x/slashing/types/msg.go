@@ -8,6 +8,8 @@ import (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgScheduleMaintenanceWindow{}
+	_ sdk.Msg = &MsgCancelMaintenanceWindow{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -16,3 +18,20 @@ func NewMsgUnjail(validatorAddr string) *MsgUnjail {
 		ValidatorAddr: validatorAddr,
 	}
 }
+
+// NewMsgScheduleMaintenanceWindow creates a new MsgScheduleMaintenanceWindow instance
+func NewMsgScheduleMaintenanceWindow(validatorAddr string, startHeight, endHeight int64) *MsgScheduleMaintenanceWindow {
+	return &MsgScheduleMaintenanceWindow{
+		ValidatorAddr: validatorAddr,
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+	}
+}
+
+// NewMsgCancelMaintenanceWindow creates a new MsgCancelMaintenanceWindow instance
+func NewMsgCancelMaintenanceWindow(validatorAddr string, startHeight int64) *MsgCancelMaintenanceWindow {
+	return &MsgCancelMaintenanceWindow{
+		ValidatorAddr: validatorAddr,
+		StartHeight:   startHeight,
+	}
+}
@@ -11,12 +11,17 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	// DefaultMaintenancePeriod is roughly a month of 6 seconds blocks.
+	DefaultMaintenancePeriod = int64(432000)
+	// DefaultMinMaintenanceNotice is roughly a day of 6 seconds blocks.
+	DefaultMinMaintenanceNotice = int64(14400)
 )
 
 var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+	DefaultMaxMaintenancePower     = math.LegacyNewDecWithPrec(1, 1)
 )
 
 // NewParams creates a new Params object
@@ -30,18 +35,24 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		MaxMaintenancePower:     DefaultMaxMaintenancePower,
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultSignedBlocksWindow,
 		DefaultMinSignedPerWindow,
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
 	)
+	// maintenance windows are disabled until governance allows some
+	params.MaintenancePeriod = DefaultMaintenancePeriod
+	params.MinMaintenanceNotice = DefaultMinMaintenanceNotice
+
+	return params
 }
 
 // Validate validates the params
@@ -61,6 +72,15 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateSlashDestinations(p.SlashDestinations); err != nil {
+		return err
+	}
+	if err := validateMaintenance(p.MaxMaintenanceBlocks, p.MaintenancePeriod, p.MinMaintenanceNotice); err != nil {
+		return err
+	}
+	if err := validateMaxMaintenancePower(p.MaxMaintenancePower); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +167,81 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateSlashDestinations(i interface{}) error {
+	v, ok := i.([]SlashDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	total := math.LegacyZeroDec()
+	for _, d := range v {
+		switch d.Type {
+		case SlashDestinationTypeBurn, SlashDestinationTypeCommunityPool:
+			if d.Address != "" {
+				return fmt.Errorf("slash destination %s cannot have an address", d.Type)
+			}
+		case SlashDestinationTypeAddress:
+			if d.Address == "" {
+				return fmt.Errorf("slash destination %s must have an address", d.Type)
+			}
+		default:
+			return fmt.Errorf("invalid slash destination type: %s", d.Type)
+		}
+
+		if d.Share.IsNil() {
+			return fmt.Errorf("slash destination share cannot be nil: %s", d.Share)
+		}
+		if !d.Share.IsPositive() {
+			return fmt.Errorf("slash destination share must be positive: %s", d.Share)
+		}
+
+		total = total.Add(d.Share)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash destination shares add up to more than one: %s", total)
+	}
+
+	return nil
+}
+
+func validateMaintenance(maxBlocks, period, minNotice int64) error {
+	if maxBlocks < 0 {
+		return fmt.Errorf("max maintenance blocks cannot be negative: %d", maxBlocks)
+	}
+	if period < 0 {
+		return fmt.Errorf("maintenance period cannot be negative: %d", period)
+	}
+	if minNotice < 0 {
+		return fmt.Errorf("min maintenance notice cannot be negative: %d", minNotice)
+	}
+	if maxBlocks > 0 && maxBlocks > period {
+		return fmt.Errorf("max maintenance blocks %d exceeds the maintenance period %d", maxBlocks, period)
+	}
+
+	return nil
+}
+
+func validateMaxMaintenancePower(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max maintenance power cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max maintenance power cannot be negative: %s", v)
+	}
+	// the chain halts when a third of the voting power is offline
+	if v.MulInt64(3).GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("max maintenance power must be less than a third: %s", v)
+	}
+
+	return nil
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
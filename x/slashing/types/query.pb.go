@@ -309,6 +309,217 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryMaintenanceWindowsRequest is the request type for the
+// Query/MaintenanceWindows RPC method
+//
+// Since: cosmos-sdk 0.52
+type QueryMaintenanceWindowsRequest struct {
+	// validator_address is the operator address of the validator to query the
+	// maintenance windows of. All validators are queried when empty.
+	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMaintenanceWindowsRequest) Reset()         { *m = QueryMaintenanceWindowsRequest{} }
+func (m *QueryMaintenanceWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceWindowsRequest) ProtoMessage()    {}
+func (*QueryMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceWindowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceWindowsRequest.Merge(m, src)
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceWindowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaintenanceWindowsRequest proto.InternalMessageInfo
+
+func (m *QueryMaintenanceWindowsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryMaintenanceWindowsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMaintenanceWindowsResponse is the response type for the
+// Query/MaintenanceWindows RPC method
+//
+// Since: cosmos-sdk 0.52
+type QueryMaintenanceWindowsResponse struct {
+	// maintenance_windows are the maintenance windows which have not ended yet,
+	// ordered by validator and start height.
+	MaintenanceWindows []MaintenanceWindow `protobuf:"bytes,1,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMaintenanceWindowsResponse) Reset()         { *m = QueryMaintenanceWindowsResponse{} }
+func (m *QueryMaintenanceWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceWindowsResponse) ProtoMessage()    {}
+func (*QueryMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceWindowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceWindowsResponse.Merge(m, src)
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaintenanceWindowsResponse proto.InternalMessageInfo
+
+func (m *QueryMaintenanceWindowsResponse) GetMaintenanceWindows() []MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindows
+	}
+	return nil
+}
+
+func (m *QueryMaintenanceWindowsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryJailInfoRequest is the request type for the Query/JailInfo RPC method
+//
+// Since: cosmos-sdk 0.52
+type QueryJailInfoRequest struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryJailInfoRequest) Reset()         { *m = QueryJailInfoRequest{} }
+func (m *QueryJailInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryJailInfoRequest) ProtoMessage()    {}
+func (*QueryJailInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{8}
+}
+func (m *QueryJailInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryJailInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailInfoRequest.Merge(m, src)
+}
+func (m *QueryJailInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJailInfoRequest proto.InternalMessageInfo
+
+func (m *QueryJailInfoRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryJailInfoResponse is the response type for the Query/JailInfo RPC method
+//
+// Since: cosmos-sdk 0.52
+type QueryJailInfoResponse struct {
+	JailInfo JailInfo `protobuf:"bytes,1,opt,name=jail_info,json=jailInfo,proto3" json:"jail_info"`
+}
+
+func (m *QueryJailInfoResponse) Reset()         { *m = QueryJailInfoResponse{} }
+func (m *QueryJailInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryJailInfoResponse) ProtoMessage()    {}
+func (*QueryJailInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{9}
+}
+func (m *QueryJailInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryJailInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailInfoResponse.Merge(m, src)
+}
+func (m *QueryJailInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJailInfoResponse proto.InternalMessageInfo
+
+func (m *QueryJailInfoResponse) GetJailInfo() JailInfo {
+	if m != nil {
+		return m.JailInfo
+	}
+	return JailInfo{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +527,10 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryMaintenanceWindowsRequest)(nil), "cosmos.slashing.v1beta1.QueryMaintenanceWindowsRequest")
+	proto.RegisterType((*QueryMaintenanceWindowsResponse)(nil), "cosmos.slashing.v1beta1.QueryMaintenanceWindowsResponse")
+	proto.RegisterType((*QueryJailInfoRequest)(nil), "cosmos.slashing.v1beta1.QueryJailInfoRequest")
+	proto.RegisterType((*QueryJailInfoResponse)(nil), "cosmos.slashing.v1beta1.QueryJailInfoResponse")
 }
 
 func init() {
@@ -323,43 +538,54 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x53, 0x10, 0xf4, 0x12, 0x66,
-	0x9b, 0xe9, 0x38, 0xb8, 0x99, 0xd9, 0xee, 0x6c, 0x82, 0x45, 0xf4, 0xe0, 0xd9, 0x83, 0xe0, 0x67,
-	0x10, 0x3c, 0xaa, 0xf8, 0x21, 0x7a, 0x2c, 0x7a, 0xf1, 0x24, 0x92, 0x08, 0xde, 0xfd, 0x04, 0xb2,
-	0x33, 0x93, 0x64, 0x4a, 0x5c, 0x4d, 0xe9, 0x25, 0x0c, 0x6f, 0xde, 0xff, 0xfd, 0x7f, 0xef, 0xe5,
-	0xcd, 0xc2, 0x8b, 0xdb, 0x52, 0x75, 0xa4, 0xc2, 0x2a, 0x22, 0xea, 0x09, 0x17, 0x0c, 0xf7, 0x6a,
-	0x21, 0x4d, 0x49, 0x0d, 0xef, 0x76, 0x69, 0xb2, 0x17, 0xc4, 0x89, 0x4c, 0x25, 0x5a, 0x34, 0x49,
-	0xc1, 0x30, 0x29, 0xb0, 0x49, 0xe5, 0x75, 0xab, 0x0e, 0x89, 0xa2, 0x46, 0x31, 0xd2, 0xc7, 0x84,
-	0x71, 0x41, 0x52, 0x2e, 0x85, 0x29, 0x52, 0x5e, 0x60, 0x92, 0x49, 0x7d, 0xc4, 0xd9, 0xc9, 0x46,
-	0xcf, 0x31, 0x29, 0x59, 0x44, 0x31, 0x89, 0x39, 0x26, 0x42, 0xc8, 0x54, 0x4b, 0x94, 0xbd, 0xad,
-	0xe6, 0xd1, 0x8d, 0x48, 0x4c, 0xde, 0xb2, 0xc9, 0x6b, 0x99, 0xf2, 0x96, 0xd6, 0x5c, 0x9d, 0x21,
-	0x1d, 0x2e, 0x24, 0xd6, 0xbf, 0x26, 0xe4, 0x2f, 0x40, 0xf4, 0x20, 0x63, 0xbd, 0x4f, 0x12, 0xd2,
-	0x51, 0x4d, 0xba, 0xdb, 0xa5, 0x2a, 0xf5, 0x1f, 0xc1, 0xb3, 0x87, 0xa2, 0x2a, 0x96, 0x42, 0x51,
-	0xd4, 0x80, 0xc5, 0x58, 0x47, 0x96, 0xc0, 0x05, 0xb0, 0x56, 0xaa, 0x57, 0x82, 0x9c, 0x61, 0x04,
-	0x46, 0xd8, 0x98, 0xdb, 0xff, 0x5e, 0x29, 0xbc, 0xff, 0xf5, 0x61, 0x1d, 0x34, 0xad, 0xd2, 0x6f,
-	0xc1, 0x45, 0x5d, 0x7a, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa4, 0x75, 0x45, 0xb7, 0xe0,
-	0xfc, 0xb6, 0x14, 0xaa, 0x45, 0xda, 0xed, 0x84, 0x2a, 0x63, 0x32, 0xd7, 0x58, 0xf9, 0xf2, 0x79,
-	0xe3, 0xbc, 0xf5, 0xb9, 0x99, 0x61, 0x08, 0xd5, 0x55, 0x37, 0x4c, 0xca, 0x56, 0x9a, 0x70, 0xc1,
-	0x9a, 0xa5, 0x4c, 0x66, 0x43, 0xfe, 0x4b, 0xb8, 0x34, 0x69, 0x60, 0x1b, 0x08, 0xe1, 0xe9, 0x1e,
-	0x89, 0x5a, 0xca, 0x5c, 0xb5, 0xb8, 0xd8, 0x91, 0xb6, 0x95, 0x8d, 0xdc, 0x56, 0x1e, 0x92, 0x88,
-	0xb7, 0x49, 0x2a, 0x13, 0xa7, 0xa0, 0xdb, 0xd8, 0xa9, 0x1e, 0x89, 0x9c, 0x2b, 0x3f, 0x9c, 0xf4,
-	0x1f, 0xce, 0x15, 0xdd, 0x86, 0x70, 0xbc, 0x0b, 0xd6, 0xb9, 0x3a, 0x74, 0xce, 0x16, 0x27, 0x30,
-	0xab, 0x36, 0x1e, 0x23, 0xa3, 0x56, 0xdb, 0x74, 0x94, 0xfe, 0x27, 0x00, 0x97, 0xff, 0x62, 0x62,
-	0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0x66, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee, 0x1c, 0x62, 0x9e,
-	0xd1, 0xcc, 0xab, 0xff, 0x65, 0x36, 0x28, 0x2e, 0x74, 0xfd, 0xf7, 0x2c, 0x3c, 0xa9, 0xa1, 0xd1,
-	0x6b, 0x00, 0x8b, 0x66, 0x43, 0xd0, 0xa5, 0x5c, 0xba, 0xc9, 0xb5, 0x2c, 0x5f, 0x9e, 0x2e, 0xd9,
-	0x78, 0xfb, 0xab, 0xaf, 0xbe, 0xfe, 0x7c, 0x3b, 0xb3, 0x82, 0x2a, 0x38, 0xef, 0xe5, 0x98, 0x95,
-	0x44, 0x1f, 0x01, 0x2c, 0x39, 0x23, 0x40, 0x57, 0xfe, 0x6d, 0x33, 0xb9, 0xb9, 0xe5, 0xda, 0x11,
-	0x14, 0x96, 0xee, 0xba, 0xa6, 0xdb, 0x44, 0xd7, 0x72, 0xe9, 0xdc, 0x2d, 0x55, 0xf8, 0xb9, 0xfb,
-	0x34, 0x5e, 0xa0, 0x77, 0x00, 0xce, 0xbb, 0x7f, 0x3e, 0x9a, 0x1e, 0x61, 0x34, 0xce, 0xfa, 0x51,
-	0x24, 0x16, 0x3b, 0xd0, 0xd8, 0x6b, 0xa8, 0x3a, 0x1d, 0x76, 0x63, 0x73, 0xbf, 0xef, 0x81, 0x83,
-	0xbe, 0x07, 0x7e, 0xf4, 0x3d, 0xf0, 0x66, 0xe0, 0x15, 0x0e, 0x06, 0x5e, 0xe1, 0xdb, 0xc0, 0x2b,
-	0x3c, 0xb6, 0x6f, 0x5a, 0xb5, 0x9f, 0x06, 0x5c, 0xe2, 0x67, 0xe3, 0x42, 0xe9, 0x5e, 0x4c, 0x55,
-	0x58, 0xd4, 0xdf, 0xa7, 0xab, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x73, 0x05, 0x05, 0x95,
-	0x05, 0x00, 0x00,
+	// 749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xfc, 0x7e, 0x36, 0xf0, 0x40, 0x0c, 0x0c, 0x18, 0xa0, 0xd1, 0xad, 0xac, 0x09,
+	0x10, 0x84, 0x5d, 0xc1, 0x3f, 0x70, 0xd1, 0xc4, 0x6a, 0x30, 0x18, 0x35, 0x5a, 0x12, 0x8d, 0x5e,
+	0x9a, 0x29, 0xdd, 0xae, 0x83, 0xdb, 0x99, 0xd2, 0x59, 0x8a, 0x84, 0xe0, 0xc1, 0xb3, 0x07, 0x13,
+	0x5f, 0x83, 0x89, 0xde, 0xd4, 0x78, 0xf0, 0xe2, 0x9d, 0x23, 0xc1, 0x8b, 0x27, 0x62, 0xc0, 0xc4,
+	0xb7, 0x61, 0x3a, 0x33, 0xdd, 0x6e, 0x59, 0xb6, 0x16, 0xe5, 0xd2, 0x6c, 0x9e, 0x79, 0x9e, 0xe7,
+	0xfb, 0x79, 0x9e, 0x99, 0xe7, 0x49, 0xe1, 0xdc, 0x12, 0x17, 0x25, 0x2e, 0x6c, 0xe1, 0x11, 0xf1,
+	0x94, 0x32, 0xd7, 0xae, 0x4e, 0xe7, 0x1d, 0x9f, 0x4c, 0xdb, 0x2b, 0xab, 0x4e, 0x65, 0xdd, 0x2a,
+	0x57, 0xb8, 0xcf, 0xf1, 0xa0, 0x72, 0xb2, 0xea, 0x4e, 0x96, 0x76, 0x4a, 0x4d, 0xe8, 0xe8, 0x3c,
+	0x11, 0x8e, 0x8a, 0x08, 0xe2, 0xcb, 0xc4, 0xa5, 0x8c, 0xf8, 0x94, 0x33, 0x95, 0x24, 0x35, 0xe0,
+	0x72, 0x97, 0xcb, 0x4f, 0xbb, 0xf6, 0xa5, 0xad, 0xa7, 0x5d, 0xce, 0x5d, 0xcf, 0xb1, 0x49, 0x99,
+	0xda, 0x84, 0x31, 0xee, 0xcb, 0x10, 0xa1, 0x4f, 0x47, 0xe3, 0xe8, 0x02, 0x12, 0xe5, 0x37, 0xac,
+	0xfc, 0x72, 0x2a, 0xbd, 0xa6, 0x55, 0x47, 0x7d, 0xa4, 0x44, 0x19, 0xb7, 0xe5, 0xaf, 0x32, 0x99,
+	0x03, 0x80, 0x1f, 0xd4, 0x58, 0xef, 0x93, 0x0a, 0x29, 0x89, 0xac, 0xb3, 0xb2, 0xea, 0x08, 0xdf,
+	0x7c, 0x0c, 0xfd, 0x4d, 0x56, 0x51, 0xe6, 0x4c, 0x38, 0x38, 0x03, 0xc9, 0xb2, 0xb4, 0x0c, 0xa1,
+	0xb3, 0x68, 0xbc, 0x7b, 0x26, 0x6d, 0xc5, 0x34, 0xc3, 0x52, 0x81, 0x99, 0xae, 0xad, 0xdd, 0x74,
+	0xe2, 0xdd, 0xaf, 0x0f, 0x13, 0x28, 0xab, 0x23, 0xcd, 0x1c, 0x0c, 0xca, 0xd4, 0x8b, 0xd4, 0x65,
+	0x94, 0xb9, 0x0b, 0xac, 0xc8, 0xb5, 0x2a, 0xbe, 0x09, 0x3d, 0x4b, 0x9c, 0x89, 0x1c, 0x29, 0x14,
+	0x2a, 0x8e, 0x50, 0x22, 0x5d, 0x99, 0x91, 0x9d, 0xcf, 0x53, 0x67, 0xb4, 0xce, 0x8d, 0x1a, 0x06,
+	0x13, 0xab, 0xe2, 0xba, 0x72, 0x59, 0xf4, 0x2b, 0x94, 0xb9, 0xd9, 0xee, 0x5a, 0x98, 0x36, 0x99,
+	0x2f, 0x60, 0x28, 0x2a, 0xa0, 0x0b, 0xc8, 0x43, 0x6f, 0x95, 0x78, 0x39, 0xa1, 0x8e, 0x72, 0x94,
+	0x15, 0xb9, 0x2e, 0x65, 0x2a, 0xb6, 0x94, 0x87, 0xc4, 0xa3, 0x05, 0xe2, 0xf3, 0x4a, 0x28, 0x61,
+	0xb8, 0xb0, 0x93, 0x55, 0xe2, 0x85, 0x8e, 0xcc, 0x7c, 0x54, 0xbf, 0xde, 0x57, 0x3c, 0x0f, 0xd0,
+	0x78, 0x0b, 0x5a, 0x79, 0xb4, 0xae, 0x5c, 0x7b, 0x38, 0x96, 0x7a, 0x6a, 0x8d, 0x36, 0xba, 0x8e,
+	0x8e, 0xcd, 0x86, 0x22, 0xcd, 0x4f, 0x08, 0x86, 0x0f, 0x11, 0xd1, 0x55, 0xde, 0x81, 0xff, 0x75,
+	0x65, 0xff, 0xfd, 0x53, 0x65, 0x32, 0x0b, 0xbe, 0xd5, 0xc4, 0xdc, 0x21, 0x99, 0xc7, 0xfe, 0xc8,
+	0xac, 0x50, 0x9a, 0xa0, 0xbf, 0x20, 0x30, 0x24, 0xf4, 0x5d, 0x42, 0x99, 0xef, 0x30, 0xc2, 0x96,
+	0x9c, 0x47, 0x94, 0x15, 0xf8, 0x5a, 0xd0, 0x9f, 0x7b, 0xd0, 0x57, 0xad, 0x43, 0xb5, 0x78, 0x06,
+	0x01, 0x78, 0xf3, 0x33, 0xe8, 0xad, 0x1e, 0xb0, 0xe3, 0xf9, 0x43, 0xd8, 0xff, 0xa6, 0xdf, 0x3b,
+	0x08, 0xd2, 0xb1, 0xe8, 0xba, 0xeb, 0x45, 0xe8, 0x2f, 0x35, 0x4e, 0x73, 0x6b, 0xea, 0x58, 0x5f,
+	0xc2, 0x44, 0xec, 0x25, 0x44, 0x32, 0x86, 0x6f, 0x00, 0x97, 0x22, 0x7a, 0xc7, 0x77, 0x1f, 0x45,
+	0x18, 0x90, 0x35, 0xdd, 0x26, 0xd4, 0x0b, 0x8f, 0xe1, 0x31, 0x5f, 0x82, 0x99, 0x87, 0x53, 0x07,
+	0x74, 0x74, 0xc7, 0x16, 0xa0, 0x6b, 0x99, 0x50, 0x2f, 0x3c, 0x86, 0x23, 0xb1, 0x7d, 0xaa, 0x47,
+	0x87, 0xdb, 0xd3, 0xb9, 0xac, 0x8d, 0x33, 0xbb, 0x49, 0x38, 0x21, 0x45, 0xf0, 0x2b, 0x04, 0x49,
+	0xb5, 0x7d, 0xf0, 0xf9, 0xd8, 0x64, 0xd1, 0x95, 0x97, 0x9a, 0x6c, 0xcf, 0x59, 0xa1, 0x9b, 0x63,
+	0x2f, 0xbf, 0xfd, 0x7c, 0xd3, 0x31, 0x82, 0xd3, 0x76, 0xdc, 0x56, 0x56, 0xeb, 0x0e, 0x7f, 0x44,
+	0xd0, 0x1d, 0x1a, 0x2f, 0x7c, 0xa1, 0xb5, 0x4c, 0x74, 0x2b, 0xa6, 0xa6, 0x8f, 0x10, 0xa1, 0xe9,
+	0xae, 0x4a, 0xba, 0x59, 0x7c, 0x39, 0x96, 0x2e, 0xbc, 0x01, 0x85, 0xbd, 0x11, 0x5e, 0xbb, 0x9b,
+	0xf8, 0x2d, 0x82, 0x9e, 0x50, 0x5a, 0x81, 0xdb, 0x47, 0x08, 0xda, 0x39, 0x73, 0x94, 0x10, 0x8d,
+	0x6d, 0x49, 0xec, 0x71, 0x3c, 0xda, 0x1e, 0x36, 0xfe, 0x8a, 0x00, 0x47, 0x07, 0x12, 0xcf, 0xb6,
+	0x96, 0x8e, 0xdd, 0x3e, 0xa9, 0xb9, 0xa3, 0x07, 0x6a, 0xf2, 0x4b, 0x92, 0xdc, 0xc2, 0x93, 0xb1,
+	0xe4, 0x87, 0xac, 0x06, 0xfc, 0x1e, 0x41, 0x67, 0xfd, 0x59, 0xe3, 0xa9, 0xd6, 0xe2, 0x07, 0x86,
+	0x34, 0x65, 0xb5, 0xeb, 0xae, 0x09, 0xaf, 0x49, 0xc2, 0x39, 0x7c, 0x25, 0x96, 0x30, 0x18, 0x45,
+	0x7b, 0x23, 0x32, 0xfe, 0x9b, 0x99, 0xd9, 0xad, 0x3d, 0x03, 0x6d, 0xef, 0x19, 0xe8, 0xc7, 0x9e,
+	0x81, 0x5e, 0xef, 0x1b, 0x89, 0xed, 0x7d, 0x23, 0xf1, 0x7d, 0xdf, 0x48, 0x3c, 0xd1, 0xfb, 0x40,
+	0x14, 0x9e, 0x59, 0x94, 0xdb, 0xcf, 0x1b, 0x89, 0xfd, 0xf5, 0xb2, 0x23, 0xf2, 0x49, 0xf9, 0x3f,
+	0xe3, 0xe2, 0xef, 0x01, 0x00, 0x7b, 0xce, 0x77, 0xfc, 0x5d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +606,16 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MaintenanceWindows queries the maintenance windows which have not ended
+	// yet, of all validators or of a single one.
+	//
+	// Since: cosmos-sdk 0.52
+	MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error)
+	// JailInfo queries the jailing state of a validator and the reasons why it
+	// cannot be unjailed at the current block, if any.
+	//
+	// Since: cosmos-sdk 0.52
+	JailInfo(ctx context.Context, in *QueryJailInfoRequest, opts ...grpc.CallOption) (*QueryJailInfoResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +653,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error) {
+	out := new(QueryMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/MaintenanceWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) JailInfo(ctx context.Context, in *QueryJailInfoRequest, opts ...grpc.CallOption) (*QueryJailInfoResponse, error) {
+	out := new(QueryJailInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/JailInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +679,16 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MaintenanceWindows queries the maintenance windows which have not ended
+	// yet, of all validators or of a single one.
+	//
+	// Since: cosmos-sdk 0.52
+	MaintenanceWindows(context.Context, *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error)
+	// JailInfo queries the jailing state of a validator and the reasons why it
+	// cannot be unjailed at the current block, if any.
+	//
+	// Since: cosmos-sdk 0.52
+	JailInfo(context.Context, *QueryJailInfoRequest) (*QueryJailInfoResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +704,12 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) MaintenanceWindows(ctx context.Context, req *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceWindows not implemented")
+}
+func (*UnimplementedQueryServer) JailInfo(ctx context.Context, req *QueryJailInfoRequest) (*QueryJailInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JailInfo not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +769,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/MaintenanceWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceWindows(ctx, req.(*QueryMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_JailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJailInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).JailInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/JailInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).JailInfo(ctx, req.(*QueryJailInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +821,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MaintenanceWindows",
+			Handler:    _Query_MaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "JailInfo",
+			Handler:    _Query_JailInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,81 +1037,235 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMaintenanceWindowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryMaintenanceWindowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMaintenanceWindowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMaintenanceWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ValSigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryMaintenanceWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySigningInfosResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMaintenanceWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Info) > 0 {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for iNdEx := len(m.MaintenanceWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaintenanceWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.JailInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
 		for _, e := range m.Info {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
@@ -810,13 +1278,288 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMaintenanceWindowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMaintenanceWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaintenanceWindows) > 0 {
+		for _, e := range m.MaintenanceWindows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJailInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJailInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JailInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -839,12 +1582,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -866,7 +1642,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -889,15 +1665,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -924,7 +1700,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -949,7 +1728,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -972,17 +1751,17 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -992,23 +1771,61 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1031,7 +1848,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMaintenanceWindowsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1054,15 +1871,47 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMaintenanceWindowsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMaintenanceWindowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1089,7 +1938,10 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1114,7 +1966,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMaintenanceWindowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1137,13 +1989,47 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMaintenanceWindowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMaintenanceWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceWindows = append(m.MaintenanceWindows, MaintenanceWindow{})
+			if err := m.MaintenanceWindows[len(m.MaintenanceWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1173,7 +2059,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1200,7 +2086,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryJailInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1223,17 +2109,17 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryJailInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryJailInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1243,29 +2129,77 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryJailInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJailInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJailInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1292,10 +2226,7 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JailInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
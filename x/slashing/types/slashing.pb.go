@@ -31,6 +31,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SlashDestinationType defines where a share of the slashed tokens is sent.
+//
+// Since: cosmos-sdk 0.52
+type SlashDestinationType int32
+
+const (
+	// SLASH_DESTINATION_TYPE_UNSPECIFIED defines an invalid destination.
+	SlashDestinationTypeUnspecified SlashDestinationType = 0
+	// SLASH_DESTINATION_TYPE_BURN burns the tokens.
+	SlashDestinationTypeBurn SlashDestinationType = 1
+	// SLASH_DESTINATION_TYPE_COMMUNITY_POOL sends the tokens to the community pool.
+	SlashDestinationTypeCommunityPool SlashDestinationType = 2
+	// SLASH_DESTINATION_TYPE_ADDRESS sends the tokens to an account, e.g. an insurance fund.
+	SlashDestinationTypeAddress SlashDestinationType = 3
+)
+
+var SlashDestinationType_name = map[int32]string{
+	0: "SLASH_DESTINATION_TYPE_UNSPECIFIED",
+	1: "SLASH_DESTINATION_TYPE_BURN",
+	2: "SLASH_DESTINATION_TYPE_COMMUNITY_POOL",
+	3: "SLASH_DESTINATION_TYPE_ADDRESS",
+}
+
+var SlashDestinationType_value = map[string]int32{
+	"SLASH_DESTINATION_TYPE_UNSPECIFIED":    0,
+	"SLASH_DESTINATION_TYPE_BURN":           1,
+	"SLASH_DESTINATION_TYPE_COMMUNITY_POOL": 2,
+	"SLASH_DESTINATION_TYPE_ADDRESS":        3,
+}
+
+func (x SlashDestinationType) String() string {
+	return proto.EnumName(SlashDestinationType_name, int32(x))
+}
+
+func (SlashDestinationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -135,6 +173,34 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// slash_destinations routes shares of the slashed tokens to destinations other
+	// than burning. The shares must not add up to more than one, the remainder is
+	// burned. When empty, all slashed tokens are burned.
+	//
+	// Since: cosmos-sdk 0.52
+	SlashDestinations []SlashDestination `protobuf:"bytes,6,rep,name=slash_destinations,json=slashDestinations,proto3" json:"slash_destinations"`
+	// max_maintenance_blocks is the number of blocks of maintenance windows a
+	// validator can schedule within a maintenance period. Zero disables
+	// maintenance windows.
+	//
+	// Since: cosmos-sdk 0.52
+	MaxMaintenanceBlocks int64 `protobuf:"varint,7,opt,name=max_maintenance_blocks,json=maxMaintenanceBlocks,proto3" json:"max_maintenance_blocks,omitempty"`
+	// maintenance_period is the number of blocks of the periods over which the
+	// maintenance blocks of a validator are capped.
+	//
+	// Since: cosmos-sdk 0.52
+	MaintenancePeriod int64 `protobuf:"varint,8,opt,name=maintenance_period,json=maintenancePeriod,proto3" json:"maintenance_period,omitempty"`
+	// min_maintenance_notice is the minimum number of blocks between the
+	// scheduling of a maintenance window and its start.
+	//
+	// Since: cosmos-sdk 0.52
+	MinMaintenanceNotice int64 `protobuf:"varint,9,opt,name=min_maintenance_notice,json=minMaintenanceNotice,proto3" json:"min_maintenance_notice,omitempty"`
+	// max_maintenance_power is the maximum fraction of the bonded tokens held by
+	// the validators in maintenance at any height. It must be less than a third,
+	// as the chain halts when a third of the voting power is offline.
+	//
+	// Since: cosmos-sdk 0.52
+	MaxMaintenancePower cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_maintenance_power,json=maxMaintenancePower,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_maintenance_power"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,9 +250,279 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetSlashDestinations() []SlashDestination {
+	if m != nil {
+		return m.SlashDestinations
+	}
+	return nil
+}
+
+func (m *Params) GetMaxMaintenanceBlocks() int64 {
+	if m != nil {
+		return m.MaxMaintenanceBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenancePeriod() int64 {
+	if m != nil {
+		return m.MaintenancePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMinMaintenanceNotice() int64 {
+	if m != nil {
+		return m.MinMaintenanceNotice
+	}
+	return 0
+}
+
+// SlashDestination defines a share of the slashed tokens and where it is sent.
+//
+// Since: cosmos-sdk 0.52
+type SlashDestination struct {
+	// type is where the tokens are sent.
+	Type SlashDestinationType `protobuf:"varint,1,opt,name=type,proto3,enum=cosmos.slashing.v1beta1.SlashDestinationType" json:"type,omitempty"`
+	// address is the account receiving the tokens, only set for SLASH_DESTINATION_TYPE_ADDRESS.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// share is the fraction of the slashed tokens sent to the destination.
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *SlashDestination) Reset()         { *m = SlashDestination{} }
+func (m *SlashDestination) String() string { return proto.CompactTextString(m) }
+func (*SlashDestination) ProtoMessage()    {}
+func (*SlashDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *SlashDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashDestination.Merge(m, src)
+}
+func (m *SlashDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashDestination proto.InternalMessageInfo
+
+func (m *SlashDestination) GetType() SlashDestinationType {
+	if m != nil {
+		return m.Type
+	}
+	return SlashDestinationTypeUnspecified
+}
+
+func (m *SlashDestination) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MaintenanceWindow defines a range of heights during which the blocks missed
+// by a validator do not count toward its downtime.
+//
+// Since: cosmos-sdk 0.52
+type MaintenanceWindow struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// start_height is the first height of the window.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height following the last height of the window.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// JailInfo gathers the state checked to unjail a validator, and the reasons why
+// it cannot be unjailed at the current block.
+//
+// Since: cosmos-sdk 0.52
+type JailInfo struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// status is the bond status of the validator.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// jailed is whether the validator is jailed.
+	Jailed bool `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// tombstoned is whether the validator was tombstoned, which prevents it from
+	// ever being unjailed.
+	Tombstoned bool `protobuf:"varint,4,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// jailed_until is the time until which the validator is jailed for downtime.
+	JailedUntil time.Time `protobuf:"bytes,5,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// missed_blocks_counter is the number of blocks missed by the validator in
+	// the current signing window.
+	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// self_delegation is the amount of tokens self-delegated by the validator.
+	SelfDelegation cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=self_delegation,json=selfDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"self_delegation"`
+	// min_self_delegation is the minimum self-delegation of the validator, below
+	// which it cannot be unjailed.
+	MinSelfDelegation cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"min_self_delegation"`
+	// unjail_blockers are the reasons why the validator cannot be unjailed at the
+	// current block, in the order MsgUnjail checks them. The validator can be
+	// unjailed when empty.
+	UnjailBlockers []string `protobuf:"bytes,9,rep,name=unjail_blockers,json=unjailBlockers,proto3" json:"unjail_blockers,omitempty"`
+}
+
+func (m *JailInfo) Reset()         { *m = JailInfo{} }
+func (m *JailInfo) String() string { return proto.CompactTextString(m) }
+func (*JailInfo) ProtoMessage()    {}
+func (*JailInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{4}
+}
+func (m *JailInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailInfo.Merge(m, src)
+}
+func (m *JailInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailInfo proto.InternalMessageInfo
+
+func (m *JailInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *JailInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *JailInfo) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *JailInfo) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *JailInfo) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func (m *JailInfo) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *JailInfo) GetUnjailBlockers() []string {
+	if m != nil {
+		return m.UnjailBlockers
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.SlashDestinationType", SlashDestinationType_name, SlashDestinationType_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*SlashDestination)(nil), "cosmos.slashing.v1beta1.SlashDestination")
+	proto.RegisterType((*MaintenanceWindow)(nil), "cosmos.slashing.v1beta1.MaintenanceWindow")
+	proto.RegisterType((*JailInfo)(nil), "cosmos.slashing.v1beta1.JailInfo")
 }
 
 func init() {
@@ -194,47 +530,80 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 1161 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1a, 0xc7,
+	0x17, 0x67, 0x01, 0x13, 0x33, 0xf8, 0x9b, 0xc0, 0x04, 0x27, 0x1b, 0x1c, 0x03, 0xe6, 0x2b, 0xa7,
+	0xd4, 0x15, 0x90, 0xd0, 0xaa, 0x07, 0x47, 0x3d, 0x18, 0x70, 0x14, 0x5a, 0x1b, 0xa3, 0x05, 0xb7,
+	0x72, 0x0f, 0xdd, 0x2e, 0xec, 0xb0, 0x9e, 0x98, 0x9d, 0x41, 0x3b, 0x83, 0x7f, 0xdc, 0x7b, 0xa8,
+	0x7c, 0x8a, 0x7a, 0xea, 0xc5, 0x52, 0xa5, 0xaa, 0x52, 0x8e, 0x39, 0xf8, 0xde, 0x53, 0xa5, 0x1c,
+	0x23, 0x9f, 0xaa, 0x1e, 0xd2, 0xca, 0x3e, 0xa4, 0xff, 0x42, 0x6f, 0xd5, 0xce, 0x2c, 0x36, 0xc6,
+	0x20, 0x45, 0x71, 0x2f, 0x88, 0x7d, 0x3f, 0x3e, 0x9f, 0x79, 0x9f, 0x79, 0xf3, 0x1e, 0x78, 0xd0,
+	0xa6, 0xcc, 0xa6, 0xac, 0xc0, 0xba, 0x06, 0xdb, 0xc6, 0xc4, 0x2a, 0xec, 0x3e, 0x6a, 0x21, 0x6e,
+	0x3c, 0x3a, 0x37, 0xe4, 0x7b, 0x0e, 0xe5, 0x14, 0xde, 0x95, 0x71, 0xf9, 0x73, 0xb3, 0x17, 0x97,
+	0x88, 0x5b, 0xd4, 0xa2, 0x22, 0xa6, 0xe0, 0xfe, 0x93, 0xe1, 0x89, 0xa4, 0x45, 0xa9, 0xd5, 0x45,
+	0x05, 0xf1, 0xd5, 0xea, 0x77, 0x0a, 0x66, 0xdf, 0x31, 0x38, 0xa6, 0xc4, 0xf3, 0xa7, 0x46, 0xfd,
+	0x1c, 0xdb, 0x88, 0x71, 0xc3, 0xee, 0x79, 0x01, 0xf7, 0x24, 0x9f, 0x2e, 0x91, 0x3d, 0x72, 0xe9,
+	0x8a, 0x19, 0x36, 0x26, 0xb4, 0x20, 0x7e, 0xa5, 0x29, 0xf3, 0x9b, 0x1f, 0xc4, 0xbf, 0x34, 0xba,
+	0xd8, 0x34, 0x38, 0x75, 0x1a, 0xd8, 0x22, 0x98, 0x58, 0x55, 0xd2, 0xa1, 0xf0, 0x31, 0xb8, 0x61,
+	0x98, 0xa6, 0x83, 0x18, 0x53, 0x95, 0xb4, 0x92, 0x0d, 0x97, 0x16, 0x4e, 0x8e, 0x73, 0xf3, 0x1e,
+	0x5c, 0x99, 0x12, 0x86, 0x08, 0xeb, 0xb3, 0x15, 0x19, 0xd2, 0xe0, 0x0e, 0x26, 0x96, 0x36, 0xc8,
+	0x80, 0x0b, 0x60, 0x86, 0x71, 0xc3, 0xe1, 0xfa, 0x36, 0xc2, 0xd6, 0x36, 0x57, 0xfd, 0x69, 0x25,
+	0x1b, 0xd0, 0x22, 0xc2, 0xf6, 0x54, 0x98, 0xe0, 0x22, 0x98, 0xc1, 0xc4, 0x44, 0xfb, 0x3a, 0xed,
+	0x74, 0x18, 0xe2, 0x6a, 0xc0, 0x0d, 0x29, 0xf9, 0x55, 0x45, 0x8b, 0x08, 0xfb, 0x86, 0x30, 0xc3,
+	0x35, 0x30, 0xf3, 0xcc, 0xc0, 0x5d, 0x64, 0xea, 0x7d, 0xc2, 0x71, 0x57, 0x0d, 0xa6, 0x95, 0x6c,
+	0xa4, 0x98, 0xc8, 0x4b, 0x15, 0xf2, 0x03, 0x15, 0xf2, 0xcd, 0x81, 0x0a, 0xa5, 0xff, 0xbd, 0x7a,
+	0x93, 0xf2, 0x3d, 0xff, 0x33, 0xa5, 0xbc, 0x78, 0xfb, 0x72, 0x49, 0xd1, 0x22, 0x32, 0x7d, 0xd3,
+	0xcd, 0x86, 0x49, 0x00, 0x38, 0xb5, 0x5b, 0x8c, 0x53, 0x82, 0x4c, 0x75, 0x2a, 0xad, 0x64, 0xa7,
+	0xb5, 0x21, 0x0b, 0x2c, 0x82, 0x59, 0x1b, 0x33, 0x86, 0x4c, 0xbd, 0xd5, 0xa5, 0xed, 0x1d, 0xa6,
+	0xb7, 0x69, 0x9f, 0x70, 0xe4, 0xa8, 0x21, 0x51, 0xc0, 0x6d, 0xe9, 0x2c, 0x09, 0x5f, 0x59, 0xba,
+	0x96, 0x83, 0x7f, 0xff, 0x94, 0x52, 0x32, 0xff, 0x84, 0x40, 0xa8, 0x6e, 0x38, 0x86, 0xcd, 0xe0,
+	0x43, 0x10, 0x67, 0xd8, 0x22, 0x17, 0x20, 0x7b, 0x98, 0x98, 0x74, 0x4f, 0xc8, 0x18, 0xd0, 0xa0,
+	0xf4, 0x49, 0x8c, 0xaf, 0x84, 0x07, 0x62, 0x97, 0x96, 0xe8, 0x5e, 0x56, 0x0f, 0x39, 0x83, 0x14,
+	0x57, 0xb7, 0x99, 0xd2, 0xa7, 0x6e, 0x45, 0x7f, 0xbc, 0x49, 0xcd, 0x49, 0xf5, 0x99, 0xb9, 0x93,
+	0xc7, 0xb4, 0x60, 0x1b, 0x7c, 0x3b, 0xbf, 0x86, 0x2c, 0xa3, 0x7d, 0x50, 0x41, 0xed, 0x93, 0xe3,
+	0x1c, 0xf0, 0x2e, 0xa7, 0x82, 0xda, 0xb2, 0x74, 0x68, 0x63, 0xd2, 0x10, 0x98, 0x75, 0xe4, 0x78,
+	0x54, 0xdf, 0x80, 0x3b, 0x26, 0xdd, 0x23, 0x6e, 0xd3, 0xe8, 0xae, 0x32, 0xfa, 0xa0, 0xbd, 0xc4,
+	0x05, 0x44, 0x8a, 0xf7, 0xae, 0x28, 0x5b, 0xf1, 0x02, 0xa4, 0xb0, 0x3f, 0x9e, 0x0b, 0x1b, 0x1f,
+	0xe0, 0x7c, 0x6e, 0xe0, 0xee, 0x20, 0x08, 0x32, 0x90, 0x10, 0x8d, 0xae, 0x77, 0x1c, 0xa3, 0xed,
+	0x5a, 0x74, 0x93, 0xf6, 0x5b, 0x5d, 0x24, 0x8a, 0x53, 0x83, 0xd7, 0xaa, 0xe7, 0xae, 0x40, 0x7e,
+	0xe2, 0x01, 0x57, 0x04, 0xae, 0x5b, 0x1f, 0x24, 0xe0, 0xee, 0x15, 0x52, 0x79, 0x36, 0x75, 0xea,
+	0x5a, 0x8c, 0xb3, 0x23, 0x8c, 0x12, 0x14, 0xb6, 0x01, 0x94, 0x7c, 0x26, 0x62, 0x1c, 0x13, 0x51,
+	0x39, 0x53, 0x43, 0xe9, 0x40, 0x36, 0x52, 0xfc, 0x30, 0x3f, 0xe1, 0xbd, 0xe7, 0x1b, 0xae, 0xa1,
+	0x72, 0x91, 0x51, 0x0a, 0xbb, 0xa7, 0x92, 0x44, 0x31, 0x36, 0xe2, 0x64, 0xf0, 0x13, 0x70, 0xc7,
+	0x36, 0xf6, 0x75, 0xdb, 0xc0, 0x84, 0x23, 0x62, 0x90, 0x36, 0xf2, 0xfa, 0x49, 0xbd, 0x21, 0x1a,
+	0x29, 0x6e, 0x1b, 0xfb, 0xeb, 0x17, 0x4e, 0xd9, 0x50, 0x30, 0x07, 0xe0, 0x70, 0x46, 0x0f, 0x39,
+	0x98, 0x9a, 0xea, 0xb4, 0xc8, 0x88, 0x0d, 0x79, 0xea, 0xc2, 0x21, 0x48, 0x30, 0xb9, 0x44, 0x42,
+	0x28, 0xc7, 0x6d, 0xa4, 0x86, 0x3d, 0x12, 0x4c, 0x86, 0x48, 0x6a, 0xc2, 0x07, 0x9f, 0x81, 0xd9,
+	0xd1, 0xa3, 0xf5, 0xe8, 0x1e, 0x72, 0x54, 0x70, 0x2d, 0xb5, 0x6f, 0x5f, 0xae, 0xa8, 0xee, 0x42,
+	0x2e, 0x2f, 0x1c, 0xbe, 0x7d, 0xb9, 0x74, 0x5f, 0x86, 0xe6, 0x98, 0xb9, 0x53, 0xd8, 0xbf, 0x98,
+	0xb8, 0xf2, 0xc1, 0x65, 0xbe, 0xf3, 0x83, 0xe8, 0xa8, 0xb8, 0x70, 0x05, 0x04, 0xf9, 0x41, 0x0f,
+	0x89, 0x57, 0x77, 0xb3, 0x98, 0x7b, 0xe7, 0x5b, 0x69, 0x1e, 0xf4, 0x90, 0x26, 0x52, 0x61, 0xf1,
+	0x62, 0x04, 0xfa, 0xc5, 0x08, 0x54, 0x4f, 0x8e, 0x73, 0x71, 0x0f, 0x68, 0xc2, 0xe4, 0x5b, 0x03,
+	0x53, 0x6c, 0xdb, 0x70, 0x90, 0x1a, 0xb8, 0x96, 0x14, 0x12, 0x64, 0xf9, 0x23, 0xb7, 0xf8, 0x07,
+	0xe3, 0x8b, 0x1f, 0x3d, 0x78, 0xe6, 0x17, 0x05, 0xc4, 0x86, 0xe4, 0xf3, 0x1e, 0x7c, 0x0d, 0xc4,
+	0x76, 0x07, 0xf3, 0x5d, 0x9f, 0x3c, 0xd1, 0xcf, 0x77, 0xc0, 0xe5, 0xba, 0xa2, 0xbb, 0x23, 0xf6,
+	0x77, 0x19, 0xed, 0xf3, 0x00, 0x20, 0x62, 0x0e, 0x02, 0xc4, 0x60, 0xd7, 0xc2, 0x88, 0x98, 0xd2,
+	0x9d, 0xf9, 0x21, 0x08, 0xa6, 0xdd, 0x99, 0x21, 0xd6, 0xcc, 0x7f, 0x7d, 0xbc, 0x3b, 0x20, 0xc4,
+	0xb8, 0xc1, 0xfb, 0xde, 0x95, 0x69, 0xde, 0x97, 0x6b, 0x97, 0x8b, 0x40, 0x9c, 0x67, 0x5a, 0xf3,
+	0xbe, 0x46, 0x36, 0x42, 0xf0, 0xca, 0x46, 0x18, 0xdd, 0x3f, 0x53, 0xd7, 0xda, 0x3f, 0xef, 0xb1,
+	0x5f, 0xe0, 0x16, 0xb8, 0xc5, 0x50, 0xb7, 0xa3, 0x9b, 0xa8, 0x8b, 0x2c, 0x39, 0xaa, 0x6f, 0x08,
+	0x7d, 0x1e, 0x7a, 0xbd, 0x35, 0x7b, 0xb5, 0xb7, 0xaa, 0x84, 0x0f, 0x75, 0x55, 0x95, 0x70, 0x79,
+	0x96, 0x9b, 0x2e, 0x50, 0xe5, 0x1c, 0x07, 0x7e, 0x0b, 0x6e, 0x8b, 0xbd, 0x33, 0x02, 0x3f, 0xfd,
+	0x9e, 0xf0, 0x31, 0x77, 0xdf, 0x5c, 0x66, 0xf8, 0x00, 0xdc, 0xea, 0x13, 0xb1, 0x67, 0x44, 0xc1,
+	0xc8, 0x61, 0x6a, 0x38, 0x1d, 0xc8, 0x86, 0xb5, 0x9b, 0xd2, 0x5c, 0xf2, 0xac, 0x4b, 0xbf, 0xfa,
+	0x41, 0x7c, 0xdc, 0x53, 0x84, 0x5f, 0x80, 0x4c, 0x63, 0x6d, 0xa5, 0xf1, 0x54, 0xaf, 0xac, 0x36,
+	0x9a, 0xd5, 0xda, 0x4a, 0xb3, 0xba, 0x51, 0xd3, 0x9b, 0x5b, 0xf5, 0x55, 0x7d, 0xb3, 0xd6, 0xa8,
+	0xaf, 0x96, 0xab, 0x4f, 0xaa, 0xab, 0x95, 0xa8, 0x2f, 0xf1, 0xff, 0xc3, 0xa3, 0x74, 0x6a, 0x1c,
+	0xc2, 0x26, 0x61, 0x3d, 0xd4, 0xc6, 0x1d, 0x8c, 0x4c, 0xf8, 0x19, 0x98, 0x9b, 0x00, 0x56, 0xda,
+	0xd4, 0x6a, 0x51, 0x25, 0x71, 0xff, 0xf0, 0x28, 0xad, 0x8e, 0x43, 0x29, 0xf5, 0x1d, 0x02, 0xeb,
+	0x60, 0x71, 0x42, 0x7a, 0x79, 0x63, 0x7d, 0x7d, 0xb3, 0x56, 0x6d, 0x6e, 0xe9, 0xf5, 0x8d, 0x8d,
+	0xb5, 0xa8, 0x3f, 0xb1, 0x78, 0x78, 0x94, 0x5e, 0x18, 0x07, 0x54, 0xa6, 0xb6, 0xdd, 0x27, 0x98,
+	0x1f, 0xd4, 0x29, 0xed, 0xc2, 0x32, 0x48, 0x4e, 0x40, 0x5c, 0xa9, 0x54, 0xb4, 0xd5, 0x46, 0x23,
+	0x1a, 0x48, 0xa4, 0x0e, 0x8f, 0xd2, 0x73, 0xe3, 0xa0, 0xbc, 0x9e, 0x4f, 0x04, 0xbf, 0xff, 0x39,
+	0xe9, 0x2b, 0x3d, 0x7e, 0x71, 0x9a, 0x54, 0x5e, 0x9d, 0x26, 0x95, 0xd7, 0xa7, 0x49, 0xe5, 0xaf,
+	0xd3, 0xa4, 0xf2, 0xfc, 0x2c, 0xe9, 0x7b, 0x7d, 0x96, 0xf4, 0xfd, 0x7e, 0x96, 0xf4, 0x7d, 0x3d,
+	0x7f, 0xe9, 0x16, 0x87, 0xc6, 0x88, 0x3b, 0xe9, 0x58, 0x2b, 0x24, 0x1a, 0xf9, 0xe3, 0x7f, 0x07,
+	0x00, 0xc3, 0xde, 0xf6, 0x45, 0xd5, 0x0a, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -310,91 +679,263 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.SlashDestinations) != len(that1.SlashDestinations) {
+		return false
+	}
+	for i := range this.SlashDestinations {
+		if !this.SlashDestinations[i].Equal(&that1.SlashDestinations[i]) {
+			return false
+		}
+	}
+	if this.MaxMaintenanceBlocks != that1.MaxMaintenanceBlocks {
+		return false
+	}
+	if this.MaintenancePeriod != that1.MaintenancePeriod {
+		return false
+	}
+	if this.MinMaintenanceNotice != that1.MinMaintenanceNotice {
+		return false
+	}
+	if !this.MaxMaintenancePower.Equal(that1.MaxMaintenancePower) {
+		return false
+	}
 	return true
 }
-func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SlashDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissedBlocksCounter != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Tombstoned {
-		i--
-		if m.Tombstoned {
-			dAtA[i] = 1
+	that1, ok := that.(*SlashDestination)
+	if !ok {
+		that2, ok := that.(SlashDestination)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	if m.IndexOffset != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.IndexOffset))
-		i--
-		dAtA[i] = 0x18
+	if this.Type != that1.Type {
+		return false
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x10
+	if this.Address != that1.Address {
+		return false
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Share.Equal(that1.Share) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MaintenanceWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+	that1, ok := that.(*MaintenanceWindow)
+	if !ok {
+		that2, ok := that.(MaintenanceWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	return true
+}
+func (this *JailInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JailInfo)
+	if !ok {
+		that2, ok := that.(JailInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Jailed != that1.Jailed {
+		return false
+	}
+	if this.Tombstoned != that1.Tombstoned {
+		return false
+	}
+	if !this.JailedUntil.Equal(that1.JailedUntil) {
+		return false
+	}
+	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
+		return false
+	}
+	if !this.SelfDelegation.Equal(that1.SelfDelegation) {
+		return false
+	}
+	if !this.MinSelfDelegation.Equal(that1.MinSelfDelegation) {
+		return false
+	}
+	if len(this.UnjailBlockers) != len(that1.UnjailBlockers) {
+		return false
+	}
+	for i := range this.UnjailBlockers {
+		if this.UnjailBlockers[i] != that1.UnjailBlockers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.IndexOffset != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxMaintenancePower.Size()
+		i -= size
+		if _, err := m.MaxMaintenancePower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinMaintenanceNotice != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MinMaintenanceNotice))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaintenancePeriod != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaintenancePeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxMaintenanceBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxMaintenanceBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SlashDestinations) > 0 {
+		for iNdEx := len(m.SlashDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -441,71 +982,998 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SlashDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SlashDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovSlashing(uint64(m.StartHeight))
-	}
-	if m.IndexOffset != 0 {
-		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
-	n += 1 + l + sovSlashing(uint64(l))
-	if m.Tombstoned {
-		n += 2
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	if m.Type != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SignedBlocksWindow != 0 {
-		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	if m.EndHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JailInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnjailBlockers) > 0 {
+		for iNdEx := len(m.UnjailBlockers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnjailBlockers[iNdEx])
+			copy(dAtA[i:], m.UnjailBlockers[iNdEx])
+			i = encodeVarintSlashing(dAtA, i, uint64(len(m.UnjailBlockers[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SelfDelegation.Size()
+		i -= size
+		if _, err := m.SelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x30
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.SlashDestinations) > 0 {
+		for _, e := range m.SlashDestinations {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.MaxMaintenanceBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxMaintenanceBlocks))
+	}
+	if m.MaintenancePeriod != 0 {
+		n += 1 + sovSlashing(uint64(m.MaintenancePeriod))
+	}
+	if m.MinMaintenanceNotice != 0 {
+		n += 1 + sovSlashing(uint64(m.MinMaintenanceNotice))
+	}
+	l = m.MaxMaintenancePower.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *SlashDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSlashing(uint64(m.Type))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *JailInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	l = m.SelfDelegation.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.UnjailBlockers) > 0 {
+		for _, s := range m.UnjailBlockers {
+			l = len(s)
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashDestinations = append(m.SlashDestinations, SlashDestination{})
+			if err := m.SlashDestinations[len(m.SlashDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceBlocks", wireType)
+			}
+			m.MaxMaintenanceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaintenanceBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenancePeriod", wireType)
+			}
+			m.MaintenancePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenancePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMaintenanceNotice", wireType)
+			}
+			m.MinMaintenanceNotice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMaintenanceNotice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenancePower", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMaintenancePower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SlashDestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.MinSignedPerWindow.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDoubleSign.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDowntime.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	return n
-}
 
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -528,15 +1996,15 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -564,7 +2032,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -587,81 +2055,9 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
-			}
-			m.IndexOffset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Tombstoned = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -671,7 +2067,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.EndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -697,7 +2093,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *JailInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -720,17 +2116,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: JailInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JailInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.SignedBlocksWindow = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -740,16 +2136,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -759,28 +2168,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -807,15 +2255,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegation", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -825,30 +2292,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -858,25 +2326,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailBlockers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnjailBlockers = append(m.UnjailBlockers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
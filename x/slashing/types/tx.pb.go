@@ -205,46 +205,224 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleMaintenanceWindow is the Msg/ScheduleMaintenanceWindow request type.
+//
+// Since: cosmos-sdk 0.52
+type MsgScheduleMaintenanceWindow struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// start_height is the first height of the window.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height following the last height of the window.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *MsgScheduleMaintenanceWindow) Reset()         { *m = MsgScheduleMaintenanceWindow{} }
+func (m *MsgScheduleMaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMaintenanceWindow) ProtoMessage()    {}
+func (*MsgScheduleMaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgScheduleMaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMaintenanceWindow.Merge(m, src)
+}
+func (m *MsgScheduleMaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMaintenanceWindow proto.InternalMessageInfo
+
+// MsgScheduleMaintenanceWindowResponse defines the Msg/ScheduleMaintenanceWindow response type.
+//
+// Since: cosmos-sdk 0.52
+type MsgScheduleMaintenanceWindowResponse struct {
+}
+
+func (m *MsgScheduleMaintenanceWindowResponse) Reset()         { *m = MsgScheduleMaintenanceWindowResponse{} }
+func (m *MsgScheduleMaintenanceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMaintenanceWindowResponse) ProtoMessage()    {}
+func (*MsgScheduleMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgScheduleMaintenanceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMaintenanceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMaintenanceWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMaintenanceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMaintenanceWindowResponse.Merge(m, src)
+}
+func (m *MsgScheduleMaintenanceWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMaintenanceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMaintenanceWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMaintenanceWindowResponse proto.InternalMessageInfo
+
+// MsgCancelMaintenanceWindow is the Msg/CancelMaintenanceWindow request type.
+//
+// Since: cosmos-sdk 0.52
+type MsgCancelMaintenanceWindow struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// start_height is the start height of the window to cancel.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *MsgCancelMaintenanceWindow) Reset()         { *m = MsgCancelMaintenanceWindow{} }
+func (m *MsgCancelMaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMaintenanceWindow) ProtoMessage()    {}
+func (*MsgCancelMaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{6}
+}
+func (m *MsgCancelMaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMaintenanceWindow.Merge(m, src)
+}
+func (m *MsgCancelMaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelMaintenanceWindow proto.InternalMessageInfo
+
+// MsgCancelMaintenanceWindowResponse defines the Msg/CancelMaintenanceWindow response type.
+//
+// Since: cosmos-sdk 0.52
+type MsgCancelMaintenanceWindowResponse struct {
+}
+
+func (m *MsgCancelMaintenanceWindowResponse) Reset()         { *m = MsgCancelMaintenanceWindowResponse{} }
+func (m *MsgCancelMaintenanceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMaintenanceWindowResponse) ProtoMessage()    {}
+func (*MsgCancelMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{7}
+}
+func (m *MsgCancelMaintenanceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMaintenanceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMaintenanceWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMaintenanceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMaintenanceWindowResponse.Merge(m, src)
+}
+func (m *MsgCancelMaintenanceWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMaintenanceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMaintenanceWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelMaintenanceWindowResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleMaintenanceWindow)(nil), "cosmos.slashing.v1beta1.MsgScheduleMaintenanceWindow")
+	proto.RegisterType((*MsgScheduleMaintenanceWindowResponse)(nil), "cosmos.slashing.v1beta1.MsgScheduleMaintenanceWindowResponse")
+	proto.RegisterType((*MsgCancelMaintenanceWindow)(nil), "cosmos.slashing.v1beta1.MsgCancelMaintenanceWindow")
+	proto.RegisterType((*MsgCancelMaintenanceWindowResponse)(nil), "cosmos.slashing.v1beta1.MsgCancelMaintenanceWindowResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x62, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x14, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0xdb, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x65,
-	0xa1, 0xd6, 0x85, 0xc1, 0xd4, 0x3a, 0x42, 0xa4, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d,
-	0xdf, 0xa0, 0x05, 0x53, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xde, 0x32, 0x64, 0x85, 0x56,
-	0x06, 0x1d, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xb3, 0xac, 0xeb, 0xf9, 0x06, 0x2d,
-	0x11, 0x88, 0xd1, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x70, 0x77, 0x29, 0x09, 0x73, 0x09, 0xc2, 0x39,
-	0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0xc7, 0x19, 0xb9, 0xf8, 0x41, 0xa2, 0x05,
-	0x29, 0x89, 0x25, 0xa9, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5,
-	0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x50, 0xb7, 0x4b, 0x5c, 0xda, 0xa2, 0x0b, 0x35, 0x55,
-	0x0f, 0xc5, 0x9d, 0x41, 0x08, 0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0x9a, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x20, 0xbe, 0x83, 0xea, 0xb4, 0xb2, 0x00, 0x79, 0x09, 0x61, 0x26, 0xc8, 0x37, 0xaa,
-	0x48, 0xbe, 0xa9, 0x40, 0x44, 0x29, 0x9a, 0xab, 0x95, 0x24, 0xb9, 0xc4, 0xd1, 0x84, 0x60, 0x9e,
-	0x34, 0xba, 0xc4, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x14, 0xc1, 0xc5, 0x06, 0x8d, 0x23, 0x25,
-	0x9c, 0x4e, 0x83, 0x07, 0x91, 0x94, 0x16, 0x61, 0x35, 0x30, 0x1b, 0x84, 0xb2, 0xb8, 0x78, 0x50,
-	0x82, 0x50, 0x03, 0xaf, 0x5e, 0x24, 0x95, 0x52, 0x06, 0xc4, 0xaa, 0x84, 0xd9, 0x25, 0xc5, 0xda,
-	0x00, 0x0a, 0x31, 0x27, 0xeb, 0x15, 0x8f, 0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x0a, 0x9a, 0xba, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0x91, 0x83, 0xad, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x70, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x11,
-	0xf4, 0x13, 0xa9, 0x7a, 0x03, 0x00, 0x00,
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xbd, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0x73, 0xbf, 0xe8, 0x17, 0x94, 0x6b, 0x01, 0xd5, 0x54, 0x4a, 0x62, 0x51, 0x27, 0xb1,
+	0x4a, 0x15, 0x45, 0x8a, 0xdd, 0x17, 0x81, 0x50, 0xa2, 0x0c, 0x84, 0xa5, 0x4b, 0x24, 0x94, 0x8a,
+	0x17, 0xb1, 0x44, 0xd7, 0xf8, 0xe4, 0xb8, 0x4d, 0xee, 0x22, 0xdf, 0x35, 0xb4, 0x1b, 0x62, 0x42,
+	0xb0, 0xc0, 0x7f, 0x80, 0x60, 0xe9, 0x98, 0xa1, 0x7f, 0x03, 0x74, 0xac, 0x3a, 0x31, 0x55, 0x28,
+	0x19, 0x22, 0x98, 0xf9, 0x03, 0x90, 0x7d, 0xb6, 0xf3, 0x02, 0x76, 0x51, 0x17, 0x96, 0xc4, 0xf7,
+	0x3c, 0x9f, 0xe7, 0xe5, 0x7b, 0xcf, 0xdd, 0xc1, 0x5c, 0x8b, 0xb2, 0x2e, 0x65, 0x3a, 0xeb, 0x20,
+	0xd6, 0xb6, 0x88, 0xa9, 0xf7, 0x37, 0x76, 0x31, 0x47, 0x1b, 0x3a, 0x3f, 0xd4, 0x7a, 0x36, 0xe5,
+	0x54, 0x4a, 0x09, 0x42, 0xf3, 0x09, 0xcd, 0x23, 0xe4, 0x65, 0x93, 0x9a, 0xd4, 0x65, 0x74, 0xe7,
+	0x4b, 0xe0, 0xf2, 0x5a, 0x58, 0xc2, 0x20, 0x5e, 0x70, 0x19, 0xc1, 0x35, 0x45, 0x02, 0xaf, 0x86,
+	0x70, 0x79, 0x15, 0xf5, 0x2e, 0x73, 0xa2, 0x9d, 0x3f, 0xcf, 0xb1, 0x84, 0xba, 0x16, 0xa1, 0xba,
+	0xfb, 0x2b, 0x4c, 0xea, 0x27, 0x00, 0x93, 0x75, 0x66, 0x3e, 0x26, 0x7b, 0xc8, 0xea, 0x48, 0x06,
+	0xbc, 0xd1, 0x47, 0x1d, 0xcb, 0x40, 0x9c, 0xda, 0x4d, 0x64, 0x18, 0x76, 0x1a, 0xe4, 0x40, 0x21,
+	0x59, 0xab, 0xfe, 0xb8, 0xc8, 0x5e, 0x73, 0xd6, 0x98, 0xb1, 0xf3, 0x93, 0xd2, 0x8a, 0x57, 0xee,
+	0x89, 0xcf, 0x3e, 0x10, 0xae, 0x1d, 0x6e, 0x5b, 0xc4, 0xfc, 0x38, 0x1e, 0x14, 0x7d, 0xf8, 0x78,
+	0x3c, 0x28, 0x82, 0xc6, 0xf5, 0xfe, 0x34, 0x58, 0x5e, 0x7f, 0xfd, 0x21, 0x1b, 0x7b, 0x35, 0x1e,
+	0x14, 0xe7, 0x8a, 0xbd, 0x19, 0x0f, 0x8a, 0xcb, 0x22, 0x75, 0x89, 0x19, 0xfb, 0x7a, 0xd0, 0x97,
+	0x7a, 0x0b, 0x2e, 0x05, 0x8b, 0x06, 0x66, 0x3d, 0x4a, 0x18, 0x56, 0xbf, 0x00, 0x78, 0xd3, 0xb1,
+	0xf6, 0x0c, 0xc4, 0xf1, 0x23, 0x64, 0xa3, 0x2e, 0x93, 0xee, 0xc1, 0x24, 0x3a, 0xe0, 0x6d, 0x6a,
+	0x5b, 0xfc, 0xc8, 0xeb, 0x3d, 0x7d, 0x7e, 0x52, 0xf2, 0xb2, 0x6a, 0x33, 0x7d, 0x36, 0x26, 0xa8,
+	0x54, 0x83, 0x89, 0x9e, 0x9b, 0x21, 0xfd, 0x5f, 0x0e, 0x14, 0x16, 0x36, 0xb3, 0x5a, 0xc8, 0xd4,
+	0x34, 0x51, 0xa8, 0x96, 0x3c, 0xbd, 0xc8, 0xc6, 0x84, 0x3a, 0x2f, 0xb2, 0x7c, 0xdf, 0x91, 0x34,
+	0xc9, 0xe9, 0xa8, 0xb9, 0x33, 0xa5, 0xe6, 0x70, 0x32, 0xd2, 0xb9, 0xae, 0xd5, 0x0c, 0x4c, 0xcd,
+	0x99, 0x02, 0x91, 0xdf, 0x01, 0xbc, 0x5d, 0x67, 0xe6, 0x4e, 0xab, 0x8d, 0x8d, 0x83, 0x0e, 0xae,
+	0x23, 0x8b, 0x70, 0x4c, 0x10, 0x69, 0xe1, 0xa7, 0x16, 0x31, 0xe8, 0x0b, 0x69, 0x3b, 0x64, 0x64,
+	0xf9, 0x4b, 0xe7, 0x34, 0x37, 0x16, 0x29, 0x0f, 0x17, 0x19, 0x47, 0x36, 0x6f, 0xb6, 0xb1, 0x65,
+	0xb6, 0xb9, 0xbb, 0x13, 0xf1, 0xc6, 0x82, 0x6b, 0xdb, 0x76, 0x4d, 0xd2, 0x0a, 0x84, 0x98, 0x18,
+	0x3e, 0x10, 0x77, 0x81, 0x24, 0x26, 0x86, 0x70, 0x97, 0xab, 0x11, 0x83, 0xcd, 0xcf, 0x0e, 0xf6,
+	0x0f, 0x82, 0xd4, 0x35, 0xb8, 0x1a, 0x25, 0x35, 0xd8, 0x93, 0xcf, 0x00, 0xca, 0x75, 0x66, 0x3e,
+	0x74, 0x5c, 0x9d, 0x7f, 0xbb, 0x23, 0xe5, 0x4a, 0x84, 0xe4, 0xec, 0xac, 0xe4, 0xdf, 0xfa, 0x55,
+	0x57, 0xa1, 0x1a, 0xae, 0xc3, 0x97, 0xbb, 0xf9, 0x33, 0x0e, 0xe3, 0x75, 0x66, 0x4a, 0xcf, 0x60,
+	0xc2, 0xbb, 0xa6, 0x6a, 0xe8, 0xe9, 0x0c, 0x6e, 0x89, 0x5c, 0xbc, 0x9c, 0xf1, 0x2b, 0x48, 0x7b,
+	0x70, 0x71, 0xe6, 0x16, 0x15, 0x22, 0x63, 0xa7, 0x48, 0x79, 0xfd, 0x6f, 0xc9, 0xa0, 0xd6, 0x7b,
+	0x00, 0x33, 0xe1, 0xa7, 0xf9, 0x6e, 0x54, 0xbe, 0xd0, 0x30, 0xb9, 0x7a, 0xa5, 0xb0, 0xa0, 0xa7,
+	0xb7, 0x00, 0xa6, 0xc2, 0x4e, 0xd3, 0x56, 0x54, 0xea, 0x90, 0x20, 0xb9, 0x72, 0x85, 0x20, 0xbf,
+	0x1b, 0xf9, 0xff, 0x97, 0xce, 0xb3, 0x52, 0xab, 0x1c, 0x0f, 0x15, 0x70, 0x3a, 0x54, 0xc0, 0xd9,
+	0x50, 0x01, 0xdf, 0x86, 0x0a, 0x78, 0x37, 0x52, 0x62, 0x67, 0x23, 0x25, 0xf6, 0x75, 0xa4, 0xc4,
+	0x9e, 0x7b, 0x07, 0x99, 0x19, 0xfb, 0x9a, 0x45, 0xa7, 0xdf, 0x16, 0x7e, 0xd4, 0xc3, 0x6c, 0x37,
+	0xe1, 0xbe, 0xee, 0x5b, 0xbf, 0x06, 0x00, 0x6a, 0x8d, 0xd8, 0xe4, 0x9f, 0x06, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -340,6 +518,105 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgScheduleMaintenanceWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgScheduleMaintenanceWindow)
+	if !ok {
+		that2, ok := that.(MsgScheduleMaintenanceWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	return true
+}
+func (this *MsgScheduleMaintenanceWindowResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgScheduleMaintenanceWindowResponse)
+	if !ok {
+		that2, ok := that.(MsgScheduleMaintenanceWindowResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelMaintenanceWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelMaintenanceWindow)
+	if !ok {
+		that2, ok := that.(MsgCancelMaintenanceWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelMaintenanceWindowResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelMaintenanceWindowResponse)
+	if !ok {
+		that2, ok := that.(MsgCancelMaintenanceWindowResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -362,6 +639,18 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleMaintenanceWindow defines a method for a validator to schedule a
+	// maintenance window, during which the blocks it misses do not count toward
+	// its downtime. The windows are bounded by the module parameters.
+	//
+	// Since: cosmos-sdk 0.52
+	ScheduleMaintenanceWindow(ctx context.Context, in *MsgScheduleMaintenanceWindow, opts ...grpc.CallOption) (*MsgScheduleMaintenanceWindowResponse, error)
+	// CancelMaintenanceWindow defines a method for a validator to cancel a
+	// maintenance window. A window which already started ends at the current
+	// height.
+	//
+	// Since: cosmos-sdk 0.52
+	CancelMaintenanceWindow(ctx context.Context, in *MsgCancelMaintenanceWindow, opts ...grpc.CallOption) (*MsgCancelMaintenanceWindowResponse, error)
 }
 
 type msgClient struct {
@@ -390,6 +679,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMaintenanceWindow(ctx context.Context, in *MsgScheduleMaintenanceWindow, opts ...grpc.CallOption) (*MsgScheduleMaintenanceWindowResponse, error) {
+	out := new(MsgScheduleMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/ScheduleMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMaintenanceWindow(ctx context.Context, in *MsgCancelMaintenanceWindow, opts ...grpc.CallOption) (*MsgCancelMaintenanceWindowResponse, error) {
+	out := new(MsgCancelMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/CancelMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -401,6 +708,18 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleMaintenanceWindow defines a method for a validator to schedule a
+	// maintenance window, during which the blocks it misses do not count toward
+	// its downtime. The windows are bounded by the module parameters.
+	//
+	// Since: cosmos-sdk 0.52
+	ScheduleMaintenanceWindow(context.Context, *MsgScheduleMaintenanceWindow) (*MsgScheduleMaintenanceWindowResponse, error)
+	// CancelMaintenanceWindow defines a method for a validator to cancel a
+	// maintenance window. A window which already started ends at the current
+	// height.
+	//
+	// Since: cosmos-sdk 0.52
+	CancelMaintenanceWindow(context.Context, *MsgCancelMaintenanceWindow) (*MsgCancelMaintenanceWindowResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -413,6 +732,12 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleMaintenanceWindow(ctx context.Context, req *MsgScheduleMaintenanceWindow) (*MsgScheduleMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenanceWindow not implemented")
+}
+func (*UnimplementedMsgServer) CancelMaintenanceWindow(ctx context.Context, req *MsgCancelMaintenanceWindow) (*MsgCancelMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenanceWindow not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -454,6 +779,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMaintenanceWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/ScheduleMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMaintenanceWindow(ctx, req.(*MsgScheduleMaintenanceWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMaintenanceWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/CancelMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMaintenanceWindow(ctx, req.(*MsgCancelMaintenanceWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -466,6 +827,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleMaintenanceWindow",
+			Handler:    _Msg_ScheduleMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "CancelMaintenanceWindow",
+			Handler:    _Msg_CancelMaintenanceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -587,37 +956,158 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleMaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUnjail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgScheduleMaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnjailResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgScheduleMaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMaintenanceWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMaintenanceWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMaintenanceWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMaintenanceWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMaintenanceWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMaintenanceWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgUpdateParams) Size() (n int) {
@@ -644,6 +1134,59 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleMaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *MsgScheduleMaintenanceWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelMaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelMaintenanceWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -947,6 +1490,327 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleMaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleMaintenanceWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMaintenanceWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMaintenanceWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelMaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelMaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelMaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelMaintenanceWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelMaintenanceWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelMaintenanceWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
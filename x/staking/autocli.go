@@ -125,6 +125,37 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "height"},
 					},
 				},
+				{
+					RpcMethod: "HistoricalInfoByChainID",
+					Use:       "historical-info-by-chain-id [chain-id] [height]",
+					Short:     "Query historical info of a chain-id at given height, including the records archived across state exports",
+					Example:   fmt.Sprintf("$ %s query staking historical-info-by-chain-id cosmoshub-3 5", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "chain_id"},
+						{ProtoField: "height"},
+					},
+				},
+				{
+					RpcMethod: "HistoricalInfos",
+					Use:       "historical-infos",
+					Short:     "Query the historical info of the heights within a range",
+					Example:   fmt.Sprintf("$ %s query staking historical-infos --min-height 5 --max-height 10", version.AppName),
+				},
+				{
+					RpcMethod: "ValidatorSetChanges",
+					Use:       "validator-set-changes",
+					Short:     "Query the changes of the bonded validator set of the heights within a range",
+					Long:      "Query the validators joining or leaving the bonded validator set, changing power, or being jailed or unjailed, at the heights within a range. The changes are kept for the HistoricalEntries most recent heights.",
+					Example:   fmt.Sprintf("$ %s query staking validator-set-changes --min-height 5 --max-height 10", version.AppName),
+				},
+				{
+					RpcMethod:      "ValidatorPerformance",
+					Use:            "validator-performance [validator-addr]",
+					Short:          "Query a validator with its liveness and jailing history",
+					Long:           "Query a validator with its liveness over the signing window, as tracked by the slashing module: its missed blocks, uptime, jail end time and whether it is tombstoned, and the heights at which it was jailed or unjailed over the last HistoricalEntries heights.",
+					Example:        fmt.Sprintf("$ %s query staking validator-performance [validator-addr]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
 				{
 					RpcMethod: "Pool",
 					Use:       "pool",
@@ -137,34 +168,73 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod:      "CommissionChangeAllowance",
+					Use:            "commission-change-allowance [validator-addr]",
+					Short:          "Query the commission rates a validator may change to now, and when it may next change them",
+					Long:           "Query the range of commission rates a validator may change to at the current block time, given its max change rate and the chain minimum commission rate, and whether the commission change cooldown since its last change has elapsed.",
+					Example:        fmt.Sprintf("$ %s query staking commission-change-allowance [val-addr]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
+				{
+					RpcMethod:      "FractionAmount",
+					Use:            "fraction-amount [delegator-addr] [fraction]",
+					Short:          "Query the amount of tokens worth a fraction of a delegation or of the spendable balance",
+					Long:           "Query the amount of tokens worth a fraction of the delegation of a delegator to the validator given with --validator-addr, or of the spendable balance of the delegator in the bond denom if no validator is given. The amount is truncated.",
+					Example:        fmt.Sprintf("$ %s query staking fraction-amount [delegator-addr] 0.5 --validator-addr [val-addr]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_addr"}, {ProtoField: "fraction"}},
+				},
+				{
+					RpcMethod:      "MaturityCalendar",
+					Use:            "maturity-calendar [end-time]",
+					Short:          "Query the unbonding delegation and redelegation entries completing before a time",
+					Long:           "Query the unbonding delegation and redelegation entries completing from --start-time, or from the current block time if unset, to the given RFC 3339 end time, with their totals chain-wide and per delegator. Restrict the query to a delegator with --delegator-addr.",
+					Example:        fmt.Sprintf("$ %s query staking maturity-calendar 2024-01-31T00:00:00Z --delegator-addr [delegator-addr]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "end_time"}},
+				},
+				{
+					RpcMethod: "QueuedMsgs",
+					Use:       "queued-msgs",
+					Short:     "Query the messages queued until the end of the epoch",
+					Long:      "Query the messages changing delegations queued until the end of the epoch, when epoching is enabled, and the height of the block at the end of which they are executed.",
+				},
+				{
+					RpcMethod: "PendingCommissionChanges",
+					Use:       "pending-commission-changes",
+					Short:     "Query the commission changes pending until the end of their notice period",
+					Long:      "Query the commission rate changes announced by validators, when the commission change notice period is positive, and the time from which they take effect. The --validator-addr flag restricts the query to the change of a validator.",
+					Example:   fmt.Sprintf("$ %s query staking pending-commission-changes --validator-addr [validator-addr]", version.AppName),
+				},
+			},
+			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
+				"node": {
+					Service: stakingv1beta.Node_ServiceDesc.ServiceName,
+					RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+						{
+							RpcMethod: "ShareAudit",
+							Use:       "share-audit",
+							Short:     "Query the delegation share math divergences recorded by the node share audit mode",
+							Long:      "Query the delegation share computations that diverged from their exact results, as recorded by the share audit mode of the queried node. The result is local to the node.",
+						},
+					},
+				},
 			},
+			EnhanceCustomCommand: true,
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: stakingv1beta.Msg_ServiceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
-					RpcMethod:      "Delegate",
-					Use:            "delegate [validator-addr] [amount] --from [delegator_address]",
-					Short:          "Delegate liquid tokens to a validator",
-					Long:           "Delegate an amount of liquid coins to a validator from your wallet.",
-					Example:        fmt.Sprintf("%s tx staking delegate cosmosvaloper... 1000stake --from mykey", version.AppName),
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+					RpcMethod: "Delegate",
+					Skip:      true, // skipped because of the custom command resolving fractions
 				},
 				{
-					RpcMethod:      "BeginRedelegate",
-					Use:            "redelegate [src-validator-addr] [dst-validator-addr] [amount] --from [delegator]",
-					Short:          "Generate multisig signatures for transactions generated offline",
-					Long:           "Redelegate an amount of illiquid staking tokens from one validator to another.",
-					Example:        fmt.Sprintf(`%s tx staking redelegate cosmosvaloper... cosmosvaloper... 100stake --from mykey`, version.AppName),
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_src_address"}, {ProtoField: "validator_dst_address"}, {ProtoField: "amount"}},
+					RpcMethod: "BeginRedelegate",
+					Skip:      true, // skipped because of the custom command resolving fractions
 				},
 				{
-					RpcMethod:      "Undelegate",
-					Use:            "unbond [validator-addr] [amount] --from [delegator_address]",
-					Short:          "Unbond shares from a validator",
-					Long:           "Unbond an amount of bonded shares from a validator.",
-					Example:        fmt.Sprintf(`%s tx staking unbond cosmosvaloper... 100stake --from mykey`, version.AppName),
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+					RpcMethod: "Undelegate",
+					Skip:      true, // skipped because of the custom command resolving fractions
 				},
 				{
 					RpcMethod:      "CancelUnbondingDelegation",
@@ -189,6 +259,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "TransferDelegation",
+					Use:            "transfer-delegation-proposal [delegator-addr] [recipient-addr] [validator-addr] [amount]",
+					Short:          "Submit a proposal to transfer a delegation to another account without unbonding it",
+					Example:        fmt.Sprintf(`%s tx staking transfer-delegation-proposal cosmos1... cosmos1... cosmosvaloper... 100stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}, {ProtoField: "recipient_address"}, {ProtoField: "validator_address"}, {ProtoField: "amount"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "TransferUnbonding",
+					Use:            "transfer-unbonding-proposal [delegator-addr] [recipient-addr] [validator-addr] [amount]",
+					Short:          "Submit a proposal to transfer the unbonding delegation entries of a delegator to another account",
+					Example:        fmt.Sprintf(`%s tx staking transfer-unbonding-proposal cosmos1... cosmos1... cosmosvaloper... 100stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}, {ProtoField: "recipient_address"}, {ProtoField: "validator_address"}, {ProtoField: "amount"}},
+					GovProposal:    true,
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
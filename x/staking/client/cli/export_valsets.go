@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
+	FlagOutputDir  = "output-dir"
+	FlagFormat     = "format"
+
+	// ValsetFormatJSON writes the historical info entries as JSON files.
+	ValsetFormatJSON = "json"
+	// ValsetFormatProto writes the historical info entries as protobuf binary files.
+	ValsetFormatProto = "proto"
+)
+
+// ExportValsetsCmd returns a CLI command writing the historical info entries
+// of a range of heights, one file per height, so that the validator sets of
+// past blocks can be analysed offline.
+func ExportValsetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-valsets",
+		Short: "Export the historical info entries of a range of heights to JSON or proto files",
+		Long: strings.TrimSpace(`Export the historical info entries tracked by the staking module for the heights within
+--from-height and --to-height, each to a "valset-<height>.json" or "valset-<height>.pb" file of the
+output directory. An entry holds the app hash, the time and the validator set hash of its block.
+
+The entries are fetched page by page and written as they are received. Only the heights kept by the
+queried node, as set by the historical_entries param, are exported. A zero --to-height leaves the
+range unbounded.`),
+		Example: fmt.Sprintf(`$ %[1]s export-valsets --from-height 1000 --to-height 2000 --output-dir ./valsets
+$ %[1]s export-valsets --from-height 1000 --format proto`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, _ := cmd.Flags().GetInt64(FlagFromHeight)
+			toHeight, _ := cmd.Flags().GetInt64(FlagToHeight)
+			outputDir, _ := cmd.Flags().GetString(FlagOutputDir)
+			format, _ := cmd.Flags().GetString(FlagFormat)
+			if format != ValsetFormatJSON && format != ValsetFormatProto {
+				return fmt.Errorf("invalid format %q, expected %q or %q", format, ValsetFormatJSON, ValsetFormatProto)
+			}
+
+			if err := os.MkdirAll(outputDir, 0o755); err != nil {
+				return err
+			}
+
+			var exported int
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq := &query.PageRequest{}
+			for {
+				res, err := queryClient.HistoricalInfos(cmd.Context(), &types.QueryHistoricalInfosRequest{
+					MinHeight:  fromHeight,
+					MaxHeight:  toHeight,
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+
+				for _, entry := range res.HistoricalInfos {
+					if err := WriteHistoricalInfoEntry(clientCtx.Codec, outputDir, format, entry); err != nil {
+						return err
+					}
+				}
+				exported += len(res.HistoricalInfos)
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("exported %d historical info entries to %s\n", exported, outputDir))
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "The lowest height to export")
+	cmd.Flags().Int64(FlagToHeight, 0, "The highest height to export, unbounded if zero")
+	cmd.Flags().String(FlagOutputDir, ".", "The directory the entries are written to")
+	cmd.Flags().String(FlagFormat, ValsetFormatJSON, fmt.Sprintf("The format of the files, %q or %q", ValsetFormatJSON, ValsetFormatProto))
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// WriteHistoricalInfoEntry writes the historical info entry to the
+// "valset-<height>" file of the directory, in the JSON or proto format.
+func WriteHistoricalInfoEntry(cdc codec.Codec, dir, format string, entry types.HistoricalInfoEntry) error {
+	var (
+		bz  []byte
+		ext string
+		err error
+	)
+	switch format {
+	case ValsetFormatJSON:
+		bz, err = cdc.MarshalJSON(&entry)
+		ext = "json"
+	case ValsetFormatProto:
+		bz, err = cdc.Marshal(&entry)
+		ext = "pb"
+	default:
+		return fmt.Errorf("invalid format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, fmt.Sprintf("valset-%d.%s", entry.Height, ext)), bz, 0o600)
+}
@@ -0,0 +1,46 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/staking/client/cli"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func TestWriteHistoricalInfoEntry(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dir := t.TempDir()
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	entry := types.HistoricalInfoEntry{
+		Height: 42,
+		HistoricalRecord: types.HistoricalRecord{
+			Apphash:        []byte("apphash"),
+			Time:           &blockTime,
+			ValidatorsHash: []byte("validatorshash"),
+		},
+	}
+
+	require.NoError(t, cli.WriteHistoricalInfoEntry(cdc, dir, cli.ValsetFormatJSON, entry))
+	bz, err := os.ReadFile(filepath.Join(dir, "valset-42.json"))
+	require.NoError(t, err)
+	var fromJSON types.HistoricalInfoEntry
+	require.NoError(t, cdc.UnmarshalJSON(bz, &fromJSON))
+	require.Equal(t, entry, fromJSON)
+
+	require.NoError(t, cli.WriteHistoricalInfoEntry(cdc, dir, cli.ValsetFormatProto, entry))
+	bz, err = os.ReadFile(filepath.Join(dir, "valset-42.pb"))
+	require.NoError(t, err)
+	var fromProto types.HistoricalInfoEntry
+	require.NoError(t, cdc.Unmarshal(bz, &fromProto))
+	require.Equal(t, entry, fromProto)
+
+	require.ErrorContains(t, cli.WriteHistoricalInfoEntry(cdc, dir, "yaml", entry), "invalid format")
+}
@@ -14,6 +14,8 @@ const (
 	FlagAmount              = "amount"
 	FlagSharesAmount        = "shares-amount"
 	FlagSharesFraction      = "shares-fraction"
+	FlagFraction            = "fraction"
+	FlagPercent             = "percent"
 
 	FlagMoniker         = "moniker"
 	FlagEditMoniker     = "new-moniker"
@@ -49,6 +51,15 @@ func init() {
 	fsRedelegation.String(FlagAddressValidatorDst, "", "The address of the destination validator")
 }
 
+// FlagSetFraction Returns the FlagSet used to give an amount as a fraction of a
+// delegation or balance.
+func FlagSetFraction() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagFraction, "", "Fraction >0 and <=1 of the delegation or balance to use as amount, resolved by the queried node")
+	fs.String(FlagPercent, "", "Percentage >0 and <=100 of the delegation or balance to use as amount, resolved by the queried node")
+	return fs
+}
+
 // FlagSetCommissionCreate Returns the FlagSet used for commission create.
 func FlagSetCommissionCreate() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// GetQueryCmd returns the query commands for the staking module. The commands
+// of the query service are added by autocli.
+func GetQueryCmd() *cobra.Command {
+	stakingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the staking module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	stakingQueryCmd.AddCommand(
+		GetGenesisValidatorsCmd(),
+	)
+
+	return stakingQueryCmd
+}
+
+// GetGenesisValidatorsCmd returns a CLI command printing the bonded validator
+// set in the format of the validators of a CometBFT genesis file.
+func GetGenesisValidatorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis-validators",
+		Short: "Query the bonded validator set in the CometBFT genesis validators format",
+		Long: strings.TrimSpace(`Query the bonded validator set in the format of the "validators" field of a
+CometBFT genesis file, with the consensus public keys and voting powers of the validators.
+
+The set is the one computed by the staking module at the end of the queried block. Use --height
+to export the set of a past block: historical info records only keep the hash of the validator
+set, so the queried node must still have the state of that height.`),
+		Example: fmt.Sprintf(`$ %[1]s query staking genesis-validators
+$ %[1]s query staking genesis-validators --height 1000`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var validators []types.Validator
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq := &query.PageRequest{}
+			for {
+				res, err := queryClient.Validators(cmd.Context(), &types.QueryValidatorsRequest{
+					Status:     types.BondStatusBonded,
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+
+				validators = append(validators, res.Validators...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			genVals, err := GenesisValidators(validators, sdk.DefaultPowerReduction)
+			if err != nil {
+				return err
+			}
+
+			bz, err := cmtjson.MarshalIndent(genVals, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GenesisValidators returns the validators with a voting power in the format of
+// the validators of a CometBFT genesis file, sorted as in a CometBFT validator
+// set: by decreasing voting power, then by address.
+func GenesisValidators(validators []types.Validator, powerReduction math.Int) ([]cmttypes.GenesisValidator, error) {
+	genVals := make([]cmttypes.GenesisValidator, 0, len(validators))
+	for _, val := range validators {
+		genVal, err := val.GenesisValidator(powerReduction)
+		if err != nil {
+			return nil, err
+		}
+
+		// validators with less than a unit of power are not part of the set
+		if genVal.Power == 0 {
+			continue
+		}
+
+		genVals = append(genVals, genVal)
+	}
+
+	sort.SliceStable(genVals, func(i, j int) bool {
+		if genVals[i].Power != genVals[j].Power {
+			return genVals[i].Power > genVals[j].Power
+		}
+		return bytes.Compare(genVals[i].Address, genVals[j].Address) < 0
+	})
+
+	return genVals, nil
+}
@@ -0,0 +1,41 @@
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/client/cli"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisValidators(t *testing.T) {
+	newValidator := func(i int, power int64) types.Validator {
+		val, err := types.NewValidator(sdk.ValAddress(PKs[i].Address()).String(), PKs[i], types.Description{Moniker: "val"})
+		require.NoError(t, err)
+		val.Status = types.Bonded
+		val.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+		return val
+	}
+
+	validators := []types.Validator{newValidator(0, 10), newValidator(1, 30), newValidator(2, 10), newValidator(3, 0)}
+	validators[3].Tokens = math.NewInt(1)
+
+	genVals, err := cli.GenesisValidators(validators, sdk.DefaultPowerReduction)
+	require.NoError(t, err)
+
+	// the validator without voting power is dropped, the others are sorted by
+	// power then address
+	require.Len(t, genVals, 3)
+	require.Equal(t, int64(30), genVals[0].Power)
+	require.Equal(t, PKs[1].Address().Bytes(), genVals[0].Address.Bytes())
+	require.Equal(t, PKs[1].Bytes(), genVals[0].PubKey.Bytes())
+	require.Equal(t, "val", genVals[0].Name)
+	require.Equal(t, int64(10), genVals[1].Power)
+	require.Equal(t, int64(10), genVals[2].Power)
+	require.Negative(t, bytes.Compare(genVals[1].Address, genVals[2].Address))
+}
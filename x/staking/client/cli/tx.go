@@ -45,6 +45,10 @@ func NewTxCmd() *cobra.Command {
 	stakingTxCmd.AddCommand(
 		NewCreateValidatorCmd(),
 		NewEditValidatorCmd(),
+		NewDelegateCmd(),
+		NewRedelegateCmd(),
+		NewUnbondCmd(),
+		NewDelegateMultiCmd(),
 	)
 
 	return stakingTxCmd
@@ -174,6 +178,253 @@ func NewEditValidatorCmd() *cobra.Command {
 	return cmd
 }
 
+// NewDelegateCmd returns a CLI command handler for creating a MsgDelegate
+// transaction, with the amount optionally given as a fraction of the spendable
+// balance.
+func NewDelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate [validator-addr] [amount]",
+		Short: "Delegate liquid tokens to a validator",
+		Long: `Delegate an amount of liquid coins to a validator from your wallet.
+The amount can be given instead as a fraction or percentage of your spendable balance in the bond denom.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+$ %[1]s tx staking delegate cosmosvaloper... 1000stake --from mykey
+$ %[1]s tx staking delegate cosmosvaloper... --percent 50 --from mykey
+`, version.AppName),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			amount, err := amountFromArgs(cmd, clientCtx, args[1:], delAddr, "")
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegate(delAddr, args[0], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetFraction())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRedelegateCmd returns a CLI command handler for creating a
+// MsgBeginRedelegate transaction, with the amount optionally given as a
+// fraction of the delegation to the source validator.
+func NewRedelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegate [src-validator-addr] [dst-validator-addr] [amount]",
+		Short: "Redelegate illiquid tokens from one validator to another",
+		Long: `Redelegate an amount of illiquid staking tokens from one validator to another.
+The amount can be given instead as a fraction or percentage of your delegation to the source validator.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+$ %[1]s tx staking redelegate cosmosvaloper... cosmosvaloper... 100stake --from mykey
+$ %[1]s tx staking redelegate cosmosvaloper... cosmosvaloper... --fraction 0.25 --from mykey
+`, version.AppName),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			amount, err := amountFromArgs(cmd, clientCtx, args[2:], delAddr, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBeginRedelegate(delAddr, args[0], args[1], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetFraction())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnbondCmd returns a CLI command handler for creating a MsgUndelegate
+// transaction, with the amount optionally given as a fraction of the
+// delegation.
+func NewUnbondCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbond [validator-addr] [amount]",
+		Short: "Unbond shares from a validator",
+		Long: `Unbond an amount of bonded shares from a validator.
+The amount can be given instead as a fraction or percentage of your delegation to the validator.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+$ %[1]s tx staking unbond cosmosvaloper... 100stake --from mykey
+$ %[1]s tx staking unbond cosmosvaloper... --percent 100 --from mykey
+`, version.AppName),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			amount, err := amountFromArgs(cmd, clientCtx, args[1:], delAddr, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUndelegate(delAddr, args[0], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetFraction())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// amountFromArgs returns the amount given as optional argument, or else the
+// amount worth the --fraction or --percent flag of the delegation to the
+// validator, or of the spendable balance if the validator is empty. The amount
+// worth a fraction is resolved by the queried node, from the delegation shares.
+func amountFromArgs(cmd *cobra.Command, clientCtx client.Context, args []string, delAddr, valAddr string) (sdk.Coin, error) {
+	fractionStr, _ := cmd.Flags().GetString(FlagFraction)
+	percentStr, _ := cmd.Flags().GetString(FlagPercent)
+
+	var fraction math.LegacyDec
+	switch {
+	case fractionStr != "" && percentStr != "":
+		return sdk.Coin{}, fmt.Errorf("--%s and --%s cannot be used together", FlagFraction, FlagPercent)
+	case fractionStr != "":
+		dec, err := math.LegacyNewDecFromStr(fractionStr)
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("invalid fraction: %w", err)
+		}
+		fraction = dec
+	case percentStr != "":
+		dec, err := math.LegacyNewDecFromStr(percentStr)
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("invalid percentage: %w", err)
+		}
+		fraction = dec.QuoInt64(100)
+	default:
+		if len(args) == 0 {
+			return sdk.Coin{}, fmt.Errorf("an amount, --%s or --%s is required", FlagFraction, FlagPercent)
+		}
+		return sdk.ParseCoinNormalized(args[0])
+	}
+
+	if len(args) > 0 {
+		return sdk.Coin{}, fmt.Errorf("an amount cannot be given with --%s or --%s", FlagFraction, FlagPercent)
+	}
+	if !fraction.IsPositive() || fraction.GT(math.LegacyOneDec()) {
+		return sdk.Coin{}, fmt.Errorf("fraction must be greater than 0 and at most 1, got %s", fraction)
+	}
+
+	res, err := types.NewQueryClient(clientCtx).FractionAmount(cmd.Context(), &types.QueryFractionAmountRequest{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		Fraction:      fraction,
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !res.Amount.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("fraction %s amounts to no tokens", fraction)
+	}
+
+	return res.Amount, nil
+}
+
+// NewDelegateMultiCmd returns a CLI command handler for creating a MsgDelegateMulti transaction.
+func NewDelegateMultiCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-multi [amount] [validator-addr=weight,...]",
+		Short: "Delegate liquid tokens split across a weighted set of validators",
+		Long: `Delegate an amount of liquid coins split across a set of validators, by weights summing to 1.
+The remainder left by truncating the amounts is delegated to the first validator.`,
+		Example: fmt.Sprintf(`$ %s tx staking delegate-multi 1000stake cosmosvaloper1...=0.6,cosmosvaloper1...=0.4 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			validators, err := parseWeightedValidators(args[1])
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateMulti(delAddr, amount, validators)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseWeightedValidators parses a comma separated list of validator=weight
+// pairs.
+func parseWeightedValidators(s string) ([]types.WeightedValidator, error) {
+	var validators []types.WeightedValidator
+	for _, pair := range strings.Split(s, ",") {
+		valAddr, weightStr, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid validator weight %q, expected validator-addr=weight", pair)
+		}
+
+		weight, err := math.LegacyNewDecFromStr(weightStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of validator %s: %w", valAddr, err)
+		}
+
+		validators = append(validators, types.NewWeightedValidator(valAddr, weight))
+	}
+
+	return validators, nil
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet, val validator, valAc address.Codec) (tx.Factory, *types.MsgCreateValidator, error) {
 	valAddr := clientCtx.GetFromAddress()
 
@@ -3,16 +3,19 @@ package cli_test
 import (
 	"fmt"
 	"io"
+	"strings"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/suite"
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/staking"
 	"cosmossdk.io/x/staking/client/cli"
+	"cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -438,3 +441,165 @@ func (s *CLITestSuite) TestNewEditValidatorCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestNewDelegateMultiCmd() {
+	cmd := cli.NewDelegateMultiCmd()
+	val1, val2 := sdk.ValAddress(s.addrs[1]).String(), sdk.ValAddress(s.addrs[2]).String()
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+	}{
+		{
+			"invalid amount",
+			[]string{
+				"stake",
+				fmt.Sprintf("%s=0.5,%s=0.5", val1, val2),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			},
+			"failed to parse decimal coin amount",
+		},
+		{
+			"missing weight",
+			[]string{
+				"1000stake",
+				fmt.Sprintf("%s=0.5,%s", val1, val2),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			},
+			"expected validator-addr=weight",
+		},
+		{
+			"invalid weight",
+			[]string{
+				"1000stake",
+				fmt.Sprintf("%s=0.5,%s=half", val1, val2),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+			},
+			"invalid weight of validator",
+		},
+		{
+			"valid transaction",
+			[]string{
+				"1000stake",
+				fmt.Sprintf("%s=0.6,%s=0.4", val1, val2),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))).String()),
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+				resp := &sdk.TxResponse{}
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), resp))
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestFractionAmountCmds() {
+	val1, val2 := sdk.ValAddress(s.addrs[1]).String(), sdk.ValAddress(s.addrs[2]).String()
+
+	// the node resolves every fraction to 27stake
+	bz, err := s.encCfg.Codec.Marshal(&types.QueryFractionAmountResponse{Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 27)})
+	s.Require().NoError(err)
+	clientCtx := s.baseCtx.WithClient(clitestutil.NewMockCometRPC(abci.ResponseQuery{Value: bz}))
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErrMsg string
+		expectAmount string
+	}{
+		{
+			"delegate without amount",
+			cli.NewDelegateCmd(),
+			[]string{val1},
+			"an amount, --fraction or --percent is required",
+			"",
+		},
+		{
+			"delegate with amount and fraction",
+			cli.NewDelegateCmd(),
+			[]string{val1, "10stake", fmt.Sprintf("--%s=0.5", cli.FlagFraction)},
+			"an amount cannot be given with --fraction or --percent",
+			"",
+		},
+		{
+			"unbond with fraction and percent",
+			cli.NewUnbondCmd(),
+			[]string{val1, fmt.Sprintf("--%s=0.5", cli.FlagFraction), fmt.Sprintf("--%s=50", cli.FlagPercent)},
+			"cannot be used together",
+			"",
+		},
+		{
+			"unbond more than the delegation",
+			cli.NewUnbondCmd(),
+			[]string{val1, fmt.Sprintf("--%s=101", cli.FlagPercent)},
+			"fraction must be greater than 0 and at most 1",
+			"",
+		},
+		{
+			"delegate amount",
+			cli.NewDelegateCmd(),
+			[]string{val1, "10stake"},
+			"",
+			"10",
+		},
+		{
+			"delegate fraction of the balance",
+			cli.NewDelegateCmd(),
+			[]string{val1, fmt.Sprintf("--%s=0.5", cli.FlagFraction)},
+			"",
+			"27",
+		},
+		{
+			"unbond percentage of the delegation",
+			cli.NewUnbondCmd(),
+			[]string{val1, fmt.Sprintf("--%s=30", cli.FlagPercent)},
+			"",
+			"27",
+		},
+		{
+			"redelegate fraction of the delegation",
+			cli.NewRedelegateCmd(),
+			[]string{val1, val2, fmt.Sprintf("--%s=0.3", cli.FlagFraction)},
+			"",
+			"27",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, append(tc.args, txFlags...))
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+				// the JSON output is compacted, its whitespace being unstable
+				s.Require().Contains(strings.Join(strings.Fields(out.String()), ""), fmt.Sprintf(`"amount":{"denom":"stake","amount":"%s"}`, tc.expectAmount))
+			}
+		})
+	}
+}
@@ -31,6 +31,8 @@ func init() {
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
 		appconfig.Invoke(InvokeSetStakingHooks),
+		appconfig.Invoke(InvokeSetSlashedTokensHandler),
+		appconfig.Invoke(InvokeSetValidatorLivenessProvider),
 	)
 }
 
@@ -118,6 +120,50 @@ func InvokeSetStakingHooks(
 	return nil
 }
 
+func InvokeSetSlashedTokensHandler(
+	keeper *keeper.Keeper,
+	handlers map[string]types.SlashedTokensHandlerWrapper,
+) error {
+	// all arguments to invokers are optional
+	if keeper == nil || len(handlers) == 0 {
+		return nil
+	}
+
+	if len(handlers) > 1 {
+		modNames := maps.Keys(handlers)
+		sort.Strings(modNames)
+		return fmt.Errorf("only one module can handle slashed tokens, got %v", modNames)
+	}
+
+	for _, handler := range handlers {
+		keeper.SetSlashedTokensHandler(handler)
+	}
+
+	return nil
+}
+
+func InvokeSetValidatorLivenessProvider(
+	keeper *keeper.Keeper,
+	providers map[string]types.ValidatorLivenessProviderWrapper,
+) error {
+	// all arguments to invokers are optional
+	if keeper == nil || len(providers) == 0 {
+		return nil
+	}
+
+	if len(providers) > 1 {
+		modNames := maps.Keys(providers)
+		sort.Strings(modNames)
+		return fmt.Errorf("only one module can provide the liveness of validators, got %v", modNames)
+	}
+
+	for _, provider := range providers {
+		keeper.SetValidatorLivenessProvider(provider)
+	}
+
+	return nil
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the staking module.
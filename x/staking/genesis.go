@@ -6,10 +6,10 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
-	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,23 +21,13 @@ func WriteValidators(ctx sdk.Context, keeper *keeper.Keeper) (vals []cmttypes.Ge
 			return true, err
 		}
 
-		pk, err := validator.ConsPubKey()
-		if err != nil {
-			returnErr = err
-			return true, err
-		}
-		cmtPk, err := cryptocodec.ToCmtPubKeyInterface(pk)
+		genVal, err := validator.GenesisValidator(keeper.PowerReduction(ctx))
 		if err != nil {
 			returnErr = err
 			return true, err
 		}
 
-		vals = append(vals, cmttypes.GenesisValidator{
-			Address: sdk.ConsAddress(cmtPk.Address()).Bytes(),
-			PubKey:  cmtPk,
-			Power:   validator.GetConsensusPower(keeper.PowerReduction(ctx)),
-			Name:    validator.GetMoniker(),
-		})
+		vals = append(vals, genVal)
 
 		return false, nil
 	})
@@ -55,6 +45,18 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateArchivedHistoricalRecords(data.ArchivedHistoricalRecords); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateQueuedMsgs(data.QueuedMsgs); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStatePendingCommissionChanges(data.PendingCommissionChanges); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -95,3 +97,62 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateArchivedHistoricalRecords(records []types.ArchivedHistoricalRecord) error {
+	seen := make(map[string]map[int64]bool)
+	for _, record := range records {
+		if record.ChainId == "" {
+			return fmt.Errorf("archived historical record at height %d has an empty chain-id", record.Height)
+		}
+
+		if record.Height <= 0 {
+			return fmt.Errorf("archived historical record of chain %s has a non-positive height %d", record.ChainId, record.Height)
+		}
+
+		if seen[record.ChainId] == nil {
+			seen[record.ChainId] = make(map[int64]bool)
+		}
+		if seen[record.ChainId][record.Height] {
+			return fmt.Errorf("duplicate archived historical record of chain %s at height %d", record.ChainId, record.Height)
+		}
+		seen[record.ChainId][record.Height] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateQueuedMsgs(msgs []types.QueuedMsg) error {
+	seen := make(map[uint64]bool, len(msgs))
+	for _, msg := range msgs {
+		if msg.Msg == nil {
+			return fmt.Errorf("queued message %d is empty", msg.Id)
+		}
+
+		if seen[msg.Id] {
+			return fmt.Errorf("duplicate queued message %d", msg.Id)
+		}
+		seen[msg.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStatePendingCommissionChanges(changes []types.PendingCommissionChange) error {
+	seen := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		if change.Rate.IsNil() || change.Rate.IsNegative() || change.Rate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid pending commission rate of validator %s: %s", change.ValidatorAddress, change.Rate)
+		}
+
+		if change.EffectiveTime.Before(change.AnnounceTime) {
+			return fmt.Errorf("pending commission change of validator %s takes effect before its announcement", change.ValidatorAddress)
+		}
+
+		if seen[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate pending commission change of validator %s", change.ValidatorAddress)
+		}
+		seen[change.ValidatorAddress] = true
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package staking_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -41,6 +42,56 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate archived historical records
+		{"archived historical records", func(data *types.GenesisState) {
+			data.ArchivedHistoricalRecords = []types.ArchivedHistoricalRecord{
+				{ChainId: "chain-1", Height: 5},
+				{ChainId: "chain-2", Height: 5},
+			}
+		}, false},
+		{"archived historical record without chain-id", func(data *types.GenesisState) {
+			data.ArchivedHistoricalRecords = []types.ArchivedHistoricalRecord{{Height: 5}}
+		}, true},
+		{"archived historical record with non-positive height", func(data *types.GenesisState) {
+			data.ArchivedHistoricalRecords = []types.ArchivedHistoricalRecord{{ChainId: "chain-1"}}
+		}, true},
+		{"duplicate archived historical record", func(data *types.GenesisState) {
+			data.ArchivedHistoricalRecords = []types.ArchivedHistoricalRecord{
+				{ChainId: "chain-1", Height: 5},
+				{ChainId: "chain-1", Height: 5},
+			}
+		}, true},
+		// validate queued messages
+		{"queued messages", func(data *types.GenesisState) {
+			data.QueuedMsgs = []types.QueuedMsg{queuedMsg(t, 0), queuedMsg(t, 1)}
+		}, false},
+		{"empty queued message", func(data *types.GenesisState) {
+			data.QueuedMsgs = []types.QueuedMsg{{Id: 0, Height: 5}}
+		}, true},
+		{"duplicate queued message", func(data *types.GenesisState) {
+			data.QueuedMsgs = []types.QueuedMsg{queuedMsg(t, 0), queuedMsg(t, 0)}
+		}, true},
+		// validate pending commission changes
+		{"pending commission changes", func(data *types.GenesisState) {
+			data.PendingCommissionChanges = []types.PendingCommissionChange{
+				{ValidatorAddress: "val1", Rate: math.LegacyNewDecWithPrec(1, 1), EffectiveTime: time.Unix(10, 0)},
+				{ValidatorAddress: "val2", Rate: math.LegacyNewDecWithPrec(1, 1), EffectiveTime: time.Unix(10, 0)},
+			}
+		}, false},
+		{"pending commission change with invalid rate", func(data *types.GenesisState) {
+			data.PendingCommissionChanges = []types.PendingCommissionChange{{ValidatorAddress: "val1", Rate: math.LegacyNewDec(2)}}
+		}, true},
+		{"pending commission change effective before its announcement", func(data *types.GenesisState) {
+			data.PendingCommissionChanges = []types.PendingCommissionChange{
+				{ValidatorAddress: "val1", Rate: math.LegacyNewDecWithPrec(1, 1), AnnounceTime: time.Unix(10, 0), EffectiveTime: time.Unix(5, 0)},
+			}
+		}, true},
+		{"duplicate pending commission change", func(data *types.GenesisState) {
+			data.PendingCommissionChanges = []types.PendingCommissionChange{
+				{ValidatorAddress: "val1", Rate: math.LegacyNewDecWithPrec(1, 1)},
+				{ValidatorAddress: "val1", Rate: math.LegacyNewDecWithPrec(2, 1)},
+			}
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -58,3 +109,10 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func queuedMsg(t *testing.T, id uint64) types.QueuedMsg {
+	t.Helper()
+	msg, err := types.NewQueuedMsg(id, 5, &types.MsgDelegate{Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)})
+	assert.NoError(t, err)
+	return msg
+}
@@ -17,8 +17,19 @@ func (k *Keeper) BeginBlocker(ctx context.Context) error {
 	return k.TrackHistoricalInfo(ctx)
 }
 
-// EndBlocker called at every block, update validator set
+// EndBlocker called at every block, executes the messages queued until the end
+// of the epoch, if it ends, applies the commission changes whose notice period
+// elapsed and updates the validator set
 func (k *Keeper) EndBlocker(ctx context.Context) ([]module.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.ExecuteEpochMsgs(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.ApplyPendingCommissionChanges(ctx); err != nil {
+		return nil, err
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetAllPendingCommissionChanges returns the commission changes pending until
+// their effective time, by validator address.
+func (k Keeper) GetAllPendingCommissionChanges(ctx context.Context) ([]types.PendingCommissionChange, error) {
+	iter, err := k.PendingCommissionChanges.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// queueCommissionChange queues the change of the commission rate of validator
+// to newRate until the end of the notice period, replacing the change already
+// pending if any. The new rate is validated against the current commission
+// when it is announced.
+func (k Keeper) queueCommissionChange(ctx context.Context, validator types.Validator, newRate math.LegacyDec, noticePeriod time.Duration) error {
+	if _, err := k.UpdateValidatorCommission(ctx, validator, newRate); err != nil {
+		return err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	change := types.PendingCommissionChange{
+		ValidatorAddress: validator.GetOperator(),
+		Rate:             newRate,
+		AnnounceTime:     blockTime,
+		EffectiveTime:    blockTime.Add(noticePeriod),
+	}
+	if err := k.PendingCommissionChanges.Set(ctx, valAddr, change); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeQueueCommissionChange,
+		event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+		event.NewAttribute(types.AttributeKeyEffectiveTime, change.EffectiveTime.Format(time.RFC3339)),
+	)
+}
+
+// ApplyPendingCommissionChanges applies the pending commission changes whose
+// effective time is reached. A rate below the current minimum commission rate
+// is raised to it, and the changes of the validators removed in the meantime
+// are discarded.
+func (k Keeper) ApplyPendingCommissionChanges(ctx context.Context) error {
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+
+	var due []collections.KeyValue[sdk.ValAddress, types.PendingCommissionChange]
+	err := k.PendingCommissionChanges.Walk(ctx, nil, func(valAddr sdk.ValAddress, change types.PendingCommissionChange) (bool, error) {
+		if !change.EffectiveTime.After(blockTime) {
+			due = append(due, collections.KeyValue[sdk.ValAddress, types.PendingCommissionChange]{Key: valAddr, Value: change})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(due) == 0 {
+		return nil
+	}
+
+	minCommissionRate, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range due {
+		valAddr, change := kv.Key, kv.Value
+		if err := k.PendingCommissionChanges.Remove(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if errors.Is(err, types.ErrNoValidatorFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator.Commission.Rate = math.LegacyMaxDec(change.Rate, minCommissionRate)
+		validator.Commission.UpdateTime = blockTime
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeCommissionChange,
+			event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyCommissionRate, validator.Commission.Rate.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestPendingCommissionChanges() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.CommissionChangeNoticePeriod = 7 * 24 * time.Hour
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	pk := ed25519.GenPrivKey().PubKey()
+	comm := types.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1))
+	createValidator, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createValidator)
+	require.NoError(err)
+
+	editValidator := func(ctx sdk.Context, rate math.LegacyDec) error {
+		_, err := msgServer.EditValidator(ctx, &types.MsgEditValidator{
+			Description:      types.Description{Moniker: "NewVal"},
+			ValidatorAddress: s.valAddressToString(ValAddr),
+			CommissionRate:   &rate,
+		})
+		return err
+	}
+
+	// the changes are validated against the current commission when announced
+	announceTime := ctx.HeaderInfo().Time.Add(params.CommissionChangeCooldown)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: announceTime}).WithEventManager(sdk.NewEventManager())
+	require.ErrorIs(editValidator(ctx, math.LegacyNewDecWithPrec(3, 1)), types.ErrCommissionGTMaxChangeRate)
+
+	// an announced change is pending until the end of the notice period
+	require.NoError(editValidator(ctx, math.LegacyNewDecWithPrec(2, 1)))
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	var queued []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeQueueCommissionChange {
+			queued = append(queued, event)
+		}
+	}
+	require.Len(queued, 1)
+
+	res, err := stakingkeeper.NewQuerier(keeper).PendingCommissionChanges(ctx, &types.QueryPendingCommissionChangesRequest{})
+	require.NoError(err)
+	expected := types.PendingCommissionChange{
+		ValidatorAddress: s.valAddressToString(ValAddr),
+		Rate:             math.LegacyNewDecWithPrec(2, 1),
+		AnnounceTime:     announceTime,
+		EffectiveTime:    announceTime.Add(params.CommissionChangeNoticePeriod),
+	}
+	require.Equal([]types.PendingCommissionChange{expected}, res.PendingCommissionChanges)
+
+	res, err = stakingkeeper.NewQuerier(keeper).PendingCommissionChanges(ctx, &types.QueryPendingCommissionChangesRequest{ValidatorAddr: s.valAddressToString(PKS[1].Address())})
+	require.NoError(err)
+	require.Empty(res.PendingCommissionChanges)
+
+	// the changes are kept until their effective time
+	ctx = ctx.WithHeaderInfo(header.Info{Time: expected.EffectiveTime.Add(-time.Second)})
+	require.NoError(keeper.ApplyPendingCommissionChanges(ctx))
+	changes, err := keeper.GetAllPendingCommissionChanges(ctx)
+	require.NoError(err)
+	require.Len(changes, 1)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: expected.EffectiveTime}).WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.ApplyPendingCommissionChanges(ctx))
+	changes, err = keeper.GetAllPendingCommissionChanges(ctx)
+	require.NoError(err)
+	require.Empty(changes)
+
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), validator.Commission.Rate)
+	require.Equal(expected.EffectiveTime, validator.Commission.UpdateTime)
+	require.Len(ctx.EventManager().Events(), 1)
+	require.Equal(types.EventTypeCommissionChange, ctx.EventManager().Events()[0].Type)
+
+	// the rate is raised to the min commission rate set in the meantime
+	ctx = ctx.WithHeaderInfo(header.Info{Time: expected.EffectiveTime.Add(params.CommissionChangeCooldown)})
+	require.NoError(editValidator(ctx, math.LegacyNewDecWithPrec(1, 1)))
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(15, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: ctx.HeaderInfo().Time.Add(params.CommissionChangeNoticePeriod)})
+	require.NoError(keeper.ApplyPendingCommissionChanges(ctx))
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(15, 2), validator.Commission.Rate)
+
+	// disabling the notice period applies the changes right away
+	params.CommissionChangeNoticePeriod = 0
+	require.NoError(keeper.Params.Set(ctx, params))
+	ctx = ctx.WithHeaderInfo(header.Info{Time: ctx.HeaderInfo().Time.Add(params.CommissionChangeCooldown)})
+	require.NoError(editValidator(ctx, math.LegacyNewDecWithPrec(2, 1)))
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), validator.Commission.Rate)
+}
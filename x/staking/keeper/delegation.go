@@ -57,25 +57,23 @@ func (k Keeper) GetValidatorDelegations(ctx context.Context, valAddr sdk.ValAddr
 
 // GetDelegatorDelegations returns a given amount of all the delegations from a
 // delegator.
+//
+// Deprecated: the delegations beyond maxRetrieve are silently dropped. Use
+// IterateDelegatorDelegations or GetAllDelegatorDelegations instead.
 func (k Keeper) GetDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]types.Delegation, error) {
-	delegations := make([]types.Delegation, maxRetrieve)
-
-	var i uint16
-	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delegator)
-	err := k.Delegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], del types.Delegation) (stop bool, err error) {
-		if i >= maxRetrieve {
-			return true, nil
+	var delegations []types.Delegation
+	err := k.IterateDelegatorDelegations(ctx, delegator, func(delegation types.Delegation) bool {
+		if len(delegations) >= int(maxRetrieve) {
+			return true
 		}
-		delegations[i] = del
-		i++
-
-		return false, nil
+		delegations = append(delegations, delegation)
+		return false
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return delegations[:i], nil // trim if the array length < maxRetrieve
+	return delegations, nil
 }
 
 // SetDelegation sets a delegation.
@@ -125,29 +123,23 @@ func (k Keeper) RemoveDelegation(ctx context.Context, delegation types.Delegatio
 }
 
 // GetUnbondingDelegations returns a given amount of all the delegator unbonding-delegations.
+//
+// Deprecated: the unbonding delegations beyond maxRetrieve are silently
+// dropped. Use IterateDelegatorUnbondingDelegations or
+// GetAllUnbondingDelegations instead.
 func (k Keeper) GetUnbondingDelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) (unbondingDelegations []types.UnbondingDelegation, err error) {
-	unbondingDelegations = make([]types.UnbondingDelegation, maxRetrieve)
-
-	i := 0
-	rng := collections.NewPrefixedPairRange[[]byte, []byte](delegator)
-	err = k.UnbondingDelegations.Walk(
-		ctx,
-		rng,
-		func(key collections.Pair[[]byte, []byte], value types.UnbondingDelegation) (stop bool, err error) {
-			unbondingDelegations = append(unbondingDelegations, value)
-			i++
-
-			if i >= int(maxRetrieve) {
-				return true, nil
-			}
-			return false, nil
-		},
-	)
+	err = k.IterateDelegatorUnbondingDelegations(ctx, delegator, func(ubd types.UnbondingDelegation) bool {
+		if len(unbondingDelegations) >= int(maxRetrieve) {
+			return true
+		}
+		unbondingDelegations = append(unbondingDelegations, ubd)
+		return false
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return unbondingDelegations[:i], nil // trim if the array length < maxRetrieve
+	return unbondingDelegations, nil
 }
 
 // GetUnbondingDelegation returns a unbonding delegation.
@@ -195,20 +187,12 @@ func (k Keeper) GetUnbondingDelegationsFromValidator(ctx context.Context, valAdd
 // GetDelegatorUnbonding returns the total amount a delegator has unbonding.
 func (k Keeper) GetDelegatorUnbonding(ctx context.Context, delegator sdk.AccAddress) (math.Int, error) {
 	unbonding := math.ZeroInt()
-	rng := collections.NewPrefixedPairRange[[]byte, []byte](delegator)
-	err := k.UnbondingDelegations.Walk(
-		ctx,
-		rng,
-		func(key collections.Pair[[]byte, []byte], ubd types.UnbondingDelegation) (stop bool, err error) {
-			for _, entry := range ubd.Entries {
-				unbonding = unbonding.Add(entry.Balance)
-			}
-			return false, nil
-		},
-	)
-	if err != nil {
-		return unbonding, err
-	}
+	err := k.IterateDelegatorUnbondingDelegations(ctx, delegator, func(ubd types.UnbondingDelegation) bool {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+		return false
+	})
 	return unbonding, err
 }
 
@@ -254,6 +238,24 @@ func (k Keeper) IterateDelegatorDelegations(ctx context.Context, delegator sdk.A
 	return nil
 }
 
+// IterateDelegatorUnbondingDelegations iterates through one delegator's
+// unbonding delegations.
+func (k Keeper) IterateDelegatorUnbondingDelegations(ctx context.Context, delegator sdk.AccAddress, cb func(ubd types.UnbondingDelegation) (stop bool)) error {
+	rng := collections.NewPrefixedPairRange[[]byte, []byte](delegator)
+	return k.UnbondingDelegations.Walk(ctx, rng, func(key collections.Pair[[]byte, []byte], ubd types.UnbondingDelegation) (stop bool, err error) {
+		return cb(ubd), nil
+	})
+}
+
+// IterateDelegatorRedelegations iterates through one delegator's
+// redelegations.
+func (k Keeper) IterateDelegatorRedelegations(ctx context.Context, delegator sdk.AccAddress, cb func(red types.Redelegation) (stop bool)) error {
+	rng := collections.NewPrefixedTripleRange[[]byte, []byte, []byte](delegator)
+	return k.Redelegations.Walk(ctx, rng, func(key collections.Triple[[]byte, []byte, []byte], red types.Redelegation) (stop bool, err error) {
+		return cb(red), nil
+	})
+}
+
 // HasMaxUnbondingDelegationEntries checks if unbonding delegation has maximum number of entries.
 func (k Keeper) HasMaxUnbondingDelegationEntries(ctx context.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) (bool, error) {
 	ubd, err := k.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
@@ -417,25 +419,22 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx context.Context, currTime time.Time
 }
 
 // GetRedelegations returns a given amount of all the delegator redelegations.
+//
+// Deprecated: the redelegations beyond maxRetrieve are silently dropped. Use
+// IterateDelegatorRedelegations or GetAllRedelegations instead.
 func (k Keeper) GetRedelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) (redelegations []types.Redelegation, err error) {
-	redelegations = make([]types.Redelegation, maxRetrieve)
-
-	i := 0
-	rng := collections.NewPrefixedTripleRange[[]byte, []byte, []byte](delegator)
-	err = k.Redelegations.Walk(ctx, rng, func(key collections.Triple[[]byte, []byte, []byte], redelegation types.Redelegation) (stop bool, err error) {
-		if i >= int(maxRetrieve) {
-			return true, nil
+	err = k.IterateDelegatorRedelegations(ctx, delegator, func(red types.Redelegation) bool {
+		if len(redelegations) >= int(maxRetrieve) {
+			return true
 		}
-
-		redelegations[i] = redelegation
-		i++
-		return false, nil
+		redelegations = append(redelegations, red)
+		return false
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return redelegations[:i], nil // trim if the array length < maxRetrieve
+	return redelegations, nil
 }
 
 // GetRedelegationsFromSrcValidator returns all redelegations from a particular
@@ -782,6 +781,10 @@ func (k Keeper) Delegate(
 		return newShares, err
 	}
 
+	if err := k.checkLiquidStakingDelegation(ctx, delAddr, valbz); err != nil {
+		return newShares, err
+	}
+
 	return newShares, nil
 }
 
@@ -942,6 +945,10 @@ func (k Keeper) Undelegate(
 		return time.Time{}, math.Int{}, err
 	}
 
+	if err := k.checkValidatorBondUnbond(ctx, delAddr, valAddr); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
 	// transfer the validator tokens to the not bonded pool
 	if validator.IsBonded() {
 		err = k.bondedTokensToNotBonded(ctx, returnAmount)
@@ -1078,6 +1085,10 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, err
 	}
 
+	if err := k.checkValidatorBondUnbond(ctx, delAddr, valSrcAddr); err != nil {
+		return time.Time{}, err
+	}
+
 	if returnAmount.IsZero() {
 		return time.Time{}, types.ErrTinyRedelegationAmount
 	}
@@ -1163,6 +1174,210 @@ func (k Keeper) CompleteRedelegation(
 	return balances, nil
 }
 
+// vestingAccount is implemented by the vesting accounts, which track the
+// coins they delegate when they are delegated and undelegated through the bank
+// keeper.
+type vestingAccount interface {
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
+// checkTransferAccounts checks that the delegations of delAddr may be
+// transferred to recipientAddr. Transferring the delegations of a vesting
+// account would leave its delegated vesting and free coins tracked while the
+// coins are gone, and transferring delegations to a vesting account would
+// untrack coins it never delegated once they are undelegated, so neither may
+// be a vesting account.
+func (k Keeper) checkTransferAccounts(ctx context.Context, delAddr, recipientAddr sdk.AccAddress) error {
+	if delAddr.Equals(recipientAddr) {
+		return types.ErrTransferDelegationToSelf
+	}
+
+	for _, addr := range []sdk.AccAddress{delAddr, recipientAddr} {
+		if _, ok := k.authKeeper.GetAccount(ctx, addr).(vestingAccount); ok {
+			return errorsmod.Wrap(types.ErrTransferVestingAccount, addr.String())
+		}
+	}
+
+	return nil
+}
+
+// TransferDelegation transfers the given shares of the delegation of delAddr
+// to valAddr to recipientAddr, without unbonding them. The validator tokens and
+// shares are left unchanged. The self-delegation of a validator, delegations
+// with redelegations to the validator in progress, which could be slashed for
+// an infraction of the source validator, and the delegations of or to vesting
+// accounts cannot be transferred.
+func (k Keeper) TransferDelegation(
+	ctx context.Context, delAddr, recipientAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) error {
+	if bytes.Equal(delAddr, valAddr) {
+		return types.ErrSelfDelegationTransfer
+	}
+
+	if err := k.checkTransferAccounts(ctx, delAddr, recipientAddr); err != nil {
+		return err
+	}
+
+	if !shares.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid shares amount")
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrNoDelegatorForAddress
+	} else if err != nil {
+		return err
+	}
+
+	if delegation.Shares.LT(shares) {
+		return errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+	if hasRedelegation {
+		return types.ErrTransferRedelegatedDelegation
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	// remove the shares from the delegation of the delegator
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, delegation)
+	} else {
+		if err = k.SetDelegation(ctx, delegation); err != nil {
+			return err
+		}
+
+		err = k.Hooks().AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	// add them to the delegation of the recipient
+	recipient, err := k.Delegations.Get(ctx, collections.Join(recipientAddr, valAddr))
+	if err == nil {
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, recipientAddr, valAddr)
+	} else if errors.Is(err, collections.ErrNotFound) {
+		recipientAddrStr, err1 := k.authKeeper.AddressCodec().BytesToString(recipientAddr)
+		if err1 != nil {
+			return err1
+		}
+
+		recipient = types.NewDelegation(recipientAddrStr, validator.GetOperator(), math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, recipientAddr, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	recipient.Shares = recipient.Shares.Add(shares)
+	if err := k.SetDelegation(ctx, recipient); err != nil {
+		return err
+	}
+
+	if err := k.Hooks().AfterDelegationModified(ctx, recipientAddr, valAddr); err != nil {
+		return err
+	}
+
+	return k.checkLiquidStakingDelegation(ctx, recipientAddr, valAddr)
+}
+
+// TransferUnbonding transfers up to amount tokens of the unbonding delegation
+// of delAddr from valAddr to recipientAddr, and returns the amount transferred.
+// The entries are transferred in order, the last one possibly in part, and
+// keep their creation height and completion time, so that they can still be
+// slashed for the infractions committed before they started unbonding and
+// complete at the same time. The transfer stops at the first entry put on
+// hold, or once the unbonding delegation of the recipient has the maximum
+// number of entries. The unbonding delegations of or to vesting accounts
+// cannot be transferred.
+func (k Keeper) TransferUnbonding(
+	ctx context.Context, delAddr, recipientAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int,
+) (math.Int, error) {
+	if err := k.checkTransferAccounts(ctx, delAddr, recipientAddr); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if !amount.IsPositive() {
+		return math.ZeroInt(), errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid unbonding amount")
+	}
+
+	ubd, err := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	transferred := math.ZeroInt()
+	for i := 0; i < len(ubd.Entries) && amount.IsPositive(); i++ {
+		entry := ubd.Entries[i]
+		// the completion of an entry on hold is awaited by another module
+		if entry.OnHold() {
+			break
+		}
+
+		maxEntries, err := k.HasMaxUnbondingDelegationEntries(ctx, recipientAddr, valAddr)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+		if maxEntries {
+			break
+		}
+
+		entryAmount := math.MinInt(entry.Balance, amount)
+		if !entryAmount.IsPositive() {
+			continue
+		}
+
+		recipientUbd, err := k.SetUnbondingDelegationEntry(ctx, recipientAddr, valAddr, entry.CreationHeight, entry.CompletionTime, entryAmount)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+		if err := k.InsertUBDQueue(ctx, recipientUbd, entry.CompletionTime); err != nil {
+			return math.ZeroInt(), err
+		}
+
+		transferred = transferred.Add(entryAmount)
+		amount = amount.Sub(entryAmount)
+
+		if entryAmount.Equal(entry.Balance) {
+			ubd.RemoveEntry(int64(i))
+			i--
+			if err := k.DeleteUnbondingIndex(ctx, entry.UnbondingId); err != nil {
+				return math.ZeroInt(), err
+			}
+			continue
+		}
+
+		entry.Balance = entry.Balance.Sub(entryAmount)
+		entry.InitialBalance = entry.InitialBalance.Sub(entryAmount)
+		ubd.Entries[i] = entry
+	}
+
+	if len(ubd.Entries) == 0 {
+		err = k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		err = k.SetUnbondingDelegation(ctx, ubd)
+	}
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return transferred, nil
+}
+
 // ValidateUnbondAmount validates that a given unbond or redelegation amount is
 // valid based on upon the converted shares. If the amount is valid, the total
 // amount of respective shares is returned, otherwise an error is returned.
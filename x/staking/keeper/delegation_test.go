@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -88,6 +89,20 @@ func (s *KeeperTestSuite) TestDelegation() {
 	resBonds, err = keeper.GetDelegatorDelegations(ctx, addrDels[0], 2)
 	require.NoError(err)
 	require.Equal(2, len(resBonds))
+
+	// the iterator visits all the delegations, until stopped
+	var iterBonds []stakingtypes.Delegation
+	require.NoError(keeper.IterateDelegatorDelegations(ctx, addrDels[0], func(del stakingtypes.Delegation) bool {
+		iterBonds = append(iterBonds, del)
+		return false
+	}))
+	require.Equal([]stakingtypes.Delegation{bond1to1, bond1to2, bond1to3}, iterBonds)
+	iterBonds = nil
+	require.NoError(keeper.IterateDelegatorDelegations(ctx, addrDels[0], func(del stakingtypes.Delegation) bool {
+		iterBonds = append(iterBonds, del)
+		return true
+	}))
+	require.Equal([]stakingtypes.Delegation{bond1to1}, iterBonds)
 	resBonds, err = keeper.GetDelegatorDelegations(ctx, addrDels[1], 5)
 	require.NoError(err)
 	require.Equal(3, len(resBonds))
@@ -271,11 +286,23 @@ func (s *KeeperTestSuite) TestUnbondingDelegation() {
 
 	resUnbonds, err := keeper.GetUnbondingDelegations(ctx, delAddrs[0], 5)
 	require.NoError(err)
-	require.Equal(1, len(resUnbonds))
+	require.Equal([]stakingtypes.UnbondingDelegation{ubd}, resUnbonds)
 
 	resUnbonds, err = keeper.GetAllUnbondingDelegations(ctx, delAddrs[0])
 	require.NoError(err)
-	require.Equal(1, len(resUnbonds))
+	require.Equal([]stakingtypes.UnbondingDelegation{ubd}, resUnbonds)
+
+	// the unbonding delegations of other delegators are not returned
+	resUnbonds, err = keeper.GetAllUnbondingDelegations(ctx, bytes.Repeat([]byte{0xff}, 20))
+	require.NoError(err)
+	require.Empty(resUnbonds)
+
+	var iterUnbonds []stakingtypes.UnbondingDelegation
+	require.NoError(keeper.IterateDelegatorUnbondingDelegations(ctx, delAddrs[0], func(ubd stakingtypes.UnbondingDelegation) bool {
+		iterUnbonds = append(iterUnbonds, ubd)
+		return false
+	}))
+	require.Equal([]stakingtypes.UnbondingDelegation{ubd}, iterUnbonds)
 
 	resUnbond, err = keeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
 	require.NoError(err)
@@ -299,6 +326,68 @@ func (s *KeeperTestSuite) TestUnbondingDelegation() {
 	require.Equal(0, len(resUnbonds))
 }
 
+func (s *KeeperTestSuite) TestTransferUnbonding() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	delAddr, recipientAddr, valAddr := delAddrs[0], delAddrs[1], valAddrs[0]
+
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	completionTimes := []time.Time{time.Unix(100, 0).UTC(), time.Unix(200, 0).UTC()}
+	for i, completionTime := range completionTimes {
+		ubd, err := keeper.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, int64(i+1), completionTime, math.NewInt(10))
+		require.NoError(err)
+		require.NoError(keeper.InsertUBDQueue(ctx, ubd, completionTime))
+	}
+
+	_, err := keeper.TransferUnbonding(ctx, delAddr, delAddr, valAddr, math.NewInt(5))
+	require.ErrorIs(err, stakingtypes.ErrTransferDelegationToSelf)
+	_, err = keeper.TransferUnbonding(ctx, delAddr, recipientAddr, valAddr, math.ZeroInt())
+	require.ErrorContains(err, "invalid unbonding amount")
+
+	// the first entry is transferred in full and the second one in part
+	transferred, err := keeper.TransferUnbonding(ctx, delAddr, recipientAddr, valAddr, math.NewInt(15))
+	require.NoError(err)
+	require.Equal(math.NewInt(15), transferred)
+
+	ubd, err := keeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.NoError(err)
+	require.Len(ubd.Entries, 1)
+	require.Equal(math.NewInt(5), ubd.Entries[0].Balance)
+	require.Equal(completionTimes[1], ubd.Entries[0].CompletionTime)
+
+	recipientUbd, err := keeper.GetUnbondingDelegation(ctx, recipientAddr, valAddr)
+	require.NoError(err)
+	require.Len(recipientUbd.Entries, 2)
+	for i, entry := range recipientUbd.Entries {
+		require.Equal(int64(i+1), entry.CreationHeight)
+		require.Equal(completionTimes[i], entry.CompletionTime)
+	}
+	require.Equal(math.NewInt(10), recipientUbd.Entries[0].Balance)
+	require.Equal(math.NewInt(5), recipientUbd.Entries[1].Balance)
+
+	// the transferred entries complete with the unbonding queue
+	dvPairs, err := keeper.GetUBDQueueTimeSlice(ctx, completionTimes[0])
+	require.NoError(err)
+	require.Contains(dvPairs, stakingtypes.DVPair{DelegatorAddress: recipientAddr.String(), ValidatorAddress: valAddr.String()})
+
+	// the unbonding delegation is removed once all its entries are transferred
+	transferred, err = keeper.TransferUnbonding(ctx, delAddr, recipientAddr, valAddr, math.NewInt(20))
+	require.NoError(err)
+	require.Equal(math.NewInt(5), transferred)
+	_, err = keeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.ErrorIs(err, stakingtypes.ErrNoUnbondingDelegation)
+
+	// the rest of the partially transferred entry is merged into its transferred part
+	recipientUbd, err = keeper.GetUnbondingDelegation(ctx, recipientAddr, valAddr)
+	require.NoError(err)
+	require.Len(recipientUbd.Entries, 2)
+	require.Equal(math.NewInt(10), recipientUbd.Entries[1].Balance)
+}
+
 func (s *KeeperTestSuite) TestUnbondingDelegationsFromValidator() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -742,6 +831,20 @@ func (s *KeeperTestSuite) TestRedelegation() {
 	require.Equal(1, len(redelegations))
 	require.Equal(redelegations[0], resRed)
 
+	redelegations, err = keeper.GetAllRedelegations(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.NoError(err)
+	require.Equal([]stakingtypes.Redelegation{resRed}, redelegations)
+	redelegations, err = keeper.GetAllRedelegations(ctx, addrDels[0], addrVals[1], nil)
+	require.NoError(err)
+	require.Empty(redelegations)
+
+	var iterReds []stakingtypes.Redelegation
+	require.NoError(keeper.IterateDelegatorRedelegations(ctx, addrDels[0], func(red stakingtypes.Redelegation) bool {
+		iterReds = append(iterReds, red)
+		return false
+	}))
+	require.Equal([]stakingtypes.Redelegation{resRed}, iterReds)
+
 	// check if it has the redelegation
 	has, err = keeper.HasReceivingRedelegation(ctx, addrDels[0], addrVals[1])
 	require.NoError(err)
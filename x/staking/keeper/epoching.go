@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochEndHeight returns the height of the block at the end of which the
+// queued messages are executed: the first multiple of the epoch length from
+// the current height, or the current height if epoching is disabled.
+func (k Keeper) EpochEndHeight(ctx context.Context) (int64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	if params.EpochLength == 0 {
+		return height, nil
+	}
+
+	epochLength := int64(params.EpochLength)
+	return height + (epochLength-height%epochLength)%epochLength, nil
+}
+
+// GetAllQueuedMsgs returns the messages queued until the end of the epoch, in
+// queue order.
+func (k Keeper) GetAllQueuedMsgs(ctx context.Context) ([]types.QueuedMsg, error) {
+	iter, err := k.EpochMsgQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// queueEpochMsg queues msg until the end of the epoch if epoching is enabled,
+// and returns the response of its dry run if it did. msg is first executed
+// against the current state with its changes discarded, so that a message
+// which would fail now is rejected instead of queued, and the coins it
+// delegates are then escrowed in the epoch escrow pool until it's executed.
+// A queued message may still fail at the end of the epoch if the messages
+// executed before it change the state it depends on.
+func (k *Keeper) queueEpochMsg(ctx context.Context, msg sdk.Msg) (any, bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if params.EpochLength == 0 {
+		return nil, false, nil
+	}
+
+	res, err := k.dryRunEpochMsg(ctx, msg)
+	if err != nil {
+		return nil, false, err
+	}
+
+	delegator, escrow, err := k.epochMsgEscrow(msg)
+	if err != nil {
+		return nil, false, err
+	}
+	if !escrow.IsZero() {
+		if k.authKeeper.GetModuleAddress(types.EpochEscrowPoolName) == nil {
+			return nil, false, fmt.Errorf("%s module account has not been set", types.EpochEscrowPoolName)
+		}
+		if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delegator, types.EpochEscrowPoolName, escrow); err != nil {
+			return nil, false, err
+		}
+	}
+
+	id, err := k.EpochMsgSequence.Next(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	queuedMsg, err := types.NewQueuedMsg(id, k.environment.HeaderService.GetHeaderInfo(ctx).Height, msg)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := k.EpochMsgQueue.Set(ctx, id, queuedMsg); err != nil {
+		return nil, false, err
+	}
+
+	epochEndHeight, err := k.EpochEndHeight(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeQueueEpochMsg,
+		event.NewAttribute(types.AttributeKeyQueuedMsgID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+		event.NewAttribute(types.AttributeKeyEpochEndHeight, strconv.FormatInt(epochEndHeight, 10)),
+	); err != nil {
+		return nil, false, err
+	}
+
+	return res, true, nil
+}
+
+// errEpochMsgDryRun discards the changes of the dry run of a message.
+var errEpochMsgDryRun = errors.New("epoch message dry run")
+
+// dryRunEpochMsg executes msg against the current state, discards its changes
+// and events, and returns its response.
+func (k *Keeper) dryRunEpochMsg(ctx context.Context, msg sdk.Msg) (any, error) {
+	ms := msgServer{Keeper: k, executingEpochMsgs: true}
+
+	var res any
+	err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = ms.executeQueuedMsg(ctx, msg); err != nil {
+			return err
+		}
+		return errEpochMsgDryRun
+	})
+	if !errors.Is(err, errEpochMsgDryRun) {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// epochMsgEscrow returns the account the coins delegated by msg are taken
+// from, and the coins, which are escrowed while msg is queued.
+func (k Keeper) epochMsgEscrow(msg sdk.Msg) (sdk.AccAddress, sdk.Coins, error) {
+	var (
+		delegator string
+		amount    sdk.Coin
+	)
+	switch msg := msg.(type) {
+	case *types.MsgCreateValidator:
+		valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+		return valAddr, sdk.NewCoins(msg.Value), nil
+	case *types.MsgDelegate:
+		delegator, amount = msg.DelegatorAddress, msg.Amount
+	case *types.MsgDelegateMulti:
+		delegator, amount = msg.DelegatorAddress, msg.Amount
+	default:
+		return nil, nil, nil
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	return delAddr, sdk.NewCoins(amount), nil
+}
+
+// ExecuteEpochMsgs executes, in queue order, the messages queued until the end
+// of the last epoch, or all the queued messages once epoching is disabled. At
+// most MaxEpochMsgsPerBlock messages are executed per block, and the messages
+// left are carried over to the following blocks. The coins escrowed by a
+// message are returned to the delegator before it's executed, so they are
+// refunded if it fails: the changes of a failing message are discarded, and
+// the failure is reported by an event.
+func (k *Keeper) ExecuteEpochMsgs(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	lastEpochEndHeight := height
+	if params.EpochLength > 0 {
+		lastEpochEndHeight = height - height%int64(params.EpochLength)
+	}
+
+	queuedMsgs, err := k.dueQueuedMsgs(ctx, lastEpochEndHeight, params.EpochMsgsPerBlock())
+	if err != nil {
+		return err
+	}
+
+	// the queued messages are executed as sent, without being queued again
+	ms := msgServer{Keeper: k, executingEpochMsgs: true}
+	for _, queuedMsg := range queuedMsgs {
+		if err := k.EpochMsgQueue.Remove(ctx, queuedMsg.Id); err != nil {
+			return err
+		}
+
+		msg, err := queuedMsg.GetMsg()
+		if err != nil {
+			return err
+		}
+
+		delegator, escrow, err := k.epochMsgEscrow(msg)
+		if err != nil {
+			return err
+		}
+		if !escrow.IsZero() {
+			if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.EpochEscrowPoolName, delegator, escrow); err != nil {
+				return err
+			}
+		}
+
+		err = k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			_, err := ms.executeQueuedMsg(ctx, msg)
+			return err
+		})
+		if err == nil {
+			continue
+		}
+
+		k.Logger().Info("queued message failed", "id", queuedMsg.Id, "type", sdk.MsgTypeURL(msg), "err", err)
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeEpochMsgFailed,
+			event.NewAttribute(types.AttributeKeyQueuedMsgID, strconv.FormatUint(queuedMsg.Id, 10)),
+			event.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+			event.NewAttribute(types.AttributeKeyError, err.Error()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dueQueuedMsgs returns, in queue order, at most limit messages queued up to
+// the given height.
+func (k Keeper) dueQueuedMsgs(ctx context.Context, height int64, limit int) ([]types.QueuedMsg, error) {
+	iter, err := k.EpochMsgQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var queuedMsgs []types.QueuedMsg
+	for ; iter.Valid() && len(queuedMsgs) < limit; iter.Next() {
+		queuedMsg, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		// the messages are queued in height order
+		if queuedMsg.Height > height {
+			break
+		}
+		queuedMsgs = append(queuedMsgs, queuedMsg)
+	}
+
+	return queuedMsgs, nil
+}
+
+// executeQueuedMsg executes a queued message and returns its response.
+func (k msgServer) executeQueuedMsg(ctx context.Context, msg sdk.Msg) (any, error) {
+	switch msg := msg.(type) {
+	case *types.MsgCreateValidator:
+		return k.CreateValidator(ctx, msg)
+	case *types.MsgDelegate:
+		return k.Delegate(ctx, msg)
+	case *types.MsgDelegateMulti:
+		return k.DelegateMulti(ctx, msg)
+	case *types.MsgBeginRedelegate:
+		return k.BeginRedelegate(ctx, msg)
+	case *types.MsgUndelegate:
+		return k.Undelegate(ctx, msg)
+	case *types.MsgCancelUnbondingDelegation:
+		return k.CancelUnbondingDelegation(ctx, msg)
+	default:
+		return nil, fmt.Errorf("unexpected queued message %T", msg)
+	}
+}
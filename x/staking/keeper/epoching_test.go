@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestEpochQueuedMsgs() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.NotBondedPoolName, gomock.Any()).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(types.EpochEscrowPoolName).Return(sdk.AccAddress("epoch_escrow_pool")).AnyTimes()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.EpochLength = 10
+	params.MaxEpochMsgsPerBlock = 2
+	require.NoError(keeper.Params.Set(ctx, params))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 7, Time: ctx.HeaderInfo().Time})
+
+	// the messages changing delegations are queued, and the coins they
+	// delegate escrowed
+	pk := ed25519.GenPrivKey().PubKey()
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	selfBond := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	createValidator, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, selfBond, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(ValAddr), types.EpochEscrowPoolName, sdk.NewCoins(selfBond)).Times(1)
+	_, err = msgServer.CreateValidator(ctx, createValidator)
+	require.NoError(err)
+
+	// the messages failing against the current state are rejected
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+	require.ErrorIs(err, types.ErrNoValidatorFound)
+
+	_, err = keeper.GetValidator(ctx, ValAddr)
+	require.ErrorIs(err, types.ErrNoValidatorFound)
+
+	res, err := stakingkeeper.NewQuerier(keeper).QueuedMsgs(ctx, &types.QueryQueuedMsgsRequest{})
+	require.NoError(err)
+	require.Len(res.Msgs, 1)
+	require.Equal(int64(10), res.EpochEndHeight)
+	require.Equal(uint64(0), res.Msgs[0].Id)
+	require.Equal(int64(7), res.Msgs[0].Height)
+	msg, err := res.Msgs[0].GetMsg()
+	require.NoError(err)
+	require.Equal(createValidator.ValidatorAddress, msg.(*types.MsgCreateValidator).ValidatorAddress)
+
+	// the queued messages are kept until the end of the epoch
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 9, Time: ctx.HeaderInfo().Time})
+	require.NoError(keeper.ExecuteEpochMsgs(ctx))
+	queuedMsgs, err := keeper.GetAllQueuedMsgs(ctx)
+	require.NoError(err)
+	require.Len(queuedMsgs, 1)
+
+	// the escrowed coins are returned before the message is executed
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.EpochEscrowPoolName, sdk.AccAddress(ValAddr), sdk.NewCoins(selfBond)).Times(1)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: ctx.HeaderInfo().Time})
+	require.NoError(keeper.ExecuteEpochMsgs(ctx))
+	queuedMsgs, err = keeper.GetAllQueuedMsgs(ctx)
+	require.NoError(err)
+	require.Empty(queuedMsgs)
+
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(100), validator.Tokens)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 12, Time: ctx.HeaderInfo().Time})
+	delegation := sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, types.EpochEscrowPoolName, sdk.NewCoins(delegation)).Times(1)
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(s.addressToString(delAddr), s.valAddressToString(ValAddr), delegation))
+	require.NoError(err)
+
+	// the response of a queued message is the one of its dry run
+	undelegateRes, err := msgServer.Undelegate(ctx, types.NewMsgUndelegate(s.addressToString(sdk.AccAddress(ValAddr)), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30), undelegateRes.Amount)
+
+	// a message failing only after the messages queued before it
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(s.addressToString(sdk.AccAddress(ValAddr)), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 80)))
+	require.NoError(err)
+
+	// at most MaxEpochMsgsPerBlock messages are executed per block, the others
+	// are carried over
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.EpochEscrowPoolName, delAddr, sdk.NewCoins(delegation)).Times(1)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 20, Time: ctx.HeaderInfo().Time})
+	require.NoError(keeper.ExecuteEpochMsgs(ctx))
+	queuedMsgs, err = keeper.GetAllQueuedMsgs(ctx)
+	require.NoError(err)
+	require.Len(queuedMsgs, 1)
+	require.Equal(uint64(3), queuedMsgs[0].Id)
+
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(120), validator.Tokens)
+	_, err = keeper.Delegations.Get(ctx, collections.Join(delAddr, ValAddr))
+	require.NoError(err)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 21, Time: ctx.HeaderInfo().Time}).WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.ExecuteEpochMsgs(ctx))
+	queuedMsgs, err = keeper.GetAllQueuedMsgs(ctx)
+	require.NoError(err)
+	require.Empty(queuedMsgs)
+
+	var failed []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEpochMsgFailed {
+			failed = append(failed, event)
+		}
+	}
+	require.Len(failed, 1)
+	id, ok := failed[0].GetAttribute(types.AttributeKeyQueuedMsgID)
+	require.True(ok)
+	require.Equal("3", id.Value)
+
+	// disabling epoching executes the messages right away
+	params.EpochLength = 0
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+	require.NoError(err)
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(170), validator.Tokens)
+}
+
+func (s *KeeperTestSuite) TestEpochEndHeight() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+
+	for _, tc := range []struct {
+		epochLength    uint64
+		height         int64
+		epochEndHeight int64
+	}{
+		{0, 7, 7},
+		{10, 7, 10},
+		{10, 10, 10},
+		{10, 11, 20},
+		{1, 11, 11},
+	} {
+		params.EpochLength = tc.epochLength
+		require.NoError(keeper.Params.Set(ctx, params))
+		epochEndHeight, err := keeper.EpochEndHeight(ctx.WithHeaderInfo(header.Info{Height: tc.height}))
+		require.NoError(err)
+		require.Equal(tc.epochEndHeight, epochEndHeight, tc)
+	}
+}
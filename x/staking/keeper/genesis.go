@@ -137,6 +137,57 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]mo
 		}
 	}
 
+	for _, record := range data.ArchivedHistoricalRecords {
+		if err := k.ArchivedHistoricalInfo.Set(ctx, collections.Join(record.ChainId, uint64(record.Height)), record.Record); err != nil {
+			return nil, err
+		}
+	}
+
+	var nextQueuedMsgID uint64
+	escrowedCoins := sdk.NewCoins()
+	for _, queuedMsg := range data.QueuedMsgs {
+		if err := k.EpochMsgQueue.Set(ctx, queuedMsg.Id, queuedMsg); err != nil {
+			return nil, err
+		}
+		nextQueuedMsgID = max(nextQueuedMsgID, queuedMsg.Id+1)
+
+		msg, err := queuedMsg.GetMsg()
+		if err != nil {
+			return nil, err
+		}
+		_, escrow, err := k.epochMsgEscrow(msg)
+		if err != nil {
+			return nil, err
+		}
+		escrowedCoins = escrowedCoins.Add(escrow...)
+	}
+	if len(data.QueuedMsgs) > 0 {
+		if err := k.EpochMsgSequence.Set(ctx, nextQueuedMsgID); err != nil {
+			return nil, err
+		}
+	}
+
+	// if balance is different from the coins escrowed by the queued messages
+	// error because genesis is most likely malformed
+	var escrowBalance sdk.Coins
+	if escrowPoolAddr := k.authKeeper.GetModuleAddress(types.EpochEscrowPoolName); escrowPoolAddr != nil {
+		escrowBalance = k.bankKeeper.GetAllBalances(ctx, escrowPoolAddr)
+	}
+	if !escrowBalance.Equal(escrowedCoins) {
+		return nil, fmt.Errorf("epoch escrow pool balance is different from escrowed coins: %s <-> %s", escrowBalance, escrowedCoins)
+	}
+
+	for _, change := range data.PendingCommissionChanges {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.PendingCommissionChanges.Set(ctx, valAddr, change); err != nil {
+			return nil, err
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -275,14 +326,32 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	archivedHistoricalRecords, err := k.GetArchivedHistoricalRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queuedMsgs, err := k.GetAllQueuedMsgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingCommissionChanges, err := k.GetAllPendingCommissionChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    params,
+		LastTotalPower:            totalPower,
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                allValidators,
+		Delegations:               allDelegations,
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		ArchivedHistoricalRecords: archivedHistoricalRecords,
+		QueuedMsgs:                queuedMsgs,
+		PendingCommissionChanges:  pendingCommissionChanges,
 	}, nil
 }
@@ -3,12 +3,15 @@ package keeper
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/staking/types"
@@ -404,6 +407,177 @@ func (k Querier) HistoricalInfo(ctx context.Context, req *types.QueryHistoricalI
 	return &types.QueryHistoricalInfoResponse{HistoricalRecord: &hi}, nil
 }
 
+// HistoricalInfoByChainID queries the historical info for given chain-id and
+// height, including the records archived across state exports
+func (k Querier) HistoricalInfoByChainID(ctx context.Context, req *types.QueryHistoricalInfoByChainIDRequest) (*types.QueryHistoricalInfoByChainIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	record, archived, err := k.Keeper.GetHistoricalRecordByChainID(ctx, req.ChainId, uint64(req.Height))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "historical info of chain %q for height %d not found", req.ChainId, req.Height)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricalInfoByChainIDResponse{HistoricalRecord: &record, Archived: archived}, nil
+}
+
+// HistoricalInfos queries the historical info of the heights within the given
+// range
+func (k Querier) HistoricalInfos(ctx context.Context, req *types.QueryHistoricalInfosRequest) (*types.QueryHistoricalInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MinHeight < 0 || req.MaxHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+	if req.MaxHeight != 0 && req.MaxHeight < req.MinHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "max height %d is lower than min height %d", req.MaxHeight, req.MinHeight)
+	}
+
+	entries, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Keeper.HistoricalInfo, req.Pagination,
+		func(height uint64, _ types.HistoricalRecord) (bool, error) {
+			return height >= uint64(req.MinHeight) && (req.MaxHeight == 0 || height <= uint64(req.MaxHeight)), nil
+		},
+		func(height uint64, record types.HistoricalRecord) (types.HistoricalInfoEntry, error) {
+			return types.HistoricalInfoEntry{Height: int64(height), HistoricalRecord: record}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricalInfosResponse{HistoricalInfos: entries, Pagination: pageRes}, nil
+}
+
+// ValidatorSetChanges queries the changes of the bonded validator set at the
+// heights within the given range
+func (k Querier) ValidatorSetChanges(ctx context.Context, req *types.QueryValidatorSetChangesRequest) (*types.QueryValidatorSetChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MinHeight < 0 || req.MaxHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+	if req.MaxHeight != 0 && req.MaxHeight < req.MinHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "max height %d is lower than min height %d", req.MaxHeight, req.MinHeight)
+	}
+
+	entries, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Keeper.ValidatorSetChanges, req.Pagination,
+		func(height uint64, _ types.ValidatorSetChanges) (bool, error) {
+			return height >= uint64(req.MinHeight) && (req.MaxHeight == 0 || height <= uint64(req.MaxHeight)), nil
+		},
+		func(height uint64, record types.ValidatorSetChanges) (types.ValidatorSetChangesEntry, error) {
+			return types.ValidatorSetChangesEntry{Height: int64(height), Changes: record.Changes}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorSetChangesResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// ValidatorPerformance queries a validator with its liveness and jailing history
+func (k Querier) ValidatorPerformance(ctx context.Context, req *types.QueryValidatorPerformanceRequest) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	res := &types.QueryValidatorPerformanceResponse{Validator: validator}
+
+	if k.livenessProvider != nil {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		res.Liveness, err = k.livenessProvider.ValidatorLiveness(ctx, consAddr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	err = k.Keeper.ValidatorSetChanges.Walk(ctx, nil, func(height uint64, record types.ValidatorSetChanges) (bool, error) {
+		var changes []types.ValidatorSetChange
+		for _, change := range record.Changes {
+			if change.ValidatorAddress != validator.OperatorAddress {
+				continue
+			}
+			if change.ChangeType == types.ValidatorSetChangeJailed || change.ChangeType == types.ValidatorSetChangeUnjailed {
+				changes = append(changes, change)
+			}
+		}
+		if len(changes) > 0 {
+			res.JailingHistory = append(res.JailingHistory, types.ValidatorSetChangesEntry{Height: int64(height), Changes: changes})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+// PendingCommissionChanges queries the commission changes pending until their
+// effective time, of all the validators or of the given validator
+func (k Querier) PendingCommissionChanges(ctx context.Context, req *types.QueryPendingCommissionChangesRequest) (*types.QueryPendingCommissionChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr != "" {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		change, err := k.Keeper.PendingCommissionChanges.Get(ctx, valAddr)
+		if errors.Is(err, collections.ErrNotFound) {
+			return &types.QueryPendingCommissionChangesResponse{}, nil
+		} else if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &types.QueryPendingCommissionChangesResponse{PendingCommissionChanges: []types.PendingCommissionChange{change}}, nil
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.PendingCommissionChanges, req.Pagination,
+		func(_ sdk.ValAddress, change types.PendingCommissionChange) (types.PendingCommissionChange, error) {
+			return change, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingCommissionChangesResponse{PendingCommissionChanges: changes, Pagination: pageRes}, nil
+}
+
 // Redelegations queries redelegations of given address
 func (k Querier) Redelegations(ctx context.Context, req *types.QueryRedelegationsRequest) (*types.QueryRedelegationsResponse, error) {
 	if req == nil {
@@ -649,3 +823,352 @@ func redelegationsToRedelegationResponses(ctx context.Context, k *Keeper, redels
 
 	return resp, nil
 }
+
+// CommissionChangeAllowance queries the commission rates a validator may change
+// to at the current block time, and when it may next change them.
+func (k Querier) CommissionChangeAllowance(ctx context.Context, req *types.QueryCommissionChangeAllowanceRequest) (*types.QueryCommissionChangeAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commission := validator.Commission
+	nextChangeTime := commission.NextChangeTime(params.CommissionChangeCooldown)
+	return &types.QueryCommissionChangeAllowanceResponse{
+		Rate:           commission.Rate,
+		MinRate:        params.MinCommissionRate,
+		MaxRate:        commission.MaxNewRate(),
+		CanChange:      !k.environment.HeaderService.GetHeaderInfo(ctx).Time.Before(nextChangeTime),
+		LastUpdateTime: commission.UpdateTime,
+		NextChangeTime: nextChangeTime,
+	}, nil
+}
+
+// FractionAmount queries the amount of tokens worth a fraction of a delegation,
+// or of the spendable balance of the delegator in the bond denom if no
+// validator is given.
+func (k Querier) FractionAmount(ctx context.Context, req *types.QueryFractionAmountRequest) (*types.QueryFractionAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	if req.Fraction.IsNil() || !req.Fraction.IsPositive() || req.Fraction.GT(math.LegacyOneDec()) {
+		return nil, status.Error(codes.InvalidArgument, "fraction must be greater than 0 and at most 1")
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ValidatorAddr == "" {
+		balance := k.bankKeeper.SpendableCoins(ctx, delAddr).AmountOf(bondDenom)
+		return &types.QueryFractionAmountResponse{
+			Amount: sdk.NewCoin(bondDenom, math.LegacyNewDecFromInt(balance).Mul(req.Fraction).TruncateInt()),
+		}, nil
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(delAddr), sdk.ValAddress(valAddr)))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(
+				codes.NotFound,
+				"delegation with delegator %s not found for validator %s",
+				req.DelegatorAddr, req.ValidatorAddr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the tokens are truncated, unbonding all of them still unbonds the whole
+	// delegation as ValidateUnbondAmount leaves no dust shares
+	tokens := validator.TokensFromShares(delegation.Shares.Mul(req.Fraction)).TruncateInt()
+	return &types.QueryFractionAmountResponse{Amount: sdk.NewCoin(bondDenom, tokens)}, nil
+}
+
+// MaturityCalendar queries the unbonding delegation and redelegation entries
+// completing within a time window, with their totals chain-wide and per delegator.
+func (k Querier) MaturityCalendar(ctx context.Context, req *types.QueryMaturityCalendarRequest) (*types.QueryMaturityCalendarResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	startTime := req.StartTime
+	if startTime.IsZero() {
+		startTime = k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	}
+	if req.EndTime.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "end time cannot be empty")
+	}
+	if req.EndTime.Before(startTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "end time %s is before start time %s", req.EndTime, startTime)
+	}
+
+	var (
+		ubds []types.UnbondingDelegation
+		reds []types.Redelegation
+		err  error
+	)
+	if req.DelegatorAddr != "" {
+		ubds, reds, err = k.delegatorMaturingEntries(ctx, req.DelegatorAddr)
+	} else {
+		ubds, reds, err = k.queuedMaturingEntries(ctx, startTime, req.EndTime)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inWindow := func(t time.Time) bool {
+		return !t.Before(startTime) && !t.After(req.EndTime)
+	}
+
+	res := &types.QueryMaturityCalendarResponse{
+		UnbondingEntries:    []types.MaturingUnbondingEntry{},
+		RedelegationEntries: []types.MaturingRedelegationEntry{},
+		TotalUnbonding:      sdk.NewCoin(bondDenom, math.ZeroInt()),
+		TotalRedelegating:   sdk.NewCoin(bondDenom, math.ZeroInt()),
+		Delegators:          []types.DelegatorMaturity{},
+	}
+	delegators := make(map[string]*types.DelegatorMaturity)
+	delegatorMaturity := func(delegatorAddr string) *types.DelegatorMaturity {
+		if dm, ok := delegators[delegatorAddr]; ok {
+			return dm
+		}
+		dm := &types.DelegatorMaturity{
+			DelegatorAddress: delegatorAddr,
+			Unbonding:        sdk.NewCoin(bondDenom, math.ZeroInt()),
+			Redelegating:     sdk.NewCoin(bondDenom, math.ZeroInt()),
+		}
+		delegators[delegatorAddr] = dm
+		return dm
+	}
+
+	for _, ubd := range ubds {
+		for _, entry := range ubd.Entries {
+			if !inWindow(entry.CompletionTime) {
+				continue
+			}
+
+			res.UnbondingEntries = append(res.UnbondingEntries, types.MaturingUnbondingEntry{
+				DelegatorAddress: ubd.DelegatorAddress,
+				ValidatorAddress: ubd.ValidatorAddress,
+				CreationHeight:   entry.CreationHeight,
+				CompletionTime:   entry.CompletionTime,
+				Balance:          entry.Balance,
+			})
+			res.TotalUnbonding.Amount = res.TotalUnbonding.Amount.Add(entry.Balance)
+			dm := delegatorMaturity(ubd.DelegatorAddress)
+			dm.Unbonding.Amount = dm.Unbonding.Amount.Add(entry.Balance)
+		}
+	}
+
+	for _, red := range reds {
+		for _, entry := range red.Entries {
+			if !inWindow(entry.CompletionTime) {
+				continue
+			}
+
+			res.RedelegationEntries = append(res.RedelegationEntries, types.MaturingRedelegationEntry{
+				DelegatorAddress:    red.DelegatorAddress,
+				ValidatorSrcAddress: red.ValidatorSrcAddress,
+				ValidatorDstAddress: red.ValidatorDstAddress,
+				CreationHeight:      entry.CreationHeight,
+				CompletionTime:      entry.CompletionTime,
+				Balance:             entry.InitialBalance,
+			})
+			res.TotalRedelegating.Amount = res.TotalRedelegating.Amount.Add(entry.InitialBalance)
+			dm := delegatorMaturity(red.DelegatorAddress)
+			dm.Redelegating.Amount = dm.Redelegating.Amount.Add(entry.InitialBalance)
+		}
+	}
+
+	sort.SliceStable(res.UnbondingEntries, func(i, j int) bool {
+		return res.UnbondingEntries[i].CompletionTime.Before(res.UnbondingEntries[j].CompletionTime)
+	})
+	sort.SliceStable(res.RedelegationEntries, func(i, j int) bool {
+		return res.RedelegationEntries[i].CompletionTime.Before(res.RedelegationEntries[j].CompletionTime)
+	})
+
+	for _, dm := range delegators {
+		res.Delegators = append(res.Delegators, *dm)
+	}
+	sort.Slice(res.Delegators, func(i, j int) bool {
+		return res.Delegators[i].DelegatorAddress < res.Delegators[j].DelegatorAddress
+	})
+
+	return res, nil
+}
+
+// delegatorMaturingEntries returns the unbonding delegations and redelegations
+// of a delegator.
+func (k Querier) delegatorMaturingEntries(ctx context.Context, delegatorAddr string) ([]types.UnbondingDelegation, []types.Redelegation, error) {
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegatorAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ubds []types.UnbondingDelegation
+	err = k.IterateDelegatorUnbondingDelegations(ctx, delAddr, func(ubd types.UnbondingDelegation) bool {
+		ubds = append(ubds, ubd)
+		return false
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reds []types.Redelegation
+	err = k.IterateDelegatorRedelegations(ctx, delAddr, func(red types.Redelegation) bool {
+		reds = append(reds, red)
+		return false
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ubds, reds, nil
+}
+
+// queuedMaturingEntries returns the unbonding delegations and redelegations
+// queued for completion between startTime and endTime, inclusive.
+func (k Querier) queuedMaturingEntries(ctx context.Context, startTime, endTime time.Time) ([]types.UnbondingDelegation, []types.Redelegation, error) {
+	rng := (&collections.Range[time.Time]{}).StartInclusive(startTime).EndInclusive(endTime)
+
+	// an unbonding delegation or redelegation is queued once per entry, it is
+	// returned once
+	var ubds []types.UnbondingDelegation
+	seenPairs := make(map[types.DVPair]struct{})
+	err := k.UnbondingQueue.Walk(ctx, rng, func(_ time.Time, dvPairs types.DVPairs) (bool, error) {
+		for _, dvPair := range dvPairs.Pairs {
+			if _, ok := seenPairs[dvPair]; ok {
+				continue
+			}
+			seenPairs[dvPair] = struct{}{}
+
+			delAddr, err := k.authKeeper.AddressCodec().StringToBytes(dvPair.DelegatorAddress)
+			if err != nil {
+				return true, err
+			}
+			valAddr, err := k.validatorAddressCodec.StringToBytes(dvPair.ValidatorAddress)
+			if err != nil {
+				return true, err
+			}
+
+			ubd, err := k.UnbondingDelegations.Get(ctx, collections.Join(delAddr, valAddr))
+			if err != nil {
+				// the unbonding delegation was removed, e.g. by the cancellation
+				// of its last entry, but not its queue entries
+				if errors.Is(err, collections.ErrNotFound) {
+					continue
+				}
+				return true, err
+			}
+			ubds = append(ubds, ubd)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reds []types.Redelegation
+	seenTriplets := make(map[types.DVVTriplet]struct{})
+	err = k.RedelegationQueue.Walk(ctx, rng, func(_ time.Time, triplets types.DVVTriplets) (bool, error) {
+		for _, triplet := range triplets.Triplets {
+			if _, ok := seenTriplets[triplet]; ok {
+				continue
+			}
+			seenTriplets[triplet] = struct{}{}
+
+			delAddr, err := k.authKeeper.AddressCodec().StringToBytes(triplet.DelegatorAddress)
+			if err != nil {
+				return true, err
+			}
+			valSrcAddr, err := k.validatorAddressCodec.StringToBytes(triplet.ValidatorSrcAddress)
+			if err != nil {
+				return true, err
+			}
+			valDstAddr, err := k.validatorAddressCodec.StringToBytes(triplet.ValidatorDstAddress)
+			if err != nil {
+				return true, err
+			}
+
+			red, err := k.Keeper.Redelegations.Get(ctx, collections.Join3(delAddr, valSrcAddr, valDstAddr))
+			if err != nil {
+				if errors.Is(err, collections.ErrNotFound) {
+					continue
+				}
+				return true, err
+			}
+			reds = append(reds, red)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ubds, reds, nil
+}
+
+// QueuedMsgs queries the messages queued until the end of the current epoch.
+func (k Querier) QueuedMsgs(ctx context.Context, req *types.QueryQueuedMsgsRequest) (*types.QueryQueuedMsgsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	msgs, pageRes, err := query.CollectionPaginate(ctx, k.EpochMsgQueue, req.Pagination,
+		func(_ uint64, msg types.QueuedMsg) (types.QueuedMsg, error) {
+			return msg, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	epochEndHeight, err := k.EpochEndHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQueuedMsgsResponse{Msgs: msgs, EpochEndHeight: epochEndHeight, Pagination: pageRes}, nil
+}
@@ -3,7 +3,15 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	coreheader "cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/testutil"
 	"cosmossdk.io/x/staking/types"
 
@@ -61,3 +69,227 @@ func (s *KeeperTestSuite) TestGRPCQueryValidator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryCommissionChangeAllowance() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	now := time.Now().UTC().Round(0)
+	ctx = ctx.WithHeaderInfo(coreheader.Info{Time: now})
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	validator, err = validator.SetInitialCommission(types.NewCommissionWithTime(
+		math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(25, 2), math.LegacyNewDecWithPrec(1, 1), now.Add(-time.Hour),
+	))
+	require.NoError(err)
+	require.NoError(keeper.SetValidator(ctx, validator))
+	querier := stakingkeeper.Querier{Keeper: keeper}
+
+	_, err = queryClient.CommissionChangeAllowance(gocontext.Background(), &types.QueryCommissionChangeAllowanceRequest{})
+	require.Error(err)
+	_, err = queryClient.CommissionChangeAllowance(gocontext.Background(), &types.QueryCommissionChangeAllowanceRequest{
+		ValidatorAddr: "cosmosvaloper15jkng8hytwt22lllv6mw4k89qkqehtahd84ptu",
+	})
+	require.Error(err)
+
+	// the rate was changed an hour ago, within the default cooldown
+	req := &types.QueryCommissionChangeAllowanceRequest{ValidatorAddr: validator.OperatorAddress}
+	res, err := querier.CommissionChangeAllowance(ctx, req)
+	require.NoError(err)
+	require.False(res.CanChange)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), res.Rate)
+	require.Equal(math.LegacyNewDecWithPrec(5, 2), res.MinRate)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), res.MaxRate)
+	require.Equal(now.Add(-time.Hour), res.LastUpdateTime)
+	require.Equal(now.Add(23*time.Hour), res.NextChangeTime)
+	_, err = keeper.UpdateValidatorCommission(ctx, validator, res.MaxRate)
+	require.ErrorIs(err, types.ErrCommissionUpdateTime)
+
+	// a shorter cooldown allows the change, capped by the max rate
+	params.CommissionChangeCooldown = 30 * time.Minute
+	require.NoError(keeper.Params.Set(ctx, params))
+	validator.Commission.Rate = math.LegacyNewDecWithPrec(2, 1)
+	require.NoError(keeper.SetValidator(ctx, validator))
+	res, err = querier.CommissionChangeAllowance(ctx, req)
+	require.NoError(err)
+	require.True(res.CanChange)
+	require.Equal(math.LegacyNewDecWithPrec(25, 2), res.MaxRate)
+	require.Equal(now.Add(-30*time.Minute), res.NextChangeTime)
+	_, err = keeper.UpdateValidatorCommission(ctx, validator, res.MaxRate)
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryFractionAmount() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.Querier{Keeper: keeper}
+
+	delAddr := sdk.AccAddress(PKs[1].Address())
+	valAddr := sdk.ValAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(100))
+	// a slash leaves 0.9 tokens per share
+	validator.Tokens = math.NewInt(90)
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(s.addressToString(delAddr), s.valAddressToString(valAddr), math.LegacyNewDec(60))))
+
+	for _, fraction := range []math.LegacyDec{{}, math.LegacyZeroDec(), math.LegacyNewDecWithPrec(11, 1)} {
+		_, err := querier.FractionAmount(ctx, &types.QueryFractionAmountRequest{DelegatorAddr: s.addressToString(delAddr), Fraction: fraction})
+		require.Equal(codes.InvalidArgument, status.Code(err), fraction)
+	}
+
+	_, err := querier.FractionAmount(ctx, &types.QueryFractionAmountRequest{
+		DelegatorAddr: s.addressToString(PKs[2].Address()),
+		ValidatorAddr: s.valAddressToString(valAddr),
+		Fraction:      math.LegacyOneDec(),
+	})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// the fraction of the delegation is worth its shares in tokens, truncated
+	req := &types.QueryFractionAmountRequest{
+		DelegatorAddr: s.addressToString(delAddr),
+		ValidatorAddr: s.valAddressToString(valAddr),
+		Fraction:      math.LegacyNewDecWithPrec(55, 2),
+	}
+	res, err := querier.FractionAmount(ctx, req)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 29), res.Amount)
+
+	// the whole delegation unbonds all of its shares
+	req.Fraction = math.LegacyOneDec()
+	res, err = querier.FractionAmount(ctx, req)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 54), res.Amount)
+	shares, err := keeper.ValidateUnbondAmount(ctx, delAddr, valAddr, res.Amount.Amount)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(60), shares)
+
+	// without a validator, the fraction is of the spendable balance
+	s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), delAddr).Return(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001), sdk.NewInt64Coin("atom", 10)))
+	res, err = querier.FractionAmount(ctx, &types.QueryFractionAmountRequest{DelegatorAddr: s.addressToString(delAddr), Fraction: math.LegacyNewDecWithPrec(5, 1)})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), res.Amount)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryMaturityCalendar() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.Querier{Keeper: keeper}
+
+	now := time.Now().UTC().Round(0)
+	ctx = ctx.WithHeaderInfo(coreheader.Info{Time: now})
+	addrDels, addrVals := createValAddrs(2)
+	valAc, delAc := keeper.ValidatorAddressCodec(), s.accountKeeper.AddressCodec()
+
+	ubd0 := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 1, now.Add(time.Hour), math.NewInt(10), 1, valAc, delAc)
+	ubd0.AddEntry(2, now.Add(10*24*time.Hour), math.NewInt(20), 2)
+	ubd1 := types.NewUnbondingDelegation(addrDels[1], addrVals[0], 1, now.Add(2*time.Hour), math.NewInt(5), 3, valAc, delAc)
+	for _, ubd := range []types.UnbondingDelegation{ubd0, ubd1} {
+		require.NoError(keeper.SetUnbondingDelegation(ctx, ubd))
+		for _, entry := range ubd.Entries {
+			require.NoError(keeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime))
+		}
+	}
+	red := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 1, now.Add(3*time.Hour), math.NewInt(15), math.LegacyNewDec(15), 4, valAc, delAc)
+	require.NoError(keeper.SetRedelegation(ctx, red))
+	require.NoError(keeper.InsertRedelegationQueue(ctx, red, now.Add(3*time.Hour)))
+
+	_, err := querier.MaturityCalendar(ctx, &types.QueryMaturityCalendarRequest{})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = querier.MaturityCalendar(ctx, &types.QueryMaturityCalendarRequest{EndTime: now.Add(-time.Hour)})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// chain-wide, from the block time
+	res, err := querier.MaturityCalendar(ctx, &types.QueryMaturityCalendarRequest{EndTime: now.Add(24 * time.Hour)})
+	require.NoError(err)
+	require.Equal([]types.MaturingUnbondingEntry{
+		{DelegatorAddress: ubd0.DelegatorAddress, ValidatorAddress: ubd0.ValidatorAddress, CreationHeight: 1, CompletionTime: now.Add(time.Hour), Balance: math.NewInt(10)},
+		{DelegatorAddress: ubd1.DelegatorAddress, ValidatorAddress: ubd1.ValidatorAddress, CreationHeight: 1, CompletionTime: now.Add(2 * time.Hour), Balance: math.NewInt(5)},
+	}, res.UnbondingEntries)
+	require.Equal([]types.MaturingRedelegationEntry{
+		{
+			DelegatorAddress: red.DelegatorAddress, ValidatorSrcAddress: red.ValidatorSrcAddress, ValidatorDstAddress: red.ValidatorDstAddress,
+			CreationHeight: 1, CompletionTime: now.Add(3 * time.Hour), Balance: math.NewInt(15),
+		},
+	}, res.RedelegationEntries)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15), res.TotalUnbonding)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15), res.TotalRedelegating)
+	require.ElementsMatch([]types.DelegatorMaturity{
+		{DelegatorAddress: ubd0.DelegatorAddress, Unbonding: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), Redelegating: sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)},
+		{DelegatorAddress: ubd1.DelegatorAddress, Unbonding: sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), Redelegating: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+	}, res.Delegators)
+	require.True(res.Delegators[0].DelegatorAddress < res.Delegators[1].DelegatorAddress)
+
+	// the window bounds are inclusive
+	res, err = querier.MaturityCalendar(ctx, &types.QueryMaturityCalendarRequest{StartTime: now.Add(2 * time.Hour), EndTime: now.Add(3 * time.Hour)})
+	require.NoError(err)
+	require.Len(res.UnbondingEntries, 1)
+	require.Equal(ubd1.DelegatorAddress, res.UnbondingEntries[0].DelegatorAddress)
+	require.Len(res.RedelegationEntries, 1)
+
+	// per delegator
+	res, err = querier.MaturityCalendar(ctx, &types.QueryMaturityCalendarRequest{EndTime: now.Add(30 * 24 * time.Hour), DelegatorAddr: ubd0.DelegatorAddress})
+	require.NoError(err)
+	require.Len(res.UnbondingEntries, 2)
+	require.Len(res.RedelegationEntries, 1)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30), res.TotalUnbonding)
+	require.Equal([]types.DelegatorMaturity{
+		{DelegatorAddress: ubd0.DelegatorAddress, Unbonding: sdk.NewInt64Coin(sdk.DefaultBondDenom, 30), Redelegating: sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)},
+	}, res.Delegators)
+}
+
+// livenessProvider is a types.ValidatorLivenessProvider tracking the liveness
+// of the validators of its map.
+type livenessProvider map[string]*types.ValidatorLiveness
+
+func (p livenessProvider) ValidatorLiveness(_ gocontext.Context, consAddr sdk.ConsAddress) (*types.ValidatorLiveness, error) {
+	return p[string(consAddr)], nil
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorPerformance() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.NewQuerier(keeper)
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	other := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[1].Address().Bytes()), PKs[1])
+	require.NoError(keeper.SetValidator(ctx, other))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, other))
+
+	_, err := querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformanceRequest{})
+	require.ErrorContains(err, "validator address cannot be empty")
+
+	_, err = querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformanceRequest{ValidatorAddr: "cosmosvaloper15jkng8hytwt22lllv6mw4k89qkqehtahd84ptu"})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// no liveness without a provider
+	res, err := querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformanceRequest{ValidatorAddr: validator.OperatorAddress})
+	require.NoError(err)
+	require.True(validator.Equal(&res.Validator))
+	require.Nil(res.Liveness)
+	require.Empty(res.JailingHistory)
+
+	liveness := &types.ValidatorLiveness{SignedBlocksWindow: 100, WindowBlocks: 100, MissedBlocksCounter: 10, Uptime: math.LegacyNewDecWithPrec(9, 1)}
+	keeper.SetValidatorLivenessProvider(livenessProvider{string(PKs[0].Address()): liveness})
+
+	// the validators are jailed and unjailed at different heights
+	require.NoError(keeper.Jail(ctx.WithHeaderInfo(coreheader.Info{Height: 5}), sdk.ConsAddress(PKs[0].Address())))
+	require.NoError(keeper.Jail(ctx.WithHeaderInfo(coreheader.Info{Height: 5}), sdk.ConsAddress(PKs[1].Address())))
+	require.NoError(keeper.Unjail(ctx.WithHeaderInfo(coreheader.Info{Height: 8}), sdk.ConsAddress(PKs[1].Address())))
+	require.NoError(keeper.Unjail(ctx.WithHeaderInfo(coreheader.Info{Height: 9}), sdk.ConsAddress(PKs[0].Address())))
+
+	res, err = querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformanceRequest{ValidatorAddr: validator.OperatorAddress})
+	require.NoError(err)
+	require.Equal(liveness, res.Liveness)
+	require.Equal([]types.ValidatorSetChangesEntry{
+		{Height: 5, Changes: []types.ValidatorSetChange{{ValidatorAddress: validator.OperatorAddress, ChangeType: types.ValidatorSetChangeJailed}}},
+		{Height: 9, Changes: []types.ValidatorSetChange{{ValidatorAddress: validator.OperatorAddress, ChangeType: types.ValidatorSetChangeUnjailed}}},
+	}, res.JailingHistory)
+}
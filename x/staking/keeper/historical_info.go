@@ -2,14 +2,23 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxHistoricalInfoPrunedPerBlock is the maximum number of historical info
+// entries pruned by TrackHistoricalInfo in a single block. After the
+// HistoricalEntries param is decreased, the entries beyond the new limit are
+// pruned over the following blocks instead of all at once.
+const MaxHistoricalInfoPrunedPerBlock = 100
+
 // TrackHistoricalInfo saves the latest historical-info and deletes the oldest
-// heights that are below pruning height
+// heights that are below pruning height, at most
+// MaxHistoricalInfoPrunedPerBlock of them per call.
 func (k Keeper) TrackHistoricalInfo(ctx context.Context) error {
 	entryNum, err := k.HistoricalEntries(ctx)
 	if err != nil {
@@ -21,19 +30,14 @@ func (k Keeper) TrackHistoricalInfo(ctx context.Context) error {
 	// Prune store to ensure we only have parameter-defined historical entries.
 	// In most cases, this will involve removing a single historical entry.
 	// In the rare scenario when the historical entries gets reduced to a lower value k'
-	// from the original value k. k - k' entries must be deleted from the store.
-	// Since the entries to be deleted are always in a continuous range, we can iterate
-	// over the historical entries starting from the most recent version to be pruned
-	// and then return at the first empty entry.
-	for i := headerInfo.Height - int64(entryNum); i >= 0; i-- {
-		has, err := k.HistoricalInfo.Has(ctx, uint64(i))
-		if err != nil {
+	// from the original value k, k - k' entries must be deleted from the store.
+	// The oldest entries are deleted first, in bounded batches, so that the
+	// backlog is caught up over the following blocks without stalling any of them.
+	if pruneHeight := headerInfo.Height - int64(entryNum); pruneHeight >= 0 {
+		if err := k.pruneHistoricalInfo(ctx, uint64(pruneHeight), MaxHistoricalInfoPrunedPerBlock); err != nil {
 			return err
 		}
-		if !has {
-			break
-		}
-		if err = k.HistoricalInfo.Remove(ctx, uint64(i)); err != nil {
+		if err := k.pruneValidatorSetChanges(ctx, uint64(pruneHeight), MaxHistoricalInfoPrunedPerBlock); err != nil {
 			return err
 		}
 	}
@@ -52,3 +56,78 @@ func (k Keeper) TrackHistoricalInfo(ctx context.Context) error {
 	// Set latest HistoricalInfo at current height
 	return k.HistoricalInfo.Set(ctx, uint64(headerInfo.Height), historicalEntry)
 }
+
+// pruneHistoricalInfo deletes the oldest historical info entries up to the
+// given height, at most limit of them.
+func (k Keeper) pruneHistoricalInfo(ctx context.Context, height uint64, limit int) error {
+	var heights []uint64
+	rng := new(collections.Range[uint64]).EndInclusive(height)
+	err := k.HistoricalInfo.Walk(ctx, rng, func(h uint64, _ types.HistoricalRecord) (bool, error) {
+		heights = append(heights, h)
+		return len(heights) >= limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, h := range heights {
+		if err := k.HistoricalInfo.Remove(ctx, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetHistoricalRecordByChainID returns the historical record tracked at the
+// given height by the chain with the given chain-id, and whether it was archived
+// across a state export. Records of the current chain are looked up first in
+// the live historical info, an empty chain-id selecting the current chain.
+func (k Keeper) GetHistoricalRecordByChainID(ctx context.Context, chainID string, height uint64) (types.HistoricalRecord, bool, error) {
+	currentChainID := k.environment.HeaderService.GetHeaderInfo(ctx).ChainID
+	if chainID == "" {
+		chainID = currentChainID
+	}
+
+	if chainID == currentChainID {
+		record, err := k.HistoricalInfo.Get(ctx, height)
+		if err == nil || !errors.Is(err, collections.ErrNotFound) {
+			return record, false, err
+		}
+	}
+
+	record, err := k.ArchivedHistoricalInfo.Get(ctx, collections.Join(chainID, height))
+	return record, true, err
+}
+
+// GetArchivedHistoricalRecords returns the archived historical records followed
+// by the live historical info of the current chain, to be kept across a state
+// export.
+func (k Keeper) GetArchivedHistoricalRecords(ctx context.Context) ([]types.ArchivedHistoricalRecord, error) {
+	var records []types.ArchivedHistoricalRecord
+	err := k.ArchivedHistoricalInfo.Walk(ctx, nil, func(key collections.Pair[string, uint64], record types.HistoricalRecord) (bool, error) {
+		records = append(records, types.ArchivedHistoricalRecord{
+			ChainId: key.K1(),
+			Height:  int64(key.K2()),
+			Record:  record,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := k.environment.HeaderService.GetHeaderInfo(ctx).ChainID
+	err = k.HistoricalInfo.Walk(ctx, nil, func(height uint64, record types.HistoricalRecord) (bool, error) {
+		records = append(records, types.ArchivedHistoricalRecord{
+			ChainId: chainID,
+			Height:  int64(height),
+			Record:  record,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -8,6 +8,8 @@ import (
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/testutil"
 	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // IsValSetSorted reports whether valset is sorted.
@@ -134,6 +136,47 @@ func (s *KeeperTestSuite) TestTrackHistoricalInfo() {
 	require.Equal(stakingtypes.HistoricalRecord{}, recv, "GetHistoricalInfo at height 5 is not empty after prune")
 }
 
+func (s *KeeperTestSuite) TestTrackHistoricalInfoBatchedPruning() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	t := time.Now().Round(0).UTC()
+	hi := stakingtypes.HistoricalRecord{
+		Time:           &t,
+		ValidatorsHash: []byte("validatorHash"),
+		Apphash:        []byte("AppHash"),
+	}
+	for h := uint64(1); h <= 250; h++ {
+		require.NoError(keeper.HistoricalInfo.Set(ctx, h, hi))
+	}
+
+	// decrease the historical entries far below the stored ones
+	params := stakingtypes.DefaultParams()
+	params.HistoricalEntries = 5
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	heights := func() []uint64 {
+		var heights []uint64
+		require.NoError(keeper.HistoricalInfo.Walk(ctx, nil, func(h uint64, _ stakingtypes.HistoricalRecord) (bool, error) {
+			heights = append(heights, h)
+			return false, nil
+		}))
+		return heights
+	}
+
+	// the oldest entries are pruned in batches over the following blocks
+	for i, oldest := range []uint64{101, 201, 249} {
+		height := int64(251 + i)
+		ctx = ctx.WithHeaderInfo(coreheader.Info{Height: height, Time: t})
+		require.NoError(keeper.TrackHistoricalInfo(ctx))
+
+		stored := heights()
+		require.Equal(oldest, stored[0])
+		require.Equal(uint64(height), stored[len(stored)-1])
+	}
+	require.Len(heights(), 5)
+}
+
 func (s *KeeperTestSuite) TestGetAllHistoricalInfo() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -171,3 +214,91 @@ func (s *KeeperTestSuite) TestGetAllHistoricalInfo() {
 	require.NoError(err)
 	require.Equal(expHistInfos, infos)
 }
+
+func (s *KeeperTestSuite) TestHistoricalRecordByChainID() {
+	keeper := s.stakingKeeper
+	require := s.Require()
+
+	ctx := s.ctx.WithHeaderInfo(coreheader.Info{ChainID: "chain-2", Height: 10})
+
+	t := time.Now().Round(0).UTC()
+	live := stakingtypes.HistoricalRecord{Time: &t, ValidatorsHash: []byte("live"), Apphash: []byte("AppHash")}
+	archived := stakingtypes.HistoricalRecord{Time: &t, ValidatorsHash: []byte("archived"), Apphash: []byte("AppHash")}
+	require.NoError(keeper.HistoricalInfo.Set(ctx, 5, live))
+	require.NoError(keeper.ArchivedHistoricalInfo.Set(ctx, collections.Join("chain-1", uint64(5)), archived))
+	require.NoError(keeper.ArchivedHistoricalInfo.Set(ctx, collections.Join("chain-2", uint64(3)), archived))
+
+	// the live historical info serves the current chain
+	recv, isArchived, err := keeper.GetHistoricalRecordByChainID(ctx, "", 5)
+	require.NoError(err)
+	require.False(isArchived)
+	require.Equal(live, recv)
+
+	// archived records serve previous chains
+	recv, isArchived, err = keeper.GetHistoricalRecordByChainID(ctx, "chain-1", 5)
+	require.NoError(err)
+	require.True(isArchived)
+	require.Equal(archived, recv)
+
+	// and the current chain before a state export that kept its chain-id
+	recv, isArchived, err = keeper.GetHistoricalRecordByChainID(ctx, "chain-2", 3)
+	require.NoError(err)
+	require.True(isArchived)
+	require.Equal(archived, recv)
+
+	_, _, err = keeper.GetHistoricalRecordByChainID(ctx, "chain-1", 4)
+	require.ErrorIs(err, collections.ErrNotFound)
+
+	// exports keep the archived records and archive the live ones
+	records, err := keeper.GetArchivedHistoricalRecords(ctx)
+	require.NoError(err)
+	require.Equal([]stakingtypes.ArchivedHistoricalRecord{
+		{ChainId: "chain-1", Height: 5, Record: archived},
+		{ChainId: "chain-2", Height: 3, Record: archived},
+		{ChainId: "chain-2", Height: 5, Record: live},
+	}, records)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryHistoricalInfos() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	t := time.Now().Round(0).UTC()
+	var entries []stakingtypes.HistoricalInfoEntry
+	for height := int64(1); height <= 10; height++ {
+		record := stakingtypes.HistoricalRecord{Time: &t, ValidatorsHash: []byte{byte(height)}, Apphash: []byte("AppHash")}
+		require.NoError(keeper.HistoricalInfo.Set(ctx, uint64(height), record))
+		entries = append(entries, stakingtypes.HistoricalInfoEntry{Height: height, HistoricalRecord: record})
+	}
+
+	res, err := queryClient.HistoricalInfos(ctx, &stakingtypes.QueryHistoricalInfosRequest{})
+	require.NoError(err)
+	require.Equal(entries, res.HistoricalInfos)
+
+	res, err = queryClient.HistoricalInfos(ctx, &stakingtypes.QueryHistoricalInfosRequest{MinHeight: 3, MaxHeight: 6})
+	require.NoError(err)
+	require.Equal(entries[2:6], res.HistoricalInfos)
+
+	// the range is paginated
+	res, err = queryClient.HistoricalInfos(ctx, &stakingtypes.QueryHistoricalInfosRequest{
+		MinHeight:  3,
+		Pagination: &query.PageRequest{Limit: 5},
+	})
+	require.NoError(err)
+	require.Equal(entries[2:7], res.HistoricalInfos)
+	require.NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.HistoricalInfos(ctx, &stakingtypes.QueryHistoricalInfosRequest{
+		MinHeight:  3,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal(entries[7:], res.HistoricalInfos)
+	require.Nil(res.Pagination.NextKey)
+
+	_, err = queryClient.HistoricalInfos(ctx, &stakingtypes.QueryHistoricalInfosRequest{MinHeight: -1})
+	require.ErrorContains(err, "height cannot be negative")
+
+	_, err = queryClient.HistoricalInfos(ctx, &stakingtypes.QueryHistoricalInfosRequest{MinHeight: 6, MaxHeight: 3})
+	require.ErrorContains(err, "max height 3 is lower than min height 6")
+}
@@ -73,14 +73,21 @@ type Keeper struct {
 	authKeeper            types.AccountKeeper
 	bankKeeper            types.BankKeeper
 	hooks                 types.StakingHooks
+	slashedTokensHandler  types.SlashedTokensHandler
+	livenessProvider      types.ValidatorLivenessProvider
 	authority             string
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
+	shareAudit            *shareAuditor
 
 	Schema collections.Schema
 
 	// HistoricalInfo key: Height | value: HistoricalInfo
 	HistoricalInfo collections.Map[uint64, types.HistoricalRecord]
+	// ArchivedHistoricalInfo key: ChainID+Height | value: HistoricalRecord
+	ArchivedHistoricalInfo collections.Map[collections.Pair[string, uint64], types.HistoricalRecord]
+	// ValidatorSetChanges key: Height | value: ValidatorSetChanges
+	ValidatorSetChanges collections.Map[uint64, types.ValidatorSetChanges]
 	// LastTotalPower value: LastTotalPower
 	LastTotalPower collections.Item[math.Int]
 	// ValidatorUpdates value: ValidatorUpdates
@@ -114,6 +121,12 @@ type Keeper struct {
 	RedelegationQueue collections.Map[time.Time, types.DVVTriplets]
 	// ValidatorQueue key: len(timestamp bytes)+timestamp+height | value: ValAddresses
 	ValidatorQueue collections.Map[collections.Triple[uint64, time.Time, uint64], types.ValAddresses]
+	// EpochMsgQueue key: id | value: QueuedMsg
+	EpochMsgQueue collections.Map[uint64, types.QueuedMsg]
+	// EpochMsgSequence value: the id of the next queued message
+	EpochMsgSequence collections.Sequence
+	// PendingCommissionChanges key: valAddr | value: PendingCommissionChange
+	PendingCommissionChanges collections.Map[sdk.ValAddress, types.PendingCommissionChange]
 	// LastValidatorPower key: valAddr | value: power(gogotypes.Int64Value())
 	LastValidatorPower collections.Map[[]byte, gogotypes.Int64Value]
 	// Params key: ParamsKeyPrefix | value: Params
@@ -169,9 +182,16 @@ func NewKeeper(
 		authority:             authority,
 		validatorAddressCodec: validatorAddressCodec,
 		consensusAddressCodec: consensusAddressCodec,
+		shareAudit:            newShareAuditor(),
 		LastTotalPower:        collections.NewItem(sb, types.LastTotalPowerKey, "last_total_power", sdk.IntValue),
 		HistoricalInfo:        collections.NewMap(sb, types.HistoricalInfoKey, "historical_info", collections.Uint64Key, HistoricalInfoCodec(cdc)),
-		ValidatorUpdates:      collections.NewItem(sb, types.ValidatorUpdatesKey, "validator_updates", codec.CollValue[types.ValidatorUpdates](cdc)),
+		ArchivedHistoricalInfo: collections.NewMap(
+			sb, types.ArchivedHistoricalInfoKey, "archived_historical_info",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.HistoricalRecord](cdc),
+		),
+		ValidatorSetChanges: collections.NewMap(sb, types.ValidatorSetChangesKey, "validator_set_changes", collections.Uint64Key, codec.CollValue[types.ValidatorSetChanges](cdc)),
+		ValidatorUpdates:    collections.NewItem(sb, types.ValidatorUpdatesKey, "validator_updates", codec.CollValue[types.ValidatorUpdates](cdc)),
 		Delegations: collections.NewMap(
 			sb, types.DelegationKey, "delegations",
 			collections.PairKeyCodec(
@@ -260,6 +280,11 @@ func NewKeeper(
 			),
 			codec.CollValue[types.ValAddresses](cdc),
 		),
+		// key format is: 68 | id
+		EpochMsgQueue:    collections.NewMap(sb, types.EpochMsgQueueKey, "epoch_msg_queue", collections.Uint64Key, codec.CollValue[types.QueuedMsg](cdc)),
+		EpochMsgSequence: collections.NewSequence(sb, types.EpochMsgSequenceKey, "epoch_msg_sequence"),
+		// key format is: 70 | valAddr
+		PendingCommissionChanges: collections.NewMap(sb, types.PendingCommissionChangesKey, "pending_commission_changes", sdk.ValAddressKey, codec.CollValue[types.PendingCommissionChange](cdc)),
 		// key is: 113 (it's a direct prefix)
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 
@@ -339,6 +364,29 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetSlashedTokensHandler sets the handler of slashed tokens. When no handler
+// is set, slashed tokens are burned. Like SetHooks, this method must take a
+// pointer so that it can be called after the keeper was created.
+func (k *Keeper) SetSlashedTokensHandler(h types.SlashedTokensHandler) {
+	if k.slashedTokensHandler != nil {
+		panic("cannot set slashed tokens handler twice")
+	}
+
+	k.slashedTokensHandler = h
+}
+
+// SetValidatorLivenessProvider sets the provider of the liveness of validators
+// returned by the ValidatorPerformance query. When no provider is set, the
+// query returns no liveness. Like SetHooks, this method must take a pointer so
+// that it can be called after the keeper was created.
+func (k *Keeper) SetValidatorLivenessProvider(p types.ValidatorLivenessProvider) {
+	if k.livenessProvider != nil {
+		panic("cannot set validator liveness provider twice")
+	}
+
+	k.livenessProvider = p
+}
+
 // GetAuthority returns the x/staking module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
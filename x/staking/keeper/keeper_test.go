@@ -124,6 +124,26 @@ func (s *KeeperTestSuite) TestParams() {
 	require.True(expParams.Equal(resParams))
 }
 
+func (s *KeeperTestSuite) TestMigrate5to6() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	// params stored before the cooldown and liquid staking params existed
+	// have a zero cooldown and nil liquid staking params
+	params := stakingtypes.DefaultParams()
+	params.CommissionChangeCooldown = 0
+	params.ValidatorBondFactor = math.LegacyDec{}
+	params.GlobalLiquidStakingCap = math.LegacyDec{}
+	params.ValidatorLiquidStakingCap = math.LegacyDec{}
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	require.NoError(stakingkeeper.NewMigrator(keeper).Migrate5to6(ctx))
+	resParams, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	require.Equal(24*time.Hour, resParams.CommissionChangeCooldown)
+	require.Equal(stakingtypes.DefaultParams(), resParams)
+}
+
 func (s *KeeperTestSuite) TestLastTotalPower() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -267,7 +287,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"e8edda47ad7a074e00bbdce0ba0f624ba2e84652f5ab88cfa92f18230801bd96",
 	)
 	s.Require().NoError(err)
 
@@ -282,7 +302,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"e8edda47ad7a074e00bbdce0ba0f624ba2e84652f5ab88cfa92f18230801bd96",
 	)
 	s.Require().NoError(err)
 }
@@ -300,7 +320,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"0937e39bef242bf04d587159fe322b9148ab60842d95203a174095a168495400",
 	)
 	s.Require().NoError(err)
 
@@ -313,7 +333,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"0937e39bef242bf04d587159fe322b9148ab60842d95203a174095a168495400",
 	)
 
 	s.Require().NoError(err)
@@ -332,7 +352,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81", // this hash obtained when ran this test in main branch
+		"487959997084f1aed1462688d1f9e2c1d59a9d167ed46e6ffb8eec017f5306f5", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -345,7 +365,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81",
+		"487959997084f1aed1462688d1f9e2c1d59a9d167ed46e6ffb8eec017f5306f5",
 	)
 
 	s.Require().NoError(err)
@@ -376,7 +396,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"d30702b35b00df89aea5a10e1bd4e1f5ce11b5d8fba7e4365059c93b888f0b2f",
 	)
 	s.Require().NoError(err)
 
@@ -400,7 +420,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"d30702b35b00df89aea5a10e1bd4e1f5ce11b5d8fba7e4365059c93b888f0b2f",
 	)
 	s.Require().NoError(err)
 }
@@ -417,7 +437,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"1eee81bbd7357e5d884e1513455fc8e349b82d9839a2b21bcbb18243560731d0",
 	)
 	s.Require().NoError(err)
 
@@ -430,7 +450,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"1eee81bbd7357e5d884e1513455fc8e349b82d9839a2b21bcbb18243560731d0",
 	)
 	s.Require().NoError(err)
 }
@@ -464,7 +484,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"fc521203403cc892ac3ced038618809f99b23c631387a7ce5326799922a34c90",
 	)
 	s.Require().NoError(err)
 
@@ -490,7 +510,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"fc521203403cc892ac3ced038618809f99b23c631387a7ce5326799922a34c90",
 	)
 	s.Require().NoError(err)
 }
@@ -513,7 +533,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"4825111fa9d86a623ff3caac80f5393273a7000149622c3c6052bfdcd3aaefb9",
 	)
 	s.Require().NoError(err)
 
@@ -528,7 +548,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"4825111fa9d86a623ff3caac80f5393273a7000149622c3c6052bfdcd3aaefb9",
 	)
 	s.Require().NoError(err)
 }
@@ -556,7 +576,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"ea035c9281d3ad3dbd8fa88e606301a655b44402dcef324e76823ac95c597f1d",
 	)
 	s.Require().NoError(err)
 
@@ -578,7 +598,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"ea035c9281d3ad3dbd8fa88e606301a655b44402dcef324e76823ac95c597f1d",
 	)
 	s.Require().NoError(err)
 }
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsLiquidStakingProvider returns true if the delegations of the given account
+// are liquid staked, the account being listed in the liquid staking providers
+// param.
+func (k Keeper) IsLiquidStakingProvider(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	addrStr, err := k.authKeeper.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(params.LiquidStakingProviders, addrStr), nil
+}
+
+// ValidatorBondShares returns the validator bond of a validator, the shares of
+// its self-delegation.
+func (k Keeper) ValidatorBondShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	delegation, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(valAddr), valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	} else if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return delegation.Shares, nil
+}
+
+// ValidatorLiquidShares returns the liquid staked shares of a validator, the
+// shares delegated to it by the liquid staking providers.
+func (k Keeper) ValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	shares := math.LegacyZeroDec()
+	for _, provider := range params.LiquidStakingProviders {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(provider)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		delegation, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(delAddr), valAddr))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		shares = shares.Add(delegation.Shares)
+	}
+
+	return shares, nil
+}
+
+// TotalLiquidStakedTokens returns the tokens delegated by the liquid staking
+// providers, across all validators.
+func (k Keeper) TotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	tokens := math.LegacyZeroDec()
+	err := k.walkLiquidStakingDelegations(ctx, func(delegation types.Delegation) error {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		tokens = tokens.Add(validator.TokensFromShares(delegation.Shares))
+		return nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return tokens.TruncateInt(), nil
+}
+
+// CheckLiquidStakingCaps returns an error if the liquid staked shares of the
+// validator exceed its validator bond multiplied by the validator bond factor,
+// or the validator liquid staking cap, or if the liquid staked tokens exceed
+// the global liquid staking cap.
+//
+// The keeper checks the caps whenever a delegation of a liquid staking
+// provider increases. Tokenization providers should call it before minting
+// liquid staking derivatives for delegations they did not create through the
+// keeper, such as delegations received before they were listed.
+func (k Keeper) CheckLiquidStakingCaps(ctx context.Context, valAddr sdk.ValAddress) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(params.LiquidStakingProviders) == 0 {
+		return nil
+	}
+
+	liquidShares, err := k.ValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if !params.ValidatorBondFactor.IsNegative() {
+		if err := k.checkValidatorBond(ctx, valAddr, liquidShares, params.ValidatorBondFactor); err != nil {
+			return err
+		}
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	maxValidatorShares := validator.DelegatorShares.Mul(params.ValidatorLiquidStakingCap)
+	if liquidShares.GT(maxValidatorShares) {
+		return errorsmod.Wrapf(
+			types.ErrValidatorLiquidStakingCapExceeded,
+			"liquid staked shares %s, max %s", liquidShares, maxValidatorShares,
+		)
+	}
+
+	liquidTokens, err := k.TotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	bondedTokens, err := k.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxLiquidTokens := math.LegacyNewDecFromInt(bondedTokens).Mul(params.GlobalLiquidStakingCap)
+	if math.LegacyNewDecFromInt(liquidTokens).GT(maxLiquidTokens) {
+		return errorsmod.Wrapf(
+			types.ErrGlobalLiquidStakingCapExceeded,
+			"liquid staked tokens %s, max %s", liquidTokens, maxLiquidTokens.TruncateInt(),
+		)
+	}
+
+	return nil
+}
+
+// checkValidatorBondUnbond returns an error if the self-delegation of a
+// validator was unbonded below the validator bond required by its liquid
+// staked shares.
+func (k Keeper) checkValidatorBondUnbond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if !delAddr.Equals(sdk.AccAddress(valAddr)) {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(params.LiquidStakingProviders) == 0 || params.ValidatorBondFactor.IsNegative() {
+		return nil
+	}
+
+	liquidShares, err := k.ValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.checkValidatorBond(ctx, valAddr, liquidShares, params.ValidatorBondFactor)
+}
+
+func (k Keeper) checkValidatorBond(ctx context.Context, valAddr sdk.ValAddress, liquidShares, factor math.LegacyDec) error {
+	bondShares, err := k.ValidatorBondShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	maxLiquidShares := bondShares.Mul(factor)
+	if liquidShares.GT(maxLiquidShares) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValidatorBond,
+			"liquid staked shares %s, max %s for a validator bond of %s shares", liquidShares, maxLiquidShares, bondShares,
+		)
+	}
+
+	return nil
+}
+
+// checkLiquidStakingDelegation checks the liquid staking caps of the validator
+// if the delegator is a liquid staking provider.
+func (k Keeper) checkLiquidStakingDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	isProvider, err := k.IsLiquidStakingProvider(ctx, delAddr)
+	if err != nil || !isProvider {
+		return err
+	}
+
+	return k.CheckLiquidStakingCaps(ctx, valAddr)
+}
+
+// walkLiquidStakingDelegations calls fn for each delegation of the liquid
+// staking providers.
+func (k Keeper) walkLiquidStakingDelegations(ctx context.Context, fn func(types.Delegation) error) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, provider := range params.LiquidStakingProviders {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(provider)
+		if err != nil {
+			return err
+		}
+
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr)
+		err = k.Delegations.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], delegation types.Delegation) (bool, error) {
+			return false, fn(delegation)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
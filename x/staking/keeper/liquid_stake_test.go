@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestLiquidStakingCaps() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	// the validator bond is 100 shares
+	pk := ed25519.GenPrivKey().PubKey()
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	provider, delegator := sdk.AccAddress(PKS[1].Address()), sdk.AccAddress(PKS[2].Address())
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.NotBondedPoolName, gomock.Any()).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	bondedTokens := math.NewInt(1000)
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).DoAndReturn(
+		func(context.Context, sdk.AccAddress, string) sdk.Coin {
+			return sdk.NewCoin(sdk.DefaultBondDenom, bondedTokens)
+		},
+	).AnyTimes()
+
+	// the state changes of failed messages are discarded, as in a transaction
+	delegate := func(delAddr sdk.AccAddress, amount int64) error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := msgServer.Delegate(cacheCtx, types.NewMsgDelegate(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+		if err == nil {
+			write()
+		}
+		return err
+	}
+	setParams := func(factor, validatorCap, globalCap math.LegacyDec) {
+		params, err := keeper.Params.Get(ctx)
+		require.NoError(err)
+		params.ValidatorBondFactor = factor
+		params.ValidatorLiquidStakingCap = validatorCap
+		params.GlobalLiquidStakingCap = globalCap
+		params.LiquidStakingProviders = []string{s.addressToString(provider)}
+		require.NoError(keeper.Params.Set(ctx, params))
+	}
+
+	// the liquid staked shares may reach half of the validator bond
+	setParams(math.LegacyNewDecWithPrec(5, 1), math.LegacyOneDec(), math.LegacyOneDec())
+	require.NoError(delegate(provider, 40))
+	require.ErrorIs(delegate(provider, 20), types.ErrInsufficientValidatorBond)
+
+	shares, err := keeper.ValidatorLiquidShares(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(40), shares)
+	tokens, err := keeper.TotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(40), tokens)
+
+	// the delegations of other accounts are not liquid staked
+	require.NoError(delegate(delegator, 200))
+
+	// 60 of the 360 delegator shares is above the validator cap of 15%
+	setParams(types.DefaultValidatorBondFactor, math.LegacyNewDecWithPrec(15, 2), math.LegacyOneDec())
+	require.ErrorIs(delegate(provider, 20), types.ErrValidatorLiquidStakingCapExceeded)
+
+	// 60 of the 100 bonded tokens is above the global cap of 50%
+	bondedTokens = math.NewInt(100)
+	setParams(types.DefaultValidatorBondFactor, math.LegacyOneDec(), math.LegacyNewDecWithPrec(5, 1))
+	require.ErrorIs(delegate(provider, 20), types.ErrGlobalLiquidStakingCapExceeded)
+
+	setParams(types.DefaultValidatorBondFactor, math.LegacyOneDec(), math.LegacyOneDec())
+	require.NoError(delegate(provider, 20))
+
+	// the validator bond cannot be unbonded below the liquid staked shares
+	setParams(math.LegacyOneDec(), math.LegacyOneDec(), math.LegacyOneDec())
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, err = keeper.Undelegate(cacheCtx, Addr, ValAddr, math.LegacyNewDec(50))
+	require.ErrorIs(err, types.ErrInsufficientValidatorBond)
+	_, _, err = keeper.Undelegate(ctx, Addr, ValAddr, math.LegacyNewDec(40))
+	require.NoError(err)
+
+	// the transfer of a delegation to a provider is capped as well
+	authority := s.addressToString(authtypes.NewModuleAddress(types.GovModuleName))
+	_, err = msgServer.TransferDelegation(ctx, types.NewMsgTransferDelegation(authority, s.addressToString(delegator), s.addressToString(provider), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	require.ErrorIs(err, types.ErrInsufficientValidatorBond)
+}
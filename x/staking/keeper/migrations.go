@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v5 "cosmossdk.io/x/staking/migrations/v5"
+	"cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -40,3 +41,19 @@ func (m Migrator) Migrate4to5(ctx context.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.environment.KVStoreService.OpenKVStore(ctx))
 	return v5.MigrateStore(ctx, store, m.keeper.cdc, m.keeper.Logger())
 }
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6. It sets
+// the commission change cooldown param to the 24 hours previously enforced,
+// and the liquid staking params to their defaults, disabling the caps.
+func (m Migrator) Migrate5to6(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.CommissionChangeCooldown = types.DefaultCommissionChangeCooldown
+	params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -27,6 +27,10 @@ import (
 
 type msgServer struct {
 	*Keeper
+
+	// executingEpochMsgs is set when executing the messages queued until the
+	// end of the epoch, which must not be queued again.
+	executingEpochMsgs bool
 }
 
 // NewMsgServerImpl returns an implementation of the staking MsgServer interface
@@ -39,6 +43,14 @@ var _ types.MsgServer = msgServer{}
 
 // CreateValidator defines a method for creating a new validator
 func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error) {
+	_, queued, err := k.queueEpochMsg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgCreateValidatorResponse{}, nil
+	}
+
 	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
@@ -209,17 +221,29 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 	validator.Description = description
 
 	if msg.CommissionRate != nil {
-		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
+		params, err := k.Params.Get(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		// call the before-modification hook since we're about to update the commission
-		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
-			return nil, err
-		}
+		if params.CommissionChangeNoticePeriod > 0 {
+			// the change takes effect at the end of the notice period
+			if err := k.queueCommissionChange(ctx, validator, *msg.CommissionRate, params.CommissionChangeNoticePeriod); err != nil {
+				return nil, err
+			}
+		} else {
+			commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
+			if err != nil {
+				return nil, err
+			}
 
-		validator.Commission = commission
+			// call the before-modification hook since we're about to update the commission
+			if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+				return nil, err
+			}
+
+			validator.Commission = commission
+		}
 	}
 
 	if msg.MinSelfDelegation != nil {
@@ -252,6 +276,14 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 
 // Delegate defines a method for performing a delegation of coins from a delegator to a validator
 func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	_, queued, err := k.queueEpochMsg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgDelegateResponse{}, nil
+	}
+
 	valAddr, valErr := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
 	if valErr != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", valErr)
@@ -315,8 +347,128 @@ func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types
 	return &types.MsgDelegateResponse{}, nil
 }
 
+// DelegateMulti defines a method for performing a delegation of coins from a
+// delegator split across a weighted list of validators. The delegations are
+// all performed or none is.
+func (k msgServer) DelegateMulti(ctx context.Context, msg *types.MsgDelegateMulti) (*types.MsgDelegateMultiResponse, error) {
+	res, queued, err := k.queueEpochMsg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		// the amounts delegated by the dry run of the message
+		return res.(*types.MsgDelegateMultiResponse), nil
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid delegation amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	maxValidators, err := k.MaxValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Validators) == 0 || len(msg.Validators) > int(maxValidators) {
+		return nil, types.ErrInvalidValidatorWeights.Wrapf("got %d validators, expected between 1 and %d", len(msg.Validators), maxValidators)
+	}
+
+	validators := make([]types.Validator, len(msg.Validators))
+	seen := make(map[string]bool, len(msg.Validators))
+	totalWeight := math.LegacyZeroDec()
+	for i, weighted := range msg.Validators {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(weighted.ValidatorAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+
+		if seen[string(valAddr)] {
+			return nil, types.ErrInvalidValidatorWeights.Wrapf("duplicate validator %s", weighted.ValidatorAddress)
+		}
+		seen[string(valAddr)] = true
+
+		if weighted.Weight.IsNil() || !weighted.Weight.IsPositive() {
+			return nil, types.ErrInvalidValidatorWeights.Wrapf("weight of validator %s must be positive", weighted.ValidatorAddress)
+		}
+		totalWeight = totalWeight.Add(weighted.Weight)
+
+		validators[i], err = k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !totalWeight.Equal(math.LegacyOneDec()) {
+		return nil, types.ErrInvalidValidatorWeights.Wrapf("weights sum to %s, expected 1", totalWeight)
+	}
+
+	// the remainder left by truncating the amounts goes to the first validator
+	amounts := make([]math.Int, len(msg.Validators))
+	remainder := msg.Amount.Amount
+	for i, weighted := range msg.Validators {
+		amounts[i] = weighted.Weight.MulInt(msg.Amount.Amount).TruncateInt()
+		if !amounts[i].IsPositive() {
+			return nil, types.ErrTinyDelegationSplit.Wrapf("validator %s with weight %s", weighted.ValidatorAddress, weighted.Weight)
+		}
+		remainder = remainder.Sub(amounts[i])
+	}
+	amounts[0] = amounts[0].Add(remainder)
+
+	resp := &types.MsgDelegateMultiResponse{Amounts: make([]sdk.Coin, len(amounts))}
+	for i, validator := range validators {
+		// NOTE: source funds are always unbonded
+		newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, amounts[i], types.Unbonded, validator, true)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Amounts[i] = sdk.NewCoin(bondDenom, amounts[i])
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeDelegate,
+			event.NewAttribute(types.AttributeKeyValidator, msg.Validators[i].ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			event.NewAttribute(sdk.AttributeKeyAmount, resp.Amounts[i].String()),
+			event.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	telemetry.IncrCounter(float32(len(validators)), types.ModuleName, "delegate")
+
+	return resp, nil
+}
+
 // BeginRedelegate defines a method for performing a redelegation of coins from a source validator to a destination validator of given delegator
 func (k msgServer) BeginRedelegate(ctx context.Context, msg *types.MsgBeginRedelegate) (*types.MsgBeginRedelegateResponse, error) {
+	_, queued, err := k.queueEpochMsg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		// the completion time is only known once the message is executed
+		return &types.MsgBeginRedelegateResponse{}, nil
+	}
+
 	valSrcAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorSrcAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid source validator address: %s", err)
@@ -392,6 +544,16 @@ func (k msgServer) BeginRedelegate(ctx context.Context, msg *types.MsgBeginRedel
 
 // Undelegate defines a method for performing an undelegation from a delegate and a validator
 func (k msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	res, queued, err := k.queueEpochMsg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		// the amount undelegated by the dry run of the message, the completion
+		// time is only known once the message is executed
+		return &types.MsgUndelegateResponse{Amount: res.(*types.MsgUndelegateResponse).Amount}, nil
+	}
+
 	addr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
@@ -464,6 +626,14 @@ func (k msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*t
 // CancelUnbondingDelegation defines a method for canceling the unbonding delegation
 // and delegate back to the validator.
 func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.MsgCancelUnbondingDelegation) (*types.MsgCancelUnbondingDelegationResponse, error) {
+	_, queued, err := k.queueEpochMsg(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgCancelUnbondingDelegationResponse{}, nil
+	}
+
 	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
@@ -599,6 +769,17 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	// evidence accepted after the unbonding time of the stake bonded at the time of
+	// the infraction would leave the equivocation unpunished.
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: remove this
+	if cp := sdkCtx.ConsensusParams(); cp.Evidence != nil && msg.Params.UnbondingTime < cp.Evidence.MaxAgeDuration {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unbonding time %s must not be shorter than the evidence max age duration %s",
+			msg.Params.UnbondingTime, cp.Evidence.MaxAgeDuration,
+		)
+	}
+
 	// get previous staking params
 	previousParams, err := k.Params.Get(ctx)
 	if err != nil {
@@ -639,6 +820,137 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// TransferDelegation defines a governance operation for transferring a
+// delegation to another account without unbonding it.
+func (k msgServer) TransferDelegation(ctx context.Context, msg *types.MsgTransferDelegation) (*types.MsgTransferDelegationResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	recipientAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.RecipientAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TransferDelegation(ctx, delegatorAddress, recipientAddress, valAddr, shares); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferDelegation,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyRecipient, msg.RecipientAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		event.NewAttribute(types.AttributeKeyShares, shares.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferDelegationResponse{Shares: shares}, nil
+}
+
+// TransferUnbonding defines a governance operation for transferring the
+// unbonding delegation entries of a delegator to another account.
+func (k msgServer) TransferUnbonding(ctx context.Context, msg *types.MsgTransferUnbonding) (*types.MsgTransferUnbondingResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	recipientAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.RecipientAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	transferred, err := k.Keeper.TransferUnbonding(ctx, delegatorAddress, recipientAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transfer stops at the first entry on hold and at the maximum number
+	// of entries of the recipient, which may leave nothing to transfer
+	if transferred.IsZero() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"no unbonding entry can be transferred, the first one is on hold or the recipient has the maximum number of entries",
+		)
+	}
+
+	amount := sdk.NewCoin(bondDenom, transferred)
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferUnbonding,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyRecipient, msg.RecipientAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferUnbondingResponse{Amount: amount}, nil
+}
+
 func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateConsPubKey) (res *types.MsgRotateConsPubKeyResponse, err error) {
 	cv := msg.NewPubkey.GetCachedValue()
 	pk, ok := cv.(cryptotypes.PubKey)
@@ -716,3 +1028,13 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 
 	return res, nil
 }
+
+// queueEpochMsg queues msg until the end of the epoch if epoching is enabled,
+// unless the queued messages are being executed, and returns the response of
+// its dry run if it did.
+func (k msgServer) queueEpochMsg(ctx context.Context, msg sdk.Msg) (any, bool, error) {
+	if k.executingEpochMsgs {
+		return nil, false, nil
+	}
+	return k.Keeper.queueEpochMsg(ctx, msg)
+}
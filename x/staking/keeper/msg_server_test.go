@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
@@ -569,6 +570,103 @@ func (s *KeeperTestSuite) TestMsgDelegate() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgDelegateMulti() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	addr2 := sdk.AccAddress(PKS[1].Address())
+	valAddr1, valAddr2 := s.valAddressToString(ValAddr), s.valAddressToString(sdk.ValAddress(addr2))
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addr2, types.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	for _, valAddr := range []string{valAddr1, valAddr2} {
+		msg, err := types.NewMsgCreateValidator(valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+		require.NoError(err)
+		_, err = msgServer.CreateValidator(ctx, msg)
+		require.NoError(err)
+	}
+
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 101)
+	half := math.LegacyNewDecWithPrec(5, 1)
+	delegateMulti := func(validators ...types.WeightedValidator) *types.MsgDelegateMulti {
+		return types.NewMsgDelegateMulti(s.addressToString(Addr), amount, validators)
+	}
+	invalidDenom := delegateMulti(types.NewWeightedValidator(valAddr1, math.LegacyOneDec()))
+	invalidDenom.Amount.Denom = "test"
+	tinyAmount := delegateMulti(types.NewWeightedValidator(valAddr1, math.LegacyNewDecWithPrec(999, 3)), types.NewWeightedValidator(valAddr2, math.LegacyNewDecWithPrec(1, 3)))
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgDelegateMulti
+		expErrMsg string
+	}{
+		{
+			name:      "invalid coin denom",
+			input:     invalidDenom,
+			expErrMsg: "invalid coin denomination",
+		},
+		{
+			name:      "no validators",
+			input:     delegateMulti(),
+			expErrMsg: "got 0 validators",
+		},
+		{
+			name:      "duplicate validator",
+			input:     delegateMulti(types.NewWeightedValidator(valAddr1, half), types.NewWeightedValidator(valAddr1, half)),
+			expErrMsg: "duplicate validator",
+		},
+		{
+			name:      "zero weight",
+			input:     delegateMulti(types.NewWeightedValidator(valAddr1, math.LegacyOneDec()), types.NewWeightedValidator(valAddr2, math.LegacyZeroDec())),
+			expErrMsg: "must be positive",
+		},
+		{
+			name:      "weights not summing to 1",
+			input:     delegateMulti(types.NewWeightedValidator(valAddr1, half), types.NewWeightedValidator(valAddr2, math.LegacyNewDecWithPrec(4, 1))),
+			expErrMsg: "weights sum to 0.900000000000000000",
+		},
+		{
+			name:      "validator does not exist",
+			input:     delegateMulti(types.NewWeightedValidator(valAddr1, half), types.NewWeightedValidator(s.valAddressToString([]byte("val")), half)),
+			expErrMsg: "validator does not exist",
+		},
+		{
+			name:      "amount truncating to zero",
+			input:     tinyAmount,
+			expErrMsg: "too few tokens to split across the validators",
+		},
+		{
+			name:  "valid msg",
+			input: delegateMulti(types.NewWeightedValidator(valAddr1, half), types.NewWeightedValidator(valAddr2, half)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			before, err := keeper.Delegations.Get(ctx, collections.Join(Addr, ValAddr))
+			require.NoError(err)
+
+			res, err := msgServer.DelegateMulti(ctx, tc.input)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+
+			// the remainder is delegated to the first validator
+			require.Equal([]sdk.Coin{sdk.NewInt64Coin(sdk.DefaultBondDenom, 51), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}, res.Amounts)
+			after, err := keeper.Delegations.Get(ctx, collections.Join(Addr, ValAddr))
+			require.NoError(err)
+			require.Equal(math.LegacyNewDec(51), after.Shares.Sub(before.Shares))
+			del, err := keeper.Delegations.Get(ctx, collections.Join(Addr, sdk.ValAddress(addr2)))
+			require.NoError(err)
+			require.Equal(math.LegacyNewDec(50), del.Shares)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgBeginRedelegate() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
@@ -1038,6 +1136,12 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 	require.NoError(err)
 	paramsWithUpdatedMinCommissionRate := types.DefaultParams()
 	paramsWithUpdatedMinCommissionRate.MinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+	paramsWithUnbondingTimeBelowEvidenceMaxAge := types.DefaultParams()
+	paramsWithUnbondingTimeBelowEvidenceMaxAge.UnbondingTime = 24 * time.Hour
+
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Evidence: &cmtproto.EvidenceParams{MaxAgeNumBlocks: 100000, MaxAgeDuration: 48 * time.Hour},
+	})
 
 	testCases := []struct {
 		name      string
@@ -1172,6 +1276,14 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			},
 			expErrMsg: "unbonding time must not be negative",
 		},
+		{
+			name: "unbonding time shorter than evidence max age",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params:    paramsWithUnbondingTimeBelowEvidenceMaxAge,
+			},
+			expErrMsg: "must not be shorter than the evidence max age duration",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1192,6 +1304,179 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgTransferDelegation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	delAddr, recipientAddr := sdk.AccAddress(PKS[1].Address()), sdk.AccAddress(PKS[2].Address())
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(s.addressToString(delAddr), s.valAddressToString(ValAddr), math.LegacyNewDec(100))))
+
+	vestingAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	vestingAcc, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(vestingAddr), sdk.NewCoins(amt), time.Now().Add(time.Hour).Unix())
+	require.NoError(err)
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), vestingAddr).Return(vestingAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	authority := s.addressToString(authtypes.NewModuleAddress(types.GovModuleName))
+	transfer := func(from, to sdk.AccAddress, amount int64) *types.MsgTransferDelegation {
+		return types.NewMsgTransferDelegation(authority, s.addressToString(from), s.addressToString(to), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	invalidAuthority := transfer(delAddr, recipientAddr, 10)
+	invalidAuthority.Authority = s.addressToString(delAddr)
+	invalidDenom := transfer(delAddr, recipientAddr, 10)
+	invalidDenom.Amount.Denom = "test"
+
+	testCases := []struct {
+		name       string
+		input      *types.MsgTransferDelegation
+		expErrMsg  string
+		expDel     math.LegacyDec
+		expRecDel  math.LegacyDec
+		delRemoved bool
+	}{
+		{
+			name:      "invalid authority",
+			input:     invalidAuthority,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "invalid coin denom",
+			input:     invalidDenom,
+			expErrMsg: "invalid coin denomination",
+		},
+		{
+			name:      "zero amount",
+			input:     transfer(delAddr, recipientAddr, 0),
+			expErrMsg: "invalid shares amount",
+		},
+		{
+			name:      "amount greater than delegated shares amount",
+			input:     transfer(delAddr, recipientAddr, 101),
+			expErrMsg: "invalid shares amount",
+		},
+		{
+			name:      "self-delegation",
+			input:     transfer(Addr, recipientAddr, 10),
+			expErrMsg: types.ErrSelfDelegationTransfer.Error(),
+		},
+		{
+			name:      "transfer to the delegator",
+			input:     transfer(delAddr, delAddr, 10),
+			expErrMsg: types.ErrTransferDelegationToSelf.Error(),
+		},
+		{
+			name:      "transfer to a vesting account",
+			input:     transfer(delAddr, vestingAddr, 10),
+			expErrMsg: types.ErrTransferVestingAccount.Error(),
+		},
+		{
+			name:      "partial transfer",
+			input:     transfer(delAddr, recipientAddr, 40),
+			expDel:    math.LegacyNewDec(60),
+			expRecDel: math.LegacyNewDec(40),
+		},
+		{
+			name:       "transfer of the remaining shares",
+			input:      transfer(delAddr, recipientAddr, 60),
+			expRecDel:  math.LegacyNewDec(100),
+			delRemoved: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := msgServer.TransferDelegation(ctx, tc.input)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.input.Amount.Amount, res.Shares.RoundInt())
+
+			del, err := keeper.Delegations.Get(ctx, collections.Join(delAddr, ValAddr))
+			if tc.delRemoved {
+				require.ErrorIs(err, collections.ErrNotFound)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.expDel, del.Shares)
+			}
+
+			recDel, err := keeper.Delegations.Get(ctx, collections.Join(recipientAddr, ValAddr))
+			require.NoError(err)
+			require.Equal(tc.expRecDel, recDel.Shares)
+		})
+	}
+
+	// a delegation redelegated to the validator cannot be transferred
+	red := types.NewRedelegation(recipientAddr, sdk.ValAddress(PKS[1].Address()), ValAddr, 0, time.Unix(0, 0), math.NewInt(5), math.LegacyNewDec(5), 0, address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos"))
+	require.NoError(keeper.SetRedelegation(ctx, red))
+	_, err = msgServer.TransferDelegation(ctx, transfer(recipientAddr, delAddr, 10))
+	require.ErrorIs(err, types.ErrTransferRedelegatedDelegation)
+}
+
+func (s *KeeperTestSuite) TestMsgTransferUnbonding() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	delAddr, recipientAddr := sdk.AccAddress(PKS[1].Address()), sdk.AccAddress(PKS[2].Address())
+	completionTime := time.Unix(100, 0).UTC()
+	ubd, err := keeper.SetUnbondingDelegationEntry(ctx, delAddr, ValAddr, 1, completionTime, math.NewInt(10))
+	require.NoError(err)
+	require.NoError(keeper.InsertUBDQueue(ctx, ubd, completionTime))
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	authority := s.addressToString(authtypes.NewModuleAddress(types.GovModuleName))
+	transfer := func(from, to sdk.AccAddress, amount int64) *types.MsgTransferUnbonding {
+		return types.NewMsgTransferUnbonding(authority, s.addressToString(from), s.addressToString(to), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	invalidAuthority := transfer(delAddr, recipientAddr, 10)
+	invalidAuthority.Authority = s.addressToString(delAddr)
+	_, err = msgServer.TransferUnbonding(ctx, invalidAuthority)
+	require.ErrorContains(err, "invalid authority")
+
+	invalidDenom := transfer(delAddr, recipientAddr, 10)
+	invalidDenom.Amount.Denom = "test"
+	_, err = msgServer.TransferUnbonding(ctx, invalidDenom)
+	require.ErrorContains(err, "invalid coin denomination")
+
+	_, err = msgServer.TransferUnbonding(ctx, transfer(delAddr, recipientAddr, 0))
+	require.ErrorContains(err, "invalid amount")
+
+	_, err = msgServer.TransferUnbonding(ctx, transfer(delAddr, delAddr, 10))
+	require.ErrorIs(err, types.ErrTransferDelegationToSelf)
+
+	// the transfer is capped by the unbonding balance
+	res, err := msgServer.TransferUnbonding(ctx, transfer(delAddr, recipientAddr, 15))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), res.Amount)
+
+	recipientUbd, err := keeper.GetUnbondingDelegation(ctx, recipientAddr, ValAddr)
+	require.NoError(err)
+	require.Len(recipientUbd.Entries, 1)
+	require.Equal(math.NewInt(10), recipientUbd.Entries[0].Balance)
+
+	// entries on hold are not transferred
+	ubd, err = keeper.SetUnbondingDelegationEntry(ctx, delAddr, ValAddr, 2, completionTime, math.NewInt(10))
+	require.NoError(err)
+	ubd.Entries[0].UnbondingOnHoldRefCount++
+	require.NoError(keeper.SetUnbondingDelegation(ctx, ubd))
+	_, err = msgServer.TransferUnbonding(ctx, transfer(delAddr, recipientAddr, 10))
+	require.ErrorContains(err, "no unbonding entry can be transferred")
+}
+
 func (s *KeeperTestSuite) TestConsKeyRotn() {
 	stakingKeeper, ctx, accountKeeper, bankKeeper := s.stakingKeeper, s.ctx, s.accountKeeper, s.bankKeeper
 
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/staking/types"
+)
+
+// nodeServer implements the Node service, serving the staking data local to the
+// node. It is registered by the application rather than by the module, as its
+// responses differ between nodes.
+type nodeServer struct {
+	*Keeper
+}
+
+var _ types.NodeServer = nodeServer{}
+
+// NewNodeServer returns an implementation of the staking Node service for the
+// provided Keeper.
+func NewNodeServer(keeper *Keeper) types.NodeServer {
+	return nodeServer{Keeper: keeper}
+}
+
+// ShareAudit queries the delegation share math divergences recorded by the share audit
+// mode of the node.
+func (k nodeServer) ShareAudit(_ context.Context, _ *types.ShareAuditRequest) (*types.ShareAuditResponse, error) {
+	return k.ShareAuditReport(), nil
+}
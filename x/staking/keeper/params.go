@@ -54,3 +54,10 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	params, err := k.Params.Get(ctx)
 	return params.MinCommissionRate, err
 }
+
+// CommissionChangeCooldown - Minimum time between two commission rate changes
+// of a validator
+func (k Keeper) CommissionChangeCooldown(ctx context.Context) (time.Duration, error) {
+	params, err := k.Params.Get(ctx)
+	return params.CommissionChangeCooldown, err
+}
@@ -41,7 +41,8 @@ func (k Keeper) notBondedTokensToBonded(ctx context.Context, tokens math.Int) er
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.NotBondedPoolName, types.BondedPoolName, coins)
 }
 
-// burnBondedTokens burns coins from the bonded pool module account
+// burnBondedTokens burns coins from the bonded pool module account, or hands
+// them to the slashed tokens handler if one is set
 func (k Keeper) burnBondedTokens(ctx context.Context, amt math.Int) error {
 	if !amt.IsPositive() {
 		// skip as no coins need to be burned
@@ -54,11 +55,15 @@ func (k Keeper) burnBondedTokens(ctx context.Context, amt math.Int) error {
 	}
 
 	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, amt))
+	if k.slashedTokensHandler != nil {
+		return k.slashedTokensHandler.HandleSlashedTokens(ctx, types.BondedPoolName, coins)
+	}
 
 	return k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.BondedPoolName), coins)
 }
 
-// burnNotBondedTokens burns coins from the not bonded pool module account
+// burnNotBondedTokens burns coins from the not bonded pool module account, or
+// hands them to the slashed tokens handler if one is set
 func (k Keeper) burnNotBondedTokens(ctx context.Context, amt math.Int) error {
 	if !amt.IsPositive() {
 		// skip as no coins need to be burned
@@ -71,6 +76,9 @@ func (k Keeper) burnNotBondedTokens(ctx context.Context, amt math.Int) error {
 	}
 
 	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, amt))
+	if k.slashedTokensHandler != nil {
+		return k.slashedTokensHandler.HandleSlashedTokens(ctx, types.NotBondedPoolName, coins)
+	}
 
 	return k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.NotBondedPoolName), coins)
 }
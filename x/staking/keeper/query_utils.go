@@ -13,35 +13,38 @@ import (
 func (k Keeper) GetDelegatorValidators(
 	ctx context.Context, delegatorAddr sdk.AccAddress, maxRetrieve uint32,
 ) (types.Validators, error) {
-	validators := make([]types.Validator, maxRetrieve)
-
-	var i uint32
-	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delegatorAddr)
-	err := k.Delegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], del types.Delegation) (stop bool, err error) {
-		if i >= maxRetrieve {
-			return true, nil
+	var (
+		validators []types.Validator
+		iterErr    error
+	)
+	err := k.IterateDelegatorDelegations(ctx, delegatorAddr, func(del types.Delegation) bool {
+		if len(validators) >= int(maxRetrieve) {
+			return true
 		}
 
 		valAddr, err := k.validatorAddressCodec.StringToBytes(del.GetValidatorAddr())
 		if err != nil {
-			return false, err
+			iterErr = err
+			return true
 		}
 
 		validator, err := k.GetValidator(ctx, valAddr)
 		if err != nil {
-			return false, err
+			iterErr = err
+			return true
 		}
 
-		validators[i] = validator
-		i++
-
-		return false, nil
+		validators = append(validators, validator)
+		return false
 	})
+	if err == nil {
+		err = iterErr
+	}
 	if err != nil {
 		return types.Validators{}, err
 	}
 
-	return types.Validators{Validators: validators[:i], ValidatorCodec: k.validatorAddressCodec}, nil // trim
+	return types.Validators{Validators: validators, ValidatorCodec: k.validatorAddressCodec}, nil
 }
 
 // GetDelegatorValidator returns a validator that a delegator is bonded to
@@ -64,14 +67,9 @@ func (k Keeper) GetDelegatorValidator(
 // GetAllDelegatorDelegations returns all delegations of a delegator
 func (k Keeper) GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]types.Delegation, error) {
 	delegations := make([]types.Delegation, 0)
-
-	var i int64
-	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delegator)
-	err := k.Delegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], del types.Delegation) (stop bool, err error) {
+	err := k.IterateDelegatorDelegations(ctx, delegator, func(del types.Delegation) bool {
 		delegations = append(delegations, del)
-		i++
-
-		return false, nil
+		return false
 	})
 	if err != nil {
 		return nil, err
@@ -83,19 +81,14 @@ func (k Keeper) GetAllDelegatorDelegations(ctx context.Context, delegator sdk.Ac
 // GetAllUnbondingDelegations returns all unbonding-delegations of a delegator
 func (k Keeper) GetAllUnbondingDelegations(ctx context.Context, delegator sdk.AccAddress) ([]types.UnbondingDelegation, error) {
 	unbondingDelegations := make([]types.UnbondingDelegation, 0)
-
-	rng := collections.NewPrefixUntilPairRange[[]byte, []byte](delegator)
-	err := k.UnbondingDelegations.Walk(
-		ctx,
-		rng,
-		func(key collections.Pair[[]byte, []byte], value types.UnbondingDelegation) (stop bool, err error) {
-			unbondingDelegations = append(unbondingDelegations, value)
-			return false, nil
-		},
-	)
+	err := k.IterateDelegatorUnbondingDelegations(ctx, delegator, func(ubd types.UnbondingDelegation) bool {
+		unbondingDelegations = append(unbondingDelegations, ubd)
+		return false
+	})
 	if err != nil {
 		return nil, err
 	}
+
 	return unbondingDelegations, nil
 }
 
@@ -106,24 +99,32 @@ func (k Keeper) GetAllRedelegations(
 	srcValFilter := !(srcValAddress.Empty())
 	dstValFilter := !(dstValAddress.Empty())
 
+	var srcValAddrStr, dstValAddrStr string
+	var err error
+	if srcValFilter {
+		if srcValAddrStr, err = k.validatorAddressCodec.BytesToString(srcValAddress); err != nil {
+			return nil, err
+		}
+	}
+	if dstValFilter {
+		if dstValAddrStr, err = k.validatorAddressCodec.BytesToString(dstValAddress); err != nil {
+			return nil, err
+		}
+	}
+
 	redelegations := []types.Redelegation{}
-	rng := collections.NewPrefixedTripleRange[[]byte, []byte, []byte](delegator)
-	err := k.Redelegations.Walk(ctx, rng,
-		func(key collections.Triple[[]byte, []byte, []byte], redelegation types.Redelegation) (stop bool, err error) {
-			valSrcAddr, valDstAddr := key.K2(), key.K3()
-
-			if srcValFilter && !(srcValAddress.Equals(sdk.ValAddress(valSrcAddr))) {
-				return false, nil
-			}
-
-			if dstValFilter && !(dstValAddress.Equals(sdk.ValAddress(valDstAddr))) {
-				return false, nil
-			}
-
-			redelegations = append(redelegations, redelegation)
-			return false, nil
-		},
-	)
+	err = k.IterateDelegatorRedelegations(ctx, delegator, func(redelegation types.Redelegation) bool {
+		if srcValFilter && redelegation.ValidatorSrcAddress != srcValAddrStr {
+			return false
+		}
+
+		if dstValFilter && redelegation.ValidatorDstAddress != dstValAddrStr {
+			return false
+		}
+
+		redelegations = append(redelegations, redelegation)
+		return false
+	})
 	if err != nil {
 		return nil, err
 	}
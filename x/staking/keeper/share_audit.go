@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	// ShareAuditOperationIssueShares is the audited operation issuing shares for delegated tokens.
+	ShareAuditOperationIssueShares = "issue_shares"
+	// ShareAuditOperationRemoveShares is the audited operation returning tokens for removed shares.
+	ShareAuditOperationRemoveShares = "remove_shares"
+
+	// shareAuditRecentDivergences is the number of recent divergences kept by the share audit.
+	shareAuditRecentDivergences = 100
+	// shareAuditPrecision is the number of decimal places exact results are reported with.
+	shareAuditPrecision = 2 * math.LegacyPrecision
+)
+
+// DefaultShareAuditEpsilon is the default divergence above which a share computation
+// is reported, one unit of the last decimal place of math.LegacyDec.
+var DefaultShareAuditEpsilon = math.LegacyNewDecWithPrec(1, math.LegacyPrecision)
+
+var legacyDecMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(math.LegacyPrecision), nil)
+
+// shareAuditor recomputes the delegation share arithmetic with exact rational numbers
+// and records the computations diverging from the exact results by more than epsilon.
+//
+// The audit is local to the node: it never changes the result of the state machine
+// and its records are lost when the node restarts.
+type shareAuditor struct {
+	mtx sync.Mutex
+
+	enabled       bool
+	epsilon       *big.Rat
+	epsilonDec    math.LegacyDec
+	checks        uint64
+	divergences   uint64
+	maxDivergence *big.Rat
+	recent        []types.ShareDivergence
+}
+
+func newShareAuditor() *shareAuditor {
+	return &shareAuditor{maxDivergence: new(big.Rat)}
+}
+
+// EnableShareAudit enables the share audit mode of the keeper. Every share computed
+// for delegated tokens, and every token amount computed for removed shares, is also
+// computed exactly and compared with the result of the state machine. Differences
+// larger than epsilon are logged, counted in telemetry and served by the ShareAudit
+// method of the Node service.
+//
+// The audit has no effect on the state and can be enabled on any node, as
+// groundwork for changing the share arithmetic without consensus risk.
+func (k *Keeper) EnableShareAudit(epsilon math.LegacyDec) {
+	if epsilon.IsNil() || epsilon.IsNegative() {
+		panic("share audit epsilon must be non-negative")
+	}
+
+	k.shareAudit.mtx.Lock()
+	defer k.shareAudit.mtx.Unlock()
+
+	k.shareAudit.enabled = true
+	k.shareAudit.epsilon = legacyDecToRat(epsilon)
+	k.shareAudit.epsilonDec = epsilon
+}
+
+// ShareAuditReport returns the divergences recorded by the share audit mode.
+func (k Keeper) ShareAuditReport() *types.ShareAuditResponse {
+	a := k.shareAudit
+	if a == nil {
+		return &types.ShareAuditResponse{Epsilon: math.LegacyZeroDec(), MaxDivergence: "0"}
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	epsilon := a.epsilonDec
+	if epsilon.IsNil() {
+		epsilon = math.LegacyZeroDec()
+	}
+
+	return &types.ShareAuditResponse{
+		Enabled:           a.enabled,
+		Epsilon:           epsilon,
+		Checks:            a.checks,
+		Divergences:       a.divergences,
+		MaxDivergence:     a.maxDivergence.FloatString(shareAuditPrecision),
+		RecentDivergences: append([]types.ShareDivergence(nil), a.recent...),
+	}
+}
+
+// auditIssuedShares audits the shares issued by validator, before the delegation,
+// for the given amount of tokens.
+func (k Keeper) auditIssuedShares(ctx context.Context, validator types.Validator, amount math.Int, issuedShares math.LegacyDec) {
+	if !k.shareAudit.isEnabled() || validator.DelegatorShares.IsZero() || validator.Tokens.IsZero() {
+		return
+	}
+
+	// shares = delegatorShares * amount / tokens
+	exact := legacyDecToRat(validator.DelegatorShares)
+	exact.Mul(exact, new(big.Rat).SetInt(amount.BigInt()))
+	exact.Quo(exact, new(big.Rat).SetInt(validator.Tokens.BigInt()))
+
+	k.recordShareAudit(ctx, validator, ShareAuditOperationIssueShares, issuedShares, exact)
+}
+
+// auditRemovedShares audits the tokens returned by validator, before the removal,
+// for the given amount of shares. The tokens are audited before being truncated to
+// an integer amount, as the truncation is deliberate.
+func (k Keeper) auditRemovedShares(ctx context.Context, validator types.Validator, shares math.LegacyDec) {
+	if !k.shareAudit.isEnabled() || !validator.DelegatorShares.IsPositive() || validator.DelegatorShares.Equal(shares) {
+		return
+	}
+
+	// tokens = shares * tokens / delegatorShares
+	exact := legacyDecToRat(shares)
+	exact.Mul(exact, new(big.Rat).SetInt(validator.Tokens.BigInt()))
+	exact.Quo(exact, legacyDecToRat(validator.DelegatorShares))
+
+	k.recordShareAudit(ctx, validator, ShareAuditOperationRemoveShares, validator.TokensFromShares(shares), exact)
+}
+
+func (k Keeper) recordShareAudit(ctx context.Context, validator types.Validator, operation string, computed math.LegacyDec, exact *big.Rat) {
+	divergence := new(big.Rat).Sub(legacyDecToRat(computed), exact)
+	divergence.Abs(divergence)
+
+	a := k.shareAudit
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	a.checks++
+	if divergence.Cmp(a.epsilon) <= 0 {
+		return
+	}
+
+	a.divergences++
+	if divergence.Cmp(a.maxDivergence) > 0 {
+		a.maxDivergence = divergence
+	}
+
+	record := types.ShareDivergence{
+		Height:           k.environment.HeaderService.GetHeaderInfo(ctx).Height,
+		ValidatorAddress: validator.GetOperator(),
+		Operation:        operation,
+		Computed:         computed,
+		Exact:            exact.FloatString(shareAuditPrecision),
+		Divergence:       divergence.FloatString(shareAuditPrecision),
+	}
+	a.recent = append(a.recent, record)
+	if len(a.recent) > shareAuditRecentDivergences {
+		a.recent = a.recent[len(a.recent)-shareAuditRecentDivergences:]
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "share_audit", "divergence"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("operation", operation)},
+	)
+	k.Logger().Info(
+		"delegation share computation diverges from exact result",
+		"height", record.Height,
+		"validator", record.ValidatorAddress,
+		"operation", operation,
+		"computed", computed,
+		"exact", record.Exact,
+		"divergence", record.Divergence,
+	)
+}
+
+func (a *shareAuditor) isEnabled() bool {
+	if a == nil {
+		return false
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	return a.enabled
+}
+
+// legacyDecToRat returns the exact rational value of d.
+func legacyDecToRat(d math.LegacyDec) *big.Rat {
+	return new(big.Rat).SetFrac(d.BigInt(), legacyDecMultiplier)
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestShareAudit() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+
+	// 10 shares backed by 3 tokens, so that issuing and removing shares rounds
+	validator := testutil.NewValidator(s.T(), valAddr, valPubKey)
+	validator, _, err := keeper.AddValidatorTokensAndShares(ctx, validator, math.NewInt(10))
+	require.NoError(err)
+	validator, err = keeper.RemoveValidatorTokens(ctx, validator, math.NewInt(7))
+	require.NoError(err)
+
+	nodeServer := stakingkeeper.NewNodeServer(keeper)
+	res, err := nodeServer.ShareAudit(ctx, &stakingtypes.ShareAuditRequest{})
+	require.NoError(err)
+	require.False(res.Enabled)
+	require.Zero(res.Checks)
+
+	// the shares issued are off by less than one unit of the last decimal place
+	keeper.EnableShareAudit(stakingkeeper.DefaultShareAuditEpsilon)
+	validator, shares, err := keeper.AddValidatorTokensAndShares(ctx, validator, math.NewInt(1))
+	require.NoError(err)
+	require.Equal(math.LegacyMustNewDecFromStr("3.333333333333333333"), shares)
+
+	res, err = nodeServer.ShareAudit(ctx, &stakingtypes.ShareAuditRequest{})
+	require.NoError(err)
+	require.True(res.Enabled)
+	require.Equal(uint64(1), res.Checks)
+	require.Zero(res.Divergences)
+	require.Empty(res.RecentDivergences)
+
+	// any rounding is reported without epsilon
+	keeper.EnableShareAudit(math.LegacyZeroDec())
+	_, _, err = keeper.RemoveValidatorTokensAndShares(ctx, validator, math.LegacyOneDec())
+	require.NoError(err)
+
+	res, err = nodeServer.ShareAudit(ctx, &stakingtypes.ShareAuditRequest{})
+	require.NoError(err)
+	require.Equal(uint64(2), res.Checks)
+	require.Equal(uint64(1), res.Divergences)
+	require.Len(res.RecentDivergences, 1)
+
+	divergence := res.RecentDivergences[0]
+	require.Equal(stakingkeeper.ShareAuditOperationRemoveShares, divergence.Operation)
+	require.Equal(validator.GetOperator(), divergence.ValidatorAddress)
+	require.Equal(validator.TokensFromShares(math.LegacyOneDec()), divergence.Computed)
+	require.Equal("0.300000000000000000007500000000000000", divergence.Exact)
+	require.Equal(res.MaxDivergence, divergence.Divergence)
+	require.NotEqual("0.000000000000000000000000000000000000", divergence.Divergence)
+}
@@ -165,6 +165,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]module
 
 	var updates []abci.ValidatorUpdate
 	var moduleValidatorUpdates []module.ValidatorUpdate
+	var changes []types.ValidatorSetChange
 	for count := 0; iterator.Valid() && count < int(maxValidators); iterator.Next() {
 		// everything that is iterated in this loop is becoming or already a
 		// part of the bonded validator set
@@ -220,6 +221,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]module
 			if err = k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
 				return nil, err
 			}
+
+			change := types.ValidatorSetChange{ValidatorAddress: valAddrStr, ChangeType: types.ValidatorSetChangeJoined, Power: newPower}
+			if found {
+				change.ChangeType = types.ValidatorSetChangePowerChanged
+				change.PreviousPower = k.lastPowerFromBytes(oldPowerBytes)
+			}
+			changes = append(changes, change)
 		}
 
 		delete(last, valAddrStr)
@@ -253,6 +261,15 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]module
 
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 		moduleValidatorUpdates = append(moduleValidatorUpdates, validator.ModuleValidatorUpdateZero())
+		changes = append(changes, types.ValidatorSetChange{
+			ValidatorAddress: validator.GetOperator(),
+			ChangeType:       types.ValidatorSetChangeLeft,
+			PreviousPower:    k.lastPowerFromBytes(last[validator.GetOperator()]),
+		})
+	}
+
+	if err := k.recordValidatorSetChanges(ctx, changes...); err != nil {
+		return nil, err
 	}
 
 	// ApplyAndReturnValidatorSetUpdates checks if there is ConsPubKeyRotationHistory
@@ -405,6 +422,13 @@ func (k Keeper) jailValidator(ctx context.Context, validator types.Validator) er
 		return err
 	}
 
+	if err := k.recordValidatorSetChanges(ctx, types.ValidatorSetChange{
+		ValidatorAddress: validator.GetOperator(),
+		ChangeType:       types.ValidatorSetChangeJailed,
+	}); err != nil {
+		return err
+	}
+
 	return k.DeleteValidatorByPowerIndex(ctx, validator)
 }
 
@@ -419,6 +443,13 @@ func (k Keeper) unjailValidator(ctx context.Context, validator types.Validator)
 		return err
 	}
 
+	if err := k.recordValidatorSetChanges(ctx, types.ValidatorSetChange{
+		ValidatorAddress: validator.GetOperator(),
+		ChangeType:       types.ValidatorSetChangeUnjailed,
+	}); err != nil {
+		return err
+	}
+
 	return k.SetValidatorByPowerIndex(ctx, validator)
 }
 
@@ -570,6 +601,14 @@ func (k Keeper) getLastValidatorsByAddr(ctx context.Context) (validatorsByAddr,
 	return last, nil
 }
 
+// lastPowerFromBytes returns the power of a last validator power record of the
+// last validator set.
+func (k Keeper) lastPowerFromBytes(bz []byte) int64 {
+	var power gogotypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &power)
+	return power.GetValue()
+}
+
 // given a map of remaining validators to previous bonded power
 // returns the list of validators to be unbonded, sorted by operator address
 func sortNoLongerBonded(last validatorsByAddr, ac address.Codec) ([][]byte, error) {
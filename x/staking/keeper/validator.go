@@ -133,7 +133,10 @@ func (k Keeper) AddValidatorTokensAndShares(ctx context.Context, validator types
 		return valOut, addedShares, err
 	}
 
+	validatorBefore := validator
 	validator, addedShares = validator.AddTokensFromDel(tokensToAdd)
+	k.auditIssuedShares(ctx, validatorBefore, tokensToAdd, addedShares)
+
 	err = k.SetValidator(ctx, validator)
 	if err != nil {
 		return validator, addedShares, err
@@ -151,6 +154,7 @@ func (k Keeper) RemoveValidatorTokensAndShares(ctx context.Context, validator ty
 	if err != nil {
 		return valOut, removedTokens, err
 	}
+	k.auditRemovedShares(ctx, validator, sharesToRemove)
 	validator, removedTokens = validator.RemoveDelShares(sharesToRemove)
 	err = k.SetValidator(ctx, validator)
 	if err != nil {
@@ -189,15 +193,17 @@ func (k Keeper) UpdateValidatorCommission(ctx context.Context,
 	commission := validator.Commission
 	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
 
-	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
 		return commission, err
 	}
 
-	minCommissionRate, err := k.MinCommissionRate(ctx)
-	if err != nil {
+	if err := commission.ValidateNewRate(newRate, blockTime, params.CommissionChangeCooldown); err != nil {
 		return commission, err
 	}
 
+	minCommissionRate := params.MinCommissionRate
+
 	if newRate.LT(minCommissionRate) {
 		return commission, fmt.Errorf("cannot set validator commission to less than minimum rate of %s", minCommissionRate)
 	}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/staking/types"
+)
+
+// recordValidatorSetChanges appends changes to the changes of the bonded
+// validator set at the current height. Like the historical info, the changes
+// are kept for the last HistoricalEntries heights, and not recorded if the
+// param is zero.
+func (k Keeper) recordValidatorSetChanges(ctx context.Context, changes ...types.ValidatorSetChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	entryNum, err := k.HistoricalEntries(ctx)
+	if err != nil {
+		return err
+	}
+	if entryNum == 0 {
+		return nil
+	}
+
+	height := uint64(k.environment.HeaderService.GetHeaderInfo(ctx).Height)
+	record, err := k.ValidatorSetChanges.Get(ctx, height)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	record.Changes = append(record.Changes, changes...)
+	return k.ValidatorSetChanges.Set(ctx, height, record)
+}
+
+// pruneValidatorSetChanges deletes the changes of the bonded validator set of
+// the oldest heights up to the given height, at most limit of them.
+func (k Keeper) pruneValidatorSetChanges(ctx context.Context, height uint64, limit int) error {
+	var heights []uint64
+	rng := new(collections.Range[uint64]).EndInclusive(height)
+	err := k.ValidatorSetChanges.Walk(ctx, rng, func(h uint64, _ types.ValidatorSetChanges) (bool, error) {
+		heights = append(heights, h)
+		return len(heights) >= limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, h := range heights {
+		if err := k.ValidatorSetChanges.Remove(ctx, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
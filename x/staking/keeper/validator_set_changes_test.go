@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorSetChanges() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.NewQuerier(keeper)
+
+	var validators [2]stakingtypes.Validator
+	for i := range validators {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, int64(100-i)))
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validators[i]))
+	}
+
+	// both validators join the validator set
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validators[0] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[0], false)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validators[1] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[1], false)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 2)
+
+	// the power of the first validator decreases
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2})
+	validators[0], _ = validators[0].RemoveDelShares(math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 20)))
+	validators[0] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[0], false)
+	s.applyValidatorSetUpdates(ctx, keeper, 1)
+
+	// the second validator is jailed and leaves the validator set
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3})
+	require.NoError(keeper.Jail(ctx, sdk.ConsAddress(PKs[1].Address())))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 1)
+
+	// and is unjailed
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 4})
+	require.NoError(keeper.Unjail(ctx, sdk.ConsAddress(PKs[1].Address())))
+
+	val0, val1 := validators[0].GetOperator(), validators[1].GetOperator()
+	res, err := querier.ValidatorSetChanges(ctx, &stakingtypes.QueryValidatorSetChangesRequest{})
+	require.NoError(err)
+	require.Equal([]stakingtypes.ValidatorSetChangesEntry{
+		{Height: 1, Changes: []stakingtypes.ValidatorSetChange{
+			{ValidatorAddress: val0, ChangeType: stakingtypes.ValidatorSetChangeJoined, Power: 100},
+			{ValidatorAddress: val1, ChangeType: stakingtypes.ValidatorSetChangeJoined, Power: 99},
+		}},
+		{Height: 2, Changes: []stakingtypes.ValidatorSetChange{
+			{ValidatorAddress: val0, ChangeType: stakingtypes.ValidatorSetChangePowerChanged, PreviousPower: 100, Power: 80},
+		}},
+		{Height: 3, Changes: []stakingtypes.ValidatorSetChange{
+			{ValidatorAddress: val1, ChangeType: stakingtypes.ValidatorSetChangeJailed},
+			{ValidatorAddress: val1, ChangeType: stakingtypes.ValidatorSetChangeLeft, PreviousPower: 99},
+		}},
+		{Height: 4, Changes: []stakingtypes.ValidatorSetChange{
+			{ValidatorAddress: val1, ChangeType: stakingtypes.ValidatorSetChangeUnjailed},
+		}},
+	}, res.Entries)
+
+	res, err = querier.ValidatorSetChanges(ctx, &stakingtypes.QueryValidatorSetChangesRequest{MinHeight: 2, MaxHeight: 3})
+	require.NoError(err)
+	require.Len(res.Entries, 2)
+	require.Equal(int64(2), res.Entries[0].Height)
+	require.Equal(int64(3), res.Entries[1].Height)
+
+	_, err = querier.ValidatorSetChanges(ctx, &stakingtypes.QueryValidatorSetChangesRequest{MinHeight: 3, MaxHeight: 2})
+	require.ErrorContains(err, "max height 2 is lower than min height 3")
+
+	// the changes are pruned with the historical info
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.HistoricalEntries = 2
+	require.NoError(keeper.Params.Set(ctx, params))
+	require.NoError(keeper.TrackHistoricalInfo(ctx.WithHeaderInfo(header.Info{Height: 5})))
+
+	res, err = querier.ValidatorSetChanges(ctx, &stakingtypes.QueryValidatorSetChangesRequest{})
+	require.NoError(err)
+	require.Len(res.Entries, 1)
+	require.Equal(int64(4), res.Entries[0].Height)
+}
@@ -242,7 +242,12 @@ func SimulateMsgEditValidator(
 		address := val.GetOperator()
 		newCommissionRate := simtypes.RandomDecAmount(r, val.Commission.MaxRate)
 
-		if err := val.Commission.ValidateNewRate(newCommissionRate, ctx.HeaderInfo().Time); err != nil {
+		cooldown, err := k.CommissionChangeCooldown(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get the commission change cooldown"), nil, err
+		}
+
+		if err := val.Commission.ValidateNewRate(newCommissionRate, ctx.HeaderInfo().Time, cooldown); err != nil {
 			// skip as the commission is invalid
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid commission rate"), nil, nil
 		}
@@ -20,6 +20,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgRotateConsPubKey{}, "cosmos-sdk/MsgRotateConsPubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferDelegation{}, "cosmos-sdk/MsgTransferDelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferUnbonding{}, "cosmos-sdk/MsgTransferUnbonding")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateMulti{}, "cosmos-sdk/MsgDelegateMulti")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
@@ -38,6 +41,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
 		&MsgUpdateParams{},
+		&MsgTransferDelegation{},
+		&MsgTransferUnbonding{},
+		&MsgDelegateMulti{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
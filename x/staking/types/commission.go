@@ -65,12 +65,13 @@ func (cr CommissionRates) Validate() error {
 }
 
 // ValidateNewRate performs basic sanity validation checks of a new commission
-// rate. If validation fails, an SDK error is returned.
-func (c Commission) ValidateNewRate(newRate math.LegacyDec, blockTime time.Time) error {
+// rate, which cannot be set within cooldown of the last change. If validation
+// fails, an SDK error is returned.
+func (c Commission) ValidateNewRate(newRate math.LegacyDec, blockTime time.Time, cooldown time.Duration) error {
 	switch {
-	case blockTime.Sub(c.UpdateTime).Hours() < 24:
-		// new rate cannot be changed more than once within 24 hours
-		return ErrCommissionUpdateTime
+	case blockTime.Before(c.NextChangeTime(cooldown)):
+		// new rate cannot be changed more than once within the cooldown
+		return ErrCommissionUpdateTime.Wrapf("commission can be changed again at %s", c.NextChangeTime(cooldown))
 
 	case newRate.IsNegative():
 		// new rate cannot be negative
@@ -87,3 +88,15 @@ func (c Commission) ValidateNewRate(newRate math.LegacyDec, blockTime time.Time)
 
 	return nil
 }
+
+// NextChangeTime returns the time from which the rate can be changed again,
+// given the cooldown between two changes.
+func (c Commission) NextChangeTime(cooldown time.Duration) time.Time {
+	return c.UpdateTime.Add(cooldown)
+}
+
+// MaxNewRate returns the highest rate the commission can be changed to: its
+// rate increased by its max change rate, capped by its max rate.
+func (c Commission) MaxNewRate() math.LegacyDec {
+	return math.LegacyMinDec(c.Rate.Add(c.MaxChangeRate), c.MaxRate)
+}
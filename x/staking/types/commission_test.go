@@ -60,10 +60,14 @@ func TestCommissionValidateNewRate(t *testing.T) {
 		{c1, math.LegacyMustNewDecFromStr("0.50"), now.Add(48 * time.Hour), false},
 		// valid commission
 		{c1, math.LegacyMustNewDecFromStr("0.10"), now.Add(48 * time.Hour), false},
+		// invalid new commission rate; last update within the cooldown
+		{c1, math.LegacyMustNewDecFromStr("0.50"), now.Add(24*time.Hour - time.Second), true},
+		// valid commission; the cooldown elapsed
+		{c1, math.LegacyMustNewDecFromStr("0.50"), now.Add(24 * time.Hour), false},
 	}
 
 	for i, tc := range testCases {
-		err := tc.input.ValidateNewRate(tc.newRate, tc.blockTime)
+		err := tc.input.ValidateNewRate(tc.newRate, tc.blockTime, types.DefaultCommissionChangeCooldown)
 		require.Equal(
 			t, tc.expectErr, err != nil,
 			"unexpected result; tc #%d, input: %v, newRate: %s, blockTime: %s",
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = QueuedMsg{}
+	_ codectypes.UnpackInterfacesMessage = QueryQueuedMsgsResponse{}
+)
+
+// NewQueuedMsg creates a new QueuedMsg instance.
+func NewQueuedMsg(id uint64, height int64, msg sdk.Msg) (QueuedMsg, error) {
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedMsg{}, err
+	}
+
+	return QueuedMsg{Id: id, Height: height, Msg: anyMsg}, nil
+}
+
+// GetMsg returns the queued message. The message must have been unpacked.
+func (m QueuedMsg) GetMsg() (sdk.Msg, error) {
+	if m.Msg == nil {
+		return nil, errors.New("queued message cannot be empty")
+	}
+
+	msg, ok := m.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", (sdk.Msg)(nil), m.Msg.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedMsg) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryQueuedMsgsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, m := range r.Msgs {
+		if err := m.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -52,4 +52,19 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already used for a validator")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 47, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid              = errors.Register(ModuleName, 48, "consensus pubkey len is invalid")
+
+	// delegation transfer errors
+	ErrSelfDelegationTransfer        = errors.Register(ModuleName, 49, "cannot transfer the self-delegation of a validator")
+	ErrTransferDelegationToSelf      = errors.Register(ModuleName, 50, "cannot transfer a delegation to its delegator")
+	ErrTransferRedelegatedDelegation = errors.Register(ModuleName, 51, "cannot transfer a delegation with redelegations to the validator in progress")
+	ErrTransferVestingAccount        = errors.Register(ModuleName, 57, "cannot transfer the delegations of or to a vesting account")
+
+	// multi-validator delegation errors
+	ErrInvalidValidatorWeights = errors.Register(ModuleName, 52, "invalid validator weights")
+	ErrTinyDelegationSplit     = errors.Register(ModuleName, 53, "too few tokens to split across the validators (truncates to zero tokens)")
+
+	// liquid staking errors
+	ErrInsufficientValidatorBond         = errors.Register(ModuleName, 54, "insufficient validator bond for the liquid staked shares")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 55, "validator liquid staking cap exceeded")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 56, "global liquid staking cap exceeded")
 )
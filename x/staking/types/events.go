@@ -10,6 +10,12 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeTransferDelegation        = "transfer_delegation"
+	EventTypeTransferUnbonding         = "transfer_unbonding"
+	EventTypeQueueEpochMsg             = "queue_epoch_msg"
+	EventTypeEpochMsgFailed            = "epoch_msg_failed"
+	EventTypeQueueCommissionChange     = "queue_commission_change"
+	EventTypeCommissionChange          = "commission_change"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,4 +26,11 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyRecipient         = "recipient"
+	AttributeKeyShares            = "shares"
+	AttributeKeyQueuedMsgID       = "queued_msg_id"
+	AttributeKeyMsgTypeURL        = "msg_type_url"
+	AttributeKeyEpochEndHeight    = "epoch_end_height"
+	AttributeKeyError             = "error"
+	AttributeKeyEffectiveTime     = "effective_time"
 )
@@ -115,3 +115,29 @@ type StakingHooksWrapper struct{ StakingHooks }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (StakingHooksWrapper) IsOnePerModuleType() {}
+
+// SlashedTokensHandler handles the tokens removed from the bonded or not bonded
+// pool by a slash. The handler is responsible for moving the tokens out of the
+// pool, e.g. by burning them or sending them to another account.
+type SlashedTokensHandler interface {
+	HandleSlashedTokens(ctx context.Context, fromModule string, amt sdk.Coins) error
+}
+
+// SlashedTokensHandlerWrapper is a wrapper for modules to inject a SlashedTokensHandler using depinject.
+type SlashedTokensHandlerWrapper struct{ SlashedTokensHandler }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (SlashedTokensHandlerWrapper) IsOnePerModuleType() {}
+
+// ValidatorLivenessProvider provides the liveness of validators, as tracked by
+// the slashing module, to the ValidatorPerformance query. It returns nil if the
+// liveness of the validator is not tracked.
+type ValidatorLivenessProvider interface {
+	ValidatorLiveness(ctx context.Context, consAddr sdk.ConsAddress) (*ValidatorLiveness, error)
+}
+
+// ValidatorLivenessProviderWrapper is a wrapper for modules to inject a ValidatorLivenessProvider using depinject.
+type ValidatorLivenessProviderWrapper struct{ ValidatorLivenessProvider }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (ValidatorLivenessProviderWrapper) IsOnePerModuleType() {}
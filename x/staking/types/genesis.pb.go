@@ -46,6 +46,21 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// archived_historical_records defines the historical records kept across
+	// state exports, including the records of the exported chain.
+	//
+	// Since: cosmos-sdk 0.51
+	ArchivedHistoricalRecords []ArchivedHistoricalRecord `protobuf:"bytes,9,rep,name=archived_historical_records,json=archivedHistoricalRecords,proto3" json:"archived_historical_records"`
+	// queued_msgs defines the messages queued until the end of the current
+	// epoch.
+	//
+	// Since: cosmos-sdk 0.51
+	QueuedMsgs []QueuedMsg `protobuf:"bytes,10,rep,name=queued_msgs,json=queuedMsgs,proto3" json:"queued_msgs"`
+	// pending_commission_changes defines the commission rate changes announced
+	// by validators which did not take effect yet.
+	//
+	// Since: cosmos-sdk 0.51
+	PendingCommissionChanges []PendingCommissionChange `protobuf:"bytes,11,rep,name=pending_commission_changes,json=pendingCommissionChanges,proto3" json:"pending_commission_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +145,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetArchivedHistoricalRecords() []ArchivedHistoricalRecord {
+	if m != nil {
+		return m.ArchivedHistoricalRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedMsgs() []QueuedMsg {
+	if m != nil {
+		return m.QueuedMsgs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingCommissionChanges() []PendingCommissionChange {
+	if m != nil {
+		return m.PendingCommissionChanges
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -181,39 +217,46 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x11, 0x72, 0x42, 0xd4, 0x21,
-	0x2a, 0xaa, 0x4d, 0x83, 0xc4, 0xc0, 0xd6, 0x08, 0x09, 0x55, 0xaa, 0x44, 0xe5, 0x52, 0x86, 0x2e,
-	0xd1, 0x05, 0x9f, 0xdc, 0x53, 0x9c, 0x3b, 0xcb, 0xf7, 0xb5, 0x94, 0x7f, 0xc0, 0xc8, 0xc6, 0xda,
-	0x91, 0x91, 0xa1, 0x3f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x28, 0x19, 0xe0, 0x67, 0x20, 0xdf,
-	0x39, 0xe6, 0xaa, 0xd4, 0x4b, 0x94, 0xcb, 0xf7, 0xbc, 0xcf, 0xfb, 0x49, 0xb9, 0x43, 0xeb, 0x1f,
-	0x85, 0x9c, 0x0a, 0xe9, 0x4b, 0x20, 0x13, 0xc6, 0x23, 0xff, 0x64, 0x6b, 0x4c, 0x81, 0x6c, 0xf9,
-	0x11, 0xe5, 0x54, 0x32, 0xe9, 0x25, 0xa9, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xcb, 0x29, 0x2f, 0xa7,
-	0xda, 0xcd, 0x48, 0x44, 0x42, 0x21, 0x7e, 0xf6, 0x4d, 0xd3, 0xed, 0x32, 0xe7, 0x22, 0xad, 0xa9,
-	0x27, 0x9a, 0x1a, 0xe9, 0x78, 0x5e, 0xa0, 0x47, 0x0f, 0xc9, 0x94, 0x71, 0xe1, 0xab, 0x4f, 0xfd,
-	0x53, 0xef, 0x5b, 0x15, 0xad, 0xbd, 0xd5, 0x3b, 0xed, 0x03, 0x01, 0x8a, 0xb7, 0x51, 0x2d, 0x21,
-	0x29, 0x99, 0x4a, 0xc7, 0xee, 0xda, 0xfd, 0xc6, 0xc0, 0xf5, 0x6e, 0xdf, 0xd1, 0xdb, 0x53, 0xd4,
-	0x70, 0xf5, 0xe2, 0xba, 0x63, 0x7d, 0xff, 0xf3, 0x63, 0xc3, 0x0e, 0xf2, 0x20, 0x3e, 0x44, 0x0f,
-	0x62, 0x22, 0x61, 0x04, 0x02, 0x48, 0x3c, 0x4a, 0xc4, 0x27, 0x9a, 0x3a, 0x77, 0xba, 0x76, 0x7f,
-	0x6d, 0xf8, 0x22, 0x83, 0x7f, 0x5d, 0x77, 0x5a, 0xda, 0x29, 0xc3, 0x89, 0xc7, 0x84, 0x3f, 0x25,
-	0x70, 0xe4, 0xed, 0x70, 0xb8, 0x3a, 0xdf, 0x44, 0x79, 0xd9, 0x0e, 0x07, 0xed, 0xbc, 0x9f, 0x99,
-	0xde, 0x67, 0xa2, 0xbd, 0xcc, 0x83, 0x19, 0x6a, 0x29, 0xf7, 0x09, 0x89, 0x59, 0x48, 0x40, 0xa4,
-	0xda, 0x2f, 0x9d, 0x4a, 0xb7, 0xd2, 0x6f, 0x0c, 0x36, 0xca, 0xb6, 0xdd, 0x25, 0x12, 0x3e, 0x2c,
-	0x32, 0x4a, 0x65, 0x6e, 0xfe, 0x28, 0x5e, 0x1a, 0x4b, 0xbc, 0x8b, 0x50, 0xd1, 0x22, 0x9d, 0xbb,
-	0xca, 0xff, 0xac, 0xcc, 0x5f, 0x84, 0x4d, 0xad, 0x91, 0xc7, 0xef, 0x50, 0x23, 0xa4, 0x31, 0x8d,
-	0x08, 0x30, 0xc1, 0xa5, 0x53, 0x55, 0xba, 0x5e, 0x99, 0xee, 0x4d, 0x81, 0x9a, 0x3e, 0xd3, 0x80,
-	0x27, 0xa8, 0x75, 0xcc, 0xc7, 0x82, 0x87, 0x8c, 0x47, 0x23, 0x53, 0x5d, 0x53, 0xea, 0xe7, 0x65,
-	0xea, 0x83, 0x45, 0xe8, 0xf6, 0x8e, 0xe6, 0xf1, 0xf2, 0x5c, 0xe2, 0x03, 0x74, 0x2f, 0xa5, 0x66,
-	0xc9, 0x8a, 0x2a, 0x59, 0x2f, 0x2b, 0x09, 0x0c, 0xd8, 0xb4, 0xdf, 0xb4, 0xe0, 0x36, 0xaa, 0xd3,
-	0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb5, 0xfb, 0xf5, 0xa0, 0x38, 0xf7, 0x8e, 0x10, 0x5e,
-	0xfe, 0xd3, 0xf0, 0x00, 0xad, 0x90, 0x30, 0x4c, 0xa9, 0xd4, 0xf7, 0x73, 0x75, 0xe8, 0x5c, 0x9d,
-	0x6f, 0x36, 0xf3, 0x2d, 0xb6, 0xf5, 0x64, 0x1f, 0x52, 0xc6, 0xa3, 0x60, 0x01, 0xe2, 0x26, 0xaa,
-	0xfe, 0xbf, 0x84, 0x95, 0x40, 0x1f, 0x5e, 0xd7, 0xbf, 0x9c, 0x75, 0xac, 0xbf, 0x67, 0x1d, 0x6b,
-	0xf8, 0xea, 0x62, 0xe6, 0xda, 0x97, 0x33, 0xd7, 0xfe, 0x3d, 0x73, 0xed, 0xaf, 0x73, 0xd7, 0xba,
-	0x9c, 0xbb, 0xd6, 0xcf, 0xb9, 0x6b, 0x1d, 0x3e, 0xbd, 0x71, 0x4f, 0x4f, 0x8b, 0x97, 0x07, 0x9f,
-	0x13, 0x2a, 0xc7, 0x35, 0xf5, 0x84, 0x5e, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x8a, 0xed,
-	0xf3, 0xec, 0x03, 0x00, 0x00,
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x4e, 0xd4, 0x40,
+	0x1c, 0xc6, 0xb7, 0x22, 0xb0, 0xcc, 0xa2, 0xd1, 0x71, 0x31, 0x65, 0x35, 0xdd, 0x95, 0x70, 0xd8,
+	0x60, 0x68, 0x01, 0x13, 0x0f, 0xde, 0x58, 0x4c, 0x94, 0x04, 0x22, 0x2e, 0xe2, 0x81, 0x4b, 0x33,
+	0xb4, 0x93, 0xee, 0x84, 0x76, 0xa6, 0xcc, 0x7f, 0x16, 0x31, 0xbe, 0x80, 0x47, 0x1f, 0x81, 0xa3,
+	0x47, 0x0f, 0x3c, 0x04, 0x47, 0xc2, 0xc9, 0x98, 0x48, 0x0c, 0x1c, 0xf4, 0x31, 0xcc, 0xce, 0x74,
+	0x6b, 0x09, 0x5b, 0x2f, 0x9b, 0x6d, 0xff, 0xdf, 0xf7, 0xfb, 0xbe, 0x64, 0xfa, 0x1f, 0x34, 0x1f,
+	0x08, 0x48, 0x04, 0x78, 0xa0, 0xc8, 0x3e, 0xe3, 0x91, 0x77, 0xb8, 0xbc, 0x47, 0x15, 0x59, 0xf6,
+	0x22, 0xca, 0x29, 0x30, 0x70, 0x53, 0x29, 0x94, 0xc0, 0x0f, 0x8d, 0xca, 0xcd, 0x54, 0x6e, 0xa6,
+	0x6a, 0xd4, 0x23, 0x11, 0x09, 0x2d, 0xf1, 0x06, 0xff, 0x8c, 0xba, 0x51, 0xc6, 0x1c, 0xba, 0x8d,
+	0x6a, 0xd6, 0xa8, 0x7c, 0x63, 0xcf, 0x02, 0xcc, 0xe8, 0x3e, 0x49, 0x18, 0x17, 0x9e, 0xfe, 0x35,
+	0xaf, 0xe6, 0x7e, 0x4e, 0xa2, 0xe9, 0x57, 0xa6, 0xd3, 0xb6, 0x22, 0x8a, 0xe2, 0x55, 0x34, 0x91,
+	0x12, 0x49, 0x12, 0xb0, 0xad, 0x96, 0xd5, 0xae, 0xad, 0x38, 0xee, 0xe8, 0x8e, 0xee, 0x96, 0x56,
+	0x75, 0xa6, 0x4e, 0x2f, 0x9a, 0x95, 0xaf, 0xbf, 0xbf, 0x2d, 0x58, 0xdd, 0xcc, 0x88, 0x77, 0xd1,
+	0xbd, 0x98, 0x80, 0xf2, 0x95, 0x50, 0x24, 0xf6, 0x53, 0xf1, 0x81, 0x4a, 0xfb, 0x56, 0xcb, 0x6a,
+	0x4f, 0x77, 0x96, 0x06, 0xe2, 0x1f, 0x17, 0xcd, 0x19, 0xc3, 0x84, 0x70, 0xdf, 0x65, 0xc2, 0x4b,
+	0x88, 0xea, 0xb9, 0xeb, 0x5c, 0x9d, 0x9f, 0x2c, 0xa2, 0x2c, 0x6c, 0x9d, 0x2b, 0xc3, 0xbc, 0x3b,
+	0x20, 0xbd, 0x1b, 0x80, 0xb6, 0x06, 0x1c, 0xcc, 0xd0, 0x8c, 0x66, 0x1f, 0x92, 0x98, 0x85, 0x44,
+	0x09, 0x69, 0xf8, 0x60, 0x8f, 0xb5, 0xc6, 0xda, 0xb5, 0x95, 0x85, 0xb2, 0xb6, 0x1b, 0x04, 0xd4,
+	0xfb, 0xa1, 0x47, 0xa3, 0x8a, 0xcd, 0x1f, 0xc4, 0x37, 0xc6, 0x80, 0x37, 0x10, 0xca, 0x53, 0xc0,
+	0xbe, 0xad, 0xf9, 0x4f, 0xca, 0xf8, 0xb9, 0xb9, 0x88, 0x2d, 0xf8, 0xf1, 0x1b, 0x54, 0x0b, 0x69,
+	0x4c, 0x23, 0xa2, 0x98, 0xe0, 0x60, 0x8f, 0x6b, 0xdc, 0x5c, 0x19, 0xee, 0x65, 0x2e, 0x2d, 0xf2,
+	0x8a, 0x04, 0xbc, 0x8f, 0x66, 0xfa, 0x7c, 0x4f, 0xf0, 0x90, 0xf1, 0xc8, 0x2f, 0xa2, 0x27, 0x34,
+	0xfa, 0x69, 0x19, 0x7a, 0x67, 0x68, 0x1a, 0x9d, 0x51, 0xef, 0xdf, 0x9c, 0x03, 0xde, 0x41, 0x77,
+	0x24, 0x2d, 0x86, 0x4c, 0xea, 0x90, 0xf9, 0xb2, 0x90, 0x2e, 0x0d, 0x47, 0xd2, 0xaf, 0x53, 0x70,
+	0x03, 0x55, 0xe9, 0x51, 0x2a, 0xa4, 0xa2, 0xa1, 0x5d, 0x6d, 0x59, 0xed, 0x6a, 0x37, 0x7f, 0xc6,
+	0x9f, 0xd0, 0x23, 0x22, 0x83, 0x1e, 0x3b, 0xa4, 0xa1, 0xdf, 0x63, 0xa0, 0x84, 0x64, 0x01, 0x89,
+	0x7d, 0x49, 0x03, 0x21, 0x43, 0xb0, 0xa7, 0x74, 0x81, 0xa5, 0xb2, 0x02, 0xab, 0x99, 0xf5, 0x75,
+	0xee, 0xec, 0x6a, 0x63, 0xb1, 0xcc, 0x2c, 0x29, 0x11, 0x01, 0xde, 0x44, 0xb5, 0x83, 0x3e, 0xed,
+	0xd3, 0xd0, 0x4f, 0x20, 0x02, 0x1b, 0xfd, 0xff, 0xf0, 0xdf, 0x6a, 0xe9, 0x26, 0x44, 0xd7, 0x0e,
+	0xff, 0x60, 0xf8, 0x16, 0xf0, 0x11, 0x6a, 0xa4, 0xd4, 0x9c, 0x54, 0x20, 0x92, 0x84, 0x01, 0x30,
+	0xc1, 0xfd, 0xa0, 0x47, 0x78, 0x44, 0xc1, 0xae, 0x69, 0xba, 0x57, 0xba, 0x68, 0xc6, 0xb9, 0x96,
+	0x1b, 0xd7, 0xb4, 0xaf, 0x98, 0x65, 0xa7, 0xa3, 0x35, 0x30, 0xd7, 0x43, 0xf8, 0xe6, 0xa7, 0x8f,
+	0x57, 0xd0, 0x24, 0x09, 0x43, 0x49, 0xc1, 0x6c, 0xf9, 0x54, 0xc7, 0x3e, 0x3f, 0x59, 0xac, 0x67,
+	0xf9, 0xab, 0x66, 0xb2, 0xad, 0x24, 0xe3, 0x51, 0x77, 0x28, 0xc4, 0x75, 0x34, 0xfe, 0x6f, 0x95,
+	0xc7, 0xba, 0xe6, 0xe1, 0x45, 0xf5, 0xf3, 0x71, 0xb3, 0xf2, 0xe7, 0xb8, 0x59, 0xe9, 0x3c, 0x3f,
+	0xbd, 0x74, 0xac, 0xb3, 0x4b, 0xc7, 0xfa, 0x75, 0xe9, 0x58, 0x5f, 0xae, 0x9c, 0xca, 0xd9, 0x95,
+	0x53, 0xf9, 0x7e, 0xe5, 0x54, 0x76, 0x1f, 0x5f, 0xdb, 0xf6, 0xa3, 0xfc, 0xfe, 0x52, 0x1f, 0x53,
+	0x0a, 0x7b, 0x13, 0xfa, 0x22, 0x7a, 0xf6, 0x77, 0x00, 0xb2, 0x20, 0x00, 0xc7, 0x32, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -236,6 +279,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingCommissionChanges) > 0 {
+		for iNdEx := len(m.PendingCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.QueuedMsgs) > 0 {
+		for iNdEx := len(m.QueuedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ArchivedHistoricalRecords) > 0 {
+		for iNdEx := len(m.ArchivedHistoricalRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedHistoricalRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -428,6 +513,24 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.ArchivedHistoricalRecords) > 0 {
+		for _, e := range m.ArchivedHistoricalRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedMsgs) > 0 {
+		for _, e := range m.QueuedMsgs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingCommissionChanges) > 0 {
+		for _, e := range m.PendingCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -738,6 +841,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedHistoricalRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedHistoricalRecords = append(m.ArchivedHistoricalRecords, ArchivedHistoricalRecord{})
+			if err := m.ArchivedHistoricalRecords[len(m.ArchivedHistoricalRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedMsgs = append(m.QueuedMsgs, QueuedMsg{})
+			if err := m.QueuedMsgs[len(m.QueuedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommissionChanges = append(m.PendingCommissionChanges, PendingCommissionChange{})
+			if err := m.PendingCommissionChanges[len(m.PendingCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
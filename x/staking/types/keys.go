@@ -56,9 +56,15 @@ var (
 	UnbondingQueueKey    = collections.NewPrefix(65) // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = collections.NewPrefix(66) // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = collections.NewPrefix(67) // prefix for the timestamps in validator queue
+	EpochMsgQueueKey     = collections.NewPrefix(68) // prefix for the messages queued until the end of the epoch
+	EpochMsgSequenceKey  = collections.NewPrefix(69) // key for the sequence of the messages queued until the end of the epoch
 
-	HistoricalInfoKey   = collections.NewPrefix(80) // prefix for the historical info
-	ValidatorUpdatesKey = collections.NewPrefix(97) // prefix for the end block validator updates key
+	PendingCommissionChangesKey = collections.NewPrefix(70) // prefix for the commission changes pending until their effective time, by validator
+
+	HistoricalInfoKey         = collections.NewPrefix(80) // prefix for the historical info
+	ArchivedHistoricalInfoKey = collections.NewPrefix(82) // prefix for the historical info archived across chain-id changes
+	ValidatorSetChangesKey    = collections.NewPrefix(83) // prefix for the changes of the bonded validator set, by height
+	ValidatorUpdatesKey       = collections.NewPrefix(97) // prefix for the end block validator updates key
 
 	ParamsKey = collections.NewPrefix(81) // prefix for parameters for module x/staking
 
@@ -20,6 +20,9 @@ var (
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgTransferDelegation{}
+	_ sdk.Msg                            = &MsgTransferUnbonding{}
+	_ sdk.Msg                            = &MsgDelegateMulti{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -112,6 +115,23 @@ func NewMsgDelegate(delAddr, valAddr string, amount sdk.Coin) *MsgDelegate {
 	}
 }
 
+// NewMsgDelegateMulti creates a new MsgDelegateMulti instance.
+func NewMsgDelegateMulti(delAddr string, amount sdk.Coin, validators []WeightedValidator) *MsgDelegateMulti {
+	return &MsgDelegateMulti{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+		Validators:       validators,
+	}
+}
+
+// NewWeightedValidator creates a new WeightedValidator instance.
+func NewWeightedValidator(valAddr string, weight math.LegacyDec) WeightedValidator {
+	return WeightedValidator{
+		ValidatorAddress: valAddr,
+		Weight:           weight,
+	}
+}
+
 // NewMsgBeginRedelegate creates a new MsgBeginRedelegate instance.
 func NewMsgBeginRedelegate(
 	delAddr, valSrcAddr, valDstAddr string, amount sdk.Coin,
@@ -143,6 +163,28 @@ func NewMsgCancelUnbondingDelegation(delAddr, valAddr string, creationHeight int
 	}
 }
 
+// NewMsgTransferDelegation creates a new MsgTransferDelegation instance.
+func NewMsgTransferDelegation(authority, delAddr, recipientAddr, valAddr string, amount sdk.Coin) *MsgTransferDelegation {
+	return &MsgTransferDelegation{
+		Authority:        authority,
+		DelegatorAddress: delAddr,
+		RecipientAddress: recipientAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgTransferUnbonding creates a new MsgTransferUnbonding instance.
+func NewMsgTransferUnbonding(authority, delAddr, recipientAddr, valAddr string, amount sdk.Coin) *MsgTransferUnbonding {
+	return &MsgTransferUnbonding{
+		Authority:        authority,
+		DelegatorAddress: delAddr,
+		RecipientAddress: recipientAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
 // NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
 func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
 	var pkAny *codectypes.Any
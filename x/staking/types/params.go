@@ -29,6 +29,14 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultCommissionChangeCooldown is the default minimum time between two
+	// commission rate changes of a validator.
+	DefaultCommissionChangeCooldown time.Duration = time.Hour * 24
+
+	// DefaultMaxEpochMsgsPerBlock is the default maximum number of queued
+	// messages executed at the end of a block.
+	DefaultMaxEpochMsgsPerBlock uint32 = 1000
 )
 
 var (
@@ -37,13 +45,23 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultValidatorBondFactor is set to -1, disabling the validator bond check
+	DefaultValidatorBondFactor = math.LegacyNewDec(-1)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
 )
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration,
 	maxValidators, maxEntries, historicalEntries uint32,
 	bondDenom string, minCommissionRate math.LegacyDec,
-	keyRotationFee sdk.Coin,
+	keyRotationFee sdk.Coin, commissionChangeCooldown time.Duration,
+	validatorBondFactor, globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
 ) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
@@ -53,6 +71,12 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		CommissionChangeCooldown: commissionChangeCooldown,
+
+		ValidatorBondFactor:       validatorBondFactor,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
 	}
 }
 
@@ -66,9 +90,22 @@ func DefaultParams() Params {
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
 		DefaultKeyRotationFee,
+		DefaultCommissionChangeCooldown,
+		DefaultValidatorBondFactor,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
 	)
 }
 
+// EpochMsgsPerBlock returns the maximum number of queued messages executed at
+// the end of a block, DefaultMaxEpochMsgsPerBlock if it is not set.
+func (p Params) EpochMsgsPerBlock() int {
+	if p.MaxEpochMsgsPerBlock == 0 {
+		return int(DefaultMaxEpochMsgsPerBlock)
+	}
+	return int(p.MaxEpochMsgsPerBlock)
+}
+
 // unmarshal the current staking params value from store key or panic
 func MustUnmarshalParams(cdc *codec.LegacyAmino, value []byte) Params {
 	params, err := UnmarshalParams(cdc, value)
@@ -119,6 +156,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCommissionChangeCooldown(p.CommissionChangeCooldown); err != nil {
+		return err
+	}
+
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingProviders(p.LiquidStakingProviders); err != nil {
+		return err
+	}
+
+	if err := validateCommissionChangeNoticePeriod(p.CommissionChangeNoticePeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -135,6 +196,32 @@ func validateUnbondingTime(i interface{}) error {
 	return nil
 }
 
+func validateCommissionChangeCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("commission change cooldown must not be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateCommissionChangeNoticePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("commission change notice period must not be negative: %d", v)
+	}
+
+	return nil
+}
+
 func validateMaxValidators(i interface{}) error {
 	v, ok := i.(uint32)
 	if !ok {
@@ -234,3 +321,58 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil: %s", v)
+	}
+	if v.IsNegative() && !v.Equal(math.LegacyNewDec(-1)) {
+		return fmt.Errorf("invalid validator bond factor: %s, must be -1 (disabled) or non-negative", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(kind string, i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil: %s", kind, v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", kind, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", kind, v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingProviders(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, addr := range v {
+		if strings.TrimSpace(addr) == "" {
+			return errors.New("liquid staking provider cannot be blank")
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate liquid staking provider %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
@@ -47,3 +47,33 @@ func TestValidateParams(t *testing.T) {
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
 }
+
+func TestValidateLiquidStakingParams(t *testing.T) {
+	params := types.DefaultParams()
+
+	params.ValidatorBondFactor = math.LegacyNewDec(-2)
+	require.Error(t, params.Validate())
+	params.ValidatorBondFactor = math.LegacyNewDec(250)
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	require.NoError(t, params.Validate())
+
+	params.ValidatorLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, params.Validate())
+
+	params.LiquidStakingProviders = []string{"cosmos1provider", "cosmos1provider"}
+	require.Error(t, params.Validate())
+}
+
+func TestEpochMsgsPerBlock(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, int(types.DefaultMaxEpochMsgsPerBlock), params.EpochMsgsPerBlock())
+
+	params.MaxEpochMsgsPerBlock = 10
+	require.Equal(t, 10, params.EpochMsgsPerBlock())
+}
@@ -108,6 +108,52 @@ func (Infraction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{1}
 }
 
+// ValidatorSetChangeType is the type of a change of the bonded validator set.
+//
+// Since: cosmos-sdk 0.51
+type ValidatorSetChangeType int32
+
+const (
+	// UNSPECIFIED defines an invalid change.
+	ValidatorSetChangeUnspecified ValidatorSetChangeType = 0
+	// JOINED defines a validator joining the bonded validator set.
+	ValidatorSetChangeJoined ValidatorSetChangeType = 1
+	// LEFT defines a validator leaving the bonded validator set.
+	ValidatorSetChangeLeft ValidatorSetChangeType = 2
+	// POWER_CHANGED defines a change of the power of a bonded validator.
+	ValidatorSetChangePowerChanged ValidatorSetChangeType = 3
+	// JAILED defines a validator being jailed.
+	ValidatorSetChangeJailed ValidatorSetChangeType = 4
+	// UNJAILED defines a validator being unjailed.
+	ValidatorSetChangeUnjailed ValidatorSetChangeType = 5
+)
+
+var ValidatorSetChangeType_name = map[int32]string{
+	0: "VALIDATOR_SET_CHANGE_TYPE_UNSPECIFIED",
+	1: "VALIDATOR_SET_CHANGE_TYPE_JOINED",
+	2: "VALIDATOR_SET_CHANGE_TYPE_LEFT",
+	3: "VALIDATOR_SET_CHANGE_TYPE_POWER_CHANGED",
+	4: "VALIDATOR_SET_CHANGE_TYPE_JAILED",
+	5: "VALIDATOR_SET_CHANGE_TYPE_UNJAILED",
+}
+
+var ValidatorSetChangeType_value = map[string]int32{
+	"VALIDATOR_SET_CHANGE_TYPE_UNSPECIFIED":   0,
+	"VALIDATOR_SET_CHANGE_TYPE_JOINED":        1,
+	"VALIDATOR_SET_CHANGE_TYPE_LEFT":          2,
+	"VALIDATOR_SET_CHANGE_TYPE_POWER_CHANGED": 3,
+	"VALIDATOR_SET_CHANGE_TYPE_JAILED":        4,
+	"VALIDATOR_SET_CHANGE_TYPE_UNJAILED":      5,
+}
+
+func (x ValidatorSetChangeType) String() string {
+	return proto.EnumName(ValidatorSetChangeType_name, int32(x))
+}
+
+func (ValidatorSetChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
+}
+
 // HistoricalInfo contains header and validator information for a given block.
 // It is stored as part of staking module's state, which persists the `n` most
 // recent HistoricalInfo
@@ -230,6 +276,74 @@ func (m *HistoricalRecord) GetValidatorsHash() []byte {
 	return nil
 }
 
+// ArchivedHistoricalRecord is a HistoricalRecord of a chain-id epoch, kept
+// across the state exports that change the chain-id so that validator sets can
+// be retrieved across them.
+//
+// Since: cosmos-sdk 0.51
+type ArchivedHistoricalRecord struct {
+	// chain_id is the chain-id of the chain the record was tracked by.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// height is the height of the record on that chain.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// record is the archived historical record.
+	Record HistoricalRecord `protobuf:"bytes,3,opt,name=record,proto3" json:"record"`
+}
+
+func (m *ArchivedHistoricalRecord) Reset()         { *m = ArchivedHistoricalRecord{} }
+func (m *ArchivedHistoricalRecord) String() string { return proto.CompactTextString(m) }
+func (*ArchivedHistoricalRecord) ProtoMessage()    {}
+func (*ArchivedHistoricalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
+}
+func (m *ArchivedHistoricalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedHistoricalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedHistoricalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedHistoricalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedHistoricalRecord.Merge(m, src)
+}
+func (m *ArchivedHistoricalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedHistoricalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedHistoricalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedHistoricalRecord proto.InternalMessageInfo
+
+func (m *ArchivedHistoricalRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ArchivedHistoricalRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ArchivedHistoricalRecord) GetRecord() HistoricalRecord {
+	if m != nil {
+		return m.Record
+	}
+	return HistoricalRecord{}
+}
+
 // CommissionRates defines the initial commission rates to be used for creating
 // a validator.
 type CommissionRates struct {
@@ -245,7 +359,7 @@ func (m *CommissionRates) Reset()         { *m = CommissionRates{} }
 func (m *CommissionRates) String() string { return proto.CompactTextString(m) }
 func (*CommissionRates) ProtoMessage()    {}
 func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{2}
+	return fileDescriptor_64c30c6cf92913c9, []int{3}
 }
 func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +400,7 @@ func (m *Commission) Reset()         { *m = Commission{} }
 func (m *Commission) String() string { return proto.CompactTextString(m) }
 func (*Commission) ProtoMessage()    {}
 func (*Commission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{3}
+	return fileDescriptor_64c30c6cf92913c9, []int{4}
 }
 func (m *Commission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +454,7 @@ func (m *Description) Reset()         { *m = Description{} }
 func (m *Description) String() string { return proto.CompactTextString(m) }
 func (*Description) ProtoMessage()    {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{4}
+	return fileDescriptor_64c30c6cf92913c9, []int{5}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,7 +561,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{5}
+	return fileDescriptor_64c30c6cf92913c9, []int{6}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +599,7 @@ func (m *ValAddresses) Reset()         { *m = ValAddresses{} }
 func (m *ValAddresses) String() string { return proto.CompactTextString(m) }
 func (*ValAddresses) ProtoMessage()    {}
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{6}
+	return fileDescriptor_64c30c6cf92913c9, []int{7}
 }
 func (m *ValAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,7 +647,7 @@ func (m *DVPair) Reset()         { *m = DVPair{} }
 func (m *DVPair) String() string { return proto.CompactTextString(m) }
 func (*DVPair) ProtoMessage()    {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{7}
+	return fileDescriptor_64c30c6cf92913c9, []int{8}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +685,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{8}
+	return fileDescriptor_64c30c6cf92913c9, []int{9}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +735,7 @@ func (m *DVVTriplet) Reset()         { *m = DVVTriplet{} }
 func (m *DVVTriplet) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplet) ProtoMessage()    {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{9}
+	return fileDescriptor_64c30c6cf92913c9, []int{10}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +773,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{10}
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +825,7 @@ func (m *Delegation) Reset()         { *m = Delegation{} }
 func (m *Delegation) String() string { return proto.CompactTextString(m) }
 func (*Delegation) ProtoMessage()    {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +869,7 @@ func (m *UnbondingDelegation) Reset()         { *m = UnbondingDelegation{} }
 func (m *UnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegation) ProtoMessage()    {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,7 +918,7 @@ func (m *UnbondingDelegationEntry) Reset()         { *m = UnbondingDelegationEnt
 func (m *UnbondingDelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegationEntry) ProtoMessage()    {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +995,7 @@ func (m *RedelegationEntry) Reset()         { *m = RedelegationEntry{} }
 func (m *RedelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntry) ProtoMessage()    {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1069,7 @@ func (m *Redelegation) Reset()         { *m = Redelegation{} }
 func (m *Redelegation) String() string { return proto.CompactTextString(m) }
 func (*Redelegation) ProtoMessage()    {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1001,13 +1115,60 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types2.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// commission_change_cooldown is the minimum time between two commission rate
+	// changes of a validator.
+	//
+	// Since: cosmos-sdk 0.51
+	CommissionChangeCooldown time.Duration `protobuf:"bytes,8,opt,name=commission_change_cooldown,json=commissionChangeCooldown,proto3,stdduration" json:"commission_change_cooldown"`
+	// validator_bond_factor is the maximum of the liquid staked shares of a
+	// validator as a multiple of its validator bond, the shares of its
+	// self-delegation. A negative value disables the check.
+	//
+	// Since: cosmos-sdk 0.51
+	ValidatorBondFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_factor"`
+	// global_liquid_staking_cap is the maximum fraction of the bonded tokens
+	// that may be liquid staked.
+	//
+	// Since: cosmos-sdk 0.51
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator
+	// shares of a validator that may be liquid staked.
+	//
+	// Since: cosmos-sdk 0.51
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// liquid_staking_providers are the accounts of the tokenization providers,
+	// whose delegations are liquid staked.
+	//
+	// Since: cosmos-sdk 0.51
+	LiquidStakingProviders []string `protobuf:"bytes,12,rep,name=liquid_staking_providers,json=liquidStakingProviders,proto3" json:"liquid_staking_providers,omitempty"`
+	// epoch_length is the number of blocks of an epoch. If positive, the
+	// messages changing delegations are queued and executed at the end of the
+	// epoch, the last block of which has a height multiple of epoch_length. Zero
+	// disables epoching.
+	//
+	// Since: cosmos-sdk 0.51
+	EpochLength uint64 `protobuf:"varint,13,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// commission_change_notice_period is the time between the announcement of a
+	// commission rate change by a validator and the time the change takes
+	// effect. If positive, commission rate changes are queued as pending
+	// commission changes, so that delegators are notified in advance. Zero
+	// applies the changes immediately.
+	//
+	// Since: cosmos-sdk 0.51
+	CommissionChangeNoticePeriod time.Duration `protobuf:"bytes,14,opt,name=commission_change_notice_period,json=commissionChangeNoticePeriod,proto3,stdduration" json:"commission_change_notice_period"`
+	// max_epoch_msgs_per_block is the maximum number of queued messages executed
+	// at the end of a block. The messages left are carried over to the following
+	// blocks. Zero uses the default of 1000 messages.
+	//
+	// Since: cosmos-sdk 0.51
+	MaxEpochMsgsPerBlock uint32 `protobuf:"varint,15,opt,name=max_epoch_msgs_per_block,json=maxEpochMsgsPerBlock,proto3" json:"max_epoch_msgs_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1078,6 +1239,41 @@ func (m *Params) GetKeyRotationFee() types2.Coin {
 	return types2.Coin{}
 }
 
+func (m *Params) GetCommissionChangeCooldown() time.Duration {
+	if m != nil {
+		return m.CommissionChangeCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetLiquidStakingProviders() []string {
+	if m != nil {
+		return m.LiquidStakingProviders
+	}
+	return nil
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetCommissionChangeNoticePeriod() time.Duration {
+	if m != nil {
+		return m.CommissionChangeNoticePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEpochMsgsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxEpochMsgsPerBlock
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1089,7 +1285,7 @@ func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
 func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegationResponse) ProtoMessage()    {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1144,7 +1340,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1388,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,7 +1442,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1275,24 +1471,34 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
-// ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
-// TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
-type ValidatorUpdates struct {
-	Updates []types3.ValidatorUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
-}
-
-func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
-func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
-func (*ValidatorUpdates) ProtoMessage()    {}
-func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{21}
+// ValidatorSetChange is a change of the bonded validator set.
+//
+// Since: cosmos-sdk 0.51
+type ValidatorSetChange struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// change_type is the type of the change.
+	ChangeType ValidatorSetChangeType `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=cosmos.staking.v1beta1.ValidatorSetChangeType" json:"change_type,omitempty"`
+	// previous_power is the consensus power of the validator before a JOINED,
+	// LEFT or POWER_CHANGED change, zero if it was not bonded.
+	PreviousPower int64 `protobuf:"varint,3,opt,name=previous_power,json=previousPower,proto3" json:"previous_power,omitempty"`
+	// power is the consensus power of the validator after a JOINED, LEFT or
+	// POWER_CHANGED change, zero if it is no longer bonded.
+	Power int64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorSetChange) Reset()         { *m = ValidatorSetChange{} }
+func (m *ValidatorSetChange) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetChange) ProtoMessage()    {}
+func (*ValidatorSetChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
-func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorSetChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorSetChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorUpdates.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorSetChange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1302,51 +1508,66 @@ func (m *ValidatorUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ValidatorUpdates) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorUpdates.Merge(m, src)
+func (m *ValidatorSetChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetChange.Merge(m, src)
 }
-func (m *ValidatorUpdates) XXX_Size() int {
+func (m *ValidatorSetChange) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorUpdates) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorUpdates.DiscardUnknown(m)
+func (m *ValidatorSetChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorUpdates proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorSetChange proto.InternalMessageInfo
 
-func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
+func (m *ValidatorSetChange) GetValidatorAddress() string {
 	if m != nil {
-		return m.Updates
+		return m.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-// ConsPubKeyRotationHistory contains a validator's consensus public key rotation history.
-type ConsPubKeyRotationHistory struct {
-	// operator_address defines the address of the validator's operator; bech encoded in JSON.
-	OperatorAddress []byte `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
-	// old_cons_pubkey is the old consensus public key of the validator, as a Protobuf Any.
-	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
-	// new_cons_pubkey is the new consensus public key of the validator, as a Protobuf Any.
-	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
-	// height defines the block height at which the rotation event occurred.
-	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
-	// fee holds the amount of fee deduced for the rotation.
-	Fee types2.Coin `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
+func (m *ValidatorSetChange) GetChangeType() ValidatorSetChangeType {
+	if m != nil {
+		return m.ChangeType
+	}
+	return ValidatorSetChangeUnspecified
 }
 
-func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
-func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
-func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
-func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{22}
+func (m *ValidatorSetChange) GetPreviousPower() int64 {
+	if m != nil {
+		return m.PreviousPower
+	}
+	return 0
 }
-func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+
+func (m *ValidatorSetChange) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// ValidatorSetChanges defines the changes of the bonded validator set at a
+// height, in the order they occurred.
+//
+// Since: cosmos-sdk 0.51
+type ValidatorSetChanges struct {
+	Changes []ValidatorSetChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *ValidatorSetChanges) Reset()         { *m = ValidatorSetChanges{} }
+func (m *ValidatorSetChanges) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetChanges) ProtoMessage()    {}
+func (*ValidatorSetChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *ValidatorSetChanges) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorSetChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorSetChanges.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1356,36 +1577,66 @@ func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+func (m *ValidatorSetChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetChanges.Merge(m, src)
 }
-func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+func (m *ValidatorSetChanges) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+func (m *ValidatorSetChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetChanges.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorSetChanges proto.InternalMessageInfo
 
-// ValAddrsOfRotatedConsKeys contains the array of validator addresses which rotated their keys
-// This is to block the validator's next rotation till unbonding period.
-type ValAddrsOfRotatedConsKeys struct {
-	Addresses [][]byte `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+func (m *ValidatorSetChanges) GetChanges() []ValidatorSetChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
 
-func (m *ValAddrsOfRotatedConsKeys) Reset()         { *m = ValAddrsOfRotatedConsKeys{} }
-func (m *ValAddrsOfRotatedConsKeys) String() string { return proto.CompactTextString(m) }
-func (*ValAddrsOfRotatedConsKeys) ProtoMessage()    {}
-func (*ValAddrsOfRotatedConsKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{23}
-}
-func (m *ValAddrsOfRotatedConsKeys) XXX_Unmarshal(b []byte) error {
+// ValidatorLiveness is the liveness of a validator over the signing window, as
+// tracked by the slashing module.
+//
+// Since: cosmos-sdk 0.51
+type ValidatorLiveness struct {
+	// signed_blocks_window is the number of blocks of the signing window.
+	SignedBlocksWindow int64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// min_signed_per_window is the minimum fraction of the blocks of the window
+	// the validator must sign not to be jailed for downtime.
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+	// start_height is the height at which the validator was first bonded or was
+	// last unjailed.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// window_blocks is the number of blocks of the signing window elapsed since
+	// start_height, at most signed_blocks_window.
+	WindowBlocks int64 `protobuf:"varint,4,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// missed_blocks_counter is the number of blocks of the signing window missed
+	// by the validator.
+	MissedBlocksCounter int64 `protobuf:"varint,5,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// uptime is the fraction of the window_blocks signed by the validator. It is
+	// one if window_blocks is zero.
+	Uptime cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=uptime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"uptime"`
+	// jailed_until is the time until which the validator is jailed for downtime.
+	JailedUntil time.Time `protobuf:"bytes,7,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// tombstoned is whether the validator was tombstoned, i.e. permanently
+	// removed from the validator set for double signing.
+	Tombstoned bool `protobuf:"varint,8,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+}
+
+func (m *ValidatorLiveness) Reset()         { *m = ValidatorLiveness{} }
+func (m *ValidatorLiveness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiveness) ProtoMessage()    {}
+func (*ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *ValidatorLiveness) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValAddrsOfRotatedConsKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValAddrsOfRotatedConsKeys.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorLiveness.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1395,909 +1646,1369 @@ func (m *ValAddrsOfRotatedConsKeys) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ValAddrsOfRotatedConsKeys) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValAddrsOfRotatedConsKeys.Merge(m, src)
+func (m *ValidatorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiveness.Merge(m, src)
 }
-func (m *ValAddrsOfRotatedConsKeys) XXX_Size() int {
+func (m *ValidatorLiveness) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValAddrsOfRotatedConsKeys) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValAddrsOfRotatedConsKeys.DiscardUnknown(m)
+func (m *ValidatorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiveness.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValAddrsOfRotatedConsKeys proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorLiveness proto.InternalMessageInfo
 
-func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
+func (m *ValidatorLiveness) GetSignedBlocksWindow() int64 {
 	if m != nil {
-		return m.Addresses
+		return m.SignedBlocksWindow
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
-	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
-	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
-	proto.RegisterType((*HistoricalRecord)(nil), "cosmos.staking.v1beta1.HistoricalRecord")
-	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
-	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
-	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
-	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
-	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
-	proto.RegisterType((*DVPair)(nil), "cosmos.staking.v1beta1.DVPair")
-	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.v1beta1.DVPairs")
-	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
-	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
-	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
-	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
-	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
-	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
-	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
-	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
-	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
-	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
-	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
-	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
-	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
-	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
-	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+func (m *ValidatorLiveness) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("cosmos/staking/v1beta1/staking.proto", fileDescriptor_64c30c6cf92913c9)
+func (m *ValidatorLiveness) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
 }
 
-var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x5b, 0x49,
-	0x1d, 0xcf, 0xb3, 0x5d, 0x27, 0xf9, 0xdb, 0x89, 0x9d, 0xe9, 0x97, 0xe3, 0xee, 0xc6, 0xae, 0xb7,
-	0xb0, 0x6d, 0xa1, 0x0e, 0x2d, 0xa8, 0x87, 0x80, 0x40, 0x75, 0xec, 0x6e, 0xbd, 0x1f, 0x49, 0x78,
-	0x4e, 0x02, 0xcb, 0xd7, 0xd3, 0xf8, 0xbd, 0xb1, 0xfd, 0x88, 0x3d, 0xcf, 0xbc, 0x19, 0xb7, 0xf5,
-	0x9d, 0xc3, 0x2a, 0x2b, 0xa4, 0x3d, 0x01, 0x12, 0xaa, 0xa8, 0xc4, 0x65, 0xb9, 0xed, 0xa1, 0xe2,
-	0xce, 0x6d, 0x41, 0x42, 0xaa, 0x7a, 0x42, 0x48, 0x04, 0xd4, 0x1e, 0xba, 0x82, 0x0b, 0xe2, 0xc4,
-	0x11, 0xcd, 0xbc, 0x79, 0x1f, 0x8e, 0xe3, 0xe6, 0xa3, 0x2b, 0xb4, 0x62, 0x2f, 0x91, 0x67, 0xe6,
-	0xff, 0xff, 0xbd, 0xff, 0xf7, 0xcc, 0xff, 0x1f, 0xb8, 0x64, 0x3a, 0xac, 0xe7, 0xb0, 0x65, 0xc6,
-	0xf1, 0x8e, 0x4d, 0xdb, 0xcb, 0x77, 0xaf, 0x37, 0x09, 0xc7, 0xd7, 0xfd, 0x75, 0xb9, 0xef, 0x3a,
-	0xdc, 0x41, 0xe7, 0x3c, 0xaa, 0xb2, 0xbf, 0xab, 0xa8, 0xf2, 0x67, 0xda, 0x4e, 0xdb, 0x91, 0x24,
-	0xcb, 0xe2, 0x97, 0x47, 0x9d, 0x5f, 0x6c, 0x3b, 0x4e, 0xbb, 0x4b, 0x96, 0xe5, 0xaa, 0x39, 0x68,
-	0x2d, 0x63, 0x3a, 0x54, 0x47, 0x4b, 0xfb, 0x8f, 0xac, 0x81, 0x8b, 0xb9, 0xed, 0x50, 0x75, 0x5e,
-	0xd8, 0x7f, 0xce, 0xed, 0x1e, 0x61, 0x1c, 0xf7, 0xfa, 0x3e, 0xb6, 0x27, 0x89, 0xe1, 0x7d, 0x54,
-	0x89, 0xa5, 0xb0, 0x95, 0x2a, 0x4d, 0xcc, 0x48, 0xa0, 0x87, 0xe9, 0xd8, 0x3e, 0xf6, 0x02, 0xee,
-	0xd9, 0xd4, 0x59, 0x96, 0x7f, 0xd5, 0xd6, 0x2b, 0x9c, 0x50, 0x8b, 0xb8, 0x3d, 0x9b, 0xf2, 0x65,
-	0x3e, 0xec, 0x13, 0xe6, 0xfd, 0x55, 0xa7, 0x17, 0x22, 0xa7, 0xb8, 0x69, 0xda, 0xd1, 0xc3, 0xd2,
-	0x2f, 0x34, 0x98, 0xbf, 0x63, 0x33, 0xee, 0xb8, 0xb6, 0x89, 0xbb, 0x75, 0xda, 0x72, 0xd0, 0xd7,
-	0x21, 0xd9, 0x21, 0xd8, 0x22, 0x6e, 0x4e, 0x2b, 0x6a, 0x97, 0x53, 0x37, 0x72, 0xe5, 0x10, 0xa0,
-	0xec, 0xf1, 0xde, 0x91, 0xe7, 0x95, 0xd9, 0x8f, 0xf7, 0x0a, 0x53, 0x1f, 0x3e, 0xff, 0xe8, 0xaa,
-	0xa6, 0x2b, 0x16, 0x54, 0x85, 0xe4, 0x5d, 0xdc, 0x65, 0x84, 0xe7, 0x62, 0xc5, 0xf8, 0xe5, 0xd4,
-	0x8d, 0x8b, 0xe5, 0x83, 0x6d, 0x5e, 0xde, 0xc6, 0x5d, 0xdb, 0xc2, 0xdc, 0x19, 0x45, 0xf1, 0x78,
-	0x57, 0x62, 0x39, 0xad, 0xf4, 0xbe, 0x06, 0xd9, 0x50, 0x32, 0x9d, 0x98, 0x8e, 0x6b, 0xa1, 0x1c,
-	0x4c, 0xe3, 0x7e, 0xbf, 0x83, 0x59, 0x47, 0x0a, 0x97, 0xd6, 0xfd, 0x25, 0xfa, 0x1a, 0x24, 0x84,
-	0x91, 0x73, 0x31, 0x29, 0x73, 0xbe, 0xec, 0x79, 0xa0, 0xec, 0x7b, 0xa0, 0xbc, 0xe9, 0x7b, 0xa0,
-	0x92, 0xf8, 0xe0, 0x6f, 0x05, 0x4d, 0x97, 0xd4, 0xe8, 0x75, 0xc8, 0xdc, 0xf5, 0x05, 0x61, 0x86,
-	0xc4, 0x8d, 0x4b, 0xdc, 0xf9, 0x70, 0xfb, 0x0e, 0x66, 0x9d, 0xd2, 0xcf, 0x63, 0x90, 0x59, 0x75,
-	0x7a, 0x3d, 0x9b, 0x31, 0xdb, 0xa1, 0x3a, 0xe6, 0x84, 0xa1, 0x37, 0x21, 0xe1, 0x62, 0x4e, 0xa4,
-	0x24, 0xb3, 0x95, 0x9b, 0x42, 0x8d, 0xbf, 0xec, 0x15, 0x2e, 0x78, 0x0a, 0x33, 0x6b, 0xa7, 0x6c,
-	0x3b, 0xcb, 0x3d, 0xcc, 0x3b, 0xe5, 0xb7, 0x49, 0x1b, 0x9b, 0xc3, 0x2a, 0x31, 0x9f, 0x3c, 0xba,
-	0x06, 0xca, 0x1e, 0x55, 0x62, 0x7a, 0x3a, 0x4b, 0x0c, 0xf4, 0x6d, 0x98, 0xe9, 0xe1, 0xfb, 0x86,
-	0xc4, 0x8b, 0xbd, 0x14, 0xde, 0x74, 0x0f, 0xdf, 0x17, 0xf2, 0xa1, 0x1f, 0x41, 0x46, 0x40, 0x9a,
-	0x1d, 0x4c, 0xdb, 0xc4, 0x43, 0x8e, 0xbf, 0x14, 0xf2, 0x5c, 0x0f, 0xdf, 0x5f, 0x95, 0x68, 0x02,
-	0x7f, 0x25, 0xf1, 0xc9, 0xc3, 0x82, 0x56, 0xfa, 0xbd, 0x06, 0x10, 0x1a, 0x06, 0x61, 0xc8, 0x9a,
-	0xc1, 0x4a, 0x7e, 0x94, 0xa9, 0x30, 0x7a, 0x7d, 0x52, 0x24, 0xec, 0x33, 0x6b, 0x65, 0x4e, 0x88,
-	0xf7, 0x78, 0xaf, 0xa0, 0x79, 0x5f, 0xcd, 0x98, 0x63, 0x66, 0x4f, 0x0d, 0xfa, 0x16, 0xe6, 0xc4,
-	0x38, 0xa2, 0xc3, 0x25, 0xa0, 0x70, 0xba, 0x07, 0x08, 0x1e, 0xb7, 0x38, 0x57, 0x3a, 0x7c, 0xa8,
-	0x41, 0xaa, 0x4a, 0x98, 0xe9, 0xda, 0x7d, 0x91, 0xc4, 0x22, 0xca, 0x7a, 0x0e, 0xb5, 0x77, 0x54,
-	0x0a, 0xcc, 0xea, 0xfe, 0x12, 0xe5, 0x61, 0xc6, 0xb6, 0x08, 0xe5, 0x36, 0x1f, 0x7a, 0x6e, 0xd2,
-	0x83, 0xb5, 0xe0, 0xba, 0x47, 0x9a, 0xcc, 0xf6, 0xed, 0xac, 0xfb, 0x4b, 0x74, 0x05, 0xb2, 0x8c,
-	0x98, 0x03, 0xd7, 0xe6, 0x43, 0xc3, 0x74, 0x28, 0xc7, 0x26, 0xcf, 0x25, 0x24, 0x49, 0xc6, 0xdf,
-	0x5f, 0xf5, 0xb6, 0x05, 0x88, 0x45, 0x38, 0xb6, 0xbb, 0x2c, 0x77, 0xca, 0x03, 0x51, 0x4b, 0x25,
-	0xea, 0xee, 0x34, 0xcc, 0x06, 0xa9, 0x83, 0x56, 0x21, 0xeb, 0xf4, 0x89, 0x2b, 0x7e, 0x1b, 0xd8,
-	0xb2, 0x5c, 0xc2, 0x98, 0x8a, 0xc6, 0xdc, 0x93, 0x47, 0xd7, 0xce, 0x28, 0x83, 0xdf, 0xf2, 0x4e,
-	0x1a, 0xdc, 0xb5, 0x69, 0x5b, 0xcf, 0xf8, 0x1c, 0x6a, 0x1b, 0xbd, 0x2b, 0x5c, 0x46, 0x19, 0xa1,
-	0x6c, 0xc0, 0x8c, 0xfe, 0xa0, 0xb9, 0x43, 0x86, 0xca, 0xa8, 0x67, 0xc6, 0x8c, 0x7a, 0x8b, 0x0e,
-	0x2b, 0xb9, 0x3f, 0x86, 0xd0, 0xa6, 0x3b, 0xec, 0x73, 0xa7, 0xbc, 0x31, 0x68, 0xbe, 0x45, 0x86,
-	0xc2, 0x55, 0x0a, 0x67, 0x43, 0xc2, 0xa0, 0x73, 0x90, 0xfc, 0x31, 0xb6, 0xbb, 0xc4, 0x92, 0x16,
-	0x99, 0xd1, 0xd5, 0x0a, 0xad, 0x40, 0x92, 0x71, 0xcc, 0x07, 0x4c, 0x9a, 0x61, 0xfe, 0x46, 0x69,
-	0x52, 0x6c, 0x54, 0x1c, 0x6a, 0x35, 0x24, 0xa5, 0xae, 0x38, 0xd0, 0x2a, 0x24, 0xb9, 0xb3, 0x43,
-	0xa8, 0x32, 0x50, 0xe5, 0x4b, 0x2a, 0x9a, 0xcf, 0x8e, 0x47, 0x73, 0x9d, 0xf2, 0x48, 0x1c, 0xd7,
-	0x29, 0xd7, 0x15, 0x2b, 0xfa, 0x01, 0x64, 0x2d, 0xd2, 0x25, 0x6d, 0x69, 0x39, 0xd6, 0xc1, 0x2e,
-	0x61, 0xb9, 0xa4, 0x84, 0xbb, 0x7e, 0xec, 0xe4, 0xd0, 0x33, 0x01, 0x54, 0x43, 0x22, 0xa1, 0x0d,
-	0x48, 0x59, 0x61, 0x38, 0xe5, 0xa6, 0xa5, 0x31, 0x5f, 0x9b, 0xa4, 0x63, 0x24, 0xf2, 0xa2, 0xb5,
-	0x30, 0x0a, 0x21, 0x22, 0x68, 0x40, 0x9b, 0x0e, 0xb5, 0x6c, 0xda, 0x36, 0x3a, 0xc4, 0x6e, 0x77,
-	0x78, 0x6e, 0xa6, 0xa8, 0x5d, 0x8e, 0xeb, 0x99, 0x60, 0xff, 0x8e, 0xdc, 0x46, 0x1b, 0x30, 0x1f,
-	0x92, 0xca, 0x0c, 0x99, 0x3d, 0x6e, 0x86, 0xcc, 0x05, 0x00, 0x82, 0x04, 0xbd, 0x03, 0x10, 0xe6,
-	0x60, 0x0e, 0x24, 0x5a, 0xe9, 0xf0, 0x6c, 0x8e, 0x2a, 0x13, 0x01, 0x40, 0xdf, 0x87, 0xd3, 0x3d,
-	0x9b, 0x1a, 0x8c, 0x74, 0x5b, 0x86, 0xb2, 0x9c, 0xc0, 0x4d, 0x1d, 0xdf, 0x9b, 0x0b, 0x3d, 0x9b,
-	0x36, 0x48, 0xb7, 0x55, 0x0d, 0x50, 0xd0, 0x37, 0xe0, 0x42, 0xa8, 0xbd, 0x43, 0x8d, 0x8e, 0xd3,
-	0xb5, 0x0c, 0x97, 0xb4, 0x0c, 0xd3, 0x19, 0x50, 0x9e, 0x4b, 0x4b, 0x9b, 0x9d, 0x0f, 0x48, 0xd6,
-	0xe9, 0x1d, 0xa7, 0x6b, 0xe9, 0xa4, 0xb5, 0x2a, 0x8e, 0xd1, 0x6b, 0x10, 0xaa, 0x6e, 0xd8, 0x16,
-	0xcb, 0xcd, 0x15, 0xe3, 0x97, 0x13, 0x7a, 0x3a, 0xd8, 0xac, 0x5b, 0x6c, 0x65, 0xe6, 0xbd, 0x87,
-	0x85, 0xa9, 0x4f, 0x1e, 0x16, 0xa6, 0x4a, 0xb7, 0x21, 0xbd, 0x8d, 0xbb, 0x2a, 0x8f, 0x08, 0x43,
-	0x37, 0x61, 0x16, 0xfb, 0x8b, 0x9c, 0x56, 0x8c, 0xbf, 0x30, 0x0f, 0x43, 0xd2, 0xd2, 0x6f, 0x35,
-	0x48, 0x56, 0xb7, 0x37, 0xb0, 0xed, 0xa2, 0x1a, 0x2c, 0x84, 0x81, 0x79, 0xd4, 0x94, 0x0e, 0x63,
-	0xd9, 0xcf, 0xe9, 0x35, 0x58, 0x08, 0x2e, 0xb0, 0x00, 0xc6, 0xbb, 0x57, 0x2e, 0x3e, 0x79, 0x74,
-	0xed, 0x55, 0x05, 0x13, 0x54, 0x92, 0x7d, 0x78, 0x77, 0xf7, 0xed, 0x47, 0x74, 0x7e, 0x13, 0xa6,
-	0x3d, 0x51, 0x19, 0xfa, 0x16, 0x9c, 0xea, 0x8b, 0x1f, 0x52, 0xd5, 0xd4, 0x8d, 0xa5, 0x89, 0x01,
-	0x2e, 0xe9, 0xa3, 0xe1, 0xe0, 0xf1, 0x95, 0xde, 0x8f, 0x01, 0x54, 0xb7, 0xb7, 0x37, 0x5d, 0xbb,
-	0xdf, 0x25, 0xfc, 0xd3, 0xd2, 0x7d, 0x0b, 0xce, 0x86, 0xba, 0x33, 0xd7, 0x3c, 0xbe, 0xfe, 0xa7,
-	0x03, 0xfe, 0x86, 0x6b, 0x1e, 0x08, 0x6b, 0x31, 0x1e, 0xc0, 0xc6, 0x8f, 0x0f, 0x5b, 0x65, 0x7c,
-	0xdc, 0xb2, 0xdf, 0x85, 0x54, 0x68, 0x0c, 0x86, 0xea, 0x30, 0xc3, 0xd5, 0x6f, 0x65, 0xe0, 0xd2,
-	0x64, 0x03, 0xfb, 0x6c, 0x51, 0x23, 0x07, 0xec, 0xa5, 0xff, 0x68, 0x00, 0x91, 0x1c, 0xf9, 0x6c,
-	0xc6, 0x18, 0xaa, 0x43, 0x52, 0x55, 0xe2, 0xf8, 0x49, 0x2b, 0xb1, 0x02, 0x88, 0x18, 0xf5, 0x67,
-	0x31, 0x38, 0xbd, 0xe5, 0x67, 0xef, 0x67, 0xdf, 0x06, 0x5b, 0x30, 0x4d, 0x28, 0x77, 0x6d, 0x69,
-	0x04, 0xe1, 0xf3, 0xaf, 0x4c, 0xf2, 0xf9, 0x01, 0x4a, 0xd5, 0x28, 0x77, 0x87, 0xd1, 0x08, 0xf0,
-	0xb1, 0x22, 0xf6, 0xf8, 0x55, 0x1c, 0x72, 0x93, 0x58, 0xc5, 0x6b, 0xd8, 0x74, 0x89, 0xdc, 0xf0,
-	0x2f, 0x19, 0x4d, 0x16, 0xcc, 0x79, 0x7f, 0x5b, 0xdd, 0x31, 0x3a, 0x88, 0x57, 0x99, 0x08, 0x2e,
-	0x41, 0x7a, 0xb2, 0x67, 0xd8, 0x7c, 0x88, 0x20, 0x6f, 0x99, 0x4d, 0xc8, 0xd8, 0xd4, 0xe6, 0x36,
-	0xee, 0x1a, 0x4d, 0xdc, 0xc5, 0xd4, 0xf4, 0x9f, 0xab, 0xc7, 0xba, 0x12, 0xe6, 0x15, 0x46, 0xc5,
-	0x83, 0x40, 0x35, 0x98, 0xf6, 0xd1, 0x12, 0xc7, 0x47, 0xf3, 0x79, 0xd1, 0x45, 0x48, 0x47, 0x2f,
-	0x06, 0xf9, 0xf4, 0x48, 0xe8, 0xa9, 0xc8, 0xbd, 0x70, 0xd8, 0xcd, 0x93, 0x7c, 0xe1, 0xcd, 0xa3,
-	0x5e, 0x77, 0xbf, 0x8e, 0xc3, 0x82, 0x4e, 0xac, 0xff, 0x7f, 0xb7, 0x6c, 0x00, 0x78, 0xa9, 0x2a,
-	0x2a, 0xa9, 0xf2, 0xcc, 0x09, 0xf2, 0x7d, 0xd6, 0x03, 0xa9, 0x32, 0xfe, 0xbf, 0xf2, 0xd0, 0x5f,
-	0x63, 0x90, 0x8e, 0x7a, 0xe8, 0x73, 0x79, 0x69, 0xa1, 0xb5, 0xb0, 0x4c, 0x25, 0x64, 0x99, 0xba,
-	0x32, 0xa9, 0x4c, 0x8d, 0x45, 0xf3, 0x21, 0xf5, 0xe9, 0x79, 0x1c, 0x92, 0x1b, 0xd8, 0xc5, 0x3d,
-	0x86, 0xd6, 0xc7, 0x1e, 0xb2, 0x5e, 0x23, 0xb9, 0x38, 0x16, 0xcc, 0x55, 0x35, 0x7d, 0xf1, 0x62,
-	0xf9, 0x97, 0x93, 0xde, 0xb1, 0x5f, 0x80, 0x79, 0xd1, 0x10, 0x87, 0x9d, 0xbd, 0x34, 0xee, 0x9c,
-	0xec, 0x6b, 0x03, 0xed, 0x19, 0x2a, 0x40, 0x4a, 0x90, 0x85, 0x75, 0x58, 0xd0, 0x40, 0x0f, 0xdf,
-	0xaf, 0x79, 0x3b, 0xe8, 0x1a, 0xa0, 0x4e, 0x30, 0x98, 0x30, 0x42, 0x43, 0x08, 0xba, 0x85, 0xf0,
-	0xc4, 0x27, 0x7f, 0x15, 0x40, 0x48, 0x61, 0x58, 0x84, 0x3a, 0x3d, 0xd5, 0xd5, 0xcd, 0x8a, 0x9d,
-	0xaa, 0xd8, 0x40, 0x3f, 0xd5, 0xbc, 0xf7, 0xf0, 0xbe, 0xb6, 0x59, 0xb5, 0x23, 0x9b, 0x47, 0x48,
-	0x8a, 0x7f, 0xef, 0x15, 0xf2, 0x43, 0xdc, 0xeb, 0xae, 0x94, 0x0e, 0xc0, 0x29, 0x1d, 0xd4, 0xc9,
-	0x8b, 0x87, 0xf3, 0x68, 0xdb, 0x8d, 0xea, 0x90, 0xdd, 0x21, 0x43, 0xc3, 0x75, 0xb8, 0x57, 0x68,
-	0x5a, 0x84, 0xa8, 0xc6, 0x65, 0xd1, 0xf7, 0x6d, 0x13, 0x33, 0x12, 0x79, 0xe7, 0xdb, 0xb4, 0x92,
-	0x10, 0xd2, 0xe9, 0xf3, 0x3b, 0x64, 0xa8, 0x2b, 0xbe, 0xdb, 0x84, 0xac, 0x5c, 0x12, 0x99, 0xb2,
-	0xfb, 0xfc, 0xa3, 0xab, 0x4a, 0xe8, 0x6b, 0xcc, 0xda, 0x59, 0xbe, 0x1f, 0xcc, 0xe6, 0x3c, 0xf7,
-	0x8a, 0x47, 0x2f, 0x0a, 0x2f, 0x20, 0x9d, 0xb0, 0xbe, 0x68, 0x1e, 0x45, 0xb3, 0x11, 0x69, 0x0a,
-	0xb4, 0x17, 0x37, 0x1b, 0x21, 0xff, 0x48, 0xb3, 0x11, 0x49, 0xcf, 0x6f, 0x86, 0xf5, 0x3f, 0x76,
-	0x98, 0x36, 0xd1, 0xc8, 0x54, 0x4c, 0x32, 0xeb, 0xa7, 0x4a, 0x7f, 0xd2, 0x60, 0x71, 0x2c, 0x92,
-	0x03, 0x91, 0x4d, 0x40, 0x6e, 0xe4, 0x50, 0x46, 0xc4, 0x50, 0x89, 0x7e, 0xb2, 0xc4, 0x58, 0x70,
-	0xc7, 0x2e, 0x81, 0x4f, 0xe7, 0x22, 0x53, 0x55, 0xec, 0x0f, 0x1a, 0x9c, 0x89, 0x0a, 0x10, 0xa8,
-	0xd2, 0x80, 0x74, 0xf4, 0xd3, 0x4a, 0x89, 0x4b, 0x47, 0x51, 0x22, 0x2a, 0xff, 0x08, 0x08, 0xda,
-	0x0e, 0xab, 0x85, 0x37, 0x14, 0xbc, 0x7e, 0x64, 0xa3, 0xf8, 0x82, 0x1d, 0x58, 0x35, 0x3c, 0xdf,
-	0xfc, 0x53, 0x83, 0xc4, 0x86, 0xe3, 0x74, 0xd1, 0x4f, 0x60, 0x81, 0x3a, 0xdc, 0x10, 0x99, 0x45,
-	0x2c, 0x43, 0xcd, 0x08, 0xbc, 0x4a, 0x5c, 0x7b, 0xa1, 0xad, 0xfe, 0xb1, 0x57, 0x18, 0xe7, 0x1c,
-	0x35, 0xa0, 0x1a, 0x45, 0x51, 0x87, 0x57, 0x24, 0xd1, 0xa6, 0x37, 0x46, 0x68, 0xc1, 0xdc, 0xe8,
-	0xe7, 0xbc, 0x6a, 0x7d, 0xeb, 0xb0, 0xcf, 0xcd, 0x1d, 0xfa, 0xa9, 0x74, 0x33, 0xf2, 0x9d, 0x95,
-	0x19, 0xe1, 0xb5, 0x7f, 0x09, 0xcf, 0xbd, 0x0b, 0xd9, 0xa0, 0x54, 0x6d, 0xc9, 0x39, 0x16, 0x13,
-	0xa1, 0xe1, 0x8d, 0xb4, 0xfc, 0x46, 0xa1, 0x18, 0x9d, 0xd8, 0xe2, 0xa6, 0x69, 0x97, 0xf7, 0xf1,
-	0x8c, 0x98, 0x53, 0xf1, 0x96, 0x1e, 0xc7, 0x60, 0x71, 0xd5, 0xa1, 0x4c, 0x0d, 0x73, 0x54, 0x42,
-	0x7b, 0x23, 0xd8, 0x21, 0xba, 0x32, 0x61, 0xd4, 0x94, 0x1e, 0x1f, 0x28, 0x6d, 0x43, 0x46, 0xdc,
-	0xac, 0xa6, 0x43, 0x5f, 0x72, 0x9e, 0x34, 0xe7, 0x74, 0x2d, 0x25, 0xd1, 0x0e, 0x19, 0x0a, 0x5c,
-	0x4a, 0xee, 0x8d, 0xe0, 0xc6, 0x4f, 0x86, 0x4b, 0xc9, 0xbd, 0x08, 0xee, 0x39, 0x48, 0xaa, 0x67,
-	0x55, 0x42, 0x3e, 0x1a, 0xd4, 0x0a, 0xdd, 0x84, 0xb8, 0xa8, 0x82, 0xa7, 0x8e, 0x51, 0x37, 0x04,
-	0x43, 0xe4, 0x36, 0x6b, 0xc0, 0xa2, 0x1a, 0x10, 0xb0, 0xf5, 0x96, 0xb4, 0x28, 0x91, 0x0a, 0xbd,
-	0x45, 0x86, 0x07, 0x4c, 0x0b, 0xd2, 0x47, 0x9a, 0x16, 0x5c, 0xfd, 0x9d, 0x06, 0x10, 0xce, 0xc5,
-	0xd0, 0x97, 0xe1, 0x7c, 0x65, 0x7d, 0xad, 0x6a, 0x34, 0x36, 0x6f, 0x6d, 0x6e, 0x35, 0x8c, 0xad,
-	0xb5, 0xc6, 0x46, 0x6d, 0xb5, 0x7e, 0xbb, 0x5e, 0xab, 0x66, 0xa7, 0xf2, 0x99, 0xdd, 0x07, 0xc5,
-	0xd4, 0x16, 0x65, 0x7d, 0x62, 0xda, 0x2d, 0x9b, 0x58, 0xe8, 0x8b, 0x70, 0x66, 0x94, 0x5a, 0xac,
-	0x6a, 0xd5, 0xac, 0x96, 0x4f, 0xef, 0x3e, 0x28, 0xce, 0x78, 0xad, 0x01, 0xb1, 0xd0, 0x65, 0x38,
-	0x3b, 0x4e, 0x57, 0x5f, 0x7b, 0x23, 0x1b, 0xcb, 0xcf, 0xed, 0x3e, 0x28, 0xce, 0x06, 0x3d, 0x04,
-	0x2a, 0x01, 0x8a, 0x52, 0x2a, 0xbc, 0x78, 0x1e, 0x76, 0x1f, 0x14, 0x93, 0x5e, 0xb6, 0xe4, 0x13,
-	0xef, 0xfd, 0x66, 0x69, 0xea, 0xea, 0x0f, 0x01, 0xea, 0xb4, 0xe5, 0x62, 0x53, 0x56, 0x85, 0x3c,
-	0x9c, 0xab, 0xaf, 0xdd, 0xd6, 0x6f, 0xad, 0x6e, 0xd6, 0xd7, 0xd7, 0x46, 0xc5, 0xde, 0x77, 0x56,
-	0x5d, 0xdf, 0xaa, 0xbc, 0x5d, 0x33, 0x1a, 0xf5, 0x37, 0xd6, 0xb2, 0x1a, 0x3a, 0x0f, 0xa7, 0x47,
-	0xce, 0xbe, 0xb3, 0xb6, 0x59, 0x7f, 0xa7, 0x96, 0x8d, 0x55, 0x6e, 0x7e, 0xfc, 0x74, 0x49, 0x7b,
-	0xfc, 0x74, 0x49, 0xfb, 0xfb, 0xd3, 0x25, 0xed, 0x83, 0x67, 0x4b, 0x53, 0x8f, 0x9f, 0x2d, 0x4d,
-	0xfd, 0xf9, 0xd9, 0xd2, 0xd4, 0xf7, 0x5e, 0x19, 0xc9, 0xc3, 0xf0, 0x26, 0x92, 0xff, 0xcc, 0x68,
-	0x26, 0x65, 0xd4, 0x7c, 0xf5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x51, 0xe0, 0x99, 0x08, 0x44,
-	0x1a, 0x00, 0x00,
+func (m *ValidatorLiveness) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
 }
 
-func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	return StakingDescription()
+func (m *ValidatorLiveness) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorLiveness) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+// PendingCommissionChange is a commission rate change announced by a
+// validator, which takes effect at the end of the first block whose time is
+// at or after effective_time.
+//
+// Since: cosmos-sdk 0.51
+type PendingCommissionChange struct {
+	// validator_address is the address of the validator changing its rate.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rate is the new commission rate.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// announce_time is the block time at which the change was announced.
+	AnnounceTime time.Time `protobuf:"bytes,3,opt,name=announce_time,json=announceTime,proto3,stdtime" json:"announce_time"`
+	// effective_time is the time from which the change takes effect.
+	EffectiveTime time.Time `protobuf:"bytes,4,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingCommissionChange) Reset()         { *m = PendingCommissionChange{} }
+func (m *PendingCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*PendingCommissionChange) ProtoMessage()    {}
+func (*PendingCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
+}
+func (m *PendingCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommissionChange.Merge(m, src)
+}
+func (m *PendingCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCommissionChange proto.InternalMessageInfo
+
+func (m *PendingCommissionChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *PendingCommissionChange) GetAnnounceTime() time.Time {
+	if m != nil {
+		return m.AnnounceTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingCommissionChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
+// TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
+type ValidatorUpdates struct {
+	Updates []types3.ValidatorUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
+func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUpdates) ProtoMessage()    {}
+func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{26}
+}
+func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUpdates.Merge(m, src)
+}
+func (m *ValidatorUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUpdates proto.InternalMessageInfo
+
+func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+// ConsPubKeyRotationHistory contains a validator's consensus public key rotation history.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress []byte `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the old consensus public key of the validator, as a Protobuf Any.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the new consensus public key of the validator, as a Protobuf Any.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height defines the block height at which the rotation event occurred.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// fee holds the amount of fee deduced for the rotation.
+	Fee types2.Coin `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{27}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
+// ValAddrsOfRotatedConsKeys contains the array of validator addresses which rotated their keys
+// This is to block the validator's next rotation till unbonding period.
+type ValAddrsOfRotatedConsKeys struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *ValAddrsOfRotatedConsKeys) Reset()         { *m = ValAddrsOfRotatedConsKeys{} }
+func (m *ValAddrsOfRotatedConsKeys) String() string { return proto.CompactTextString(m) }
+func (*ValAddrsOfRotatedConsKeys) ProtoMessage()    {}
+func (*ValAddrsOfRotatedConsKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{28}
+}
+func (m *ValAddrsOfRotatedConsKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValAddrsOfRotatedConsKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValAddrsOfRotatedConsKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValAddrsOfRotatedConsKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValAddrsOfRotatedConsKeys.Merge(m, src)
+}
+func (m *ValAddrsOfRotatedConsKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValAddrsOfRotatedConsKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValAddrsOfRotatedConsKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValAddrsOfRotatedConsKeys proto.InternalMessageInfo
+
+func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// QueuedMsg is a message changing delegations queued until the end of the
+// current epoch, when epoching is enabled.
+//
+// Since: cosmos-sdk 0.51
+type QueuedMsg struct {
+	// id is the sequence number of the message, in queue order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height is the height at which the message was queued.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// msg is the queued message.
+	Msg *types1.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *QueuedMsg) Reset()         { *m = QueuedMsg{} }
+func (m *QueuedMsg) String() string { return proto.CompactTextString(m) }
+func (*QueuedMsg) ProtoMessage()    {}
+func (*QueuedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{29}
+}
+func (m *QueuedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedMsg.Merge(m, src)
+}
+func (m *QueuedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedMsg proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.ValidatorSetChangeType", ValidatorSetChangeType_name, ValidatorSetChangeType_value)
+	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
+	proto.RegisterType((*HistoricalRecord)(nil), "cosmos.staking.v1beta1.HistoricalRecord")
+	proto.RegisterType((*ArchivedHistoricalRecord)(nil), "cosmos.staking.v1beta1.ArchivedHistoricalRecord")
+	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
+	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
+	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
+	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
+	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
+	proto.RegisterType((*DVPair)(nil), "cosmos.staking.v1beta1.DVPair")
+	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.v1beta1.DVPairs")
+	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
+	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
+	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
+	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
+	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
+	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
+	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
+	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
+	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
+	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
+	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*ValidatorSetChange)(nil), "cosmos.staking.v1beta1.ValidatorSetChange")
+	proto.RegisterType((*ValidatorSetChanges)(nil), "cosmos.staking.v1beta1.ValidatorSetChanges")
+	proto.RegisterType((*ValidatorLiveness)(nil), "cosmos.staking.v1beta1.ValidatorLiveness")
+	proto.RegisterType((*PendingCommissionChange)(nil), "cosmos.staking.v1beta1.PendingCommissionChange")
+	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
+	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*QueuedMsg)(nil), "cosmos.staking.v1beta1.QueuedMsg")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/staking/v1beta1/staking.proto", fileDescriptor_64c30c6cf92913c9)
+}
+
+var fileDescriptor_64c30c6cf92913c9 = []byte{
+	// 2866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4b, 0x6c, 0x1c, 0xc7,
+	0xd1, 0xe6, 0xec, 0xae, 0xf8, 0xa8, 0x5d, 0xbe, 0x5a, 0x94, 0x34, 0x5c, 0xc9, 0xe4, 0x6a, 0x6d,
+	0xff, 0x96, 0xf5, 0xff, 0x22, 0x2d, 0xfd, 0x3f, 0x74, 0xe0, 0x1f, 0x38, 0xe0, 0x3e, 0x68, 0xad,
+	0x4c, 0x91, 0xeb, 0xe1, 0xc3, 0x71, 0x5e, 0x83, 0xe1, 0x4c, 0xef, 0x72, 0xcc, 0xdd, 0xee, 0xf5,
+	0xf4, 0x2c, 0xa9, 0xbd, 0xe4, 0x94, 0x83, 0x41, 0x23, 0x80, 0x4f, 0x49, 0x90, 0x80, 0x88, 0x81,
+	0x5c, 0x9c, 0x9b, 0x0f, 0x42, 0xee, 0x39, 0xc5, 0x31, 0x60, 0x40, 0xf0, 0x25, 0x41, 0x80, 0xc8,
+	0x81, 0x7d, 0xb0, 0xe1, 0x5c, 0x02, 0x9f, 0x72, 0x0c, 0xfa, 0x31, 0x0f, 0x72, 0x77, 0x45, 0x52,
+	0x14, 0x02, 0x23, 0xb9, 0x10, 0x3b, 0xdd, 0x55, 0x5f, 0x57, 0x55, 0x57, 0x55, 0x57, 0x75, 0x13,
+	0x9e, 0xb3, 0x29, 0x6b, 0x52, 0x36, 0xcf, 0x7c, 0x6b, 0xc7, 0x25, 0xf5, 0xf9, 0xdd, 0x9b, 0x5b,
+	0xd8, 0xb7, 0x6e, 0x06, 0xdf, 0x73, 0x2d, 0x8f, 0xfa, 0x14, 0x5d, 0x94, 0x54, 0x73, 0xc1, 0xa8,
+	0xa2, 0xca, 0x4e, 0xd5, 0x69, 0x9d, 0x0a, 0x92, 0x79, 0xfe, 0x4b, 0x52, 0x67, 0xa7, 0xeb, 0x94,
+	0xd6, 0x1b, 0x78, 0x5e, 0x7c, 0x6d, 0xb5, 0x6b, 0xf3, 0x16, 0xe9, 0xa8, 0xa9, 0x99, 0xa3, 0x53,
+	0x4e, 0xdb, 0xb3, 0x7c, 0x97, 0x12, 0x35, 0x3f, 0x7b, 0x74, 0xde, 0x77, 0x9b, 0x98, 0xf9, 0x56,
+	0xb3, 0x15, 0x60, 0x4b, 0x49, 0x4c, 0xb9, 0xa8, 0x12, 0x4b, 0x61, 0x2b, 0x55, 0xb6, 0x2c, 0x86,
+	0x43, 0x3d, 0x6c, 0xea, 0x06, 0xd8, 0x93, 0x56, 0xd3, 0x25, 0x74, 0x5e, 0xfc, 0x55, 0x43, 0x57,
+	0x7c, 0x4c, 0x1c, 0xec, 0x35, 0x5d, 0xe2, 0xcf, 0xfb, 0x9d, 0x16, 0x66, 0xf2, 0xaf, 0x9a, 0xbd,
+	0x1c, 0x9b, 0xb5, 0xb6, 0x6c, 0x37, 0x3e, 0x99, 0xff, 0x99, 0x06, 0x63, 0x77, 0x5c, 0xe6, 0x53,
+	0xcf, 0xb5, 0xad, 0x46, 0x85, 0xd4, 0x28, 0xfa, 0x7f, 0x18, 0xdc, 0xc6, 0x96, 0x83, 0x3d, 0x5d,
+	0xcb, 0x69, 0xd7, 0xd2, 0xb7, 0xf4, 0xb9, 0x08, 0x60, 0x4e, 0xf2, 0xde, 0x11, 0xf3, 0x85, 0x91,
+	0x0f, 0x1f, 0xcd, 0x0e, 0xbc, 0xff, 0xc5, 0x07, 0xd7, 0x35, 0x43, 0xb1, 0xa0, 0x12, 0x0c, 0xee,
+	0x5a, 0x0d, 0x86, 0x7d, 0x3d, 0x91, 0x4b, 0x5e, 0x4b, 0xdf, 0xba, 0x3a, 0xd7, 0xdb, 0xe6, 0x73,
+	0x9b, 0x56, 0xc3, 0x75, 0x2c, 0x9f, 0x1e, 0x46, 0x91, 0xbc, 0x0b, 0x09, 0x5d, 0xcb, 0xbf, 0xa3,
+	0xc1, 0x44, 0x24, 0x99, 0x81, 0x6d, 0xea, 0x39, 0x48, 0x87, 0x21, 0xab, 0xd5, 0xda, 0xb6, 0xd8,
+	0xb6, 0x10, 0x2e, 0x63, 0x04, 0x9f, 0xe8, 0xff, 0x20, 0xc5, 0x8d, 0xac, 0x27, 0x84, 0xcc, 0xd9,
+	0x39, 0xb9, 0x03, 0x73, 0xc1, 0x0e, 0xcc, 0xad, 0x07, 0x3b, 0x50, 0x48, 0xbd, 0xfb, 0xe9, 0xac,
+	0x66, 0x08, 0x6a, 0xf4, 0x02, 0x8c, 0xef, 0x06, 0x82, 0x30, 0x53, 0xe0, 0x26, 0x05, 0xee, 0x58,
+	0x34, 0x7c, 0xc7, 0x62, 0xdb, 0xf9, 0x5f, 0x68, 0xa0, 0x2f, 0x7a, 0xf6, 0xb6, 0xbb, 0x8b, 0x9d,
+	0x2e, 0xa9, 0xa6, 0x61, 0xd8, 0xde, 0xb6, 0x5c, 0x62, 0xba, 0x8e, 0x10, 0x6b, 0xc4, 0x18, 0x12,
+	0xdf, 0x15, 0x07, 0x5d, 0xe4, 0xc6, 0x74, 0xeb, 0xdb, 0xbe, 0x10, 0x2c, 0x69, 0xa8, 0x2f, 0xf4,
+	0x2a, 0x0c, 0x7a, 0x82, 0x59, 0xac, 0x97, 0xbe, 0x75, 0xad, 0x9f, 0x9d, 0x8e, 0x2e, 0x76, 0xc8,
+	0x5c, 0x12, 0x22, 0xff, 0xd3, 0x04, 0x8c, 0x17, 0x69, 0xb3, 0xe9, 0x32, 0xe6, 0x52, 0x62, 0x58,
+	0x3e, 0x66, 0xe8, 0x2e, 0xa4, 0x3c, 0xcb, 0xc7, 0x52, 0x9e, 0xc2, 0x6d, 0xce, 0xf4, 0xe7, 0x47,
+	0xb3, 0x97, 0xe5, 0x2a, 0xcc, 0xd9, 0x99, 0x73, 0xe9, 0x7c, 0xd3, 0xf2, 0xb7, 0xe7, 0x96, 0x71,
+	0xdd, 0xb2, 0x3b, 0x25, 0x6c, 0x7f, 0xf2, 0xe0, 0x06, 0x28, 0x21, 0x4a, 0xd8, 0x96, 0x2b, 0x08,
+	0x0c, 0xf4, 0x1a, 0x0c, 0x37, 0xad, 0xfb, 0xa6, 0xc0, 0x4b, 0x9c, 0x09, 0x6f, 0xa8, 0x69, 0xdd,
+	0xe7, 0xf2, 0xa1, 0x1f, 0xc2, 0x38, 0x87, 0xb4, 0xb7, 0x2d, 0x52, 0xc7, 0x12, 0x39, 0x79, 0x26,
+	0xe4, 0xd1, 0xa6, 0x75, 0xbf, 0x28, 0xd0, 0x38, 0xfe, 0x42, 0xea, 0xcb, 0xf7, 0x66, 0xb5, 0xfc,
+	0xef, 0x34, 0x80, 0xc8, 0x30, 0xc8, 0x82, 0x09, 0x3b, 0xfc, 0x12, 0x8b, 0x32, 0xe5, 0xe3, 0x2f,
+	0xf4, 0x33, 0xff, 0x11, 0xb3, 0x16, 0x46, 0xb9, 0x78, 0x0f, 0x1f, 0xcd, 0x6a, 0x72, 0xd5, 0x71,
+	0xbb, 0xcb, 0xec, 0xe9, 0x76, 0xcb, 0xb1, 0x7c, 0x6c, 0x9e, 0xd0, 0x1b, 0x05, 0xe0, 0xbb, 0x9f,
+	0x06, 0x80, 0x20, 0xb9, 0xf9, 0xbc, 0xd2, 0xe1, 0x7d, 0x0d, 0xd2, 0x25, 0xcc, 0x6c, 0xcf, 0x6d,
+	0xf1, 0x0c, 0xc3, 0x43, 0xa0, 0x49, 0x89, 0xbb, 0xa3, 0xe2, 0x73, 0xc4, 0x08, 0x3e, 0x51, 0x16,
+	0x86, 0x5d, 0x07, 0x13, 0xdf, 0xf5, 0x3b, 0x72, 0x9b, 0x8c, 0xf0, 0x9b, 0x73, 0xed, 0xe1, 0x2d,
+	0xe6, 0x06, 0x76, 0x36, 0x82, 0x4f, 0xf4, 0x22, 0x4c, 0x30, 0x6c, 0xb7, 0x3d, 0xd7, 0xef, 0x98,
+	0x36, 0x25, 0xbe, 0x65, 0xfb, 0x7a, 0x4a, 0x90, 0x8c, 0x07, 0xe3, 0x45, 0x39, 0xcc, 0x41, 0x1c,
+	0xec, 0x5b, 0x6e, 0x83, 0xe9, 0xe7, 0x24, 0x88, 0xfa, 0x54, 0xa2, 0xee, 0x0f, 0xc1, 0x48, 0x18,
+	0xd7, 0xa8, 0x08, 0x13, 0xb4, 0x85, 0x3d, 0xfe, 0xdb, 0xb4, 0x1c, 0xc7, 0xc3, 0x8c, 0x29, 0x6f,
+	0xd4, 0x3f, 0x79, 0x70, 0x63, 0x4a, 0x19, 0x7c, 0x51, 0xce, 0xac, 0xf9, 0x9e, 0x4b, 0xea, 0xc6,
+	0x78, 0xc0, 0xa1, 0x86, 0xd1, 0x1b, 0x7c, 0xcb, 0x08, 0xc3, 0x84, 0xb5, 0x99, 0xd9, 0x6a, 0x6f,
+	0xed, 0xe0, 0x8e, 0x32, 0xea, 0x54, 0x97, 0x51, 0x17, 0x49, 0xa7, 0xa0, 0x7f, 0x14, 0x41, 0xdb,
+	0x5e, 0xa7, 0xe5, 0xd3, 0xb9, 0x6a, 0x7b, 0xeb, 0x55, 0xdc, 0x31, 0xc6, 0x43, 0x9c, 0xaa, 0x80,
+	0xe1, 0xa1, 0xf9, 0xa6, 0xe5, 0x36, 0xb0, 0x0c, 0xc1, 0x61, 0x43, 0x7d, 0xa1, 0x05, 0x18, 0x64,
+	0xbe, 0xe5, 0xb7, 0x99, 0x30, 0xc3, 0xd8, 0xad, 0x7c, 0x3f, 0xdf, 0x28, 0x50, 0xe2, 0xac, 0x09,
+	0x4a, 0x43, 0x71, 0xa0, 0x22, 0x0c, 0xfa, 0x74, 0x07, 0x13, 0x65, 0xa0, 0xc2, 0x7f, 0x2b, 0x6f,
+	0xbe, 0xd0, 0xed, 0xcd, 0x15, 0xe2, 0xc7, 0xfc, 0xb8, 0x42, 0x7c, 0x43, 0xb1, 0xa2, 0xef, 0xc3,
+	0x84, 0x83, 0x1b, 0xb8, 0x2e, 0x2c, 0xc7, 0xb6, 0x2d, 0x0f, 0x33, 0x7d, 0x50, 0xc0, 0xdd, 0x3c,
+	0x75, 0x70, 0x18, 0xe3, 0x21, 0xd4, 0x9a, 0x40, 0x42, 0x55, 0x48, 0x3b, 0x91, 0x3b, 0xe9, 0x43,
+	0xc2, 0x98, 0xcf, 0xf6, 0xd3, 0x31, 0xe6, 0x79, 0xf1, 0xcc, 0x13, 0x87, 0xe0, 0x1e, 0xd4, 0x26,
+	0x5b, 0x94, 0x38, 0x2e, 0xa9, 0x9b, 0x2a, 0xdb, 0x0d, 0x8b, 0x6c, 0x37, 0x1e, 0x8e, 0xdf, 0x11,
+	0xc3, 0xa8, 0x0a, 0x63, 0x11, 0xa9, 0x88, 0x90, 0x91, 0xd3, 0x46, 0xc8, 0x68, 0x08, 0xc0, 0x49,
+	0xd0, 0x3d, 0x80, 0x28, 0x06, 0x75, 0x10, 0x68, 0xf9, 0xe3, 0xa3, 0x39, 0xae, 0x4c, 0x0c, 0x00,
+	0x7d, 0x0f, 0xce, 0x37, 0x5d, 0x62, 0x32, 0xdc, 0xa8, 0x99, 0xca, 0x72, 0x1c, 0x37, 0x7d, 0xfa,
+	0xdd, 0x9c, 0x6c, 0xba, 0x64, 0x0d, 0x37, 0x6a, 0xa5, 0x10, 0x05, 0x7d, 0x0b, 0x2e, 0x47, 0xda,
+	0x53, 0x62, 0x6e, 0xd3, 0x86, 0x63, 0x7a, 0xb8, 0x66, 0xda, 0xb4, 0x4d, 0x7c, 0x3d, 0x23, 0x6c,
+	0x76, 0x29, 0x24, 0x59, 0x25, 0x77, 0x68, 0xc3, 0x31, 0x70, 0xad, 0xc8, 0xa7, 0xd1, 0xb3, 0x10,
+	0xa9, 0x6e, 0xba, 0x0e, 0xd3, 0x47, 0x73, 0xc9, 0x6b, 0x29, 0x23, 0x13, 0x0e, 0x56, 0x1c, 0xb6,
+	0x30, 0xfc, 0xf6, 0x7b, 0xb3, 0x03, 0x5f, 0xbe, 0x37, 0x3b, 0x90, 0x5f, 0x82, 0xcc, 0xa6, 0xd5,
+	0x50, 0x71, 0x84, 0x19, 0xba, 0x0d, 0x23, 0x56, 0xf0, 0xa1, 0x6b, 0xb9, 0xe4, 0x63, 0xe3, 0x30,
+	0x22, 0xcd, 0xff, 0x46, 0x83, 0xc1, 0xd2, 0x66, 0xd5, 0x72, 0x3d, 0x54, 0x86, 0xc9, 0xc8, 0x31,
+	0x4f, 0x1a, 0xd2, 0x91, 0x2f, 0x07, 0x31, 0xbd, 0x02, 0x93, 0xe1, 0xe9, 0x1a, 0xc2, 0xc8, 0x73,
+	0xe5, 0xea, 0x27, 0x0f, 0x6e, 0x3c, 0xa3, 0x60, 0xc2, 0x4c, 0x72, 0x04, 0x6f, 0xf7, 0xc8, 0x78,
+	0x4c, 0xe7, 0xbb, 0x30, 0x24, 0x45, 0x65, 0xe8, 0xdb, 0x70, 0xae, 0xc5, 0x7f, 0x08, 0x55, 0xd3,
+	0xb7, 0x66, 0xfa, 0x3a, 0xb8, 0xa0, 0x8f, 0xbb, 0x83, 0xe4, 0xcb, 0xbf, 0x93, 0x00, 0x28, 0x6d,
+	0x6e, 0xae, 0x7b, 0x6e, 0xab, 0x81, 0xfd, 0xa7, 0xa5, 0xfb, 0x06, 0x5c, 0x88, 0x74, 0x67, 0x9e,
+	0x7d, 0x7a, 0xfd, 0xcf, 0x87, 0xfc, 0x6b, 0x9e, 0xdd, 0x13, 0xd6, 0x61, 0x7e, 0x08, 0x9b, 0x3c,
+	0x3d, 0x6c, 0x89, 0xf9, 0xdd, 0x96, 0xfd, 0x0e, 0xa4, 0x23, 0x63, 0x30, 0x54, 0x81, 0x61, 0x5f,
+	0xfd, 0x56, 0x06, 0xce, 0xf7, 0x37, 0x70, 0xc0, 0x16, 0x37, 0x72, 0xc8, 0x9e, 0xff, 0x87, 0x06,
+	0x10, 0x8b, 0x91, 0x6f, 0xa6, 0x8f, 0xa1, 0x0a, 0x0c, 0xaa, 0x4c, 0x9c, 0x7c, 0xd2, 0x4c, 0xac,
+	0x00, 0x62, 0x46, 0xfd, 0x49, 0x02, 0xce, 0x6f, 0x04, 0xd1, 0xfb, 0xcd, 0xb7, 0xc1, 0x06, 0x0c,
+	0x61, 0xe2, 0x7b, 0xae, 0x30, 0x02, 0xdf, 0xf3, 0x97, 0xfa, 0xed, 0x79, 0x0f, 0xa5, 0xca, 0xc4,
+	0xf7, 0x3a, 0x71, 0x0f, 0x08, 0xb0, 0x62, 0xf6, 0xf8, 0x65, 0x12, 0xf4, 0x7e, 0xac, 0xbc, 0x54,
+	0xb7, 0x3d, 0x2c, 0x06, 0x82, 0x43, 0x46, 0x13, 0x09, 0x73, 0x2c, 0x18, 0x56, 0x67, 0x8c, 0x01,
+	0xbc, 0x2a, 0xe3, 0xce, 0xc5, 0x49, 0x9f, 0xac, 0x0c, 0x1b, 0x8b, 0x10, 0xc4, 0x29, 0xb3, 0x0e,
+	0xe3, 0x2e, 0x71, 0x7d, 0xd7, 0x6a, 0x98, 0x5b, 0x56, 0xc3, 0x22, 0x76, 0x50, 0xae, 0x9e, 0xea,
+	0x48, 0x18, 0x53, 0x18, 0x05, 0x09, 0x81, 0xca, 0x30, 0x14, 0xa0, 0xa5, 0x4e, 0x8f, 0x16, 0xf0,
+	0xa2, 0xab, 0x90, 0x89, 0x1f, 0x0c, 0xa2, 0xf4, 0x48, 0x19, 0xe9, 0xd8, 0xb9, 0x70, 0xdc, 0xc9,
+	0x33, 0xf8, 0xd8, 0x93, 0x47, 0x55, 0x77, 0xbf, 0x4a, 0xc2, 0xa4, 0x81, 0x9d, 0x7f, 0xff, 0x6d,
+	0xa9, 0x02, 0xc8, 0x50, 0xe5, 0x99, 0x54, 0x4f, 0x3d, 0x69, 0xbc, 0x8f, 0x48, 0x90, 0x12, 0xf3,
+	0xff, 0x55, 0x3b, 0xf4, 0x97, 0x04, 0x64, 0xe2, 0x3b, 0xf4, 0x1f, 0x79, 0x68, 0xa1, 0x95, 0x28,
+	0x4d, 0xa5, 0x44, 0x9a, 0x7a, 0xb1, 0x5f, 0x9a, 0xea, 0xf2, 0xe6, 0x63, 0xf2, 0xd3, 0xef, 0x47,
+	0x60, 0xb0, 0x6a, 0x79, 0x56, 0x93, 0xa1, 0xd5, 0xae, 0x42, 0x56, 0x36, 0x92, 0xd3, 0x5d, 0xce,
+	0x5c, 0x52, 0x57, 0x43, 0xd2, 0x97, 0x7f, 0xde, 0xaf, 0x8e, 0x7d, 0x1e, 0xc6, 0x78, 0x43, 0x1c,
+	0x2a, 0x24, 0x8d, 0x3b, 0x2a, 0xfa, 0xda, 0x50, 0x7b, 0x86, 0x66, 0x21, 0xcd, 0xc9, 0xa2, 0x3c,
+	0xcc, 0x69, 0xa0, 0x69, 0xdd, 0x2f, 0xcb, 0x11, 0x74, 0x03, 0xd0, 0x76, 0x78, 0x65, 0x60, 0x46,
+	0x86, 0xe0, 0x74, 0x93, 0xd1, 0x4c, 0x40, 0xfe, 0x0c, 0x00, 0x97, 0xc2, 0x74, 0x30, 0xa1, 0x4d,
+	0xd5, 0xd5, 0x8d, 0xf0, 0x91, 0x12, 0x1f, 0x40, 0x3f, 0xd6, 0x64, 0x3d, 0x7c, 0xa4, 0x6d, 0x56,
+	0xed, 0xc8, 0xfa, 0x09, 0x82, 0xe2, 0xeb, 0x47, 0xb3, 0xd9, 0x8e, 0xd5, 0x6c, 0x2c, 0xe4, 0x7b,
+	0xe0, 0xe4, 0x7b, 0x75, 0xf2, 0xbc, 0x70, 0x3e, 0xdc, 0x76, 0xa3, 0x0a, 0x4c, 0xec, 0xe0, 0x8e,
+	0xe9, 0x51, 0x5f, 0x26, 0x9a, 0x1a, 0xc6, 0xaa, 0x71, 0x99, 0x0e, 0xf6, 0x96, 0x5f, 0x97, 0xc5,
+	0xea, 0x7c, 0x97, 0x14, 0x52, 0x5c, 0x3a, 0x63, 0x6c, 0x07, 0x77, 0x0c, 0xc5, 0xb7, 0x84, 0x31,
+	0xaa, 0x41, 0x36, 0x26, 0x84, 0xba, 0x7f, 0xb0, 0x29, 0x6d, 0x38, 0x74, 0x8f, 0xe8, 0xc3, 0x0a,
+	0xf4, 0xa4, 0x9b, 0xa8, 0x47, 0x58, 0xf2, 0xf2, 0xa1, 0xa8, 0x90, 0xd0, 0x9b, 0x71, 0xe7, 0x16,
+	0x26, 0xae, 0x59, 0xb6, 0x4f, 0x3d, 0x7d, 0xe4, 0x4c, 0xd7, 0x1c, 0x91, 0xc7, 0xf3, 0xee, 0x73,
+	0x49, 0x40, 0xa2, 0xb7, 0x60, 0xba, 0xde, 0xa0, 0x5b, 0x56, 0xc3, 0x6c, 0xb8, 0x6f, 0xb5, 0x5d,
+	0xc7, 0x54, 0x8e, 0x6e, 0xda, 0x56, 0x4b, 0x87, 0x33, 0xad, 0x77, 0x51, 0x02, 0x2f, 0x0b, 0xdc,
+	0x35, 0x09, 0x5b, 0xb4, 0x5a, 0x68, 0x0f, 0xae, 0x44, 0xea, 0xf5, 0x58, 0x35, 0x7d, 0xa6, 0x55,
+	0xa7, 0x43, 0xec, 0xae, 0x85, 0x0d, 0xd0, 0x8f, 0x2c, 0xd7, 0xf2, 0xe8, 0xae, 0xeb, 0x60, 0x8f,
+	0xe9, 0x99, 0x63, 0xba, 0x9a, 0x8b, 0x8d, 0x38, 0x5a, 0x35, 0xe0, 0xe3, 0xe9, 0x19, 0xb7, 0xa8,
+	0xbd, 0x6d, 0x36, 0x30, 0xa9, 0xfb, 0xdb, 0xfa, 0xa8, 0x4c, 0xcf, 0x62, 0x6c, 0x59, 0x0c, 0x21,
+	0x0a, 0xb3, 0xdd, 0x6e, 0x43, 0xa8, 0xef, 0xda, 0xd8, 0x6c, 0x61, 0xcf, 0xa5, 0x8e, 0x3e, 0x76,
+	0x4a, 0xdf, 0xb9, 0x72, 0xd4, 0x77, 0x56, 0x04, 0x5c, 0x55, 0xa0, 0xa1, 0xdb, 0xa0, 0x8b, 0x40,
+	0x17, 0x72, 0x35, 0x59, 0x9d, 0xf1, 0x65, 0xcc, 0xad, 0x06, 0xb5, 0x77, 0xf4, 0x71, 0x11, 0xcd,
+	0x53, 0x3c, 0xea, 0xf9, 0xf4, 0x3d, 0x56, 0x67, 0x55, 0xec, 0x15, 0xf8, 0xdc, 0xc2, 0x73, 0xfc,
+	0x24, 0xd8, 0xff, 0xe2, 0x83, 0xeb, 0xca, 0xe6, 0x37, 0x98, 0xb3, 0x33, 0x7f, 0x3f, 0xbc, 0x18,
+	0x97, 0xe9, 0x8b, 0x37, 0x75, 0x28, 0x2a, 0xb0, 0x0c, 0xcc, 0x5a, 0x94, 0x30, 0xd1, 0x4c, 0xc7,
+	0x9a, 0x5e, 0xed, 0xf1, 0xcd, 0x74, 0xc4, 0x7f, 0xa8, 0x99, 0x8e, 0x1d, 0x3f, 0x2f, 0x47, 0xf5,
+	0x4d, 0xe2, 0xb8, 0x68, 0x8d, 0x67, 0x5e, 0xc5, 0x24, 0x4e, 0xb5, 0x81, 0xfc, 0xc7, 0x1a, 0x4c,
+	0x77, 0x65, 0xea, 0x50, 0x64, 0x1b, 0x90, 0x17, 0x9b, 0x14, 0x19, 0xaf, 0xa3, 0x44, 0x7f, 0xb2,
+	0xc4, 0x3f, 0xe9, 0x1d, 0x9d, 0x7d, 0x4a, 0x85, 0x9a, 0x3a, 0xa5, 0xff, 0xa0, 0xc1, 0x54, 0x5c,
+	0x80, 0x50, 0x95, 0x35, 0xc8, 0xc4, 0x97, 0x56, 0x4a, 0x3c, 0x77, 0x12, 0x25, 0xe2, 0xf2, 0x1f,
+	0x02, 0x41, 0x9b, 0xd1, 0x69, 0x28, 0x6f, 0xe4, 0x6f, 0x9e, 0xd8, 0x28, 0x81, 0x60, 0x3d, 0x4f,
+	0x45, 0xb9, 0x37, 0x7f, 0xd3, 0x20, 0x55, 0xa5, 0xb4, 0x81, 0xde, 0x82, 0x49, 0x42, 0x7d, 0x91,
+	0xe8, 0xb0, 0x63, 0xaa, 0x3b, 0x30, 0x59, 0x69, 0x94, 0x1f, 0x6b, 0xab, 0xaf, 0x1e, 0xcd, 0x76,
+	0x73, 0x1e, 0x36, 0xa0, 0xba, 0x6a, 0x25, 0xd4, 0x2f, 0x08, 0xa2, 0x75, 0x41, 0x83, 0x6a, 0x30,
+	0x7a, 0x78, 0x39, 0x59, 0x8d, 0x2c, 0x1e, 0xb7, 0xdc, 0xe8, 0xb1, 0x4b, 0x65, 0xb6, 0x62, 0xeb,
+	0x2c, 0x0c, 0xf3, 0x5d, 0xfb, 0x3b, 0xdf, 0xb9, 0xaf, 0x35, 0x40, 0xe1, 0x59, 0xbc, 0x86, 0x7d,
+	0x19, 0xb6, 0xbd, 0xfb, 0x2c, 0xed, 0xc9, 0xfb, 0xac, 0x55, 0x48, 0xab, 0x04, 0xc3, 0x5f, 0x5b,
+	0x84, 0x5a, 0x63, 0xb7, 0xe6, 0x8e, 0x7d, 0x48, 0x09, 0x05, 0x5a, 0xef, 0xb4, 0xb0, 0x01, 0x76,
+	0xf8, 0x9b, 0xd7, 0x16, 0x2d, 0x0f, 0xef, 0xba, 0x94, 0xdf, 0xa1, 0xd2, 0x3d, 0xec, 0x89, 0xba,
+	0x21, 0x69, 0x8c, 0x06, 0xa3, 0x55, 0x3e, 0x88, 0xa6, 0xe0, 0x9c, 0x9c, 0x4d, 0x89, 0x59, 0xf9,
+	0x91, 0xaf, 0xc1, 0xf9, 0xee, 0x25, 0xb8, 0x90, 0x43, 0x72, 0x85, 0xe0, 0x02, 0xe0, 0xfa, 0xc9,
+	0x05, 0x3c, 0xe4, 0x50, 0x0a, 0x25, 0xff, 0x55, 0x12, 0x26, 0x37, 0xa3, 0xb4, 0xbf, 0x8b, 0x09,
+	0xb7, 0xc5, 0x4b, 0x30, 0xc5, 0xdc, 0x3a, 0xc1, 0x8e, 0x4c, 0x7d, 0xcc, 0xdc, 0x73, 0x89, 0x43,
+	0xf7, 0x54, 0x8f, 0x81, 0xe4, 0x9c, 0xc8, 0x7c, 0xec, 0x75, 0x31, 0x83, 0x5c, 0xb8, 0x20, 0x6e,
+	0xf0, 0x24, 0x17, 0x4f, 0x9a, 0x8a, 0xe5, 0x6c, 0x2f, 0x17, 0x88, 0x5f, 0xe7, 0x09, 0xcc, 0x2a,
+	0xf6, 0xd4, 0x52, 0x57, 0x21, 0xc3, 0x7c, 0xcb, 0xf3, 0x83, 0xc6, 0x47, 0x5a, 0x35, 0x2d, 0xc6,
+	0x54, 0xd7, 0xf3, 0x2c, 0x8c, 0xca, 0xe5, 0x95, 0xfc, 0xca, 0xb6, 0x19, 0x39, 0x28, 0x05, 0x47,
+	0xb7, 0xb8, 0xc8, 0x8c, 0x45, 0x4a, 0x8a, 0x9a, 0x1f, 0x7b, 0xa2, 0x1e, 0x4b, 0x1a, 0xe7, 0xe5,
+	0xa4, 0x24, 0x2e, 0xca, 0x29, 0xb4, 0x02, 0x83, 0xed, 0x96, 0x28, 0x3c, 0x07, 0xcf, 0xa4, 0x97,
+	0x42, 0x41, 0xcb, 0x90, 0x91, 0xf7, 0xdf, 0x66, 0x9b, 0xf8, 0x6e, 0x43, 0x1f, 0x3a, 0x6d, 0x6f,
+	0x96, 0x96, 0xec, 0x1b, 0x9c, 0x1b, 0xcd, 0x00, 0xf8, 0xb4, 0xb9, 0xc5, 0x7c, 0x4a, 0xb0, 0x23,
+	0xaa, 0xaa, 0x61, 0x23, 0x36, 0x92, 0xff, 0x63, 0x02, 0x2e, 0x55, 0xb1, 0xa8, 0x7e, 0x8b, 0x47,
+	0x4e, 0xc1, 0xa7, 0x1e, 0x4e, 0xc1, 0x4b, 0x58, 0xe2, 0x29, 0xbc, 0x84, 0xad, 0xc0, 0xa8, 0x45,
+	0x08, 0x6d, 0x13, 0x5b, 0x3d, 0xf0, 0x24, 0x4f, 0x6b, 0xa6, 0x4c, 0xc0, 0xcf, 0x29, 0xf8, 0x7d,
+	0x38, 0xae, 0xd5, 0xb0, 0xed, 0xbb, 0xbb, 0x0a, 0x30, 0x75, 0xea, 0xfb, 0xf0, 0x10, 0x80, 0x93,
+	0xe4, 0xdf, 0x80, 0x89, 0xd0, 0x32, 0x1b, 0xe2, 0x2d, 0x89, 0xf1, 0xe3, 0x4b, 0x3e, 0x2b, 0x05,
+	0xb1, 0x9a, 0x8b, 0x3f, 0xe9, 0xf2, 0x37, 0xe1, 0xb9, 0x23, 0x3c, 0x87, 0x22, 0x54, 0xf1, 0xe6,
+	0x1f, 0x26, 0x60, 0xba, 0x48, 0x09, 0x53, 0x0f, 0x2a, 0xaa, 0xa8, 0x96, 0x0f, 0x94, 0x1d, 0xfe,
+	0x0a, 0xd0, 0xf3, 0xb9, 0x27, 0xd3, 0xfd, 0xa8, 0xb3, 0x09, 0xe3, 0xbc, 0xbb, 0xb5, 0x29, 0x39,
+	0xe3, 0x9b, 0xce, 0x28, 0x6d, 0x38, 0x4a, 0x22, 0xfe, 0xa2, 0xb3, 0x09, 0xe3, 0x04, 0xef, 0x1d,
+	0xc2, 0x4d, 0x3e, 0x19, 0x2e, 0xc1, 0x7b, 0x31, 0xdc, 0xe8, 0x11, 0x37, 0x25, 0x2a, 0x43, 0xf5,
+	0x85, 0x6e, 0x43, 0x92, 0x77, 0x22, 0xe7, 0x4e, 0x51, 0xdb, 0x70, 0x86, 0x58, 0x47, 0xb9, 0x06,
+	0xd3, 0xea, 0x92, 0x9e, 0xad, 0xd6, 0x84, 0x45, 0xb1, 0x50, 0xe8, 0x55, 0xdc, 0xe9, 0x71, 0x63,
+	0x9f, 0x39, 0xd9, 0x8d, 0xfd, 0x8f, 0x60, 0xe4, 0xb5, 0x36, 0x6e, 0x63, 0xe7, 0x1e, 0xab, 0xa3,
+	0x31, 0x48, 0xa8, 0x57, 0xe9, 0x94, 0x91, 0x70, 0xfb, 0x3f, 0x48, 0x17, 0x21, 0xd9, 0x64, 0xf5,
+	0xc7, 0xda, 0xeb, 0xf2, 0x47, 0x0f, 0x6e, 0x5c, 0xea, 0xa5, 0xe4, 0x3d, 0x56, 0x37, 0x38, 0xf7,
+	0x42, 0x8a, 0x2b, 0x76, 0xfd, 0xb7, 0x1a, 0x40, 0xf4, 0x36, 0x86, 0xfe, 0x07, 0x2e, 0x15, 0x56,
+	0x57, 0x4a, 0xe6, 0xda, 0xfa, 0xe2, 0xfa, 0xc6, 0x9a, 0xb9, 0xb1, 0xb2, 0x56, 0x2d, 0x17, 0x2b,
+	0x4b, 0x95, 0x72, 0x69, 0x62, 0x20, 0x3b, 0xbe, 0x7f, 0x90, 0x4b, 0x6f, 0x10, 0xd6, 0xc2, 0xb6,
+	0x5b, 0x73, 0xb1, 0x83, 0xfe, 0x0b, 0xa6, 0x0e, 0x53, 0xf3, 0xaf, 0x72, 0x69, 0x42, 0xcb, 0x66,
+	0xf6, 0x0f, 0x72, 0xc3, 0xf2, 0x7a, 0x10, 0x3b, 0xe8, 0x1a, 0x5c, 0xe8, 0xa6, 0xab, 0xac, 0xbc,
+	0x32, 0x91, 0xc8, 0x8e, 0xee, 0x1f, 0xe4, 0x46, 0xc2, 0x7b, 0x44, 0x94, 0x07, 0x14, 0xa7, 0x54,
+	0x78, 0xc9, 0x2c, 0xec, 0x1f, 0xe4, 0x06, 0x65, 0x45, 0x91, 0x4d, 0xbd, 0xfd, 0xeb, 0x99, 0x81,
+	0xeb, 0x3f, 0x00, 0xa8, 0x90, 0x9a, 0x67, 0xd9, 0xa2, 0x72, 0xca, 0xc2, 0xc5, 0xca, 0xca, 0x92,
+	0xb1, 0x58, 0x5c, 0xaf, 0xac, 0xae, 0x1c, 0x16, 0xfb, 0xc8, 0x5c, 0x69, 0x75, 0xa3, 0xb0, 0x5c,
+	0x36, 0xd7, 0x2a, 0xaf, 0xac, 0x4c, 0x68, 0xe8, 0x12, 0x9c, 0x3f, 0x34, 0xf7, 0xfa, 0xca, 0x7a,
+	0xe5, 0x5e, 0x79, 0x22, 0x71, 0xfd, 0xe3, 0x24, 0x5c, 0xec, 0x7d, 0x5a, 0xa3, 0x65, 0x78, 0x7e,
+	0x73, 0x71, 0xb9, 0x52, 0x5a, 0x5c, 0x5f, 0x35, 0xcc, 0xb5, 0xf2, 0xba, 0x59, 0xbc, 0xb3, 0xb8,
+	0xf2, 0x4a, 0xd9, 0x5c, 0x7f, 0xa3, 0x5a, 0x3e, 0x62, 0xb1, 0xab, 0xfb, 0x07, 0xb9, 0x67, 0xba,
+	0x61, 0xe2, 0x36, 0x2c, 0x40, 0xae, 0x3f, 0xda, 0xdd, 0xd5, 0xca, 0x8a, 0xb0, 0xe7, 0x95, 0xfd,
+	0x83, 0x9c, 0xde, 0x0d, 0x74, 0x97, 0xba, 0x04, 0x3b, 0xe8, 0x65, 0x98, 0xe9, 0x8f, 0xb1, 0x5c,
+	0x5e, 0x5a, 0x9f, 0x48, 0x64, 0xb3, 0xfb, 0x07, 0xb9, 0x1e, 0x1a, 0x2d, 0xe3, 0x9a, 0x8f, 0x56,
+	0xe1, 0x85, 0xfe, 0xfc, 0xd5, 0xd5, 0xd7, 0xcb, 0x86, 0x1a, 0xe1, 0x5b, 0x91, 0xdf, 0x3f, 0xc8,
+	0xcd, 0x74, 0x03, 0x89, 0xb2, 0x44, 0xfe, 0x3c, 0x4e, 0xa9, 0xc5, 0xca, 0x72, 0xb9, 0x34, 0x91,
+	0xea, 0xab, 0x94, 0x7c, 0xda, 0x5d, 0x82, 0xfc, 0xe3, 0xcc, 0xac, 0x50, 0xce, 0x65, 0x67, 0xf6,
+	0x0f, 0x72, 0xd9, 0x5e, 0x36, 0x96, 0x67, 0x9c, 0x74, 0x97, 0xc2, 0xed, 0x0f, 0x3f, 0x9b, 0xd1,
+	0x1e, 0x7e, 0x36, 0xa3, 0xfd, 0xf5, 0xb3, 0x19, 0xed, 0xdd, 0xcf, 0x67, 0x06, 0x1e, 0x7e, 0x3e,
+	0x33, 0xf0, 0xa7, 0xcf, 0x67, 0x06, 0xbe, 0x7b, 0xe5, 0xd0, 0xe1, 0x12, 0x75, 0x5f, 0xe2, 0xbf,
+	0x67, 0xb6, 0x06, 0x45, 0x54, 0xfd, 0xef, 0x3f, 0x07, 0x00, 0xef, 0x7c, 0x2c, 0xd0, 0xb5, 0x24,
+	0x00, 0x00,
+}
+
+func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	return StakingDescription()
 }
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11335 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x1c, 0xd7,
-		0x75, 0x18, 0xbc, 0xf3, 0x9e, 0x39, 0xf3, 0xea, 0xbd, 0xbb, 0x00, 0x16, 0x03, 0x12, 0xbb, 0x6c,
-		0x8a, 0x24, 0x08, 0x92, 0x0b, 0x12, 0x24, 0x40, 0x71, 0x21, 0x89, 0xdf, 0xcc, 0xec, 0x00, 0x18,
-		0x70, 0x5f, 0xec, 0x99, 0x85, 0x48, 0xda, 0x56, 0xbb, 0xb7, 0xe7, 0xee, 0x6e, 0x13, 0x33, 0xdd,
-		0xad, 0xee, 0x1e, 0x60, 0x97, 0xf5, 0x55, 0x4a, 0x8e, 0xec, 0x44, 0x86, 0x1f, 0x91, 0x63, 0x97,
-		0x2d, 0x5b, 0x82, 0x2d, 0xd9, 0xb1, 0x25, 0x3b, 0x2f, 0x3f, 0x14, 0x3f, 0xe2, 0x8a, 0x63, 0xa7,
-		0x9c, 0xc4, 0x76, 0x25, 0x29, 0xc5, 0x3f, 0x12, 0x27, 0x55, 0x66, 0x6c, 0xc9, 0x15, 0x29, 0xb2,
-		0x9c, 0xf8, 0x21, 0x27, 0x4e, 0xa9, 0x92, 0x4a, 0xdd, 0x57, 0x3f, 0xe6, 0xb1, 0x33, 0x0b, 0x91,
-		0xb2, 0x63, 0xe7, 0x0f, 0x30, 0x7d, 0xee, 0x39, 0xe7, 0xde, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x73,
-		0xee, 0x63, 0xe1, 0x77, 0x6b, 0xb0, 0xb4, 0x67, 0x59, 0x7b, 0x5d, 0x7c, 0xc1, 0x76, 0x2c, 0xcf,
-		0xda, 0xe9, 0xef, 0x5e, 0xe8, 0x60, 0x57, 0x77, 0x0c, 0xdb, 0xb3, 0x9c, 0x65, 0x0a, 0x43, 0x65,
-		0x86, 0xb1, 0x2c, 0x30, 0xe4, 0x75, 0x98, 0xbd, 0x6a, 0x74, 0xf1, 0xaa, 0x8f, 0xd8, 0xc2, 0x1e,
-		0x7a, 0x27, 0x24, 0x77, 0x8d, 0x2e, 0x5e, 0x88, 0x2d, 0x25, 0xce, 0xe5, 0x2f, 0xbe, 0x63, 0x79,
-		0x80, 0x68, 0x39, 0x4a, 0xb1, 0x45, 0xc0, 0x0a, 0xa5, 0x90, 0xff, 0x77, 0x12, 0xe6, 0x46, 0x94,
-		0x22, 0x04, 0x49, 0x53, 0xeb, 0x11, 0x8e, 0xb1, 0x73, 0x39, 0x85, 0xfe, 0x46, 0x0b, 0x90, 0xb1,
-		0x35, 0xfd, 0x96, 0xb6, 0x87, 0x17, 0xe2, 0x14, 0x2c, 0x3e, 0xd1, 0x59, 0x80, 0x0e, 0xb6, 0xb1,
-		0xd9, 0xc1, 0xa6, 0x7e, 0xb8, 0x90, 0x58, 0x4a, 0x9c, 0xcb, 0x29, 0x21, 0x08, 0x7a, 0x02, 0x66,
-		0xed, 0xfe, 0x4e, 0xd7, 0xd0, 0xd5, 0x10, 0x1a, 0x2c, 0x25, 0xce, 0xa5, 0x14, 0x89, 0x15, 0xac,
-		0x06, 0xc8, 0x8f, 0x41, 0xf9, 0x0e, 0xd6, 0x6e, 0x85, 0x51, 0xf3, 0x14, 0xb5, 0x44, 0xc0, 0x21,
-		0xc4, 0x3a, 0x14, 0x7a, 0xd8, 0x75, 0xb5, 0x3d, 0xac, 0x7a, 0x87, 0x36, 0x5e, 0x48, 0xd2, 0xde,
-		0x2f, 0x0d, 0xf5, 0x7e, 0xb0, 0xe7, 0x79, 0x4e, 0xd5, 0x3e, 0xb4, 0x31, 0xaa, 0x42, 0x0e, 0x9b,
-		0xfd, 0x1e, 0xe3, 0x90, 0x1a, 0x23, 0xbf, 0x86, 0xd9, 0xef, 0x0d, 0x72, 0xc9, 0x12, 0x32, 0xce,
-		0x22, 0xe3, 0x62, 0xe7, 0xb6, 0xa1, 0xe3, 0x85, 0x34, 0x65, 0xf0, 0xd8, 0x10, 0x83, 0x16, 0x2b,
-		0x1f, 0xe4, 0x21, 0xe8, 0x50, 0x1d, 0x72, 0xf8, 0xc0, 0xc3, 0xa6, 0x6b, 0x58, 0xe6, 0x42, 0x86,
-		0x32, 0x79, 0x64, 0xc4, 0x28, 0xe2, 0x6e, 0x67, 0x90, 0x45, 0x40, 0x87, 0x2e, 0x43, 0xc6, 0xb2,
-		0x3d, 0xc3, 0x32, 0xdd, 0x85, 0xec, 0x52, 0xec, 0x5c, 0xfe, 0xe2, 0x03, 0x23, 0x15, 0x61, 0x93,
-		0xe1, 0x28, 0x02, 0x19, 0x35, 0x41, 0x72, 0xad, 0xbe, 0xa3, 0x63, 0x55, 0xb7, 0x3a, 0x58, 0x35,
-		0xcc, 0x5d, 0x6b, 0x21, 0x47, 0x19, 0x2c, 0x0e, 0x77, 0x84, 0x22, 0xd6, 0xad, 0x0e, 0x6e, 0x9a,
-		0xbb, 0x96, 0x52, 0x72, 0x23, 0xdf, 0xe8, 0x24, 0xa4, 0xdd, 0x43, 0xd3, 0xd3, 0x0e, 0x16, 0x0a,
-		0x54, 0x43, 0xf8, 0x17, 0x51, 0x1d, 0xdc, 0x31, 0x48, 0x75, 0x0b, 0x45, 0xa6, 0x3a, 0xfc, 0x53,
-		0xfe, 0x85, 0x34, 0x94, 0xa7, 0x51, 0xbe, 0x2b, 0x90, 0xda, 0x25, 0xfd, 0x5f, 0x88, 0x1f, 0x47,
-		0x3a, 0x8c, 0x26, 0x2a, 0xde, 0xf4, 0x7d, 0x8a, 0xb7, 0x0a, 0x79, 0x13, 0xbb, 0x1e, 0xee, 0x30,
-		0x5d, 0x49, 0x4c, 0xa9, 0x6d, 0xc0, 0x88, 0x86, 0x95, 0x2d, 0x79, 0x5f, 0xca, 0xf6, 0x0a, 0x94,
-		0xfd, 0x26, 0xa9, 0x8e, 0x66, 0xee, 0x09, 0xad, 0xbd, 0x30, 0xa9, 0x25, 0xcb, 0x0d, 0x41, 0xa7,
-		0x10, 0x32, 0xa5, 0x84, 0x23, 0xdf, 0x68, 0x15, 0xc0, 0x32, 0xb1, 0xb5, 0xab, 0x76, 0xb0, 0xde,
-		0x5d, 0xc8, 0x8e, 0x91, 0xd2, 0x26, 0x41, 0x19, 0x92, 0x92, 0xc5, 0xa0, 0x7a, 0x17, 0xbd, 0x10,
-		0x28, 0x61, 0x66, 0x8c, 0x0e, 0xad, 0xb3, 0xe9, 0x37, 0xa4, 0x87, 0xdb, 0x50, 0x72, 0x30, 0x99,
-		0x11, 0xb8, 0xc3, 0x7b, 0x96, 0xa3, 0x8d, 0x58, 0x9e, 0xd8, 0x33, 0x85, 0x93, 0xb1, 0x8e, 0x15,
-		0x9d, 0xf0, 0x27, 0x7a, 0x18, 0x7c, 0x80, 0x4a, 0xd5, 0x0a, 0xa8, 0x7d, 0x2a, 0x08, 0xe0, 0x86,
-		0xd6, 0xc3, 0x95, 0x37, 0xa0, 0x14, 0x15, 0x0f, 0x9a, 0x87, 0x94, 0xeb, 0x69, 0x8e, 0x47, 0xb5,
-		0x30, 0xa5, 0xb0, 0x0f, 0x24, 0x41, 0x02, 0x9b, 0x1d, 0x6a, 0xff, 0x52, 0x0a, 0xf9, 0x89, 0xfe,
-		0xbf, 0xa0, 0xc3, 0x09, 0xda, 0xe1, 0x47, 0x87, 0x47, 0x34, 0xc2, 0x79, 0xb0, 0xdf, 0x95, 0xe7,
-		0xa1, 0x18, 0xe9, 0xc0, 0xb4, 0x55, 0xcb, 0xff, 0x3f, 0x9c, 0x18, 0xc9, 0x1a, 0xbd, 0x02, 0xf3,
-		0x7d, 0xd3, 0x30, 0x3d, 0xec, 0xd8, 0x0e, 0x26, 0x1a, 0xcb, 0xaa, 0x5a, 0xf8, 0x7c, 0x66, 0x8c,
-		0xce, 0x6d, 0x87, 0xb1, 0x19, 0x17, 0x65, 0xae, 0x3f, 0x0c, 0x3c, 0x9f, 0xcb, 0x7e, 0x21, 0x23,
-		0x7d, 0xe0, 0x03, 0x1f, 0xf8, 0x40, 0x5c, 0xfe, 0x95, 0x34, 0xcc, 0x8f, 0x9a, 0x33, 0x23, 0xa7,
-		0xef, 0x49, 0x48, 0x9b, 0xfd, 0xde, 0x0e, 0x76, 0xa8, 0x90, 0x52, 0x0a, 0xff, 0x42, 0x55, 0x48,
-		0x75, 0xb5, 0x1d, 0xdc, 0x5d, 0x48, 0x2e, 0xc5, 0xce, 0x95, 0x2e, 0x3e, 0x31, 0xd5, 0xac, 0x5c,
-		0x5e, 0x23, 0x24, 0x0a, 0xa3, 0x44, 0xef, 0x81, 0x24, 0x37, 0xde, 0x84, 0xc3, 0xf9, 0xe9, 0x38,
-		0x90, 0xb9, 0xa4, 0x50, 0x3a, 0x74, 0x06, 0x72, 0xe4, 0x7f, 0xa6, 0x1b, 0x69, 0xda, 0xe6, 0x2c,
-		0x01, 0x10, 0xbd, 0x40, 0x15, 0xc8, 0xd2, 0x69, 0xd2, 0xc1, 0x62, 0xd1, 0xf3, 0xbf, 0x89, 0x62,
-		0x75, 0xf0, 0xae, 0xd6, 0xef, 0x7a, 0xea, 0x6d, 0xad, 0xdb, 0xc7, 0x54, 0xe1, 0x73, 0x4a, 0x81,
-		0x03, 0x6f, 0x12, 0x18, 0x5a, 0x84, 0x3c, 0x9b, 0x55, 0x86, 0xd9, 0xc1, 0x07, 0xd4, 0xae, 0xa6,
-		0x14, 0x36, 0xd1, 0x9a, 0x04, 0x42, 0xaa, 0x7f, 0xdd, 0xb5, 0x4c, 0xa1, 0x9a, 0xb4, 0x0a, 0x02,
-		0xa0, 0xd5, 0x3f, 0x3f, 0x68, 0xd2, 0x1f, 0x1c, 0xdd, 0xbd, 0xa1, 0xb9, 0xf4, 0x18, 0x94, 0x29,
-		0xc6, 0xb3, 0x7c, 0xe8, 0xb5, 0xee, 0xc2, 0xec, 0x52, 0xec, 0x5c, 0x56, 0x29, 0x31, 0xf0, 0x26,
-		0x87, 0xca, 0x3f, 0x1b, 0x87, 0x24, 0x35, 0x2c, 0x65, 0xc8, 0xb7, 0x5f, 0xdd, 0x6a, 0xa8, 0xab,
-		0x9b, 0xdb, 0xb5, 0xb5, 0x86, 0x14, 0x43, 0x25, 0x00, 0x0a, 0xb8, 0xba, 0xb6, 0x59, 0x6d, 0x4b,
-		0x71, 0xff, 0xbb, 0xb9, 0xd1, 0xbe, 0xfc, 0x9c, 0x94, 0xf0, 0x09, 0xb6, 0x19, 0x20, 0x19, 0x46,
-		0x78, 0xf6, 0xa2, 0x94, 0x42, 0x12, 0x14, 0x18, 0x83, 0xe6, 0x2b, 0x8d, 0xd5, 0xcb, 0xcf, 0x49,
-		0xe9, 0x28, 0xe4, 0xd9, 0x8b, 0x52, 0x06, 0x15, 0x21, 0x47, 0x21, 0xb5, 0xcd, 0xcd, 0x35, 0x29,
-		0xeb, 0xf3, 0x6c, 0xb5, 0x95, 0xe6, 0xc6, 0x35, 0x29, 0xe7, 0xf3, 0xbc, 0xa6, 0x6c, 0x6e, 0x6f,
-		0x49, 0xe0, 0x73, 0x58, 0x6f, 0xb4, 0x5a, 0xd5, 0x6b, 0x0d, 0x29, 0xef, 0x63, 0xd4, 0x5e, 0x6d,
-		0x37, 0x5a, 0x52, 0x21, 0xd2, 0xac, 0x67, 0x2f, 0x4a, 0x45, 0xbf, 0x8a, 0xc6, 0xc6, 0xf6, 0xba,
-		0x54, 0x42, 0xb3, 0x50, 0x64, 0x55, 0x88, 0x46, 0x94, 0x07, 0x40, 0x97, 0x9f, 0x93, 0xa4, 0xa0,
-		0x21, 0x8c, 0xcb, 0x6c, 0x04, 0x70, 0xf9, 0x39, 0x09, 0xc9, 0x75, 0x48, 0x51, 0x35, 0x44, 0x08,
-		0x4a, 0x6b, 0xd5, 0x5a, 0x63, 0x4d, 0xdd, 0xdc, 0x6a, 0x37, 0x37, 0x37, 0xaa, 0x6b, 0x52, 0x2c,
-		0x80, 0x29, 0x8d, 0x97, 0xb7, 0x9b, 0x4a, 0x63, 0x55, 0x8a, 0x87, 0x61, 0x5b, 0x8d, 0x6a, 0xbb,
-		0xb1, 0x2a, 0x25, 0x64, 0x1d, 0xe6, 0x47, 0x19, 0xd4, 0x91, 0x53, 0x28, 0xa4, 0x0b, 0xf1, 0x31,
-		0xba, 0x40, 0x79, 0x0d, 0xea, 0x82, 0xfc, 0xb9, 0x38, 0xcc, 0x8d, 0x58, 0x54, 0x46, 0x56, 0xf2,
-		0x22, 0xa4, 0x98, 0x2e, 0xb3, 0x65, 0xf6, 0xf1, 0x91, 0xab, 0x13, 0xd5, 0xec, 0xa1, 0xa5, 0x96,
-		0xd2, 0x85, 0x9d, 0x90, 0xc4, 0x18, 0x27, 0x84, 0xb0, 0x18, 0x52, 0xd8, 0x6f, 0x18, 0x32, 0xfe,
-		0x6c, 0x7d, 0xbc, 0x3c, 0xcd, 0xfa, 0x48, 0x61, 0xc7, 0x5b, 0x04, 0x52, 0x23, 0x16, 0x81, 0x2b,
-		0x30, 0x3b, 0xc4, 0x68, 0x6a, 0x63, 0xfc, 0xc1, 0x18, 0x2c, 0x8c, 0x13, 0xce, 0x04, 0x93, 0x18,
-		0x8f, 0x98, 0xc4, 0x2b, 0x83, 0x12, 0x7c, 0x68, 0xfc, 0x20, 0x0c, 0x8d, 0xf5, 0x27, 0x63, 0x70,
-		0x72, 0xb4, 0xb3, 0x39, 0xb2, 0x0d, 0xef, 0x81, 0x74, 0x0f, 0x7b, 0xfb, 0x96, 0x70, 0xab, 0x1e,
-		0x1d, 0xb1, 0x58, 0x93, 0xe2, 0xc1, 0xc1, 0xe6, 0x54, 0xe1, 0xd5, 0x3e, 0x31, 0xce, 0x63, 0x64,
-		0xad, 0x19, 0x6a, 0xe9, 0xb7, 0xc6, 0xe1, 0xc4, 0x48, 0xe6, 0x23, 0x1b, 0xfa, 0x20, 0x80, 0x61,
-		0xda, 0x7d, 0x8f, 0xb9, 0x4e, 0xcc, 0x12, 0xe7, 0x28, 0x84, 0x1a, 0x2f, 0x62, 0x65, 0xfb, 0x9e,
-		0x5f, 0x9e, 0xa0, 0xe5, 0xc0, 0x40, 0x14, 0xe1, 0x9d, 0x41, 0x43, 0x93, 0xb4, 0xa1, 0x67, 0xc7,
-		0xf4, 0x74, 0x48, 0x31, 0x9f, 0x06, 0x49, 0xef, 0x1a, 0xd8, 0xf4, 0x54, 0xd7, 0x73, 0xb0, 0xd6,
-		0x33, 0xcc, 0x3d, 0xba, 0xd4, 0x64, 0x57, 0x52, 0xbb, 0x5a, 0xd7, 0xc5, 0x4a, 0x99, 0x15, 0xb7,
-		0x44, 0x29, 0xa1, 0xa0, 0x0a, 0xe4, 0x84, 0x28, 0xd2, 0x11, 0x0a, 0x56, 0xec, 0x53, 0xc8, 0xdf,
-		0x95, 0x83, 0x7c, 0xc8, 0x35, 0x47, 0x0f, 0x41, 0xe1, 0x75, 0xed, 0xb6, 0xa6, 0x8a, 0x70, 0x8b,
-		0x49, 0x22, 0x4f, 0x60, 0x5b, 0x3c, 0xe4, 0x7a, 0x1a, 0xe6, 0x29, 0x8a, 0xd5, 0xf7, 0xb0, 0xa3,
-		0xea, 0x5d, 0xcd, 0x75, 0xa9, 0xd0, 0xb2, 0x14, 0x15, 0x91, 0xb2, 0x4d, 0x52, 0x54, 0x17, 0x25,
-		0xe8, 0x12, 0xcc, 0x51, 0x8a, 0x5e, 0xbf, 0xeb, 0x19, 0x76, 0x17, 0xab, 0x24, 0x00, 0x74, 0xe9,
-		0x92, 0xe3, 0xb7, 0x6c, 0x96, 0x60, 0xac, 0x73, 0x04, 0xd2, 0x22, 0x17, 0xad, 0xc2, 0x83, 0x94,
-		0x6c, 0x0f, 0x9b, 0xd8, 0xd1, 0x3c, 0xac, 0xe2, 0xf7, 0xf7, 0xb5, 0xae, 0xab, 0x6a, 0x66, 0x47,
-		0xdd, 0xd7, 0xdc, 0xfd, 0x85, 0x79, 0xc2, 0xa0, 0x16, 0x5f, 0x88, 0x29, 0xa7, 0x09, 0xe2, 0x35,
-		0x8e, 0xd7, 0xa0, 0x68, 0x55, 0xb3, 0x73, 0x5d, 0x73, 0xf7, 0xd1, 0x0a, 0x9c, 0xa4, 0x5c, 0x5c,
-		0xcf, 0x31, 0xcc, 0x3d, 0x55, 0xdf, 0xc7, 0xfa, 0x2d, 0xb5, 0xef, 0xed, 0xbe, 0x73, 0xe1, 0x4c,
-		0xb8, 0x7e, 0xda, 0xc2, 0x16, 0xc5, 0xa9, 0x13, 0x94, 0x6d, 0x6f, 0xf7, 0x9d, 0xa8, 0x05, 0x05,
-		0x32, 0x18, 0x3d, 0xe3, 0x0d, 0xac, 0xee, 0x5a, 0x0e, 0x5d, 0x43, 0x4b, 0x23, 0x4c, 0x53, 0x48,
-		0x82, 0xcb, 0x9b, 0x9c, 0x60, 0xdd, 0xea, 0xe0, 0x95, 0x54, 0x6b, 0xab, 0xd1, 0x58, 0x55, 0xf2,
-		0x82, 0xcb, 0x55, 0xcb, 0x21, 0x0a, 0xb5, 0x67, 0xf9, 0x02, 0xce, 0x33, 0x85, 0xda, 0xb3, 0x84,
-		0x78, 0x2f, 0xc1, 0x9c, 0xae, 0xb3, 0x3e, 0x1b, 0xba, 0xca, 0xc3, 0x34, 0x77, 0x41, 0x8a, 0x08,
-		0x4b, 0xd7, 0xaf, 0x31, 0x04, 0xae, 0xe3, 0x2e, 0x7a, 0x01, 0x4e, 0x04, 0xc2, 0x0a, 0x13, 0xce,
-		0x0e, 0xf5, 0x72, 0x90, 0xf4, 0x12, 0xcc, 0xd9, 0x87, 0xc3, 0x84, 0x28, 0x52, 0xa3, 0x7d, 0x38,
-		0x48, 0xf6, 0x3c, 0xcc, 0xdb, 0xfb, 0xf6, 0x30, 0xdd, 0xf9, 0x30, 0x1d, 0xb2, 0xf7, 0xed, 0x41,
-		0xc2, 0x47, 0x68, 0xcc, 0xee, 0x60, 0x5d, 0xf3, 0x70, 0x67, 0xe1, 0x54, 0x18, 0x3d, 0x54, 0x80,
-		0x96, 0x41, 0xd2, 0x75, 0x15, 0x9b, 0xda, 0x4e, 0x17, 0xab, 0x9a, 0x83, 0x4d, 0xcd, 0x5d, 0x58,
-		0xa4, 0xc8, 0x49, 0xcf, 0xe9, 0x63, 0xa5, 0xa4, 0xeb, 0x0d, 0x5a, 0x58, 0xa5, 0x65, 0xe8, 0x3c,
-		0xcc, 0x5a, 0x3b, 0xaf, 0xeb, 0x4c, 0x23, 0x55, 0xdb, 0xc1, 0xbb, 0xc6, 0xc1, 0xc2, 0x3b, 0xa8,
-		0x78, 0xcb, 0xa4, 0x80, 0xea, 0xe3, 0x16, 0x05, 0xa3, 0xc7, 0x41, 0xd2, 0xdd, 0x7d, 0xcd, 0xb1,
-		0xa9, 0x49, 0x76, 0x6d, 0x4d, 0xc7, 0x0b, 0x8f, 0x30, 0x54, 0x06, 0xdf, 0x10, 0x60, 0x32, 0x23,
-		0xdc, 0x3b, 0xc6, 0xae, 0x27, 0x38, 0x3e, 0xc6, 0x66, 0x04, 0x85, 0x71, 0x6e, 0xe7, 0x40, 0x22,
-		0x92, 0x88, 0x54, 0x7c, 0x8e, 0xa2, 0x95, 0xec, 0x7d, 0x3b, 0x5c, 0xef, 0xc3, 0x50, 0x24, 0x98,
-		0x41, 0xa5, 0x8f, 0x33, 0xc7, 0xcd, 0xde, 0x0f, 0xd5, 0xf8, 0x1c, 0x9c, 0x24, 0x48, 0x3d, 0xec,
-		0x69, 0x1d, 0xcd, 0xd3, 0x42, 0xd8, 0x4f, 0x52, 0x6c, 0x22, 0xf6, 0x75, 0x5e, 0x18, 0x69, 0xa7,
-		0xd3, 0xdf, 0x39, 0xf4, 0x15, 0xeb, 0x29, 0xd6, 0x4e, 0x02, 0x13, 0xaa, 0xf5, 0xb6, 0x39, 0xe7,
-		0xf2, 0x0a, 0x14, 0xc2, 0x7a, 0x8f, 0x72, 0xc0, 0x34, 0x5f, 0x8a, 0x11, 0x27, 0xa8, 0xbe, 0xb9,
-		0x4a, 0xdc, 0x97, 0xd7, 0x1a, 0x52, 0x9c, 0xb8, 0x51, 0x6b, 0xcd, 0x76, 0x43, 0x55, 0xb6, 0x37,
-		0xda, 0xcd, 0xf5, 0x86, 0x94, 0x08, 0x39, 0xf6, 0x37, 0x92, 0xd9, 0x47, 0xa5, 0xc7, 0xe4, 0x5f,
-		0x4c, 0x40, 0x29, 0x1a, 0xa9, 0xa1, 0x77, 0xc1, 0x29, 0x91, 0x70, 0x71, 0xb1, 0xa7, 0xde, 0x31,
-		0x1c, 0x3a, 0x21, 0x7b, 0x1a, 0x5b, 0x1c, 0x7d, 0xfd, 0x99, 0xe7, 0x58, 0x2d, 0xec, 0xbd, 0xd7,
-		0x70, 0xc8, 0x74, 0xeb, 0x69, 0x1e, 0x5a, 0x83, 0x45, 0xd3, 0x52, 0x5d, 0x4f, 0x33, 0x3b, 0x9a,
-		0xd3, 0x51, 0x83, 0x54, 0x97, 0xaa, 0xe9, 0x3a, 0x76, 0x5d, 0x8b, 0x2d, 0x84, 0x3e, 0x97, 0x07,
-		0x4c, 0xab, 0xc5, 0x91, 0x83, 0x15, 0xa2, 0xca, 0x51, 0x07, 0xd4, 0x37, 0x31, 0x4e, 0x7d, 0xcf,
-		0x40, 0xae, 0xa7, 0xd9, 0x2a, 0x36, 0x3d, 0xe7, 0x90, 0xfa, 0xe7, 0x59, 0x25, 0xdb, 0xd3, 0xec,
-		0x06, 0xf9, 0x46, 0x37, 0xe1, 0xd1, 0x00, 0x55, 0xed, 0xe2, 0x3d, 0x4d, 0x3f, 0x54, 0xa9, 0x33,
-		0x4e, 0xd3, 0x06, 0xaa, 0x6e, 0x99, 0xbb, 0x5d, 0x43, 0xf7, 0x5c, 0x6a, 0x1f, 0x98, 0x8d, 0x93,
-		0x03, 0x8a, 0x35, 0x4a, 0x70, 0xc3, 0xb5, 0x4c, 0xea, 0x83, 0xd7, 0x05, 0xf6, 0xd7, 0x24, 0xfc,
-		0xba, 0x91, 0xcc, 0x26, 0xa5, 0xd4, 0x8d, 0x64, 0x36, 0x25, 0xa5, 0x6f, 0x24, 0xb3, 0x69, 0x29,
-		0x73, 0x23, 0x99, 0xcd, 0x4a, 0xb9, 0x1b, 0xc9, 0x6c, 0x4e, 0x02, 0xf9, 0xe7, 0xb2, 0x50, 0x08,
-		0x47, 0x06, 0x24, 0xd0, 0xd2, 0xe9, 0xda, 0x18, 0xa3, 0xd6, 0xf3, 0xe1, 0x23, 0xe3, 0x88, 0xe5,
-		0x3a, 0x59, 0x34, 0x57, 0xd2, 0xcc, 0x0d, 0x57, 0x18, 0x25, 0x71, 0x58, 0x88, 0x5a, 0x63, 0xe6,
-		0xf6, 0x64, 0x15, 0xfe, 0x85, 0xae, 0x41, 0xfa, 0x75, 0x97, 0xf2, 0x4e, 0x53, 0xde, 0xef, 0x38,
-		0x9a, 0xf7, 0x8d, 0x16, 0x65, 0x9e, 0xbb, 0xd1, 0x52, 0x37, 0x36, 0x95, 0xf5, 0xea, 0x9a, 0xc2,
-		0xc9, 0xd1, 0x69, 0x48, 0x76, 0xb5, 0x37, 0x0e, 0xa3, 0xcb, 0x2b, 0x05, 0xa1, 0x65, 0x28, 0xf7,
-		0xcd, 0xdb, 0xd8, 0x31, 0x76, 0x0d, 0x32, 0x54, 0x04, 0xab, 0x1c, 0xc6, 0x2a, 0x05, 0xa5, 0x6b,
-		0x04, 0x7f, 0x4a, 0xf5, 0x38, 0x0d, 0xc9, 0x3b, 0x58, 0xbb, 0x15, 0x5d, 0x04, 0x29, 0x08, 0x9d,
-		0x83, 0x42, 0x07, 0xef, 0xf4, 0xf7, 0x54, 0x07, 0x77, 0x34, 0xdd, 0x8b, 0x9a, 0xfe, 0x3c, 0x2d,
-		0x52, 0x68, 0x09, 0x7a, 0x09, 0x72, 0x64, 0x8c, 0x4c, 0x3a, 0xc6, 0xb3, 0x54, 0x04, 0x4f, 0x1d,
-		0x2d, 0x02, 0x3e, 0xc4, 0x82, 0x48, 0x09, 0xe8, 0xd1, 0x55, 0x48, 0x7b, 0x9a, 0xb3, 0x87, 0x3d,
-		0x6a, 0xf9, 0x4b, 0x23, 0x92, 0x1f, 0x23, 0x38, 0xb5, 0x29, 0x05, 0x8d, 0x69, 0x39, 0xf5, 0xdb,
-		0x68, 0x65, 0x2e, 0x40, 0x8a, 0xaa, 0x07, 0x02, 0xe0, 0x0a, 0x22, 0xcd, 0xa0, 0x2c, 0x24, 0xeb,
-		0x9b, 0x0a, 0xb1, 0x34, 0x12, 0x14, 0x18, 0x54, 0xdd, 0x6a, 0x36, 0xea, 0x0d, 0x29, 0x2e, 0x5f,
-		0x82, 0x34, 0x1b, 0x73, 0x62, 0x85, 0xfc, 0x51, 0x97, 0x66, 0xf8, 0x27, 0xe7, 0x11, 0x13, 0xa5,
-		0xdb, 0xeb, 0xb5, 0x86, 0x22, 0xc5, 0xe5, 0x6d, 0x28, 0x0f, 0xc8, 0x09, 0x9d, 0x80, 0x59, 0xa5,
-		0xd1, 0x6e, 0x6c, 0x90, 0x38, 0x4b, 0xdd, 0xde, 0x78, 0x69, 0x63, 0xf3, 0xbd, 0x1b, 0xd2, 0x4c,
-		0x14, 0x2c, 0x4c, 0x5a, 0x0c, 0xcd, 0x83, 0x14, 0x80, 0x5b, 0x9b, 0xdb, 0x0a, 0x6d, 0xcd, 0xb7,
-		0xc7, 0x41, 0x1a, 0x94, 0x1a, 0x3a, 0x05, 0x73, 0xed, 0xaa, 0x72, 0xad, 0xd1, 0x56, 0x59, 0xec,
-		0xe8, 0xb3, 0x9e, 0x07, 0x29, 0x5c, 0x70, 0xb5, 0x49, 0x43, 0xe3, 0x45, 0x38, 0x13, 0x86, 0x36,
-		0x5e, 0x69, 0x37, 0x36, 0x5a, 0xb4, 0xf2, 0xea, 0xc6, 0x35, 0x62, 0x5f, 0x07, 0xf8, 0x89, 0x68,
-		0x35, 0x41, 0x9a, 0x1a, 0xe5, 0xd7, 0x58, 0x5b, 0x95, 0x92, 0x83, 0xe0, 0xcd, 0x8d, 0xc6, 0xe6,
-		0x55, 0x29, 0x35, 0x58, 0x3b, 0x8d, 0x60, 0xd3, 0xa8, 0x02, 0x27, 0x07, 0xa1, 0x6a, 0x63, 0xa3,
-		0xad, 0xbc, 0x2a, 0x65, 0x06, 0x2b, 0x6e, 0x35, 0x94, 0x9b, 0xcd, 0x7a, 0x43, 0xca, 0xa2, 0x93,
-		0x80, 0xa2, 0x2d, 0x6a, 0x5f, 0xdf, 0x5c, 0x95, 0x72, 0x43, 0x16, 0x45, 0x76, 0xa1, 0x10, 0x0e,
-		0x23, 0xbf, 0x36, 0xb9, 0xa4, 0x8f, 0xc4, 0x21, 0x1f, 0x0a, 0x0b, 0x89, 0x3f, 0xaf, 0x75, 0xbb,
-		0xd6, 0x1d, 0x55, 0xeb, 0x1a, 0x9a, 0xcb, 0xed, 0x0d, 0x50, 0x50, 0x95, 0x40, 0xa6, 0x9d, 0xdf,
-		0xd3, 0x5b, 0xf8, 0xf4, 0x5f, 0x44, 0x0b, 0x9f, 0x92, 0xd2, 0xf2, 0x0f, 0xc6, 0x40, 0x1a, 0x8c,
-		0xf7, 0x06, 0xba, 0x1f, 0x1b, 0xd7, 0xfd, 0xaf, 0xc9, 0xd8, 0x7d, 0x2c, 0x06, 0xa5, 0x68, 0x90,
-		0x37, 0xd0, 0xbc, 0x87, 0xfe, 0x5c, 0x9b, 0xf7, 0x3b, 0x71, 0x28, 0x46, 0x42, 0xbb, 0x69, 0x5b,
-		0xf7, 0x7e, 0x98, 0x35, 0x3a, 0xb8, 0x67, 0x5b, 0x1e, 0x36, 0xf5, 0x43, 0xb5, 0x8b, 0x6f, 0xe3,
-		0xee, 0x82, 0x4c, 0x8d, 0xf2, 0x85, 0xa3, 0x83, 0xc7, 0xe5, 0x66, 0x40, 0xb7, 0x46, 0xc8, 0x56,
-		0xe6, 0x9a, 0xab, 0x8d, 0xf5, 0xad, 0xcd, 0x76, 0x63, 0xa3, 0xfe, 0xaa, 0xb0, 0x2e, 0x8a, 0x64,
-		0x0c, 0xa0, 0xbd, 0x8d, 0x46, 0x7b, 0x0b, 0xa4, 0xc1, 0x46, 0x11, 0x5b, 0x31, 0xa2, 0x59, 0xd2,
-		0x0c, 0x9a, 0x83, 0xf2, 0xc6, 0xa6, 0xda, 0x6a, 0xae, 0x36, 0xd4, 0xc6, 0xd5, 0xab, 0x8d, 0x7a,
-		0xbb, 0xc5, 0xd2, 0x81, 0x3e, 0x76, 0x5b, 0x8a, 0x87, 0x45, 0xfc, 0x03, 0x09, 0x98, 0x1b, 0xd1,
-		0x12, 0x54, 0xe5, 0x81, 0x3c, 0xcb, 0x2d, 0x3c, 0x35, 0x4d, 0xeb, 0x97, 0x89, 0x2b, 0xbd, 0xa5,
-		0x39, 0x1e, 0x8f, 0xfb, 0x1f, 0x07, 0x22, 0x25, 0xd3, 0x23, 0x2b, 0xbb, 0xc3, 0xd3, 0xac, 0x2c,
-		0xba, 0x2f, 0x07, 0x70, 0x96, 0x69, 0x7d, 0x12, 0x90, 0x6d, 0xb9, 0x86, 0x67, 0xdc, 0xc6, 0xaa,
-		0x61, 0x8a, 0x9c, 0x2c, 0x89, 0xf6, 0x93, 0x8a, 0x24, 0x4a, 0x9a, 0xa6, 0xe7, 0x63, 0x9b, 0x78,
-		0x4f, 0x1b, 0xc0, 0x26, 0x9e, 0x47, 0x42, 0x91, 0x44, 0x89, 0x8f, 0xfd, 0x10, 0x14, 0x3a, 0x56,
-		0x9f, 0x84, 0x40, 0x0c, 0x8f, 0x58, 0x8b, 0x98, 0x92, 0x67, 0x30, 0x1f, 0x85, 0x07, 0xb7, 0x41,
-		0x32, 0xb8, 0xa0, 0xe4, 0x19, 0x8c, 0xa1, 0x3c, 0x06, 0x65, 0x6d, 0x6f, 0xcf, 0x21, 0xcc, 0x05,
-		0x23, 0x16, 0xae, 0x97, 0x7c, 0x30, 0x45, 0xac, 0xdc, 0x80, 0xac, 0x90, 0x03, 0xf1, 0x60, 0x89,
-		0x24, 0x54, 0x9b, 0xe5, 0xa0, 0xe2, 0xe7, 0x72, 0x4a, 0xd6, 0x14, 0x85, 0x0f, 0x41, 0xc1, 0x70,
-		0xd5, 0x60, 0x6f, 0x2b, 0xbe, 0x14, 0x3f, 0x97, 0x55, 0xf2, 0x86, 0xeb, 0xef, 0x0b, 0xc8, 0x9f,
-		0x8c, 0x43, 0x29, 0xba, 0x6b, 0x87, 0x56, 0x21, 0xdb, 0xb5, 0x74, 0x8d, 0xaa, 0x16, 0xdb, 0x32,
-		0x3e, 0x37, 0x61, 0xa3, 0x6f, 0x79, 0x8d, 0xe3, 0x2b, 0x3e, 0x65, 0xe5, 0xdf, 0xc4, 0x20, 0x2b,
-		0xc0, 0xe8, 0x24, 0x24, 0x6d, 0xcd, 0xdb, 0xa7, 0xec, 0x52, 0xb5, 0xb8, 0x14, 0x53, 0xe8, 0x37,
-		0x81, 0xbb, 0xb6, 0x66, 0x52, 0x15, 0xe0, 0x70, 0xf2, 0x4d, 0xc6, 0xb5, 0x8b, 0xb5, 0x0e, 0xcd,
-		0x05, 0x58, 0xbd, 0x1e, 0x36, 0x3d, 0x57, 0x8c, 0x2b, 0x87, 0xd7, 0x39, 0x18, 0x3d, 0x01, 0xb3,
-		0x9e, 0xa3, 0x19, 0xdd, 0x08, 0x6e, 0x92, 0xe2, 0x4a, 0xa2, 0xc0, 0x47, 0x5e, 0x81, 0xd3, 0x82,
-		0x6f, 0x07, 0x7b, 0x9a, 0xbe, 0x8f, 0x3b, 0x01, 0x51, 0x9a, 0xe6, 0xfc, 0x4e, 0x71, 0x84, 0x55,
-		0x5e, 0x2e, 0x68, 0xe5, 0xcf, 0xc4, 0x61, 0x56, 0x64, 0x2f, 0x3a, 0xbe, 0xb0, 0xd6, 0x01, 0x34,
-		0xd3, 0xb4, 0xbc, 0xb0, 0xb8, 0x86, 0x55, 0x79, 0x88, 0x6e, 0xb9, 0xea, 0x13, 0x29, 0x21, 0x06,
-		0x95, 0xdf, 0x8f, 0x01, 0x04, 0x45, 0x63, 0xe5, 0xb6, 0x08, 0x79, 0xbe, 0x27, 0x4b, 0x37, 0xf6,
-		0x59, 0xc2, 0x0b, 0x18, 0xe8, 0xaa, 0xd1, 0xa5, 0x69, 0xc9, 0x1d, 0xbc, 0x67, 0x98, 0x7c, 0x3f,
-		0x85, 0x7d, 0x88, 0xb4, 0x64, 0x32, 0xd8, 0x9e, 0x52, 0x20, 0xeb, 0xe2, 0x9e, 0x66, 0x7a, 0x86,
-		0xce, 0x77, 0x48, 0x2e, 0x1f, 0xab, 0xf1, 0xcb, 0x2d, 0x4e, 0xad, 0xf8, 0x7c, 0xe4, 0x73, 0x90,
-		0x15, 0x50, 0xe2, 0xf8, 0x6d, 0x6c, 0x6e, 0x34, 0xa4, 0x19, 0x94, 0x81, 0x44, 0xab, 0xd1, 0x96,
-		0x62, 0x24, 0xec, 0xac, 0xae, 0x35, 0xab, 0x2d, 0x29, 0x5e, 0xfb, 0x6b, 0x30, 0xa7, 0x5b, 0xbd,
-		0xc1, 0x0a, 0x6b, 0xd2, 0x40, 0xca, 0xcf, 0xbd, 0x1e, 0x7b, 0xed, 0x29, 0x8e, 0xb4, 0x67, 0x75,
-		0x35, 0x73, 0x6f, 0xd9, 0x72, 0xf6, 0x82, 0x63, 0x11, 0x24, 0x3a, 0x70, 0x43, 0x87, 0x23, 0xec,
-		0x9d, 0x3f, 0x8b, 0xc5, 0x7e, 0x38, 0x9e, 0xb8, 0xb6, 0x55, 0xfb, 0x89, 0x78, 0xe5, 0x1a, 0x23,
-		0xdc, 0x12, 0xdd, 0x51, 0xf0, 0x6e, 0x17, 0xeb, 0xa4, 0xf1, 0xf0, 0x07, 0x4f, 0xc0, 0xfc, 0x9e,
-		0xb5, 0x67, 0x51, 0x4e, 0x17, 0xc8, 0x2f, 0x7e, 0xae, 0x22, 0xe7, 0x43, 0x2b, 0x13, 0x0f, 0x61,
-		0xac, 0x6c, 0xc0, 0x1c, 0x47, 0x56, 0xe9, 0xf6, 0x2d, 0x4b, 0x2e, 0xa0, 0x23, 0x33, 0xdb, 0x0b,
-		0x3f, 0xf5, 0x7b, 0xd4, 0x2b, 0x51, 0x66, 0x39, 0x29, 0x29, 0x63, 0xf9, 0x87, 0x15, 0x05, 0x4e,
-		0x44, 0xf8, 0x31, 0x1b, 0x81, 0x9d, 0x09, 0x1c, 0xff, 0x39, 0xe7, 0x38, 0x17, 0xe2, 0xd8, 0xe2,
-		0xa4, 0x2b, 0x75, 0x28, 0x1e, 0x87, 0xd7, 0xbf, 0xe0, 0xbc, 0x0a, 0x38, 0xcc, 0xe4, 0x1a, 0x94,
-		0x29, 0x13, 0xbd, 0xef, 0x7a, 0x56, 0x8f, 0x1a, 0xe0, 0xa3, 0xd9, 0xfc, 0xcb, 0xdf, 0x63, 0x93,
-		0xb6, 0x44, 0xc8, 0xea, 0x3e, 0xd5, 0xca, 0x0a, 0xd0, 0x1d, 0xeb, 0x0e, 0xd6, 0xbb, 0x13, 0x38,
-		0xfc, 0x1a, 0x6f, 0x88, 0x8f, 0xbf, 0x72, 0x13, 0xe6, 0xc9, 0x6f, 0x6a, 0x1f, 0xc3, 0x2d, 0x99,
-		0x9c, 0x06, 0x5f, 0xf8, 0xb7, 0x1f, 0x64, 0x76, 0x61, 0xce, 0x67, 0x10, 0x6a, 0x53, 0x68, 0x14,
-		0xf7, 0xb0, 0xe7, 0x61, 0xc7, 0x55, 0xb5, 0xee, 0xa8, 0xe6, 0x85, 0xf2, 0x88, 0x0b, 0xdf, 0xff,
-		0xa5, 0xe8, 0x28, 0x5e, 0x63, 0x94, 0xd5, 0x6e, 0x77, 0x65, 0x1b, 0x4e, 0x8d, 0xd0, 0x8a, 0x29,
-		0x78, 0xfe, 0x00, 0xe7, 0x39, 0x3f, 0xa4, 0x19, 0x84, 0xed, 0x16, 0x08, 0xb8, 0x3f, 0x96, 0x53,
-		0xf0, 0xfc, 0x28, 0xe7, 0x89, 0x38, 0xad, 0x18, 0x52, 0xc2, 0xf1, 0x06, 0xcc, 0xde, 0xc6, 0xce,
-		0x8e, 0xe5, 0xf2, 0xdc, 0xed, 0x14, 0xec, 0x3e, 0xc6, 0xd9, 0x95, 0x39, 0x21, 0x4d, 0xe6, 0x12,
-		0x5e, 0x2f, 0x40, 0x76, 0x57, 0xd3, 0xf1, 0x14, 0x2c, 0xee, 0x71, 0x16, 0x19, 0x82, 0x4f, 0x48,
-		0xab, 0x50, 0xd8, 0xb3, 0xf8, 0x12, 0x39, 0x99, 0xfc, 0x07, 0x39, 0x79, 0x5e, 0xd0, 0x70, 0x16,
-		0xb6, 0x65, 0xf7, 0xbb, 0x64, 0xfd, 0x9c, 0xcc, 0xe2, 0x87, 0x04, 0x0b, 0x41, 0xc3, 0x59, 0x1c,
-		0x43, 0xac, 0x1f, 0x17, 0x2c, 0xdc, 0x90, 0x3c, 0x5f, 0x84, 0xbc, 0x65, 0x76, 0x0f, 0x2d, 0x73,
-		0x9a, 0x46, 0x7c, 0x82, 0x73, 0x00, 0x4e, 0x42, 0x18, 0x5c, 0x81, 0xdc, 0xb4, 0x03, 0xf1, 0xa3,
-		0x5f, 0x12, 0xd3, 0x43, 0x8c, 0xc0, 0x35, 0x28, 0x0b, 0x03, 0x65, 0x58, 0xe6, 0x14, 0x2c, 0x7e,
-		0x8c, 0xb3, 0x28, 0x85, 0xc8, 0x78, 0x37, 0x3c, 0xec, 0x7a, 0x7b, 0x78, 0x1a, 0x26, 0x9f, 0x14,
-		0xdd, 0xe0, 0x24, 0x5c, 0x94, 0x3b, 0xd8, 0xd4, 0xf7, 0xa7, 0xe3, 0xf0, 0x29, 0x21, 0x4a, 0x41,
-		0x43, 0x58, 0xd4, 0xa1, 0xd8, 0xd3, 0x1c, 0x77, 0x5f, 0xeb, 0x4e, 0x35, 0x1c, 0x3f, 0xce, 0x79,
-		0x14, 0x7c, 0x22, 0x2e, 0x91, 0xbe, 0x79, 0x1c, 0x36, 0x3f, 0x21, 0x24, 0x12, 0x22, 0xe3, 0x53,
-		0xcf, 0xf5, 0x68, 0xa2, 0xfb, 0x38, 0xdc, 0xfe, 0xae, 0x98, 0x7a, 0x8c, 0x76, 0x3d, 0xcc, 0xf1,
-		0x0a, 0xe4, 0x5c, 0xe3, 0x8d, 0xa9, 0xd8, 0xfc, 0x3d, 0x31, 0xd2, 0x94, 0x80, 0x10, 0xbf, 0x0a,
-		0xa7, 0x47, 0x2e, 0x13, 0x53, 0x30, 0xfb, 0xfb, 0x9c, 0xd9, 0xc9, 0x11, 0x4b, 0x05, 0x37, 0x09,
-		0xc7, 0x65, 0xf9, 0x0f, 0x84, 0x49, 0xc0, 0x03, 0xbc, 0xb6, 0x48, 0xd0, 0xe2, 0x6a, 0xbb, 0xc7,
-		0x93, 0xda, 0x3f, 0x14, 0x52, 0x63, 0xb4, 0x11, 0xa9, 0xb5, 0xe1, 0x24, 0xe7, 0x78, 0xbc, 0x71,
-		0xfd, 0x49, 0x61, 0x58, 0x19, 0xf5, 0x76, 0x74, 0x74, 0xbf, 0x0e, 0x2a, 0xbe, 0x38, 0x85, 0x77,
-		0xec, 0xaa, 0x3d, 0xcd, 0x9e, 0x82, 0xf3, 0x4f, 0x71, 0xce, 0xc2, 0xe2, 0xfb, 0xee, 0xb5, 0xbb,
-		0xae, 0xd9, 0x84, 0xf9, 0x2b, 0xb0, 0x20, 0x98, 0xf7, 0x4d, 0x07, 0xeb, 0xd6, 0x9e, 0x69, 0xbc,
-		0x81, 0x3b, 0x53, 0xb0, 0xfe, 0xe9, 0x81, 0xa1, 0xda, 0x0e, 0x91, 0x13, 0xce, 0x4d, 0x90, 0x7c,
-		0x5f, 0x45, 0x35, 0x7a, 0xb6, 0xe5, 0x78, 0x13, 0x38, 0xfe, 0x8c, 0x18, 0x29, 0x9f, 0xae, 0x49,
-		0xc9, 0x56, 0x1a, 0xc0, 0x4e, 0x7f, 0x4c, 0xab, 0x92, 0x9f, 0xe6, 0x8c, 0x8a, 0x01, 0x15, 0x37,
-		0x1c, 0xba, 0xd5, 0xb3, 0x35, 0x67, 0x1a, 0xfb, 0xf7, 0x8f, 0x84, 0xe1, 0xe0, 0x24, 0xdc, 0x70,
-		0x10, 0x8f, 0x8e, 0xac, 0xf6, 0x53, 0x70, 0xf8, 0x59, 0x61, 0x38, 0x04, 0x0d, 0x67, 0x21, 0x1c,
-		0x86, 0x29, 0x58, 0xfc, 0x9c, 0x60, 0x21, 0x68, 0x08, 0x8b, 0x97, 0x83, 0x85, 0xd6, 0xc1, 0x7b,
-		0x86, 0xeb, 0x39, 0xcc, 0x25, 0x3f, 0x9a, 0xd5, 0xcf, 0x7f, 0x29, 0xea, 0x84, 0x29, 0x21, 0x52,
-		0x62, 0x89, 0xf8, 0xd6, 0x07, 0x0d, 0xd9, 0x26, 0x37, 0xec, 0x17, 0x84, 0x25, 0x0a, 0x91, 0x91,
-		0xb6, 0x85, 0x3c, 0x44, 0x22, 0x76, 0x9d, 0x04, 0x2a, 0x53, 0xb0, 0xfb, 0xc7, 0x03, 0x8d, 0x6b,
-		0x09, 0x5a, 0xc2, 0x33, 0xe4, 0xff, 0xf4, 0xcd, 0x5b, 0xf8, 0x70, 0x2a, 0xed, 0xfc, 0xc5, 0x01,
-		0xff, 0x67, 0x9b, 0x51, 0x32, 0x1b, 0x52, 0x1e, 0xf0, 0xa7, 0xd0, 0xa4, 0xb3, 0x7e, 0x0b, 0xdf,
-		0xf4, 0x65, 0xde, 0xdf, 0xa8, 0x3b, 0xb5, 0xb2, 0x46, 0x94, 0x3c, 0xea, 0xf4, 0x4c, 0x66, 0xf6,
-		0xc1, 0x2f, 0xfb, 0x7a, 0x1e, 0xf1, 0x79, 0x56, 0xae, 0x42, 0x31, 0xe2, 0xf0, 0x4c, 0x66, 0xf5,
-		0xcd, 0x9c, 0x55, 0x21, 0xec, 0xef, 0xac, 0x5c, 0x82, 0x24, 0x71, 0x5e, 0x26, 0x93, 0x7f, 0x0b,
-		0x27, 0xa7, 0xe8, 0x2b, 0xef, 0x86, 0xac, 0x70, 0x5a, 0x26, 0x93, 0xfe, 0x0d, 0x4e, 0xea, 0x93,
-		0x10, 0x72, 0xe1, 0xb0, 0x4c, 0x26, 0xff, 0x9b, 0x82, 0x5c, 0x90, 0x10, 0xf2, 0xe9, 0x45, 0xf8,
-		0xcb, 0xdf, 0x96, 0xe4, 0x8b, 0x8e, 0x90, 0xdd, 0x15, 0xc8, 0x70, 0x4f, 0x65, 0x32, 0xf5, 0xb7,
-		0xf2, 0xca, 0x05, 0xc5, 0xca, 0xf3, 0x90, 0x9a, 0x52, 0xe0, 0xdf, 0xc1, 0x49, 0x19, 0xfe, 0x4a,
-		0x1d, 0xf2, 0x21, 0xef, 0x64, 0x32, 0xf9, 0x77, 0x72, 0xf2, 0x30, 0x15, 0x69, 0x3a, 0xf7, 0x4e,
-		0x26, 0x33, 0xf8, 0x5b, 0xa2, 0xe9, 0x9c, 0x82, 0x88, 0x4d, 0x38, 0x26, 0x93, 0xa9, 0x3f, 0x2c,
-		0xa4, 0x2e, 0x48, 0x56, 0x5e, 0x84, 0x9c, 0xbf, 0xd8, 0x4c, 0xa6, 0xff, 0x2e, 0x4e, 0x1f, 0xd0,
-		0x10, 0x09, 0x84, 0x16, 0xbb, 0xc9, 0x2c, 0xfe, 0xb6, 0x90, 0x40, 0x88, 0x8a, 0x4c, 0xa3, 0x41,
-		0x07, 0x66, 0x32, 0xa7, 0xef, 0x16, 0xd3, 0x68, 0xc0, 0x7f, 0x21, 0xa3, 0x49, 0x6d, 0xfe, 0x64,
-		0x16, 0xdf, 0x23, 0x46, 0x93, 0xe2, 0x93, 0x66, 0x0c, 0x7a, 0x04, 0x93, 0x79, 0x7c, 0x9f, 0x68,
-		0xc6, 0x80, 0x43, 0xb0, 0xb2, 0x05, 0x68, 0xd8, 0x1b, 0x98, 0xcc, 0xef, 0x23, 0x9c, 0xdf, 0xec,
-		0x90, 0x33, 0xb0, 0xf2, 0x5e, 0x38, 0x39, 0xda, 0x13, 0x98, 0xcc, 0xf5, 0xfb, 0xbf, 0x3c, 0x10,
-		0xbb, 0x85, 0x1d, 0x81, 0x95, 0x76, 0xb0, 0xa4, 0x84, 0xbd, 0x80, 0xc9, 0x6c, 0x7f, 0xe0, 0xcb,
-		0x51, 0xc3, 0x1d, 0x76, 0x02, 0x56, 0xaa, 0x00, 0xc1, 0x02, 0x3c, 0x99, 0xd7, 0xc7, 0x38, 0xaf,
-		0x10, 0x11, 0x99, 0x1a, 0x7c, 0xfd, 0x9d, 0x4c, 0x7f, 0x4f, 0x4c, 0x0d, 0x4e, 0x41, 0xa6, 0x86,
-		0x58, 0x7a, 0x27, 0x53, 0xff, 0xa0, 0x98, 0x1a, 0x82, 0x84, 0x68, 0x76, 0x68, 0x75, 0x9b, 0xcc,
-		0xe1, 0x13, 0x42, 0xb3, 0x43, 0x54, 0x2b, 0x1b, 0x30, 0x3b, 0xb4, 0x20, 0x4e, 0x66, 0xf5, 0xc3,
-		0x9c, 0x95, 0x34, 0xb8, 0x1e, 0x86, 0x17, 0x2f, 0xbe, 0x18, 0x4e, 0xe6, 0xf6, 0x23, 0x03, 0x8b,
-		0x17, 0x5f, 0x0b, 0x57, 0xae, 0x40, 0xd6, 0xec, 0x77, 0xbb, 0x64, 0xf2, 0xa0, 0xa3, 0xcf, 0xe7,
-		0x2e, 0xfc, 0x97, 0xaf, 0x70, 0xe9, 0x08, 0x82, 0x95, 0x4b, 0x90, 0xc2, 0xbd, 0x1d, 0xdc, 0x99,
-		0x44, 0xf9, 0xc5, 0xaf, 0x08, 0x83, 0x49, 0xb0, 0x57, 0x5e, 0x04, 0x60, 0xa9, 0x11, 0xba, 0x71,
-		0x3e, 0x81, 0xf6, 0xf7, 0xbf, 0xc2, 0x0f, 0xc4, 0x05, 0x24, 0x01, 0x03, 0x76, 0xbc, 0xee, 0x68,
-		0x06, 0x5f, 0x8a, 0x32, 0xa0, 0x23, 0xf2, 0x02, 0x64, 0x5e, 0x77, 0x2d, 0xd3, 0xd3, 0xf6, 0x26,
-		0x51, 0xff, 0x01, 0xa7, 0x16, 0xf8, 0x44, 0x60, 0x3d, 0xcb, 0xc1, 0x9e, 0xb6, 0xe7, 0x4e, 0xa2,
-		0xfd, 0xaf, 0x9c, 0xd6, 0x27, 0x20, 0xc4, 0xba, 0xe6, 0x7a, 0xd3, 0xf4, 0xfb, 0xbf, 0x09, 0x62,
-		0x41, 0x40, 0x1a, 0x4d, 0x7e, 0xdf, 0xc2, 0x87, 0x93, 0x68, 0xff, 0x50, 0x34, 0x9a, 0xe3, 0xaf,
-		0xbc, 0x1b, 0x72, 0xe4, 0x27, 0x3b, 0xe5, 0x3a, 0x81, 0xf8, 0x8f, 0x38, 0x71, 0x40, 0x41, 0x6a,
-		0x76, 0xbd, 0x8e, 0x67, 0x4c, 0x16, 0xf6, 0x1f, 0xf3, 0x91, 0x16, 0xf8, 0x2b, 0x55, 0xc8, 0xbb,
-		0x5e, 0xa7, 0xd3, 0xe7, 0xfe, 0xe9, 0x04, 0xf2, 0x3f, 0xf9, 0x8a, 0x9f, 0xb2, 0xf0, 0x69, 0xc8,
-		0x68, 0xdf, 0xb9, 0xe5, 0xd9, 0x16, 0xdd, 0x6f, 0x99, 0xc4, 0xe1, 0xcb, 0x9c, 0x43, 0x88, 0x64,
-		0xa5, 0x0e, 0x05, 0xd2, 0x17, 0x07, 0xdb, 0x98, 0x6e, 0x8e, 0x4d, 0x60, 0xf1, 0xa7, 0x5c, 0x00,
-		0x11, 0xa2, 0xda, 0x37, 0xfe, 0xda, 0x67, 0xcf, 0xc6, 0x3e, 0xf3, 0xd9, 0xb3, 0xb1, 0xdf, 0xf9,
-		0xec, 0xd9, 0xd8, 0x87, 0x3f, 0x77, 0x76, 0xe6, 0x33, 0x9f, 0x3b, 0x3b, 0xf3, 0x5b, 0x9f, 0x3b,
-		0x3b, 0x33, 0x3a, 0x4b, 0x0c, 0xd7, 0xac, 0x6b, 0x16, 0xcb, 0x0f, 0xbf, 0xf6, 0xc8, 0x9e, 0xe1,
-		0xed, 0xf7, 0x77, 0x96, 0x75, 0xab, 0x77, 0x41, 0xb7, 0xdc, 0x9e, 0xe5, 0x5e, 0x88, 0xe6, 0x75,
-		0xe9, 0x2f, 0xf8, 0x5f, 0x31, 0x12, 0x33, 0x47, 0xd3, 0xb9, 0x9a, 0x79, 0x38, 0xee, 0x32, 0xdd,
-		0x65, 0x48, 0x54, 0xcd, 0x43, 0x74, 0x9a, 0x19, 0x38, 0xb5, 0xef, 0x74, 0xf9, 0x51, 0xcb, 0x0c,
-		0xf9, 0xde, 0x76, 0xba, 0x68, 0x3e, 0x38, 0x0f, 0x1d, 0x3b, 0x57, 0xe0, 0x87, 0x9c, 0x6b, 0xdf,
-		0x19, 0x3b, 0x5e, 0x4f, 0xb2, 0x55, 0xf3, 0x90, 0x76, 0x64, 0x2b, 0xf6, 0xda, 0x93, 0x13, 0xf3,
-		0xdc, 0xb7, 0x4c, 0xeb, 0x8e, 0x49, 0x9a, 0x6d, 0xef, 0x88, 0x1c, 0xf7, 0xd9, 0xc1, 0x1c, 0xf7,
-		0x7b, 0x71, 0xb7, 0xfb, 0x12, 0xc1, 0x6b, 0x13, 0x92, 0x9d, 0x34, 0x3b, 0xd5, 0x0f, 0xdf, 0x1d,
-		0x87, 0xb3, 0x43, 0xe9, 0x6c, 0xae, 0x04, 0xe3, 0x84, 0xb0, 0x02, 0xd9, 0x55, 0xa1, 0x5b, 0x0b,
-		0x90, 0x71, 0xb1, 0x6e, 0x99, 0x1d, 0x97, 0x0a, 0x22, 0xa1, 0x88, 0x4f, 0x22, 0x08, 0x53, 0x33,
-		0x2d, 0x97, 0x1f, 0x56, 0x66, 0x1f, 0xb5, 0x8f, 0x1e, 0x53, 0x10, 0x45, 0x51, 0x93, 0x90, 0xc6,
-		0x33, 0x53, 0x4a, 0x43, 0x74, 0x22, 0x92, 0xf9, 0x9f, 0x56, 0x2a, 0xdf, 0x17, 0x87, 0xc5, 0x41,
-		0xa9, 0x90, 0x99, 0xe5, 0x7a, 0x5a, 0xcf, 0x1e, 0x27, 0x96, 0x2b, 0x90, 0x6b, 0x0b, 0x9c, 0x63,
-		0xcb, 0xe5, 0xde, 0x31, 0xe5, 0x52, 0xf2, 0xab, 0x12, 0x82, 0xb9, 0x38, 0xa5, 0x60, 0xfc, 0x7e,
-		0xdc, 0x97, 0x64, 0xfe, 0x67, 0x1a, 0x4e, 0xb3, 0xe9, 0xa4, 0xb2, 0xa9, 0xc4, 0x3e, 0xb8, 0x4c,
-		0x0a, 0xe1, 0xa2, 0xc9, 0xfb, 0x24, 0xf2, 0x4b, 0x30, 0xd7, 0x24, 0xd6, 0x82, 0x44, 0x41, 0xc1,
-		0x0e, 0xcf, 0xc8, 0xf3, 0xdc, 0x4b, 0x11, 0x87, 0x9f, 0xef, 0x6f, 0x85, 0x41, 0xf2, 0x37, 0xc5,
-		0x40, 0x6a, 0xe9, 0x5a, 0x57, 0x73, 0xbe, 0x5a, 0x56, 0xe8, 0x79, 0x00, 0x76, 0xdc, 0xc3, 0xbf,
-		0xb8, 0x57, 0xba, 0xb8, 0xb0, 0x1c, 0xee, 0xdc, 0x32, 0xab, 0x89, 0x9e, 0xa0, 0xca, 0x51, 0x5c,
-		0xf2, 0xf3, 0xfc, 0x2b, 0x00, 0x41, 0x01, 0x3a, 0x03, 0xa7, 0x5a, 0xf5, 0xea, 0x5a, 0x55, 0x11,
-		0x87, 0x84, 0x5a, 0x5b, 0x8d, 0x7a, 0xf3, 0x6a, 0xb3, 0xb1, 0x2a, 0xcd, 0xa0, 0x93, 0x80, 0xc2,
-		0x85, 0xfe, 0xa1, 0xa6, 0x13, 0x30, 0x1b, 0x86, 0xb3, 0x5b, 0x2a, 0x71, 0xe2, 0x29, 0x1a, 0x3d,
-		0xbb, 0x8b, 0xe9, 0xce, 0xa3, 0x6a, 0x08, 0xa9, 0x4d, 0x76, 0x42, 0x7e, 0xfd, 0xdf, 0xb1, 0x9b,
-		0x0b, 0x73, 0x01, 0xb9, 0x2f, 0xf3, 0x95, 0x35, 0x98, 0xd5, 0x74, 0x1d, 0xdb, 0x11, 0x96, 0x13,
-		0x4c, 0x35, 0x61, 0x48, 0xf7, 0x52, 0x39, 0x65, 0xc0, 0xed, 0x79, 0x48, 0xbb, 0xb4, 0xf7, 0x93,
-		0x58, 0xfc, 0x06, 0x67, 0xc1, 0xd1, 0x57, 0x4c, 0x98, 0x25, 0x9e, 0x9f, 0xe6, 0xe0, 0x50, 0x33,
-		0x8e, 0xce, 0x33, 0xfc, 0x93, 0x9f, 0x79, 0x9a, 0xee, 0xac, 0x3e, 0x14, 0x1d, 0x96, 0x11, 0xea,
-		0xa4, 0x48, 0x9c, 0x77, 0xd0, 0x50, 0x0c, 0x25, 0x51, 0x1f, 0x6f, 0xf0, 0xd1, 0x95, 0xfd, 0x12,
-		0xaf, 0xec, 0xec, 0x28, 0x1d, 0x08, 0xd5, 0x54, 0xe4, 0x5c, 0x59, 0x41, 0xad, 0x31, 0x6e, 0x4e,
-		0xbf, 0xf6, 0xc4, 0xf0, 0xea, 0xc4, 0xfe, 0x7b, 0x8a, 0x72, 0xbe, 0x12, 0xae, 0xc6, 0x9f, 0x7b,
-		0x1f, 0x4d, 0xc2, 0xac, 0xd6, 0x33, 0x4c, 0xeb, 0x02, 0xfd, 0x97, 0xcf, 0xb9, 0x14, 0xfd, 0x98,
-		0x62, 0x53, 0xf2, 0x32, 0x9b, 0x0a, 0x93, 0x35, 0xe6, 0x8f, 0xbe, 0xfd, 0xc7, 0x52, 0xc1, 0x74,
-		0x59, 0x59, 0x07, 0x49, 0x1c, 0xe2, 0xc5, 0xa6, 0x6e, 0x75, 0xa6, 0xca, 0x52, 0xfc, 0xb1, 0xe0,
-		0x21, 0xf2, 0x5b, 0x0d, 0x4e, 0xba, 0xf2, 0x2e, 0xc8, 0xfa, 0x6c, 0x26, 0x79, 0x26, 0x82, 0x89,
-		0x4f, 0x41, 0xfc, 0x12, 0x36, 0x33, 0xa7, 0xf1, 0x42, 0xbf, 0x2c, 0xe8, 0xd9, 0x0c, 0xdd, 0x20,
-		0xbd, 0xb9, 0x06, 0xa5, 0x8e, 0x65, 0x7a, 0xaa, 0xd5, 0x33, 0x3c, 0xdc, 0xb3, 0xbd, 0x89, 0x7e,
-		0xdd, 0x9f, 0x32, 0x26, 0x59, 0xa5, 0x48, 0xe8, 0x36, 0x05, 0x19, 0x69, 0x09, 0xbb, 0xa7, 0x37,
-		0x4d, 0x4b, 0xfe, 0xbb, 0xdf, 0x12, 0x4a, 0x43, 0x5a, 0x72, 0x5f, 0xda, 0xe1, 0x76, 0x6e, 0x71,
-		0x63, 0xee, 0x1d, 0x30, 0x2d, 0xf0, 0xb5, 0xe3, 0x93, 0x09, 0x38, 0xcb, 0x91, 0x77, 0x34, 0x17,
-		0x5f, 0xb8, 0xfd, 0xcc, 0x0e, 0xf6, 0xb4, 0x67, 0x2e, 0xe8, 0x96, 0x21, 0x56, 0xf2, 0x39, 0x6e,
-		0xac, 0x49, 0xf9, 0x32, 0x2f, 0xaf, 0x8c, 0xdc, 0xee, 0xae, 0x8c, 0x37, 0xf2, 0x95, 0x61, 0x1d,
-		0x94, 0xbb, 0x90, 0xac, 0x5b, 0x86, 0x49, 0xd6, 0xb6, 0x0e, 0x36, 0xad, 0x1e, 0x37, 0xb7, 0xec,
-		0x03, 0x5d, 0x87, 0xb4, 0xd6, 0xb3, 0xfa, 0xa6, 0xc7, 0x4c, 0x6d, 0xed, 0xe9, 0x5f, 0x7b, 0x73,
-		0x71, 0xe6, 0x3f, 0xbe, 0xb9, 0x78, 0x82, 0xb1, 0x75, 0x3b, 0xb7, 0x96, 0x0d, 0xeb, 0x42, 0x4f,
-		0xf3, 0xf6, 0xc9, 0xf4, 0xfd, 0xcd, 0x4f, 0x3f, 0x05, 0xbc, 0xbe, 0xa6, 0xe9, 0x7d, 0xea, 0xf3,
-		0x3f, 0x79, 0x3e, 0xa6, 0x70, 0xfa, 0x95, 0xe4, 0x17, 0x3e, 0xbe, 0x18, 0x93, 0x6d, 0xc8, 0xac,
-		0x62, 0xfd, 0x88, 0x0a, 0x9b, 0x03, 0x15, 0x3e, 0xc3, 0x2b, 0x3c, 0x33, 0x5c, 0x21, 0x3b, 0xb0,
-		0xb7, 0x8a, 0xf5, 0x50, 0xb5, 0xab, 0x58, 0x8f, 0xd6, 0x58, 0x5b, 0xfd, 0xad, 0xdf, 0x3d, 0x3b,
-		0xf3, 0x81, 0xcf, 0x9e, 0x9d, 0x19, 0x3b, 0x64, 0xf2, 0xe4, 0x21, 0xf3, 0x47, 0xea, 0xc7, 0x92,
-		0xf0, 0x20, 0xbd, 0x11, 0xea, 0xf4, 0x0c, 0xd3, 0xbb, 0xa0, 0x3b, 0x87, 0xb6, 0x67, 0x91, 0x89,
-		0x6b, 0xed, 0xf2, 0x81, 0x9a, 0x0d, 0x8a, 0x97, 0x59, 0xf1, 0xe8, 0x61, 0x92, 0x77, 0x21, 0xb5,
-		0x45, 0xe8, 0x88, 0x20, 0x3c, 0xcb, 0xd3, 0xba, 0xdc, 0xdb, 0x60, 0x1f, 0x04, 0xca, 0x6e, 0x91,
-		0xc6, 0x19, 0xd4, 0x10, 0x17, 0x48, 0xbb, 0x58, 0xdb, 0x65, 0x97, 0x71, 0x12, 0xd4, 0x4d, 0xcd,
-		0x12, 0x00, 0xbd, 0x77, 0x33, 0x0f, 0x29, 0xad, 0xcf, 0x0e, 0xcc, 0x24, 0x88, 0xff, 0x4a, 0x3f,
-		0xe4, 0x97, 0x20, 0xc3, 0xf7, 0xcd, 0x91, 0x04, 0x89, 0x5b, 0xf8, 0x90, 0xd6, 0x53, 0x50, 0xc8,
-		0x4f, 0xb4, 0x0c, 0x29, 0xda, 0x78, 0x7e, 0xcb, 0x70, 0x61, 0x79, 0xa8, 0xf5, 0xcb, 0xb4, 0x91,
-		0x0a, 0x43, 0x93, 0x6f, 0x40, 0x76, 0xd5, 0x22, 0xda, 0x13, 0xe5, 0x96, 0x63, 0xdc, 0x68, 0x9b,
-		0xed, 0x3e, 0x1f, 0x3b, 0x85, 0x7d, 0xa0, 0x93, 0x90, 0x66, 0x97, 0xb3, 0xf8, 0xa1, 0x1f, 0xfe,
-		0x25, 0xd7, 0x21, 0x43, 0x79, 0x6f, 0xda, 0x64, 0xa9, 0xf7, 0xcf, 0xab, 0xe7, 0xf8, 0x55, 0x5d,
-		0xce, 0x3e, 0x1e, 0x34, 0x16, 0x41, 0xb2, 0xa3, 0x79, 0x1a, 0xef, 0x37, 0xfd, 0x2d, 0xbf, 0x07,
-		0xb2, 0x9c, 0x89, 0x8b, 0x2e, 0x42, 0xc2, 0xb2, 0x5d, 0x7e, 0x6c, 0xa7, 0x32, 0xae, 0x2b, 0x9b,
-		0x76, 0x2d, 0x49, 0x94, 0x4a, 0x21, 0xc8, 0xb5, 0x8d, 0xb1, 0x6a, 0xf1, 0x5c, 0x44, 0x2d, 0x7a,
-		0xd8, 0xdb, 0xd9, 0xf5, 0x82, 0x1f, 0x6c, 0x38, 0x87, 0x54, 0xc1, 0x57, 0x94, 0x7b, 0x71, 0x38,
-		0x1b, 0x2a, 0xbd, 0x8d, 0x1d, 0xd7, 0xb0, 0x4c, 0xa6, 0x4d, 0x5c, 0x53, 0x50, 0xa8, 0x81, 0xbc,
-		0x7c, 0x8c, 0xaa, 0xbc, 0x1b, 0x12, 0x55, 0xdb, 0x46, 0x15, 0xc8, 0xd2, 0x6f, 0xdd, 0x62, 0xba,
-		0x92, 0x54, 0xfc, 0x6f, 0x52, 0xe6, 0x5a, 0xbb, 0xde, 0x1d, 0xcd, 0xf1, 0xef, 0x2e, 0x8b, 0x6f,
-		0xf9, 0x05, 0xc8, 0xd5, 0x2d, 0xd3, 0xc5, 0xa6, 0xdb, 0xa7, 0x3e, 0xec, 0x4e, 0xd7, 0xd2, 0x6f,
-		0x71, 0x0e, 0xec, 0x83, 0x08, 0x5b, 0xb3, 0x6d, 0x4a, 0x99, 0x54, 0xc8, 0x4f, 0x3e, 0x7b, 0x36,
-		0xc7, 0x8a, 0xe7, 0xd2, 0xf1, 0xc4, 0xc3, 0x3b, 0x18, 0x38, 0xa3, 0x31, 0x78, 0x60, 0x78, 0x22,
-		0xdd, 0xc2, 0x87, 0xee, 0x71, 0xe7, 0xd1, 0x2b, 0x90, 0xdb, 0xa2, 0xcf, 0x8a, 0xbc, 0x84, 0x0f,
-		0x51, 0x05, 0x32, 0xb8, 0x73, 0xf1, 0xd2, 0xa5, 0x67, 0x5e, 0x60, 0x5a, 0x7e, 0x7d, 0x46, 0x11,
-		0x00, 0x74, 0x16, 0x72, 0x2e, 0xd6, 0xed, 0x8b, 0x97, 0x2e, 0xdf, 0x7a, 0x86, 0xa9, 0xd5, 0xf5,
-		0x19, 0x25, 0x00, 0xad, 0x64, 0x49, 0x8f, 0xbf, 0xf0, 0x89, 0xc5, 0x58, 0x2d, 0x05, 0x09, 0xb7,
-		0xdf, 0x7b, 0xdb, 0x74, 0xe3, 0x0f, 0xd3, 0xb0, 0x14, 0x2a, 0x65, 0x8b, 0xc2, 0x6d, 0xad, 0x6b,
-		0x74, 0xb4, 0xe0, 0x31, 0x18, 0x29, 0xd4, 0x7f, 0x8a, 0x31, 0xc6, 0xda, 0x1f, 0x29, 0x45, 0xf9,
-		0xa7, 0x63, 0x50, 0xb8, 0x29, 0x38, 0xb7, 0xb0, 0x87, 0xae, 0x00, 0xf8, 0x35, 0x89, 0xa9, 0x72,
-		0x66, 0x79, 0xb0, 0xae, 0x65, 0x9f, 0x46, 0x09, 0xa1, 0xa3, 0xe7, 0xa9, 0x02, 0xda, 0x96, 0xcb,
-		0xef, 0xb1, 0x4e, 0x20, 0xf5, 0x91, 0xd1, 0x93, 0x80, 0xa8, 0x55, 0x53, 0x6f, 0x5b, 0x9e, 0x61,
-		0xee, 0xa9, 0xb6, 0x75, 0x87, 0xbf, 0x0e, 0x90, 0x50, 0x24, 0x5a, 0x72, 0x93, 0x16, 0x6c, 0x11,
-		0x38, 0x69, 0x74, 0xce, 0xe7, 0x42, 0xc2, 0x31, 0xad, 0xd3, 0x71, 0xb0, 0xeb, 0x72, 0xc3, 0x25,
-		0x3e, 0xd1, 0x15, 0xc8, 0xd8, 0xfd, 0x1d, 0x55, 0x58, 0x89, 0xfc, 0xc5, 0x07, 0x46, 0xcd, 0x79,
-		0xa1, 0x1b, 0x7c, 0xd6, 0xa7, 0xed, 0xfe, 0x0e, 0xd1, 0x94, 0x87, 0xa0, 0x30, 0xa2, 0x31, 0xf9,
-		0xdb, 0x41, 0x3b, 0xe8, 0x4b, 0x36, 0xbc, 0x07, 0xaa, 0xed, 0x18, 0x96, 0x63, 0x78, 0x87, 0xf4,
-		0xb8, 0x5d, 0x42, 0x91, 0x44, 0xc1, 0x16, 0x87, 0xcb, 0xb7, 0xa0, 0xdc, 0xa2, 0x6e, 0x7a, 0xd0,
-		0xf2, 0x4b, 0x41, 0xfb, 0x62, 0x93, 0xdb, 0x37, 0xb6, 0x65, 0xf1, 0xa1, 0x96, 0x9d, 0xff, 0xf7,
-		0x31, 0xc8, 0xd7, 0xc8, 0xc4, 0x6d, 0xae, 0x5e, 0xed, 0x6a, 0x7b, 0xe8, 0x19, 0x38, 0x51, 0x5b,
-		0xdb, 0xac, 0xbf, 0xa4, 0x36, 0x57, 0xd5, 0xab, 0x6b, 0xd5, 0x6b, 0xc1, 0xf9, 0xde, 0xca, 0xc9,
-		0xbb, 0xf7, 0x96, 0x50, 0x08, 0x77, 0xdb, 0xa4, 0xb1, 0x24, 0xba, 0x00, 0xf3, 0x51, 0x92, 0x6a,
-		0xad, 0xd5, 0xd8, 0x68, 0x4b, 0xb1, 0xca, 0x89, 0xbb, 0xf7, 0x96, 0x66, 0x43, 0x14, 0xd5, 0x1d,
-		0x17, 0x9b, 0xde, 0x30, 0x41, 0x7d, 0x73, 0x7d, 0xbd, 0xd9, 0x96, 0xe2, 0x43, 0x04, 0x75, 0xab,
-		0xd7, 0x33, 0x3c, 0xf4, 0x38, 0xcc, 0x46, 0x09, 0x36, 0x9a, 0x6b, 0x52, 0xa2, 0x82, 0xee, 0xde,
-		0x5b, 0x2a, 0x85, 0xb0, 0x37, 0x8c, 0x6e, 0x25, 0xfb, 0xa1, 0x1f, 0x39, 0x3b, 0xf3, 0xa9, 0xbf,
-		0x73, 0x36, 0x56, 0x5b, 0x1f, 0x3b, 0xe7, 0x9e, 0x3d, 0xde, 0x9c, 0x8b, 0xae, 0xdb, 0x5f, 0x79,
-		0x20, 0x62, 0x6e, 0xb8, 0x1f, 0x16, 0x32, 0xc6, 0xd3, 0x4e, 0xb7, 0x49, 0xb9, 0x85, 0xca, 0xd1,
-		0xee, 0x41, 0x65, 0xc2, 0xa2, 0x50, 0x99, 0x68, 0x18, 0xe4, 0x17, 0xa0, 0xb8, 0xa5, 0x39, 0x5e,
-		0x0b, 0x7b, 0xd7, 0xb1, 0xd6, 0xc1, 0x4e, 0xd4, 0x7f, 0x28, 0x0a, 0xff, 0x01, 0x41, 0x92, 0x3a,
-		0x09, 0x6c, 0xfd, 0xa4, 0xbf, 0xe5, 0x7d, 0x48, 0xd2, 0x93, 0xc4, 0xbe, 0x6f, 0xc1, 0x29, 0x98,
-		0x6f, 0x41, 0x56, 0x86, 0x43, 0x0f, 0xbb, 0x22, 0xfd, 0x45, 0x3f, 0xd0, 0x73, 0xc2, 0x43, 0x48,
-		0x1c, 0xed, 0x21, 0xf0, 0xe9, 0xc5, 0xfd, 0x84, 0x2e, 0x64, 0xf8, 0x10, 0xfb, 0x0d, 0x89, 0x05,
-		0x0d, 0x41, 0xeb, 0x50, 0xb6, 0x35, 0xc7, 0xa3, 0x37, 0x0b, 0xf7, 0x69, 0x2f, 0xf8, 0x0c, 0x5e,
-		0x1c, 0xb6, 0x27, 0x91, 0xce, 0xf2, 0x5a, 0x8a, 0x76, 0x18, 0x28, 0xff, 0xe7, 0x24, 0xa4, 0xb9,
-		0x30, 0xde, 0x0d, 0x19, 0x2e, 0x56, 0x3e, 0xe7, 0x1e, 0x5c, 0x1e, 0x5e, 0x66, 0x97, 0xfd, 0xe5,
-		0x90, 0xf3, 0x13, 0x34, 0xe8, 0x51, 0xc8, 0xea, 0xfb, 0x9a, 0x61, 0xaa, 0x46, 0x87, 0x3b, 0xa0,
-		0xf9, 0xcf, 0xbe, 0xb9, 0x98, 0xa9, 0x13, 0x58, 0x73, 0x55, 0xc9, 0xd0, 0xc2, 0x66, 0x87, 0xf8,
-		0x34, 0xfb, 0xd8, 0xd8, 0xdb, 0xf7, 0xb8, 0xdd, 0xe0, 0x5f, 0xe8, 0x9d, 0x90, 0x24, 0x0a, 0xc1,
-		0xef, 0x9d, 0x57, 0x86, 0x22, 0x0b, 0x3f, 0xf5, 0x53, 0xcb, 0x92, 0x8a, 0x3f, 0xfc, 0x9f, 0x16,
-		0x63, 0x0a, 0xa5, 0x40, 0x75, 0x28, 0x76, 0x35, 0xd7, 0x53, 0xe9, 0x7a, 0x4c, 0xaa, 0x4f, 0x51,
-		0x16, 0xa7, 0x87, 0x05, 0xc2, 0x05, 0xcb, 0x9b, 0x9e, 0x27, 0x54, 0x0c, 0xd4, 0x41, 0xe7, 0x40,
-		0xa2, 0x4c, 0x74, 0x3a, 0x03, 0x99, 0x97, 0x98, 0xa6, 0x72, 0x2f, 0x11, 0x38, 0x9b, 0x98, 0xd4,
-		0x57, 0x3c, 0x03, 0x39, 0x7a, 0xd3, 0x95, 0xa2, 0xb0, 0xe3, 0xeb, 0x59, 0x02, 0xa0, 0x85, 0x8f,
-		0x41, 0x39, 0xb0, 0xfa, 0x0c, 0x25, 0xcb, 0xb8, 0x04, 0x60, 0x8a, 0xf8, 0x34, 0xcc, 0x9b, 0xf8,
-		0x80, 0x1e, 0xa8, 0x8f, 0x60, 0xe7, 0x28, 0x36, 0x22, 0x65, 0x37, 0xa3, 0x14, 0x8f, 0x40, 0x49,
-		0x17, 0xc2, 0x67, 0xb8, 0x40, 0x71, 0x8b, 0x3e, 0x94, 0xa2, 0x9d, 0x86, 0xac, 0x66, 0xdb, 0x0c,
-		0x21, 0xcf, 0xad, 0xbe, 0x6d, 0xd3, 0xa2, 0xf3, 0x30, 0x4b, 0xfb, 0xe8, 0x60, 0xb7, 0xdf, 0xf5,
-		0x38, 0x93, 0x02, 0xc5, 0x29, 0x93, 0x02, 0x85, 0xc1, 0x29, 0xee, 0xc3, 0x50, 0xc4, 0xb7, 0x8d,
-		0x0e, 0x36, 0x75, 0xcc, 0xf0, 0x8a, 0x14, 0xaf, 0x20, 0x80, 0x14, 0xe9, 0x71, 0xf0, 0xad, 0xb9,
-		0x2a, 0x56, 0x9a, 0x12, 0xe3, 0x27, 0xe0, 0x55, 0x06, 0x96, 0x17, 0x20, 0xb9, 0xaa, 0x79, 0x1a,
-		0x71, 0x97, 0xbc, 0x03, 0xb6, 0x7c, 0x16, 0x14, 0xf2, 0x53, 0xfe, 0xb9, 0x04, 0x24, 0x6f, 0x5a,
-		0x1e, 0x46, 0xcf, 0x86, 0x5c, 0xd9, 0xd2, 0x28, 0x7d, 0x6e, 0x19, 0x7b, 0x26, 0xee, 0xac, 0xbb,
-		0x7b, 0xa1, 0x67, 0x69, 0x02, 0x75, 0x8a, 0x47, 0xd4, 0x69, 0x1e, 0x52, 0x8e, 0xd5, 0x37, 0x3b,
-		0xe2, 0xe0, 0x37, 0xfd, 0x40, 0x0d, 0xc8, 0xfa, 0x5a, 0x92, 0x9c, 0xa4, 0x25, 0x65, 0xa2, 0x25,
-		0x44, 0x87, 0x39, 0x40, 0xc9, 0xec, 0x70, 0x65, 0xa9, 0x41, 0xce, 0x37, 0x5e, 0x5c, 0xdb, 0xa6,
-		0x53, 0xd8, 0x80, 0x8c, 0x2c, 0x91, 0xfe, 0xd8, 0xfb, 0xc2, 0x63, 0x1a, 0x27, 0xf9, 0x05, 0x5c,
-		0x7a, 0x11, 0xb5, 0xe2, 0x4f, 0xe4, 0x64, 0x68, 0xbf, 0x02, 0xb5, 0x62, 0xcf, 0xe4, 0x3c, 0x00,
-		0x39, 0xd7, 0xd8, 0x33, 0x35, 0xaf, 0xef, 0x60, 0xae, 0x79, 0x01, 0x80, 0x94, 0x06, 0x97, 0x20,
-		0x98, 0xa6, 0x85, 0x5e, 0xee, 0xba, 0x00, 0x73, 0xc1, 0x9b, 0x59, 0x01, 0x17, 0xa6, 0x65, 0xc8,
-		0x2f, 0x6a, 0x89, 0x12, 0xf9, 0x97, 0x63, 0x90, 0xe6, 0x2b, 0x56, 0x30, 0x0c, 0xb1, 0xd1, 0xc3,
-		0x10, 0x1f, 0x37, 0x0c, 0x89, 0xfb, 0x1f, 0x86, 0x2a, 0x80, 0xdf, 0x4c, 0x97, 0x3f, 0x84, 0x32,
-		0xc2, 0xad, 0x62, 0x4d, 0x6c, 0x19, 0x7b, 0x7c, 0xde, 0x87, 0x88, 0xe4, 0xdf, 0x8e, 0x11, 0x0f,
-		0x9f, 0x97, 0xa3, 0x2a, 0x14, 0x45, 0xbb, 0xd4, 0xdd, 0xae, 0xb6, 0xc7, 0x55, 0xf1, 0xc1, 0xb1,
-		0x8d, 0x23, 0xab, 0xb0, 0x92, 0xe7, 0xed, 0xa1, 0xfe, 0xc4, 0xc8, 0x61, 0x8d, 0x8f, 0x19, 0xd6,
-		0x88, 0x1e, 0x25, 0xee, 0x4f, 0x8f, 0x22, 0x23, 0x9e, 0x1c, 0x18, 0x71, 0xf9, 0x77, 0x63, 0xfc,
-		0xc5, 0xae, 0x0e, 0xbb, 0xc1, 0xf1, 0xe7, 0x35, 0x54, 0xaf, 0x71, 0xdd, 0xea, 0xe0, 0x8e, 0x3a,
-		0x34, 0x66, 0x0f, 0x0f, 0x73, 0x8c, 0xb6, 0x39, 0x18, 0x3b, 0x24, 0xb8, 0xb4, 0x82, 0x31, 0xfc,
-		0x74, 0x1c, 0x66, 0x87, 0xf0, 0xff, 0xf2, 0x8d, 0x65, 0x74, 0xf6, 0xa6, 0xa6, 0x9c, 0xbd, 0xe9,
-		0xb1, 0xb3, 0xf7, 0x67, 0xe2, 0x34, 0x01, 0x60, 0x5b, 0xae, 0xd6, 0xfd, 0x5a, 0xd8, 0xde, 0x33,
-		0x90, 0xb3, 0xad, 0xae, 0xca, 0x4a, 0xd8, 0xd5, 0x9b, 0xac, 0x6d, 0x75, 0x95, 0x21, 0x35, 0x4b,
-		0xbd, 0x45, 0x86, 0x39, 0xfd, 0x16, 0x0c, 0x42, 0x66, 0x70, 0x42, 0x39, 0x50, 0x60, 0xa2, 0xe0,
-		0x5e, 0xd3, 0xd3, 0x44, 0x06, 0xd4, 0x0d, 0x8b, 0x0d, 0x7b, 0x79, 0xac, 0xd9, 0x0c, 0x53, 0xe1,
-		0x78, 0x84, 0x82, 0x39, 0x19, 0xa3, 0x32, 0x47, 0x61, 0x8b, 0xa5, 0x70, 0x3c, 0xf9, 0x7b, 0x63,
-		0x00, 0x6b, 0x44, 0xb2, 0xb4, 0xbf, 0xc4, 0xdf, 0x71, 0x69, 0x13, 0xd4, 0x48, 0xcd, 0x67, 0xc7,
-		0x0d, 0x1a, 0xaf, 0xbf, 0xe0, 0x86, 0xdb, 0x5d, 0x87, 0x62, 0xa0, 0xdb, 0x2e, 0x16, 0x8d, 0x39,
-		0x7b, 0x44, 0x54, 0xda, 0xc2, 0x9e, 0x52, 0xb8, 0x1d, 0xfa, 0x92, 0xff, 0x59, 0x0c, 0x72, 0xb4,
-		0x4d, 0xeb, 0xd8, 0xd3, 0x22, 0x63, 0x18, 0xbb, 0xff, 0x31, 0x7c, 0x10, 0x80, 0xb1, 0x71, 0x8d,
-		0x37, 0x30, 0xd7, 0xac, 0x1c, 0x85, 0xb4, 0x8c, 0x37, 0x30, 0xba, 0xec, 0x0b, 0x3c, 0x71, 0xb4,
-		0xc0, 0x45, 0xd4, 0xca, 0xc5, 0x7e, 0x0a, 0x32, 0xf4, 0x4d, 0xc9, 0x03, 0x97, 0x07, 0xa2, 0x69,
-		0xb3, 0xdf, 0x6b, 0x1f, 0xb8, 0xf2, 0xeb, 0x90, 0x69, 0x1f, 0xb0, 0x7c, 0xe2, 0x19, 0xc8, 0x39,
-		0x96, 0xc5, 0xbd, 0x3f, 0xe6, 0x75, 0x67, 0x09, 0x80, 0x3a, 0x3b, 0x22, 0x87, 0x16, 0x0f, 0x72,
-		0x68, 0x41, 0x12, 0x30, 0x31, 0x55, 0x12, 0x90, 0x44, 0x9f, 0xc5, 0xc8, 0x4c, 0x42, 0x4f, 0xc2,
-		0xa9, 0x56, 0xf3, 0xda, 0x46, 0x63, 0x55, 0x5d, 0x6f, 0x5d, 0x1b, 0xb8, 0x56, 0x5f, 0x29, 0xdf,
-		0xbd, 0xb7, 0x94, 0xe7, 0x61, 0xe7, 0x38, 0xec, 0x2d, 0xa5, 0x71, 0x73, 0xb3, 0xdd, 0x90, 0x62,
-		0x0c, 0x7b, 0xcb, 0xc1, 0xb7, 0x2d, 0x8f, 0x3d, 0xda, 0xfa, 0x34, 0x9c, 0x1e, 0x81, 0xed, 0x07,
-		0x9f, 0xb3, 0x77, 0xef, 0x2d, 0x15, 0xb7, 0x1c, 0xcc, 0xb4, 0x8c, 0x52, 0x2c, 0xc3, 0xc2, 0x30,
-		0xc5, 0xe6, 0xd6, 0x66, 0xab, 0xba, 0x26, 0x2d, 0x55, 0xa4, 0xbb, 0xf7, 0x96, 0x0a, 0xc2, 0x64,
-		0x10, 0xfc, 0xb7, 0x3f, 0xfa, 0xfc, 0xd6, 0x6c, 0x24, 0x6b, 0xcc, 0xe2, 0x3a, 0x5b, 0x73, 0xb4,
-		0xde, 0x71, 0xc3, 0xcf, 0x09, 0x1b, 0xfe, 0xf2, 0x4f, 0xc7, 0xa1, 0xec, 0x07, 0x37, 0x5b, 0xb4,
-		0x06, 0xf4, 0x6c, 0x38, 0xe3, 0x97, 0x1f, 0xbb, 0x76, 0x30, 0x6c, 0x91, 0x10, 0x7c, 0x17, 0x64,
-		0x85, 0x93, 0xcc, 0x27, 0xd5, 0xd2, 0x88, 0xf5, 0x8d, 0x63, 0x70, 0x52, 0x9f, 0x02, 0xbd, 0x08,
-		0x39, 0x7f, 0x8a, 0xf9, 0x0f, 0x9b, 0x8d, 0x9f, 0x93, 0x9c, 0x3e, 0xa0, 0x41, 0x2f, 0x04, 0x61,
-		0x5c, 0x72, 0x5c, 0x60, 0x78, 0x93, 0x21, 0x70, 0x62, 0x3f, 0x84, 0x7b, 0x1a, 0x92, 0xda, 0x8e,
-		0x6e, 0x70, 0x03, 0xfc, 0xc0, 0x30, 0x5d, 0xb5, 0x56, 0x6f, 0x72, 0x22, 0x8a, 0x29, 0x37, 0x79,
-		0x32, 0x85, 0xcb, 0x8b, 0x3e, 0x23, 0x73, 0xa0, 0xb2, 0x58, 0x98, 0x79, 0x17, 0xd9, 0x9e, 0x76,
-		0x50, 0xa3, 0xe1, 0xf0, 0x29, 0xc8, 0x90, 0xc2, 0x3d, 0xfe, 0x50, 0x41, 0x42, 0x49, 0xf7, 0xb4,
-		0x83, 0x6b, 0x9a, 0x7b, 0x23, 0x99, 0x4d, 0x48, 0x49, 0xf9, 0xc7, 0x89, 0xa7, 0x12, 0x91, 0x0a,
-		0x7a, 0x02, 0x10, 0xa1, 0xd0, 0xf6, 0xb0, 0x4a, 0xa6, 0x2e, 0x15, 0xaf, 0xe0, 0x5b, 0xee, 0x69,
-		0x07, 0xd5, 0x3d, 0xbc, 0xd1, 0xef, 0xd1, 0x06, 0xb8, 0x68, 0x1d, 0x24, 0x81, 0x2c, 0x46, 0x96,
-		0x8b, 0xff, 0xf4, 0xf0, 0xc3, 0xa8, 0x1c, 0x81, 0x2d, 0x00, 0x1f, 0x21, 0x0b, 0x40, 0x89, 0xf1,
-		0xf3, 0x8f, 0x78, 0x44, 0xba, 0x92, 0x88, 0x76, 0x45, 0x7e, 0x11, 0xca, 0x03, 0x23, 0x80, 0x64,
-		0x28, 0xf2, 0x8c, 0x15, 0xdd, 0x3e, 0x67, 0x11, 0x4e, 0x4e, 0xc9, 0xb3, 0xcc, 0x14, 0x3d, 0x4e,
-		0xb0, 0x92, 0xfd, 0xf9, 0x8f, 0x2f, 0xc6, 0xe8, 0x66, 0xce, 0x13, 0x50, 0x8c, 0x8c, 0x81, 0xc8,
-		0x22, 0xc7, 0x82, 0x2c, 0x72, 0x80, 0xfc, 0x1a, 0x14, 0x88, 0x01, 0xc2, 0x1d, 0x8e, 0xfb, 0x28,
-		0x94, 0x99, 0x81, 0x1c, 0x94, 0x35, 0x73, 0x78, 0xd6, 0x85, 0xc0, 0x65, 0xe1, 0x01, 0x45, 0xc5,
-		0x9e, 0x17, 0x58, 0xd7, 0x34, 0x57, 0xde, 0x04, 0x08, 0x06, 0x15, 0x55, 0xe1, 0x41, 0x62, 0x3c,
-		0xc2, 0xc7, 0x3d, 0xf9, 0x93, 0x56, 0x11, 0x8f, 0xb1, 0x42, 0x90, 0x82, 0x23, 0x9d, 0xec, 0x61,
-		0xab, 0xeb, 0x14, 0xa3, 0xf6, 0xf2, 0xa7, 0x3e, 0x7b, 0x36, 0xf6, 0xf6, 0xd8, 0x82, 0xff, 0xf0,
-		0x2a, 0x9c, 0x09, 0x15, 0x12, 0x05, 0x8c, 0x24, 0xa2, 0xca, 0x21, 0x7d, 0x25, 0x85, 0x93, 0x12,
-		0x4a, 0x47, 0xe6, 0x7f, 0x2b, 0x47, 0x9b, 0x9d, 0xc9, 0xd9, 0xa6, 0xc9, 0xf9, 0xae, 0xd1, 0x49,
-		0xf9, 0x7f, 0x9a, 0x83, 0x8c, 0x82, 0xdf, 0xdf, 0xc7, 0xae, 0x87, 0x2e, 0x42, 0x12, 0xeb, 0xfb,
-		0xd6, 0xa8, 0x1c, 0x28, 0xe9, 0xe0, 0x32, 0xc7, 0x6b, 0xe8, 0xfb, 0xd6, 0xf5, 0x19, 0x85, 0xe2,
-		0xa2, 0x4b, 0x90, 0xda, 0xed, 0xf6, 0x79, 0xfa, 0x6a, 0xc0, 0x66, 0x85, 0x89, 0xae, 0x12, 0xa4,
-		0xeb, 0x33, 0x0a, 0xc3, 0x26, 0x55, 0xd1, 0x17, 0xad, 0x13, 0x47, 0x57, 0xd5, 0x34, 0x77, 0x69,
-		0x55, 0x04, 0x17, 0xd5, 0x00, 0x0c, 0xd3, 0xf0, 0x54, 0x9a, 0xda, 0xe1, 0x56, 0xe3, 0xa1, 0xf1,
-		0x94, 0x86, 0x47, 0x93, 0x41, 0xd7, 0x67, 0x94, 0x9c, 0x21, 0x3e, 0x48, 0x73, 0xdf, 0xdf, 0xc7,
-		0xce, 0x21, 0xf7, 0xd6, 0xc6, 0x36, 0xf7, 0x65, 0x82, 0x44, 0x9a, 0x4b, 0xb1, 0x89, 0x91, 0x65,
-		0x8f, 0xe3, 0x79, 0x07, 0xfc, 0xc9, 0xd7, 0xc5, 0x71, 0x94, 0xf4, 0x85, 0xbc, 0xf6, 0xc1, 0xf5,
-		0x19, 0x25, 0xa3, 0xb3, 0x9f, 0xe8, 0x9d, 0xbe, 0x0b, 0x96, 0x1f, 0xf6, 0x7a, 0x22, 0xb4, 0x2c,
-		0xed, 0x33, 0x23, 0x5c, 0x31, 0xb4, 0x01, 0xa5, 0xae, 0xe1, 0x7a, 0xaa, 0x6b, 0x6a, 0xb6, 0xbb,
-		0x6f, 0x79, 0x2e, 0xcd, 0x9f, 0xe4, 0x2f, 0x3e, 0x32, 0x8e, 0xc3, 0x9a, 0xe1, 0x7a, 0x2d, 0x81,
-		0x7c, 0x7d, 0x46, 0x29, 0x76, 0xc3, 0x00, 0xc2, 0xcf, 0xda, 0xdd, 0xc5, 0x8e, 0xcf, 0x90, 0xe6,
-		0x59, 0x8e, 0xe0, 0xb7, 0x49, 0xb0, 0x05, 0x3d, 0xe1, 0x67, 0x85, 0x01, 0xe8, 0xeb, 0x60, 0xae,
-		0x6b, 0x69, 0x1d, 0x9f, 0x9d, 0xaa, 0xef, 0xf7, 0xcd, 0x5b, 0x34, 0x29, 0x93, 0xbf, 0xf8, 0xf8,
-		0xd8, 0x46, 0x5a, 0x5a, 0x47, 0xb0, 0xa8, 0x13, 0x82, 0xeb, 0x33, 0xca, 0x6c, 0x77, 0x10, 0x88,
-		0xde, 0x07, 0xf3, 0x9a, 0x6d, 0x77, 0x0f, 0x07, 0xb9, 0x97, 0x29, 0xf7, 0xf3, 0xe3, 0xb8, 0x57,
-		0x09, 0xcd, 0x20, 0x7b, 0xa4, 0x0d, 0x41, 0x51, 0x1b, 0x24, 0xdb, 0xc1, 0xf4, 0xc6, 0x94, 0xcd,
-		0xbd, 0x0c, 0xfa, 0x9e, 0x54, 0xfe, 0xe2, 0x63, 0xe3, 0x78, 0x6f, 0x31, 0x7c, 0xe1, 0x94, 0x5c,
-		0x9f, 0x51, 0xca, 0x76, 0x14, 0xc4, 0xb8, 0x5a, 0x3a, 0xa6, 0xcf, 0xdd, 0x71, 0xae, 0xb3, 0x93,
-		0xb8, 0x52, 0xfc, 0x28, 0xd7, 0x08, 0x08, 0x35, 0x20, 0xcf, 0x42, 0x51, 0x95, 0x18, 0x43, 0xfa,
-		0x0a, 0x55, 0xfe, 0xa2, 0x3c, 0x76, 0x86, 0x52, 0xd4, 0x9b, 0x96, 0x87, 0xaf, 0xcf, 0x28, 0x80,
-		0xfd, 0x2f, 0xa4, 0xc1, 0x09, 0xfa, 0x20, 0xd7, 0xa1, 0x1a, 0x35, 0xbc, 0x0b, 0x73, 0x94, 0xe1,
-		0x13, 0xe3, 0x18, 0xde, 0xa4, 0x44, 0x37, 0xc3, 0x76, 0xf8, 0xfa, 0x8c, 0x32, 0x77, 0x7b, 0x18,
-		0x4c, 0x54, 0x6c, 0xd7, 0x30, 0xb5, 0xae, 0xf1, 0x06, 0x66, 0x4b, 0x28, 0x7d, 0x8a, 0xf2, 0x08,
-		0x15, 0xbb, 0xca, 0xb1, 0xe9, 0xc2, 0x4a, 0x54, 0x6c, 0x37, 0x0c, 0xa8, 0x65, 0xf8, 0x59, 0x4f,
-		0xff, 0x69, 0xb5, 0x8c, 0x94, 0x65, 0xcf, 0xa9, 0xdd, 0x48, 0x66, 0x41, 0xca, 0xcb, 0x8f, 0x41,
-		0x3e, 0x64, 0x98, 0xd0, 0x02, 0x64, 0xf8, 0x69, 0x18, 0x71, 0x6a, 0x94, 0x7f, 0xca, 0x25, 0x28,
-		0x84, 0x8d, 0x91, 0xfc, 0xe1, 0x98, 0x4f, 0x49, 0xdf, 0x94, 0x58, 0x88, 0x66, 0xa4, 0x73, 0x81,
-		0xa7, 0xf2, 0xb0, 0x58, 0xda, 0x44, 0x39, 0xdb, 0x7e, 0x2d, 0x50, 0x20, 0x5f, 0x59, 0xd1, 0x22,
-		0xe4, 0xed, 0x8b, 0xb6, 0x8f, 0x92, 0xa0, 0x28, 0x60, 0x5f, 0xb4, 0x05, 0xc2, 0x43, 0x50, 0x20,
-		0xfd, 0x56, 0xc3, 0xfe, 0x52, 0x4e, 0xc9, 0x13, 0x18, 0x47, 0x91, 0xff, 0x55, 0x1c, 0xa4, 0x41,
-		0x03, 0xe6, 0xa7, 0xaa, 0x63, 0xc7, 0x4e, 0x55, 0x9f, 0x1e, 0x4c, 0x92, 0x07, 0x79, 0xf1, 0x35,
-		0x90, 0x82, 0xf4, 0x2e, 0x5b, 0x6a, 0xc6, 0xfb, 0x7f, 0x03, 0x8e, 0xaa, 0x52, 0xd6, 0x07, 0x3c,
-		0xd7, 0xab, 0x91, 0xcd, 0x4a, 0xf1, 0x27, 0x1f, 0x06, 0x07, 0xdc, 0x77, 0x62, 0xb6, 0xed, 0x8e,
-		0xe6, 0x61, 0x91, 0x1f, 0x0b, 0xed, 0x5b, 0x3e, 0x0a, 0x65, 0xcd, 0xb6, 0x55, 0xd7, 0xd3, 0x3c,
-		0xcc, 0x7d, 0x0d, 0x96, 0x79, 0x28, 0x6a, 0xb6, 0xdd, 0x22, 0x50, 0xe6, 0x6b, 0x3c, 0x02, 0x25,
-		0x62, 0xd3, 0x0d, 0xad, 0x2b, 0x5c, 0x85, 0x34, 0x73, 0x49, 0x38, 0x94, 0x79, 0x07, 0x72, 0xc7,
-		0x1f, 0x71, 0x6a, 0xcf, 0xfd, 0x98, 0x2a, 0x16, 0x8a, 0xa9, 0x10, 0x7f, 0xeb, 0x83, 0xc9, 0x47,
-		0xbc, 0x8f, 0x32, 0x7a, 0xd3, 0x60, 0x9e, 0xc6, 0x5f, 0xb7, 0x59, 0xb2, 0x24, 0xab, 0xb0, 0x0f,
-		0x59, 0x81, 0x52, 0xd4, 0xf6, 0xa3, 0x12, 0xc4, 0xbd, 0x03, 0x5e, 0x4b, 0xdc, 0x3b, 0x20, 0x9e,
-		0xae, 0xff, 0x42, 0x6e, 0x69, 0xc4, 0x6a, 0xc7, 0xe9, 0x82, 0x5c, 0x87, 0x5c, 0x86, 0x62, 0x64,
-		0x4d, 0x90, 0x4f, 0xc2, 0xfc, 0x28, 0x13, 0x2f, 0xef, 0xfb, 0xf0, 0x88, 0xa9, 0x46, 0x97, 0x20,
-		0xeb, 0xdb, 0xf8, 0x11, 0xd1, 0x31, 0xad, 0x56, 0x20, 0x2b, 0x3e, 0x6a, 0x24, 0x9d, 0x1f, 0x8f,
-		0xa4, 0xf3, 0xe5, 0x6f, 0x84, 0x85, 0x71, 0xf6, 0x7b, 0x20, 0xc9, 0x97, 0xf4, 0x05, 0x76, 0x12,
-		0xd2, 0xfc, 0x29, 0xca, 0x38, 0xdd, 0xc0, 0xe2, 0x5f, 0x44, 0x90, 0xcc, 0x96, 0x27, 0xd8, 0xbe,
-		0x16, 0xfd, 0x90, 0x55, 0x38, 0x3d, 0xd6, 0x86, 0x8f, 0xdf, 0x0a, 0x63, 0x8c, 0xf8, 0x56, 0x98,
-		0x2e, 0x9a, 0xe3, 0xd2, 0xbe, 0x8a, 0x83, 0x2c, 0xec, 0x4b, 0xfe, 0x48, 0x02, 0x4e, 0x8e, 0xb6,
-		0xe4, 0x68, 0x09, 0x0a, 0xc4, 0x6f, 0xf5, 0xa2, 0x2e, 0x2e, 0xf4, 0xb4, 0x83, 0x36, 0xf7, 0x6f,
-		0xf9, 0x56, 0x42, 0xdc, 0xdf, 0x4a, 0x40, 0xdb, 0x30, 0xdb, 0xb5, 0x74, 0xad, 0xab, 0x86, 0xb6,
-		0x72, 0xf8, 0x24, 0x7a, 0x78, 0x48, 0xd8, 0xd1, 0x94, 0x21, 0x31, 0x38, 0x5c, 0xff, 0xcb, 0x94,
-		0xc7, 0x9a, 0xbf, 0xeb, 0x83, 0x56, 0x21, 0xdf, 0x33, 0xdc, 0x1d, 0xbc, 0xaf, 0xdd, 0x36, 0x2c,
-		0x87, 0xcf, 0xa6, 0x61, 0xa5, 0x59, 0x0f, 0x70, 0xc4, 0x0e, 0x53, 0x88, 0x2c, 0x34, 0x24, 0xa9,
-		0x91, 0x1b, 0x5f, 0xe9, 0x63, 0x5b, 0x93, 0x71, 0x7b, 0x48, 0x99, 0xb1, 0x7b, 0x48, 0xa3, 0x36,
-		0x6c, 0xb2, 0xa3, 0x37, 0x6c, 0x3e, 0x14, 0x1e, 0x9a, 0xe8, 0xda, 0x37, 0xb4, 0x87, 0x83, 0x5a,
-		0x30, 0xcf, 0xe9, 0x3b, 0x11, 0xd9, 0x8f, 0x38, 0xea, 0xc0, 0xe6, 0xd7, 0xa0, 0xcc, 0x91, 0x20,
-		0x1f, 0x2f, 0xf6, 0xc4, 0xfd, 0x89, 0x5d, 0x6c, 0xa2, 0x26, 0x43, 0x9b, 0xa8, 0xff, 0x97, 0x0d,
-		0xc5, 0x07, 0x13, 0x30, 0x3b, 0xe4, 0x48, 0x8c, 0xdc, 0x1d, 0x1e, 0x97, 0x91, 0x15, 0x1d, 0x4b,
-		0x1c, 0xbb, 0x63, 0x7c, 0xac, 0x93, 0x93, 0xc7, 0x3a, 0xf5, 0x16, 0x8e, 0x75, 0xfa, 0xfe, 0xc6,
-		0xfa, 0x6d, 0x1d, 0x85, 0x8f, 0xc5, 0xa0, 0x32, 0xde, 0xfb, 0x1a, 0x39, 0x1c, 0xc7, 0xda, 0x40,
-		0x18, 0xb7, 0xc6, 0x3d, 0x02, 0xa5, 0x01, 0xdf, 0x90, 0xa9, 0x72, 0x31, 0x12, 0x85, 0xcb, 0xdf,
-		0x9c, 0xf0, 0x17, 0x9e, 0x88, 0x03, 0x37, 0x62, 0xb6, 0xbe, 0x0c, 0x73, 0x1d, 0xac, 0x1b, 0x9d,
-		0xfb, 0x9d, 0xac, 0xb3, 0x9c, 0xfa, 0xff, 0xcd, 0xd5, 0x61, 0x2d, 0xf9, 0x1e, 0x80, 0xac, 0x82,
-		0x5d, 0x9b, 0xf8, 0x63, 0xa8, 0x06, 0x39, 0x7c, 0xa0, 0x63, 0xdb, 0x0b, 0x0e, 0x55, 0x8c, 0x0a,
-		0x11, 0x18, 0x76, 0x43, 0x60, 0x92, 0x00, 0xd9, 0x27, 0x43, 0xcf, 0xf2, 0x1c, 0xc0, 0xf8, 0x70,
-		0x9e, 0x93, 0x87, 0x93, 0x00, 0x97, 0x45, 0x12, 0x20, 0x31, 0x36, 0xbe, 0x65, 0x54, 0x03, 0x59,
-		0x80, 0x67, 0x79, 0x16, 0x20, 0x39, 0xa1, 0xb2, 0x48, 0x1a, 0xa0, 0x1e, 0x49, 0x03, 0xa4, 0x27,
-		0x74, 0x73, 0x4c, 0x1e, 0xe0, 0xb2, 0xc8, 0x03, 0x64, 0x26, 0xb4, 0x78, 0x20, 0x11, 0xf0, 0xee,
-		0x50, 0x22, 0x20, 0x37, 0x9c, 0x6d, 0x8d, 0x90, 0x8e, 0xc8, 0x04, 0xbc, 0xe0, 0x67, 0x02, 0x0a,
-		0x63, 0xb3, 0x08, 0x9c, 0x78, 0x30, 0x15, 0xb0, 0x39, 0x94, 0x0a, 0x28, 0xf2, 0x3f, 0x6c, 0x34,
-		0x8e, 0xc5, 0x84, 0x5c, 0xc0, 0xe6, 0x50, 0x2e, 0xa0, 0x34, 0x81, 0xe1, 0x84, 0x64, 0xc0, 0xd7,
-		0x8f, 0x4e, 0x06, 0x8c, 0x0f, 0xd7, 0x79, 0x33, 0xa7, 0xcb, 0x06, 0xa8, 0x63, 0xb2, 0x01, 0xd2,
-		0xd8, 0xc8, 0x95, 0xb1, 0x9f, 0x3a, 0x1d, 0xb0, 0x3d, 0x22, 0x1d, 0xc0, 0x02, 0xf7, 0x73, 0x63,
-		0x99, 0x4f, 0x91, 0x0f, 0xd8, 0x1e, 0x91, 0x0f, 0x40, 0x13, 0xd9, 0x4e, 0x4c, 0x08, 0x5c, 0x8d,
-		0x26, 0x04, 0xe6, 0xc6, 0x78, 0x9d, 0xc1, 0x6c, 0x1f, 0x93, 0x11, 0xd8, 0x19, 0x97, 0x11, 0x60,
-		0x51, 0xfb, 0x93, 0x63, 0x39, 0x1e, 0x23, 0x25, 0xb0, 0x39, 0x94, 0x12, 0x38, 0x31, 0x41, 0xd3,
-		0xa6, 0xcf, 0x09, 0xb0, 0x87, 0xd6, 0xd9, 0x13, 0xeb, 0x20, 0xe5, 0x6f, 0x24, 0xb3, 0x79, 0xa9,
-		0x20, 0x3f, 0x4e, 0x3c, 0x98, 0x01, 0x3b, 0x47, 0x62, 0x05, 0xec, 0x38, 0x96, 0x23, 0xee, 0x31,
-		0xd0, 0x0f, 0xf9, 0x1c, 0x89, 0x11, 0x03, 0x9b, 0x76, 0x44, 0xfe, 0x80, 0xc6, 0x64, 0x21, 0x3b,
-		0x26, 0xff, 0x7c, 0x2c, 0xa0, 0xa5, 0x19, 0x84, 0x70, 0x7c, 0x99, 0xe3, 0xf1, 0x65, 0x28, 0xab,
-		0x10, 0x8f, 0x66, 0x15, 0x16, 0x21, 0x4f, 0x62, 0xad, 0x81, 0x84, 0x81, 0x66, 0xfb, 0x09, 0x03,
-		0x71, 0x80, 0x8a, 0xe5, 0x1e, 0xf8, 0xb2, 0xc4, 0x76, 0x13, 0xcb, 0xfe, 0x61, 0x32, 0x16, 0xea,
-		0xa2, 0xa7, 0x60, 0x2e, 0x84, 0xeb, 0xc7, 0x70, 0x2c, 0x7a, 0x96, 0x7c, 0xec, 0x2a, 0x0f, 0xe6,
-		0x7e, 0x35, 0x16, 0x48, 0x28, 0xc8, 0x34, 0x8c, 0x4a, 0x0a, 0xc4, 0xde, 0xa2, 0xa4, 0x40, 0xfc,
-		0xbe, 0x93, 0x02, 0xe1, 0x98, 0x34, 0x11, 0x8d, 0x49, 0xff, 0x47, 0x2c, 0x18, 0x13, 0x3f, 0xc4,
-		0xd7, 0xad, 0x0e, 0xe6, 0x51, 0x22, 0xfd, 0x4d, 0x5c, 0x92, 0xae, 0xb5, 0xc7, 0x63, 0x41, 0xf2,
-		0x93, 0x60, 0xf9, 0x0b, 0x4f, 0x8e, 0xaf, 0x2b, 0x7e, 0x80, 0x99, 0x0a, 0xdf, 0xe3, 0xe0, 0x97,
-		0x1b, 0xd2, 0xc1, 0xe5, 0x06, 0xff, 0xf2, 0x71, 0x26, 0x74, 0xf9, 0x18, 0xbd, 0x13, 0x72, 0x34,
-		0xd9, 0xaf, 0x5a, 0xb6, 0xf8, 0xab, 0x60, 0x67, 0xc6, 0x5f, 0x6c, 0x70, 0xe9, 0x91, 0x6b, 0x76,
-		0x19, 0x22, 0xf0, 0x38, 0x72, 0x11, 0x8f, 0xe3, 0x01, 0xc8, 0x91, 0xd6, 0xb3, 0xbf, 0x6e, 0x01,
-		0xfc, 0xe6, 0xba, 0x00, 0xc8, 0x3f, 0x11, 0x87, 0xf2, 0xc0, 0x42, 0x33, 0xb2, 0xef, 0xa3, 0xb6,
-		0x91, 0xa7, 0x93, 0xc7, 0x59, 0x80, 0x3d, 0xcd, 0x55, 0xef, 0x68, 0xa6, 0x87, 0x3b, 0x5c, 0x28,
-		0x21, 0x08, 0xaa, 0x40, 0x96, 0x7c, 0xf5, 0x5d, 0xdc, 0xe1, 0xd9, 0x17, 0xff, 0x1b, 0x5d, 0x87,
-		0x34, 0xbe, 0x4d, 0x5f, 0x77, 0x65, 0x6f, 0x24, 0x9f, 0x1c, 0x0e, 0x87, 0x49, 0x71, 0x6d, 0x81,
-		0x0c, 0xf6, 0x17, 0xdf, 0x5c, 0x94, 0x18, 0xf6, 0x93, 0xfe, 0x85, 0x31, 0x85, 0xd3, 0x47, 0xa5,
-		0x90, 0x1d, 0x90, 0x02, 0xcd, 0x03, 0x16, 0x44, 0x78, 0x4f, 0x64, 0xca, 0xce, 0x78, 0x2b, 0xc5,
-		0x1e, 0xee, 0xd9, 0x96, 0xd5, 0x55, 0xd9, 0x1c, 0xaf, 0x42, 0x29, 0xba, 0xae, 0xb2, 0xbf, 0x3c,
-		0xe5, 0x69, 0x86, 0xa9, 0x46, 0x9c, 0xe0, 0x02, 0x03, 0xb2, 0x39, 0x75, 0x23, 0x99, 0x8d, 0x49,
-		0xf1, 0x1b, 0xc9, 0x6c, 0x5c, 0x4a, 0xc8, 0x5b, 0x70, 0x62, 0xe4, 0xba, 0x8a, 0x9e, 0x87, 0x5c,
-		0xb0, 0x24, 0xb3, 0x63, 0xfa, 0x47, 0x64, 0x5a, 0x02, 0x5c, 0xf9, 0x97, 0x62, 0x01, 0xcb, 0x68,
-		0xee, 0xa6, 0x01, 0x69, 0x76, 0x66, 0x92, 0x9f, 0x8f, 0x79, 0x6a, 0xba, 0x15, 0x79, 0x99, 0x1d,
-		0xa8, 0x54, 0x38, 0xb1, 0xfc, 0x3e, 0x48, 0x33, 0x08, 0xca, 0x43, 0x26, 0x78, 0x47, 0x1a, 0x20,
-		0x5d, 0xad, 0xd7, 0x1b, 0x5b, 0xe2, 0x51, 0xd8, 0xda, 0xa6, 0xd2, 0x96, 0xe2, 0x04, 0xac, 0x34,
-		0x6e, 0x34, 0xea, 0x6d, 0x29, 0x81, 0x66, 0xa1, 0xc8, 0x7e, 0xab, 0x57, 0x37, 0x95, 0xf5, 0x6a,
-		0x5b, 0x4a, 0x86, 0x40, 0xad, 0xc6, 0xc6, 0x6a, 0x43, 0x91, 0x52, 0xf2, 0x33, 0x70, 0x7a, 0xec,
-		0x1a, 0x1e, 0x24, 0x66, 0x62, 0xa1, 0xc4, 0x8c, 0xfc, 0x91, 0x38, 0x09, 0x6a, 0xc6, 0x2d, 0xcc,
-		0xe8, 0xc6, 0x40, 0xc7, 0x2f, 0x1e, 0x63, 0x55, 0x1f, 0xe8, 0x3d, 0x89, 0x63, 0x1c, 0xbc, 0x8b,
-		0x3d, 0x7d, 0x9f, 0x39, 0x0a, 0xcc, 0x02, 0x15, 0x95, 0x22, 0x87, 0x52, 0x22, 0x97, 0xa1, 0xbd,
-		0x8e, 0x75, 0x4f, 0x65, 0x4a, 0xe4, 0xf2, 0x3f, 0x94, 0x5b, 0x64, 0xd0, 0x16, 0x03, 0xca, 0xdf,
-		0x78, 0x2c, 0x59, 0xe6, 0x20, 0xa5, 0x34, 0xda, 0xca, 0xab, 0x52, 0x02, 0x21, 0x28, 0xd1, 0x9f,
-		0x6a, 0x6b, 0xa3, 0xba, 0xd5, 0xba, 0xbe, 0x49, 0x64, 0x39, 0x07, 0x65, 0x21, 0x4b, 0x01, 0x4c,
-		0xc9, 0x4f, 0xc0, 0xa9, 0x31, 0x5e, 0xc5, 0x88, 0x43, 0xac, 0x9f, 0x88, 0x85, 0xb1, 0xa3, 0x9e,
-		0xc1, 0x26, 0xa4, 0x5d, 0x4f, 0xf3, 0xfa, 0x2e, 0x17, 0xe2, 0xf3, 0xd3, 0xba, 0x19, 0xcb, 0xe2,
-		0x47, 0x8b, 0x92, 0x2b, 0x9c, 0x8d, 0x7c, 0x09, 0x4a, 0xd1, 0x92, 0xf1, 0x32, 0x08, 0x94, 0x28,
-		0x2e, 0x5f, 0x01, 0x34, 0xec, 0x7d, 0x8c, 0x08, 0x2f, 0x63, 0xa3, 0xc2, 0xcb, 0x1f, 0x8d, 0xc1,
-		0x99, 0x23, 0x3c, 0x0d, 0xf4, 0xf2, 0x40, 0x27, 0x5f, 0x38, 0x8e, 0x9f, 0xb2, 0xcc, 0x60, 0x03,
-		0xdd, 0x7c, 0x16, 0x0a, 0x61, 0xf8, 0x74, 0x9d, 0xfc, 0x62, 0x3c, 0x98, 0xc4, 0xd1, 0x38, 0x38,
-		0x30, 0x81, 0xb1, 0xaf, 0xd2, 0x04, 0xbe, 0x0b, 0xc0, 0x3b, 0x10, 0xa7, 0xa8, 0xf9, 0x3a, 0xfa,
-		0xe0, 0x88, 0xfc, 0x22, 0xd6, 0xdb, 0x07, 0x7c, 0x12, 0xe4, 0x3c, 0xfe, 0xcb, 0x45, 0xad, 0x70,
-		0x52, 0xa0, 0x4f, 0xd7, 0x58, 0xd7, 0xff, 0x33, 0xb9, 0xd3, 0x2d, 0xc6, 0x41, 0xf2, 0x80, 0x81,
-		0x5d, 0xf4, 0x2a, 0x9c, 0x1a, 0x70, 0x14, 0x7c, 0xd6, 0xc9, 0x69, 0xfd, 0x85, 0x13, 0x51, 0x7f,
-		0x41, 0xb0, 0x0e, 0xaf, 0xf6, 0xa9, 0xe8, 0x6a, 0xff, 0x2a, 0x40, 0x90, 0x1c, 0x08, 0x8e, 0xfd,
-		0xc5, 0xc2, 0xc7, 0xfe, 0x2e, 0x41, 0x8a, 0x68, 0x92, 0x90, 0xd3, 0xb0, 0x29, 0x26, 0x9a, 0x10,
-		0x4a, 0x2e, 0x30, 0x6c, 0xd9, 0x00, 0x34, 0x9c, 0xa0, 0x1d, 0x53, 0xc5, 0xbb, 0xa3, 0x55, 0x3c,
-		0x34, 0x36, 0xd5, 0x3b, 0xba, 0xaa, 0x37, 0x20, 0x45, 0x47, 0x7e, 0xe4, 0x5d, 0xca, 0x6f, 0x00,
-		0xd0, 0x3c, 0xcf, 0x31, 0x76, 0xfa, 0x41, 0x05, 0x8b, 0xa3, 0x35, 0xa7, 0x2a, 0xf0, 0x6a, 0x0f,
-		0x70, 0x15, 0x9a, 0x0f, 0x48, 0x43, 0x6a, 0x14, 0x62, 0x28, 0x6f, 0x40, 0x29, 0x4a, 0x3b, 0xfa,
-		0x6e, 0x68, 0xf0, 0xb8, 0x4a, 0x4e, 0xf8, 0x37, 0xbe, 0x77, 0xc4, 0x1f, 0x3c, 0xa2, 0x1f, 0xf2,
-		0x07, 0xe2, 0x50, 0x08, 0x2b, 0xde, 0x5f, 0x3d, 0x17, 0x44, 0xfe, 0x96, 0x18, 0x64, 0xfd, 0xee,
-		0x1f, 0x71, 0xd8, 0x39, 0xb8, 0x23, 0xec, 0x6f, 0x5e, 0xb0, 0x6d, 0xa2, 0x84, 0xbf, 0x4d, 0x74,
-		0xc5, 0x5f, 0xfe, 0xc6, 0x25, 0x44, 0xc2, 0xb2, 0x16, 0x07, 0x0e, 0xf9, 0x6a, 0x7f, 0x65, 0xba,
-		0xab, 0x78, 0xf3, 0x90, 0x0a, 0x5f, 0xa3, 0x63, 0x1f, 0x72, 0x27, 0x74, 0xc2, 0x88, 0x4d, 0xc4,
-		0xf0, 0x9d, 0xbd, 0xd8, 0xb1, 0xef, 0xec, 0xf9, 0xb5, 0xc4, 0xc3, 0xb5, 0x7c, 0x77, 0x0c, 0xb2,
-		0x62, 0x4e, 0xa0, 0xf7, 0x84, 0x4f, 0x9e, 0x89, 0xad, 0xcd, 0xb1, 0xf6, 0x88, 0xf3, 0x0f, 0x1d,
-		0x3c, 0x1b, 0x3a, 0x70, 0x9d, 0x38, 0xee, 0x81, 0x6b, 0xee, 0xd9, 0xfd, 0x49, 0x0c, 0xa4, 0xc1,
-		0x19, 0xfb, 0x55, 0xb7, 0x6e, 0x78, 0x99, 0x4b, 0x8c, 0x58, 0xe6, 0xc6, 0x9d, 0xa2, 0x4e, 0x8e,
-		0x3b, 0x45, 0x3d, 0xdc, 0xeb, 0xd4, 0x7d, 0xf6, 0xfa, 0x83, 0x71, 0xc8, 0x87, 0xd2, 0xa3, 0xe8,
-		0xb9, 0xc8, 0x89, 0xec, 0xa5, 0xa3, 0x52, 0xa9, 0xa1, 0x23, 0xd9, 0x11, 0x31, 0xc5, 0x8f, 0x2f,
-		0xa6, 0xb7, 0xfe, 0x76, 0xd6, 0xe8, 0x0b, 0xac, 0xa9, 0x31, 0x17, 0x58, 0xff, 0x7a, 0x0c, 0xb2,
-		0xbe, 0xdb, 0x7d, 0xdc, 0x4d, 0xcc, 0x93, 0x90, 0xe6, 0x9e, 0x25, 0xdb, 0xc5, 0xe4, 0x5f, 0x23,
-		0xd3, 0xca, 0x15, 0xc8, 0x8a, 0xbf, 0x59, 0xc8, 0x57, 0x35, 0xff, 0xfb, 0xfc, 0x0b, 0x90, 0x0f,
-		0x6d, 0x00, 0x13, 0xd3, 0xb8, 0xd1, 0x78, 0xaf, 0x34, 0x53, 0xc9, 0xdc, 0xbd, 0xb7, 0x94, 0xd8,
-		0xc0, 0x77, 0xc8, 0x6c, 0x56, 0x1a, 0xf5, 0xeb, 0x8d, 0xfa, 0x4b, 0x52, 0xac, 0x92, 0xbf, 0x7b,
-		0x6f, 0x29, 0xa3, 0x60, 0x9a, 0x51, 0x3c, 0xff, 0x12, 0x94, 0x07, 0x06, 0x26, 0xea, 0xb6, 0x20,
-		0x28, 0xad, 0x6e, 0x6f, 0xad, 0x35, 0xeb, 0xd5, 0x76, 0x43, 0x65, 0xe7, 0x76, 0xd1, 0x29, 0x98,
-		0x5b, 0x6b, 0x5e, 0xbb, 0xde, 0x56, 0xeb, 0x6b, 0xcd, 0xc6, 0x46, 0x5b, 0xad, 0xb6, 0xdb, 0xd5,
-		0xfa, 0x4b, 0x52, 0xfc, 0xe2, 0xbd, 0x3c, 0x24, 0xab, 0xb5, 0x7a, 0x13, 0xd5, 0x21, 0x49, 0x53,
-		0x21, 0x47, 0x9e, 0x00, 0xab, 0x1c, 0x9d, 0x1b, 0x46, 0x57, 0x21, 0x45, 0xb3, 0x24, 0xe8, 0xe8,
-		0x23, 0x61, 0x95, 0x09, 0xc9, 0x62, 0xd2, 0x18, 0x3a, 0x23, 0x8f, 0x3c, 0x23, 0x56, 0x39, 0x3a,
-		0x77, 0x8c, 0xd6, 0x20, 0x23, 0x82, 0xe4, 0x49, 0x07, 0xb7, 0x2a, 0x13, 0x13, 0xba, 0xa4, 0x6b,
-		0x2c, 0xd9, 0x70, 0xf4, 0xf1, 0xb1, 0xca, 0x84, 0xac, 0x32, 0x6a, 0xfa, 0xf7, 0x99, 0x26, 0x9c,
-		0x08, 0xab, 0x4c, 0xca, 0x13, 0x23, 0x05, 0x72, 0x41, 0x1a, 0x67, 0xf2, 0xa1, 0xb8, 0xca, 0x14,
-		0x09, 0x73, 0xf4, 0x3e, 0x28, 0x46, 0x43, 0xdd, 0xe9, 0x4e, 0x9d, 0x55, 0xa6, 0xcc, 0x48, 0x13,
-		0xfe, 0xd1, 0xb8, 0x77, 0xba, 0x53, 0x68, 0x95, 0x29, 0x13, 0xd4, 0xe8, 0x75, 0x98, 0x1d, 0x8e,
-		0x4b, 0xa7, 0x3f, 0x94, 0x56, 0x39, 0x46, 0xca, 0x1a, 0xf5, 0x00, 0x8d, 0x88, 0x67, 0x8f, 0x71,
-		0x46, 0xad, 0x72, 0x9c, 0x0c, 0x36, 0xea, 0x40, 0x79, 0x30, 0x48, 0x9c, 0xf6, 0xcc, 0x5a, 0x65,
-		0xea, 0x6c, 0x36, 0xab, 0x25, 0x1a, 0x5c, 0x4e, 0x7b, 0x86, 0xad, 0x32, 0x75, 0x72, 0x1b, 0x6d,
-		0x03, 0x84, 0xe2, 0xc3, 0x29, 0xce, 0xb4, 0x55, 0xa6, 0x49, 0x73, 0x23, 0x1b, 0xe6, 0x46, 0x05,
-		0x8e, 0xc7, 0x39, 0xe2, 0x56, 0x39, 0x56, 0xf6, 0x9b, 0xe8, 0x73, 0x34, 0x04, 0x9c, 0xee, 0xc8,
-		0x5b, 0x65, 0xca, 0x34, 0x78, 0xad, 0x3a, 0xf6, 0x9c, 0xf3, 0x63, 0x47, 0x9e, 0x73, 0x0e, 0x4e,
-		0x2e, 0xfb, 0x67, 0x9b, 0x7f, 0xf5, 0x39, 0x78, 0x07, 0x7f, 0x41, 0xc7, 0xf5, 0xb4, 0x5b, 0x86,
-		0xb9, 0xe7, 0x3f, 0x65, 0xc4, 0xbf, 0xf9, 0x21, 0xe7, 0x93, 0xfc, 0xb9, 0x1e, 0x01, 0x9d, 0xf0,
-		0xa0, 0xd1, 0xd8, 0x57, 0x1e, 0x27, 0xdd, 0x87, 0x98, 0x7c, 0x7c, 0xf9, 0x88, 0xc7, 0x92, 0x26,
-		0x3c, 0xc9, 0x34, 0xe2, 0x31, 0xa5, 0xca, 0x91, 0x6f, 0x0c, 0x54, 0x8e, 0x3a, 0xf7, 0x2d, 0x7f,
-		0x5f, 0x0c, 0x4a, 0xd7, 0x0d, 0xd7, 0xb3, 0x1c, 0x43, 0xd7, 0xba, 0x74, 0x79, 0xb9, 0x32, 0xed,
-		0xad, 0xac, 0x5a, 0x8e, 0xb8, 0x2b, 0xfc, 0xbd, 0x25, 0x7e, 0x53, 0x68, 0x15, 0xd2, 0xb7, 0xb5,
-		0x2e, 0xbb, 0x13, 0x15, 0x7e, 0x6c, 0x6d, 0x50, 0xe6, 0x21, 0x3f, 0x2a, 0xcc, 0x85, 0xd1, 0xae,
-		0xc4, 0x17, 0x62, 0xf2, 0xb7, 0xc5, 0x40, 0x0a, 0x5a, 0xa6, 0x60, 0xdd, 0x72, 0x3a, 0x34, 0x14,
-		0xb0, 0xed, 0xd0, 0x59, 0x01, 0xf1, 0x49, 0x5d, 0x3e, 0xa3, 0x87, 0x7d, 0xbf, 0x6d, 0xbc, 0x93,
-		0x95, 0x0c, 0x39, 0x58, 0x23, 0xae, 0x9c, 0x27, 0x46, 0x5d, 0x39, 0x97, 0xbf, 0x97, 0xde, 0x70,
-		0xe9, 0xf5, 0x0c, 0x97, 0xcc, 0x0e, 0x85, 0x06, 0xf7, 0x37, 0x20, 0xe9, 0x68, 0x1e, 0x0f, 0x79,
-		0x6b, 0x97, 0x8f, 0xfd, 0x64, 0x14, 0xeb, 0x33, 0xe5, 0x81, 0x5e, 0x86, 0x6c, 0x4f, 0x3b, 0x50,
-		0x29, 0xbf, 0xf8, 0x57, 0xc5, 0x2f, 0xd3, 0xd3, 0x0e, 0x48, 0xfb, 0xd0, 0xfb, 0xa0, 0x4c, 0x58,
-		0xea, 0xfb, 0x9a, 0xb9, 0x87, 0x19, 0xe7, 0xc4, 0x57, 0xc5, 0xb9, 0xd8, 0xd3, 0x0e, 0xea, 0x94,
-		0x1b, 0xe1, 0xcf, 0x9f, 0xd6, 0xfa, 0x95, 0x18, 0xcf, 0x63, 0x50, 0xc1, 0x20, 0x0d, 0x24, 0xdd,
-		0xff, 0xa2, 0x95, 0x8a, 0x4d, 0x97, 0xc7, 0xc6, 0x69, 0xc2, 0x80, 0x58, 0x6b, 0x45, 0xd2, 0xbc,
-		0xcf, 0xbc, 0xb9, 0x18, 0x63, 0xb5, 0x96, 0xf5, 0x21, 0xb1, 0xe7, 0x59, 0x7a, 0x46, 0x9d, 0x72,
-		0xc0, 0x8b, 0xc2, 0xab, 0x66, 0x0c, 0x81, 0x51, 0x93, 0x72, 0xde, 0x87, 0x4f, 0xc5, 0x20, 0xbf,
-		0x1a, 0x7a, 0xcc, 0x71, 0x01, 0x32, 0x3d, 0xcb, 0x34, 0x6e, 0x61, 0xc7, 0xdf, 0x35, 0x63, 0x9f,
-		0xc4, 0xf3, 0x65, 0x7f, 0x13, 0xd0, 0x3b, 0x14, 0xef, 0x1d, 0x89, 0x6f, 0x42, 0x75, 0x07, 0xef,
-		0xb8, 0x86, 0x90, 0xb3, 0x22, 0x3e, 0xd1, 0xe3, 0x20, 0xb9, 0x58, 0xef, 0x3b, 0x86, 0x77, 0xa8,
-		0xea, 0x96, 0xe9, 0x69, 0xba, 0xc7, 0x33, 0x03, 0x65, 0x01, 0xaf, 0x33, 0x30, 0x61, 0xd2, 0xc1,
-		0x9e, 0x66, 0x74, 0xd9, 0x59, 0xd1, 0x9c, 0x22, 0x3e, 0x79, 0x53, 0xef, 0x66, 0xc2, 0x91, 0x71,
-		0x1d, 0x24, 0xcb, 0xc6, 0x4e, 0xe4, 0x88, 0x0c, 0xd3, 0xc6, 0x85, 0xdf, 0xfc, 0xf4, 0x53, 0xf3,
-		0x5c, 0xe0, 0xfc, 0x78, 0x05, 0xfb, 0xdb, 0x05, 0x4a, 0x59, 0x50, 0x88, 0xb3, 0x33, 0xaf, 0x46,
-		0xf6, 0xc9, 0xfa, 0x3b, 0xc1, 0xc3, 0x36, 0xf3, 0x43, 0x42, 0xad, 0x9a, 0x87, 0xb5, 0x85, 0xdf,
-		0x08, 0x58, 0x07, 0x91, 0xf3, 0x4b, 0xf8, 0x30, 0xbc, 0x69, 0x46, 0xd9, 0x90, 0xa0, 0xe2, 0x75,
-		0xcd, 0xe8, 0x8a, 0x3f, 0x9f, 0xaa, 0xf0, 0x2f, 0xb4, 0xe2, 0x27, 0x3c, 0x93, 0x34, 0x42, 0x93,
-		0xc7, 0xe9, 0x46, 0xcd, 0x32, 0x3b, 0xd1, 0xcc, 0x26, 0xaa, 0x43, 0xda, 0xb3, 0x6e, 0x61, 0x93,
-		0x0b, 0xa8, 0xf6, 0xc4, 0x31, 0xde, 0x86, 0x53, 0x38, 0x29, 0xfa, 0x7a, 0x90, 0x3a, 0xb8, 0x8b,
-		0xf7, 0xd8, 0x0d, 0xce, 0x7d, 0xcd, 0xc1, 0xec, 0xfd, 0x80, 0xfb, 0x7a, 0xf9, 0xad, 0xec, 0xb3,
-		0x6a, 0x51, 0x4e, 0x68, 0x2b, 0xfa, 0x5c, 0x68, 0x86, 0x6f, 0x67, 0x8f, 0xe9, 0x63, 0x48, 0xf3,
-		0xc2, 0xb6, 0x30, 0xf2, 0xbc, 0xe8, 0xe3, 0x20, 0xf5, 0xcd, 0x1d, 0xcb, 0xa4, 0x7f, 0x75, 0x90,
-		0xc7, 0x75, 0x59, 0xb6, 0x77, 0xea, 0xc3, 0xf9, 0xde, 0xe9, 0x16, 0x94, 0x02, 0x54, 0x3a, 0x43,
-		0x72, 0xc7, 0x9d, 0x21, 0x45, 0x9f, 0x01, 0x41, 0x41, 0xeb, 0x00, 0xc1, 0x1c, 0xa4, 0x9b, 0x77,
-		0xf9, 0xf1, 0x23, 0x16, 0xcc, 0xe6, 0x70, 0x67, 0x42, 0x0c, 0xd0, 0xd7, 0xc1, 0x5c, 0xcf, 0x30,
-		0x55, 0x17, 0x77, 0x77, 0x55, 0x2e, 0x39, 0xc2, 0x37, 0x7f, 0xfc, 0xd1, 0x9c, 0xed, 0x19, 0x66,
-		0x0b, 0x77, 0x77, 0x57, 0x7d, 0x2e, 0xe8, 0x5d, 0x70, 0x26, 0xe8, 0xbd, 0x65, 0xaa, 0xfb, 0x56,
-		0xb7, 0xa3, 0x3a, 0x78, 0x57, 0xd5, 0xe9, 0xeb, 0x7e, 0x05, 0x2a, 0xb3, 0x53, 0x3e, 0xca, 0xa6,
-		0x79, 0xdd, 0xea, 0x76, 0x14, 0xbc, 0x5b, 0x27, 0xc5, 0xe8, 0x61, 0x08, 0xba, 0xae, 0x1a, 0x1d,
-		0x77, 0xa1, 0xb8, 0x94, 0x38, 0x97, 0x54, 0x0a, 0x3e, 0xb0, 0xd9, 0x71, 0x57, 0xb2, 0x1f, 0xfa,
-		0xf8, 0xe2, 0xcc, 0x17, 0x3e, 0xbe, 0x38, 0x23, 0x5f, 0xa5, 0xaf, 0x5c, 0xf1, 0x79, 0x84, 0x5d,
-		0x74, 0x19, 0x72, 0x9a, 0xf8, 0x60, 0x77, 0xd8, 0x8e, 0x98, 0x87, 0x01, 0xaa, 0xfc, 0xe3, 0x31,
-		0x48, 0xaf, 0xde, 0xdc, 0xd2, 0x0c, 0x07, 0x35, 0x60, 0x36, 0x50, 0xcc, 0x69, 0xa7, 0x74, 0xa0,
-		0xcb, 0x62, 0x4e, 0x6f, 0x8c, 0x3b, 0x3c, 0x97, 0xab, 0x3d, 0xf4, 0x9b, 0x9f, 0x7e, 0xea, 0x41,
-		0xce, 0xe6, 0xe6, 0xc0, 0x39, 0x3a, 0xc1, 0x6f, 0xf0, 0x7c, 0x5d, 0xa8, 0xcf, 0x37, 0x20, 0xc3,
-		0x9a, 0xea, 0xa2, 0x17, 0x21, 0x65, 0x93, 0x1f, 0x7c, 0x4f, 0xe0, 0xec, 0x58, 0x05, 0xa7, 0xf8,
-		0x61, 0x75, 0x60, 0x74, 0xf2, 0xb7, 0xc5, 0x01, 0x56, 0x6f, 0xde, 0x6c, 0x3b, 0x86, 0xdd, 0xc5,
-		0xde, 0x5b, 0xd5, 0xf7, 0x6d, 0x38, 0x11, 0xba, 0x9d, 0xed, 0xe8, 0xc7, 0xef, 0xff, 0x5c, 0x70,
-		0x51, 0xdb, 0xd1, 0x47, 0xb2, 0xed, 0xb8, 0x9e, 0xcf, 0x36, 0x71, 0x7c, 0xb6, 0xab, 0xae, 0x37,
-		0x2c, 0xd9, 0x57, 0x20, 0x1f, 0x08, 0xc3, 0x45, 0x4d, 0xc8, 0x7a, 0xfc, 0x37, 0x17, 0xb0, 0x3c,
-		0x5e, 0xc0, 0x82, 0x2c, 0x2c, 0x64, 0x9f, 0x5c, 0xfe, 0xb3, 0x18, 0x40, 0x68, 0x8e, 0xfc, 0xc5,
-		0xd4, 0x31, 0xd4, 0x84, 0x34, 0xb7, 0xc4, 0x89, 0xfb, 0x7e, 0x83, 0x93, 0x31, 0x08, 0x09, 0xf5,
-		0x3b, 0xe2, 0x30, 0xb7, 0x2d, 0x66, 0xef, 0x5f, 0x7c, 0x19, 0x6c, 0x43, 0x06, 0x9b, 0x9e, 0x63,
-		0xf8, 0xbb, 0x5a, 0x4f, 0x8f, 0x1b, 0xf3, 0x11, 0x9d, 0x6a, 0x98, 0x9e, 0x73, 0x18, 0xd6, 0x00,
-		0xc1, 0x2b, 0x24, 0x8f, 0x8f, 0x26, 0x60, 0x61, 0x1c, 0x29, 0xf1, 0x86, 0x75, 0x07, 0x53, 0x40,
-		0xf4, 0xd2, 0x6a, 0x49, 0x80, 0xf9, 0x1a, 0xa3, 0x00, 0xf1, 0xca, 0x88, 0x72, 0x11, 0xd4, 0xfb,
-		0x73, 0xc3, 0x4a, 0x01, 0x07, 0xba, 0xca, 0xb4, 0xa1, 0x2c, 0x6e, 0xc1, 0xec, 0x68, 0x5d, 0xcd,
-		0xd4, 0x85, 0xbb, 0x7a, 0xac, 0x25, 0x41, 0xdc, 0xa4, 0xa9, 0x31, 0x16, 0xa8, 0x01, 0x19, 0xc1,
-		0x2d, 0x79, 0x7c, 0x6e, 0x82, 0x16, 0x3d, 0x04, 0x85, 0xf0, 0xc2, 0x40, 0x5d, 0x8f, 0xa4, 0x92,
-		0x0f, 0xad, 0x0b, 0x93, 0x56, 0x9e, 0xf4, 0x91, 0x2b, 0x0f, 0xf7, 0xee, 0x7e, 0x88, 0x1e, 0x56,
-		0xef, 0xfc, 0xe5, 0x1f, 0x96, 0x2d, 0x00, 0x36, 0x55, 0x89, 0x25, 0xe5, 0x23, 0x73, 0x1f, 0xf3,
-		0x3d, 0xc7, 0x98, 0xac, 0xba, 0xde, 0xd7, 0x6a, 0x84, 0x7e, 0x3b, 0x0e, 0x85, 0xf0, 0x08, 0xfd,
-		0x95, 0x5c, 0xb4, 0xd0, 0x46, 0x60, 0xa6, 0xd8, 0x85, 0x9e, 0xc7, 0xc7, 0x99, 0xa9, 0x21, 0x6d,
-		0x9e, 0x60, 0x9f, 0x3e, 0x9f, 0x80, 0x34, 0x3f, 0x71, 0xb7, 0x39, 0xe4, 0xc8, 0xc6, 0x26, 0x3d,
-		0x49, 0x50, 0x14, 0x4f, 0x12, 0x8c, 0xf4, 0x63, 0x1f, 0x81, 0x12, 0x09, 0x88, 0x23, 0xc7, 0xf8,
-		0x62, 0xe7, 0x8a, 0x34, 0xae, 0x0d, 0x0e, 0xad, 0xa3, 0x45, 0xc8, 0x13, 0xb4, 0xc0, 0x0e, 0x13,
-		0x1c, 0xe8, 0x69, 0x07, 0x0d, 0x06, 0x41, 0x4f, 0x01, 0xda, 0xf7, 0x13, 0x13, 0x6a, 0x20, 0x08,
-		0x82, 0x37, 0x1b, 0x94, 0x08, 0xf4, 0x07, 0x01, 0x48, 0x2b, 0x54, 0xf6, 0xec, 0x34, 0x7f, 0xd4,
-		0x9b, 0x40, 0x56, 0xe9, 0xd3, 0xd3, 0xdf, 0x1c, 0x63, 0xfe, 0xf0, 0x40, 0xd8, 0xcc, 0xc3, 0x91,
-		0xf6, 0x14, 0x93, 0xe2, 0x8f, 0xdf, 0x5c, 0xac, 0x1c, 0x6a, 0xbd, 0xee, 0x8a, 0x3c, 0x82, 0x8f,
-		0x3c, 0x2a, 0x92, 0x27, 0x8e, 0x73, 0x34, 0xec, 0x46, 0x4d, 0x90, 0x6e, 0xe1, 0x43, 0xd5, 0xe1,
-		0x7f, 0x93, 0x5b, 0xdd, 0xc5, 0x98, 0x07, 0x2e, 0xa7, 0x97, 0x47, 0x3c, 0x02, 0xbe, 0x5c, 0xb7,
-		0x0c, 0x93, 0x6f, 0x81, 0x95, 0x6e, 0xe1, 0x43, 0x85, 0xd3, 0x5d, 0xc5, 0x78, 0xe5, 0x1d, 0x64,
-		0xa6, 0xdc, 0xfd, 0xfc, 0x4f, 0x9e, 0x3f, 0x13, 0x7a, 0xd0, 0xfa, 0xc0, 0xcf, 0xcd, 0xb1, 0xe1,
-		0x25, 0x4e, 0x2f, 0x0a, 0x16, 0x20, 0xff, 0xd8, 0xff, 0x3a, 0x40, 0x28, 0x28, 0x88, 0x1d, 0x1d,
-		0x6c, 0x04, 0xf4, 0x91, 0x60, 0x23, 0x34, 0x3d, 0xdf, 0x13, 0xd8, 0xff, 0xf8, 0xa4, 0xde, 0x84,
-		0x35, 0x93, 0x13, 0xd1, 0x59, 0x3f, 0x23, 0xff, 0xeb, 0x18, 0x9c, 0x1e, 0xd2, 0x64, 0xbf, 0xc9,
-		0x3a, 0x20, 0x27, 0x54, 0x48, 0x35, 0x42, 0xec, 0x33, 0xdf, 0xdf, 0xc4, 0x98, 0x75, 0x86, 0x16,
-		0x81, 0xb7, 0x66, 0x21, 0xe3, 0x56, 0xec, 0xd7, 0x63, 0x30, 0x1f, 0x6e, 0x80, 0xdf, 0x95, 0x16,
-		0x14, 0xc2, 0x55, 0xf3, 0x4e, 0xbc, 0x63, 0x9a, 0x4e, 0x84, 0xdb, 0x1f, 0x61, 0x82, 0x6e, 0x06,
-		0xd6, 0x82, 0x25, 0x05, 0x9f, 0x99, 0x5a, 0x28, 0xa2, 0x61, 0x23, 0xad, 0x06, 0x1b, 0x9b, 0xdf,
-		0x8f, 0x41, 0x72, 0xcb, 0xb2, 0xba, 0xe8, 0xfd, 0x30, 0x6b, 0x5a, 0x9e, 0x4a, 0x66, 0x16, 0xee,
-		0xa8, 0x3c, 0x47, 0xc0, 0x2c, 0x71, 0xe3, 0x48, 0x59, 0x7d, 0xf1, 0xcd, 0xc5, 0x61, 0xca, 0x51,
-		0x8f, 0xca, 0x97, 0x4d, 0xcb, 0xab, 0x51, 0xa4, 0x36, 0x4b, 0x23, 0xec, 0x42, 0x31, 0x5a, 0x1d,
-		0xb3, 0xd6, 0xd5, 0x49, 0xd5, 0x15, 0x27, 0x56, 0x55, 0xd8, 0x09, 0xd5, 0xc3, 0xde, 0x88, 0xfe,
-		0x43, 0x32, 0x72, 0xaf, 0x82, 0x74, 0x73, 0xf0, 0xfc, 0x52, 0x03, 0x32, 0xe2, 0xbc, 0x52, 0x6c,
-		0xca, 0xa3, 0x50, 0x61, 0x71, 0x72, 0x5a, 0xf9, 0x33, 0x71, 0x38, 0x5d, 0xb7, 0x4c, 0x97, 0x27,
-		0x73, 0xf8, 0x84, 0x66, 0x29, 0xd8, 0x43, 0xf4, 0xf8, 0x98, 0x54, 0x53, 0x61, 0x38, 0xa1, 0x74,
-		0x13, 0xca, 0x64, 0x65, 0xd5, 0x2d, 0xf3, 0xab, 0xcc, 0x27, 0x15, 0xad, 0x6e, 0x87, 0xb7, 0xe8,
-		0x16, 0x3e, 0x24, 0x7c, 0x4d, 0x7c, 0x27, 0xc2, 0x37, 0x71, 0x7f, 0x7c, 0x4d, 0x7c, 0x27, 0xc4,
-		0x37, 0xd8, 0x2a, 0x4f, 0x46, 0xb6, 0xca, 0x2f, 0x43, 0x82, 0x58, 0xc1, 0xd4, 0x31, 0xec, 0x06,
-		0x21, 0x08, 0xad, 0x66, 0x2d, 0x38, 0xcd, 0x13, 0x04, 0xee, 0xe6, 0x2e, 0x95, 0x28, 0xa6, 0x1d,
-		0x7a, 0x09, 0x1f, 0x8e, 0xc8, 0x16, 0x14, 0xa6, 0xca, 0x16, 0x9c, 0xff, 0xd9, 0x18, 0x40, 0x90,
-		0x17, 0x43, 0x4f, 0xc2, 0xa9, 0xda, 0xe6, 0xc6, 0xaa, 0xda, 0x6a, 0x57, 0xdb, 0xdb, 0xad, 0xe8,
-		0x9f, 0x8c, 0x11, 0x8f, 0x60, 0xb9, 0x36, 0xd6, 0x8d, 0x5d, 0x03, 0x77, 0xd0, 0xa3, 0x30, 0x1f,
-		0xc5, 0x26, 0x5f, 0x8d, 0x55, 0x29, 0x56, 0x29, 0xdc, 0xbd, 0xb7, 0x94, 0x65, 0xa1, 0x01, 0xee,
-		0xa0, 0x73, 0x70, 0x62, 0x18, 0xaf, 0xb9, 0x71, 0x4d, 0x8a, 0x57, 0x8a, 0x77, 0xef, 0x2d, 0xe5,
-		0xfc, 0x18, 0x02, 0xc9, 0x80, 0xc2, 0x98, 0x9c, 0x5f, 0xa2, 0x02, 0x77, 0xef, 0x2d, 0xa5, 0xd9,
-		0x6c, 0xa9, 0x24, 0x3f, 0xf4, 0x23, 0x67, 0x67, 0xce, 0x7f, 0x03, 0x40, 0xd3, 0xdc, 0x75, 0x34,
-		0x9d, 0x5a, 0x85, 0x0a, 0x9c, 0x6c, 0x6e, 0x5c, 0x55, 0xaa, 0xf5, 0x76, 0x73, 0x73, 0x63, 0xe0,
-		0x2f, 0xdd, 0x44, 0xcb, 0x56, 0x37, 0xb7, 0x6b, 0x6b, 0x0d, 0xb5, 0xd5, 0xbc, 0xb6, 0xc1, 0x76,
-		0xfc, 0x23, 0x65, 0xef, 0xdd, 0x68, 0x37, 0xd7, 0x1b, 0x52, 0xbc, 0x76, 0x79, 0xec, 0x8e, 0xd2,
-		0x03, 0x91, 0x79, 0x18, 0xac, 0x44, 0x91, 0x6d, 0xa4, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x18,
-		0x00, 0x33, 0xab, 0x2d, 0xa3, 0x00, 0x00,
+		// 13154 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x64, 0xc7,
+		0x55, 0x20, 0x3c, 0xb7, 0x5e, 0x5d, 0x75, 0xea, 0xd1, 0xb7, 0xb3, 0x7b, 0x66, 0x6a, 0x6a, 0xa4,
+		0x99, 0xd6, 0x95, 0x25, 0x8d, 0x46, 0x56, 0x8f, 0x34, 0xd2, 0x8c, 0x34, 0x2d, 0xdb, 0xfa, 0xaa,
+		0xab, 0x6b, 0x66, 0x7a, 0xd4, 0x5d, 0xd5, 0xba, 0xd5, 0x3d, 0xd2, 0x08, 0xf0, 0xe5, 0x76, 0x55,
+		0x76, 0x77, 0x69, 0xaa, 0xee, 0x2d, 0xd7, 0xbd, 0x35, 0x33, 0xed, 0x1f, 0x0e, 0x83, 0xcd, 0xf7,
+		0x99, 0x31, 0xe6, 0x33, 0x1f, 0x04, 0x08, 0x9b, 0x01, 0x1b, 0x3e, 0xb0, 0x61, 0x61, 0x17, 0x8c,
+		0x79, 0xed, 0xc6, 0xb2, 0xb0, 0xb1, 0xbb, 0x3c, 0x02, 0x36, 0x0c, 0x11, 0xbb, 0xb0, 0x1b, 0x81,
+		0x77, 0xb1, 0x09, 0x4b, 0x18, 0xb3, 0x80, 0x11, 0xbb, 0x6c, 0x38, 0x36, 0xbc, 0x71, 0xf2, 0x71,
+		0x1f, 0xf5, 0xe8, 0xaa, 0x1e, 0xc9, 0xac, 0x16, 0xf6, 0x4f, 0x77, 0x65, 0xe6, 0x39, 0x27, 0x33,
+		0x4f, 0x9e, 0x3c, 0x79, 0xf2, 0xe4, 0xc9, 0xbc, 0xf0, 0x15, 0x03, 0xe6, 0x77, 0x6c, 0x7b, 0xa7,
+		0x45, 0xcf, 0x74, 0xba, 0xb6, 0x6b, 0x6f, 0xf5, 0xb6, 0xcf, 0x34, 0xa8, 0x53, 0xef, 0x36, 0x3b,
+		0xae, 0xdd, 0x5d, 0x60, 0x79, 0x64, 0x9a, 0x43, 0x2c, 0x48, 0x08, 0x6d, 0x0d, 0x66, 0x2e, 0x36,
+		0x5b, 0x74, 0xd9, 0x03, 0xac, 0x51, 0x97, 0x3c, 0x0d, 0xb1, 0xed, 0x66, 0x8b, 0xe6, 0x95, 0xf9,
+		0xe8, 0xa9, 0xf4, 0xd9, 0xb7, 0x2d, 0xf4, 0x21, 0x2d, 0x84, 0x31, 0xd6, 0x31, 0x5b, 0x67, 0x18,
+		0xda, 0x2b, 0x71, 0x98, 0x1d, 0x52, 0x4a, 0x08, 0xc4, 0x2c, 0xb3, 0x8d, 0x14, 0x95, 0x53, 0x29,
+		0x9d, 0xfd, 0x26, 0x79, 0x98, 0xea, 0x98, 0xf5, 0xeb, 0xe6, 0x0e, 0xcd, 0x47, 0x58, 0xb6, 0x4c,
+		0x92, 0x13, 0x00, 0x0d, 0xda, 0xa1, 0x56, 0x83, 0x5a, 0xf5, 0xbd, 0x7c, 0x74, 0x3e, 0x7a, 0x2a,
+		0xa5, 0x07, 0x72, 0xc8, 0x23, 0x30, 0xd3, 0xe9, 0x6d, 0xb5, 0x9a, 0x75, 0x23, 0x00, 0x06, 0xf3,
+		0xd1, 0x53, 0x71, 0x5d, 0xe5, 0x05, 0xcb, 0x3e, 0xf0, 0x43, 0x30, 0x7d, 0x93, 0x9a, 0xd7, 0x83,
+		0xa0, 0x69, 0x06, 0x9a, 0xc3, 0xec, 0x00, 0x60, 0x09, 0x32, 0x6d, 0xea, 0x38, 0xe6, 0x0e, 0x35,
+		0xdc, 0xbd, 0x0e, 0xcd, 0xc7, 0x58, 0xef, 0xe7, 0x07, 0x7a, 0xdf, 0xdf, 0xf3, 0xb4, 0xc0, 0xda,
+		0xd8, 0xeb, 0x50, 0x52, 0x84, 0x14, 0xb5, 0x7a, 0x6d, 0x4e, 0x21, 0x3e, 0x82, 0x7f, 0x65, 0xab,
+		0xd7, 0xee, 0xa7, 0x92, 0x44, 0x34, 0x41, 0x62, 0xca, 0xa1, 0xdd, 0x1b, 0xcd, 0x3a, 0xcd, 0x27,
+		0x18, 0x81, 0x87, 0x06, 0x08, 0xd4, 0x78, 0x79, 0x3f, 0x0d, 0x89, 0x47, 0x4a, 0x90, 0xa2, 0xb7,
+		0x5c, 0x6a, 0x39, 0x4d, 0xdb, 0xca, 0x4f, 0x31, 0x22, 0x0f, 0x0c, 0x19, 0x45, 0xda, 0x6a, 0xf4,
+		0x93, 0xf0, 0xf1, 0xc8, 0x79, 0x98, 0xb2, 0x3b, 0x6e, 0xd3, 0xb6, 0x9c, 0x7c, 0x72, 0x5e, 0x39,
+		0x95, 0x3e, 0x7b, 0xcf, 0x50, 0x41, 0xa8, 0x72, 0x18, 0x5d, 0x02, 0x93, 0x15, 0x50, 0x1d, 0xbb,
+		0xd7, 0xad, 0x53, 0xa3, 0x6e, 0x37, 0xa8, 0xd1, 0xb4, 0xb6, 0xed, 0x7c, 0x8a, 0x11, 0x38, 0x39,
+		0xd8, 0x11, 0x06, 0x58, 0xb2, 0x1b, 0x74, 0xc5, 0xda, 0xb6, 0xf5, 0x9c, 0x13, 0x4a, 0x93, 0x23,
+		0x90, 0x70, 0xf6, 0x2c, 0xd7, 0xbc, 0x95, 0xcf, 0x30, 0x09, 0x11, 0x29, 0x72, 0x16, 0xa6, 0x68,
+		0xa3, 0x89, 0xd5, 0xe5, 0x73, 0xf3, 0xca, 0xa9, 0xdc, 0xd9, 0xfc, 0x20, 0x8f, 0x79, 0xb9, 0x2e,
+		0x01, 0xb5, 0x5f, 0x49, 0xc0, 0xf4, 0x24, 0x62, 0xf9, 0x0c, 0xc4, 0xb7, 0x91, 0x33, 0xf9, 0xc8,
+		0x41, 0xf8, 0xc6, 0x71, 0xc2, 0x8c, 0x4f, 0xdc, 0x25, 0xe3, 0x8b, 0x90, 0xb6, 0xa8, 0xe3, 0xd2,
+		0x06, 0x97, 0xa2, 0xe8, 0x84, 0x72, 0x08, 0x1c, 0x69, 0x50, 0x0c, 0x63, 0x77, 0x25, 0x86, 0x2f,
+		0xc2, 0xb4, 0xd7, 0x24, 0xa3, 0x6b, 0x5a, 0x3b, 0x52, 0x9e, 0xcf, 0x8c, 0x6b, 0xc9, 0x42, 0x59,
+		0xe2, 0xe9, 0x88, 0xa6, 0xe7, 0x68, 0x28, 0x4d, 0x96, 0x01, 0x6c, 0x8b, 0xda, 0xdb, 0x46, 0x83,
+		0xd6, 0x5b, 0xf9, 0xe4, 0x08, 0x2e, 0x55, 0x11, 0x64, 0x80, 0x4b, 0x36, 0xcf, 0xad, 0xb7, 0xc8,
+		0x05, 0x5f, 0x3c, 0xa7, 0x46, 0x48, 0xd7, 0x1a, 0x9f, 0x98, 0x03, 0x12, 0xba, 0x09, 0xb9, 0x2e,
+		0xc5, 0xb9, 0x42, 0x1b, 0xa2, 0x67, 0x29, 0xd6, 0x88, 0x85, 0xb1, 0x3d, 0xd3, 0x05, 0x1a, 0xef,
+		0x58, 0xb6, 0x1b, 0x4c, 0x92, 0xfb, 0xc1, 0xcb, 0x30, 0x98, 0x58, 0x01, 0xd3, 0x5c, 0x19, 0x99,
+		0x59, 0x31, 0xdb, 0xb4, 0xf0, 0x5e, 0xc8, 0x85, 0xd9, 0x43, 0xe6, 0x20, 0xee, 0xb8, 0x66, 0xd7,
+		0x65, 0x52, 0x18, 0xd7, 0x79, 0x82, 0xa8, 0x10, 0xa5, 0x56, 0x83, 0x69, 0xc6, 0xb8, 0x8e, 0x3f,
+		0xc9, 0xff, 0xe5, 0x77, 0x38, 0xca, 0x3a, 0xfc, 0xe0, 0xe0, 0x88, 0x86, 0x28, 0xf7, 0xf7, 0xbb,
+		0xf0, 0x14, 0x64, 0x43, 0x1d, 0x98, 0xb4, 0x6a, 0xed, 0x77, 0x62, 0x70, 0x78, 0x28, 0x6d, 0xf2,
+		0x22, 0xcc, 0xf5, 0xac, 0xa6, 0xe5, 0xd2, 0x6e, 0xa7, 0x4b, 0x51, 0x64, 0x79, 0x5d, 0xf9, 0x57,
+		0xa7, 0x46, 0x08, 0xdd, 0x66, 0x10, 0x9a, 0x53, 0xd1, 0x67, 0x7b, 0x83, 0x99, 0xe4, 0x1a, 0xa4,
+		0x51, 0x3e, 0xcc, 0xae, 0xc9, 0x08, 0xf2, 0xd9, 0x78, 0x76, 0xb2, 0x2e, 0x2f, 0x2c, 0xfb, 0x98,
+		0x4b, 0xd1, 0x0f, 0x29, 0x11, 0x3d, 0x48, 0x8b, 0x3c, 0x05, 0xc9, 0x6d, 0x6a, 0xba, 0xbd, 0x2e,
+		0x75, 0xf2, 0x67, 0x19, 0x2b, 0x8f, 0x0f, 0x4e, 0x52, 0x0e, 0x50, 0xa3, 0xae, 0xee, 0x01, 0x93,
+		0x36, 0x64, 0x6e, 0xd0, 0x6e, 0x73, 0xbb, 0x59, 0xe7, 0x8d, 0x8a, 0x32, 0xe5, 0xf3, 0xf4, 0x84,
+		0x8d, 0xba, 0x1a, 0x40, 0xad, 0xb9, 0xa6, 0x4b, 0x17, 0x61, 0xb3, 0x72, 0xb5, 0xac, 0xaf, 0x5c,
+		0x5c, 0x29, 0x2f, 0xf3, 0x66, 0x86, 0xc8, 0x17, 0xbe, 0x4f, 0x81, 0x74, 0xa0, 0x27, 0xa8, 0x0e,
+		0xad, 0x5e, 0x7b, 0x8b, 0x76, 0xc5, 0x78, 0x89, 0x14, 0x39, 0x0e, 0xa9, 0xed, 0x5e, 0xab, 0xc5,
+		0x85, 0x8e, 0xaf, 0xa5, 0x49, 0xcc, 0x40, 0x81, 0x43, 0x1d, 0x27, 0xd4, 0x08, 0xd3, 0x71, 0xf8,
+		0x9b, 0x14, 0x20, 0x29, 0x85, 0x32, 0x1f, 0x9f, 0x57, 0x4e, 0x25, 0x75, 0x2f, 0xcd, 0xcb, 0x3a,
+		0xd4, 0x74, 0x69, 0x23, 0x9f, 0x90, 0x65, 0x3c, 0x7d, 0x25, 0x96, 0x8c, 0xa9, 0x71, 0xed, 0x49,
+		0x98, 0x19, 0xe8, 0x0a, 0x99, 0x86, 0xf4, 0x72, 0xb9, 0xb4, 0x5a, 0xd4, 0x8b, 0x1b, 0x2b, 0xd5,
+		0x8a, 0x7a, 0x88, 0xe4, 0x20, 0xd0, 0x3b, 0x55, 0x39, 0x9d, 0x4a, 0xbe, 0x36, 0xa5, 0xbe, 0xff,
+		0xfd, 0xef, 0x7f, 0x7f, 0x44, 0xfb, 0xf5, 0x04, 0xcc, 0x0d, 0x53, 0x82, 0x43, 0xf5, 0xb1, 0xdf,
+		0xe9, 0x68, 0xa8, 0xd3, 0x45, 0x88, 0xb7, 0xcc, 0x2d, 0xda, 0xca, 0xc7, 0xd8, 0x20, 0x3c, 0x32,
+		0x91, 0x9a, 0x5d, 0x58, 0x45, 0x14, 0x9d, 0x63, 0x92, 0x77, 0x09, 0xd6, 0xc4, 0x19, 0x85, 0xd3,
+		0x93, 0x51, 0x40, 0xe5, 0x28, 0xd8, 0x78, 0x1c, 0x52, 0xf8, 0x9f, 0xf3, 0x3d, 0xc1, 0xf9, 0x8e,
+		0x19, 0x8c, 0xef, 0x05, 0x48, 0x32, 0xbd, 0xd7, 0xa0, 0xde, 0x98, 0xc8, 0x34, 0x6a, 0x8a, 0x06,
+		0xdd, 0x36, 0x7b, 0x2d, 0xd7, 0xb8, 0x61, 0xb6, 0x7a, 0x94, 0x69, 0xb0, 0x94, 0x9e, 0x11, 0x99,
+		0x57, 0x31, 0x8f, 0x9c, 0x84, 0x34, 0x57, 0x93, 0x4d, 0xab, 0x41, 0x6f, 0xb1, 0x25, 0x34, 0xae,
+		0x73, 0xcd, 0xb9, 0x82, 0x39, 0x58, 0xfd, 0xcb, 0x8e, 0x6d, 0x49, 0x5d, 0xc3, 0xaa, 0xc0, 0x0c,
+		0x56, 0xfd, 0x53, 0xfd, 0xab, 0xf7, 0xbd, 0xc3, 0xbb, 0x37, 0xa0, 0x1c, 0x1f, 0x82, 0x69, 0x06,
+		0xf1, 0x84, 0x98, 0xca, 0x66, 0x2b, 0x3f, 0xc3, 0xc4, 0x20, 0xc7, 0xb3, 0xab, 0x22, 0x57, 0xfb,
+		0xc5, 0x08, 0xc4, 0xd8, 0x4a, 0x31, 0x0d, 0xe9, 0x8d, 0x6b, 0xeb, 0x65, 0x63, 0xb9, 0xba, 0xb9,
+		0xb4, 0x5a, 0x56, 0x15, 0x1c, 0x7a, 0x96, 0x71, 0x71, 0xb5, 0x5a, 0xdc, 0x50, 0x23, 0x5e, 0x7a,
+		0xa5, 0xb2, 0x71, 0xfe, 0x49, 0x35, 0xea, 0x21, 0x6c, 0xf2, 0x8c, 0x58, 0x10, 0xe0, 0x89, 0xb3,
+		0x6a, 0x9c, 0xa8, 0x90, 0xe1, 0x04, 0x56, 0x5e, 0x2c, 0x2f, 0x9f, 0x7f, 0x52, 0x4d, 0x84, 0x73,
+		0x9e, 0x38, 0xab, 0x4e, 0x91, 0x2c, 0xa4, 0x58, 0xce, 0x52, 0xb5, 0xba, 0xaa, 0x26, 0x3d, 0x9a,
+		0xb5, 0x0d, 0x7d, 0xa5, 0x72, 0x49, 0x4d, 0x79, 0x34, 0x2f, 0xe9, 0xd5, 0xcd, 0x75, 0x15, 0x3c,
+		0x0a, 0x6b, 0xe5, 0x5a, 0xad, 0x78, 0xa9, 0xac, 0xa6, 0x3d, 0x88, 0xa5, 0x6b, 0x1b, 0xe5, 0x9a,
+		0x9a, 0x09, 0x35, 0xeb, 0x89, 0xb3, 0x6a, 0xd6, 0xab, 0xa2, 0x5c, 0xd9, 0x5c, 0x53, 0x73, 0x64,
+		0x06, 0xb2, 0xbc, 0x0a, 0xd9, 0x88, 0xe9, 0xbe, 0xac, 0xf3, 0x4f, 0xaa, 0xaa, 0xdf, 0x10, 0x4e,
+		0x65, 0x26, 0x94, 0x71, 0xfe, 0x49, 0x95, 0x68, 0x25, 0x88, 0x33, 0x31, 0x24, 0x04, 0x72, 0xab,
+		0xc5, 0xa5, 0xf2, 0xaa, 0x51, 0x5d, 0xc7, 0x49, 0x53, 0x5c, 0x55, 0x15, 0x3f, 0x4f, 0x2f, 0xaf,
+		0x97, 0x8b, 0x1b, 0xe5, 0x65, 0x35, 0x1a, 0xcc, 0x7b, 0x7e, 0x73, 0x45, 0x2f, 0x2f, 0xab, 0x11,
+		0xad, 0x0e, 0x73, 0xc3, 0x56, 0xc8, 0xa1, 0x53, 0x28, 0x20, 0x0b, 0x91, 0x11, 0xb2, 0xc0, 0x68,
+		0xf5, 0xcb, 0x82, 0xf6, 0xc5, 0x08, 0xcc, 0x0e, 0xb1, 0x12, 0x86, 0x56, 0xf2, 0x2c, 0xc4, 0xb9,
+		0x2c, 0x73, 0x4d, 0xfd, 0xf0, 0x50, 0x73, 0x83, 0x49, 0xf6, 0x80, 0xed, 0xc4, 0xf0, 0x82, 0xf6,
+		0x66, 0x74, 0x84, 0xbd, 0x89, 0x24, 0x06, 0x04, 0xf6, 0x5b, 0x06, 0x56, 0x73, 0x6e, 0xf0, 0x9c,
+		0x9f, 0xc4, 0xe0, 0x61, 0x79, 0x07, 0x5b, 0xd5, 0xe3, 0x43, 0x56, 0xf5, 0x67, 0x60, 0x66, 0x80,
+		0xd0, 0xc4, 0xab, 0xeb, 0x07, 0x14, 0xc8, 0x8f, 0x62, 0xce, 0x18, 0x95, 0x18, 0x09, 0xa9, 0xc4,
+		0x67, 0xfa, 0x39, 0x78, 0xdf, 0xe8, 0x41, 0x18, 0x18, 0xeb, 0x4f, 0x29, 0x70, 0x64, 0xf8, 0xbe,
+		0x62, 0x68, 0x1b, 0xde, 0x05, 0x89, 0x36, 0x75, 0x77, 0x6d, 0x69, 0x27, 0x3f, 0x38, 0xc4, 0xfa,
+		0xc2, 0xe2, 0xfe, 0xc1, 0x16, 0x58, 0xe4, 0x42, 0x7f, 0x5b, 0x4f, 0x8e, 0xda, 0xe5, 0x0c, 0xb4,
+		0xf4, 0x3b, 0x23, 0x70, 0x78, 0x28, 0xf1, 0xa1, 0x0d, 0xbd, 0x17, 0xa0, 0x69, 0x75, 0x7a, 0x2e,
+		0xb7, 0x85, 0xb9, 0x26, 0x4e, 0xb1, 0x1c, 0xa6, 0xbc, 0x50, 0xcb, 0xf6, 0x5c, 0xaf, 0x9c, 0xaf,
+		0x92, 0xc0, 0xb3, 0x18, 0xc0, 0xd3, 0x7e, 0x43, 0x63, 0xac, 0xa1, 0x27, 0x46, 0xf4, 0x74, 0x40,
+		0x30, 0x1f, 0x03, 0xb5, 0xde, 0x6a, 0x52, 0xcb, 0x35, 0x1c, 0xb7, 0x4b, 0xcd, 0x76, 0xd3, 0xda,
+		0xe1, 0xab, 0xed, 0x62, 0x7c, 0xdb, 0x6c, 0x39, 0x54, 0x9f, 0xe6, 0xc5, 0x35, 0x59, 0x8a, 0x18,
+		0x4c, 0x80, 0xba, 0x01, 0x8c, 0x44, 0x08, 0x83, 0x17, 0x7b, 0x18, 0xda, 0xcf, 0xa4, 0x20, 0x1d,
+		0xd8, 0x85, 0x91, 0xfb, 0x20, 0xf3, 0xb2, 0x79, 0xc3, 0x34, 0xe4, 0xce, 0x9a, 0x73, 0x22, 0x8d,
+		0x79, 0xeb, 0x3c, 0x8b, 0x3c, 0x06, 0x73, 0x0c, 0xc4, 0xee, 0xb9, 0xb4, 0x6b, 0xd4, 0x5b, 0xa6,
+		0xe3, 0x30, 0xa6, 0x25, 0x19, 0x28, 0xc1, 0xb2, 0x2a, 0x16, 0x95, 0x64, 0x09, 0x39, 0x07, 0xb3,
+		0x0c, 0xa3, 0xdd, 0x6b, 0xb9, 0xcd, 0x4e, 0x8b, 0x1a, 0xb8, 0xd7, 0x77, 0xf2, 0x10, 0x6c, 0xd9,
+		0x0c, 0x42, 0xac, 0x09, 0x00, 0x6c, 0x91, 0x43, 0x96, 0xe1, 0x5e, 0x86, 0xb6, 0x43, 0x2d, 0xda,
+		0x35, 0x5d, 0x6a, 0xd0, 0xf7, 0xf4, 0xcc, 0x96, 0x63, 0x98, 0x56, 0xc3, 0xd8, 0x35, 0x9d, 0xdd,
+		0xfc, 0x1c, 0x12, 0x58, 0x8a, 0xe4, 0x15, 0xfd, 0x18, 0x02, 0x5e, 0x12, 0x70, 0x65, 0x06, 0x56,
+		0xb4, 0x1a, 0x97, 0x4d, 0x67, 0x97, 0x2c, 0xc2, 0x11, 0x46, 0xc5, 0x71, 0xbb, 0x4d, 0x6b, 0xc7,
+		0xa8, 0xef, 0xd2, 0xfa, 0x75, 0xa3, 0xe7, 0x6e, 0x3f, 0x9d, 0x3f, 0x1e, 0xac, 0x9f, 0xb5, 0xb0,
+		0xc6, 0x60, 0x4a, 0x08, 0xb2, 0xe9, 0x6e, 0x3f, 0x4d, 0x6a, 0x90, 0xc1, 0xc1, 0x68, 0x37, 0xdf,
+		0x4b, 0x8d, 0x6d, 0xbb, 0xcb, 0xd6, 0xd0, 0xdc, 0x10, 0xd5, 0x14, 0xe0, 0xe0, 0x42, 0x55, 0x20,
+		0xac, 0xd9, 0x0d, 0xba, 0x18, 0xaf, 0xad, 0x97, 0xcb, 0xcb, 0x7a, 0x5a, 0x52, 0xb9, 0x68, 0x77,
+		0x51, 0xa0, 0x76, 0x6c, 0x8f, 0xc1, 0x69, 0x2e, 0x50, 0x3b, 0xb6, 0x64, 0xef, 0x39, 0x98, 0xad,
+		0xd7, 0x79, 0x9f, 0x9b, 0x75, 0x43, 0xec, 0xc8, 0x9d, 0xbc, 0x1a, 0x62, 0x56, 0xbd, 0x7e, 0x89,
+		0x03, 0x08, 0x19, 0x77, 0xc8, 0x05, 0x38, 0xec, 0x33, 0x2b, 0x88, 0x38, 0x33, 0xd0, 0xcb, 0x7e,
+		0xd4, 0x73, 0x30, 0xdb, 0xd9, 0x1b, 0x44, 0x24, 0xa1, 0x1a, 0x3b, 0x7b, 0xfd, 0x68, 0x0f, 0x30,
+		0x2f, 0x4b, 0x97, 0xd6, 0x99, 0xa9, 0x77, 0x34, 0x08, 0x1d, 0x28, 0x20, 0x0b, 0xa0, 0xd6, 0xeb,
+		0x06, 0xb5, 0xcc, 0xad, 0x16, 0x35, 0xcc, 0x2e, 0xb5, 0x4c, 0x27, 0x7f, 0x92, 0x01, 0xc7, 0xdc,
+		0x6e, 0x8f, 0xea, 0xb9, 0x7a, 0xbd, 0xcc, 0x0a, 0x8b, 0xac, 0x8c, 0x9c, 0x86, 0x19, 0x7b, 0xeb,
+		0xe5, 0x3a, 0x17, 0x2c, 0xa3, 0xd3, 0xa5, 0xdb, 0xcd, 0x5b, 0xf9, 0xb7, 0x31, 0x2e, 0x4d, 0x63,
+		0x01, 0x13, 0xab, 0x75, 0x96, 0x4d, 0x1e, 0x06, 0xb5, 0xee, 0xec, 0x9a, 0xdd, 0x0e, 0xd3, 0xac,
+		0x4e, 0xc7, 0xac, 0xd3, 0xfc, 0x03, 0x1c, 0x94, 0xe7, 0x57, 0x64, 0x36, 0x0a, 0xb6, 0x73, 0xb3,
+		0xb9, 0xed, 0x4a, 0x8a, 0x0f, 0x71, 0xc1, 0x66, 0x79, 0x82, 0xda, 0x29, 0x50, 0x3b, 0xbb, 0x9d,
+		0x70, 0xc5, 0xa7, 0x18, 0x58, 0xae, 0xb3, 0xdb, 0x09, 0xd6, 0x7b, 0x3f, 0x64, 0x3b, 0xbb, 0xc1,
+		0x4a, 0x1f, 0xe6, 0xf6, 0x57, 0x67, 0x37, 0x50, 0xe3, 0x93, 0x70, 0x04, 0x81, 0xda, 0xd4, 0x35,
+		0x1b, 0xa6, 0x6b, 0x06, 0xa0, 0xdf, 0xce, 0xa0, 0xe7, 0x3a, 0xbb, 0x9d, 0x35, 0x51, 0x18, 0x6a,
+		0x67, 0xb7, 0xb7, 0xb5, 0xe7, 0xc9, 0xc7, 0xa3, 0xbc, 0x9d, 0x98, 0x27, 0x25, 0xe4, 0xae, 0xb7,
+		0x1f, 0xdf, 0xb0, 0xcd, 0x96, 0xb6, 0x08, 0x99, 0xa0, 0xdc, 0x93, 0x14, 0x70, 0xc9, 0x57, 0x15,
+		0x34, 0x82, 0x4a, 0xd5, 0x65, 0x34, 0x5f, 0x5e, 0x2a, 0xab, 0x11, 0x34, 0xa3, 0x56, 0x57, 0x36,
+		0xca, 0x86, 0xbe, 0x59, 0xd9, 0x58, 0x59, 0x2b, 0xab, 0xd1, 0x80, 0x61, 0x7f, 0x25, 0x96, 0x3c,
+		0xad, 0x3e, 0x72, 0x25, 0x96, 0x7c, 0x50, 0x7d, 0x88, 0xb1, 0x67, 0x40, 0x28, 0xb5, 0xd7, 0xa3,
+		0x90, 0x0b, 0x6f, 0xcb, 0xc9, 0x3b, 0xe0, 0xa8, 0xf4, 0xbb, 0x39, 0xd4, 0x35, 0x6e, 0x36, 0xbb,
+		0x6c, 0xb2, 0xb6, 0x4d, 0xbe, 0x70, 0x7a, 0x42, 0x39, 0x27, 0xa0, 0x6a, 0xd4, 0x7d, 0xa1, 0xd9,
+		0xc5, 0xa9, 0xd8, 0x36, 0x5d, 0xb2, 0x0a, 0x27, 0x2d, 0xdb, 0x70, 0x5c, 0xd3, 0x6a, 0x98, 0xdd,
+		0x86, 0xe1, 0x7b, 0x3c, 0x0d, 0xb3, 0x5e, 0xa7, 0x8e, 0x63, 0xf3, 0x45, 0xd2, 0xa3, 0x72, 0x8f,
+		0x65, 0xd7, 0x04, 0xb0, 0xbf, 0x7a, 0x14, 0x05, 0x68, 0xdf, 0x9c, 0x88, 0x8e, 0x9a, 0x13, 0xc7,
+		0x21, 0xd5, 0x36, 0x3b, 0x06, 0xb5, 0xdc, 0xee, 0x1e, 0xb3, 0xdd, 0x93, 0x7a, 0xb2, 0x6d, 0x76,
+		0xca, 0x98, 0x26, 0x57, 0xe1, 0x41, 0x1f, 0xd4, 0x68, 0xd1, 0x1d, 0xb3, 0xbe, 0x67, 0x30, 0x43,
+		0x9d, 0xf9, 0x88, 0x8c, 0xba, 0x6d, 0x6d, 0xb7, 0x9a, 0x75, 0xd7, 0xc9, 0xa7, 0x3d, 0xfd, 0xa7,
+		0xf9, 0x18, 0xab, 0x0c, 0xe1, 0x8a, 0x63, 0x5b, 0xcc, 0x3e, 0x2f, 0x49, 0xe8, 0x90, 0xd8, 0x64,
+		0xde, 0x12, 0x62, 0x13, 0x1e, 0xfa, 0x98, 0x1a, 0xbf, 0x12, 0x4b, 0xc6, 0xd5, 0xc4, 0x95, 0x58,
+		0x32, 0xa1, 0x4e, 0x5d, 0x89, 0x25, 0x93, 0x6a, 0xea, 0x4a, 0x2c, 0x99, 0x52, 0x41, 0xbb, 0x93,
+		0x85, 0x4c, 0x70, 0xbb, 0x81, 0xbb, 0xb7, 0x3a, 0x5b, 0x70, 0x15, 0xa6, 0x92, 0xef, 0xdf, 0x77,
+		0x73, 0xb2, 0x50, 0xc2, 0x95, 0x78, 0x31, 0xc1, 0x6d, 0x7b, 0x9d, 0x63, 0xa2, 0x15, 0x84, 0x93,
+		0x8c, 0x72, 0x5b, 0x2a, 0xa9, 0x8b, 0x14, 0xb9, 0x04, 0x89, 0x97, 0x1d, 0x46, 0x3b, 0xc1, 0x68,
+		0xbf, 0x6d, 0x7f, 0xda, 0x57, 0x6a, 0x8c, 0x78, 0xea, 0x4a, 0xcd, 0xa8, 0x54, 0xf5, 0xb5, 0xe2,
+		0xaa, 0x2e, 0xd0, 0xc9, 0x31, 0x88, 0xb5, 0xcc, 0xf7, 0xee, 0x85, 0xd7, 0x6c, 0x96, 0x45, 0x16,
+		0x60, 0xba, 0x67, 0xf1, 0xbd, 0x3a, 0x8e, 0x31, 0x42, 0x4d, 0x07, 0xa1, 0x72, 0x7e, 0xe9, 0x2a,
+		0xc2, 0x4f, 0x28, 0x57, 0xc7, 0x20, 0x86, 0x4e, 0xe9, 0xf0, 0xca, 0xca, 0xb2, 0xc8, 0x29, 0xc8,
+		0x34, 0xe8, 0x56, 0x6f, 0xc7, 0xe8, 0xd2, 0x86, 0x59, 0x77, 0xc3, 0xeb, 0x49, 0x9a, 0x15, 0xe9,
+		0xac, 0x84, 0x3c, 0x07, 0x29, 0x1c, 0x23, 0x8b, 0x8d, 0xf1, 0x0c, 0x63, 0xc1, 0xa3, 0xfb, 0xb3,
+		0x40, 0x0c, 0xb1, 0x44, 0xd2, 0x7d, 0x7c, 0x72, 0x19, 0xa6, 0x5c, 0xb3, 0xbb, 0x43, 0x5d, 0x27,
+		0x3f, 0x3b, 0x1f, 0x3d, 0x95, 0x3b, 0xbb, 0x30, 0x09, 0xa9, 0x0d, 0x86, 0xc2, 0x76, 0xca, 0x12,
+		0x9d, 0xbc, 0x00, 0xaa, 0x70, 0xc5, 0x1a, 0x62, 0x9b, 0xeb, 0xe4, 0xe7, 0x98, 0x00, 0xbe, 0x7d,
+		0x7f, 0x92, 0xc2, 0x93, 0xbb, 0xcc, 0x91, 0xf4, 0x69, 0x1a, 0x4a, 0x87, 0xe7, 0xc5, 0xe1, 0x83,
+		0xcc, 0x8b, 0x4d, 0x98, 0x16, 0xbf, 0x0d, 0xa7, 0xd7, 0xe9, 0xd8, 0x5d, 0x37, 0x7f, 0x64, 0x5e,
+		0x19, 0xdf, 0x20, 0x49, 0x8c, 0xe3, 0xe8, 0xb9, 0xed, 0x50, 0xfa, 0x1b, 0x37, 0xdd, 0x0a, 0x2f,
+		0x41, 0x2e, 0xcc, 0x8c, 0xa0, 0x23, 0x3c, 0x3a, 0xa1, 0x23, 0x1c, 0xb7, 0x25, 0x72, 0xa3, 0x86,
+		0x4b, 0x13, 0x4f, 0x14, 0xbe, 0x3f, 0x02, 0xb9, 0x70, 0xc7, 0xc8, 0x25, 0x20, 0x72, 0xc4, 0x9a,
+		0x96, 0xdb, 0xb5, 0x1b, 0xbd, 0x3a, 0x6d, 0xe4, 0x95, 0x31, 0xf5, 0xcc, 0x08, 0x9c, 0x15, 0x0f,
+		0x25, 0x48, 0x28, 0x30, 0x0b, 0x22, 0x13, 0x12, 0x5a, 0xf6, 0xe7, 0xc7, 0x19, 0x98, 0x95, 0x04,
+		0x90, 0xd8, 0x4d, 0xb3, 0x6b, 0xa1, 0x89, 0xcc, 0x8d, 0x76, 0x12, 0x28, 0x7a, 0x81, 0x97, 0x90,
+		0x22, 0x48, 0x71, 0x31, 0xba, 0xb4, 0x6d, 0xa3, 0xbf, 0x2b, 0x36, 0xa6, 0xda, 0x9c, 0x40, 0xd0,
+		0x39, 0xbc, 0x76, 0x06, 0xe2, 0x4c, 0xfd, 0x10, 0x00, 0xa1, 0x80, 0xd4, 0x43, 0x24, 0x09, 0xb1,
+		0x52, 0x55, 0xc7, 0xe5, 0x51, 0x85, 0x0c, 0xcf, 0x35, 0xd6, 0x57, 0xca, 0xa5, 0xb2, 0x1a, 0xd1,
+		0xce, 0x41, 0x82, 0xeb, 0x14, 0x5c, 0x3a, 0x3d, 0xad, 0xa2, 0x1e, 0x12, 0x49, 0x41, 0x43, 0x91,
+		0xa5, 0x9b, 0x6b, 0x4b, 0x65, 0x5d, 0x8d, 0x68, 0x9b, 0x30, 0xdd, 0x37, 0x0f, 0xc9, 0x61, 0x98,
+		0xd1, 0xcb, 0x1b, 0xe5, 0x0a, 0x3a, 0x07, 0x8c, 0xcd, 0xca, 0x73, 0x95, 0xea, 0x0b, 0xe8, 0x59,
+		0x0b, 0x65, 0xcb, 0x75, 0x58, 0x21, 0x73, 0xa0, 0xfa, 0xd9, 0xb5, 0xea, 0xa6, 0xce, 0x5a, 0xf3,
+		0x5d, 0x11, 0x50, 0xfb, 0x27, 0x25, 0x39, 0x0a, 0xb3, 0x1b, 0x45, 0xfd, 0x52, 0x79, 0xc3, 0xe0,
+		0x0e, 0x0f, 0x8f, 0xf4, 0x1c, 0xa8, 0xc1, 0x82, 0x8b, 0x2b, 0xcc, 0x9f, 0x73, 0x12, 0x8e, 0x07,
+		0x73, 0xcb, 0x2f, 0x6e, 0x94, 0x2b, 0x35, 0x56, 0x79, 0xb1, 0x72, 0x09, 0x8d, 0x82, 0x3e, 0x7a,
+		0xd2, 0xc5, 0x12, 0xc5, 0xa6, 0x86, 0xe9, 0x95, 0x57, 0x97, 0xd5, 0x58, 0x7f, 0x76, 0xb5, 0x52,
+		0xae, 0x5e, 0x54, 0xe3, 0xfd, 0xb5, 0x33, 0xb7, 0x4b, 0x82, 0x14, 0xe0, 0x48, 0x7f, 0xae, 0x51,
+		0xae, 0x6c, 0xe8, 0xd7, 0xd4, 0xa9, 0xfe, 0x8a, 0x6b, 0x65, 0xfd, 0xea, 0x4a, 0xa9, 0xac, 0x26,
+		0xc9, 0x11, 0x20, 0xe1, 0x16, 0x6d, 0x5c, 0xae, 0x2e, 0xab, 0xa9, 0x61, 0x2b, 0x16, 0x51, 0x67,
+		0xb5, 0x9f, 0x56, 0x20, 0x13, 0x74, 0x81, 0x84, 0x94, 0x8a, 0xf2, 0x56, 0x5b, 0x6c, 0xb5, 0xdf,
+		0x8b, 0x40, 0x3a, 0xe0, 0x0b, 0xc1, 0x4d, 0xac, 0xd9, 0x6a, 0xd9, 0x37, 0x0d, 0xb3, 0xd5, 0x34,
+		0x1d, 0xb1, 0x1e, 0x02, 0xcb, 0x2a, 0x62, 0xce, 0xa4, 0xeb, 0xcf, 0xe4, 0xa6, 0x4b, 0xe2, 0xae,
+		0x4d, 0x97, 0xa9, 0xb7, 0xa0, 0xe9, 0x12, 0x57, 0x13, 0xda, 0x1f, 0x46, 0x40, 0xed, 0xf7, 0x8e,
+		0xf4, 0xf1, 0x4d, 0x19, 0xc5, 0xb7, 0x60, 0xff, 0x22, 0x07, 0xe9, 0x5f, 0xff, 0xaa, 0x1e, 0x1d,
+		0xb9, 0xaa, 0x0f, 0x59, 0xac, 0x62, 0x6f, 0xe5, 0xc5, 0x2a, 0x28, 0xae, 0xff, 0x4e, 0x81, 0x5c,
+		0xd8, 0x99, 0x13, 0xe2, 0x98, 0x76, 0x10, 0x8e, 0x85, 0x47, 0xe4, 0xbe, 0x51, 0x23, 0xf2, 0x77,
+		0xd2, 0xaf, 0x1f, 0x8c, 0x42, 0x36, 0xe4, 0xfb, 0x99, 0xb4, 0x75, 0xef, 0x81, 0x99, 0x66, 0x83,
+		0xb6, 0x3b, 0xb6, 0x8b, 0x91, 0x07, 0x46, 0x8b, 0xde, 0xa0, 0x2d, 0xc6, 0x86, 0xdc, 0x90, 0xd3,
+		0xd5, 0x50, 0x0d, 0x0b, 0x2b, 0x3e, 0xde, 0x2a, 0xa2, 0x2d, 0xce, 0xae, 0x2c, 0x97, 0xd7, 0xd6,
+		0xab, 0x1b, 0xe5, 0x4a, 0xe9, 0x9a, 0xd4, 0xe4, 0xba, 0xda, 0xec, 0x03, 0x0b, 0x31, 0xfc, 0xfe,
+		0xb7, 0xc6, 0xa6, 0x73, 0x1d, 0xd4, 0xfe, 0xde, 0xa0, 0x42, 0x1f, 0xd2, 0x1f, 0xf5, 0x10, 0x99,
+		0x85, 0xe9, 0x4a, 0xd5, 0xa8, 0xad, 0x2c, 0x97, 0x8d, 0xf2, 0xc5, 0x8b, 0xe5, 0xd2, 0x46, 0x8d,
+		0x1f, 0x34, 0x78, 0xd0, 0x1b, 0x6a, 0x24, 0x38, 0x36, 0x1f, 0x8b, 0xc2, 0xec, 0x90, 0x96, 0x90,
+		0xa2, 0x70, 0x11, 0x72, 0xaf, 0xe5, 0xa3, 0x93, 0xb4, 0x7e, 0x01, 0x77, 0xf7, 0xeb, 0x66, 0xd7,
+		0x15, 0x1e, 0xc5, 0x87, 0x01, 0xd9, 0x6b, 0xb9, 0x68, 0xde, 0x77, 0xc5, 0x01, 0x0e, 0x37, 0x41,
+		0xa6, 0xfd, 0x7c, 0x7e, 0x86, 0xf3, 0x76, 0x20, 0x1d, 0xdb, 0x69, 0xba, 0xcd, 0x1b, 0x18, 0x08,
+		0x21, 0x4f, 0x7b, 0x70, 0xe2, 0xc6, 0x74, 0x55, 0x96, 0xac, 0x58, 0xae, 0x07, 0x6d, 0xd1, 0x1d,
+		0xb3, 0x0f, 0x1a, 0xb7, 0x1f, 0x51, 0x5d, 0x95, 0x25, 0x1e, 0xf4, 0x7d, 0x90, 0x69, 0xd8, 0x3d,
+		0xf4, 0xca, 0x70, 0x38, 0x54, 0xc9, 0x8a, 0x9e, 0xe6, 0x79, 0x1e, 0x88, 0x70, 0x9b, 0xf9, 0xc7,
+		0x4c, 0x19, 0x3d, 0xcd, 0xf3, 0x38, 0xc8, 0x43, 0x30, 0x6d, 0xee, 0xec, 0x74, 0x91, 0xb8, 0x24,
+		0xc4, 0x1d, 0x81, 0x39, 0x2f, 0x9b, 0x01, 0x16, 0xae, 0x40, 0x52, 0xf2, 0x01, 0xf7, 0xbf, 0xc8,
+		0x09, 0xa3, 0xc3, 0xbd, 0xdb, 0x11, 0x3c, 0x79, 0xb2, 0x64, 0xe1, 0x7d, 0x90, 0x69, 0x3a, 0x86,
+		0x1f, 0x06, 0x11, 0x99, 0x8f, 0x9c, 0x4a, 0xea, 0xe9, 0xa6, 0xe3, 0x9d, 0x8a, 0x6a, 0x9f, 0x02,
+		0x00, 0x5f, 0xd8, 0xc8, 0xf7, 0x28, 0x90, 0xe3, 0x0b, 0x4c, 0xa7, 0x4b, 0x1d, 0x6a, 0xd5, 0xe5,
+		0xb6, 0xf0, 0xe1, 0x7d, 0x44, 0x94, 0xab, 0xb9, 0x75, 0x81, 0xb0, 0xf4, 0xec, 0x87, 0x14, 0xe5,
+		0x15, 0x25, 0xf6, 0x8a, 0xa2, 0xfc, 0xa8, 0x92, 0x25, 0xc9, 0xf2, 0x8b, 0xeb, 0xab, 0x2b, 0xa5,
+		0x95, 0x8d, 0xfc, 0x97, 0xa6, 0x58, 0x7a, 0x65, 0x4d, 0xa4, 0x5f, 0x9d, 0x0a, 0x97, 0xbf, 0x36,
+		0xf5, 0x19, 0x25, 0x9a, 0x7c, 0x6d, 0x4a, 0xcf, 0x6e, 0x07, 0xe9, 0x91, 0x56, 0x30, 0x82, 0x22,
+		0x32, 0x6a, 0x23, 0xe9, 0xb7, 0xa6, 0x2c, 0xe2, 0x26, 0x96, 0x1e, 0x66, 0x0d, 0x49, 0xb0, 0x86,
+		0xa4, 0x49, 0xa2, 0xb4, 0x5a, 0xad, 0x95, 0x97, 0x59, 0x33, 0x52, 0x24, 0x56, 0x5d, 0x2f, 0x57,
+		0xf2, 0xaf, 0xca, 0x2a, 0xfd, 0x60, 0x8b, 0x57, 0x14, 0x38, 0x2a, 0x4f, 0x59, 0xc5, 0x5a, 0x4b,
+		0xad, 0xba, 0xdd, 0x90, 0xd6, 0x6d, 0xee, 0xec, 0xe3, 0xfb, 0x55, 0xae, 0x0b, 0x54, 0xc6, 0x92,
+		0xb2, 0x40, 0x5c, 0x7a, 0x74, 0x80, 0x25, 0xc5, 0xca, 0xb2, 0x68, 0x4b, 0x9a, 0x24, 0xd6, 0x8b,
+		0xa5, 0xe7, 0xca, 0xcb, 0x7e, 0x6b, 0x0e, 0x77, 0x87, 0x51, 0x21, 0xef, 0x83, 0x69, 0xf4, 0xb6,
+		0xa2, 0x6c, 0x34, 0x1b, 0xfc, 0xd8, 0x3b, 0x36, 0xea, 0xbc, 0xd4, 0x6f, 0x11, 0xba, 0x5f, 0xaf,
+		0x7a, 0x18, 0x4b, 0x0f, 0x07, 0x9a, 0x92, 0x22, 0xb1, 0x4a, 0xb5, 0x52, 0x96, 0xcd, 0x60, 0x47,
+		0xc4, 0xd7, 0xfc, 0x66, 0xe4, 0x7a, 0x21, 0x54, 0xf2, 0x3e, 0x50, 0xa5, 0x7b, 0xc8, 0x63, 0x49,
+		0x7c, 0xd4, 0x91, 0xaf, 0xdf, 0x00, 0xe1, 0x64, 0xf2, 0x98, 0xf1, 0x60, 0xa0, 0x05, 0x73, 0x64,
+		0x7a, 0xb5, 0x5c, 0xb9, 0xb4, 0x71, 0xd9, 0x58, 0xd7, 0xcb, 0xec, 0xe4, 0x2e, 0xff, 0x25, 0x59,
+		0xfd, 0x74, 0x3b, 0x8c, 0x48, 0xbe, 0x5d, 0x81, 0x34, 0x37, 0x81, 0xb8, 0x4f, 0x8a, 0x3b, 0x15,
+		0x1e, 0xdc, 0xaf, 0x6e, 0x66, 0x01, 0x31, 0xe8, 0xa5, 0x0b, 0xac, 0xda, 0xa8, 0x14, 0x88, 0xa3,
+		0x84, 0xac, 0x96, 0x2f, 0x15, 0x4b, 0xd7, 0x8c, 0xa5, 0x72, 0x6d, 0x03, 0x35, 0x59, 0x55, 0xe7,
+		0x32, 0x0a, 0x24, 0x5e, 0x5c, 0x5d, 0xad, 0xbe, 0xe0, 0x33, 0x02, 0x5e, 0xf6, 0xc8, 0x68, 0xdf,
+		0x0c, 0xd9, 0x90, 0xb8, 0xa3, 0x51, 0xcc, 0x8c, 0x69, 0xec, 0x41, 0xad, 0x5c, 0x29, 0x05, 0x8d,
+		0xf8, 0x0c, 0x78, 0xe2, 0xad, 0x2a, 0x98, 0x92, 0xc2, 0xaf, 0x46, 0x50, 0x8d, 0x8a, 0x06, 0x78,
+		0x67, 0x89, 0x51, 0xed, 0x29, 0x48, 0x4a, 0xf1, 0x45, 0xd3, 0x9c, 0x59, 0xd8, 0x7d, 0x1b, 0x83,
+		0x24, 0x30, 0xd9, 0x55, 0x15, 0xdc, 0x06, 0x71, 0x99, 0x56, 0x23, 0xda, 0x55, 0x38, 0x3c, 0x54,
+		0xf4, 0xc8, 0xfd, 0x70, 0x52, 0x9e, 0x5f, 0x72, 0xa3, 0xdf, 0x28, 0x57, 0x4a, 0xd5, 0x65, 0xdc,
+		0x26, 0xf9, 0x34, 0x01, 0x84, 0x0c, 0xf2, 0x56, 0x4a, 0xf9, 0x54, 0x23, 0xda, 0x0a, 0xe4, 0xc2,
+		0x02, 0x44, 0x8e, 0xc3, 0xd1, 0xcd, 0x8d, 0x8b, 0x4f, 0x1b, 0x57, 0x8b, 0xab, 0x2b, 0xcb, 0xc5,
+		0xbe, 0x0d, 0x11, 0x80, 0x90, 0x22, 0x35, 0x82, 0x0d, 0x45, 0xe9, 0x52, 0xa3, 0x5a, 0x2c, 0xa9,
+		0xa8, 0x8a, 0x56, 0x83, 0xe9, 0x3e, 0x51, 0x20, 0xf7, 0x40, 0x5e, 0xec, 0x50, 0x86, 0xb5, 0x6a,
+		0x16, 0xfa, 0x85, 0x83, 0xef, 0xd5, 0x96, 0xcb, 0xab, 0x2b, 0x6b, 0x2b, 0x1b, 0xac, 0x7d, 0x97,
+		0x01, 0xfc, 0x31, 0xc6, 0x35, 0xeb, 0x4a, 0xad, 0x5a, 0x31, 0x2e, 0xe2, 0x46, 0x6f, 0x23, 0x40,
+		0x2a, 0x05, 0x7c, 0x4c, 0x55, 0x05, 0xf7, 0x23, 0x83, 0x03, 0xaf, 0x46, 0x4e, 0x27, 0x70, 0xc5,
+		0xfa, 0x70, 0xe5, 0x74, 0x22, 0xf9, 0xe1, 0x8a, 0xfa, 0x51, 0xfc, 0xff, 0xd1, 0x8a, 0xfa, 0x3d,
+		0x95, 0x2b, 0x89, 0xe4, 0xab, 0x53, 0xea, 0x6b, 0x53, 0xda, 0x5f, 0x44, 0x81, 0xf8, 0x92, 0xe5,
+		0xf9, 0x3c, 0x5e, 0x84, 0xa4, 0xe7, 0x44, 0xe1, 0x51, 0x9a, 0xef, 0xd8, 0x47, 0x20, 0x25, 0x5a,
+		0x20, 0xab, 0xcf, 0xa9, 0xe2, 0x51, 0xc3, 0x1d, 0x73, 0xbb, 0x69, 0x35, 0xdb, 0xbd, 0xb6, 0x21,
+		0x3d, 0x0b, 0x63, 0x77, 0xcc, 0x02, 0x41, 0xa4, 0x19, 0x09, 0xf3, 0x56, 0x88, 0x44, 0x7c, 0x2c,
+		0x09, 0x8e, 0x20, 0xd2, 0x85, 0xbf, 0x55, 0x20, 0x3f, 0xaa, 0xb1, 0x77, 0xe5, 0xf4, 0xa8, 0xc0,
+		0x9c, 0x7d, 0x83, 0x76, 0xbb, 0xcd, 0x06, 0x3b, 0xc7, 0xf0, 0x4c, 0xa1, 0xd8, 0x78, 0x53, 0x68,
+		0x36, 0x80, 0x28, 0xb2, 0x1d, 0xb2, 0x84, 0x2b, 0xd6, 0x2d, 0x54, 0xd6, 0x92, 0x52, 0x7c, 0x3c,
+		0xa5, 0x2c, 0x43, 0x91, 0x34, 0xae, 0xa0, 0x80, 0xe2, 0xee, 0x23, 0xa2, 0x46, 0x7d, 0x7b, 0x4b,
+		0xfb, 0x54, 0x04, 0x72, 0xe1, 0xb0, 0x48, 0xb2, 0x0c, 0xc9, 0x96, 0x2d, 0x42, 0x8e, 0xf8, 0x68,
+		0x9f, 0x1a, 0x13, 0x49, 0xb9, 0xb0, 0x2a, 0xe0, 0x75, 0x0f, 0xb3, 0xf0, 0x6f, 0x15, 0x48, 0xca,
+		0x6c, 0x72, 0x04, 0x62, 0x1d, 0xd3, 0xdd, 0x65, 0xe4, 0xe2, 0x4b, 0x11, 0x55, 0xd1, 0x59, 0x1a,
+		0xf3, 0x9d, 0x8e, 0xc9, 0xc3, 0xad, 0x44, 0x3e, 0xa6, 0xd1, 0xe6, 0x69, 0x51, 0xb3, 0xc1, 0x4e,
+		0xe0, 0xec, 0x76, 0x9b, 0x5a, 0xae, 0x23, 0x6d, 0x1e, 0x91, 0x5f, 0x12, 0xd9, 0x18, 0x9d, 0xeb,
+		0x76, 0xcd, 0x66, 0x2b, 0x04, 0x1b, 0x63, 0xb0, 0xaa, 0x2c, 0xf0, 0x80, 0x17, 0xe1, 0x98, 0xa4,
+		0xdb, 0xa0, 0xae, 0x59, 0xdf, 0xa5, 0x0d, 0x1f, 0x29, 0xc1, 0x4e, 0xda, 0x8f, 0x0a, 0x80, 0x65,
+		0x51, 0x2e, 0x71, 0xb5, 0xcf, 0x45, 0x60, 0x46, 0x9e, 0x19, 0x36, 0x3c, 0x66, 0xad, 0x01, 0x98,
+		0x96, 0x65, 0xbb, 0x41, 0x76, 0x0d, 0x9a, 0x79, 0x03, 0x78, 0x0b, 0x45, 0x0f, 0x49, 0x0f, 0x10,
+		0x28, 0xfc, 0x99, 0x02, 0xe0, 0x17, 0x8d, 0xe4, 0xdb, 0x49, 0x48, 0x8b, 0xa0, 0x57, 0x16, 0x39,
+		0xcd, 0x5d, 0x6b, 0xc0, 0xb3, 0xf0, 0x74, 0x11, 0xbd, 0x6e, 0x5b, 0x74, 0xa7, 0x69, 0x89, 0x28,
+		0x26, 0x9e, 0x90, 0xc1, 0x00, 0x31, 0x3f, 0xca, 0x4f, 0x87, 0xa4, 0x43, 0xdb, 0xa6, 0xe5, 0x36,
+		0xeb, 0x62, 0xd6, 0x9c, 0x3f, 0x50, 0xe3, 0x17, 0x6a, 0x02, 0x5b, 0xf7, 0xe8, 0x68, 0xa7, 0x20,
+		0x29, 0x73, 0x3d, 0xfd, 0x78, 0x88, 0x4c, 0x41, 0xb4, 0x56, 0xc6, 0x15, 0x82, 0xa9, 0xa9, 0x95,
+		0x62, 0x4d, 0x8d, 0x9c, 0xfe, 0x54, 0x04, 0xa6, 0xe4, 0x34, 0x9e, 0x85, 0xe9, 0xf2, 0xf2, 0x4a,
+		0x9f, 0xaa, 0x9d, 0x85, 0x9c, 0xcc, 0xe4, 0xfa, 0x4c, 0xfd, 0xe0, 0x54, 0x30, 0x73, 0x5d, 0xaf,
+		0x6e, 0x54, 0xcf, 0xaa, 0x5f, 0x1a, 0xcc, 0x7c, 0x42, 0x7d, 0x75, 0x8a, 0xcc, 0x40, 0x46, 0x66,
+		0x9e, 0x7d, 0xec, 0xec, 0x13, 0xea, 0x6b, 0xfd, 0x59, 0x4f, 0xaa, 0x7f, 0xca, 0xbc, 0x3a, 0x32,
+		0xeb, 0x71, 0x63, 0x03, 0xf5, 0x65, 0xb5, 0xb2, 0x7a, 0x4d, 0x55, 0x82, 0x05, 0x67, 0x03, 0x05,
+		0x11, 0x72, 0x2f, 0x1c, 0x95, 0x05, 0x17, 0x2e, 0x5c, 0xb8, 0xf0, 0x54, 0xa0, 0xf0, 0xce, 0x47,
+		0x12, 0xfd, 0xc5, 0x4f, 0x07, 0x8a, 0x7f, 0x78, 0xb0, 0xf8, 0x42, 0xa0, 0xf8, 0x47, 0x3e, 0x92,
+		0x20, 0xb3, 0x90, 0x96, 0xc5, 0x6b, 0xc5, 0x17, 0xd5, 0xaf, 0x7f, 0xfd, 0xeb, 0x5f, 0x9f, 0x5a,
+		0x7a, 0x1f, 0xcc, 0xd6, 0xed, 0x76, 0xff, 0xd0, 0x2c, 0xa9, 0x7d, 0x21, 0x09, 0xce, 0x65, 0xe5,
+		0xa5, 0x47, 0x05, 0xd0, 0x8e, 0xdd, 0x32, 0xad, 0x9d, 0x05, 0xbb, 0xbb, 0xe3, 0x47, 0xe8, 0xa3,
+		0x79, 0xe9, 0x04, 0xe2, 0xf4, 0x3b, 0x5b, 0x7f, 0xab, 0x28, 0x3f, 0x1a, 0x89, 0x5e, 0x5a, 0x5f,
+		0xfa, 0xa9, 0x48, 0xe1, 0x12, 0x47, 0x5c, 0x97, 0x03, 0xaf, 0xd3, 0xed, 0x16, 0xad, 0xe3, 0xe8,
+		0xc0, 0x9f, 0x3f, 0x02, 0x73, 0x3b, 0xf6, 0x8e, 0xcd, 0x28, 0x9d, 0xc1, 0x5f, 0xbc, 0x11, 0x24,
+		0xe5, 0xe5, 0x16, 0xc6, 0xde, 0x07, 0x58, 0xac, 0xc0, 0xac, 0x00, 0x36, 0x98, 0xb5, 0xcb, 0x4f,
+		0x4d, 0xc9, 0xbe, 0x91, 0x37, 0xf9, 0x9f, 0xfb, 0x13, 0xe6, 0xa6, 0xd0, 0x67, 0x04, 0x2a, 0x96,
+		0xf1, 0x83, 0xd5, 0x45, 0x1d, 0x0e, 0x87, 0xe8, 0xf1, 0x9d, 0x06, 0xed, 0x8e, 0xa1, 0xf8, 0xaf,
+		0x05, 0xc5, 0xd9, 0x00, 0xc5, 0x9a, 0x40, 0x5d, 0x2c, 0x41, 0xf6, 0x20, 0xb4, 0xfe, 0x8d, 0xa0,
+		0x95, 0xa1, 0x41, 0x22, 0x97, 0x60, 0x9a, 0x11, 0xa9, 0xf7, 0x1c, 0xd7, 0x6e, 0xb3, 0x6d, 0xdc,
+		0xfe, 0x64, 0x7e, 0xe3, 0x4f, 0xb8, 0x7a, 0xcb, 0x21, 0x5a, 0xc9, 0xc3, 0x5a, 0x5c, 0x04, 0x66,
+		0xb5, 0x63, 0x38, 0xe9, 0x18, 0x0a, 0xbf, 0x29, 0x1a, 0xe2, 0xc1, 0x2f, 0x5e, 0x85, 0x39, 0xfc,
+		0xcd, 0x76, 0x59, 0xc1, 0x96, 0x8c, 0x0f, 0xd3, 0xc9, 0xff, 0xde, 0x07, 0xb8, 0x06, 0x9d, 0xf5,
+		0x08, 0x04, 0xda, 0x14, 0x18, 0xc5, 0x1d, 0xea, 0xba, 0xb4, 0xeb, 0x18, 0x66, 0x6b, 0x58, 0xf3,
+		0x02, 0x71, 0x0e, 0xf9, 0x1f, 0xfc, 0x4a, 0x78, 0x14, 0x2f, 0x71, 0xcc, 0x62, 0xab, 0xb5, 0xb8,
+		0x09, 0x47, 0x87, 0x48, 0xc5, 0x04, 0x34, 0x3f, 0x26, 0x68, 0xce, 0x0d, 0x48, 0x06, 0x92, 0x5d,
+		0x07, 0x99, 0xef, 0x8d, 0xe5, 0x04, 0x34, 0x3f, 0x2e, 0x68, 0x12, 0x81, 0x2b, 0x87, 0x14, 0x29,
+		0x5e, 0x81, 0x99, 0x1b, 0xb4, 0xbb, 0x65, 0x3b, 0x22, 0xb6, 0x64, 0x02, 0x72, 0x3f, 0x24, 0xc8,
+		0x4d, 0x0b, 0x44, 0x16, 0x6c, 0x82, 0xb4, 0x2e, 0x40, 0x72, 0xdb, 0xac, 0xd3, 0x09, 0x48, 0xdc,
+		0x11, 0x24, 0xa6, 0x10, 0x1e, 0x51, 0x8b, 0x90, 0xd9, 0xb1, 0xc5, 0x46, 0x7b, 0x3c, 0xfa, 0x0f,
+		0x0b, 0xf4, 0xb4, 0xc4, 0x11, 0x24, 0x3a, 0x76, 0xa7, 0xd7, 0xc2, 0x5d, 0xf8, 0x78, 0x12, 0x3f,
+		0x22, 0x49, 0x48, 0x1c, 0x41, 0xe2, 0x00, 0x6c, 0xfd, 0x84, 0x24, 0xe1, 0x04, 0xf8, 0xf9, 0x2c,
+		0x86, 0x9c, 0xb6, 0xf6, 0x6c, 0x6b, 0x92, 0x46, 0x7c, 0x52, 0x50, 0x00, 0x81, 0x82, 0x04, 0x9e,
+		0x81, 0xd4, 0xa4, 0x03, 0xf1, 0xe3, 0x5f, 0x91, 0xd3, 0x43, 0x8e, 0xc0, 0x25, 0x98, 0x96, 0x0a,
+		0x0a, 0x0f, 0x6c, 0xc6, 0x93, 0xf8, 0x09, 0x41, 0x22, 0x17, 0x40, 0x13, 0xdd, 0x70, 0xa9, 0xe3,
+		0xee, 0xd0, 0x49, 0x88, 0x7c, 0x4a, 0x76, 0x43, 0xa0, 0x08, 0x56, 0x6e, 0x51, 0xab, 0xbe, 0x3b,
+		0x19, 0x85, 0x4f, 0x4b, 0x56, 0x4a, 0x1c, 0x24, 0x51, 0x82, 0x6c, 0xdb, 0xec, 0x3a, 0xbb, 0x66,
+		0x6b, 0xa2, 0xe1, 0xf8, 0x49, 0x41, 0x23, 0xe3, 0x21, 0x09, 0x8e, 0xf4, 0xac, 0x83, 0x90, 0xf9,
+		0x29, 0xc9, 0x91, 0x9e, 0x15, 0x22, 0xb4, 0x0e, 0x73, 0x8e, 0xcb, 0x2c, 0xdf, 0x83, 0x50, 0xfb,
+		0x47, 0x72, 0xea, 0x71, 0xdc, 0xb5, 0x20, 0xc5, 0x67, 0x20, 0xe5, 0x34, 0xdf, 0x3b, 0x11, 0x99,
+		0x9f, 0x96, 0x23, 0xcd, 0x10, 0x10, 0xf9, 0x1a, 0x1c, 0x1b, 0xba, 0x4c, 0x4c, 0x40, 0xec, 0x67,
+		0x04, 0xb1, 0x23, 0x43, 0x96, 0x0a, 0xa1, 0x12, 0x0e, 0x4a, 0xf2, 0x1f, 0x4b, 0x95, 0x40, 0xfb,
+		0x68, 0xad, 0xa3, 0xeb, 0xd3, 0x31, 0xb7, 0x0f, 0xc6, 0xb5, 0x7f, 0x22, 0xb9, 0xc6, 0x71, 0x43,
+		0x5c, 0xdb, 0x80, 0x23, 0x82, 0xe2, 0xc1, 0xc6, 0xf5, 0x67, 0xa5, 0x62, 0xe5, 0xd8, 0x9b, 0xe1,
+		0xd1, 0xfd, 0x26, 0x28, 0x78, 0xec, 0x94, 0x3e, 0x36, 0xc7, 0xc0, 0x08, 0x95, 0xf1, 0x94, 0x7f,
+		0x4e, 0x50, 0x96, 0x1a, 0xdf, 0x73, 0xd2, 0x39, 0x6b, 0x66, 0x07, 0x89, 0xbf, 0x08, 0x79, 0x49,
+		0xbc, 0x67, 0x75, 0x69, 0xdd, 0xde, 0xb1, 0x9a, 0xef, 0xa5, 0x8d, 0x09, 0x48, 0x7f, 0xa6, 0x6f,
+		0xa8, 0x36, 0x03, 0xe8, 0x48, 0x79, 0x05, 0x54, 0xcf, 0x56, 0x31, 0x9a, 0x6d, 0x76, 0x1e, 0xb1,
+		0x3f, 0xc5, 0x9f, 0x97, 0x23, 0xe5, 0xe1, 0xad, 0x30, 0xb4, 0xc5, 0x32, 0xf0, 0xe8, 0xf4, 0x49,
+		0x45, 0xf2, 0xb3, 0x82, 0x50, 0xd6, 0xc7, 0x12, 0x8a, 0xa3, 0x6e, 0xb7, 0x3b, 0x66, 0x77, 0x12,
+		0xfd, 0xf7, 0x0b, 0x52, 0x71, 0x08, 0x14, 0xa1, 0x38, 0xd0, 0xa2, 0xc3, 0xd5, 0x7e, 0x02, 0x0a,
+		0xbf, 0x28, 0x15, 0x87, 0xc4, 0x11, 0x24, 0xa4, 0xc1, 0x30, 0x01, 0x89, 0x5f, 0x92, 0x24, 0x24,
+		0x0e, 0x92, 0x78, 0xde, 0x5f, 0x68, 0xbb, 0x74, 0xa7, 0xe9, 0xb8, 0xe2, 0xfe, 0xc8, 0xfe, 0xa4,
+		0x7e, 0xf9, 0x2b, 0x61, 0x23, 0x4c, 0x0f, 0xa0, 0xa2, 0x26, 0x12, 0x8e, 0x31, 0xe6, 0xf8, 0x1d,
+		0xdf, 0xb0, 0x5f, 0x91, 0x9a, 0x28, 0x80, 0x86, 0x6d, 0x0b, 0x58, 0x88, 0xc8, 0xf6, 0x3a, 0x6e,
+		0xe9, 0x26, 0x20, 0xf7, 0x4f, 0xfb, 0x1a, 0x57, 0x93, 0xb8, 0x48, 0x33, 0x60, 0xff, 0xf4, 0xac,
+		0xeb, 0x74, 0x6f, 0x22, 0xe9, 0xfc, 0x67, 0x7d, 0xf6, 0xcf, 0x26, 0xc7, 0xe4, 0x3a, 0x64, 0xba,
+		0xcf, 0x9e, 0x22, 0xe3, 0x2e, 0x97, 0xe5, 0xbf, 0xed, 0x75, 0xd1, 0xdf, 0xb0, 0x39, 0xb5, 0xb8,
+		0x0a, 0xaa, 0xc8, 0xf1, 0x0d, 0xd8, 0xb1, 0xc4, 0x3e, 0xf0, 0xba, 0x27, 0xe7, 0x21, 0x9b, 0x67,
+		0xf1, 0x22, 0x64, 0x43, 0x06, 0xcf, 0x78, 0x52, 0x1f, 0x14, 0xa4, 0x32, 0x41, 0x7b, 0x67, 0xf1,
+		0x1c, 0xc4, 0xd0, 0x78, 0x19, 0x8f, 0xfe, 0x1d, 0x02, 0x9d, 0x81, 0x2f, 0xbe, 0x13, 0x92, 0xd2,
+		0x68, 0x19, 0x8f, 0xfa, 0x7f, 0x0b, 0x54, 0x0f, 0x05, 0xd1, 0xa5, 0xc1, 0x32, 0x1e, 0xfd, 0xff,
+		0x91, 0xe8, 0x12, 0x05, 0xd1, 0x27, 0x67, 0xe1, 0xaf, 0x7d, 0x38, 0xc6, 0xd1, 0x25, 0xca, 0x22,
+		0x46, 0xc7, 0x73, 0x4b, 0x65, 0x3c, 0xf6, 0x77, 0x8a, 0xca, 0x25, 0xc6, 0xe2, 0x53, 0x10, 0x9f,
+		0x90, 0xe1, 0x1f, 0x11, 0xa8, 0x1c, 0x7e, 0xb1, 0x04, 0xe9, 0x80, 0x75, 0x32, 0x1e, 0xfd, 0xbb,
+		0x05, 0x7a, 0x10, 0x0b, 0x9b, 0x2e, 0xac, 0x93, 0xf1, 0x04, 0xfe, 0x5f, 0xd9, 0x74, 0x81, 0x81,
+		0x6c, 0x93, 0x86, 0xc9, 0x78, 0xec, 0x8f, 0x4a, 0xae, 0x4b, 0x94, 0xc5, 0x67, 0x21, 0xe5, 0x2d,
+		0x36, 0xe3, 0xf1, 0xbf, 0x47, 0xe0, 0xfb, 0x38, 0xc8, 0x81, 0x9e, 0x75, 0x00, 0x12, 0xff, 0x9f,
+		0xe4, 0x40, 0x00, 0x0b, 0xa7, 0x51, 0xbf, 0x01, 0x33, 0x9e, 0xd2, 0xf7, 0xca, 0x69, 0xd4, 0x67,
+		0xbf, 0xe0, 0x68, 0x32, 0x9d, 0x3f, 0x9e, 0xc4, 0xf7, 0xc9, 0xd1, 0x64, 0xf0, 0xd8, 0x8c, 0x7e,
+		0x8b, 0x60, 0x3c, 0x8d, 0x1f, 0x90, 0xcd, 0xe8, 0x33, 0x08, 0x16, 0xd7, 0x81, 0x0c, 0x5a, 0x03,
+		0xe3, 0xe9, 0xbd, 0x22, 0xe8, 0xcd, 0x0c, 0x18, 0x03, 0x8b, 0x2f, 0xc0, 0x91, 0xe1, 0x96, 0xc0,
+		0x78, 0xaa, 0x3f, 0xf8, 0x7a, 0xdf, 0xde, 0x2d, 0x68, 0x08, 0x2c, 0x6e, 0xc0, 0xdc, 0x30, 0x2b,
+		0x60, 0x3c, 0xd9, 0x8f, 0xbd, 0x1e, 0x56, 0xdc, 0x41, 0x23, 0x60, 0xb1, 0x08, 0xe0, 0x2f, 0xc0,
+		0xe3, 0x69, 0xfd, 0x90, 0xa0, 0x15, 0x40, 0xc2, 0xa9, 0x21, 0xd6, 0xdf, 0xf1, 0xf8, 0x77, 0xe4,
+		0xd4, 0x10, 0x18, 0x38, 0x35, 0xe4, 0xd2, 0x3b, 0x1e, 0xfb, 0x87, 0xe5, 0xd4, 0x90, 0x28, 0x28,
+		0xd9, 0x81, 0xd5, 0x6d, 0x3c, 0x85, 0x4f, 0x4a, 0xc9, 0x0e, 0x60, 0x2d, 0x56, 0x60, 0x66, 0x60,
+		0x41, 0x1c, 0x4f, 0xea, 0x47, 0x05, 0x29, 0xb5, 0x7f, 0x3d, 0x0c, 0x2e, 0x5e, 0x62, 0x31, 0x1c,
+		0x4f, 0xed, 0xc7, 0xfa, 0x16, 0x2f, 0xb1, 0x16, 0x2e, 0x3e, 0x03, 0x49, 0xab, 0xd7, 0x6a, 0xe1,
+		0xe4, 0x21, 0xfb, 0xdf, 0x1f, 0xcc, 0xff, 0xe9, 0xd7, 0x04, 0x77, 0x24, 0xc2, 0xe2, 0x39, 0x88,
+		0xd3, 0xf6, 0x16, 0x6d, 0x8c, 0xc3, 0xfc, 0xf2, 0xd7, 0xa4, 0xc2, 0x44, 0xe8, 0xc5, 0x67, 0x01,
+		0xb8, 0x6b, 0x84, 0xc5, 0xe0, 0x8e, 0xc1, 0xfd, 0xb3, 0xaf, 0x89, 0x0b, 0x3b, 0x3e, 0x8a, 0x4f,
+		0x80, 0x5f, 0xff, 0xd9, 0x9f, 0xc0, 0x57, 0xc2, 0x04, 0xd8, 0x88, 0x5c, 0x80, 0x29, 0x3c, 0x7a,
+		0x73, 0xcd, 0x9d, 0x71, 0xd8, 0x7f, 0x2e, 0xb0, 0x25, 0x3c, 0x32, 0xac, 0x6d, 0x77, 0xa9, 0x6b,
+		0xee, 0x38, 0xe3, 0x70, 0xff, 0x8b, 0xc0, 0xf5, 0x10, 0x10, 0xb9, 0x6e, 0x3a, 0xee, 0x24, 0xfd,
+		0xfe, 0x0b, 0x89, 0x2c, 0x11, 0xb0, 0xd1, 0xf8, 0xfb, 0x3a, 0xdd, 0x1b, 0x87, 0xfb, 0x97, 0xb2,
+		0xd1, 0x02, 0x7e, 0xf1, 0x9d, 0x90, 0xc2, 0x9f, 0xfc, 0x16, 0xde, 0x18, 0xe4, 0xbf, 0x12, 0xc8,
+		0x3e, 0x06, 0xd6, 0xec, 0xb8, 0x0d, 0xb7, 0x39, 0x9e, 0xd9, 0x5f, 0x15, 0x23, 0x2d, 0xe1, 0x17,
+		0x8b, 0x90, 0x76, 0xdc, 0x46, 0xa3, 0x27, 0xec, 0xd3, 0x31, 0xe8, 0x7f, 0xfd, 0x35, 0xcf, 0x65,
+		0xe1, 0xe1, 0xe0, 0x68, 0xdf, 0xbc, 0xee, 0x76, 0x6c, 0x16, 0xb5, 0x31, 0x8e, 0xc2, 0xeb, 0x82,
+		0x42, 0x00, 0x65, 0xb1, 0x04, 0x19, 0xec, 0x8b, 0x3c, 0xfc, 0x1e, 0x47, 0xe2, 0x6f, 0x04, 0x03,
+		0x42, 0x48, 0x4b, 0xdf, 0xfa, 0x9b, 0x5f, 0x38, 0xa1, 0x7c, 0xee, 0x0b, 0x27, 0x94, 0xff, 0xfc,
+		0x85, 0x13, 0xca, 0x47, 0xbf, 0x78, 0xe2, 0xd0, 0xe7, 0xbe, 0x78, 0xe2, 0xd0, 0x1f, 0x7e, 0xf1,
+		0xc4, 0xa1, 0xe1, 0x5e, 0x62, 0xb8, 0x64, 0x5f, 0xb2, 0xb9, 0x7f, 0xf8, 0xa5, 0x07, 0x76, 0x9a,
+		0xee, 0x6e, 0x6f, 0x6b, 0xa1, 0x6e, 0xb7, 0xcf, 0xd4, 0x6d, 0xa7, 0x6d, 0x3b, 0x67, 0xc2, 0x7e,
+		0x5d, 0xf6, 0x0b, 0xfe, 0x87, 0x02, 0xc7, 0x38, 0x19, 0xdf, 0x9d, 0x6b, 0x5a, 0x7b, 0xa3, 0xde,
+		0x75, 0x39, 0x0f, 0xd1, 0xa2, 0xb5, 0x47, 0x8e, 0x71, 0x05, 0x67, 0xf4, 0xba, 0x2d, 0x71, 0x15,
+		0x6c, 0x0a, 0xd3, 0x9b, 0xdd, 0x56, 0x38, 0x0c, 0x38, 0x23, 0xc2, 0x80, 0x97, 0xbe, 0x5b, 0x39,
+		0x58, 0x4f, 0x92, 0x45, 0x6b, 0x8f, 0x75, 0x64, 0x5d, 0x79, 0xe9, 0xed, 0x63, 0xfd, 0xdc, 0xd7,
+		0x2d, 0xfb, 0xa6, 0x85, 0xcd, 0xee, 0x6c, 0x49, 0x1f, 0xf7, 0x89, 0x7e, 0x1f, 0xf7, 0x0b, 0xb4,
+		0xd5, 0x7a, 0x0e, 0xe1, 0xf0, 0x38, 0xda, 0xd9, 0x4a, 0xf0, 0x5b, 0xc7, 0xf0, 0xbd, 0x11, 0x38,
+		0x31, 0xe0, 0xce, 0x16, 0x42, 0x30, 0x8a, 0x09, 0x8b, 0x90, 0x5c, 0x96, 0xb2, 0x95, 0xc7, 0x57,
+		0x55, 0xea, 0xb6, 0xd5, 0xe0, 0xf1, 0x9c, 0x51, 0x5d, 0x26, 0x91, 0x11, 0x96, 0x69, 0xd9, 0x8e,
+		0xb8, 0x4c, 0xc9, 0x13, 0x4b, 0x1f, 0x3f, 0x20, 0x23, 0xb2, 0xb2, 0x26, 0xc9, 0x8d, 0xc7, 0x27,
+		0xe4, 0x86, 0xec, 0x44, 0xc8, 0xf3, 0x3f, 0x29, 0x57, 0x7e, 0x20, 0x02, 0x27, 0xfb, 0xb9, 0x82,
+		0x33, 0xcb, 0x71, 0xcd, 0x76, 0x67, 0x14, 0x5b, 0x9e, 0x81, 0xd4, 0x86, 0x84, 0x39, 0x30, 0x5f,
+		0xee, 0x1c, 0x90, 0x2f, 0x39, 0xaf, 0x2a, 0xc9, 0x98, 0xb3, 0x13, 0x32, 0xc6, 0xeb, 0xc7, 0x5d,
+		0x71, 0xe6, 0xbf, 0x27, 0xe0, 0x18, 0x9f, 0x4e, 0x06, 0x9f, 0x4a, 0x3c, 0x21, 0x78, 0x92, 0x09,
+		0x16, 0x8d, 0x3f, 0x27, 0xd1, 0x9e, 0x83, 0xd9, 0x15, 0xd4, 0x16, 0xb8, 0x0b, 0xf2, 0x4f, 0x78,
+		0x86, 0xde, 0x37, 0x9d, 0x0f, 0x19, 0xfc, 0xe2, 0x24, 0x30, 0x98, 0xa5, 0x7d, 0x9b, 0x02, 0x6a,
+		0xad, 0x6e, 0xb6, 0xcc, 0xee, 0x1b, 0x25, 0x45, 0x9e, 0x02, 0xe0, 0xd1, 0x42, 0xde, 0x4b, 0x31,
+		0x78, 0x1a, 0x1e, 0xec, 0xdc, 0x02, 0xaf, 0x89, 0xdd, 0xc5, 0x48, 0x31, 0x58, 0xfc, 0x79, 0xfa,
+		0x45, 0x00, 0xbf, 0x00, 0xa3, 0x2b, 0x6a, 0xa5, 0xe2, 0x6a, 0x51, 0x97, 0x61, 0x1f, 0xb5, 0xf5,
+		0x72, 0x89, 0x3f, 0xdc, 0x70, 0x08, 0x43, 0x17, 0x82, 0x85, 0x5e, 0xfc, 0xfa, 0x61, 0x98, 0x09,
+		0xe6, 0xf3, 0x5b, 0xf4, 0x11, 0xb4, 0x14, 0x9b, 0xed, 0x4e, 0x8b, 0xb2, 0x33, 0x5a, 0xa3, 0x29,
+		0xb9, 0x36, 0xde, 0x08, 0xf9, 0xad, 0x7f, 0xcf, 0x6f, 0x56, 0xcf, 0xfa, 0xe8, 0x1e, 0xcf, 0x17,
+		0x57, 0x61, 0x06, 0xef, 0x73, 0x75, 0x42, 0x24, 0xc7, 0xa8, 0x6a, 0x24, 0xc8, 0x4e, 0x9d, 0x05,
+		0xa6, 0x4f, 0xed, 0x29, 0x48, 0x38, 0xac, 0xf7, 0xe3, 0x48, 0xfc, 0xb6, 0x20, 0x21, 0xc0, 0x17,
+		0x2d, 0x98, 0xe1, 0x0f, 0x89, 0xd0, 0x40, 0x33, 0xf6, 0xf7, 0x33, 0xfc, 0xf3, 0x9f, 0x7f, 0x8c,
+		0x9d, 0x41, 0xdf, 0x17, 0x1e, 0x96, 0x21, 0xe2, 0xa4, 0xab, 0x82, 0xb6, 0xdf, 0x50, 0x0a, 0x39,
+		0x59, 0x9f, 0x68, 0xf0, 0xfe, 0x95, 0xfd, 0xaa, 0xa8, 0xec, 0xc4, 0x30, 0x19, 0x08, 0xd4, 0x94,
+		0x15, 0x54, 0x79, 0xc1, 0x52, 0x79, 0xd4, 0x9c, 0x7e, 0xe9, 0x91, 0xc1, 0xd5, 0x89, 0xff, 0x7b,
+		0x94, 0x51, 0x7e, 0x26, 0x58, 0x8d, 0x37, 0xf7, 0x3e, 0x1e, 0x83, 0x19, 0xbc, 0x9e, 0x6c, 0x9f,
+		0x61, 0x7f, 0xc5, 0x9c, 0x8b, 0xb3, 0xc4, 0x04, 0x87, 0x92, 0xe7, 0xf9, 0x54, 0x18, 0x2f, 0x31,
+		0x7f, 0xf5, 0x5d, 0x3f, 0x11, 0xf7, 0xa7, 0xcb, 0xe2, 0xda, 0x60, 0xa4, 0xd8, 0x78, 0x1a, 0x5f,
+		0x95, 0x34, 0xfa, 0x03, 0xbf, 0x16, 0xdf, 0x81, 0x27, 0x7d, 0x82, 0xcc, 0x38, 0xcb, 0x44, 0x12,
+		0xf1, 0x30, 0xd0, 0x2e, 0xe1, 0x33, 0x73, 0x12, 0x2b, 0xf4, 0x75, 0x89, 0xcf, 0x67, 0x28, 0xc6,
+		0x59, 0x2e, 0x5e, 0x82, 0x5c, 0xc3, 0xb6, 0x5c, 0xc3, 0x6e, 0x37, 0x5d, 0xda, 0xee, 0xb8, 0x63,
+		0xed, 0xba, 0xbf, 0xe1, 0x44, 0x92, 0x7a, 0x16, 0xf1, 0xaa, 0x12, 0x0d, 0x5b, 0xc2, 0xdf, 0x11,
+		0x99, 0xa4, 0x25, 0xff, 0xd5, 0x6b, 0x09, 0xc3, 0xc1, 0x96, 0xdc, 0x95, 0x74, 0x38, 0x8d, 0xeb,
+		0x42, 0x99, 0xbb, 0xb7, 0xb8, 0x14, 0x78, 0xd2, 0xf1, 0xa9, 0x28, 0x9c, 0x10, 0xc0, 0x5b, 0xa6,
+		0x43, 0xcf, 0xdc, 0x78, 0x7c, 0x8b, 0xba, 0xe6, 0xe3, 0x67, 0xea, 0x76, 0x53, 0xae, 0xe4, 0xb3,
+		0xbc, 0x7c, 0x01, 0xcb, 0x17, 0x44, 0x79, 0x61, 0xe8, 0x71, 0x77, 0x61, 0xb4, 0x92, 0x2f, 0x0c,
+		0xca, 0xa0, 0xd6, 0x82, 0x58, 0xc9, 0x6e, 0xb2, 0x3b, 0x50, 0x0d, 0x6a, 0xd9, 0x6d, 0xa1, 0x6e,
+		0x79, 0x82, 0x5c, 0x86, 0x84, 0xd9, 0xb6, 0x7b, 0x96, 0xcb, 0x55, 0xed, 0xd2, 0x63, 0xbf, 0xf9,
+		0xf9, 0x93, 0x87, 0xfe, 0xe3, 0xe7, 0x4f, 0x1e, 0xe6, 0x64, 0x9d, 0xc6, 0xf5, 0x85, 0xa6, 0x7d,
+		0xa6, 0x6d, 0xba, 0xbb, 0x38, 0x7d, 0x7f, 0xff, 0xb3, 0x8f, 0x82, 0xa8, 0x6f, 0xc5, 0x72, 0x3f,
+		0xfd, 0xea, 0xcf, 0x9e, 0x56, 0x74, 0x81, 0xbf, 0x18, 0x7b, 0xed, 0x13, 0x27, 0x15, 0xad, 0x03,
+		0x53, 0xcb, 0xb4, 0xbe, 0x4f, 0x85, 0x2b, 0x7d, 0x15, 0x3e, 0x2e, 0x2a, 0x3c, 0x3e, 0x58, 0x21,
+		0xbf, 0x5b, 0xb1, 0x4c, 0xeb, 0x81, 0x6a, 0x97, 0x69, 0x3d, 0x5c, 0xe3, 0xd2, 0xf2, 0x1f, 0xfe,
+		0xf1, 0x89, 0x43, 0xef, 0xff, 0xc2, 0x89, 0x43, 0x23, 0x87, 0x4c, 0x1b, 0x3f, 0x64, 0xde, 0x48,
+		0xfd, 0x44, 0x0c, 0xee, 0x65, 0x2f, 0xd6, 0x74, 0xdb, 0x4d, 0xcb, 0x3d, 0x53, 0xef, 0xee, 0x75,
+		0x5c, 0x1b, 0x27, 0xae, 0xbd, 0x2d, 0x06, 0x6a, 0xc6, 0x2f, 0x5e, 0xe0, 0xc5, 0xc3, 0x87, 0x49,
+		0xdb, 0x86, 0xf8, 0x3a, 0xe2, 0x21, 0x23, 0x5c, 0xdb, 0x35, 0x5b, 0xc2, 0xda, 0xe0, 0x09, 0xcc,
+		0xe5, 0xaf, 0xdc, 0x44, 0x78, 0x6e, 0x53, 0x3e, 0x70, 0xd3, 0xa2, 0xe6, 0x36, 0x7f, 0x2c, 0x20,
+		0xca, 0xcc, 0xd4, 0x24, 0x66, 0xb0, 0x77, 0x01, 0xe6, 0x20, 0x6e, 0xf6, 0x78, 0x68, 0x51, 0x14,
+		0xed, 0x57, 0x96, 0xd0, 0x9e, 0x83, 0x29, 0x71, 0x6e, 0x8e, 0xb1, 0x35, 0xd7, 0xe9, 0x1e, 0xab,
+		0x27, 0xa3, 0xe3, 0x4f, 0xb2, 0x00, 0x71, 0xd6, 0x78, 0x71, 0x47, 0x23, 0xbf, 0x30, 0xd0, 0xfa,
+		0x05, 0xd6, 0x48, 0x9d, 0x83, 0x69, 0x57, 0x20, 0xb9, 0x6c, 0xa3, 0xf4, 0x84, 0xa9, 0xa5, 0x38,
+		0x35, 0xd6, 0xe6, 0x4e, 0xcf, 0x95, 0xf7, 0xe8, 0x58, 0x02, 0x6f, 0xa5, 0xf2, 0xc7, 0x23, 0x44,
+		0x78, 0x94, 0x48, 0x69, 0x25, 0x98, 0x62, 0xb4, 0xab, 0x1d, 0xef, 0x45, 0x26, 0x25, 0xf0, 0x22,
+		0x93, 0x20, 0x1f, 0xf1, 0x1b, 0x4b, 0x20, 0x86, 0x37, 0xcb, 0x45, 0xbf, 0xd9, 0x6f, 0xed, 0x5d,
+		0x90, 0x14, 0x44, 0x1c, 0x72, 0x16, 0xa2, 0x76, 0x47, 0x46, 0xff, 0x15, 0x46, 0x75, 0xa5, 0xda,
+		0x59, 0x8a, 0xa1, 0x50, 0xe9, 0x08, 0xbc, 0x54, 0x19, 0x29, 0x16, 0x4f, 0x86, 0xc4, 0xa2, 0x4d,
+		0xdd, 0xad, 0x6d, 0xd7, 0xff, 0xc1, 0x87, 0x73, 0x40, 0x14, 0x3c, 0x41, 0xb9, 0x13, 0x81, 0x13,
+		0x81, 0xd2, 0x1b, 0xb4, 0x8b, 0x8e, 0x23, 0x2e, 0x4d, 0x42, 0x52, 0x48, 0xa0, 0x81, 0xa2, 0x7c,
+		0x84, 0xa8, 0xbc, 0x13, 0xa2, 0xc5, 0x4e, 0x07, 0xdf, 0x4f, 0x62, 0xe9, 0xba, 0xcd, 0x65, 0x25,
+		0xa6, 0x7b, 0x69, 0x2c, 0x73, 0xec, 0x6d, 0xf7, 0xa6, 0xd9, 0xf5, 0xde, 0x56, 0x92, 0x69, 0xed,
+		0x02, 0xa4, 0x4a, 0xb6, 0xe5, 0x50, 0xcb, 0xe9, 0x31, 0x1b, 0x76, 0xab, 0x65, 0xd7, 0xaf, 0x0b,
+		0x0a, 0x3c, 0x81, 0xcc, 0x36, 0x3b, 0x1d, 0x86, 0x19, 0xd3, 0xf1, 0xa7, 0x98, 0x3d, 0xd5, 0x91,
+		0xec, 0x39, 0x77, 0x30, 0xf6, 0x88, 0x0e, 0xfa, 0xc6, 0xa8, 0x02, 0xf7, 0x0c, 0x4e, 0xa4, 0xeb,
+		0x74, 0xcf, 0x39, 0xe8, 0x3c, 0x7a, 0x11, 0x52, 0xeb, 0xec, 0x85, 0xcb, 0xe7, 0xe8, 0x1e, 0x29,
+		0x60, 0x20, 0xe4, 0xd9, 0x73, 0xe7, 0x1e, 0xbf, 0xc0, 0xa5, 0xfc, 0xf2, 0x21, 0x5d, 0x66, 0x90,
+		0x13, 0x90, 0x72, 0x68, 0xbd, 0x73, 0xf6, 0xdc, 0xf9, 0xeb, 0x8f, 0x73, 0xb1, 0xba, 0x7c, 0x48,
+		0xf7, 0xb3, 0x16, 0x93, 0xd8, 0xe3, 0xd7, 0x3e, 0x79, 0x52, 0x59, 0x8a, 0x43, 0xd4, 0xe9, 0xb5,
+		0xbf, 0x61, 0xb2, 0xf1, 0x97, 0x09, 0x98, 0x0f, 0x94, 0xf2, 0x45, 0x41, 0x04, 0x93, 0x7b, 0xef,
+		0x92, 0xaa, 0x81, 0xfe, 0x33, 0x88, 0x11, 0xda, 0x7e, 0x5f, 0x2e, 0x6a, 0x9f, 0x51, 0x20, 0x73,
+		0x55, 0x52, 0xc6, 0x8b, 0x05, 0xcf, 0x00, 0x78, 0x35, 0xc9, 0xa9, 0x72, 0x7c, 0xa1, 0xbf, 0xae,
+		0x05, 0x0f, 0x47, 0x0f, 0x80, 0xe3, 0x8d, 0x99, 0x4e, 0xd7, 0xee, 0xd8, 0x8e, 0x78, 0x67, 0x67,
+		0x0c, 0xaa, 0x07, 0x8c, 0xd7, 0x38, 0x98, 0x56, 0x33, 0x6e, 0xd8, 0x2e, 0x86, 0x84, 0x74, 0xec,
+		0x9b, 0xe2, 0xf5, 0xb2, 0xa8, 0xae, 0xb2, 0x92, 0xab, 0xac, 0x60, 0x1d, 0xf3, 0xb1, 0xd1, 0x29,
+		0x8f, 0x0a, 0x6e, 0xc7, 0xcc, 0x46, 0xa3, 0x4b, 0x1d, 0x47, 0x28, 0x2e, 0x99, 0xc4, 0xc7, 0x7d,
+		0x3a, 0xbd, 0x2d, 0x43, 0x6a, 0x09, 0x7c, 0x1e, 0x69, 0xc8, 0x9c, 0x97, 0xb2, 0x21, 0x66, 0x7d,
+		0xa2, 0xd3, 0xdb, 0x42, 0x49, 0xb9, 0x0f, 0x32, 0x43, 0x1a, 0x93, 0xbe, 0xe1, 0xb7, 0x83, 0x3d,
+		0xaa, 0x2a, 0x7a, 0x60, 0x74, 0xba, 0x4d, 0xbb, 0xdb, 0x74, 0xf7, 0x58, 0x60, 0x62, 0x54, 0x57,
+		0x65, 0xc1, 0xba, 0xc8, 0xd7, 0xae, 0xc3, 0x74, 0x8d, 0x99, 0xe9, 0x7e, 0xcb, 0xcf, 0xf9, 0xed,
+		0x53, 0xc6, 0xb7, 0x6f, 0x64, 0xcb, 0x22, 0x03, 0x2d, 0x3b, 0xfd, 0x07, 0x0a, 0xa4, 0x97, 0x70,
+		0xe2, 0xae, 0x2c, 0x5f, 0x6c, 0x99, 0x3b, 0xe4, 0x71, 0x38, 0xbc, 0xb4, 0x5a, 0x2d, 0x3d, 0x67,
+		0xac, 0x2c, 0x1b, 0x17, 0x57, 0x8b, 0x81, 0xe0, 0xed, 0xc2, 0x91, 0xdb, 0x77, 0xe6, 0x49, 0x00,
+		0x76, 0xd3, 0x62, 0x7b, 0x49, 0x72, 0x06, 0xe6, 0xc2, 0x28, 0xc5, 0xa5, 0x1a, 0x5e, 0x19, 0x52,
+		0x0a, 0x87, 0x6f, 0xdf, 0x99, 0x9f, 0x09, 0x60, 0x14, 0xb7, 0x1c, 0x6a, 0xb9, 0x83, 0x08, 0xa5,
+		0xea, 0xda, 0x1a, 0x46, 0xd0, 0x0f, 0x20, 0x60, 0x38, 0x6a, 0xd3, 0x25, 0x0f, 0xc3, 0x4c, 0x18,
+		0xa1, 0xb2, 0xb2, 0xaa, 0x46, 0x0b, 0xe4, 0xf6, 0x9d, 0xf9, 0x5c, 0x00, 0xba, 0xd2, 0x6c, 0x15,
+		0x92, 0x1f, 0xfa, 0xb1, 0x13, 0x87, 0x3e, 0xfd, 0xff, 0x9f, 0x50, 0x96, 0xd6, 0x46, 0xce, 0xb9,
+		0x27, 0x0e, 0x36, 0xe7, 0xc2, 0xeb, 0xf6, 0xd7, 0xee, 0x81, 0x7b, 0xfa, 0x0b, 0x43, 0xca, 0x78,
+		0xd2, 0xe9, 0x36, 0xce, 0xb7, 0x50, 0xd8, 0xdf, 0x3c, 0x28, 0x8c, 0x59, 0x14, 0x0a, 0x63, 0x15,
+		0x83, 0x76, 0x01, 0xb2, 0x78, 0xa9, 0xa8, 0x46, 0xdd, 0xcb, 0xd4, 0x6c, 0xd0, 0x6e, 0xd8, 0x7e,
+		0xc8, 0x4a, 0xfb, 0x81, 0x40, 0x8c, 0x19, 0x09, 0x7c, 0xfd, 0x64, 0xbf, 0xb5, 0x5d, 0x88, 0x21,
+		0xaa, 0x6f, 0x5b, 0x08, 0x0c, 0x96, 0xc0, 0xdc, 0xad, 0x3d, 0x57, 0xdc, 0xd7, 0xcc, 0xe8, 0x3c,
+		0x41, 0x9e, 0x94, 0x16, 0x42, 0x74, 0x7f, 0x0b, 0x41, 0x4c, 0x2f, 0x61, 0x27, 0xb4, 0x60, 0x4a,
+		0x0c, 0xb1, 0xd7, 0x10, 0xc5, 0x6f, 0x08, 0x59, 0x83, 0xe9, 0x8e, 0xd9, 0x75, 0xd9, 0xeb, 0x26,
+		0xbb, 0xac, 0x17, 0x62, 0x06, 0x9f, 0x1c, 0xd4, 0x27, 0xa1, 0xce, 0x8a, 0x5a, 0xb2, 0x9d, 0x60,
+		0xa6, 0xf6, 0xa5, 0x18, 0x24, 0x04, 0x33, 0xde, 0x09, 0x53, 0x82, 0xad, 0x62, 0xce, 0xdd, 0xbb,
+		0x30, 0xb8, 0xcc, 0x2e, 0x78, 0xcb, 0xa1, 0xa0, 0x27, 0x71, 0xc8, 0x83, 0x90, 0xac, 0xef, 0x9a,
+		0x4d, 0xcb, 0x68, 0x36, 0x84, 0x01, 0x9a, 0xfe, 0xc2, 0xe7, 0x4f, 0x4e, 0x95, 0x30, 0x6f, 0x65,
+		0x59, 0x9f, 0x62, 0x85, 0x2b, 0x0d, 0xb4, 0x69, 0x76, 0x69, 0x73, 0x67, 0xd7, 0x15, 0x7a, 0x43,
+		0xa4, 0xf0, 0x9d, 0x68, 0x14, 0x08, 0x11, 0x44, 0x5f, 0x18, 0xd8, 0x59, 0x78, 0xae, 0x9f, 0xa5,
+		0x24, 0x56, 0xfc, 0xd1, 0xff, 0x74, 0x52, 0xd1, 0x19, 0x06, 0x29, 0x41, 0xb6, 0x65, 0x3a, 0xae,
+		0xc1, 0xd6, 0x63, 0xac, 0x9e, 0x47, 0xcf, 0x1f, 0x1b, 0x64, 0x88, 0x60, 0xac, 0x68, 0x7a, 0x1a,
+		0xb1, 0x78, 0x56, 0x03, 0xdf, 0xfb, 0x61, 0x44, 0xea, 0x6c, 0x06, 0x72, 0x2b, 0x31, 0xc1, 0xf8,
+		0x9e, 0xc3, 0x7c, 0x3e, 0x31, 0x99, 0xad, 0x78, 0x1c, 0x52, 0xec, 0x09, 0x1f, 0x06, 0xc2, 0x2f,
+		0xc1, 0x25, 0x31, 0x83, 0x15, 0x3e, 0x04, 0xd3, 0xbe, 0xd6, 0xe7, 0x20, 0x49, 0x4e, 0xc5, 0xcf,
+		0x66, 0x80, 0x8f, 0xc1, 0x9c, 0x45, 0x6f, 0xb9, 0x46, 0x3f, 0x74, 0x8a, 0x41, 0x13, 0x2c, 0xbb,
+		0x1a, 0xc6, 0x78, 0x00, 0x72, 0x75, 0xc9, 0x7c, 0x0e, 0x0b, 0x0c, 0x36, 0xeb, 0xe5, 0x32, 0xb0,
+		0x63, 0x90, 0x34, 0x3b, 0x1d, 0x0e, 0x90, 0x16, 0x5a, 0xbf, 0xd3, 0x61, 0x45, 0xa7, 0x61, 0x86,
+		0xf5, 0xb1, 0x4b, 0x1d, 0xbc, 0x9e, 0xc1, 0x61, 0x32, 0x0c, 0x66, 0x1a, 0x0b, 0x74, 0x9e, 0xcf,
+		0x60, 0xef, 0x87, 0x2c, 0xbd, 0x81, 0x37, 0x10, 0xeb, 0x94, 0xc3, 0x65, 0x19, 0x5c, 0x46, 0x66,
+		0x32, 0xa0, 0x87, 0xc1, 0xd3, 0xe6, 0x86, 0x5c, 0x69, 0x72, 0x9c, 0x9e, 0xcc, 0x2f, 0xf2, 0x6c,
+		0x2d, 0x0f, 0xb1, 0x65, 0xd3, 0x35, 0xd1, 0x5c, 0x72, 0x6f, 0xf1, 0xe5, 0x33, 0xa3, 0xe3, 0x4f,
+		0xed, 0x97, 0xa2, 0x10, 0xbb, 0x6a, 0xbb, 0x94, 0x3c, 0x11, 0x30, 0x65, 0x73, 0xc3, 0xe4, 0xb9,
+		0xd6, 0xdc, 0xb1, 0x68, 0x63, 0xcd, 0xd9, 0x09, 0x3c, 0x9b, 0xe9, 0x8b, 0x53, 0x24, 0x24, 0x4e,
+		0x73, 0x10, 0xef, 0xda, 0x3d, 0xab, 0x21, 0x43, 0xe4, 0x59, 0x82, 0x94, 0x21, 0xe9, 0x49, 0x49,
+		0x6c, 0x9c, 0x94, 0x4c, 0xa3, 0x94, 0xa0, 0x0c, 0x8b, 0x0c, 0x7d, 0x6a, 0x4b, 0x08, 0xcb, 0x12,
+		0xa4, 0x3c, 0xe5, 0x95, 0x8f, 0x1f, 0x40, 0x60, 0x7d, 0x34, 0x5c, 0x22, 0xbd, 0xb1, 0xf7, 0x98,
+		0xc7, 0x25, 0x4e, 0xf5, 0x0a, 0x04, 0xf7, 0x42, 0x62, 0x25, 0x9e, 0xf0, 0x9c, 0x62, 0xfd, 0xf2,
+		0xc5, 0x8a, 0x3f, 0xe3, 0x79, 0x0f, 0x46, 0xd2, 0xed, 0x58, 0xec, 0xfa, 0x87, 0x90, 0x3c, 0x3f,
+		0x03, 0x4b, 0xfd, 0xab, 0x94, 0x5c, 0xd2, 0xfc, 0x0c, 0x7c, 0x10, 0xc3, 0x4b, 0x18, 0x3e, 0x15,
+		0x2e, 0x65, 0xc4, 0x2b, 0xaa, 0xc9, 0x12, 0xed, 0xd7, 0x14, 0x48, 0x88, 0x15, 0xcb, 0x1f, 0x06,
+		0x65, 0xf8, 0x30, 0x44, 0x46, 0x0d, 0x43, 0xf4, 0xee, 0x87, 0xa1, 0x08, 0xe0, 0x35, 0xd3, 0x11,
+		0x0f, 0x35, 0x0e, 0x31, 0xab, 0x78, 0x13, 0x6b, 0xcd, 0x1d, 0x31, 0xef, 0x03, 0x48, 0xda, 0x1f,
+		0x29, 0x90, 0xf2, 0xca, 0x49, 0x11, 0xb2, 0xb2, 0x5d, 0xc6, 0x76, 0xcb, 0xdc, 0x11, 0xa2, 0x78,
+		0xef, 0xc8, 0xc6, 0xe1, 0x2a, 0xac, 0xa7, 0x45, 0x7b, 0x30, 0x31, 0x7c, 0x58, 0x23, 0x23, 0x86,
+		0x35, 0x24, 0x47, 0xd1, 0xbb, 0x93, 0xa3, 0xd0, 0x88, 0xc7, 0xfa, 0x46, 0x5c, 0xfb, 0x63, 0x45,
+		0x3c, 0x11, 0xdd, 0xa0, 0x8d, 0xff, 0x95, 0x43, 0xf5, 0x92, 0x90, 0xad, 0x06, 0x6d, 0x18, 0x03,
+		0x63, 0x76, 0xff, 0x20, 0xc5, 0x70, 0x9b, 0xfd, 0xb1, 0x23, 0x92, 0x4a, 0xcd, 0x1f, 0xc3, 0xcf,
+		0x46, 0x60, 0x66, 0x00, 0xfe, 0xef, 0xdf, 0x58, 0x86, 0x67, 0x6f, 0x7c, 0xc2, 0xd9, 0x9b, 0x18,
+		0x39, 0x7b, 0x7f, 0x3e, 0xc2, 0x1c, 0x00, 0x1d, 0xdb, 0x31, 0x5b, 0x7f, 0x17, 0xba, 0xf7, 0x38,
+		0xa4, 0x3a, 0x76, 0xcb, 0xe0, 0x25, 0xfc, 0x92, 0x52, 0xb2, 0x63, 0xb7, 0xf4, 0x01, 0x31, 0x8b,
+		0xbf, 0x49, 0x8a, 0x39, 0xf1, 0x26, 0x0c, 0xc2, 0x54, 0xff, 0x84, 0xea, 0x42, 0x86, 0xb3, 0x42,
+		0x58, 0x4d, 0x8f, 0x21, 0x0f, 0xf0, 0x57, 0x5e, 0x19, 0xb4, 0xf2, 0x78, 0xb3, 0x39, 0xa4, 0x9e,
+		0xd8, 0xf5, 0x30, 0xb8, 0x91, 0x91, 0x8f, 0x8c, 0xc2, 0xe0, 0x52, 0xac, 0x0b, 0x38, 0xed, 0xfb,
+		0x15, 0x80, 0x55, 0xe4, 0x2c, 0xeb, 0x2f, 0xda, 0x3b, 0x0e, 0x6b, 0x82, 0x11, 0xaa, 0xf9, 0xc4,
+		0xa8, 0x41, 0x13, 0xf5, 0x67, 0x9c, 0x60, 0xbb, 0x4b, 0x90, 0xf5, 0x65, 0xdb, 0xa1, 0xb2, 0x31,
+		0x27, 0xf6, 0xd9, 0x95, 0xe2, 0xad, 0xc3, 0xcc, 0x8d, 0x40, 0x4a, 0xfb, 0x97, 0x0a, 0xa4, 0x58,
+		0x9b, 0xf0, 0xe9, 0xc2, 0xd0, 0x18, 0x2a, 0x77, 0x3f, 0x86, 0xf7, 0x02, 0x70, 0x32, 0x18, 0xc1,
+		0x22, 0x24, 0x2b, 0xc5, 0x72, 0x30, 0x2e, 0x85, 0x9c, 0xf7, 0x18, 0x1e, 0xdd, 0x9f, 0xe1, 0x72,
+		0xd7, 0x2a, 0xd8, 0x7e, 0x14, 0xa6, 0xd8, 0x15, 0xfc, 0x5b, 0x8e, 0xd8, 0x88, 0xe2, 0x43, 0xb7,
+		0x1b, 0xb7, 0x1c, 0xed, 0x65, 0x98, 0xda, 0xb8, 0xc5, 0xfd, 0x89, 0xc7, 0x21, 0xd5, 0xb5, 0x6d,
+		0x61, 0xfd, 0x71, 0xab, 0x3b, 0x89, 0x19, 0xcc, 0xd8, 0x91, 0x3e, 0xb4, 0x88, 0xef, 0x43, 0xf3,
+		0x9d, 0x80, 0xd1, 0x89, 0x9c, 0x80, 0xb8, 0xfb, 0xcc, 0x86, 0x66, 0x12, 0x79, 0x3b, 0x1c, 0xad,
+		0xad, 0x5c, 0xaa, 0x94, 0x97, 0x8d, 0xb5, 0xda, 0xa5, 0xbe, 0x8b, 0xd2, 0x85, 0xe9, 0xdb, 0x77,
+		0xe6, 0xd3, 0x62, 0xdb, 0x39, 0x0a, 0x7a, 0x5d, 0x2f, 0x5f, 0xad, 0x6e, 0x94, 0x55, 0x85, 0x43,
+		0xaf, 0x77, 0xe9, 0x0d, 0xdb, 0xe5, 0xdf, 0x0f, 0x79, 0x0c, 0x8e, 0x0d, 0x81, 0xf6, 0x36, 0x9f,
+		0x33, 0xb7, 0xef, 0xcc, 0x67, 0xd7, 0xbb, 0x94, 0x4b, 0x19, 0xc3, 0x58, 0x80, 0xfc, 0x20, 0x46,
+		0x75, 0xbd, 0x5a, 0x2b, 0xae, 0xaa, 0xf3, 0x05, 0xf5, 0xf6, 0x9d, 0xf9, 0x8c, 0x54, 0x19, 0x08,
+		0xff, 0x8d, 0xdf, 0x7d, 0x7e, 0x67, 0x12, 0xee, 0xed, 0x2f, 0x3c, 0xd3, 0x31, 0xbb, 0x66, 0xfb,
+		0xa0, 0xdb, 0xcf, 0x31, 0x07, 0xfe, 0xda, 0x67, 0x22, 0x30, 0xed, 0x6d, 0x6e, 0xd6, 0x59, 0x0d,
+		0xe4, 0x89, 0xa0, 0xc7, 0x2f, 0x3d, 0x72, 0xed, 0xe0, 0xd0, 0xd2, 0x21, 0x88, 0x47, 0x3d, 0xc2,
+		0x48, 0x16, 0x93, 0x6a, 0x7e, 0xc8, 0xfa, 0x26, 0x20, 0x04, 0xaa, 0x87, 0x41, 0x9e, 0x85, 0x94,
+		0x37, 0xc5, 0xbc, 0x87, 0x97, 0x47, 0xcf, 0x49, 0x81, 0xef, 0xe3, 0xe0, 0x5b, 0xc8, 0x72, 0x1b,
+		0x17, 0x1b, 0xb5, 0x31, 0xbc, 0xca, 0x01, 0x04, 0xb2, 0x84, 0x27, 0x8f, 0x41, 0xcc, 0xdc, 0xaa,
+		0x37, 0xf3, 0xf1, 0x41, 0x97, 0x0b, 0xc7, 0x2b, 0x2e, 0x95, 0x56, 0x04, 0x12, 0x83, 0xd4, 0x56,
+		0x84, 0x33, 0x45, 0xf0, 0x8b, 0x3d, 0x65, 0x79, 0xcb, 0xe0, 0x7b, 0x61, 0x6e, 0x5d, 0x24, 0xdb,
+		0xe6, 0xad, 0x25, 0x4c, 0xe3, 0x04, 0xc4, 0xc2, 0x1d, 0xf1, 0xa6, 0x54, 0x54, 0x4f, 0xb4, 0xcd,
+		0x5b, 0x97, 0x4c, 0xbc, 0xba, 0x1c, 0x55, 0x63, 0xda, 0x4f, 0xa2, 0xa5, 0x12, 0xe2, 0x0a, 0x79,
+		0x04, 0x08, 0x62, 0xe0, 0x19, 0x1c, 0x4e, 0x5d, 0xc6, 0x5e, 0x49, 0x17, 0x6f, 0x85, 0x17, 0x77,
+		0x68, 0xa5, 0xd7, 0x66, 0x0d, 0x70, 0x08, 0x1e, 0xd8, 0x09, 0x60, 0x39, 0xb2, 0x82, 0xfd, 0xc7,
+		0x06, 0xbf, 0xc4, 0x21, 0x00, 0xf8, 0x02, 0xf0, 0x0a, 0x2e, 0x00, 0x39, 0x4e, 0x4f, 0x96, 0x84,
+		0xbb, 0x12, 0x0d, 0x77, 0x45, 0x7b, 0x16, 0xa6, 0xfb, 0x46, 0x80, 0x68, 0x90, 0x15, 0x1e, 0x2b,
+		0x76, 0x7c, 0xce, 0x77, 0x38, 0x29, 0x3d, 0xcd, 0x3d, 0x53, 0x2c, 0x9c, 0x60, 0x31, 0xf9, 0xcb,
+		0xe8, 0x26, 0xc5, 0xc3, 0x9c, 0x47, 0x20, 0x1b, 0x1a, 0x03, 0xe9, 0x45, 0x56, 0x7c, 0x2f, 0xb2,
+		0x0f, 0xfc, 0x12, 0x64, 0x50, 0x01, 0xd1, 0x86, 0x80, 0x7d, 0x10, 0xa6, 0xb9, 0x82, 0xec, 0xe7,
+		0x35, 0x37, 0x78, 0xd6, 0x24, 0xc3, 0x35, 0xc8, 0xfa, 0x70, 0x3e, 0xdb, 0xd3, 0x12, 0xea, 0x92,
+		0xe9, 0x68, 0x55, 0x00, 0x7f, 0x50, 0x49, 0x11, 0xee, 0x45, 0xe5, 0x11, 0x0c, 0xf7, 0x14, 0x6f,
+		0xf5, 0x86, 0x2c, 0xc6, 0x02, 0x02, 0xf9, 0x21, 0x9d, 0xfc, 0xc5, 0xde, 0xcb, 0x0c, 0x62, 0xe9,
+		0xf9, 0x4f, 0x7f, 0xe1, 0x84, 0xf2, 0x8d, 0xd1, 0x05, 0xff, 0xe1, 0x1a, 0x1c, 0x0f, 0x14, 0xa2,
+		0x00, 0x86, 0x1c, 0x51, 0xd3, 0x7e, 0xe1, 0x02, 0x16, 0x8e, 0x73, 0x28, 0xed, 0xeb, 0xff, 0x2d,
+		0xec, 0xaf, 0x76, 0xc6, 0x7b, 0x9b, 0xc6, 0xfb, 0xbb, 0x86, 0x3b, 0xe5, 0xff, 0x45, 0x0a, 0xa6,
+		0x74, 0xfa, 0x9e, 0x1e, 0x75, 0xf0, 0x71, 0x82, 0x18, 0xad, 0xef, 0xda, 0xc3, 0x7c, 0xa0, 0xd8,
+		0xc1, 0x05, 0x01, 0x57, 0xae, 0xef, 0xda, 0x97, 0x0f, 0xe9, 0x0c, 0x96, 0x9c, 0x83, 0xf8, 0x76,
+		0xab, 0x27, 0xdc, 0x57, 0x7d, 0x3a, 0x2b, 0x88, 0x74, 0x11, 0x81, 0x2e, 0x1f, 0xd2, 0x39, 0x34,
+		0x56, 0xc5, 0x3e, 0xae, 0x14, 0xdd, 0xbf, 0x2a, 0xbc, 0x20, 0x8e, 0x55, 0x21, 0x2c, 0x59, 0x02,
+		0x68, 0x5a, 0x4d, 0xd7, 0x60, 0xae, 0x9d, 0x7c, 0x7c, 0x50, 0x59, 0x85, 0x31, 0x9b, 0x2e, 0x73,
+		0x06, 0xe1, 0xd1, 0x41, 0x53, 0x26, 0xb0, 0xb9, 0xef, 0xe9, 0xd1, 0xee, 0x5e, 0x3e, 0xb1, 0x7f,
+		0x73, 0x9f, 0x47, 0x20, 0x6c, 0x2e, 0x83, 0x46, 0x25, 0xcb, 0x1f, 0xef, 0x76, 0x6f, 0xe5, 0x93,
+		0x83, 0x6a, 0x2e, 0x88, 0xc9, 0x5e, 0xf0, 0xde, 0xb8, 0x85, 0xe7, 0x19, 0x75, 0xfe, 0x93, 0x3c,
+		0xed, 0x99, 0x60, 0xe9, 0x41, 0xab, 0x27, 0x84, 0xcb, 0xdd, 0x3e, 0x87, 0xa4, 0x29, 0x46, 0x2a,
+		0x90, 0x6b, 0x35, 0x1d, 0xd7, 0x70, 0x2c, 0xb3, 0xe3, 0xec, 0xda, 0xae, 0x7c, 0x3d, 0xf7, 0x81,
+		0x51, 0x14, 0x56, 0x9b, 0x8e, 0x5b, 0x93, 0xc0, 0x97, 0x0f, 0xe9, 0xd9, 0x56, 0x30, 0x03, 0xe9,
+		0xd9, 0xdb, 0xdb, 0xb4, 0xeb, 0x11, 0xcc, 0x67, 0xf7, 0xa7, 0x57, 0x45, 0x68, 0x89, 0x8f, 0xf4,
+		0xec, 0x60, 0x06, 0xf9, 0x26, 0x98, 0x6d, 0xd9, 0x66, 0xc3, 0x23, 0x67, 0xd4, 0x77, 0x7b, 0xd6,
+		0x75, 0xe6, 0x94, 0xc1, 0xcf, 0x28, 0x8c, 0x6a, 0xa4, 0x6d, 0x36, 0x24, 0x89, 0x12, 0x22, 0x5c,
+		0x3e, 0xa4, 0xcf, 0xb4, 0xfa, 0x33, 0xc9, 0xbb, 0x61, 0xce, 0xec, 0x74, 0x5a, 0x7b, 0xfd, 0xd4,
+		0xa7, 0x19, 0xf5, 0xd3, 0xa3, 0xa8, 0x17, 0x11, 0xa7, 0x9f, 0x3c, 0x31, 0x07, 0x72, 0xc9, 0x06,
+		0xba, 0x93, 0x28, 0xbb, 0x31, 0xd5, 0x11, 0x56, 0x06, 0x7b, 0x9a, 0x16, 0xbf, 0x5a, 0x36, 0x82,
+		0xf6, 0x3a, 0x87, 0x97, 0x46, 0xc9, 0xe5, 0x43, 0xe8, 0x79, 0x0a, 0x65, 0x71, 0xaa, 0x76, 0x9d,
+		0xb2, 0x77, 0xbc, 0x05, 0xd5, 0x99, 0x71, 0x54, 0x19, 0x7c, 0x98, 0x6a, 0x28, 0x8b, 0x94, 0x21,
+		0xcd, 0xb7, 0xa2, 0x78, 0x30, 0x43, 0xd9, 0xfb, 0xe8, 0xe9, 0xb3, 0xda, 0xc8, 0x19, 0xca, 0x40,
+		0xd1, 0xcb, 0x75, 0xf9, 0x90, 0x0e, 0xd4, 0x4b, 0x11, 0x13, 0x0e, 0xb3, 0xb7, 0x7d, 0xf7, 0x8c,
+		0xb0, 0xe2, 0xcd, 0xcf, 0x32, 0x82, 0x8f, 0x8c, 0x22, 0xc8, 0xbe, 0x99, 0xb3, 0x77, 0x35, 0xa8,
+		0x87, 0x2f, 0x1f, 0xd2, 0x67, 0x6f, 0x0c, 0x66, 0xa3, 0x88, 0x6d, 0x37, 0x2d, 0xb3, 0x85, 0xef,
+		0xd6, 0x73, 0x6b, 0x66, 0x6e, 0x7f, 0x11, 0xbb, 0x28, 0xa0, 0xd9, 0xc2, 0x8a, 0x22, 0xb6, 0x1d,
+		0xcc, 0x58, 0x9a, 0x12, 0xb1, 0x9e, 0xde, 0x9b, 0x97, 0x53, 0x6a, 0x92, 0xbf, 0xcc, 0x7c, 0x25,
+		0x96, 0x04, 0x35, 0xad, 0x3d, 0x04, 0xe9, 0x80, 0x62, 0xc2, 0x53, 0x28, 0x11, 0x0d, 0x23, 0xa3,
+		0x46, 0x45, 0x52, 0xcb, 0x41, 0x26, 0xa8, 0x8c, 0xb4, 0x8f, 0x2a, 0x90, 0x0e, 0xe8, 0x19, 0xc4,
+		0x0c, 0x7a, 0xa4, 0x53, 0xbe, 0xa5, 0x72, 0xbf, 0x5c, 0xda, 0x64, 0x39, 0x3f, 0x7e, 0xcd, 0xb0,
+		0x4c, 0xb1, 0xb2, 0xe2, 0x33, 0x1a, 0x9d, 0xb3, 0x1d, 0x0f, 0x24, 0xca, 0x40, 0xa0, 0x73, 0xb6,
+		0x23, 0x01, 0xee, 0x83, 0x0c, 0xf6, 0xdb, 0x08, 0xda, 0x4b, 0x29, 0x3d, 0x8d, 0x79, 0x02, 0x44,
+		0xfb, 0x9d, 0x08, 0xa8, 0xfd, 0x0a, 0xcc, 0x73, 0x55, 0x2b, 0x07, 0x76, 0x55, 0x1f, 0xeb, 0x77,
+		0x92, 0xfb, 0x7e, 0xf1, 0x55, 0x50, 0x7d, 0xf7, 0x2e, 0x5f, 0x6a, 0x46, 0xdb, 0x7f, 0x7d, 0x86,
+		0xaa, 0x3e, 0x5d, 0x0f, 0x67, 0x90, 0x8b, 0xa1, 0xc3, 0x4a, 0xf9, 0xf5, 0xc1, 0xfe, 0x01, 0xf7,
+		0x8c, 0x98, 0xcd, 0x4e, 0xc3, 0x74, 0xa9, 0xf4, 0x8f, 0xf9, 0x98, 0x68, 0x6b, 0xa0, 0x37, 0xd9,
+		0x71, 0x4d, 0x97, 0x0a, 0x5b, 0x83, 0x7b, 0x1e, 0xb2, 0x66, 0xa7, 0xc3, 0x3e, 0xd6, 0xc4, 0x6d,
+		0x8d, 0x07, 0x20, 0x87, 0x3a, 0xbd, 0x69, 0xb6, 0xa4, 0xa9, 0x90, 0xe0, 0x26, 0x89, 0xc8, 0xe5,
+		0xd6, 0x81, 0xd6, 0x80, 0x4c, 0x50, 0x9f, 0x7b, 0x7b, 0x2a, 0x25, 0xb0, 0xa7, 0x22, 0xe2, 0x55,
+		0x14, 0xce, 0x1f, 0xf9, 0x92, 0xcc, 0xf0, 0x43, 0x83, 0x39, 0xb6, 0xff, 0xba, 0xc1, 0x9d, 0x25,
+		0x49, 0x9d, 0x27, 0x34, 0x1d, 0x72, 0x61, 0xdd, 0x4f, 0x72, 0x10, 0x71, 0x6f, 0x89, 0x5a, 0x22,
+		0xee, 0x2d, 0xb4, 0x74, 0x03, 0x6f, 0xb1, 0x0d, 0xae, 0x76, 0x02, 0xcf, 0xf7, 0x75, 0x68, 0xd3,
+		0x90, 0x95, 0x34, 0xf9, 0xa6, 0xfc, 0x08, 0xcc, 0x0d, 0x53, 0xf1, 0xda, 0x2e, 0xcc, 0x0d, 0x53,
+		0xd5, 0xe4, 0x1c, 0x24, 0x3d, 0x1d, 0x3f, 0x64, 0x77, 0xcc, 0xaa, 0x95, 0xc0, 0xba, 0x07, 0x1a,
+		0x72, 0xe7, 0x47, 0x42, 0xee, 0x7c, 0xed, 0x5b, 0x21, 0x3f, 0x4a, 0x7f, 0xf7, 0x39, 0xf9, 0x62,
+		0x1e, 0xc3, 0x8e, 0x40, 0x42, 0x3c, 0x3d, 0x16, 0x61, 0x07, 0x58, 0x22, 0x85, 0x8c, 0xe4, 0xba,
+		0x3c, 0xca, 0xb2, 0x79, 0x42, 0x33, 0xe0, 0xd8, 0x48, 0x1d, 0x3e, 0xfa, 0x28, 0x8c, 0x13, 0x12,
+		0x47, 0x61, 0x75, 0xd9, 0x1c, 0x87, 0xf5, 0x55, 0x06, 0xb2, 0xf0, 0x94, 0xf6, 0x4a, 0x14, 0x8e,
+		0x0c, 0xd7, 0xe4, 0x64, 0x1e, 0x32, 0x68, 0xb7, 0xba, 0x61, 0x13, 0x17, 0xda, 0xe6, 0xad, 0x0d,
+		0x61, 0xdf, 0x8a, 0xa3, 0x84, 0x88, 0x77, 0x94, 0x40, 0x36, 0x61, 0xa6, 0x65, 0xd7, 0xcd, 0x96,
+		0x11, 0x38, 0xca, 0x11, 0x93, 0xe8, 0xfe, 0x01, 0x66, 0x87, 0x5d, 0x86, 0xa8, 0x70, 0x84, 0xfc,
+		0x4f, 0x33, 0x1a, 0xab, 0xde, 0xa9, 0x0f, 0x59, 0x86, 0x74, 0xbb, 0xe9, 0x6c, 0xd1, 0x5d, 0xf3,
+		0x46, 0xd3, 0xee, 0x8a, 0xd9, 0x34, 0x28, 0x34, 0x6b, 0x3e, 0x8c, 0x3c, 0x61, 0x0a, 0xa0, 0x05,
+		0x86, 0x24, 0x3e, 0xf4, 0xe0, 0x2b, 0x71, 0x60, 0x6d, 0x32, 0xea, 0x0c, 0x69, 0x6a, 0xe4, 0x19,
+		0xd2, 0xb0, 0x03, 0x9b, 0xe4, 0xf0, 0x03, 0x9b, 0x0f, 0x05, 0x87, 0x26, 0xbc, 0xf6, 0x0d, 0x9c,
+		0xe1, 0x90, 0x1a, 0xcc, 0x09, 0xfc, 0x46, 0x88, 0xf7, 0x43, 0x42, 0x1d, 0xf8, 0xfc, 0xea, 0xe7,
+		0x39, 0x91, 0xe8, 0xa3, 0xd9, 0x1e, 0xbd, 0x3b, 0xb6, 0xcb, 0x43, 0xd4, 0x58, 0xe0, 0x10, 0xf5,
+		0x7f, 0xb3, 0xa1, 0xf8, 0x40, 0x14, 0x66, 0x06, 0x0c, 0x89, 0xa1, 0xa7, 0xc3, 0xa3, 0x3c, 0xb2,
+		0xb2, 0x63, 0xd1, 0x03, 0x77, 0x4c, 0x8c, 0x75, 0x6c, 0xfc, 0x58, 0xc7, 0xdf, 0xc4, 0xb1, 0x4e,
+		0xdc, 0xdd, 0x58, 0x7f, 0x43, 0x47, 0xe1, 0x87, 0x14, 0x28, 0x8c, 0xb6, 0xbe, 0x86, 0x0e, 0xc7,
+		0x81, 0x0e, 0x10, 0x46, 0xad, 0x71, 0x0f, 0x40, 0xae, 0xcf, 0x36, 0xe4, 0xa2, 0x9c, 0x0d, 0xed,
+		0xc2, 0xb5, 0x0f, 0x46, 0x61, 0x6e, 0x98, 0x01, 0x37, 0x64, 0xb6, 0x3e, 0x8f, 0x2f, 0xdf, 0xd7,
+		0x9b, 0x8d, 0xbb, 0x9d, 0xac, 0x33, 0x02, 0xfb, 0xff, 0xcc, 0xd5, 0x41, 0x29, 0xf9, 0x3e, 0x80,
+		0xa4, 0x4e, 0x9d, 0x0e, 0xda, 0x63, 0x78, 0x1c, 0x41, 0x6f, 0xd5, 0x69, 0xc7, 0xf5, 0x83, 0x2a,
+		0x86, 0x6d, 0x11, 0x38, 0x74, 0x59, 0x42, 0xe2, 0x06, 0xd9, 0x43, 0xc3, 0x93, 0x19, 0xe6, 0x03,
+		0x18, 0xbd, 0x9d, 0x17, 0xe8, 0x41, 0x27, 0xc0, 0x79, 0xe9, 0x04, 0x88, 0x8e, 0xdc, 0xdf, 0x72,
+		0xac, 0x3e, 0x2f, 0xc0, 0x13, 0xc2, 0x0b, 0x10, 0x1b, 0x53, 0x59, 0xc8, 0x0d, 0x50, 0x0a, 0xb9,
+		0x01, 0x12, 0x63, 0xba, 0x39, 0xc2, 0x0f, 0x70, 0x5e, 0xfa, 0x01, 0xa6, 0xc6, 0xb4, 0xb8, 0xcf,
+		0x11, 0xf0, 0xce, 0x80, 0x23, 0x20, 0x35, 0xe8, 0x6d, 0x0d, 0xa1, 0x0e, 0xf1, 0x04, 0x5c, 0xf0,
+		0x3c, 0x01, 0x99, 0x91, 0x5e, 0x04, 0x81, 0xdc, 0xef, 0x0a, 0xa8, 0x0e, 0xb8, 0x02, 0xb2, 0xe2,
+		0x4b, 0xba, 0xa3, 0x48, 0x8c, 0xf1, 0x05, 0x54, 0x07, 0x7c, 0x01, 0xb9, 0x31, 0x04, 0xc7, 0x38,
+		0x03, 0xbe, 0x79, 0xb8, 0x33, 0x60, 0xf4, 0x76, 0x5d, 0x34, 0x73, 0x32, 0x6f, 0x80, 0x31, 0xc2,
+		0x1b, 0xa0, 0x8e, 0xdc, 0xb9, 0x72, 0xf2, 0x13, 0xbb, 0x03, 0x36, 0x87, 0xb8, 0x03, 0xf8, 0xc6,
+		0xfd, 0xd4, 0x48, 0xe2, 0x13, 0xf8, 0x03, 0x36, 0x87, 0xf8, 0x03, 0xc8, 0x58, 0xb2, 0x63, 0x1d,
+		0x02, 0x17, 0xc3, 0x0e, 0x81, 0xd9, 0x11, 0x56, 0xa7, 0x3f, 0xdb, 0x47, 0x78, 0x04, 0xb6, 0x46,
+		0x79, 0x04, 0xe6, 0xc4, 0x0b, 0xfd, 0xa3, 0x28, 0x1e, 0xc0, 0x25, 0x50, 0x1d, 0x70, 0x09, 0x1c,
+		0x1e, 0x23, 0x69, 0x93, 0xfb, 0x04, 0xf8, 0x37, 0x9b, 0xf8, 0xd7, 0x9a, 0x40, 0x4d, 0x5f, 0x89,
+		0x25, 0xd3, 0x6a, 0x46, 0x7b, 0x18, 0x66, 0x24, 0x29, 0x4f, 0xcf, 0xe1, 0x5e, 0x81, 0x76, 0xbb,
+		0x76, 0x57, 0xde, 0x63, 0x60, 0x09, 0xed, 0x14, 0x64, 0x3c, 0xd0, 0xfd, 0xfd, 0x07, 0x6c, 0x4f,
+		0x16, 0xd0, 0x63, 0xda, 0x2f, 0x2b, 0x90, 0x09, 0xaa, 0xa8, 0xd0, 0xfe, 0x32, 0x25, 0xf6, 0x97,
+		0x01, 0xaf, 0x42, 0x24, 0xec, 0x55, 0xc0, 0x2f, 0x5f, 0x74, 0x06, 0x1c, 0x06, 0x66, 0xc7, 0x73,
+		0x18, 0xc8, 0x00, 0x2a, 0xee, 0x7b, 0x10, 0xcb, 0x12, 0x3f, 0x4d, 0x9c, 0xf6, 0x82, 0xc9, 0xf8,
+		0x56, 0x97, 0x3c, 0x0a, 0xb3, 0x01, 0x58, 0x6f, 0x0f, 0xc7, 0x77, 0xcf, 0xaa, 0x07, 0x5d, 0x14,
+		0x9b, 0xb9, 0x7f, 0xa5, 0xc0, 0xcc, 0x80, 0x8a, 0x1c, 0xea, 0x14, 0x50, 0xde, 0x24, 0xa7, 0x40,
+		0xe4, 0xae, 0x9d, 0x02, 0xc1, 0x3d, 0x69, 0x34, 0xbc, 0x27, 0xfd, 0x6f, 0x0a, 0x64, 0x43, 0x9a,
+		0x1a, 0x87, 0xa0, 0x6e, 0x37, 0xa8, 0xd8, 0x25, 0xb2, 0xdf, 0x68, 0x92, 0xb4, 0x6c, 0xf9, 0xa9,
+		0x1d, 0xfc, 0x89, 0x50, 0xde, 0xc2, 0x93, 0x12, 0xeb, 0x8a, 0xb7, 0xc1, 0x8c, 0x07, 0xef, 0x71,
+		0x88, 0xcb, 0x0d, 0x09, 0xff, 0x72, 0x83, 0x77, 0xf9, 0x78, 0x2a, 0x70, 0xf9, 0x98, 0x3c, 0x0d,
+		0x29, 0xe6, 0xec, 0x37, 0xec, 0x0e, 0x5f, 0xac, 0xfb, 0x4c, 0x9b, 0xf0, 0xc5, 0x06, 0x87, 0x85,
+		0x5c, 0xb3, 0x5f, 0x01, 0x8b, 0x23, 0x15, 0xb2, 0x38, 0xee, 0x81, 0x14, 0xb6, 0x9e, 0x7f, 0xb6,
+		0x0f, 0xc4, 0xcd, 0x75, 0x99, 0xa1, 0xfd, 0x54, 0x04, 0xa6, 0xfb, 0x16, 0x9a, 0xa1, 0x7d, 0x1f,
+		0x76, 0x8c, 0x3c, 0x19, 0x3f, 0x4e, 0x00, 0xec, 0x98, 0x8e, 0x71, 0xd3, 0xb4, 0x5c, 0xf1, 0xa9,
+		0xed, 0xa8, 0x1e, 0xc8, 0xc1, 0x8b, 0x0c, 0x98, 0xea, 0x39, 0xe2, 0x63, 0xdb, 0x51, 0xdd, 0x4b,
+		0xe3, 0x6d, 0x24, 0x7a, 0x83, 0xbd, 0x83, 0xcb, 0xbf, 0xb4, 0x70, 0x64, 0x70, 0x3b, 0x8c, 0xc5,
+		0x4b, 0x79, 0x1c, 0xec, 0x2f, 0x7f, 0xfe, 0xa4, 0xca, 0xa1, 0xdf, 0xee, 0x5d, 0x18, 0xd3, 0x05,
+		0x7e, 0x98, 0x0b, 0xc9, 0x3e, 0x2e, 0x30, 0x3f, 0x60, 0x46, 0x6e, 0xef, 0x91, 0xa7, 0x3c, 0xc6,
+		0x5b, 0xcf, 0xb6, 0x69, 0xbb, 0x63, 0xdb, 0x2d, 0x83, 0xcf, 0xf1, 0x22, 0xe4, 0x3c, 0x5e, 0xf1,
+		0xd5, 0x94, 0x7d, 0x19, 0xd7, 0x45, 0xd7, 0x58, 0xc8, 0x08, 0xce, 0xf0, 0x4c, 0x3e, 0xa7, 0x82,
+		0x8f, 0x1c, 0x6b, 0xeb, 0x70, 0x58, 0x92, 0x08, 0xad, 0xab, 0xe4, 0x29, 0x48, 0xf9, 0x4b, 0x32,
+		0x0f, 0xd3, 0xdf, 0xc7, 0xd3, 0xe2, 0xc3, 0x6a, 0xbf, 0xaa, 0xc0, 0xe1, 0xa1, 0x2b, 0x2b, 0x29,
+		0x43, 0x82, 0xc7, 0x4c, 0x8a, 0xf8, 0x98, 0x47, 0x27, 0x5b, 0x91, 0x17, 0x78, 0x40, 0xa5, 0x2e,
+		0x90, 0xb5, 0x77, 0x43, 0x82, 0xe7, 0x90, 0x34, 0x4c, 0x85, 0x5e, 0x1c, 0x2f, 0x96, 0x4a, 0xe5,
+		0x75, 0xf9, 0x7c, 0xee, 0x12, 0x7b, 0xcd, 0x1b, 0xb3, 0xf5, 0xf2, 0x95, 0x72, 0x69, 0x43, 0x8d,
+		0xe2, 0xa7, 0xa1, 0xf9, 0x6f, 0xf1, 0x2e, 0xb8, 0x1a, 0x0b, 0x64, 0xd5, 0xca, 0x95, 0xe5, 0xb2,
+		0xae, 0xc6, 0xb5, 0xc7, 0xe1, 0x98, 0x6c, 0xc7, 0xa0, 0x47, 0xc8, 0x73, 0xcc, 0x28, 0x01, 0xc7,
+		0x8c, 0xf6, 0x4a, 0x04, 0x0a, 0x12, 0x67, 0x88, 0x8f, 0xe7, 0x4a, 0x5f, 0xc7, 0xcf, 0x1e, 0x60,
+		0x55, 0xef, 0xeb, 0x3d, 0xee, 0x63, 0xba, 0x74, 0x9b, 0xba, 0xf5, 0x5d, 0x6e, 0x28, 0x70, 0x0d,
+		0x94, 0xd5, 0xb3, 0x22, 0x97, 0x21, 0x39, 0x1c, 0xec, 0x65, 0x5a, 0x77, 0x0d, 0x2e, 0x44, 0x0e,
+		0xdb, 0x4c, 0xa4, 0xf4, 0x2c, 0xcf, 0xad, 0xf1, 0x4c, 0xed, 0x5b, 0x0f, 0xc4, 0xcb, 0x14, 0xc4,
+		0xf5, 0x32, 0x7e, 0xe5, 0x89, 0x7d, 0xff, 0x9a, 0xfd, 0x34, 0x6a, 0x95, 0xe2, 0x7a, 0xed, 0x72,
+		0x15, 0x79, 0x39, 0x0b, 0xd3, 0x92, 0x97, 0x32, 0x33, 0xae, 0x3d, 0x02, 0x47, 0x47, 0x58, 0x15,
+		0x43, 0x82, 0x58, 0x3f, 0xa9, 0x04, 0xa1, 0xc3, 0x96, 0x41, 0x15, 0x12, 0x8e, 0x6b, 0xba, 0x3d,
+		0x47, 0x30, 0xf1, 0xa9, 0x49, 0xcd, 0x8c, 0x05, 0xf9, 0xa3, 0xc6, 0xd0, 0x75, 0x41, 0x46, 0x3b,
+		0x07, 0xb9, 0x70, 0xc9, 0x68, 0x1e, 0xf8, 0x42, 0x14, 0xd1, 0x9e, 0x01, 0x32, 0x68, 0x7d, 0x0c,
+		0xd9, 0x5e, 0x2a, 0xc3, 0xb6, 0x97, 0x3f, 0xae, 0xc0, 0xf1, 0x7d, 0x2c, 0x0d, 0xf2, 0x7c, 0x5f,
+		0x27, 0x2f, 0x1c, 0xc4, 0x4e, 0x59, 0xe0, 0x79, 0x7d, 0xdd, 0x7c, 0x02, 0x32, 0xc1, 0xfc, 0xc9,
+		0x3a, 0xf9, 0xe5, 0x08, 0x1c, 0x1e, 0x6a, 0xb4, 0x04, 0x54, 0xa0, 0xf2, 0x06, 0x55, 0xe0, 0x3b,
+		0x00, 0xdc, 0x5b, 0x32, 0x8a, 0x5a, 0xac, 0xa3, 0xf7, 0x0e, 0xf1, 0x2f, 0xd2, 0xfa, 0xc6, 0x2d,
+		0x31, 0x09, 0x52, 0xae, 0xf8, 0x85, 0xfe, 0x93, 0x80, 0x53, 0xa0, 0xc7, 0xd6, 0x58, 0x27, 0x1f,
+		0x3d, 0xd0, 0x62, 0xac, 0xde, 0x08, 0x67, 0x3b, 0xe4, 0x1a, 0x1c, 0xed, 0x33, 0x14, 0x3c, 0xd2,
+		0xb1, 0x49, 0xed, 0x85, 0xc3, 0x61, 0x7b, 0x41, 0x92, 0x0e, 0xae, 0xf6, 0xf1, 0xf0, 0x6a, 0x7f,
+		0x0d, 0xc0, 0x77, 0x0e, 0xf8, 0x61, 0x7f, 0x4a, 0x30, 0xec, 0xef, 0x1c, 0xc4, 0x51, 0x92, 0x24,
+		0x9f, 0x06, 0x55, 0x31, 0x4a, 0x42, 0xc0, 0xb9, 0xc0, 0xa1, 0xb5, 0x26, 0x90, 0x41, 0x07, 0xed,
+		0x88, 0x2a, 0xde, 0x19, 0xae, 0xe2, 0xbe, 0x91, 0xae, 0xde, 0xe1, 0x55, 0xbd, 0x17, 0xe2, 0x6c,
+		0xe4, 0x87, 0xde, 0xa5, 0xfc, 0x16, 0x00, 0xd3, 0x75, 0xbb, 0xcd, 0xad, 0x9e, 0x5f, 0xc1, 0xc9,
+		0xe1, 0x92, 0x53, 0x94, 0x70, 0x4b, 0xf7, 0x08, 0x11, 0x9a, 0xf3, 0x51, 0x03, 0x62, 0x14, 0x20,
+		0xa8, 0x55, 0x20, 0x17, 0xc6, 0x1d, 0x7e, 0x37, 0x74, 0xf0, 0x1b, 0x8b, 0xbe, 0x75, 0x24, 0x1e,
+		0x3c, 0x62, 0x09, 0xed, 0xfd, 0x11, 0xc8, 0x04, 0x05, 0xef, 0x1f, 0x9e, 0x09, 0xa2, 0x7d, 0x87,
+		0x02, 0x49, 0xaf, 0xfb, 0xfb, 0x04, 0x3b, 0xfb, 0x77, 0x84, 0xbd, 0xc3, 0x0b, 0x7e, 0x4c, 0x14,
+		0xf5, 0x8e, 0x89, 0x9e, 0xf1, 0x96, 0xbf, 0x51, 0x0e, 0x91, 0x20, 0xaf, 0x65, 0xc0, 0xa1, 0x58,
+		0xed, 0x9f, 0x99, 0xec, 0x2a, 0x1e, 0x1e, 0x61, 0x05, 0xae, 0xd1, 0xf1, 0x84, 0xd6, 0x08, 0x44,
+		0x18, 0xf1, 0x89, 0x18, 0xbc, 0xb3, 0xa7, 0x1c, 0xf8, 0xce, 0x9e, 0x57, 0x4b, 0x24, 0x58, 0xcb,
+		0xf7, 0x2a, 0x90, 0x94, 0x73, 0x82, 0xbc, 0x2b, 0x18, 0x79, 0x26, 0x8f, 0x36, 0x47, 0xea, 0x23,
+		0x41, 0xdf, 0x47, 0x19, 0x0c, 0xb8, 0x8e, 0x1e, 0x34, 0xe0, 0x5a, 0x58, 0x76, 0x7f, 0xad, 0x80,
+		0xda, 0x3f, 0x63, 0xdf, 0x70, 0xeb, 0x06, 0x97, 0xb9, 0xe8, 0x90, 0x65, 0x6e, 0x54, 0x14, 0x75,
+		0x6c, 0x54, 0x14, 0xf5, 0x60, 0xaf, 0xe3, 0x77, 0xd9, 0xeb, 0x0f, 0x44, 0x20, 0x1d, 0x70, 0x8f,
+		0x92, 0x27, 0x43, 0x11, 0xd9, 0xf3, 0xfb, 0xb9, 0x52, 0x03, 0x21, 0xd9, 0x21, 0x36, 0x45, 0x0e,
+		0xce, 0xa6, 0x37, 0xff, 0x76, 0xd6, 0xf0, 0x0b, 0xac, 0xf1, 0x11, 0x17, 0x58, 0xbf, 0x5d, 0x81,
+		0xa4, 0x67, 0x76, 0x1f, 0xf4, 0x10, 0xf3, 0x08, 0x24, 0x84, 0x65, 0xc9, 0x4f, 0x31, 0x45, 0x6a,
+		0xa8, 0x5b, 0xb9, 0x00, 0x49, 0xf9, 0x31, 0x76, 0xb1, 0xaa, 0x79, 0xe9, 0xd3, 0x17, 0x20, 0x1d,
+		0x38, 0x00, 0x46, 0xd5, 0x58, 0x29, 0xbf, 0xa0, 0x1e, 0x2a, 0x4c, 0xdd, 0xbe, 0x33, 0x1f, 0xad,
+		0xd0, 0x9b, 0x38, 0x9b, 0xf5, 0x72, 0xe9, 0x72, 0xb9, 0xf4, 0x9c, 0xaa, 0x14, 0xd2, 0xb7, 0xef,
+		0xcc, 0x4f, 0xe9, 0x94, 0x79, 0x14, 0x4f, 0x3f, 0x07, 0xd3, 0x7d, 0x03, 0x13, 0x36, 0x5b, 0x08,
+		0xe4, 0x96, 0x37, 0xf1, 0x03, 0x4a, 0xc5, 0x8d, 0xb2, 0xc1, 0xe3, 0x76, 0xf1, 0x43, 0x14, 0xab,
+		0x2b, 0x97, 0x2e, 0x6f, 0x18, 0xa5, 0xd5, 0x95, 0x72, 0x65, 0xc3, 0x28, 0x6e, 0x6c, 0x14, 0x4b,
+		0xcf, 0xa9, 0x91, 0xb3, 0x77, 0xd2, 0x10, 0xc3, 0xe8, 0x3c, 0x52, 0x82, 0x18, 0x73, 0x85, 0xec,
+		0x1b, 0x01, 0x56, 0xd8, 0xdf, 0x37, 0x4c, 0x2e, 0x42, 0x9c, 0x79, 0x49, 0xc8, 0xfe, 0x21, 0x61,
+		0x85, 0x31, 0xce, 0x62, 0x6c, 0x0c, 0x9b, 0x91, 0xfb, 0xc6, 0x88, 0x15, 0xf6, 0xf7, 0x1d, 0x93,
+		0x55, 0x98, 0x92, 0x9b, 0xe4, 0x71, 0x81, 0x5b, 0x85, 0xb1, 0x0e, 0x5d, 0xec, 0x1a, 0x77, 0x36,
+		0xec, 0x1f, 0x3e, 0x56, 0x18, 0xe3, 0x55, 0xc6, 0x27, 0x34, 0xc4, 0x76, 0x74, 0x4c, 0x44, 0x58,
+		0x61, 0x9c, 0x9f, 0x98, 0xe8, 0x90, 0xf2, 0xdd, 0x38, 0xe3, 0x83, 0xe2, 0x0a, 0x13, 0x38, 0xcc,
+		0xc9, 0xbb, 0x21, 0x1b, 0xde, 0xea, 0x4e, 0x16, 0x75, 0x56, 0x98, 0xd0, 0x23, 0x8d, 0xf4, 0xc3,
+		0xfb, 0xde, 0xc9, 0xa2, 0xd0, 0x0a, 0x13, 0x3a, 0xa8, 0xc9, 0xcb, 0x30, 0x33, 0xb8, 0x2f, 0x9d,
+		0x3c, 0x28, 0xad, 0x70, 0x00, 0x97, 0x35, 0x69, 0x03, 0x19, 0xb2, 0x9f, 0x3d, 0x40, 0x8c, 0x5a,
+		0xe1, 0x20, 0x1e, 0x6c, 0xd2, 0x80, 0xe9, 0xfe, 0x4d, 0xe2, 0xa4, 0x31, 0x6b, 0x85, 0x89, 0xbd,
+		0xd9, 0xbc, 0x96, 0xf0, 0xe6, 0x72, 0xd2, 0x18, 0xb6, 0xc2, 0xc4, 0xce, 0x6d, 0xb2, 0x09, 0x10,
+		0xd8, 0x1f, 0x4e, 0x10, 0xd3, 0x56, 0x98, 0xc4, 0xcd, 0x4d, 0x3a, 0x30, 0x3b, 0x6c, 0xe3, 0x78,
+		0x90, 0x10, 0xb7, 0xc2, 0x81, 0xbc, 0xdf, 0x28, 0xcf, 0xe1, 0x2d, 0xe0, 0x64, 0x21, 0x6f, 0x85,
+		0x09, 0xdd, 0xe0, 0x4b, 0xc5, 0x91, 0x71, 0xce, 0x0f, 0xed, 0x1b, 0xe7, 0xec, 0x47, 0x2e, 0x7b,
+		0xb1, 0xcd, 0xbf, 0xb0, 0x02, 0x6f, 0x13, 0x2f, 0xe8, 0x38, 0xae, 0x79, 0xbd, 0x69, 0xed, 0x78,
+		0x4f, 0x19, 0x89, 0xb4, 0x08, 0x72, 0x3e, 0xc2, 0xa1, 0x16, 0x64, 0xee, 0x98, 0x07, 0x8d, 0x46,
+		0xbe, 0xf2, 0x38, 0xee, 0x3e, 0xc4, 0xf8, 0xf0, 0xe5, 0x7d, 0x1e, 0x4b, 0x1a, 0xf3, 0x24, 0xd3,
+		0x90, 0xc7, 0x94, 0x0a, 0xfb, 0xbe, 0x31, 0x50, 0xd8, 0x2f, 0xee, 0x5b, 0xfb, 0x01, 0x05, 0x72,
+		0x97, 0x9b, 0x8e, 0x6b, 0x77, 0x9b, 0x75, 0xb3, 0xc5, 0x96, 0x97, 0x67, 0x26, 0xbd, 0x95, 0xb5,
+		0x94, 0x42, 0x73, 0x45, 0xbc, 0xb7, 0xc4, 0x51, 0xc8, 0x32, 0x24, 0x6e, 0x98, 0x2d, 0x7e, 0x27,
+		0x2a, 0xf8, 0xd8, 0x5a, 0x3f, 0xcf, 0x03, 0x76, 0x54, 0x90, 0x0a, 0xc7, 0x5d, 0x8c, 0xe4, 0x15,
+		0xed, 0xc3, 0x0a, 0xa8, 0x7e, 0xcb, 0x74, 0x5a, 0xb7, 0xbb, 0x0d, 0xb6, 0x15, 0xe8, 0x74, 0x02,
+		0xb1, 0x02, 0x32, 0x49, 0x9e, 0x14, 0x46, 0x56, 0x64, 0xac, 0x91, 0x15, 0x0b, 0x18, 0x58, 0x43,
+		0xae, 0x9c, 0x47, 0x87, 0x5d, 0x39, 0xd7, 0x3e, 0xa6, 0x40, 0xbe, 0xd8, 0xad, 0xef, 0x36, 0x6f,
+		0xd0, 0xc6, 0x40, 0xab, 0x82, 0x91, 0x89, 0x4a, 0x38, 0x32, 0x71, 0x54, 0x50, 0xc9, 0x73, 0xb8,
+		0x3b, 0x42, 0x64, 0x71, 0xca, 0x7c, 0x6a, 0x14, 0x9f, 0xfa, 0x2b, 0x0b, 0xb1, 0x8b, 0x93, 0xd0,
+		0xbe, 0x9f, 0x5d, 0xbf, 0x69, 0xb7, 0x9b, 0x0e, 0x4e, 0x5d, 0x9d, 0x79, 0x1e, 0xae, 0x40, 0xac,
+		0x6b, 0xba, 0x62, 0x3f, 0xbe, 0x74, 0xfe, 0xc0, 0xef, 0x59, 0xf1, 0x1a, 0x18, 0x0d, 0xf2, 0x3c,
+		0xe0, 0xf5, 0x0d, 0x83, 0xd1, 0x8b, 0xbc, 0x21, 0x7a, 0x78, 0x8b, 0x05, 0xdb, 0x47, 0xde, 0xcd,
+		0x3e, 0x4d, 0x88, 0xc7, 0xde, 0xd6, 0x0e, 0xe5, 0x94, 0xa3, 0x6f, 0x88, 0x72, 0xb6, 0x6d, 0xde,
+		0x2a, 0x31, 0x6a, 0x48, 0x5f, 0xbc, 0xfb, 0xf5, 0xeb, 0x0a, 0x80, 0xcf, 0x18, 0x62, 0xe2, 0x89,
+		0x90, 0x4c, 0xb1, 0x4a, 0xe5, 0x89, 0xd0, 0x43, 0xa3, 0xd8, 0xdf, 0xc7, 0xd6, 0xa5, 0x2c, 0x36,
+		0xef, 0x73, 0x9f, 0x3f, 0xa9, 0xf0, 0x5a, 0xa7, 0xeb, 0x03, 0x6c, 0x4f, 0x73, 0xdf, 0x91, 0x31,
+		0xa1, 0x34, 0x66, 0xa5, 0xc9, 0xcf, 0x09, 0x02, 0xc7, 0xc6, 0x72, 0xd1, 0x87, 0x4f, 0x2b, 0x90,
+		0x5e, 0x0e, 0xbc, 0x34, 0x89, 0x47, 0x7a, 0xb6, 0xd5, 0xbc, 0x4e, 0xbb, 0xde, 0x91, 0x1e, 0x4f,
+		0xa2, 0x59, 0xce, 0x3f, 0x7b, 0xec, 0xee, 0xc9, 0xc7, 0x98, 0x64, 0x1a, 0xb1, 0x6e, 0xd2, 0x2d,
+		0xa7, 0x29, 0xf9, 0xac, 0xcb, 0x24, 0xc6, 0x67, 0x38, 0xb4, 0xde, 0xc3, 0x93, 0x06, 0xfc, 0xac,
+		0xbc, 0x8b, 0x5f, 0x3f, 0xe7, 0x6e, 0x8b, 0x69, 0x99, 0x5f, 0xe2, 0xd9, 0x48, 0xa4, 0x41, 0x5d,
+		0xb3, 0xd9, 0xe2, 0x81, 0xac, 0x29, 0x5d, 0x26, 0x45, 0x53, 0x6f, 0x4f, 0x05, 0xb7, 0xed, 0x25,
+		0x50, 0xed, 0x0e, 0xed, 0x86, 0xe2, 0x77, 0xb8, 0x34, 0xe6, 0x7f, 0xff, 0xb3, 0x8f, 0xce, 0x09,
+		0x86, 0x8b, 0xd8, 0x0f, 0xfe, 0x61, 0x05, 0x7d, 0x5a, 0x62, 0x88, 0x6c, 0x72, 0x2d, 0x74, 0x88,
+		0xd7, 0xdb, 0xf2, 0x5f, 0xdd, 0x99, 0x1b, 0x60, 0x6a, 0xd1, 0xda, 0x5b, 0xca, 0xff, 0xb6, 0x4f,
+		0xda, 0xdf, 0xd6, 0xe3, 0x3b, 0x37, 0x81, 0x13, 0x3d, 0x46, 0x06, 0xa7, 0xe6, 0xcb, 0x66, 0xb3,
+		0x25, 0x3f, 0xc3, 0xaf, 0x8b, 0x14, 0x59, 0xf4, 0xbc, 0xb1, 0xfc, 0x7b, 0x9b, 0xda, 0x28, 0xd9,
+		0x58, 0xb2, 0xad, 0x46, 0xd8, 0xed, 0x4a, 0x4a, 0x90, 0x70, 0xed, 0xeb, 0xd4, 0x12, 0x0c, 0x5a,
+		0x7a, 0xe4, 0x00, 0x0f, 0xd7, 0xe9, 0x02, 0x95, 0x7c, 0x33, 0xa8, 0x0d, 0xda, 0xa2, 0x3b, 0x8c,
+		0x73, 0xce, 0xae, 0x89, 0x97, 0xbd, 0x13, 0x77, 0xfb, 0x2c, 0xdd, 0xb4, 0x47, 0xaa, 0xc6, 0x28,
+		0x91, 0xf5, 0xf0, 0x5b, 0xa6, 0x53, 0xe2, 0xac, 0x7d, 0x44, 0x1f, 0x03, 0x92, 0x17, 0xd4, 0x3c,
+		0x41, 0x12, 0x28, 0x41, 0x3d, 0x6b, 0xcb, 0xb6, 0xd8, 0xc7, 0x23, 0x85, 0xb6, 0x4b, 0xf2, 0x83,
+		0x5d, 0x2f, 0x5f, 0x1c, 0xec, 0xae, 0x43, 0xce, 0x07, 0x65, 0x33, 0x24, 0x75, 0xd0, 0x19, 0x92,
+		0xf5, 0x08, 0x20, 0x08, 0x7e, 0x65, 0xd2, 0x9f, 0x83, 0x79, 0x10, 0x01, 0x34, 0x63, 0x67, 0x73,
+		0xb0, 0x33, 0x01, 0x02, 0x78, 0x07, 0xa4, 0xdd, 0xb4, 0x0c, 0x87, 0xb6, 0xb6, 0x0d, 0xc1, 0x39,
+		0xa4, 0x9b, 0x3e, 0xf8, 0x68, 0xce, 0xb4, 0x9b, 0x56, 0x8d, 0xb6, 0xb6, 0x97, 0x3d, 0x2a, 0xe4,
+		0x1d, 0x70, 0xdc, 0xef, 0xbd, 0x6d, 0x19, 0xbb, 0x76, 0xab, 0x61, 0x74, 0xe9, 0xb6, 0x51, 0x67,
+		0x4f, 0x0f, 0x66, 0x18, 0xcf, 0x8e, 0x7a, 0x20, 0x55, 0xeb, 0xb2, 0xdd, 0x6a, 0xe8, 0x74, 0xbb,
+		0x84, 0xc5, 0x78, 0xca, 0xe7, 0x63, 0x37, 0x1b, 0x18, 0x32, 0x13, 0xc5, 0xb8, 0x7d, 0x2f, 0x73,
+		0xa5, 0xe1, 0x2c, 0x26, 0x3f, 0xf4, 0x89, 0x93, 0x87, 0x5e, 0xfb, 0xc4, 0xc9, 0x43, 0xda, 0x45,
+		0xf6, 0x04, 0x97, 0x98, 0x47, 0xd4, 0x21, 0xe7, 0x21, 0x65, 0xca, 0x04, 0xbf, 0x60, 0xb7, 0xcf,
+		0x3c, 0xf4, 0x41, 0xf1, 0x6e, 0x61, 0x62, 0xf9, 0xea, 0xba, 0xd9, 0xec, 0x92, 0x32, 0x3e, 0xba,
+		0x2a, 0x05, 0x73, 0xd2, 0x29, 0xed, 0xcb, 0xb2, 0x9c, 0xd3, 0x95, 0x51, 0x91, 0x7d, 0xa9, 0xa5,
+		0xfb, 0x7e, 0xff, 0xb3, 0x8f, 0xde, 0x2b, 0xc8, 0x5c, 0xed, 0x0b, 0xf2, 0x93, 0xf4, 0xfa, 0x83,
+		0xff, 0x02, 0x7d, 0xbe, 0x02, 0x53, 0xbc, 0xa9, 0x0e, 0x79, 0x16, 0xe2, 0x1d, 0xfc, 0x91, 0x57,
+		0x42, 0xef, 0xb0, 0x0e, 0x0a, 0x38, 0x83, 0x0f, 0x8a, 0x03, 0xc7, 0xd3, 0x3e, 0x1c, 0x01, 0x58,
+		0xbe, 0x7a, 0x75, 0xa3, 0xdb, 0xec, 0xb4, 0xa8, 0xfb, 0x66, 0xf5, 0x7d, 0x13, 0x0e, 0xfb, 0x7d,
+		0x77, 0xba, 0xf5, 0x83, 0xf7, 0x7f, 0xd6, 0xbf, 0x45, 0xde, 0xad, 0x0f, 0x25, 0xdb, 0x70, 0x5c,
+		0x8f, 0x6c, 0xf4, 0xe0, 0x64, 0x97, 0x1d, 0x77, 0x90, 0xb3, 0x2f, 0x42, 0xda, 0x67, 0x86, 0x43,
+		0x56, 0x20, 0xe9, 0x8a, 0xdf, 0x82, 0xc1, 0xda, 0x68, 0x06, 0x4b, 0xb4, 0x20, 0x93, 0x3d, 0x74,
+		0xed, 0x6f, 0x15, 0x80, 0xc0, 0x1c, 0x79, 0x6b, 0xca, 0x18, 0x7a, 0x37, 0x84, 0x26, 0x8e, 0xde,
+		0xf5, 0x03, 0xa1, 0x9c, 0x40, 0x80, 0xa9, 0x1f, 0x89, 0xc0, 0xec, 0xa6, 0x9c, 0xbd, 0x6f, 0x7d,
+		0x1e, 0x6c, 0xc2, 0x14, 0xb5, 0xdc, 0x6e, 0xd3, 0x3b, 0x72, 0x7b, 0x6c, 0xd4, 0x98, 0x0f, 0xe9,
+		0x54, 0xd9, 0x72, 0xbb, 0x7b, 0x41, 0x09, 0x90, 0xb4, 0x02, 0xfc, 0xf8, 0x78, 0x14, 0xf2, 0xa3,
+		0x50, 0xd1, 0x54, 0xaf, 0x77, 0x29, 0xcb, 0x08, 0xdf, 0xa8, 0xcd, 0xc9, 0x6c, 0xb1, 0xc6, 0xe8,
+		0x80, 0x56, 0x19, 0x0a, 0x17, 0x82, 0xde, 0x9d, 0x19, 0x96, 0xf3, 0x29, 0xb0, 0x55, 0x66, 0x03,
+		0xa6, 0xe5, 0x15, 0x9d, 0x2d, 0xb3, 0x65, 0xe2, 0xf5, 0xf4, 0xe8, 0xc1, 0x97, 0x04, 0x79, 0xcd,
+		0x67, 0x89, 0x93, 0x20, 0x65, 0x98, 0x92, 0xd4, 0x62, 0x07, 0xa7, 0x26, 0x71, 0xf1, 0x2a, 0x56,
+		0x70, 0x61, 0x60, 0xa6, 0x47, 0x4c, 0x4f, 0x7b, 0x79, 0x2b, 0x8d, 0x71, 0x2b, 0x4f, 0x62, 0xdf,
+		0x95, 0x47, 0x58, 0x77, 0x3f, 0xc2, 0x22, 0xe9, 0x1b, 0x7f, 0xff, 0x87, 0x65, 0x1d, 0x80, 0x4f,
+		0x55, 0xd4, 0xa4, 0xf9, 0xd8, 0xdd, 0xce, 0xf7, 0x14, 0x27, 0xb2, 0xec, 0xb8, 0x7f, 0x57, 0x23,
+		0xf4, 0x47, 0x11, 0xc8, 0x04, 0x47, 0xe8, 0x1f, 0xe4, 0xa2, 0x45, 0x2a, 0xbe, 0x9a, 0xe2, 0xb7,
+		0x8d, 0x1e, 0x1e, 0xa5, 0xa6, 0x06, 0xa4, 0x79, 0x8c, 0x7e, 0xfa, 0x8d, 0x14, 0x24, 0x44, 0x38,
+		0x60, 0x75, 0xc0, 0x90, 0x55, 0xc6, 0xbd, 0x97, 0x90, 0x95, 0xef, 0x25, 0x0c, 0xb5, 0x63, 0x1f,
+		0x00, 0x7c, 0x45, 0xc1, 0x08, 0xc5, 0x18, 0xe2, 0x39, 0x0c, 0xee, 0x6b, 0xbd, 0xde, 0x3b, 0x18,
+		0x66, 0x89, 0x60, 0xbe, 0x1e, 0x46, 0x18, 0xbc, 0xd8, 0x55, 0xe6, 0x39, 0xe4, 0x51, 0x20, 0xbb,
+		0x9e, 0xcb, 0xc0, 0xf0, 0x19, 0x81, 0x70, 0x33, 0x7e, 0x89, 0x04, 0xc7, 0x07, 0x63, 0x6c, 0xab,
+		0x61, 0xf0, 0x37, 0xb1, 0xc5, 0x8b, 0xe3, 0x98, 0xb3, 0x8c, 0x19, 0xe4, 0x83, 0x0a, 0xb7, 0x87,
+		0xfb, 0xb6, 0xcd, 0x62, 0x3b, 0xb2, 0x31, 0xc1, 0xa4, 0xf8, 0xea, 0xe7, 0x4f, 0x16, 0xf6, 0xcc,
+		0x76, 0x6b, 0x51, 0x1b, 0x42, 0x47, 0x1b, 0xb6, 0x93, 0x47, 0xc3, 0x39, 0xbc, 0xed, 0x26, 0x2b,
+		0xa0, 0xe2, 0xe3, 0x10, 0x5d, 0xf1, 0x69, 0x75, 0x63, 0x9b, 0x52, 0xb1, 0x71, 0x39, 0xb6, 0x30,
+		0xe4, 0x85, 0xf2, 0x05, 0x7c, 0xe9, 0x5b, 0x9c, 0xcf, 0xe5, 0xae, 0xd3, 0x3d, 0x5d, 0xe0, 0x5d,
+		0xa4, 0x94, 0x6c, 0x43, 0x21, 0xd0, 0x08, 0xe1, 0x7f, 0xa8, 0xdb, 0x76, 0xab, 0x61, 0xdf, 0xb4,
+		0xf2, 0xc9, 0x03, 0x0e, 0x62, 0xde, 0xa7, 0xc5, 0x9d, 0x0f, 0x25, 0x41, 0x89, 0xbc, 0x1c, 0x14,
+		0x6e, 0xc6, 0xe2, 0x6d, 0xb3, 0x8e, 0x07, 0x8b, 0xa9, 0x37, 0xe4, 0xe6, 0xf0, 0x25, 0x1e, 0x77,
+		0x9f, 0x17, 0x19, 0x49, 0xf2, 0x1e, 0x38, 0xb6, 0xd3, 0xb2, 0xb7, 0xf0, 0xee, 0x5e, 0xf3, 0x3d,
+		0xbd, 0x66, 0xc3, 0x10, 0x82, 0x6e, 0xd4, 0xcd, 0x4e, 0x1e, 0xde, 0x50, 0x7d, 0x47, 0x38, 0xe1,
+		0x55, 0x46, 0xb7, 0xc6, 0xc9, 0x96, 0xcc, 0x0e, 0xb9, 0x09, 0xf7, 0xf8, 0xdd, 0x1b, 0x52, 0x6b,
+		0xfa, 0x0d, 0xd5, 0x7a, 0xcc, 0xa3, 0x3d, 0x50, 0xb1, 0x0e, 0xf9, 0xbe, 0xea, 0xf0, 0xde, 0x6a,
+		0x93, 0x05, 0xbb, 0x65, 0xc6, 0xec, 0x6a, 0x8e, 0xb4, 0x82, 0xd4, 0xd6, 0x25, 0x1e, 0xaa, 0x67,
+		0xda, 0xb1, 0xeb, 0xbb, 0x46, 0x8b, 0x5a, 0x3b, 0x2e, 0x7f, 0xae, 0x31, 0xa6, 0xa7, 0x59, 0xde,
+		0x2a, 0xcb, 0x22, 0x36, 0x9c, 0x1c, 0x14, 0x1b, 0xcb, 0x76, 0x9b, 0x75, 0x6a, 0x74, 0x68, 0xb7,
+		0x69, 0x37, 0xf2, 0xb9, 0x03, 0xca, 0xce, 0x3d, 0xfd, 0xb2, 0x53, 0x61, 0xe4, 0xd6, 0x19, 0x35,
+		0x72, 0x1e, 0xf2, 0x6c, 0xa2, 0xb3, 0x76, 0xb5, 0x9d, 0x1d, 0x07, 0xab, 0x11, 0x01, 0xe7, 0xd3,
+		0x6c, 0x36, 0xcf, 0xe1, 0xac, 0xc7, 0xe2, 0x35, 0x67, 0xc7, 0x59, 0xa7, 0x5d, 0xe6, 0x57, 0x5f,
+		0x7c, 0x1b, 0xae, 0x04, 0xb7, 0x5f, 0xfd, 0xd9, 0xd3, 0xc7, 0x03, 0xaf, 0xc9, 0xdf, 0xf2, 0x1c,
+		0xe3, 0x5c, 0x7d, 0xe1, 0xa6, 0x8e, 0xf8, 0x06, 0x96, 0x77, 0xe7, 0x66, 0x0d, 0x20, 0xb0, 0xe9,
+		0x55, 0xf6, 0xdf, 0x4c, 0xfb, 0xf8, 0xa1, 0xcd, 0x74, 0x60, 0xf9, 0x79, 0x97, 0x6f, 0xdf, 0x44,
+		0xc6, 0xcd, 0xd6, 0xa0, 0xe6, 0x15, 0x48, 0x6c, 0x55, 0x3b, 0xa4, 0xfd, 0xae, 0x82, 0xa1, 0x99,
+		0x7d, 0x9a, 0xda, 0x6b, 0x72, 0x1d, 0x48, 0x37, 0x50, 0xc8, 0x34, 0x9e, 0x0c, 0xf2, 0xb8, 0x3b,
+		0xc5, 0x3f, 0xd3, 0xed, 0x2f, 0x7d, 0x93, 0x0c, 0x35, 0xb1, 0x4a, 0xff, 0x96, 0x82, 0x97, 0xcd,
+		0x1a, 0x83, 0xdc, 0xaf, 0x41, 0x26, 0x58, 0xb5, 0xe8, 0xc4, 0xdb, 0x26, 0xe9, 0x44, 0xb0, 0xfd,
+		0x21, 0x22, 0xe4, 0xaa, 0xbf, 0x1a, 0x72, 0x8f, 0xfc, 0xe3, 0x13, 0x33, 0x45, 0x36, 0x6c, 0xe8,
+		0xaa, 0xc8, 0xc7, 0xe6, 0xcf, 0x14, 0x88, 0xad, 0xdb, 0x76, 0x8b, 0xbc, 0x07, 0x66, 0x2c, 0xdb,
+		0x65, 0x8a, 0x8e, 0x36, 0x0c, 0xe1, 0x03, 0xe3, 0x96, 0x46, 0x79, 0x5f, 0x5e, 0x7d, 0xf9, 0xf3,
+		0x27, 0x07, 0x31, 0x87, 0x7d, 0xd1, 0x61, 0xda, 0xb2, 0xdd, 0x25, 0x06, 0xb4, 0xc1, 0x60, 0xc8,
+		0x36, 0x64, 0xc3, 0xd5, 0x71, 0x6b, 0xa4, 0x38, 0xae, 0xba, 0xec, 0xd8, 0xaa, 0x32, 0x5b, 0x81,
+		0x7a, 0xf8, 0x03, 0xed, 0x7f, 0x89, 0x23, 0xf7, 0x55, 0x05, 0x48, 0xf0, 0x45, 0x37, 0x3e, 0x6d,
+		0x87, 0xef, 0xb3, 0x94, 0xbb, 0xdf, 0x67, 0x55, 0x21, 0x2d, 0x14, 0x4c, 0xe0, 0x9e, 0xfd, 0xc2,
+		0xd8, 0x83, 0x14, 0xaf, 0x41, 0x2c, 0xa4, 0x05, 0xea, 0xde, 0x6f, 0xb4, 0x2d, 0x3a, 0x5d, 0x7a,
+		0xa3, 0x69, 0xa3, 0x0f, 0x35, 0x10, 0x2f, 0x95, 0x95, 0xb9, 0xfc, 0xe1, 0x71, 0x2f, 0xce, 0x29,
+		0x16, 0x8c, 0x73, 0xda, 0x86, 0xd9, 0xc1, 0x2a, 0xb0, 0x91, 0x53, 0xbc, 0x06, 0xe9, 0x00, 0x38,
+		0x3d, 0x79, 0x03, 0x43, 0x02, 0x25, 0xa8, 0x68, 0x5f, 0x8e, 0xc2, 0xcc, 0x55, 0x5f, 0xed, 0xdf,
+		0xa0, 0x16, 0xf2, 0xe2, 0x31, 0x98, 0x13, 0xef, 0xf5, 0xf1, 0x17, 0xac, 0x8c, 0x9b, 0x4d, 0xab,
+		0x61, 0xdf, 0x14, 0x7b, 0x0c, 0xc2, 0xcb, 0xf8, 0x2b, 0x56, 0x2f, 0xb0, 0x12, 0xd2, 0x84, 0xc3,
+		0xcc, 0x83, 0xc7, 0xb1, 0x50, 0x69, 0x0a, 0x94, 0x37, 0x76, 0x72, 0x41, 0xd0, 0x9d, 0xc7, 0x68,
+		0xae, 0xd3, 0xae, 0xa8, 0xea, 0x3e, 0xfc, 0xd0, 0xbf, 0xd9, 0x75, 0xc3, 0x61, 0xf7, 0x69, 0x96,
+		0x27, 0x76, 0x3d, 0xf7, 0x43, 0x96, 0x57, 0x2f, 0xda, 0x2f, 0x78, 0x9b, 0xe1, 0x99, 0xbc, 0xe1,
+		0xe4, 0x2c, 0x36, 0xd9, 0x71, 0xfc, 0x4e, 0x32, 0x9b, 0xdf, 0x8b, 0xf4, 0x99, 0xe5, 0x85, 0x1c,
+		0xb8, 0xc4, 0x8b, 0x48, 0x05, 0x12, 0xbd, 0x8e, 0x77, 0x89, 0xf3, 0xee, 0xfb, 0x25, 0xa8, 0x90,
+		0x55, 0xc8, 0x70, 0xff, 0xb7, 0xd1, 0xb3, 0xdc, 0x66, 0x2b, 0x3f, 0x75, 0xd0, 0xbd, 0x59, 0x9a,
+		0xa3, 0x6f, 0x22, 0x36, 0xc6, 0x3a, 0xba, 0x76, 0x7b, 0xcb, 0x71, 0x6d, 0x8b, 0x36, 0xf2, 0x49,
+		0xf1, 0x5d, 0x7f, 0x2f, 0x47, 0xfb, 0x83, 0x08, 0x1c, 0x5d, 0xa7, 0xcc, 0xfa, 0x2d, 0xf5, 0xad,
+		0x82, 0x6f, 0xfa, 0x74, 0x92, 0x27, 0x61, 0x91, 0x37, 0xe1, 0x24, 0xac, 0x02, 0x59, 0xd3, 0xb2,
+		0xec, 0x1e, 0xbe, 0xec, 0x3c, 0xe1, 0xa5, 0xf0, 0x3e, 0x36, 0x65, 0x24, 0x3e, 0x42, 0xa0, 0x3f,
+		0x9c, 0x6e, 0x6f, 0xd3, 0xba, 0xdb, 0xbc, 0x21, 0x08, 0xc6, 0x0e, 0xec, 0x0f, 0xf7, 0x08, 0x20,
+		0x88, 0x76, 0x0d, 0xd4, 0xab, 0xfd, 0x01, 0xce, 0x65, 0x98, 0x92, 0x01, 0xcd, 0xca, 0x84, 0xb1,
+		0xd2, 0xc1, 0x19, 0x2a, 0x70, 0xb5, 0xcf, 0x45, 0xe0, 0x18, 0xc6, 0x3d, 0x8b, 0x03, 0x15, 0x61,
+		0x54, 0xf3, 0x03, 0xca, 0x3d, 0x3c, 0x05, 0x18, 0x7a, 0xdc, 0x93, 0x19, 0x3c, 0xd4, 0xb9, 0x0a,
+		0xd3, 0xb8, 0xbb, 0xc5, 0x03, 0x99, 0x37, 0x76, 0xa6, 0x93, 0xb5, 0x5b, 0x0d, 0xd1, 0x22, 0x3c,
+		0xd1, 0xb9, 0x0a, 0xd3, 0x16, 0xbd, 0x19, 0xa2, 0x1b, 0xbd, 0x3b, 0xba, 0x16, 0xbd, 0x19, 0xa0,
+		0xeb, 0x1f, 0xe2, 0xc6, 0x42, 0xb1, 0x74, 0xe7, 0x21, 0x8a, 0x3b, 0x91, 0xf8, 0x01, 0x6c, 0x1b,
+		0x44, 0x08, 0xec, 0x28, 0x6b, 0x70, 0x4c, 0x38, 0xe9, 0x9d, 0xea, 0x36, 0xe3, 0x28, 0x65, 0x1d,
+		0x7a, 0x8e, 0xee, 0x0d, 0xf1, 0xd8, 0x67, 0x26, 0xf3, 0xd8, 0xbf, 0x0f, 0x52, 0xcf, 0xf7, 0x68,
+		0x8f, 0xbd, 0x93, 0x89, 0x61, 0xb9, 0xe2, 0x54, 0x3a, 0xa6, 0x47, 0x9a, 0xa3, 0x0f, 0xa4, 0x4b,
+		0x10, 0x6d, 0x3b, 0x3b, 0xfb, 0xf2, 0xeb, 0xf8, 0x6f, 0x7f, 0xf6, 0xd1, 0xa3, 0xc3, 0x3a, 0xb9,
+		0xe6, 0xec, 0xe8, 0x88, 0xbd, 0x18, 0xc3, 0x8e, 0x9d, 0xfe, 0x45, 0x05, 0xc0, 0x3f, 0x1b, 0xc3,
+		0x77, 0x37, 0x97, 0xaa, 0x95, 0x65, 0xa3, 0xb6, 0x51, 0xdc, 0xd8, 0xac, 0x85, 0xbf, 0x69, 0x27,
+		0x5f, 0xe9, 0x74, 0x3a, 0xb4, 0xde, 0xdc, 0x6e, 0xd2, 0x06, 0x79, 0x10, 0xe6, 0xc2, 0xd0, 0x98,
+		0x2a, 0x2f, 0xab, 0x4a, 0x21, 0x73, 0xfb, 0xce, 0x7c, 0x92, 0xbb, 0x07, 0x29, 0xbe, 0x39, 0x7f,
+		0x78, 0x10, 0x0e, 0xbf, 0x87, 0x17, 0x29, 0x64, 0x6f, 0xdf, 0x99, 0x4f, 0x79, 0x7e, 0x44, 0xa2,
+		0x01, 0x09, 0x42, 0x0a, 0x7a, 0xd1, 0x02, 0xdc, 0xbe, 0x33, 0x9f, 0xe0, 0x16, 0x45, 0x21, 0x86,
+		0x6f, 0x71, 0x9e, 0xfe, 0x16, 0x80, 0x15, 0x6b, 0xbb, 0x6b, 0xd6, 0x99, 0xe5, 0x54, 0x80, 0x23,
+		0x2b, 0x95, 0x8b, 0x7a, 0xb1, 0xb4, 0xb1, 0x52, 0xad, 0x84, 0x9b, 0xdd, 0x57, 0xb6, 0x5c, 0xdd,
+		0x5c, 0x5a, 0x2d, 0x1b, 0xf8, 0xfa, 0x27, 0x0f, 0x49, 0x0c, 0x95, 0xbd, 0x50, 0xd9, 0x58, 0x59,
+		0x2b, 0xab, 0x91, 0xd3, 0xbf, 0x1b, 0x85, 0x23, 0xc3, 0x57, 0x6b, 0xb2, 0x0a, 0x0f, 0x5c, 0x2d,
+		0xae, 0xae, 0x2c, 0x17, 0x37, 0xaa, 0xba, 0x51, 0x2b, 0x6f, 0x18, 0xa5, 0xcb, 0xc5, 0xca, 0xa5,
+		0xf2, 0x90, 0xaf, 0x00, 0x16, 0xee, 0xbb, 0x7d, 0x67, 0xfe, 0xde, 0x41, 0x32, 0x41, 0x1e, 0x2e,
+		0xc1, 0xfc, 0x68, 0x6a, 0x57, 0xaa, 0x2b, 0x15, 0xc6, 0xcf, 0x7b, 0x6e, 0xdf, 0x99, 0xcf, 0x0f,
+		0x12, 0xba, 0x62, 0x37, 0x2d, 0xda, 0x20, 0xef, 0x82, 0x13, 0xa3, 0x69, 0xac, 0x96, 0x2f, 0xe2,
+		0x23, 0xa8, 0x85, 0xdb, 0x77, 0xe6, 0x87, 0xf4, 0x68, 0x95, 0x6e, 0xe3, 0x0d, 0xef, 0x87, 0x46,
+		0xe3, 0xaf, 0x57, 0x5f, 0x28, 0xeb, 0x22, 0x07, 0x87, 0x42, 0xbb, 0x7d, 0x67, 0xfe, 0xc4, 0x20,
+		0x21, 0x66, 0x96, 0xf0, 0x9f, 0xe3, 0x3a, 0x55, 0x5c, 0x59, 0x2d, 0x2f, 0xab, 0xb1, 0x91, 0x9d,
+		0xe2, 0x47, 0xbb, 0x17, 0x41, 0xdb, 0x8f, 0xcd, 0x82, 0x4a, 0xbc, 0x70, 0xe2, 0xf6, 0x9d, 0xf9,
+		0xc2, 0x30, 0x1e, 0xf3, 0x35, 0x8e, 0x8b, 0xcb, 0xd2, 0xf9, 0x91, 0x21, 0x4c, 0xf7, 0x84, 0x16,
+		0x17, 0x7f, 0xf7, 0x15, 0x8a, 0x5b, 0xfa, 0x9f, 0x03, 0x00, 0x47, 0xba, 0x20, 0x2f, 0x29, 0xbc,
+		0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2524,6 +3235,35 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if this.CommissionChangeCooldown != that1.CommissionChangeCooldown {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if len(this.LiquidStakingProviders) != len(that1.LiquidStakingProviders) {
+		return false
+	}
+	for i := range this.LiquidStakingProviders {
+		if this.LiquidStakingProviders[i] != that1.LiquidStakingProviders[i] {
+			return false
+		}
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if this.CommissionChangeNoticePeriod != that1.CommissionChangeNoticePeriod {
+		return false
+	}
+	if this.MaxEpochMsgsPerBlock != that1.MaxEpochMsgsPerBlock {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2674,7 +3414,52 @@ func (m *HistoricalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
+func (m *ArchivedHistoricalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedHistoricalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedHistoricalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2747,12 +3532,12 @@ func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintStaking(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintStaking(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -2847,20 +3632,20 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.UnbondingIds) > 0 {
-		dAtA6 := make([]byte, len(m.UnbondingIds)*10)
-		var j5 int
+		dAtA7 := make([]byte, len(m.UnbondingIds)*10)
+		var j6 int
 		for _, num := range m.UnbondingIds {
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j6++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintStaking(dAtA, i, uint64(j5))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintStaking(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -2889,12 +3674,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintStaking(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -3304,12 +4089,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err11 != nil {
-		return 0, err11
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n11
-	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3370,12 +4155,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3464,6 +4249,71 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxEpochMsgsPerBlock != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEpochMsgsPerBlock))
+		i--
+		dAtA[i] = 0x78
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeNoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x72
+	if m.EpochLength != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.LiquidStakingProviders) > 0 {
+		for iNdEx := len(m.LiquidStakingProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LiquidStakingProviders[iNdEx])
+			copy(dAtA[i:], m.LiquidStakingProviders[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.LiquidStakingProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeCooldown):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3506,12 +4356,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err14 != nil {
-		return 0, err14
+	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n14
-	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i -= n17
+	i = encodeVarintStaking(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3693,7 +4543,7 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorSetChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3703,20 +4553,65 @@ func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorSetChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorSetChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousPower != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.PreviousPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChangeType != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ChangeType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetChanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3730,7 +4625,7 @@ func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorLiveness) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3740,66 +4635,78 @@ func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorLiveness) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintStaking(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x3a
 	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if m.Height != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+	dAtA[i] = 0x32
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.WindowBlocks))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.NewConsPubkey != nil {
-		{
-			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStaking(dAtA, i, uint64(size))
-		}
+	if m.StartHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.StartHeight))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.OldConsPubkey != nil {
-		{
-			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStaking(dAtA, i, uint64(size))
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SignedBlocksWindow))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValAddrsOfRotatedConsKeys) Marshal() (dAtA []byte, err error) {
+func (m *PendingCommissionChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3809,50 +4716,257 @@ func (m *ValAddrsOfRotatedConsKeys) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValAddrsOfRotatedConsKeys) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingCommissionChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintStaking(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x22
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AnnounceTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AnnounceTime):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintStaking(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValAddrsOfRotatedConsKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValAddrsOfRotatedConsKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
 			n += 1 + l + sovStaking(uint64(l))
 		}
 	}
@@ -3880,6 +4994,24 @@ func (m *HistoricalRecord) Size() (n int) {
 	return n
 }
 
+func (m *ArchivedHistoricalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *CommissionRates) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4210,6 +5342,28 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeCooldown)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.LiquidStakingProviders) > 0 {
+		for _, s := range m.LiquidStakingProviders {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovStaking(uint64(m.EpochLength))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxEpochMsgsPerBlock != 0 {
+		n += 1 + sovStaking(uint64(m.MaxEpochMsgsPerBlock))
+	}
 	return n
 }
 
@@ -4269,14 +5423,36 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
-func (m *ValidatorUpdates) Size() (n int) {
+func (m *ValidatorSetChange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ChangeType != 0 {
+		n += 1 + sovStaking(uint64(m.ChangeType))
+	}
+	if m.PreviousPower != 0 {
+		n += 1 + sovStaking(uint64(m.PreviousPower))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorSetChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
 			l = e.Size()
 			n += 1 + l + sovStaking(uint64(l))
 		}
@@ -4284,51 +5460,134 @@ func (m *ValidatorUpdates) Size() (n int) {
 	return n
 }
 
-func (m *ConsPubKeyRotationHistory) Size() (n int) {
+func (m *ValidatorLiveness) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovStaking(uint64(m.SignedBlocksWindow))
 	}
-	if m.OldConsPubkey != nil {
-		l = m.OldConsPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovStaking(uint64(m.StartHeight))
 	}
-	if m.NewConsPubkey != nil {
-		l = m.NewConsPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovStaking(uint64(m.WindowBlocks))
 	}
-	if m.Height != 0 {
-		n += 1 + sovStaking(uint64(m.Height))
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovStaking(uint64(m.MissedBlocksCounter))
 	}
-	l = m.Fee.Size()
+	l = m.Uptime.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
 	return n
 }
 
-func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
+func (m *PendingCommissionChange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for _, b := range m.Addresses {
-			l = len(b)
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AnnounceTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
+func (m *ValidatorUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
 	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
@@ -4602,6 +5861,140 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ArchivedHistoricalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedHistoricalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedHistoricalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7172,59 +8565,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeCooldown", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7251,11 +8594,299 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeCooldown, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidStakingProviders = append(m.LiquidStakingProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeNoticePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeNoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochMsgsPerBlock", wireType)
+			}
+			m.MaxEpochMsgsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEpochMsgsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
@@ -7284,7 +8915,582 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			m.ChangeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeType |= ValidatorSetChangeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPower", wireType)
+			}
+			m.PreviousPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ValidatorSetChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7309,7 +9515,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorLiveness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7332,15 +9538,159 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorLiveness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7367,15 +9717,15 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7385,26 +9735,12 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Tombstoned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7426,7 +9762,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7449,17 +9785,17 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingCommissionChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7469,30 +9805,29 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7502,81 +9837,31 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnounceTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7586,31 +9871,30 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AnnounceTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7620,23 +9904,22 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8035,6 +10318,130 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueuedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types1.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/errors"
@@ -519,6 +520,27 @@ func (v Validator) CmtConsPublicKey() (cmtprotocrypto.PublicKey, error) {
 	return tmPk, nil
 }
 
+// GenesisValidator returns the validator in the format of the validators of a
+// CometBFT genesis file.
+func (v Validator) GenesisValidator(powerReduction math.Int) (cmttypes.GenesisValidator, error) {
+	pk, err := v.ConsPubKey()
+	if err != nil {
+		return cmttypes.GenesisValidator{}, err
+	}
+
+	cmtPk, err := cryptocodec.ToCmtPubKeyInterface(pk)
+	if err != nil {
+		return cmttypes.GenesisValidator{}, err
+	}
+
+	return cmttypes.GenesisValidator{
+		Address: cmtPk.Address(),
+		PubKey:  cmtPk,
+		Power:   v.GetConsensusPower(powerReduction),
+		Name:    v.GetMoniker(),
+	}, nil
+}
+
 // Deprecated: use CmtConsPublicKey instead
 // We do not delete this function as it is part of the ValidatorI interface
 func (v Validator) TmConsPublicKey() (cmtprotocrypto.PublicKey, error) {
@@ -4,12 +4,20 @@ import (
 	"context"
 	"testing"
 
+	"github.com/cosmos/cosmos-proto/anyutil"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/testutil"
 )
@@ -90,3 +98,69 @@ func TestNewSignModeHandler(t *testing.T) {
 	})
 	require.NotNil(t, handler)
 }
+
+// clientMetadataResolver resolves the ClientMetadata extension option on top
+// of the global registry.
+type clientMetadataResolver struct {
+	*protoregistry.Files
+	desc protoreflect.MessageDescriptor
+}
+
+func (r clientMetadataResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if name == r.desc.FullName() {
+		return r.desc, nil
+	}
+	return r.Files.FindDescriptorByName(name)
+}
+
+func TestAminoJsonSignModeClientMetadata(t *testing.T) {
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("client_metadata_test.proto"),
+		Package: proto.String("cosmos.tx.v1beta1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("ClientMetadata"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("wallet_name"),
+				JsonName: proto.String("walletName"),
+				Number:   proto.Int32(1),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			}},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	desc := file.Messages().Get(0)
+	resolver := clientMetadataResolver{Files: protoregistry.GlobalFiles, desc: desc}
+
+	metadata := dynamicpb.NewMessage(desc)
+	metadata.Set(desc.Fields().ByName("wallet_name"), protoreflect.ValueOfString("wallet"))
+	value, err := proto.Marshal(metadata)
+	require.NoError(t, err)
+	clientMetadata := &anypb.Any{TypeUrl: signing.ClientMetadataTypeURL, Value: value}
+
+	otherOption, err := anyutil.New(&basev1beta1.Coin{Denom: "uatom", Amount: "1"})
+	require.NoError(t, err)
+
+	handlerOptions := testutil.HandlerArgumentOptions{
+		ChainID: "test-chain",
+		Msg: &bankv1beta1.MsgSend{
+			FromAddress: "foo",
+			ToAddress:   "bar",
+			Amount:      []*basev1beta1.Coin{{Denom: "demon", Amount: "100"}},
+		},
+		SignerAddress: "signerAddress",
+		Fee:           &txv1beta1.Fee{Amount: []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}}},
+	}
+	handler := aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{FileResolver: resolver})
+
+	// the client metadata cannot be signed over, so it is rejected like any
+	// other extension option
+	for _, opts := range [][]*anypb.Any{{clientMetadata}, {clientMetadata, otherOption}} {
+		handlerOptions.NonCriticalExtensionOptions = opts
+		signerData, txData, err := testutil.MakeHandlerArguments(handlerOptions)
+		require.NoError(t, err)
+		_, err = handler.GetSignBytes(context.Background(), signerData, txData)
+		require.ErrorContains(t, err, "does not support protobuf extension options")
+	}
+}
@@ -0,0 +1,21 @@
+package signing
+
+import "google.golang.org/protobuf/types/known/anypb"
+
+// ClientMetadataTypeURL is the type URL of the cosmos.tx.v1beta1.ClientMetadata
+// non-critical extension option, which describes the client which built a
+// transaction. It is informational only, so SIGN_MODE_TEXTUAL leaves it out of
+// its screens, which are still bound by the hash of the body bytes.
+const ClientMetadataTypeURL = "/cosmos.tx.v1beta1.ClientMetadata"
+
+// WithoutClientMetadata returns the extension options which are not
+// ClientMetadata.
+func WithoutClientMetadata(opts []*anypb.Any) []*anypb.Any {
+	var filtered []*anypb.Any
+	for _, opt := range opts {
+		if opt.TypeUrl != ClientMetadataTypeURL {
+			filtered = append(filtered, opt)
+		}
+	}
+	return filtered
+}
@@ -12,13 +12,14 @@ import (
 )
 
 type HandlerArgumentOptions struct {
-	ChainID       string
-	Memo          string
-	Msg           proto.Message
-	AccNum        uint64
-	AccSeq        uint64
-	Fee           *txv1beta1.Fee
-	SignerAddress string
+	ChainID                     string
+	Memo                        string
+	Msg                         proto.Message
+	AccNum                      uint64
+	AccSeq                      uint64
+	Fee                         *txv1beta1.Fee
+	SignerAddress               string
+	NonCriticalExtensionOptions []*anypb.Any
 }
 
 func MakeHandlerArguments(options HandlerArgumentOptions) (signing.SignerData, signing.TxData, error) {
@@ -50,8 +51,9 @@ func MakeHandlerArguments(options HandlerArgumentOptions) (signing.SignerData, s
 	}
 
 	txBody := &txv1beta1.TxBody{
-		Messages: []*anypb.Any{anyMsg},
-		Memo:     options.Memo,
+		Messages:                    []*anypb.Any{anyMsg},
+		Memo:                        options.Memo,
+		NonCriticalExtensionOptions: options.NonCriticalExtensionOptions,
 	}
 
 	authInfo := &txv1beta1.AuthInfo{
@@ -16,6 +16,7 @@ import (
 	msg "cosmossdk.io/api/cosmos/msg/v1"
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/tx/signing/textual/internal/textualpb"
 )
 
@@ -80,7 +81,7 @@ func (vr txValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]S
 		GasLimit:                    txAuthInfo.Fee.GasLimit,
 		TimeoutHeight:               txBody.TimeoutHeight,
 		ExtensionOptions:            txBody.ExtensionOptions,
-		NonCriticalExtensionOptions: txBody.NonCriticalExtensionOptions,
+		NonCriticalExtensionOptions: signing.WithoutClientMetadata(txBody.NonCriticalExtensionOptions),
 		HashOfRawBytes:              getHash(textualData.BodyBytes, textualData.AuthInfoBytes),
 	}
 
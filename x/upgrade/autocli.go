@@ -40,6 +40,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "BinaryModuleVersions",
+					Use:       "binary-module-versions",
+					Short:     "Query the module versions of the running binary",
+					Long:      "Gets the consensus versions of the modules of the running binary, the versions from which their in-place store migrations are registered and their versions in state.",
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
@@ -55,6 +61,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:       "Submit a proposal to cancel a planned chain upgrade.",
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "PostponeUpgrade",
+					Use:            "postpone-upgrade-proposal [new-height]",
+					Short:          "Submit a proposal to postpone a planned chain upgrade to a later height.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_height"}},
+					GovProposal:    true,
+				},
 				{
 					RpcMethod: "SoftwareUpgrade",
 					Skip:      true, // skipped because authority gated
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewCompatCheckCmd returns a command reporting whether the binary can migrate
+// the modules of the node state to their consensus versions in the binary.
+func NewCompatCheckCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compat-check [module-versions-file]",
+		Short: "Report whether the binary can migrate the modules of the node state",
+		Long: `Report, for each module, whether the in-place store migrations registered in the binary
+can migrate the module from its consensus version in state to its consensus version in the
+binary, and fail when one of the modules cannot be migrated.
+
+The module versions in state are read from the node database, which requires the node to be
+stopped, e.g. halted at an upgrade height with the new binary installed. They can instead be
+read from a JSON file written by the query upgrade module-versions command, e.g. of another
+node. The versions of the binary are still read from the application of the node home.`,
+		Example: fmt.Sprintf(`%[1]s compat-check
+%[1]s query upgrade module-versions --output json > module-versions.json
+%[1]s compat-check module-versions.json`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// an incompatible state is reported as an error, not a usage error
+			cmd.SilenceUsage = true
+
+			versions, err := queryBinaryModuleVersions(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				stateVersions, err := readModuleVersions(args[0])
+				if err != nil {
+					return err
+				}
+				versions = withStateVersions(versions, stateVersions)
+			}
+
+			failed := 0
+			for _, v := range versions {
+				desc, err := v.CheckMigration()
+				if err != nil {
+					failed++
+					desc = err.Error()
+				}
+				cmd.Printf("%s: %s\n", v.Name, desc)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("the binary cannot migrate %d module(s) of the state", failed)
+			}
+
+			cmd.Println("the binary can migrate the state")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// queryBinaryModuleVersions queries the module versions from an application
+// built over the node database.
+func queryBinaryModuleVersions[T servertypes.Application](cmd *cobra.Command, appCreator servertypes.AppCreator[T]) ([]*types.BinaryModuleVersion, error) {
+	ctx := server.GetServerContextFromCmd(cmd)
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(ctx.Viper), filepath.Join(ctx.Config.RootDir, "data"))
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	app := appCreator(log.NewNopLogger(), db, nil, ctx.Viper)
+
+	req, err := (&types.QueryBinaryModuleVersionsRequest{}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := app.Query(context.Background(), &abci.RequestQuery{
+		Path: "/cosmos.upgrade.v1beta1.Query/BinaryModuleVersions",
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !res.IsOK() {
+		return nil, fmt.Errorf("querying the module versions: %s", res.Log)
+	}
+
+	var resp types.QueryBinaryModuleVersionsResponse
+	if err := resp.Unmarshal(res.Value); err != nil {
+		return nil, err
+	}
+
+	return resp.ModuleVersions, nil
+}
+
+// readModuleVersions reads a Query/ModuleVersions JSON response.
+func readModuleVersions(path string) (map[string]uint64, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp types.QueryModuleVersionsResponse
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	if err := cdc.UnmarshalJSON(bz, &resp); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	versions := make(map[string]uint64, len(resp.ModuleVersions))
+	for _, v := range resp.ModuleVersions {
+		versions[v.Name] = v.Version
+	}
+
+	return versions, nil
+}
+
+// withStateVersions replaces the state versions of the modules by the given
+// ones, adding the modules missing from the binary.
+func withStateVersions(versions []*types.BinaryModuleVersion, stateVersions map[string]uint64) []*types.BinaryModuleVersion {
+	res := make([]*types.BinaryModuleVersion, 0, len(versions))
+	for _, v := range versions {
+		// modules in neither the binary nor the given state are dropped
+		if v.Version == 0 && stateVersions[v.Name] == 0 {
+			continue
+		}
+
+		res = append(res, &types.BinaryModuleVersion{
+			Name:           v.Name,
+			Version:        v.Version,
+			StateVersion:   stateVersions[v.Name],
+			MigrationsFrom: v.MigrationsFrom,
+		})
+	}
+
+	for name, version := range stateVersions {
+		if !slices.ContainsFunc(versions, func(v *types.BinaryModuleVersion) bool { return v.Name == name }) {
+			res = append(res, &types.BinaryModuleVersion{Name: name, StateVersion: version})
+		}
+	}
+
+	slices.SortFunc(res, func(a, b *types.BinaryModuleVersion) int { return strings.Compare(a.Name, b.Name) })
+	return res
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/upgrade/types"
+)
+
+func TestReadModuleVersions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "module-versions.json")
+	// as written by query upgrade module-versions --output json
+	require.NoError(t, os.WriteFile(path, []byte(`{"module_versions":[{"name":"bank","version":"2"},{"name":"crisis","version":"1"}]}`), 0o600))
+
+	versions, err := readModuleVersions(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"bank": 2, "crisis": 1}, versions)
+}
+
+func TestWithStateVersions(t *testing.T) {
+	versions := withStateVersions([]*types.BinaryModuleVersion{
+		{Name: "accounts", Version: 1, StateVersion: 1},
+		{Name: "bank", Version: 4, StateVersion: 4, MigrationsFrom: []uint64{1, 2, 3}},
+		{Name: "group", StateVersion: 2},
+	}, map[string]uint64{"bank": 2, "crisis": 1})
+
+	require.Equal(t, []*types.BinaryModuleVersion{
+		{Name: "accounts", Version: 1},
+		{Name: "bank", Version: 4, StateVersion: 2, MigrationsFrom: []uint64{1, 2, 3}},
+		{Name: "crisis", StateVersion: 1},
+	}, versions)
+}
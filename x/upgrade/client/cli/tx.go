@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -36,6 +37,8 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewCmdSubmitUpgradeProposal(),
+		NewCmdCancelUpgrade(),
+		NewCmdPostponeUpgrade(),
 	)
 
 	return cmd
@@ -136,6 +139,72 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	return cmd
 }
 
+// NewCmdCancelUpgrade implements a command handler for approving the cancellation of
+// the planned upgrade as an authority of the upgrade authority policy.
+func NewCmdCancelUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-upgrade",
+		Args:  cobra.NoArgs,
+		Short: "Approve the cancellation of the planned upgrade as an authority of the upgrade authority policy",
+		Long: "Approve the cancellation of the planned upgrade as an authority of the upgrade authority policy.\n" +
+			"The upgrade is cancelled once the threshold of the authorities of the policy approved it.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &types.MsgCancelUpgrade{Authority: authority})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdPostponeUpgrade implements a command handler for approving the postponement
+// of the planned upgrade as an authority of the upgrade authority policy.
+func NewCmdPostponeUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "postpone-upgrade [new-height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Approve the postponement of the planned upgrade to a later height as an authority of the upgrade authority policy",
+		Long: "Approve the postponement of the planned upgrade to a later height as an authority of the upgrade authority policy.\n" +
+			"The upgrade is postponed once the threshold of the authorities of the policy approved the same height.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			newHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid new height: %w", err)
+			}
+
+			authority, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &types.MsgPostponeUpgrade{
+				Authority: authority,
+				NewHeight: newHeight,
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getDefaultDaemonName gets the default name to use for the daemon.
 // If a DAEMON_NAME env var is set, that is used.
 // Otherwise, the last part of the currently running executable is used.
@@ -78,6 +78,19 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	// set the governance module account as the authority for conducting upgrades
 	k := keeper.NewKeeper(in.Environment, skipUpgradeHeights, in.Cdc, homePath, in.AppVersionModifier, authorityStr)
+
+	policy := types.AuthorityPolicy{Threshold: in.Config.PolicyThreshold}
+	for _, authority := range in.Config.PolicyAuthorities {
+		addr, err := in.AddressCodec.BytesToString(authtypes.NewModuleAddressOrBech32Address(authority))
+		if err != nil {
+			panic(err)
+		}
+		policy.Authorities = append(policy.Authorities, addr)
+	}
+	if err := k.SetAuthorityPolicy(policy); err != nil {
+		panic(err)
+	}
+
 	m := NewAppModule(k)
 
 	return ModuleOutputs{UpgradeKeeper: k, Module: m}
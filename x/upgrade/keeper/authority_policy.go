@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+)
+
+// SetAuthorityPolicy sets the policy of the authorities allowed to cancel or
+// postpone the scheduled upgrade in place of the module authority. Once enabled,
+// the module authority can only cancel or postpone the upgrade as an authority
+// of the policy.
+func (k *Keeper) SetAuthorityPolicy(policy types.AuthorityPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	k.authorityPolicy = policy
+	return nil
+}
+
+// GetAuthorityPolicy returns the policy of the authorities allowed to cancel or
+// postpone the scheduled upgrade.
+func (k Keeper) GetAuthorityPolicy() types.AuthorityPolicy {
+	return k.authorityPolicy
+}
+
+// approveUpgradeAction records the approval by the authority of cancelling the
+// plan, or of postponing it to newHeight if not 0, and returns true if the
+// action can be executed. Without an authority policy, the action is executed
+// if the authority is the module authority. Otherwise it is executed once the
+// threshold of the authorities of the policy approved the same action.
+func (k Keeper) approveUpgradeAction(ctx context.Context, authority string, plan types.Plan, newHeight int64) (bool, error) {
+	if !k.authorityPolicy.Enabled() {
+		if authority != k.authority {
+			return false, errorsmod.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, authority)
+		}
+		return true, nil
+	}
+
+	if !k.authorityPolicy.HasAuthority(authority) {
+		return false, errorsmod.Wrapf(types.ErrInvalidSigner, "%s is not an authority of the upgrade authority policy", authority)
+	}
+
+	approval := types.UpgradeApproval{PlanName: plan.Name, NewHeight: newHeight}
+	bz, err := k.cdc.Marshal(&approval)
+	if err != nil {
+		return false, err
+	}
+
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	if err := store.Set(types.ApprovalKey(authority), bz); err != nil {
+		return false, err
+	}
+
+	// the approvals of other actions, or of previous plans, are not counted
+	var approvals uint32
+	for _, a := range k.authorityPolicy.Authorities {
+		bz, err := store.Get(types.ApprovalKey(a))
+		if err != nil {
+			return false, err
+		}
+		if bz == nil {
+			continue
+		}
+
+		var other types.UpgradeApproval
+		if err := k.cdc.Unmarshal(bz, &other); err != nil {
+			return false, err
+		}
+		if other == approval {
+			approvals++
+		}
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUpgradeApproval,
+		event.NewAttribute(types.AttributeKeyAuthority, authority),
+		event.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+		event.NewAttribute(types.AttributeKeyNewHeight, strconv.FormatInt(newHeight, 10)),
+		event.NewAttribute(types.AttributeKeyApprovals, strconv.FormatUint(uint64(approvals), 10)),
+		event.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(k.authorityPolicy.Threshold), 10)),
+	); err != nil {
+		return false, err
+	}
+
+	return approvals >= k.authorityPolicy.Threshold, nil
+}
+
+// clearUpgradeApprovals deletes the approvals of the authorities of the policy,
+// once the plan they approve an action on is changed.
+func (k Keeper) clearUpgradeApprovals(ctx context.Context) error {
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	prefix := []byte{types.ApprovalByte}
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/upgrade/types"
@@ -77,3 +78,35 @@ func (k Keeper) ModuleVersions(ctx context.Context, req *types.QueryModuleVersio
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// BinaryModuleVersions implements the Query/BinaryModuleVersions gRPC method, returning the consensus
+// versions of the modules of the binary, the versions they can be migrated from and their versions in state.
+func (k Keeper) BinaryModuleVersions(ctx context.Context, _ *types.QueryBinaryModuleVersionsRequest) (*types.QueryBinaryModuleVersionsResponse, error) {
+	stateVM, err := k.GetModuleVersionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(k.binaryVersionMap))
+	for name := range k.binaryVersionMap {
+		names = append(names, name)
+	}
+	for name := range stateVM {
+		if _, ok := k.binaryVersionMap[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	versions := make([]*types.BinaryModuleVersion, 0, len(names))
+	for _, name := range names {
+		versions = append(versions, &types.BinaryModuleVersion{
+			Name:           name,
+			Version:        k.binaryVersionMap[name],
+			StateVersion:   stateVM[name],
+			MigrationsFrom: k.binaryMigrations[name],
+		})
+	}
+
+	return &types.QueryBinaryModuleVersionsResponse{ModuleVersions: versions}, nil
+}
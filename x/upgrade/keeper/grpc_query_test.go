@@ -239,6 +239,31 @@ func (suite *UpgradeTestSuite) TestAuthority() {
 	suite.Require().Equal(suite.encodedAuthority, res.Address)
 }
 
+func (suite *UpgradeTestSuite) TestBinaryModuleVersions() {
+	suite.Require().NoError(suite.upgradeKeeper.SetModuleVersionMap(suite.ctx, module.VersionMap{
+		"bank":    2,
+		"staking": 3,
+		"crisis":  1,
+	}))
+	suite.upgradeKeeper.SetBinaryModuleVersions(
+		module.VersionMap{"bank": 4, "staking": 3, "accounts": 1},
+		map[string][]uint64{"bank": {1, 2, 3}},
+	)
+
+	res, err := suite.queryClient.BinaryModuleVersions(context.Background(), &types.QueryBinaryModuleVersionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.BinaryModuleVersion{
+		{Name: "accounts", Version: 1},
+		{Name: "bank", Version: 4, StateVersion: 2, MigrationsFrom: []uint64{1, 2, 3}},
+		{Name: "crisis", StateVersion: 1},
+		{Name: "staking", Version: 3, StateVersion: 3},
+	}, res.ModuleVersions)
+	for _, v := range res.ModuleVersions {
+		_, err := v.CheckMigration()
+		suite.Require().NoError(err)
+	}
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
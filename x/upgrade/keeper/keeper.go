@@ -40,6 +40,9 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
+	binaryVersionMap   module.VersionMap               // the consensus versions of the modules of the binary
+	binaryMigrations   map[string][]uint64             // the versions from which the module migrations are registered
+	authorityPolicy    types.AuthorityPolicy           // the authorities allowed to cancel or postpone an upgrade in place of the authority
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -78,6 +81,15 @@ func (k *Keeper) GetInitVersionMap() module.VersionMap {
 	return k.initVersionMap
 }
 
+// SetBinaryModuleVersions sets the consensus versions of the modules of the binary
+// and the versions from which their in-place store migrations are registered, as
+// returned by the module manager GetVersionMap and GetMigrationVersions. They are
+// served by the BinaryModuleVersions query.
+func (k *Keeper) SetBinaryModuleVersions(vm module.VersionMap, migrations map[string][]uint64) {
+	k.binaryVersionMap = vm
+	k.binaryMigrations = migrations
+}
+
 // SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name. This handler will be called when the upgrade
 // with this name is applied. In order for an upgrade with the given name to proceed, a handler for this upgrade
 // must be set even if it is a no-op function.
@@ -231,6 +243,12 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
+	// the approvals of actions on the old plan no longer apply
+	err = k.clearUpgradeApprovals(ctx)
+	if err != nil {
+		return err
+	}
+
 	telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, []metrics.Label{telemetry.NewLabel("upgrade_height", strconv.FormatInt(plan.Height, 10))})
 
 	return nil
@@ -365,6 +383,11 @@ func (k Keeper) ClearUpgradePlan(ctx context.Context) error {
 		return err
 	}
 
+	err = k.clearUpgradeApprovals(ctx)
+	if err != nil {
+		return err
+	}
+
 	store := k.environment.KVStoreService.OpenKVStore(ctx)
 	return store.Delete(types.PlanKey())
 }
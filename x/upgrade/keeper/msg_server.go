@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type msgServer struct {
@@ -24,6 +27,7 @@ func NewMsgServerImpl(k *Keeper) types.MsgServer {
 var (
 	_    types.MsgServer = msgServer{}
 	_, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}
+	_    sdk.Msg         = &types.MsgPostponeUpgrade{}
 )
 
 // SoftwareUpgrade implements the Msg/SoftwareUpgrade Msg service.
@@ -40,16 +44,81 @@ func (k msgServer) SoftwareUpgrade(ctx context.Context, msg *types.MsgSoftwareUp
 	return &types.MsgSoftwareUpgradeResponse{}, nil
 }
 
-// CancelUpgrade implements the Msg/CancelUpgrade Msg service.
+// CancelUpgrade implements the Msg/CancelUpgrade Msg service. With an authority
+// policy, each message is an approval of the cancellation by an authority of the
+// policy, and the plan is cancelled once the threshold is met.
 func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
-	if k.authority != msg.Authority {
-		return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	if !k.authorityPolicy.Enabled() {
+		if k.authority != msg.Authority {
+			return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+		}
+
+		err := k.ClearUpgradePlan(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.MsgCancelUpgradeResponse{}, nil
 	}
 
-	err := k.ClearUpgradePlan(ctx)
+	plan, err := k.GetUpgradePlan(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	approved, err := k.approveUpgradeAction(ctx, msg.Authority, plan, 0)
+	if err != nil || !approved {
+		return &types.MsgCancelUpgradeResponse{}, err
+	}
+
+	if err := k.ClearUpgradePlan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCancelUpgrade,
+		event.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(plan.Height, 10)),
+	); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// PostponeUpgrade implements the Msg/PostponeUpgrade Msg service. With an
+// authority policy, each message is an approval of the postponement to the new
+// height by an authority of the policy, and the plan is postponed once the
+// threshold is met.
+func (k msgServer) PostponeUpgrade(ctx context.Context, msg *types.MsgPostponeUpgrade) (*types.MsgPostponeUpgradeResponse, error) {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.NewHeight <= plan.Height {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "new height %d must be after the plan height %d", msg.NewHeight, plan.Height)
+	}
+
+	approved, err := k.approveUpgradeAction(ctx, msg.Authority, plan, msg.NewHeight)
+	if err != nil || !approved {
+		return &types.MsgPostponeUpgradeResponse{}, err
+	}
+
+	height := plan.Height
+	plan.Height = msg.NewHeight
+	if err := k.ScheduleUpgrade(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypePostponeUpgrade,
+		event.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+		event.NewAttribute(types.AttributeKeyNewHeight, strconv.FormatInt(plan.Height, 10)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPostponeUpgradeResponse{}, nil
+}
@@ -2,6 +2,9 @@ package keeper_test
 
 import (
 	"cosmossdk.io/x/upgrade/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 )
 
 func (s *KeeperTestSuite) TestSoftwareUpgrade() {
@@ -130,3 +133,119 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestPostponeUpgrade() {
+	_, err := s.msgSrvr.PostponeUpgrade(s.ctx, &types.MsgPostponeUpgrade{Authority: s.encodedAuthority, NewHeight: 200})
+	s.Require().ErrorIs(err, types.ErrNoUpgradePlanFound)
+
+	plan := types.Plan{Name: "some name", Info: "some info", Height: 100}
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgPostponeUpgrade
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unauthorized authority address",
+			&types.MsgPostponeUpgrade{
+				Authority: s.encodedAddrs[0],
+				NewHeight: 200,
+			},
+			true,
+			"expected authority account as only signer for proposal message",
+		},
+		{
+			"new height before the plan height",
+			&types.MsgPostponeUpgrade{
+				Authority: s.encodedAuthority,
+				NewHeight: 100,
+			},
+			true,
+			"must be after the plan height",
+		},
+		{
+			"upgrade postponed successfully",
+			&types.MsgPostponeUpgrade{
+				Authority: s.encodedAuthority,
+				NewHeight: 200,
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.PostponeUpgrade(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				postponed, err := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.req.NewHeight, postponed.Height)
+				s.Require().Equal(plan.Name, postponed.Name)
+				s.Require().Equal(plan.Info, postponed.Info)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAuthorityPolicy() {
+	ac := addresscodec.NewBech32Codec("cosmos")
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	council, err := ac.BytesToString(addrs[1])
+	s.Require().NoError(err)
+	emergency, err := ac.BytesToString(addrs[2])
+	s.Require().NoError(err)
+
+	s.Require().Error(s.upgradeKeeper.SetAuthorityPolicy(types.AuthorityPolicy{Authorities: []string{council}, Threshold: 2}))
+	s.Require().Error(s.upgradeKeeper.SetAuthorityPolicy(types.AuthorityPolicy{Authorities: []string{council, council}, Threshold: 2}))
+	// 2-of-3 of gov, a security council and an emergency key
+	s.Require().NoError(s.upgradeKeeper.SetAuthorityPolicy(types.AuthorityPolicy{
+		Authorities: []string{s.encodedAuthority, council, emergency},
+		Threshold:   2,
+	}))
+
+	plan := types.Plan{Name: "some name", Height: 100}
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+
+	// the authorities outside of the policy are rejected
+	_, err = s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: s.encodedAddrs[0]})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+	_, err = s.msgSrvr.PostponeUpgrade(s.ctx, &types.MsgPostponeUpgrade{Authority: s.encodedAddrs[0], NewHeight: 200})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	// approvals of different heights, or of a cancellation, are not combined
+	_, err = s.msgSrvr.PostponeUpgrade(s.ctx, &types.MsgPostponeUpgrade{Authority: council, NewHeight: 200})
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.PostponeUpgrade(s.ctx, &types.MsgPostponeUpgrade{Authority: emergency, NewHeight: 300})
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: s.encodedAuthority})
+	s.Require().NoError(err)
+	current, err := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(plan, current)
+
+	// the second approval of the same height postpones the plan
+	_, err = s.msgSrvr.PostponeUpgrade(s.ctx, &types.MsgPostponeUpgrade{Authority: emergency, NewHeight: 200})
+	s.Require().NoError(err)
+	current, err = s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(200), current.Height)
+
+	// the approvals of actions on the old plan are cleared
+	_, err = s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: council})
+	s.Require().NoError(err)
+	_, err = s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: s.encodedAuthority})
+	s.Require().NoError(err)
+	_, err = s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().ErrorIs(err, types.ErrNoUpgradePlanFound)
+
+	_, err = s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: council})
+	s.Require().ErrorIs(err, types.ErrNoUpgradePlanFound)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+// AuthorityPolicy is a threshold policy of the authorities allowed to cancel or
+// postpone the scheduled upgrade in place of the module authority, e.g. 2-of-3
+// of the governance module, a security council group and an emergency key. An
+// action is executed once Threshold of the Authorities approved it.
+type AuthorityPolicy struct {
+	Authorities []string
+	Threshold   uint32
+}
+
+// Enabled returns true if the policy has a threshold.
+func (p AuthorityPolicy) Enabled() bool {
+	return p.Threshold > 0
+}
+
+// HasAuthority returns true if the address is an authority of the policy.
+func (p AuthorityPolicy) HasAuthority(addr string) bool {
+	return slices.Contains(p.Authorities, addr)
+}
+
+// Validate returns an error if the threshold of an enabled policy cannot be met
+// by its authorities, or if an authority is empty or repeated.
+func (p AuthorityPolicy) Validate() error {
+	if !p.Enabled() {
+		return nil
+	}
+
+	if int(p.Threshold) > len(p.Authorities) {
+		return fmt.Errorf("threshold %d is greater than the number of authorities %d", p.Threshold, len(p.Authorities))
+	}
+
+	seen := make(map[string]bool, len(p.Authorities))
+	for _, authority := range p.Authorities {
+		if authority == "" {
+			return fmt.Errorf("empty authority")
+		}
+		if seen[authority] {
+			return fmt.Errorf("duplicate authority %s", authority)
+		}
+		seen[authority] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CheckMigration describes how the module manager RunMigrations handles the
+// module when upgrading the state with the binary, and returns an error when the
+// binary cannot migrate the module from its state version.
+func (v *BinaryModuleVersion) CheckMigration() (string, error) {
+	switch {
+	case v.Version == 0 && v.StateVersion == 0:
+		return "not versioned", nil
+	case v.Version == 0:
+		return "not in the binary, its store must be deleted by the upgrade store loader", nil
+	case v.StateVersion == 0:
+		return fmt.Sprintf("added at version %d, initialized with its default genesis", v.Version), nil
+	case v.StateVersion > v.Version:
+		return "", errorsmod.Wrapf(sdkerrors.ErrInvalidVersion, "module %s cannot be downgraded from version %d to version %d", v.Name, v.StateVersion, v.Version)
+	case v.StateVersion == v.Version || v.Version <= 1:
+		return fmt.Sprintf("up to date at version %d", v.Version), nil
+	}
+
+	for i := v.StateVersion; i < v.Version; i++ {
+		if !slices.Contains(v.MigrationsFrom, i) {
+			return "", errorsmod.Wrapf(sdkerrors.ErrNotFound, "no migration found for module %s from version %d to version %d", v.Name, i, i+1)
+		}
+	}
+
+	return fmt.Sprintf("migrated from version %d to version %d", v.StateVersion, v.Version), nil
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/upgrade/types"
+)
+
+func TestCheckMigration(t *testing.T) {
+	cases := map[string]struct {
+		version types.BinaryModuleVersion
+		desc    string
+		err     string
+	}{
+		"added": {
+			version: types.BinaryModuleVersion{Name: "accounts", Version: 1},
+			desc:    "added at version 1, initialized with its default genesis",
+		},
+		"not versioned": {
+			version: types.BinaryModuleVersion{Name: "runtime"},
+			desc:    "not versioned",
+		},
+		"removed": {
+			version: types.BinaryModuleVersion{Name: "crisis", StateVersion: 2},
+			desc:    "not in the binary, its store must be deleted by the upgrade store loader",
+		},
+		"up to date": {
+			version: types.BinaryModuleVersion{Name: "bank", Version: 4, StateVersion: 4},
+			desc:    "up to date at version 4",
+		},
+		"migrated": {
+			version: types.BinaryModuleVersion{Name: "bank", Version: 4, StateVersion: 2, MigrationsFrom: []uint64{1, 2, 3}},
+			desc:    "migrated from version 2 to version 4",
+		},
+		"missing migration": {
+			version: types.BinaryModuleVersion{Name: "bank", Version: 4, StateVersion: 2, MigrationsFrom: []uint64{1, 2}},
+			err:     "no migration found for module bank from version 3 to version 4",
+		},
+		"downgrade": {
+			version: types.BinaryModuleVersion{Name: "bank", Version: 3, StateVersion: 4, MigrationsFrom: []uint64{1, 2}},
+			err:     "module bank cannot be downgraded from version 4 to version 3",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			desc, err := tc.version.CheckMigration()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.desc, desc)
+		})
+	}
+}
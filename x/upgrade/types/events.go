@@ -0,0 +1,15 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeUpgradeApproval = "upgrade_approval"
+	EventTypeCancelUpgrade   = "cancel_upgrade"
+	EventTypePostponeUpgrade = "postpone_upgrade"
+
+	AttributeKeyAuthority = "authority"
+	AttributeKeyPlanName  = "plan_name"
+	AttributeKeyHeight    = "height"
+	AttributeKeyNewHeight = "new_height"
+	AttributeKeyApprovals = "approvals"
+	AttributeKeyThreshold = "threshold"
+)
@@ -28,6 +28,10 @@ const (
 	// VersionMapByte is a prefix to look up module names (key) and versions (value)
 	VersionMapByte = 0x2
 
+	// ApprovalByte is a prefix to look up the approvals of the authorities of the
+	// authority policy by authority
+	ApprovalByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -44,6 +48,11 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// ApprovalKey is the key under which the approval of the authority is saved
+func ApprovalKey(authority string) []byte {
+	return append([]byte{ApprovalByte}, authority...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -492,6 +492,169 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryBinaryModuleVersionsRequest is the request type for the
+// Query/BinaryModuleVersions RPC method.
+type QueryBinaryModuleVersionsRequest struct {
+}
+
+func (m *QueryBinaryModuleVersionsRequest) Reset()         { *m = QueryBinaryModuleVersionsRequest{} }
+func (m *QueryBinaryModuleVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBinaryModuleVersionsRequest) ProtoMessage()    {}
+func (*QueryBinaryModuleVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryBinaryModuleVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBinaryModuleVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBinaryModuleVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBinaryModuleVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBinaryModuleVersionsRequest.Merge(m, src)
+}
+func (m *QueryBinaryModuleVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBinaryModuleVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBinaryModuleVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBinaryModuleVersionsRequest proto.InternalMessageInfo
+
+// QueryBinaryModuleVersionsResponse is the response type for the
+// Query/BinaryModuleVersions RPC method.
+type QueryBinaryModuleVersionsResponse struct {
+	// module_versions are the versions of the modules of the binary and of the
+	// modules found in state only, sorted by name.
+	ModuleVersions []*BinaryModuleVersion `protobuf:"bytes,1,rep,name=module_versions,json=moduleVersions,proto3" json:"module_versions,omitempty"`
+}
+
+func (m *QueryBinaryModuleVersionsResponse) Reset()         { *m = QueryBinaryModuleVersionsResponse{} }
+func (m *QueryBinaryModuleVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBinaryModuleVersionsResponse) ProtoMessage()    {}
+func (*QueryBinaryModuleVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryBinaryModuleVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBinaryModuleVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBinaryModuleVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBinaryModuleVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBinaryModuleVersionsResponse.Merge(m, src)
+}
+func (m *QueryBinaryModuleVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBinaryModuleVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBinaryModuleVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBinaryModuleVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryBinaryModuleVersionsResponse) GetModuleVersions() []*BinaryModuleVersion {
+	if m != nil {
+		return m.ModuleVersions
+	}
+	return nil
+}
+
+// BinaryModuleVersion is the consensus version of a module in the running
+// binary and in state, with the versions from which the binary can migrate it.
+type BinaryModuleVersion struct {
+	// name of the module.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the consensus version of the module in the binary, 0 when the
+	// module is not part of the binary.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// state_version is the consensus version of the module in state, 0 when the
+	// module is not in state yet.
+	StateVersion uint64 `protobuf:"varint,3,opt,name=state_version,json=stateVersion,proto3" json:"state_version,omitempty"`
+	// migrations_from are the sorted versions from which an in-place store
+	// migration of the module is registered in the binary.
+	MigrationsFrom []uint64 `protobuf:"varint,4,rep,packed,name=migrations_from,json=migrationsFrom,proto3" json:"migrations_from,omitempty"`
+}
+
+func (m *BinaryModuleVersion) Reset()         { *m = BinaryModuleVersion{} }
+func (m *BinaryModuleVersion) String() string { return proto.CompactTextString(m) }
+func (*BinaryModuleVersion) ProtoMessage()    {}
+func (*BinaryModuleVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
+}
+func (m *BinaryModuleVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BinaryModuleVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BinaryModuleVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BinaryModuleVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinaryModuleVersion.Merge(m, src)
+}
+func (m *BinaryModuleVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *BinaryModuleVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinaryModuleVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BinaryModuleVersion proto.InternalMessageInfo
+
+func (m *BinaryModuleVersion) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BinaryModuleVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *BinaryModuleVersion) GetStateVersion() uint64 {
+	if m != nil {
+		return m.StateVersion
+	}
+	return 0
+}
+
+func (m *BinaryModuleVersion) GetMigrationsFrom() []uint64 {
+	if m != nil {
+		return m.MigrationsFrom
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -503,6 +666,9 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryBinaryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryBinaryModuleVersionsRequest")
+	proto.RegisterType((*QueryBinaryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryBinaryModuleVersionsResponse")
+	proto.RegisterType((*BinaryModuleVersion)(nil), "cosmos.upgrade.v1beta1.BinaryModuleVersion")
 }
 
 func init() {
@@ -510,47 +676,55 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0x66, 0x16, 0x44, 0x79, 0x6b, 0xd0, 0x4c, 0xe2, 0x52, 0x2b, 0x59, 0x71, 0x40, 0x85, 0x28,
-	0x1d, 0x58, 0x12, 0x63, 0x30, 0x1a, 0x95, 0x8b, 0x18, 0x25, 0xba, 0x46, 0x0f, 0x5e, 0x36, 0x03,
-	0x9d, 0x2c, 0x8d, 0xdd, 0x4e, 0xe9, 0x4c, 0x89, 0x84, 0x70, 0xf1, 0xe4, 0xd1, 0xc4, 0x78, 0xf5,
-	0xe6, 0xc5, 0x5f, 0xe2, 0x91, 0xc4, 0x8b, 0x07, 0x0f, 0x06, 0xfc, 0x09, 0xfe, 0x00, 0xd3, 0xe9,
-	0x94, 0x74, 0xa1, 0x2d, 0xe8, 0x6d, 0x3b, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0xce, 0xf7, 0xb6, 0x40,
-	0xd6, 0x84, 0xec, 0x09, 0x49, 0xe3, 0xb0, 0x1b, 0x31, 0x97, 0xd3, 0xcd, 0xf9, 0x55, 0xae, 0xd8,
-	0x3c, 0xdd, 0x88, 0x79, 0xb4, 0xe5, 0x84, 0x91, 0x50, 0x02, 0x37, 0x52, 0x8c, 0x63, 0x30, 0x8e,
-	0xc1, 0xd8, 0xe3, 0x5d, 0x21, 0xba, 0x3e, 0xa7, 0x2c, 0xf4, 0x28, 0x0b, 0x02, 0xa1, 0x98, 0xf2,
-	0x44, 0x20, 0x53, 0x96, 0x3d, 0x55, 0xa2, 0x9c, 0xa9, 0x68, 0x14, 0xb9, 0x08, 0x63, 0xcf, 0x93,
-	0x56, 0x4b, 0x71, 0x14, 0xf1, 0x40, 0x3d, 0xf3, 0x59, 0xd0, 0xe6, 0x1b, 0x31, 0x97, 0x8a, 0x3c,
-	0x01, 0xeb, 0x68, 0x49, 0x86, 0x22, 0x90, 0x1c, 0xcf, 0xc1, 0x50, 0xe8, 0xb3, 0xc0, 0x42, 0x13,
-	0x68, 0xba, 0xde, 0x1a, 0x77, 0x8a, 0x1d, 0x3a, 0x9a, 0xa3, 0x91, 0x64, 0xd6, 0x34, 0x7a, 0x10,
-	0x86, 0xbe, 0xc7, 0xdd, 0x5c, 0x23, 0x8c, 0x61, 0x28, 0x60, 0x3d, 0xae, 0xc5, 0x46, 0xda, 0xfa,
-	0x37, 0x69, 0x99, 0xe6, 0x7d, 0x70, 0xd3, 0xbc, 0x01, 0xc3, 0xeb, 0xdc, 0xeb, 0xae, 0x2b, 0xcd,
-	0x18, 0x6c, 0x9b, 0x27, 0xb2, 0x0c, 0x44, 0x73, 0x5e, 0xa6, 0x2e, 0xdc, 0xa5, 0x04, 0x1d, 0xc8,
-	0x58, 0xbe, 0x50, 0x4c, 0xf1, 0xac, 0xdb, 0x65, 0xa8, 0xfb, 0x4c, 0xaa, 0x4e, 0x9f, 0x04, 0x24,
-	0x47, 0x8f, 0xf4, 0xc9, 0x62, 0xcd, 0x42, 0xc4, 0x83, 0xc9, 0x4a, 0x29, 0xe3, 0xe4, 0x36, 0x58,
-	0x66, 0x64, 0xb7, 0xb3, 0x96, 0x41, 0x3a, 0x32, 0xc1, 0x58, 0xb5, 0x09, 0x34, 0x7d, 0xb6, 0xdd,
-	0x88, 0x0b, 0x15, 0x92, 0x26, 0x8f, 0x87, 0xce, 0xa0, 0xf3, 0x35, 0x72, 0x17, 0x6c, 0xdd, 0xea,
-	0xa9, 0x70, 0x63, 0x9f, 0xbf, 0xe2, 0x91, 0x4c, 0x2e, 0x31, 0xe7, 0xb6, 0xa7, 0x0b, 0x9d, 0xdc,
-	0x2b, 0x82, 0xf4, 0x68, 0x25, 0x79, 0x51, 0x3d, 0xb8, 0x54, 0x48, 0x37, 0x0e, 0x57, 0xe0, 0x9c,
-	0xe1, 0x6f, 0x9a, 0x92, 0x85, 0x26, 0x06, 0xa7, 0xeb, 0xad, 0xab, 0x65, 0x77, 0xd6, 0x27, 0xd4,
-	0x1e, 0xed, 0xf5, 0xe9, 0x92, 0x31, 0xb8, 0x90, 0xde, 0x4b, 0xac, 0xd6, 0x45, 0xe4, 0xa9, 0xad,
-	0x2c, 0x2d, 0x2d, 0x68, 0x1c, 0x2e, 0x18, 0x0b, 0x16, 0x9c, 0x66, 0xae, 0x1b, 0x71, 0x29, 0x8d,
-	0xfd, 0xec, 0xb1, 0xf5, 0x67, 0x18, 0x4e, 0x69, 0x12, 0xfe, 0x8c, 0xa0, 0x9e, 0xcb, 0x19, 0xa6,
-	0x65, 0xee, 0x4a, 0xc2, 0x6a, 0xcf, 0x9d, 0x9c, 0x90, 0xda, 0x22, 0x37, 0xdf, 0x7d, 0xff, 0xfd,
-	0xb1, 0x76, 0x0d, 0x4f, 0xd1, 0x92, 0x45, 0x59, 0x4b, 0x49, 0x9d, 0x24, 0xbe, 0xf8, 0x0b, 0x82,
-	0x7a, 0x2e, 0x8b, 0xc7, 0x18, 0x3c, 0x1a, 0xf2, 0x63, 0x0c, 0x16, 0xc4, 0x9c, 0x2c, 0x68, 0x83,
-	0xb3, 0xf8, 0x46, 0x99, 0x41, 0x96, 0x92, 0xb4, 0x41, 0xba, 0x9d, 0xe4, 0x63, 0x07, 0xff, 0x44,
-	0xd0, 0x28, 0x0e, 0x2d, 0x5e, 0xac, 0x74, 0x50, 0xb9, 0x34, 0xf6, 0x9d, 0xff, 0xe2, 0x9a, 0x41,
-	0x96, 0xf5, 0x20, 0xf7, 0xf1, 0x3d, 0x5a, 0xfd, 0x97, 0x74, 0x64, 0x87, 0xe8, 0x76, 0x6e, 0x53,
-	0x77, 0xde, 0xd7, 0x10, 0xfe, 0x8a, 0x60, 0xb4, 0x3f, 0xe9, 0xb8, 0x55, 0x69, 0xad, 0x70, 0xab,
-	0xec, 0x85, 0x7f, 0xe2, 0x98, 0x31, 0xa8, 0x1e, 0x63, 0x06, 0x5f, 0x2f, 0x1b, 0xe3, 0xd0, 0xa2,
-	0xe1, 0x4f, 0x08, 0x46, 0x0e, 0xd6, 0x01, 0xcf, 0x56, 0x07, 0xe0, 0xd0, 0x3e, 0xd9, 0xce, 0x49,
-	0xe1, 0xc6, 0xdd, 0x8c, 0x76, 0x37, 0x89, 0xaf, 0x94, 0xa6, 0x25, 0xa3, 0x3c, 0xbc, 0xf5, 0x6d,
-	0xaf, 0x89, 0x76, 0xf7, 0x9a, 0xe8, 0xd7, 0x5e, 0x13, 0x7d, 0xd8, 0x6f, 0x0e, 0xec, 0xee, 0x37,
-	0x07, 0x7e, 0xec, 0x37, 0x07, 0x5e, 0x8f, 0xa7, 0x5c, 0xe9, 0xbe, 0x71, 0x3c, 0x41, 0xdf, 0x1e,
-	0x68, 0xa8, 0xad, 0x90, 0xcb, 0xd5, 0x61, 0xfd, 0xc9, 0x58, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0x77, 0x58, 0xe1, 0xb4, 0x06, 0x00, 0x00,
+	// 756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0xd4, 0x4c,
+	0x18, 0x66, 0x76, 0xf7, 0xe3, 0x83, 0x77, 0xf9, 0xe0, 0xcb, 0x7c, 0x9f, 0x4b, 0xad, 0x64, 0x5d,
+	0x0a, 0xca, 0x12, 0xa4, 0x5d, 0x96, 0x84, 0x20, 0x46, 0xa3, 0x90, 0x18, 0x31, 0x4a, 0x74, 0xfd,
+	0x71, 0xf0, 0xd2, 0x14, 0x3a, 0x2e, 0x8d, 0xdb, 0x4e, 0xe9, 0xb4, 0xc4, 0x0d, 0xe1, 0xe2, 0xc9,
+	0xa3, 0xc6, 0x78, 0xf5, 0xe6, 0xc5, 0x3f, 0xc1, 0xab, 0x17, 0x8f, 0x24, 0x5e, 0x3c, 0x78, 0x30,
+	0xe0, 0x1f, 0x62, 0x3a, 0x9d, 0xe2, 0xfe, 0x68, 0x2b, 0x72, 0xdb, 0xce, 0x3c, 0xcf, 0xf3, 0x3e,
+	0xcf, 0xcc, 0xbc, 0x6f, 0x16, 0x94, 0x2d, 0xca, 0x6c, 0xca, 0xb4, 0xc0, 0x6d, 0x7a, 0x86, 0x49,
+	0xb4, 0xdd, 0x85, 0x4d, 0xe2, 0x1b, 0x0b, 0xda, 0x4e, 0x40, 0xbc, 0xb6, 0xea, 0x7a, 0xd4, 0xa7,
+	0xb8, 0x14, 0x61, 0x54, 0x81, 0x51, 0x05, 0x46, 0x9e, 0x68, 0x52, 0xda, 0x6c, 0x11, 0xcd, 0x70,
+	0x2d, 0xcd, 0x70, 0x1c, 0xea, 0x1b, 0xbe, 0x45, 0x1d, 0x16, 0xb1, 0xe4, 0xe9, 0x14, 0xe5, 0x58,
+	0x85, 0xa3, 0x94, 0xb3, 0x30, 0x7e, 0x3f, 0x2c, 0xb5, 0x16, 0x78, 0x1e, 0x71, 0xfc, 0x7b, 0x2d,
+	0xc3, 0x69, 0x90, 0x9d, 0x80, 0x30, 0x5f, 0xb9, 0x03, 0x52, 0xff, 0x16, 0x73, 0xa9, 0xc3, 0x08,
+	0xae, 0x41, 0xc1, 0x6d, 0x19, 0x8e, 0x84, 0x2a, 0xa8, 0x5a, 0xac, 0x4f, 0xa8, 0xc9, 0x0e, 0x55,
+	0xce, 0xe1, 0x48, 0x65, 0x5e, 0x14, 0xba, 0xe1, 0xba, 0x2d, 0x8b, 0x98, 0x1d, 0x85, 0x30, 0x86,
+	0x82, 0x63, 0xd8, 0x84, 0x8b, 0x0d, 0x37, 0xf8, 0x6f, 0xa5, 0x0e, 0x52, 0x3f, 0x5c, 0x14, 0x2f,
+	0xc1, 0xe0, 0x36, 0xb1, 0x9a, 0xdb, 0x3e, 0x67, 0xe4, 0x1b, 0xe2, 0x4b, 0x59, 0x07, 0x85, 0x73,
+	0x1e, 0x45, 0x2e, 0xcc, 0xb5, 0x10, 0xed, 0xb0, 0x80, 0x3d, 0xf0, 0x0d, 0x9f, 0xc4, 0xd5, 0xce,
+	0x43, 0xb1, 0x65, 0x30, 0x5f, 0xef, 0x92, 0x80, 0x70, 0xe9, 0x16, 0x5f, 0x59, 0xc9, 0x49, 0x48,
+	0xb1, 0x60, 0x2a, 0x53, 0x4a, 0x38, 0x59, 0x06, 0x49, 0x44, 0x36, 0xf5, 0xad, 0x18, 0xa2, 0xb3,
+	0x10, 0x23, 0xe5, 0x2a, 0xa8, 0x3a, 0xd2, 0x28, 0x05, 0x89, 0x0a, 0x61, 0x91, 0xdb, 0x85, 0x21,
+	0xf4, 0x6f, 0x4e, 0xb9, 0x0a, 0x32, 0x2f, 0x75, 0x97, 0x9a, 0x41, 0x8b, 0x3c, 0x26, 0x1e, 0x0b,
+	0x2f, 0xb1, 0xc3, 0xad, 0xcd, 0x37, 0xf4, 0x8e, 0x23, 0x82, 0x68, 0x69, 0x23, 0x3c, 0x28, 0x1b,
+	0xce, 0x25, 0xd2, 0x85, 0xc3, 0x0d, 0x18, 0x13, 0xfc, 0x5d, 0xb1, 0x25, 0xa1, 0x4a, 0xbe, 0x5a,
+	0xac, 0x5f, 0x48, 0xbb, 0xb3, 0x2e, 0xa1, 0xc6, 0xa8, 0xdd, 0xa5, 0xab, 0x8c, 0xc3, 0x99, 0xe8,
+	0x5e, 0x02, 0x7f, 0x9b, 0x7a, 0x96, 0xdf, 0x8e, 0x5f, 0x4b, 0x1d, 0x4a, 0xbd, 0x1b, 0xc2, 0x82,
+	0x04, 0x7f, 0x1b, 0xa6, 0xe9, 0x11, 0xc6, 0x84, 0xfd, 0xf8, 0x53, 0x51, 0xa0, 0xc2, 0x39, 0xab,
+	0x96, 0x63, 0xa4, 0x1c, 0x80, 0xd2, 0x86, 0xc9, 0x0c, 0x8c, 0x28, 0xf1, 0x30, 0x2d, 0xe5, 0x5c,
+	0x5a, 0xca, 0x04, 0xb9, 0xbe, 0xac, 0xaf, 0x11, 0xfc, 0x97, 0x80, 0x4b, 0x7a, 0xaf, 0x61, 0x48,
+	0x51, 0x9a, 0x5f, 0x7c, 0xa1, 0x11, 0x7f, 0xe2, 0x29, 0xf8, 0x87, 0x3f, 0x88, 0xd8, 0x9a, 0x94,
+	0xe7, 0xfb, 0x23, 0x7c, 0x31, 0x96, 0x9c, 0x81, 0x31, 0xdb, 0x6a, 0x7a, 0x51, 0x03, 0xeb, 0x4f,
+	0x3d, 0x6a, 0x4b, 0x85, 0x4a, 0xbe, 0x5a, 0x68, 0x8c, 0xfe, 0x5a, 0xbe, 0xe9, 0x51, 0xbb, 0xfe,
+	0x71, 0x08, 0xfe, 0xe2, 0xe7, 0x81, 0xdf, 0x21, 0x28, 0x76, 0xb4, 0x26, 0xd6, 0xd2, 0xa2, 0xa6,
+	0xf4, 0xb7, 0x5c, 0x3b, 0x39, 0x21, 0x3a, 0x66, 0xe5, 0xd2, 0x8b, 0x2f, 0x3f, 0xde, 0xe4, 0x2e,
+	0xe2, 0x69, 0x2d, 0x65, 0xb6, 0x6c, 0x45, 0x24, 0x3d, 0xec, 0x78, 0xfc, 0x1e, 0x41, 0xb1, 0xa3,
+	0x7d, 0x7f, 0x63, 0xb0, 0x7f, 0x2e, 0xc8, 0xb5, 0x93, 0x13, 0x84, 0xc1, 0x45, 0x6e, 0x70, 0x1e,
+	0xcf, 0xa5, 0x19, 0x34, 0x22, 0x12, 0x37, 0xa8, 0xed, 0x85, 0x37, 0xb7, 0x8f, 0xbf, 0x21, 0x28,
+	0x25, 0xf7, 0x39, 0x5e, 0xc9, 0x74, 0x90, 0x39, 0x67, 0xe4, 0x2b, 0xa7, 0xe2, 0x8a, 0x20, 0xeb,
+	0x3c, 0xc8, 0x75, 0x7c, 0x4d, 0xcb, 0x9e, 0xe2, 0x7d, 0x63, 0x47, 0xdb, 0xeb, 0x18, 0x6e, 0xfb,
+	0x2f, 0x73, 0x08, 0x7f, 0x40, 0x30, 0xda, 0xdd, 0x36, 0xb8, 0x9e, 0x69, 0x2d, 0xb1, 0x0f, 0xe5,
+	0xc5, 0x3f, 0xe2, 0x88, 0x18, 0x1a, 0x8f, 0x31, 0x8b, 0x67, 0xd2, 0x62, 0xf4, 0x74, 0x2d, 0x7e,
+	0x8b, 0x60, 0xf8, 0x78, 0x82, 0xe0, 0xf9, 0xec, 0x07, 0xd0, 0x33, 0x82, 0x64, 0xf5, 0xa4, 0x70,
+	0xe1, 0x6e, 0x96, 0xbb, 0x9b, 0xc2, 0x93, 0xa9, 0xaf, 0xe5, 0xd8, 0xc9, 0x27, 0x04, 0xff, 0x27,
+	0x4d, 0x20, 0xbc, 0x9c, 0x59, 0x33, 0x63, 0xb0, 0xc9, 0x97, 0x4f, 0xc1, 0x14, 0xc6, 0x97, 0xb8,
+	0xf1, 0x1a, 0x56, 0xd3, 0x8c, 0x6f, 0x72, 0xb6, 0xde, 0x73, 0xba, 0xab, 0x4b, 0x9f, 0x0f, 0xcb,
+	0xe8, 0xe0, 0xb0, 0x8c, 0xbe, 0x1f, 0x96, 0xd1, 0xab, 0xa3, 0xf2, 0xc0, 0xc1, 0x51, 0x79, 0xe0,
+	0xeb, 0x51, 0x79, 0xe0, 0xc9, 0x44, 0x24, 0xc4, 0xcc, 0x67, 0xaa, 0x45, 0xb5, 0xe7, 0xc7, 0x82,
+	0x7e, 0xdb, 0x25, 0x6c, 0x73, 0x90, 0xff, 0x57, 0x58, 0xfc, 0x39, 0x00, 0x3d, 0x17, 0xe2, 0x76,
+	0xad, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -584,6 +758,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// BinaryModuleVersions queries the consensus versions of the modules of the
+	// running binary, the versions from which they can be migrated, and their
+	// versions in state.
+	BinaryModuleVersions(ctx context.Context, in *QueryBinaryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryBinaryModuleVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -640,6 +818,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) BinaryModuleVersions(ctx context.Context, in *QueryBinaryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryBinaryModuleVersionsResponse, error) {
+	out := new(QueryBinaryModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/BinaryModuleVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -661,6 +848,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// BinaryModuleVersions queries the consensus versions of the modules of the
+	// running binary, the versions from which they can be migrated, and their
+	// versions in state.
+	BinaryModuleVersions(context.Context, *QueryBinaryModuleVersionsRequest) (*QueryBinaryModuleVersionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -682,6 +873,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) BinaryModuleVersions(ctx context.Context, req *QueryBinaryModuleVersionsRequest) (*QueryBinaryModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryModuleVersions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -777,6 +971,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BinaryModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBinaryModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BinaryModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/BinaryModuleVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BinaryModuleVersions(ctx, req.(*QueryBinaryModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -801,6 +1013,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "BinaryModuleVersions",
+			Handler:    _Query_BinaryModuleVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1100,6 +1316,124 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBinaryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBinaryModuleVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBinaryModuleVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBinaryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBinaryModuleVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBinaryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BinaryModuleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BinaryModuleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BinaryModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigrationsFrom) > 0 {
+		dAtA3 := make([]byte, len(m.MigrationsFrom)*10)
+		var j2 int
+		for _, num := range m.MigrationsFrom {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StateVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StateVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1233,6 +1567,56 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBinaryModuleVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBinaryModuleVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for _, e := range m.ModuleVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BinaryModuleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.StateVersion != 0 {
+		n += 1 + sovQuery(uint64(m.StateVersion))
+	}
+	if len(m.MigrationsFrom) > 0 {
+		l = 0
+		for _, e := range m.MigrationsFrom {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1977,6 +2361,336 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBinaryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBinaryModuleVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBinaryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBinaryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBinaryModuleVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBinaryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, &BinaryModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BinaryModuleVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinaryModuleVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinaryModuleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateVersion", wireType)
+			}
+			m.StateVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MigrationsFrom = append(m.MigrationsFrom, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MigrationsFrom) == 0 {
+					m.MigrationsFrom = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MigrationsFrom = append(m.MigrationsFrom, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationsFrom", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
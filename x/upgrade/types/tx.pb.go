@@ -131,7 +131,8 @@ var xxx_messageInfo_MsgSoftwareUpgradeResponse proto.InternalMessageInfo
 //
 // Since: cosmos-sdk 0.46
 type MsgCancelUpgrade struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	// authority is the address that controls the module (defaults to x/gov unless overwritten),
+	// or an authority of the upgrade authority policy if the module has one.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
@@ -214,42 +215,143 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgPostponeUpgrade is the Msg/PostponeUpgrade request type.
+type MsgPostponeUpgrade struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten),
+	// or an authority of the upgrade authority policy if the module has one.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// new_height is the height the scheduled upgrade is postponed to. It must be
+	// after the current height of the plan.
+	NewHeight int64 `protobuf:"varint,2,opt,name=new_height,json=newHeight,proto3" json:"new_height,omitempty"`
+}
+
+func (m *MsgPostponeUpgrade) Reset()         { *m = MsgPostponeUpgrade{} }
+func (m *MsgPostponeUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgPostponeUpgrade) ProtoMessage()    {}
+func (*MsgPostponeUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgPostponeUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostponeUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostponeUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostponeUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostponeUpgrade.Merge(m, src)
+}
+func (m *MsgPostponeUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostponeUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostponeUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostponeUpgrade proto.InternalMessageInfo
+
+func (m *MsgPostponeUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgPostponeUpgrade) GetNewHeight() int64 {
+	if m != nil {
+		return m.NewHeight
+	}
+	return 0
+}
+
+// MsgPostponeUpgradeResponse is the Msg/PostponeUpgrade response type.
+type MsgPostponeUpgradeResponse struct {
+}
+
+func (m *MsgPostponeUpgradeResponse) Reset()         { *m = MsgPostponeUpgradeResponse{} }
+func (m *MsgPostponeUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPostponeUpgradeResponse) ProtoMessage()    {}
+func (*MsgPostponeUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgPostponeUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostponeUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostponeUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostponeUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostponeUpgradeResponse.Merge(m, src)
+}
+func (m *MsgPostponeUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostponeUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostponeUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostponeUpgradeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgPostponeUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgPostponeUpgrade")
+	proto.RegisterType((*MsgPostponeUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgPostponeUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0xda, 0xcd, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x19, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xac, 0xeb, 0xf9, 0x06, 0x2d, 0x59, 0x88,
-	0x01, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x98, 0xce, 0x54, 0x92, 0xe1, 0x92, 0xc2, 0x14, 0x0d, 0x4a,
-	0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x55, 0xaa, 0xe2, 0x12, 0xf0, 0x2d, 0x4e, 0x77, 0x4e, 0xcc,
-	0x4b, 0x4e, 0xcd, 0xa1, 0xd0, 0x63, 0x56, 0x7a, 0x98, 0x6e, 0x93, 0x46, 0x75, 0x1b, 0x8a, 0x3d,
-	0x4a, 0x52, 0x5c, 0x12, 0xe8, 0x62, 0x30, 0x77, 0x19, 0xbd, 0x61, 0xe4, 0x62, 0xf6, 0x2d, 0x4e,
-	0x17, 0x2a, 0xe4, 0xe2, 0x47, 0x0f, 0x77, 0x2d, 0x5c, 0x21, 0x86, 0xe9, 0x4d, 0x29, 0x23, 0xe2,
-	0xd5, 0xc2, 0xac, 0x16, 0xca, 0xe6, 0xe2, 0x45, 0x0d, 0x0f, 0x0d, 0x3c, 0x86, 0xa0, 0xa8, 0x94,
-	0x32, 0x20, 0x56, 0x25, 0xcc, 0x32, 0x29, 0xd6, 0x06, 0x50, 0xe4, 0x3a, 0x99, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x0c, 0xc4, 0xc4, 0xe2, 0x94, 0x6c, 0xbd, 0xcc,
-	0x7c, 0xfd, 0x0a, 0x78, 0xb2, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xa7, 0x50, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0x8c, 0x8c, 0xfe, 0x5f, 0x03, 0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x2d, 0x20, 0xf9, 0x10, 0x2a, 0x58, 0x15, 0x98, 0x23, 0x75, 0x2b, 0x8b, 0x21,
+	0xb2, 0x54, 0x5f, 0x63, 0xa4, 0x0e, 0x65, 0x22, 0x2c, 0x2c, 0x91, 0x2a, 0x57, 0x2c, 0x2c, 0xd5,
+	0xb5, 0x3e, 0x2e, 0x56, 0x92, 0x3b, 0xd7, 0x77, 0x6d, 0x5a, 0x26, 0xc4, 0xc8, 0xc4, 0x17, 0x60,
+	0x67, 0xcc, 0xc0, 0xc6, 0x17, 0xe8, 0x58, 0x31, 0x31, 0x01, 0x4a, 0x86, 0x7c, 0x0d, 0x14, 0x9f,
+	0x9d, 0xca, 0x36, 0xad, 0x2c, 0x65, 0x49, 0xac, 0xf7, 0x7e, 0xf7, 0x7f, 0xff, 0xf7, 0x9e, 0xcf,
+	0x70, 0xf3, 0x58, 0xc8, 0xa1, 0x90, 0xf8, 0x34, 0x66, 0x09, 0x09, 0x29, 0x3e, 0x6b, 0x1f, 0x51,
+	0x45, 0xda, 0x58, 0x9d, 0x7b, 0x71, 0x22, 0x94, 0x30, 0x1f, 0x6b, 0xc0, 0xcb, 0x00, 0x2f, 0x03,
+	0xd0, 0x3a, 0x13, 0x4c, 0xa4, 0x08, 0x9e, 0x3f, 0x69, 0x1a, 0x3d, 0xd5, 0xf4, 0xa1, 0x4e, 0x64,
+	0x47, 0x75, 0xea, 0xf9, 0x0d, 0x95, 0x72, 0x61, 0x4d, 0x3d, 0xc9, 0xa8, 0xa1, 0x64, 0xf8, 0xac,
+	0x3d, 0xff, 0xcb, 0x12, 0x8f, 0xc8, 0x30, 0xe2, 0x02, 0xa7, 0xbf, 0x3a, 0xe4, 0xfc, 0x00, 0xd0,
+	0xec, 0x4a, 0x76, 0x20, 0xde, 0xab, 0x11, 0x49, 0xe8, 0x5b, 0x2d, 0x64, 0xee, 0x42, 0x83, 0x9c,
+	0xaa, 0x9e, 0x48, 0x22, 0x75, 0x61, 0x81, 0x2d, 0xd0, 0x32, 0x3a, 0xd6, 0xcf, 0xef, 0xdb, 0xeb,
+	0x99, 0x9b, 0x57, 0x61, 0x98, 0x50, 0x29, 0x0f, 0x54, 0x12, 0x71, 0x16, 0x5c, 0xa3, 0xe6, 0x4b,
+	0x78, 0x27, 0x1e, 0x10, 0x6e, 0xad, 0x6c, 0x81, 0xd6, 0x7d, 0xbf, 0xe9, 0xfd, 0xbf, 0x71, 0x6f,
+	0x7f, 0x40, 0x78, 0xc7, 0xb8, 0xfc, 0xbd, 0xd9, 0xf8, 0x36, 0x1b, 0xbb, 0x20, 0x48, 0x0f, 0xed,
+	0xed, 0x7c, 0x9a, 0x8d, 0xdd, 0x6b, 0xb1, 0xcf, 0xb3, 0xb1, 0xbb, 0xa1, 0x05, 0xb6, 0x65, 0xd8,
+	0xc7, 0x55, 0x9b, 0x4e, 0x13, 0xa2, 0x6a, 0x34, 0xa0, 0x32, 0x16, 0x5c, 0x52, 0xe7, 0x03, 0x7c,
+	0xd8, 0x95, 0xec, 0x35, 0xe1, 0xc7, 0x74, 0xb0, 0x64, 0x63, 0x7b, 0x5e, 0xd5, 0xdb, 0xb3, 0xa2,
+	0xb7, 0x42, 0x1d, 0x07, 0x41, 0xab, 0x1c, 0x5b, 0xf8, 0xfa, 0xaa, 0x67, 0xbe, 0x2f, 0xa4, 0x8a,
+	0x05, 0x5f, 0x7a, 0xe6, 0x1b, 0x10, 0x72, 0x3a, 0x3a, 0xec, 0xd1, 0x88, 0xf5, 0x54, 0x3a, 0xf9,
+	0xd5, 0xc0, 0xe0, 0x74, 0xf4, 0x26, 0x0d, 0xd4, 0x98, 0x6a, 0xc9, 0x48, 0x36, 0xd5, 0x52, 0x34,
+	0x77, 0xef, 0xff, 0x59, 0x81, 0xab, 0x5d, 0xc9, 0xcc, 0x13, 0xb8, 0x56, 0x7e, 0x6b, 0xdc, 0x9b,
+	0xf6, 0x5d, 0x5d, 0x12, 0xf2, 0xeb, 0xb3, 0x79, 0x69, 0xb3, 0x0f, 0x1f, 0x14, 0xb7, 0xd9, 0xba,
+	0x45, 0xa4, 0x40, 0xa2, 0x9d, 0xba, 0xe4, 0xa2, 0xd8, 0x09, 0x5c, 0x2b, 0x6f, 0xe8, 0xb6, 0xfe,
+	0x4a, 0x2c, 0xf2, 0xeb, 0xb3, 0x79, 0x49, 0x74, 0xf7, 0xe3, 0xfc, 0x36, 0x74, 0x76, 0x2f, 0x27,
+	0x36, 0xb8, 0x9a, 0xd8, 0xe0, 0xef, 0xc4, 0x06, 0x5f, 0xa6, 0x76, 0xe3, 0x6a, 0x6a, 0x37, 0x7e,
+	0x4d, 0xed, 0xc6, 0xbb, 0xa6, 0xd6, 0x94, 0x61, 0xdf, 0x8b, 0x04, 0x3e, 0x5f, 0x7c, 0x07, 0xd4,
+	0x45, 0x4c, 0xe5, 0xd1, 0xbd, 0xf4, 0x4a, 0xbf, 0xf8, 0x37, 0x00, 0xf1, 0x3a, 0x49, 0x18, 0x90,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +375,10 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// PostponeUpgrade is an operation for postponing the scheduled software
+	// upgrade to a later height, e.g. when a bug is found late in the binary of
+	// an imminent upgrade.
+	PostponeUpgrade(ctx context.Context, in *MsgPostponeUpgrade, opts ...grpc.CallOption) (*MsgPostponeUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -301,6 +407,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) PostponeUpgrade(ctx context.Context, in *MsgPostponeUpgrade, opts ...grpc.CallOption) (*MsgPostponeUpgradeResponse, error) {
+	out := new(MsgPostponeUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/PostponeUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -312,6 +427,10 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// PostponeUpgrade is an operation for postponing the scheduled software
+	// upgrade to a later height, e.g. when a bug is found late in the binary of
+	// an imminent upgrade.
+	PostponeUpgrade(context.Context, *MsgPostponeUpgrade) (*MsgPostponeUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -324,6 +443,9 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) PostponeUpgrade(ctx context.Context, req *MsgPostponeUpgrade) (*MsgPostponeUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostponeUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -365,6 +487,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PostponeUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPostponeUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PostponeUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/PostponeUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PostponeUpgrade(ctx, req.(*MsgPostponeUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -377,6 +517,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "PostponeUpgrade",
+			Handler:    _Msg_PostponeUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -498,6 +642,64 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPostponeUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostponeUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostponeUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPostponeUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostponeUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostponeUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -555,6 +757,31 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPostponeUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewHeight != 0 {
+		n += 1 + sovTx(uint64(m.NewHeight))
+	}
+	return n
+}
+
+func (m *MsgPostponeUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -858,6 +1085,157 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPostponeUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPostponeUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPostponeUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeight", wireType)
+			}
+			m.NewHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPostponeUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPostponeUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPostponeUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -225,11 +225,56 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// UpgradeApproval is the approval by an authority of the upgrade authority
+// policy of cancelling or postponing the scheduled upgrade. The action is
+// executed once the threshold of the authorities of the policy approved it.
+type UpgradeApproval struct {
+	// plan_name is the name of the scheduled upgrade plan.
+	PlanName string `protobuf:"bytes,1,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// new_height is the height the plan is postponed to, or 0 if the plan is
+	// cancelled.
+	NewHeight int64 `protobuf:"varint,2,opt,name=new_height,json=newHeight,proto3" json:"new_height,omitempty"`
+}
+
+func (m *UpgradeApproval) Reset()         { *m = UpgradeApproval{} }
+func (m *UpgradeApproval) String() string { return proto.CompactTextString(m) }
+func (*UpgradeApproval) ProtoMessage()    {}
+func (*UpgradeApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *UpgradeApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeApproval.Merge(m, src)
+}
+func (m *UpgradeApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeApproval proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*UpgradeApproval)(nil), "cosmos.upgrade.v1beta1.UpgradeApproval")
 }
 
 func init() {
@@ -237,40 +282,43 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xf7, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0x94, 0x6b, 0x54, 0x9c, 0xc8, 0x62, 0x88,
-	0x2a, 0xd5, 0x56, 0xcb, 0x66, 0x06, 0x44, 0x32, 0x02, 0x52, 0x71, 0x81, 0x81, 0x25, 0xba, 0xc4,
-	0x17, 0xf7, 0x54, 0xfb, 0xce, 0xf2, 0x5d, 0x02, 0x79, 0x05, 0xa6, 0x3e, 0x02, 0x23, 0x62, 0xea,
-	0xc0, 0x43, 0x44, 0x4c, 0x1d, 0x91, 0x90, 0xf8, 0x93, 0x0c, 0x65, 0xe3, 0x15, 0xd0, 0xdd, 0xd9,
-	0x55, 0x04, 0x05, 0x31, 0x74, 0xb1, 0xbe, 0xef, 0xbb, 0xef, 0xe7, 0xdf, 0x1f, 0x9f, 0xe1, 0xdd,
-	0x21, 0x17, 0x19, 0x17, 0xc1, 0x38, 0x4f, 0x0a, 0x1c, 0x93, 0x60, 0xb2, 0x37, 0x20, 0x12, 0xef,
-	0x55, 0xbd, 0x9f, 0x17, 0x5c, 0x72, 0x67, 0xd3, 0x6c, 0xf9, 0xd5, 0xb4, 0xdc, 0x6a, 0x6e, 0x25,
-	0x9c, 0x27, 0x29, 0x09, 0xf4, 0xd6, 0x60, 0x3c, 0x0a, 0x30, 0x9b, 0x1a, 0x48, 0xb3, 0x91, 0xf0,
-	0x84, 0xeb, 0x32, 0x50, 0x55, 0x39, 0x6d, 0xfd, 0x0e, 0x90, 0x34, 0x23, 0x42, 0xe2, 0x2c, 0x2f,
-	0x17, 0xb6, 0x0c, 0x53, 0xdf, 0x20, 0x4b, 0x5a, 0x73, 0x74, 0x03, 0x67, 0x94, 0xf1, 0x40, 0x3f,
-	0xcd, 0xc8, 0xfb, 0x09, 0xa0, 0x7d, 0x90, 0x62, 0xe6, 0x38, 0xd0, 0x66, 0x38, 0x23, 0x08, 0xb4,
-	0x41, 0xa7, 0x16, 0xe9, 0xda, 0x79, 0x00, 0x6d, 0xf5, 0x76, 0xb4, 0xd2, 0x06, 0x9d, 0xfa, 0x7e,
-	0xd3, 0x37, 0xd4, 0x7e, 0x45, 0xed, 0x3f, 0xab, 0xa8, 0xbb, 0x1b, 0xb3, 0x2f, 0x2d, 0xeb, 0xe4,
-	0x6b, 0x0b, 0xbc, 0x3b, 0x3f, 0xdd, 0x01, 0x08, 0x44, 0x1a, 0xe8, 0x6c, 0xc2, 0xf5, 0x23, 0x42,
-	0x93, 0x23, 0x89, 0x56, 0xdb, 0xa0, 0xb3, 0x1a, 0x95, 0x9d, 0x22, 0xa3, 0x6c, 0xc4, 0x91, 0x6d,
-	0xc8, 0x54, 0xed, 0x3c, 0x86, 0xb7, 0xca, 0x70, 0xe2, 0xfe, 0x30, 0xa5, 0x84, 0xc9, 0xbe, 0x90,
-	0x58, 0x12, 0xb4, 0xa6, 0xd9, 0x1b, 0x7f, 0xb0, 0x3f, 0x64, 0xd3, 0xee, 0x0a, 0x02, 0xd1, 0xcd,
-	0x0a, 0xd6, 0xd3, 0xa8, 0x43, 0x05, 0x0a, 0xd1, 0x8f, 0xb7, 0x2d, 0xf0, 0xe6, 0xfc, 0x74, 0x67,
-	0xc3, 0x24, 0xb0, 0x2b, 0xe2, 0xe3, 0x40, 0x19, 0xf5, 0x3e, 0x03, 0x78, 0xfb, 0x90, 0x8f, 0xe4,
-	0x2b, 0x5c, 0x90, 0xe7, 0x06, 0x79, 0x50, 0xf0, 0x9c, 0x0b, 0x9c, 0x3a, 0x0d, 0xb8, 0x26, 0xa9,
-	0x4c, 0xab, 0x14, 0x4c, 0xe3, 0xb4, 0x61, 0x3d, 0x26, 0x62, 0x58, 0xd0, 0x5c, 0x52, 0xce, 0x74,
-	0x1a, 0xb5, 0x68, 0x79, 0xe4, 0xdc, 0x87, 0x76, 0x9e, 0x62, 0xa6, 0x5d, 0xd6, 0xf7, 0xb7, 0xfd,
-	0xcb, 0x3f, 0xb6, 0xaf, 0xf8, 0xbb, 0x35, 0x15, 0x95, 0x8e, 0x29, 0xd2, 0xa0, 0xf0, 0x91, 0x92,
-	0xfa, 0xf1, 0xc3, 0x6e, 0xb3, 0x44, 0x25, 0x7c, 0x72, 0x81, 0xe8, 0x71, 0x26, 0x09, 0x93, 0xca,
-	0x88, 0xb7, 0x64, 0xe4, 0x2f, 0xfa, 0x11, 0xf0, 0xde, 0x03, 0x78, 0xa7, 0x87, 0xd9, 0x90, 0xa4,
-	0x57, 0xec, 0x31, 0x7c, 0xfa, 0x7f, 0x32, 0x3b, 0x4b, 0x32, 0xff, 0x29, 0x04, 0x01, 0xaf, 0x07,
-	0xaf, 0x3f, 0xe1, 0xf1, 0x38, 0x25, 0x2f, 0x48, 0x21, 0x54, 0x8e, 0x97, 0x5d, 0x42, 0x04, 0xaf,
-	0x4d, 0xcc, 0xb1, 0x56, 0x65, 0x47, 0x55, 0x1b, 0xda, 0x4a, 0x51, 0x37, 0x9c, 0x7d, 0x77, 0xad,
-	0xd9, 0xdc, 0x05, 0x67, 0x73, 0x17, 0x7c, 0x9b, 0xbb, 0xe0, 0x64, 0xe1, 0x5a, 0x67, 0x0b, 0xd7,
-	0xfa, 0xb4, 0x70, 0xad, 0x97, 0xdb, 0x46, 0x8e, 0x88, 0x8f, 0x7d, 0xca, 0x83, 0xd7, 0x17, 0x7f,
-	0xa9, 0x9c, 0xe6, 0x44, 0x0c, 0xd6, 0xf5, 0x65, 0xba, 0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x89,
-	0x0e, 0x9c, 0x59, 0xc4, 0x03, 0x00, 0x00,
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xce, 0xa6, 0x6e, 0x7f, 0xbf, 0x6c, 0x84, 0x22, 0x4c, 0x28, 0xdb, 0xd0, 0x3a, 0x91, 0xc5,
+	0x21, 0xaa, 0x54, 0x5b, 0x2d, 0xb7, 0x70, 0x40, 0x4d, 0x2e, 0x48, 0x50, 0x54, 0x5c, 0xe0, 0xc0,
+	0x25, 0xda, 0xc4, 0x1b, 0xd7, 0xaa, 0xb3, 0x63, 0xd9, 0x9b, 0x84, 0xbc, 0x02, 0xa7, 0x3e, 0x02,
+	0x47, 0xc4, 0xa9, 0x07, 0x1e, 0x22, 0xe2, 0xd4, 0x23, 0x12, 0x12, 0x7f, 0x92, 0x43, 0xb9, 0xf1,
+	0x0a, 0x68, 0x77, 0xed, 0x2a, 0x82, 0x82, 0x38, 0x70, 0x89, 0x66, 0x66, 0xe7, 0x9b, 0xef, 0x9b,
+	0x2f, 0x63, 0x7c, 0xa7, 0x0f, 0xe9, 0x10, 0x52, 0x77, 0x14, 0x07, 0x09, 0xf5, 0x99, 0x3b, 0xde,
+	0xed, 0x31, 0x41, 0x77, 0xf3, 0xdc, 0x89, 0x13, 0x10, 0x60, 0xae, 0xeb, 0x2e, 0x27, 0xaf, 0x66,
+	0x5d, 0xb5, 0x8d, 0x00, 0x20, 0x88, 0x98, 0xab, 0xba, 0x7a, 0xa3, 0x81, 0x4b, 0xf9, 0x54, 0x43,
+	0x6a, 0xd5, 0x00, 0x02, 0x50, 0xa1, 0x2b, 0xa3, 0xac, 0x5a, 0xff, 0x19, 0x20, 0xc2, 0x21, 0x4b,
+	0x05, 0x1d, 0xc6, 0x59, 0xc3, 0x86, 0x66, 0xea, 0x6a, 0x64, 0x46, 0xab, 0x9f, 0xae, 0xd3, 0x61,
+	0xc8, 0xc1, 0x55, 0xbf, 0xba, 0x64, 0x7f, 0x47, 0xd8, 0x38, 0x8c, 0x28, 0x37, 0x4d, 0x6c, 0x70,
+	0x3a, 0x64, 0x04, 0x35, 0x50, 0xb3, 0xe4, 0xa9, 0xd8, 0xbc, 0x8f, 0x0d, 0x39, 0x9d, 0x14, 0x1b,
+	0xa8, 0x59, 0xde, 0xab, 0x39, 0x9a, 0xda, 0xc9, 0xa9, 0x9d, 0xa7, 0x39, 0x75, 0xbb, 0x32, 0xfb,
+	0x54, 0x2f, 0x9c, 0x7e, 0xae, 0xa3, 0x37, 0x17, 0x67, 0xdb, 0x88, 0x20, 0x4f, 0x01, 0xcd, 0x75,
+	0xbc, 0x76, 0xcc, 0xc2, 0xe0, 0x58, 0x90, 0x95, 0x06, 0x6a, 0xae, 0x78, 0x59, 0x26, 0xc9, 0x42,
+	0x3e, 0x00, 0x62, 0x68, 0x32, 0x19, 0x9b, 0x8f, 0xf0, 0xcd, 0xcc, 0x1c, 0xbf, 0xdb, 0x8f, 0x42,
+	0xc6, 0x45, 0x37, 0x15, 0x54, 0x30, 0xb2, 0xaa, 0xd8, 0xab, 0xbf, 0xb0, 0xef, 0xf3, 0x69, 0xbb,
+	0x48, 0x90, 0x77, 0x23, 0x87, 0x75, 0x14, 0xea, 0x48, 0x82, 0x5a, 0xe4, 0xdb, 0xeb, 0x3a, 0x7a,
+	0x75, 0x71, 0xb6, 0x5d, 0xd1, 0x0e, 0xec, 0xa4, 0xfe, 0x89, 0x2b, 0x17, 0xb5, 0x3f, 0x22, 0x7c,
+	0xeb, 0x08, 0x06, 0x62, 0x42, 0x13, 0xf6, 0x4c, 0x23, 0x0f, 0x13, 0x88, 0x21, 0xa5, 0x91, 0x59,
+	0xc5, 0xab, 0x22, 0x14, 0x51, 0xee, 0x82, 0x4e, 0xcc, 0x06, 0x2e, 0xfb, 0x2c, 0xed, 0x27, 0x61,
+	0x2c, 0x42, 0xe0, 0xca, 0x8d, 0x92, 0xb7, 0x5c, 0x32, 0xef, 0x61, 0x23, 0x8e, 0x28, 0x57, 0x5b,
+	0x96, 0xf7, 0x36, 0x9d, 0xab, 0xff, 0x6c, 0x47, 0xf2, 0xb7, 0x4b, 0xd2, 0x2a, 0x65, 0x93, 0xa7,
+	0x40, 0xad, 0x87, 0x52, 0xea, 0xfb, 0x77, 0x3b, 0xb5, 0x0c, 0x15, 0xc0, 0xf8, 0x12, 0xd1, 0x01,
+	0x2e, 0x18, 0x17, 0x72, 0x11, 0x7b, 0x69, 0x91, 0xdf, 0xe8, 0x27, 0xc8, 0x7e, 0x8b, 0xf0, 0x56,
+	0x87, 0xf2, 0x3e, 0x8b, 0xfe, 0xf1, 0x8e, 0xad, 0x27, 0x7f, 0x27, 0xb3, 0xb9, 0x24, 0xf3, 0x8f,
+	0x42, 0x08, 0xb2, 0x3b, 0xf8, 0xda, 0x01, 0xf8, 0xa3, 0x88, 0x3d, 0x67, 0x49, 0x1a, 0xc2, 0xd5,
+	0x47, 0x48, 0xf0, 0x7f, 0x63, 0xfd, 0xac, 0x54, 0x19, 0x5e, 0x9e, 0xb6, 0x0c, 0xa9, 0xc8, 0x3e,
+	0xc0, 0x95, 0x6c, 0xf2, 0x7e, 0x1c, 0x27, 0x30, 0xa6, 0x91, 0x79, 0x1b, 0x97, 0xa4, 0xb3, 0xdd,
+	0xa5, 0x59, 0xff, 0xcb, 0xc2, 0x63, 0x39, 0x6f, 0x0b, 0x63, 0xce, 0x26, 0xdd, 0xec, 0x2e, 0x8b,
+	0xea, 0x2e, 0x4b, 0x9c, 0x4d, 0x1e, 0xa8, 0x42, 0xbb, 0x35, 0xfb, 0x6a, 0x15, 0x66, 0x73, 0x0b,
+	0x9d, 0xcf, 0x2d, 0xf4, 0x65, 0x6e, 0xa1, 0xd3, 0x85, 0x55, 0x38, 0x5f, 0x58, 0x85, 0x0f, 0x0b,
+	0xab, 0xf0, 0x62, 0x53, 0x6f, 0x97, 0xfa, 0x27, 0x4e, 0x08, 0xee, 0xcb, 0xcb, 0x8f, 0x5e, 0x4c,
+	0x63, 0x96, 0xf6, 0xd6, 0xd4, 0x6d, 0xde, 0xfd, 0x31, 0x00, 0x3a, 0xc3, 0x79, 0x32, 0x13, 0x04,
+	0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -574,6 +622,41 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpgradeApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewHeight != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.NewHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -663,6 +746,22 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *UpgradeApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.NewHeight != 0 {
+		n += 1 + sovUpgrade(uint64(m.NewHeight))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1233,6 +1332,107 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpgradeApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeight", wireType)
+			}
+			m.NewHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0